@@ -8,32 +8,32 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/usememos/memos/internal/logging"
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/internal/version"
+	"github.com/usememos/memos/plugin/markdown"
 	"github.com/usememos/memos/server"
+	"github.com/usememos/memos/server/runner/memopayload"
 	"github.com/usememos/memos/store"
 	"github.com/usememos/memos/store/db"
 )
 
 var (
+	// logHandler is the handler of the default logger, reconfigured when the runtime settings change.
+	logHandler = logging.NewHandler(os.Stderr)
+
 	rootCmd = &cobra.Command{
 		Use:   "memos",
 		Short: `An open source, lightweight note-taking service. Easily capture and share your great thoughts.`,
 		Run: func(_ *cobra.Command, _ []string) {
-			instanceProfile := &profile.Profile{
-				Mode:        viper.GetString("mode"),
-				Addr:        viper.GetString("addr"),
-				Port:        viper.GetInt("port"),
-				UNIXSock:    viper.GetString("unix-sock"),
-				Data:        viper.GetString("data"),
-				Driver:      viper.GetString("driver"),
-				DSN:         viper.GetString("dsn"),
-				InstanceURL: viper.GetString("instance-url"),
-				Version:     version.GetCurrentVersion(viper.GetString("mode")),
+			instanceProfile, err := newInstanceProfile()
+			if err != nil {
+				panic(err)
 			}
 			if err := instanceProfile.Validate(); err != nil {
 				panic(err)
@@ -82,10 +82,60 @@ var (
 				cancel()
 			}()
 
+			// Reload the runtime settings from the config file on SIGHUP.
+			hup := make(chan os.Signal, 1)
+			signal.Notify(hup, syscall.SIGHUP)
+			go func() {
+				for range hup {
+					reloadRuntimeSettings(instanceProfile)
+				}
+			}()
+
 			// Wait for CTRL-C.
 			<-ctx.Done()
 		},
 	}
+
+	rebuildMemoPayloadsCmd = &cobra.Command{
+		Use:   "rebuild-memo-payloads",
+		Short: "Rebuild the payloads (tags, properties, links) of all memos",
+		Run: func(_ *cobra.Command, _ []string) {
+			instanceProfile, err := newInstanceProfile()
+			if err != nil {
+				panic(err)
+			}
+			if err := instanceProfile.Validate(); err != nil {
+				panic(err)
+			}
+
+			ctx := context.Background()
+			dbDriver, err := db.NewDBDriver(instanceProfile)
+			if err != nil {
+				slog.Error("failed to create db driver", "error", err)
+				return
+			}
+			defer dbDriver.Close()
+
+			storeInstance := store.New(dbDriver, instanceProfile)
+			if err := storeInstance.Migrate(ctx); err != nil {
+				slog.Error("failed to migrate", "error", err)
+				return
+			}
+
+			markdownService := markdown.NewService(
+				markdown.WithTagExtension(),
+			)
+			runner := memopayload.NewRunner(storeInstance, markdownService)
+			progress, err := runner.Rebuild(ctx, func(progress memopayload.Progress) {
+				fmt.Printf("Processed %d memos (%d updated, %d failed)\n", progress.Processed, progress.Updated, progress.Failed)
+			})
+			if err != nil {
+				slog.Error("failed to rebuild memo payloads", "error", err)
+				return
+			}
+			fmt.Printf("Rebuilt memo payloads: %d processed, %d updated, %d failed\n", progress.Processed, progress.Updated, progress.Failed)
+		},
+	}
 )
 
 func init() {
@@ -98,9 +148,24 @@ func init() {
 	rootCmd.PersistentFlags().Int("port", 8081, "port of server")
 	rootCmd.PersistentFlags().String("unix-sock", "", "path to the unix socket, overrides --addr and --port")
 	rootCmd.PersistentFlags().String("data", "", "data directory")
-	rootCmd.PersistentFlags().String("driver", "sqlite", "database driver")
+	rootCmd.PersistentFlags().String("driver", "sqlite", "database driver: sqlite, mysql, postgres or cockroach")
 	rootCmd.PersistentFlags().String("dsn", "", "database source name(aka. DSN)")
 	rootCmd.PersistentFlags().String("instance-url", "", "the url of your memos instance")
+	rootCmd.PersistentFlags().String("sqlite-journal-mode", "WAL", "journal mode of the sqlite database")
+	rootCmd.PersistentFlags().Int("sqlite-busy-timeout", 10000, "busy timeout of the sqlite database in milliseconds")
+	rootCmd.PersistentFlags().Int64("sqlite-mmap-size", 0, "memory-mapped I/O size of the sqlite database in bytes, 0 disables it")
+	rootCmd.PersistentFlags().Int("db-max-open-conns", 0, "maximum number of open database connections, 0 means unlimited")
+	rootCmd.PersistentFlags().Int("db-max-idle-conns", 0, "maximum number of idle database connections, 0 keeps the default")
+	rootCmd.PersistentFlags().Duration("db-conn-max-lifetime", 0, "maximum amount of time a database connection may be reused, 0 means forever")
+	rootCmd.PersistentFlags().String("config", "", "path to a YAML, TOML or JSON config file whose keys are the flag names")
+	rootCmd.PersistentFlags().Duration("db-slow-query-threshold", 0, "log database queries slower than this duration, 0 disables it")
+	rootCmd.PersistentFlags().Duration("request-timeout", 30*time.Second, "default timeout of API requests, 0 disables request timeouts")
+	rootCmd.PersistentFlags().Int("max-request-size-mb", 64, "maximum size of API requests in MiB, 0 means unlimited")
+	rootCmd.PersistentFlags().Bool("enable-profiling", false, "serve the pprof endpoints under /debug/pprof to the host")
+	rootCmd.PersistentFlags().String("log-level", "info", `minimum level of logged messages, can be "debug", "info", "warn" or "error", reloaded on SIGHUP`)
+	rootCmd.PersistentFlags().String("log-format", "text", `format of logged messages, can be "text" or "json", reloaded on SIGHUP`)
+	rootCmd.PersistentFlags().Int("ai-rate-limit", profile.DefaultAIRateLimit, "default number of AI requests a user may make per hour, reloaded on SIGHUP")
+	rootCmd.PersistentFlags().StringSlice("trusted-proxies", nil, "addresses or CIDR ranges of reverse proxies trusted to set X-Forwarded-For, reloaded on SIGHUP")
 
 	if err := viper.BindPFlag("mode", rootCmd.PersistentFlags().Lookup("mode")); err != nil {
 		panic(err)
@@ -126,6 +191,54 @@ func init() {
 	if err := viper.BindPFlag("instance-url", rootCmd.PersistentFlags().Lookup("instance-url")); err != nil {
 		panic(err)
 	}
+	if err := viper.BindPFlag("sqlite-journal-mode", rootCmd.PersistentFlags().Lookup("sqlite-journal-mode")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("sqlite-busy-timeout", rootCmd.PersistentFlags().Lookup("sqlite-busy-timeout")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("sqlite-mmap-size", rootCmd.PersistentFlags().Lookup("sqlite-mmap-size")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("db-max-open-conns", rootCmd.PersistentFlags().Lookup("db-max-open-conns")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("db-max-idle-conns", rootCmd.PersistentFlags().Lookup("db-max-idle-conns")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("db-conn-max-lifetime", rootCmd.PersistentFlags().Lookup("db-conn-max-lifetime")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("db-slow-query-threshold", rootCmd.PersistentFlags().Lookup("db-slow-query-threshold")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("config", rootCmd.PersistentFlags().Lookup("config")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("request-timeout", rootCmd.PersistentFlags().Lookup("request-timeout")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("max-request-size-mb", rootCmd.PersistentFlags().Lookup("max-request-size-mb")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("enable-profiling", rootCmd.PersistentFlags().Lookup("enable-profiling")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("log-level", rootCmd.PersistentFlags().Lookup("log-level")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("log-format", rootCmd.PersistentFlags().Lookup("log-format")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("ai-rate-limit", rootCmd.PersistentFlags().Lookup("ai-rate-limit")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("trusted-proxies", rootCmd.PersistentFlags().Lookup("trusted-proxies")); err != nil {
+		panic(err)
+	}
+
+	rootCmd.AddCommand(rebuildMemoPayloadsCmd)
+	cobra.OnInitialize(initConfig)
 
 	viper.SetEnvPrefix("memos")
 	viper.AutomaticEnv()
@@ -134,6 +247,84 @@ func init() {
 	}
 }
 
+// newInstanceProfile builds the instance profile from the flags, environment variables and config file.
+func newInstanceProfile() (*profile.Profile, error) {
+	runtimeSettings, err := loadRuntimeSettings()
+	if err != nil {
+		return nil, err
+	}
+	if err := applyLogSettings(runtimeSettings); err != nil {
+		return nil, err
+	}
+	return &profile.Profile{
+		Mode:               viper.GetString("mode"),
+		Addr:               viper.GetString("addr"),
+		Port:               viper.GetInt("port"),
+		UNIXSock:           viper.GetString("unix-sock"),
+		Data:               viper.GetString("data"),
+		Driver:             viper.GetString("driver"),
+		DSN:                viper.GetString("dsn"),
+		InstanceURL:        viper.GetString("instance-url"),
+		Version:            version.GetCurrentVersion(viper.GetString("mode")),
+		SQLiteJournalMode:  viper.GetString("sqlite-journal-mode"),
+		SQLiteBusyTimeout:  viper.GetInt("sqlite-busy-timeout"),
+		SQLiteMmapSize:     viper.GetInt64("sqlite-mmap-size"),
+		MaxOpenConns:       viper.GetInt("db-max-open-conns"),
+		MaxIdleConns:       viper.GetInt("db-max-idle-conns"),
+		ConnMaxLifetime:    viper.GetDuration("db-conn-max-lifetime"),
+		SlowQueryThreshold: viper.GetDuration("db-slow-query-threshold"),
+		RequestTimeout:     viper.GetDuration("request-timeout"),
+		MaxRequestSize:     viper.GetInt64("max-request-size-mb") << 20,
+		EnableProfiling:    viper.GetBool("enable-profiling"),
+		Runtime:            profile.NewRuntimeConfig(runtimeSettings),
+	}, nil
+}
+
+func loadRuntimeSettings() (*profile.RuntimeSettings, error) {
+	return profile.ParseRuntimeSettings(viper.GetString("log-level"), viper.GetString("log-format"), viper.GetInt("ai-rate-limit"), viper.GetStringSlice("trusted-proxies"))
+}
+
+// applyLogSettings configures the level and format of the default logger.
+func applyLogSettings(runtimeSettings *profile.RuntimeSettings) error {
+	logHandler.SetLevel(runtimeSettings.LogLevel)
+	return logHandler.SetFormat(runtimeSettings.LogFormat)
+}
+
+// initConfig reads the config file, if any. Its keys are the flag names; flags and
+// environment variables take precedence over it.
+func initConfig() {
+	configFile := viper.GetString("config")
+	if configFile == "" {
+		return
+	}
+	viper.SetConfigFile(configFile)
+	if err := viper.ReadInConfig(); err != nil {
+		panic(err)
+	}
+}
+
+// reloadRuntimeSettings re-reads the config file and applies the settings that can change at runtime.
+// Invalid settings are logged and the current ones are kept.
+func reloadRuntimeSettings(instanceProfile *profile.Profile) {
+	if viper.ConfigFileUsed() != "" {
+		if err := viper.ReadInConfig(); err != nil {
+			slog.Error("failed to read config file", "error", err)
+			return
+		}
+	}
+	runtimeSettings, err := loadRuntimeSettings()
+	if err != nil {
+		slog.Error("failed to reload runtime settings", "error", err)
+		return
+	}
+	if err := applyLogSettings(runtimeSettings); err != nil {
+		slog.Error("failed to apply log settings", "error", err)
+		return
+	}
+	instanceProfile.Runtime.Set(runtimeSettings)
+	slog.Info("reloaded runtime settings", "logLevel", runtimeSettings.LogLevel, "logFormat", runtimeSettings.LogFormat, "aiRateLimit", runtimeSettings.AIRateLimit, "trustedProxies", len(runtimeSettings.TrustedProxies))
+}
+
 func printGreetings(profile *profile.Profile) {
 	fmt.Printf("Memos %s started successfully!\n", profile.Version)
 
@@ -169,6 +360,7 @@ func printGreetings(profile *profile.Profile) {
 }
 
 func main() {
+	slog.SetDefault(slog.New(logHandler))
 	if err := rootCmd.Execute(); err != nil {
 		panic(err)
 	}
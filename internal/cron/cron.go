@@ -0,0 +1,232 @@
+// Package cron parses cron expressions and computes their activation times.
+package cron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Schedule describes the activation times of a job.
+type Schedule interface {
+	// Next returns the first activation time after t, or the zero time if there is none.
+	Next(t time.Time) time.Time
+}
+
+// Parse parses a standard five field cron expression (minute, hour, day of month, month, day of week)
+// or one of the descriptors @yearly, @monthly, @weekly, @daily, @hourly and @every <duration>.
+func Parse(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, errors.New("empty cron expression")
+	}
+	if strings.HasPrefix(spec, "@") {
+		return parseDescriptor(spec)
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, errors.Errorf("cron expression %q must have 5 fields, got %d", spec, len(fields))
+	}
+	schedule := &specSchedule{}
+	var err error
+	if schedule.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, errors.Wrap(err, "invalid minute field")
+	}
+	if schedule.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, errors.Wrap(err, "invalid hour field")
+	}
+	if schedule.dayOfMonth, err = parseField(fields[2], dayOfMonthBounds); err != nil {
+		return nil, errors.Wrap(err, "invalid day of month field")
+	}
+	if schedule.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, errors.Wrap(err, "invalid month field")
+	}
+	if schedule.dayOfWeek, err = parseField(fields[4], dayOfWeekBounds); err != nil {
+		return nil, errors.Wrap(err, "invalid day of week field")
+	}
+	// Sunday can be written as either 0 or 7.
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+	schedule.dayOfMonthRestricted = fields[2] != "*" && fields[2] != "?"
+	schedule.dayOfWeekRestricted = fields[4] != "*" && fields[4] != "?"
+	return schedule, nil
+}
+
+func parseDescriptor(spec string) (Schedule, error) {
+	switch spec {
+	case "@yearly", "@annually":
+		return Parse("0 0 1 1 *")
+	case "@monthly":
+		return Parse("0 0 1 * *")
+	case "@weekly":
+		return Parse("0 0 * * 0")
+	case "@daily", "@midnight":
+		return Parse("0 0 * * *")
+	case "@hourly":
+		return Parse("0 * * * *")
+	}
+	if interval, ok := strings.CutPrefix(spec, "@every "); ok {
+		duration, err := time.ParseDuration(strings.TrimSpace(interval))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid interval in %q", spec)
+		}
+		if duration < time.Second {
+			return nil, errors.Errorf("interval in %q must be at least one second", spec)
+		}
+		return &intervalSchedule{interval: duration}, nil
+	}
+	return nil, errors.Errorf("unknown cron descriptor %q", spec)
+}
+
+type bounds struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteBounds     = bounds{min: 0, max: 59}
+	hourBounds       = bounds{min: 0, max: 23}
+	dayOfMonthBounds = bounds{min: 1, max: 31}
+	monthBounds      = bounds{min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dayOfWeekBounds = bounds{min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// parseField parses a comma separated list of values, ranges and steps into a bit set.
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangeSpec, stepSpec, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepSpec); err != nil || step <= 0 {
+				return 0, errors.Errorf("invalid step %q", stepSpec)
+			}
+		}
+
+		var start, end int
+		switch {
+		case rangeSpec == "*" || rangeSpec == "?":
+			start, end = b.min, b.max
+		case strings.Contains(rangeSpec, "-"):
+			startSpec, endSpec, _ := strings.Cut(rangeSpec, "-")
+			var err error
+			if start, err = parseValue(startSpec, b); err != nil {
+				return 0, err
+			}
+			if end, err = parseValue(endSpec, b); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, errors.Errorf("invalid range %q", rangeSpec)
+			}
+		default:
+			value, err := parseValue(rangeSpec, b)
+			if err != nil {
+				return 0, err
+			}
+			start, end = value, value
+			// "5/15" means every 15 starting at 5.
+			if hasStep {
+				end = b.max
+			}
+		}
+
+		for value := start; value <= end; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(spec string, b bounds) (int, error) {
+	if value, ok := b.names[strings.ToLower(spec)]; ok {
+		return value, nil
+	}
+	value, err := strconv.Atoi(spec)
+	if err != nil {
+		return 0, errors.Errorf("invalid value %q", spec)
+	}
+	if value < b.min || value > b.max {
+		return 0, errors.Errorf("value %d out of range [%d, %d]", value, b.min, b.max)
+	}
+	return value, nil
+}
+
+// specSchedule is a schedule given by a five field cron expression.
+type specSchedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+	// dayOfMonthRestricted and dayOfWeekRestricted follow the cron convention that a day
+	// matches either field when both are restricted.
+	dayOfMonthRestricted, dayOfWeekRestricted bool
+}
+
+// maxSearchYears bounds the search of expressions that never match, such as February 30th.
+const maxSearchYears = 5
+
+func (s *specSchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc).Add(time.Minute)
+	yearLimit := t.Year() + maxSearchYears
+
+WRAP:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+	for !has(s.month, int(t.Month())) {
+		t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		if t.Month() == time.January {
+			goto WRAP
+		}
+	}
+	for !s.matchesDay(t) {
+		t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		if t.Day() == 1 {
+			goto WRAP
+		}
+	}
+	for !has(s.hour, t.Hour()) {
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		if t.Hour() == 0 {
+			goto WRAP
+		}
+	}
+	for !has(s.minute, t.Minute()) {
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto WRAP
+		}
+	}
+	return t
+}
+
+func (s *specSchedule) matchesDay(t time.Time) bool {
+	dayOfMonthMatches := has(s.dayOfMonth, t.Day())
+	dayOfWeekMatches := has(s.dayOfWeek, int(t.Weekday()))
+	if s.dayOfMonthRestricted && s.dayOfWeekRestricted {
+		return dayOfMonthMatches || dayOfWeekMatches
+	}
+	return dayOfMonthMatches && dayOfWeekMatches
+}
+
+func has(bits uint64, value int) bool {
+	return bits&(1<<uint(value)) != 0
+}
+
+// intervalSchedule is a schedule given by an @every descriptor.
+type intervalSchedule struct {
+	interval time.Duration
+}
+
+func (s *intervalSchedule) Next(t time.Time) time.Time {
+	return t.Add(s.interval)
+}
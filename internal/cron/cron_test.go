@@ -0,0 +1,56 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleNext(t *testing.T) {
+	start := time.Date(2025, time.January, 15, 10, 30, 45, 0, time.UTC)
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{spec: "* * * * *", want: time.Date(2025, time.January, 15, 10, 31, 0, 0, time.UTC)},
+		{spec: "*/15 * * * *", want: time.Date(2025, time.January, 15, 10, 45, 0, 0, time.UTC)},
+		{spec: "0 3 * * *", want: time.Date(2025, time.January, 16, 3, 0, 0, 0, time.UTC)},
+		{spec: "30 9-17/4 * * mon-fri", want: time.Date(2025, time.January, 15, 13, 30, 0, 0, time.UTC)},
+		{spec: "0 0 1 */3 *", want: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 12 * * 7", want: time.Date(2025, time.January, 19, 12, 0, 0, 0, time.UTC)},
+		// A day matches either field when both day fields are restricted.
+		{spec: "0 0 25 * mon", want: time.Date(2025, time.January, 20, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 16 * mon", want: time.Date(2025, time.January, 16, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 29 feb *", want: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{spec: "@daily", want: time.Date(2025, time.January, 16, 0, 0, 0, 0, time.UTC)},
+		{spec: "@monthly", want: time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "@every 90m", want: start.Add(90 * time.Minute)},
+		{spec: "0 0 30 feb *", want: time.Time{}},
+	}
+	for _, test := range tests {
+		t.Run(test.spec, func(t *testing.T) {
+			schedule, err := Parse(test.spec)
+			require.NoError(t, err)
+			require.Equal(t, test.want, schedule.Next(start))
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"@sometimes",
+		"@every 10ms",
+	} {
+		_, err := Parse(spec)
+		require.Error(t, err, spec)
+	}
+}
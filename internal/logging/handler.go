@@ -0,0 +1,94 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// Log formats supported by Handler.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Handler is the slog handler of the server. Its level and format can be changed
+// at runtime, and it adds the request ID carried by the context to every record.
+type Handler struct {
+	state *handlerState
+	// wraps replays the WithAttrs and WithGroup calls on the current format handler.
+	wraps []func(slog.Handler) slog.Handler
+}
+
+type handlerState struct {
+	writer  io.Writer
+	level   slog.LevelVar
+	handler atomic.Pointer[slog.Handler]
+}
+
+// NewHandler returns a text handler writing to w at the info level.
+func NewHandler(w io.Writer) *Handler {
+	h := &Handler{state: &handlerState{writer: w}}
+	if err := h.SetFormat(FormatText); err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// SetLevel sets the minimum level of logged records.
+func (h *Handler) SetLevel(level slog.Level) {
+	h.state.level.Set(level)
+}
+
+// SetFormat switches the output format, either FormatText or FormatJSON.
+func (h *Handler) SetFormat(format string) error {
+	options := &slog.HandlerOptions{Level: &h.state.level}
+	var handler slog.Handler
+	switch format {
+	case FormatText, "":
+		handler = slog.NewTextHandler(h.state.writer, options)
+	case FormatJSON:
+		handler = slog.NewJSONHandler(h.state.writer, options)
+	default:
+		return errors.Errorf("unsupported log format %q", format)
+	}
+	h.state.handler.Store(&handler)
+	return nil
+}
+
+func (h *Handler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.state.level.Level()
+}
+
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	handler := *h.state.handler.Load()
+	for _, wrap := range h.wraps {
+		handler = wrap(handler)
+	}
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		record = record.Clone()
+		record.AddAttrs(slog.String("request_id", requestID))
+	}
+	return handler.Handle(ctx, record)
+}
+
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h.with(func(handler slog.Handler) slog.Handler {
+		return handler.WithAttrs(attrs)
+	})
+}
+
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return h.with(func(handler slog.Handler) slog.Handler {
+		return handler.WithGroup(name)
+	})
+}
+
+func (h *Handler) with(wrap func(slog.Handler) slog.Handler) *Handler {
+	wraps := make([]func(slog.Handler) slog.Handler, 0, len(h.wraps)+1)
+	wraps = append(wraps, h.wraps...)
+	return &Handler{state: h.state, wraps: append(wraps, wrap)}
+}
@@ -0,0 +1,49 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	var buf bytes.Buffer
+	handler := NewHandler(&buf)
+	logger := slog.New(handler).With("component", "test")
+	ctx := WithRequestID(context.Background(), "req-1")
+
+	logger.DebugContext(ctx, "hidden")
+	require.Empty(t, buf.String())
+
+	logger.InfoContext(ctx, "text record")
+	require.Contains(t, buf.String(), "msg=\"text record\"")
+	require.Contains(t, buf.String(), "component=test")
+	require.Contains(t, buf.String(), "request_id=req-1")
+
+	// Level and format changes apply to loggers created before them.
+	buf.Reset()
+	handler.SetLevel(slog.LevelDebug)
+	require.NoError(t, handler.SetFormat(FormatJSON))
+	logger.DebugContext(ctx, "json record")
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Equal(t, "json record", record["msg"])
+	require.Equal(t, "test", record["component"])
+	require.Equal(t, "req-1", record["request_id"])
+
+	require.Error(t, handler.SetFormat("xml"))
+}
+
+func TestIsValidRequestID(t *testing.T) {
+	require.True(t, IsValidRequestID(NewRequestID()))
+	require.True(t, IsValidRequestID("abc-123_DEF"))
+	require.False(t, IsValidRequestID(""))
+	require.False(t, IsValidRequestID("with space"))
+	require.False(t, IsValidRequestID("line\nbreak"))
+	require.False(t, IsValidRequestID(strings.Repeat("a", maxRequestIDLength+1)))
+}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -26,18 +27,58 @@ type Profile struct {
 	// DSN points to where memos stores its own data
 	DSN string
 	// Driver is the database driver
-	// sqlite, mysql
+	// sqlite, mysql, postgres, cockroach
+	// libsql is not supported, its local database files can be opened with the sqlite driver.
 	Driver string
+	// SQLiteJournalMode is the SQLite journal mode, defaults to WAL.
+	SQLiteJournalMode string
+	// SQLiteBusyTimeout is the SQLite busy timeout in milliseconds, defaults to 10000.
+	SQLiteBusyTimeout int
+	// SQLiteMmapSize is the SQLite memory-mapped I/O size in bytes, 0 disables it.
+	SQLiteMmapSize int64
+	// MaxOpenConns is the maximum number of open database connections, 0 means unlimited.
+	MaxOpenConns int
+	// MaxIdleConns is the maximum number of idle database connections, 0 keeps the default.
+	MaxIdleConns int
+	// ConnMaxLifetime is the maximum amount of time a database connection may be reused, 0 means forever.
+	ConnMaxLifetime time.Duration
+	// SlowQueryThreshold logs database queries taking longer than it, 0 disables slow query logging.
+	SlowQueryThreshold time.Duration
+	// RequestTimeout is the default timeout of API requests, 0 disables request timeouts.
+	RequestTimeout time.Duration
+	// MaxRequestSize is the maximum size of API requests in bytes, 0 means unlimited.
+	MaxRequestSize int64
+	// EnableProfiling serves the pprof endpoints under /debug/pprof, to the host only.
+	EnableProfiling bool
 	// Version is the current version of server
 	Version string
 	// InstanceURL is the url of your memos instance.
 	InstanceURL string
+	// Runtime holds the settings reloaded on SIGHUP, such as the log level and rate limits.
+	Runtime *RuntimeConfig
 }
 
 func (p *Profile) IsDev() bool {
 	return p.Mode != "prod"
 }
 
+// IsDemo returns true if the instance is running as a public demo.
+func (p *Profile) IsDemo() bool {
+	return p.Mode == "demo"
+}
+
+// Dialect returns the SQL dialect spoken by the configured driver.
+// CockroachDB is wire compatible with PostgreSQL, so it reuses the
+// migrations and queries of PostgreSQL.
+func (p *Profile) Dialect() string {
+	switch p.Driver {
+	case "cockroach":
+		return "postgres"
+	default:
+		return p.Driver
+	}
+}
+
 func checkDataDir(dataDir string) (string, error) {
 	// Convert to absolute path if relative path is supplied.
 	if !filepath.IsAbs(dataDir) {
@@ -83,7 +124,11 @@ func (p *Profile) Validate() error {
 	}
 
 	p.Data = dataDir
-	if p.Driver == "sqlite" && p.DSN == "" {
+	// No libsql client is bundled, the remote libsql (Turso) databases cannot be reached.
+	if p.Driver == "libsql" {
+		return errors.New("the libsql driver is not supported, use the sqlite driver for local libsql database files")
+	}
+	if p.Dialect() == "sqlite" && p.DSN == "" {
 		dbFile := fmt.Sprintf("memos_%s.db", p.Mode)
 		p.DSN = filepath.Join(dataDir, dbFile)
 	}
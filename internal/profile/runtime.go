@@ -0,0 +1,118 @@
+package profile
+
+import (
+	"log/slog"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultAIRateLimit is the default number of AI requests a user may make per hour.
+const DefaultAIRateLimit = 5
+
+// RuntimeSettings are the settings that can be reloaded while the server is running.
+type RuntimeSettings struct {
+	// LogLevel is the minimum level of logged messages.
+	LogLevel slog.Level
+	// LogFormat is the format of logged messages, "text" or "json".
+	LogFormat string
+	// AIRateLimit is the number of AI requests a user may make per hour, unless the workspace
+	// AI setting sets another limit for their role.
+	AIRateLimit int
+	// TrustedProxies are the networks of the reverse proxies allowed to report the client
+	// address through X-Forwarded-For. When empty, forwarded headers are always trusted.
+	TrustedProxies []netip.Prefix
+}
+
+// DefaultRuntimeSettings returns the runtime settings used when none are configured.
+func DefaultRuntimeSettings() *RuntimeSettings {
+	return &RuntimeSettings{
+		LogLevel:    slog.LevelInfo,
+		LogFormat:   "text",
+		AIRateLimit: DefaultAIRateLimit,
+	}
+}
+
+// ParseRuntimeSettings builds the runtime settings from their configuration values.
+func ParseRuntimeSettings(logLevel, logFormat string, aiRateLimit int, trustedProxies []string) (*RuntimeSettings, error) {
+	settings := DefaultRuntimeSettings()
+	if logLevel != "" {
+		if err := settings.LogLevel.UnmarshalText([]byte(logLevel)); err != nil {
+			return nil, errors.Wrapf(err, "invalid log level %q", logLevel)
+		}
+	}
+	switch logFormat {
+	case "":
+	case "text", "json":
+		settings.LogFormat = logFormat
+	default:
+		return nil, errors.Errorf("invalid log format %q", logFormat)
+	}
+	if aiRateLimit < 0 {
+		return nil, errors.Errorf("invalid AI rate limit %d", aiRateLimit)
+	}
+	if aiRateLimit > 0 {
+		settings.AIRateLimit = aiRateLimit
+	}
+	for _, proxy := range trustedProxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		// Accept single addresses as well as CIDR ranges.
+		if !strings.Contains(proxy, "/") {
+			addr, err := netip.ParseAddr(proxy)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid trusted proxy %q", proxy)
+			}
+			settings.TrustedProxies = append(settings.TrustedProxies, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid trusted proxy %q", proxy)
+		}
+		settings.TrustedProxies = append(settings.TrustedProxies, prefix.Masked())
+	}
+	return settings, nil
+}
+
+// IsTrustedProxy returns whether the address belongs to one of the trusted proxies.
+func (s *RuntimeSettings) IsTrustedProxy(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range s.TrustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// RuntimeConfig holds the current runtime settings and is safe for concurrent use.
+type RuntimeConfig struct {
+	settings atomic.Pointer[RuntimeSettings]
+}
+
+func NewRuntimeConfig(settings *RuntimeSettings) *RuntimeConfig {
+	config := &RuntimeConfig{}
+	config.Set(settings)
+	return config
+}
+
+// Get returns the current runtime settings, or the defaults when the config is nil.
+func (c *RuntimeConfig) Get() *RuntimeSettings {
+	if c == nil {
+		return DefaultRuntimeSettings()
+	}
+	if settings := c.settings.Load(); settings != nil {
+		return settings
+	}
+	return DefaultRuntimeSettings()
+}
+
+// Set replaces the runtime settings.
+func (c *RuntimeConfig) Set(settings *RuntimeSettings) {
+	c.settings.Store(settings)
+}
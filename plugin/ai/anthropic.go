@@ -0,0 +1,219 @@
+package ai
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultAnthropicEndpoint = "https://api.anthropic.com"
+	anthropicVersion         = "2023-06-01"
+	// anthropicMaxTokens is the maximum number of tokens of a reply, which the Messages API requires.
+	anthropicMaxTokens = 4096
+)
+
+// anthropicProvider speaks the Anthropic Messages API.
+type anthropicProvider struct {
+	client *httpClient
+}
+
+func newAnthropicProvider(config Config) *anthropicProvider {
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = defaultAnthropicEndpoint
+	}
+	headers := map[string]string{
+		"x-api-key":         config.APIKey,
+		"anthropic-version": anthropicVersion,
+	}
+	for key, value := range config.Headers {
+		headers[key] = value
+	}
+	return &anthropicProvider{client: &httpClient{endpoint: endpoint, headers: headers}}
+}
+
+type anthropicMessage struct {
+	Role string `json:"role"`
+	// Content is the text of the message, or its content blocks when it has images.
+	Content any `json:"content"`
+}
+
+type anthropicContentBlock struct {
+	Type   string                `json:"type"`
+	Text   string                `json:"text,omitempty"`
+	Source *anthropicImageSource `json:"source,omitempty"`
+}
+
+type anthropicImageSource struct {
+	Type      string `json:"type"`
+	MediaType string `json:"media_type"`
+	Data      string `json:"data"`
+}
+
+type anthropicRequest struct {
+	Model       string             `json:"model"`
+	MaxTokens   int                `json:"max_tokens"`
+	Temperature *float64           `json:"temperature,omitempty"`
+	System      string             `json:"system,omitempty"`
+	Messages    []anthropicMessage `json:"messages"`
+	Stream      bool               `json:"stream,omitempty"`
+	// Tools and ToolChoice force the reply into the input of a tool, for the structured outputs.
+	Tools      []anthropicTool      `json:"tools,omitempty"`
+	ToolChoice *anthropicToolChoice `json:"tool_choice,omitempty"`
+}
+
+type anthropicTool struct {
+	Name        string         `json:"name"`
+	InputSchema map[string]any `json:"input_schema"`
+}
+
+type anthropicToolChoice struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type anthropicUsage struct {
+	InputTokens  int64 `json:"input_tokens"`
+	OutputTokens int64 `json:"output_tokens"`
+}
+
+type anthropicResponse struct {
+	Content []struct {
+		Type  string          `json:"type"`
+		Text  string          `json:"text"`
+		Input json.RawMessage `json:"input"`
+	} `json:"content"`
+	Usage anthropicUsage `json:"usage"`
+}
+
+// anthropicEvent is a server-sent event of a streamed reply.
+type anthropicEvent struct {
+	Type  string `json:"type"`
+	Delta struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"delta"`
+	Message struct {
+		Usage anthropicUsage `json:"usage"`
+	} `json:"message"`
+	Usage anthropicUsage `json:"usage"`
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func newAnthropicRequest(request *CompletionRequest, stream bool) *anthropicRequest {
+	system, conversation := splitSystemMessages(request.Messages)
+	messages := make([]anthropicMessage, 0, len(conversation))
+	for _, message := range conversation {
+		role := "user"
+		if message.Role == RoleAssistant {
+			role = "assistant"
+		}
+		if len(message.Images) == 0 {
+			messages = append(messages, anthropicMessage{Role: role, Content: message.Content})
+			continue
+		}
+		blocks := make([]anthropicContentBlock, 0, len(message.Images)+1)
+		for _, image := range message.Images {
+			blocks = append(blocks, anthropicContentBlock{
+				Type:   "image",
+				Source: &anthropicImageSource{Type: "base64", MediaType: image.Type, Data: base64.StdEncoding.EncodeToString(image.Data)},
+			})
+		}
+		blocks = append(blocks, anthropicContentBlock{Type: "text", Text: message.Content})
+		messages = append(messages, anthropicMessage{Role: role, Content: blocks})
+	}
+	body := &anthropicRequest{
+		Model:       request.Model,
+		MaxTokens:   anthropicMaxTokens,
+		Temperature: request.Temperature,
+		System:      system,
+		Messages:    messages,
+		Stream:      stream,
+	}
+	if request.MaxTokens > 0 {
+		body.MaxTokens = request.MaxTokens
+	}
+	if request.ResponseSchema != nil && !stream {
+		body.Tools = []anthropicTool{{Name: request.ResponseSchema.Name, InputSchema: request.ResponseSchema.Schema}}
+		body.ToolChoice = &anthropicToolChoice{Type: "tool", Name: request.ResponseSchema.Name}
+	}
+	return body
+}
+
+func (p *anthropicProvider) Complete(ctx context.Context, request *CompletionRequest) (*Completion, error) {
+	response := &anthropicResponse{}
+	if err := p.client.postJSON(ctx, "/v1/messages", newAnthropicRequest(request, false), response); err != nil {
+		return nil, err
+	}
+	var content strings.Builder
+	for _, block := range response.Content {
+		// The structured reply is the input of the forced tool, the text around it is left out.
+		if block.Type == "tool_use" && request.ResponseSchema != nil {
+			content.Reset()
+			content.Write(block.Input)
+			break
+		}
+		if block.Type == "text" {
+			content.WriteString(block.Text)
+		}
+	}
+	return &Completion{
+		Content:          content.String(),
+		PromptTokens:     response.Usage.InputTokens,
+		CompletionTokens: response.Usage.OutputTokens,
+		TotalTokens:      response.Usage.InputTokens + response.Usage.OutputTokens,
+	}, nil
+}
+
+func (p *anthropicProvider) Stream(ctx context.Context, request *CompletionRequest, onDelta func(string) error) (*Completion, error) {
+	resp, err := p.client.post(ctx, "/v1/messages", newAnthropicRequest(request, true))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var content strings.Builder
+	var inputTokens, outputTokens int64
+	err = readEvents(resp.Body, func(data []byte) error {
+		event := &anthropicEvent{}
+		if err := json.Unmarshal(data, event); err != nil {
+			return errors.Wrap(err, "failed to decode event")
+		}
+		switch event.Type {
+		case "message_start":
+			inputTokens = event.Message.Usage.InputTokens
+		case "message_delta":
+			outputTokens = event.Usage.OutputTokens
+		case "content_block_delta":
+			if event.Delta.Type != "text_delta" || event.Delta.Text == "" {
+				return nil
+			}
+			content.WriteString(event.Delta.Text)
+			return onDelta(event.Delta.Text)
+		case "error":
+			return errors.Errorf("stream failed: %s", event.Error.Message)
+		}
+		return nil
+	})
+	completion := &Completion{
+		Content:          content.String(),
+		PromptTokens:     inputTokens,
+		CompletionTokens: outputTokens,
+		TotalTokens:      inputTokens + outputTokens,
+	}
+	if err != nil {
+		return completion, err
+	}
+	return completion, nil
+}
+
+// Embed is not supported, Anthropic does not offer embedding models.
+func (*anthropicProvider) Embed(context.Context, *EmbeddingRequest) (*Embeddings, error) {
+	return nil, ErrNotSupported
+}
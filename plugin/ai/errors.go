@@ -0,0 +1,129 @@
+package ai
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/openai/openai-go/v2"
+	"github.com/pkg/errors"
+)
+
+// ErrorKind classifies the failures of the calls to the providers.
+type ErrorKind string
+
+const (
+	// ErrorKindRateLimit is a call refused because of the rate limits or the quota of the account.
+	ErrorKindRateLimit ErrorKind = "RATE_LIMIT"
+	// ErrorKindAuthentication is a call refused because of the API key.
+	ErrorKindAuthentication ErrorKind = "AUTHENTICATION"
+	// ErrorKindNotFound is a call to an unknown model or endpoint.
+	ErrorKindNotFound ErrorKind = "NOT_FOUND"
+	// ErrorKindInvalidRequest is a call the provider refused as invalid, e.g. with a too long prompt.
+	ErrorKindInvalidRequest ErrorKind = "INVALID_REQUEST"
+	// ErrorKindTimeout is a call which did not complete in time.
+	ErrorKindTimeout ErrorKind = "TIMEOUT"
+	// ErrorKindUnavailable is a call which failed on the side of the provider, or could not reach it.
+	ErrorKindUnavailable ErrorKind = "UNAVAILABLE"
+	// ErrorKindUnknown is any other failure.
+	ErrorKindUnknown ErrorKind = "UNKNOWN"
+)
+
+// Error is the classified failure of a call to a provider.
+type Error struct {
+	Kind ErrorKind
+	// StatusCode is the HTTP status code of the response, 0 if there was none.
+	StatusCode int
+	// RetryAfter is the delay the provider asked to wait before retrying, 0 if it did not.
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Retryable reports whether the call may succeed if it is sent again later.
+func (e *Error) Retryable() bool {
+	return e.Kind == ErrorKindRateLimit || e.Kind == ErrorKindUnavailable
+}
+
+// ClassifyError returns the classified failure of the error returned by a provider: the Error it wraps, or the
+// classification of the errors of the OpenAI client, of the status codes and of the network errors.
+func ClassifyError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+	var classified *Error
+	if errors.As(err, &classified) {
+		return classified
+	}
+	var apiErr *openai.Error
+	if errors.As(err, &apiErr) {
+		classified := newStatusError(apiErr.StatusCode, err)
+		if apiErr.Response != nil {
+			classified.RetryAfter = parseRetryAfter(apiErr.Response.Header, time.Now())
+		}
+		return classified
+	}
+	classified = &Error{Kind: ErrorKindUnknown, Err: err}
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		classified.Kind = ErrorKindTimeout
+	case errors.Is(err, ErrUnavailable):
+		classified.Kind = ErrorKindUnavailable
+	case errors.As(err, &netErr):
+		classified.Kind = ErrorKindUnavailable
+		if netErr.Timeout() {
+			classified.Kind = ErrorKindTimeout
+		}
+	}
+	return classified
+}
+
+// newStatusError returns the failure of a call answered with the HTTP status code.
+func newStatusError(statusCode int, err error) *Error {
+	classified := &Error{Kind: ErrorKindUnknown, StatusCode: statusCode, Err: err}
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		classified.Kind = ErrorKindRateLimit
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		classified.Kind = ErrorKindAuthentication
+	case statusCode == http.StatusNotFound:
+		classified.Kind = ErrorKindNotFound
+	case statusCode == http.StatusRequestTimeout || statusCode == http.StatusGatewayTimeout:
+		classified.Kind = ErrorKindTimeout
+	case statusCode >= 400 && statusCode < 500:
+		classified.Kind = ErrorKindInvalidRequest
+	case statusCode >= 500:
+		// Including the 529 of the overloaded Anthropic API.
+		classified.Kind = ErrorKindUnavailable
+	}
+	return classified
+}
+
+// parseRetryAfter returns the delay of the Retry-After header, in seconds or as an HTTP date, or of the
+// retry-after-ms header of the OpenAI API. It is 0 without a valid header.
+func parseRetryAfter(header http.Header, now time.Time) time.Duration {
+	if milliseconds, err := strconv.ParseFloat(header.Get("Retry-After-Ms"), 64); err == nil && milliseconds > 0 {
+		return time.Duration(milliseconds * float64(time.Millisecond))
+	}
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+		return time.Duration(seconds * float64(time.Second))
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
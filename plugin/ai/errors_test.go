@@ -0,0 +1,66 @@
+package ai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyError(t *testing.T) {
+	for _, tc := range []struct {
+		statusCode int
+		kind       ErrorKind
+	}{
+		{http.StatusTooManyRequests, ErrorKindRateLimit},
+		{http.StatusUnauthorized, ErrorKindAuthentication},
+		{http.StatusForbidden, ErrorKindAuthentication},
+		{http.StatusNotFound, ErrorKindNotFound},
+		{http.StatusBadRequest, ErrorKindInvalidRequest},
+		{http.StatusGatewayTimeout, ErrorKindTimeout},
+		{529, ErrorKindUnavailable},
+	} {
+		assert.Equal(t, tc.kind, newStatusError(tc.statusCode, errors.New("failed")).Kind, tc.statusCode)
+	}
+
+	assert.Equal(t, ErrorKindTimeout, ClassifyError(errors.Wrap(context.DeadlineExceeded, "failed")).Kind)
+	assert.Equal(t, ErrorKindUnavailable, ClassifyError(ErrUnavailable).Kind)
+	assert.Equal(t, ErrorKindUnknown, ClassifyError(errors.New("failed")).Kind)
+	assert.Nil(t, ClassifyError(nil))
+
+	// The errors of the OpenAI client are classified by their status code, whatever their message.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":{"message":"Clé API invalide","type":"invalid_request_error"}}`))
+	}))
+	defer server.Close()
+	provider, err := NewProvider(Config{Type: ProviderOpenAI, Endpoint: server.URL, APIKey: "key"})
+	require.NoError(t, err)
+	_, err = provider.Complete(context.Background(), testRequest)
+	classified := ClassifyError(err)
+	assert.Equal(t, ErrorKindAuthentication, classified.Kind)
+	assert.Equal(t, http.StatusUnauthorized, classified.StatusCode)
+	assert.False(t, classified.Retryable())
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		header http.Header
+		delay  time.Duration
+	}{
+		{http.Header{"Retry-After": {"3"}}, 3 * time.Second},
+		{http.Header{"Retry-After": {now.Add(time.Minute).Format(http.TimeFormat)}}, time.Minute},
+		{http.Header{"Retry-After-Ms": {"250"}, "Retry-After": {"3"}}, 250 * time.Millisecond},
+		{http.Header{"Retry-After": {"soon"}}, 0},
+		{http.Header{}, 0},
+	} {
+		assert.Equal(t, tc.delay, parseRetryAfter(tc.header, now), tc.header)
+	}
+}
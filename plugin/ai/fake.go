@@ -0,0 +1,202 @@
+package ai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// fakeEmbeddingDimensions is the number of dimensions of the embeddings of the fake provider.
+const fakeEmbeddingDimensions = 16
+
+// fakeFailurePattern matches the markers of the prompts the fake provider fails on: "[fake:rate_limit]",
+// "[fake:rate_limit:30]" with the seconds of the Retry-After of the failure, "[fake:timeout]" and "[fake:unavailable]".
+var fakeFailurePattern = regexp.MustCompile(`\[fake:(rate_limit|timeout|unavailable)(?::(\d+))?\]`)
+
+// fakeProvider replies deterministic canned responses without calling any API, for the integration tests and the
+// development of the clients. The failures of the real providers are injected with markers in the prompts.
+type fakeProvider struct{}
+
+func newFakeProvider() *fakeProvider {
+	return &fakeProvider{}
+}
+
+func (*fakeProvider) Complete(_ context.Context, request *CompletionRequest) (*Completion, error) {
+	if err := fakeFailure(request.Messages); err != nil {
+		return nil, err
+	}
+	content := fakeReply(request)
+	if request.ResponseSchema != nil {
+		value, err := json.Marshal(fakeJSONValue(request.ResponseSchema.Schema))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal fake reply")
+		}
+		content = string(value)
+	}
+	return fakeCompletion(request, content), nil
+}
+
+func (*fakeProvider) Stream(ctx context.Context, request *CompletionRequest, onDelta func(string) error) (*Completion, error) {
+	if err := fakeFailure(request.Messages); err != nil {
+		return nil, err
+	}
+	content := fakeReply(request)
+	for _, word := range strings.SplitAfter(content, " ") {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := onDelta(word); err != nil {
+			return nil, err
+		}
+	}
+	return fakeCompletion(request, content), nil
+}
+
+func (*fakeProvider) Embed(_ context.Context, request *EmbeddingRequest) (*Embeddings, error) {
+	messages := make([]Message, 0, len(request.Input))
+	for _, input := range request.Input {
+		messages = append(messages, Message{Role: RoleUser, Content: input})
+	}
+	if err := fakeFailure(messages); err != nil {
+		return nil, err
+	}
+	embeddings := &Embeddings{Vectors: make([][]float32, 0, len(request.Input))}
+	for _, input := range request.Input {
+		embeddings.Vectors = append(embeddings.Vectors, fakeEmbedding(input))
+		embeddings.TotalTokens += int64(EstimateTokens(input))
+	}
+	return embeddings, nil
+}
+
+// fakeFailure returns the failure injected by the marker of the last user message, if any.
+func fakeFailure(messages []Message) error {
+	match := fakeFailurePattern.FindStringSubmatch(lastUserContent(messages))
+	if match == nil {
+		return nil
+	}
+	switch match[1] {
+	case "rate_limit":
+		failure := newStatusError(http.StatusTooManyRequests, errors.New("fake provider: rate limit exceeded"))
+		if seconds, err := strconv.Atoi(match[2]); err == nil {
+			failure.RetryAfter = time.Duration(seconds) * time.Second
+		}
+		return failure
+	case "timeout":
+		return &Error{Kind: ErrorKindTimeout, Err: errors.Wrap(context.DeadlineExceeded, "fake provider")}
+	default:
+		return newStatusError(http.StatusServiceUnavailable, errors.New("fake provider: service unavailable"))
+	}
+}
+
+// fakeReply returns the canned reply of the conversation, which quotes the beginning of the last user message.
+func fakeReply(request *CompletionRequest) string {
+	quote := []rune(strings.Join(strings.Fields(lastUserContent(request.Messages)), " "))
+	if len(quote) > 80 {
+		quote = append(quote[:80], '…')
+	}
+	return "This is a fake reply to: " + string(quote)
+}
+
+// fakeCompletion returns the completion of the content with the estimated token counts of the request.
+func fakeCompletion(request *CompletionRequest, content string) *Completion {
+	completion := &Completion{Content: content, CompletionTokens: int64(EstimateTokens(content))}
+	for _, message := range request.Messages {
+		completion.PromptTokens += int64(EstimateTokens(message.Content))
+	}
+	completion.TotalTokens = completion.PromptTokens + completion.CompletionTokens
+	return completion
+}
+
+// fakeJSONValue returns the simplest value matching the JSON schema: the first option of the enums, the minimum of
+// the numbers, and the strings and arrays of the minimum length.
+func fakeJSONValue(schema map[string]any) any {
+	if enum, ok := schema["enum"].([]any); ok && len(enum) > 0 {
+		return enum[0]
+	}
+	types := schemaStrings(schema["type"])
+	if len(types) == 0 {
+		return nil
+	}
+	switch types[0] {
+	case "object":
+		value := map[string]any{}
+		properties, _ := schema["properties"].(map[string]any)
+		for name, property := range properties {
+			if propertySchema, ok := property.(map[string]any); ok {
+				value[name] = fakeJSONValue(propertySchema)
+			}
+		}
+		return value
+	case "array":
+		items, _ := schema["items"].(map[string]any)
+		count := 1
+		if minItems, ok := schemaNumber(schema["minItems"]); ok {
+			count = max(count, int(minItems))
+		}
+		if maxItems, ok := schemaNumber(schema["maxItems"]); ok {
+			count = min(count, int(maxItems))
+		}
+		value := make([]any, 0, count)
+		for range count {
+			value = append(value, fakeJSONValue(items))
+		}
+		return value
+	case "string":
+		value := "fake"
+		if minLength, ok := schemaNumber(schema["minLength"]); ok && len(value) < int(minLength) {
+			value += strings.Repeat("x", int(minLength)-len(value))
+		}
+		if maxLength, ok := schemaNumber(schema["maxLength"]); ok && len(value) > int(maxLength) {
+			value = value[:int(maxLength)]
+		}
+		return value
+	case "number", "integer":
+		if minimum, ok := schemaNumber(schema["minimum"]); ok {
+			return math.Ceil(minimum)
+		}
+		return 0
+	case "boolean":
+		return false
+	default:
+		return nil
+	}
+}
+
+// fakeEmbedding returns the unit vector derived from the hash of the text, the same for the same text.
+func fakeEmbedding(text string) []float32 {
+	sum := sha256.Sum256([]byte(text))
+	vector := make([]float32, fakeEmbeddingDimensions)
+	norm := 0.0
+	for i := range vector {
+		value := float64(int16(binary.BigEndian.Uint16(sum[i*2:]))) / math.MaxInt16
+		vector[i] = float32(value)
+		norm += value * value
+	}
+	if norm == 0 {
+		vector[0] = 1
+		return vector
+	}
+	for i := range vector {
+		vector[i] /= float32(math.Sqrt(norm))
+	}
+	return vector
+}
+
+// lastUserContent returns the content of the last user message of the conversation.
+func lastUserContent(messages []Message) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role == RoleUser {
+			return messages[i].Content
+		}
+	}
+	return ""
+}
@@ -0,0 +1,187 @@
+package ai
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const defaultGeminiEndpoint = "https://generativelanguage.googleapis.com"
+
+// geminiProvider speaks the Google Gemini API.
+type geminiProvider struct {
+	client *httpClient
+}
+
+func newGeminiProvider(config Config) *geminiProvider {
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = defaultGeminiEndpoint
+	}
+	headers := map[string]string{"x-goog-api-key": config.APIKey}
+	for key, value := range config.Headers {
+		headers[key] = value
+	}
+	return &geminiProvider{client: &httpClient{endpoint: endpoint, headers: headers}}
+}
+
+type geminiPart struct {
+	Text       string            `json:"text,omitempty"`
+	InlineData *geminiInlineData `json:"inlineData,omitempty"`
+}
+
+type geminiInlineData struct {
+	MimeType string `json:"mimeType"`
+	Data     string `json:"data"`
+}
+
+type geminiContent struct {
+	Role  string       `json:"role,omitempty"`
+	Parts []geminiPart `json:"parts"`
+}
+
+type geminiRequest struct {
+	SystemInstruction *geminiContent  `json:"systemInstruction,omitempty"`
+	Contents          []geminiContent `json:"contents"`
+	GenerationConfig  *geminiConfig   `json:"generationConfig,omitempty"`
+}
+
+type geminiConfig struct {
+	ResponseMimeType   string         `json:"responseMimeType,omitempty"`
+	ResponseJSONSchema map[string]any `json:"responseJsonSchema,omitempty"`
+	Temperature        *float64       `json:"temperature,omitempty"`
+	MaxOutputTokens    int            `json:"maxOutputTokens,omitempty"`
+}
+
+type geminiResponse struct {
+	Candidates []struct {
+		Content geminiContent `json:"content"`
+	} `json:"candidates"`
+	UsageMetadata struct {
+		PromptTokenCount     int64 `json:"promptTokenCount"`
+		CandidatesTokenCount int64 `json:"candidatesTokenCount"`
+		TotalTokenCount      int64 `json:"totalTokenCount"`
+	} `json:"usageMetadata"`
+}
+
+// text returns the text of the first candidate.
+func (r *geminiResponse) text() string {
+	if len(r.Candidates) == 0 {
+		return ""
+	}
+	var text strings.Builder
+	for _, part := range r.Candidates[0].Content.Parts {
+		text.WriteString(part.Text)
+	}
+	return text.String()
+}
+
+func newGeminiRequest(request *CompletionRequest) *geminiRequest {
+	system, conversation := splitSystemMessages(request.Messages)
+	body := &geminiRequest{Contents: make([]geminiContent, 0, len(conversation))}
+	if system != "" {
+		body.SystemInstruction = &geminiContent{Parts: []geminiPart{{Text: system}}}
+	}
+	for _, message := range conversation {
+		role := "user"
+		if message.Role == RoleAssistant {
+			role = "model"
+		}
+		parts := []geminiPart{{Text: message.Content}}
+		for _, image := range message.Images {
+			parts = append(parts, geminiPart{InlineData: &geminiInlineData{MimeType: image.Type, Data: base64.StdEncoding.EncodeToString(image.Data)}})
+		}
+		body.Contents = append(body.Contents, geminiContent{Role: role, Parts: parts})
+	}
+	if request.ResponseSchema != nil || request.Temperature != nil || request.MaxTokens > 0 {
+		body.GenerationConfig = &geminiConfig{Temperature: request.Temperature, MaxOutputTokens: request.MaxTokens}
+	}
+	if request.ResponseSchema != nil {
+		body.GenerationConfig.ResponseMimeType = "application/json"
+		body.GenerationConfig.ResponseJSONSchema = request.ResponseSchema.Schema
+	}
+	return body
+}
+
+// geminiModelPath returns the path of the method of the model.
+func geminiModelPath(model, method string) string {
+	return "/v1beta/models/" + url.PathEscape(strings.TrimPrefix(model, "models/")) + ":" + method
+}
+
+func (p *geminiProvider) Complete(ctx context.Context, request *CompletionRequest) (*Completion, error) {
+	response := &geminiResponse{}
+	if err := p.client.postJSON(ctx, geminiModelPath(request.Model, "generateContent"), newGeminiRequest(request), response); err != nil {
+		return nil, err
+	}
+	return &Completion{
+		Content:          response.text(),
+		PromptTokens:     response.UsageMetadata.PromptTokenCount,
+		CompletionTokens: response.UsageMetadata.CandidatesTokenCount,
+		TotalTokens:      response.UsageMetadata.TotalTokenCount,
+	}, nil
+}
+
+func (p *geminiProvider) Stream(ctx context.Context, request *CompletionRequest, onDelta func(string) error) (*Completion, error) {
+	resp, err := p.client.post(ctx, geminiModelPath(request.Model, "streamGenerateContent?alt=sse"), newGeminiRequest(request))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var content strings.Builder
+	completion := &Completion{}
+	err = readEvents(resp.Body, func(data []byte) error {
+		chunk := &geminiResponse{}
+		if err := json.Unmarshal(data, chunk); err != nil {
+			return errors.Wrap(err, "failed to decode chunk")
+		}
+		// Each chunk reports the usage so far.
+		if chunk.UsageMetadata.TotalTokenCount > 0 {
+			completion.PromptTokens = chunk.UsageMetadata.PromptTokenCount
+			completion.CompletionTokens = chunk.UsageMetadata.CandidatesTokenCount
+			completion.TotalTokens = chunk.UsageMetadata.TotalTokenCount
+		}
+		delta := chunk.text()
+		if delta == "" {
+			return nil
+		}
+		content.WriteString(delta)
+		return onDelta(delta)
+	})
+	completion.Content = content.String()
+	if err != nil {
+		return completion, err
+	}
+	return completion, nil
+}
+
+func (p *geminiProvider) Embed(ctx context.Context, request *EmbeddingRequest) (*Embeddings, error) {
+	model := "models/" + strings.TrimPrefix(request.Model, "models/")
+	requests := make([]map[string]any, 0, len(request.Input))
+	for _, input := range request.Input {
+		requests = append(requests, map[string]any{
+			"model":   model,
+			"content": geminiContent{Parts: []geminiPart{{Text: input}}},
+		})
+	}
+	response := &struct {
+		Embeddings []struct {
+			Values []float32 `json:"values"`
+		} `json:"embeddings"`
+	}{}
+	if err := p.client.postJSON(ctx, geminiModelPath(request.Model, "batchEmbedContents"), map[string]any{"requests": requests}, response); err != nil {
+		return nil, err
+	}
+	if len(response.Embeddings) != len(request.Input) {
+		return nil, errors.Errorf("expected %d embeddings, got %d", len(request.Input), len(response.Embeddings))
+	}
+	embeddings := &Embeddings{Vectors: make([][]float32, 0, len(response.Embeddings))}
+	for _, embedding := range response.Embeddings {
+		embeddings.Vectors = append(embeddings.Vectors, embedding.Values)
+	}
+	return embeddings, nil
+}
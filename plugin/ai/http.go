@@ -0,0 +1,91 @@
+package ai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// maxErrorBodySize is the size of the error responses read into the error messages.
+const maxErrorBodySize = 4 * 1024
+
+// httpClient sends the JSON requests of the providers without an SDK.
+type httpClient struct {
+	endpoint string
+	headers  map[string]string
+}
+
+// post sends the request body as JSON and returns the response, which is an error unless its status is 2xx.
+// The caller must close the body of the response.
+func (c *httpClient) post(ctx context.Context, path string, body any) (*http.Response, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request")
+	}
+	url := strings.TrimSuffix(c.endpoint, "/") + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to construct request to %s", url)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range c.headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := outbound.NewStreamingClient().Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send request to %s", url)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		statusErr := newStatusError(resp.StatusCode, errors.Errorf("request to %s failed with status %d: %s", url, resp.StatusCode, strings.TrimSpace(string(message))))
+		statusErr.RetryAfter = parseRetryAfter(resp.Header, time.Now())
+		return nil, statusErr
+	}
+	return resp, nil
+}
+
+// postJSON sends the request body as JSON and decodes the JSON response into result.
+func (c *httpClient) postJSON(ctx context.Context, path string, body, result any) error {
+	resp, err := c.post(ctx, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return errors.Wrap(err, "failed to decode response")
+	}
+	return nil
+}
+
+// readEvents calls onData with the data of each server-sent event of the body, or with each line
+// of a newline delimited JSON body.
+func readEvents(body io.Reader, onData func([]byte) error) error {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || bytes.HasPrefix(line, []byte("event:")) || bytes.HasPrefix(line, []byte(":")) {
+			continue
+		}
+		if data, ok := bytes.CutPrefix(line, []byte("data:")); ok {
+			line = bytes.TrimSpace(data)
+		}
+		if string(line) == "[DONE]" {
+			return nil
+		}
+		if err := onData(line); err != nil {
+			return err
+		}
+	}
+	return errors.Wrap(scanner.Err(), "failed to read stream")
+}
@@ -0,0 +1,131 @@
+package ai
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// limiterMaxWait is how long a call waits for a slot before failing with ErrBusy.
+const limiterMaxWait = 5 * time.Second
+
+// ErrBusy is returned without calling the provider when no slot frees up in time for the call. It is an
+// ErrUnavailable, the call may succeed if it is sent again later.
+var ErrBusy = errors.Wrap(ErrUnavailable, "too many AI requests in flight")
+
+// Limiter limits the number of calls in flight to the providers it wraps, so that a slow provider cannot hold
+// an unbounded number of requests. The calls over the limit wait for a slot, and fail with ErrBusy if none frees
+// up in time. The zero value does not limit the calls.
+type Limiter struct {
+	mu       sync.Mutex
+	limit    int
+	inFlight int
+	// released is closed, and replaced, when a slot is released, waking up the calls waiting for one.
+	released chan struct{}
+	// maxWait overrides limiterMaxWait, it is replaced in tests.
+	maxWait time.Duration
+}
+
+// SetLimit sets the maximum number of calls in flight, 0 for no limit. The calls in flight are not interrupted
+// when the limit is lowered, the new calls wait for their number to drop under it.
+func (l *Limiter) SetLimit(limit int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limit = limit
+	l.wake()
+}
+
+// Stats returns the number of calls in flight and the limit.
+func (l *Limiter) Stats() (inFlight int, limit int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inFlight, l.limit
+}
+
+// Wrap returns the provider with its calls limited by the limiter.
+func (l *Limiter) Wrap(provider Provider) Provider {
+	return &limitedProvider{Provider: provider, limiter: l}
+}
+
+// acquire waits for a slot, returning ErrBusy if none frees up in time or the error of the context if it is done
+// first. The slot must be released once the call is over.
+func (l *Limiter) acquire(ctx context.Context) error {
+	maxWait := limiterMaxWait
+	if l.maxWait > 0 {
+		maxWait = l.maxWait
+	}
+	var timer *time.Timer
+	for {
+		l.mu.Lock()
+		if l.limit <= 0 || l.inFlight < l.limit {
+			l.inFlight++
+			l.mu.Unlock()
+			return nil
+		}
+		if l.released == nil {
+			l.released = make(chan struct{})
+		}
+		released := l.released
+		l.mu.Unlock()
+
+		if timer == nil {
+			timer = time.NewTimer(maxWait)
+			defer timer.Stop()
+		}
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return ErrBusy
+		}
+	}
+}
+
+// release releases the slot of a call.
+func (l *Limiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inFlight--
+	l.wake()
+}
+
+// wake wakes up the calls waiting for a slot. The lock must be held.
+func (l *Limiter) wake() {
+	if l.released != nil {
+		close(l.released)
+		l.released = nil
+	}
+}
+
+// limitedProvider limits the calls in flight to the provider with the limiter.
+type limitedProvider struct {
+	Provider
+	limiter *Limiter
+}
+
+func (p *limitedProvider) Complete(ctx context.Context, request *CompletionRequest) (*Completion, error) {
+	if err := p.limiter.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer p.limiter.release()
+	return p.Provider.Complete(ctx, request)
+}
+
+func (p *limitedProvider) Stream(ctx context.Context, request *CompletionRequest, onDelta func(string) error) (*Completion, error) {
+	if err := p.limiter.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer p.limiter.release()
+	return p.Provider.Stream(ctx, request, onDelta)
+}
+
+func (p *limitedProvider) Embed(ctx context.Context, request *EmbeddingRequest) (*Embeddings, error) {
+	if err := p.limiter.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer p.limiter.release()
+	return p.Provider.Embed(ctx, request)
+}
@@ -0,0 +1,74 @@
+package ai
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingProvider blocks its calls until they are released.
+type blockingProvider struct {
+	Provider
+	started chan struct{}
+	release chan struct{}
+}
+
+func (p *blockingProvider) Complete(_ context.Context, _ *CompletionRequest) (*Completion, error) {
+	p.started <- struct{}{}
+	<-p.release
+	return &Completion{Content: "ok"}, nil
+}
+
+func TestLimiter(t *testing.T) {
+	limiter := &Limiter{maxWait: 50 * time.Millisecond}
+	limiter.SetLimit(2)
+	provider := &blockingProvider{started: make(chan struct{}), release: make(chan struct{})}
+	limited := limiter.Wrap(provider)
+
+	results := make(chan error, 3)
+	for range 2 {
+		go func() {
+			_, err := limited.Complete(context.Background(), testRequest)
+			results <- err
+		}()
+		<-provider.started
+	}
+	inFlight, limit := limiter.Stats()
+	assert.Equal(t, 2, inFlight)
+	assert.Equal(t, 2, limit)
+
+	// The calls over the limit fail once no slot frees up in time, or once their context is done.
+	_, err := limited.Complete(context.Background(), testRequest)
+	require.ErrorIs(t, err, ErrBusy)
+	require.ErrorIs(t, err, ErrUnavailable)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = limited.Complete(ctx, testRequest)
+	require.ErrorIs(t, err, context.Canceled)
+
+	// A waiting call goes through once a slot is released.
+	limiter.maxWait = time.Minute
+	go func() {
+		_, err := limited.Complete(context.Background(), testRequest)
+		results <- err
+	}()
+	provider.release <- struct{}{}
+	<-provider.started
+	provider.release <- struct{}{}
+	provider.release <- struct{}{}
+	for range 3 {
+		require.NoError(t, <-results)
+	}
+	inFlight, _ = limiter.Stats()
+	assert.Equal(t, 0, inFlight)
+
+	// Without a limit, the calls do not wait.
+	limiter.SetLimit(0)
+	close(provider.release)
+	go func() { <-provider.started }()
+	_, err = limited.Complete(context.Background(), testRequest)
+	require.NoError(t, err)
+}
@@ -0,0 +1,326 @@
+package ai
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// monitorWindow is how long the calls are taken into account for the health of the provider.
+	monitorWindow = 5 * time.Minute
+	// monitorMaxCalls is the maximum number of recent calls kept.
+	monitorMaxCalls = 50
+	// breakerMinCalls is the number of recent calls needed before the circuit breaker may open.
+	breakerMinCalls = 5
+	// breakerFailureRate is the ratio of failed recent calls from which the circuit breaker opens.
+	breakerFailureRate = 0.5
+	// breakerConsecutiveFailures is the default number of consecutive failed calls from which the circuit breaker opens.
+	breakerConsecutiveFailures = 5
+	// breakerCooldown is the default time the circuit breaker stays open before a trial call is let through.
+	breakerCooldown = 30 * time.Second
+)
+
+// ErrUnavailable is returned without calling the provider while its circuit breaker is open.
+var ErrUnavailable = errors.New("AI provider unavailable")
+
+// CircuitState is the state of the circuit breaker guarding the calls to a provider.
+type CircuitState int
+
+const (
+	// CircuitClosed lets the calls through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails the calls fast until the cooldown is over.
+	CircuitOpen
+	// CircuitHalfOpen lets a single trial call through to find out whether the provider recovered.
+	CircuitHalfOpen
+)
+
+// BreakerConfig configures the circuit breaker of a Monitor. The zero values are replaced by the defaults.
+type BreakerConfig struct {
+	// ConsecutiveFailures is the number of consecutive failed calls from which the circuit opens, whatever the
+	// failure rate of the recent calls.
+	ConsecutiveFailures int
+	// Cooldown is how long the circuit stays open before a trial call is let through.
+	Cooldown time.Duration
+}
+
+// Health is the health of a provider from its recent calls.
+type Health struct {
+	State    CircuitState
+	Calls    int
+	Failures int
+	// ConsecutiveFailures is the number of calls that failed in a row since the last successful one.
+	ConsecutiveFailures int
+	AverageLatency      time.Duration
+	LastError           string
+	LastErrorTime       time.Time
+	// RetryTime is the time the next trial call is let through while the circuit is open.
+	RetryTime time.Time
+}
+
+type monitoredCall struct {
+	time    time.Time
+	latency time.Duration
+	failed  bool
+}
+
+// Monitor tracks the failures and latencies of the recent calls to a provider, and opens a circuit breaker
+// when too many of them fail, or too many in a row, so that the calls fail fast with ErrUnavailable instead of waiting on an
+// unhealthy provider. Once the cooldown is over, a single trial call is let through: its success closes
+// the circuit and its failure opens it again. The zero value is ready to use.
+type Monitor struct {
+	mu       sync.Mutex
+	config   BreakerConfig
+	calls    []monitoredCall
+	state    CircuitState
+	openedAt time.Time
+	// consecutiveFailures is the number of calls that failed since the last successful one.
+	consecutiveFailures int
+	// trialRunning is set while the trial call of the half open circuit is running.
+	trialRunning  bool
+	lastError     string
+	lastErrorTime time.Time
+	// openHandler is called with the health of the provider whenever the circuit opens.
+	openHandler func(Health)
+	// now returns the current time, it is replaced in tests.
+	now func() time.Time
+}
+
+// Wrap returns the provider with its calls guarded and tracked by the monitor.
+func (m *Monitor) Wrap(provider Provider) Provider {
+	return &monitoredProvider{Provider: provider, monitor: m}
+}
+
+// Configure sets the configuration of the circuit breaker, applied from the next call.
+func (m *Monitor) Configure(config BreakerConfig) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.config = config
+}
+
+// SetOpenHandler sets the function called with the health of the provider whenever the circuit opens, e.g. to alert
+// of its failures. It is called on the goroutine of the failed call, without the monitor locked.
+func (m *Monitor) SetOpenHandler(handler func(Health)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.openHandler = handler
+}
+
+// Open reports whether the circuit is open, and the time the next trial call is let through.
+func (m *Monitor) Open() (time.Time, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	retryTime := m.openedAt.Add(m.cooldown())
+	return retryTime, m.state == CircuitOpen && m.currentTime().Before(retryTime)
+}
+
+// Health returns the health of the provider from its recent calls.
+func (m *Monitor) Health() Health {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.prune()
+	health := Health{
+		State:               m.state,
+		Calls:               len(m.calls),
+		ConsecutiveFailures: m.consecutiveFailures,
+		LastError:           m.lastError,
+		LastErrorTime:       m.lastErrorTime,
+	}
+	var totalLatency time.Duration
+	for _, call := range m.calls {
+		totalLatency += call.latency
+		if call.failed {
+			health.Failures++
+		}
+	}
+	if len(m.calls) > 0 {
+		health.AverageLatency = totalLatency / time.Duration(len(m.calls))
+	}
+	if m.state == CircuitOpen {
+		health.RetryTime = m.openedAt.Add(m.cooldown())
+	}
+	return health
+}
+
+// Reset forgets the recent calls and closes the circuit, e.g. when the provider is configured again.
+func (m *Monitor) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = nil
+	m.state = CircuitClosed
+	m.consecutiveFailures = 0
+	m.trialRunning = false
+	m.lastError = ""
+	m.lastErrorTime = time.Time{}
+}
+
+// allow returns ErrUnavailable if the call must fail fast, otherwise the call must be recorded or released.
+func (m *Monitor) allow() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	switch m.state {
+	case CircuitOpen:
+		if m.currentTime().Before(m.openedAt.Add(m.cooldown())) {
+			return ErrUnavailable
+		}
+		m.state = CircuitHalfOpen
+		m.trialRunning = true
+	case CircuitHalfOpen:
+		if m.trialRunning {
+			return ErrUnavailable
+		}
+		m.trialRunning = true
+	default:
+	}
+	return nil
+}
+
+// record records the outcome of a call started at the given time. The errors that are not failures
+// of the provider, e.g. a call canceled by the caller, are not taken into account.
+func (m *Monitor) record(start time.Time, err error) {
+	if err != nil && (errors.Is(err, context.Canceled) || errors.Is(err, ErrNotSupported)) {
+		m.release()
+		return
+	}
+
+	m.mu.Lock()
+	wasOpen := m.state == CircuitOpen
+	m.update(start, err)
+	openHandler := m.openHandler
+	opened := !wasOpen && m.state == CircuitOpen
+	m.mu.Unlock()
+	if opened && openHandler != nil {
+		openHandler(m.Health())
+	}
+}
+
+// update updates the recent calls and the state of the circuit with the outcome of a call. The lock must be held.
+func (m *Monitor) update(start time.Time, err error) {
+	now := m.currentTime()
+	if err != nil {
+		m.lastError = err.Error()
+		m.lastErrorTime = now
+		m.consecutiveFailures++
+	} else {
+		m.consecutiveFailures = 0
+	}
+	if m.state == CircuitHalfOpen && m.trialRunning {
+		m.trialRunning = false
+		if err != nil {
+			m.state, m.openedAt = CircuitOpen, now
+			return
+		}
+		// The provider recovered, the failures before the trial call are not held against it anymore.
+		m.state, m.calls = CircuitClosed, nil
+	}
+	m.calls = append(m.calls, monitoredCall{time: now, latency: now.Sub(start), failed: err != nil})
+	m.prune()
+
+	if m.state != CircuitClosed {
+		return
+	}
+	if m.consecutiveFailures >= m.maxConsecutiveFailures() {
+		m.state, m.openedAt = CircuitOpen, now
+		return
+	}
+	if len(m.calls) < breakerMinCalls {
+		return
+	}
+	failures := 0
+	for _, call := range m.calls {
+		if call.failed {
+			failures++
+		}
+	}
+	if float64(failures)/float64(len(m.calls)) >= breakerFailureRate {
+		m.state, m.openedAt = CircuitOpen, now
+	}
+}
+
+// release ends a call without recording it, letting another trial call through if it was the trial call.
+func (m *Monitor) release() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.trialRunning = false
+}
+
+// prune drops the calls out of the window. The lock must be held.
+func (m *Monitor) prune() {
+	cutoff := m.currentTime().Add(-monitorWindow)
+	first := 0
+	for first < len(m.calls) && (m.calls[first].time.Before(cutoff) || len(m.calls)-first > monitorMaxCalls) {
+		first++
+	}
+	m.calls = m.calls[first:]
+}
+
+// cooldown returns how long the circuit stays open. The lock must be held.
+func (m *Monitor) cooldown() time.Duration {
+	if m.config.Cooldown > 0 {
+		return m.config.Cooldown
+	}
+	return breakerCooldown
+}
+
+// maxConsecutiveFailures returns the number of consecutive failures opening the circuit. The lock must be held.
+func (m *Monitor) maxConsecutiveFailures() int {
+	if m.config.ConsecutiveFailures > 0 {
+		return m.config.ConsecutiveFailures
+	}
+	return breakerConsecutiveFailures
+}
+
+func (m *Monitor) currentTime() time.Time {
+	if m.now != nil {
+		return m.now()
+	}
+	return time.Now()
+}
+
+// monitoredProvider guards and tracks the calls to the provider with the monitor.
+type monitoredProvider struct {
+	Provider
+	monitor *Monitor
+}
+
+func (p *monitoredProvider) Complete(ctx context.Context, request *CompletionRequest) (*Completion, error) {
+	if err := p.monitor.allow(); err != nil {
+		return nil, err
+	}
+	start := p.monitor.currentTime()
+	completion, err := p.Provider.Complete(ctx, request)
+	p.monitor.record(start, err)
+	return completion, err
+}
+
+func (p *monitoredProvider) Stream(ctx context.Context, request *CompletionRequest, onDelta func(string) error) (*Completion, error) {
+	if err := p.monitor.allow(); err != nil {
+		return nil, err
+	}
+	start := p.monitor.currentTime()
+	var deltaErr error
+	completion, err := p.Provider.Stream(ctx, request, func(delta string) error {
+		deltaErr = onDelta(delta)
+		return deltaErr
+	})
+	// The errors of the caller handling the deltas are not failures of the provider.
+	if deltaErr != nil {
+		p.monitor.release()
+	} else {
+		p.monitor.record(start, err)
+	}
+	return completion, err
+}
+
+func (p *monitoredProvider) Embed(ctx context.Context, request *EmbeddingRequest) (*Embeddings, error) {
+	if err := p.monitor.allow(); err != nil {
+		return nil, err
+	}
+	start := p.monitor.currentTime()
+	embeddings, err := p.Provider.Embed(ctx, request)
+	p.monitor.record(start, err)
+	return embeddings, err
+}
@@ -0,0 +1,117 @@
+package ai
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingProvider fails its calls while err is set, and counts them.
+type failingProvider struct {
+	Provider
+	err   error
+	calls int
+}
+
+func (p *failingProvider) Complete(_ context.Context, _ *CompletionRequest) (*Completion, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &Completion{Content: "ok"}, nil
+}
+
+func TestMonitor(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	monitor := &Monitor{now: func() time.Time { return now }}
+	var openHealths []Health
+	monitor.SetOpenHandler(func(health Health) { openHealths = append(openHealths, health) })
+	provider := &failingProvider{}
+	monitored := monitor.Wrap(provider)
+	complete := func() error {
+		_, err := monitored.Complete(context.Background(), testRequest)
+		return err
+	}
+
+	require.NoError(t, complete())
+	require.NoError(t, complete())
+	require.NoError(t, complete())
+	// The calls canceled by the caller are not failures of the provider.
+	provider.err = context.Canceled
+	require.Error(t, complete())
+	assert.Equal(t, Health{State: CircuitClosed, Calls: 3}, monitor.Health())
+
+	// The circuit opens once half of the recent calls failed, and the calls fail fast.
+	provider.err = errors.New("502 Bad Gateway")
+	for range 3 {
+		require.ErrorContains(t, complete(), "502")
+	}
+	health := monitor.Health()
+	assert.Equal(t, CircuitOpen, health.State)
+	assert.Equal(t, 6, health.Calls)
+	assert.Equal(t, 3, health.Failures)
+	assert.Equal(t, "502 Bad Gateway", health.LastError)
+	assert.Equal(t, now.Add(breakerCooldown), health.RetryTime)
+	assert.Equal(t, []Health{health}, openHealths)
+	calls := provider.calls
+	require.ErrorIs(t, complete(), ErrUnavailable)
+	assert.Equal(t, calls, provider.calls)
+	_, open := monitor.Open()
+	assert.True(t, open)
+
+	// After the cooldown, a failed trial call opens the circuit again and a successful one closes it.
+	now = now.Add(breakerCooldown)
+	require.ErrorContains(t, complete(), "502")
+	require.ErrorIs(t, complete(), ErrUnavailable)
+	assert.Len(t, openHealths, 2)
+	now = now.Add(breakerCooldown)
+	provider.err = nil
+	require.NoError(t, complete())
+	assert.Equal(t, CircuitClosed, monitor.Health().State)
+	assert.Equal(t, 1, monitor.Health().Calls)
+	_, open = monitor.Open()
+	assert.False(t, open)
+
+	// The calls out of the window are forgotten.
+	now = now.Add(monitorWindow + time.Second)
+	assert.Equal(t, 0, monitor.Health().Calls)
+
+	monitor.Reset()
+	assert.Equal(t, Health{State: CircuitClosed}, monitor.Health())
+}
+
+func TestMonitorConsecutiveFailures(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	monitor := &Monitor{now: func() time.Time { return now }}
+	monitor.Configure(BreakerConfig{ConsecutiveFailures: 3, Cooldown: time.Minute})
+	provider := &failingProvider{}
+	monitored := monitor.Wrap(provider)
+	complete := func() error {
+		_, err := monitored.Complete(context.Background(), testRequest)
+		return err
+	}
+
+	// The failure rate stays low, but the circuit opens after the consecutive failures.
+	for range 10 {
+		require.NoError(t, complete())
+	}
+	provider.err = assert.AnError
+	require.Error(t, complete())
+	require.Error(t, complete())
+	assert.Equal(t, 2, monitor.Health().ConsecutiveFailures)
+	assert.Equal(t, CircuitClosed, monitor.Health().State)
+	require.Error(t, complete())
+	health := monitor.Health()
+	assert.Equal(t, CircuitOpen, health.State)
+	assert.Equal(t, 3, health.ConsecutiveFailures)
+	assert.Equal(t, now.Add(time.Minute), health.RetryTime)
+
+	now = now.Add(time.Minute)
+	provider.err = nil
+	require.NoError(t, complete())
+	assert.Equal(t, 0, monitor.Health().ConsecutiveFailures)
+}
@@ -0,0 +1,145 @@
+package ai
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const defaultOllamaEndpoint = "http://localhost:11434"
+
+// ollamaProvider speaks the native API of an Ollama server.
+type ollamaProvider struct {
+	client *httpClient
+}
+
+func newOllamaProvider(config Config) *ollamaProvider {
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = defaultOllamaEndpoint
+	}
+	headers := map[string]string{}
+	// Ollama does not authenticate, the key is for the proxies put in front of it.
+	if config.APIKey != "" {
+		headers["Authorization"] = "Bearer " + config.APIKey
+	}
+	for key, value := range config.Headers {
+		headers[key] = value
+	}
+	return &ollamaProvider{client: &httpClient{endpoint: endpoint, headers: headers}}
+}
+
+type ollamaMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+	// Images are the base64 encoded images of the message.
+	Images []string `json:"images,omitempty"`
+}
+
+type ollamaChatRequest struct {
+	Model    string          `json:"model"`
+	Messages []ollamaMessage `json:"messages"`
+	Stream   bool            `json:"stream"`
+	// Format is the JSON schema the reply is constrained to, if any.
+	Format  map[string]any `json:"format,omitempty"`
+	Options *ollamaOptions `json:"options,omitempty"`
+}
+
+type ollamaOptions struct {
+	Temperature *float64 `json:"temperature,omitempty"`
+	NumPredict  int      `json:"num_predict,omitempty"`
+}
+
+type ollamaChatResponse struct {
+	Message         ollamaMessage `json:"message"`
+	Done            bool          `json:"done"`
+	PromptEvalCount int64         `json:"prompt_eval_count"`
+	EvalCount       int64         `json:"eval_count"`
+	Error           string        `json:"error"`
+}
+
+func newOllamaChatRequest(request *CompletionRequest, stream bool) *ollamaChatRequest {
+	messages := make([]ollamaMessage, 0, len(request.Messages))
+	for _, message := range request.Messages {
+		chatMessage := ollamaMessage{Role: string(message.Role), Content: message.Content}
+		for _, image := range message.Images {
+			chatMessage.Images = append(chatMessage.Images, base64.StdEncoding.EncodeToString(image.Data))
+		}
+		messages = append(messages, chatMessage)
+	}
+	body := &ollamaChatRequest{Model: request.Model, Messages: messages, Stream: stream}
+	if request.ResponseSchema != nil {
+		body.Format = request.ResponseSchema.Schema
+	}
+	if request.Temperature != nil || request.MaxTokens > 0 {
+		body.Options = &ollamaOptions{Temperature: request.Temperature, NumPredict: request.MaxTokens}
+	}
+	return body
+}
+
+func (p *ollamaProvider) Complete(ctx context.Context, request *CompletionRequest) (*Completion, error) {
+	response := &ollamaChatResponse{}
+	if err := p.client.postJSON(ctx, "/api/chat", newOllamaChatRequest(request, false), response); err != nil {
+		return nil, err
+	}
+	return &Completion{
+		Content:          response.Message.Content,
+		PromptTokens:     response.PromptEvalCount,
+		CompletionTokens: response.EvalCount,
+		TotalTokens:      response.PromptEvalCount + response.EvalCount,
+	}, nil
+}
+
+func (p *ollamaProvider) Stream(ctx context.Context, request *CompletionRequest, onDelta func(string) error) (*Completion, error) {
+	resp, err := p.client.post(ctx, "/api/chat", newOllamaChatRequest(request, true))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var content strings.Builder
+	completion := &Completion{}
+	// The reply is streamed as newline delimited JSON objects.
+	err = readEvents(resp.Body, func(data []byte) error {
+		chunk := &ollamaChatResponse{}
+		if err := json.Unmarshal(data, chunk); err != nil {
+			return errors.Wrap(err, "failed to decode chunk")
+		}
+		if chunk.Error != "" {
+			return errors.Errorf("stream failed: %s", chunk.Error)
+		}
+		if chunk.Done {
+			completion.PromptTokens = chunk.PromptEvalCount
+			completion.CompletionTokens = chunk.EvalCount
+			completion.TotalTokens = chunk.PromptEvalCount + chunk.EvalCount
+		}
+		if chunk.Message.Content == "" {
+			return nil
+		}
+		content.WriteString(chunk.Message.Content)
+		return onDelta(chunk.Message.Content)
+	})
+	completion.Content = content.String()
+	if err != nil {
+		return completion, err
+	}
+	return completion, nil
+}
+
+func (p *ollamaProvider) Embed(ctx context.Context, request *EmbeddingRequest) (*Embeddings, error) {
+	response := &struct {
+		Embeddings      [][]float32 `json:"embeddings"`
+		PromptEvalCount int64       `json:"prompt_eval_count"`
+	}{}
+	body := map[string]any{"model": request.Model, "input": request.Input}
+	if err := p.client.postJSON(ctx, "/api/embed", body, response); err != nil {
+		return nil, err
+	}
+	if len(response.Embeddings) != len(request.Input) {
+		return nil, errors.Errorf("expected %d embeddings, got %d", len(request.Input), len(response.Embeddings))
+	}
+	return &Embeddings{Vectors: response.Embeddings, TotalTokens: response.PromptEvalCount}, nil
+}
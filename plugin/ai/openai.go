@@ -0,0 +1,187 @@
+package ai
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/option"
+	"github.com/openai/openai-go/v2/shared"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+const (
+	defaultOpenAIEndpoint = "https://api.openai.com/v1"
+	// defaultAzureAPIVersion is the Azure OpenAI API version used when none is configured.
+	defaultAzureAPIVersion = "2024-10-21"
+)
+
+// openAIProvider speaks the OpenAI API through the official client, to OpenAI or to Azure OpenAI.
+type openAIProvider struct {
+	config Config
+	azure  bool
+}
+
+func newOpenAIProvider(config Config) *openAIProvider {
+	return &openAIProvider{config: config}
+}
+
+func newAzureOpenAIProvider(config Config) *openAIProvider {
+	if config.APIVersion == "" {
+		config.APIVersion = defaultAzureAPIVersion
+	}
+	return &openAIProvider{config: config, azure: true}
+}
+
+// client returns a client for the model. Azure OpenAI addresses the models, its deployments, in the path.
+func (p *openAIProvider) client(model string) openai.Client {
+	opts := []option.RequestOption{option.WithHTTPClient(outbound.NewStreamingClient())}
+	if p.azure {
+		baseURL := strings.TrimSuffix(p.config.Endpoint, "/") + "/openai/deployments/" + url.PathEscape(model) + "/"
+		opts = append(opts,
+			option.WithBaseURL(baseURL),
+			option.WithQueryAdd("api-version", p.config.APIVersion),
+			option.WithHeader("api-key", p.config.APIKey),
+		)
+	} else {
+		opts = append(opts, option.WithAPIKey(p.config.APIKey))
+		if p.config.Endpoint != "" && p.config.Endpoint != defaultOpenAIEndpoint {
+			opts = append(opts, option.WithBaseURL(p.config.Endpoint))
+		}
+	}
+	for key, value := range p.config.Headers {
+		opts = append(opts, option.WithHeader(key, value))
+	}
+	return openai.NewClient(opts...)
+}
+
+func newOpenAIParams(request *CompletionRequest) openai.ChatCompletionNewParams {
+	params := openai.ChatCompletionNewParams{
+		Messages: convertOpenAIMessages(request.Messages),
+		Model:    openai.ChatModel(request.Model),
+	}
+	if request.Temperature != nil {
+		params.Temperature = openai.Float(*request.Temperature)
+	}
+	if request.MaxTokens > 0 {
+		params.MaxCompletionTokens = openai.Int(int64(request.MaxTokens))
+	}
+	return params
+}
+
+func (p *openAIProvider) Complete(ctx context.Context, request *CompletionRequest) (*Completion, error) {
+	client := p.client(request.Model)
+	params := newOpenAIParams(request)
+	if request.ResponseSchema != nil {
+		params.ResponseFormat = openai.ChatCompletionNewParamsResponseFormatUnion{
+			OfJSONSchema: &shared.ResponseFormatJSONSchemaParam{
+				JSONSchema: shared.ResponseFormatJSONSchemaJSONSchemaParam{
+					Name:   request.ResponseSchema.Name,
+					Strict: openai.Bool(true),
+					Schema: request.ResponseSchema.Schema,
+				},
+			},
+		}
+	}
+	chatCompletion, err := client.Chat.Completions.New(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	completion := &Completion{
+		PromptTokens:     chatCompletion.Usage.PromptTokens,
+		CompletionTokens: chatCompletion.Usage.CompletionTokens,
+		TotalTokens:      chatCompletion.Usage.TotalTokens,
+	}
+	if len(chatCompletion.Choices) > 0 {
+		completion.Content = chatCompletion.Choices[0].Message.Content
+	}
+	return completion, nil
+}
+
+func (p *openAIProvider) Stream(ctx context.Context, request *CompletionRequest, onDelta func(string) error) (*Completion, error) {
+	client := p.client(request.Model)
+	params := newOpenAIParams(request)
+	params.StreamOptions = openai.ChatCompletionStreamOptionsParam{IncludeUsage: openai.Bool(true)}
+	stream := client.Chat.Completions.NewStreaming(ctx, params)
+	defer stream.Close()
+
+	var content strings.Builder
+	completion := &Completion{}
+	for stream.Next() {
+		chunk := stream.Current()
+		if chunk.Usage.TotalTokens > 0 {
+			completion.PromptTokens = chunk.Usage.PromptTokens
+			completion.CompletionTokens = chunk.Usage.CompletionTokens
+			completion.TotalTokens = chunk.Usage.TotalTokens
+		}
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			continue
+		}
+		delta := chunk.Choices[0].Delta.Content
+		content.WriteString(delta)
+		if err := onDelta(delta); err != nil {
+			return completion, err
+		}
+	}
+	completion.Content = content.String()
+	if err := stream.Err(); err != nil {
+		return completion, err
+	}
+	return completion, nil
+}
+
+func (p *openAIProvider) Embed(ctx context.Context, request *EmbeddingRequest) (*Embeddings, error) {
+	client := p.client(request.Model)
+	response, err := client.Embeddings.New(ctx, openai.EmbeddingNewParams{
+		Input: openai.EmbeddingNewParamsInputUnion{OfArrayOfStrings: request.Input},
+		Model: openai.EmbeddingModel(request.Model),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Data) != len(request.Input) {
+		return nil, errors.Errorf("expected %d embeddings, got %d", len(request.Input), len(response.Data))
+	}
+	embeddings := &Embeddings{Vectors: make([][]float32, len(response.Data)), TotalTokens: response.Usage.TotalTokens}
+	for _, data := range response.Data {
+		if data.Index < 0 || int(data.Index) >= len(embeddings.Vectors) {
+			return nil, errors.Errorf("unexpected embedding index %d", data.Index)
+		}
+		embeddings.Vectors[data.Index] = toFloat32(data.Embedding)
+	}
+	return embeddings, nil
+}
+
+func convertOpenAIMessages(messages []Message) []openai.ChatCompletionMessageParamUnion {
+	params := make([]openai.ChatCompletionMessageParamUnion, 0, len(messages))
+	for _, message := range messages {
+		switch message.Role {
+		case RoleSystem:
+			params = append(params, openai.SystemMessage(message.Content))
+		case RoleAssistant:
+			params = append(params, openai.AssistantMessage(message.Content))
+		default:
+			if len(message.Images) == 0 {
+				params = append(params, openai.UserMessage(message.Content))
+				continue
+			}
+			parts := []openai.ChatCompletionContentPartUnionParam{openai.TextContentPart(message.Content)}
+			for _, image := range message.Images {
+				parts = append(parts, openai.ImageContentPart(openai.ChatCompletionContentPartImageImageURLParam{URL: image.dataURL()}))
+			}
+			params = append(params, openai.UserMessage(parts))
+		}
+	}
+	return params
+}
+
+func toFloat32(values []float64) []float32 {
+	vector := make([]float32, len(values))
+	for i, value := range values {
+		vector[i] = float32(value)
+	}
+	return vector
+}
@@ -0,0 +1,162 @@
+package ai
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/pkg/errors"
+)
+
+// ProviderType is the API an AI provider speaks.
+type ProviderType string
+
+const (
+	// ProviderOpenAI is the OpenAI API, also spoken by many self-hosted and third party services.
+	ProviderOpenAI ProviderType = "OPENAI"
+	// ProviderAzureOpenAI is the Azure OpenAI service, the model is the name of the deployment.
+	ProviderAzureOpenAI ProviderType = "AZURE_OPENAI"
+	// ProviderAnthropic is the Anthropic Messages API.
+	ProviderAnthropic ProviderType = "ANTHROPIC"
+	// ProviderOllama is the native API of a local Ollama server.
+	ProviderOllama ProviderType = "OLLAMA"
+	// ProviderGemini is the Google Gemini API.
+	ProviderGemini ProviderType = "GEMINI"
+	// ProviderFake is a test-mode provider replying deterministic canned responses without any API key, with the
+	// failures injected by markers in the prompts.
+	ProviderFake ProviderType = "FAKE"
+)
+
+// ErrNotSupported is returned by the providers for the operations their API does not offer.
+var ErrNotSupported = errors.New("operation not supported by the AI provider")
+
+// Role is the author of a message.
+type Role string
+
+const (
+	RoleSystem    Role = "system"
+	RoleUser      Role = "user"
+	RoleAssistant Role = "assistant"
+)
+
+// Message is a message of a conversation with a model.
+type Message struct {
+	Role    Role
+	Content string
+	// Images are sent along with the content of user messages, to the models with vision.
+	Images []Image
+}
+
+// Image is an image input of a model.
+type Image struct {
+	// Type is the MIME type of the image, e.g. "image/png".
+	Type string
+	Data []byte
+}
+
+// dataURL returns the image as a base64 data URL.
+func (i Image) dataURL() string {
+	return "data:" + i.Type + ";base64," + base64.StdEncoding.EncodeToString(i.Data)
+}
+
+// CompletionRequest asks the model to continue a conversation.
+type CompletionRequest struct {
+	Model    string
+	Messages []Message
+	// ResponseSchema, if any, constrains the reply of Complete to a JSON value matching it. Stream ignores it.
+	// See CompleteJSON, which validates the reply as well.
+	ResponseSchema *JSONSchema
+	// Temperature, if set, is the sampling temperature of the reply.
+	Temperature *float64
+	// MaxTokens, if positive, caps the number of tokens of the reply.
+	MaxTokens int
+}
+
+// Completion is the reply of the model.
+type Completion struct {
+	Content string
+	// PromptTokens and CompletionTokens are the number of tokens of the prompt and of the reply,
+	// 0 if the provider did not report them.
+	PromptTokens     int64
+	CompletionTokens int64
+	// TotalTokens is the number of prompt and completion tokens used, 0 if the provider did not report it.
+	TotalTokens int64
+}
+
+// EmbeddingRequest asks the model for the embeddings of texts.
+type EmbeddingRequest struct {
+	Model string
+	Input []string
+}
+
+// Embeddings are the embedding vectors of the input texts, in the same order.
+type Embeddings struct {
+	Vectors     [][]float32
+	TotalTokens int64
+}
+
+// Provider is an AI provider.
+type Provider interface {
+	// Complete returns the completion of the conversation.
+	Complete(ctx context.Context, request *CompletionRequest) (*Completion, error)
+	// Stream streams the completion of the conversation, calling onDelta with each part of the content
+	// as it arrives, and returns the whole completion.
+	Stream(ctx context.Context, request *CompletionRequest, onDelta func(string) error) (*Completion, error)
+	// Embed returns the embeddings of the input texts.
+	Embed(ctx context.Context, request *EmbeddingRequest) (*Embeddings, error)
+}
+
+// Config is the configuration of a provider.
+type Config struct {
+	Type ProviderType
+	// Endpoint is the base URL of the API, the public endpoint of the provider is used when empty.
+	Endpoint string
+	APIKey   string
+	// APIVersion is the API version of Azure OpenAI.
+	APIVersion string
+	// Headers are added to every request, e.g. to forward a request ID.
+	Headers map[string]string
+}
+
+// NewProvider returns the provider of the config. An empty type is the OpenAI API.
+func NewProvider(config Config) (Provider, error) {
+	switch config.Type {
+	case "", ProviderOpenAI:
+		return newOpenAIProvider(config), nil
+	case ProviderAzureOpenAI:
+		if config.Endpoint == "" {
+			return nil, errors.New("endpoint is required by Azure OpenAI")
+		}
+		return newAzureOpenAIProvider(config), nil
+	case ProviderAnthropic:
+		return newAnthropicProvider(config), nil
+	case ProviderOllama:
+		return newOllamaProvider(config), nil
+	case ProviderGemini:
+		return newGeminiProvider(config), nil
+	case ProviderFake:
+		return newFakeProvider(), nil
+	default:
+		return nil, errors.Errorf("unknown AI provider %q", config.Type)
+	}
+}
+
+// splitSystemMessages returns the content of the system messages and the other messages, for the
+// APIs taking the system prompt apart from the conversation.
+func splitSystemMessages(messages []Message) (string, []Message) {
+	system := ""
+	conversation := make([]Message, 0, len(messages))
+	for _, message := range messages {
+		if message.Role != RoleSystem {
+			conversation = append(conversation, message)
+			continue
+		}
+		if message.Content == "" {
+			continue
+		}
+		if system != "" {
+			system += "\n\n"
+		}
+		system += message.Content
+	}
+	return system, conversation
+}
@@ -0,0 +1,576 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// TestMain allows the requests to the test servers, listening on the loopback address.
+func TestMain(m *testing.M) {
+	outbound.SetPolicy(&outbound.Policy{AllowedHosts: []string{"127.0.0.1"}})
+	os.Exit(m.Run())
+}
+
+var testRequest = &CompletionRequest{
+	Model: "model",
+	Messages: []Message{
+		{Role: RoleSystem, Content: "Be brief."},
+		{Role: RoleUser, Content: "Hello"},
+	},
+}
+
+// decodeBody decodes the JSON body of the request.
+func decodeBody(t *testing.T, r *http.Request) map[string]any {
+	body := map[string]any{}
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+	return body
+}
+
+// writeEvents writes the chunks as server-sent events.
+func writeEvents(w http.ResponseWriter, chunks ...string) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	for _, chunk := range chunks {
+		_, _ = fmt.Fprintf(w, "data: %s\n\n", chunk)
+	}
+}
+
+// collect streams the completion and returns it with the deltas received.
+func collect(t *testing.T, provider Provider) (*Completion, []string) {
+	deltas := []string{}
+	completion, err := provider.Stream(context.Background(), testRequest, func(delta string) error {
+		deltas = append(deltas, delta)
+		return nil
+	})
+	require.NoError(t, err)
+	return completion, deltas
+}
+
+func TestOpenAIProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		assert.Equal(t, "request", r.Header.Get("X-Request-Id"))
+		w.Header().Set("Content-Type", "application/json")
+		body := decodeBody(t, r)
+		switch r.URL.Path {
+		case "/chat/completions":
+			assert.Len(t, body["messages"], 2)
+			if body["stream"] == true {
+				writeEvents(w,
+					`{"id":"1","object":"chat.completion.chunk","model":"model","choices":[{"index":0,"delta":{"content":"Hi"}}]}`,
+					`{"id":"1","object":"chat.completion.chunk","model":"model","choices":[{"index":0,"delta":{"content":" there"}}]}`,
+					`{"id":"1","object":"chat.completion.chunk","model":"model","choices":[],"usage":{"prompt_tokens":5,"completion_tokens":2,"total_tokens":7}}`,
+					`[DONE]`)
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":"1","object":"chat.completion","model":"model","choices":[{"index":0,"message":{"role":"assistant","content":"Hi there"}}],"usage":{"prompt_tokens":5,"completion_tokens":2,"total_tokens":7}}`))
+		case "/embeddings":
+			_, _ = w.Write([]byte(`{"object":"list","model":"model","data":[{"object":"embedding","index":1,"embedding":[0.5]},{"object":"embedding","index":0,"embedding":[0.25]}],"usage":{"total_tokens":3}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(Config{Endpoint: server.URL, APIKey: "key", Headers: map[string]string{"X-Request-Id": "request"}})
+	require.NoError(t, err)
+	completion, err := provider.Complete(context.Background(), testRequest)
+	require.NoError(t, err)
+	assert.Equal(t, &Completion{Content: "Hi there", PromptTokens: 5, CompletionTokens: 2, TotalTokens: 7}, completion)
+
+	completion, deltas := collect(t, provider)
+	assert.Equal(t, []string{"Hi", " there"}, deltas)
+	assert.Equal(t, &Completion{Content: "Hi there", PromptTokens: 5, CompletionTokens: 2, TotalTokens: 7}, completion)
+
+	embeddings, err := provider.Embed(context.Background(), &EmbeddingRequest{Model: "model", Input: []string{"a", "b"}})
+	require.NoError(t, err)
+	assert.Equal(t, [][]float32{{0.25}, {0.5}}, embeddings.Vectors)
+}
+
+func TestAzureOpenAIProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/openai/deployments/my-deployment/chat/completions", r.URL.Path)
+		assert.Equal(t, defaultAzureAPIVersion, r.URL.Query().Get("api-version"))
+		assert.Equal(t, "key", r.Header.Get("api-key"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","object":"chat.completion","model":"gpt-4o","choices":[{"index":0,"message":{"role":"assistant","content":"Hi"}}],"usage":{"total_tokens":2}}`))
+	}))
+	defer server.Close()
+
+	_, err := NewProvider(Config{Type: ProviderAzureOpenAI, APIKey: "key"})
+	require.Error(t, err)
+	provider, err := NewProvider(Config{Type: ProviderAzureOpenAI, Endpoint: server.URL, APIKey: "key"})
+	require.NoError(t, err)
+	completion, err := provider.Complete(context.Background(), &CompletionRequest{Model: "my-deployment", Messages: testRequest.Messages})
+	require.NoError(t, err)
+	assert.Equal(t, "Hi", completion.Content)
+}
+
+func TestAnthropicProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/messages", r.URL.Path)
+		assert.Equal(t, "key", r.Header.Get("x-api-key"))
+		assert.Equal(t, anthropicVersion, r.Header.Get("anthropic-version"))
+		body := decodeBody(t, r)
+		assert.Equal(t, "Be brief.", body["system"])
+		assert.Equal(t, []any{map[string]any{"role": "user", "content": "Hello"}}, body["messages"])
+		if body["stream"] == true {
+			writeEvents(w,
+				`{"type":"message_start","message":{"usage":{"input_tokens":5}}}`,
+				`{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"Hi"}}`,
+				`{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":" there"}}`,
+				`{"type":"message_delta","usage":{"output_tokens":2}}`,
+				`{"type":"message_stop"}`)
+			return
+		}
+		_, _ = w.Write([]byte(`{"content":[{"type":"text","text":"Hi there"}],"usage":{"input_tokens":5,"output_tokens":2}}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(Config{Type: ProviderAnthropic, Endpoint: server.URL, APIKey: "key"})
+	require.NoError(t, err)
+	completion, err := provider.Complete(context.Background(), testRequest)
+	require.NoError(t, err)
+	assert.Equal(t, &Completion{Content: "Hi there", PromptTokens: 5, CompletionTokens: 2, TotalTokens: 7}, completion)
+
+	completion, deltas := collect(t, provider)
+	assert.Equal(t, []string{"Hi", " there"}, deltas)
+	assert.Equal(t, &Completion{Content: "Hi there", PromptTokens: 5, CompletionTokens: 2, TotalTokens: 7}, completion)
+
+	_, err = provider.Embed(context.Background(), &EmbeddingRequest{Model: "model", Input: []string{"a"}})
+	assert.ErrorIs(t, err, ErrNotSupported)
+}
+
+func TestOllamaProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		body := decodeBody(t, r)
+		switch r.URL.Path {
+		case "/api/chat":
+			assert.Len(t, body["messages"], 2)
+			if body["stream"] == true {
+				_, _ = w.Write([]byte(strings.Join([]string{
+					`{"message":{"role":"assistant","content":"Hi"},"done":false}`,
+					`{"message":{"role":"assistant","content":" there"},"done":false}`,
+					`{"message":{"role":"assistant","content":""},"done":true,"prompt_eval_count":5,"eval_count":2}`,
+				}, "\n")))
+				return
+			}
+			_, _ = w.Write([]byte(`{"message":{"role":"assistant","content":"Hi there"},"done":true,"prompt_eval_count":5,"eval_count":2}`))
+		case "/api/embed":
+			_, _ = w.Write([]byte(`{"embeddings":[[0.25],[0.5]],"prompt_eval_count":3}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(Config{Type: ProviderOllama, Endpoint: server.URL})
+	require.NoError(t, err)
+	completion, err := provider.Complete(context.Background(), testRequest)
+	require.NoError(t, err)
+	assert.Equal(t, &Completion{Content: "Hi there", PromptTokens: 5, CompletionTokens: 2, TotalTokens: 7}, completion)
+
+	completion, deltas := collect(t, provider)
+	assert.Equal(t, []string{"Hi", " there"}, deltas)
+	assert.Equal(t, &Completion{Content: "Hi there", PromptTokens: 5, CompletionTokens: 2, TotalTokens: 7}, completion)
+
+	embeddings, err := provider.Embed(context.Background(), &EmbeddingRequest{Model: "model", Input: []string{"a", "b"}})
+	require.NoError(t, err)
+	assert.Equal(t, &Embeddings{Vectors: [][]float32{{0.25}, {0.5}}, TotalTokens: 3}, embeddings)
+}
+
+func TestGeminiProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "key", r.Header.Get("x-goog-api-key"))
+		body := decodeBody(t, r)
+		switch r.URL.Path {
+		case "/v1beta/models/gemini:generateContent", "/v1beta/models/gemini:streamGenerateContent":
+			assert.Equal(t, map[string]any{"parts": []any{map[string]any{"text": "Be brief."}}}, body["systemInstruction"])
+			assert.Equal(t, []any{map[string]any{"role": "user", "parts": []any{map[string]any{"text": "Hello"}}}}, body["contents"])
+			if strings.HasSuffix(r.URL.Path, ":streamGenerateContent") {
+				assert.Equal(t, "sse", r.URL.Query().Get("alt"))
+				writeEvents(w,
+					`{"candidates":[{"content":{"role":"model","parts":[{"text":"Hi"}]}}],"usageMetadata":{"promptTokenCount":5,"candidatesTokenCount":1,"totalTokenCount":6}}`,
+					`{"candidates":[{"content":{"role":"model","parts":[{"text":" there"}]}}],"usageMetadata":{"promptTokenCount":5,"candidatesTokenCount":2,"totalTokenCount":7}}`)
+				return
+			}
+			_, _ = w.Write([]byte(`{"candidates":[{"content":{"role":"model","parts":[{"text":"Hi there"}]}}],"usageMetadata":{"promptTokenCount":5,"candidatesTokenCount":2,"totalTokenCount":7}}`))
+		case "/v1beta/models/embedding:batchEmbedContents":
+			assert.Len(t, body["requests"], 2)
+			_, _ = w.Write([]byte(`{"embeddings":[{"values":[0.25]},{"values":[0.5]}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(Config{Type: ProviderGemini, Endpoint: server.URL, APIKey: "key"})
+	require.NoError(t, err)
+	request := &CompletionRequest{Model: "gemini", Messages: testRequest.Messages}
+	completion, err := provider.Complete(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, &Completion{Content: "Hi there", PromptTokens: 5, CompletionTokens: 2, TotalTokens: 7}, completion)
+
+	deltas := []string{}
+	completion, err = provider.Stream(context.Background(), request, func(delta string) error {
+		deltas = append(deltas, delta)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Hi", " there"}, deltas)
+	assert.Equal(t, &Completion{Content: "Hi there", PromptTokens: 5, CompletionTokens: 2, TotalTokens: 7}, completion)
+
+	embeddings, err := provider.Embed(context.Background(), &EmbeddingRequest{Model: "models/embedding", Input: []string{"a", "b"}})
+	require.NoError(t, err)
+	assert.Equal(t, [][]float32{{0.25}, {0.5}}, embeddings.Vectors)
+}
+
+func TestProviderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "7")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"error":"slow down"}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(Config{Type: ProviderOllama, Endpoint: server.URL})
+	require.NoError(t, err)
+	_, err = provider.Complete(context.Background(), testRequest)
+	require.ErrorContains(t, err, "429")
+	classified := ClassifyError(err)
+	assert.Equal(t, ErrorKindRateLimit, classified.Kind)
+	assert.Equal(t, 7*time.Second, classified.RetryAfter)
+	assert.True(t, classified.Retryable())
+
+	_, err = NewProvider(Config{Type: "UNKNOWN"})
+	require.Error(t, err)
+}
+
+func TestFakeProvider(t *testing.T) {
+	provider, err := NewProvider(Config{Type: ProviderFake})
+	require.NoError(t, err)
+
+	completion, err := provider.Complete(context.Background(), testRequest)
+	require.NoError(t, err)
+	assert.Equal(t, "This is a fake reply to: Hello", completion.Content)
+	assert.Equal(t, completion.PromptTokens+completion.CompletionTokens, completion.TotalTokens)
+	streamed, deltas := collect(t, provider)
+	assert.Equal(t, completion.Content, streamed.Content)
+	assert.Equal(t, completion.Content, strings.Join(deltas, ""))
+
+	// The replies constrained to a schema match it.
+	var result struct {
+		Tags []string `json:"tags"`
+	}
+	_, err = CompleteJSON(context.Background(), provider, &CompletionRequest{Messages: testRequest.Messages, ResponseSchema: testSchema}, &result)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fake"}, result.Tags)
+
+	embeddings, err := provider.Embed(context.Background(), &EmbeddingRequest{Input: []string{"a", "b", "a"}})
+	require.NoError(t, err)
+	require.Len(t, embeddings.Vectors, 3)
+	assert.Equal(t, embeddings.Vectors[0], embeddings.Vectors[2])
+	assert.NotEqual(t, embeddings.Vectors[0], embeddings.Vectors[1])
+
+	for _, tc := range []struct {
+		prompt     string
+		kind       ErrorKind
+		retryAfter time.Duration
+	}{
+		{prompt: "[fake:rate_limit]", kind: ErrorKindRateLimit},
+		{prompt: "Hello [fake:rate_limit:30]", kind: ErrorKindRateLimit, retryAfter: 30 * time.Second},
+		{prompt: "[fake:timeout]", kind: ErrorKindTimeout},
+		{prompt: "[fake:unavailable]", kind: ErrorKindUnavailable},
+	} {
+		_, err := provider.Complete(context.Background(), &CompletionRequest{Messages: []Message{{Role: RoleUser, Content: tc.prompt}}})
+		require.Error(t, err, tc.prompt)
+		classified := ClassifyError(err)
+		assert.Equal(t, tc.kind, classified.Kind, tc.prompt)
+		assert.Equal(t, tc.retryAfter, classified.RetryAfter, tc.prompt)
+	}
+}
+
+var testSchema = &JSONSchema{
+	Name: "tags",
+	Schema: map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"tags": map[string]any{"type": "array", "items": map[string]any{"type": "string", "minLength": 1}, "maxItems": 2},
+		},
+		"required":             []string{"tags"},
+		"additionalProperties": false,
+	},
+}
+
+func TestStructuredOutputRequest(t *testing.T) {
+	request := &CompletionRequest{Model: "model", Messages: testRequest.Messages, ResponseSchema: testSchema}
+	for _, tc := range []struct {
+		config Config
+		// check checks the structured output options of the request body, and returns the response.
+		check func(body map[string]any) string
+	}{
+		{
+			config: Config{Type: ProviderOpenAI},
+			check: func(body map[string]any) string {
+				responseFormat := body["response_format"].(map[string]any)
+				assert.Equal(t, "json_schema", responseFormat["type"])
+				assert.Equal(t, "tags", responseFormat["json_schema"].(map[string]any)["name"])
+				assert.Equal(t, true, responseFormat["json_schema"].(map[string]any)["strict"])
+				return `{"id":"1","object":"chat.completion","model":"model","choices":[{"index":0,"message":{"role":"assistant","content":"{\"tags\":[\"a\"]}"}}]}`
+			},
+		},
+		{
+			config: Config{Type: ProviderAnthropic},
+			check: func(body map[string]any) string {
+				assert.Equal(t, map[string]any{"type": "tool", "name": "tags"}, body["tool_choice"])
+				assert.Equal(t, "object", body["tools"].([]any)[0].(map[string]any)["input_schema"].(map[string]any)["type"])
+				return `{"content":[{"type":"text","text":"Sure."},{"type":"tool_use","name":"tags","input":{"tags":["a"]}}]}`
+			},
+		},
+		{
+			config: Config{Type: ProviderOllama},
+			check: func(body map[string]any) string {
+				assert.Equal(t, "object", body["format"].(map[string]any)["type"])
+				return `{"message":{"role":"assistant","content":"{\"tags\":[\"a\"]}"},"done":true}`
+			},
+		},
+		{
+			config: Config{Type: ProviderGemini},
+			check: func(body map[string]any) string {
+				generationConfig := body["generationConfig"].(map[string]any)
+				assert.Equal(t, "application/json", generationConfig["responseMimeType"])
+				assert.Equal(t, "object", generationConfig["responseJsonSchema"].(map[string]any)["type"])
+				return `{"candidates":[{"content":{"role":"model","parts":[{"text":"{\"tags\":[\"a\"]}"}]}}]}`
+			},
+		},
+	} {
+		t.Run(string(tc.config.Type), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				response := tc.check(decodeBody(t, r))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(response))
+			}))
+			defer server.Close()
+
+			tc.config.Endpoint, tc.config.APIKey = server.URL, "key"
+			provider, err := NewProvider(tc.config)
+			require.NoError(t, err)
+			completion, err := provider.Complete(context.Background(), request)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"tags":["a"]}`, completion.Content)
+		})
+	}
+}
+
+func TestImageRequest(t *testing.T) {
+	request := &CompletionRequest{Model: "model", Messages: []Message{
+		{Role: RoleUser, Content: "Describe it.", Images: []Image{{Type: "image/png", Data: []byte("png")}}},
+	}}
+	for _, tc := range []struct {
+		config Config
+		// check checks the image of the request body, and returns the response.
+		check func(body map[string]any) string
+	}{
+		{
+			config: Config{Type: ProviderOpenAI},
+			check: func(body map[string]any) string {
+				content := body["messages"].([]any)[0].(map[string]any)["content"].([]any)
+				assert.Equal(t, map[string]any{"type": "text", "text": "Describe it."}, content[0])
+				assert.Equal(t, "data:image/png;base64,cG5n", content[1].(map[string]any)["image_url"].(map[string]any)["url"])
+				return `{"id":"1","object":"chat.completion","model":"model","choices":[{"index":0,"message":{"role":"assistant","content":"A cat."}}]}`
+			},
+		},
+		{
+			config: Config{Type: ProviderAnthropic},
+			check: func(body map[string]any) string {
+				content := body["messages"].([]any)[0].(map[string]any)["content"].([]any)
+				assert.Equal(t, map[string]any{"type": "base64", "media_type": "image/png", "data": "cG5n"}, content[0].(map[string]any)["source"])
+				assert.Equal(t, map[string]any{"type": "text", "text": "Describe it."}, content[1])
+				return `{"content":[{"type":"text","text":"A cat."}]}`
+			},
+		},
+		{
+			config: Config{Type: ProviderOllama},
+			check: func(body map[string]any) string {
+				assert.Equal(t, []any{"cG5n"}, body["messages"].([]any)[0].(map[string]any)["images"])
+				return `{"message":{"role":"assistant","content":"A cat."},"done":true}`
+			},
+		},
+		{
+			config: Config{Type: ProviderGemini},
+			check: func(body map[string]any) string {
+				parts := body["contents"].([]any)[0].(map[string]any)["parts"].([]any)
+				assert.Equal(t, map[string]any{"mimeType": "image/png", "data": "cG5n"}, parts[1].(map[string]any)["inlineData"])
+				return `{"candidates":[{"content":{"role":"model","parts":[{"text":"A cat."}]}}]}`
+			},
+		},
+	} {
+		t.Run(string(tc.config.Type), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				response := tc.check(decodeBody(t, r))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(response))
+			}))
+			defer server.Close()
+
+			tc.config.Endpoint, tc.config.APIKey = server.URL, "key"
+			provider, err := NewProvider(tc.config)
+			require.NoError(t, err)
+			completion, err := provider.Complete(context.Background(), request)
+			require.NoError(t, err)
+			assert.Equal(t, "A cat.", completion.Content)
+		})
+	}
+}
+
+func TestGenerationOptionsRequest(t *testing.T) {
+	temperature := 0.2
+	request := &CompletionRequest{Model: "model", Messages: []Message{{Role: RoleUser, Content: "Hello"}}, Temperature: &temperature, MaxTokens: 64}
+	for _, tc := range []struct {
+		config Config
+		// check checks the generation options of the request body, and returns the response.
+		check func(body map[string]any) string
+	}{
+		{
+			config: Config{Type: ProviderOpenAI},
+			check: func(body map[string]any) string {
+				assert.Equal(t, 0.2, body["temperature"])
+				assert.Equal(t, float64(64), body["max_completion_tokens"])
+				return `{"id":"1","object":"chat.completion","model":"model","choices":[{"index":0,"message":{"role":"assistant","content":"Hi"}}]}`
+			},
+		},
+		{
+			config: Config{Type: ProviderAnthropic},
+			check: func(body map[string]any) string {
+				assert.Equal(t, 0.2, body["temperature"])
+				assert.Equal(t, float64(64), body["max_tokens"])
+				return `{"content":[{"type":"text","text":"Hi"}]}`
+			},
+		},
+		{
+			config: Config{Type: ProviderOllama},
+			check: func(body map[string]any) string {
+				assert.Equal(t, map[string]any{"temperature": 0.2, "num_predict": float64(64)}, body["options"])
+				return `{"message":{"role":"assistant","content":"Hi"},"done":true}`
+			},
+		},
+		{
+			config: Config{Type: ProviderGemini},
+			check: func(body map[string]any) string {
+				assert.Equal(t, map[string]any{"temperature": 0.2, "maxOutputTokens": float64(64)}, body["generationConfig"])
+				return `{"candidates":[{"content":{"role":"model","parts":[{"text":"Hi"}]}}]}`
+			},
+		},
+	} {
+		t.Run(string(tc.config.Type), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				response := tc.check(decodeBody(t, r))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(response))
+			}))
+			defer server.Close()
+
+			tc.config.Endpoint, tc.config.APIKey = server.URL, "key"
+			provider, err := NewProvider(tc.config)
+			require.NoError(t, err)
+			completion, err := provider.Complete(context.Background(), request)
+			require.NoError(t, err)
+			assert.Equal(t, "Hi", completion.Content)
+		})
+	}
+}
+
+// replyProvider replies to the completions with its replies in turn, and keeps the requests.
+type replyProvider struct {
+	Provider
+	replies  []string
+	requests []*CompletionRequest
+}
+
+func (p *replyProvider) Complete(_ context.Context, request *CompletionRequest) (*Completion, error) {
+	p.requests = append(p.requests, request)
+	reply := p.replies[0]
+	p.replies = p.replies[1:]
+	return &Completion{Content: reply, PromptTokens: 8, CompletionTokens: 2, TotalTokens: 10}, nil
+}
+
+func TestCompleteJSON(t *testing.T) {
+	request := &CompletionRequest{Model: "model", Messages: testRequest.Messages, ResponseSchema: testSchema}
+	var result struct {
+		Tags []string `json:"tags"`
+	}
+
+	provider := &replyProvider{replies: []string{"```json\n{\"tags\": [\"a\", \"b\"]}\n```"}}
+	completion, err := CompleteJSON(context.Background(), provider, request, &result)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, result.Tags)
+	assert.Equal(t, int64(10), completion.TotalTokens)
+	assert.Equal(t, RoleSystem, provider.requests[0].Messages[0].Role)
+	assert.Contains(t, provider.requests[0].Messages[0].Content, `"additionalProperties":false`)
+
+	// The invalid replies are sent back to be repaired, with what is wrong with them.
+	provider = &replyProvider{replies: []string{"Here are the tags: a, b", `{"tags": ["a", 1]}`, `{"tags": ["c"]}`}}
+	completion, err = CompleteJSON(context.Background(), provider, request, &result)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c"}, result.Tags)
+	assert.Equal(t, int64(24), completion.PromptTokens)
+	assert.Equal(t, int64(6), completion.CompletionTokens)
+	assert.Equal(t, int64(30), completion.TotalTokens)
+	repair := provider.requests[2].Messages
+	assert.Equal(t, `{"tags": ["a", 1]}`, repair[len(repair)-2].Content)
+	assert.Contains(t, repair[len(repair)-1].Content, "$.tags[1] must be of type string")
+
+	provider = &replyProvider{replies: []string{`{}`, `{"tags": [""]}`, `{"tags": ["a", "b", "c"]}`, `{"tags": ["d"]}`}}
+	completion, err = CompleteJSON(context.Background(), provider, request, &result)
+	require.ErrorContains(t, err, "must have at most 2 items")
+	assert.Equal(t, int64(30), completion.TotalTokens)
+	assert.Len(t, provider.requests, 3)
+
+	_, err = CompleteJSON(context.Background(), provider, testRequest, &result)
+	require.Error(t, err)
+}
+
+func TestValidateJSON(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"status":   map[string]any{"type": "string", "enum": []any{"open", "done"}},
+			"priority": map[string]any{"type": "integer", "minimum": 1, "maximum": 3},
+			"due":      map[string]any{"type": []any{"string", "null"}, "maxLength": 10},
+		},
+		"required":             []any{"status", "priority", "due"},
+		"additionalProperties": false,
+	}
+	for content, want := range map[string]string{
+		`{"status":"open","priority":2,"due":null}`:               "",
+		`{"status":"done","priority":3,"due":"2025-01-01"}`:       "",
+		`{"status":"open","priority":2}`:                          "$.due is required",
+		`{"status":"closed","priority":2,"due":null}`:             "$.status must be one of",
+		`{"status":"open","priority":2.5,"due":null}`:             "$.priority must be of type integer",
+		`{"status":"open","priority":4,"due":null}`:               "$.priority must be at most 3",
+		`{"status":"open","priority":2,"due":"next week please"}`: "$.due must have at most 10 characters",
+		`{"status":"open","priority":2,"due":null,"owner":"me"}`:  "$.owner is not allowed",
+		`["open"]`: "$ must be of type object",
+	} {
+		var value any
+		require.NoError(t, json.Unmarshal([]byte(content), &value))
+		err := validateJSON(schema, value, "$")
+		if want == "" {
+			assert.NoError(t, err, content)
+		} else {
+			assert.ErrorContains(t, err, want, content)
+		}
+	}
+}
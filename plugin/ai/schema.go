@@ -0,0 +1,235 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxRepairAttempts is the number of times a reply not matching the response schema is sent back to the model
+// for it to repair the reply.
+const maxRepairAttempts = 2
+
+// JSONSchema constrains the reply of the model to a JSON value, using the structured output mode of the provider.
+type JSONSchema struct {
+	// Name identifies the schema, e.g. "memo_tags". It may only contain letters, digits, "_" and "-".
+	Name string
+	// Schema is the JSON schema of the reply, whose root must be an object. It must be valid in the strict mode
+	// of the OpenAI structured outputs: the objects require all their properties and disallow additional ones.
+	Schema map[string]any
+}
+
+// CompleteJSON returns the completion of the conversation constrained to the response schema of the request, and
+// decodes the reply into result. The reply is validated against the schema on the server too, as not all providers
+// and models enforce it: an invalid reply is sent back to the model with the validation error for it to repair it.
+// The returned completion counts the tokens of all the attempts, and is returned along an invalid reply error.
+func CompleteJSON(ctx context.Context, provider Provider, request *CompletionRequest, result any) (*Completion, error) {
+	if request.ResponseSchema == nil {
+		return nil, errors.New("response schema is required")
+	}
+	schema, err := json.Marshal(request.ResponseSchema.Schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal response schema")
+	}
+	// The schema is described in the prompt too, for the providers and models without a structured output mode.
+	messages := append([]Message{{
+		Role:    RoleSystem,
+		Content: "Reply with a JSON value matching the following JSON schema, without any other text:\n" + string(schema),
+	}}, request.Messages...)
+
+	total := &Completion{}
+	for attempt := 0; ; attempt++ {
+		completion, err := provider.Complete(ctx, &CompletionRequest{
+			Model:          request.Model,
+			Messages:       messages,
+			ResponseSchema: request.ResponseSchema,
+			Temperature:    request.Temperature,
+			MaxTokens:      request.MaxTokens,
+		})
+		if err != nil {
+			return total, err
+		}
+		total.PromptTokens += completion.PromptTokens
+		total.CompletionTokens += completion.CompletionTokens
+		total.TotalTokens += completion.TotalTokens
+		total.Content = stripCodeFence(completion.Content)
+
+		replyErr := decodeJSON(total.Content, request.ResponseSchema.Schema, result)
+		if replyErr == nil {
+			return total, nil
+		}
+		if attempt == maxRepairAttempts {
+			return total, errors.Wrapf(replyErr, "invalid reply after %d repair attempts", maxRepairAttempts)
+		}
+		messages = append(messages,
+			Message{Role: RoleAssistant, Content: completion.Content},
+			Message{Role: RoleUser, Content: fmt.Sprintf("Your reply is invalid: %v. Reply again with only the corrected JSON value.", replyErr)},
+		)
+	}
+}
+
+// decodeJSON validates the JSON content against the schema and decodes it into result.
+func decodeJSON(content string, schema map[string]any, result any) error {
+	var value any
+	if err := json.Unmarshal([]byte(content), &value); err != nil {
+		return errors.Wrap(err, "the reply is not valid JSON")
+	}
+	if err := validateJSON(schema, value, "$"); err != nil {
+		return err
+	}
+	if err := json.Unmarshal([]byte(content), result); err != nil {
+		return errors.Wrap(err, "the reply does not match the expected type")
+	}
+	return nil
+}
+
+// stripCodeFence removes the code fence models sometimes wrap JSON replies in.
+func stripCodeFence(content string) string {
+	content = strings.TrimSpace(content)
+	if !strings.HasPrefix(content, "```") || !strings.HasSuffix(content, "```") {
+		return content
+	}
+	_, body, found := strings.Cut(content, "\n")
+	if !found {
+		return content
+	}
+	return strings.TrimSpace(strings.TrimSuffix(body, "```"))
+}
+
+// validateJSON checks a decoded JSON value against the schema. It supports the subset of JSON schema
+// the structured output modes of the providers support: types, enums, object properties, array items,
+// and the length and range constraints.
+func validateJSON(schema map[string]any, value any, path string) error {
+	if types := schemaStrings(schema["type"]); len(types) > 0 && !slices.ContainsFunc(types, func(t string) bool { return isJSONType(value, t) }) {
+		return errors.Errorf("%s must be of type %s", path, strings.Join(types, " or "))
+	}
+	if enum, ok := schema["enum"].([]any); ok && !slices.ContainsFunc(enum, func(option any) bool { return jsonEqual(option, value) }) {
+		return errors.Errorf("%s must be one of %v", path, enum)
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		properties, _ := schema["properties"].(map[string]any)
+		for _, name := range schemaStrings(schema["required"]) {
+			if _, ok := value[name]; !ok {
+				return errors.Errorf("%s.%s is required", path, name)
+			}
+		}
+		for name, property := range value {
+			propertySchema, ok := properties[name].(map[string]any)
+			if !ok {
+				if additional, ok := schema["additionalProperties"].(bool); ok && !additional {
+					return errors.Errorf("%s.%s is not allowed", path, name)
+				}
+				continue
+			}
+			if err := validateJSON(propertySchema, property, path+"."+name); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if minItems, ok := schemaNumber(schema["minItems"]); ok && float64(len(value)) < minItems {
+			return errors.Errorf("%s must have at least %v items", path, minItems)
+		}
+		if maxItems, ok := schemaNumber(schema["maxItems"]); ok && float64(len(value)) > maxItems {
+			return errors.Errorf("%s must have at most %v items", path, maxItems)
+		}
+		if items, ok := schema["items"].(map[string]any); ok {
+			for i, item := range value {
+				if err := validateJSON(items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case string:
+		length := float64(len([]rune(value)))
+		if minLength, ok := schemaNumber(schema["minLength"]); ok && length < minLength {
+			return errors.Errorf("%s must have at least %v characters", path, minLength)
+		}
+		if maxLength, ok := schemaNumber(schema["maxLength"]); ok && length > maxLength {
+			return errors.Errorf("%s must have at most %v characters", path, maxLength)
+		}
+	case float64:
+		if minimum, ok := schemaNumber(schema["minimum"]); ok && value < minimum {
+			return errors.Errorf("%s must be at least %v", path, minimum)
+		}
+		if maximum, ok := schemaNumber(schema["maximum"]); ok && value > maximum {
+			return errors.Errorf("%s must be at most %v", path, maximum)
+		}
+	}
+	return nil
+}
+
+// isJSONType reports whether the decoded JSON value is of the JSON schema type.
+func isJSONType(value any, jsonType string) bool {
+	switch jsonType {
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	default:
+		return false
+	}
+}
+
+// jsonEqual reports whether a value of a schema equals a decoded JSON value.
+func jsonEqual(a, b any) bool {
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aJSON) == string(bJSON)
+}
+
+// schemaStrings returns a keyword of a schema that is a string or a list of strings, e.g. "type" or "required".
+func schemaStrings(keyword any) []string {
+	switch keyword := keyword.(type) {
+	case string:
+		return []string{keyword}
+	case []string:
+		return keyword
+	case []any:
+		values := make([]string, 0, len(keyword))
+		for _, value := range keyword {
+			if value, ok := value.(string); ok {
+				values = append(values, value)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// schemaNumber returns a keyword of a schema that is a number, e.g. "maxItems".
+func schemaNumber(keyword any) (float64, bool) {
+	switch keyword := keyword.(type) {
+	case int:
+		return float64(keyword), true
+	case int64:
+		return float64(keyword), true
+	case float64:
+		return keyword, true
+	default:
+		return 0, false
+	}
+}
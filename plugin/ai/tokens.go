@@ -0,0 +1,110 @@
+package ai
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultContextWindow is the context window, in tokens, of the models whose context window is not known.
+const DefaultContextWindow = 8192
+
+// pretokenizePattern splits the text like the pre-tokenization of the tiktoken encodings of the GPT models:
+// contractions, words with their leading space, groups of up to three digits, punctuation and whitespace.
+// The byte pair encoding then merges the bytes of each piece into tokens.
+var pretokenizePattern = regexp.MustCompile(`(?i:'s|'t|'re|'ve|'m|'ll|'d)|[^\r\n\p{L}\p{N}]?\p{L}+|\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n]*|\s*[\r\n]+|\s+`)
+
+// knownContextWindows are the context windows, in tokens, of the model families by the prefix of their names.
+// The longest matching prefix wins.
+var knownContextWindows = map[string]int{
+	"gpt-3.5-turbo": 16385,
+	"gpt-4":         8192,
+	"gpt-4-turbo":   128000,
+	"gpt-4o":        128000,
+	"gpt-4.1":       1047576,
+	"gpt-5":         400000,
+	"o1":            200000,
+	"o3":            200000,
+	"o4":            200000,
+	"claude":        200000,
+	"gemini":        1048576,
+	"llama3":        8192,
+	"llama3.1":      131072,
+	"llama3.2":      131072,
+	"llama3.3":      131072,
+	"mistral":       32768,
+	"mixtral":       32768,
+	"qwen":          32768,
+	"deepseek":      65536,
+}
+
+// EstimateTokens estimates the number of tokens of the text for the tokenizers of the GPT models. The text is split
+// with the pre-tokenization of tiktoken, then the tokens of each piece are estimated from the merges the byte pair
+// encoding usually makes: a common word is a single token, and the characters of Chinese, Japanese and Korean are
+// about a token each. The estimate is close for the other tokenizers too.
+func EstimateTokens(text string) int {
+	tokens := 0
+	for _, piece := range pretokenizePattern.FindAllString(text, -1) {
+		tokens += estimatePieceTokens(piece)
+	}
+	return tokens
+}
+
+func estimatePieceTokens(piece string) int {
+	trimmed := strings.TrimSpace(piece)
+	if trimmed == "" {
+		// A run of whitespace, or of line breaks.
+		return 1
+	}
+	first, _ := utf8.DecodeRuneInString(trimmed)
+	switch {
+	case unicode.IsDigit(first):
+		return 1
+	case unicode.IsLetter(first) || strings.HasPrefix(trimmed, "'"):
+		ascii, cjk, other := 0, 0, 0
+		for _, r := range trimmed {
+			switch {
+			case r < utf8.RuneSelf:
+				ascii++
+			case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul):
+				cjk++
+			default:
+				other++
+			}
+		}
+		// The leading punctuation of a word, if any, is usually merged with it.
+		return max(ceilDiv(ascii, 6)+cjk+ceilDiv(other, 3), 1)
+	default:
+		ascii, other := 0, 0
+		for _, r := range trimmed {
+			if r < utf8.RuneSelf {
+				ascii++
+			} else {
+				other++
+			}
+		}
+		// The symbols out of ASCII, e.g. the emoji, take several bytes and tokens.
+		return max(ceilDiv(ascii, 2)+2*other, 1)
+	}
+}
+
+// ContextWindow returns the context window of the model in tokens: the known context window of its family, or
+// DefaultContextWindow. The provider prefix of the name, e.g. "openai/", is ignored.
+func ContextWindow(model string) int {
+	if i := strings.LastIndex(model, "/"); i >= 0 {
+		model = model[i+1:]
+	}
+	model = strings.ToLower(model)
+	window, matched := DefaultContextWindow, ""
+	for prefix, tokens := range knownContextWindows {
+		if strings.HasPrefix(model, prefix) && len(prefix) > len(matched) {
+			window, matched = tokens, prefix
+		}
+	}
+	return window
+}
+
+func ceilDiv(a, b int) int {
+	return (a + b - 1) / b
+}
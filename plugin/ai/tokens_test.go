@@ -0,0 +1,39 @@
+package ai
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateTokens(t *testing.T) {
+	for _, tc := range []struct {
+		text   string
+		tokens int
+	}{
+		{"", 0},
+		{"hello world", 2},
+		{"The quick brown fox jumps over the lazy dog.", 10},
+		{"1234567", 3},
+		{"你好世界", 4},
+		{"I'll be there", 4},
+	} {
+		assert.Equal(t, tc.tokens, EstimateTokens(tc.text), tc.text)
+	}
+
+	// Long words are split into several tokens, like the emoji.
+	assert.Greater(t, EstimateTokens("internationalization"), 1)
+	assert.Greater(t, EstimateTokens("🎉"), 1)
+	// The estimate grows with the text.
+	assert.Equal(t, 100*EstimateTokens(" garden"), EstimateTokens(strings.Repeat(" garden", 100)))
+}
+
+func TestContextWindow(t *testing.T) {
+	assert.Equal(t, 128000, ContextWindow("gpt-4o-mini"))
+	assert.Equal(t, 8192, ContextWindow("gpt-4"))
+	assert.Equal(t, 128000, ContextWindow("gpt-4-turbo-preview"))
+	assert.Equal(t, 200000, ContextWindow("claude-sonnet-4-5"))
+	assert.Equal(t, 131072, ContextWindow("meta/Llama3.1-8B"))
+	assert.Equal(t, DefaultContextWindow, ContextWindow("my-local-model"))
+}
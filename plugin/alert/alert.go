@@ -0,0 +1,142 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// Alert is an operational failure the admins are alerted of.
+type Alert struct {
+	// Kind identifies the failure, e.g. "webhook_delivery".
+	Kind    string    `json:"kind"`
+	Title   string    `json:"title"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// ChannelType is the type of a channel.
+type ChannelType int
+
+const (
+	// Webhook posts the alert as JSON.
+	Webhook ChannelType = iota + 1
+	// Slack posts the alert as a Slack incoming webhook message.
+	Slack
+	// Email sends the alert by email.
+	Email
+)
+
+// Channel is where the alerts are sent to.
+type Channel struct {
+	Type ChannelType
+	// URL is the URL the alerts are posted to, for the webhook and Slack channels.
+	URL string
+	// SMTP is the server the alerts are sent with, for the email channel.
+	SMTP SMTPConfig
+	// Recipients are the email addresses the alerts are sent to, for the email channel.
+	Recipients []string
+}
+
+// SMTPConfig is the SMTP server the alert emails are sent with.
+type SMTPConfig struct {
+	Host string
+	// Port is the port of the server, 587 if 0.
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// sendTimeout is the time limit of sending an alert on a channel.
+const sendTimeout = 10 * time.Second
+
+// Send sends the alert on the channel.
+func Send(ctx context.Context, channel Channel, alert Alert) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	switch channel.Type {
+	case Webhook:
+		return post(ctx, channel.URL, alert)
+	case Slack:
+		return post(ctx, channel.URL, map[string]string{"text": fmt.Sprintf("*%s*\n%s", alert.Title, alert.Message)})
+	case Email:
+		return sendEmail(ctx, channel.SMTP, channel.Recipients, alert)
+	default:
+		return errors.Errorf("unsupported channel type %d", channel.Type)
+	}
+}
+
+// post posts the message as JSON to the URL.
+func post(ctx context.Context, url string, message any) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal alert")
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create alert request")
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := outbound.NewClient().Do(request)
+	if err != nil {
+		return errors.Wrap(err, "failed to post alert")
+	}
+	defer response.Body.Close()
+	// The body is drained for the connection to be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64*1024))
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return errors.Errorf("failed to post alert, status code: %d", response.StatusCode)
+	}
+	return nil
+}
+
+// sendEmail sends the alert to the recipients with the SMTP server, with STARTTLS if the server supports it.
+func sendEmail(ctx context.Context, config SMTPConfig, recipients []string, alert Alert) error {
+	if config.Host == "" || config.From == "" || len(recipients) == 0 {
+		return errors.New("the SMTP host, the sender and the recipients are required")
+	}
+	port := config.Port
+	if port == 0 {
+		port = 587
+	}
+	var auth smtp.Auth
+	if config.Username != "" {
+		auth = smtp.PlainAuth("", config.Username, config.Password, config.Host)
+	}
+	message := strings.Join([]string{
+		"From: " + config.From,
+		"To: " + strings.Join(recipients, ", "),
+		"Subject: " + mime.QEncoding.Encode("UTF-8", alert.Title),
+		"Date: " + alert.Time.Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		alert.Message,
+	}, "\r\n")
+
+	// smtp.SendMail has no context, the sending is abandoned once the context is done.
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(net.JoinHostPort(config.Host, strconv.Itoa(port)), auth, config.From, recipients, []byte(message))
+	}()
+	select {
+	case err := <-done:
+		return errors.Wrap(err, "failed to send alert email")
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "failed to send alert email")
+	}
+}
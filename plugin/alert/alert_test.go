@@ -0,0 +1,48 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// TestMain allows the requests to the test servers, listening on the loopback address.
+func TestMain(m *testing.M) {
+	outbound.SetPolicy(&outbound.Policy{AllowedHosts: []string{"127.0.0.1"}})
+	os.Exit(m.Run())
+}
+
+func TestSend(t *testing.T) {
+	var body []byte
+	statusCode := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	alert := Alert{Kind: "disk_usage", Title: "Disk usage over threshold", Message: "The disk is 95% full.", Time: time.Unix(1_700_000_000, 0).UTC()}
+
+	require.NoError(t, Send(context.Background(), Channel{Type: Webhook, URL: server.URL}, alert))
+	var posted Alert
+	require.NoError(t, json.Unmarshal(body, &posted))
+	require.Equal(t, alert, posted)
+
+	require.NoError(t, Send(context.Background(), Channel{Type: Slack, URL: server.URL}, alert))
+	require.JSONEq(t, `{"text":"*Disk usage over threshold*\nThe disk is 95% full."}`, string(body))
+
+	statusCode = http.StatusInternalServerError
+	require.ErrorContains(t, Send(context.Background(), Channel{Type: Webhook, URL: server.URL}, alert), "status code: 500")
+	require.Error(t, Send(context.Background(), Channel{Type: Email}, alert))
+	require.Error(t, Send(context.Background(), Channel{}, alert))
+}
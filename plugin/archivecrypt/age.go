@@ -0,0 +1,77 @@
+package archivecrypt
+
+import (
+	"io"
+
+	"filippo.io/age"
+	"github.com/pkg/errors"
+)
+
+// ageScryptWorkFactor is the base 2 logarithm of the scrypt cost of the passphrase, the default of age.
+var ageScryptWorkFactor = 18
+
+// NewAgeWriter returns a writer encrypting the content written to it with the passphrase to w in the age format. The
+// writer must be closed to write the end of the content.
+func NewAgeWriter(w io.Writer, passphrase string) (io.WriteCloser, error) {
+	recipient, err := age.NewScryptRecipient(passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create recipient")
+	}
+	recipient.SetWorkFactor(ageScryptWorkFactor)
+	return age.Encrypt(w, recipient)
+}
+
+// NewAgeReader returns a reader of the content encrypted with the passphrase in the age format read from r. The
+// content is authenticated as it is read: a read returning ErrTampered means the content read until then must be
+// discarded.
+func NewAgeReader(r io.Reader, passphrase string) (io.Reader, error) {
+	identity, err := age.NewScryptIdentity(passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create identity")
+	}
+	source := &sourceReader{r: r}
+	reader, err := age.Decrypt(source, identity)
+	if err != nil {
+		var noMatch *age.NoIdentityMatchError
+		if errors.As(err, &noMatch) {
+			return nil, ErrWrongPassphrase
+		}
+		return nil, source.wrap(err)
+	}
+	return &ageReader{r: reader, source: source}, nil
+}
+
+type ageReader struct {
+	r      io.Reader
+	source *sourceReader
+}
+
+func (r *ageReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && err != io.EOF {
+		err = r.source.wrap(err)
+	}
+	return n, err
+}
+
+// sourceReader keeps the error of reading the encrypted data, to tell it from the errors of decrypting them.
+type sourceReader struct {
+	r   io.Reader
+	err error
+}
+
+func (r *sourceReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && err != io.EOF {
+		r.err = err
+	}
+	return n, err
+}
+
+// wrap returns the error of reading the encrypted data as is, and the other errors as ErrTampered.
+func (r *sourceReader) wrap(err error) error {
+	if r.err != nil && errors.Is(err, r.err) {
+		return err
+	}
+	return errors.Wrap(ErrTampered, err.Error())
+}
@@ -0,0 +1,25 @@
+// Package archivecrypt encrypts the archives and backups produced by the server with a passphrase, so that they are
+// not stored in plain text, e.g. on a third-party storage. Two formats are written, readable by the usual tools:
+// ZIP archives whose entries are encrypted with AES-256 (WinZip AE-2, opened by 7-Zip, WinZip or macOS' Archive
+// Utility), and age files encrypted with a passphrase (https://age-encryption.org/v1, decrypted with `age -d`).
+package archivecrypt
+
+import (
+	"crypto/rand"
+
+	"github.com/pkg/errors"
+)
+
+// ErrWrongPassphrase is the error of decrypting with a passphrase other than the one of the encryption.
+var ErrWrongPassphrase = errors.New("wrong passphrase")
+
+// ErrTampered is the error of decrypting data that were modified or truncated after their encryption.
+var ErrTampered = errors.New("the encrypted data were tampered with")
+
+func randomBytes(size int) ([]byte, error) {
+	data := make([]byte, size)
+	if _, err := rand.Read(data); err != nil {
+		return nil, errors.Wrap(err, "failed to generate random bytes")
+	}
+	return data, nil
+}
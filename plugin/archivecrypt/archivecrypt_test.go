@@ -0,0 +1,125 @@
+package archivecrypt
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestZipWriter(t *testing.T) {
+	content := bytes.Repeat([]byte("memos "), 10000)
+	modified := time.Date(2024, 5, 6, 7, 8, 10, 0, time.UTC)
+	var buffer bytes.Buffer
+	archive := NewZipWriter(&buffer, "passphrase")
+	writer, err := archive.CreateHeader(&zip.FileHeader{Name: "memos.db", Method: zip.Deflate, Modified: modified})
+	require.NoError(t, err)
+	_, err = writer.Write(content)
+	require.NoError(t, err)
+	_, err = archive.Create("empty.txt")
+	require.NoError(t, err)
+	require.NoError(t, archive.Close())
+	require.False(t, bytes.Contains(buffer.Bytes(), []byte("memos memos")))
+
+	reader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	require.NoError(t, err)
+	require.Len(t, reader.File, 2)
+	file := reader.File[0]
+	require.Equal(t, uint16(zipMethodAES), file.Method)
+	require.NotZero(t, file.Flags&zipFlagEncrypted)
+	require.True(t, modified.Equal(file.Modified))
+	// The entries are AE-2 ones, without CRC.
+	require.Zero(t, file.CRC32)
+	require.Equal(t, uint16(zipAESVersion), binary.LittleEndian.Uint16(file.Extra[4:]))
+	require.Equal(t, uint64(len(content)), file.UncompressedSize64)
+
+	// The entries cannot be read without the passphrase.
+	_, err = file.Open()
+	require.ErrorIs(t, err, zip.ErrAlgorithm)
+	_, err = OpenZipEntry(file, "wrong")
+	require.ErrorIs(t, err, ErrWrongPassphrase)
+
+	for i, expected := range [][]byte{content, {}} {
+		entry, err := OpenZipEntry(reader.File[i], "passphrase")
+		require.NoError(t, err)
+		data, err := io.ReadAll(entry)
+		require.NoError(t, err)
+		require.Equal(t, expected, data)
+		require.NoError(t, entry.Close())
+	}
+
+	// A modified content is detected by its authentication code.
+	offset, err := file.DataOffset()
+	require.NoError(t, err)
+	tampered := bytes.Clone(buffer.Bytes())
+	tampered[offset+zipAESSaltSize+zipAESVerifierSize+10] ^= 1
+	reader, err = zip.NewReader(bytes.NewReader(tampered), int64(len(tampered)))
+	require.NoError(t, err)
+	entry, err := OpenZipEntry(reader.File[0], "passphrase")
+	require.NoError(t, err)
+	_, err = io.ReadAll(entry)
+	require.ErrorIs(t, err, ErrTampered)
+
+	// Without a passphrase the archive is a plain one.
+	buffer.Reset()
+	archive = NewZipWriter(&buffer, "")
+	writer, err = archive.Create("memos.db")
+	require.NoError(t, err)
+	_, err = writer.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, archive.Close())
+	reader, err = zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	require.NoError(t, err)
+	require.Equal(t, zip.Deflate, reader.File[0].Method)
+	entry, err = OpenZipEntry(reader.File[0], "")
+	require.NoError(t, err)
+	data, err := io.ReadAll(entry)
+	require.NoError(t, err)
+	require.Equal(t, content, data)
+}
+
+func TestAge(t *testing.T) {
+	// A low work factor keeps the test fast.
+	ageScryptWorkFactor = 10
+	defer func() { ageScryptWorkFactor = 18 }()
+
+	for _, size := range []int{0, 100, 64 * 1024, 128*1024 + 1} {
+		content := bytes.Repeat([]byte("m"), size)
+		var buffer bytes.Buffer
+		writer, err := NewAgeWriter(&buffer, "passphrase")
+		require.NoError(t, err)
+		_, err = writer.Write(content)
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		require.True(t, bytes.HasPrefix(buffer.Bytes(), []byte("age-encryption.org/v1\n-> scrypt ")))
+
+		reader, err := NewAgeReader(bytes.NewReader(buffer.Bytes()), "passphrase")
+		require.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, content, data)
+
+		_, err = NewAgeReader(bytes.NewReader(buffer.Bytes()), "wrong")
+		require.ErrorIs(t, err, ErrWrongPassphrase)
+
+		// A truncated payload is detected.
+		if size > 0 {
+			reader, err = NewAgeReader(bytes.NewReader(buffer.Bytes()[:buffer.Len()-1]), "passphrase")
+			require.NoError(t, err)
+			_, err = io.ReadAll(reader)
+			require.ErrorIs(t, err, ErrTampered)
+		}
+
+		// A modified payload is detected.
+		tampered := bytes.Clone(buffer.Bytes())
+		tampered[len(tampered)-1] ^= 1
+		reader, err = NewAgeReader(bytes.NewReader(tampered), "passphrase")
+		require.NoError(t, err)
+		_, err = io.ReadAll(reader)
+		require.ErrorIs(t, err, ErrTampered)
+	}
+}
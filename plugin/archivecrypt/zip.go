@@ -0,0 +1,411 @@
+package archivecrypt
+
+import (
+	"archive/zip"
+	"compress/flate"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/sha1"
+	"encoding/binary"
+	"hash"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// zipMethodAES is the compression method of the AES encrypted entries, whose actual method is in their extra field.
+	zipMethodAES = 99
+	// zipAESExtraID is the ID of the extra field of the AES encrypted entries.
+	zipAESExtraID = 0x9901
+	// zipAESVersion is AE-2, whose entries have no CRC: the CRC of the plain content would leak information about it,
+	// and the authentication code already detects the modifications.
+	zipAESVersion = 2
+	// zipAESStrength is the key strength of AES-256.
+	zipAESStrength = 3
+	zipAESKeySize  = 32
+	zipAESSaltSize = 16
+	// zipAESVerifierSize is the size of the password verification value, checked before decrypting.
+	zipAESVerifierSize = 2
+	zipAESAuthCodeSize = 10
+	zipAESIterations   = 1000
+	// zipAESReaderVersion is the version needed to extract the AES encrypted entries.
+	zipAESReaderVersion = 51
+	// zipFlagEncrypted is the general purpose flag of the encrypted entries.
+	zipFlagEncrypted = 0x1
+	// zipFlagDataDescriptor is the general purpose flag of the entries whose sizes follow their content.
+	zipFlagDataDescriptor = 0x8
+	// zipFlagUTF8 is the general purpose flag of the entries whose name is encoded in UTF-8.
+	zipFlagUTF8 = 0x800
+	// zipExtTimeExtraID is the ID of the extended timestamp extra field.
+	zipExtTimeExtraID = 0x5455
+)
+
+// ZipWriter writes a ZIP archive whose entries are compressed with Deflate and encrypted with AES-256, or a plain ZIP
+// archive when its passphrase is empty. The entries must be added with Create or CreateHeader: Copy and CreateRaw
+// add them unencrypted.
+type ZipWriter struct {
+	*zip.Writer
+	passphrase string
+	// entry is the encrypted entry being written, finished before the next entry is added or the archive is closed.
+	entry *zipAESWriter
+}
+
+// NewZipWriter returns a writer of a ZIP archive encrypted with the passphrase to w.
+func NewZipWriter(w io.Writer, passphrase string) *ZipWriter {
+	return &ZipWriter{Writer: zip.NewWriter(w), passphrase: passphrase}
+}
+
+// Create adds an entry with the name to the archive and returns the writer of its content.
+func (w *ZipWriter) Create(name string) (io.Writer, error) {
+	return w.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate})
+}
+
+// CreateHeader adds an entry with the header to the archive and returns the writer of its content. The content of
+// the entries is compressed with Deflate whatever the method of the header when the archive is encrypted.
+func (w *ZipWriter) CreateHeader(header *zip.FileHeader) (io.Writer, error) {
+	if err := w.finishEntry(); err != nil {
+		return nil, err
+	}
+	// The directories have no content to encrypt.
+	if w.passphrase == "" || strings.HasSuffix(header.Name, "/") {
+		return w.Writer.CreateHeader(header)
+	}
+	// The entry is written raw: the writer of the archive would set the CRC of the content, which AE-2 leaves to 0.
+	encrypted := *header
+	encrypted.Method = zipMethodAES
+	encrypted.Flags |= zipFlagEncrypted | zipFlagDataDescriptor
+	encrypted.CRC32 = 0
+	encrypted.CompressedSize64 = 0
+	encrypted.UncompressedSize64 = 0
+	encrypted.CreatorVersion = encrypted.CreatorVersion&0xff00 | zipAESReaderVersion
+	encrypted.ReaderVersion = zipAESReaderVersion
+	if !encrypted.NonUTF8 && utf8.ValidString(encrypted.Name) && !isASCII(encrypted.Name) {
+		encrypted.Flags |= zipFlagUTF8
+	}
+	extra := make([]byte, 11)
+	binary.LittleEndian.PutUint16(extra[0:], zipAESExtraID)
+	binary.LittleEndian.PutUint16(extra[2:], 7)
+	binary.LittleEndian.PutUint16(extra[4:], zipAESVersion)
+	copy(extra[6:], "AE")
+	extra[8] = zipAESStrength
+	binary.LittleEndian.PutUint16(extra[9:], zip.Deflate)
+	encrypted.Extra = append(extra, header.Extra...)
+	if !encrypted.Modified.IsZero() {
+		encrypted.ModifiedDate, encrypted.ModifiedTime = msDosTime(encrypted.Modified)
+		extTime := make([]byte, 9)
+		binary.LittleEndian.PutUint16(extTime[0:], zipExtTimeExtraID)
+		binary.LittleEndian.PutUint16(extTime[2:], 5)
+		extTime[4] = 1
+		binary.LittleEndian.PutUint32(extTime[5:], uint32(encrypted.Modified.Unix()))
+		encrypted.Extra = append(encrypted.Extra, extTime...)
+	}
+
+	out, err := w.Writer.CreateRaw(&encrypted)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := newZipAESWriter(out, w.passphrase)
+	if err != nil {
+		return nil, err
+	}
+	entry.header = &encrypted
+	w.entry = entry
+	return entry, nil
+}
+
+// CreateRaw adds an unencrypted entry whose content is written as is to the archive.
+func (w *ZipWriter) CreateRaw(header *zip.FileHeader) (io.Writer, error) {
+	if err := w.finishEntry(); err != nil {
+		return nil, err
+	}
+	return w.Writer.CreateRaw(header)
+}
+
+// Copy copies the entry of another archive to the archive as is.
+func (w *ZipWriter) Copy(f *zip.File) error {
+	if err := w.finishEntry(); err != nil {
+		return err
+	}
+	return w.Writer.Copy(f)
+}
+
+// Close finishes the archive, without closing the underlying writer.
+func (w *ZipWriter) Close() error {
+	if err := w.finishEntry(); err != nil {
+		return err
+	}
+	return w.Writer.Close()
+}
+
+// finishEntry writes the end of the encrypted entry being written and its sizes to its header, which the writer of the
+// archive writes in the data descriptor of the entry and in the central directory.
+func (w *ZipWriter) finishEntry() error {
+	entry := w.entry
+	if entry == nil {
+		return nil
+	}
+	w.entry = nil
+	if err := entry.Close(); err != nil {
+		return err
+	}
+	entry.header.CompressedSize64 = entry.compressedSize
+	entry.header.UncompressedSize64 = entry.uncompressedSize
+	entry.header.CompressedSize = uint32(min(entry.compressedSize, math.MaxUint32))
+	entry.header.UncompressedSize = uint32(min(entry.uncompressedSize, math.MaxUint32))
+	return nil
+}
+
+// OpenZipEntry returns a reader of the content of an entry of an archive written by a ZipWriter with the passphrase.
+// The content is decrypted and authenticated as it is read: a read returning ErrTampered means the content read until
+// then must be discarded. The entries that are not encrypted are opened as is.
+func OpenZipEntry(f *zip.File, passphrase string) (io.ReadCloser, error) {
+	if f.Method != zipMethodAES {
+		return f.Open()
+	}
+	raw, err := f.OpenRaw()
+	if err != nil {
+		return nil, err
+	}
+	return newZipAESReader(raw, int64(f.CompressedSize64), passphrase)
+}
+
+// zipAESKeys derives the encryption key, the authentication key and the password verification value of an entry.
+func zipAESKeys(passphrase string, salt []byte) ([]byte, []byte, []byte, error) {
+	keys, err := pbkdf2.Key(sha1.New, passphrase, salt, zipAESIterations, 2*zipAESKeySize+zipAESVerifierSize)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to derive keys")
+	}
+	return keys[:zipAESKeySize], keys[zipAESKeySize : 2*zipAESKeySize], keys[2*zipAESKeySize:], nil
+}
+
+// zipAESWriter compresses and encrypts the content of an entry: its salt and password verification value, the
+// encrypted compressed content, then the authentication code of the encrypted content.
+type zipAESWriter struct {
+	out      io.Writer
+	deflater *flate.Writer
+	stream   *zipAESStream
+	mac      hash.Hash
+	// prefix is the salt and the password verification value, written before the first encrypted content.
+	prefix []byte
+	// header is the header of the entry, whose sizes are set once the entry is finished.
+	header           *zip.FileHeader
+	compressedSize   uint64
+	uncompressedSize uint64
+}
+
+func newZipAESWriter(out io.Writer, passphrase string) (*zipAESWriter, error) {
+	salt, err := randomBytes(zipAESSaltSize)
+	if err != nil {
+		return nil, err
+	}
+	encryptionKey, authKey, verifier, err := zipAESKeys(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := newZipAESStream(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	w := &zipAESWriter{out: out, stream: stream, mac: hmac.New(sha1.New, authKey), prefix: append(salt, verifier...)}
+	w.deflater, err = flate.NewWriter(writerFunc(w.writeEncrypted), flate.DefaultCompression)
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *zipAESWriter) Write(p []byte) (int, error) {
+	n, err := w.deflater.Write(p)
+	w.uncompressedSize += uint64(n)
+	return n, err
+}
+
+func (w *zipAESWriter) writePrefix() error {
+	if w.prefix == nil {
+		return nil
+	}
+	if _, err := w.out.Write(w.prefix); err != nil {
+		return err
+	}
+	w.compressedSize += uint64(len(w.prefix))
+	w.prefix = nil
+	return nil
+}
+
+func (w *zipAESWriter) writeEncrypted(p []byte) (int, error) {
+	if err := w.writePrefix(); err != nil {
+		return 0, err
+	}
+	encrypted := make([]byte, len(p))
+	w.stream.XORKeyStream(encrypted, p)
+	w.mac.Write(encrypted)
+	if _, err := w.out.Write(encrypted); err != nil {
+		return 0, err
+	}
+	w.compressedSize += uint64(len(encrypted))
+	return len(p), nil
+}
+
+func (w *zipAESWriter) Close() error {
+	if err := w.deflater.Close(); err != nil {
+		return err
+	}
+	if err := w.writePrefix(); err != nil {
+		return err
+	}
+	if _, err := w.out.Write(w.mac.Sum(nil)[:zipAESAuthCodeSize]); err != nil {
+		return err
+	}
+	w.compressedSize += zipAESAuthCodeSize
+	return nil
+}
+
+// zipAESReader decrypts and decompresses the content of an entry, and checks its authentication code at the end of
+// the encrypted content.
+type zipAESReader struct {
+	raw      io.Reader
+	inflater io.ReadCloser
+	// encrypted is the encrypted content, read before the authentication code.
+	encrypted io.Reader
+	stream    *zipAESStream
+	mac       hash.Hash
+	err       error
+}
+
+func newZipAESReader(raw io.Reader, size int64, passphrase string) (*zipAESReader, error) {
+	if size < zipAESSaltSize+zipAESVerifierSize+zipAESAuthCodeSize {
+		return nil, ErrTampered
+	}
+	prefix := make([]byte, zipAESSaltSize+zipAESVerifierSize)
+	if _, err := io.ReadFull(raw, prefix); err != nil {
+		return nil, err
+	}
+	encryptionKey, authKey, verifier, err := zipAESKeys(passphrase, prefix[:zipAESSaltSize])
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(verifier, prefix[zipAESSaltSize:]) {
+		return nil, ErrWrongPassphrase
+	}
+	stream, err := newZipAESStream(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	r := &zipAESReader{
+		raw:       raw,
+		encrypted: io.LimitReader(raw, size-int64(len(prefix))-zipAESAuthCodeSize),
+		stream:    stream,
+		mac:       hmac.New(sha1.New, authKey),
+	}
+	r.inflater = flate.NewReader(readerFunc(r.readDecrypted))
+	return r, nil
+}
+
+func (r *zipAESReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	n, err := r.inflater.Read(p)
+	if err != nil {
+		// The content is authenticated whatever ended it: a modified content may be corrupted for the inflater.
+		if authErr := r.authenticate(); authErr != nil {
+			err = authErr
+		}
+		r.err = err
+	}
+	return n, err
+}
+
+func (r *zipAESReader) readDecrypted(p []byte) (int, error) {
+	n, err := r.encrypted.Read(p)
+	r.mac.Write(p[:n])
+	r.stream.XORKeyStream(p[:n], p[:n])
+	return n, err
+}
+
+// authenticate reads the rest of the encrypted content and checks the authentication code following it.
+func (r *zipAESReader) authenticate() error {
+	if _, err := io.Copy(r.mac, r.encrypted); err != nil {
+		return err
+	}
+	authCode := make([]byte, zipAESAuthCodeSize)
+	if _, err := io.ReadFull(r.raw, authCode); err != nil {
+		return err
+	}
+	if !hmac.Equal(r.mac.Sum(nil)[:zipAESAuthCodeSize], authCode) {
+		return ErrTampered
+	}
+	return nil
+}
+
+func (r *zipAESReader) Close() error {
+	return r.inflater.Close()
+}
+
+// zipAESStream is AES in counter mode with the little-endian counter, starting at 1, of the ZIP AES encryption.
+type zipAESStream struct {
+	block     cipher.Block
+	counter   [aes.BlockSize]byte
+	keystream [aes.BlockSize]byte
+	used      int
+}
+
+func newZipAESStream(key []byte) (*zipAESStream, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	return &zipAESStream{block: block, used: aes.BlockSize}, nil
+}
+
+func (s *zipAESStream) XORKeyStream(dst, src []byte) {
+	for i := range src {
+		if s.used == aes.BlockSize {
+			for j := range s.counter {
+				s.counter[j]++
+				if s.counter[j] != 0 {
+					break
+				}
+			}
+			s.block.Encrypt(s.keystream[:], s.counter[:])
+			s.used = 0
+		}
+		dst[i] = src[i] ^ s.keystream[s.used]
+		s.used++
+	}
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
+
+type readerFunc func(p []byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) {
+	return f(p)
+}
+
+// msDosTime returns the MS-DOS date and time of t, in its location.
+func msDosTime(t time.Time) (uint16, uint16) {
+	date := uint16(t.Day() + int(t.Month())<<5 + (t.Year()-1980)<<9)
+	clock := uint16(t.Second()/2 + t.Minute()<<5 + t.Hour()<<11)
+	return date, clock
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,174 @@
+// Package bluesky posts to a Bluesky account through the XRPC API of its AT Protocol server.
+package bluesky
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// DefaultService is the server hosting the accounts created on bsky.app.
+const DefaultService = "https://bsky.social"
+
+// postCollection is the collection of the posts in the repository of an account.
+const postCollection = "app.bsky.feed.post"
+
+// Client is a minimal client of the XRPC API of an AT Protocol server.
+type Client struct {
+	service    string
+	httpClient *http.Client
+}
+
+// Session is the session of an account, created with its identifier and an app password.
+type Session struct {
+	DID       string `json:"did"`
+	Handle    string `json:"handle"`
+	AccessJwt string `json:"accessJwt"`
+}
+
+// NewClient returns a client of the server. Its requests are initiated by the server, so they are restricted by the
+// outbound policy.
+func NewClient(service string) (*Client, error) {
+	service, err := ParseServiceURL(service)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		service:    service,
+		httpClient: outbound.NewClient(),
+	}, nil
+}
+
+// ParseServiceURL validates the URL of a server and returns it without trailing slash, the default server when empty.
+func ParseServiceURL(service string) (string, error) {
+	service = strings.TrimSpace(service)
+	if service == "" {
+		return DefaultService, nil
+	}
+	u, err := url.Parse(service)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid service url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", errors.Errorf("unsupported service url scheme: %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return "", errors.New("service url host is empty")
+	}
+	return strings.TrimRight(u.String(), "/"), nil
+}
+
+// CreateSession signs in to the account with its handle, email or DID and an app password.
+func (c *Client) CreateSession(ctx context.Context, identifier, password string) (*Session, error) {
+	session := &Session{}
+	request := map[string]string{"identifier": identifier, "password": password}
+	if err := c.call(ctx, "", "com.atproto.server.createSession", request, session); err != nil {
+		return nil, err
+	}
+	if session.DID == "" || session.AccessJwt == "" {
+		return nil, errors.New("invalid session")
+	}
+	return session, nil
+}
+
+// CreatePost creates the post in the repository of the account and returns its AT URI.
+func (c *Client) CreatePost(ctx context.Context, session *Session, post *Post) (string, error) {
+	request := map[string]any{
+		"repo":       session.DID,
+		"collection": postCollection,
+		"record":     post,
+	}
+	response := struct {
+		URI string `json:"uri"`
+	}{}
+	if err := c.call(ctx, session.AccessJwt, "com.atproto.repo.createRecord", request, &response); err != nil {
+		return "", err
+	}
+	if response.URI == "" {
+		return "", errors.New("the created post has no uri")
+	}
+	return response.URI, nil
+}
+
+// DeletePost deletes the post with the AT URI from the repository of the account.
+func (c *Client) DeletePost(ctx context.Context, session *Session, uri string) error {
+	_, rkey, err := parsePostURI(uri)
+	if err != nil {
+		return err
+	}
+	request := map[string]string{
+		"repo":       session.DID,
+		"collection": postCollection,
+		"rkey":       rkey,
+	}
+	return c.call(ctx, session.AccessJwt, "com.atproto.repo.deleteRecord", request, nil)
+}
+
+// PostURL returns the URL of the post with the AT URI on bsky.app.
+func PostURL(uri string) (string, error) {
+	did, rkey, err := parsePostURI(uri)
+	if err != nil {
+		return "", err
+	}
+	return "https://bsky.app/profile/" + did + "/post/" + rkey, nil
+}
+
+// parsePostURI returns the DID of the account and the record key of the post with the AT URI, which is
+// at://{did}/app.bsky.feed.post/{rkey}.
+func parsePostURI(uri string) (string, string, error) {
+	parts := strings.Split(strings.TrimPrefix(uri, "at://"), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] != postCollection || parts[2] == "" {
+		return "", "", errors.Errorf("invalid post uri: %s", uri)
+	}
+	return parts[0], parts[2], nil
+}
+
+// call calls the XRPC procedure with the request and decodes its response into response if not nil.
+func (c *Client) call(ctx context.Context, accessJwt, method string, request, response any) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal request of %s", method)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.service+"/xrpc/"+method, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct request of %s", method)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if accessJwt != "" {
+		req.Header.Set("Authorization", "Bearer "+accessJwt)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to request %s", method)
+	}
+	defer resp.Body.Close()
+	data, err := outbound.ReadBody(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read response of %s", method)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		xrpcError := struct {
+			Error   string `json:"error"`
+			Message string `json:"message"`
+		}{}
+		if json.Unmarshal(data, &xrpcError) == nil && xrpcError.Error != "" {
+			return errors.Errorf("failed to request %s, status code: %d, error: %s %s", method, resp.StatusCode, xrpcError.Error, xrpcError.Message)
+		}
+		return errors.Errorf("failed to request %s, status code: %d", method, resp.StatusCode)
+	}
+	if response == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, response); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal response of %s", method)
+	}
+	return nil
+}
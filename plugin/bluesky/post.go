@@ -0,0 +1,85 @@
+package bluesky
+
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxPostLength is the maximum length of the text of a post, in graphemes. The text is measured in runes, which
+// are never fewer than the graphemes, so that a post is never rejected as too long.
+const MaxPostLength = 300
+
+// Post is a record of the app.bsky.feed.post collection.
+type Post struct {
+	Type      string  `json:"$type"`
+	Text      string  `json:"text"`
+	CreatedAt string  `json:"createdAt"`
+	Facets    []Facet `json:"facets,omitempty"`
+}
+
+// Facet annotates a range of the text of a post, in UTF-8 bytes.
+type Facet struct {
+	Index    FacetIndex     `json:"index"`
+	Features []FacetFeature `json:"features"`
+}
+
+type FacetIndex struct {
+	ByteStart int `json:"byteStart"`
+	ByteEnd   int `json:"byteEnd"`
+}
+
+type FacetFeature struct {
+	Type string `json:"$type"`
+	URI  string `json:"uri"`
+}
+
+// NewPost returns the post of the content followed by the link, the content being truncated for the post to fit in
+// MaxPostLength. The link is annotated so that it is clickable.
+func NewPost(content, link string, createdAt time.Time) *Post {
+	content = strings.TrimSpace(content)
+	suffix := ""
+	if link != "" {
+		suffix = link
+		if content != "" {
+			suffix = "\n\n" + link
+		}
+	}
+	text := truncate(content, MaxPostLength-utf8.RuneCountInString(suffix)) + suffix
+
+	post := &Post{
+		Type:      "app.bsky.feed.post",
+		Text:      text,
+		CreatedAt: createdAt.UTC().Format(time.RFC3339),
+	}
+	if link != "" {
+		post.Facets = []Facet{{
+			Index: FacetIndex{ByteStart: len(text) - len(link), ByteEnd: len(text)},
+			Features: []FacetFeature{{
+				Type: "app.bsky.richtext.facet#link",
+				URI:  link,
+			}},
+		}}
+	}
+	return post
+}
+
+// truncate truncates the text to at most length runes, preferably at a word boundary, marking it with an ellipsis.
+func truncate(text string, length int) string {
+	if utf8.RuneCountInString(text) <= length {
+		return text
+	}
+	if length <= 0 {
+		return ""
+	}
+	runes := []rune(text)[:length-1]
+	// Cut at the last space unless it would drop more than half of the text.
+	for i := len(runes) - 1; i >= len(runes)/2; i-- {
+		if unicode.IsSpace(runes[i]) {
+			runes = runes[:i]
+			break
+		}
+	}
+	return strings.TrimRightFunc(string(runes), unicode.IsSpace) + "…"
+}
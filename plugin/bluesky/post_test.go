@@ -0,0 +1,41 @@
+package bluesky
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPost(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	link := "https://memos.example.com/memos/abc"
+
+	post := NewPost("  Hello #world  ", link, createdAt)
+	require.Equal(t, "Hello #world\n\n"+link, post.Text)
+	require.Equal(t, "2024-05-01T12:00:00Z", post.CreatedAt)
+	require.Len(t, post.Facets, 1)
+	require.Equal(t, link, post.Text[post.Facets[0].Index.ByteStart:post.Facets[0].Index.ByteEnd])
+
+	post = NewPost("", link, createdAt)
+	require.Equal(t, link, post.Text)
+
+	post = NewPost("Hello", "", createdAt)
+	require.Equal(t, "Hello", post.Text)
+	require.Empty(t, post.Facets)
+
+	// Long content is truncated at a word boundary, the link kept whole.
+	content := strings.Repeat("été ", 100)
+	post = NewPost(content, link, createdAt)
+	require.LessOrEqual(t, utf8.RuneCountInString(post.Text), MaxPostLength)
+	require.Greater(t, utf8.RuneCountInString(post.Text), MaxPostLength-5)
+	require.True(t, strings.HasPrefix(post.Text, "été été"))
+	require.True(t, strings.HasSuffix(post.Text, "été…\n\n"+link))
+	require.Equal(t, link, post.Text[post.Facets[0].Index.ByteStart:post.Facets[0].Index.ByteEnd])
+
+	// Content without spaces is cut at the limit.
+	post = NewPost(strings.Repeat("a", 400), "", createdAt)
+	require.Equal(t, strings.Repeat("a", MaxPostLength-1)+"…", post.Text)
+}
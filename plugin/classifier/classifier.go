@@ -0,0 +1,59 @@
+package classifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// timeout is the timeout for a classification request. Local models can be slow on large images.
+var timeout = time.Minute
+
+// Result is the response expected from the classifier endpoint.
+type Result struct {
+	// Sensitive is whether the image is sensitive.
+	Sensitive bool `json:"sensitive"`
+}
+
+// Classify posts the image to the classifier endpoint and returns whether it is sensitive.
+// The image is sent as the request body with its MIME type as content type, and the endpoint
+// responds with a JSON Result. The API key, if any, is sent as a bearer token.
+func Classify(ctx context.Context, endpoint, apiKey, contentType string, blob []byte) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(blob))
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to construct classification request to %s", endpoint)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("User-Agent", "memos-classifier")
+	if apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+	resp, err := outbound.NewStreamingClient().Do(req)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to post image to %s", endpoint)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return false, errors.Errorf("failed to classify image, status code: %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to read classification response")
+	}
+	result := &Result{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return false, errors.Wrap(err, "failed to parse classification response")
+	}
+	return result.Sensitive, nil
+}
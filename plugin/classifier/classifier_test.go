@@ -0,0 +1,50 @@
+package classifier
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// TestMain allows the requests to the test servers, listening on the loopback address.
+func TestMain(m *testing.M) {
+	outbound.SetPolicy(&outbound.Policy{AllowedHosts: []string{"127.0.0.1"}})
+	os.Exit(m.Run())
+}
+
+func TestClassify(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, "image/png", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		if string(body) == "sensitive" {
+			_, _ = w.Write([]byte(`{"sensitive": true}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"sensitive": false}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	sensitive, err := Classify(ctx, server.URL, "key", "image/png", []byte("sensitive"))
+	require.NoError(t, err)
+	assert.True(t, sensitive)
+
+	sensitive, err = Classify(ctx, server.URL, "key", "image/png", []byte("safe"))
+	require.NoError(t, err)
+	assert.False(t, sensitive)
+
+	_, err = Classify(ctx, server.URL, "", "image/png", []byte("safe"))
+	require.Error(t, err)
+}
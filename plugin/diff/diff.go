@@ -0,0 +1,228 @@
+package diff
+
+import (
+	"strings"
+	"unicode"
+)
+
+// maxEdits is the maximum number of edits searched for, beyond which the tokens are all replaced. It bounds the
+// memory of the search, quadratic in the number of edits.
+const maxEdits = 1000
+
+// Op is the operation of an edit.
+type Op int
+
+const (
+	// Equal keeps the text.
+	Equal Op = iota
+	// Insert adds the text of the new tokens.
+	Insert
+	// Delete removes the text of the old tokens.
+	Delete
+)
+
+// Edit is an operation on the text of a token, or of a run of tokens once merged.
+type Edit struct {
+	Op   Op
+	Text string
+}
+
+// Lines returns the edits of the lines of the old text into the lines of the new text, one edit per line. The
+// deletions of a change come before its insertions.
+func Lines(old, new string) []Edit {
+	return Diff(splitLines(old), splitLines(new))
+}
+
+// Words returns the edits of the words of the old text into the words of the new text, the consecutive edits of the
+// same operation merged. The words are the runs of letters and digits, the runs of spaces and the other characters.
+func Words(old, new string) []Edit {
+	return Merge(Diff(splitWords(old), splitWords(new)))
+}
+
+// Diff returns the shortest edits of the old tokens into the new tokens, one edit per token, by the algorithm of
+// Myers. The deletions of a change come before its insertions.
+func Diff(old, new []string) []Edit {
+	// The common prefix and suffix are kept without searching them.
+	prefix := 0
+	for prefix < len(old) && prefix < len(new) && old[prefix] == new[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(old)-prefix && suffix < len(new)-prefix && old[len(old)-1-suffix] == new[len(new)-1-suffix] {
+		suffix++
+	}
+
+	edits := make([]Edit, 0, len(old)+len(new))
+	for _, token := range old[:prefix] {
+		edits = append(edits, Edit{Op: Equal, Text: token})
+	}
+	edits = append(edits, search(old[prefix:len(old)-suffix], new[prefix:len(new)-suffix])...)
+	for _, token := range old[len(old)-suffix:] {
+		edits = append(edits, Edit{Op: Equal, Text: token})
+	}
+	return edits
+}
+
+// Merge merges the consecutive edits of the same operation.
+func Merge(edits []Edit) []Edit {
+	merged := []Edit{}
+	for _, edit := range edits {
+		if last := len(merged) - 1; last >= 0 && merged[last].Op == edit.Op {
+			merged[last].Text += edit.Text
+			continue
+		}
+		merged = append(merged, edit)
+	}
+	return merged
+}
+
+// search returns the shortest edits of the old tokens into the new tokens, replacing them all when there are more
+// than maxEdits.
+func search(old, new []string) []Edit {
+	n, m := len(old), len(new)
+	offset := n + m + 1
+	// v is the furthest x on each diagonal k = x - y, trace the diagonals -d-1 to d+1 of v before each number of
+	// edits d, the ones the backtracking reads.
+	v := make([]int, 2*offset+2)
+	trace := [][]int{}
+	found := false
+	for d := 0; d <= n+m && d <= maxEdits; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && old[x] == new[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+		if found {
+			break
+		}
+	}
+	if !found {
+		return replaceAll(old, new)
+	}
+
+	// Backtrack from the end, the edits in reverse.
+	reversed := []Edit{}
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		// The diagonal k of the trace of d is at k + d + 1.
+		v := trace[d]
+		k := x - y
+		var previousK int
+		if k == -d || (k != d && v[k+d] < v[k+d+2]) {
+			previousK = k + 1
+		} else {
+			previousK = k - 1
+		}
+		previousX := v[previousK+d+1]
+		previousY := previousX - previousK
+		for x > previousX && y > previousY {
+			x--
+			y--
+			reversed = append(reversed, Edit{Op: Equal, Text: old[x]})
+		}
+		if x == previousX {
+			y--
+			reversed = append(reversed, Edit{Op: Insert, Text: new[y]})
+		} else {
+			x--
+			reversed = append(reversed, Edit{Op: Delete, Text: old[x]})
+		}
+	}
+	for x > 0 && y > 0 {
+		x--
+		y--
+		reversed = append(reversed, Edit{Op: Equal, Text: old[x]})
+	}
+
+	edits := make([]Edit, 0, len(reversed))
+	for index := len(reversed) - 1; index >= 0; index-- {
+		edits = append(edits, reversed[index])
+	}
+	return groupChanges(edits)
+}
+
+// groupChanges moves the deletions of each run of changes before its insertions.
+func groupChanges(edits []Edit) []Edit {
+	grouped := make([]Edit, 0, len(edits))
+	for start := 0; start < len(edits); {
+		if edits[start].Op == Equal {
+			grouped = append(grouped, edits[start])
+			start++
+			continue
+		}
+		end := start
+		for end < len(edits) && edits[end].Op != Equal {
+			end++
+		}
+		for _, op := range []Op{Delete, Insert} {
+			for _, edit := range edits[start:end] {
+				if edit.Op == op {
+					grouped = append(grouped, edit)
+				}
+			}
+		}
+		start = end
+	}
+	return grouped
+}
+
+func replaceAll(old, new []string) []Edit {
+	edits := make([]Edit, 0, len(old)+len(new))
+	for _, token := range old {
+		edits = append(edits, Edit{Op: Delete, Text: token})
+	}
+	for _, token := range new {
+		edits = append(edits, Edit{Op: Insert, Text: token})
+	}
+	return edits
+}
+
+// splitLines splits the text into its lines, none for an empty text.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+}
+
+// splitWords splits the text into the runs of letters and digits, the runs of spaces and the other characters.
+func splitWords(text string) []string {
+	words := []string{}
+	class := func(r rune) int {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			return 1
+		case unicode.IsSpace(r):
+			return 2
+		default:
+			return 0
+		}
+	}
+	start, previous := 0, -1
+	for index, r := range text {
+		current := class(r)
+		if index > start && (current != previous || current == 0) {
+			words = append(words, text[start:index])
+			start = index
+		}
+		previous = current
+	}
+	if start < len(text) {
+		words = append(words, text[start:])
+	}
+	return words
+}
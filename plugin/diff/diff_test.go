@@ -0,0 +1,86 @@
+package diff
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLines(t *testing.T) {
+	edits := Lines("a\nb\nc\nd", "a\nB\nc\nd\ne")
+	require.Equal(t, []Edit{
+		{Op: Equal, Text: "a"},
+		{Op: Delete, Text: "b"},
+		{Op: Insert, Text: "B"},
+		{Op: Equal, Text: "c"},
+		{Op: Equal, Text: "d"},
+		{Op: Insert, Text: "e"},
+	}, edits)
+
+	require.Empty(t, Lines("", ""))
+	require.Equal(t, []Edit{{Op: Insert, Text: "new"}}, Lines("", "new"))
+}
+
+func TestWords(t *testing.T) {
+	edits := Words("The quick brown fox.", "The slow brown fox!")
+	require.Equal(t, []Edit{
+		{Op: Equal, Text: "The "},
+		{Op: Delete, Text: "quick"},
+		{Op: Insert, Text: "slow"},
+		{Op: Equal, Text: " brown fox"},
+		{Op: Delete, Text: "."},
+		{Op: Insert, Text: "!"},
+	}, edits)
+}
+
+func TestDiffIsShortest(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	randomTokens := func() []string {
+		tokens := make([]string, random.Intn(30))
+		for index := range tokens {
+			tokens[index] = string(rune('a' + random.Intn(4)))
+		}
+		return tokens
+	}
+	for range 200 {
+		old, new := randomTokens(), randomTokens()
+		edits := Diff(old, new)
+
+		// The edits rebuild both sides, with the fewest changes.
+		var rebuiltOld, rebuiltNew []string
+		changes := 0
+		for _, edit := range edits {
+			if edit.Op != Insert {
+				rebuiltOld = append(rebuiltOld, edit.Text)
+			}
+			if edit.Op != Delete {
+				rebuiltNew = append(rebuiltNew, edit.Text)
+			}
+			if edit.Op != Equal {
+				changes++
+			}
+		}
+		require.Equal(t, strings.Join(old, ""), strings.Join(rebuiltOld, ""))
+		require.Equal(t, strings.Join(new, ""), strings.Join(rebuiltNew, ""))
+		require.Equal(t, len(old)+len(new)-2*longestCommonSubsequence(old, new), changes)
+	}
+}
+
+func longestCommonSubsequence(a, b []string) int {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+	return lengths[0][0]
+}
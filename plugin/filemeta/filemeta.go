@@ -0,0 +1,45 @@
+package filemeta
+
+import (
+	"strings"
+	"time"
+)
+
+// Metadata is the metadata extracted from a file. Fields that could not be extracted are zero.
+type Metadata struct {
+	// Width and Height are the dimensions in pixels of an image.
+	Width  int
+	Height int
+	// Duration is the duration of an audio or video file.
+	Duration time.Duration
+	// PageCount is the number of pages of a PDF document.
+	PageCount int
+	// OriginalTime is the time the content was originally created, e.g. when a photo was taken.
+	OriginalTime time.Time
+}
+
+// Extract extracts the metadata of a file from its content, based on its MIME type. It returns nil
+// for unsupported types and for files no metadata could be extracted from.
+//
+// Supported are PNG, JPEG and GIF images, with the EXIF capture time of JPEG photos, MP4 and
+// QuickTime audio and video, WAV audio and PDF documents.
+func Extract(contentType string, blob []byte) *Metadata {
+	mediaType, _, _ := strings.Cut(strings.ToLower(contentType), ";")
+	mediaType = strings.TrimSpace(mediaType)
+
+	var metadata *Metadata
+	switch {
+	case strings.HasPrefix(mediaType, "image/"):
+		metadata = extractImage(blob)
+	case mediaType == "audio/wav" || mediaType == "audio/x-wav" || mediaType == "audio/wave":
+		metadata = extractWAV(blob)
+	case strings.HasPrefix(mediaType, "video/") || strings.HasPrefix(mediaType, "audio/"):
+		metadata = extractMP4(blob)
+	case mediaType == "application/pdf":
+		metadata = extractPDF(blob)
+	}
+	if metadata == nil || *metadata == (Metadata{}) {
+		return nil
+	}
+	return metadata
+}
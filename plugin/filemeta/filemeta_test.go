@@ -0,0 +1,128 @@
+package filemeta
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractImage(t *testing.T) {
+	var buffer bytes.Buffer
+	require.NoError(t, png.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, 40, 30))))
+	metadata := Extract("image/png", buffer.Bytes())
+	require.NotNil(t, metadata)
+	require.Equal(t, 40, metadata.Width)
+	require.Equal(t, 30, metadata.Height)
+	require.True(t, metadata.OriginalTime.IsZero())
+
+	require.Nil(t, Extract("image/png", []byte("not an image")))
+}
+
+func TestExtractJPEGOriginalTime(t *testing.T) {
+	var buffer bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, 16, 8)), nil))
+	encoded := buffer.Bytes()
+
+	// A big endian TIFF structure with an IFD0 pointing to an Exif IFD holding DateTimeOriginal.
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = append(tiff, 0x00, 0x01)
+	tiff = append(tiff, 0x87, 0x69, 0x00, 0x04, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x1a)
+	tiff = append(tiff, 0x00, 0x00, 0x00, 0x00)
+	tiff = append(tiff, 0x00, 0x01)
+	tiff = append(tiff, 0x90, 0x03, 0x00, 0x02, 0x00, 0x00, 0x00, 0x14, 0x00, 0x00, 0x00, 0x2c)
+	tiff = append(tiff, 0x00, 0x00, 0x00, 0x00)
+	tiff = append(tiff, []byte("2024:05:06 07:08:09\x00")...)
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(app1[2:], uint16(len(segment)+2))
+	blob := append([]byte{0xFF, 0xD8}, append(append(app1, segment...), encoded[2:]...)...)
+
+	metadata := Extract("image/jpeg", blob)
+	require.NotNil(t, metadata)
+	require.Equal(t, 16, metadata.Width)
+	require.Equal(t, 8, metadata.Height)
+	require.Equal(t, time.Date(2024, time.May, 6, 7, 8, 9, 0, time.UTC), metadata.OriginalTime)
+}
+
+func TestExtractMP4(t *testing.T) {
+	mvhd := make([]byte, 100)
+	// Version 0, created on 2020-01-01, 90 seconds at a timescale of 1000.
+	binary.BigEndian.PutUint32(mvhd[4:8], uint32(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC).Sub(mp4Epoch)/time.Second))
+	binary.BigEndian.PutUint32(mvhd[12:16], 1000)
+	binary.BigEndian.PutUint32(mvhd[16:20], 90000)
+	blob := append(mp4Box("ftyp", []byte("isom\x00\x00\x02\x00")), mp4Box("moov", mp4Box("mvhd", mvhd))...)
+
+	metadata := Extract("video/mp4", blob)
+	require.NotNil(t, metadata)
+	require.Equal(t, 90*time.Second, metadata.Duration)
+	require.Equal(t, time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), metadata.OriginalTime)
+
+	require.Nil(t, Extract("audio/mpeg", []byte("ID3")))
+}
+
+func mp4Box(boxType string, content []byte) []byte {
+	box := make([]byte, 8, 8+len(content))
+	binary.BigEndian.PutUint32(box[:4], uint32(8+len(content)))
+	copy(box[4:], boxType)
+	return append(box, content...)
+}
+
+func TestExtractWAV(t *testing.T) {
+	blob := []byte("RIFF\x00\x00\x00\x00WAVE")
+	fmtChunk := make([]byte, 24)
+	copy(fmtChunk, "fmt ")
+	binary.LittleEndian.PutUint32(fmtChunk[4:8], 16)
+	binary.LittleEndian.PutUint32(fmtChunk[16:20], 8000)
+	blob = append(blob, fmtChunk...)
+	dataChunk := make([]byte, 8+16000)
+	copy(dataChunk, "data")
+	binary.LittleEndian.PutUint32(dataChunk[4:8], 16000)
+	blob = append(blob, dataChunk...)
+
+	metadata := Extract("audio/wav", blob)
+	require.NotNil(t, metadata)
+	require.Equal(t, 2*time.Second, metadata.Duration)
+}
+
+func TestExtractPDF(t *testing.T) {
+	document := "%PDF-1.4\n1 0 obj << /Type /Pages /Kids [2 0 R 3 0 R] /Count 2 >> endobj\n" +
+		"2 0 obj << /Type /Page /Parent 1 0 R >> endobj\n3 0 obj << /Type/Page /Parent 1 0 R >> endobj\n"
+	metadata := Extract("application/pdf", []byte(document))
+	require.NotNil(t, metadata)
+	require.Equal(t, 2, metadata.PageCount)
+
+	// The page count of the page tree is used when the page objects are compressed.
+	metadata = Extract("application/pdf", []byte("%PDF-1.7\n1 0 obj << /Type /Pages /Count 12 /Kids [] >> endobj\n"))
+	require.NotNil(t, metadata)
+	require.Equal(t, 12, metadata.PageCount)
+
+	require.Nil(t, Extract("text/plain", []byte("hello")))
+}
+
+func TestExtractPDFText(t *testing.T) {
+	content := "BT /F1 12 Tf 72 712 Td (Quarterly \\(draft\\) report) Tj 0 -14 Td [(Reve) 30 (nue) -250 (grew)] TJ ET\n" +
+		"BT <FEFF00430061006600E9> Tj ET"
+	var compressed bytes.Buffer
+	writer := zlib.NewWriter(&compressed)
+	_, err := writer.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	document := "%PDF-1.4\n4 0 obj << /Length " + strconv.Itoa(compressed.Len()) + " /Filter /FlateDecode >>\nstream\n" +
+		compressed.String() + "\nendstream\nendobj\n" +
+		"5 0 obj << /Length 30 >>\nstream\nBT (Uncompressed page) Tj ET\nendstream\nendobj\n" +
+		"6 0 obj << /Length 3 /Filter /DCTDecode >>\nstream\nBT (image) Tj ET\nendstream\nendobj\n"
+
+	text := ExtractText("application/pdf", []byte(document))
+	require.Equal(t, "Quarterly (draft) report\nRevenue grew\nCafé\nUncompressed page", text)
+
+	require.Empty(t, ExtractText("text/plain", []byte(document)))
+	require.Empty(t, ExtractText("application/pdf", []byte("%PDF-1.4\n")))
+}
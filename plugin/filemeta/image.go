@@ -0,0 +1,127 @@
+package filemeta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	// Register the decoders of the supported image formats.
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"strings"
+	"time"
+)
+
+const (
+	exifTagDateTime         = 0x0132
+	exifTagExifIFDPointer   = 0x8769
+	exifTagDateTimeOriginal = 0x9003
+	exifTypeASCII           = 2
+)
+
+func extractImage(blob []byte) *Metadata {
+	config, _, err := image.DecodeConfig(bytes.NewReader(blob))
+	if err != nil {
+		return nil
+	}
+	return &Metadata{
+		Width:        config.Width,
+		Height:       config.Height,
+		OriginalTime: extractJPEGOriginalTime(blob),
+	}
+}
+
+// extractJPEGOriginalTime returns the capture time recorded in the EXIF data of a JPEG image, the
+// zero time when there is none. EXIF times have no time zone, they are returned as UTC.
+func extractJPEGOriginalTime(blob []byte) time.Time {
+	tiff := findJPEGExif(blob)
+	if tiff == nil {
+		return time.Time{}
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return time.Time{}
+	}
+	ifd0 := readIFD(tiff, order, order.Uint32(tiff[4:8]))
+	if exifIFD, ok := ifd0[exifTagExifIFDPointer]; ok {
+		entries := readIFD(tiff, order, order.Uint32(exifIFD[8:12]))
+		if entry, ok := entries[exifTagDateTimeOriginal]; ok {
+			if t := parseEXIFTime(tiff, order, entry); !t.IsZero() {
+				return t
+			}
+		}
+	}
+	if entry, ok := ifd0[exifTagDateTime]; ok {
+		return parseEXIFTime(tiff, order, entry)
+	}
+	return time.Time{}
+}
+
+// findJPEGExif returns the TIFF structure of the EXIF segment of a JPEG image, nil when there is none.
+func findJPEGExif(blob []byte) []byte {
+	if len(blob) < 4 || blob[0] != 0xFF || blob[1] != 0xD8 {
+		return nil
+	}
+	for offset := 2; offset+4 <= len(blob); {
+		if blob[offset] != 0xFF {
+			return nil
+		}
+		marker := blob[offset+1]
+		// The metadata segments come before the start of scan.
+		if marker == 0xDA || marker == 0xD9 {
+			return nil
+		}
+		length := int(binary.BigEndian.Uint16(blob[offset+2 : offset+4]))
+		end := offset + 2 + length
+		if length < 2 || end > len(blob) {
+			return nil
+		}
+		segment := blob[offset+4 : end]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) && len(segment) >= 14 {
+			return segment[6:]
+		}
+		offset = end
+	}
+	return nil
+}
+
+// readIFD reads the entries of an image file directory, keyed by tag. Each entry is 12 bytes long:
+// the tag, the type, the count and the value or the offset of the value.
+func readIFD(tiff []byte, order binary.ByteOrder, offset uint32) map[uint16][]byte {
+	entries := map[uint16][]byte{}
+	if int64(offset)+2 > int64(len(tiff)) {
+		return entries
+	}
+	count := int(order.Uint16(tiff[offset : offset+2]))
+	for i := 0; i < count; i++ {
+		start := int(offset) + 2 + i*12
+		if start+12 > len(tiff) {
+			break
+		}
+		entry := tiff[start : start+12]
+		entries[order.Uint16(entry[:2])] = entry
+	}
+	return entries
+}
+
+func parseEXIFTime(tiff []byte, order binary.ByteOrder, entry []byte) time.Time {
+	if order.Uint16(entry[2:4]) != exifTypeASCII {
+		return time.Time{}
+	}
+	count := int64(order.Uint32(entry[4:8]))
+	offset := int64(order.Uint32(entry[8:12]))
+	if count <= 4 || offset+count > int64(len(tiff)) {
+		return time.Time{}
+	}
+	value := strings.TrimRight(string(tiff[offset:offset+count]), "\x00 ")
+	t, err := time.ParseInLocation("2006:01:02 15:04:05", value, time.UTC)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
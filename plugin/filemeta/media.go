@@ -0,0 +1,106 @@
+package filemeta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+)
+
+// mp4Epoch is the epoch of the times in MP4 files.
+var mp4Epoch = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// extractMP4 reads the duration and creation time from the movie header of an MP4 or QuickTime file.
+func extractMP4(blob []byte) *Metadata {
+	moov := findMP4Box(blob, "moov")
+	if moov == nil {
+		return nil
+	}
+	mvhd := findMP4Box(moov, "mvhd")
+	if len(mvhd) < 4 {
+		return nil
+	}
+	var creationTime, timescale, duration uint64
+	switch mvhd[0] {
+	case 0:
+		if len(mvhd) < 20 {
+			return nil
+		}
+		creationTime = uint64(binary.BigEndian.Uint32(mvhd[4:8]))
+		timescale = uint64(binary.BigEndian.Uint32(mvhd[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(mvhd[16:20]))
+	case 1:
+		if len(mvhd) < 32 {
+			return nil
+		}
+		creationTime = binary.BigEndian.Uint64(mvhd[4:12])
+		timescale = uint64(binary.BigEndian.Uint32(mvhd[20:24]))
+		duration = binary.BigEndian.Uint64(mvhd[24:32])
+	default:
+		return nil
+	}
+
+	metadata := &Metadata{}
+	if timescale > 0 {
+		metadata.Duration = time.Duration(float64(duration) / float64(timescale) * float64(time.Second))
+	}
+	if creationTime > 0 {
+		metadata.OriginalTime = mp4Epoch.Add(time.Duration(creationTime) * time.Second)
+	}
+	return metadata
+}
+
+// findMP4Box returns the content of the first box of the type among the boxes, nil when there is none.
+func findMP4Box(boxes []byte, boxType string) []byte {
+	for offset := 0; offset+8 <= len(boxes); {
+		size := uint64(binary.BigEndian.Uint32(boxes[offset : offset+4]))
+		headerSize := uint64(8)
+		switch size {
+		case 0:
+			// The box extends to the end of the file.
+			size = uint64(len(boxes) - offset)
+		case 1:
+			if offset+16 > len(boxes) {
+				return nil
+			}
+			size = binary.BigEndian.Uint64(boxes[offset+8 : offset+16])
+			headerSize = 16
+		}
+		if size < headerSize || uint64(offset)+size > uint64(len(boxes)) {
+			return nil
+		}
+		if string(boxes[offset+4:offset+8]) == boxType {
+			return boxes[uint64(offset)+headerSize : uint64(offset)+size]
+		}
+		offset += int(size)
+	}
+	return nil
+}
+
+// extractWAV computes the duration of a WAV file from its byte rate and the size of its data.
+func extractWAV(blob []byte) *Metadata {
+	if len(blob) < 12 || !bytes.Equal(blob[:4], []byte("RIFF")) || !bytes.Equal(blob[8:12], []byte("WAVE")) {
+		return nil
+	}
+	var byteRate uint32
+	for offset := 12; offset+8 <= len(blob); {
+		chunkID := string(blob[offset : offset+4])
+		chunkSize := int(binary.LittleEndian.Uint32(blob[offset+4 : offset+8]))
+		switch chunkID {
+		case "fmt ":
+			if offset+20 > len(blob) {
+				return nil
+			}
+			byteRate = binary.LittleEndian.Uint32(blob[offset+16 : offset+20])
+		case "data":
+			if byteRate == 0 {
+				return nil
+			}
+			return &Metadata{
+				Duration: time.Duration(float64(chunkSize) / float64(byteRate) * float64(time.Second)),
+			}
+		}
+		// Chunks are padded to an even size.
+		offset += 8 + chunkSize + chunkSize%2
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package filemeta
+
+import (
+	"regexp"
+	"strconv"
+)
+
+var (
+	pdfPageRegexp  = regexp.MustCompile(`/Type\s*/Page\b`)
+	pdfCountRegexp = regexp.MustCompile(`/Count\s+(\d+)`)
+)
+
+// extractPDF counts the pages of a PDF document. The page objects are counted when they are stored
+// uncompressed, otherwise the page count of the largest page tree node is used.
+func extractPDF(blob []byte) *Metadata {
+	pageCount := len(pdfPageRegexp.FindAllIndex(blob, -1))
+	if pageCount == 0 {
+		for _, match := range pdfCountRegexp.FindAllSubmatch(blob, -1) {
+			if count, err := strconv.Atoi(string(match[1])); err == nil && count > pageCount {
+				pageCount = count
+			}
+		}
+	}
+	if pageCount == 0 {
+		return nil
+	}
+	return &Metadata{PageCount: pageCount}
+}
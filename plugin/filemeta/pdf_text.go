@@ -0,0 +1,340 @@
+package filemeta
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+)
+
+const (
+	// MaxTextLength is the maximum number of runes of text extracted from a document.
+	MaxTextLength = 32 * 1024
+	// maxStreamSize is the maximum size of a decompressed PDF stream, guarding against decompression bombs.
+	maxStreamSize = 8 << 20
+	// tjSpaceThreshold is the kerning adjustment in a TJ array, in thousandths of an em, above which
+	// a word space is assumed.
+	tjSpaceThreshold = 200
+)
+
+var pdfStreamRegexp = regexp.MustCompile(`stream\r?\n`)
+
+// ExtractText extracts the text of a document for search, based on its MIME type. It returns an empty
+// string for unsupported types and for documents without extractable text.
+//
+// Only PDF documents are supported. The text is read from the text showing operators of the page
+// content streams, so text drawn with fonts using custom encodings may not be extracted.
+func ExtractText(contentType string, blob []byte) string {
+	mediaType, _, _ := strings.Cut(strings.ToLower(contentType), ";")
+	if strings.TrimSpace(mediaType) != "application/pdf" {
+		return ""
+	}
+	return extractPDFText(blob)
+}
+
+func extractPDFText(blob []byte) string {
+	var text strings.Builder
+	for _, match := range pdfStreamRegexp.FindAllIndex(blob, -1) {
+		if bytes.HasSuffix(blob[:match[0]], []byte("end")) {
+			continue
+		}
+		// The stream dictionary is between the start of the object and the stream keyword.
+		dictStart := bytes.LastIndex(blob[:match[0]], []byte("obj"))
+		if dictStart < 0 {
+			continue
+		}
+		dictionary := blob[dictStart:match[0]]
+		data := blob[match[1]:]
+		if end := bytes.Index(data, []byte("endstream")); end >= 0 {
+			data = data[:end]
+		}
+		switch {
+		case bytes.Contains(dictionary, []byte("/FlateDecode")):
+			inflated, err := inflate(data)
+			if err != nil {
+				continue
+			}
+			data = inflated
+		case bytes.Contains(dictionary, []byte("/Filter")):
+			// Other filters are used for images and fonts rather than page contents.
+			continue
+		}
+		if !bytes.Contains(data, []byte("BT")) {
+			continue
+		}
+		extractContentStreamText(data, &text)
+		if text.Len() > MaxTextLength*4 {
+			break
+		}
+	}
+	return normalizeText(text.String())
+}
+
+func inflate(data []byte) ([]byte, error) {
+	reader, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	inflated, err := io.ReadAll(io.LimitReader(reader, maxStreamSize))
+	// Streams are often followed by padding, the data read until an error is kept.
+	if len(inflated) == 0 && err != nil {
+		return nil, err
+	}
+	return inflated, nil
+}
+
+// extractContentStreamText writes the strings shown by the text operators of a content stream.
+func extractContentStreamText(data []byte, text *strings.Builder) {
+	lexer := &pdfLexer{data: data}
+	// The operands of the next operator.
+	operands := []pdfToken{}
+	for {
+		token, ok := lexer.next()
+		if !ok {
+			return
+		}
+		if token.kind != pdfTokenOperator {
+			operands = append(operands, token)
+			continue
+		}
+		switch token.value {
+		case "Tj", "'", "\"":
+			if token.value != "Tj" {
+				text.WriteString("\n")
+			}
+			if len(operands) > 0 && operands[len(operands)-1].kind == pdfTokenString {
+				text.WriteString(operands[len(operands)-1].value)
+			}
+		case "TJ":
+			for _, operand := range operands {
+				switch operand.kind {
+				case pdfTokenString:
+					text.WriteString(operand.value)
+				case pdfTokenNumber:
+					if adjustment, err := strconv.ParseFloat(operand.value, 64); err == nil && adjustment < -tjSpaceThreshold {
+						text.WriteString(" ")
+					}
+				}
+			}
+		case "Td", "TD", "T*", "Tm":
+			text.WriteString("\n")
+		case "ET":
+			text.WriteString("\n")
+		}
+		operands = operands[:0]
+	}
+}
+
+type pdfTokenKind int
+
+const (
+	pdfTokenOperator pdfTokenKind = iota
+	pdfTokenString
+	pdfTokenNumber
+	pdfTokenOther
+)
+
+type pdfToken struct {
+	kind  pdfTokenKind
+	value string
+}
+
+// pdfLexer splits a content stream into tokens. The elements of arrays are returned as separate
+// tokens, which is enough for the operands of the text operators.
+type pdfLexer struct {
+	data   []byte
+	offset int
+}
+
+func (l *pdfLexer) next() (pdfToken, bool) {
+	for l.offset < len(l.data) {
+		c := l.data[l.offset]
+		switch {
+		case isPDFWhitespace(c) || c == '[' || c == ']':
+			l.offset++
+		case c == '%':
+			// Comments run to the end of the line.
+			for l.offset < len(l.data) && l.data[l.offset] != '\n' && l.data[l.offset] != '\r' {
+				l.offset++
+			}
+		case c == '(':
+			return pdfToken{kind: pdfTokenString, value: decodePDFString(l.readLiteralString())}, true
+		case c == '<' && l.offset+1 < len(l.data) && l.data[l.offset+1] == '<':
+			l.offset += 2
+			return pdfToken{kind: pdfTokenOther, value: "<<"}, true
+		case c == '>' && l.offset+1 < len(l.data) && l.data[l.offset+1] == '>':
+			l.offset += 2
+			return pdfToken{kind: pdfTokenOther, value: ">>"}, true
+		case c == '<':
+			return pdfToken{kind: pdfTokenString, value: decodePDFString(l.readHexString())}, true
+		case c == '/':
+			start := l.offset
+			l.offset++
+			l.skipRegular()
+			return pdfToken{kind: pdfTokenOther, value: string(l.data[start:l.offset])}, true
+		case c == '+' || c == '-' || c == '.' || (c >= '0' && c <= '9'):
+			start := l.offset
+			l.skipRegular()
+			return pdfToken{kind: pdfTokenNumber, value: string(l.data[start:l.offset])}, true
+		default:
+			start := l.offset
+			l.skipRegular()
+			if l.offset == start {
+				// A stray delimiter.
+				l.offset++
+				continue
+			}
+			return pdfToken{kind: pdfTokenOperator, value: string(l.data[start:l.offset])}, true
+		}
+	}
+	return pdfToken{}, false
+}
+
+func (l *pdfLexer) skipRegular() {
+	for l.offset < len(l.data) && !isPDFWhitespace(l.data[l.offset]) && !isPDFDelimiter(l.data[l.offset]) {
+		l.offset++
+	}
+}
+
+// readLiteralString reads a string in parentheses, which may contain balanced parentheses and escapes.
+func (l *pdfLexer) readLiteralString() []byte {
+	l.offset++
+	value := []byte{}
+	depth := 1
+	for l.offset < len(l.data) {
+		c := l.data[l.offset]
+		l.offset++
+		switch c {
+		case '\\':
+			if l.offset >= len(l.data) {
+				return value
+			}
+			escaped := l.data[l.offset]
+			l.offset++
+			switch escaped {
+			case 'n':
+				value = append(value, '\n')
+			case 'r':
+				value = append(value, '\r')
+			case 't':
+				value = append(value, '\t')
+			case 'b', 'f':
+			case '\r', '\n':
+				// A line continuation.
+				if escaped == '\r' && l.offset < len(l.data) && l.data[l.offset] == '\n' {
+					l.offset++
+				}
+			default:
+				if escaped >= '0' && escaped <= '7' {
+					code := int(escaped - '0')
+					for i := 0; i < 2 && l.offset < len(l.data) && l.data[l.offset] >= '0' && l.data[l.offset] <= '7'; i++ {
+						code = code*8 + int(l.data[l.offset]-'0')
+						l.offset++
+					}
+					value = append(value, byte(code))
+				} else {
+					value = append(value, escaped)
+				}
+			}
+		case '(':
+			depth++
+			value = append(value, c)
+		case ')':
+			depth--
+			if depth == 0 {
+				return value
+			}
+			value = append(value, c)
+		default:
+			value = append(value, c)
+		}
+	}
+	return value
+}
+
+func (l *pdfLexer) readHexString() []byte {
+	l.offset++
+	digits := []byte{}
+	for l.offset < len(l.data) && l.data[l.offset] != '>' {
+		if c := l.data[l.offset]; isHexDigit(c) {
+			digits = append(digits, c)
+		}
+		l.offset++
+	}
+	l.offset++
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	value := make([]byte, len(digits)/2)
+	for i := range value {
+		value[i] = hexValue(digits[2*i])<<4 | hexValue(digits[2*i+1])
+	}
+	return value
+}
+
+// decodePDFString decodes a text string, UTF-16 when it starts with a byte order mark and
+// otherwise treated as Latin-1, which matches PDFDocEncoding for the common characters.
+func decodePDFString(value []byte) string {
+	if len(value) >= 2 && value[0] == 0xFE && value[1] == 0xFF {
+		units := make([]uint16, 0, len(value)/2)
+		for i := 2; i+1 < len(value); i += 2 {
+			units = append(units, uint16(value[i])<<8|uint16(value[i+1]))
+		}
+		return string(utf16.Decode(units))
+	}
+	runes := make([]rune, 0, len(value))
+	for _, b := range value {
+		runes = append(runes, rune(b))
+	}
+	return string(runes)
+}
+
+// normalizeText collapses the whitespace of each line, drops blank lines and control characters,
+// and truncates the text to MaxTextLength runes.
+func normalizeText(text string) string {
+	lines := []string{}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.Map(func(r rune) rune {
+			if unicode.IsControl(r) {
+				return ' '
+			}
+			return r
+		}, line)
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	runes := []rune(strings.Join(lines, "\n"))
+	if len(runes) > MaxTextLength {
+		runes = runes[:MaxTextLength]
+	}
+	return string(runes)
+}
+
+func isPDFWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+}
+
+func isPDFDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func hexValue(c byte) byte {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0'
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
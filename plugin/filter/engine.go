@@ -99,6 +99,10 @@ var (
 	defaultOnce sync.Once
 	defaultInst *Engine
 	defaultErr  error
+
+	attachmentOnce sync.Once
+	attachmentInst *Engine
+	attachmentErr  error
 )
 
 // DefaultEngine returns the process-wide memo filter engine.
@@ -109,6 +113,14 @@ func DefaultEngine() (*Engine, error) {
 	return defaultInst, defaultErr
 }
 
+// AttachmentEngine returns the process-wide attachment filter engine.
+func AttachmentEngine() (*Engine, error) {
+	attachmentOnce.Do(func() {
+		attachmentInst, attachmentErr = NewEngine(NewAttachmentSchema())
+	})
+	return attachmentInst, attachmentErr
+}
+
 func normalizeLegacyFilter(expr string) string {
 	expr = rewriteNumericLogicalOperand(expr, "&&")
 	expr = rewriteNumericLogicalOperand(expr, "||")
@@ -73,10 +73,12 @@ type ElementInCondition struct {
 
 func (*ElementInCondition) isCondition() {}
 
-// ContainsCondition models the <field>.contains(<value>) call.
+// ContainsCondition models the <field>.contains(<value>) call, and the <field>.fuzzy_contains(<value>) one.
 type ContainsCondition struct {
 	Field string
 	Value string
+	// Fuzzy also matches the words spelled alike, where the dialect supports it.
+	Fuzzy bool
 }
 
 func (*ContainsCondition) isCondition() {}
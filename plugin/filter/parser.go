@@ -90,7 +90,7 @@ func buildCallCondition(call *exprv1.Expr_Call, schema Schema) (Condition, error
 		return buildComparisonCondition(call, schema)
 	case "@in":
 		return buildInCondition(call, schema)
-	case "contains":
+	case "contains", "fuzzy_contains":
 		return buildContainsCondition(call, schema)
 	default:
 		val, ok, err := evaluateBool(call)
@@ -161,6 +161,8 @@ func buildInCondition(call *exprv1.Expr_Call, schema Schema) (Condition, error)
 			}
 		} else if !ok {
 			return nil, errors.Errorf("unknown identifier %q", identName)
+		} else if field.AllowedComparisonOps != nil && !field.AllowedComparisonOps[CompareEq] {
+			return nil, errors.Errorf("operator in not allowed for field %q", identName)
 		}
 
 		if listExpr := call.Args[1].GetListExpr(); listExpr != nil {
@@ -210,8 +212,9 @@ func buildContainsCondition(call *exprv1.Expr_Call, schema Schema) (Condition, e
 	if !ok {
 		return nil, errors.Errorf("unknown identifier %q", targetName)
 	}
-	if !field.SupportsContains {
-		return nil, errors.Errorf("identifier %q does not support contains()", targetName)
+	fuzzy := call.Function == "fuzzy_contains"
+	if !field.SupportsContains || (fuzzy && !field.SupportsFuzzyContains) {
+		return nil, errors.Errorf("identifier %q does not support %s()", targetName, call.Function)
 	}
 	if len(call.Args) != 1 {
 		return nil, errors.New("contains expects exactly one argument")
@@ -227,6 +230,7 @@ func buildContainsCondition(call *exprv1.Expr_Call, schema Schema) (Condition, e
 	return &ContainsCondition{
 		Field: targetName,
 		Value: str,
+		Fuzzy: fuzzy,
 	}, nil
 }
 
@@ -1,6 +1,7 @@
 package filter
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -139,6 +140,12 @@ func (r *renderer) renderFieldPredicate(cond *FieldPredicateCondition) (renderRe
 			return renderResult{}, err
 		}
 		return renderResult{sql: sql}, nil
+	case FieldKindBoolExpression:
+		sql, err := r.boolExpressionPredicate(field)
+		if err != nil {
+			return renderResult{}, err
+		}
+		return renderResult{sql: sql}, nil
 	default:
 		return renderResult{}, errors.Errorf("field %q cannot be used as a predicate", cond.Field)
 	}
@@ -156,6 +163,8 @@ func (r *renderer) renderComparison(cond *ComparisonCondition) (renderResult, er
 			return r.renderBoolColumnComparison(field, cond.Operator, cond.Right)
 		case FieldKindJSONBool:
 			return r.renderJSONBoolComparison(field, cond.Operator, cond.Right)
+		case FieldKindBoolExpression:
+			return r.renderBoolExpressionComparison(field, cond.Operator, cond.Right)
 		case FieldKindScalar:
 			return r.renderScalarComparison(field, cond.Operator, cond.Right)
 		default:
@@ -242,6 +251,28 @@ func (r *renderer) renderBoolColumnComparison(field Field, op ComparisonOperator
 	}, nil
 }
 
+func (r *renderer) renderBoolExpressionComparison(field Field, op ComparisonOperator, right ValueExpr) (renderResult, error) {
+	value, err := expectBool(right)
+	if err != nil {
+		return renderResult{}, err
+	}
+	sql, err := r.boolExpressionPredicate(field)
+	if err != nil {
+		return renderResult{}, err
+	}
+	switch op {
+	case CompareEq:
+	case CompareNeq:
+		value = !value
+	default:
+		return renderResult{}, errors.Errorf("operator %s not supported for field %q", op, field.Name)
+	}
+	if !value {
+		sql = fmt.Sprintf("NOT (%s)", sql)
+	}
+	return renderResult{sql: sql}, nil
+}
+
 func (r *renderer) renderJSONBoolComparison(field Field, op ComparisonOperator, right ValueExpr) (renderResult, error) {
 	value, err := expectBool(right)
 	if err != nil {
@@ -325,7 +356,7 @@ func (r *renderer) renderTagInList(values []ValueExpr) (renderResult, error) {
 		return renderResult{}, errors.New("tag attribute is not configured")
 	}
 
-	conditions := make([]string, 0, len(values))
+	tags := make([]string, 0, len(values))
 	for _, v := range values {
 		lit, err := expectLiteral(v)
 		if err != nil {
@@ -335,7 +366,14 @@ func (r *renderer) renderTagInList(values []ValueExpr) (renderResult, error) {
 		if !ok {
 			return renderResult{}, errors.New("tags must be compared with string literals")
 		}
+		tags = append(tags, str)
+	}
+	if _, ok := field.MembershipExpr[r.dialect]; ok {
+		return r.renderMembership(field, tags), nil
+	}
 
+	conditions := make([]string, 0, len(tags))
+	for _, str := range tags {
 		switch r.dialect {
 		case DialectSQLite:
 			expr := fmt.Sprintf("%s LIKE %s", jsonArrayExpr(r.dialect, field), r.addArg(fmt.Sprintf(`%%"%s"%%`, str)))
@@ -344,7 +382,7 @@ func (r *renderer) renderTagInList(values []ValueExpr) (renderResult, error) {
 			expr := fmt.Sprintf("JSON_CONTAINS(%s, %s)", jsonArrayExpr(r.dialect, field), r.addArg(fmt.Sprintf(`"%s"`, str)))
 			conditions = append(conditions, expr)
 		case DialectPostgres:
-			expr := fmt.Sprintf("%s @> jsonb_build_array(%s::json)", jsonArrayExpr(r.dialect, field), r.addArg(fmt.Sprintf(`"%s"`, str)))
+			expr := fmt.Sprintf("%s @> %s::jsonb", jsonArrayExpr(r.dialect, field), r.addArg(jsonStringArray(str)))
 			conditions = append(conditions, expr)
 		default:
 			return renderResult{}, errors.Errorf("unsupported dialect %s", r.dialect)
@@ -376,6 +414,9 @@ func (r *renderer) renderElementInCondition(cond *ElementInCondition) (renderRes
 	if !ok {
 		return renderResult{}, errors.New("tags membership requires string literal")
 	}
+	if _, ok := field.MembershipExpr[r.dialect]; ok {
+		return r.renderMembership(field, []string{str}), nil
+	}
 
 	switch r.dialect {
 	case DialectSQLite:
@@ -385,13 +426,23 @@ func (r *renderer) renderElementInCondition(cond *ElementInCondition) (renderRes
 		sql := fmt.Sprintf("JSON_CONTAINS(%s, %s)", jsonArrayExpr(r.dialect, field), r.addArg(fmt.Sprintf(`"%s"`, str)))
 		return renderResult{sql: sql}, nil
 	case DialectPostgres:
-		sql := fmt.Sprintf("%s @> jsonb_build_array(%s::json)", jsonArrayExpr(r.dialect, field), r.addArg(fmt.Sprintf(`"%s"`, str)))
+		sql := fmt.Sprintf("%s @> %s::jsonb", jsonArrayExpr(r.dialect, field), r.addArg(jsonStringArray(str)))
 		return renderResult{sql: sql}, nil
 	default:
 		return renderResult{}, errors.Errorf("unsupported dialect %s", r.dialect)
 	}
 }
 
+// renderMembership renders the condition of the list of the field containing one of the elements with its
+// membership expression.
+func (r *renderer) renderMembership(field Field, elements []string) renderResult {
+	placeholders := make([]string, 0, len(elements))
+	for _, element := range elements {
+		placeholders = append(placeholders, r.addArg(element))
+	}
+	return renderResult{sql: fmt.Sprintf(field.MembershipExpr[r.dialect], strings.Join(placeholders, ", "))}
+}
+
 func (r *renderer) renderScalarInCondition(field Field, values []ValueExpr) (renderResult, error) {
 	placeholders := make([]string, 0, len(values))
 
@@ -429,16 +480,31 @@ func (r *renderer) renderContainsCondition(cond *ContainsCondition) (renderResul
 	if !ok {
 		return renderResult{}, errors.Errorf("unknown field %q", cond.Field)
 	}
-	column := field.columnExpr(r.dialect)
 	arg := fmt.Sprintf("%%%s%%", cond.Value)
+	if expr, ok := field.ContainsExpr[r.dialect]; ok {
+		return renderResult{sql: fmt.Sprintf(expr, r.addArg(arg))}, nil
+	}
+	column := field.columnExpr(r.dialect)
+	var sql string
 	switch r.dialect {
 	case DialectPostgres:
-		sql := fmt.Sprintf("%s ILIKE %s", column, r.addArg(arg))
-		return renderResult{sql: sql}, nil
+		sql = fmt.Sprintf("%s ILIKE %s", column, r.addArg(arg))
 	default:
-		sql := fmt.Sprintf("%s LIKE %s", column, r.addArg(arg))
-		return renderResult{sql: sql}, nil
+		sql = fmt.Sprintf("%s LIKE %s", column, r.addArg(arg))
 	}
+	if also, ok := field.ContainsAlso[r.dialect]; ok {
+		sql = fmt.Sprintf("(%s OR %s)", sql, fmt.Sprintf(also, r.addArg(arg)))
+	}
+	if cond.Fuzzy {
+		switch r.dialect {
+		case DialectSQLite, DialectPostgres:
+			// The word_similarity function of pg_trgm, emulated by a function registered by the SQLite driver.
+			sql = fmt.Sprintf("(%s OR word_similarity(%s, %s) >= %v)", sql, r.addArg(cond.Value), column, fuzzyMatchThreshold)
+		default:
+			// MySQL has no trigram matching, the fuzzy search is an exact one.
+		}
+	}
+	return renderResult{sql: sql}, nil
 }
 
 func (r *renderer) jsonBoolPredicate(field Field) (string, error) {
@@ -447,7 +513,8 @@ func (r *renderer) jsonBoolPredicate(field Field) (string, error) {
 	case DialectSQLite:
 		return fmt.Sprintf("%s IS TRUE", expr), nil
 	case DialectMySQL:
-		return fmt.Sprintf("%s = CAST('true' AS JSON)", expr), nil
+		// The null-safe comparison keeps the negation of the predicate true for the memos without the property.
+		return fmt.Sprintf("%s <=> CAST('true' AS JSON)", expr), nil
 	case DialectPostgres:
 		return fmt.Sprintf("(%s)::boolean IS TRUE", expr), nil
 	default:
@@ -455,6 +522,14 @@ func (r *renderer) jsonBoolPredicate(field Field) (string, error) {
 	}
 }
 
+func (r *renderer) boolExpressionPredicate(field Field) (string, error) {
+	expr, ok := field.Expressions[r.dialect]
+	if !ok {
+		return "", errors.Errorf("unsupported dialect %s for field %q", r.dialect, field.Name)
+	}
+	return expr, nil
+}
+
 func combineAnd(left, right renderResult) renderResult {
 	if left.unsatisfiable || right.unsatisfiable {
 		return renderResult{sql: "1 = 0", unsatisfiable: true}
@@ -599,6 +674,14 @@ func jsonArrayExpr(d DialectName, field Field) string {
 	}
 }
 
+// jsonStringArray encodes a single-element JSON string array. Comparing the
+// tag array against a constant jsonb value lets PostgreSQL use the GIN index
+// on the tags expression.
+func jsonStringArray(value string) string {
+	bytes, _ := json.Marshal([]string{value})
+	return string(bytes)
+}
+
 func jsonArrayLengthExpr(d DialectName, field Field) string {
 	arrayExpr := jsonArrayExpr(d, field)
 	switch d {
@@ -2,6 +2,7 @@ package filter
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/cel-go/cel"
@@ -18,6 +19,10 @@ const (
 	DialectPostgres DialectName = "postgres"
 )
 
+// fuzzyMatchThreshold is the minimum word similarity, from 0 to 1, of the content matched by fuzzy_contains(). It
+// matches a swap of two letters in a word of ten, e.g. "kuberentes" for "kubernetes".
+const fuzzyMatchThreshold = 0.4
+
 // FieldType represents the logical type of a field.
 type FieldType string
 
@@ -37,6 +42,8 @@ const (
 	FieldKindJSONBool     FieldKind = "json_bool"
 	FieldKindJSONList     FieldKind = "json_list"
 	FieldKindVirtualAlias FieldKind = "virtual_alias"
+	// FieldKindBoolExpression is a boolean computed by its SQL expression in each dialect.
+	FieldKindBoolExpression FieldKind = "bool_expression"
 )
 
 // Column identifies the backing table column.
@@ -47,15 +54,27 @@ type Column struct {
 
 // Field captures the schema metadata for an exposed CEL identifier.
 type Field struct {
-	Name                 string
-	Kind                 FieldKind
-	Type                 FieldType
-	Column               Column
-	JSONPath             []string
-	AliasFor             string
-	SupportsContains     bool
-	Expressions          map[DialectName]string
-	AllowedComparisonOps map[ComparisonOperator]bool
+	Name             string
+	Kind             FieldKind
+	Type             FieldType
+	Column           Column
+	JSONPath         []string
+	AliasFor         string
+	SupportsContains bool
+	// ContainsAlso holds, per dialect, a condition also matched by contains, with %s standing for the
+	// pattern placeholder. It indexes related content, e.g. the text of the attachments of a memo.
+	ContainsAlso map[DialectName]string
+	// ContainsExpr holds, per dialect, the condition of contains for the fields without a column, with %s standing
+	// for the pattern placeholder.
+	ContainsExpr map[DialectName]string
+	// MembershipExpr holds, per dialect, the condition of a list containing one of the elements, with %s standing for
+	// the comma-separated placeholders of the elements. It matches the elements in a table indexing the list, e.g. the
+	// tags of a memo.
+	MembershipExpr map[DialectName]string
+	// SupportsFuzzyContains allows fuzzy_contains(), matching the words spelled alike too.
+	SupportsFuzzyContains bool
+	Expressions           map[DialectName]string
+	AllowedComparisonOps  map[ComparisonOperator]bool
 }
 
 // Schema collects CEL environment options and field metadata.
@@ -97,16 +116,50 @@ var nowFunction = cel.Function("now",
 	),
 )
 
+// fuzzyContainsFunction declares <string>.fuzzy_contains(<string>), rendered by each dialect. Its evaluation
+// outside of SQL only matches the exact substring.
+var fuzzyContainsFunction = cel.Function("fuzzy_contains",
+	cel.MemberOverload("string_fuzzy_contains_string",
+		[]*cel.Type{cel.StringType, cel.StringType},
+		cel.BoolType,
+		cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+			return types.Bool(strings.Contains(string(lhs.(types.String)), string(rhs.(types.String))))
+		}),
+	),
+)
+
 // NewSchema constructs the memo filter schema and CEL environment.
 func NewSchema() Schema {
 	fields := map[string]Field{
 		"content": {
-			Name:             "content",
+			Name:                  "content",
+			Kind:                  FieldKindScalar,
+			Type:                  FieldTypeString,
+			Column:                Column{Table: "memo", Name: "content"},
+			SupportsContains:      true,
+			SupportsFuzzyContains: true,
+			// Searching the content also searches the text extracted from the attached documents.
+			ContainsAlso: map[DialectName]string{
+				DialectSQLite:   "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND JSON_EXTRACT(`resource`.`payload`, '$.extractedText') LIKE %s)",
+				DialectMySQL:    "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND JSON_UNQUOTE(JSON_EXTRACT(`resource`.`payload`, '$.extractedText')) LIKE %s)",
+				DialectPostgres: "EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id AND resource.payload::JSONB->>'extractedText' ILIKE %s)",
+			},
+			Expressions: map[DialectName]string{},
+		},
+		// The content of the comments on the memo that everyone who can see the memo can see: the public ones and
+		// the ones as visible as the memo.
+		"comment_content": {
+			Name:             "comment_content",
 			Kind:             FieldKindScalar,
 			Type:             FieldTypeString,
-			Column:           Column{Table: "memo", Name: "content"},
 			SupportsContains: true,
-			Expressions:      map[DialectName]string{},
+			ContainsExpr: map[DialectName]string{
+				DialectSQLite:   "EXISTS (SELECT 1 FROM `memo_relation` JOIN `memo` AS `comment_memo` ON `comment_memo`.`id` = `memo_relation`.`memo_id` WHERE `memo_relation`.`related_memo_id` = `memo`.`id` AND `memo_relation`.`type` = 'COMMENT' AND `comment_memo`.`row_status` = 'NORMAL' AND (`comment_memo`.`visibility` = 'PUBLIC' OR `comment_memo`.`visibility` = `memo`.`visibility`) AND `comment_memo`.`content` LIKE %s)",
+				DialectMySQL:    "EXISTS (SELECT 1 FROM `memo_relation` JOIN `memo` AS `comment_memo` ON `comment_memo`.`id` = `memo_relation`.`memo_id` WHERE `memo_relation`.`related_memo_id` = `memo`.`id` AND `memo_relation`.`type` = 'COMMENT' AND `comment_memo`.`row_status` = 'NORMAL' AND (`comment_memo`.`visibility` = 'PUBLIC' OR `comment_memo`.`visibility` = `memo`.`visibility`) AND `comment_memo`.`content` LIKE %s)",
+				DialectPostgres: "EXISTS (SELECT 1 FROM memo_relation JOIN memo AS comment_memo ON comment_memo.id = memo_relation.memo_id WHERE memo_relation.related_memo_id = memo.id AND memo_relation.type = 'COMMENT' AND comment_memo.row_status = 'NORMAL' AND (comment_memo.visibility = 'PUBLIC' OR comment_memo.visibility = memo.visibility) AND comment_memo.content ILIKE %s)",
+			},
+			Expressions:          map[DialectName]string{},
+			AllowedComparisonOps: map[ComparisonOperator]bool{},
 		},
 		"creator_id": {
 			Name:        "creator_id",
@@ -161,12 +214,117 @@ func NewSchema() Schema {
 				CompareNeq: true,
 			},
 		},
+		"state": {
+			Name:        "state",
+			Kind:        FieldKindScalar,
+			Type:        FieldTypeString,
+			Column:      Column{Table: "memo", Name: "row_status"},
+			Expressions: map[DialectName]string{},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
+		"language": {
+			Name:   "language",
+			Kind:   FieldKindScalar,
+			Type:   FieldTypeString,
+			Column: Column{Table: "memo", Name: "payload"},
+			// The language set on the memo takes precedence over the one detected from its content.
+			Expressions: map[DialectName]string{
+				DialectSQLite:   "COALESCE(JSON_EXTRACT(%[1]s, '$.language'), JSON_EXTRACT(%[1]s, '$.detectedLanguage'))",
+				DialectMySQL:    "COALESCE(JSON_UNQUOTE(JSON_EXTRACT(%[1]s, '$.language')), JSON_UNQUOTE(JSON_EXTRACT(%[1]s, '$.detectedLanguage')))",
+				DialectPostgres: "COALESCE(%[1]s->>'language', %[1]s->>'detectedLanguage')",
+			},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
+		// The reading properties of the front matter of the content, see MemoPayload.Reading.
+		"reading_author": {
+			Name:             "reading_author",
+			Kind:             FieldKindScalar,
+			Type:             FieldTypeString,
+			Column:           Column{Table: "memo", Name: "payload"},
+			SupportsContains: true,
+			Expressions: map[DialectName]string{
+				DialectSQLite:   "JSON_EXTRACT(%s, '$.reading.author')",
+				DialectMySQL:    "JSON_UNQUOTE(JSON_EXTRACT(%s, '$.reading.author'))",
+				DialectPostgres: "%s->'reading'->>'author'",
+			},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
+		// The memos without an approval have an empty approval state, the others "PENDING", "APPROVED" or "REJECTED".
+		"approval_state": {
+			Name:   "approval_state",
+			Kind:   FieldKindScalar,
+			Type:   FieldTypeString,
+			Column: Column{Table: "memo", Name: "payload"},
+			Expressions: map[DialectName]string{
+				DialectSQLite:   "COALESCE(JSON_EXTRACT(%s, '$.approval.state'), '')",
+				DialectMySQL:    "COALESCE(JSON_UNQUOTE(JSON_EXTRACT(%s, '$.approval.state')), '')",
+				DialectPostgres: "COALESCE(%s->'approval'->>'state', '')",
+			},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
+		"reading_status": {
+			Name:   "reading_status",
+			Kind:   FieldKindScalar,
+			Type:   FieldTypeString,
+			Column: Column{Table: "memo", Name: "payload"},
+			Expressions: map[DialectName]string{
+				DialectSQLite:   "JSON_EXTRACT(%s, '$.reading.status')",
+				DialectMySQL:    "JSON_UNQUOTE(JSON_EXTRACT(%s, '$.reading.status'))",
+				DialectPostgres: "%s->'reading'->>'status'",
+			},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
+		"reading_rating": {
+			Name:   "reading_rating",
+			Kind:   FieldKindScalar,
+			Type:   FieldTypeInt,
+			Column: Column{Table: "memo", Name: "payload"},
+			// The memos without a rating are unrated, 0.
+			Expressions: map[DialectName]string{
+				DialectSQLite:   "COALESCE(JSON_EXTRACT(%s, '$.reading.rating'), 0)",
+				DialectMySQL:    "COALESCE(CAST(JSON_EXTRACT(%s, '$.reading.rating') AS SIGNED), 0)",
+				DialectPostgres: "COALESCE((%s->'reading'->>'rating')::INTEGER, 0)",
+			},
+		},
+		// The finished dates are in the format "YYYY-MM-DD", compared in the order of the dates.
+		"reading_finished_date": {
+			Name:   "reading_finished_date",
+			Kind:   FieldKindScalar,
+			Type:   FieldTypeString,
+			Column: Column{Table: "memo", Name: "payload"},
+			Expressions: map[DialectName]string{
+				DialectSQLite:   "JSON_EXTRACT(%s, '$.reading.finishedDate')",
+				DialectMySQL:    "JSON_UNQUOTE(JSON_EXTRACT(%s, '$.reading.finishedDate'))",
+				DialectPostgres: "%s->'reading'->>'finishedDate'",
+			},
+		},
 		"tags": {
 			Name:     "tags",
 			Kind:     FieldKindJSONList,
 			Type:     FieldTypeString,
 			Column:   Column{Table: "memo", Name: "payload"},
 			JSONPath: []string{"tags"},
+			// The tags are indexed in the memo_tag table, maintained along with the payload.
+			MembershipExpr: map[DialectName]string{
+				DialectSQLite:   "EXISTS (SELECT 1 FROM `memo_tag` WHERE `memo_tag`.`memo_id` = `memo`.`id` AND `memo_tag`.`tag` IN (%s))",
+				DialectMySQL:    "EXISTS (SELECT 1 FROM `memo_tag` WHERE `memo_tag`.`memo_id` = `memo`.`id` AND `memo_tag`.`tag` IN (%s))",
+				DialectPostgres: "EXISTS (SELECT 1 FROM memo_tag WHERE memo_tag.memo_id = memo.id AND memo_tag.tag IN (%s))",
+			},
 		},
 		"tag": {
 			Name:     "tag",
@@ -218,10 +376,47 @@ func NewSchema() Schema {
 				CompareNeq: true,
 			},
 		},
+		"has_broken_link": {
+			Name:     "has_broken_link",
+			Kind:     FieldKindJSONBool,
+			Type:     FieldTypeBool,
+			Column:   Column{Table: "memo", Name: "payload"},
+			JSONPath: []string{"property", "hasBrokenLink"},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
+		"has_attachment": {
+			Name: "has_attachment",
+			Kind: FieldKindBoolExpression,
+			Type: FieldTypeBool,
+			Expressions: map[DialectName]string{
+				DialectSQLite:   "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`)",
+				DialectMySQL:    "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`)",
+				DialectPostgres: "EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id)",
+			},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
+		"is_ai_generated": {
+			Name:     "is_ai_generated",
+			Kind:     FieldKindJSONBool,
+			Type:     FieldTypeBool,
+			Column:   Column{Table: "memo", Name: "payload"},
+			JSONPath: []string{"property", "isAiGenerated"},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
 	}
 
 	envOptions := []cel.EnvOption{
 		cel.Variable("content", cel.StringType),
+		cel.Variable("comment_content", cel.StringType),
 		cel.Variable("creator_id", cel.IntType),
 		cel.Variable("created_ts", cel.IntType),
 		cel.Variable("updated_ts", cel.IntType),
@@ -229,11 +424,22 @@ func NewSchema() Schema {
 		cel.Variable("tag", cel.StringType),
 		cel.Variable("tags", cel.ListType(cel.StringType)),
 		cel.Variable("visibility", cel.StringType),
+		cel.Variable("state", cel.StringType),
+		cel.Variable("language", cel.StringType),
+		cel.Variable("reading_author", cel.StringType),
+		cel.Variable("approval_state", cel.StringType),
+		cel.Variable("reading_status", cel.StringType),
+		cel.Variable("reading_rating", cel.IntType),
+		cel.Variable("reading_finished_date", cel.StringType),
 		cel.Variable("has_task_list", cel.BoolType),
 		cel.Variable("has_link", cel.BoolType),
 		cel.Variable("has_code", cel.BoolType),
 		cel.Variable("has_incomplete_tasks", cel.BoolType),
+		cel.Variable("has_broken_link", cel.BoolType),
+		cel.Variable("has_attachment", cel.BoolType),
+		cel.Variable("is_ai_generated", cel.BoolType),
 		nowFunction,
+		fuzzyContainsFunction,
 	}
 
 	return Schema{
@@ -243,6 +449,60 @@ func NewSchema() Schema {
 	}
 }
 
+// NewAttachmentSchema constructs the attachment filter schema and CEL environment.
+func NewAttachmentSchema() Schema {
+	fields := map[string]Field{
+		"filename": {
+			Name:             "filename",
+			Kind:             FieldKindScalar,
+			Type:             FieldTypeString,
+			Column:           Column{Table: "resource", Name: "filename"},
+			SupportsContains: true,
+			Expressions:      map[DialectName]string{},
+		},
+		// CEL reserves the "type" identifier, so the MIME type is exposed as "mime_type".
+		"mime_type": {
+			Name:             "mime_type",
+			Kind:             FieldKindScalar,
+			Type:             FieldTypeString,
+			Column:           Column{Table: "resource", Name: "type"},
+			SupportsContains: true,
+			Expressions:      map[DialectName]string{},
+		},
+		"size": {
+			Name:        "size",
+			Kind:        FieldKindScalar,
+			Type:        FieldTypeInt,
+			Column:      Column{Table: "resource", Name: "size"},
+			Expressions: map[DialectName]string{},
+		},
+		"created_ts": {
+			Name:   "created_ts",
+			Kind:   FieldKindScalar,
+			Type:   FieldTypeTimestamp,
+			Column: Column{Table: "resource", Name: "created_ts"},
+			Expressions: map[DialectName]string{
+				DialectMySQL:    "UNIX_TIMESTAMP(%s)",
+				DialectPostgres: "EXTRACT(EPOCH FROM TO_TIMESTAMP(%s))",
+			},
+		},
+	}
+
+	envOptions := []cel.EnvOption{
+		cel.Variable("filename", cel.StringType),
+		cel.Variable("mime_type", cel.StringType),
+		cel.Variable("size", cel.IntType),
+		cel.Variable("created_ts", cel.IntType),
+		nowFunction,
+	}
+
+	return Schema{
+		Name:       "attachment",
+		Fields:     fields,
+		EnvOptions: envOptions,
+	}
+}
+
 // columnExpr returns the field expression for the given dialect, applying
 // any schema-specific overrides (e.g. UNIX timestamp conversions).
 func (f Field) columnExpr(d DialectName) string {
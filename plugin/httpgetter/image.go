@@ -1,11 +1,11 @@
 package httpgetter
 
 import (
+	"context"
 	"errors"
-	"io"
-	"net/http"
-	"net/url"
 	"strings"
+
+	"github.com/usememos/memos/plugin/outbound"
 )
 
 type Image struct {
@@ -14,11 +14,11 @@ type Image struct {
 }
 
 func GetImage(urlStr string) (*Image, error) {
-	if _, err := url.Parse(urlStr); err != nil {
+	if err := outbound.ValidateURL(context.Background(), urlStr); err != nil {
 		return nil, err
 	}
 
-	response, err := http.Get(urlStr)
+	response, err := outbound.NewClient().Get(urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -32,7 +32,7 @@ func GetImage(urlStr string) (*Image, error) {
 		return nil, errors.New("wrong image mediatype")
 	}
 
-	bodyBytes, err := io.ReadAll(response.Body)
+	bodyBytes, err := outbound.ReadBody(response.Body)
 	if err != nil {
 		return nil, err
 	}
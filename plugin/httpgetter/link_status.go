@@ -0,0 +1,43 @@
+package httpgetter
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// linkStatusTimeout is the time limit of a link check, shorter than the outbound policy's as many links are checked.
+const linkStatusTimeout = 15 * time.Second
+
+// GetLinkStatus returns the HTTP status code of the given link.
+// A HEAD request is tried first and falls back to GET for servers that don't support HEAD.
+func GetLinkStatus(urlStr string) (int, error) {
+	if err := outbound.ValidateURL(context.Background(), urlStr); err != nil {
+		return 0, err
+	}
+
+	statusCode, err := requestLinkStatus(http.MethodHead, urlStr)
+	if err == nil && statusCode != http.StatusMethodNotAllowed && statusCode != http.StatusNotImplemented {
+		return statusCode, nil
+	}
+	return requestLinkStatus(http.MethodGet, urlStr)
+}
+
+func requestLinkStatus(method, urlStr string) (int, error) {
+	request, err := http.NewRequest(method, urlStr, nil)
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("User-Agent", "memos-link-checker")
+
+	client := outbound.NewClient()
+	client.Timeout = min(client.Timeout, linkStatusTimeout)
+	response, err := client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	return response.StatusCode, nil
+}
@@ -0,0 +1,120 @@
+package indieauth
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// Endpoints are the endpoints advertised by the page of an identity, empty if not advertised.
+type Endpoints struct {
+	// TokenEndpoint is the endpoint verifying the access tokens issued for the identity, rel="token_endpoint".
+	TokenEndpoint string
+	// Micropub is the Micropub endpoint the identity publishes to, rel="micropub".
+	Micropub string
+}
+
+// Discover fetches the page of the identity and returns its endpoints, advertised by the Link headers of the
+// response or the link and a elements of the HTML page, the headers first. Advertising the Micropub endpoint of a
+// user proves the owner of the page chose the user. The requests are initiated by the server, so they are restricted
+// by the outbound policy.
+func Discover(ctx context.Context, me string) (*Endpoints, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, me, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to construct request to the identity")
+	}
+	req.Header.Set("Accept", "text/html, */*;q=0.8")
+	resp, err := outbound.NewClient().Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request the identity")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, errors.Errorf("failed to request the identity, status code: %d", resp.StatusCode)
+	}
+	body, err := outbound.ReadBody(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the identity")
+	}
+
+	endpoints := &Endpoints{}
+	parseLinkHeaders(resp.Request.URL, resp.Header.Values("Link"), endpoints)
+	if err := parseHTMLLinks(bytes.NewReader(body), resp.Request.URL, endpoints); err != nil {
+		return nil, err
+	}
+	return endpoints, nil
+}
+
+// setEndpoint sets the endpoint of the relations, keeping the first one found.
+func (e *Endpoints) setEndpoint(base *url.URL, rels []string, rawURL string) {
+	u, err := base.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return
+	}
+	if e.TokenEndpoint == "" && slices.Contains(rels, "token_endpoint") {
+		e.TokenEndpoint = u.String()
+	}
+	if e.Micropub == "" && slices.Contains(rels, "micropub") {
+		e.Micropub = u.String()
+	}
+}
+
+// parseLinkHeaders reads the endpoints of the Link headers, e.g. `<https://example.com/token>; rel="token_endpoint"`.
+func parseLinkHeaders(base *url.URL, headers []string, endpoints *Endpoints) {
+	for _, header := range headers {
+		for _, link := range strings.Split(header, ",") {
+			target, params, ok := strings.Cut(link, ";")
+			target = strings.TrimSpace(target)
+			if !ok || !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range strings.Split(params, ";") {
+				key, value, ok := strings.Cut(param, "=")
+				if !ok || !strings.EqualFold(strings.TrimSpace(key), "rel") {
+					continue
+				}
+				rels := strings.Fields(strings.ToLower(strings.Trim(strings.TrimSpace(value), `"`)))
+				endpoints.setEndpoint(base, rels, strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">"))
+			}
+		}
+	}
+}
+
+// parseHTMLLinks reads the endpoints of the link and a elements of the HTML page.
+func parseHTMLLinks(r io.Reader, base *url.URL, endpoints *Endpoints) error {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse the identity")
+	}
+	var visit func(n *html.Node)
+	visit = func(n *html.Node) {
+		if n.Type == html.ElementNode && (n.Data == "link" || n.Data == "a") {
+			rel, href := "", ""
+			for _, attr := range n.Attr {
+				switch attr.Key {
+				case "rel":
+					rel = attr.Val
+				case "href":
+					href = attr.Val
+				}
+			}
+			if rel != "" && href != "" {
+				endpoints.setEndpoint(base, strings.Fields(strings.ToLower(rel)), href)
+			}
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			visit(child)
+		}
+	}
+	visit(doc)
+	return nil
+}
@@ -0,0 +1,43 @@
+package indieauth
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEndpoints(t *testing.T) {
+	base, err := url.Parse("https://alice.example/about")
+	require.NoError(t, err)
+
+	t.Run("html links", func(t *testing.T) {
+		page := `<html><head>
+			<link rel="token_endpoint" href="https://tokens.indieauth.com/token">
+			<link rel="micropub" href="/micropub">
+			<link rel="micropub" href="https://other.example/micropub">
+		</head><body><a rel="me authorization_endpoint" href="https://indieauth.com/auth">Sign in</a></body></html>`
+		endpoints := &Endpoints{}
+		require.NoError(t, parseHTMLLinks(strings.NewReader(page), base, endpoints))
+		require.Equal(t, "https://tokens.indieauth.com/token", endpoints.TokenEndpoint)
+		require.Equal(t, "https://alice.example/micropub", endpoints.Micropub)
+	})
+
+	t.Run("link headers come first", func(t *testing.T) {
+		endpoints := &Endpoints{}
+		parseLinkHeaders(base, []string{`<https://memos.example/micropub/users/1>; rel="micropub", </token>; rel="token_endpoint"`}, endpoints)
+		page := `<link rel="micropub" href="/micropub"><link rel="token_endpoint" href="javascript:alert(1)">`
+		require.NoError(t, parseHTMLLinks(strings.NewReader(page), base, endpoints))
+		require.Equal(t, "https://memos.example/micropub/users/1", endpoints.Micropub)
+		require.Equal(t, "https://alice.example/token", endpoints.TokenEndpoint)
+	})
+
+	t.Run("no endpoints", func(t *testing.T) {
+		endpoints := &Endpoints{}
+		parseLinkHeaders(base, []string{`<https://alice.example/style.css>; rel=stylesheet`}, endpoints)
+		require.NoError(t, parseHTMLLinks(strings.NewReader(`<p>Hello</p>`), base, endpoints))
+		require.Empty(t, endpoints.TokenEndpoint)
+		require.Empty(t, endpoints.Micropub)
+	})
+}
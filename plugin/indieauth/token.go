@@ -0,0 +1,81 @@
+// Package indieauth verifies the access tokens issued by the token endpoint of an IndieAuth identity.
+package indieauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// Token is the information of a valid access token.
+type Token struct {
+	// Me is the URL of the identity the token was issued for.
+	Me       string `json:"me"`
+	ClientID string `json:"client_id"`
+	// Scope is the space-separated scopes of the token, e.g. "create update media".
+	Scope string `json:"scope"`
+}
+
+// VerifyToken verifies the access token with the token endpoint which issued it. The requests are initiated by the
+// server, so they are restricted by the outbound policy.
+func VerifyToken(ctx context.Context, tokenEndpoint, accessToken string) (*Token, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenEndpoint, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to construct token verification request")
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := outbound.NewClient().Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request token endpoint")
+	}
+	defer resp.Body.Close()
+	data, err := outbound.ReadBody(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response from token endpoint")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, errors.Errorf("invalid access token, status code: %d", resp.StatusCode)
+	}
+
+	token := &Token{}
+	if err := json.Unmarshal(data, token); err != nil {
+		// Some token endpoints only respond form-encoded.
+		values, err := url.ParseQuery(string(data))
+		if err != nil {
+			return nil, errors.New("invalid response from token endpoint")
+		}
+		token = &Token{Me: values.Get("me"), ClientID: values.Get("client_id"), Scope: values.Get("scope")}
+	}
+	if token.Me == "" {
+		return nil, errors.New("invalid access token")
+	}
+	return token, nil
+}
+
+// CanonicalizeURL returns the canonical form of the URL of an identity, with a lowercase host and a path.
+func CanonicalizeURL(me string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(me))
+	if err != nil {
+		return "", errors.Wrap(err, "invalid url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", errors.Errorf("unsupported url scheme: %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return "", errors.New("url host is empty")
+	}
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment = ""
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	return u.String(), nil
+}
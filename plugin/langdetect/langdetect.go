@@ -0,0 +1,128 @@
+package langdetect
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+const (
+	// minLetters is the number of letters below which the text is too short to tell its language.
+	minLetters = 20
+	// maxRunes is the number of runes of the text looked at, the beginning of a memo is enough.
+	maxRunes = 2000
+	// minScore is the similarity below which no trigram profile is considered a match.
+	minScore = 0.1
+)
+
+// scriptLanguages maps the scripts written by a single language to it.
+var scriptLanguages = []struct {
+	table    *unicode.RangeTable
+	language string
+}{
+	{unicode.Hangul, "ko"},
+	{unicode.Hiragana, "ja"},
+	{unicode.Katakana, "ja"},
+	{unicode.Han, "zh"},
+	{unicode.Cyrillic, "ru"},
+	{unicode.Greek, "el"},
+	{unicode.Hebrew, "he"},
+	{unicode.Arabic, "ar"},
+	{unicode.Thai, "th"},
+	{unicode.Devanagari, "hi"},
+}
+
+// Detect returns the ISO 639-1 code of the language the text is written in, or an empty
+// string if the text is too short or its language is not recognized.
+// Languages with their own script are told apart by the script, Latin script languages by
+// comparing the trigram frequencies of the text with the profiles of the supported languages.
+func Detect(text string) string {
+	runes := []rune(text)
+	if len(runes) > maxRunes {
+		runes = runes[:maxRunes]
+	}
+
+	letters, latin := 0, 0
+	scripts := make(map[string]int)
+	for _, r := range runes {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if unicode.Is(unicode.Latin, r) {
+			latin++
+			continue
+		}
+		for _, script := range scriptLanguages {
+			if unicode.Is(script.table, r) {
+				scripts[script.language]++
+				break
+			}
+		}
+	}
+	if letters == 0 {
+		return ""
+	}
+
+	// Japanese mixes kana with kanji, any kana tells it apart from Chinese.
+	if scripts["ja"] > 0 && scripts["ja"]+scripts["zh"] > latin {
+		return "ja"
+	}
+	script, count := "", 0
+	for _, candidate := range scriptLanguages {
+		if scripts[candidate.language] > count {
+			script, count = candidate.language, scripts[candidate.language]
+		}
+	}
+	if count > latin {
+		// Ideographic scripts carry more per character than alphabets.
+		if script == "zh" || script == "ko" || count >= minLetters {
+			return script
+		}
+		return ""
+	}
+	if latin < minLetters {
+		return ""
+	}
+
+	vector := trigramVector(string(runes))
+	language, best := "", minScore
+	for _, profile := range profiles {
+		if score := similarity(vector, profile.vector); score > best {
+			language, best = profile.language, score
+		}
+	}
+	return language
+}
+
+// trigramVector counts the letter trigrams of the words of the text, padded with spaces so the
+// beginnings and ends of words are counted too.
+func trigramVector(text string) map[string]float64 {
+	vector := make(map[string]float64)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\''
+	})
+	for _, word := range words {
+		padded := []rune(" " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			vector[string(padded[i:i+3])]++
+		}
+	}
+	return vector
+}
+
+// similarity returns the cosine similarity of two trigram vectors.
+func similarity(a, b map[string]float64) float64 {
+	var dot, normA, normB float64
+	for trigram, count := range a {
+		dot += count * b[trigram]
+		normA += count * count
+	}
+	for _, count := range b {
+		normB += count * count
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / math.Sqrt(normA*normB)
+}
@@ -0,0 +1,36 @@
+package langdetect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{text: "", expected: ""},
+		{text: "Hello there", expected: ""},
+		{text: "I have to buy milk and bread on the way home, and remember to water the plants.", expected: "en"},
+		{text: "Morgen muss ich unbedingt die Steuererklärung fertig machen, bevor die Frist abläuft.", expected: "de"},
+		{text: "Il faut que je pense à réserver les billets de train pour les vacances avec les enfants.", expected: "fr"},
+		{text: "Tengo que llamar al médico mañana por la mañana para pedir una cita para mi hermana.", expected: "es"},
+		{text: "Domani devo ricordarmi di passare in farmacia e di comprare il regalo per la nonna.", expected: "it"},
+		{text: "Preciso lembrar de pagar as contas de luz e de água antes do fim desta semana.", expected: "pt"},
+		{text: "Vergeet niet om morgen de boodschappen te doen en de fiets naar de reparateur te brengen.", expected: "nl"},
+		{text: "Jag måste komma ihåg att handla mjölk och bröd på vägen hem från jobbet i morgon.", expected: "sv"},
+		{text: "Jutro muszę pamiętać, żeby kupić chleb i mleko w drodze do domu z pracy.", expected: "pl"},
+		{text: "Yarın işten eve dönerken ekmek ve süt almayı unutmamam gerekiyor.", expected: "tr"},
+		{text: "明天要记得去超市买牛奶", expected: "zh"},
+		{text: "明日は牛乳を買うのを忘れないでください", expected: "ja"},
+		{text: "내일 우유 사는 거 잊지 마세요", expected: "ko"},
+		{text: "Завтра нужно не забыть купить молоко и хлеб по дороге домой.", expected: "ru"},
+		{text: "Αύριο πρέπει να θυμηθώ να αγοράσω γάλα και ψωμί.", expected: "el"},
+		{text: "// 12345 67890 !!!", expected: ""},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, Detect(test.text), test.text)
+	}
+}
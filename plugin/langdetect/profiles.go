@@ -0,0 +1,95 @@
+package langdetect
+
+// profile is the trigram vector of a sample text of a language.
+type profile struct {
+	language string
+	vector   map[string]float64
+}
+
+// samples are everyday texts of the Latin script languages told apart by trigrams.
+var samples = map[string]string{
+	"en": `This is a short note about the things that happened today and what I want to do next week.
+We had a meeting with the team in the morning and talked about the new project. It was decided that
+everyone should write down their ideas before the end of the month. In the afternoon I went to the
+store to buy some food for the weekend, and then I read a book about the history of the city. There
+are still many questions that have not been answered, but I think we are making good progress with
+the work. I should remember to call my friend and ask him about the trip that we are planning for the
+summer holidays. The weather was nice and warm, which is why we decided to have dinner outside.`,
+	"de": `Das ist eine kurze Notiz über die Dinge, die heute passiert sind, und was ich nächste Woche machen
+möchte. Wir hatten am Morgen eine Besprechung mit dem Team und haben über das neue Projekt gesprochen.
+Es wurde beschlossen, dass jeder seine Ideen bis zum Ende des Monats aufschreiben soll. Am Nachmittag
+bin ich in den Laden gegangen, um etwas zu essen für das Wochenende zu kaufen, und dann habe ich ein
+Buch über die Geschichte der Stadt gelesen. Es gibt noch viele Fragen, die nicht beantwortet wurden,
+aber ich glaube, dass wir mit der Arbeit gut vorankommen. Ich sollte daran denken, meinen Freund
+anzurufen und ihn nach der Reise zu fragen, die wir für die Sommerferien planen. Das Wetter war schön
+und warm, deshalb haben wir beschlossen, draußen zu essen.`,
+	"fr": `Ceci est une courte note sur les choses qui se sont passées aujourd'hui et sur ce que je veux faire
+la semaine prochaine. Nous avons eu une réunion avec l'équipe le matin et nous avons parlé du nouveau
+projet. Il a été décidé que chacun devrait écrire ses idées avant la fin du mois. L'après-midi, je suis
+allé au magasin pour acheter de la nourriture pour le week-end, puis j'ai lu un livre sur l'histoire de
+la ville. Il reste encore beaucoup de questions sans réponse, mais je pense que nous faisons de bons
+progrès dans notre travail. Je dois me souvenir d'appeler mon ami et de lui demander des nouvelles du
+voyage que nous préparons pour les vacances d'été. Il faisait beau et chaud, c'est pourquoi nous avons
+décidé de dîner dehors.`,
+	"es": `Esta es una nota corta sobre las cosas que pasaron hoy y lo que quiero hacer la próxima semana.
+Tuvimos una reunión con el equipo por la mañana y hablamos del nuevo proyecto. Se decidió que cada uno
+debería escribir sus ideas antes del final del mes. Por la tarde fui a la tienda para comprar comida
+para el fin de semana, y luego leí un libro sobre la historia de la ciudad. Todavía hay muchas
+preguntas que no han sido respondidas, pero creo que estamos avanzando bien con el trabajo. Debo
+acordarme de llamar a mi amigo y preguntarle por el viaje que estamos planeando para las vacaciones de
+verano. El tiempo era bueno y cálido, por eso decidimos cenar afuera.`,
+	"it": `Questa è una breve nota sulle cose che sono successe oggi e su quello che voglio fare la prossima
+settimana. Abbiamo avuto una riunione con la squadra la mattina e abbiamo parlato del nuovo progetto.
+È stato deciso che ognuno dovrebbe scrivere le sue idee prima della fine del mese. Nel pomeriggio sono
+andato al negozio per comprare del cibo per il fine settimana, e poi ho letto un libro sulla storia
+della città. Ci sono ancora molte domande che non hanno avuto risposta, ma penso che stiamo facendo
+buoni progressi con il lavoro. Devo ricordarmi di chiamare il mio amico e chiedergli del viaggio che
+stiamo organizzando per le vacanze estive. Il tempo era bello e caldo, per questo abbiamo deciso di
+cenare fuori.`,
+	"pt": `Esta é uma nota curta sobre as coisas que aconteceram hoje e o que eu quero fazer na próxima semana.
+Tivemos uma reunião com a equipe de manhã e falamos sobre o novo projeto. Foi decidido que cada um
+deveria escrever as suas ideias antes do fim do mês. À tarde fui à loja para comprar comida para o fim
+de semana, e depois li um livro sobre a história da cidade. Ainda há muitas perguntas que não foram
+respondidas, mas acho que estamos fazendo um bom progresso com o trabalho. Eu devo me lembrar de ligar
+para o meu amigo e perguntar sobre a viagem que estamos planejando para as férias de verão. O tempo
+estava bom e quente, por isso decidimos jantar lá fora.`,
+	"nl": `Dit is een korte notitie over de dingen die vandaag gebeurd zijn en wat ik volgende week wil doen.
+We hadden 's ochtends een vergadering met het team en hebben over het nieuwe project gepraat. Er werd
+besloten dat iedereen zijn ideeën voor het einde van de maand moet opschrijven. In de middag ben ik
+naar de winkel gegaan om eten voor het weekend te kopen, en daarna heb ik een boek over de geschiedenis
+van de stad gelezen. Er zijn nog veel vragen die niet beantwoord zijn, maar ik denk dat we goede
+vooruitgang boeken met het werk. Ik moet eraan denken mijn vriend te bellen en hem te vragen naar de
+reis die we voor de zomervakantie plannen. Het weer was mooi en warm, daarom hebben we besloten buiten
+te eten.`,
+	"sv": `Det här är en kort anteckning om de saker som hände i dag och vad jag vill göra nästa vecka. Vi hade
+ett möte med teamet på morgonen och pratade om det nya projektet. Det bestämdes att alla skulle skriva
+ner sina idéer innan slutet av månaden. På eftermiddagen gick jag till affären för att köpa mat till
+helgen, och sedan läste jag en bok om stadens historia. Det finns fortfarande många frågor som inte har
+besvarats, men jag tror att vi gör goda framsteg med arbetet. Jag måste komma ihåg att ringa min vän och
+fråga honom om resan som vi planerar till sommarlovet. Vädret var fint och varmt, och därför bestämde
+vi oss för att äta middag ute.`,
+	"pl": `To jest krótka notatka o rzeczach, które wydarzyły się dzisiaj, i o tym, co chcę zrobić w przyszłym
+tygodniu. Rano mieliśmy spotkanie z zespołem i rozmawialiśmy o nowym projekcie. Zdecydowano, że każdy
+powinien zapisać swoje pomysły przed końcem miesiąca. Po południu poszedłem do sklepu, żeby kupić
+jedzenie na weekend, a potem przeczytałem książkę o historii miasta. Wciąż jest wiele pytań, na które
+nie ma odpowiedzi, ale myślę, że robimy dobre postępy w pracy. Muszę pamiętać, żeby zadzwonić do mojego
+przyjaciela i zapytać go o podróż, którą planujemy na wakacje. Pogoda była ładna i ciepła, dlatego
+postanowiliśmy zjeść kolację na zewnątrz.`,
+	"tr": `Bu, bugün olan şeyler ve gelecek hafta yapmak istediklerim hakkında kısa bir not. Sabah ekiple bir
+toplantı yaptık ve yeni proje hakkında konuştuk. Herkesin fikirlerini ay sonundan önce yazması gerektiğine
+karar verildi. Öğleden sonra hafta sonu için yiyecek almak üzere markete gittim, sonra şehrin tarihi
+hakkında bir kitap okudum. Hâlâ cevaplanmamış birçok soru var, ama işte iyi ilerleme kaydettiğimizi
+düşünüyorum. Arkadaşımı aramayı ve yaz tatili için planladığımız yolculuğu ona sormayı unutmamalıyım.
+Hava güzel ve sıcaktı, bu yüzden akşam yemeğini dışarıda yemeye karar verdik.`,
+}
+
+// profiles are built from the samples once.
+var profiles = buildProfiles()
+
+func buildProfiles() []profile {
+	profiles := make([]profile, 0, len(samples))
+	for language, sample := range samples {
+		profiles = append(profiles, profile{language: language, vector: trigramVector(sample)})
+	}
+	return profiles
+}
@@ -0,0 +1,339 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+)
+
+// FormatOptions are the formatters applied by Format.
+type FormatOptions struct {
+	// NormalizeHeadings writes the headings as "# Heading", without closing hashes or setext underlines.
+	NormalizeHeadings bool
+	// LinkURLs writes the bare URLs as autolinks, e.g. "<https://example.com>".
+	LinkURLs bool
+	// NormalizeReferences writes the tags with the case of their first occurrence in the content, and the mentions
+	// of the Usernames with their username.
+	NormalizeReferences bool
+	// Usernames are the usernames the mentions are matched against, case-insensitively.
+	Usernames []string
+	// TrimTrailingWhitespace trims the trailing whitespace of the lines and the content, keeping the hard line breaks.
+	TrimTrailingWhitespace bool
+	// Shortcodes are the texts the shortcodes are expanded to by their name, e.g. "🎉" for "tada" expanding ":tada:".
+	Shortcodes map[string]string
+}
+
+var (
+	fencePattern         = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+	atxHeadingPattern    = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?[ \t]*$`)
+	closingHashesPattern = regexp.MustCompile(`(?:^|[ \t]+)#+$`)
+	// unspacedHeadingPattern matches the headings missing the space after their hashes, e.g. "##Heading". A single
+	// hash starts a tag.
+	unspacedHeadingPattern = regexp.MustCompile(`^ {0,3}(#{2,6})([^#\s].*)$`)
+	setextUnderlinePattern = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	// blockStartPattern matches the lines starting a block other than a paragraph, which a setext underline does not
+	// turn into a heading.
+	blockStartPattern = regexp.MustCompile(`^ {0,3}(?:[-*+>#|]|\d+[.)]|$)`)
+	// linkDefinitionPattern matches the link reference definitions, e.g. "[1]: https://example.com".
+	linkDefinitionPattern = regexp.MustCompile(`^ {0,3}\[[^\]]+\]:`)
+	// protectedPattern matches the inline links, images and autolinks, and the HTML tags, left as is.
+	protectedPattern = regexp.MustCompile(`!?\[[^\]]*\]\([^)]*\)|<[^<>\s][^<>]*>`)
+	bareURLPattern   = regexp.MustCompile(`https?://[^\s<>]+`)
+	tagPattern       = regexp.MustCompile(`#([A-Za-z0-9_/-]+)`)
+	mentionPattern   = regexp.MustCompile(`@([A-Za-z0-9_-]+)`)
+)
+
+type formatLineKind int
+
+const (
+	formatLineText formatLineKind = iota
+	formatLineCode
+	formatLineFrontMatter
+)
+
+// Format applies the formatters of the options to the content, leaving the front matter, the code blocks and the
+// code spans as is.
+func Format(content string, options FormatOptions) string {
+	if !options.NormalizeHeadings && !options.LinkURLs && !options.NormalizeReferences && !options.TrimTrailingWhitespace && len(options.Shortcodes) == 0 {
+		return content
+	}
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	kinds := classifyLines(lines)
+
+	if options.NormalizeHeadings {
+		lines, kinds = normalizeHeadings(lines, kinds)
+	}
+	if options.LinkURLs || options.NormalizeReferences || len(options.Shortcodes) > 0 {
+		formatter := &inlineFormatter{options: options, tags: map[string]string{}, usernames: map[string]string{}}
+		for _, username := range options.Usernames {
+			formatter.usernames[strings.ToLower(username)] = username
+		}
+		for index, line := range lines {
+			if kinds[index] == formatLineText {
+				lines[index] = formatter.formatLine(line)
+			}
+		}
+	}
+	if options.TrimTrailingWhitespace {
+		lines = trimTrailingWhitespace(lines, kinds)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// classifyLines returns the kinds of the lines: the front matter, the fenced and indented code blocks, or text.
+func classifyLines(lines []string) []formatLineKind {
+	kinds := make([]formatLineKind, len(lines))
+	start := 0
+	if strings.TrimSpace(lines[0]) == "---" {
+		for index := 1; index < len(lines); index++ {
+			if trimmed := strings.TrimSpace(lines[index]); trimmed == "---" || trimmed == "..." {
+				for frontMatterIndex := 0; frontMatterIndex <= index; frontMatterIndex++ {
+					kinds[frontMatterIndex] = formatLineFrontMatter
+				}
+				start = index + 1
+				break
+			}
+		}
+	}
+
+	fence := ""
+	for index := start; index < len(lines); index++ {
+		line := lines[index]
+		if fence != "" {
+			kinds[index] = formatLineCode
+			if strings.HasPrefix(strings.TrimSpace(line), fence) && strings.Trim(strings.TrimSpace(line), fence[:1]) == "" {
+				fence = ""
+			}
+			continue
+		}
+		if match := fencePattern.FindStringSubmatch(line); match != nil {
+			kinds[index] = formatLineCode
+			fence = match[1]
+			continue
+		}
+		// The indented lines after a blank line or an indented code line are code, the list items included.
+		if strings.TrimSpace(line) != "" && (strings.HasPrefix(line, "    ") || strings.HasPrefix(line, "\t")) &&
+			index > 0 && (strings.TrimSpace(lines[index-1]) == "" || kinds[index-1] == formatLineCode) {
+			kinds[index] = formatLineCode
+		}
+	}
+	return kinds
+}
+
+// normalizeHeadings rewrites the ATX headings as "# Heading" and the single line setext headings as ATX headings.
+func normalizeHeadings(lines []string, kinds []formatLineKind) ([]string, []formatLineKind) {
+	normalizedLines := make([]string, 0, len(lines))
+	normalizedKinds := make([]formatLineKind, 0, len(kinds))
+	for index, line := range lines {
+		if kinds[index] != formatLineText {
+			normalizedLines = append(normalizedLines, line)
+			normalizedKinds = append(normalizedKinds, kinds[index])
+			continue
+		}
+		if match := unspacedHeadingPattern.FindStringSubmatch(line); match != nil {
+			line = match[1] + " " + match[2]
+		}
+		if match := atxHeadingPattern.FindStringSubmatch(line); match != nil {
+			text := strings.TrimSpace(closingHashesPattern.ReplaceAllString(match[2], ""))
+			line = match[1]
+			if text != "" {
+				line += " " + text
+			}
+		} else if match := setextUnderlinePattern.FindStringSubmatch(line); match != nil && isSetextHeadingText(normalizedLines, normalizedKinds) {
+			level := "#"
+			if match[1][0] == '-' {
+				level = "##"
+			}
+			previous := len(normalizedLines) - 1
+			normalizedLines[previous] = level + " " + strings.TrimSpace(normalizedLines[previous])
+			continue
+		}
+		normalizedLines = append(normalizedLines, line)
+		normalizedKinds = append(normalizedKinds, kinds[index])
+	}
+	return normalizedLines, normalizedKinds
+}
+
+// isSetextHeadingText returns whether the last line is a single line paragraph, the text of a setext heading when
+// followed by an underline.
+func isSetextHeadingText(lines []string, kinds []formatLineKind) bool {
+	last := len(lines) - 1
+	if last < 0 || kinds[last] != formatLineText || blockStartPattern.MatchString(lines[last]) || strings.HasPrefix(lines[last], "    ") {
+		return false
+	}
+	return last == 0 || kinds[last-1] != formatLineText || strings.TrimSpace(lines[last-1]) == ""
+}
+
+// trimTrailingWhitespace trims the trailing whitespace of the text lines, keeping two spaces for the hard line
+// breaks, and the blank lines ending the content.
+func trimTrailingWhitespace(lines []string, kinds []formatLineKind) []string {
+	for index, line := range lines {
+		if kinds[index] == formatLineCode {
+			continue
+		}
+		trimmed := strings.TrimRight(line, " \t")
+		isHardLineBreak := strings.HasSuffix(line, "  ") && strings.TrimSpace(line) != "" &&
+			index+1 < len(lines) && kinds[index+1] == formatLineText && strings.TrimSpace(lines[index+1]) != ""
+		if isHardLineBreak {
+			trimmed += "  "
+		}
+		lines[index] = trimmed
+	}
+	for len(lines) > 1 && strings.TrimSpace(lines[len(lines)-1]) == "" && kinds[len(lines)-1] != formatLineCode {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// inlineFormatter formats the text of the lines, outside the code spans, links and HTML tags.
+type inlineFormatter struct {
+	options FormatOptions
+	// tags are the tags by their lowercase, with the case of their first occurrence.
+	tags map[string]string
+	// usernames are the usernames by their lowercase.
+	usernames map[string]string
+}
+
+func (f *inlineFormatter) formatLine(line string) string {
+	if linkDefinitionPattern.MatchString(line) {
+		return line
+	}
+	var builder strings.Builder
+	for len(line) > 0 {
+		start, end := findCodeSpan(line)
+		if start < 0 {
+			builder.WriteString(f.formatText(line))
+			break
+		}
+		builder.WriteString(f.formatText(line[:start]))
+		builder.WriteString(line[start:end])
+		line = line[end:]
+	}
+	return builder.String()
+}
+
+// formatText formats the text outside the links and the HTML tags.
+func (f *inlineFormatter) formatText(text string) string {
+	var builder strings.Builder
+	last := 0
+	for _, match := range protectedPattern.FindAllStringIndex(text, -1) {
+		builder.WriteString(f.formatWords(text[last:match[0]]))
+		builder.WriteString(text[match[0]:match[1]])
+		last = match[1]
+	}
+	builder.WriteString(f.formatWords(text[last:]))
+	return builder.String()
+}
+
+// formatWords links the bare URLs of the text, and normalizes the references and expands the shortcodes around them.
+func (f *inlineFormatter) formatWords(text string) string {
+	var builder strings.Builder
+	last := 0
+	for _, match := range bareURLPattern.FindAllStringIndex(text, -1) {
+		end := match[0] + len(trimURLPunctuation(text[match[0]:match[1]]))
+		builder.WriteString(expandShortcodes(f.normalizeReferences(text[last:match[0]]), f.options.Shortcodes))
+		url := text[match[0]:end]
+		// The URLs in words are not links, e.g. "xhttps://".
+		if f.options.LinkURLs && (match[0] == 0 || !isMentionByte(text[match[0]-1])) {
+			url = "<" + url + ">"
+		}
+		builder.WriteString(url)
+		last = end
+	}
+	builder.WriteString(expandShortcodes(f.normalizeReferences(text[last:]), f.options.Shortcodes))
+	return builder.String()
+}
+
+// normalizeReferences writes the tags with the case of their first occurrence and the mentions with the usernames.
+func (f *inlineFormatter) normalizeReferences(text string) string {
+	if !f.options.NormalizeReferences {
+		return text
+	}
+	text = replaceReferences(text, tagPattern, '#', func(tag string) string {
+		lower := strings.ToLower(tag)
+		if canonical, ok := f.tags[lower]; ok {
+			return canonical
+		}
+		f.tags[lower] = tag
+		return tag
+	})
+	return replaceReferences(text, mentionPattern, '@', func(username string) string {
+		if canonical, ok := f.usernames[strings.ToLower(username)]; ok {
+			return canonical
+		}
+		return username
+	})
+}
+
+// replaceReferences replaces the references of the pattern, not preceded by a word character, a dot or the prefix,
+// by the canonical names.
+func replaceReferences(text string, pattern *regexp.Regexp, prefix byte, canonical func(string) string) string {
+	var builder strings.Builder
+	last := 0
+	for _, match := range pattern.FindAllStringSubmatchIndex(text, -1) {
+		if match[0] > 0 {
+			if before := text[match[0]-1]; isMentionByte(before) || before == '.' || before == prefix || before == '&' {
+				continue
+			}
+		}
+		builder.WriteString(text[last:match[2]])
+		builder.WriteString(canonical(text[match[2]:match[3]]))
+		last = match[3]
+	}
+	builder.WriteString(text[last:])
+	return builder.String()
+}
+
+// findCodeSpan returns the bounds of the first code span of the line, -1 if it has none. A code span is closed by
+// a backtick run of the same length on the same line.
+func findCodeSpan(line string) (int, int) {
+	for start := 0; start < len(line); {
+		index := strings.IndexByte(line[start:], '`')
+		if index < 0 {
+			return -1, -1
+		}
+		open := start + index
+		length := 1
+		for open+length < len(line) && line[open+length] == '`' {
+			length++
+		}
+		for close := open + length; close < len(line); {
+			index := strings.IndexByte(line[close:], '`')
+			if index < 0 {
+				break
+			}
+			close += index
+			closeLength := 1
+			for close+closeLength < len(line) && line[close+closeLength] == '`' {
+				closeLength++
+			}
+			if closeLength == length {
+				return open, close + closeLength
+			}
+			close += closeLength
+		}
+		start = open + length
+	}
+	return -1, -1
+}
+
+// trimURLPunctuation trims the punctuation ending the sentence around the URL, and the unbalanced closing parentheses.
+func trimURLPunctuation(url string) string {
+	for len(url) > 0 {
+		last := url[len(url)-1]
+		if strings.IndexByte(".,:;!?'\"*_~", last) >= 0 {
+			url = url[:len(url)-1]
+			continue
+		}
+		if last == ')' && strings.Count(url, ")") > strings.Count(url, "(") {
+			url = url[:len(url)-1]
+			continue
+		}
+		break
+	}
+	return url
+}
+
+// isMentionByte reports whether the byte may be part of a word, a tag or a username.
+func isMentionByte(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9' || b == '_' || b == '-' || b >= 0x80
+}
@@ -0,0 +1,76 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		options  FormatOptions
+		expected string
+	}{
+		{
+			name:     "no formatters",
+			content:  "##Title  \nhttps://example.com",
+			expected: "##Title  \nhttps://example.com",
+		},
+		{
+			name:     "atx headings",
+			content:  "##Title\n#  Spaced  #\n### Closed ###\n# C#\n#tag",
+			options:  FormatOptions{NormalizeHeadings: true},
+			expected: "## Title\n# Spaced\n### Closed\n# C#\n#tag",
+		},
+		{
+			name:     "setext headings",
+			content:  "Title\n=====\n\nSection\n---\n\nLine one\nline two\n---\n\n- item\n---",
+			options:  FormatOptions{NormalizeHeadings: true},
+			expected: "# Title\n\n## Section\n\nLine one\nline two\n---\n\n- item\n---",
+		},
+		{
+			name:     "front matter and code are left as is",
+			content:  "---\nauthor: Me\n---\n```\n##Title  \nhttps://example.com\n```\nText `https://example.com` and\n\n    https://example.com",
+			options:  FormatOptions{NormalizeHeadings: true, LinkURLs: true, TrimTrailingWhitespace: true},
+			expected: "---\nauthor: Me\n---\n```\n##Title  \nhttps://example.com\n```\nText `https://example.com` and\n\n    https://example.com",
+		},
+		{
+			name:     "bare urls",
+			content:  "See https://example.com/a_(b). Or <https://example.com> and [link](https://example.com), (https://example.com/x)\n[1]: https://example.com",
+			options:  FormatOptions{LinkURLs: true},
+			expected: "See <https://example.com/a_(b)>. Or <https://example.com> and [link](https://example.com), (<https://example.com/x>)\n[1]: https://example.com",
+		},
+		{
+			name:     "references",
+			content:  "#Work and #work/meeting, later #WORK with @Steven and @Unknown. See https://example.com/#Work or steven@example.com",
+			options:  FormatOptions{NormalizeReferences: true, Usernames: []string{"steven"}},
+			expected: "#Work and #work/meeting, later #Work with @steven and @Unknown. See https://example.com/#Work or steven@example.com",
+		},
+		{
+			name:     "trailing whitespace",
+			content:  "Hard break  \nnext line \t\nlast  \n\n\n",
+			options:  FormatOptions{TrimTrailingWhitespace: true},
+			expected: "Hard break  \nnext line\nlast",
+		},
+		{
+			name:     "windows line endings",
+			content:  "One \r\nTwo\r\n",
+			options:  FormatOptions{TrimTrailingWhitespace: true},
+			expected: "One\nTwo",
+		},
+		{
+			name:     "shortcodes",
+			content:  "Shipped :tada::ship: :unknown: at 10:30:45\n`:tada:` [:tada:](https://example.com/:tada:) https://example.com/:tada: word:tada:",
+			options:  FormatOptions{Shortcodes: map[string]string{"tada": "🎉", "ship": "🚢", "30": "thirty"}},
+			expected: "Shipped 🎉🚢 :unknown: at 10:30:45\n`:tada:` [:tada:](https://example.com/:tada:) https://example.com/:tada: word:tada:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Format(tt.content, tt.options))
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package markdown
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// maxReadingRating is the highest rating of a reading.
+const maxReadingRating = 10
+
+// ParseFrontMatter returns the "key: value" properties of the front matter of the content, the block between the
+// "---" lines starting it. The keys are lowercased and the quotes around the values removed; the other YAML
+// constructs are ignored.
+func ParseFrontMatter(content []byte) map[string]string {
+	content = bytes.TrimPrefix(content, []byte("\ufeff"))
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		return nil
+	}
+	properties := map[string]string{}
+	for _, line := range lines[1:] {
+		if trimmed := strings.TrimSpace(line); trimmed == "---" || trimmed == "..." {
+			return properties
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok || strings.HasPrefix(line, " ") || strings.HasPrefix(line, "#") {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		properties[strings.ToLower(strings.TrimSpace(key))] = value
+	}
+	// The front matter is not closed.
+	return nil
+}
+
+// extractReading returns the reading properties of the front matter of the content, nil if it has no status,
+// rating or finished date. The invalid ratings and dates are ignored.
+func extractReading(content []byte) *storepb.MemoPayload_Reading {
+	properties := ParseFrontMatter(content)
+	reading := &storepb.MemoPayload_Reading{
+		Author: properties["author"],
+		Status: strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(properties["status"])),
+	}
+	// The ratings may be written out of their scale, e.g. "4/5".
+	ratingValue, _, _ := strings.Cut(properties["rating"], "/")
+	if rating, err := strconv.Atoi(strings.TrimSpace(ratingValue)); err == nil && rating >= 1 && rating <= maxReadingRating {
+		reading.Rating = int32(rating)
+	}
+	if finishedDate, err := time.Parse(time.DateOnly, properties["finished_date"]); err == nil {
+		reading.FinishedDate = finishedDate.Format(time.DateOnly)
+	}
+	if reading.Status == "" && reading.Rating == 0 && reading.FinishedDate == "" {
+		return nil
+	}
+	if reading.Status == "" {
+		reading.Status = "to_read"
+		if reading.FinishedDate != "" {
+			reading.Status = "finished"
+		}
+	}
+	return reading
+}
@@ -21,6 +21,14 @@ import (
 type ExtractedData struct {
 	Tags     []string
 	Property *storepb.MemoPayload_Property
+	// Links are the unique http(s) link destinations found in the content.
+	Links []string
+	// TimeEntries are the time entries of the "@time" blocks of the content.
+	TimeEntries []*storepb.MemoPayload_TimeEntry
+	// Amounts are the amounts of the content, e.g. "$12.50 #food".
+	Amounts []*storepb.MemoPayload_Amount
+	// Reading is the reading properties of the front matter of the content, nil if it has none.
+	Reading *storepb.MemoPayload_Reading
 }
 
 // Service handles markdown metadata extraction.
@@ -162,7 +170,9 @@ func (s *service) ExtractProperties(content []byte) (*storepb.MemoPayload_Proper
 		case east.KindTaskCheckBox:
 			prop.HasTaskList = true
 			if checkBox, ok := n.(*east.TaskCheckBox); ok {
-				if !checkBox.IsChecked {
+				if checkBox.IsChecked {
+					prop.CompletedTaskCount++
+				} else {
 					prop.HasIncompleteTasks = true
 				}
 			}
@@ -293,8 +303,11 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 	}
 
 	data := &ExtractedData{
-		Tags:     []string{},
-		Property: &storepb.MemoPayload_Property{},
+		Tags:        []string{},
+		Property:    &storepb.MemoPayload_Property{},
+		Links:       []string{},
+		TimeEntries: []*storepb.MemoPayload_TimeEntry{},
+		Amounts:     []*storepb.MemoPayload_Amount{},
 	}
 
 	// Single walk to collect all data
@@ -312,6 +325,18 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 		switch n.Kind() {
 		case gast.KindLink:
 			data.Property.HasLink = true
+			if link, ok := n.(*gast.Link); ok {
+				data.Links = append(data.Links, string(link.Destination))
+			}
+
+		case gast.KindAutoLink:
+			if autoLink, ok := n.(*gast.AutoLink); ok && autoLink.AutoLinkType == gast.AutoLinkURL {
+				data.Links = append(data.Links, string(autoLink.URL(content)))
+			}
+
+		case gast.KindParagraph, gast.KindTextBlock, gast.KindHeading:
+			data.TimeEntries = append(data.TimeEntries, extractTimeEntries(n, content)...)
+			data.Amounts = append(data.Amounts, extractAmounts(n, content)...)
 
 		case gast.KindCodeBlock, gast.KindFencedCodeBlock, gast.KindCodeSpan:
 			data.Property.HasCode = true
@@ -319,7 +344,9 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 		case east.KindTaskCheckBox:
 			data.Property.HasTaskList = true
 			if checkBox, ok := n.(*east.TaskCheckBox); ok {
-				if !checkBox.IsChecked {
+				if checkBox.IsChecked {
+					data.Property.CompletedTaskCount++
+				} else {
 					data.Property.HasIncompleteTasks = true
 				}
 			}
@@ -334,8 +361,11 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 		return nil, err
 	}
 
+	data.Reading = extractReading(content)
+
 	// Deduplicate and normalize tags
 	data.Tags = uniqueLowercase(data.Tags)
+	data.Links = uniqueHTTPLinks(data.Links)
 
 	return data, nil
 }
@@ -388,6 +418,25 @@ func uniqueLowercase(strs []string) []string {
 	return result
 }
 
+// uniqueHTTPLinks returns the unique http(s) links, preserving their order.
+func uniqueHTTPLinks(links []string) []string {
+	seen := make(map[string]bool)
+	result := []string{}
+
+	for _, link := range links {
+		lower := strings.ToLower(link)
+		if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") {
+			continue
+		}
+		if !seen[link] {
+			seen[link] = true
+			result = append(result, link)
+		}
+	}
+
+	return result
+}
+
 // truncateAtWord truncates a string at the last word boundary before maxLength.
 func truncateAtWord(s string, maxLength int) string {
 	if len(s) <= maxLength {
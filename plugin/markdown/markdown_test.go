@@ -5,6 +5,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
 func TestNewService(t *testing.T) {
@@ -113,6 +116,8 @@ func TestExtractProperties(t *testing.T) {
 		hasCode  bool
 		hasTasks bool
 		hasInc   bool
+		// completed is the number of completed tasks.
+		completed int32
 	}{
 		{
 			name:     "plain text",
@@ -147,12 +152,13 @@ func TestExtractProperties(t *testing.T) {
 			hasInc:   false,
 		},
 		{
-			name:     "with completed task",
-			content:  "- [x] Completed task",
-			hasLink:  false,
-			hasCode:  false,
-			hasTasks: true,
-			hasInc:   false,
+			name:      "with completed task",
+			content:   "- [x] Completed task",
+			hasLink:   false,
+			hasCode:   false,
+			hasTasks:  true,
+			hasInc:    false,
+			completed: 1,
 		},
 		{
 			name:     "with incomplete task",
@@ -163,12 +169,13 @@ func TestExtractProperties(t *testing.T) {
 			hasInc:   true,
 		},
 		{
-			name:     "mixed tasks",
-			content:  "- [x] Done\n- [ ] Not done",
-			hasLink:  false,
-			hasCode:  false,
-			hasTasks: true,
-			hasInc:   true,
+			name:      "mixed tasks",
+			content:   "- [x] Done\n- [ ] Not done\n- [X] Also done",
+			hasLink:   false,
+			hasCode:   false,
+			hasTasks:  true,
+			hasInc:    true,
+			completed: 2,
 		},
 		{
 			name:     "everything",
@@ -190,6 +197,195 @@ func TestExtractProperties(t *testing.T) {
 			assert.Equal(t, tt.hasCode, props.HasCode, "HasCode")
 			assert.Equal(t, tt.hasTasks, props.HasTaskList, "HasTaskList")
 			assert.Equal(t, tt.hasInc, props.HasIncompleteTasks, "HasIncompleteTasks")
+			assert.Equal(t, tt.completed, props.CompletedTaskCount, "CompletedTaskCount")
+		})
+	}
+}
+
+func TestExtractAllLinks(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "no links",
+			content:  "Just plain text",
+			expected: []string{},
+		},
+		{
+			name:     "markdown link",
+			content:  "Check out [this link](https://example.com)",
+			expected: []string{"https://example.com"},
+		},
+		{
+			name:     "autolink",
+			content:  "Visit https://example.com/docs today",
+			expected: []string{"https://example.com/docs"},
+		},
+		{
+			name:     "duplicates",
+			content:  "[a](https://example.com) and [b](https://example.com)",
+			expected: []string{"https://example.com"},
+		},
+		{
+			name:     "non http links are skipped",
+			content:  "[mail](mailto:me@example.com) and [rel](/memos/1)",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService()
+
+			data, err := svc.ExtractAll([]byte(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data.Links)
+		})
+	}
+}
+
+func TestExtractAllTimeEntries(t *testing.T) {
+	type entry struct {
+		minutes int32
+		tags    []string
+	}
+	tests := []struct {
+		name     string
+		content  string
+		expected []entry
+	}{
+		{
+			name:     "no entries",
+			content:  "Spent some time on #project",
+			expected: []entry{},
+		},
+		{
+			name:     "hours with a tag",
+			content:  "@time 2h #project",
+			expected: []entry{{120, []string{"project"}}},
+		},
+		{
+			name:     "tags of each line",
+			content:  "Daily notes\n@time 1h30m #Acme #meeting\n@time 45m #beta\n@time 0.5h",
+			expected: []entry{{90, []string{"acme", "meeting"}}, {45, []string{"beta"}}, {30, nil}},
+		},
+		{
+			name:     "list items",
+			content:  "- @time 1h 15m #acme\n- [x] @time 20m #beta",
+			expected: []entry{{75, []string{"acme"}}, {20, []string{"beta"}}},
+		},
+		{
+			name:     "code and invalid entries are skipped",
+			content:  "`@time 2h` @time soon\n\n```\n@time 3h #code\n```\n\nemail@time 2h",
+			expected: []entry{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(WithTagExtension())
+
+			data, err := svc.ExtractAll([]byte(tt.content))
+			require.NoError(t, err)
+			entries := []entry{}
+			for _, timeEntry := range data.TimeEntries {
+				entries = append(entries, entry{timeEntry.Minutes, timeEntry.Tags})
+			}
+			assert.Equal(t, tt.expected, entries)
+		})
+	}
+}
+
+func TestExtractAllAmounts(t *testing.T) {
+	type amount struct {
+		value    float64
+		currency string
+		tags     []string
+	}
+	tests := []struct {
+		name     string
+		content  string
+		expected []amount
+	}{
+		{
+			name:     "no amounts",
+			content:  "Lunch with #team, 12 people",
+			expected: []amount{},
+		},
+		{
+			name:     "amount with a tag",
+			content:  "$12.50 #food",
+			expected: []amount{{12.5, "$", []string{"food"}}},
+		},
+		{
+			name:     "tags of each line",
+			content:  "Groceries €8 and (£1,200.99) #Home\n-$5 refund #food\n¥500",
+			expected: []amount{{8, "€", []string{"home"}}, {1200.99, "£", []string{"home"}}, {-5, "$", []string{"food"}}, {500, "¥", nil}},
+		},
+		{
+			name:     "code and words are skipped",
+			content:  "`$12 #food` US$3 $12abc $1.234\n\n```\n$40 #code\n```",
+			expected: []amount{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(WithTagExtension())
+
+			data, err := svc.ExtractAll([]byte(tt.content))
+			require.NoError(t, err)
+			amounts := []amount{}
+			for _, a := range data.Amounts {
+				amounts = append(amounts, amount{a.Value, a.Currency, a.Tags})
+			}
+			assert.Equal(t, tt.expected, amounts)
+		})
+	}
+}
+
+func TestExtractAllReading(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected *storepb.MemoPayload_Reading
+	}{
+		{
+			name:     "no front matter",
+			content:  "status: reading",
+			expected: nil,
+		},
+		{
+			name:     "front matter without reading properties",
+			content:  "---\nauthor: Jane\ntitle: A post\n---\nHello",
+			expected: nil,
+		},
+		{
+			name:     "reading",
+			content:  "---\nAuthor: \"Ursula K. Le Guin\"\nstatus: Want To-Read\nrating: 4/5\n---\n# The Dispossessed",
+			expected: &storepb.MemoPayload_Reading{Author: "Ursula K. Le Guin", Status: "want_to_read", Rating: 4},
+		},
+		{
+			name:     "finished by default with a finished date",
+			content:  "---\r\nfinished_date: 2025-03-02\r\nrating: 11\r\n---\r\nNotes",
+			expected: &storepb.MemoPayload_Reading{Status: "finished", FinishedDate: "2025-03-02"},
+		},
+		{
+			name:     "unclosed front matter",
+			content:  "---\nstatus: reading\n",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService()
+
+			data, err := svc.ExtractAll([]byte(tt.content))
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(tt.expected, data.Reading), "Reading: %v", data.Reading)
 		})
 	}
 }
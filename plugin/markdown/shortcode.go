@@ -0,0 +1,72 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+)
+
+// shortcodePattern matches the shortcodes, e.g. ":tada:".
+var shortcodePattern = regexp.MustCompile(`:([a-z0-9_+-]+):`)
+
+// ShortcodeNamePattern matches the valid names of the shortcodes, without their colons.
+var ShortcodeNamePattern = regexp.MustCompile(`^[a-z0-9_+-]+$`)
+
+// BuiltinShortcodes are the texts of the built-in shortcodes by their name, escaped for markdown.
+var BuiltinShortcodes = map[string]string{
+	"shrug":            `¯\\\_(ツ)\_/¯`,
+	"tableflip":        `(╯°□°)╯︵ ┻━┻`,
+	"unflip":           `┬─┬ノ( º \_ ºノ)`,
+	"lenny":            `( ͡° ͜ʖ ͡°)`,
+	"smile":            "😄",
+	"laughing":         "😆",
+	"joy":              "😂",
+	"wink":             "😉",
+	"thinking":         "🤔",
+	"cry":              "😢",
+	"sob":              "😭",
+	"+1":               "👍",
+	"thumbsup":         "👍",
+	"-1":               "👎",
+	"thumbsdown":       "👎",
+	"clap":             "👏",
+	"pray":             "🙏",
+	"wave":             "👋",
+	"eyes":             "👀",
+	"heart":            "❤️",
+	"fire":             "🔥",
+	"sparkles":         "✨",
+	"star":             "⭐",
+	"tada":             "🎉",
+	"rocket":           "🚀",
+	"bulb":             "💡",
+	"memo":             "📝",
+	"pushpin":          "📌",
+	"warning":          "⚠️",
+	"x":                "❌",
+	"check":            "✔️",
+	"white_check_mark": "✅",
+	"question":         "❓",
+	"bug":              "🐛",
+	"coffee":           "☕",
+}
+
+// expandShortcodes replaces the known shortcodes of the text, not preceded or followed by a word character, by their
+// text.
+func expandShortcodes(text string, shortcodes map[string]string) string {
+	if !strings.Contains(text, ":") {
+		return text
+	}
+	var builder strings.Builder
+	last := 0
+	for _, match := range shortcodePattern.FindAllStringSubmatchIndex(text, -1) {
+		expansion, ok := shortcodes[text[match[2]:match[3]]]
+		if !ok || (match[0] > 0 && isMentionByte(text[match[0]-1])) || (match[1] < len(text) && isMentionByte(text[match[1]])) {
+			continue
+		}
+		builder.WriteString(text[last:match[0]])
+		builder.WriteString(expansion)
+		last = match[1]
+	}
+	builder.WriteString(text[last:])
+	return builder.String()
+}
@@ -0,0 +1,124 @@
+package markdown
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	gast "github.com/yuin/goldmark/ast"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// amountPattern matches the amounts of a line, a currency symbol before a number with at most two decimals,
+// e.g. "$12.50", "-€8" or "£1,200".
+var amountPattern = regexp.MustCompile(`(?:^|[\s(])(-?)([$€£¥])(\d+(?:,\d{3})*(?:\.\d{1,2})?)`)
+
+// timeEntryPattern matches the time-tracking blocks of a line, e.g. "@time 2h", "@time 1.5h", "@time 1h30m" or
+// "@time 45m".
+var timeEntryPattern = regexp.MustCompile(`(?i)(?:^|\s)@time\s+(?:(\d+(?:\.\d+)?)h)?\s*(?:(\d+)m)?(?:\s|$)`)
+
+// walkLines calls visit with the text and the tags of each line of a paragraph or a heading, the code spans left
+// out.
+func walkLines(block gast.Node, source []byte, visit func(line string, tags []string)) {
+	var line strings.Builder
+	tags := []string{}
+	flush := func() {
+		visit(line.String(), uniqueLowercase(tags))
+		line.Reset()
+		tags = []string{}
+	}
+
+	_ = gast.Walk(block, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		switch node := n.(type) {
+		case *gast.CodeSpan:
+			// Keep the words apart.
+			line.WriteString(" ")
+			return gast.WalkSkipChildren, nil
+		case *mast.TagNode:
+			tags = append(tags, string(node.Tag))
+			line.WriteString(" ")
+		case *gast.Text:
+			line.Write(node.Segment.Value(source))
+			if node.SoftLineBreak() || node.HardLineBreak() {
+				flush()
+			}
+		default:
+		}
+		return gast.WalkContinue, nil
+	})
+	flush()
+}
+
+// extractTimeEntries returns the time entries of the lines of a paragraph or a heading, each with the tags on
+// its line.
+func extractTimeEntries(block gast.Node, source []byte) []*storepb.MemoPayload_TimeEntry {
+	entries := []*storepb.MemoPayload_TimeEntry{}
+	walkLines(block, source, func(line string, tags []string) {
+		for _, match := range timeEntryPattern.FindAllStringSubmatch(line, -1) {
+			if minutes := parseTimeEntryMinutes(match[1], match[2]); minutes > 0 {
+				entries = append(entries, &storepb.MemoPayload_TimeEntry{
+					Minutes: minutes,
+					Tags:    tags,
+				})
+			}
+		}
+	})
+	return entries
+}
+
+// extractAmounts returns the amounts of the lines of a paragraph or a heading, each with the tags on its line.
+func extractAmounts(block gast.Node, source []byte) []*storepb.MemoPayload_Amount {
+	amounts := []*storepb.MemoPayload_Amount{}
+	walkLines(block, source, func(line string, tags []string) {
+		for _, match := range amountPattern.FindAllStringSubmatchIndex(line, -1) {
+			// The amount is a whole word, e.g. not the start of "$12abc".
+			if match[1] < len(line) {
+				if next, _ := utf8.DecodeRuneInString(line[match[1]:]); unicode.IsLetter(next) || unicode.IsDigit(next) {
+					continue
+				}
+			}
+			value, err := strconv.ParseFloat(strings.ReplaceAll(line[match[6]:match[7]], ",", ""), 64)
+			if err != nil || value == 0 {
+				continue
+			}
+			if match[3] > match[2] {
+				value = -value
+			}
+			amounts = append(amounts, &storepb.MemoPayload_Amount{
+				Value:    value,
+				Currency: line[match[4]:match[5]],
+				Tags:     tags,
+			})
+		}
+	})
+	return amounts
+}
+
+// parseTimeEntryMinutes returns the minutes of the hours and minutes of a time entry, 0 if there are none.
+func parseTimeEntryMinutes(hours, minutes string) int32 {
+	total := 0.0
+	if hours != "" {
+		value, err := strconv.ParseFloat(hours, 64)
+		if err != nil {
+			return 0
+		}
+		total += value * 60
+	}
+	if minutes != "" {
+		value, err := strconv.Atoi(minutes)
+		if err != nil {
+			return 0
+		}
+		total += float64(value)
+	}
+	// An entry is at most a day.
+	return int32(math.Min(math.Round(total), 24*60))
+}
@@ -0,0 +1,178 @@
+// Package mastodon posts statuses to a Mastodon account through the REST API of its instance.
+package mastodon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// Client is a minimal client of the REST API of a Mastodon instance, authenticated with an access token.
+type Client struct {
+	instanceURL string
+	accessToken string
+	httpClient  *http.Client
+}
+
+// Account is the account the access token belongs to.
+type Account struct {
+	// Acct is the address of the account, without the domain of the instance for local accounts.
+	Acct string `json:"acct"`
+	URL  string `json:"url"`
+}
+
+// Status is a status to post.
+type Status struct {
+	Status      string   `json:"status"`
+	MediaIDs    []string `json:"media_ids,omitempty"`
+	SpoilerText string   `json:"spoiler_text,omitempty"`
+	Sensitive   bool     `json:"sensitive,omitempty"`
+	Visibility  string   `json:"visibility"`
+}
+
+// PostedStatus is a status posted to the instance.
+type PostedStatus struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+// NewClient returns a client of the instance. Its requests are initiated by the server, so they are restricted by
+// the outbound policy.
+func NewClient(instanceURL, accessToken string) (*Client, error) {
+	instanceURL, err := ParseInstanceURL(instanceURL)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		instanceURL: instanceURL,
+		accessToken: accessToken,
+		httpClient:  outbound.NewClient(),
+	}, nil
+}
+
+// ParseInstanceURL validates the base URL of an instance and returns it without trailing slash.
+func ParseInstanceURL(instanceURL string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(instanceURL))
+	if err != nil {
+		return "", errors.Wrap(err, "invalid instance url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", errors.Errorf("unsupported instance url scheme: %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return "", errors.New("instance url host is empty")
+	}
+	return strings.TrimRight(u.String(), "/"), nil
+}
+
+// VerifyCredentials returns the account of the access token.
+func (c *Client) VerifyCredentials(ctx context.Context) (*Account, error) {
+	account := &Account{}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/accounts/verify_credentials", "", nil, account); err != nil {
+		return nil, err
+	}
+	if account.Acct == "" {
+		return nil, errors.New("invalid account")
+	}
+	return account, nil
+}
+
+// UploadMedia uploads the file as a media attachment and returns its ID, to attach it to a status.
+func (c *Client) UploadMedia(ctx context.Context, filename string, blob []byte, description string) (string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create form file")
+	}
+	if _, err := part.Write(blob); err != nil {
+		return "", errors.Wrap(err, "failed to write form file")
+	}
+	if description != "" {
+		if err := writer.WriteField("description", description); err != nil {
+			return "", errors.Wrap(err, "failed to write form field")
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", errors.Wrap(err, "failed to close multipart writer")
+	}
+
+	media := struct {
+		ID string `json:"id"`
+	}{}
+	if err := c.do(ctx, http.MethodPost, "/api/v2/media", writer.FormDataContentType(), body, &media); err != nil {
+		return "", err
+	}
+	if media.ID == "" {
+		return "", errors.New("the uploaded media has no id")
+	}
+	return media.ID, nil
+}
+
+// PostStatus posts the status.
+func (c *Client) PostStatus(ctx context.Context, status *Status) (*PostedStatus, error) {
+	body, err := json.Marshal(status)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal status")
+	}
+	posted := &PostedStatus{}
+	if err := c.do(ctx, http.MethodPost, "/api/v1/statuses", "application/json", bytes.NewReader(body), posted); err != nil {
+		return nil, err
+	}
+	if posted.ID == "" {
+		return nil, errors.New("the posted status has no id")
+	}
+	return posted, nil
+}
+
+// DeleteStatus deletes the status with the ID.
+func (c *Client) DeleteStatus(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/api/v1/statuses/"+url.PathEscape(id), "", nil, nil)
+}
+
+// do sends the request and decodes its response into response if not nil.
+func (c *Client) do(ctx context.Context, method, path, contentType string, body io.Reader, response any) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.instanceURL+path, body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct request to %s", path)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to request %s", path)
+	}
+	defer resp.Body.Close()
+	data, err := outbound.ReadBody(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read response from %s", path)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiError := struct {
+			Error string `json:"error"`
+		}{}
+		if json.Unmarshal(data, &apiError) == nil && apiError.Error != "" {
+			return errors.Errorf("failed to request %s, status code: %d, error: %s", path, resp.StatusCode, apiError.Error)
+		}
+		return errors.Errorf("failed to request %s, status code: %d", path, resp.StatusCode)
+	}
+	if response == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, response); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal response from %s", path)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package mastodon
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MaxStatusLength is the default maximum length of a status, in characters.
+	MaxStatusLength = 500
+	// MaxMediaAttachments is the maximum number of media attachments of a status.
+	MaxMediaAttachments = 4
+)
+
+// StatusText returns the text of the status of the content followed by the link, the content being truncated for
+// the text to fit in MaxStatusLength.
+func StatusText(content, link string) string {
+	content = strings.TrimSpace(content)
+	suffix := ""
+	if link != "" {
+		suffix = link
+		if content != "" {
+			suffix = "\n\n" + link
+		}
+	}
+	return truncate(content, MaxStatusLength-utf8.RuneCountInString(suffix)) + suffix
+}
+
+// truncate truncates the text to at most length runes, preferably at a word boundary, marking it with an ellipsis.
+func truncate(text string, length int) string {
+	if utf8.RuneCountInString(text) <= length {
+		return text
+	}
+	if length <= 0 {
+		return ""
+	}
+	runes := []rune(text)[:length-1]
+	// Cut at the last space unless it would drop more than half of the text.
+	for i := len(runes) - 1; i >= len(runes)/2; i-- {
+		if unicode.IsSpace(runes[i]) {
+			runes = runes[:i]
+			break
+		}
+	}
+	return strings.TrimRightFunc(string(runes), unicode.IsSpace) + "…"
+}
@@ -0,0 +1,20 @@
+package mastodon
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusText(t *testing.T) {
+	link := "https://memos.example.com/memos/abc"
+	require.Equal(t, "Hello\n\n"+link, StatusText(" Hello ", link))
+	require.Equal(t, link, StatusText("", link))
+	require.Equal(t, "Hello", StatusText("Hello", ""))
+
+	text := StatusText(strings.Repeat("word ", 200), link)
+	require.LessOrEqual(t, utf8.RuneCountInString(text), MaxStatusLength)
+	require.True(t, strings.HasSuffix(text, "word…\n\n"+link))
+}
@@ -0,0 +1,178 @@
+package memosclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/plugin/outbound"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// maxBinarySize is the max size of an attachment binary to download. Default to 256 MiB.
+var maxBinarySize int64 = 256 << 20
+
+// protojsonUnmarshaler ignores unknown fields so that instances running a newer version or a fork can be read.
+var protojsonUnmarshaler = protojson.UnmarshalOptions{
+	DiscardUnknown: true,
+}
+
+// Client is a minimal client of the memos v1 REST API.
+type Client struct {
+	instanceURL string
+	accessToken string
+	httpClient  *http.Client
+}
+
+// NewClient returns a client authenticated with the access token of the remote user. Its requests are initiated by
+// the server, so they are restricted by the outbound policy.
+func NewClient(instanceURL, accessToken string) (*Client, error) {
+	instanceURL, err := ParseInstanceURL(instanceURL)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		instanceURL: instanceURL,
+		accessToken: accessToken,
+		httpClient:  outbound.NewClient(),
+	}, nil
+}
+
+// NewPublicClient returns a client without access token, reading the public content of the instance. Its requests
+// are initiated by the server, so they are restricted by the outbound policy.
+func NewPublicClient(instanceURL string) (*Client, error) {
+	instanceURL, err := ParseInstanceURL(instanceURL)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		instanceURL: instanceURL,
+		httpClient:  outbound.NewClient(),
+	}, nil
+}
+
+// ParseInstanceURL validates the base URL of an instance and returns it without trailing slash.
+func ParseInstanceURL(instanceURL string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(instanceURL))
+	if err != nil {
+		return "", errors.Wrap(err, "invalid instance url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", errors.Errorf("unsupported instance url scheme: %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return "", errors.New("instance url host is empty")
+	}
+	return strings.TrimRight(u.String(), "/"), nil
+}
+
+// GetCurrentUser returns the user owning the access token.
+func (c *Client) GetCurrentUser(ctx context.Context) (*v1pb.User, error) {
+	response := &v1pb.GetCurrentSessionResponse{}
+	if err := c.getJSON(ctx, "/api/v1/auth/sessions/current", nil, response); err != nil {
+		return nil, err
+	}
+	if response.User == nil {
+		return nil, errors.New("current user not found")
+	}
+	return response.User, nil
+}
+
+// GetUser returns the user with the given name, e.g. "users/1".
+func (c *Client) GetUser(ctx context.Context, name string) (*v1pb.User, error) {
+	user := &v1pb.User{}
+	if err := c.getJSON(ctx, "/api/v1/"+name, nil, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// ListMemos lists a page of memos with the given state.
+func (c *Client) ListMemos(ctx context.Context, state v1pb.State, pageToken string) (*v1pb.ListMemosResponse, error) {
+	query := url.Values{}
+	query.Set("pageSize", "100")
+	query.Set("state", state.String())
+	if pageToken != "" {
+		query.Set("pageToken", pageToken)
+	}
+	response := &v1pb.ListMemosResponse{}
+	if err := c.getJSON(ctx, "/api/v1/memos", query, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ListMemoComments lists the comments of the memo with the given name.
+func (c *Client) ListMemoComments(ctx context.Context, memoName string) ([]*v1pb.Memo, error) {
+	response := &v1pb.ListMemoCommentsResponse{}
+	if err := c.getJSON(ctx, fmt.Sprintf("/api/v1/%s/comments", memoName), nil, response); err != nil {
+		return nil, err
+	}
+	return response.Memos, nil
+}
+
+// GetAttachmentBinary downloads the content of the attachment.
+func (c *Client) GetAttachmentBinary(ctx context.Context, attachment *v1pb.Attachment) ([]byte, error) {
+	resp, err := c.do(ctx, fmt.Sprintf("/file/%s/%s", attachment.Name, url.PathEscape(attachment.Filename)), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	blob, err := io.ReadAll(io.LimitReader(resp.Body, maxBinarySize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read attachment %s", attachment.Name)
+	}
+	if int64(len(blob)) > maxBinarySize {
+		return nil, errors.Errorf("attachment %s is too large", attachment.Name)
+	}
+	return blob, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, path string, query url.Values, message proto.Message) error {
+	resp, err := c.do(ctx, path, query)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := outbound.ReadBody(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read response from %s", path)
+	}
+	if err := protojsonUnmarshaler.Unmarshal(body, message); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal response from %s", path)
+	}
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, path string, query url.Values) (*http.Response, error) {
+	requestURL := c.instanceURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to construct request to %s", path)
+	}
+	if c.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to request %s", path)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, errors.Errorf("failed to request %s, status code: %d", path, resp.StatusCode)
+	}
+	return resp, nil
+}
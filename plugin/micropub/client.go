@@ -0,0 +1,202 @@
+// Package micropub creates and deletes entries through a Micropub endpoint, e.g. of a personal website, and parses
+// the requests to the Micropub endpoint of the instance.
+package micropub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// Client is a minimal client of a Micropub endpoint, authenticated with an access token.
+type Client struct {
+	endpoint    string
+	accessToken string
+	httpClient  *http.Client
+}
+
+// Config is the configuration of the endpoint.
+type Config struct {
+	// MediaEndpoint is the URL of the media endpoint, empty if the endpoint has none.
+	MediaEndpoint string `json:"media-endpoint"`
+}
+
+// Entry is an h-entry with its properties, e.g. "content" or "photo".
+type Entry struct {
+	Type       []string            `json:"type"`
+	Properties map[string][]string `json:"properties"`
+}
+
+// NewEntry returns an entry of the content with the categories.
+func NewEntry(content string, categories []string) *Entry {
+	entry := &Entry{
+		Type:       []string{"h-entry"},
+		Properties: map[string][]string{"content": {content}},
+	}
+	if len(categories) > 0 {
+		entry.Properties["category"] = categories
+	}
+	return entry
+}
+
+// AddMedia adds the URL of a media file to the photo, video or audio property according to its MIME type.
+// Other files are not added.
+func (e *Entry) AddMedia(mediaURL, contentType string) {
+	for _, property := range []string{"photo", "video", "audio"} {
+		prefix := property + "/"
+		if property == "photo" {
+			prefix = "image/"
+		}
+		if strings.HasPrefix(contentType, prefix) {
+			e.Properties[property] = append(e.Properties[property], mediaURL)
+			return
+		}
+	}
+}
+
+// NewClient returns a client of the endpoint. Its requests are initiated by the server, so they are restricted by
+// the outbound policy.
+func NewClient(endpoint, accessToken string) (*Client, error) {
+	endpoint, err := ParseEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		endpoint:    endpoint,
+		accessToken: accessToken,
+		httpClient:  outbound.NewClient(),
+	}, nil
+}
+
+// ParseEndpoint validates the URL of an endpoint.
+func ParseEndpoint(endpoint string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil {
+		return "", errors.Wrap(err, "invalid endpoint url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", errors.Errorf("unsupported endpoint url scheme: %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return "", errors.New("endpoint url host is empty")
+	}
+	return u.String(), nil
+}
+
+// GetConfig queries the configuration of the endpoint, which also verifies the access token.
+func (c *Client) GetConfig(ctx context.Context) (*Config, error) {
+	u, err := url.Parse(c.endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid endpoint url")
+	}
+	query := u.Query()
+	query.Set("q", "config")
+	u.RawQuery = query.Encode()
+
+	resp, err := c.do(ctx, http.MethodGet, u.String(), "", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := outbound.ReadBody(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read config")
+	}
+	config := &Config{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal config")
+	}
+	return config, nil
+}
+
+// UploadMedia uploads the file to the media endpoint and returns its URL.
+func (c *Client) UploadMedia(ctx context.Context, mediaEndpoint, filename string, blob []byte) (string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create form file")
+	}
+	if _, err := part.Write(blob); err != nil {
+		return "", errors.Wrap(err, "failed to write form file")
+	}
+	if err := writer.Close(); err != nil {
+		return "", errors.Wrap(err, "failed to close multipart writer")
+	}
+
+	resp, err := c.do(ctx, http.MethodPost, mediaEndpoint, writer.FormDataContentType(), body)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	return location(resp)
+}
+
+// Create creates the entry and returns its URL.
+func (c *Client) Create(ctx context.Context, entry *Entry) (string, error) {
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal entry")
+	}
+	resp, err := c.do(ctx, http.MethodPost, c.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	return location(resp)
+}
+
+// Delete deletes the entry with the URL.
+func (c *Client) Delete(ctx context.Context, entryURL string) error {
+	body, err := json.Marshal(map[string]string{"action": "delete", "url": entryURL})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal delete action")
+	}
+	resp, err := c.do(ctx, http.MethodPost, c.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// do sends the request, failing on the responses with an error status.
+func (c *Client) do(ctx context.Context, method, requestURL, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to construct request to %s", requestURL)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to request %s", requestURL)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, errors.Errorf("failed to request %s, status code: %d", requestURL, resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// location returns the absolute URL of the Location header of the response.
+func location(resp *http.Response) (string, error) {
+	u, err := resp.Location()
+	if err != nil {
+		return "", errors.Wrap(err, "the response has no location")
+	}
+	return u.String(), nil
+}
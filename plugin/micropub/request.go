@@ -0,0 +1,203 @@
+package micropub
+
+import (
+	"encoding/json"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The actions of the requests to an endpoint.
+const (
+	ActionCreate   = "create"
+	ActionUpdate   = "update"
+	ActionDelete   = "delete"
+	ActionUndelete = "undelete"
+)
+
+// Value is a value of a property, e.g. the text of the content or the URL of a photo with its alternative text.
+type Value struct {
+	Value string
+	Alt   string
+}
+
+// Request is a request to an endpoint, form-encoded, multipart or JSON.
+type Request struct {
+	Action string
+	// URL is the URL of the entry to update or delete.
+	URL string
+	// Type is the type of the entry to create without the "h-" prefix, e.g. "entry".
+	Type       string
+	Properties map[string][]Value
+	// Files are the files uploaded with a multipart request to create an entry, by property.
+	Files map[string][]*multipart.FileHeader
+
+	// Replace, Add and Delete are the changes of an update. A property of Delete without values is deleted.
+	Replace map[string][]Value
+	Add     map[string][]Value
+	Delete  map[string][]Value
+}
+
+// ParseRequest parses the body of a POST request to an endpoint, whose size must already be limited.
+func ParseRequest(r *http.Request, maxMemory int64) (*Request, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		return parseJSONRequest(r)
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(maxMemory); err != nil {
+			return nil, errors.Wrap(err, "invalid multipart body")
+		}
+		request := parseFormRequest(r.PostForm)
+		request.Files = map[string][]*multipart.FileHeader{}
+		for key, files := range r.MultipartForm.File {
+			key = strings.TrimSuffix(key, "[]")
+			request.Files[key] = append(request.Files[key], files...)
+		}
+		return request, nil
+	default:
+		if err := r.ParseForm(); err != nil {
+			return nil, errors.Wrap(err, "invalid form body")
+		}
+		return parseFormRequest(r.PostForm), nil
+	}
+}
+
+// parseFormRequest parses the fields of a form, where the properties of an entry are the fields besides "h",
+// "action", "url" and "access_token", and the fields with a "[]" suffix have several values.
+func parseFormRequest(form map[string][]string) *Request {
+	request := &Request{
+		Action:     ActionCreate,
+		Properties: map[string][]Value{},
+	}
+	for key, values := range form {
+		key = strings.TrimSuffix(key, "[]")
+		switch key {
+		case "h":
+			if len(values) > 0 {
+				request.Type = values[0]
+			}
+		case "action":
+			if len(values) > 0 {
+				request.Action = values[0]
+			}
+		case "url":
+			if len(values) > 0 {
+				request.URL = values[0]
+			}
+		case "access_token":
+		default:
+			for _, value := range values {
+				request.Properties[key] = append(request.Properties[key], Value{Value: value})
+			}
+		}
+	}
+	return request
+}
+
+// parseJSONRequest parses a JSON request, an entry in the microformats2 JSON format or an action on an entry.
+func parseJSONRequest(r *http.Request) (*Request, error) {
+	body := struct {
+		Type       []string                     `json:"type"`
+		Properties map[string][]json.RawMessage `json:"properties"`
+		Action     string                       `json:"action"`
+		URL        string                       `json:"url"`
+		Replace    map[string][]json.RawMessage `json:"replace"`
+		Add        map[string][]json.RawMessage `json:"add"`
+		Delete     json.RawMessage              `json:"delete"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errors.Wrap(err, "invalid json body")
+	}
+
+	request := &Request{
+		Action: body.Action,
+		URL:    body.URL,
+	}
+	if request.Action == "" {
+		request.Action = ActionCreate
+	}
+	if len(body.Type) > 0 {
+		request.Type = strings.TrimPrefix(body.Type[0], "h-")
+	}
+	var err error
+	if request.Properties, err = parseJSONProperties(body.Properties); err != nil {
+		return nil, err
+	}
+	if request.Replace, err = parseJSONProperties(body.Replace); err != nil {
+		return nil, err
+	}
+	if request.Add, err = parseJSONProperties(body.Add); err != nil {
+		return nil, err
+	}
+	if len(body.Delete) > 0 {
+		// The properties to delete are either listed, or mapped to the values to delete.
+		names := []string{}
+		if err := json.Unmarshal(body.Delete, &names); err == nil {
+			request.Delete = map[string][]Value{}
+			for _, name := range names {
+				request.Delete[name] = nil
+			}
+		} else {
+			values := map[string][]json.RawMessage{}
+			if err := json.Unmarshal(body.Delete, &values); err != nil {
+				return nil, errors.New("invalid delete")
+			}
+			if request.Delete, err = parseJSONProperties(values); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return request, nil
+}
+
+// parseJSONProperties parses the values of the properties, which are strings or objects such as
+// {"html": "..."} for the content and {"value": "...", "alt": "..."} for the photos.
+func parseJSONProperties(properties map[string][]json.RawMessage) (map[string][]Value, error) {
+	parsed := map[string][]Value{}
+	for name, rawValues := range properties {
+		for _, rawValue := range rawValues {
+			var text string
+			if err := json.Unmarshal(rawValue, &text); err == nil {
+				parsed[name] = append(parsed[name], Value{Value: text})
+				continue
+			}
+			object := struct {
+				Value string `json:"value"`
+				HTML  string `json:"html"`
+				Alt   string `json:"alt"`
+			}{}
+			if err := json.Unmarshal(rawValue, &object); err != nil {
+				return nil, errors.Errorf("invalid value of property %s", name)
+			}
+			value := Value{Value: object.Value, Alt: object.Alt}
+			if value.Value == "" {
+				value.Value = object.HTML
+			}
+			parsed[name] = append(parsed[name], value)
+		}
+	}
+	return parsed, nil
+}
+
+// First returns the first value of the property, empty if it has none.
+func First(values []Value) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0].Value
+}
+
+// HasScope reports whether the space-separated scopes of an access token grant the scope, the legacy "post"
+// scope granting the creation of entries.
+func HasScope(scopes, scope string) bool {
+	for _, granted := range strings.Fields(scopes) {
+		if granted == scope || (granted == "post" && scope == ActionCreate) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package micropub
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRequest(t *testing.T) {
+	t.Run("form", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "/micropub", strings.NewReader("h=entry&content=Hello&category[]=a&category[]=b&access_token=secret"))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		request, err := ParseRequest(req, 1<<20)
+		require.NoError(t, err)
+		require.Equal(t, ActionCreate, request.Action)
+		require.Equal(t, "entry", request.Type)
+		require.Equal(t, "Hello", First(request.Properties["content"]))
+		require.Equal(t, []Value{{Value: "a"}, {Value: "b"}}, request.Properties["category"])
+		require.NotContains(t, request.Properties, "access_token")
+	})
+
+	t.Run("json entry", func(t *testing.T) {
+		body := `{"type":["h-entry"],"properties":{"content":[{"html":"<p>Hello</p>"}],"photo":[{"value":"https://example.com/a.jpg","alt":"A"}]}}`
+		req, err := http.NewRequest(http.MethodPost, "/micropub", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+		request, err := ParseRequest(req, 1<<20)
+		require.NoError(t, err)
+		require.Equal(t, "entry", request.Type)
+		require.Equal(t, "<p>Hello</p>", First(request.Properties["content"]))
+		require.Equal(t, []Value{{Value: "https://example.com/a.jpg", Alt: "A"}}, request.Properties["photo"])
+	})
+
+	t.Run("json update", func(t *testing.T) {
+		body := `{"action":"update","url":"https://example.com/1","replace":{"content":["New"]},"delete":["category"]}`
+		req, err := http.NewRequest(http.MethodPost, "/micropub", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		request, err := ParseRequest(req, 1<<20)
+		require.NoError(t, err)
+		require.Equal(t, ActionUpdate, request.Action)
+		require.Equal(t, "https://example.com/1", request.URL)
+		require.Equal(t, "New", First(request.Replace["content"]))
+		require.Contains(t, request.Delete, "category")
+		require.Nil(t, request.Delete["category"])
+	})
+}
+
+func TestHasScope(t *testing.T) {
+	require.True(t, HasScope("create media", ActionCreate))
+	require.True(t, HasScope("post", ActionCreate))
+	require.False(t, HasScope("post", ActionDelete))
+	require.False(t, HasScope("", ActionCreate))
+}
@@ -0,0 +1,138 @@
+package nostr
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The keys are shown to the users in the bech32 encoding of NIP-19, e.g. "npub1..." for the public keys.
+
+const (
+	PublicKeyPrefix  = "npub"
+	PrivateKeyPrefix = "nsec"
+)
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	checksum := uint32(1)
+	for _, value := range values {
+		top := checksum >> 25
+		checksum = (checksum&0x1ffffff)<<5 ^ uint32(value)
+		for i := range 5 {
+			if (top>>i)&1 == 1 {
+				checksum ^= generator[i]
+			}
+		}
+	}
+	return checksum
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+	for i := range len(hrp) {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := range len(hrp) {
+		expanded = append(expanded, hrp[i]&31)
+	}
+	return expanded
+}
+
+// convertBits regroups the bits of the data from groups of fromBits to groups of toBits.
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	accumulator, bits := uint(0), uint(0)
+	maxValue := uint(1)<<toBits - 1
+	result := []byte{}
+	for _, value := range data {
+		if uint(value)>>fromBits != 0 {
+			return nil, errors.New("invalid data")
+		}
+		accumulator = accumulator<<fromBits | uint(value)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			result = append(result, byte(accumulator>>bits&maxValue))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			result = append(result, byte(accumulator<<(toBits-bits)&maxValue))
+		}
+	} else if bits >= fromBits || accumulator<<(toBits-bits)&maxValue != 0 {
+		return nil, errors.New("invalid padding")
+	}
+	return result, nil
+}
+
+// EncodeKey returns the bech32 encoding of the key with the prefix.
+func EncodeKey(prefix string, key []byte) string {
+	data, _ := convertBits(key, 8, 5, true)
+	values := append(bech32HRPExpand(prefix), data...)
+	polymod := bech32Polymod(append(values, 0, 0, 0, 0, 0, 0)) ^ 1
+	var builder strings.Builder
+	builder.WriteString(prefix)
+	builder.WriteByte('1')
+	for _, value := range data {
+		builder.WriteByte(bech32Charset[value])
+	}
+	for i := range 6 {
+		builder.WriteByte(bech32Charset[(polymod>>(5*(5-i)))&31])
+	}
+	return builder.String()
+}
+
+// DecodeKey returns the key of its bech32 encoding with the prefix.
+func DecodeKey(prefix, encoded string) ([]byte, error) {
+	encoded = strings.ToLower(encoded)
+	separator := strings.LastIndexByte(encoded, '1')
+	if separator < 1 || encoded[:separator] != prefix || len(encoded)-separator-1 < 6 {
+		return nil, errors.Errorf("not a %s key", prefix)
+	}
+	values := make([]byte, 0, len(encoded)-separator-1)
+	for i := separator + 1; i < len(encoded); i++ {
+		value := strings.IndexByte(bech32Charset, encoded[i])
+		if value < 0 {
+			return nil, errors.Errorf("invalid character %q", encoded[i])
+		}
+		values = append(values, byte(value))
+	}
+	if bech32Polymod(append(bech32HRPExpand(prefix), values...)) != 1 {
+		return nil, errors.New("invalid checksum")
+	}
+	key, err := convertBits(values[:len(values)-6], 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != KeySize {
+		return nil, errors.Errorf("the key must have %d bytes", KeySize)
+	}
+	return key, nil
+}
+
+// ParsePrivateKey returns the private key of its nsec or hex encoding.
+func ParsePrivateKey(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	var privateKey []byte
+	if strings.HasPrefix(strings.ToLower(s), PrivateKeyPrefix+"1") {
+		key, err := DecodeKey(PrivateKeyPrefix, s)
+		if err != nil {
+			return nil, err
+		}
+		privateKey = key
+	} else {
+		key, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, errors.New("the private key must be an nsec or hex key")
+		}
+		privateKey = key
+	}
+	if _, err := parsePrivateKey(privateKey); err != nil {
+		return nil, err
+	}
+	return privateKey, nil
+}
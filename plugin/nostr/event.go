@@ -0,0 +1,118 @@
+// Package nostr signs Nostr events and publishes them to relays.
+package nostr
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// KindTextNote is the kind of the short text notes of NIP-01.
+	KindTextNote = 1
+	// KindDeletion is the kind of the deletion requests of NIP-09.
+	KindDeletion = 5
+)
+
+// Event is a Nostr event.
+type Event struct {
+	ID        string     `json:"id"`
+	PubKey    string     `json:"pubkey"`
+	CreatedAt int64      `json:"created_at"`
+	Kind      int        `json:"kind"`
+	Tags      [][]string `json:"tags"`
+	Content   string     `json:"content"`
+	Sig       string     `json:"sig"`
+}
+
+// Sign sets the public key, the ID and the signature of the event with the private key.
+func (e *Event) Sign(privateKey []byte) error {
+	publicKey, err := PublicKey(privateKey)
+	if err != nil {
+		return err
+	}
+	e.PubKey = hex.EncodeToString(publicKey)
+	id := e.hash()
+	signature, err := Sign(privateKey, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign event")
+	}
+	e.ID = hex.EncodeToString(id)
+	e.Sig = hex.EncodeToString(signature)
+	return nil
+}
+
+// Verify returns whether the ID and the signature of the event are valid.
+func (e *Event) Verify() bool {
+	id := e.hash()
+	if hex.EncodeToString(id) != e.ID {
+		return false
+	}
+	publicKey, err := hex.DecodeString(e.PubKey)
+	if err != nil {
+		return false
+	}
+	signature, err := hex.DecodeString(e.Sig)
+	if err != nil {
+		return false
+	}
+	return Verify(publicKey, id, signature)
+}
+
+// hash returns the ID of the event, the hash of its serialization.
+func (e *Event) hash() []byte {
+	serialization := []byte(`[0,"`)
+	serialization = append(serialization, e.PubKey...)
+	serialization = append(serialization, `",`...)
+	serialization = strconv.AppendInt(serialization, e.CreatedAt, 10)
+	serialization = append(serialization, ',')
+	serialization = strconv.AppendInt(serialization, int64(e.Kind), 10)
+	serialization = append(serialization, ",["...)
+	for i, tag := range e.Tags {
+		if i > 0 {
+			serialization = append(serialization, ',')
+		}
+		serialization = append(serialization, '[')
+		for j, value := range tag {
+			if j > 0 {
+				serialization = append(serialization, ',')
+			}
+			serialization = appendString(serialization, value)
+		}
+		serialization = append(serialization, ']')
+	}
+	serialization = append(serialization, "],"...)
+	serialization = appendString(serialization, e.Content)
+	serialization = append(serialization, ']')
+	hash := sha256.Sum256(serialization)
+	return hash[:]
+}
+
+// appendString appends the JSON string of the serialization of NIP-01, which only escapes the line breaks, the
+// quotes, the backslashes, the tabulations, the backspaces and the form feeds, unlike encoding/json.
+func appendString(b []byte, s string) []byte {
+	b = append(b, '"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\n':
+			b = append(b, `\n`...)
+		case '"':
+			b = append(b, `\"`...)
+		case '\\':
+			b = append(b, `\\`...)
+		case '\r':
+			b = append(b, `\r`...)
+		case '\t':
+			b = append(b, `\t`...)
+		case '\b':
+			b = append(b, `\b`...)
+		case '\f':
+			b = append(b, `\f`...)
+		default:
+			b = append(b, c)
+		}
+	}
+	return append(b, '"')
+}
@@ -0,0 +1,95 @@
+package nostr
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestSign(t *testing.T) {
+	// The test vectors of BIP-340.
+	tests := []struct {
+		privateKey string
+		publicKey  string
+		auxRand    string
+		message    string
+		signature  string
+	}{
+		{
+			privateKey: "0000000000000000000000000000000000000000000000000000000000000003",
+			publicKey:  "F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+			auxRand:    "0000000000000000000000000000000000000000000000000000000000000000",
+			message:    "0000000000000000000000000000000000000000000000000000000000000000",
+			signature:  "E907831F80848D1069A5371B402410364BDF1C5F8307B0084C55F1CE2DCA821525F66A4A85EA8B71E482A74F382D2CE5EBEEE8FDB2172F477DF4900D310536C0",
+		},
+		{
+			privateKey: "B7E151628AED2A6ABF7158809CF4F3C762E7160F38B4DA56A784D9045190CFEF",
+			publicKey:  "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			auxRand:    "0000000000000000000000000000000000000000000000000000000000000001",
+			message:    "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			signature:  "6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A",
+		},
+	}
+	for _, test := range tests {
+		privateKey := mustDecodeHex(t, test.privateKey)
+		publicKey, err := PublicKey(privateKey)
+		require.NoError(t, err)
+		require.Equal(t, test.publicKey, strings.ToUpper(hex.EncodeToString(publicKey)))
+		message := mustDecodeHex(t, test.message)
+		signature, err := sign(privateKey, message, mustDecodeHex(t, test.auxRand))
+		require.NoError(t, err)
+		require.Equal(t, test.signature, strings.ToUpper(hex.EncodeToString(signature)))
+		require.True(t, Verify(publicKey, message, signature))
+		message[0] ^= 1
+		require.False(t, Verify(publicKey, message, signature))
+	}
+}
+
+func TestEvent(t *testing.T) {
+	privateKey, err := GenerateKey()
+	require.NoError(t, err)
+	event := &Event{
+		CreatedAt: 1700000000,
+		Kind:      KindTextNote,
+		Tags:      [][]string{{"t", "work"}},
+		Content:   "hello \"world\"\n<b> ",
+	}
+	require.NoError(t, event.Sign(privateKey))
+	require.Len(t, event.ID, 2*KeySize)
+	require.True(t, event.Verify())
+	event.Content = "changed"
+	require.False(t, event.Verify())
+
+	// The serialization of NIP-01 escapes only the control characters it lists.
+	require.Equal(t, `"a\"\\\n\t<>`+" "+`"`, string(appendString(nil, "a\"\\\n\t<> ")))
+}
+
+func TestKeyEncoding(t *testing.T) {
+	// The example of NIP-19.
+	publicKey := mustDecodeHex(t, "3bf0c63fcb93463407af97a5e5ee64fa883d107ef9e558472c4eb9aaaefa459d")
+	require.Equal(t, "npub180cvv07tjdrrgpa0j7j7tmnyl2yr6yr7l8j4s3evf6u64th6gkwsyjh6w6", EncodeKey(PublicKeyPrefix, publicKey))
+	decoded, err := DecodeKey(PublicKeyPrefix, "npub180cvv07tjdrrgpa0j7j7tmnyl2yr6yr7l8j4s3evf6u64th6gkwsyjh6w6")
+	require.NoError(t, err)
+	require.Equal(t, publicKey, decoded)
+	_, err = DecodeKey(PublicKeyPrefix, "npub180cvv07tjdrrgpa0j7j7tmnyl2yr6yr7l8j4s3evf6u64th6gkwsyjh6w7")
+	require.Error(t, err)
+
+	privateKey, err := GenerateKey()
+	require.NoError(t, err)
+	parsed, err := ParsePrivateKey(EncodeKey(PrivateKeyPrefix, privateKey))
+	require.NoError(t, err)
+	require.Equal(t, privateKey, parsed)
+	parsed, err = ParsePrivateKey(hex.EncodeToString(privateKey))
+	require.NoError(t, err)
+	require.Equal(t, privateKey, parsed)
+	_, err = ParsePrivateKey("nope")
+	require.Error(t, err)
+}
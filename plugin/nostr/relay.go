@@ -0,0 +1,90 @@
+package nostr
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"nhooyr.io/websocket"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+var (
+	// publishTimeout is the time limit to publish an event to a relay. Default to 15 seconds.
+	publishTimeout = 15 * time.Second
+	// maxMessageSize is the max size of the messages read from a relay. Default to 64 KiB.
+	maxMessageSize int64 = 64 << 10
+)
+
+// ValidateRelayURL returns an error if the relay URL is not a ws or wss URL allowed by the outbound policy.
+func ValidateRelayURL(ctx context.Context, relayURL string) error {
+	u, err := url.Parse(relayURL)
+	if err != nil {
+		return errors.New("invalid relay URL")
+	}
+	switch u.Scheme {
+	case "ws":
+		u.Scheme = "http"
+	case "wss":
+		u.Scheme = "https"
+	default:
+		return errors.Errorf("unsupported relay URL scheme: %s", u.Scheme)
+	}
+	return outbound.ValidateURL(ctx, u.String())
+}
+
+// Publish sends the event to the relay and waits for the relay to accept it. The connection is restricted by the
+// outbound policy.
+func Publish(ctx context.Context, relayURL string, event *Event) error {
+	ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+	conn, _, err := websocket.Dial(ctx, relayURL, &websocket.DialOptions{
+		HTTPClient: outbound.NewStreamingClient(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to relay %s", relayURL)
+	}
+	defer conn.CloseNow()
+	conn.SetReadLimit(maxMessageSize)
+
+	request, err := json.Marshal([]any{"EVENT", event})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal event")
+	}
+	if err := conn.Write(ctx, websocket.MessageText, request); err != nil {
+		return errors.Wrapf(err, "failed to send event to relay %s", relayURL)
+	}
+	// The relay answers with ["OK", <event id>, <accepted>, <message>], possibly after other messages.
+	for {
+		_, data, err := conn.Read(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read from relay %s", relayURL)
+		}
+		var message []json.RawMessage
+		if err := json.Unmarshal(data, &message); err != nil || len(message) < 3 {
+			continue
+		}
+		var label, eventID string
+		var accepted bool
+		if json.Unmarshal(message[0], &label) != nil || label != "OK" {
+			continue
+		}
+		if json.Unmarshal(message[1], &eventID) != nil || eventID != event.ID {
+			continue
+		}
+		if err := json.Unmarshal(message[2], &accepted); err != nil {
+			return errors.Errorf("invalid answer from relay %s", relayURL)
+		}
+		if !accepted {
+			reason := ""
+			if len(message) > 3 {
+				_ = json.Unmarshal(message[3], &reason)
+			}
+			return errors.Errorf("relay %s rejected the event: %s", relayURL, reason)
+		}
+		return conn.Close(websocket.StatusNormalClosure, "")
+	}
+}
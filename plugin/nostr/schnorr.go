@@ -0,0 +1,84 @@
+package nostr
+
+import (
+	"crypto/rand"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/pkg/errors"
+)
+
+// The keys and signatures of Nostr are the BIP-340 Schnorr keys and signatures over the secp256k1 curve.
+
+// KeySize is the size of the private keys, of the x-only public keys and of the event IDs.
+const KeySize = 32
+
+// SignatureSize is the size of the signatures.
+const SignatureSize = 64
+
+// parsePrivateKey returns the private key of its 32 bytes.
+func parsePrivateKey(privateKey []byte) (*btcec.PrivateKey, error) {
+	if len(privateKey) != KeySize {
+		return nil, errors.Errorf("the private key must have %d bytes", KeySize)
+	}
+	var scalar btcec.ModNScalar
+	if overflow := scalar.SetByteSlice(privateKey); overflow || scalar.IsZero() {
+		return nil, errors.New("the private key is out of range")
+	}
+	return btcec.PrivKeyFromScalar(&scalar), nil
+}
+
+// GenerateKey returns a new random private key.
+func GenerateKey() ([]byte, error) {
+	privateKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return privateKey.Serialize(), nil
+}
+
+// PublicKey returns the x-only public key of the private key.
+func PublicKey(privateKey []byte) ([]byte, error) {
+	key, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return schnorr.SerializePubKey(key.PubKey()), nil
+}
+
+// Sign returns the BIP-340 signature of the 32-byte message with the private key.
+func Sign(privateKey, message []byte) ([]byte, error) {
+	auxRand := make([]byte, KeySize)
+	if _, err := rand.Read(auxRand); err != nil {
+		return nil, err
+	}
+	return sign(privateKey, message, auxRand)
+}
+
+func sign(privateKey, message, auxRand []byte) ([]byte, error) {
+	key, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(auxRand) != KeySize {
+		return nil, errors.Errorf("the auxiliary random data must have %d bytes", KeySize)
+	}
+	signature, err := schnorr.Sign(key, message, schnorr.CustomNonce([KeySize]byte(auxRand)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign")
+	}
+	return signature.Serialize(), nil
+}
+
+// Verify returns whether the signature of the 32-byte message is a valid BIP-340 signature for the public key.
+func Verify(publicKey, message, signature []byte) bool {
+	key, err := schnorr.ParsePubKey(publicKey)
+	if err != nil {
+		return false
+	}
+	sig, err := schnorr.ParseSignature(signature)
+	if err != nil {
+		return false
+	}
+	return sig.Verify(message, key)
+}
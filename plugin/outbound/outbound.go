@@ -0,0 +1,321 @@
+// Package outbound sends the HTTP requests initiated by the server, e.g. to unfurl links, to post webhooks or to call
+// the AI providers. The URLs come from the users or the admins, so the requests are restricted to http and https, to
+// the usual ports and to the public addresses, unless their host is allowlisted, to protect the network of the server
+// from server-side request forgery.
+package outbound
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultMaxResponseSize is the maximum size of the response bodies read when the policy does not set it.
+	DefaultMaxResponseSize = 10 << 20
+	// DefaultTimeout is the time limit of the requests when the policy does not set it.
+	DefaultTimeout = 30 * time.Second
+	// maxRedirects is the maximum number of redirects followed by a request.
+	maxRedirects = 10
+)
+
+// ErrBlocked is the error of the requests the policy does not allow.
+var ErrBlocked = errors.New("outbound request blocked")
+
+// ErrResponseTooLarge is the error of the response bodies larger than the maximum size of the policy.
+var ErrResponseTooLarge = errors.New("outbound response too large")
+
+// internalPrefixes are the address ranges not reachable unless allowlisted, besides the loopback, private,
+// link-local, multicast and unspecified addresses.
+var internalPrefixes = []netip.Prefix{
+	// Shared address space of the carrier-grade NATs.
+	netip.MustParsePrefix("100.64.0.0/10"),
+	// IPv4 addresses of the IETF protocol assignments, benchmarking and reserved for future use.
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	// NAT64 addresses, which reach IPv4 addresses.
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// Policy restricts the outbound requests.
+type Policy struct {
+	// AllowedHosts are reached whatever their address and port: host names, "*.example.com" for the subdomains of
+	// a domain, IP addresses or CIDR ranges, e.g. "10.0.0.0/8" for an AI model served on the local network.
+	AllowedHosts []string
+	// AllowedPorts are the ports the other hosts are reached on. When empty, the default ports of http and https
+	// and the unprivileged ports, from 1024, are allowed.
+	AllowedPorts []int
+	// MaxResponseSize is the maximum size of the response bodies, DefaultMaxResponseSize when 0.
+	MaxResponseSize int64
+	// Timeout is the time limit of the requests, DefaultTimeout when 0.
+	Timeout time.Duration
+}
+
+var policy atomic.Pointer[Policy]
+
+// SetPolicy replaces the policy of the outbound requests.
+func SetPolicy(p *Policy) {
+	if p == nil {
+		p = &Policy{}
+	}
+	policy.Store(p)
+}
+
+// GetPolicy returns the policy of the outbound requests.
+func GetPolicy() *Policy {
+	if p := policy.Load(); p != nil {
+		return p
+	}
+	return &Policy{}
+}
+
+func (p *Policy) maxResponseSize() int64 {
+	if p.MaxResponseSize > 0 {
+		return p.MaxResponseSize
+	}
+	return DefaultMaxResponseSize
+}
+
+func (p *Policy) timeout() time.Duration {
+	if p.Timeout > 0 {
+		return p.Timeout
+	}
+	return DefaultTimeout
+}
+
+// allowsHost reports whether the host, a name or an address, is allowlisted.
+func (p *Policy) allowsHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	addr, addrErr := netip.ParseAddr(host)
+	for _, allowed := range p.AllowedHosts {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		switch {
+		case addrErr == nil && strings.Contains(allowed, "/"):
+			if prefix, err := netip.ParsePrefix(allowed); err == nil && prefix.Contains(addr.Unmap()) {
+				return true
+			}
+		case addrErr == nil:
+			if allowedAddr, err := netip.ParseAddr(allowed); err == nil && allowedAddr.Unmap() == addr.Unmap() {
+				return true
+			}
+		case strings.HasPrefix(allowed, "*."):
+			if strings.HasSuffix(host, allowed[1:]) {
+				return true
+			}
+		case host == allowed:
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) allowsPort(port int) bool {
+	if len(p.AllowedPorts) == 0 {
+		return port == 80 || port == 443 || port >= 1024
+	}
+	for _, allowed := range p.AllowedPorts {
+		if port == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateHosts returns an error if an allowlisted host is neither a host name nor an address or a CIDR range.
+func ValidateHosts(hosts []string) error {
+	for _, host := range hosts {
+		host = strings.TrimSpace(host)
+		if host == "" {
+			return errors.New("allowed host must not be empty")
+		}
+		if strings.Contains(host, "/") {
+			if _, err := netip.ParsePrefix(host); err != nil {
+				return errors.Errorf("invalid CIDR range %q", host)
+			}
+			continue
+		}
+		if strings.ContainsAny(host, ":@?#") && net.ParseIP(host) == nil {
+			return errors.Errorf("invalid host %q, the allowed hosts are host names, addresses or CIDR ranges", host)
+		}
+	}
+	return nil
+}
+
+// isInternalAddr reports whether the address is not public.
+func isInternalAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return true
+	}
+	for _, prefix := range internalPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkURL returns an error wrapping ErrBlocked if the URL is not allowed by the policy, without resolving its host:
+// the addresses are checked when connecting.
+func checkURL(p *Policy, u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Wrapf(ErrBlocked, "only http/https protocols are allowed, got %q", u.Scheme)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return errors.Wrap(ErrBlocked, "empty hostname")
+	}
+	if u.User != nil {
+		return errors.Wrap(ErrBlocked, "credentials in the URL are not allowed")
+	}
+	if p.allowsHost(host) {
+		return nil
+	}
+	port := 80
+	if u.Scheme == "https" {
+		port = 443
+	}
+	if u.Port() != "" {
+		var err error
+		if port, err = strconv.Atoi(u.Port()); err != nil {
+			return errors.Wrapf(ErrBlocked, "invalid port %q", u.Port())
+		}
+	}
+	if !p.allowsPort(port) {
+		return errors.Wrapf(ErrBlocked, "port %d is not allowed", port)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && isInternalAddr(addr) {
+		return errors.Wrapf(ErrBlocked, "internal address %s is not allowed", addr)
+	}
+	return nil
+}
+
+// ValidateURL returns an error wrapping ErrBlocked if the URL is not allowed by the policy, resolving its host to
+// check its addresses, e.g. to reject the URL of a webhook when it is created rather than when it is posted to.
+func ValidateURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.New("invalid URL format")
+	}
+	p := GetPolicy()
+	if err := checkURL(p, u); err != nil {
+		return err
+	}
+	host := u.Hostname()
+	if p.allowsHost(host) {
+		return nil
+	}
+	if _, err := netip.ParseAddr(host); err == nil {
+		return nil
+	}
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return errors.Errorf("failed to resolve hostname: %v", err)
+	}
+	for _, addr := range addrs {
+		if isInternalAddr(addr) {
+			return errors.Wrapf(ErrBlocked, "host %s resolves to the internal address %s", host, addr.Unmap())
+		}
+	}
+	return nil
+}
+
+// dialContext connects to the address, refusing the internal addresses of the hosts that are not allowlisted. The
+// addresses are checked once resolved, so that a host cannot resolve to another address when connecting than when
+// it was validated.
+func dialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	p := GetPolicy()
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if !p.allowsHost(host) {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return errors.Wrapf(ErrBlocked, "invalid address %s", address)
+			}
+			if isInternalAddr(addrPort.Addr()) {
+				return errors.Wrapf(ErrBlocked, "host %s resolves to the internal address %s", host, addrPort.Addr().Unmap())
+			}
+			return nil
+		}
+	}
+	return dialer.DialContext(ctx, network, address)
+}
+
+// Transport is the transport of the outbound requests, enforcing the policy on each request and connection.
+var Transport http.RoundTripper = &transport{
+	base: &http.Transport{
+		DialContext:           dialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	},
+}
+
+type transport struct {
+	base *http.Transport
+}
+
+func (t *transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if err := checkURL(GetPolicy(), request.URL); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(request)
+}
+
+// NewClient returns a client enforcing the policy, whose requests are limited to the timeout of the policy.
+func NewClient() *http.Client {
+	client := NewStreamingClient()
+	client.Timeout = GetPolicy().timeout()
+	return client
+}
+
+// NewStreamingClient returns a client enforcing the policy, without a time limit, for the requests whose responses
+// are streamed or that are limited by their context, e.g. the calls to the AI providers.
+func NewStreamingClient() *http.Client {
+	return &http.Client{
+		Transport: Transport,
+		CheckRedirect: func(_ *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return errors.New("too many redirects")
+			}
+			return nil
+		},
+	}
+}
+
+// ReadBody reads the response body, returning ErrResponseTooLarge if it exceeds the maximum size of the policy.
+func ReadBody(body io.Reader) ([]byte, error) {
+	maxSize := GetPolicy().maxResponseSize()
+	data, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, errors.Wrapf(ErrResponseTooLarge, "the response exceeds %d bytes", maxSize)
+	}
+	return data, nil
+}
+
+// LimitBody returns the reader of the response body limited to the maximum size of the policy, for the bodies read
+// as streams, e.g. parsed as they are read. The reader stops at the limit without an error.
+func LimitBody(body io.Reader) io.Reader {
+	return io.LimitReader(body, GetPolicy().maxResponseSize())
+}
@@ -0,0 +1,152 @@
+package rules
+
+import (
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// MaxRules is the maximum number of tagging rules of a user.
+const MaxRules = 50
+
+// visibilities are the visibilities a rule can set.
+var visibilities = []string{"PRIVATE", "PROTECTED", "PUBLIC"}
+
+// Memo is the memo the rules are evaluated against.
+type Memo struct {
+	Content string
+	// Links are the links of the content.
+	Links []string
+	// CreatedTime is the creation time of the memo, in the timezone the hours of the rules are in.
+	CreatedTime time.Time
+}
+
+// Result is the outcome of the rules matching a memo.
+type Result struct {
+	// Tags are the tags of the matching rules, without duplicates.
+	Tags []string
+	// Visibility is the visibility of the last matching rule setting one, empty if none does.
+	Visibility string
+	// MatchedRules are the indexes of the matching rules.
+	MatchedRules []int
+}
+
+// Normalize validates the rules and returns them with their tags, domain and visibility normalized.
+func Normalize(rules []*storepb.TaggingRulesUserSetting_Rule) ([]*storepb.TaggingRulesUserSetting_Rule, error) {
+	if len(rules) > MaxRules {
+		return nil, errors.Errorf("too many rules (max %d)", MaxRules)
+	}
+	normalized := make([]*storepb.TaggingRulesUserSetting_Rule, 0, len(rules))
+	for index, rule := range rules {
+		if _, err := regexp.Compile(rule.ContentPattern); err != nil {
+			return nil, errors.Wrapf(err, "invalid content pattern of rule %d", index)
+		}
+		for _, weekday := range rule.Weekdays {
+			if weekday < 0 || weekday > 6 {
+				return nil, errors.Errorf("invalid weekday %d of rule %d", weekday, index)
+			}
+		}
+		if rule.StartHour < 0 || rule.StartHour > 23 || rule.EndHour < 0 || rule.EndHour > 24 {
+			return nil, errors.Errorf("invalid hours of rule %d", index)
+		}
+		if rule.StartHour == rule.EndHour && rule.StartHour != 0 {
+			return nil, errors.Errorf("empty hours of rule %d", index)
+		}
+		visibility := strings.ToUpper(strings.TrimSpace(rule.Visibility))
+		if visibility != "" && !slices.Contains(visibilities, visibility) {
+			return nil, errors.Errorf("invalid visibility %q of rule %d", rule.Visibility, index)
+		}
+		tags := []string{}
+		for _, tag := range rule.Tags {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+			if tag == "" || strings.ContainsAny(tag, " \t\n#") {
+				return nil, errors.Errorf("invalid tag %q of rule %d", tag, index)
+			}
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		if len(tags) == 0 && visibility == "" {
+			return nil, errors.Errorf("rule %d has no actions", index)
+		}
+		weekdays := slices.Clone(rule.Weekdays)
+		slices.Sort(weekdays)
+		normalized = append(normalized, &storepb.TaggingRulesUserSetting_Rule{
+			Title:          strings.TrimSpace(rule.Title),
+			ContentPattern: rule.ContentPattern,
+			LinkDomain:     strings.TrimPrefix(strings.ToLower(strings.TrimSpace(rule.LinkDomain)), "www."),
+			Weekdays:       slices.Compact(weekdays),
+			StartHour:      rule.StartHour,
+			EndHour:        rule.EndHour,
+			Tags:           tags,
+			Visibility:     visibility,
+		})
+	}
+	return normalized, nil
+}
+
+// Evaluate returns the result of the rules matching the memo. The rules are expected to be normalized, the rules
+// with an invalid content pattern never match.
+func Evaluate(rules []*storepb.TaggingRulesUserSetting_Rule, memo Memo) *Result {
+	result := &Result{Tags: []string{}, MatchedRules: []int{}}
+	for index, rule := range rules {
+		if !matches(rule, memo) {
+			continue
+		}
+		result.MatchedRules = append(result.MatchedRules, index)
+		for _, tag := range rule.Tags {
+			if !slices.Contains(result.Tags, tag) {
+				result.Tags = append(result.Tags, tag)
+			}
+		}
+		if rule.Visibility != "" {
+			result.Visibility = rule.Visibility
+		}
+	}
+	return result
+}
+
+// matches returns whether all the conditions of the rule hold for the memo.
+func matches(rule *storepb.TaggingRulesUserSetting_Rule, memo Memo) bool {
+	if rule.ContentPattern != "" {
+		pattern, err := regexp.Compile(rule.ContentPattern)
+		if err != nil || !pattern.MatchString(memo.Content) {
+			return false
+		}
+	}
+	if rule.LinkDomain != "" && !slices.ContainsFunc(memo.Links, func(link string) bool {
+		return isOnDomain(link, rule.LinkDomain)
+	}) {
+		return false
+	}
+	if len(rule.Weekdays) > 0 && !slices.Contains(rule.Weekdays, int32(memo.CreatedTime.Weekday())) {
+		return false
+	}
+	if rule.StartHour != 0 || rule.EndHour != 0 {
+		hour := int32(memo.CreatedTime.Hour())
+		if rule.StartHour < rule.EndHour {
+			if hour < rule.StartHour || hour >= rule.EndHour {
+				return false
+			}
+		} else if hour < rule.StartHour && hour >= rule.EndHour {
+			return false
+		}
+	}
+	return true
+}
+
+// isOnDomain returns whether the link is on the domain or one of its subdomains.
+func isOnDomain(link, domain string) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestEvaluate(t *testing.T) {
+	rules, err := Normalize([]*storepb.TaggingRulesUserSetting_Rule{
+		{Title: "Work hours", Weekdays: []int32{5, 1, 2, 3, 4}, StartHour: 9, EndHour: 17, Tags: []string{"#work"}},
+		{Title: "GitHub", LinkDomain: "GitHub.com", Tags: []string{"code", "work"}},
+		{Title: "Diary", ContentPattern: `(?i)^dear diary`, Visibility: "private"},
+		{Title: "Night", StartHour: 22, EndHour: 6, Tags: []string{"night"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"work"}, rules[0].Tags)
+	require.Equal(t, []int32{1, 2, 3, 4, 5}, rules[0].Weekdays)
+	require.Equal(t, "github.com", rules[1].LinkDomain)
+	require.Equal(t, "PRIVATE", rules[2].Visibility)
+
+	// Wednesday.
+	wednesday := time.Date(2025, 6, 4, 10, 30, 0, 0, time.UTC)
+	result := Evaluate(rules, Memo{Content: "See https://gist.github.com/x", Links: []string{"https://gist.github.com/x"}, CreatedTime: wednesday})
+	require.Equal(t, []int{0, 1}, result.MatchedRules)
+	require.Equal(t, []string{"work", "code"}, result.Tags)
+	require.Empty(t, result.Visibility)
+
+	// Saturday night.
+	saturday := time.Date(2025, 6, 7, 23, 0, 0, 0, time.UTC)
+	result = Evaluate(rules, Memo{Content: "Dear diary, today...", CreatedTime: saturday})
+	require.Equal(t, []int{2, 3}, result.MatchedRules)
+	require.Equal(t, []string{"night"}, result.Tags)
+	require.Equal(t, "PRIVATE", result.Visibility)
+
+	// The domain matches whole labels only.
+	result = Evaluate(rules, Memo{Links: []string{"https://notgithub.com"}, CreatedTime: saturday.Add(-12 * time.Hour)})
+	require.Empty(t, result.MatchedRules)
+}
+
+func TestNormalize(t *testing.T) {
+	for _, rule := range []*storepb.TaggingRulesUserSetting_Rule{
+		{ContentPattern: "(", Tags: []string{"a"}},
+		{Weekdays: []int32{7}, Tags: []string{"a"}},
+		{StartHour: 9, EndHour: 9, Tags: []string{"a"}},
+		{EndHour: 25, Tags: []string{"a"}},
+		{Tags: []string{"two words"}},
+		{Visibility: "WORKSPACE"},
+		{Title: "No actions"},
+	} {
+		_, err := Normalize([]*storepb.TaggingRulesUserSetting_Rule{rule})
+		require.Error(t, err, rule.String())
+	}
+}
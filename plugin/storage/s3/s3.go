@@ -10,8 +10,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/pkg/errors"
 
+	"github.com/usememos/memos/internal/logging"
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
@@ -34,6 +37,7 @@ func NewClient(ctx context.Context, s3Config *storepb.StorageS3Config) (*Client,
 		o.UsePathStyle = s3Config.UsePathStyle
 		o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
 		o.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
+		o.APIOptions = append(o.APIOptions, addRequestIDHeader)
 	})
 	return &Client{
 		Client: client,
@@ -41,6 +45,18 @@ func NewClient(ctx context.Context, s3Config *storepb.StorageS3Config) (*Client,
 	}, nil
 }
 
+// addRequestIDHeader forwards the request ID of the incoming call to the storage provider.
+func addRequestIDHeader(stack *middleware.Stack) error {
+	return stack.Build.Add(middleware.BuildMiddlewareFunc("RequestID", func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (middleware.BuildOutput, middleware.Metadata, error) {
+		if requestID := logging.RequestIDFromContext(ctx); requestID != "" {
+			if req, ok := in.Request.(*smithyhttp.Request); ok {
+				req.Header.Set(logging.RequestIDHeader, requestID)
+			}
+		}
+		return next.HandleBuild(ctx, in)
+	}), middleware.After)
+}
+
 // UploadObject uploads an object to S3.
 func (c *Client) UploadObject(ctx context.Context, key string, fileType string, content io.Reader) (string, error) {
 	uploader := manager.NewUploader(c.Client)
@@ -0,0 +1,54 @@
+package webarchive
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// waybackEndpoint is the base URL of the Wayback Machine.
+	waybackEndpoint = "https://web.archive.org"
+	// timeout is the timeout for a save request. Capturing a page can take a while.
+	timeout = 2 * time.Minute
+)
+
+// Save submits the given URL to the Wayback Machine and returns the URL of the captured snapshot.
+func Save(link string) (string, error) {
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", errors.Errorf("invalid link %s", link)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, waybackEndpoint+"/save/"+link, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to construct save request for %s", link)
+	}
+	req.Header.Set("User-Agent", "memos-web-archive")
+	client := &http.Client{
+		Timeout: timeout,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to save %s", link)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", errors.Errorf("failed to save %s, status code: %d", link, resp.StatusCode)
+	}
+
+	// The snapshot path is returned in the Content-Location header, otherwise the
+	// request is redirected to the snapshot itself.
+	snapshotPath := resp.Header.Get("Content-Location")
+	if snapshotPath == "" {
+		snapshotPath = resp.Request.URL.Path
+	}
+	if !strings.HasPrefix(snapshotPath, "/web/") {
+		return "", errors.Errorf("no snapshot returned for %s", link)
+	}
+	return waybackEndpoint + snapshotPath, nil
+}
@@ -0,0 +1,36 @@
+package webarchive
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSave(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/save/https://example.com/page" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Location", "/web/20240101000000/https://example.com/page")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	originalEndpoint := waybackEndpoint
+	waybackEndpoint = server.URL
+	defer func() { waybackEndpoint = originalEndpoint }()
+
+	snapshotURL, err := Save("https://example.com/page")
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/web/20240101000000/https://example.com/page", snapshotURL)
+
+	_, err = Save("https://example.com/missing")
+	require.Error(t, err)
+
+	_, err = Save("ftp://example.com/file")
+	require.Error(t, err)
+}
@@ -2,22 +2,19 @@ package webhook
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
-	"io"
 	"log/slog"
 	"net/http"
-	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/usememos/memos/plugin/outbound"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 )
 
-var (
-	// timeout is the timeout for webhook request. Default to 30 seconds.
-	timeout = 30 * time.Second
-)
-
 type WebhookRequestPayload struct {
 	// The target URL for the webhook request.
 	URL string `json:"url"`
@@ -27,37 +24,82 @@ type WebhookRequestPayload struct {
 	Creator string `json:"creator"`
 	// The memo that triggered this webhook (if applicable).
 	Memo *v1pb.Memo `json:"memo"`
+	// The memo before the update, only set for memo updated events.
+	PreviousMemo *v1pb.Memo `json:"previousMemo,omitempty"`
+	// The memo fields changed by the update, only set for memo updated events.
+	ChangedFields []string `json:"changedFields,omitempty"`
+	// The AI summary generation, only set for AI summary events.
+	AISummary *AISummaryPayload `json:"aiSummary,omitempty"`
+	// The secret used to sign the request body, not sent.
+	Secret string `json:"-"`
+}
+
+// AISummaryPayload describes the generation of an AI summary.
+type AISummaryPayload struct {
+	// The time range of the source memos, e.g. "7d" or "custom".
+	TimeRange string `json:"timeRange"`
+	// The dates of a custom time range, formatted as YYYY-MM-DD.
+	StartDate string `json:"startDate,omitempty"`
+	EndDate   string `json:"endDate,omitempty"`
+	// The tags the source memos are filtered by.
+	Tags []string `json:"tags,omitempty"`
+	// The number of memos the summary is generated from.
+	SourceMemoCount int `json:"sourceMemoCount"`
+	// The error the generation failed with, only set for failed events.
+	Error string `json:"error,omitempty"`
 }
 
+// SignatureHeader is the header carrying the HMAC-SHA256 hex digest of the request body
+// when the webhook has a secret.
+const SignatureHeader = "X-Memos-Signature"
+
 // Post posts the message to webhook endpoint.
 func Post(requestPayload *WebhookRequestPayload) error {
+	_, err := Send(requestPayload)
+	return err
+}
+
+// Send posts the message to webhook endpoint and returns the HTTP status code of the response,
+// 0 if no response was received.
+func Send(requestPayload *WebhookRequestPayload) (int, error) {
 	body, err := json.Marshal(requestPayload)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal webhook request to %s", requestPayload.URL)
+		return 0, errors.Wrapf(err, "failed to marshal webhook request to %s", requestPayload.URL)
 	}
+	return SendBody(requestPayload.URL, body, requestPayload.Secret)
+}
+
+// PostBody posts an already marshaled message to webhook endpoint, e.g. when retrying a failed webhook.
+func PostBody(url string, body []byte) error {
+	_, err := SendBody(url, body, "")
+	return err
+}
 
-	req, err := http.NewRequest("POST", requestPayload.URL, bytes.NewBuffer(body))
+// SendBody posts an already marshaled message to webhook endpoint, signing it with secret if set,
+// and returns the HTTP status code of the response, 0 if no response was received.
+func SendBody(url string, body []byte, secret string) (int, error) {
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook request to %s", requestPayload.URL)
+		return 0, errors.Wrapf(err, "failed to construct webhook request to %s", url)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: timeout,
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(body, secret))
 	}
-	resp, err := client.Do(req)
+	resp, err := outbound.NewClient().Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to post webhook to %s", requestPayload.URL)
+		return 0, errors.Wrapf(err, "failed to post webhook to %s", url)
 	}
 
-	b, err := io.ReadAll(resp.Body)
+	b, err := outbound.ReadBody(resp.Body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to read webhook response from %s", requestPayload.URL)
+		return resp.StatusCode, errors.Wrapf(err, "failed to read webhook response from %s", url)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return errors.Errorf("failed to post webhook %s, status code: %d, response body: %s", requestPayload.URL, resp.StatusCode, b)
+		return resp.StatusCode, errors.Errorf("failed to post webhook %s, status code: %d, response body: %s", url, resp.StatusCode, b)
 	}
 
 	response := &struct {
@@ -65,26 +107,37 @@ func Post(requestPayload *WebhookRequestPayload) error {
 		Message string `json:"message"`
 	}{}
 	if err := json.Unmarshal(b, response); err != nil {
-		return errors.Wrapf(err, "failed to unmarshal webhook response from %s", requestPayload.URL)
+		return resp.StatusCode, errors.Wrapf(err, "failed to unmarshal webhook response from %s", url)
 	}
 
 	if response.Code != 0 {
-		return errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", response.Code, response.Message)
+		return resp.StatusCode, errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", response.Code, response.Message)
 	}
 
-	return nil
+	return resp.StatusCode, nil
+}
+
+// Sign returns the "sha256=" prefixed HMAC-SHA256 hex digest of body.
+func Sign(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
 }
 
 // PostAsync posts the message to webhook endpoint asynchronously.
 // It spawns a new goroutine to handle the request and does not wait for the response.
-func PostAsync(requestPayload *WebhookRequestPayload) {
+// onResult, if set, is called with the status code of the response and the error of the request.
+func PostAsync(requestPayload *WebhookRequestPayload, onResult func(statusCode int, err error)) {
 	go func() {
-		if err := Post(requestPayload); err != nil {
-			// Since we're in a goroutine, we can only log the error
+		statusCode, err := Send(requestPayload)
+		if err != nil {
 			slog.Warn("Failed to dispatch webhook asynchronously",
 				slog.String("url", requestPayload.URL),
 				slog.String("activityType", requestPayload.ActivityType),
 				slog.Any("err", err))
 		}
+		if onResult != nil {
+			onResult(statusCode, err)
+		}
 	}()
 }
@@ -1 +1,39 @@
 package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// TestMain allows the requests to the test servers, listening on the loopback address.
+func TestMain(m *testing.M) {
+	outbound.SetPolicy(&outbound.Policy{AllowedHosts: []string{"127.0.0.1"}})
+	os.Exit(m.Run())
+}
+
+func TestSendBodySignature(t *testing.T) {
+	body := []byte(`{"activityType":"memos.memo.created"}`)
+	signatures := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signatures <- r.Header.Get(SignatureHeader)
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	statusCode, err := SendBody(server.URL, body, "secret")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "sha256=73e443ca0f7cbef7b8ad0d63f358880fac8d97faaa3887b2f644c624d4c98e38", <-signatures)
+
+	// Requests are not signed without a secret.
+	statusCode, err = SendBody(server.URL, body, "")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Empty(t, <-signatures)
+}
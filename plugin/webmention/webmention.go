@@ -0,0 +1,289 @@
+// Package webmention verifies the Webmentions received by the instance, see https://www.w3.org/TR/webmention/,
+// reading the response to the target in the microformats of the source.
+package webmention
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+
+	"github.com/usememos/memos/plugin/outbound"
+)
+
+// MaxContentLength is the maximum number of characters of the content of a mention.
+const MaxContentLength = 500
+
+// The types of the mentions, from the class of the link to the target.
+const (
+	TypeMention  = "mention"
+	TypeReply    = "reply"
+	TypeLike     = "like"
+	TypeRepost   = "repost"
+	TypeBookmark = "bookmark"
+)
+
+var (
+	// ErrGone is the error of a deleted source, whose mentions are to be deleted.
+	ErrGone = errors.New("the source is gone")
+	// ErrNoLink is the error of a source not linking to the target, whose mentions are to be deleted.
+	ErrNoLink = errors.New("the source does not link to the target")
+)
+
+// typeClasses maps the classes of the links to the target to the type of the mention.
+var typeClasses = map[string]string{
+	"u-in-reply-to": TypeReply,
+	"u-like-of":     TypeLike,
+	"u-repost-of":   TypeRepost,
+	"u-bookmark-of": TypeBookmark,
+}
+
+// Mention is the response to the target in the source.
+type Mention struct {
+	Type        string
+	AuthorName  string
+	AuthorURL   string
+	AuthorPhoto string
+	// Content is the text of the response, at most MaxContentLength characters.
+	Content string
+	// Published is the time the response was published, zero if unknown.
+	Published time.Time
+}
+
+// Verify fetches the source and returns its mention of the target. The requests are initiated by the server, so they
+// are restricted by the outbound policy.
+func Verify(ctx context.Context, source, target string) (*Mention, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to construct request to the source")
+	}
+	req.Header.Set("Accept", "text/html, */*;q=0.8")
+	resp, err := outbound.NewClient().Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request the source")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusGone {
+		return nil, ErrGone
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, errors.Errorf("failed to request the source, status code: %d", resp.StatusCode)
+	}
+	body, err := outbound.ReadBody(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the source")
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "" && mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		// The other documents only mention the target.
+		if !bytes.Contains(body, []byte(target)) {
+			return nil, ErrNoLink
+		}
+		return &Mention{Type: TypeMention}, nil
+	}
+	return Parse(bytes.NewReader(body), resp.Request.URL, target)
+}
+
+// Parse returns the mention of the target in the HTML page of the source, the response being the h-entry of the
+// page and its type the class of the link to the target.
+func Parse(r io.Reader, source *url.URL, target string) (*Mention, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the source")
+	}
+	targetURL := normalizeURL(source, target)
+
+	var link *html.Node
+	walk(doc, func(n *html.Node) bool {
+		for _, key := range []string{"href", "src"} {
+			if value, ok := getAttr(n, key); ok && normalizeURL(source, value) == targetURL {
+				link = n
+				return true
+			}
+		}
+		return false
+	})
+	if link == nil {
+		return nil, ErrNoLink
+	}
+
+	mention := &Mention{Type: TypeMention}
+	entry := findAncestor(link, "h-entry")
+	if entry == nil {
+		entry = find(doc, func(n *html.Node) bool { return hasClass(n, "h-entry") })
+	}
+	for n := link; n != nil && n != entry; n = n.Parent {
+		if mentionType := findTypeClass(n); mentionType != "" {
+			mention.Type = mentionType
+			break
+		}
+	}
+	if entry == nil {
+		if title := find(doc, func(n *html.Node) bool { return n.Type == html.ElementNode && n.Data == "title" }); title != nil {
+			mention.Content = truncate(textContent(title))
+		}
+		return mention, nil
+	}
+
+	if author := find(entry, func(n *html.Node) bool { return hasClass(n, "p-author") }); author != nil {
+		if hasClass(author, "h-card") {
+			if name := find(author, func(n *html.Node) bool { return hasClass(n, "p-name") }); name != nil {
+				mention.AuthorName = textContent(name)
+			} else {
+				mention.AuthorName = textContent(author)
+			}
+			if authorURL := find(author, func(n *html.Node) bool { return hasClass(n, "u-url") }); authorURL != nil {
+				mention.AuthorURL = getURL(source, authorURL, "href")
+			} else {
+				mention.AuthorURL = getURL(source, author, "href")
+			}
+			if photo := find(author, func(n *html.Node) bool { return hasClass(n, "u-photo") }); photo != nil {
+				mention.AuthorPhoto = getURL(source, photo, "src")
+			}
+		} else {
+			mention.AuthorName = textContent(author)
+			mention.AuthorURL = getURL(source, author, "href")
+		}
+	}
+	for _, class := range []string{"e-content", "p-content", "p-summary", "p-name"} {
+		if content := find(entry, func(n *html.Node) bool { return hasClass(n, class) && findAncestor(n, "h-card") == nil }); content != nil {
+			mention.Content = truncate(textContent(content))
+			break
+		}
+	}
+	if published := find(entry, func(n *html.Node) bool { return hasClass(n, "dt-published") }); published != nil {
+		value, ok := getAttr(published, "datetime")
+		if !ok {
+			value = textContent(published)
+		}
+		mention.Published = parseTime(value)
+	}
+	return mention, nil
+}
+
+// walk visits the nodes in document order until visit returns true.
+func walk(n *html.Node, visit func(*html.Node) bool) bool {
+	if visit(n) {
+		return true
+	}
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if walk(child, visit) {
+			return true
+		}
+	}
+	return false
+}
+
+// find returns the first node under n, n included, matching the predicate.
+func find(n *html.Node, match func(*html.Node) bool) *html.Node {
+	var found *html.Node
+	walk(n, func(n *html.Node) bool {
+		if match(n) {
+			found = n
+			return true
+		}
+		return false
+	})
+	return found
+}
+
+// findAncestor returns the closest ancestor of n, n included, with the class.
+func findAncestor(n *html.Node, class string) *html.Node {
+	for ; n != nil; n = n.Parent {
+		if hasClass(n, class) {
+			return n
+		}
+	}
+	return nil
+}
+
+func findTypeClass(n *html.Node) string {
+	class, _ := getAttr(n, "class")
+	for _, name := range strings.Fields(class) {
+		if mentionType, ok := typeClasses[name]; ok {
+			return mentionType
+		}
+	}
+	return ""
+}
+
+func hasClass(n *html.Node, class string) bool {
+	value, ok := getAttr(n, "class")
+	return ok && slices.Contains(strings.Fields(value), class)
+}
+
+func getAttr(n *html.Node, key string) (string, bool) {
+	if n.Type != html.ElementNode {
+		return "", false
+	}
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val, true
+		}
+	}
+	return "", false
+}
+
+// getURL returns the absolute URL of the attribute of n, empty if it has none.
+func getURL(source *url.URL, n *html.Node, key string) string {
+	value, ok := getAttr(n, key)
+	if !ok || strings.TrimSpace(value) == "" {
+		return ""
+	}
+	u, err := source.Parse(strings.TrimSpace(value))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	return u.String()
+}
+
+// normalizeURL returns the absolute URL without fragment, to compare the links to the target.
+func normalizeURL(source *url.URL, rawURL string) string {
+	u, err := source.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return ""
+	}
+	u.Fragment = ""
+	u.Host = strings.ToLower(u.Host)
+	return u.String()
+}
+
+// textContent returns the text of the node with its whitespace collapsed.
+func textContent(n *html.Node) string {
+	var builder strings.Builder
+	walk(n, func(n *html.Node) bool {
+		if n.Type == html.TextNode {
+			builder.WriteString(n.Data)
+			builder.WriteString(" ")
+		}
+		return false
+	})
+	return strings.Join(strings.Fields(builder.String()), " ")
+}
+
+func truncate(text string) string {
+	if utf8.RuneCountInString(text) <= MaxContentLength {
+		return text
+	}
+	return string([]rune(text)[:MaxContentLength-1]) + "…"
+}
+
+func parseTime(value string) time.Time {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05-0700", "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
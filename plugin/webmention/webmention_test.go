@@ -0,0 +1,55 @@
+package webmention
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	source, err := url.Parse("https://blog.example/posts/1")
+	require.NoError(t, err)
+	target := "https://memos.example/memos/abc"
+
+	t.Run("reply", func(t *testing.T) {
+		page := `<html><body><article class="h-entry">
+			<a class="p-author h-card" href="/"><img class="u-photo" src="/me.jpg"><span class="p-name">Alice</span></a>
+			<p>In reply to <a class="u-in-reply-to" href="https://memos.example/memos/abc#comments">a memo</a></p>
+			<div class="e-content"><p>Great   memo!</p></div>
+			<time class="dt-published" datetime="2025-06-01T10:00:00Z">June 1</time>
+		</article></body></html>`
+		mention, err := Parse(strings.NewReader(page), source, target)
+		require.NoError(t, err)
+		require.Equal(t, TypeReply, mention.Type)
+		require.Equal(t, "Alice", mention.AuthorName)
+		require.Equal(t, "https://blog.example/", mention.AuthorURL)
+		require.Equal(t, "https://blog.example/me.jpg", mention.AuthorPhoto)
+		require.Equal(t, "Great memo!", mention.Content)
+		require.Equal(t, time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC), mention.Published)
+	})
+
+	t.Run("like", func(t *testing.T) {
+		page := `<div class="h-entry"><span class="p-author">Bob</span> liked <a class="u-like-of" href="https://memos.example/memos/abc">this</a></div>`
+		mention, err := Parse(strings.NewReader(page), source, target)
+		require.NoError(t, err)
+		require.Equal(t, TypeLike, mention.Type)
+		require.Equal(t, "Bob", mention.AuthorName)
+	})
+
+	t.Run("mention without microformats", func(t *testing.T) {
+		page := `<html><head><title>A post</title></head><body><a href="https://memos.example/memos/abc">link</a></body></html>`
+		mention, err := Parse(strings.NewReader(page), source, target)
+		require.NoError(t, err)
+		require.Equal(t, TypeMention, mention.Type)
+		require.Equal(t, "A post", mention.Content)
+	})
+
+	t.Run("no link", func(t *testing.T) {
+		page := `<p>https://memos.example/memos/abc</p><a href="https://memos.example/memos/abcd">other</a>`
+		_, err := Parse(strings.NewReader(page), source, target)
+		require.ErrorIs(t, err, ErrNoLink)
+	})
+}
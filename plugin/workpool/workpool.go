@@ -0,0 +1,152 @@
+package workpool
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrBusy is returned when the queue of a pool is full, or when a task waited too long for a worker.
+var ErrBusy = errors.New("too many tasks in progress")
+
+// Pool runs a limited number of tasks at once, so that the expensive tasks cannot starve the others of CPU and
+// memory. The tasks over the limit wait in a queue of a limited length, ahead of the tasks coming in later, and the
+// tasks over the queue are rejected with ErrBusy.
+type Pool struct {
+	workers int
+	queue   int
+	// maxWait is how long a task acquired with Acquire waits in the queue, 0 for as long as its context.
+	maxWait time.Duration
+
+	mu       sync.Mutex
+	inFlight int
+	waiting  int
+	// released is closed, and replaced, when a worker is released, waking up the tasks waiting for one.
+	released chan struct{}
+}
+
+// NewPool returns a pool running at most workers tasks at once, with at most queue tasks waiting for a worker.
+func NewPool(workers, queue int, maxWait time.Duration) *Pool {
+	return &Pool{
+		workers: max(workers, 1),
+		queue:   max(queue, 0),
+		maxWait: maxWait,
+	}
+}
+
+// Acquire waits for a worker, returning ErrBusy if the queue is full or no worker frees up within the maximum wait,
+// or the error of the context if it is done first. The returned function releases the worker once the task is over.
+func (p *Pool) Acquire(ctx context.Context) (func(), error) {
+	acquired, err := p.enter()
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		var timeout <-chan time.Time
+		if p.maxWait > 0 {
+			timer := time.NewTimer(p.maxWait)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+		if err := p.wait(ctx, timeout); err != nil {
+			return nil, err
+		}
+	}
+	var once sync.Once
+	return func() { once.Do(p.release) }, nil
+}
+
+// Go runs the task in the background once a worker is free, returning ErrBusy without running it if the queue is
+// full. The task waits in the queue for as long as it takes.
+func (p *Pool) Go(task func()) error {
+	acquired, err := p.enter()
+	if err != nil {
+		return err
+	}
+	go func() {
+		if !acquired {
+			// The wait cannot fail without a context to cancel or a timeout.
+			_ = p.wait(context.Background(), nil)
+		}
+		defer p.release()
+		task()
+	}()
+	return nil
+}
+
+// Stats returns the number of tasks running and waiting for a worker.
+func (p *Pool) Stats() (inFlight int, waiting int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.inFlight, p.waiting
+}
+
+// enter takes a worker if one is free and no task is waiting, or a place in the queue otherwise.
+func (p *Pool) enter() (acquired bool, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.inFlight < p.workers && p.waiting == 0 {
+		p.inFlight++
+		return true, nil
+	}
+	if p.waiting >= p.queue {
+		return false, ErrBusy
+	}
+	p.waiting++
+	return false, nil
+}
+
+// wait waits in the queue until a worker is free, the context is done or the timeout fires, leaving the queue.
+func (p *Pool) wait(ctx context.Context, timeout <-chan time.Time) error {
+	for {
+		p.mu.Lock()
+		if p.inFlight < p.workers {
+			p.inFlight++
+			p.waiting--
+			p.mu.Unlock()
+			return nil
+		}
+		if p.released == nil {
+			p.released = make(chan struct{})
+		}
+		released := p.released
+		p.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			p.leave()
+			return ctx.Err()
+		case <-timeout:
+			p.leave()
+			return ErrBusy
+		}
+	}
+}
+
+// leave removes a task from the queue without running it.
+func (p *Pool) leave() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.waiting--
+	// The task may have been woken up for a worker it does not take.
+	p.wake()
+}
+
+// release releases the worker of a task.
+func (p *Pool) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.inFlight--
+	p.wake()
+}
+
+// wake wakes up the tasks waiting for a worker. The lock must be held.
+func (p *Pool) wake() {
+	if p.released != nil {
+		close(p.released)
+		p.released = nil
+	}
+}
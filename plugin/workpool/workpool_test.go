@@ -0,0 +1,77 @@
+package workpool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolAcquire(t *testing.T) {
+	pool := NewPool(1, 1, 50*time.Millisecond)
+
+	release, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+
+	// The task over the limit waits in the queue, and fails once no worker frees up in time.
+	_, err = pool.Acquire(context.Background())
+	require.ErrorIs(t, err, ErrBusy)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = pool.Acquire(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	inFlight, waiting := pool.Stats()
+	require.Equal(t, 1, inFlight)
+	require.Zero(t, waiting)
+
+	// A waiting task goes through once the worker is released, and the queue is full meanwhile.
+	pool.maxWait = time.Minute
+	acquired := make(chan func())
+	go func() {
+		release, err := pool.Acquire(context.Background())
+		require.NoError(t, err)
+		acquired <- release
+	}()
+	require.Eventually(t, func() bool {
+		_, waiting := pool.Stats()
+		return waiting == 1
+	}, time.Second, time.Millisecond)
+	_, err = pool.Acquire(context.Background())
+	require.ErrorIs(t, err, ErrBusy)
+
+	release()
+	// Releasing twice releases once.
+	release()
+	next := <-acquired
+	inFlight, waiting = pool.Stats()
+	require.Equal(t, 1, inFlight)
+	require.Zero(t, waiting)
+	next()
+}
+
+func TestPoolGo(t *testing.T) {
+	pool := NewPool(1, 1, 0)
+	started := make(chan int, 2)
+	finish := make(chan struct{})
+	task := func(index int) func() {
+		return func() {
+			started <- index
+			<-finish
+		}
+	}
+
+	require.NoError(t, pool.Go(task(1)))
+	require.Equal(t, 1, <-started)
+	require.NoError(t, pool.Go(task(2)))
+	require.ErrorIs(t, pool.Go(task(3)), ErrBusy)
+
+	// The queued task runs once the first one is over.
+	finish <- struct{}{}
+	require.Equal(t, 2, <-started)
+	finish <- struct{}{}
+	require.Eventually(t, func() bool {
+		inFlight, waiting := pool.Stats()
+		return inFlight == 0 && waiting == 0
+	}, time.Second, time.Millisecond)
+}
@@ -8,9 +8,12 @@ package apiv1
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/emptypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,12 +26,188 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The transformation of the memo content.
+type TransformMemoRequest_Action int32
+
+const (
+	TransformMemoRequest_ACTION_UNSPECIFIED TransformMemoRequest_Action = 0
+	// Translates the content to the target language.
+	TransformMemoRequest_TRANSLATE TransformMemoRequest_Action = 1
+	// Fixes the grammar and spelling of the content.
+	TransformMemoRequest_FIX_GRAMMAR TransformMemoRequest_Action = 2
+	// Expands the content with more detail.
+	TransformMemoRequest_EXPAND TransformMemoRequest_Action = 3
+	// Condenses the content to its essentials.
+	TransformMemoRequest_CONDENSE TransformMemoRequest_Action = 4
+)
+
+// Enum value maps for TransformMemoRequest_Action.
+var (
+	TransformMemoRequest_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "TRANSLATE",
+		2: "FIX_GRAMMAR",
+		3: "EXPAND",
+		4: "CONDENSE",
+	}
+	TransformMemoRequest_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"TRANSLATE":          1,
+		"FIX_GRAMMAR":        2,
+		"EXPAND":             3,
+		"CONDENSE":           4,
+	}
+)
+
+func (x TransformMemoRequest_Action) Enum() *TransformMemoRequest_Action {
+	p := new(TransformMemoRequest_Action)
+	*p = x
+	return p
+}
+
+func (x TransformMemoRequest_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransformMemoRequest_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_ai_service_proto_enumTypes[0].Descriptor()
+}
+
+func (TransformMemoRequest_Action) Type() protoreflect.EnumType {
+	return &file_api_v1_ai_service_proto_enumTypes[0]
+}
+
+func (x TransformMemoRequest_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransformMemoRequest_Action.Descriptor instead.
+func (TransformMemoRequest_Action) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{13, 0}
+}
+
+// The state of the circuit breaker guarding the calls to the provider.
+type AIProviderStatus_CircuitState int32
+
+const (
+	AIProviderStatus_CIRCUIT_STATE_UNSPECIFIED AIProviderStatus_CircuitState = 0
+	// The calls go through.
+	AIProviderStatus_CLOSED AIProviderStatus_CircuitState = 1
+	// The provider is unhealthy, the AI requests fail fast until the retry time.
+	AIProviderStatus_OPEN AIProviderStatus_CircuitState = 2
+	// A trial call is let through to find out whether the provider recovered.
+	AIProviderStatus_HALF_OPEN AIProviderStatus_CircuitState = 3
+)
+
+// Enum value maps for AIProviderStatus_CircuitState.
+var (
+	AIProviderStatus_CircuitState_name = map[int32]string{
+		0: "CIRCUIT_STATE_UNSPECIFIED",
+		1: "CLOSED",
+		2: "OPEN",
+		3: "HALF_OPEN",
+	}
+	AIProviderStatus_CircuitState_value = map[string]int32{
+		"CIRCUIT_STATE_UNSPECIFIED": 0,
+		"CLOSED":                    1,
+		"OPEN":                      2,
+		"HALF_OPEN":                 3,
+	}
+)
+
+func (x AIProviderStatus_CircuitState) Enum() *AIProviderStatus_CircuitState {
+	p := new(AIProviderStatus_CircuitState)
+	*p = x
+	return p
+}
+
+func (x AIProviderStatus_CircuitState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AIProviderStatus_CircuitState) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_ai_service_proto_enumTypes[1].Descriptor()
+}
+
+func (AIProviderStatus_CircuitState) Type() protoreflect.EnumType {
+	return &file_api_v1_ai_service_proto_enumTypes[1]
+}
+
+func (x AIProviderStatus_CircuitState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AIProviderStatus_CircuitState.Descriptor instead.
+func (AIProviderStatus_CircuitState) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{18, 0}
+}
+
+type AIJob_State int32
+
+const (
+	AIJob_STATE_UNSPECIFIED AIJob_State = 0
+	// The job waits for the worker.
+	AIJob_QUEUED AIJob_State = 1
+	// The job is being processed.
+	AIJob_RUNNING AIJob_State = 2
+	// The job succeeded.
+	AIJob_DONE AIJob_State = 3
+	// The job failed.
+	AIJob_FAILED AIJob_State = 4
+)
+
+// Enum value maps for AIJob_State.
+var (
+	AIJob_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "QUEUED",
+		2: "RUNNING",
+		3: "DONE",
+		4: "FAILED",
+	}
+	AIJob_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"QUEUED":            1,
+		"RUNNING":           2,
+		"DONE":              3,
+		"FAILED":            4,
+	}
+)
+
+func (x AIJob_State) Enum() *AIJob_State {
+	p := new(AIJob_State)
+	*p = x
+	return p
+}
+
+func (x AIJob_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AIJob_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_ai_service_proto_enumTypes[2].Descriptor()
+}
+
+func (AIJob_State) Type() protoreflect.EnumType {
+	return &file_api_v1_ai_service_proto_enumTypes[2]
+}
+
+func (x AIJob_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AIJob_State.Descriptor instead.
+func (AIJob_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{50, 0}
+}
+
 // Request message for GenerateAISummary method.
 type GenerateAISummaryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The time range for selecting source memos.
 	// Supported values: "7d", "30d", "90d", "custom"
 	// If "custom" is specified, start_date and end_date must be provided.
+	// Optional when memo_names or filter is set, narrowing the memos they select.
 	TimeRange string `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
 	// Optional. Tags to filter source memos.
 	// Only memos containing these tags will be included in the summary.
@@ -40,7 +219,30 @@ type GenerateAISummaryRequest struct {
 	// Optional. The end date for custom time range.
 	// Format: YYYY-MM-DD
 	// Required when time_range is "custom".
-	EndDate       string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	EndDate string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Optional. Whether the summary highlights what changed versus the previous period, such as the
+	// new topics and the items resolved since. The memos of the previous period are the source memos,
+	// preceding the time range, of the latest AI summary created before its end, or the memos of the
+	// period of the same length preceding it if there are none.
+	ComparePrevious bool `protobuf:"varint,5,opt,name=compare_previous,json=comparePrevious,proto3" json:"compare_previous,omitempty"`
+	// Optional. The name of the prompt template used instead of the system prompt of the workspace AI setting:
+	// the template of the current user with that name, or else the workspace template with that name.
+	PromptTemplate string `protobuf:"bytes,6,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
+	// Optional. The names of the memos of the current user to summarize, instead of all the memos of the time range.
+	// Format: memos/{memo}
+	MemoNames []string `protobuf:"bytes,7,rep,name=memo_names,json=memoNames,proto3" json:"memo_names,omitempty"`
+	// Optional. The CEL filter of the memos of the current user to summarize, with the syntax of ListMemos,
+	// e.g. `pinned && tag in ["project-x"]`.
+	Filter string `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. A key chosen by the client, e.g. a UUID, identifying the request among its retries, up to 255
+	// characters. A request with the key of a request of the current user in the last 24 hours returns the memo of that
+	// request instead of generating another one, without counting against the rate limit. The parameters of the request
+	// must be the same: INVALID_ARGUMENT is returned otherwise, and ABORTED while the first request is in progress.
+	IdempotencyKey string `protobuf:"bytes,9,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Optional. The IANA timezone of the dates of the custom time range, e.g. "Europe/Paris". UTC when empty.
+	Timezone string `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Optional. The visibility of the summary memo. PRIVATE when unspecified.
+	Visibility    Visibility `protobuf:"varint,11,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -103,27 +305,79 @@ func (x *GenerateAISummaryRequest) GetEndDate() string {
 	return ""
 }
 
-// Request message for TestAIConfig method.
-type TestAIConfigRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GenerateAISummaryRequest) GetComparePrevious() bool {
+	if x != nil {
+		return x.ComparePrevious
+	}
+	return false
 }
 
-func (x *TestAIConfigRequest) Reset() {
-	*x = TestAIConfigRequest{}
+func (x *GenerateAISummaryRequest) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *GenerateAISummaryRequest) GetMemoNames() []string {
+	if x != nil {
+		return x.MemoNames
+	}
+	return nil
+}
+
+func (x *GenerateAISummaryRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GenerateAISummaryRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *GenerateAISummaryRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GenerateAISummaryRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+type SummarizeTodayRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The IANA timezone of the day, e.g. "Europe/Paris". The timezone of the user when empty.
+	Timezone string `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Optional. A key chosen by the client identifying the request among its retries, as for GenerateAISummary.
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SummarizeTodayRequest) Reset() {
+	*x = SummarizeTodayRequest{}
 	mi := &file_api_v1_ai_service_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestAIConfigRequest) String() string {
+func (x *SummarizeTodayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestAIConfigRequest) ProtoMessage() {}
+func (*SummarizeTodayRequest) ProtoMessage() {}
 
-func (x *TestAIConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *SummarizeTodayRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_ai_service_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -135,38 +389,62 @@ func (x *TestAIConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestAIConfigRequest.ProtoReflect.Descriptor instead.
-func (*TestAIConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SummarizeTodayRequest.ProtoReflect.Descriptor instead.
+func (*SummarizeTodayRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{1}
 }
 
-// Response message for TestAIConfig method.
-type TestAIConfigResponse struct {
+func (x *SummarizeTodayRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SummarizeTodayRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type GenerateWorkspaceAISummaryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the AI configuration test was successful.
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Optional. Error message if the test failed.
-	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	// Optional. Additional details about the test result.
-	Details       string `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	// The time range for selecting source memos.
+	// Supported values: "7d", "30d", "90d", "custom"
+	// If "custom" is specified, start_date and end_date must be provided.
+	TimeRange string `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// Optional. Tags to filter source memos.
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Optional. The start date for custom time range.
+	// Format: YYYY-MM-DD
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Optional. The end date for custom time range.
+	// Format: YYYY-MM-DD
+	EndDate string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Optional. The visibilities of the source memos, PUBLIC or PROTECTED. Both when empty.
+	SourceVisibilities []Visibility `protobuf:"varint,5,rep,packed,name=source_visibilities,json=sourceVisibilities,proto3,enum=memos.api.v1.Visibility" json:"source_visibilities,omitempty"`
+	// Optional. The visibility of the summary memo, PUBLIC or PROTECTED. PROTECTED when unspecified.
+	// A public summary may only cover public memos.
+	Visibility    Visibility `protobuf:"varint,6,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TestAIConfigResponse) Reset() {
-	*x = TestAIConfigResponse{}
+func (x *GenerateWorkspaceAISummaryRequest) Reset() {
+	*x = GenerateWorkspaceAISummaryRequest{}
 	mi := &file_api_v1_ai_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestAIConfigResponse) String() string {
+func (x *GenerateWorkspaceAISummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestAIConfigResponse) ProtoMessage() {}
+func (*GenerateWorkspaceAISummaryRequest) ProtoMessage() {}
 
-func (x *TestAIConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *GenerateWorkspaceAISummaryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_ai_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -178,64 +456,78 @@ func (x *TestAIConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestAIConfigResponse.ProtoReflect.Descriptor instead.
-func (*TestAIConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenerateWorkspaceAISummaryRequest.ProtoReflect.Descriptor instead.
+func (*GenerateWorkspaceAISummaryRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *TestAIConfigResponse) GetSuccess() bool {
+func (x *GenerateWorkspaceAISummaryRequest) GetTimeRange() string {
 	if x != nil {
-		return x.Success
+		return x.TimeRange
 	}
-	return false
+	return ""
 }
 
-func (x *TestAIConfigResponse) GetErrorMessage() string {
+func (x *GenerateWorkspaceAISummaryRequest) GetTags() []string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GenerateWorkspaceAISummaryRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *TestAIConfigResponse) GetDetails() string {
+func (x *GenerateWorkspaceAISummaryRequest) GetEndDate() string {
 	if x != nil {
-		return x.Details
+		return x.EndDate
 	}
 	return ""
 }
 
-// Request message for GetMemoSourceMemos method.
-type GetMemoSourceMemosRequest struct {
+func (x *GenerateWorkspaceAISummaryRequest) GetSourceVisibilities() []Visibility {
+	if x != nil {
+		return x.SourceVisibilities
+	}
+	return nil
+}
+
+func (x *GenerateWorkspaceAISummaryRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+// Response message for StreamAISummary method.
+type StreamAISummaryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the AI memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of source memos to return.
-	// The service may return fewer than this value.
-	// If unspecified, at most 50 memos will be returned.
-	// The maximum value is 100; values above 100 will be coerced to 100.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token, received from a previous `GetMemoSourceMemos` call.
-	// Provide this to retrieve the subsequent page.
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The summary text generated since the previous message.
+	Delta string `protobuf:"bytes,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	// The created AI memo, only set on the last message.
+	Memo          *Memo `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemoSourceMemosRequest) Reset() {
-	*x = GetMemoSourceMemosRequest{}
+func (x *StreamAISummaryResponse) Reset() {
+	*x = StreamAISummaryResponse{}
 	mi := &file_api_v1_ai_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemoSourceMemosRequest) String() string {
+func (x *StreamAISummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemoSourceMemosRequest) ProtoMessage() {}
+func (*StreamAISummaryResponse) ProtoMessage() {}
 
-func (x *GetMemoSourceMemosRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamAISummaryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_ai_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -247,60 +539,60 @@ func (x *GetMemoSourceMemosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemoSourceMemosRequest.ProtoReflect.Descriptor instead.
-func (*GetMemoSourceMemosRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamAISummaryResponse.ProtoReflect.Descriptor instead.
+func (*StreamAISummaryResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetMemoSourceMemosRequest) GetName() string {
+func (x *StreamAISummaryResponse) GetDelta() string {
 	if x != nil {
-		return x.Name
+		return x.Delta
 	}
 	return ""
 }
 
-func (x *GetMemoSourceMemosRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *GetMemoSourceMemosRequest) GetPageToken() string {
+func (x *StreamAISummaryResponse) GetMemo() *Memo {
 	if x != nil {
-		return x.PageToken
+		return x.Memo
 	}
-	return ""
+	return nil
 }
 
-// Response message for GetMemoSourceMemos method.
-type GetMemoSourceMemosResponse struct {
+// AISummaryPreview is what GenerateAISummary would send to the AI provider.
+type AISummaryPreview struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of source memos that were used to generate the AI summary.
-	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
-	// A token that can be sent as `page_token` to retrieve the next page.
-	// If this field is omitted, there are no subsequent pages.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of source memos (may be approximate).
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The prompt sent to the AI provider, including the system prompt.
+	Prompt string `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	// The source memos the summary would be generated from.
+	// Format: memos/{memo}
+	SourceMemos []string `protobuf:"bytes,2,rep,name=source_memos,json=sourceMemos,proto3" json:"source_memos,omitempty"`
+	// The estimated number of prompt tokens.
+	EstimatedPromptTokens int32 `protobuf:"varint,3,opt,name=estimated_prompt_tokens,json=estimatedPromptTokens,proto3" json:"estimated_prompt_tokens,omitempty"`
+	// The estimated maximum number of completion tokens.
+	EstimatedCompletionTokens int32 `protobuf:"varint,4,opt,name=estimated_completion_tokens,json=estimatedCompletionTokens,proto3" json:"estimated_completion_tokens,omitempty"`
+	// The estimated maximum cost in the currency of the AI provider prices, 0 when the prices are not configured.
+	EstimatedCost float64 `protobuf:"fixed64,5,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`
+	// The number of chunks the source memos are summarized in before the summary, 0 when they fit in a single
+	// request. The prompt then lists the prompts of the chunks, and the estimates include their requests.
+	ChunkCount    int32 `protobuf:"varint,6,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemoSourceMemosResponse) Reset() {
-	*x = GetMemoSourceMemosResponse{}
+func (x *AISummaryPreview) Reset() {
+	*x = AISummaryPreview{}
 	mi := &file_api_v1_ai_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemoSourceMemosResponse) String() string {
+func (x *AISummaryPreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemoSourceMemosResponse) ProtoMessage() {}
+func (*AISummaryPreview) ProtoMessage() {}
 
-func (x *GetMemoSourceMemosResponse) ProtoReflect() protoreflect.Message {
+func (x *AISummaryPreview) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_ai_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -312,64 +604,4283 @@ func (x *GetMemoSourceMemosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemoSourceMemosResponse.ProtoReflect.Descriptor instead.
-func (*GetMemoSourceMemosResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AISummaryPreview.ProtoReflect.Descriptor instead.
+func (*AISummaryPreview) Descriptor() ([]byte, []int) {
 	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetMemoSourceMemosResponse) GetMemos() []*Memo {
+func (x *AISummaryPreview) GetPrompt() string {
 	if x != nil {
-		return x.Memos
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *AISummaryPreview) GetSourceMemos() []string {
+	if x != nil {
+		return x.SourceMemos
 	}
 	return nil
 }
 
-func (x *GetMemoSourceMemosResponse) GetNextPageToken() string {
+func (x *AISummaryPreview) GetEstimatedPromptTokens() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.EstimatedPromptTokens
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMemoSourceMemosResponse) GetTotalSize() int32 {
+func (x *AISummaryPreview) GetEstimatedCompletionTokens() int32 {
 	if x != nil {
-		return x.TotalSize
+		return x.EstimatedCompletionTokens
 	}
 	return 0
 }
 
-var File_api_v1_ai_service_proto protoreflect.FileDescriptor
+func (x *AISummaryPreview) GetEstimatedCost() float64 {
+	if x != nil {
+		return x.EstimatedCost
+	}
+	return 0
+}
 
-const file_api_v1_ai_service_proto_rawDesc = "" +
-	"\n" +
-	"\x17api/v1/ai_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/memo_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x9b\x01\n" +
-	"\x18GenerateAISummaryRequest\x12\"\n" +
-	"\n" +
-	"time_range\x18\x01 \x01(\tB\x03\xe0A\x02R\ttimeRange\x12\x17\n" +
-	"\x04tags\x18\x02 \x03(\tB\x03\xe0A\x01R\x04tags\x12\"\n" +
-	"\n" +
-	"start_date\x18\x03 \x01(\tB\x03\xe0A\x01R\tstartDate\x12\x1e\n" +
-	"\bend_date\x18\x04 \x01(\tB\x03\xe0A\x01R\aendDate\"\x15\n" +
-	"\x13TestAIConfigRequest\"y\n" +
-	"\x14TestAIConfigResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12(\n" +
-	"\rerror_message\x18\x02 \x01(\tB\x03\xe0A\x01R\ferrorMessage\x12\x1d\n" +
-	"\adetails\x18\x03 \x01(\tB\x03\xe0A\x01R\adetails\"\x90\x01\n" +
-	"\x19GetMemoSourceMemosRequest\x12-\n" +
-	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
-	"\x11memos.api.v1/MemoR\x04name\x12 \n" +
-	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
-	"\n" +
-	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x8d\x01\n" +
-	"\x1aGetMemoSourceMemosResponse\x12(\n" +
+func (x *AISummaryPreview) GetChunkCount() int32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+// Request message for ChatWithMemos method.
+type ChatWithMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The question about the memos.
+	Question string `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	// Optional. The ID of the conversation to continue, as returned by a previous call.
+	// A new conversation is started when empty.
+	ConversationId string `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	// Optional. Tags restricting the memos the answer is grounded in.
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Optional. The first day of the memos the answer is grounded in.
+	// Format: YYYY-MM-DD
+	StartDate string `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Optional. The last day of the memos the answer is grounded in.
+	// Format: YYYY-MM-DD
+	EndDate       string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatWithMemosRequest) Reset() {
+	*x = ChatWithMemosRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatWithMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatWithMemosRequest) ProtoMessage() {}
+
+func (x *ChatWithMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatWithMemosRequest.ProtoReflect.Descriptor instead.
+func (*ChatWithMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChatWithMemosRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *ChatWithMemosRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ChatWithMemosRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ChatWithMemosRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ChatWithMemosRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// Response message for ChatWithMemos method.
+type ChatWithMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the conversation, to continue it with follow-up questions.
+	ConversationId string `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	// The answer, citing the memos with their resource names in brackets, e.g. [memos/abc].
+	Answer string `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	// The memos cited by the answer.
+	// Format: memos/{memo}
+	Citations     []string `protobuf:"bytes,3,rep,name=citations,proto3" json:"citations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatWithMemosResponse) Reset() {
+	*x = ChatWithMemosResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatWithMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatWithMemosResponse) ProtoMessage() {}
+
+func (x *ChatWithMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatWithMemosResponse.ProtoReflect.Descriptor instead.
+func (*ChatWithMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChatWithMemosResponse) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ChatWithMemosResponse) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *ChatWithMemosResponse) GetCitations() []string {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
+// Request message for SuggestTagMerges method.
+type SuggestTagMergesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The minimum similarity of two tags, from 0 to 1, for them to be merged. 0.85 by default.
+	SimilarityThreshold float32 `protobuf:"fixed32,1,opt,name=similarity_threshold,json=similarityThreshold,proto3" json:"similarity_threshold,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SuggestTagMergesRequest) Reset() {
+	*x = SuggestTagMergesRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTagMergesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagMergesRequest) ProtoMessage() {}
+
+func (x *SuggestTagMergesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagMergesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestTagMergesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SuggestTagMergesRequest) GetSimilarityThreshold() float32 {
+	if x != nil {
+		return x.SimilarityThreshold
+	}
+	return 0
+}
+
+// Response message for SuggestTagMerges method.
+type SuggestTagMergesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The suggestions, the most similar tags first.
+	Suggestions   []*SuggestTagMergesResponse_Suggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTagMergesResponse) Reset() {
+	*x = SuggestTagMergesResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTagMergesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagMergesResponse) ProtoMessage() {}
+
+func (x *SuggestTagMergesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagMergesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestTagMergesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SuggestTagMergesResponse) GetSuggestions() []*SuggestTagMergesResponse_Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// Request message for SuggestMemoTags method.
+type SuggestMemoTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The content of the memo, e.g. while it is written.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Optional. The maximum number of suggestions. 5 by default, at most 10.
+	MaxSuggestions int32 `protobuf:"varint,2,opt,name=max_suggestions,json=maxSuggestions,proto3" json:"max_suggestions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SuggestMemoTagsRequest) Reset() {
+	*x = SuggestMemoTagsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestMemoTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestMemoTagsRequest) ProtoMessage() {}
+
+func (x *SuggestMemoTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestMemoTagsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestMemoTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SuggestMemoTagsRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SuggestMemoTagsRequest) GetMaxSuggestions() int32 {
+	if x != nil {
+		return x.MaxSuggestions
+	}
+	return 0
+}
+
+// Response message for SuggestMemoTags method.
+type SuggestMemoTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The suggestions, the tags the user already uses first, then the most relevant first.
+	Suggestions   []*SuggestMemoTagsResponse_Suggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestMemoTagsResponse) Reset() {
+	*x = SuggestMemoTagsResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestMemoTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestMemoTagsResponse) ProtoMessage() {}
+
+func (x *SuggestMemoTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestMemoTagsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestMemoTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SuggestMemoTagsResponse) GetSuggestions() []*SuggestMemoTagsResponse_Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// Request message for GenerateMemoInsights method. The source memos are selected like the ones of GenerateAISummary.
+type GenerateMemoInsightsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time range for selecting source memos.
+	// Supported values: "7d", "30d", "90d", "custom"
+	// If "custom" is specified, start_date and end_date must be provided.
+	// Optional when memo_names or filter is set, narrowing the memos they select.
+	TimeRange string `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// Optional. Tags to filter source memos.
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Optional. The start date for custom time range.
+	// Format: YYYY-MM-DD
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Optional. The end date for custom time range.
+	// Format: YYYY-MM-DD
+	EndDate string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Optional. The names of the memos of the current user to analyze, instead of all the memos of the time range.
+	// Format: memos/{memo}
+	MemoNames []string `protobuf:"bytes,5,rep,name=memo_names,json=memoNames,proto3" json:"memo_names,omitempty"`
+	// Optional. The CEL filter of the memos of the current user to analyze, with the syntax of ListMemos.
+	Filter        string `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateMemoInsightsRequest) Reset() {
+	*x = GenerateMemoInsightsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateMemoInsightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateMemoInsightsRequest) ProtoMessage() {}
+
+func (x *GenerateMemoInsightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateMemoInsightsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateMemoInsightsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GenerateMemoInsightsRequest) GetTimeRange() string {
+	if x != nil {
+		return x.TimeRange
+	}
+	return ""
+}
+
+func (x *GenerateMemoInsightsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GenerateMemoInsightsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GenerateMemoInsightsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GenerateMemoInsightsRequest) GetMemoNames() []string {
+	if x != nil {
+		return x.MemoNames
+	}
+	return nil
+}
+
+func (x *GenerateMemoInsightsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+// MemoInsights is the structured content extracted from memos. The items reference the names of their source memos.
+type MemoInsights struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	ActionItems   []*MemoInsights_ActionItem   `protobuf:"bytes,1,rep,name=action_items,json=actionItems,proto3" json:"action_items,omitempty"`
+	Decisions     []*MemoInsights_Decision     `protobuf:"bytes,2,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	OpenQuestions []*MemoInsights_OpenQuestion `protobuf:"bytes,3,rep,name=open_questions,json=openQuestions,proto3" json:"open_questions,omitempty"`
+	Topics        []*MemoInsights_Topic        `protobuf:"bytes,4,rep,name=topics,proto3" json:"topics,omitempty"`
+	// The names of the memos the insights were extracted from.
+	// Format: memos/{memo}
+	SourceMemos   []string `protobuf:"bytes,5,rep,name=source_memos,json=sourceMemos,proto3" json:"source_memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoInsights) Reset() {
+	*x = MemoInsights{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoInsights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoInsights) ProtoMessage() {}
+
+func (x *MemoInsights) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoInsights.ProtoReflect.Descriptor instead.
+func (*MemoInsights) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MemoInsights) GetActionItems() []*MemoInsights_ActionItem {
+	if x != nil {
+		return x.ActionItems
+	}
+	return nil
+}
+
+func (x *MemoInsights) GetDecisions() []*MemoInsights_Decision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+func (x *MemoInsights) GetOpenQuestions() []*MemoInsights_OpenQuestion {
+	if x != nil {
+		return x.OpenQuestions
+	}
+	return nil
+}
+
+func (x *MemoInsights) GetTopics() []*MemoInsights_Topic {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *MemoInsights) GetSourceMemos() []string {
+	if x != nil {
+		return x.SourceMemos
+	}
+	return nil
+}
+
+// Request message for TransformMemo method.
+type TransformMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name   string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Action TransformMemoRequest_Action `protobuf:"varint,2,opt,name=action,proto3,enum=memos.api.v1.TransformMemoRequest_Action" json:"action,omitempty"`
+	// The language the content is translated to as a BCP 47 tag, e.g. "en" or "pt-BR".
+	// Required for TRANSLATE.
+	TargetLanguage string `protobuf:"bytes,3,opt,name=target_language,json=targetLanguage,proto3" json:"target_language,omitempty"`
+	// Optional. Whether the result is saved as a new memo, with the visibility of the original memo: a translation
+	// of it for TRANSLATE, a revision of it otherwise.
+	CreateRevision bool `protobuf:"varint,4,opt,name=create_revision,json=createRevision,proto3" json:"create_revision,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransformMemoRequest) Reset() {
+	*x = TransformMemoRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformMemoRequest) ProtoMessage() {}
+
+func (x *TransformMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformMemoRequest.ProtoReflect.Descriptor instead.
+func (*TransformMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TransformMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransformMemoRequest) GetAction() TransformMemoRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return TransformMemoRequest_ACTION_UNSPECIFIED
+}
+
+func (x *TransformMemoRequest) GetTargetLanguage() string {
+	if x != nil {
+		return x.TargetLanguage
+	}
+	return ""
+}
+
+func (x *TransformMemoRequest) GetCreateRevision() bool {
+	if x != nil {
+		return x.CreateRevision
+	}
+	return false
+}
+
+// Response message for TransformMemo method.
+type TransformMemoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The transformed content.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// The memo the result is saved as, if create_revision was requested.
+	Memo          *Memo `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransformMemoResponse) Reset() {
+	*x = TransformMemoResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformMemoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformMemoResponse) ProtoMessage() {}
+
+func (x *TransformMemoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformMemoResponse.ProtoReflect.Descriptor instead.
+func (*TransformMemoResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TransformMemoResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TransformMemoResponse) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+// Request message for GetAIUsage method.
+type GetAIUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAIUsageRequest) Reset() {
+	*x = GetAIUsageRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAIUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAIUsageRequest) ProtoMessage() {}
+
+func (x *GetAIUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAIUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetAIUsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{15}
+}
+
+// The AI requests of a user against the rate limits of their role.
+type AIUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The requests of the current hour.
+	Hourly *AIUsage_Window `protobuf:"bytes,1,opt,name=hourly,proto3" json:"hourly,omitempty"`
+	// The requests of the current day, in UTC.
+	Daily *AIUsage_Window `protobuf:"bytes,2,opt,name=daily,proto3" json:"daily,omitempty"`
+	// The maximum number of AI tokens in the current UTC month, 0 if there is no budget.
+	MonthlyTokenBudget int64 `protobuf:"varint,3,opt,name=monthly_token_budget,json=monthlyTokenBudget,proto3" json:"monthly_token_budget,omitempty"`
+	// The number of AI tokens used in the current UTC month.
+	MonthlyTokensUsed int64 `protobuf:"varint,4,opt,name=monthly_tokens_used,json=monthlyTokensUsed,proto3" json:"monthly_tokens_used,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AIUsage) Reset() {
+	*x = AIUsage{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIUsage) ProtoMessage() {}
+
+func (x *AIUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIUsage.ProtoReflect.Descriptor instead.
+func (*AIUsage) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AIUsage) GetHourly() *AIUsage_Window {
+	if x != nil {
+		return x.Hourly
+	}
+	return nil
+}
+
+func (x *AIUsage) GetDaily() *AIUsage_Window {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *AIUsage) GetMonthlyTokenBudget() int64 {
+	if x != nil {
+		return x.MonthlyTokenBudget
+	}
+	return 0
+}
+
+func (x *AIUsage) GetMonthlyTokensUsed() int64 {
+	if x != nil {
+		return x.MonthlyTokensUsed
+	}
+	return 0
+}
+
+// Request message for GetAIProviderStatus method.
+type GetAIProviderStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The name of the AI profile whose provider to return the health of,
+	// the default provider configuration when empty.
+	Profile       string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAIProviderStatusRequest) Reset() {
+	*x = GetAIProviderStatusRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAIProviderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAIProviderStatusRequest) ProtoMessage() {}
+
+func (x *GetAIProviderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAIProviderStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetAIProviderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAIProviderStatusRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+// The health of the AI provider, from the calls of the last minutes.
+type AIProviderStatus struct {
+	state        protoimpl.MessageState        `protogen:"open.v1"`
+	CircuitState AIProviderStatus_CircuitState `protobuf:"varint,1,opt,name=circuit_state,json=circuitState,proto3,enum=memos.api.v1.AIProviderStatus_CircuitState" json:"circuit_state,omitempty"`
+	// The number of recent calls.
+	RecentCalls int32 `protobuf:"varint,2,opt,name=recent_calls,json=recentCalls,proto3" json:"recent_calls,omitempty"`
+	// The number of recent calls that failed.
+	RecentFailures int32 `protobuf:"varint,3,opt,name=recent_failures,json=recentFailures,proto3" json:"recent_failures,omitempty"`
+	// The average latency of the recent calls.
+	AverageLatency *durationpb.Duration `protobuf:"bytes,4,opt,name=average_latency,json=averageLatency,proto3" json:"average_latency,omitempty"`
+	// The last error of the provider, if any.
+	LastError string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The time of the last error.
+	LastErrorTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"`
+	// The time the next trial call is let through while the circuit is open.
+	RetryTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=retry_time,json=retryTime,proto3" json:"retry_time,omitempty"`
+	// The number of calls that failed in a row since the last successful one.
+	ConsecutiveFailures int32 `protobuf:"varint,8,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// The number of AI requests in flight to all the providers.
+	InFlightRequests int32 `protobuf:"varint,9,opt,name=in_flight_requests,json=inFlightRequests,proto3" json:"in_flight_requests,omitempty"`
+	// The maximum number of AI requests in flight to all the providers, 0 for no limit.
+	MaxConcurrentRequests int32 `protobuf:"varint,10,opt,name=max_concurrent_requests,json=maxConcurrentRequests,proto3" json:"max_concurrent_requests,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AIProviderStatus) Reset() {
+	*x = AIProviderStatus{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIProviderStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIProviderStatus) ProtoMessage() {}
+
+func (x *AIProviderStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIProviderStatus.ProtoReflect.Descriptor instead.
+func (*AIProviderStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AIProviderStatus) GetCircuitState() AIProviderStatus_CircuitState {
+	if x != nil {
+		return x.CircuitState
+	}
+	return AIProviderStatus_CIRCUIT_STATE_UNSPECIFIED
+}
+
+func (x *AIProviderStatus) GetRecentCalls() int32 {
+	if x != nil {
+		return x.RecentCalls
+	}
+	return 0
+}
+
+func (x *AIProviderStatus) GetRecentFailures() int32 {
+	if x != nil {
+		return x.RecentFailures
+	}
+	return 0
+}
+
+func (x *AIProviderStatus) GetAverageLatency() *durationpb.Duration {
+	if x != nil {
+		return x.AverageLatency
+	}
+	return nil
+}
+
+func (x *AIProviderStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *AIProviderStatus) GetLastErrorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastErrorTime
+	}
+	return nil
+}
+
+func (x *AIProviderStatus) GetRetryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetryTime
+	}
+	return nil
+}
+
+func (x *AIProviderStatus) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *AIProviderStatus) GetInFlightRequests() int32 {
+	if x != nil {
+		return x.InFlightRequests
+	}
+	return 0
+}
+
+func (x *AIProviderStatus) GetMaxConcurrentRequests() int32 {
+	if x != nil {
+		return x.MaxConcurrentRequests
+	}
+	return 0
+}
+
+// Request message for TestAIConfig method.
+type TestAIConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The name of the AI profile to test, the default provider configuration when empty.
+	Profile       string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestAIConfigRequest) Reset() {
+	*x = TestAIConfigRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestAIConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAIConfigRequest) ProtoMessage() {}
+
+func (x *TestAIConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestAIConfigRequest.ProtoReflect.Descriptor instead.
+func (*TestAIConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TestAIConfigRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+// Response message for TestAIConfig method.
+type TestAIConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the AI configuration test was successful.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Optional. Error message if the test failed.
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// Optional. Additional details about the test result.
+	Details string `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	// The results of the test of each configured model, the test failing if any of them failed.
+	ModelResults  []*TestAIConfigResponse_ModelResult `protobuf:"bytes,4,rep,name=model_results,json=modelResults,proto3" json:"model_results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestAIConfigResponse) Reset() {
+	*x = TestAIConfigResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestAIConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAIConfigResponse) ProtoMessage() {}
+
+func (x *TestAIConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestAIConfigResponse.ProtoReflect.Descriptor instead.
+func (*TestAIConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TestAIConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TestAIConfigResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *TestAIConfigResponse) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *TestAIConfigResponse) GetModelResults() []*TestAIConfigResponse_ModelResult {
+	if x != nil {
+		return x.ModelResults
+	}
+	return nil
+}
+
+// Request message for RefineAISummary method.
+type RefineAISummaryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the AI summary memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The follow-up instruction, e.g. "focus on work items".
+	Instruction   string `protobuf:"bytes,2,opt,name=instruction,proto3" json:"instruction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefineAISummaryRequest) Reset() {
+	*x = RefineAISummaryRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefineAISummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefineAISummaryRequest) ProtoMessage() {}
+
+func (x *RefineAISummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefineAISummaryRequest.ProtoReflect.Descriptor instead.
+func (*RefineAISummaryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RefineAISummaryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RefineAISummaryRequest) GetInstruction() string {
+	if x != nil {
+		return x.Instruction
+	}
+	return ""
+}
+
+// Request message for RegenerateAISummary method.
+type RegenerateAISummaryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the AI summary memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. Whether the summary is generated from the memos of its time range now, instead of its source memos.
+	// The summaries generated before the time ranges were recorded can only be regenerated from their source memos.
+	RefreshSourceMemos bool `protobuf:"varint,2,opt,name=refresh_source_memos,json=refreshSourceMemos,proto3" json:"refresh_source_memos,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RegenerateAISummaryRequest) Reset() {
+	*x = RegenerateAISummaryRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateAISummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateAISummaryRequest) ProtoMessage() {}
+
+func (x *RegenerateAISummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateAISummaryRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateAISummaryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RegenerateAISummaryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegenerateAISummaryRequest) GetRefreshSourceMemos() bool {
+	if x != nil {
+		return x.RefreshSourceMemos
+	}
+	return false
+}
+
+// Request message for ListAIMemoVersions method.
+type ListAIMemoVersionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the AI summary memo.
+	// Format: memos/{memo}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIMemoVersionsRequest) Reset() {
+	*x = ListAIMemoVersionsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIMemoVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIMemoVersionsRequest) ProtoMessage() {}
+
+func (x *ListAIMemoVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIMemoVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAIMemoVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListAIMemoVersionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Response message for ListAIMemoVersions method.
+type ListAIMemoVersionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The previous versions of the memo, most recent first.
+	Versions      []*AIMemoVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIMemoVersionsResponse) Reset() {
+	*x = ListAIMemoVersionsResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIMemoVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIMemoVersionsResponse) ProtoMessage() {}
+
+func (x *ListAIMemoVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIMemoVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAIMemoVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListAIMemoVersionsResponse) GetVersions() []*AIMemoVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// AIMemoVersion is a previous version of an AI summary memo.
+type AIMemoVersion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of the version, from 1 for the first summary generated.
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The content of the memo in that version.
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// The time the version was replaced by a regeneration.
+	ReplaceTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=replace_time,json=replaceTime,proto3" json:"replace_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIMemoVersion) Reset() {
+	*x = AIMemoVersion{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIMemoVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIMemoVersion) ProtoMessage() {}
+
+func (x *AIMemoVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIMemoVersion.ProtoReflect.Descriptor instead.
+func (*AIMemoVersion) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AIMemoVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AIMemoVersion) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AIMemoVersion) GetReplaceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReplaceTime
+	}
+	return nil
+}
+
+// Request message for GetMemoSourceMemos method.
+type GetMemoSourceMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the AI memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of source memos to return.
+	// The service may return fewer than this value.
+	// If unspecified, at most 50 memos will be returned.
+	// The maximum value is 100; values above 100 will be coerced to 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `GetMemoSourceMemos` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoSourceMemosRequest) Reset() {
+	*x = GetMemoSourceMemosRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoSourceMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoSourceMemosRequest) ProtoMessage() {}
+
+func (x *GetMemoSourceMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoSourceMemosRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoSourceMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetMemoSourceMemosRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetMemoSourceMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMemoSourceMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response message for GetMemoSourceMemos method.
+type GetMemoSourceMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of source memos that were used to generate the AI summary.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of source memos (may be approximate).
+	TotalSize int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The number of source memos left out because the current user cannot see them.
+	HiddenCount   int32 `protobuf:"varint,4,opt,name=hidden_count,json=hiddenCount,proto3" json:"hidden_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoSourceMemosResponse) Reset() {
+	*x = GetMemoSourceMemosResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoSourceMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoSourceMemosResponse) ProtoMessage() {}
+
+func (x *GetMemoSourceMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoSourceMemosResponse.ProtoReflect.Descriptor instead.
+func (*GetMemoSourceMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetMemoSourceMemosResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *GetMemoSourceMemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetMemoSourceMemosResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *GetMemoSourceMemosResponse) GetHiddenCount() int32 {
+	if x != nil {
+		return x.HiddenCount
+	}
+	return 0
+}
+
+// Request message for ExportAISummaries method.
+type ExportAISummariesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The resource names of the AI memos to export, all the AI summaries of the user when empty.
+	// Format: memos/{memo}
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// Optional. A CEL filter of the AI memos to export, e.g. `created_ts >= 1735689600`.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. How the export is encrypted, not encrypted when unspecified.
+	// An AGE export is the zip encrypted in the age format.
+	Encryption ArchiveEncryption `protobuf:"varint,3,opt,name=encryption,proto3,enum=memos.api.v1.ArchiveEncryption" json:"encryption,omitempty"`
+	// The passphrase the export is encrypted with, required with an encryption.
+	Passphrase    string `protobuf:"bytes,4,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAISummariesRequest) Reset() {
+	*x = ExportAISummariesRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAISummariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAISummariesRequest) ProtoMessage() {}
+
+func (x *ExportAISummariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAISummariesRequest.ProtoReflect.Descriptor instead.
+func (*ExportAISummariesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExportAISummariesRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ExportAISummariesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ExportAISummariesRequest) GetEncryption() ArchiveEncryption {
+	if x != nil {
+		return x.Encryption
+	}
+	return ArchiveEncryption_ARCHIVE_ENCRYPTION_UNSPECIFIED
+}
+
+func (x *ExportAISummariesRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+// Request message for ExportAIInteractions method.
+type ExportAIInteractionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Only export the interactions from that time, inclusive.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Optional. Only export the interactions before that time.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAIInteractionsRequest) Reset() {
+	*x = ExportAIInteractionsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAIInteractionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAIInteractionsRequest) ProtoMessage() {}
+
+func (x *ExportAIInteractionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAIInteractionsRequest.ProtoReflect.Descriptor instead.
+func (*ExportAIInteractionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExportAIInteractionsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ExportAIInteractionsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type SynthesizeMemoAudioRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memo to render.
+	// Format: memos/{memo}
+	// Exactly one of memo and digest_date must be set.
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The day whose memos of the current user are rendered as a digest, in YYYY-MM-DD format (UTC).
+	DigestDate string `protobuf:"bytes,2,opt,name=digest_date,json=digestDate,proto3" json:"digest_date,omitempty"`
+	// Optional. The voice to use, overriding the workspace default voice.
+	Voice         string `protobuf:"bytes,3,opt,name=voice,proto3" json:"voice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SynthesizeMemoAudioRequest) Reset() {
+	*x = SynthesizeMemoAudioRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SynthesizeMemoAudioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SynthesizeMemoAudioRequest) ProtoMessage() {}
+
+func (x *SynthesizeMemoAudioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SynthesizeMemoAudioRequest.ProtoReflect.Descriptor instead.
+func (*SynthesizeMemoAudioRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SynthesizeMemoAudioRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *SynthesizeMemoAudioRequest) GetDigestDate() string {
+	if x != nil {
+		return x.DigestDate
+	}
+	return ""
+}
+
+func (x *SynthesizeMemoAudioRequest) GetVoice() string {
+	if x != nil {
+		return x.Voice
+	}
+	return ""
+}
+
+type CreateVoiceMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The audio recording, with its filename, type and content.
+	Audio *Attachment `protobuf:"bytes,1,opt,name=audio,proto3" json:"audio,omitempty"`
+	// Optional. The visibility of the memo, the default visibility of the user when unspecified.
+	Visibility Visibility `protobuf:"varint,2,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	// Optional. The language of the recording in ISO-639-1 format (e.g. "en"), which improves the transcription.
+	Language      string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVoiceMemoRequest) Reset() {
+	*x = CreateVoiceMemoRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVoiceMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVoiceMemoRequest) ProtoMessage() {}
+
+func (x *CreateVoiceMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVoiceMemoRequest.ProtoReflect.Descriptor instead.
+func (*CreateVoiceMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateVoiceMemoRequest) GetAudio() *Attachment {
+	if x != nil {
+		return x.Audio
+	}
+	return nil
+}
+
+func (x *CreateVoiceMemoRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *CreateVoiceMemoRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// A call to the AI provider.
+type AIUsageRecord struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The user the call was made for, empty for the calls made for the workspace.
+	// Format: users/{user}
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// The AI feature that made the call, e.g. "summary" or "chat".
+	Operation        string               `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Model            string               `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	PromptTokens     int64                `protobuf:"varint,5,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int64                `protobuf:"varint,6,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	Latency          *durationpb.Duration `protobuf:"bytes,7,opt,name=latency,proto3" json:"latency,omitempty"`
+	Success          bool                 `protobuf:"varint,8,opt,name=success,proto3" json:"success,omitempty"`
+	// The error of the failed call.
+	Error         string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIUsageRecord) Reset() {
+	*x = AIUsageRecord{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIUsageRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIUsageRecord) ProtoMessage() {}
+
+func (x *AIUsageRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIUsageRecord.ProtoReflect.Descriptor instead.
+func (*AIUsageRecord) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AIUsageRecord) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *AIUsageRecord) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AIUsageRecord) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AIUsageRecord) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *AIUsageRecord) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *AIUsageRecord) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *AIUsageRecord) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *AIUsageRecord) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AIUsageRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Request message for ListAIUsage method.
+type ListAIUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of calls to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListAIUsage` call.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Only list the calls made for the user.
+	// Format: users/{user}
+	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// Optional. Only list the calls of the operation.
+	Operation string `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Optional. Only list the calls made from that time, inclusive.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Optional. Only list the calls made before that time.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIUsageRequest) Reset() {
+	*x = ListAIUsageRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIUsageRequest) ProtoMessage() {}
+
+func (x *ListAIUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIUsageRequest.ProtoReflect.Descriptor instead.
+func (*ListAIUsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListAIUsageRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAIUsageRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAIUsageRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ListAIUsageRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ListAIUsageRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAIUsageRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// Response message for ListAIUsage method.
+type ListAIUsageResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Records []*AIUsageRecord       `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// A token to retrieve the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIUsageResponse) Reset() {
+	*x = ListAIUsageResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIUsageResponse) ProtoMessage() {}
+
+func (x *ListAIUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIUsageResponse.ProtoReflect.Descriptor instead.
+func (*ListAIUsageResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListAIUsageResponse) GetRecords() []*AIUsageRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ListAIUsageResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request message for GetAIUsageStats method.
+type GetAIUsageStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The start of the period, inclusive. Defaults to the start of the current UTC month.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Optional. The end of the period. Defaults to now.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAIUsageStatsRequest) Reset() {
+	*x = GetAIUsageStatsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAIUsageStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAIUsageStatsRequest) ProtoMessage() {}
+
+func (x *GetAIUsageStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAIUsageStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAIUsageStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetAIUsageStatsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetAIUsageStatsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// The calls to the AI provider in a period.
+type AIUsageStats struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The calls of all users and operations.
+	Total *AIUsageStats_Entry `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	// The calls per user, most tokens first.
+	Users []*AIUsageStats_Entry `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+	// The calls per operation, most tokens first.
+	Operations    []*AIUsageStats_Entry `protobuf:"bytes,5,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIUsageStats) Reset() {
+	*x = AIUsageStats{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIUsageStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIUsageStats) ProtoMessage() {}
+
+func (x *AIUsageStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIUsageStats.ProtoReflect.Descriptor instead.
+func (*AIUsageStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AIUsageStats) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *AIUsageStats) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *AIUsageStats) GetTotal() *AIUsageStats_Entry {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *AIUsageStats) GetUsers() []*AIUsageStats_Entry {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *AIUsageStats) GetOperations() []*AIUsageStats_Entry {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+// The exact prompt and raw response of a call to the AI provider.
+type AIDebugLog struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The user the call was made for, empty for the calls made for the workspace.
+	// Format: users/{user}
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// The AI feature that made the call, e.g. "summary" or "chat".
+	Operation string `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Model     string `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	Success   bool   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// The request sent to the provider, as JSON.
+	Prompt string `protobuf:"bytes,6,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	// The raw content returned by the provider, or the error of the failed call.
+	Response      string `protobuf:"bytes,7,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIDebugLog) Reset() {
+	*x = AIDebugLog{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIDebugLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIDebugLog) ProtoMessage() {}
+
+func (x *AIDebugLog) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIDebugLog.ProtoReflect.Descriptor instead.
+func (*AIDebugLog) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AIDebugLog) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *AIDebugLog) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AIDebugLog) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AIDebugLog) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *AIDebugLog) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AIDebugLog) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *AIDebugLog) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+// Request message for ListAIDebugLogs method.
+type ListAIDebugLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of logs to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListAIDebugLogs` call.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Only list the calls made for the user.
+	// Format: users/{user}
+	User          string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIDebugLogsRequest) Reset() {
+	*x = ListAIDebugLogsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIDebugLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIDebugLogsRequest) ProtoMessage() {}
+
+func (x *ListAIDebugLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIDebugLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAIDebugLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListAIDebugLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAIDebugLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAIDebugLogsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+// Response message for ListAIDebugLogs method.
+type ListAIDebugLogsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	DebugLogs []*AIDebugLog          `protobuf:"bytes,1,rep,name=debug_logs,json=debugLogs,proto3" json:"debug_logs,omitempty"`
+	// A token to retrieve the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIDebugLogsResponse) Reset() {
+	*x = ListAIDebugLogsResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIDebugLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIDebugLogsResponse) ProtoMessage() {}
+
+func (x *ListAIDebugLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIDebugLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAIDebugLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListAIDebugLogsResponse) GetDebugLogs() []*AIDebugLog {
+	if x != nil {
+		return x.DebugLogs
+	}
+	return nil
+}
+
+func (x *ListAIDebugLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request message for PurgeAIDebugLogs method.
+type PurgeAIDebugLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Only purge the logs stored before that time. All the logs are purged when unset.
+	BeforeTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=before_time,json=beforeTime,proto3" json:"before_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeAIDebugLogsRequest) Reset() {
+	*x = PurgeAIDebugLogsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeAIDebugLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeAIDebugLogsRequest) ProtoMessage() {}
+
+func (x *PurgeAIDebugLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeAIDebugLogsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeAIDebugLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PurgeAIDebugLogsRequest) GetBeforeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BeforeTime
+	}
+	return nil
+}
+
+// Response message for PurgeAIDebugLogs method.
+type PurgeAIDebugLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of purged logs.
+	PurgedCount   int64 `protobuf:"varint,1,opt,name=purged_count,json=purgedCount,proto3" json:"purged_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeAIDebugLogsResponse) Reset() {
+	*x = PurgeAIDebugLogsResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeAIDebugLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeAIDebugLogsResponse) ProtoMessage() {}
+
+func (x *PurgeAIDebugLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeAIDebugLogsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeAIDebugLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PurgeAIDebugLogsResponse) GetPurgedCount() int64 {
+	if x != nil {
+		return x.PurgedCount
+	}
+	return 0
+}
+
+// An audited AI action.
+type AIAuditLog struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The user who performed the action, empty for the actions of the workspace.
+	// Format: users/{user}
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// The action, e.g. "config_update", "config_test", "summary" or "chat".
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// The parameters of the action, e.g. the model and the time range of a summary.
+	Parameters map[string]string `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The memos whose content was sent to the AI provider, including the ones deleted since.
+	// Format: memos/{memo}
+	SourceMemos []string `protobuf:"bytes,5,rep,name=source_memos,json=sourceMemos,proto3" json:"source_memos,omitempty"`
+	Success     bool     `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	// The error of the failed action.
+	Error         string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIAuditLog) Reset() {
+	*x = AIAuditLog{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIAuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIAuditLog) ProtoMessage() {}
+
+func (x *AIAuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIAuditLog.ProtoReflect.Descriptor instead.
+func (*AIAuditLog) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AIAuditLog) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *AIAuditLog) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AIAuditLog) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AIAuditLog) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *AIAuditLog) GetSourceMemos() []string {
+	if x != nil {
+		return x.SourceMemos
+	}
+	return nil
+}
+
+func (x *AIAuditLog) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AIAuditLog) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Request message for ListAIAuditLogs method.
+type ListAIAuditLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of logs to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListAIAuditLogs` call.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Only list the actions of the user.
+	// Format: users/{user}
+	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// Optional. Only list the actions of that kind.
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// Optional. Only list the actions performed from that time, inclusive.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Optional. Only list the actions performed before that time.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Optional. Only list the actions that succeeded, or that failed.
+	Success       *bool `protobuf:"varint,7,opt,name=success,proto3,oneof" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIAuditLogsRequest) Reset() {
+	*x = ListAIAuditLogsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIAuditLogsRequest) ProtoMessage() {}
+
+func (x *ListAIAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAIAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListAIAuditLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAIAuditLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAIAuditLogsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ListAIAuditLogsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAIAuditLogsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAIAuditLogsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListAIAuditLogsRequest) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+// Response message for ListAIAuditLogs method.
+type ListAIAuditLogsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AuditLogs []*AIAuditLog          `protobuf:"bytes,1,rep,name=audit_logs,json=auditLogs,proto3" json:"audit_logs,omitempty"`
+	// A token to retrieve the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIAuditLogsResponse) Reset() {
+	*x = ListAIAuditLogsResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIAuditLogsResponse) ProtoMessage() {}
+
+func (x *ListAIAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAIAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListAIAuditLogsResponse) GetAuditLogs() []*AIAuditLog {
+	if x != nil {
+		return x.AuditLogs
+	}
+	return nil
+}
+
+func (x *ListAIAuditLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// A named system prompt of the AI summaries.
+type PromptTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the template, unique among the templates of the workspace or of the user.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the template is a workspace template available to every user, otherwise it belongs to the current user.
+	Workspace   bool   `protobuf:"varint,2,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The system prompt of the summary. The variables {{date_range}}, {{tag_list}} and {{memo_count}} are replaced
+	// with the time range of the summary, the tags its memos are filtered by and the number of its memos.
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromptTemplate) Reset() {
+	*x = PromptTemplate{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromptTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptTemplate) ProtoMessage() {}
+
+func (x *PromptTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptTemplate.ProtoReflect.Descriptor instead.
+func (*PromptTemplate) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PromptTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromptTemplate) GetWorkspace() bool {
+	if x != nil {
+		return x.Workspace
+	}
+	return false
+}
+
+func (x *PromptTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PromptTemplate) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PromptTemplate) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Request message for ListPromptTemplates method.
+type ListPromptTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptTemplatesRequest) Reset() {
+	*x = ListPromptTemplatesRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptTemplatesRequest) ProtoMessage() {}
+
+func (x *ListPromptTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListPromptTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{46}
+}
+
+// Response message for ListPromptTemplates method.
+type ListPromptTemplatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The templates of the current user first, then the workspace templates, by name.
+	Templates     []*PromptTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptTemplatesResponse) Reset() {
+	*x = ListPromptTemplatesResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptTemplatesResponse) ProtoMessage() {}
+
+func (x *ListPromptTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListPromptTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListPromptTemplatesResponse) GetTemplates() []*PromptTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// Request message for UpsertPromptTemplate method.
+type UpsertPromptTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *PromptTemplate        `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertPromptTemplateRequest) Reset() {
+	*x = UpsertPromptTemplateRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertPromptTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertPromptTemplateRequest) ProtoMessage() {}
+
+func (x *UpsertPromptTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertPromptTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpsertPromptTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpsertPromptTemplateRequest) GetTemplate() *PromptTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// Request message for DeletePromptTemplate method.
+type DeletePromptTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the template.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the template is a workspace template.
+	Workspace     bool `protobuf:"varint,2,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromptTemplateRequest) Reset() {
+	*x = DeletePromptTemplateRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromptTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromptTemplateRequest) ProtoMessage() {}
+
+func (x *DeletePromptTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromptTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeletePromptTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeletePromptTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeletePromptTemplateRequest) GetWorkspace() bool {
+	if x != nil {
+		return x.Workspace
+	}
+	return false
+}
+
+type AIJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the job.
+	// Format: aiJobs/{ai_job}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The state of the job.
+	State AIJob_State `protobuf:"varint,2,opt,name=state,proto3,enum=memos.api.v1.AIJob_State" json:"state,omitempty"`
+	// The memo the job created, once it is done.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The error of the job if it failed.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// The time the job was queued.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time the state of the job last changed.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIJob) Reset() {
+	*x = AIJob{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIJob) ProtoMessage() {}
+
+func (x *AIJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIJob.ProtoReflect.Descriptor instead.
+func (*AIJob) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AIJob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AIJob) GetState() AIJob_State {
+	if x != nil {
+		return x.State
+	}
+	return AIJob_STATE_UNSPECIFIED
+}
+
+func (x *AIJob) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *AIJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AIJob) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *AIJob) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Request message for GetAIJob method.
+type GetAIJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the job.
+	// Format: aiJobs/{ai_job}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAIJobRequest) Reset() {
+	*x = GetAIJobRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAIJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAIJobRequest) ProtoMessage() {}
+
+func (x *GetAIJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAIJobRequest.ProtoReflect.Descriptor instead.
+func (*GetAIJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetAIJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Request message for ListAIJobs method.
+type ListAIJobsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of jobs to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous call.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIJobsRequest) Reset() {
+	*x = ListAIJobsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIJobsRequest) ProtoMessage() {}
+
+func (x *ListAIJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListAIJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListAIJobsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAIJobsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response message for ListAIJobs method.
+type ListAIJobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The jobs, most recent first.
+	Jobs []*AIJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	// A token to retrieve the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIJobsResponse) Reset() {
+	*x = ListAIJobsResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIJobsResponse) ProtoMessage() {}
+
+func (x *ListAIJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListAIJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListAIJobsResponse) GetJobs() []*AIJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListAIJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// A tag to merge into another one.
+type SuggestTagMergesResponse_Suggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tag to rename, without the leading "#".
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The tag to rename it to, the most used of the near-duplicate tags.
+	TargetTag string `protobuf:"bytes,2,opt,name=target_tag,json=targetTag,proto3" json:"target_tag,omitempty"`
+	// The similarity of the tags, from 0 to 1.
+	Similarity float32 `protobuf:"fixed32,3,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	// The number of memos with the tag.
+	MemoCount int32 `protobuf:"varint,4,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The number of memos with the target tag.
+	TargetMemoCount int32 `protobuf:"varint,5,opt,name=target_memo_count,json=targetMemoCount,proto3" json:"target_memo_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SuggestTagMergesResponse_Suggestion) Reset() {
+	*x = SuggestTagMergesResponse_Suggestion{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTagMergesResponse_Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagMergesResponse_Suggestion) ProtoMessage() {}
+
+func (x *SuggestTagMergesResponse_Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagMergesResponse_Suggestion.ProtoReflect.Descriptor instead.
+func (*SuggestTagMergesResponse_Suggestion) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *SuggestTagMergesResponse_Suggestion) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SuggestTagMergesResponse_Suggestion) GetTargetTag() string {
+	if x != nil {
+		return x.TargetTag
+	}
+	return ""
+}
+
+func (x *SuggestTagMergesResponse_Suggestion) GetSimilarity() float32 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *SuggestTagMergesResponse_Suggestion) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *SuggestTagMergesResponse_Suggestion) GetTargetMemoCount() int32 {
+	if x != nil {
+		return x.TargetMemoCount
+	}
+	return 0
+}
+
+// A tag suggested for the memo.
+type SuggestMemoTagsResponse_Suggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tag, without the leading "#".
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Whether the user already uses the tag.
+	Existing      bool `protobuf:"varint,2,opt,name=existing,proto3" json:"existing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestMemoTagsResponse_Suggestion) Reset() {
+	*x = SuggestMemoTagsResponse_Suggestion{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestMemoTagsResponse_Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestMemoTagsResponse_Suggestion) ProtoMessage() {}
+
+func (x *SuggestMemoTagsResponse_Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestMemoTagsResponse_Suggestion.ProtoReflect.Descriptor instead.
+func (*SuggestMemoTagsResponse_Suggestion) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *SuggestMemoTagsResponse_Suggestion) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SuggestMemoTagsResponse_Suggestion) GetExisting() bool {
+	if x != nil {
+		return x.Existing
+	}
+	return false
+}
+
+// A task to be done.
+type MemoInsights_ActionItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The task, e.g. "Send the report to Alice".
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// When the task is due as the memos mention it, e.g. "by Friday", empty if they do not.
+	DueHint string `protobuf:"bytes,2,opt,name=due_hint,json=dueHint,proto3" json:"due_hint,omitempty"`
+	// The names of the memos the task comes from.
+	// Format: memos/{memo}
+	SourceMemos   []string `protobuf:"bytes,3,rep,name=source_memos,json=sourceMemos,proto3" json:"source_memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoInsights_ActionItem) Reset() {
+	*x = MemoInsights_ActionItem{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoInsights_ActionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoInsights_ActionItem) ProtoMessage() {}
+
+func (x *MemoInsights_ActionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoInsights_ActionItem.ProtoReflect.Descriptor instead.
+func (*MemoInsights_ActionItem) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *MemoInsights_ActionItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *MemoInsights_ActionItem) GetDueHint() string {
+	if x != nil {
+		return x.DueHint
+	}
+	return ""
+}
+
+func (x *MemoInsights_ActionItem) GetSourceMemos() []string {
+	if x != nil {
+		return x.SourceMemos
+	}
+	return nil
+}
+
+// A decision that was made.
+type MemoInsights_Decision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The decision, e.g. "Use PostgreSQL for the new service".
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// The names of the memos the decision comes from.
+	// Format: memos/{memo}
+	SourceMemos   []string `protobuf:"bytes,2,rep,name=source_memos,json=sourceMemos,proto3" json:"source_memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoInsights_Decision) Reset() {
+	*x = MemoInsights_Decision{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoInsights_Decision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoInsights_Decision) ProtoMessage() {}
+
+func (x *MemoInsights_Decision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoInsights_Decision.ProtoReflect.Descriptor instead.
+func (*MemoInsights_Decision) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *MemoInsights_Decision) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *MemoInsights_Decision) GetSourceMemos() []string {
+	if x != nil {
+		return x.SourceMemos
+	}
+	return nil
+}
+
+// A question that is still open.
+type MemoInsights_OpenQuestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The question, e.g. "Who owns the migration?".
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// The names of the memos the question comes from.
+	// Format: memos/{memo}
+	SourceMemos   []string `protobuf:"bytes,2,rep,name=source_memos,json=sourceMemos,proto3" json:"source_memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoInsights_OpenQuestion) Reset() {
+	*x = MemoInsights_OpenQuestion{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoInsights_OpenQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoInsights_OpenQuestion) ProtoMessage() {}
+
+func (x *MemoInsights_OpenQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoInsights_OpenQuestion.ProtoReflect.Descriptor instead.
+func (*MemoInsights_OpenQuestion) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{12, 2}
+}
+
+func (x *MemoInsights_OpenQuestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *MemoInsights_OpenQuestion) GetSourceMemos() []string {
+	if x != nil {
+		return x.SourceMemos
+	}
+	return nil
+}
+
+// A topic several memos are about.
+type MemoInsights_Topic struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the topic, e.g. "Kitchen renovation".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A short description of what the memos say about the topic.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The names of the memos about the topic.
+	// Format: memos/{memo}
+	SourceMemos   []string `protobuf:"bytes,3,rep,name=source_memos,json=sourceMemos,proto3" json:"source_memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoInsights_Topic) Reset() {
+	*x = MemoInsights_Topic{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoInsights_Topic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoInsights_Topic) ProtoMessage() {}
+
+func (x *MemoInsights_Topic) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoInsights_Topic.ProtoReflect.Descriptor instead.
+func (*MemoInsights_Topic) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{12, 3}
+}
+
+func (x *MemoInsights_Topic) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoInsights_Topic) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MemoInsights_Topic) GetSourceMemos() []string {
+	if x != nil {
+		return x.SourceMemos
+	}
+	return nil
+}
+
+// The usage of the user in a window of time.
+type AIUsage_Window struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of requests in the window, 0 if there is no limit.
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The number of requests made in the window.
+	Used int32 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	// The number of requests that may still be made in the window, 0 if there is no limit.
+	Remaining int32 `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// The time the window ends and its count resets.
+	ResetTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=reset_time,json=resetTime,proto3" json:"reset_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIUsage_Window) Reset() {
+	*x = AIUsage_Window{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIUsage_Window) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIUsage_Window) ProtoMessage() {}
+
+func (x *AIUsage_Window) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIUsage_Window.ProtoReflect.Descriptor instead.
+func (*AIUsage_Window) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *AIUsage_Window) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AIUsage_Window) GetUsed() int32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *AIUsage_Window) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *AIUsage_Window) GetResetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetTime
+	}
+	return nil
+}
+
+// The result of the test of a configured model.
+type TestAIConfigResponse_ModelResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The operation the model is configured for: "default", "summary", "chat", "vision" or "embedding".
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// The name of the model.
+	Model string `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	// Whether the model replied to the test request.
+	Success bool `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Optional. Error message if the test of the model failed.
+	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// Optional. Additional details about the test of the model.
+	Details       string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestAIConfigResponse_ModelResult) Reset() {
+	*x = TestAIConfigResponse_ModelResult{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestAIConfigResponse_ModelResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAIConfigResponse_ModelResult) ProtoMessage() {}
+
+func (x *TestAIConfigResponse_ModelResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestAIConfigResponse_ModelResult.ProtoReflect.Descriptor instead.
+func (*TestAIConfigResponse_ModelResult) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *TestAIConfigResponse_ModelResult) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *TestAIConfigResponse_ModelResult) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *TestAIConfigResponse_ModelResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TestAIConfigResponse_ModelResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *TestAIConfigResponse_ModelResult) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+// The calls of a user or of an operation.
+type AIUsageStats_Entry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user resource name, empty for the calls made for the workspace, or the operation.
+	Key              string               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Calls            int64                `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	Failures         int64                `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	PromptTokens     int64                `protobuf:"varint,4,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int64                `protobuf:"varint,5,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	AverageLatency   *durationpb.Duration `protobuf:"bytes,6,opt,name=average_latency,json=averageLatency,proto3" json:"average_latency,omitempty"`
+	// The cost of the tokens, estimated from the token prices of the workspace AI setting.
+	EstimatedCost float64 `protobuf:"fixed64,7,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIUsageStats_Entry) Reset() {
+	*x = AIUsageStats_Entry{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIUsageStats_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIUsageStats_Entry) ProtoMessage() {}
+
+func (x *AIUsageStats_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIUsageStats_Entry.ProtoReflect.Descriptor instead.
+func (*AIUsageStats_Entry) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *AIUsageStats_Entry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AIUsageStats_Entry) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *AIUsageStats_Entry) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *AIUsageStats_Entry) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *AIUsageStats_Entry) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *AIUsageStats_Entry) GetAverageLatency() *durationpb.Duration {
+	if x != nil {
+		return x.AverageLatency
+	}
+	return nil
+}
+
+func (x *AIUsageStats_Entry) GetEstimatedCost() float64 {
+	if x != nil {
+		return x.EstimatedCost
+	}
+	return 0
+}
+
+var File_api_v1_ai_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_ai_service_proto_rawDesc = "" +
+	"\n" +
+	"\x17api/v1/ai_service.proto\x12\fmemos.api.v1\x1a\x1fapi/v1/attachment_service.proto\x1a\x13api/v1/common.proto\x1a\x19api/v1/memo_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc8\x03\n" +
+	"\x18GenerateAISummaryRequest\x12\"\n" +
+	"\n" +
+	"time_range\x18\x01 \x01(\tB\x03\xe0A\x02R\ttimeRange\x12\x17\n" +
+	"\x04tags\x18\x02 \x03(\tB\x03\xe0A\x01R\x04tags\x12\"\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tB\x03\xe0A\x01R\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x04 \x01(\tB\x03\xe0A\x01R\aendDate\x12.\n" +
+	"\x10compare_previous\x18\x05 \x01(\bB\x03\xe0A\x01R\x0fcomparePrevious\x12,\n" +
+	"\x0fprompt_template\x18\x06 \x01(\tB\x03\xe0A\x01R\x0epromptTemplate\x12\"\n" +
+	"\n" +
+	"memo_names\x18\a \x03(\tB\x03\xe0A\x01R\tmemoNames\x12\x1b\n" +
+	"\x06filter\x18\b \x01(\tB\x03\xe0A\x01R\x06filter\x12,\n" +
+	"\x0fidempotency_key\x18\t \x01(\tB\x03\xe0A\x01R\x0eidempotencyKey\x12\x1f\n" +
+	"\btimezone\x18\n" +
+	" \x01(\tB\x03\xe0A\x01R\btimezone\x12=\n" +
+	"\n" +
+	"visibility\x18\v \x01(\x0e2\x18.memos.api.v1.VisibilityB\x03\xe0A\x01R\n" +
+	"visibility\"f\n" +
+	"\x15SummarizeTodayRequest\x12\x1f\n" +
+	"\btimezone\x18\x01 \x01(\tB\x03\xe0A\x01R\btimezone\x12,\n" +
+	"\x0fidempotency_key\x18\x02 \x01(\tB\x03\xe0A\x01R\x0eidempotencyKey\"\xb3\x02\n" +
+	"!GenerateWorkspaceAISummaryRequest\x12\"\n" +
+	"\n" +
+	"time_range\x18\x01 \x01(\tB\x03\xe0A\x02R\ttimeRange\x12\x17\n" +
+	"\x04tags\x18\x02 \x03(\tB\x03\xe0A\x01R\x04tags\x12\"\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tB\x03\xe0A\x01R\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x04 \x01(\tB\x03\xe0A\x01R\aendDate\x12N\n" +
+	"\x13source_visibilities\x18\x05 \x03(\x0e2\x18.memos.api.v1.VisibilityB\x03\xe0A\x01R\x12sourceVisibilities\x12=\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\x0e2\x18.memos.api.v1.VisibilityB\x03\xe0A\x01R\n" +
+	"visibility\"W\n" +
+	"\x17StreamAISummaryResponse\x12\x14\n" +
+	"\x05delta\x18\x01 \x01(\tR\x05delta\x12&\n" +
+	"\x04memo\x18\x02 \x01(\v2\x12.memos.api.v1.MemoR\x04memo\"\x8d\x02\n" +
+	"\x10AISummaryPreview\x12\x16\n" +
+	"\x06prompt\x18\x01 \x01(\tR\x06prompt\x12!\n" +
+	"\fsource_memos\x18\x02 \x03(\tR\vsourceMemos\x126\n" +
+	"\x17estimated_prompt_tokens\x18\x03 \x01(\x05R\x15estimatedPromptTokens\x12>\n" +
+	"\x1bestimated_completion_tokens\x18\x04 \x01(\x05R\x19estimatedCompletionTokens\x12%\n" +
+	"\x0eestimated_cost\x18\x05 \x01(\x01R\restimatedCost\x12\x1f\n" +
+	"\vchunk_count\x18\x06 \x01(\x05R\n" +
+	"chunkCount\"\xc2\x01\n" +
+	"\x14ChatWithMemosRequest\x12\x1f\n" +
+	"\bquestion\x18\x01 \x01(\tB\x03\xe0A\x02R\bquestion\x12,\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tB\x03\xe0A\x01R\x0econversationId\x12\x17\n" +
+	"\x04tags\x18\x03 \x03(\tB\x03\xe0A\x01R\x04tags\x12\"\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tB\x03\xe0A\x01R\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x05 \x01(\tB\x03\xe0A\x01R\aendDate\"v\n" +
+	"\x15ChatWithMemosResponse\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12\x1c\n" +
+	"\tcitations\x18\x03 \x03(\tR\tcitations\"Q\n" +
+	"\x17SuggestTagMergesRequest\x126\n" +
+	"\x14similarity_threshold\x18\x01 \x01(\x02B\x03\xe0A\x01R\x13similarityThreshold\"\x9a\x02\n" +
+	"\x18SuggestTagMergesResponse\x12S\n" +
+	"\vsuggestions\x18\x01 \x03(\v21.memos.api.v1.SuggestTagMergesResponse.SuggestionR\vsuggestions\x1a\xa8\x01\n" +
+	"\n" +
+	"Suggestion\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1d\n" +
+	"\n" +
+	"target_tag\x18\x02 \x01(\tR\ttargetTag\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x03 \x01(\x02R\n" +
+	"similarity\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x04 \x01(\x05R\tmemoCount\x12*\n" +
+	"\x11target_memo_count\x18\x05 \x01(\x05R\x0ftargetMemoCount\"e\n" +
+	"\x16SuggestMemoTagsRequest\x12\x1d\n" +
+	"\acontent\x18\x01 \x01(\tB\x03\xe0A\x02R\acontent\x12,\n" +
+	"\x0fmax_suggestions\x18\x02 \x01(\x05B\x03\xe0A\x01R\x0emaxSuggestions\"\xa9\x01\n" +
+	"\x17SuggestMemoTagsResponse\x12R\n" +
+	"\vsuggestions\x18\x01 \x03(\v20.memos.api.v1.SuggestMemoTagsResponse.SuggestionR\vsuggestions\x1a:\n" +
+	"\n" +
+	"Suggestion\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1a\n" +
+	"\bexisting\x18\x02 \x01(\bR\bexisting\"\xdf\x01\n" +
+	"\x1bGenerateMemoInsightsRequest\x12\"\n" +
+	"\n" +
+	"time_range\x18\x01 \x01(\tB\x03\xe0A\x02R\ttimeRange\x12\x17\n" +
+	"\x04tags\x18\x02 \x03(\tB\x03\xe0A\x01R\x04tags\x12\"\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tB\x03\xe0A\x01R\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x04 \x01(\tB\x03\xe0A\x01R\aendDate\x12\"\n" +
+	"\n" +
+	"memo_names\x18\x05 \x03(\tB\x03\xe0A\x01R\tmemoNames\x12\x1b\n" +
+	"\x06filter\x18\x06 \x01(\tB\x03\xe0A\x01R\x06filter\"\x94\x05\n" +
+	"\fMemoInsights\x12H\n" +
+	"\faction_items\x18\x01 \x03(\v2%.memos.api.v1.MemoInsights.ActionItemR\vactionItems\x12A\n" +
+	"\tdecisions\x18\x02 \x03(\v2#.memos.api.v1.MemoInsights.DecisionR\tdecisions\x12N\n" +
+	"\x0eopen_questions\x18\x03 \x03(\v2'.memos.api.v1.MemoInsights.OpenQuestionR\ropenQuestions\x128\n" +
+	"\x06topics\x18\x04 \x03(\v2 .memos.api.v1.MemoInsights.TopicR\x06topics\x12!\n" +
+	"\fsource_memos\x18\x05 \x03(\tR\vsourceMemos\x1a^\n" +
+	"\n" +
+	"ActionItem\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x19\n" +
+	"\bdue_hint\x18\x02 \x01(\tR\adueHint\x12!\n" +
+	"\fsource_memos\x18\x03 \x03(\tR\vsourceMemos\x1aA\n" +
+	"\bDecision\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12!\n" +
+	"\fsource_memos\x18\x02 \x03(\tR\vsourceMemos\x1aE\n" +
+	"\fOpenQuestion\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12!\n" +
+	"\fsource_memos\x18\x02 \x03(\tR\vsourceMemos\x1a`\n" +
+	"\x05Topic\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12!\n" +
+	"\fsource_memos\x18\x03 \x03(\tR\vsourceMemos\"\xc5\x02\n" +
+	"\x14TransformMemoRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12F\n" +
+	"\x06action\x18\x02 \x01(\x0e2).memos.api.v1.TransformMemoRequest.ActionB\x03\xe0A\x02R\x06action\x12,\n" +
+	"\x0ftarget_language\x18\x03 \x01(\tB\x03\xe0A\x01R\x0etargetLanguage\x12,\n" +
+	"\x0fcreate_revision\x18\x04 \x01(\bB\x03\xe0A\x01R\x0ecreateRevision\"Z\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tTRANSLATE\x10\x01\x12\x0f\n" +
+	"\vFIX_GRAMMAR\x10\x02\x12\n" +
+	"\n" +
+	"\x06EXPAND\x10\x03\x12\f\n" +
+	"\bCONDENSE\x10\x04\"Y\n" +
+	"\x15TransformMemoResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12&\n" +
+	"\x04memo\x18\x02 \x01(\v2\x12.memos.api.v1.MemoR\x04memo\"\x13\n" +
+	"\x11GetAIUsageRequest\"\xe3\x02\n" +
+	"\aAIUsage\x124\n" +
+	"\x06hourly\x18\x01 \x01(\v2\x1c.memos.api.v1.AIUsage.WindowR\x06hourly\x122\n" +
+	"\x05daily\x18\x02 \x01(\v2\x1c.memos.api.v1.AIUsage.WindowR\x05daily\x120\n" +
+	"\x14monthly_token_budget\x18\x03 \x01(\x03R\x12monthlyTokenBudget\x12.\n" +
+	"\x13monthly_tokens_used\x18\x04 \x01(\x03R\x11monthlyTokensUsed\x1a\x8b\x01\n" +
+	"\x06Window\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04used\x18\x02 \x01(\x05R\x04used\x12\x1c\n" +
+	"\tremaining\x18\x03 \x01(\x05R\tremaining\x129\n" +
+	"\n" +
+	"reset_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tresetTime\"6\n" +
+	"\x1aGetAIProviderStatusRequest\x12\x18\n" +
+	"\aprofile\x18\x01 \x01(\tR\aprofile\"\xff\x04\n" +
+	"\x10AIProviderStatus\x12P\n" +
+	"\rcircuit_state\x18\x01 \x01(\x0e2+.memos.api.v1.AIProviderStatus.CircuitStateR\fcircuitState\x12!\n" +
+	"\frecent_calls\x18\x02 \x01(\x05R\vrecentCalls\x12'\n" +
+	"\x0frecent_failures\x18\x03 \x01(\x05R\x0erecentFailures\x12B\n" +
+	"\x0faverage_latency\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0eaverageLatency\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x05 \x01(\tR\tlastError\x12B\n" +
+	"\x0flast_error_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rlastErrorTime\x129\n" +
+	"\n" +
+	"retry_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tretryTime\x121\n" +
+	"\x14consecutive_failures\x18\b \x01(\x05R\x13consecutiveFailures\x12,\n" +
+	"\x12in_flight_requests\x18\t \x01(\x05R\x10inFlightRequests\x126\n" +
+	"\x17max_concurrent_requests\x18\n" +
+	" \x01(\x05R\x15maxConcurrentRequests\"R\n" +
+	"\fCircuitState\x12\x1d\n" +
+	"\x19CIRCUIT_STATE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06CLOSED\x10\x01\x12\b\n" +
+	"\x04OPEN\x10\x02\x12\r\n" +
+	"\tHALF_OPEN\x10\x03\"/\n" +
+	"\x13TestAIConfigRequest\x12\x18\n" +
+	"\aprofile\x18\x01 \x01(\tR\aprofile\"\xf5\x02\n" +
+	"\x14TestAIConfigResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12(\n" +
+	"\rerror_message\x18\x02 \x01(\tB\x03\xe0A\x01R\ferrorMessage\x12\x1d\n" +
+	"\adetails\x18\x03 \x01(\tB\x03\xe0A\x01R\adetails\x12S\n" +
+	"\rmodel_results\x18\x04 \x03(\v2..memos.api.v1.TestAIConfigResponse.ModelResultR\fmodelResults\x1a\xa4\x01\n" +
+	"\vModelResult\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12(\n" +
+	"\rerror_message\x18\x04 \x01(\tB\x03\xe0A\x01R\ferrorMessage\x12\x1d\n" +
+	"\adetails\x18\x05 \x01(\tB\x03\xe0A\x01R\adetails\"n\n" +
+	"\x16RefineAISummaryRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12%\n" +
+	"\vinstruction\x18\x02 \x01(\tB\x03\xe0A\x02R\vinstruction\"\x82\x01\n" +
+	"\x1aRegenerateAISummaryRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x125\n" +
+	"\x14refresh_source_memos\x18\x02 \x01(\bB\x03\xe0A\x01R\x12refreshSourceMemos\"J\n" +
+	"\x19ListAIMemoVersionsRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\"U\n" +
+	"\x1aListAIMemoVersionsResponse\x127\n" +
+	"\bversions\x18\x01 \x03(\v2\x1b.memos.api.v1.AIMemoVersionR\bversions\"\x82\x01\n" +
+	"\rAIMemoVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12=\n" +
+	"\freplace_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vreplaceTime\"\x90\x01\n" +
+	"\x19GetMemoSourceMemosRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\xb0\x01\n" +
+	"\x1aGetMemoSourceMemosResponse\x12(\n" +
 	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize2\x9d\x03\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\x12!\n" +
+	"\fhidden_count\x18\x04 \x01(\x05R\vhiddenCount\"\xbd\x01\n" +
+	"\x18ExportAISummariesRequest\x12\x19\n" +
+	"\x05names\x18\x01 \x03(\tB\x03\xe0A\x01R\x05names\x12\x1b\n" +
+	"\x06filter\x18\x02 \x01(\tB\x03\xe0A\x01R\x06filter\x12D\n" +
+	"\n" +
+	"encryption\x18\x03 \x01(\x0e2\x1f.memos.api.v1.ArchiveEncryptionB\x03\xe0A\x01R\n" +
+	"encryption\x12#\n" +
+	"\n" +
+	"passphrase\x18\x04 \x01(\tB\x03\xe0A\x01R\n" +
+	"passphrase\"\x99\x01\n" +
+	"\x1bExportAIInteractionsRequest\x12>\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\tstartTime\x12:\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\aendTime\"\x8c\x01\n" +
+	"\x1aSynthesizeMemoAudioRequest\x12-\n" +
+	"\x04memo\x18\x01 \x01(\tB\x19\xe0A\x01\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04memo\x12$\n" +
+	"\vdigest_date\x18\x02 \x01(\tB\x03\xe0A\x01R\n" +
+	"digestDate\x12\x19\n" +
+	"\x05voice\x18\x03 \x01(\tB\x03\xe0A\x01R\x05voice\"\xad\x01\n" +
+	"\x16CreateVoiceMemoRequest\x123\n" +
+	"\x05audio\x18\x01 \x01(\v2\x18.memos.api.v1.AttachmentB\x03\xe0A\x02R\x05audio\x12=\n" +
+	"\n" +
+	"visibility\x18\x02 \x01(\x0e2\x18.memos.api.v1.VisibilityB\x03\xe0A\x01R\n" +
+	"visibility\x12\x1f\n" +
+	"\blanguage\x18\x03 \x01(\tB\x03\xe0A\x01R\blanguage\"\xcb\x02\n" +
+	"\rAIUsageRecord\x12;\n" +
+	"\vcreate_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\tR\x04user\x12\x1c\n" +
+	"\toperation\x18\x03 \x01(\tR\toperation\x12\x14\n" +
+	"\x05model\x18\x04 \x01(\tR\x05model\x12#\n" +
+	"\rprompt_tokens\x18\x05 \x01(\x03R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\x06 \x01(\x03R\x10completionTokens\x123\n" +
+	"\alatency\x18\a \x01(\v2\x19.google.protobuf.DurationR\alatency\x12\x18\n" +
+	"\asuccess\x18\b \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"\xf4\x01\n" +
+	"\x12ListAIUsageRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\tR\x04user\x12\x1c\n" +
+	"\toperation\x18\x04 \x01(\tR\toperation\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"t\n" +
+	"\x13ListAIUsageResponse\x125\n" +
+	"\arecords\x18\x01 \x03(\v2\x1b.memos.api.v1.AIUsageRecordR\arecords\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8a\x01\n" +
+	"\x16GetAIUsageStatsRequest\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\xbd\x04\n" +
+	"\fAIUsageStats\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x126\n" +
+	"\x05total\x18\x03 \x01(\v2 .memos.api.v1.AIUsageStats.EntryR\x05total\x126\n" +
+	"\x05users\x18\x04 \x03(\v2 .memos.api.v1.AIUsageStats.EntryR\x05users\x12@\n" +
+	"\n" +
+	"operations\x18\x05 \x03(\v2 .memos.api.v1.AIUsageStats.EntryR\n" +
+	"operations\x1a\x88\x02\n" +
+	"\x05Entry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05calls\x18\x02 \x01(\x03R\x05calls\x12\x1a\n" +
+	"\bfailures\x18\x03 \x01(\x03R\bfailures\x12#\n" +
+	"\rprompt_tokens\x18\x04 \x01(\x03R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\x05 \x01(\x03R\x10completionTokens\x12B\n" +
+	"\x0faverage_latency\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0eaverageLatency\x12%\n" +
+	"\x0eestimated_cost\x18\a \x01(\x01R\restimatedCost\"\xdf\x01\n" +
+	"\n" +
+	"AIDebugLog\x12;\n" +
+	"\vcreate_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\tR\x04user\x12\x1c\n" +
+	"\toperation\x18\x03 \x01(\tR\toperation\x12\x14\n" +
+	"\x05model\x18\x04 \x01(\tR\x05model\x12\x18\n" +
+	"\asuccess\x18\x05 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06prompt\x18\x06 \x01(\tR\x06prompt\x12\x1a\n" +
+	"\bresponse\x18\a \x01(\tR\bresponse\"h\n" +
+	"\x16ListAIDebugLogsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\tR\x04user\"z\n" +
+	"\x17ListAIDebugLogsResponse\x127\n" +
+	"\n" +
+	"debug_logs\x18\x01 \x03(\v2\x18.memos.api.v1.AIDebugLogR\tdebugLogs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"V\n" +
+	"\x17PurgeAIDebugLogsRequest\x12;\n" +
+	"\vbefore_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"beforeTime\"=\n" +
+	"\x18PurgeAIDebugLogsResponse\x12!\n" +
+	"\fpurged_count\x18\x01 \x01(\x03R\vpurgedCount\"\xd1\x02\n" +
+	"\n" +
+	"AIAuditLog\x12;\n" +
+	"\vcreate_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\tR\x04user\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12H\n" +
+	"\n" +
+	"parameters\x18\x04 \x03(\v2(.memos.api.v1.AIAuditLog.ParametersEntryR\n" +
+	"parameters\x12!\n" +
+	"\fsource_memos\x18\x05 \x03(\tR\vsourceMemos\x12\x18\n" +
+	"\asuccess\x18\x06 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9d\x02\n" +
+	"\x16ListAIAuditLogsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\tR\x04user\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1d\n" +
+	"\asuccess\x18\a \x01(\bH\x00R\asuccess\x88\x01\x01B\n" +
+	"\n" +
+	"\b_success\"z\n" +
+	"\x17ListAIAuditLogsResponse\x127\n" +
+	"\n" +
+	"audit_logs\x18\x01 \x03(\v2\x18.memos.api.v1.AIAuditLogR\tauditLogs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xca\x01\n" +
+	"\x0ePromptTemplate\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1c\n" +
+	"\tworkspace\x18\x02 \x01(\bR\tworkspace\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\acontent\x18\x04 \x01(\tB\x03\xe0A\x02R\acontent\x12@\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"\x1c\n" +
+	"\x1aListPromptTemplatesRequest\"Y\n" +
+	"\x1bListPromptTemplatesResponse\x12:\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1c.memos.api.v1.PromptTemplateR\ttemplates\"\\\n" +
+	"\x1bUpsertPromptTemplateRequest\x12=\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1c.memos.api.v1.PromptTemplateB\x03\xe0A\x02R\btemplate\"T\n" +
+	"\x1bDeletePromptTemplateRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1c\n" +
+	"\tworkspace\x18\x02 \x01(\bR\tworkspace\"\x96\x03\n" +
+	"\x05AIJob\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x124\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x19.memos.api.v1.AIJob.StateB\x03\xe0A\x03R\x05state\x12\x17\n" +
+	"\x04memo\x18\x03 \x01(\tB\x03\xe0A\x03R\x04memo\x12\x19\n" +
+	"\x05error\x18\x04 \x01(\tB\x03\xe0A\x03R\x05error\x12@\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"M\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06QUEUED\x10\x01\x12\v\n" +
+	"\aRUNNING\x10\x02\x12\b\n" +
+	"\x04DONE\x10\x03\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x04:7\xeaA4\n" +
+	"\x12memos.api.v1/AIJob\x12\x0faiJobs/{ai_job}*\x06aiJobs2\x05aiJob\"A\n" +
+	"\x0fGetAIJobRequest\x12.\n" +
+	"\x04name\x18\x01 \x01(\tB\x1a\xe0A\x02\xfaA\x14\n" +
+	"\x12memos.api.v1/AIJobR\x04name\"Y\n" +
+	"\x11ListAIJobsRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\"e\n" +
+	"\x12ListAIJobsResponse\x12'\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x13.memos.api.v1.AIJobR\x04jobs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\x94!\n" +
 	"\tAIService\x12y\n" +
-	"\x11GenerateAISummary\x12&.memos.api.v1.GenerateAISummaryRequest\x1a\x12.memos.api.v1.Memo\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/ai/summaries:generate\x12x\n" +
+	"\x11GenerateAISummary\x12&.memos.api.v1.GenerateAISummaryRequest\x1a\x12.memos.api.v1.Memo\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/ai/summaries:generate\x12p\n" +
+	"\x0eSummarizeToday\x12#.memos.api.v1.SummarizeTodayRequest\x1a\x12.memos.api.v1.Memo\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/ai/summaries:today\x12\x94\x01\n" +
+	"\x1aGenerateWorkspaceAISummary\x12/.memos.api.v1.GenerateWorkspaceAISummaryRequest\x1a\x12.memos.api.v1.Memo\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/ai/workspaceSummaries:generate\x12\x8a\x01\n" +
+	"\x0fStreamAISummary\x12&.memos.api.v1.GenerateAISummaryRequest\x1a%.memos.api.v1.StreamAISummaryResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/ai/summaries:stream0\x01\x12x\n" +
+	"\x10EnqueueAISummary\x12&.memos.api.v1.GenerateAISummaryRequest\x1a\x13.memos.api.v1.AIJob\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/ai/summaries:enqueue\x12f\n" +
+	"\bGetAIJob\x12\x1d.memos.api.v1.GetAIJobRequest\x1a\x13.memos.api.v1.AIJob\"&\xdaA\x04name\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/{name=aiJobs/*}\x12g\n" +
+	"\n" +
+	"ListAIJobs\x12\x1f.memos.api.v1.ListAIJobsRequest\x1a .memos.api.v1.ListAIJobsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/aiJobs\x12\x83\x01\n" +
+	"\x10PreviewAISummary\x12&.memos.api.v1.GenerateAISummaryRequest\x1a\x1e.memos.api.v1.AISummaryPreview\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/ai/summaries:preview\x12\x91\x01\n" +
+	"\x0fRefineAISummary\x12$.memos.api.v1.RefineAISummaryRequest\x1a\x12.memos.api.v1.Memo\"D\xdaA\x10name,instruction\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/{name=memos/*}:refineAISummary\x12\x91\x01\n" +
+	"\x13RegenerateAISummary\x12(.memos.api.v1.RegenerateAISummaryRequest\x1a\x12.memos.api.v1.Memo\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/{name=memos/*}:regenerateAISummary\x12\x99\x01\n" +
+	"\x12ListAIMemoVersions\x12'.memos.api.v1.ListAIMemoVersionsRequest\x1a(.memos.api.v1.ListAIMemoVersionsResponse\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#\x12!/api/v1/{name=memos/*}/aiVersions\x12\x7f\n" +
+	"\rChatWithMemos\x12\".memos.api.v1.ChatWithMemosRequest\x1a#.memos.api.v1.ChatWithMemosResponse\"%\xdaA\bquestion\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/ai/chat\x12\x8b\x01\n" +
+	"\x10SuggestTagMerges\x12%.memos.api.v1.SuggestTagMergesRequest\x1a&.memos.api.v1.SuggestTagMergesResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/ai/tags:suggestMerges\x12\x8c\x01\n" +
+	"\x0fSuggestMemoTags\x12$.memos.api.v1.SuggestMemoTagsRequest\x1a%.memos.api.v1.SuggestMemoTagsResponse\",\xdaA\acontent\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/ai/tags:suggest\x12\x86\x01\n" +
+	"\x14GenerateMemoInsights\x12).memos.api.v1.GenerateMemoInsightsRequest\x1a\x1a.memos.api.v1.MemoInsights\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/ai/insights:generate\x12\x93\x01\n" +
+	"\rTransformMemo\x12\".memos.api.v1.TransformMemoRequest\x1a#.memos.api.v1.TransformMemoResponse\"9\xdaA\vname,action\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/{name=memos/*}:transform\x12x\n" +
 	"\fTestAIConfig\x12!.memos.api.v1.TestAIConfigRequest\x1a\".memos.api.v1.TestAIConfigResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/ai/config:test\x12\x9a\x01\n" +
-	"\x12GetMemoSourceMemos\x12'.memos.api.v1.GetMemoSourceMemosRequest\x1a(.memos.api.v1.GetMemoSourceMemosResponse\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=memos/*}/sourceMemosB\xa6\x01\n" +
+	"\x12GetMemoSourceMemos\x12'.memos.api.v1.GetMemoSourceMemosRequest\x1a(.memos.api.v1.GetMemoSourceMemosResponse\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=memos/*}/sourceMemos\x12\x98\x01\n" +
+	"\x11ExportAISummaries\x12&.memos.api.v1.ExportAISummariesRequest\x1a\x14.google.api.HttpBody\"E\x82\xd3\xe4\x93\x02?Z :\x01*\"\x1b/api/v1/ai/summaries:export\x12\x1b/api/v1/ai/summaries:export\x12\x7f\n" +
+	"\x14ExportAIInteractions\x12).memos.api.v1.ExportAIInteractionsRequest\x1a\x14.google.api.HttpBody\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/ai/interactions:export\x12\x82\x01\n" +
+	"\x13SynthesizeMemoAudio\x12(.memos.api.v1.SynthesizeMemoAudioRequest\x1a\x18.memos.api.v1.Attachment\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/ai/speech:synthesize\x12m\n" +
+	"\x0fCreateVoiceMemo\x12$.memos.api.v1.CreateVoiceMemoRequest\x1a\x12.memos.api.v1.Memo\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/ai/voiceMemos\x12^\n" +
+	"\n" +
+	"GetAIUsage\x12\x1f.memos.api.v1.GetAIUsageRequest\x1a\x15.memos.api.v1.AIUsage\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/ai/usage\x12\x82\x01\n" +
+	"\x13GetAIProviderStatus\x12(.memos.api.v1.GetAIProviderStatusRequest\x1a\x1e.memos.api.v1.AIProviderStatus\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/ai/providerStatus\x12t\n" +
+	"\vListAIUsage\x12 .memos.api.v1.ListAIUsageRequest\x1a!.memos.api.v1.ListAIUsageResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/ai/usage/records\x12s\n" +
+	"\x0fGetAIUsageStats\x12$.memos.api.v1.GetAIUsageStatsRequest\x1a\x1a.memos.api.v1.AIUsageStats\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/ai/usage/stats\x12|\n" +
+	"\x0fListAIDebugLogs\x12$.memos.api.v1.ListAIDebugLogsRequest\x1a%.memos.api.v1.ListAIDebugLogsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/ai/debugLogs\x12\x88\x01\n" +
+	"\x10PurgeAIDebugLogs\x12%.memos.api.v1.PurgeAIDebugLogsRequest\x1a&.memos.api.v1.PurgeAIDebugLogsResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/ai/debugLogs:purge\x12|\n" +
+	"\x0fListAIAuditLogs\x12$.memos.api.v1.ListAIAuditLogsRequest\x1a%.memos.api.v1.ListAIAuditLogsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/ai/auditLogs\x12\x8e\x01\n" +
+	"\x13ListPromptTemplates\x12(.memos.api.v1.ListPromptTemplatesRequest\x1a).memos.api.v1.ListPromptTemplatesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/ai/promptTemplates\x12\x8d\x01\n" +
+	"\x14UpsertPromptTemplate\x12).memos.api.v1.UpsertPromptTemplateRequest\x1a\x1c.memos.api.v1.PromptTemplate\",\x82\xd3\xe4\x93\x02&:\btemplate\"\x1a/api/v1/ai/promptTemplates\x12\x84\x01\n" +
+	"\x14DeletePromptTemplate\x12).memos.api.v1.DeletePromptTemplateRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#*!/api/v1/ai/promptTemplates/{name}B\xa6\x01\n" +
 	"\x10com.memos.api.v1B\x0eAiServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -384,28 +4895,211 @@ func file_api_v1_ai_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_ai_service_proto_rawDescData
 }
 
-var file_api_v1_ai_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_v1_ai_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_ai_service_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_api_v1_ai_service_proto_goTypes = []any{
-	(*GenerateAISummaryRequest)(nil),   // 0: memos.api.v1.GenerateAISummaryRequest
-	(*TestAIConfigRequest)(nil),        // 1: memos.api.v1.TestAIConfigRequest
-	(*TestAIConfigResponse)(nil),       // 2: memos.api.v1.TestAIConfigResponse
-	(*GetMemoSourceMemosRequest)(nil),  // 3: memos.api.v1.GetMemoSourceMemosRequest
-	(*GetMemoSourceMemosResponse)(nil), // 4: memos.api.v1.GetMemoSourceMemosResponse
-	(*Memo)(nil),                       // 5: memos.api.v1.Memo
+	(TransformMemoRequest_Action)(0),            // 0: memos.api.v1.TransformMemoRequest.Action
+	(AIProviderStatus_CircuitState)(0),          // 1: memos.api.v1.AIProviderStatus.CircuitState
+	(AIJob_State)(0),                            // 2: memos.api.v1.AIJob.State
+	(*GenerateAISummaryRequest)(nil),            // 3: memos.api.v1.GenerateAISummaryRequest
+	(*SummarizeTodayRequest)(nil),               // 4: memos.api.v1.SummarizeTodayRequest
+	(*GenerateWorkspaceAISummaryRequest)(nil),   // 5: memos.api.v1.GenerateWorkspaceAISummaryRequest
+	(*StreamAISummaryResponse)(nil),             // 6: memos.api.v1.StreamAISummaryResponse
+	(*AISummaryPreview)(nil),                    // 7: memos.api.v1.AISummaryPreview
+	(*ChatWithMemosRequest)(nil),                // 8: memos.api.v1.ChatWithMemosRequest
+	(*ChatWithMemosResponse)(nil),               // 9: memos.api.v1.ChatWithMemosResponse
+	(*SuggestTagMergesRequest)(nil),             // 10: memos.api.v1.SuggestTagMergesRequest
+	(*SuggestTagMergesResponse)(nil),            // 11: memos.api.v1.SuggestTagMergesResponse
+	(*SuggestMemoTagsRequest)(nil),              // 12: memos.api.v1.SuggestMemoTagsRequest
+	(*SuggestMemoTagsResponse)(nil),             // 13: memos.api.v1.SuggestMemoTagsResponse
+	(*GenerateMemoInsightsRequest)(nil),         // 14: memos.api.v1.GenerateMemoInsightsRequest
+	(*MemoInsights)(nil),                        // 15: memos.api.v1.MemoInsights
+	(*TransformMemoRequest)(nil),                // 16: memos.api.v1.TransformMemoRequest
+	(*TransformMemoResponse)(nil),               // 17: memos.api.v1.TransformMemoResponse
+	(*GetAIUsageRequest)(nil),                   // 18: memos.api.v1.GetAIUsageRequest
+	(*AIUsage)(nil),                             // 19: memos.api.v1.AIUsage
+	(*GetAIProviderStatusRequest)(nil),          // 20: memos.api.v1.GetAIProviderStatusRequest
+	(*AIProviderStatus)(nil),                    // 21: memos.api.v1.AIProviderStatus
+	(*TestAIConfigRequest)(nil),                 // 22: memos.api.v1.TestAIConfigRequest
+	(*TestAIConfigResponse)(nil),                // 23: memos.api.v1.TestAIConfigResponse
+	(*RefineAISummaryRequest)(nil),              // 24: memos.api.v1.RefineAISummaryRequest
+	(*RegenerateAISummaryRequest)(nil),          // 25: memos.api.v1.RegenerateAISummaryRequest
+	(*ListAIMemoVersionsRequest)(nil),           // 26: memos.api.v1.ListAIMemoVersionsRequest
+	(*ListAIMemoVersionsResponse)(nil),          // 27: memos.api.v1.ListAIMemoVersionsResponse
+	(*AIMemoVersion)(nil),                       // 28: memos.api.v1.AIMemoVersion
+	(*GetMemoSourceMemosRequest)(nil),           // 29: memos.api.v1.GetMemoSourceMemosRequest
+	(*GetMemoSourceMemosResponse)(nil),          // 30: memos.api.v1.GetMemoSourceMemosResponse
+	(*ExportAISummariesRequest)(nil),            // 31: memos.api.v1.ExportAISummariesRequest
+	(*ExportAIInteractionsRequest)(nil),         // 32: memos.api.v1.ExportAIInteractionsRequest
+	(*SynthesizeMemoAudioRequest)(nil),          // 33: memos.api.v1.SynthesizeMemoAudioRequest
+	(*CreateVoiceMemoRequest)(nil),              // 34: memos.api.v1.CreateVoiceMemoRequest
+	(*AIUsageRecord)(nil),                       // 35: memos.api.v1.AIUsageRecord
+	(*ListAIUsageRequest)(nil),                  // 36: memos.api.v1.ListAIUsageRequest
+	(*ListAIUsageResponse)(nil),                 // 37: memos.api.v1.ListAIUsageResponse
+	(*GetAIUsageStatsRequest)(nil),              // 38: memos.api.v1.GetAIUsageStatsRequest
+	(*AIUsageStats)(nil),                        // 39: memos.api.v1.AIUsageStats
+	(*AIDebugLog)(nil),                          // 40: memos.api.v1.AIDebugLog
+	(*ListAIDebugLogsRequest)(nil),              // 41: memos.api.v1.ListAIDebugLogsRequest
+	(*ListAIDebugLogsResponse)(nil),             // 42: memos.api.v1.ListAIDebugLogsResponse
+	(*PurgeAIDebugLogsRequest)(nil),             // 43: memos.api.v1.PurgeAIDebugLogsRequest
+	(*PurgeAIDebugLogsResponse)(nil),            // 44: memos.api.v1.PurgeAIDebugLogsResponse
+	(*AIAuditLog)(nil),                          // 45: memos.api.v1.AIAuditLog
+	(*ListAIAuditLogsRequest)(nil),              // 46: memos.api.v1.ListAIAuditLogsRequest
+	(*ListAIAuditLogsResponse)(nil),             // 47: memos.api.v1.ListAIAuditLogsResponse
+	(*PromptTemplate)(nil),                      // 48: memos.api.v1.PromptTemplate
+	(*ListPromptTemplatesRequest)(nil),          // 49: memos.api.v1.ListPromptTemplatesRequest
+	(*ListPromptTemplatesResponse)(nil),         // 50: memos.api.v1.ListPromptTemplatesResponse
+	(*UpsertPromptTemplateRequest)(nil),         // 51: memos.api.v1.UpsertPromptTemplateRequest
+	(*DeletePromptTemplateRequest)(nil),         // 52: memos.api.v1.DeletePromptTemplateRequest
+	(*AIJob)(nil),                               // 53: memos.api.v1.AIJob
+	(*GetAIJobRequest)(nil),                     // 54: memos.api.v1.GetAIJobRequest
+	(*ListAIJobsRequest)(nil),                   // 55: memos.api.v1.ListAIJobsRequest
+	(*ListAIJobsResponse)(nil),                  // 56: memos.api.v1.ListAIJobsResponse
+	(*SuggestTagMergesResponse_Suggestion)(nil), // 57: memos.api.v1.SuggestTagMergesResponse.Suggestion
+	(*SuggestMemoTagsResponse_Suggestion)(nil),  // 58: memos.api.v1.SuggestMemoTagsResponse.Suggestion
+	(*MemoInsights_ActionItem)(nil),             // 59: memos.api.v1.MemoInsights.ActionItem
+	(*MemoInsights_Decision)(nil),               // 60: memos.api.v1.MemoInsights.Decision
+	(*MemoInsights_OpenQuestion)(nil),           // 61: memos.api.v1.MemoInsights.OpenQuestion
+	(*MemoInsights_Topic)(nil),                  // 62: memos.api.v1.MemoInsights.Topic
+	(*AIUsage_Window)(nil),                      // 63: memos.api.v1.AIUsage.Window
+	(*TestAIConfigResponse_ModelResult)(nil),    // 64: memos.api.v1.TestAIConfigResponse.ModelResult
+	(*AIUsageStats_Entry)(nil),                  // 65: memos.api.v1.AIUsageStats.Entry
+	nil,                                         // 66: memos.api.v1.AIAuditLog.ParametersEntry
+	(Visibility)(0),                             // 67: memos.api.v1.Visibility
+	(*Memo)(nil),                                // 68: memos.api.v1.Memo
+	(*durationpb.Duration)(nil),                 // 69: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),               // 70: google.protobuf.Timestamp
+	(ArchiveEncryption)(0),                      // 71: memos.api.v1.ArchiveEncryption
+	(*Attachment)(nil),                          // 72: memos.api.v1.Attachment
+	(*httpbody.HttpBody)(nil),                   // 73: google.api.HttpBody
+	(*emptypb.Empty)(nil),                       // 74: google.protobuf.Empty
 }
 var file_api_v1_ai_service_proto_depIdxs = []int32{
-	5, // 0: memos.api.v1.GetMemoSourceMemosResponse.memos:type_name -> memos.api.v1.Memo
-	0, // 1: memos.api.v1.AIService.GenerateAISummary:input_type -> memos.api.v1.GenerateAISummaryRequest
-	1, // 2: memos.api.v1.AIService.TestAIConfig:input_type -> memos.api.v1.TestAIConfigRequest
-	3, // 3: memos.api.v1.AIService.GetMemoSourceMemos:input_type -> memos.api.v1.GetMemoSourceMemosRequest
-	5, // 4: memos.api.v1.AIService.GenerateAISummary:output_type -> memos.api.v1.Memo
-	2, // 5: memos.api.v1.AIService.TestAIConfig:output_type -> memos.api.v1.TestAIConfigResponse
-	4, // 6: memos.api.v1.AIService.GetMemoSourceMemos:output_type -> memos.api.v1.GetMemoSourceMemosResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	67, // 0: memos.api.v1.GenerateAISummaryRequest.visibility:type_name -> memos.api.v1.Visibility
+	67, // 1: memos.api.v1.GenerateWorkspaceAISummaryRequest.source_visibilities:type_name -> memos.api.v1.Visibility
+	67, // 2: memos.api.v1.GenerateWorkspaceAISummaryRequest.visibility:type_name -> memos.api.v1.Visibility
+	68, // 3: memos.api.v1.StreamAISummaryResponse.memo:type_name -> memos.api.v1.Memo
+	57, // 4: memos.api.v1.SuggestTagMergesResponse.suggestions:type_name -> memos.api.v1.SuggestTagMergesResponse.Suggestion
+	58, // 5: memos.api.v1.SuggestMemoTagsResponse.suggestions:type_name -> memos.api.v1.SuggestMemoTagsResponse.Suggestion
+	59, // 6: memos.api.v1.MemoInsights.action_items:type_name -> memos.api.v1.MemoInsights.ActionItem
+	60, // 7: memos.api.v1.MemoInsights.decisions:type_name -> memos.api.v1.MemoInsights.Decision
+	61, // 8: memos.api.v1.MemoInsights.open_questions:type_name -> memos.api.v1.MemoInsights.OpenQuestion
+	62, // 9: memos.api.v1.MemoInsights.topics:type_name -> memos.api.v1.MemoInsights.Topic
+	0,  // 10: memos.api.v1.TransformMemoRequest.action:type_name -> memos.api.v1.TransformMemoRequest.Action
+	68, // 11: memos.api.v1.TransformMemoResponse.memo:type_name -> memos.api.v1.Memo
+	63, // 12: memos.api.v1.AIUsage.hourly:type_name -> memos.api.v1.AIUsage.Window
+	63, // 13: memos.api.v1.AIUsage.daily:type_name -> memos.api.v1.AIUsage.Window
+	1,  // 14: memos.api.v1.AIProviderStatus.circuit_state:type_name -> memos.api.v1.AIProviderStatus.CircuitState
+	69, // 15: memos.api.v1.AIProviderStatus.average_latency:type_name -> google.protobuf.Duration
+	70, // 16: memos.api.v1.AIProviderStatus.last_error_time:type_name -> google.protobuf.Timestamp
+	70, // 17: memos.api.v1.AIProviderStatus.retry_time:type_name -> google.protobuf.Timestamp
+	64, // 18: memos.api.v1.TestAIConfigResponse.model_results:type_name -> memos.api.v1.TestAIConfigResponse.ModelResult
+	28, // 19: memos.api.v1.ListAIMemoVersionsResponse.versions:type_name -> memos.api.v1.AIMemoVersion
+	70, // 20: memos.api.v1.AIMemoVersion.replace_time:type_name -> google.protobuf.Timestamp
+	68, // 21: memos.api.v1.GetMemoSourceMemosResponse.memos:type_name -> memos.api.v1.Memo
+	71, // 22: memos.api.v1.ExportAISummariesRequest.encryption:type_name -> memos.api.v1.ArchiveEncryption
+	70, // 23: memos.api.v1.ExportAIInteractionsRequest.start_time:type_name -> google.protobuf.Timestamp
+	70, // 24: memos.api.v1.ExportAIInteractionsRequest.end_time:type_name -> google.protobuf.Timestamp
+	72, // 25: memos.api.v1.CreateVoiceMemoRequest.audio:type_name -> memos.api.v1.Attachment
+	67, // 26: memos.api.v1.CreateVoiceMemoRequest.visibility:type_name -> memos.api.v1.Visibility
+	70, // 27: memos.api.v1.AIUsageRecord.create_time:type_name -> google.protobuf.Timestamp
+	69, // 28: memos.api.v1.AIUsageRecord.latency:type_name -> google.protobuf.Duration
+	70, // 29: memos.api.v1.ListAIUsageRequest.start_time:type_name -> google.protobuf.Timestamp
+	70, // 30: memos.api.v1.ListAIUsageRequest.end_time:type_name -> google.protobuf.Timestamp
+	35, // 31: memos.api.v1.ListAIUsageResponse.records:type_name -> memos.api.v1.AIUsageRecord
+	70, // 32: memos.api.v1.GetAIUsageStatsRequest.start_time:type_name -> google.protobuf.Timestamp
+	70, // 33: memos.api.v1.GetAIUsageStatsRequest.end_time:type_name -> google.protobuf.Timestamp
+	70, // 34: memos.api.v1.AIUsageStats.start_time:type_name -> google.protobuf.Timestamp
+	70, // 35: memos.api.v1.AIUsageStats.end_time:type_name -> google.protobuf.Timestamp
+	65, // 36: memos.api.v1.AIUsageStats.total:type_name -> memos.api.v1.AIUsageStats.Entry
+	65, // 37: memos.api.v1.AIUsageStats.users:type_name -> memos.api.v1.AIUsageStats.Entry
+	65, // 38: memos.api.v1.AIUsageStats.operations:type_name -> memos.api.v1.AIUsageStats.Entry
+	70, // 39: memos.api.v1.AIDebugLog.create_time:type_name -> google.protobuf.Timestamp
+	40, // 40: memos.api.v1.ListAIDebugLogsResponse.debug_logs:type_name -> memos.api.v1.AIDebugLog
+	70, // 41: memos.api.v1.PurgeAIDebugLogsRequest.before_time:type_name -> google.protobuf.Timestamp
+	70, // 42: memos.api.v1.AIAuditLog.create_time:type_name -> google.protobuf.Timestamp
+	66, // 43: memos.api.v1.AIAuditLog.parameters:type_name -> memos.api.v1.AIAuditLog.ParametersEntry
+	70, // 44: memos.api.v1.ListAIAuditLogsRequest.start_time:type_name -> google.protobuf.Timestamp
+	70, // 45: memos.api.v1.ListAIAuditLogsRequest.end_time:type_name -> google.protobuf.Timestamp
+	45, // 46: memos.api.v1.ListAIAuditLogsResponse.audit_logs:type_name -> memos.api.v1.AIAuditLog
+	70, // 47: memos.api.v1.PromptTemplate.update_time:type_name -> google.protobuf.Timestamp
+	48, // 48: memos.api.v1.ListPromptTemplatesResponse.templates:type_name -> memos.api.v1.PromptTemplate
+	48, // 49: memos.api.v1.UpsertPromptTemplateRequest.template:type_name -> memos.api.v1.PromptTemplate
+	2,  // 50: memos.api.v1.AIJob.state:type_name -> memos.api.v1.AIJob.State
+	70, // 51: memos.api.v1.AIJob.create_time:type_name -> google.protobuf.Timestamp
+	70, // 52: memos.api.v1.AIJob.update_time:type_name -> google.protobuf.Timestamp
+	53, // 53: memos.api.v1.ListAIJobsResponse.jobs:type_name -> memos.api.v1.AIJob
+	70, // 54: memos.api.v1.AIUsage.Window.reset_time:type_name -> google.protobuf.Timestamp
+	69, // 55: memos.api.v1.AIUsageStats.Entry.average_latency:type_name -> google.protobuf.Duration
+	3,  // 56: memos.api.v1.AIService.GenerateAISummary:input_type -> memos.api.v1.GenerateAISummaryRequest
+	4,  // 57: memos.api.v1.AIService.SummarizeToday:input_type -> memos.api.v1.SummarizeTodayRequest
+	5,  // 58: memos.api.v1.AIService.GenerateWorkspaceAISummary:input_type -> memos.api.v1.GenerateWorkspaceAISummaryRequest
+	3,  // 59: memos.api.v1.AIService.StreamAISummary:input_type -> memos.api.v1.GenerateAISummaryRequest
+	3,  // 60: memos.api.v1.AIService.EnqueueAISummary:input_type -> memos.api.v1.GenerateAISummaryRequest
+	54, // 61: memos.api.v1.AIService.GetAIJob:input_type -> memos.api.v1.GetAIJobRequest
+	55, // 62: memos.api.v1.AIService.ListAIJobs:input_type -> memos.api.v1.ListAIJobsRequest
+	3,  // 63: memos.api.v1.AIService.PreviewAISummary:input_type -> memos.api.v1.GenerateAISummaryRequest
+	24, // 64: memos.api.v1.AIService.RefineAISummary:input_type -> memos.api.v1.RefineAISummaryRequest
+	25, // 65: memos.api.v1.AIService.RegenerateAISummary:input_type -> memos.api.v1.RegenerateAISummaryRequest
+	26, // 66: memos.api.v1.AIService.ListAIMemoVersions:input_type -> memos.api.v1.ListAIMemoVersionsRequest
+	8,  // 67: memos.api.v1.AIService.ChatWithMemos:input_type -> memos.api.v1.ChatWithMemosRequest
+	10, // 68: memos.api.v1.AIService.SuggestTagMerges:input_type -> memos.api.v1.SuggestTagMergesRequest
+	12, // 69: memos.api.v1.AIService.SuggestMemoTags:input_type -> memos.api.v1.SuggestMemoTagsRequest
+	14, // 70: memos.api.v1.AIService.GenerateMemoInsights:input_type -> memos.api.v1.GenerateMemoInsightsRequest
+	16, // 71: memos.api.v1.AIService.TransformMemo:input_type -> memos.api.v1.TransformMemoRequest
+	22, // 72: memos.api.v1.AIService.TestAIConfig:input_type -> memos.api.v1.TestAIConfigRequest
+	29, // 73: memos.api.v1.AIService.GetMemoSourceMemos:input_type -> memos.api.v1.GetMemoSourceMemosRequest
+	31, // 74: memos.api.v1.AIService.ExportAISummaries:input_type -> memos.api.v1.ExportAISummariesRequest
+	32, // 75: memos.api.v1.AIService.ExportAIInteractions:input_type -> memos.api.v1.ExportAIInteractionsRequest
+	33, // 76: memos.api.v1.AIService.SynthesizeMemoAudio:input_type -> memos.api.v1.SynthesizeMemoAudioRequest
+	34, // 77: memos.api.v1.AIService.CreateVoiceMemo:input_type -> memos.api.v1.CreateVoiceMemoRequest
+	18, // 78: memos.api.v1.AIService.GetAIUsage:input_type -> memos.api.v1.GetAIUsageRequest
+	20, // 79: memos.api.v1.AIService.GetAIProviderStatus:input_type -> memos.api.v1.GetAIProviderStatusRequest
+	36, // 80: memos.api.v1.AIService.ListAIUsage:input_type -> memos.api.v1.ListAIUsageRequest
+	38, // 81: memos.api.v1.AIService.GetAIUsageStats:input_type -> memos.api.v1.GetAIUsageStatsRequest
+	41, // 82: memos.api.v1.AIService.ListAIDebugLogs:input_type -> memos.api.v1.ListAIDebugLogsRequest
+	43, // 83: memos.api.v1.AIService.PurgeAIDebugLogs:input_type -> memos.api.v1.PurgeAIDebugLogsRequest
+	46, // 84: memos.api.v1.AIService.ListAIAuditLogs:input_type -> memos.api.v1.ListAIAuditLogsRequest
+	49, // 85: memos.api.v1.AIService.ListPromptTemplates:input_type -> memos.api.v1.ListPromptTemplatesRequest
+	51, // 86: memos.api.v1.AIService.UpsertPromptTemplate:input_type -> memos.api.v1.UpsertPromptTemplateRequest
+	52, // 87: memos.api.v1.AIService.DeletePromptTemplate:input_type -> memos.api.v1.DeletePromptTemplateRequest
+	68, // 88: memos.api.v1.AIService.GenerateAISummary:output_type -> memos.api.v1.Memo
+	68, // 89: memos.api.v1.AIService.SummarizeToday:output_type -> memos.api.v1.Memo
+	68, // 90: memos.api.v1.AIService.GenerateWorkspaceAISummary:output_type -> memos.api.v1.Memo
+	6,  // 91: memos.api.v1.AIService.StreamAISummary:output_type -> memos.api.v1.StreamAISummaryResponse
+	53, // 92: memos.api.v1.AIService.EnqueueAISummary:output_type -> memos.api.v1.AIJob
+	53, // 93: memos.api.v1.AIService.GetAIJob:output_type -> memos.api.v1.AIJob
+	56, // 94: memos.api.v1.AIService.ListAIJobs:output_type -> memos.api.v1.ListAIJobsResponse
+	7,  // 95: memos.api.v1.AIService.PreviewAISummary:output_type -> memos.api.v1.AISummaryPreview
+	68, // 96: memos.api.v1.AIService.RefineAISummary:output_type -> memos.api.v1.Memo
+	68, // 97: memos.api.v1.AIService.RegenerateAISummary:output_type -> memos.api.v1.Memo
+	27, // 98: memos.api.v1.AIService.ListAIMemoVersions:output_type -> memos.api.v1.ListAIMemoVersionsResponse
+	9,  // 99: memos.api.v1.AIService.ChatWithMemos:output_type -> memos.api.v1.ChatWithMemosResponse
+	11, // 100: memos.api.v1.AIService.SuggestTagMerges:output_type -> memos.api.v1.SuggestTagMergesResponse
+	13, // 101: memos.api.v1.AIService.SuggestMemoTags:output_type -> memos.api.v1.SuggestMemoTagsResponse
+	15, // 102: memos.api.v1.AIService.GenerateMemoInsights:output_type -> memos.api.v1.MemoInsights
+	17, // 103: memos.api.v1.AIService.TransformMemo:output_type -> memos.api.v1.TransformMemoResponse
+	23, // 104: memos.api.v1.AIService.TestAIConfig:output_type -> memos.api.v1.TestAIConfigResponse
+	30, // 105: memos.api.v1.AIService.GetMemoSourceMemos:output_type -> memos.api.v1.GetMemoSourceMemosResponse
+	73, // 106: memos.api.v1.AIService.ExportAISummaries:output_type -> google.api.HttpBody
+	73, // 107: memos.api.v1.AIService.ExportAIInteractions:output_type -> google.api.HttpBody
+	72, // 108: memos.api.v1.AIService.SynthesizeMemoAudio:output_type -> memos.api.v1.Attachment
+	68, // 109: memos.api.v1.AIService.CreateVoiceMemo:output_type -> memos.api.v1.Memo
+	19, // 110: memos.api.v1.AIService.GetAIUsage:output_type -> memos.api.v1.AIUsage
+	21, // 111: memos.api.v1.AIService.GetAIProviderStatus:output_type -> memos.api.v1.AIProviderStatus
+	37, // 112: memos.api.v1.AIService.ListAIUsage:output_type -> memos.api.v1.ListAIUsageResponse
+	39, // 113: memos.api.v1.AIService.GetAIUsageStats:output_type -> memos.api.v1.AIUsageStats
+	42, // 114: memos.api.v1.AIService.ListAIDebugLogs:output_type -> memos.api.v1.ListAIDebugLogsResponse
+	44, // 115: memos.api.v1.AIService.PurgeAIDebugLogs:output_type -> memos.api.v1.PurgeAIDebugLogsResponse
+	47, // 116: memos.api.v1.AIService.ListAIAuditLogs:output_type -> memos.api.v1.ListAIAuditLogsResponse
+	50, // 117: memos.api.v1.AIService.ListPromptTemplates:output_type -> memos.api.v1.ListPromptTemplatesResponse
+	48, // 118: memos.api.v1.AIService.UpsertPromptTemplate:output_type -> memos.api.v1.PromptTemplate
+	74, // 119: memos.api.v1.AIService.DeletePromptTemplate:output_type -> google.protobuf.Empty
+	88, // [88:120] is the sub-list for method output_type
+	56, // [56:88] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_ai_service_proto_init() }
@@ -413,19 +5107,23 @@ func file_api_v1_ai_service_proto_init() {
 	if File_api_v1_ai_service_proto != nil {
 		return
 	}
+	file_api_v1_attachment_service_proto_init()
+	file_api_v1_common_proto_init()
 	file_api_v1_memo_service_proto_init()
+	file_api_v1_ai_service_proto_msgTypes[43].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_ai_service_proto_rawDesc), len(file_api_v1_ai_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      3,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_v1_ai_service_proto_goTypes,
 		DependencyIndexes: file_api_v1_ai_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_ai_service_proto_enumTypes,
 		MessageInfos:      file_api_v1_ai_service_proto_msgTypes,
 	}.Build()
 	File_api_v1_ai_service_proto = out.File
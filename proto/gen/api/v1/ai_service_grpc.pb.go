@@ -8,9 +8,11 @@ package apiv1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,9 +21,38 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AIService_GenerateAISummary_FullMethodName  = "/memos.api.v1.AIService/GenerateAISummary"
-	AIService_TestAIConfig_FullMethodName       = "/memos.api.v1.AIService/TestAIConfig"
-	AIService_GetMemoSourceMemos_FullMethodName = "/memos.api.v1.AIService/GetMemoSourceMemos"
+	AIService_GenerateAISummary_FullMethodName          = "/memos.api.v1.AIService/GenerateAISummary"
+	AIService_SummarizeToday_FullMethodName             = "/memos.api.v1.AIService/SummarizeToday"
+	AIService_GenerateWorkspaceAISummary_FullMethodName = "/memos.api.v1.AIService/GenerateWorkspaceAISummary"
+	AIService_StreamAISummary_FullMethodName            = "/memos.api.v1.AIService/StreamAISummary"
+	AIService_EnqueueAISummary_FullMethodName           = "/memos.api.v1.AIService/EnqueueAISummary"
+	AIService_GetAIJob_FullMethodName                   = "/memos.api.v1.AIService/GetAIJob"
+	AIService_ListAIJobs_FullMethodName                 = "/memos.api.v1.AIService/ListAIJobs"
+	AIService_PreviewAISummary_FullMethodName           = "/memos.api.v1.AIService/PreviewAISummary"
+	AIService_RefineAISummary_FullMethodName            = "/memos.api.v1.AIService/RefineAISummary"
+	AIService_RegenerateAISummary_FullMethodName        = "/memos.api.v1.AIService/RegenerateAISummary"
+	AIService_ListAIMemoVersions_FullMethodName         = "/memos.api.v1.AIService/ListAIMemoVersions"
+	AIService_ChatWithMemos_FullMethodName              = "/memos.api.v1.AIService/ChatWithMemos"
+	AIService_SuggestTagMerges_FullMethodName           = "/memos.api.v1.AIService/SuggestTagMerges"
+	AIService_SuggestMemoTags_FullMethodName            = "/memos.api.v1.AIService/SuggestMemoTags"
+	AIService_GenerateMemoInsights_FullMethodName       = "/memos.api.v1.AIService/GenerateMemoInsights"
+	AIService_TransformMemo_FullMethodName              = "/memos.api.v1.AIService/TransformMemo"
+	AIService_TestAIConfig_FullMethodName               = "/memos.api.v1.AIService/TestAIConfig"
+	AIService_GetMemoSourceMemos_FullMethodName         = "/memos.api.v1.AIService/GetMemoSourceMemos"
+	AIService_ExportAISummaries_FullMethodName          = "/memos.api.v1.AIService/ExportAISummaries"
+	AIService_ExportAIInteractions_FullMethodName       = "/memos.api.v1.AIService/ExportAIInteractions"
+	AIService_SynthesizeMemoAudio_FullMethodName        = "/memos.api.v1.AIService/SynthesizeMemoAudio"
+	AIService_CreateVoiceMemo_FullMethodName            = "/memos.api.v1.AIService/CreateVoiceMemo"
+	AIService_GetAIUsage_FullMethodName                 = "/memos.api.v1.AIService/GetAIUsage"
+	AIService_GetAIProviderStatus_FullMethodName        = "/memos.api.v1.AIService/GetAIProviderStatus"
+	AIService_ListAIUsage_FullMethodName                = "/memos.api.v1.AIService/ListAIUsage"
+	AIService_GetAIUsageStats_FullMethodName            = "/memos.api.v1.AIService/GetAIUsageStats"
+	AIService_ListAIDebugLogs_FullMethodName            = "/memos.api.v1.AIService/ListAIDebugLogs"
+	AIService_PurgeAIDebugLogs_FullMethodName           = "/memos.api.v1.AIService/PurgeAIDebugLogs"
+	AIService_ListAIAuditLogs_FullMethodName            = "/memos.api.v1.AIService/ListAIAuditLogs"
+	AIService_ListPromptTemplates_FullMethodName        = "/memos.api.v1.AIService/ListPromptTemplates"
+	AIService_UpsertPromptTemplate_FullMethodName       = "/memos.api.v1.AIService/UpsertPromptTemplate"
+	AIService_DeletePromptTemplate_FullMethodName       = "/memos.api.v1.AIService/DeletePromptTemplate"
 )
 
 // AIServiceClient is the client API for AIService service.
@@ -30,10 +61,93 @@ const (
 type AIServiceClient interface {
 	// GenerateAISummary generates an AI summary for memos within a specified time range and tags.
 	GenerateAISummary(ctx context.Context, in *GenerateAISummaryRequest, opts ...grpc.CallOption) (*Memo, error)
+	// SummarizeToday generates an AI summary of the memos the current user created today, from midnight in their
+	// timezone, with the tags and the visibility of their AI auto summary setting.
+	SummarizeToday(ctx context.Context, in *SummarizeTodayRequest, opts ...grpc.CallOption) (*Memo, error)
+	// GenerateWorkspaceAISummary generates a digest of the memos the users shared with the workspace in a time
+	// range, grouped by author and tag, posted as a pinned memo of the system bot. Only the host may generate it.
+	GenerateWorkspaceAISummary(ctx context.Context, in *GenerateWorkspaceAISummaryRequest, opts ...grpc.CallOption) (*Memo, error)
+	// StreamAISummary generates an AI summary like GenerateAISummary, streaming the summary text as the
+	// AI provider generates it. The last message carries the created memo.
+	StreamAISummary(ctx context.Context, in *GenerateAISummaryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamAISummaryResponse], error)
+	// EnqueueAISummary queues the generation of an AI summary and returns the job right away. The job is
+	// processed in the background, its state is polled with GetAIJob.
+	EnqueueAISummary(ctx context.Context, in *GenerateAISummaryRequest, opts ...grpc.CallOption) (*AIJob, error)
+	// GetAIJob gets an AI job of the current user.
+	GetAIJob(ctx context.Context, in *GetAIJobRequest, opts ...grpc.CallOption) (*AIJob, error)
+	// ListAIJobs lists the AI jobs of the current user, most recent first.
+	ListAIJobs(ctx context.Context, in *ListAIJobsRequest, opts ...grpc.CallOption) (*ListAIJobsResponse, error)
+	// PreviewAISummary builds the prompt GenerateAISummary would send for the same request and estimates
+	// its token count and cost, without calling the AI provider or counting against the rate limit.
+	PreviewAISummary(ctx context.Context, in *GenerateAISummaryRequest, opts ...grpc.CallOption) (*AISummaryPreview, error)
+	// RefineAISummary re-generates an AI summary memo with a follow-up instruction, e.g. "make it shorter",
+	// continuing the conversation of its source memos and previous refinements. The memo is updated in place.
+	RefineAISummary(ctx context.Context, in *RefineAISummaryRequest, opts ...grpc.CallOption) (*Memo, error)
+	// RegenerateAISummary re-runs an AI summary memo, with the same source memos or the memos of the same time range
+	// refreshed. The memo is updated in place and its previous content is kept as a version.
+	RegenerateAISummary(ctx context.Context, in *RegenerateAISummaryRequest, opts ...grpc.CallOption) (*Memo, error)
+	// ListAIMemoVersions lists the previous versions of an AI summary memo, most recent first, to compare them
+	// with the current content or roll back to one.
+	ListAIMemoVersions(ctx context.Context, in *ListAIMemoVersionsRequest, opts ...grpc.CallOption) (*ListAIMemoVersionsResponse, error)
+	// ChatWithMemos answers a question about the user's memos, grounded in the memos the most relevant
+	// to it, and cites them. The conversation is kept so that follow-up questions keep its context.
+	ChatWithMemos(ctx context.Context, in *ChatWithMemosRequest, opts ...grpc.CallOption) (*ChatWithMemosResponse, error)
+	// SuggestTagMerges compares the tags of the user's memos with embeddings and suggests merging the
+	// near-duplicate ones, e.g. #todos into #todo. The suggestions can be applied with RenameMemoTag.
+	SuggestTagMerges(ctx context.Context, in *SuggestTagMergesRequest, opts ...grpc.CallOption) (*SuggestTagMergesResponse, error)
+	// SuggestMemoTags suggests tags for the content of a memo, preferring the tags the user already uses.
+	SuggestMemoTags(ctx context.Context, in *SuggestMemoTagsRequest, opts ...grpc.CallOption) (*SuggestMemoTagsResponse, error)
+	// GenerateMemoInsights extracts the action items, decisions, open questions and topics of the user's memos,
+	// each referencing its source memos, as a structure rather than the prose of a summary.
+	GenerateMemoInsights(ctx context.Context, in *GenerateMemoInsightsRequest, opts ...grpc.CallOption) (*MemoInsights, error)
+	// TransformMemo translates or rewrites the content of a memo visible to the current user. The result is returned,
+	// or saved as a new memo of the current user related to the original one if requested.
+	TransformMemo(ctx context.Context, in *TransformMemoRequest, opts ...grpc.CallOption) (*TransformMemoResponse, error)
 	// TestAIConfig tests the AI configuration by sending a test request to the AI provider.
 	TestAIConfig(ctx context.Context, in *TestAIConfigRequest, opts ...grpc.CallOption) (*TestAIConfigResponse, error)
 	// GetMemoSourceMemos retrieves the source memos that were used to generate an AI summary.
 	GetMemoSourceMemos(ctx context.Context, in *GetMemoSourceMemosRequest, opts ...grpc.CallOption) (*GetMemoSourceMemosResponse, error)
+	// ExportAISummaries exports the AI summaries of the current user with their source memos, as a zip of Markdown
+	// files with front matter and a relations.json file mapping each summary to its source memos.
+	ExportAISummaries(ctx context.Context, in *ExportAISummariesRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// ExportAIInteractions exports the log of the AI interactions of the current user as JSON: the memos whose content
+	// was sent to the AI provider by each audited action, and every call to the provider with its model and tokens.
+	ExportAIInteractions(ctx context.Context, in *ExportAIInteractionsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// SynthesizeMemoAudio renders a memo or a daily digest of the user's memos to speech, saved as an
+	// MP3 audio attachment. The attachment belongs to the memo when the user is its creator.
+	SynthesizeMemoAudio(ctx context.Context, in *SynthesizeMemoAudioRequest, opts ...grpc.CallOption) (*Attachment, error)
+	// CreateVoiceMemo transcribes an audio recording, structures the transcript into Markdown with tags
+	// and creates a memo with the recording attached.
+	CreateVoiceMemo(ctx context.Context, in *CreateVoiceMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// GetAIUsage returns the AI requests the current user made and may still make in the hour and in the day.
+	GetAIUsage(ctx context.Context, in *GetAIUsageRequest, opts ...grpc.CallOption) (*AIUsage, error)
+	// GetAIProviderStatus returns the health of the AI provider from the recent calls to it.
+	// Only available to admins.
+	GetAIProviderStatus(ctx context.Context, in *GetAIProviderStatusRequest, opts ...grpc.CallOption) (*AIProviderStatus, error)
+	// ListAIUsage lists the calls to the AI provider, most recent first.
+	// Only available to admins.
+	ListAIUsage(ctx context.Context, in *ListAIUsageRequest, opts ...grpc.CallOption) (*ListAIUsageResponse, error)
+	// GetAIUsageStats sums up the calls to the AI provider per user and per operation.
+	// Only available to admins.
+	GetAIUsageStats(ctx context.Context, in *GetAIUsageStatsRequest, opts ...grpc.CallOption) (*AIUsageStats, error)
+	// ListAIDebugLogs lists the prompts and responses stored while the AI debug logging is on, most recent first.
+	// Only available to admins.
+	ListAIDebugLogs(ctx context.Context, in *ListAIDebugLogsRequest, opts ...grpc.CallOption) (*ListAIDebugLogsResponse, error)
+	// PurgeAIDebugLogs deletes the stored prompts and responses.
+	// Only available to admins.
+	PurgeAIDebugLogs(ctx context.Context, in *PurgeAIDebugLogsRequest, opts ...grpc.CallOption) (*PurgeAIDebugLogsResponse, error)
+	// ListAIAuditLogs lists the audited AI actions, most recent first: the changes and the tests of the AI
+	// configuration, and the summaries and the chat questions sending memo content to the AI provider.
+	// Only available to admins.
+	ListAIAuditLogs(ctx context.Context, in *ListAIAuditLogsRequest, opts ...grpc.CallOption) (*ListAIAuditLogsResponse, error)
+	// ListPromptTemplates lists the prompt templates of the workspace and of the current user.
+	ListPromptTemplates(ctx context.Context, in *ListPromptTemplatesRequest, opts ...grpc.CallOption) (*ListPromptTemplatesResponse, error)
+	// UpsertPromptTemplate creates a prompt template or replaces the one with the same name.
+	// Only admins may upsert the workspace templates.
+	UpsertPromptTemplate(ctx context.Context, in *UpsertPromptTemplateRequest, opts ...grpc.CallOption) (*PromptTemplate, error)
+	// DeletePromptTemplate deletes a prompt template.
+	// Only admins may delete the workspace templates.
+	DeletePromptTemplate(ctx context.Context, in *DeletePromptTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type aIServiceClient struct {
@@ -54,6 +168,165 @@ func (c *aIServiceClient) GenerateAISummary(ctx context.Context, in *GenerateAIS
 	return out, nil
 }
 
+func (c *aIServiceClient) SummarizeToday(ctx context.Context, in *SummarizeTodayRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, AIService_SummarizeToday_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) GenerateWorkspaceAISummary(ctx context.Context, in *GenerateWorkspaceAISummaryRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, AIService_GenerateWorkspaceAISummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) StreamAISummary(ctx context.Context, in *GenerateAISummaryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamAISummaryResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AIService_ServiceDesc.Streams[0], AIService_StreamAISummary_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GenerateAISummaryRequest, StreamAISummaryResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AIService_StreamAISummaryClient = grpc.ServerStreamingClient[StreamAISummaryResponse]
+
+func (c *aIServiceClient) EnqueueAISummary(ctx context.Context, in *GenerateAISummaryRequest, opts ...grpc.CallOption) (*AIJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AIJob)
+	err := c.cc.Invoke(ctx, AIService_EnqueueAISummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) GetAIJob(ctx context.Context, in *GetAIJobRequest, opts ...grpc.CallOption) (*AIJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AIJob)
+	err := c.cc.Invoke(ctx, AIService_GetAIJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) ListAIJobs(ctx context.Context, in *ListAIJobsRequest, opts ...grpc.CallOption) (*ListAIJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAIJobsResponse)
+	err := c.cc.Invoke(ctx, AIService_ListAIJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) PreviewAISummary(ctx context.Context, in *GenerateAISummaryRequest, opts ...grpc.CallOption) (*AISummaryPreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AISummaryPreview)
+	err := c.cc.Invoke(ctx, AIService_PreviewAISummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) RefineAISummary(ctx context.Context, in *RefineAISummaryRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, AIService_RefineAISummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) RegenerateAISummary(ctx context.Context, in *RegenerateAISummaryRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, AIService_RegenerateAISummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) ListAIMemoVersions(ctx context.Context, in *ListAIMemoVersionsRequest, opts ...grpc.CallOption) (*ListAIMemoVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAIMemoVersionsResponse)
+	err := c.cc.Invoke(ctx, AIService_ListAIMemoVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) ChatWithMemos(ctx context.Context, in *ChatWithMemosRequest, opts ...grpc.CallOption) (*ChatWithMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatWithMemosResponse)
+	err := c.cc.Invoke(ctx, AIService_ChatWithMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) SuggestTagMerges(ctx context.Context, in *SuggestTagMergesRequest, opts ...grpc.CallOption) (*SuggestTagMergesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestTagMergesResponse)
+	err := c.cc.Invoke(ctx, AIService_SuggestTagMerges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) SuggestMemoTags(ctx context.Context, in *SuggestMemoTagsRequest, opts ...grpc.CallOption) (*SuggestMemoTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestMemoTagsResponse)
+	err := c.cc.Invoke(ctx, AIService_SuggestMemoTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) GenerateMemoInsights(ctx context.Context, in *GenerateMemoInsightsRequest, opts ...grpc.CallOption) (*MemoInsights, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoInsights)
+	err := c.cc.Invoke(ctx, AIService_GenerateMemoInsights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) TransformMemo(ctx context.Context, in *TransformMemoRequest, opts ...grpc.CallOption) (*TransformMemoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransformMemoResponse)
+	err := c.cc.Invoke(ctx, AIService_TransformMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aIServiceClient) TestAIConfig(ctx context.Context, in *TestAIConfigRequest, opts ...grpc.CallOption) (*TestAIConfigResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TestAIConfigResponse)
@@ -74,16 +347,239 @@ func (c *aIServiceClient) GetMemoSourceMemos(ctx context.Context, in *GetMemoSou
 	return out, nil
 }
 
+func (c *aIServiceClient) ExportAISummaries(ctx context.Context, in *ExportAISummariesRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, AIService_ExportAISummaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) ExportAIInteractions(ctx context.Context, in *ExportAIInteractionsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, AIService_ExportAIInteractions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) SynthesizeMemoAudio(ctx context.Context, in *SynthesizeMemoAudioRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, AIService_SynthesizeMemoAudio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) CreateVoiceMemo(ctx context.Context, in *CreateVoiceMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, AIService_CreateVoiceMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) GetAIUsage(ctx context.Context, in *GetAIUsageRequest, opts ...grpc.CallOption) (*AIUsage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AIUsage)
+	err := c.cc.Invoke(ctx, AIService_GetAIUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) GetAIProviderStatus(ctx context.Context, in *GetAIProviderStatusRequest, opts ...grpc.CallOption) (*AIProviderStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AIProviderStatus)
+	err := c.cc.Invoke(ctx, AIService_GetAIProviderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) ListAIUsage(ctx context.Context, in *ListAIUsageRequest, opts ...grpc.CallOption) (*ListAIUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAIUsageResponse)
+	err := c.cc.Invoke(ctx, AIService_ListAIUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) GetAIUsageStats(ctx context.Context, in *GetAIUsageStatsRequest, opts ...grpc.CallOption) (*AIUsageStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AIUsageStats)
+	err := c.cc.Invoke(ctx, AIService_GetAIUsageStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) ListAIDebugLogs(ctx context.Context, in *ListAIDebugLogsRequest, opts ...grpc.CallOption) (*ListAIDebugLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAIDebugLogsResponse)
+	err := c.cc.Invoke(ctx, AIService_ListAIDebugLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) PurgeAIDebugLogs(ctx context.Context, in *PurgeAIDebugLogsRequest, opts ...grpc.CallOption) (*PurgeAIDebugLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeAIDebugLogsResponse)
+	err := c.cc.Invoke(ctx, AIService_PurgeAIDebugLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) ListAIAuditLogs(ctx context.Context, in *ListAIAuditLogsRequest, opts ...grpc.CallOption) (*ListAIAuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAIAuditLogsResponse)
+	err := c.cc.Invoke(ctx, AIService_ListAIAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) ListPromptTemplates(ctx context.Context, in *ListPromptTemplatesRequest, opts ...grpc.CallOption) (*ListPromptTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromptTemplatesResponse)
+	err := c.cc.Invoke(ctx, AIService_ListPromptTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) UpsertPromptTemplate(ctx context.Context, in *UpsertPromptTemplateRequest, opts ...grpc.CallOption) (*PromptTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromptTemplate)
+	err := c.cc.Invoke(ctx, AIService_UpsertPromptTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) DeletePromptTemplate(ctx context.Context, in *DeletePromptTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AIService_DeletePromptTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AIServiceServer is the server API for AIService service.
 // All implementations must embed UnimplementedAIServiceServer
 // for forward compatibility.
 type AIServiceServer interface {
 	// GenerateAISummary generates an AI summary for memos within a specified time range and tags.
 	GenerateAISummary(context.Context, *GenerateAISummaryRequest) (*Memo, error)
+	// SummarizeToday generates an AI summary of the memos the current user created today, from midnight in their
+	// timezone, with the tags and the visibility of their AI auto summary setting.
+	SummarizeToday(context.Context, *SummarizeTodayRequest) (*Memo, error)
+	// GenerateWorkspaceAISummary generates a digest of the memos the users shared with the workspace in a time
+	// range, grouped by author and tag, posted as a pinned memo of the system bot. Only the host may generate it.
+	GenerateWorkspaceAISummary(context.Context, *GenerateWorkspaceAISummaryRequest) (*Memo, error)
+	// StreamAISummary generates an AI summary like GenerateAISummary, streaming the summary text as the
+	// AI provider generates it. The last message carries the created memo.
+	StreamAISummary(*GenerateAISummaryRequest, grpc.ServerStreamingServer[StreamAISummaryResponse]) error
+	// EnqueueAISummary queues the generation of an AI summary and returns the job right away. The job is
+	// processed in the background, its state is polled with GetAIJob.
+	EnqueueAISummary(context.Context, *GenerateAISummaryRequest) (*AIJob, error)
+	// GetAIJob gets an AI job of the current user.
+	GetAIJob(context.Context, *GetAIJobRequest) (*AIJob, error)
+	// ListAIJobs lists the AI jobs of the current user, most recent first.
+	ListAIJobs(context.Context, *ListAIJobsRequest) (*ListAIJobsResponse, error)
+	// PreviewAISummary builds the prompt GenerateAISummary would send for the same request and estimates
+	// its token count and cost, without calling the AI provider or counting against the rate limit.
+	PreviewAISummary(context.Context, *GenerateAISummaryRequest) (*AISummaryPreview, error)
+	// RefineAISummary re-generates an AI summary memo with a follow-up instruction, e.g. "make it shorter",
+	// continuing the conversation of its source memos and previous refinements. The memo is updated in place.
+	RefineAISummary(context.Context, *RefineAISummaryRequest) (*Memo, error)
+	// RegenerateAISummary re-runs an AI summary memo, with the same source memos or the memos of the same time range
+	// refreshed. The memo is updated in place and its previous content is kept as a version.
+	RegenerateAISummary(context.Context, *RegenerateAISummaryRequest) (*Memo, error)
+	// ListAIMemoVersions lists the previous versions of an AI summary memo, most recent first, to compare them
+	// with the current content or roll back to one.
+	ListAIMemoVersions(context.Context, *ListAIMemoVersionsRequest) (*ListAIMemoVersionsResponse, error)
+	// ChatWithMemos answers a question about the user's memos, grounded in the memos the most relevant
+	// to it, and cites them. The conversation is kept so that follow-up questions keep its context.
+	ChatWithMemos(context.Context, *ChatWithMemosRequest) (*ChatWithMemosResponse, error)
+	// SuggestTagMerges compares the tags of the user's memos with embeddings and suggests merging the
+	// near-duplicate ones, e.g. #todos into #todo. The suggestions can be applied with RenameMemoTag.
+	SuggestTagMerges(context.Context, *SuggestTagMergesRequest) (*SuggestTagMergesResponse, error)
+	// SuggestMemoTags suggests tags for the content of a memo, preferring the tags the user already uses.
+	SuggestMemoTags(context.Context, *SuggestMemoTagsRequest) (*SuggestMemoTagsResponse, error)
+	// GenerateMemoInsights extracts the action items, decisions, open questions and topics of the user's memos,
+	// each referencing its source memos, as a structure rather than the prose of a summary.
+	GenerateMemoInsights(context.Context, *GenerateMemoInsightsRequest) (*MemoInsights, error)
+	// TransformMemo translates or rewrites the content of a memo visible to the current user. The result is returned,
+	// or saved as a new memo of the current user related to the original one if requested.
+	TransformMemo(context.Context, *TransformMemoRequest) (*TransformMemoResponse, error)
 	// TestAIConfig tests the AI configuration by sending a test request to the AI provider.
 	TestAIConfig(context.Context, *TestAIConfigRequest) (*TestAIConfigResponse, error)
 	// GetMemoSourceMemos retrieves the source memos that were used to generate an AI summary.
 	GetMemoSourceMemos(context.Context, *GetMemoSourceMemosRequest) (*GetMemoSourceMemosResponse, error)
+	// ExportAISummaries exports the AI summaries of the current user with their source memos, as a zip of Markdown
+	// files with front matter and a relations.json file mapping each summary to its source memos.
+	ExportAISummaries(context.Context, *ExportAISummariesRequest) (*httpbody.HttpBody, error)
+	// ExportAIInteractions exports the log of the AI interactions of the current user as JSON: the memos whose content
+	// was sent to the AI provider by each audited action, and every call to the provider with its model and tokens.
+	ExportAIInteractions(context.Context, *ExportAIInteractionsRequest) (*httpbody.HttpBody, error)
+	// SynthesizeMemoAudio renders a memo or a daily digest of the user's memos to speech, saved as an
+	// MP3 audio attachment. The attachment belongs to the memo when the user is its creator.
+	SynthesizeMemoAudio(context.Context, *SynthesizeMemoAudioRequest) (*Attachment, error)
+	// CreateVoiceMemo transcribes an audio recording, structures the transcript into Markdown with tags
+	// and creates a memo with the recording attached.
+	CreateVoiceMemo(context.Context, *CreateVoiceMemoRequest) (*Memo, error)
+	// GetAIUsage returns the AI requests the current user made and may still make in the hour and in the day.
+	GetAIUsage(context.Context, *GetAIUsageRequest) (*AIUsage, error)
+	// GetAIProviderStatus returns the health of the AI provider from the recent calls to it.
+	// Only available to admins.
+	GetAIProviderStatus(context.Context, *GetAIProviderStatusRequest) (*AIProviderStatus, error)
+	// ListAIUsage lists the calls to the AI provider, most recent first.
+	// Only available to admins.
+	ListAIUsage(context.Context, *ListAIUsageRequest) (*ListAIUsageResponse, error)
+	// GetAIUsageStats sums up the calls to the AI provider per user and per operation.
+	// Only available to admins.
+	GetAIUsageStats(context.Context, *GetAIUsageStatsRequest) (*AIUsageStats, error)
+	// ListAIDebugLogs lists the prompts and responses stored while the AI debug logging is on, most recent first.
+	// Only available to admins.
+	ListAIDebugLogs(context.Context, *ListAIDebugLogsRequest) (*ListAIDebugLogsResponse, error)
+	// PurgeAIDebugLogs deletes the stored prompts and responses.
+	// Only available to admins.
+	PurgeAIDebugLogs(context.Context, *PurgeAIDebugLogsRequest) (*PurgeAIDebugLogsResponse, error)
+	// ListAIAuditLogs lists the audited AI actions, most recent first: the changes and the tests of the AI
+	// configuration, and the summaries and the chat questions sending memo content to the AI provider.
+	// Only available to admins.
+	ListAIAuditLogs(context.Context, *ListAIAuditLogsRequest) (*ListAIAuditLogsResponse, error)
+	// ListPromptTemplates lists the prompt templates of the workspace and of the current user.
+	ListPromptTemplates(context.Context, *ListPromptTemplatesRequest) (*ListPromptTemplatesResponse, error)
+	// UpsertPromptTemplate creates a prompt template or replaces the one with the same name.
+	// Only admins may upsert the workspace templates.
+	UpsertPromptTemplate(context.Context, *UpsertPromptTemplateRequest) (*PromptTemplate, error)
+	// DeletePromptTemplate deletes a prompt template.
+	// Only admins may delete the workspace templates.
+	DeletePromptTemplate(context.Context, *DeletePromptTemplateRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAIServiceServer()
 }
 
@@ -97,12 +593,99 @@ type UnimplementedAIServiceServer struct{}
 func (UnimplementedAIServiceServer) GenerateAISummary(context.Context, *GenerateAISummaryRequest) (*Memo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateAISummary not implemented")
 }
+func (UnimplementedAIServiceServer) SummarizeToday(context.Context, *SummarizeTodayRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SummarizeToday not implemented")
+}
+func (UnimplementedAIServiceServer) GenerateWorkspaceAISummary(context.Context, *GenerateWorkspaceAISummaryRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateWorkspaceAISummary not implemented")
+}
+func (UnimplementedAIServiceServer) StreamAISummary(*GenerateAISummaryRequest, grpc.ServerStreamingServer[StreamAISummaryResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAISummary not implemented")
+}
+func (UnimplementedAIServiceServer) EnqueueAISummary(context.Context, *GenerateAISummaryRequest) (*AIJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnqueueAISummary not implemented")
+}
+func (UnimplementedAIServiceServer) GetAIJob(context.Context, *GetAIJobRequest) (*AIJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAIJob not implemented")
+}
+func (UnimplementedAIServiceServer) ListAIJobs(context.Context, *ListAIJobsRequest) (*ListAIJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAIJobs not implemented")
+}
+func (UnimplementedAIServiceServer) PreviewAISummary(context.Context, *GenerateAISummaryRequest) (*AISummaryPreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewAISummary not implemented")
+}
+func (UnimplementedAIServiceServer) RefineAISummary(context.Context, *RefineAISummaryRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefineAISummary not implemented")
+}
+func (UnimplementedAIServiceServer) RegenerateAISummary(context.Context, *RegenerateAISummaryRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateAISummary not implemented")
+}
+func (UnimplementedAIServiceServer) ListAIMemoVersions(context.Context, *ListAIMemoVersionsRequest) (*ListAIMemoVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAIMemoVersions not implemented")
+}
+func (UnimplementedAIServiceServer) ChatWithMemos(context.Context, *ChatWithMemosRequest) (*ChatWithMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChatWithMemos not implemented")
+}
+func (UnimplementedAIServiceServer) SuggestTagMerges(context.Context, *SuggestTagMergesRequest) (*SuggestTagMergesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestTagMerges not implemented")
+}
+func (UnimplementedAIServiceServer) SuggestMemoTags(context.Context, *SuggestMemoTagsRequest) (*SuggestMemoTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestMemoTags not implemented")
+}
+func (UnimplementedAIServiceServer) GenerateMemoInsights(context.Context, *GenerateMemoInsightsRequest) (*MemoInsights, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateMemoInsights not implemented")
+}
+func (UnimplementedAIServiceServer) TransformMemo(context.Context, *TransformMemoRequest) (*TransformMemoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransformMemo not implemented")
+}
 func (UnimplementedAIServiceServer) TestAIConfig(context.Context, *TestAIConfigRequest) (*TestAIConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TestAIConfig not implemented")
 }
 func (UnimplementedAIServiceServer) GetMemoSourceMemos(context.Context, *GetMemoSourceMemosRequest) (*GetMemoSourceMemosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMemoSourceMemos not implemented")
 }
+func (UnimplementedAIServiceServer) ExportAISummaries(context.Context, *ExportAISummariesRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAISummaries not implemented")
+}
+func (UnimplementedAIServiceServer) ExportAIInteractions(context.Context, *ExportAIInteractionsRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAIInteractions not implemented")
+}
+func (UnimplementedAIServiceServer) SynthesizeMemoAudio(context.Context, *SynthesizeMemoAudioRequest) (*Attachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SynthesizeMemoAudio not implemented")
+}
+func (UnimplementedAIServiceServer) CreateVoiceMemo(context.Context, *CreateVoiceMemoRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVoiceMemo not implemented")
+}
+func (UnimplementedAIServiceServer) GetAIUsage(context.Context, *GetAIUsageRequest) (*AIUsage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAIUsage not implemented")
+}
+func (UnimplementedAIServiceServer) GetAIProviderStatus(context.Context, *GetAIProviderStatusRequest) (*AIProviderStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAIProviderStatus not implemented")
+}
+func (UnimplementedAIServiceServer) ListAIUsage(context.Context, *ListAIUsageRequest) (*ListAIUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAIUsage not implemented")
+}
+func (UnimplementedAIServiceServer) GetAIUsageStats(context.Context, *GetAIUsageStatsRequest) (*AIUsageStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAIUsageStats not implemented")
+}
+func (UnimplementedAIServiceServer) ListAIDebugLogs(context.Context, *ListAIDebugLogsRequest) (*ListAIDebugLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAIDebugLogs not implemented")
+}
+func (UnimplementedAIServiceServer) PurgeAIDebugLogs(context.Context, *PurgeAIDebugLogsRequest) (*PurgeAIDebugLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeAIDebugLogs not implemented")
+}
+func (UnimplementedAIServiceServer) ListAIAuditLogs(context.Context, *ListAIAuditLogsRequest) (*ListAIAuditLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAIAuditLogs not implemented")
+}
+func (UnimplementedAIServiceServer) ListPromptTemplates(context.Context, *ListPromptTemplatesRequest) (*ListPromptTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromptTemplates not implemented")
+}
+func (UnimplementedAIServiceServer) UpsertPromptTemplate(context.Context, *UpsertPromptTemplateRequest) (*PromptTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertPromptTemplate not implemented")
+}
+func (UnimplementedAIServiceServer) DeletePromptTemplate(context.Context, *DeletePromptTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePromptTemplate not implemented")
+}
 func (UnimplementedAIServiceServer) mustEmbedUnimplementedAIServiceServer() {}
 func (UnimplementedAIServiceServer) testEmbeddedByValue()                   {}
 
@@ -142,53 +725,624 @@ func _AIService_GenerateAISummary_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AIService_TestAIConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TestAIConfigRequest)
+func _AIService_SummarizeToday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SummarizeTodayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AIServiceServer).TestAIConfig(ctx, in)
+		return srv.(AIServiceServer).SummarizeToday(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AIService_TestAIConfig_FullMethodName,
+		FullMethod: AIService_SummarizeToday_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AIServiceServer).TestAIConfig(ctx, req.(*TestAIConfigRequest))
+		return srv.(AIServiceServer).SummarizeToday(ctx, req.(*SummarizeTodayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AIService_GetMemoSourceMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMemoSourceMemosRequest)
+func _AIService_GenerateWorkspaceAISummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateWorkspaceAISummaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AIServiceServer).GetMemoSourceMemos(ctx, in)
+		return srv.(AIServiceServer).GenerateWorkspaceAISummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AIService_GetMemoSourceMemos_FullMethodName,
+		FullMethod: AIService_GenerateWorkspaceAISummary_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AIServiceServer).GetMemoSourceMemos(ctx, req.(*GetMemoSourceMemosRequest))
+		return srv.(AIServiceServer).GenerateWorkspaceAISummary(ctx, req.(*GenerateWorkspaceAISummaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// AIService_ServiceDesc is the grpc.ServiceDesc for AIService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var AIService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "memos.api.v1.AIService",
+func _AIService_StreamAISummary_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateAISummaryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AIServiceServer).StreamAISummary(m, &grpc.GenericServerStream[GenerateAISummaryRequest, StreamAISummaryResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AIService_StreamAISummaryServer = grpc.ServerStreamingServer[StreamAISummaryResponse]
+
+func _AIService_EnqueueAISummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateAISummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).EnqueueAISummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_EnqueueAISummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).EnqueueAISummary(ctx, req.(*GenerateAISummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_GetAIJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAIJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).GetAIJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_GetAIJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).GetAIJob(ctx, req.(*GetAIJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ListAIJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAIJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ListAIJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ListAIJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ListAIJobs(ctx, req.(*ListAIJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_PreviewAISummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateAISummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).PreviewAISummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_PreviewAISummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).PreviewAISummary(ctx, req.(*GenerateAISummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_RefineAISummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefineAISummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).RefineAISummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_RefineAISummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).RefineAISummary(ctx, req.(*RefineAISummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_RegenerateAISummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateAISummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).RegenerateAISummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_RegenerateAISummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).RegenerateAISummary(ctx, req.(*RegenerateAISummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ListAIMemoVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAIMemoVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ListAIMemoVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ListAIMemoVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ListAIMemoVersions(ctx, req.(*ListAIMemoVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ChatWithMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatWithMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ChatWithMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ChatWithMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ChatWithMemos(ctx, req.(*ChatWithMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_SuggestTagMerges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestTagMergesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).SuggestTagMerges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_SuggestTagMerges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).SuggestTagMerges(ctx, req.(*SuggestTagMergesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_SuggestMemoTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestMemoTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).SuggestMemoTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_SuggestMemoTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).SuggestMemoTags(ctx, req.(*SuggestMemoTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_GenerateMemoInsights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateMemoInsightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).GenerateMemoInsights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_GenerateMemoInsights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).GenerateMemoInsights(ctx, req.(*GenerateMemoInsightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_TransformMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransformMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).TransformMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_TransformMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).TransformMemo(ctx, req.(*TransformMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_TestAIConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestAIConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).TestAIConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_TestAIConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).TestAIConfig(ctx, req.(*TestAIConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_GetMemoSourceMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoSourceMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).GetMemoSourceMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_GetMemoSourceMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).GetMemoSourceMemos(ctx, req.(*GetMemoSourceMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ExportAISummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAISummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ExportAISummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ExportAISummaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ExportAISummaries(ctx, req.(*ExportAISummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ExportAIInteractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAIInteractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ExportAIInteractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ExportAIInteractions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ExportAIInteractions(ctx, req.(*ExportAIInteractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_SynthesizeMemoAudio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SynthesizeMemoAudioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).SynthesizeMemoAudio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_SynthesizeMemoAudio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).SynthesizeMemoAudio(ctx, req.(*SynthesizeMemoAudioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_CreateVoiceMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVoiceMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).CreateVoiceMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_CreateVoiceMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).CreateVoiceMemo(ctx, req.(*CreateVoiceMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_GetAIUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAIUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).GetAIUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_GetAIUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).GetAIUsage(ctx, req.(*GetAIUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_GetAIProviderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAIProviderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).GetAIProviderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_GetAIProviderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).GetAIProviderStatus(ctx, req.(*GetAIProviderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ListAIUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAIUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ListAIUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ListAIUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ListAIUsage(ctx, req.(*ListAIUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_GetAIUsageStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAIUsageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).GetAIUsageStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_GetAIUsageStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).GetAIUsageStats(ctx, req.(*GetAIUsageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ListAIDebugLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAIDebugLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ListAIDebugLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ListAIDebugLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ListAIDebugLogs(ctx, req.(*ListAIDebugLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_PurgeAIDebugLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeAIDebugLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).PurgeAIDebugLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_PurgeAIDebugLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).PurgeAIDebugLogs(ctx, req.(*PurgeAIDebugLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ListAIAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAIAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ListAIAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ListAIAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ListAIAuditLogs(ctx, req.(*ListAIAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ListPromptTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromptTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ListPromptTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ListPromptTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ListPromptTemplates(ctx, req.(*ListPromptTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_UpsertPromptTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertPromptTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).UpsertPromptTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_UpsertPromptTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).UpsertPromptTemplate(ctx, req.(*UpsertPromptTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_DeletePromptTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePromptTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).DeletePromptTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_DeletePromptTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).DeletePromptTemplate(ctx, req.(*DeletePromptTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AIService_ServiceDesc is the grpc.ServiceDesc for AIService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AIService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.AIService",
 	HandlerType: (*AIServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "GenerateAISummary",
 			Handler:    _AIService_GenerateAISummary_Handler,
 		},
+		{
+			MethodName: "SummarizeToday",
+			Handler:    _AIService_SummarizeToday_Handler,
+		},
+		{
+			MethodName: "GenerateWorkspaceAISummary",
+			Handler:    _AIService_GenerateWorkspaceAISummary_Handler,
+		},
+		{
+			MethodName: "EnqueueAISummary",
+			Handler:    _AIService_EnqueueAISummary_Handler,
+		},
+		{
+			MethodName: "GetAIJob",
+			Handler:    _AIService_GetAIJob_Handler,
+		},
+		{
+			MethodName: "ListAIJobs",
+			Handler:    _AIService_ListAIJobs_Handler,
+		},
+		{
+			MethodName: "PreviewAISummary",
+			Handler:    _AIService_PreviewAISummary_Handler,
+		},
+		{
+			MethodName: "RefineAISummary",
+			Handler:    _AIService_RefineAISummary_Handler,
+		},
+		{
+			MethodName: "RegenerateAISummary",
+			Handler:    _AIService_RegenerateAISummary_Handler,
+		},
+		{
+			MethodName: "ListAIMemoVersions",
+			Handler:    _AIService_ListAIMemoVersions_Handler,
+		},
+		{
+			MethodName: "ChatWithMemos",
+			Handler:    _AIService_ChatWithMemos_Handler,
+		},
+		{
+			MethodName: "SuggestTagMerges",
+			Handler:    _AIService_SuggestTagMerges_Handler,
+		},
+		{
+			MethodName: "SuggestMemoTags",
+			Handler:    _AIService_SuggestMemoTags_Handler,
+		},
+		{
+			MethodName: "GenerateMemoInsights",
+			Handler:    _AIService_GenerateMemoInsights_Handler,
+		},
+		{
+			MethodName: "TransformMemo",
+			Handler:    _AIService_TransformMemo_Handler,
+		},
 		{
 			MethodName: "TestAIConfig",
 			Handler:    _AIService_TestAIConfig_Handler,
@@ -197,7 +1351,69 @@ var AIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMemoSourceMemos",
 			Handler:    _AIService_GetMemoSourceMemos_Handler,
 		},
+		{
+			MethodName: "ExportAISummaries",
+			Handler:    _AIService_ExportAISummaries_Handler,
+		},
+		{
+			MethodName: "ExportAIInteractions",
+			Handler:    _AIService_ExportAIInteractions_Handler,
+		},
+		{
+			MethodName: "SynthesizeMemoAudio",
+			Handler:    _AIService_SynthesizeMemoAudio_Handler,
+		},
+		{
+			MethodName: "CreateVoiceMemo",
+			Handler:    _AIService_CreateVoiceMemo_Handler,
+		},
+		{
+			MethodName: "GetAIUsage",
+			Handler:    _AIService_GetAIUsage_Handler,
+		},
+		{
+			MethodName: "GetAIProviderStatus",
+			Handler:    _AIService_GetAIProviderStatus_Handler,
+		},
+		{
+			MethodName: "ListAIUsage",
+			Handler:    _AIService_ListAIUsage_Handler,
+		},
+		{
+			MethodName: "GetAIUsageStats",
+			Handler:    _AIService_GetAIUsageStats_Handler,
+		},
+		{
+			MethodName: "ListAIDebugLogs",
+			Handler:    _AIService_ListAIDebugLogs_Handler,
+		},
+		{
+			MethodName: "PurgeAIDebugLogs",
+			Handler:    _AIService_PurgeAIDebugLogs_Handler,
+		},
+		{
+			MethodName: "ListAIAuditLogs",
+			Handler:    _AIService_ListAIAuditLogs_Handler,
+		},
+		{
+			MethodName: "ListPromptTemplates",
+			Handler:    _AIService_ListPromptTemplates_Handler,
+		},
+		{
+			MethodName: "UpsertPromptTemplate",
+			Handler:    _AIService_UpsertPromptTemplate_Handler,
+		},
+		{
+			MethodName: "DeletePromptTemplate",
+			Handler:    _AIService_DeletePromptTemplate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAISummary",
+			Handler:       _AIService_StreamAISummary_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/ai_service.proto",
 }
@@ -11,6 +11,7 @@ import (
 	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -45,7 +46,16 @@ type Attachment struct {
 	Size int64 `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
 	// Optional. The related memo. Refer to `Memo.name`.
 	// Format: memos/{memo}
-	Memo          *string `protobuf:"bytes,8,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	Memo *string `protobuf:"bytes,8,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	// Output only. Whether the attachment was tagged as sensitive by the classifier.
+	// Clients should blur sensitive attachments until the viewer chooses to reveal them.
+	Sensitive bool `protobuf:"varint,9,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	// Output only. The metadata extracted from the file at upload, unset when none could be extracted.
+	Metadata *Attachment_Metadata `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Optional. The caption of the attachment, e.g. the caption of a figure in the exports.
+	Caption string `protobuf:"bytes,11,opt,name=caption,proto3" json:"caption,omitempty"`
+	// Optional. The text alternative of an image or video attachment for the assistive technologies.
+	AltText       string `protobuf:"bytes,12,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -136,6 +146,34 @@ func (x *Attachment) GetMemo() string {
 	return ""
 }
 
+func (x *Attachment) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+func (x *Attachment) GetMetadata() *Attachment_Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Attachment) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *Attachment) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
 type CreateAttachmentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The attachment to create.
@@ -201,10 +239,9 @@ type ListAttachmentsRequest struct {
 	// Optional. A page token, received from a previous `ListAttachments` call.
 	// Provide this to retrieve the subsequent page.
 	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Optional. Filter to apply to the list results.
-	// Example: "type=image/png" or "filename:*.jpg"
-	// Supported operators: =, !=, <, <=, >, >=, :
-	// Supported fields: filename, type, size, create_time, memo
+	// Optional. Filter to apply to the list results, a CEL expression.
+	// Example: `mime_type.contains("image/") && size > 1024 && created_ts > now() - 60 * 60 * 24 * 30`
+	// Supported fields: filename, mime_type, size, created_ts
 	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	// Optional. The order to sort results by.
 	// Example: "create_time desc" or "filename asc"
@@ -335,6 +372,126 @@ func (x *ListAttachmentsResponse) GetTotalSize() int32 {
 	return 0
 }
 
+type ListMediaAttachmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of attachments to return.
+	// If unspecified, at most 50 attachments will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListMediaAttachments` call.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Filter to apply to the list results, with the same syntax as in `ListAttachments`.
+	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMediaAttachmentsRequest) Reset() {
+	*x = ListMediaAttachmentsRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMediaAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMediaAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListMediaAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMediaAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMediaAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMediaAttachmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMediaAttachmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMediaAttachmentsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type ListMediaAttachmentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of media attachments.
+	Attachments []*Attachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMediaAttachmentsResponse) Reset() {
+	*x = ListMediaAttachmentsResponse{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMediaAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMediaAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListMediaAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMediaAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMediaAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMediaAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *ListMediaAttachmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetAttachmentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The attachment name of the attachment to retrieve.
@@ -346,7 +503,7 @@ type GetAttachmentRequest struct {
 
 func (x *GetAttachmentRequest) Reset() {
 	*x = GetAttachmentRequest{}
-	mi := &file_api_v1_attachment_service_proto_msgTypes[4]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -358,7 +515,7 @@ func (x *GetAttachmentRequest) String() string {
 func (*GetAttachmentRequest) ProtoMessage() {}
 
 func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_attachment_service_proto_msgTypes[4]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +528,7 @@ func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAttachmentRequest.ProtoReflect.Descriptor instead.
 func (*GetAttachmentRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetAttachmentRequest) GetName() string {
@@ -389,14 +546,24 @@ type GetAttachmentBinaryRequest struct {
 	// The filename of the attachment. Mainly used for downloading.
 	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
 	// Optional. A flag indicating if the thumbnail version of the attachment should be returned.
-	Thumbnail     bool `protobuf:"varint,3,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Thumbnail bool `protobuf:"varint,3,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	// Optional. Whether to return a sensitive attachment as is when the workspace policy blurs it.
+	Reveal bool `protobuf:"varint,4,opt,name=reveal,proto3" json:"reveal,omitempty"`
+	// Optional. The expiration of a signed URL, in seconds since the epoch.
+	Expires int64 `protobuf:"varint,5,opt,name=expires,proto3" json:"expires,omitempty"`
+	// Optional. The user who signed the URL, whose access the URL grants.
+	// Format: users/{user}
+	Signer string `protobuf:"bytes,6,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Optional. The signature of a signed URL. The attachment is served with the access of the signer
+	// until the expiration, without authentication.
+	Signature     string `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAttachmentBinaryRequest) Reset() {
 	*x = GetAttachmentBinaryRequest{}
-	mi := &file_api_v1_attachment_service_proto_msgTypes[5]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -408,7 +575,7 @@ func (x *GetAttachmentBinaryRequest) String() string {
 func (*GetAttachmentBinaryRequest) ProtoMessage() {}
 
 func (x *GetAttachmentBinaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_attachment_service_proto_msgTypes[5]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,7 +588,7 @@ func (x *GetAttachmentBinaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAttachmentBinaryRequest.ProtoReflect.Descriptor instead.
 func (*GetAttachmentBinaryRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetAttachmentBinaryRequest) GetName() string {
@@ -445,11 +612,251 @@ func (x *GetAttachmentBinaryRequest) GetThumbnail() bool {
 	return false
 }
 
+func (x *GetAttachmentBinaryRequest) GetReveal() bool {
+	if x != nil {
+		return x.Reveal
+	}
+	return false
+}
+
+func (x *GetAttachmentBinaryRequest) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *GetAttachmentBinaryRequest) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *GetAttachmentBinaryRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type CreateAttachmentSignedUrlRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The attachment name of the attachment.
+	// Format: attachments/{attachment}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. How long the URL is valid, 15 minutes when unset and 24 hours at most.
+	Ttl           *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttachmentSignedUrlRequest) Reset() {
+	*x = CreateAttachmentSignedUrlRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttachmentSignedUrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttachmentSignedUrlRequest) ProtoMessage() {}
+
+func (x *CreateAttachmentSignedUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttachmentSignedUrlRequest.ProtoReflect.Descriptor instead.
+func (*CreateAttachmentSignedUrlRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateAttachmentSignedUrlRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAttachmentSignedUrlRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type AttachmentSignedUrl struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the attachment binary, relative to the server.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The time the URL expires.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentSignedUrl) Reset() {
+	*x = AttachmentSignedUrl{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentSignedUrl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentSignedUrl) ProtoMessage() {}
+
+func (x *AttachmentSignedUrl) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentSignedUrl.ProtoReflect.Descriptor instead.
+func (*AttachmentSignedUrl) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AttachmentSignedUrl) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AttachmentSignedUrl) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type GetAttachmentTextRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The attachment name of the attachment.
+	// Format: attachments/{attachment}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentTextRequest) Reset() {
+	*x = GetAttachmentTextRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentTextRequest) ProtoMessage() {}
+
+func (x *GetAttachmentTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentTextRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentTextRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAttachmentTextRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AttachmentText struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The attachment name of the attachment.
+	// Format: attachments/{attachment}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The text extracted from the document, or from the image or the audio recording with the AI provider when the
+	// workspace extracts them, empty when none could be extracted.
+	// Only the first 32768 characters are extracted.
+	Text          string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentText) Reset() {
+	*x = AttachmentText{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentText) ProtoMessage() {}
+
+func (x *AttachmentText) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentText.ProtoReflect.Descriptor instead.
+func (*AttachmentText) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AttachmentText) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttachmentText) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
 type UpdateAttachmentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The attachment which replaces the attachment on the server.
 	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
-	// Required. The list of fields to update.
+	// Required. The list of fields to update: filename, caption and alt_text.
 	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -457,7 +864,7 @@ type UpdateAttachmentRequest struct {
 
 func (x *UpdateAttachmentRequest) Reset() {
 	*x = UpdateAttachmentRequest{}
-	mi := &file_api_v1_attachment_service_proto_msgTypes[6]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -469,7 +876,7 @@ func (x *UpdateAttachmentRequest) String() string {
 func (*UpdateAttachmentRequest) ProtoMessage() {}
 
 func (x *UpdateAttachmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_attachment_service_proto_msgTypes[6]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +889,7 @@ func (x *UpdateAttachmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAttachmentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAttachmentRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{6}
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateAttachmentRequest) GetAttachment() *Attachment {
@@ -510,7 +917,7 @@ type DeleteAttachmentRequest struct {
 
 func (x *DeleteAttachmentRequest) Reset() {
 	*x = DeleteAttachmentRequest{}
-	mi := &file_api_v1_attachment_service_proto_msgTypes[7]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -522,7 +929,7 @@ func (x *DeleteAttachmentRequest) String() string {
 func (*DeleteAttachmentRequest) ProtoMessage() {}
 
 func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_attachment_service_proto_msgTypes[7]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,7 +942,7 @@ func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteAttachmentRequest) GetName() string {
@@ -545,11 +952,93 @@ func (x *DeleteAttachmentRequest) GetName() string {
 	return ""
 }
 
+// The metadata of a file. Fields that do not apply to the file type are unset.
+type Attachment_Metadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The width in pixels of an image.
+	Width int32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	// The height in pixels of an image.
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The duration of an audio or video file.
+	Duration *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// The number of pages of a PDF document.
+	PageCount int32 `protobuf:"varint,4,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	// The time the content was originally created, e.g. when a photo was taken.
+	OriginalTime  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=original_time,json=originalTime,proto3" json:"original_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment_Metadata) Reset() {
+	*x = Attachment_Metadata{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment_Metadata) ProtoMessage() {}
+
+func (x *Attachment_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment_Metadata.ProtoReflect.Descriptor instead.
+func (*Attachment_Metadata) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Attachment_Metadata) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Attachment_Metadata) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Attachment_Metadata) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Attachment_Metadata) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *Attachment_Metadata) GetOriginalTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OriginalTime
+	}
+	return nil
+}
+
 var File_api_v1_attachment_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_attachment_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1fapi/v1/attachment_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfb\x02\n" +
+	"\x1fapi/v1/attachment_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf3\x05\n" +
 	"\n" +
 	"Attachment\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12@\n" +
@@ -560,7 +1049,19 @@ const file_api_v1_attachment_service_proto_rawDesc = "" +
 	"\rexternal_link\x18\x05 \x01(\tB\x03\xe0A\x01R\fexternalLink\x12\x17\n" +
 	"\x04type\x18\x06 \x01(\tB\x03\xe0A\x02R\x04type\x12\x17\n" +
 	"\x04size\x18\a \x01(\x03B\x03\xe0A\x03R\x04size\x12\x1c\n" +
-	"\x04memo\x18\b \x01(\tB\x03\xe0A\x01H\x00R\x04memo\x88\x01\x01:O\xeaAL\n" +
+	"\x04memo\x18\b \x01(\tB\x03\xe0A\x01H\x00R\x04memo\x88\x01\x01\x12!\n" +
+	"\tsensitive\x18\t \x01(\bB\x03\xe0A\x03R\tsensitive\x12B\n" +
+	"\bmetadata\x18\n" +
+	" \x01(\v2!.memos.api.v1.Attachment.MetadataB\x03\xe0A\x03R\bmetadata\x12\x1d\n" +
+	"\acaption\x18\v \x01(\tB\x03\xe0A\x01R\acaption\x12\x1e\n" +
+	"\balt_text\x18\f \x01(\tB\x03\xe0A\x01R\aaltText\x1a\xcf\x01\n" +
+	"\bMetadata\x12\x14\n" +
+	"\x05width\x18\x01 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x05R\x06height\x125\n" +
+	"\bduration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x1d\n" +
+	"\n" +
+	"page_count\x18\x04 \x01(\x05R\tpageCount\x12?\n" +
+	"\roriginal_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\foriginalTime:O\xeaAL\n" +
 	"\x17memos.api.v1/Attachment\x12\x18attachments/{attachment}*\vattachments2\n" +
 	"attachmentB\a\n" +
 	"\x05_memo\"\x82\x01\n" +
@@ -579,15 +1080,41 @@ const file_api_v1_attachment_service_proto_rawDesc = "" +
 	"\vattachments\x18\x01 \x03(\v2\x18.memos.api.v1.AttachmentR\vattachments\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize\"K\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\x80\x01\n" +
+	"\x1bListMediaAttachmentsRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
+	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\"\x82\x01\n" +
+	"\x1cListMediaAttachmentsResponse\x12:\n" +
+	"\vattachments\x18\x01 \x03(\v2\x18.memos.api.v1.AttachmentR\vattachments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"K\n" +
 	"\x14GetAttachmentRequest\x123\n" +
 	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
-	"\x17memos.api.v1/AttachmentR\x04name\"\x95\x01\n" +
+	"\x17memos.api.v1/AttachmentR\x04name\"\x91\x02\n" +
 	"\x1aGetAttachmentBinaryRequest\x123\n" +
 	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
 	"\x17memos.api.v1/AttachmentR\x04name\x12\x1f\n" +
 	"\bfilename\x18\x02 \x01(\tB\x03\xe0A\x02R\bfilename\x12!\n" +
-	"\tthumbnail\x18\x03 \x01(\bB\x03\xe0A\x01R\tthumbnail\"\x9a\x01\n" +
+	"\tthumbnail\x18\x03 \x01(\bB\x03\xe0A\x01R\tthumbnail\x12\x1b\n" +
+	"\x06reveal\x18\x04 \x01(\bB\x03\xe0A\x01R\x06reveal\x12\x1d\n" +
+	"\aexpires\x18\x05 \x01(\x03B\x03\xe0A\x01R\aexpires\x12\x1b\n" +
+	"\x06signer\x18\x06 \x01(\tB\x03\xe0A\x01R\x06signer\x12!\n" +
+	"\tsignature\x18\a \x01(\tB\x03\xe0A\x01R\tsignature\"\x89\x01\n" +
+	" CreateAttachmentSignedUrlRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/AttachmentR\x04name\x120\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x01R\x03ttl\"d\n" +
+	"\x13AttachmentSignedUrl\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12;\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"O\n" +
+	"\x18GetAttachmentTextRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/AttachmentR\x04name\"8\n" +
+	"\x0eAttachmentText\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\x9a\x01\n" +
 	"\x17UpdateAttachmentRequest\x12=\n" +
 	"\n" +
 	"attachment\x18\x01 \x01(\v2\x18.memos.api.v1.AttachmentB\x03\xe0A\x02R\n" +
@@ -596,14 +1123,18 @@ const file_api_v1_attachment_service_proto_rawDesc = "" +
 	"updateMask\"N\n" +
 	"\x17DeleteAttachmentRequest\x123\n" +
 	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
-	"\x17memos.api.v1/AttachmentR\x04name2\xe5\x06\n" +
+	"\x17memos.api.v1/AttachmentR\x04name2\xb7\n" +
+	"\n" +
 	"\x11AttachmentService\x12\x89\x01\n" +
 	"\x10CreateAttachment\x12%.memos.api.v1.CreateAttachmentRequest\x1a\x18.memos.api.v1.Attachment\"4\xdaA\n" +
 	"attachment\x82\xd3\xe4\x93\x02!:\n" +
 	"attachment\"\x13/api/v1/attachments\x12{\n" +
-	"\x0fListAttachments\x12$.memos.api.v1.ListAttachmentsRequest\x1a%.memos.api.v1.ListAttachmentsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/attachments\x12z\n" +
+	"\x0fListAttachments\x12$.memos.api.v1.ListAttachmentsRequest\x1a%.memos.api.v1.ListAttachmentsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/attachments\x12\x90\x01\n" +
+	"\x14ListMediaAttachments\x12).memos.api.v1.ListMediaAttachmentsRequest\x1a*.memos.api.v1.ListMediaAttachmentsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/attachments:media\x12z\n" +
 	"\rGetAttachment\x12\".memos.api.v1.GetAttachmentRequest\x1a\x18.memos.api.v1.Attachment\"+\xdaA\x04name\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/{name=attachments/*}\x12\x9e\x01\n" +
-	"\x13GetAttachmentBinary\x12(.memos.api.v1.GetAttachmentBinaryRequest\x1a\x14.google.api.HttpBody\"G\xdaA\x17name,filename,thumbnail\x82\xd3\xe4\x93\x02'\x12%/file/{name=attachments/*}/{filename}\x12\xa9\x01\n" +
+	"\x13GetAttachmentBinary\x12(.memos.api.v1.GetAttachmentBinaryRequest\x1a\x14.google.api.HttpBody\"G\xdaA\x17name,filename,thumbnail\x82\xd3\xe4\x93\x02'\x12%/file/{name=attachments/*}/{filename}\x12\xae\x01\n" +
+	"\x19CreateAttachmentSignedUrl\x12..memos.api.v1.CreateAttachmentSignedUrlRequest\x1a!.memos.api.v1.AttachmentSignedUrl\">\xdaA\x04name\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/{name=attachments/*}:createSignedUrl\x12\x8b\x01\n" +
+	"\x11GetAttachmentText\x12&.memos.api.v1.GetAttachmentTextRequest\x1a\x1c.memos.api.v1.AttachmentText\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#\x12!/api/v1/{name=attachments/*}/text\x12\xa9\x01\n" +
 	"\x10UpdateAttachment\x12%.memos.api.v1.UpdateAttachmentRequest\x1a\x18.memos.api.v1.Attachment\"T\xdaA\x16attachment,update_mask\x82\xd3\xe4\x93\x025:\n" +
 	"attachment2'/api/v1/{attachment.name=attachments/*}\x12~\n" +
 	"\x10DeleteAttachment\x12%.memos.api.v1.DeleteAttachmentRequest\x1a\x16.google.protobuf.Empty\"+\xdaA\x04name\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/{name=attachments/*}B\xae\x01\n" +
@@ -621,44 +1152,64 @@ func file_api_v1_attachment_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_attachment_service_proto_rawDescData
 }
 
-var file_api_v1_attachment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_v1_attachment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_api_v1_attachment_service_proto_goTypes = []any{
-	(*Attachment)(nil),                 // 0: memos.api.v1.Attachment
-	(*CreateAttachmentRequest)(nil),    // 1: memos.api.v1.CreateAttachmentRequest
-	(*ListAttachmentsRequest)(nil),     // 2: memos.api.v1.ListAttachmentsRequest
-	(*ListAttachmentsResponse)(nil),    // 3: memos.api.v1.ListAttachmentsResponse
-	(*GetAttachmentRequest)(nil),       // 4: memos.api.v1.GetAttachmentRequest
-	(*GetAttachmentBinaryRequest)(nil), // 5: memos.api.v1.GetAttachmentBinaryRequest
-	(*UpdateAttachmentRequest)(nil),    // 6: memos.api.v1.UpdateAttachmentRequest
-	(*DeleteAttachmentRequest)(nil),    // 7: memos.api.v1.DeleteAttachmentRequest
-	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),      // 9: google.protobuf.FieldMask
-	(*httpbody.HttpBody)(nil),          // 10: google.api.HttpBody
-	(*emptypb.Empty)(nil),              // 11: google.protobuf.Empty
+	(*Attachment)(nil),                       // 0: memos.api.v1.Attachment
+	(*CreateAttachmentRequest)(nil),          // 1: memos.api.v1.CreateAttachmentRequest
+	(*ListAttachmentsRequest)(nil),           // 2: memos.api.v1.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),          // 3: memos.api.v1.ListAttachmentsResponse
+	(*ListMediaAttachmentsRequest)(nil),      // 4: memos.api.v1.ListMediaAttachmentsRequest
+	(*ListMediaAttachmentsResponse)(nil),     // 5: memos.api.v1.ListMediaAttachmentsResponse
+	(*GetAttachmentRequest)(nil),             // 6: memos.api.v1.GetAttachmentRequest
+	(*GetAttachmentBinaryRequest)(nil),       // 7: memos.api.v1.GetAttachmentBinaryRequest
+	(*CreateAttachmentSignedUrlRequest)(nil), // 8: memos.api.v1.CreateAttachmentSignedUrlRequest
+	(*AttachmentSignedUrl)(nil),              // 9: memos.api.v1.AttachmentSignedUrl
+	(*GetAttachmentTextRequest)(nil),         // 10: memos.api.v1.GetAttachmentTextRequest
+	(*AttachmentText)(nil),                   // 11: memos.api.v1.AttachmentText
+	(*UpdateAttachmentRequest)(nil),          // 12: memos.api.v1.UpdateAttachmentRequest
+	(*DeleteAttachmentRequest)(nil),          // 13: memos.api.v1.DeleteAttachmentRequest
+	(*Attachment_Metadata)(nil),              // 14: memos.api.v1.Attachment.Metadata
+	(*timestamppb.Timestamp)(nil),            // 15: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),              // 16: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),            // 17: google.protobuf.FieldMask
+	(*httpbody.HttpBody)(nil),                // 18: google.api.HttpBody
+	(*emptypb.Empty)(nil),                    // 19: google.protobuf.Empty
 }
 var file_api_v1_attachment_service_proto_depIdxs = []int32{
-	8,  // 0: memos.api.v1.Attachment.create_time:type_name -> google.protobuf.Timestamp
-	0,  // 1: memos.api.v1.CreateAttachmentRequest.attachment:type_name -> memos.api.v1.Attachment
-	0,  // 2: memos.api.v1.ListAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
-	0,  // 3: memos.api.v1.UpdateAttachmentRequest.attachment:type_name -> memos.api.v1.Attachment
-	9,  // 4: memos.api.v1.UpdateAttachmentRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 5: memos.api.v1.AttachmentService.CreateAttachment:input_type -> memos.api.v1.CreateAttachmentRequest
-	2,  // 6: memos.api.v1.AttachmentService.ListAttachments:input_type -> memos.api.v1.ListAttachmentsRequest
-	4,  // 7: memos.api.v1.AttachmentService.GetAttachment:input_type -> memos.api.v1.GetAttachmentRequest
-	5,  // 8: memos.api.v1.AttachmentService.GetAttachmentBinary:input_type -> memos.api.v1.GetAttachmentBinaryRequest
-	6,  // 9: memos.api.v1.AttachmentService.UpdateAttachment:input_type -> memos.api.v1.UpdateAttachmentRequest
-	7,  // 10: memos.api.v1.AttachmentService.DeleteAttachment:input_type -> memos.api.v1.DeleteAttachmentRequest
-	0,  // 11: memos.api.v1.AttachmentService.CreateAttachment:output_type -> memos.api.v1.Attachment
-	3,  // 12: memos.api.v1.AttachmentService.ListAttachments:output_type -> memos.api.v1.ListAttachmentsResponse
-	0,  // 13: memos.api.v1.AttachmentService.GetAttachment:output_type -> memos.api.v1.Attachment
-	10, // 14: memos.api.v1.AttachmentService.GetAttachmentBinary:output_type -> google.api.HttpBody
-	0,  // 15: memos.api.v1.AttachmentService.UpdateAttachment:output_type -> memos.api.v1.Attachment
-	11, // 16: memos.api.v1.AttachmentService.DeleteAttachment:output_type -> google.protobuf.Empty
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	15, // 0: memos.api.v1.Attachment.create_time:type_name -> google.protobuf.Timestamp
+	14, // 1: memos.api.v1.Attachment.metadata:type_name -> memos.api.v1.Attachment.Metadata
+	0,  // 2: memos.api.v1.CreateAttachmentRequest.attachment:type_name -> memos.api.v1.Attachment
+	0,  // 3: memos.api.v1.ListAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
+	0,  // 4: memos.api.v1.ListMediaAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
+	16, // 5: memos.api.v1.CreateAttachmentSignedUrlRequest.ttl:type_name -> google.protobuf.Duration
+	15, // 6: memos.api.v1.AttachmentSignedUrl.expire_time:type_name -> google.protobuf.Timestamp
+	0,  // 7: memos.api.v1.UpdateAttachmentRequest.attachment:type_name -> memos.api.v1.Attachment
+	17, // 8: memos.api.v1.UpdateAttachmentRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16, // 9: memos.api.v1.Attachment.Metadata.duration:type_name -> google.protobuf.Duration
+	15, // 10: memos.api.v1.Attachment.Metadata.original_time:type_name -> google.protobuf.Timestamp
+	1,  // 11: memos.api.v1.AttachmentService.CreateAttachment:input_type -> memos.api.v1.CreateAttachmentRequest
+	2,  // 12: memos.api.v1.AttachmentService.ListAttachments:input_type -> memos.api.v1.ListAttachmentsRequest
+	4,  // 13: memos.api.v1.AttachmentService.ListMediaAttachments:input_type -> memos.api.v1.ListMediaAttachmentsRequest
+	6,  // 14: memos.api.v1.AttachmentService.GetAttachment:input_type -> memos.api.v1.GetAttachmentRequest
+	7,  // 15: memos.api.v1.AttachmentService.GetAttachmentBinary:input_type -> memos.api.v1.GetAttachmentBinaryRequest
+	8,  // 16: memos.api.v1.AttachmentService.CreateAttachmentSignedUrl:input_type -> memos.api.v1.CreateAttachmentSignedUrlRequest
+	10, // 17: memos.api.v1.AttachmentService.GetAttachmentText:input_type -> memos.api.v1.GetAttachmentTextRequest
+	12, // 18: memos.api.v1.AttachmentService.UpdateAttachment:input_type -> memos.api.v1.UpdateAttachmentRequest
+	13, // 19: memos.api.v1.AttachmentService.DeleteAttachment:input_type -> memos.api.v1.DeleteAttachmentRequest
+	0,  // 20: memos.api.v1.AttachmentService.CreateAttachment:output_type -> memos.api.v1.Attachment
+	3,  // 21: memos.api.v1.AttachmentService.ListAttachments:output_type -> memos.api.v1.ListAttachmentsResponse
+	5,  // 22: memos.api.v1.AttachmentService.ListMediaAttachments:output_type -> memos.api.v1.ListMediaAttachmentsResponse
+	0,  // 23: memos.api.v1.AttachmentService.GetAttachment:output_type -> memos.api.v1.Attachment
+	18, // 24: memos.api.v1.AttachmentService.GetAttachmentBinary:output_type -> google.api.HttpBody
+	9,  // 25: memos.api.v1.AttachmentService.CreateAttachmentSignedUrl:output_type -> memos.api.v1.AttachmentSignedUrl
+	11, // 26: memos.api.v1.AttachmentService.GetAttachmentText:output_type -> memos.api.v1.AttachmentText
+	0,  // 27: memos.api.v1.AttachmentService.UpdateAttachment:output_type -> memos.api.v1.Attachment
+	19, // 28: memos.api.v1.AttachmentService.DeleteAttachment:output_type -> google.protobuf.Empty
+	20, // [20:29] is the sub-list for method output_type
+	11, // [11:20] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_attachment_service_proto_init() }
@@ -673,7 +1224,7 @@ func file_api_v1_attachment_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_attachment_service_proto_rawDesc), len(file_api_v1_attachment_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
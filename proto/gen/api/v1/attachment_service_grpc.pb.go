@@ -21,12 +21,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AttachmentService_CreateAttachment_FullMethodName    = "/memos.api.v1.AttachmentService/CreateAttachment"
-	AttachmentService_ListAttachments_FullMethodName     = "/memos.api.v1.AttachmentService/ListAttachments"
-	AttachmentService_GetAttachment_FullMethodName       = "/memos.api.v1.AttachmentService/GetAttachment"
-	AttachmentService_GetAttachmentBinary_FullMethodName = "/memos.api.v1.AttachmentService/GetAttachmentBinary"
-	AttachmentService_UpdateAttachment_FullMethodName    = "/memos.api.v1.AttachmentService/UpdateAttachment"
-	AttachmentService_DeleteAttachment_FullMethodName    = "/memos.api.v1.AttachmentService/DeleteAttachment"
+	AttachmentService_CreateAttachment_FullMethodName          = "/memos.api.v1.AttachmentService/CreateAttachment"
+	AttachmentService_ListAttachments_FullMethodName           = "/memos.api.v1.AttachmentService/ListAttachments"
+	AttachmentService_ListMediaAttachments_FullMethodName      = "/memos.api.v1.AttachmentService/ListMediaAttachments"
+	AttachmentService_GetAttachment_FullMethodName             = "/memos.api.v1.AttachmentService/GetAttachment"
+	AttachmentService_GetAttachmentBinary_FullMethodName       = "/memos.api.v1.AttachmentService/GetAttachmentBinary"
+	AttachmentService_CreateAttachmentSignedUrl_FullMethodName = "/memos.api.v1.AttachmentService/CreateAttachmentSignedUrl"
+	AttachmentService_GetAttachmentText_FullMethodName         = "/memos.api.v1.AttachmentService/GetAttachmentText"
+	AttachmentService_UpdateAttachment_FullMethodName          = "/memos.api.v1.AttachmentService/UpdateAttachment"
+	AttachmentService_DeleteAttachment_FullMethodName          = "/memos.api.v1.AttachmentService/DeleteAttachment"
 )
 
 // AttachmentServiceClient is the client API for AttachmentService service.
@@ -37,11 +40,19 @@ type AttachmentServiceClient interface {
 	CreateAttachment(ctx context.Context, in *CreateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
 	// ListAttachments lists all attachments.
 	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	// ListMediaAttachments lists the image, video and audio attachments of the memos visible to the
+	// current user across the workspace, newest first.
+	ListMediaAttachments(ctx context.Context, in *ListMediaAttachmentsRequest, opts ...grpc.CallOption) (*ListMediaAttachmentsResponse, error)
 	// GetAttachment returns a attachment by name.
 	GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
 	// GetAttachmentBinary returns a attachment binary by name.
 	GetAttachmentBinary(ctx context.Context, in *GetAttachmentBinaryRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// CreateAttachmentSignedUrl returns a short-lived URL of the attachment binary that is served without
+	// authentication, for the media elements that cannot send the credentials of the user.
+	CreateAttachmentSignedUrl(ctx context.Context, in *CreateAttachmentSignedUrlRequest, opts ...grpc.CallOption) (*AttachmentSignedUrl, error)
 	// UpdateAttachment updates a attachment.
+	// GetAttachmentText returns the text extracted from a document attachment, for previews.
+	GetAttachmentText(ctx context.Context, in *GetAttachmentTextRequest, opts ...grpc.CallOption) (*AttachmentText, error)
 	UpdateAttachment(ctx context.Context, in *UpdateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
 	// DeleteAttachment deletes a attachment by name.
 	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -75,6 +86,16 @@ func (c *attachmentServiceClient) ListAttachments(ctx context.Context, in *ListA
 	return out, nil
 }
 
+func (c *attachmentServiceClient) ListMediaAttachments(ctx context.Context, in *ListMediaAttachmentsRequest, opts ...grpc.CallOption) (*ListMediaAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMediaAttachmentsResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_ListMediaAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *attachmentServiceClient) GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Attachment)
@@ -95,6 +116,26 @@ func (c *attachmentServiceClient) GetAttachmentBinary(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *attachmentServiceClient) CreateAttachmentSignedUrl(ctx context.Context, in *CreateAttachmentSignedUrlRequest, opts ...grpc.CallOption) (*AttachmentSignedUrl, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentSignedUrl)
+	err := c.cc.Invoke(ctx, AttachmentService_CreateAttachmentSignedUrl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) GetAttachmentText(ctx context.Context, in *GetAttachmentTextRequest, opts ...grpc.CallOption) (*AttachmentText, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentText)
+	err := c.cc.Invoke(ctx, AttachmentService_GetAttachmentText_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *attachmentServiceClient) UpdateAttachment(ctx context.Context, in *UpdateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Attachment)
@@ -123,11 +164,19 @@ type AttachmentServiceServer interface {
 	CreateAttachment(context.Context, *CreateAttachmentRequest) (*Attachment, error)
 	// ListAttachments lists all attachments.
 	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	// ListMediaAttachments lists the image, video and audio attachments of the memos visible to the
+	// current user across the workspace, newest first.
+	ListMediaAttachments(context.Context, *ListMediaAttachmentsRequest) (*ListMediaAttachmentsResponse, error)
 	// GetAttachment returns a attachment by name.
 	GetAttachment(context.Context, *GetAttachmentRequest) (*Attachment, error)
 	// GetAttachmentBinary returns a attachment binary by name.
 	GetAttachmentBinary(context.Context, *GetAttachmentBinaryRequest) (*httpbody.HttpBody, error)
+	// CreateAttachmentSignedUrl returns a short-lived URL of the attachment binary that is served without
+	// authentication, for the media elements that cannot send the credentials of the user.
+	CreateAttachmentSignedUrl(context.Context, *CreateAttachmentSignedUrlRequest) (*AttachmentSignedUrl, error)
 	// UpdateAttachment updates a attachment.
+	// GetAttachmentText returns the text extracted from a document attachment, for previews.
+	GetAttachmentText(context.Context, *GetAttachmentTextRequest) (*AttachmentText, error)
 	UpdateAttachment(context.Context, *UpdateAttachmentRequest) (*Attachment, error)
 	// DeleteAttachment deletes a attachment by name.
 	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*emptypb.Empty, error)
@@ -147,12 +196,21 @@ func (UnimplementedAttachmentServiceServer) CreateAttachment(context.Context, *C
 func (UnimplementedAttachmentServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
 }
+func (UnimplementedAttachmentServiceServer) ListMediaAttachments(context.Context, *ListMediaAttachmentsRequest) (*ListMediaAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMediaAttachments not implemented")
+}
 func (UnimplementedAttachmentServiceServer) GetAttachment(context.Context, *GetAttachmentRequest) (*Attachment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAttachment not implemented")
 }
 func (UnimplementedAttachmentServiceServer) GetAttachmentBinary(context.Context, *GetAttachmentBinaryRequest) (*httpbody.HttpBody, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAttachmentBinary not implemented")
 }
+func (UnimplementedAttachmentServiceServer) CreateAttachmentSignedUrl(context.Context, *CreateAttachmentSignedUrlRequest) (*AttachmentSignedUrl, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAttachmentSignedUrl not implemented")
+}
+func (UnimplementedAttachmentServiceServer) GetAttachmentText(context.Context, *GetAttachmentTextRequest) (*AttachmentText, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachmentText not implemented")
+}
 func (UnimplementedAttachmentServiceServer) UpdateAttachment(context.Context, *UpdateAttachmentRequest) (*Attachment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAttachment not implemented")
 }
@@ -216,6 +274,24 @@ func _AttachmentService_ListAttachments_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AttachmentService_ListMediaAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMediaAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).ListMediaAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_ListMediaAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).ListMediaAttachments(ctx, req.(*ListMediaAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AttachmentService_GetAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAttachmentRequest)
 	if err := dec(in); err != nil {
@@ -252,6 +328,42 @@ func _AttachmentService_GetAttachmentBinary_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AttachmentService_CreateAttachmentSignedUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttachmentSignedUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).CreateAttachmentSignedUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_CreateAttachmentSignedUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).CreateAttachmentSignedUrl(ctx, req.(*CreateAttachmentSignedUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_GetAttachmentText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).GetAttachmentText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_GetAttachmentText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).GetAttachmentText(ctx, req.(*GetAttachmentTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AttachmentService_UpdateAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateAttachmentRequest)
 	if err := dec(in); err != nil {
@@ -303,6 +415,10 @@ var AttachmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAttachments",
 			Handler:    _AttachmentService_ListAttachments_Handler,
 		},
+		{
+			MethodName: "ListMediaAttachments",
+			Handler:    _AttachmentService_ListMediaAttachments_Handler,
+		},
 		{
 			MethodName: "GetAttachment",
 			Handler:    _AttachmentService_GetAttachment_Handler,
@@ -311,6 +427,14 @@ var AttachmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAttachmentBinary",
 			Handler:    _AttachmentService_GetAttachmentBinary_Handler,
 		},
+		{
+			MethodName: "CreateAttachmentSignedUrl",
+			Handler:    _AttachmentService_CreateAttachmentSignedUrl_Handler,
+		},
+		{
+			MethodName: "GetAttachmentText",
+			Handler:    _AttachmentService_GetAttachmentText_Handler,
+		},
 		{
 			MethodName: "UpdateAttachment",
 			Handler:    _AttachmentService_UpdateAttachment_Handler,
@@ -119,6 +119,59 @@ func (Direction) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_common_proto_rawDescGZIP(), []int{1}
 }
 
+// ArchiveEncryption is how an export or a backup is encrypted with a passphrase.
+type ArchiveEncryption int32
+
+const (
+	// The archive is not encrypted.
+	ArchiveEncryption_ARCHIVE_ENCRYPTION_UNSPECIFIED ArchiveEncryption = 0
+	// A ZIP archive whose entries are encrypted with AES-256, opened by 7-Zip, WinZip or macOS' Archive Utility.
+	ArchiveEncryption_ZIP_AES ArchiveEncryption = 1
+	// The archive encrypted with the passphrase in the age format, decrypted with `age -d`.
+	ArchiveEncryption_AGE ArchiveEncryption = 2
+)
+
+// Enum value maps for ArchiveEncryption.
+var (
+	ArchiveEncryption_name = map[int32]string{
+		0: "ARCHIVE_ENCRYPTION_UNSPECIFIED",
+		1: "ZIP_AES",
+		2: "AGE",
+	}
+	ArchiveEncryption_value = map[string]int32{
+		"ARCHIVE_ENCRYPTION_UNSPECIFIED": 0,
+		"ZIP_AES":                        1,
+		"AGE":                            2,
+	}
+)
+
+func (x ArchiveEncryption) Enum() *ArchiveEncryption {
+	p := new(ArchiveEncryption)
+	*p = x
+	return p
+}
+
+func (x ArchiveEncryption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchiveEncryption) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (ArchiveEncryption) Type() protoreflect.EnumType {
+	return &file_api_v1_common_proto_enumTypes[2]
+}
+
+func (x ArchiveEncryption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchiveEncryption.Descriptor instead.
+func (ArchiveEncryption) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
 // Used internally for obfuscating the page token.
 type PageToken struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -188,7 +241,11 @@ const file_api_v1_common_proto_rawDesc = "" +
 	"\tDirection\x12\x19\n" +
 	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03ASC\x10\x01\x12\b\n" +
-	"\x04DESC\x10\x02B\xa3\x01\n" +
+	"\x04DESC\x10\x02*M\n" +
+	"\x11ArchiveEncryption\x12\"\n" +
+	"\x1eARCHIVE_ENCRYPTION_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aZIP_AES\x10\x01\x12\a\n" +
+	"\x03AGE\x10\x02B\xa3\x01\n" +
 	"\x10com.memos.api.v1B\vCommonProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -203,12 +260,13 @@ func file_api_v1_common_proto_rawDescGZIP() []byte {
 	return file_api_v1_common_proto_rawDescData
 }
 
-var file_api_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_api_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_api_v1_common_proto_goTypes = []any{
-	(State)(0),        // 0: memos.api.v1.State
-	(Direction)(0),    // 1: memos.api.v1.Direction
-	(*PageToken)(nil), // 2: memos.api.v1.PageToken
+	(State)(0),             // 0: memos.api.v1.State
+	(Direction)(0),         // 1: memos.api.v1.Direction
+	(ArchiveEncryption)(0), // 2: memos.api.v1.ArchiveEncryption
+	(*PageToken)(nil),      // 3: memos.api.v1.PageToken
 }
 var file_api_v1_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -228,7 +286,7 @@ func file_api_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_common_proto_rawDesc), len(file_api_v1_common_proto_rawDesc)),
-			NumEnums:      2,
+			NumEnums:      3,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
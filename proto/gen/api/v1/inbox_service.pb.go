@@ -88,6 +88,14 @@ const (
 	Inbox_MEMO_COMMENT Inbox_Type = 1
 	// Version update notification.
 	Inbox_VERSION_UPDATE Inbox_Type = 2
+	// Failed async jobs are accumulating in the dead letter queue.
+	Inbox_DEAD_LETTER_ALERT Inbox_Type = 3
+	// Memo reaction notification, possibly aggregating several reactions.
+	Inbox_MEMO_REACTION Inbox_Type = 4
+	// An announcement of the admins, e.g. about a maintenance window.
+	Inbox_ANNOUNCEMENT Inbox_Type = 5
+	// A memo awaits the approval of the admins.
+	Inbox_MEMO_APPROVAL Inbox_Type = 6
 )
 
 // Enum value maps for Inbox_Type.
@@ -96,11 +104,19 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
 		2: "VERSION_UPDATE",
+		3: "DEAD_LETTER_ALERT",
+		4: "MEMO_REACTION",
+		5: "ANNOUNCEMENT",
+		6: "MEMO_APPROVAL",
 	}
 	Inbox_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"MEMO_COMMENT":     1,
-		"VERSION_UPDATE":   2,
+		"TYPE_UNSPECIFIED":  0,
+		"MEMO_COMMENT":      1,
+		"VERSION_UPDATE":    2,
+		"DEAD_LETTER_ALERT": 3,
+		"MEMO_REACTION":     4,
+		"ANNOUNCEMENT":      5,
+		"MEMO_APPROVAL":     6,
 	}
 )
 
@@ -149,9 +165,11 @@ type Inbox struct {
 	// The type of the inbox notification.
 	Type Inbox_Type `protobuf:"varint,6,opt,name=type,proto3,enum=memos.api.v1.Inbox_Type" json:"type,omitempty"`
 	// Optional. The activity ID associated with this inbox notification.
-	ActivityId    *int32 `protobuf:"varint,7,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ActivityId *int32 `protobuf:"varint,7,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	// Optional. The ID of the announcement of an ANNOUNCEMENT notification.
+	AnnouncementId *int32 `protobuf:"varint,8,opt,name=announcement_id,json=announcementId,proto3,oneof" json:"announcement_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Inbox) Reset() {
@@ -233,6 +251,13 @@ func (x *Inbox) GetActivityId() int32 {
 	return 0
 }
 
+func (x *Inbox) GetAnnouncementId() int32 {
+	if x != nil && x.AnnouncementId != nil {
+		return *x.AnnouncementId
+	}
+	return 0
+}
+
 type ListInboxesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The parent resource whose inboxes will be listed.
@@ -500,7 +525,7 @@ var File_api_v1_inbox_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_inbox_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1aapi/v1/inbox_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x87\x04\n" +
+	"\x1aapi/v1/inbox_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9e\x05\n" +
 	"\x05Inbox\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1b\n" +
 	"\x06sender\x18\x02 \x01(\tB\x03\xe0A\x03R\x06sender\x12\x1f\n" +
@@ -510,18 +535,24 @@ const file_api_v1_inbox_service_proto_rawDesc = "" +
 	"createTime\x121\n" +
 	"\x04type\x18\x06 \x01(\x0e2\x18.memos.api.v1.Inbox.TypeB\x03\xe0A\x03R\x04type\x12)\n" +
 	"\vactivity_id\x18\a \x01(\x05B\x03\xe0A\x01H\x00R\n" +
-	"activityId\x88\x01\x01\":\n" +
+	"activityId\x88\x01\x01\x121\n" +
+	"\x0fannouncement_id\x18\b \x01(\x05B\x03\xe0A\x01H\x01R\x0eannouncementId\x88\x01\x01\":\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06UNREAD\x10\x01\x12\f\n" +
-	"\bARCHIVED\x10\x02\"B\n" +
+	"\bARCHIVED\x10\x02\"\x91\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fMEMO_COMMENT\x10\x01\x12\x12\n" +
-	"\x0eVERSION_UPDATE\x10\x02:>\xeaA;\n" +
+	"\x0eVERSION_UPDATE\x10\x02\x12\x15\n" +
+	"\x11DEAD_LETTER_ALERT\x10\x03\x12\x11\n" +
+	"\rMEMO_REACTION\x10\x04\x12\x10\n" +
+	"\fANNOUNCEMENT\x10\x05\x12\x11\n" +
+	"\rMEMO_APPROVAL\x10\x06:>\xeaA;\n" +
 	"\x12memos.api.v1/Inbox\x12\x0finboxes/{inbox}\x1a\x04name*\ainboxes2\x05inboxB\x0e\n" +
-	"\f_activity_id\"\xca\x01\n" +
+	"\f_activity_idB\x12\n" +
+	"\x10_announcement_id\"\xca\x01\n" +
 	"\x12ListInboxesRequest\x121\n" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/UserR\x06parent\x12 \n" +
@@ -77,91 +77,5098 @@ func (Visibility) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{0}
 }
 
+// MemoScope is the states of the memos a list or a search covers.
+type MemoScope int32
+
+const (
+	MemoScope_MEMO_SCOPE_UNSPECIFIED MemoScope = 0
+	// The normal memos visible to the user.
+	MemoScope_MEMO_SCOPE_NORMAL MemoScope = 1
+	// The archived memos of the user.
+	MemoScope_MEMO_SCOPE_ARCHIVED MemoScope = 2
+	// The normal memos visible to the user and the archived memos of the user.
+	MemoScope_MEMO_SCOPE_ALL MemoScope = 3
+)
+
+// Enum value maps for MemoScope.
+var (
+	MemoScope_name = map[int32]string{
+		0: "MEMO_SCOPE_UNSPECIFIED",
+		1: "MEMO_SCOPE_NORMAL",
+		2: "MEMO_SCOPE_ARCHIVED",
+		3: "MEMO_SCOPE_ALL",
+	}
+	MemoScope_value = map[string]int32{
+		"MEMO_SCOPE_UNSPECIFIED": 0,
+		"MEMO_SCOPE_NORMAL":      1,
+		"MEMO_SCOPE_ARCHIVED":    2,
+		"MEMO_SCOPE_ALL":         3,
+	}
+)
+
+func (x MemoScope) Enum() *MemoScope {
+	p := new(MemoScope)
+	*p = x
+	return p
+}
+
+func (x MemoScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[1].Descriptor()
+}
+
+func (MemoScope) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[1]
+}
+
+func (x MemoScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoScope.Descriptor instead.
+func (MemoScope) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1}
+}
+
+// The type of the response, from the class of its link to the memo.
+type Webmention_Type int32
+
+const (
+	Webmention_TYPE_UNSPECIFIED Webmention_Type = 0
+	// A page linking to the memo.
+	Webmention_MENTION Webmention_Type = 1
+	// A reply to the memo, linking to it with the u-in-reply-to class.
+	Webmention_REPLY Webmention_Type = 2
+	// A like of the memo, linking to it with the u-like-of class.
+	Webmention_LIKE Webmention_Type = 3
+	// A repost of the memo, linking to it with the u-repost-of class.
+	Webmention_REPOST Webmention_Type = 4
+	// A bookmark of the memo, linking to it with the u-bookmark-of class.
+	Webmention_BOOKMARK Webmention_Type = 5
+)
+
+// Enum value maps for Webmention_Type.
+var (
+	Webmention_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "MENTION",
+		2: "REPLY",
+		3: "LIKE",
+		4: "REPOST",
+		5: "BOOKMARK",
+	}
+	Webmention_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"MENTION":          1,
+		"REPLY":            2,
+		"LIKE":             3,
+		"REPOST":           4,
+		"BOOKMARK":         5,
+	}
+)
+
+func (x Webmention_Type) Enum() *Webmention_Type {
+	p := new(Webmention_Type)
+	*p = x
+	return p
+}
+
+func (x Webmention_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Webmention_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[2].Descriptor()
+}
+
+func (Webmention_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[2]
+}
+
+func (x Webmention_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Webmention_Type.Descriptor instead.
+func (Webmention_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// The action taken on a memo when it expires.
+type Memo_ExpiryAction int32
+
+const (
+	Memo_EXPIRY_ACTION_UNSPECIFIED Memo_ExpiryAction = 0
+	// The memo is archived.
+	Memo_ARCHIVE Memo_ExpiryAction = 1
+	// The memo is deleted with its comments and attachments.
+	Memo_DELETE Memo_ExpiryAction = 2
+)
+
+// Enum value maps for Memo_ExpiryAction.
+var (
+	Memo_ExpiryAction_name = map[int32]string{
+		0: "EXPIRY_ACTION_UNSPECIFIED",
+		1: "ARCHIVE",
+		2: "DELETE",
+	}
+	Memo_ExpiryAction_value = map[string]int32{
+		"EXPIRY_ACTION_UNSPECIFIED": 0,
+		"ARCHIVE":                   1,
+		"DELETE":                    2,
+	}
+)
+
+func (x Memo_ExpiryAction) Enum() *Memo_ExpiryAction {
+	p := new(Memo_ExpiryAction)
+	*p = x
+	return p
+}
+
+func (x Memo_ExpiryAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Memo_ExpiryAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[3].Descriptor()
+}
+
+func (Memo_ExpiryAction) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[3]
+}
+
+func (x Memo_ExpiryAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Memo_ExpiryAction.Descriptor instead.
+func (Memo_ExpiryAction) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// The approval states.
+type Memo_Approval_State int32
+
+const (
+	// Unspecified state.
+	Memo_Approval_STATE_UNSPECIFIED Memo_Approval_State = 0
+	// The memo awaits the approval.
+	Memo_Approval_PENDING Memo_Approval_State = 1
+	// The memo was approved and has its requested visibility.
+	Memo_Approval_APPROVED Memo_Approval_State = 2
+	// The memo was rejected and stays private.
+	Memo_Approval_REJECTED Memo_Approval_State = 3
+)
+
+// Enum value maps for Memo_Approval_State.
+var (
+	Memo_Approval_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "APPROVED",
+		3: "REJECTED",
+	}
+	Memo_Approval_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"APPROVED":          2,
+		"REJECTED":          3,
+	}
+)
+
+func (x Memo_Approval_State) Enum() *Memo_Approval_State {
+	p := new(Memo_Approval_State)
+	*p = x
+	return p
+}
+
+func (x Memo_Approval_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Memo_Approval_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[4].Descriptor()
+}
+
+func (Memo_Approval_State) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[4]
+}
+
+func (x Memo_Approval_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Memo_Approval_State.Descriptor instead.
+func (Memo_Approval_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2, 7, 0}
+}
+
+type SumPropertiesRequest_Period int32
+
+const (
+	SumPropertiesRequest_PERIOD_UNSPECIFIED SumPropertiesRequest_Period = 0
+	SumPropertiesRequest_DAY                SumPropertiesRequest_Period = 1
+	// The weeks start on the week start day of the user.
+	SumPropertiesRequest_WEEK  SumPropertiesRequest_Period = 2
+	SumPropertiesRequest_MONTH SumPropertiesRequest_Period = 3
+	SumPropertiesRequest_YEAR  SumPropertiesRequest_Period = 4
+)
+
+// Enum value maps for SumPropertiesRequest_Period.
+var (
+	SumPropertiesRequest_Period_name = map[int32]string{
+		0: "PERIOD_UNSPECIFIED",
+		1: "DAY",
+		2: "WEEK",
+		3: "MONTH",
+		4: "YEAR",
+	}
+	SumPropertiesRequest_Period_value = map[string]int32{
+		"PERIOD_UNSPECIFIED": 0,
+		"DAY":                1,
+		"WEEK":               2,
+		"MONTH":              3,
+		"YEAR":               4,
+	}
+)
+
+func (x SumPropertiesRequest_Period) Enum() *SumPropertiesRequest_Period {
+	p := new(SumPropertiesRequest_Period)
+	*p = x
+	return p
+}
+
+func (x SumPropertiesRequest_Period) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SumPropertiesRequest_Period) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[5].Descriptor()
+}
+
+func (SumPropertiesRequest_Period) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[5]
+}
+
+func (x SumPropertiesRequest_Period) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SumPropertiesRequest_Period.Descriptor instead.
+func (SumPropertiesRequest_Period) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type ComputeDiffRequest_Granularity int32
+
+const (
+	ComputeDiffRequest_GRANULARITY_UNSPECIFIED ComputeDiffRequest_Granularity = 0
+	// The lines are equal, inserted or deleted.
+	ComputeDiffRequest_LINE ComputeDiffRequest_Granularity = 1
+	// The changed lines also have the segments of their words.
+	ComputeDiffRequest_WORD ComputeDiffRequest_Granularity = 2
+)
+
+// Enum value maps for ComputeDiffRequest_Granularity.
+var (
+	ComputeDiffRequest_Granularity_name = map[int32]string{
+		0: "GRANULARITY_UNSPECIFIED",
+		1: "LINE",
+		2: "WORD",
+	}
+	ComputeDiffRequest_Granularity_value = map[string]int32{
+		"GRANULARITY_UNSPECIFIED": 0,
+		"LINE":                    1,
+		"WORD":                    2,
+	}
+)
+
+func (x ComputeDiffRequest_Granularity) Enum() *ComputeDiffRequest_Granularity {
+	p := new(ComputeDiffRequest_Granularity)
+	*p = x
+	return p
+}
+
+func (x ComputeDiffRequest_Granularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ComputeDiffRequest_Granularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[6].Descriptor()
+}
+
+func (ComputeDiffRequest_Granularity) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[6]
+}
+
+func (x ComputeDiffRequest_Granularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ComputeDiffRequest_Granularity.Descriptor instead.
+func (ComputeDiffRequest_Granularity) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{31, 0}
+}
+
+type ComputeDiffResponse_Type int32
+
+const (
+	ComputeDiffResponse_TYPE_UNSPECIFIED ComputeDiffResponse_Type = 0
+	ComputeDiffResponse_EQUAL            ComputeDiffResponse_Type = 1
+	ComputeDiffResponse_INSERT           ComputeDiffResponse_Type = 2
+	ComputeDiffResponse_DELETE           ComputeDiffResponse_Type = 3
+)
+
+// Enum value maps for ComputeDiffResponse_Type.
+var (
+	ComputeDiffResponse_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "EQUAL",
+		2: "INSERT",
+		3: "DELETE",
+	}
+	ComputeDiffResponse_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"EQUAL":            1,
+		"INSERT":           2,
+		"DELETE":           3,
+	}
+)
+
+func (x ComputeDiffResponse_Type) Enum() *ComputeDiffResponse_Type {
+	p := new(ComputeDiffResponse_Type)
+	*p = x
+	return p
+}
+
+func (x ComputeDiffResponse_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ComputeDiffResponse_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[7].Descriptor()
+}
+
+func (ComputeDiffResponse_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[7]
+}
+
+func (x ComputeDiffResponse_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ComputeDiffResponse_Type.Descriptor instead.
+func (ComputeDiffResponse_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{32, 0}
+}
+
+// Ranking is the order of the memos matching the query.
+type SearchMemosRequest_Ranking int32
+
+const (
+	// The memos are ranked by relevance.
+	SearchMemosRequest_RANKING_UNSPECIFIED SearchMemosRequest_Ranking = 0
+	// The memos with the most occurrences of the words and phrases of the query first, scored with the tag
+	// boosts and lowered with their age.
+	SearchMemosRequest_RELEVANCE SearchMemosRequest_Ranking = 1
+	// The most recent memos first.
+	SearchMemosRequest_RECENCY SearchMemosRequest_Ranking = 2
+)
+
+// Enum value maps for SearchMemosRequest_Ranking.
+var (
+	SearchMemosRequest_Ranking_name = map[int32]string{
+		0: "RANKING_UNSPECIFIED",
+		1: "RELEVANCE",
+		2: "RECENCY",
+	}
+	SearchMemosRequest_Ranking_value = map[string]int32{
+		"RANKING_UNSPECIFIED": 0,
+		"RELEVANCE":           1,
+		"RECENCY":             2,
+	}
+)
+
+func (x SearchMemosRequest_Ranking) Enum() *SearchMemosRequest_Ranking {
+	p := new(SearchMemosRequest_Ranking)
+	*p = x
+	return p
+}
+
+func (x SearchMemosRequest_Ranking) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchMemosRequest_Ranking) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[8].Descriptor()
+}
+
+func (SearchMemosRequest_Ranking) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[8]
+}
+
+func (x SearchMemosRequest_Ranking) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchMemosRequest_Ranking.Descriptor instead.
+func (SearchMemosRequest_Ranking) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{43, 0}
+}
+
+// MatchType is where the words and phrases of the query were found in a memo.
+type SearchMemosResponse_MatchType int32
+
+const (
+	SearchMemosResponse_MATCH_TYPE_UNSPECIFIED SearchMemosResponse_MatchType = 0
+	// The content of the memo.
+	SearchMemosResponse_CONTENT SearchMemosResponse_MatchType = 1
+	// A comment on the memo.
+	SearchMemosResponse_COMMENT SearchMemosResponse_MatchType = 2
+	// The text extracted from an attachment of the memo.
+	SearchMemosResponse_ATTACHMENT SearchMemosResponse_MatchType = 3
+)
+
+// Enum value maps for SearchMemosResponse_MatchType.
+var (
+	SearchMemosResponse_MatchType_name = map[int32]string{
+		0: "MATCH_TYPE_UNSPECIFIED",
+		1: "CONTENT",
+		2: "COMMENT",
+		3: "ATTACHMENT",
+	}
+	SearchMemosResponse_MatchType_value = map[string]int32{
+		"MATCH_TYPE_UNSPECIFIED": 0,
+		"CONTENT":                1,
+		"COMMENT":                2,
+		"ATTACHMENT":             3,
+	}
+)
+
+func (x SearchMemosResponse_MatchType) Enum() *SearchMemosResponse_MatchType {
+	p := new(SearchMemosResponse_MatchType)
+	*p = x
+	return p
+}
+
+func (x SearchMemosResponse_MatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchMemosResponse_MatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[9].Descriptor()
+}
+
+func (SearchMemosResponse_MatchType) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[9]
+}
+
+func (x SearchMemosResponse_MatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchMemosResponse_MatchType.Descriptor instead.
+func (SearchMemosResponse_MatchType) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{44, 0}
+}
+
 // The type of the relation.
 type MemoRelation_Type int32
 
-const (
-	MemoRelation_TYPE_UNSPECIFIED MemoRelation_Type = 0
-	MemoRelation_REFERENCE        MemoRelation_Type = 1
-	MemoRelation_COMMENT          MemoRelation_Type = 2
-)
+const (
+	MemoRelation_TYPE_UNSPECIFIED MemoRelation_Type = 0
+	MemoRelation_REFERENCE        MemoRelation_Type = 1
+	MemoRelation_COMMENT          MemoRelation_Type = 2
+	// The memo is a translation of the related memo.
+	MemoRelation_TRANSLATION_OF MemoRelation_Type = 3
+	// The memo is an AI summary of the related memo, among others. Managed by the AI summaries.
+	MemoRelation_SUMMARY_OF MemoRelation_Type = 4
+	// The memo is a reply to the related memo.
+	MemoRelation_REPLY_TO MemoRelation_Type = 5
+	// The memo is a duplicate of the related memo.
+	MemoRelation_DUPLICATE_OF MemoRelation_Type = 6
+	// The memo, a task, is blocked by the related memo.
+	MemoRelation_BLOCKED_BY MemoRelation_Type = 7
+	// The memo is a revision of the related memo, e.g. a rewrite of its content.
+	MemoRelation_REVISION_OF MemoRelation_Type = 8
+)
+
+// Enum value maps for MemoRelation_Type.
+var (
+	MemoRelation_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "REFERENCE",
+		2: "COMMENT",
+		3: "TRANSLATION_OF",
+		4: "SUMMARY_OF",
+		5: "REPLY_TO",
+		6: "DUPLICATE_OF",
+		7: "BLOCKED_BY",
+		8: "REVISION_OF",
+	}
+	MemoRelation_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"REFERENCE":        1,
+		"COMMENT":          2,
+		"TRANSLATION_OF":   3,
+		"SUMMARY_OF":       4,
+		"REPLY_TO":         5,
+		"DUPLICATE_OF":     6,
+		"BLOCKED_BY":       7,
+		"REVISION_OF":      8,
+	}
+)
+
+func (x MemoRelation_Type) Enum() *MemoRelation_Type {
+	p := new(MemoRelation_Type)
+	*p = x
+	return p
+}
+
+func (x MemoRelation_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoRelation_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[10].Descriptor()
+}
+
+func (MemoRelation_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[10]
+}
+
+func (x MemoRelation_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoRelation_Type.Descriptor instead.
+func (MemoRelation_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{56, 0}
+}
+
+type ListMemoRelationsRequest_Direction int32
+
+const (
+	ListMemoRelationsRequest_DIRECTION_UNSPECIFIED ListMemoRelationsRequest_Direction = 0
+	// The relations from the memo to related memos, e.g. the memos it is blocked by.
+	ListMemoRelationsRequest_OUTGOING ListMemoRelationsRequest_Direction = 1
+	// The relations from other memos to the memo, e.g. the memos it blocks.
+	ListMemoRelationsRequest_INCOMING ListMemoRelationsRequest_Direction = 2
+)
+
+// Enum value maps for ListMemoRelationsRequest_Direction.
+var (
+	ListMemoRelationsRequest_Direction_name = map[int32]string{
+		0: "DIRECTION_UNSPECIFIED",
+		1: "OUTGOING",
+		2: "INCOMING",
+	}
+	ListMemoRelationsRequest_Direction_value = map[string]int32{
+		"DIRECTION_UNSPECIFIED": 0,
+		"OUTGOING":              1,
+		"INCOMING":              2,
+	}
+)
+
+func (x ListMemoRelationsRequest_Direction) Enum() *ListMemoRelationsRequest_Direction {
+	p := new(ListMemoRelationsRequest_Direction)
+	*p = x
+	return p
+}
+
+func (x ListMemoRelationsRequest_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListMemoRelationsRequest_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[11].Descriptor()
+}
+
+func (ListMemoRelationsRequest_Direction) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[11]
+}
+
+func (x ListMemoRelationsRequest_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListMemoRelationsRequest_Direction.Descriptor instead.
+func (ListMemoRelationsRequest_Direction) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{58, 0}
+}
+
+type Reaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the reaction.
+	// Format: reactions/{reaction}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The resource name of the creator.
+	// Format: users/{user}
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The resource name of the content.
+	// For memo reactions, this should be the memo's resource name.
+	// Format: memos/{memo}
+	ContentId string `protobuf:"bytes,3,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	// Required. The type of reaction (e.g., "👍", "❤️", "😄").
+	ReactionType string `protobuf:"bytes,4,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
+	// Output only. The creation timestamp.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Reaction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Reaction) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Reaction) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *Reaction) GetReactionType() string {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ""
+}
+
+func (x *Reaction) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// A Webmention of a public memo, a page of another site responding to the memo. The Webmentions are
+// received by the /webmention endpoint of the instance and kept once their source is verified to link
+// to the memo.
+type Webmention struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the webmention.
+	// Format: memos/{memo}/webmentions/{webmention}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Output only. The URL of the page responding to the memo.
+	Source string             `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Type   Webmention_Type    `protobuf:"varint,3,opt,name=type,proto3,enum=memos.api.v1.Webmention_Type" json:"type,omitempty"`
+	Author *Webmention_Author `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	// Output only. The text of the response, at most 500 characters.
+	Content string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// Output only. The time the response was published, unset if unknown.
+	PublishTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+	// Output only. The time the webmention was received.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. The time the webmention was last verified.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webmention) Reset() {
+	*x = Webmention{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webmention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webmention) ProtoMessage() {}
+
+func (x *Webmention) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webmention.ProtoReflect.Descriptor instead.
+func (*Webmention) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Webmention) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Webmention) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Webmention) GetType() Webmention_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Webmention_TYPE_UNSPECIFIED
+}
+
+func (x *Webmention) GetAuthor() *Webmention_Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *Webmention) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Webmention) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
+func (x *Webmention) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Webmention) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type Memo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the memo.
+	// Format: memos/{memo}, memo is the user defined id or uuid.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The state of the memo.
+	State State `protobuf:"varint,2,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
+	// The name of the creator.
+	// Format: users/{user}
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Output only. The creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. The last update timestamp.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// The display timestamp of the memo.
+	DisplayTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=display_time,json=displayTime,proto3" json:"display_time,omitempty"`
+	// Required. The content of the memo in Markdown format.
+	Content string `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	// The visibility of the memo.
+	Visibility Visibility `protobuf:"varint,9,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	// Output only. The tags extracted from the content.
+	Tags []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Whether the memo is pinned.
+	Pinned bool `protobuf:"varint,11,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Optional. The attachments of the memo.
+	Attachments []*Attachment `protobuf:"bytes,12,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Optional. The relations of the memo.
+	Relations []*MemoRelation `protobuf:"bytes,13,rep,name=relations,proto3" json:"relations,omitempty"`
+	// Output only. The reactions to the memo.
+	Reactions []*Reaction `protobuf:"bytes,14,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// Output only. The computed properties of the memo.
+	Property *Memo_Property `protobuf:"bytes,15,opt,name=property,proto3" json:"property,omitempty"`
+	// Output only. The name of the parent memo.
+	// Format: memos/{memo}
+	Parent *string `protobuf:"bytes,16,opt,name=parent,proto3,oneof" json:"parent,omitempty"`
+	// Output only. The snippet of the memo content. Plain text only.
+	Snippet string `protobuf:"bytes,17,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// Optional. The location of the memo.
+	Location *Location `protobuf:"bytes,18,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	// Output only. The number of comments on the memo.
+	CommentCount int32 `protobuf:"varint,19,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	// Output only. The number of reactions on the memo.
+	ReactionCount int32 `protobuf:"varint,20,opt,name=reaction_count,json=reactionCount,proto3" json:"reaction_count,omitempty"`
+	// Output only. The number of memos referenced by the memo.
+	RelationCount int32 `protobuf:"varint,21,opt,name=relation_count,json=relationCount,proto3" json:"relation_count,omitempty"`
+	// Optional. The content warning of the memo, e.g. "spoilers". When set, clients should
+	// collapse the content behind it until the reader chooses to reveal it, and the snippet
+	// is the content warning instead of a preview of the content.
+	ContentWarning string `protobuf:"bytes,22,opt,name=content_warning,json=contentWarning,proto3" json:"content_warning,omitempty"`
+	// Optional. The time at which the memo expires, after which it is archived or deleted
+	// according to the expiry action. Unset for memos that never expire.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Optional. What happens to the memo when it expires, archiving it by default.
+	ExpiryAction Memo_ExpiryAction `protobuf:"varint,24,opt,name=expiry_action,json=expiryAction,proto3,enum=memos.api.v1.Memo_ExpiryAction" json:"expiry_action,omitempty"`
+	// Optional. The language of the content as a BCP 47 tag, e.g. "en" or "pt-BR".
+	// Memos can be listed by language with the `language` filter field.
+	Language string `protobuf:"bytes,25,opt,name=language,proto3" json:"language,omitempty"`
+	// Output only. The language detected from the content as an ISO 639-1 code, empty if unknown.
+	// The `language` filter field falls back to it for memos without a language.
+	DetectedLanguage string `protobuf:"bytes,26,opt,name=detected_language,json=detectedLanguage,proto3" json:"detected_language,omitempty"`
+	// Output only. The refinements of an AI summary memo, oldest first.
+	AiSummaryRefinements []*Memo_AISummaryRefinement `protobuf:"bytes,27,rep,name=ai_summary_refinements,json=aiSummaryRefinements,proto3" json:"ai_summary_refinements,omitempty"`
+	// Optional. The human-readable slug of the memo in its public URLs, unique among the memos of its creator.
+	// Lowercase letters, digits and hyphens, e.g. "my-first-post". The previous slugs keep resolving to the memo.
+	Slug string `protobuf:"bytes,28,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Output only. Whether the AI summary memo was returned from the cache of an identical summary request rather
+	// than generated, only set in the responses of the summary generation.
+	AiSummaryCached bool `protobuf:"varint,29,opt,name=ai_summary_cached,json=aiSummaryCached,proto3" json:"ai_summary_cached,omitempty"`
+	// Output only. The copies of the memo cross-posted to other platforms by its creator,
+	// for clients to link to them.
+	Syndications []*Memo_Syndication `protobuf:"bytes,30,rep,name=syndications,proto3" json:"syndications,omitempty"`
+	// Output only. The reading properties of the front matter of the content, set when the memo tracks a book
+	// or an article, e.g. "status: reading". Memos can be listed by them with the `reading_author`, `reading_status`,
+	// `reading_rating` and `reading_finished_date` filter fields.
+	Reading *Memo_Reading `protobuf:"bytes,31,opt,name=reading,proto3" json:"reading,omitempty"`
+	// Output only. The approval of the memo, set when its tags require the approval of the admins before it gets
+	// its visibility, see `approval_required_tags` of the memo related workspace setting. The memo is private until
+	// it is approved.
+	Approval *Memo_Approval `protobuf:"bytes,32,opt,name=approval,proto3" json:"approval,omitempty"`
+	// Output only. The users who edited the content of the memo, in the order of their first edit, the creator first.
+	Contributors  []*Memo_Contributor `protobuf:"bytes,33,rep,name=contributors,proto3" json:"contributors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Memo) Reset() {
+	*x = Memo{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Memo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Memo) ProtoMessage() {}
+
+func (x *Memo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Memo.ProtoReflect.Descriptor instead.
+func (*Memo) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Memo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Memo) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *Memo) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Memo) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Memo) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Memo) GetDisplayTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisplayTime
+	}
+	return nil
+}
+
+func (x *Memo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Memo) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Memo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Memo) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Memo) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Memo) GetRelations() []*MemoRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *Memo) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Memo) GetProperty() *Memo_Property {
+	if x != nil {
+		return x.Property
+	}
+	return nil
+}
+
+func (x *Memo) GetParent() string {
+	if x != nil && x.Parent != nil {
+		return *x.Parent
+	}
+	return ""
+}
+
+func (x *Memo) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *Memo) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Memo) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *Memo) GetReactionCount() int32 {
+	if x != nil {
+		return x.ReactionCount
+	}
+	return 0
+}
+
+func (x *Memo) GetRelationCount() int32 {
+	if x != nil {
+		return x.RelationCount
+	}
+	return 0
+}
+
+func (x *Memo) GetContentWarning() string {
+	if x != nil {
+		return x.ContentWarning
+	}
+	return ""
+}
+
+func (x *Memo) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *Memo) GetExpiryAction() Memo_ExpiryAction {
+	if x != nil {
+		return x.ExpiryAction
+	}
+	return Memo_EXPIRY_ACTION_UNSPECIFIED
+}
+
+func (x *Memo) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Memo) GetDetectedLanguage() string {
+	if x != nil {
+		return x.DetectedLanguage
+	}
+	return ""
+}
+
+func (x *Memo) GetAiSummaryRefinements() []*Memo_AISummaryRefinement {
+	if x != nil {
+		return x.AiSummaryRefinements
+	}
+	return nil
+}
+
+func (x *Memo) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Memo) GetAiSummaryCached() bool {
+	if x != nil {
+		return x.AiSummaryCached
+	}
+	return false
+}
+
+func (x *Memo) GetSyndications() []*Memo_Syndication {
+	if x != nil {
+		return x.Syndications
+	}
+	return nil
+}
+
+func (x *Memo) GetReading() *Memo_Reading {
+	if x != nil {
+		return x.Reading
+	}
+	return nil
+}
+
+func (x *Memo) GetApproval() *Memo_Approval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *Memo) GetContributors() []*Memo_Contributor {
+	if x != nil {
+		return x.Contributors
+	}
+	return nil
+}
+
+type Location struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A placeholder text for the location.
+	Placeholder string `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	// The latitude of the location.
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// The longitude of the location.
+	Longitude     float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Location) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type CreateMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The memo to create.
+	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Optional. The memo ID to use for this memo.
+	// If empty, a unique ID will be generated.
+	MemoId string `protobuf:"bytes,2,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	// Optional. If set, validate the request but don't actually create the memo.
+	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	// Optional. An idempotency token.
+	RequestId string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Optional. If set, the content is saved as is, without the formatters of the formatting setting of the user.
+	SkipFormatting bool `protobuf:"varint,5,opt,name=skip_formatting,json=skipFormatting,proto3" json:"skip_formatting,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateMemoRequest) Reset() {
+	*x = CreateMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoRequest) ProtoMessage() {}
+
+func (x *CreateMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateMemoRequest) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *CreateMemoRequest) GetMemoId() string {
+	if x != nil {
+		return x.MemoId
+	}
+	return ""
+}
+
+func (x *CreateMemoRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *CreateMemoRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CreateMemoRequest) GetSkipFormatting() bool {
+	if x != nil {
+		return x.SkipFormatting
+	}
+	return false
+}
+
+// The proposed split of a memo content over the content length limit, sent as a detail of the INVALID_ARGUMENT
+// error of the request, for the client to offer creating several memos instead.
+type MemoContentSplitSuggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The content length limit in bytes.
+	ContentLengthLimit int32 `protobuf:"varint,1,opt,name=content_length_limit,json=contentLengthLimit,proto3" json:"content_length_limit,omitempty"`
+	// The byte offsets in the UTF-8 content where the parts after the first one start, each part within the limit.
+	// The content is split between paragraphs, then lines, then words where possible.
+	SplitOffsets  []int32 `protobuf:"varint,2,rep,packed,name=split_offsets,json=splitOffsets,proto3" json:"split_offsets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoContentSplitSuggestion) Reset() {
+	*x = MemoContentSplitSuggestion{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoContentSplitSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoContentSplitSuggestion) ProtoMessage() {}
+
+func (x *MemoContentSplitSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoContentSplitSuggestion.ProtoReflect.Descriptor instead.
+func (*MemoContentSplitSuggestion) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MemoContentSplitSuggestion) GetContentLengthLimit() int32 {
+	if x != nil {
+		return x.ContentLengthLimit
+	}
+	return 0
+}
+
+func (x *MemoContentSplitSuggestion) GetSplitOffsets() []int32 {
+	if x != nil {
+		return x.SplitOffsets
+	}
+	return nil
+}
+
+type ListMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of memos to return.
+	// The service may return fewer than this value.
+	// If unspecified, at most 50 memos will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListMemos` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. The state of the memos to list.
+	// Default to `NORMAL`. Set to `ARCHIVED` to list archived memos.
+	State State `protobuf:"varint,3,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
+	// Optional. The order to sort results by.
+	// Default to "display_time desc".
+	// Supports comma-separated list of fields following AIP-132.
+	// Example: "pinned desc, display_time desc" or "create_time asc"
+	// Supported fields: pinned, display_time, create_time, update_time, name
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Optional. Filter to apply to the list results.
+	// Filter is a CEL expression to filter memos.
+	// Refer to `Shortcut.filter`.
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. If true, show deleted memos in the response.
+	ShowDeleted bool `protobuf:"varint,6,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	// Optional. The states of the memos to list, taking precedence over `state` when set.
+	// The archived memos are only listed among the memos of the current user.
+	Scope         MemoScope `protobuf:"varint,7,opt,name=scope,proto3,enum=memos.api.v1.MemoScope" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemosRequest) Reset() {
+	*x = ListMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemosRequest) ProtoMessage() {}
+
+func (x *ListMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMemosRequest) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *ListMemosRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListMemosRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListMemosRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *ListMemosRequest) GetScope() MemoScope {
+	if x != nil {
+		return x.Scope
+	}
+	return MemoScope_MEMO_SCOPE_UNSPECIFIED
+}
+
+type ListMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of memos.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of memos (may be approximate).
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemosResponse) Reset() {
+	*x = ListMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemosResponse) ProtoMessage() {}
+
+func (x *ListMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListMemosResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListMemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemosResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ListMemosWithBrokenLinksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of memos to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListMemosWithBrokenLinks` call.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemosWithBrokenLinksRequest) Reset() {
+	*x = ListMemosWithBrokenLinksRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemosWithBrokenLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemosWithBrokenLinksRequest) ProtoMessage() {}
+
+func (x *ListMemosWithBrokenLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemosWithBrokenLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListMemosWithBrokenLinksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListMemosWithBrokenLinksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemosWithBrokenLinksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemosWithBrokenLinksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of memos with broken links.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemosWithBrokenLinksResponse) Reset() {
+	*x = ListMemosWithBrokenLinksResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemosWithBrokenLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemosWithBrokenLinksResponse) ProtoMessage() {}
+
+func (x *ListMemosWithBrokenLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemosWithBrokenLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListMemosWithBrokenLinksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListMemosWithBrokenLinksResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListMemosWithBrokenLinksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MemoReadState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the read state.
+	// Format: memos/{memo}/readState
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The time the current user last read the memo.
+	ReadTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=read_time,json=readTime,proto3" json:"read_time,omitempty"`
+	// The last reading position of the current user in the memo content.
+	Position int32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	// Output only. Whether the memo is unread or updated since it was last read.
+	Unread        bool `protobuf:"varint,4,opt,name=unread,proto3" json:"unread,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoReadState) Reset() {
+	*x = MemoReadState{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoReadState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoReadState) ProtoMessage() {}
+
+func (x *MemoReadState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoReadState.ProtoReflect.Descriptor instead.
+func (*MemoReadState) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MemoReadState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoReadState) GetReadTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadTime
+	}
+	return nil
+}
+
+func (x *MemoReadState) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *MemoReadState) GetUnread() bool {
+	if x != nil {
+		return x.Unread
+	}
+	return false
+}
+
+type GetMemoReadStateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the read state.
+	// Format: memos/{memo}/readState
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoReadStateRequest) Reset() {
+	*x = GetMemoReadStateRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoReadStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoReadStateRequest) ProtoMessage() {}
+
+func (x *GetMemoReadStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoReadStateRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoReadStateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetMemoReadStateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateMemoReadStateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The read state to update.
+	ReadState *MemoReadState `protobuf:"bytes,1,opt,name=read_state,json=readState,proto3" json:"read_state,omitempty"`
+	// Required. The list of fields to update.
+	// Supported fields: read_time, position. An empty read_time marks the memo as read now.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemoReadStateRequest) Reset() {
+	*x = UpdateMemoReadStateRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoReadStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoReadStateRequest) ProtoMessage() {}
+
+func (x *UpdateMemoReadStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoReadStateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoReadStateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateMemoReadStateRequest) GetReadState() *MemoReadState {
+	if x != nil {
+		return x.ReadState
+	}
+	return nil
+}
+
+func (x *UpdateMemoReadStateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type MemoStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the memo whose stats these are.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of views of the memo, each visitor being counted once per day.
+	ViewCount int32 `protobuf:"varint,2,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	// The number of views per day over the last 30 days, only the days with views are included.
+	DailyViewCounts []*MemoStats_DailyViewCount `protobuf:"bytes,3,rep,name=daily_view_counts,json=dailyViewCounts,proto3" json:"daily_view_counts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MemoStats) Reset() {
+	*x = MemoStats{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoStats) ProtoMessage() {}
+
+func (x *MemoStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoStats.ProtoReflect.Descriptor instead.
+func (*MemoStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MemoStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoStats) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *MemoStats) GetDailyViewCounts() []*MemoStats_DailyViewCount {
+	if x != nil {
+		return x.DailyViewCounts
+	}
+	return nil
+}
+
+type GetMemoStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoStatsRequest) Reset() {
+	*x = GetMemoStatsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoStatsRequest) ProtoMessage() {}
+
+func (x *GetMemoStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetMemoStatsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetCalendarMonthRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The year of the month.
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Required. The month, from 1 for January to 12 for December.
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	// Optional. The IANA timezone the days are in.
+	// Default to the timezone of the current user, or UTC.
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Optional. Filter to apply to the memos of the month.
+	// Refer to `Shortcut.filter`.
+	Filter        string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarMonthRequest) Reset() {
+	*x = GetCalendarMonthRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarMonthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarMonthRequest) ProtoMessage() {}
+
+func (x *GetCalendarMonthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarMonthRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarMonthRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCalendarMonthRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetCalendarMonthRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *GetCalendarMonthRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetCalendarMonthRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+// The summaries of the days of a month, by the display time of the memos.
+type CalendarMonth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The year of the month.
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// The month, from 1 for January to 12 for December.
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	// The IANA timezone the days are in.
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// The days of the month, from the first to the last, the days without memos included.
+	Days          []*CalendarMonth_Day `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarMonth) Reset() {
+	*x = CalendarMonth{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarMonth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarMonth) ProtoMessage() {}
+
+func (x *CalendarMonth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarMonth.ProtoReflect.Descriptor instead.
+func (*CalendarMonth) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CalendarMonth) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CalendarMonth) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *CalendarMonth) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CalendarMonth) GetDays() []*CalendarMonth_Day {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type GetTimeReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The first date of the report, in the format "YYYY-MM-DD".
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Required. The last date of the report, in the format "YYYY-MM-DD", at most a year after the first.
+	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Optional. The IANA timezone the dates are in.
+	// Default to the timezone of the current user, or UTC.
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Optional. Filter to apply to the memos of the report.
+	// Refer to `Shortcut.filter`.
+	Filter        string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimeReportRequest) Reset() {
+	*x = GetTimeReportRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeReportRequest) ProtoMessage() {}
+
+func (x *GetTimeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeReportRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetTimeReportRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetTimeReportRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetTimeReportRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetTimeReportRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+// The time logged in the memos of the user, by the display time of the memos.
+type TimeReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IANA timezone the dates are in.
+	Timezone string `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// The weeks of the report with time logged, oldest first, starting on the week start day of the user.
+	Weeks []*TimeReport_Week `protobuf:"bytes,2,rep,name=weeks,proto3" json:"weeks,omitempty"`
+	// The total time logged in minutes.
+	TotalMinutes  int32 `protobuf:"varint,3,opt,name=total_minutes,json=totalMinutes,proto3" json:"total_minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeReport) Reset() {
+	*x = TimeReport{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeReport) ProtoMessage() {}
+
+func (x *TimeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeReport.ProtoReflect.Descriptor instead.
+func (*TimeReport) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TimeReport) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *TimeReport) GetWeeks() []*TimeReport_Week {
+	if x != nil {
+		return x.Weeks
+	}
+	return nil
+}
+
+func (x *TimeReport) GetTotalMinutes() int32 {
+	if x != nil {
+		return x.TotalMinutes
+	}
+	return 0
+}
+
+type SumPropertiesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The first date of the sums, in the format "YYYY-MM-DD".
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Required. The last date of the sums, in the format "YYYY-MM-DD", at most a year after the first.
+	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Optional. The IANA timezone the dates are in.
+	// Default to the timezone of the current user, or UTC.
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Optional. Filter to apply to the memos of the sums.
+	// Refer to `Shortcut.filter`.
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. The period the amounts are grouped by. Default to MONTH.
+	Period        SumPropertiesRequest_Period `protobuf:"varint,5,opt,name=period,proto3,enum=memos.api.v1.SumPropertiesRequest_Period" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SumPropertiesRequest) Reset() {
+	*x = SumPropertiesRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SumPropertiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SumPropertiesRequest) ProtoMessage() {}
+
+func (x *SumPropertiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SumPropertiesRequest.ProtoReflect.Descriptor instead.
+func (*SumPropertiesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SumPropertiesRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *SumPropertiesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *SumPropertiesRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SumPropertiesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *SumPropertiesRequest) GetPeriod() SumPropertiesRequest_Period {
+	if x != nil {
+		return x.Period
+	}
+	return SumPropertiesRequest_PERIOD_UNSPECIFIED
+}
+
+type SumPropertiesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IANA timezone the dates are in.
+	Timezone string `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// The periods with amounts, oldest first.
+	Periods []*SumPropertiesResponse_Period `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	// The sums of the whole date range.
+	Totals        []*SumPropertiesResponse_Sum `protobuf:"bytes,3,rep,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SumPropertiesResponse) Reset() {
+	*x = SumPropertiesResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SumPropertiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SumPropertiesResponse) ProtoMessage() {}
+
+func (x *SumPropertiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SumPropertiesResponse.ProtoReflect.Descriptor instead.
+func (*SumPropertiesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SumPropertiesResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SumPropertiesResponse) GetPeriods() []*SumPropertiesResponse_Period {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *SumPropertiesResponse) GetTotals() []*SumPropertiesResponse_Sum {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type ListReadingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Filter to apply to the memos, e.g. `reading_rating >= 8`.
+	// Refer to `Shortcut.filter`.
+	Filter        string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReadingRequest) Reset() {
+	*x = ListReadingRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReadingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReadingRequest) ProtoMessage() {}
+
+func (x *ListReadingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReadingRequest.ProtoReflect.Descriptor instead.
+func (*ListReadingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListReadingRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type ListReadingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The groups of the memos by reading status: "reading", "to_read", "finished", "abandoned", then the other
+	// statuses by name. The groups without memos are omitted.
+	Groups        []*ListReadingResponse_Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReadingResponse) Reset() {
+	*x = ListReadingResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReadingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReadingResponse) ProtoMessage() {}
+
+func (x *ListReadingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReadingResponse.ProtoReflect.Descriptor instead.
+func (*ListReadingResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListReadingResponse) GetGroups() []*ListReadingResponse_Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetPersonPageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the person, the name of the person tag without its "person/" prefix.
+	// Format: people/{person}, e.g. "people/alice" for the tag "#person/alice".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of memos to return, 50 when unspecified.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `GetPersonPage` call, to retrieve the next memos.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPersonPageRequest) Reset() {
+	*x = GetPersonPageRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPersonPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonPageRequest) ProtoMessage() {}
+
+func (x *GetPersonPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonPageRequest.ProtoReflect.Descriptor instead.
+func (*GetPersonPageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPersonPageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPersonPageRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetPersonPageRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// The page of a person, from the memos of the current user with the person tag.
+type PersonPage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the person.
+	// Format: people/{person}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The person tag, e.g. "person/alice".
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The number of memos with the person tag.
+	MemoCount int32 `protobuf:"varint,3,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The display time of the oldest memo with the person tag, unset without memos.
+	FirstMentionTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=first_mention_time,json=firstMentionTime,proto3" json:"first_mention_time,omitempty"`
+	// The display time of the newest memo with the person tag, unset without memos.
+	LastMentionTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_mention_time,json=lastMentionTime,proto3" json:"last_mention_time,omitempty"`
+	// The memos with the person tag, newest first.
+	Memos []*Memo `protobuf:"bytes,6,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token to retrieve the next memos, omitted when there are none.
+	NextPageToken string `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The most recent interactions with the person, newest first, at most 20.
+	Timeline []*PersonPage_Interaction `protobuf:"bytes,8,rep,name=timeline,proto3" json:"timeline,omitempty"`
+	// The other tags of the memos with the person tag, the people met together included, by their count then name,
+	// at most 20.
+	CoOccurringTags []*PersonPage_TagCount `protobuf:"bytes,9,rep,name=co_occurring_tags,json=coOccurringTags,proto3" json:"co_occurring_tags,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PersonPage) Reset() {
+	*x = PersonPage{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonPage) ProtoMessage() {}
+
+func (x *PersonPage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonPage.ProtoReflect.Descriptor instead.
+func (*PersonPage) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PersonPage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PersonPage) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *PersonPage) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *PersonPage) GetFirstMentionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstMentionTime
+	}
+	return nil
+}
+
+func (x *PersonPage) GetLastMentionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastMentionTime
+	}
+	return nil
+}
+
+func (x *PersonPage) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *PersonPage) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *PersonPage) GetTimeline() []*PersonPage_Interaction {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+func (x *PersonPage) GetCoOccurringTags() []*PersonPage_TagCount {
+	if x != nil {
+		return x.CoOccurringTags
+	}
+	return nil
+}
+
+type ListPendingMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of memos to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListPendingMemos` call.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingMemosRequest) Reset() {
+	*x = ListPendingMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingMemosRequest) ProtoMessage() {}
+
+func (x *ListPendingMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPendingMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPendingMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPendingMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pending memos.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingMemosResponse) Reset() {
+	*x = ListPendingMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingMemosResponse) ProtoMessage() {}
+
+func (x *ListPendingMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListPendingMemosResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListPendingMemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ApproveMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveMemoRequest) Reset() {
+	*x = ApproveMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveMemoRequest) ProtoMessage() {}
+
+func (x *ApproveMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveMemoRequest.ProtoReflect.Descriptor instead.
+func (*ApproveMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ApproveMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RejectMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The reason of the rejection, shown to the creator.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectMemoRequest) Reset() {
+	*x = RejectMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectMemoRequest) ProtoMessage() {}
+
+func (x *RejectMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectMemoRequest.ProtoReflect.Descriptor instead.
+func (*RejectMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RejectMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RejectMemoRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type EvaluateTaggingRulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The content of the memo.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Optional. The creation time of the memo, now if not set.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Optional. The rules to evaluate, the saved rules of the user if not set.
+	TaggingRules  *UserSetting_TaggingRulesSetting `protobuf:"bytes,3,opt,name=tagging_rules,json=taggingRules,proto3" json:"tagging_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateTaggingRulesRequest) Reset() {
+	*x = EvaluateTaggingRulesRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateTaggingRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateTaggingRulesRequest) ProtoMessage() {}
+
+func (x *EvaluateTaggingRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateTaggingRulesRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateTaggingRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *EvaluateTaggingRulesRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *EvaluateTaggingRulesRequest) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *EvaluateTaggingRulesRequest) GetTaggingRules() *UserSetting_TaggingRulesSetting {
+	if x != nil {
+		return x.TaggingRules
+	}
+	return nil
+}
+
+type EvaluateTaggingRulesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tags the rules add to the memo.
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The visibility the rules set on the memo when it is created, unspecified to keep it.
+	Visibility Visibility `protobuf:"varint,2,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	// The indexes of the rules matching the memo, in order.
+	MatchedRules  []int32 `protobuf:"varint,3,rep,packed,name=matched_rules,json=matchedRules,proto3" json:"matched_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateTaggingRulesResponse) Reset() {
+	*x = EvaluateTaggingRulesResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateTaggingRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateTaggingRulesResponse) ProtoMessage() {}
+
+func (x *EvaluateTaggingRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateTaggingRulesResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateTaggingRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EvaluateTaggingRulesResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *EvaluateTaggingRulesResponse) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *EvaluateTaggingRulesResponse) GetMatchedRules() []int32 {
+	if x != nil {
+		return x.MatchedRules
+	}
+	return nil
+}
+
+type ComputeDiffRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo the changes are from, e.g. the revised memo.
+	// Format: memos/{memo}
+	OldName string `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	// Required. The resource name of the memo the changes are to, e.g. the revision.
+	// Format: memos/{memo}
+	NewName string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	// Optional. The granularity of the diff, LINE by default.
+	Granularity   ComputeDiffRequest_Granularity `protobuf:"varint,3,opt,name=granularity,proto3,enum=memos.api.v1.ComputeDiffRequest_Granularity" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComputeDiffRequest) Reset() {
+	*x = ComputeDiffRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComputeDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeDiffRequest) ProtoMessage() {}
+
+func (x *ComputeDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeDiffRequest.ProtoReflect.Descriptor instead.
+func (*ComputeDiffRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ComputeDiffRequest) GetOldName() string {
+	if x != nil {
+		return x.OldName
+	}
+	return ""
+}
+
+func (x *ComputeDiffRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *ComputeDiffRequest) GetGranularity() ComputeDiffRequest_Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return ComputeDiffRequest_GRANULARITY_UNSPECIFIED
+}
+
+type ComputeDiffResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The lines of both memos in order, the deleted lines of a change before its inserted lines.
+	Lines []*ComputeDiffResponse_Line `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	// The number of the inserted lines.
+	InsertedLineCount int32 `protobuf:"varint,2,opt,name=inserted_line_count,json=insertedLineCount,proto3" json:"inserted_line_count,omitempty"`
+	// The number of the deleted lines.
+	DeletedLineCount int32 `protobuf:"varint,3,opt,name=deleted_line_count,json=deletedLineCount,proto3" json:"deleted_line_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ComputeDiffResponse) Reset() {
+	*x = ComputeDiffResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComputeDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeDiffResponse) ProtoMessage() {}
+
+func (x *ComputeDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeDiffResponse.ProtoReflect.Descriptor instead.
+func (*ComputeDiffResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ComputeDiffResponse) GetLines() []*ComputeDiffResponse_Line {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ComputeDiffResponse) GetInsertedLineCount() int32 {
+	if x != nil {
+		return x.InsertedLineCount
+	}
+	return 0
+}
+
+func (x *ComputeDiffResponse) GetDeletedLineCount() int32 {
+	if x != nil {
+		return x.DeletedLineCount
+	}
+	return 0
+}
+
+type MemoSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the subscription.
+	// Format: memos/{memo}/subscription
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the current user is notified of the comments of the memo.
+	// Memo creators are subscribed unless they unsubscribed, other users are subscribed
+	// when they comment on the memo unless they unsubscribed.
+	Subscribed bool `protobuf:"varint,2,opt,name=subscribed,proto3" json:"subscribed,omitempty"`
+	// Output only. The time the subscription was last changed, unset when it was never changed.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoSubscription) Reset() {
+	*x = MemoSubscription{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoSubscription) ProtoMessage() {}
+
+func (x *MemoSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoSubscription.ProtoReflect.Descriptor instead.
+func (*MemoSubscription) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MemoSubscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoSubscription) GetSubscribed() bool {
+	if x != nil {
+		return x.Subscribed
+	}
+	return false
+}
+
+func (x *MemoSubscription) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type GetMemoSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the subscription.
+	// Format: memos/{memo}/subscription
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoSubscriptionRequest) Reset() {
+	*x = GetMemoSubscriptionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetMemoSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetMemoSubscriptionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateMemoSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The subscription to update.
+	Subscription *MemoSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// Required. The list of fields to update.
+	// Supported fields: subscribed.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemoSubscriptionRequest) Reset() {
+	*x = UpdateMemoSubscriptionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateMemoSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateMemoSubscriptionRequest) GetSubscription() *MemoSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *UpdateMemoSubscriptionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type ListSubscribedMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of memos to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListSubscribedMemos` call.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscribedMemosRequest) Reset() {
+	*x = ListSubscribedMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscribedMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribedMemosRequest) ProtoMessage() {}
+
+func (x *ListSubscribedMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribedMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscribedMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListSubscribedMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSubscribedMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSubscribedMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of subscribed memos.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscribedMemosResponse) Reset() {
+	*x = ListSubscribedMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscribedMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribedMemosResponse) ProtoMessage() {}
+
+func (x *ListSubscribedMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribedMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscribedMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListSubscribedMemosResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListSubscribedMemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListUnreadMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of memos to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListUnreadMemos` call.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnreadMemosRequest) Reset() {
+	*x = ListUnreadMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnreadMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnreadMemosRequest) ProtoMessage() {}
+
+func (x *ListUnreadMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnreadMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListUnreadMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListUnreadMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUnreadMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUnreadMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of unread memos.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnreadMemosResponse) Reset() {
+	*x = ListUnreadMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnreadMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnreadMemosResponse) ProtoMessage() {}
+
+func (x *ListUnreadMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnreadMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListUnreadMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListUnreadMemosResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListUnreadMemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListColdMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of memos to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListColdMemos` call.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Only return memos whose content contains the query.
+	Query         string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListColdMemosRequest) Reset() {
+	*x = ListColdMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListColdMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListColdMemosRequest) ProtoMessage() {}
+
+func (x *ListColdMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListColdMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListColdMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListColdMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListColdMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListColdMemosRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type ListColdMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of memos in cold storage.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListColdMemosResponse) Reset() {
+	*x = ListColdMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListColdMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListColdMemosResponse) ProtoMessage() {}
+
+func (x *ListColdMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListColdMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListColdMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListColdMemosResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListColdMemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RestoreColdMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo in cold storage.
+	// Format: memos/{memo}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreColdMemoRequest) Reset() {
+	*x = RestoreColdMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreColdMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreColdMemoRequest) ProtoMessage() {}
+
+func (x *RestoreColdMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreColdMemoRequest.ProtoReflect.Descriptor instead.
+func (*RestoreColdMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RestoreColdMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SearchMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The search query. The terms are matched against the content of the memos, all of them must match.
+	// Supported syntax:
+	//  - `word`: the memo contains the word.
+	//  - `"some phrase"`: the memo contains the phrase.
+	//  - `tag:name`: the memo has the tag.
+	//  - `after:YYYY-MM-DD`: the memo was created on or after the date, in UTC.
+	//  - `before:YYYY-MM-DD`: the memo was created before the date, in UTC.
+	//  - `has:attachment`, `has:link`, `has:code`, `has:tasks`: the memo has an attachment, a link, code or a task list.
+	//  - `creator:username`: the memo was created by the user, only for the admins.
+	//  - `-term`: excludes the memos matching the term, e.g. `-tag:draft` or `-"some phrase"`.
+	// The words and phrases are searched in the content of the memos, the text extracted from their attachments, e.g.
+	// the PDF documents or the text recognized in the images, and their comments everyone who can see the memo can see.
+	// When the fuzzy search of the workspace is enabled, the words and phrases also match the ones spelled alike in the
+	// content.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Optional. The maximum number of memos to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `SearchMemos` call.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. The states of the memos to search, the normal memos by default.
+	// The archived memos are only searched among the memos of the current user.
+	Scope MemoScope `protobuf:"varint,4,opt,name=scope,proto3,enum=memos.api.v1.MemoScope" json:"scope,omitempty"`
+	// Optional. The order of the memos matching the query, by relevance by default.
+	Ranking SearchMemosRequest_Ranking `protobuf:"varint,5,opt,name=ranking,proto3,enum=memos.api.v1.SearchMemosRequest_Ranking" json:"ranking,omitempty"`
+	// Optional. The boosts added to the relevance score of the memos with the tags, by tag without the leading "#".
+	// A memo scores 1 plus the occurrences of the words and phrases of the query. Negative boosts lower the memos.
+	TagBoosts map[string]float64 `protobuf:"bytes,6,rep,name=tag_boosts,json=tagBoosts,proto3" json:"tag_boosts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// Optional. The age in days that halves the relevance score of a memo, 30 by default.
+	RecencyHalfLifeDays int32 `protobuf:"varint,7,opt,name=recency_half_life_days,json=recencyHalfLifeDays,proto3" json:"recency_half_life_days,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SearchMemosRequest) Reset() {
+	*x = SearchMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMemosRequest) ProtoMessage() {}
+
+func (x *SearchMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMemosRequest.ProtoReflect.Descriptor instead.
+func (*SearchMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SearchMemosRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchMemosRequest) GetScope() MemoScope {
+	if x != nil {
+		return x.Scope
+	}
+	return MemoScope_MEMO_SCOPE_UNSPECIFIED
+}
+
+func (x *SearchMemosRequest) GetRanking() SearchMemosRequest_Ranking {
+	if x != nil {
+		return x.Ranking
+	}
+	return SearchMemosRequest_RANKING_UNSPECIFIED
+}
+
+func (x *SearchMemosRequest) GetTagBoosts() map[string]float64 {
+	if x != nil {
+		return x.TagBoosts
+	}
+	return nil
+}
+
+func (x *SearchMemosRequest) GetRecencyHalfLifeDays() int32 {
+	if x != nil {
+		return x.RecencyHalfLifeDays
+	}
+	return 0
+}
+
+type SearchMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memos matching the query, in the order of the ranking.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The matches of the memos, in the same order.
+	Matches       []*SearchMemosResponse_Match `protobuf:"bytes,3,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMemosResponse) Reset() {
+	*x = SearchMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMemosResponse) ProtoMessage() {}
+
+func (x *SearchMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMemosResponse.ProtoReflect.Descriptor instead.
+func (*SearchMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SearchMemosResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *SearchMemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchMemosResponse) GetMatches() []*SearchMemosResponse_Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type SearchMemosSemanticRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The text to search for, e.g. "trips to the mountains".
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Optional. The maximum number of memos to return, 10 by default and at most 50.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. The states of the memos to search, the normal memos by default.
+	// The archived memos are only searched among the memos of the current user.
+	Scope         MemoScope `protobuf:"varint,3,opt,name=scope,proto3,enum=memos.api.v1.MemoScope" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMemosSemanticRequest) Reset() {
+	*x = SearchMemosSemanticRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMemosSemanticRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMemosSemanticRequest) ProtoMessage() {}
+
+func (x *SearchMemosSemanticRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMemosSemanticRequest.ProtoReflect.Descriptor instead.
+func (*SearchMemosSemanticRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SearchMemosSemanticRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMemosSemanticRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchMemosSemanticRequest) GetScope() MemoScope {
+	if x != nil {
+		return x.Scope
+	}
+	return MemoScope_MEMO_SCOPE_UNSPECIFIED
+}
+
+type SearchMemosSemanticResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memos visible to the user, closest first.
+	Results       []*SearchMemosSemanticResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMemosSemanticResponse) Reset() {
+	*x = SearchMemosSemanticResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMemosSemanticResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMemosSemanticResponse) ProtoMessage() {}
+
+func (x *SearchMemosSemanticResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMemosSemanticResponse.ProtoReflect.Descriptor instead.
+func (*SearchMemosSemanticResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SearchMemosSemanticResponse) GetResults() []*SearchMemosSemanticResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetMemoBySlugRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The creator of the memo.
+	// Format: users/{id_or_username}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The slug of the memo.
+	Slug          string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoBySlugRequest) Reset() {
+	*x = GetMemoBySlugRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoBySlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoBySlugRequest) ProtoMessage() {}
+
+func (x *GetMemoBySlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoBySlugRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoBySlugRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetMemoBySlugRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *GetMemoBySlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type GetMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The fields to return in the response.
+	// If not specified, all fields are returned.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoRequest) Reset() {
+	*x = GetMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoRequest) ProtoMessage() {}
+
+func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetMemoRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type UpdateMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The memo to update.
+	// The `name` field is required.
+	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Optional. If set to true, allows updating sensitive fields.
+	AllowMissing bool `protobuf:"varint,3,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
+	// Optional. If set, the content is saved as is, without the formatters of the formatting setting of the user.
+	SkipFormatting bool `protobuf:"varint,4,opt,name=skip_formatting,json=skipFormatting,proto3" json:"skip_formatting,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateMemoRequest) Reset() {
+	*x = UpdateMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoRequest) ProtoMessage() {}
+
+func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateMemoRequest) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *UpdateMemoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateMemoRequest) GetAllowMissing() bool {
+	if x != nil {
+		return x.AllowMissing
+	}
+	return false
+}
+
+func (x *UpdateMemoRequest) GetSkipFormatting() bool {
+	if x != nil {
+		return x.SkipFormatting
+	}
+	return false
+}
+
+type DeleteMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo to delete.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. If set to true, the memo will be deleted even if it has associated data.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoRequest) Reset() {
+	*x = DeleteMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoRequest) ProtoMessage() {}
+
+func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteMemoRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type RenameMemoTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent, who owns the tags.
+	// Format: memos/{memo}. Use "memos/-" to rename all tags.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The old tag name to rename.
+	OldTag string `protobuf:"bytes,2,opt,name=old_tag,json=oldTag,proto3" json:"old_tag,omitempty"`
+	// Required. The new tag name.
+	NewTag        string `protobuf:"bytes,3,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameMemoTagRequest) Reset() {
+	*x = RenameMemoTagRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameMemoTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameMemoTagRequest) ProtoMessage() {}
+
+func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameMemoTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameMemoTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RenameMemoTagRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *RenameMemoTagRequest) GetOldTag() string {
+	if x != nil {
+		return x.OldTag
+	}
+	return ""
+}
+
+func (x *RenameMemoTagRequest) GetNewTag() string {
+	if x != nil {
+		return x.NewTag
+	}
+	return ""
+}
+
+type DeleteMemoTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent, who owns the tags.
+	// Format: memos/{memo}. Use "memos/-" to delete all tags.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The tag name to delete.
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Optional. Whether to delete related memos.
+	DeleteRelatedMemos bool `protobuf:"varint,3,opt,name=delete_related_memos,json=deleteRelatedMemos,proto3" json:"delete_related_memos,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeleteMemoTagRequest) Reset() {
+	*x = DeleteMemoTagRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoTagRequest) ProtoMessage() {}
+
+func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteMemoTagRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *DeleteMemoTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *DeleteMemoTagRequest) GetDeleteRelatedMemos() bool {
+	if x != nil {
+		return x.DeleteRelatedMemos
+	}
+	return false
+}
+
+type SetMemoAttachmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The attachments to set for the memo.
+	Attachments   []*Attachment `protobuf:"bytes,2,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemoAttachmentsRequest) Reset() {
+	*x = SetMemoAttachmentsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemoAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemoAttachmentsRequest) ProtoMessage() {}
+
+func (x *SetMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*SetMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SetMemoAttachmentsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetMemoAttachmentsRequest) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type ListMemoAttachmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of attachments to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoAttachmentsRequest) Reset() {
+	*x = ListMemoAttachmentsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListMemoAttachmentsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoAttachmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoAttachmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemoAttachmentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of attachments.
+	Attachments []*Attachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of attachments.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoAttachmentsResponse) Reset() {
+	*x = ListMemoAttachmentsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListMemoAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListMemoAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *ListMemoAttachmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemoAttachmentsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type MemoRelation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memo in the relation.
+	Memo *MemoRelation_Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The related memo.
+	RelatedMemo   *MemoRelation_Memo `protobuf:"bytes,2,opt,name=related_memo,json=relatedMemo,proto3" json:"related_memo,omitempty"`
+	Type          MemoRelation_Type  `protobuf:"varint,3,opt,name=type,proto3,enum=memos.api.v1.MemoRelation_Type" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRelation) Reset() {
+	*x = MemoRelation{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRelation) ProtoMessage() {}
+
+func (x *MemoRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRelation.ProtoReflect.Descriptor instead.
+func (*MemoRelation) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MemoRelation) GetMemo() *MemoRelation_Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *MemoRelation) GetRelatedMemo() *MemoRelation_Memo {
+	if x != nil {
+		return x.RelatedMemo
+	}
+	return nil
+}
+
+func (x *MemoRelation) GetType() MemoRelation_Type {
+	if x != nil {
+		return x.Type
+	}
+	return MemoRelation_TYPE_UNSPECIFIED
+}
+
+type SetMemoRelationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The relations to set for the memo.
+	Relations     []*MemoRelation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemoRelationsRequest) Reset() {
+	*x = SetMemoRelationsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemoRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemoRelationsRequest) ProtoMessage() {}
+
+func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemoRelationsRequest.ProtoReflect.Descriptor instead.
+func (*SetMemoRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetMemoRelationsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetMemoRelationsRequest) GetRelations() []*MemoRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+type ListMemoRelationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of relations to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. The direction of the relations to list relative to the memo. Both directions when unspecified.
+	Direction ListMemoRelationsRequest_Direction `protobuf:"varint,4,opt,name=direction,proto3,enum=memos.api.v1.ListMemoRelationsRequest_Direction" json:"direction,omitempty"`
+	// Optional. Only list the relations of these types. All types when empty.
+	Types         []MemoRelation_Type `protobuf:"varint,5,rep,packed,name=types,proto3,enum=memos.api.v1.MemoRelation_Type" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRelationsRequest) Reset() {
+	*x = ListMemoRelationsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRelationsRequest) ProtoMessage() {}
+
+func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRelationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListMemoRelationsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoRelationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoRelationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMemoRelationsRequest) GetDirection() ListMemoRelationsRequest_Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return ListMemoRelationsRequest_DIRECTION_UNSPECIFIED
+}
+
+func (x *ListMemoRelationsRequest) GetTypes() []MemoRelation_Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type ListMemoRelationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of relations.
+	Relations []*MemoRelation `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of relations.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRelationsResponse) Reset() {
+	*x = ListMemoRelationsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRelationsResponse) ProtoMessage() {}
+
+func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRelationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoRelationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListMemoRelationsResponse) GetRelations() []*MemoRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *ListMemoRelationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemoRelationsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type CreateMemoCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The comment to create.
+	Comment *Memo `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Optional. The comment ID to use.
+	CommentId     string `protobuf:"bytes,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoCommentRequest) Reset() {
+	*x = CreateMemoCommentRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoCommentRequest) ProtoMessage() {}
+
+func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateMemoCommentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMemoCommentRequest) GetComment() *Memo {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *CreateMemoCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type ListMemoCommentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of comments to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. The order to sort results by.
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoCommentsRequest) Reset() {
+	*x = ListMemoCommentsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoCommentsRequest) ProtoMessage() {}
+
+func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListMemoCommentsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMemoCommentsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListMemoCommentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of comment memos.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of comments.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoCommentsResponse) Reset() {
+	*x = ListMemoCommentsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoCommentsResponse) ProtoMessage() {}
+
+func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListMemoCommentsResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListMemoCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemoCommentsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ListMemoReactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of reactions to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoReactionsRequest) Reset() {
+	*x = ListMemoReactionsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoReactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-// Enum value maps for MemoRelation_Type.
-var (
-	MemoRelation_Type_name = map[int32]string{
-		0: "TYPE_UNSPECIFIED",
-		1: "REFERENCE",
-		2: "COMMENT",
+func (*ListMemoReactionsRequest) ProtoMessage() {}
+
+func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	MemoRelation_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"REFERENCE":        1,
-		"COMMENT":          2,
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoReactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoReactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListMemoReactionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-)
+	return ""
+}
 
-func (x MemoRelation_Type) Enum() *MemoRelation_Type {
-	p := new(MemoRelation_Type)
-	*p = x
-	return p
+func (x *ListMemoReactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x MemoRelation_Type) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *ListMemoReactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
 }
 
-func (MemoRelation_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_memo_service_proto_enumTypes[1].Descriptor()
+type ListMemoReactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of reactions.
+	Reactions []*Reaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of reactions.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (MemoRelation_Type) Type() protoreflect.EnumType {
-	return &file_api_v1_memo_service_proto_enumTypes[1]
+func (x *ListMemoReactionsResponse) Reset() {
+	*x = ListMemoReactionsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x MemoRelation_Type) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *ListMemoReactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Deprecated: Use MemoRelation_Type.Descriptor instead.
-func (MemoRelation_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14, 0}
+func (*ListMemoReactionsResponse) ProtoMessage() {}
+
+func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Reaction struct {
+// Deprecated: Use ListMemoReactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoReactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListMemoReactionsResponse) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *ListMemoReactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemoReactionsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type UpsertMemoReactionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The resource name of the reaction.
-	// Format: reactions/{reaction}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The resource name of the creator.
-	// Format: users/{user}
-	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	// The resource name of the content.
-	// For memo reactions, this should be the memo's resource name.
+	// Required. The resource name of the memo.
 	// Format: memos/{memo}
-	ContentId string `protobuf:"bytes,3,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
-	// Required. The type of reaction (e.g., "👍", "❤️", "😄").
-	ReactionType string `protobuf:"bytes,4,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
-	// Output only. The creation timestamp.
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The reaction to upsert.
+	Reaction      *Reaction `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Reaction) Reset() {
-	*x = Reaction{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[0]
+func (x *UpsertMemoReactionRequest) Reset() {
+	*x = UpsertMemoReactionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Reaction) String() string {
+func (x *UpsertMemoReactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reaction) ProtoMessage() {}
+func (*UpsertMemoReactionRequest) ProtoMessage() {}
 
-func (x *Reaction) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[0]
+func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -172,104 +5179,140 @@ func (x *Reaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
-func (*Reaction) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use UpsertMemoReactionRequest.ProtoReflect.Descriptor instead.
+func (*UpsertMemoReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *Reaction) GetName() string {
+func (x *UpsertMemoReactionRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Reaction) GetCreator() string {
+func (x *UpsertMemoReactionRequest) GetReaction() *Reaction {
 	if x != nil {
-		return x.Creator
+		return x.Reaction
 	}
-	return ""
+	return nil
 }
 
-func (x *Reaction) GetContentId() string {
+type ListMemoWebmentionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoWebmentionsRequest) Reset() {
+	*x = ListMemoWebmentionsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoWebmentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoWebmentionsRequest) ProtoMessage() {}
+
+func (x *ListMemoWebmentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[66]
 	if x != nil {
-		return x.ContentId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Reaction) GetReactionType() string {
+// Deprecated: Use ListMemoWebmentionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoWebmentionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListMemoWebmentionsRequest) GetName() string {
 	if x != nil {
-		return x.ReactionType
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Reaction) GetCreateTime() *timestamppb.Timestamp {
+type ListMemoWebmentionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The webmentions of the memo, the oldest first.
+	Webmentions   []*Webmention `protobuf:"bytes,1,rep,name=webmentions,proto3" json:"webmentions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoWebmentionsResponse) Reset() {
+	*x = ListMemoWebmentionsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoWebmentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoWebmentionsResponse) ProtoMessage() {}
+
+func (x *ListMemoWebmentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[67]
 	if x != nil {
-		return x.CreateTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type Memo struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The resource name of the memo.
-	// Format: memos/{memo}, memo is the user defined id or uuid.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The state of the memo.
-	State State `protobuf:"varint,2,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
-	// The name of the creator.
-	// Format: users/{user}
-	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
-	// Output only. The creation timestamp.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// Output only. The last update timestamp.
-	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	// The display timestamp of the memo.
-	DisplayTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=display_time,json=displayTime,proto3" json:"display_time,omitempty"`
-	// Required. The content of the memo in Markdown format.
-	Content string `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
-	// The visibility of the memo.
-	Visibility Visibility `protobuf:"varint,9,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
-	// Output only. The tags extracted from the content.
-	Tags []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
-	// Whether the memo is pinned.
-	Pinned bool `protobuf:"varint,11,opt,name=pinned,proto3" json:"pinned,omitempty"`
-	// Optional. The attachments of the memo.
-	Attachments []*Attachment `protobuf:"bytes,12,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	// Optional. The relations of the memo.
-	Relations []*MemoRelation `protobuf:"bytes,13,rep,name=relations,proto3" json:"relations,omitempty"`
-	// Output only. The reactions to the memo.
-	Reactions []*Reaction `protobuf:"bytes,14,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	// Output only. The computed properties of the memo.
-	Property *Memo_Property `protobuf:"bytes,15,opt,name=property,proto3" json:"property,omitempty"`
-	// Output only. The name of the parent memo.
-	// Format: memos/{memo}
-	Parent *string `protobuf:"bytes,16,opt,name=parent,proto3,oneof" json:"parent,omitempty"`
-	// Output only. The snippet of the memo content. Plain text only.
-	Snippet string `protobuf:"bytes,17,opt,name=snippet,proto3" json:"snippet,omitempty"`
-	// Optional. The location of the memo.
-	Location      *Location `protobuf:"bytes,18,opt,name=location,proto3,oneof" json:"location,omitempty"`
+// Deprecated: Use ListMemoWebmentionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoWebmentionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListMemoWebmentionsResponse) GetWebmentions() []*Webmention {
+	if x != nil {
+		return x.Webmentions
+	}
+	return nil
+}
+
+type DeleteMemoWebmentionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the webmention to delete.
+	// Format: memos/{memo}/webmentions/{webmention}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Memo) Reset() {
-	*x = Memo{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[1]
+func (x *DeleteMemoWebmentionRequest) Reset() {
+	*x = DeleteMemoWebmentionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Memo) String() string {
+func (x *DeleteMemoWebmentionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Memo) ProtoMessage() {}
+func (*DeleteMemoWebmentionRequest) ProtoMessage() {}
 
-func (x *Memo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[1]
+func (x *DeleteMemoWebmentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,157 +5323,162 @@ func (x *Memo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Memo.ProtoReflect.Descriptor instead.
-func (*Memo) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use DeleteMemoWebmentionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoWebmentionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *Memo) GetName() string {
+func (x *DeleteMemoWebmentionRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Memo) GetState() State {
-	if x != nil {
-		return x.State
-	}
-	return State_STATE_UNSPECIFIED
+type DeleteMemoReactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the reaction to delete.
+	// Format: reactions/{reaction}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Memo) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
+func (x *DeleteMemoReactionRequest) Reset() {
+	*x = DeleteMemoReactionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Memo) GetCreateTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreateTime
-	}
-	return nil
+func (x *DeleteMemoReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Memo) GetUpdateTime() *timestamppb.Timestamp {
+func (*DeleteMemoReactionRequest) ProtoMessage() {}
+
+func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[69]
 	if x != nil {
-		return x.UpdateTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Memo) GetDisplayTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.DisplayTime
-	}
-	return nil
+// Deprecated: Use DeleteMemoReactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *Memo) GetContent() string {
+func (x *DeleteMemoReactionRequest) GetName() string {
 	if x != nil {
-		return x.Content
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Memo) GetVisibility() Visibility {
-	if x != nil {
-		return x.Visibility
-	}
-	return Visibility_VISIBILITY_UNSPECIFIED
+// The author of the response, from its h-card.
+type Webmention_Author struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The URL of the photo of the author.
+	Photo         string `protobuf:"bytes,3,opt,name=photo,proto3" json:"photo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Memo) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+func (x *Webmention_Author) Reset() {
+	*x = Webmention_Author{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Memo) GetPinned() bool {
-	if x != nil {
-		return x.Pinned
-	}
-	return false
+func (x *Webmention_Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Memo) GetAttachments() []*Attachment {
-	if x != nil {
-		return x.Attachments
-	}
-	return nil
-}
+func (*Webmention_Author) ProtoMessage() {}
 
-func (x *Memo) GetRelations() []*MemoRelation {
+func (x *Webmention_Author) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[70]
 	if x != nil {
-		return x.Relations
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Memo) GetReactions() []*Reaction {
-	if x != nil {
-		return x.Reactions
-	}
-	return nil
+// Deprecated: Use Webmention_Author.ProtoReflect.Descriptor instead.
+func (*Webmention_Author) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1, 0}
 }
 
-func (x *Memo) GetProperty() *Memo_Property {
+func (x *Webmention_Author) GetName() string {
 	if x != nil {
-		return x.Property
-	}
-	return nil
-}
-
-func (x *Memo) GetParent() string {
-	if x != nil && x.Parent != nil {
-		return *x.Parent
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Memo) GetSnippet() string {
+func (x *Webmention_Author) GetUrl() string {
 	if x != nil {
-		return x.Snippet
+		return x.Url
 	}
 	return ""
 }
 
-func (x *Memo) GetLocation() *Location {
+func (x *Webmention_Author) GetPhoto() string {
 	if x != nil {
-		return x.Location
+		return x.Photo
 	}
-	return nil
+	return ""
 }
 
-type Location struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// A placeholder text for the location.
-	Placeholder string `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	// The latitude of the location.
-	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	// The longitude of the location.
-	Longitude     float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+// Computed properties of a memo.
+type Memo_Property struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	HasLink            bool                   `protobuf:"varint,1,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
+	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
+	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
+	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
+	// The links found unreachable by the link checker.
+	BrokenLinks []*Memo_BrokenLink `protobuf:"bytes,5,rep,name=broken_links,json=brokenLinks,proto3" json:"broken_links,omitempty"`
+	// The web archive snapshots of the links in the content.
+	LinkSnapshots []*Memo_LinkSnapshot `protobuf:"bytes,6,rep,name=link_snapshots,json=linkSnapshots,proto3" json:"link_snapshots,omitempty"`
+	// Whether the memo was generated by the AI, e.g. an AI summary.
+	IsAiGenerated bool `protobuf:"varint,7,opt,name=is_ai_generated,json=isAiGenerated,proto3" json:"is_ai_generated,omitempty"`
+	// The time entries of the "@time" blocks of the content, e.g. "@time 2h #project".
+	TimeEntries []*Memo_TimeEntry `protobuf:"bytes,8,rep,name=time_entries,json=timeEntries,proto3" json:"time_entries,omitempty"`
+	// The amounts of the content, e.g. "$12.50 #food".
+	Amounts       []*Memo_Amount `protobuf:"bytes,9,rep,name=amounts,proto3" json:"amounts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Location) Reset() {
-	*x = Location{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[2]
+func (x *Memo_Property) Reset() {
+	*x = Memo_Property{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Location) String() string {
+func (x *Memo_Property) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Location) ProtoMessage() {}
+func (*Memo_Property) ProtoMessage() {}
 
-func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[2]
+func (x *Memo_Property) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,149 +5489,102 @@ func (x *Location) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Location.ProtoReflect.Descriptor instead.
-func (*Location) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Memo_Property.ProtoReflect.Descriptor instead.
+func (*Memo_Property) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2, 0}
 }
 
-func (x *Location) GetPlaceholder() string {
+func (x *Memo_Property) GetHasLink() bool {
 	if x != nil {
-		return x.Placeholder
+		return x.HasLink
 	}
-	return ""
+	return false
 }
 
-func (x *Location) GetLatitude() float64 {
+func (x *Memo_Property) GetHasTaskList() bool {
 	if x != nil {
-		return x.Latitude
+		return x.HasTaskList
 	}
-	return 0
+	return false
 }
 
-func (x *Location) GetLongitude() float64 {
+func (x *Memo_Property) GetHasCode() bool {
 	if x != nil {
-		return x.Longitude
+		return x.HasCode
 	}
-	return 0
-}
-
-type CreateMemoRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The memo to create.
-	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	// Optional. The memo ID to use for this memo.
-	// If empty, a unique ID will be generated.
-	MemoId string `protobuf:"bytes,2,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
-	// Optional. If set, validate the request but don't actually create the memo.
-	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
-	// Optional. An idempotency token.
-	RequestId     string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateMemoRequest) Reset() {
-	*x = CreateMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateMemoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return false
 }
 
-func (*CreateMemoRequest) ProtoMessage() {}
-
-func (x *CreateMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+func (x *Memo_Property) GetHasIncompleteTasks() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.HasIncompleteTasks
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateMemoRequest.ProtoReflect.Descriptor instead.
-func (*CreateMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{3}
+	return false
 }
 
-func (x *CreateMemoRequest) GetMemo() *Memo {
+func (x *Memo_Property) GetBrokenLinks() []*Memo_BrokenLink {
 	if x != nil {
-		return x.Memo
+		return x.BrokenLinks
 	}
 	return nil
 }
 
-func (x *CreateMemoRequest) GetMemoId() string {
+func (x *Memo_Property) GetLinkSnapshots() []*Memo_LinkSnapshot {
 	if x != nil {
-		return x.MemoId
+		return x.LinkSnapshots
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateMemoRequest) GetValidateOnly() bool {
+func (x *Memo_Property) GetIsAiGenerated() bool {
 	if x != nil {
-		return x.ValidateOnly
+		return x.IsAiGenerated
 	}
 	return false
 }
 
-func (x *CreateMemoRequest) GetRequestId() string {
+func (x *Memo_Property) GetTimeEntries() []*Memo_TimeEntry {
 	if x != nil {
-		return x.RequestId
+		return x.TimeEntries
 	}
-	return ""
+	return nil
 }
 
-type ListMemosRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional. The maximum number of memos to return.
-	// The service may return fewer than this value.
-	// If unspecified, at most 50 memos will be returned.
-	// The maximum value is 1000; values above 1000 will be coerced to 1000.
-	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token, received from a previous `ListMemos` call.
-	// Provide this to retrieve the subsequent page.
-	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Optional. The state of the memos to list.
-	// Default to `NORMAL`. Set to `ARCHIVED` to list archived memos.
-	State State `protobuf:"varint,3,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
-	// Optional. The order to sort results by.
-	// Default to "display_time desc".
-	// Supports comma-separated list of fields following AIP-132.
-	// Example: "pinned desc, display_time desc" or "create_time asc"
-	// Supported fields: pinned, display_time, create_time, update_time, name
-	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	// Optional. Filter to apply to the list results.
-	// Filter is a CEL expression to filter memos.
-	// Refer to `Shortcut.filter`.
-	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
-	// Optional. If true, show deleted memos in the response.
-	ShowDeleted   bool `protobuf:"varint,6,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+func (x *Memo_Property) GetAmounts() []*Memo_Amount {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+// An amount written on a line of the memo content.
+type Memo_Amount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The value of the amount, negative when written with a minus sign.
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	// The currency symbol of the amount, e.g. "$".
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// The tags on the line of the amount.
+	Tags          []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemosRequest) Reset() {
-	*x = ListMemosRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[4]
+func (x *Memo_Amount) Reset() {
+	*x = Memo_Amount{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemosRequest) String() string {
+func (x *Memo_Amount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemosRequest) ProtoMessage() {}
+func (*Memo_Amount) ProtoMessage() {}
 
-func (x *ListMemosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[4]
+func (x *Memo_Amount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,81 +5595,58 @@ func (x *ListMemosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemosRequest.ProtoReflect.Descriptor instead.
-func (*ListMemosRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Memo_Amount.ProtoReflect.Descriptor instead.
+func (*Memo_Amount) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2, 1}
 }
 
-func (x *ListMemosRequest) GetPageSize() int32 {
+func (x *Memo_Amount) GetValue() float64 {
 	if x != nil {
-		return x.PageSize
+		return x.Value
 	}
 	return 0
 }
 
-func (x *ListMemosRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
-
-func (x *ListMemosRequest) GetState() State {
-	if x != nil {
-		return x.State
-	}
-	return State_STATE_UNSPECIFIED
-}
-
-func (x *ListMemosRequest) GetOrderBy() string {
-	if x != nil {
-		return x.OrderBy
-	}
-	return ""
-}
-
-func (x *ListMemosRequest) GetFilter() string {
+func (x *Memo_Amount) GetCurrency() string {
 	if x != nil {
-		return x.Filter
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *ListMemosRequest) GetShowDeleted() bool {
+func (x *Memo_Amount) GetTags() []string {
 	if x != nil {
-		return x.ShowDeleted
+		return x.Tags
 	}
-	return false
+	return nil
 }
 
-type ListMemosResponse struct {
+// The time spent logged on a line of the memo content.
+type Memo_TimeEntry struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of memos.
-	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
-	// A token that can be sent as `page_token` to retrieve the next page.
-	// If this field is omitted, there are no subsequent pages.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of memos (may be approximate).
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The time spent in minutes.
+	Minutes int32 `protobuf:"varint,1,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	// The tags on the line of the entry.
+	Tags          []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemosResponse) Reset() {
-	*x = ListMemosResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+func (x *Memo_TimeEntry) Reset() {
+	*x = Memo_TimeEntry{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemosResponse) String() string {
+func (x *Memo_TimeEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemosResponse) ProtoMessage() {}
+func (*Memo_TimeEntry) ProtoMessage() {}
 
-func (x *ListMemosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+func (x *Memo_TimeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,59 +5657,53 @@ func (x *ListMemosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemosResponse.ProtoReflect.Descriptor instead.
-func (*ListMemosResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ListMemosResponse) GetMemos() []*Memo {
-	if x != nil {
-		return x.Memos
-	}
-	return nil
+// Deprecated: Use Memo_TimeEntry.ProtoReflect.Descriptor instead.
+func (*Memo_TimeEntry) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2, 2}
 }
 
-func (x *ListMemosResponse) GetNextPageToken() string {
+func (x *Memo_TimeEntry) GetMinutes() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.Minutes
 	}
-	return ""
+	return 0
 }
 
-func (x *ListMemosResponse) GetTotalSize() int32 {
+func (x *Memo_TimeEntry) GetTags() []string {
 	if x != nil {
-		return x.TotalSize
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-type GetMemoRequest struct {
+// A link in the memo content that could not be reached.
+type Memo_BrokenLink struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The fields to return in the response.
-	// If not specified, all fields are returned.
-	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// The URL of the link.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The HTTP status code of the last check, 0 if the request failed.
+	StatusCode int32 `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// The time the link was last checked.
+	CheckTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=check_time,json=checkTime,proto3" json:"check_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemoRequest) Reset() {
-	*x = GetMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+func (x *Memo_BrokenLink) Reset() {
+	*x = Memo_BrokenLink{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemoRequest) String() string {
+func (x *Memo_BrokenLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemoRequest) ProtoMessage() {}
+func (*Memo_BrokenLink) ProtoMessage() {}
 
-func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+func (x *Memo_BrokenLink) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,53 +5714,60 @@ func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemoRequest.ProtoReflect.Descriptor instead.
-func (*GetMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Memo_BrokenLink.ProtoReflect.Descriptor instead.
+func (*Memo_BrokenLink) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2, 3}
 }
 
-func (x *GetMemoRequest) GetName() string {
+func (x *Memo_BrokenLink) GetUrl() string {
 	if x != nil {
-		return x.Name
+		return x.Url
 	}
 	return ""
 }
 
-func (x *GetMemoRequest) GetReadMask() *fieldmaskpb.FieldMask {
+func (x *Memo_BrokenLink) GetStatusCode() int32 {
 	if x != nil {
-		return x.ReadMask
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *Memo_BrokenLink) GetCheckTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckTime
 	}
 	return nil
 }
 
-type UpdateMemoRequest struct {
+// A web archive snapshot of a link in the memo content.
+type Memo_LinkSnapshot struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The memo to update.
-	// The `name` field is required.
-	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	// Required. The list of fields to update.
-	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	// Optional. If set to true, allows updating sensitive fields.
-	AllowMissing  bool `protobuf:"varint,3,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
+	// The URL of the link.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The URL of the archived snapshot.
+	SnapshotUrl string `protobuf:"bytes,2,opt,name=snapshot_url,json=snapshotUrl,proto3" json:"snapshot_url,omitempty"`
+	// The time the snapshot was captured.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMemoRequest) Reset() {
-	*x = UpdateMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[7]
+func (x *Memo_LinkSnapshot) Reset() {
+	*x = Memo_LinkSnapshot{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMemoRequest) String() string {
+func (x *Memo_LinkSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMemoRequest) ProtoMessage() {}
+func (*Memo_LinkSnapshot) ProtoMessage() {}
 
-func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[7]
+func (x *Memo_LinkSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,58 +5778,63 @@ func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMemoRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use Memo_LinkSnapshot.ProtoReflect.Descriptor instead.
+func (*Memo_LinkSnapshot) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2, 4}
 }
 
-func (x *UpdateMemoRequest) GetMemo() *Memo {
+func (x *Memo_LinkSnapshot) GetUrl() string {
 	if x != nil {
-		return x.Memo
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateMemoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *Memo_LinkSnapshot) GetSnapshotUrl() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.SnapshotUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateMemoRequest) GetAllowMissing() bool {
+func (x *Memo_LinkSnapshot) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AllowMissing
+		return x.CreateTime
 	}
-	return false
+	return nil
 }
 
-type DeleteMemoRequest struct {
+// The reading properties of a memo tracking a book or an article.
+type Memo_Reading struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo to delete.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. If set to true, the memo will be deleted even if it has associated data.
-	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// The author of the book or the article.
+	Author string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	// The reading status, lowercase with underscores, e.g. "to_read", "reading", "finished" or "abandoned".
+	// Default to "finished" with a finished date, "to_read" otherwise.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// The rating from 1 to 10, 0 if unrated.
+	Rating int32 `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	// The date the reading was finished, in the format "YYYY-MM-DD".
+	FinishedDate  string `protobuf:"bytes,4,opt,name=finished_date,json=finishedDate,proto3" json:"finished_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoRequest) Reset() {
-	*x = DeleteMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+func (x *Memo_Reading) Reset() {
+	*x = Memo_Reading{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoRequest) String() string {
+func (x *Memo_Reading) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoRequest) ProtoMessage() {}
+func (*Memo_Reading) ProtoMessage() {}
 
-func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+func (x *Memo_Reading) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -861,53 +5845,68 @@ func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Memo_Reading.ProtoReflect.Descriptor instead.
+func (*Memo_Reading) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2, 5}
 }
 
-func (x *DeleteMemoRequest) GetName() string {
+func (x *Memo_Reading) GetAuthor() string {
 	if x != nil {
-		return x.Name
+		return x.Author
 	}
 	return ""
 }
 
-func (x *DeleteMemoRequest) GetForce() bool {
+func (x *Memo_Reading) GetStatus() string {
 	if x != nil {
-		return x.Force
+		return x.Status
 	}
-	return false
+	return ""
 }
 
-type RenameMemoTagRequest struct {
+func (x *Memo_Reading) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Memo_Reading) GetFinishedDate() string {
+	if x != nil {
+		return x.FinishedDate
+	}
+	return ""
+}
+
+// A user who edited the content of a memo.
+type Memo_Contributor struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The parent, who owns the tags.
-	// Format: memos/{memo}. Use "memos/-" to rename all tags.
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// Required. The old tag name to rename.
-	OldTag string `protobuf:"bytes,2,opt,name=old_tag,json=oldTag,proto3" json:"old_tag,omitempty"`
-	// Required. The new tag name.
-	NewTag        string `protobuf:"bytes,3,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	// The user.
+	// Format: users/{user}
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// The time of the last edit of the content by the user.
+	LastEditTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_edit_time,json=lastEditTime,proto3" json:"last_edit_time,omitempty"`
+	// The number of the edits of the content by the user, the creation included.
+	EditCount     int32 `protobuf:"varint,3,opt,name=edit_count,json=editCount,proto3" json:"edit_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RenameMemoTagRequest) Reset() {
-	*x = RenameMemoTagRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+func (x *Memo_Contributor) Reset() {
+	*x = Memo_Contributor{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameMemoTagRequest) String() string {
+func (x *Memo_Contributor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameMemoTagRequest) ProtoMessage() {}
+func (*Memo_Contributor) ProtoMessage() {}
 
-func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+func (x *Memo_Contributor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,60 +5917,66 @@ func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameMemoTagRequest.ProtoReflect.Descriptor instead.
-func (*RenameMemoTagRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Memo_Contributor.ProtoReflect.Descriptor instead.
+func (*Memo_Contributor) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2, 6}
 }
 
-func (x *RenameMemoTagRequest) GetParent() string {
+func (x *Memo_Contributor) GetUser() string {
 	if x != nil {
-		return x.Parent
+		return x.User
 	}
 	return ""
 }
 
-func (x *RenameMemoTagRequest) GetOldTag() string {
+func (x *Memo_Contributor) GetLastEditTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OldTag
+		return x.LastEditTime
 	}
-	return ""
+	return nil
 }
 
-func (x *RenameMemoTagRequest) GetNewTag() string {
+func (x *Memo_Contributor) GetEditCount() int32 {
 	if x != nil {
-		return x.NewTag
+		return x.EditCount
 	}
-	return ""
+	return 0
 }
 
-type DeleteMemoTagRequest struct {
+// The approval of a memo by the admins.
+type Memo_Approval struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The parent, who owns the tags.
-	// Format: memos/{memo}. Use "memos/-" to delete all tags.
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// Required. The tag name to delete.
-	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	// Optional. Whether to delete related memos.
-	DeleteRelatedMemos bool `protobuf:"varint,3,opt,name=delete_related_memos,json=deleteRelatedMemos,proto3" json:"delete_related_memos,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	State Memo_Approval_State    `protobuf:"varint,1,opt,name=state,proto3,enum=memos.api.v1.Memo_Approval_State" json:"state,omitempty"`
+	// The visibility the memo gets when approved.
+	RequestedVisibility Visibility `protobuf:"varint,2,opt,name=requested_visibility,json=requestedVisibility,proto3,enum=memos.api.v1.Visibility" json:"requested_visibility,omitempty"`
+	// The time the approval was requested.
+	RequestTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=request_time,json=requestTime,proto3" json:"request_time,omitempty"`
+	// The admin who reviewed the memo.
+	// Format: users/{user}
+	Reviewer string `protobuf:"bytes,4,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	// The time the memo was reviewed.
+	ReviewTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=review_time,json=reviewTime,proto3" json:"review_time,omitempty"`
+	// The reason of a rejection.
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoTagRequest) Reset() {
-	*x = DeleteMemoTagRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+func (x *Memo_Approval) Reset() {
+	*x = Memo_Approval{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoTagRequest) String() string {
+func (x *Memo_Approval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoTagRequest) ProtoMessage() {}
+func (*Memo_Approval) ProtoMessage() {}
 
-func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+func (x *Memo_Approval) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,58 +5987,81 @@ func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoTagRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoTagRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Memo_Approval.ProtoReflect.Descriptor instead.
+func (*Memo_Approval) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2, 7}
 }
 
-func (x *DeleteMemoTagRequest) GetParent() string {
+func (x *Memo_Approval) GetState() Memo_Approval_State {
 	if x != nil {
-		return x.Parent
+		return x.State
 	}
-	return ""
+	return Memo_Approval_STATE_UNSPECIFIED
 }
 
-func (x *DeleteMemoTagRequest) GetTag() string {
+func (x *Memo_Approval) GetRequestedVisibility() Visibility {
 	if x != nil {
-		return x.Tag
+		return x.RequestedVisibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Memo_Approval) GetRequestTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestTime
+	}
+	return nil
+}
+
+func (x *Memo_Approval) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
 	}
 	return ""
 }
 
-func (x *DeleteMemoTagRequest) GetDeleteRelatedMemos() bool {
+func (x *Memo_Approval) GetReviewTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DeleteRelatedMemos
+		return x.ReviewTime
 	}
-	return false
+	return nil
 }
 
-type SetMemoAttachmentsRequest struct {
+func (x *Memo_Approval) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// A copy of the memo cross-posted to another platform.
+type Memo_Syndication struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The attachments to set for the memo.
-	Attachments   []*Attachment `protobuf:"bytes,2,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// The platform, e.g. "mastodon", "micropub" or "bluesky".
+	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	// The URL of the copy on the platform.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The time the memo was cross-posted.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetMemoAttachmentsRequest) Reset() {
-	*x = SetMemoAttachmentsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+func (x *Memo_Syndication) Reset() {
+	*x = Memo_Syndication{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMemoAttachmentsRequest) String() string {
+func (x *Memo_Syndication) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMemoAttachmentsRequest) ProtoMessage() {}
+func (*Memo_Syndication) ProtoMessage() {}
 
-func (x *SetMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+func (x *Memo_Syndication) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,53 +6072,60 @@ func (x *SetMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
-func (*SetMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Memo_Syndication.ProtoReflect.Descriptor instead.
+func (*Memo_Syndication) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2, 8}
+}
+
+func (x *Memo_Syndication) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
 }
 
-func (x *SetMemoAttachmentsRequest) GetName() string {
+func (x *Memo_Syndication) GetUrl() string {
 	if x != nil {
-		return x.Name
+		return x.Url
 	}
 	return ""
 }
 
-func (x *SetMemoAttachmentsRequest) GetAttachments() []*Attachment {
+func (x *Memo_Syndication) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Attachments
+		return x.CreateTime
 	}
 	return nil
 }
 
-type ListMemoAttachmentsRequest struct {
+// A refinement of an AI summary with a follow-up instruction.
+type Memo_AISummaryRefinement struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of attachments to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The follow-up instruction, e.g. "make it shorter".
+	Instruction string `protobuf:"bytes,1,opt,name=instruction,proto3" json:"instruction,omitempty"`
+	// The summary before the refinement.
+	PreviousSummary string `protobuf:"bytes,2,opt,name=previous_summary,json=previousSummary,proto3" json:"previous_summary,omitempty"`
+	// The time of the refinement.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoAttachmentsRequest) Reset() {
-	*x = ListMemoAttachmentsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+func (x *Memo_AISummaryRefinement) Reset() {
+	*x = Memo_AISummaryRefinement{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoAttachmentsRequest) String() string {
+func (x *Memo_AISummaryRefinement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoAttachmentsRequest) ProtoMessage() {}
+func (*Memo_AISummaryRefinement) ProtoMessage() {}
 
-func (x *ListMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+func (x *Memo_AISummaryRefinement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,59 +6136,56 @@ func (x *ListMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Memo_AISummaryRefinement.ProtoReflect.Descriptor instead.
+func (*Memo_AISummaryRefinement) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2, 9}
 }
 
-func (x *ListMemoAttachmentsRequest) GetName() string {
+func (x *Memo_AISummaryRefinement) GetInstruction() string {
 	if x != nil {
-		return x.Name
+		return x.Instruction
 	}
 	return ""
 }
 
-func (x *ListMemoAttachmentsRequest) GetPageSize() int32 {
+func (x *Memo_AISummaryRefinement) GetPreviousSummary() string {
 	if x != nil {
-		return x.PageSize
+		return x.PreviousSummary
 	}
-	return 0
+	return ""
 }
 
-func (x *ListMemoAttachmentsRequest) GetPageToken() string {
+func (x *Memo_AISummaryRefinement) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PageToken
+		return x.CreateTime
 	}
-	return ""
+	return nil
 }
 
-type ListMemoAttachmentsResponse struct {
+type MemoStats_DailyViewCount struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of attachments.
-	Attachments []*Attachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of attachments.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The day of the views in UTC, e.g. "2025-06-01".
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Count         int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoAttachmentsResponse) Reset() {
-	*x = ListMemoAttachmentsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+func (x *MemoStats_DailyViewCount) Reset() {
+	*x = MemoStats_DailyViewCount{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoAttachmentsResponse) String() string {
+func (x *MemoStats_DailyViewCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoAttachmentsResponse) ProtoMessage() {}
+func (*MemoStats_DailyViewCount) ProtoMessage() {}
 
-func (x *ListMemoAttachmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+func (x *MemoStats_DailyViewCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1164,58 +6196,54 @@ func (x *ListMemoAttachmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoAttachmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoAttachmentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ListMemoAttachmentsResponse) GetAttachments() []*Attachment {
-	if x != nil {
-		return x.Attachments
-	}
-	return nil
+// Deprecated: Use MemoStats_DailyViewCount.ProtoReflect.Descriptor instead.
+func (*MemoStats_DailyViewCount) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
-func (x *ListMemoAttachmentsResponse) GetNextPageToken() string {
+func (x *MemoStats_DailyViewCount) GetDate() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Date
 	}
 	return ""
 }
 
-func (x *ListMemoAttachmentsResponse) GetTotalSize() int32 {
+func (x *MemoStats_DailyViewCount) GetCount() int32 {
 	if x != nil {
-		return x.TotalSize
+		return x.Count
 	}
 	return 0
 }
 
-type MemoRelation struct {
+type CalendarMonth_Day struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The memo in the relation.
-	Memo *MemoRelation_Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	// The related memo.
-	RelatedMemo   *MemoRelation_Memo `protobuf:"bytes,2,opt,name=related_memo,json=relatedMemo,proto3" json:"related_memo,omitempty"`
-	Type          MemoRelation_Type  `protobuf:"varint,3,opt,name=type,proto3,enum=memos.api.v1.MemoRelation_Type" json:"type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The date of the day, in the format "YYYY-MM-DD".
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// The number of memos of the day.
+	MemoCount int32 `protobuf:"varint,2,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The most used tags of the memos of the day, by their count then name, at most 3.
+	TopTags []string `protobuf:"bytes,3,rep,name=top_tags,json=topTags,proto3" json:"top_tags,omitempty"`
+	// The number of completed tasks in the memos of the day.
+	CompletedTaskCount int32 `protobuf:"varint,4,opt,name=completed_task_count,json=completedTaskCount,proto3" json:"completed_task_count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *MemoRelation) Reset() {
-	*x = MemoRelation{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+func (x *CalendarMonth_Day) Reset() {
+	*x = CalendarMonth_Day{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemoRelation) String() string {
+func (x *CalendarMonth_Day) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemoRelation) ProtoMessage() {}
+func (*CalendarMonth_Day) ProtoMessage() {}
 
-func (x *MemoRelation) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+func (x *CalendarMonth_Day) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1226,58 +6254,67 @@ func (x *MemoRelation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemoRelation.ProtoReflect.Descriptor instead.
-func (*MemoRelation) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CalendarMonth_Day.ProtoReflect.Descriptor instead.
+func (*CalendarMonth_Day) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16, 0}
 }
 
-func (x *MemoRelation) GetMemo() *MemoRelation_Memo {
+func (x *CalendarMonth_Day) GetDate() string {
 	if x != nil {
-		return x.Memo
+		return x.Date
 	}
-	return nil
+	return ""
 }
 
-func (x *MemoRelation) GetRelatedMemo() *MemoRelation_Memo {
+func (x *CalendarMonth_Day) GetMemoCount() int32 {
 	if x != nil {
-		return x.RelatedMemo
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *CalendarMonth_Day) GetTopTags() []string {
+	if x != nil {
+		return x.TopTags
 	}
 	return nil
 }
 
-func (x *MemoRelation) GetType() MemoRelation_Type {
+func (x *CalendarMonth_Day) GetCompletedTaskCount() int32 {
 	if x != nil {
-		return x.Type
+		return x.CompletedTaskCount
 	}
-	return MemoRelation_TYPE_UNSPECIFIED
+	return 0
 }
 
-type SetMemoRelationsRequest struct {
+type TimeReport_Week struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The relations to set for the memo.
-	Relations     []*MemoRelation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	// The first date of the week, in the format "YYYY-MM-DD", possibly before the start date of the report.
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// The time logged per tag, by their time then name. The entries without tags are under an empty tag,
+	// and the entries with several tags count for each of them.
+	Tags []*TimeReport_TagTime `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The time logged in the week in minutes, each entry counted once.
+	TotalMinutes  int32 `protobuf:"varint,3,opt,name=total_minutes,json=totalMinutes,proto3" json:"total_minutes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetMemoRelationsRequest) Reset() {
-	*x = SetMemoRelationsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+func (x *TimeReport_Week) Reset() {
+	*x = TimeReport_Week{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMemoRelationsRequest) String() string {
+func (x *TimeReport_Week) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMemoRelationsRequest) ProtoMessage() {}
+func (*TimeReport_Week) ProtoMessage() {}
 
-func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+func (x *TimeReport_Week) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,53 +6325,58 @@ func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMemoRelationsRequest.ProtoReflect.Descriptor instead.
-func (*SetMemoRelationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use TimeReport_Week.ProtoReflect.Descriptor instead.
+func (*TimeReport_Week) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18, 0}
 }
 
-func (x *SetMemoRelationsRequest) GetName() string {
+func (x *TimeReport_Week) GetStartDate() string {
 	if x != nil {
-		return x.Name
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *SetMemoRelationsRequest) GetRelations() []*MemoRelation {
+func (x *TimeReport_Week) GetTags() []*TimeReport_TagTime {
 	if x != nil {
-		return x.Relations
+		return x.Tags
 	}
 	return nil
 }
 
-type ListMemoRelationsRequest struct {
+func (x *TimeReport_Week) GetTotalMinutes() int32 {
+	if x != nil {
+		return x.TotalMinutes
+	}
+	return 0
+}
+
+type TimeReport_TagTime struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of relations to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Tag   string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The time logged in minutes.
+	Minutes int32 `protobuf:"varint,2,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	// The time logged in hours.
+	Hours         float64 `protobuf:"fixed64,3,opt,name=hours,proto3" json:"hours,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoRelationsRequest) Reset() {
-	*x = ListMemoRelationsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+func (x *TimeReport_TagTime) Reset() {
+	*x = TimeReport_TagTime{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoRelationsRequest) String() string {
+func (x *TimeReport_TagTime) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoRelationsRequest) ProtoMessage() {}
+func (*TimeReport_TagTime) ProtoMessage() {}
 
-func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+func (x *TimeReport_TagTime) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,59 +6387,57 @@ func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoRelationsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoRelationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use TimeReport_TagTime.ProtoReflect.Descriptor instead.
+func (*TimeReport_TagTime) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18, 1}
 }
 
-func (x *ListMemoRelationsRequest) GetName() string {
+func (x *TimeReport_TagTime) GetTag() string {
 	if x != nil {
-		return x.Name
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *ListMemoRelationsRequest) GetPageSize() int32 {
+func (x *TimeReport_TagTime) GetMinutes() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.Minutes
 	}
 	return 0
 }
 
-func (x *ListMemoRelationsRequest) GetPageToken() string {
+func (x *TimeReport_TagTime) GetHours() float64 {
 	if x != nil {
-		return x.PageToken
+		return x.Hours
 	}
-	return ""
+	return 0
 }
 
-type ListMemoRelationsResponse struct {
+type SumPropertiesResponse_Period struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of relations.
-	Relations []*MemoRelation `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of relations.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The first date of the period, in the format "YYYY-MM-DD", possibly before the start date of the request.
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// The sums of the period.
+	Sums          []*SumPropertiesResponse_Sum `protobuf:"bytes,2,rep,name=sums,proto3" json:"sums,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoRelationsResponse) Reset() {
-	*x = ListMemoRelationsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+func (x *SumPropertiesResponse_Period) Reset() {
+	*x = SumPropertiesResponse_Period{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoRelationsResponse) String() string {
+func (x *SumPropertiesResponse_Period) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoRelationsResponse) ProtoMessage() {}
+func (*SumPropertiesResponse_Period) ProtoMessage() {}
 
-func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+func (x *SumPropertiesResponse_Period) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1408,60 +6448,55 @@ func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoRelationsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoRelationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *ListMemoRelationsResponse) GetRelations() []*MemoRelation {
-	if x != nil {
-		return x.Relations
-	}
-	return nil
+// Deprecated: Use SumPropertiesResponse_Period.ProtoReflect.Descriptor instead.
+func (*SumPropertiesResponse_Period) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20, 0}
 }
 
-func (x *ListMemoRelationsResponse) GetNextPageToken() string {
+func (x *SumPropertiesResponse_Period) GetStartDate() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *ListMemoRelationsResponse) GetTotalSize() int32 {
+func (x *SumPropertiesResponse_Period) GetSums() []*SumPropertiesResponse_Sum {
 	if x != nil {
-		return x.TotalSize
+		return x.Sums
 	}
-	return 0
+	return nil
 }
 
-type CreateMemoCommentRequest struct {
+// The sum of the amounts of a tag in a currency. The amounts without tags are under an empty tag,
+// and the amounts with several tags count for each of them.
+type SumPropertiesResponse_Sum struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The comment to create.
-	Comment *Memo `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
-	// Optional. The comment ID to use.
-	CommentId     string `protobuf:"bytes,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Tag   string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The currency symbol of the amounts, e.g. "$".
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// The sum of the amounts, rounded to two decimals.
+	Total float64 `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"`
+	// The number of amounts.
+	Count         int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMemoCommentRequest) Reset() {
-	*x = CreateMemoCommentRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+func (x *SumPropertiesResponse_Sum) Reset() {
+	*x = SumPropertiesResponse_Sum{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMemoCommentRequest) String() string {
+func (x *SumPropertiesResponse_Sum) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMemoCommentRequest) ProtoMessage() {}
+func (*SumPropertiesResponse_Sum) ProtoMessage() {}
 
-func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+func (x *SumPropertiesResponse_Sum) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1472,62 +6507,64 @@ func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMemoCommentRequest.ProtoReflect.Descriptor instead.
-func (*CreateMemoCommentRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SumPropertiesResponse_Sum.ProtoReflect.Descriptor instead.
+func (*SumPropertiesResponse_Sum) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20, 1}
 }
 
-func (x *CreateMemoCommentRequest) GetName() string {
+func (x *SumPropertiesResponse_Sum) GetTag() string {
 	if x != nil {
-		return x.Name
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *CreateMemoCommentRequest) GetComment() *Memo {
+func (x *SumPropertiesResponse_Sum) GetCurrency() string {
 	if x != nil {
-		return x.Comment
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateMemoCommentRequest) GetCommentId() string {
+func (x *SumPropertiesResponse_Sum) GetTotal() float64 {
 	if x != nil {
-		return x.CommentId
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type ListMemoCommentsRequest struct {
+func (x *SumPropertiesResponse_Sum) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListReadingResponse_Group struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of comments to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Optional. The order to sort results by.
-	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// The reading status of the memos.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The memos by display time, newest first, or by finished date for the finished ones.
+	Memos         []*Memo `protobuf:"bytes,2,rep,name=memos,proto3" json:"memos,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoCommentsRequest) Reset() {
-	*x = ListMemoCommentsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+func (x *ListReadingResponse_Group) Reset() {
+	*x = ListReadingResponse_Group{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoCommentsRequest) String() string {
+func (x *ListReadingResponse_Group) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoCommentsRequest) ProtoMessage() {}
+func (*ListReadingResponse_Group) ProtoMessage() {}
 
-func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+func (x *ListReadingResponse_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,66 +6575,56 @@ func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoCommentsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *ListMemoCommentsRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ListMemoCommentsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use ListReadingResponse_Group.ProtoReflect.Descriptor instead.
+func (*ListReadingResponse_Group) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22, 0}
 }
 
-func (x *ListMemoCommentsRequest) GetPageToken() string {
+func (x *ListReadingResponse_Group) GetStatus() string {
 	if x != nil {
-		return x.PageToken
+		return x.Status
 	}
 	return ""
 }
 
-func (x *ListMemoCommentsRequest) GetOrderBy() string {
+func (x *ListReadingResponse_Group) GetMemos() []*Memo {
 	if x != nil {
-		return x.OrderBy
+		return x.Memos
 	}
-	return ""
+	return nil
 }
 
-type ListMemoCommentsResponse struct {
+// An interaction with the person, a memo with the person tag.
+type PersonPage_Interaction struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of comment memos.
-	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of comments.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The resource name of the memo.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The display time of the memo.
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// The snippet of the memo.
+	Snippet string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// The other people of the memo, as resource names.
+	People        []string `protobuf:"bytes,4,rep,name=people,proto3" json:"people,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoCommentsResponse) Reset() {
-	*x = ListMemoCommentsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+func (x *PersonPage_Interaction) Reset() {
+	*x = PersonPage_Interaction{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoCommentsResponse) String() string {
+func (x *PersonPage_Interaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoCommentsResponse) ProtoMessage() {}
+func (*PersonPage_Interaction) ProtoMessage() {}
 
-func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+func (x *PersonPage_Interaction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,60 +6635,63 @@ func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoCommentsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PersonPage_Interaction.ProtoReflect.Descriptor instead.
+func (*PersonPage_Interaction) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24, 0}
 }
 
-func (x *ListMemoCommentsResponse) GetMemos() []*Memo {
+func (x *PersonPage_Interaction) GetMemo() string {
 	if x != nil {
-		return x.Memos
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *PersonPage_Interaction) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
 	}
 	return nil
 }
 
-func (x *ListMemoCommentsResponse) GetNextPageToken() string {
+func (x *PersonPage_Interaction) GetSnippet() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Snippet
 	}
 	return ""
 }
 
-func (x *ListMemoCommentsResponse) GetTotalSize() int32 {
+func (x *PersonPage_Interaction) GetPeople() []string {
 	if x != nil {
-		return x.TotalSize
+		return x.People
 	}
-	return 0
+	return nil
 }
 
-type ListMemoReactionsRequest struct {
+type PersonPage_TagCount struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of reactions to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Tag   string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The number of memos with the person tag and this tag.
+	Count         int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoReactionsRequest) Reset() {
-	*x = ListMemoReactionsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+func (x *PersonPage_TagCount) Reset() {
+	*x = PersonPage_TagCount{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoReactionsRequest) String() string {
+func (x *PersonPage_TagCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoReactionsRequest) ProtoMessage() {}
+func (*PersonPage_TagCount) ProtoMessage() {}
 
-func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+func (x *PersonPage_TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1672,59 +6702,55 @@ func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoReactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoReactionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use PersonPage_TagCount.ProtoReflect.Descriptor instead.
+func (*PersonPage_TagCount) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24, 1}
 }
 
-func (x *ListMemoReactionsRequest) GetName() string {
+func (x *PersonPage_TagCount) GetTag() string {
 	if x != nil {
-		return x.Name
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *ListMemoReactionsRequest) GetPageSize() int32 {
+func (x *PersonPage_TagCount) GetCount() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.Count
 	}
 	return 0
 }
 
-func (x *ListMemoReactionsRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
-
-type ListMemoReactionsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of reactions.
-	Reactions []*Reaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of reactions.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+type ComputeDiffResponse_Line struct {
+	state protoimpl.MessageState   `protogen:"open.v1"`
+	Type  ComputeDiffResponse_Type `protobuf:"varint,1,opt,name=type,proto3,enum=memos.api.v1.ComputeDiffResponse_Type" json:"type,omitempty"`
+	Text  string                   `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// The line number in the old memo from 1, 0 for the inserted lines.
+	OldLineNumber int32 `protobuf:"varint,3,opt,name=old_line_number,json=oldLineNumber,proto3" json:"old_line_number,omitempty"`
+	// The line number in the new memo from 1, 0 for the deleted lines.
+	NewLineNumber int32 `protobuf:"varint,4,opt,name=new_line_number,json=newLineNumber,proto3" json:"new_line_number,omitempty"`
+	// The segments of the words of the line for the WORD granularity, when it is deleted or inserted in place of
+	// another line: the equal and deleted words of a deleted line, the equal and inserted words of an inserted line.
+	Segments      []*ComputeDiffResponse_Segment `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoReactionsResponse) Reset() {
-	*x = ListMemoReactionsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+func (x *ComputeDiffResponse_Line) Reset() {
+	*x = ComputeDiffResponse_Line{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoReactionsResponse) String() string {
+func (x *ComputeDiffResponse_Line) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoReactionsResponse) ProtoMessage() {}
+func (*ComputeDiffResponse_Line) ProtoMessage() {}
 
-func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+func (x *ComputeDiffResponse_Line) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,58 +6761,69 @@ func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoReactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoReactionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ComputeDiffResponse_Line.ProtoReflect.Descriptor instead.
+func (*ComputeDiffResponse_Line) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{32, 0}
 }
 
-func (x *ListMemoReactionsResponse) GetReactions() []*Reaction {
+func (x *ComputeDiffResponse_Line) GetType() ComputeDiffResponse_Type {
 	if x != nil {
-		return x.Reactions
+		return x.Type
 	}
-	return nil
+	return ComputeDiffResponse_TYPE_UNSPECIFIED
 }
 
-func (x *ListMemoReactionsResponse) GetNextPageToken() string {
+func (x *ComputeDiffResponse_Line) GetText() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Text
 	}
 	return ""
 }
 
-func (x *ListMemoReactionsResponse) GetTotalSize() int32 {
+func (x *ComputeDiffResponse_Line) GetOldLineNumber() int32 {
 	if x != nil {
-		return x.TotalSize
+		return x.OldLineNumber
 	}
 	return 0
 }
 
-type UpsertMemoReactionRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The reaction to upsert.
-	Reaction      *Reaction `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+func (x *ComputeDiffResponse_Line) GetNewLineNumber() int32 {
+	if x != nil {
+		return x.NewLineNumber
+	}
+	return 0
+}
+
+func (x *ComputeDiffResponse_Line) GetSegments() []*ComputeDiffResponse_Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type ComputeDiffResponse_Segment struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Type          ComputeDiffResponse_Type `protobuf:"varint,1,opt,name=type,proto3,enum=memos.api.v1.ComputeDiffResponse_Type" json:"type,omitempty"`
+	Text          string                   `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertMemoReactionRequest) Reset() {
-	*x = UpsertMemoReactionRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+func (x *ComputeDiffResponse_Segment) Reset() {
+	*x = ComputeDiffResponse_Segment{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertMemoReactionRequest) String() string {
+func (x *ComputeDiffResponse_Segment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertMemoReactionRequest) ProtoMessage() {}
+func (*ComputeDiffResponse_Segment) ProtoMessage() {}
 
-func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+func (x *ComputeDiffResponse_Segment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1797,49 +6834,52 @@ func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertMemoReactionRequest.ProtoReflect.Descriptor instead.
-func (*UpsertMemoReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ComputeDiffResponse_Segment.ProtoReflect.Descriptor instead.
+func (*ComputeDiffResponse_Segment) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{32, 1}
 }
 
-func (x *UpsertMemoReactionRequest) GetName() string {
+func (x *ComputeDiffResponse_Segment) GetType() ComputeDiffResponse_Type {
 	if x != nil {
-		return x.Name
+		return x.Type
 	}
-	return ""
+	return ComputeDiffResponse_TYPE_UNSPECIFIED
 }
 
-func (x *UpsertMemoReactionRequest) GetReaction() *Reaction {
+func (x *ComputeDiffResponse_Segment) GetText() string {
 	if x != nil {
-		return x.Reaction
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-type DeleteMemoReactionRequest struct {
+// Match tells where the words and phrases of the query were found in a memo.
+type SearchMemosResponse_Match struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the reaction to delete.
-	// Format: reactions/{reaction}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the memo.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Where the words and phrases were found, empty when the query has none.
+	Types         []SearchMemosResponse_MatchType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=memos.api.v1.SearchMemosResponse_MatchType" json:"types,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoReactionRequest) Reset() {
-	*x = DeleteMemoReactionRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+func (x *SearchMemosResponse_Match) Reset() {
+	*x = SearchMemosResponse_Match{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoReactionRequest) String() string {
+func (x *SearchMemosResponse_Match) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoReactionRequest) ProtoMessage() {}
+func (*SearchMemosResponse_Match) ProtoMessage() {}
 
-func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+func (x *SearchMemosResponse_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1850,44 +6890,50 @@ func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoReactionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use SearchMemosResponse_Match.ProtoReflect.Descriptor instead.
+func (*SearchMemosResponse_Match) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{44, 0}
 }
 
-func (x *DeleteMemoReactionRequest) GetName() string {
+func (x *SearchMemosResponse_Match) GetMemo() string {
 	if x != nil {
-		return x.Name
+		return x.Memo
 	}
 	return ""
 }
 
-// Computed properties of a memo.
-type Memo_Property struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	HasLink            bool                   `protobuf:"varint,1,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
-	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
-	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
-	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *SearchMemosResponse_Match) GetTypes() []SearchMemosResponse_MatchType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
 }
 
-func (x *Memo_Property) Reset() {
-	*x = Memo_Property{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+// A memo matching the query.
+type SearchMemosSemanticResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Memo  *Memo                  `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The cosine similarity of the memo to the query, from -1 to 1, higher is closer.
+	Score         float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMemosSemanticResponse_Result) Reset() {
+	*x = SearchMemosSemanticResponse_Result{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Memo_Property) String() string {
+func (x *SearchMemosSemanticResponse_Result) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Memo_Property) ProtoMessage() {}
+func (*SearchMemosSemanticResponse_Result) ProtoMessage() {}
 
-func (x *Memo_Property) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+func (x *SearchMemosSemanticResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1898,37 +6944,23 @@ func (x *Memo_Property) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Memo_Property.ProtoReflect.Descriptor instead.
-func (*Memo_Property) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1, 0}
-}
-
-func (x *Memo_Property) GetHasLink() bool {
-	if x != nil {
-		return x.HasLink
-	}
-	return false
-}
-
-func (x *Memo_Property) GetHasTaskList() bool {
-	if x != nil {
-		return x.HasTaskList
-	}
-	return false
+// Deprecated: Use SearchMemosSemanticResponse_Result.ProtoReflect.Descriptor instead.
+func (*SearchMemosSemanticResponse_Result) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{46, 0}
 }
 
-func (x *Memo_Property) GetHasCode() bool {
+func (x *SearchMemosSemanticResponse_Result) GetMemo() *Memo {
 	if x != nil {
-		return x.HasCode
+		return x.Memo
 	}
-	return false
+	return nil
 }
 
-func (x *Memo_Property) GetHasIncompleteTasks() bool {
+func (x *SearchMemosSemanticResponse_Result) GetScore() float32 {
 	if x != nil {
-		return x.HasIncompleteTasks
+		return x.Score
 	}
-	return false
+	return 0
 }
 
 // Memo reference in relations.
@@ -1945,7 +6977,7 @@ type MemoRelation_Memo struct {
 
 func (x *MemoRelation_Memo) Reset() {
 	*x = MemoRelation_Memo{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	mi := &file_api_v1_memo_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1957,7 +6989,7 @@ func (x *MemoRelation_Memo) String() string {
 func (*MemoRelation_Memo) ProtoMessage() {}
 
 func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	mi := &file_api_v1_memo_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1970,7 +7002,7 @@ func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoRelation_Memo.ProtoReflect.Descriptor instead.
 func (*MemoRelation_Memo) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14, 0}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{56, 0}
 }
 
 func (x *MemoRelation_Memo) GetName() string {
@@ -1991,7 +7023,7 @@ var File_api_v1_memo_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19api/v1/memo_service.proto\x12\fmemos.api.v1\x1a\x1fapi/v1/attachment_service.proto\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x02\n" +
+	"\x19api/v1/memo_service.proto\x12\fmemos.api.v1\x1a\x1fapi/v1/attachment_service.proto\x1a\x13api/v1/common.proto\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x02\n" +
 	"\bReaction\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x123\n" +
 	"\acreator\x18\x02 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
@@ -2002,7 +7034,33 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\rreaction_type\x18\x04 \x01(\tB\x03\xe0A\x02R\freactionType\x12@\n" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime:K\xeaAH\n" +
-	"\x15memos.api.v1/Reaction\x12\x14reactions/{reaction}\x1a\x04name*\treactions2\breaction\"\xd8\b\n" +
+	"\x15memos.api.v1/Reaction\x12\x14reactions/{reaction}\x1a\x04name*\treactions2\breaction\"\xa6\x05\n" +
+	"\n" +
+	"Webmention\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12\x1b\n" +
+	"\x06source\x18\x02 \x01(\tB\x03\xe0A\x03R\x06source\x126\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1d.memos.api.v1.Webmention.TypeB\x03\xe0A\x03R\x04type\x12<\n" +
+	"\x06author\x18\x04 \x01(\v2\x1f.memos.api.v1.Webmention.AuthorB\x03\xe0A\x03R\x06author\x12\x1d\n" +
+	"\acontent\x18\x05 \x01(\tB\x03\xe0A\x03R\acontent\x12B\n" +
+	"\fpublish_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vpublishTime\x12@\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x1aD\n" +
+	"\x06Author\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x14\n" +
+	"\x05photo\x18\x03 \x01(\tR\x05photo\"X\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aMENTION\x10\x01\x12\t\n" +
+	"\x05REPLY\x10\x02\x12\b\n" +
+	"\x04LIKE\x10\x03\x12\n" +
+	"\n" +
+	"\x06REPOST\x10\x04\x12\f\n" +
+	"\bBOOKMARK\x10\x05:b\xeaA_\n" +
+	"\x17memos.api.v1/Webmention\x12%memos/{memo}/webmentions/{webmention}\x1a\x04name*\vwebmentions2\n" +
+	"webmention\"\xbf\x1c\n" +
 	"\x04Memo\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12.\n" +
 	"\x05state\x18\x02 \x01(\x0e2\x13.memos.api.v1.StateB\x03\xe0A\x02R\x05state\x123\n" +
@@ -2027,25 +7085,107 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x06parent\x18\x10 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoH\x00R\x06parent\x88\x01\x01\x12\x1d\n" +
 	"\asnippet\x18\x11 \x01(\tB\x03\xe0A\x03R\asnippet\x12<\n" +
-	"\blocation\x18\x12 \x01(\v2\x16.memos.api.v1.LocationB\x03\xe0A\x01H\x01R\blocation\x88\x01\x01\x1a\x96\x01\n" +
+	"\blocation\x18\x12 \x01(\v2\x16.memos.api.v1.LocationB\x03\xe0A\x01H\x01R\blocation\x88\x01\x01\x12(\n" +
+	"\rcomment_count\x18\x13 \x01(\x05B\x03\xe0A\x03R\fcommentCount\x12*\n" +
+	"\x0ereaction_count\x18\x14 \x01(\x05B\x03\xe0A\x03R\rreactionCount\x12*\n" +
+	"\x0erelation_count\x18\x15 \x01(\x05B\x03\xe0A\x03R\rrelationCount\x12,\n" +
+	"\x0fcontent_warning\x18\x16 \x01(\tB\x03\xe0A\x01R\x0econtentWarning\x12@\n" +
+	"\vexpire_time\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\n" +
+	"expireTime\x12I\n" +
+	"\rexpiry_action\x18\x18 \x01(\x0e2\x1f.memos.api.v1.Memo.ExpiryActionB\x03\xe0A\x01R\fexpiryAction\x12\x1f\n" +
+	"\blanguage\x18\x19 \x01(\tB\x03\xe0A\x01R\blanguage\x120\n" +
+	"\x11detected_language\x18\x1a \x01(\tB\x03\xe0A\x03R\x10detectedLanguage\x12a\n" +
+	"\x16ai_summary_refinements\x18\x1b \x03(\v2&.memos.api.v1.Memo.AISummaryRefinementB\x03\xe0A\x03R\x14aiSummaryRefinements\x12\x17\n" +
+	"\x04slug\x18\x1c \x01(\tB\x03\xe0A\x01R\x04slug\x12/\n" +
+	"\x11ai_summary_cached\x18\x1d \x01(\bB\x03\xe0A\x03R\x0faiSummaryCached\x12G\n" +
+	"\fsyndications\x18\x1e \x03(\v2\x1e.memos.api.v1.Memo.SyndicationB\x03\xe0A\x03R\fsyndications\x129\n" +
+	"\areading\x18\x1f \x01(\v2\x1a.memos.api.v1.Memo.ReadingB\x03\xe0A\x03R\areading\x12<\n" +
+	"\bapproval\x18  \x01(\v2\x1b.memos.api.v1.Memo.ApprovalB\x03\xe0A\x03R\bapproval\x12G\n" +
+	"\fcontributors\x18! \x03(\v2\x1e.memos.api.v1.Memo.ContributorB\x03\xe0A\x03R\fcontributors\x1a\xbe\x03\n" +
 	"\bProperty\x12\x19\n" +
 	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
 	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
 	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x120\n" +
-	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks:7\xeaA4\n" +
+	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x12@\n" +
+	"\fbroken_links\x18\x05 \x03(\v2\x1d.memos.api.v1.Memo.BrokenLinkR\vbrokenLinks\x12F\n" +
+	"\x0elink_snapshots\x18\x06 \x03(\v2\x1f.memos.api.v1.Memo.LinkSnapshotR\rlinkSnapshots\x12&\n" +
+	"\x0fis_ai_generated\x18\a \x01(\bR\risAiGenerated\x12?\n" +
+	"\ftime_entries\x18\b \x03(\v2\x1c.memos.api.v1.Memo.TimeEntryR\vtimeEntries\x123\n" +
+	"\aamounts\x18\t \x03(\v2\x19.memos.api.v1.Memo.AmountR\aamounts\x1aN\n" +
+	"\x06Amount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x1a9\n" +
+	"\tTimeEntry\x12\x18\n" +
+	"\aminutes\x18\x01 \x01(\x05R\aminutes\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x1az\n" +
+	"\n" +
+	"BrokenLink\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x129\n" +
+	"\n" +
+	"check_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckTime\x1a\x80\x01\n" +
+	"\fLinkSnapshot\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12!\n" +
+	"\fsnapshot_url\x18\x02 \x01(\tR\vsnapshotUrl\x12;\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x1av\n" +
+	"\aReading\x12\x16\n" +
+	"\x06author\x18\x01 \x01(\tR\x06author\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12#\n" +
+	"\rfinished_date\x18\x04 \x01(\tR\ffinishedDate\x1a\x82\x01\n" +
+	"\vContributor\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12@\n" +
+	"\x0elast_edit_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\flastEditTime\x12\x1d\n" +
+	"\n" +
+	"edit_count\x18\x03 \x01(\x05R\teditCount\x1a\x89\x03\n" +
+	"\bApproval\x127\n" +
+	"\x05state\x18\x01 \x01(\x0e2!.memos.api.v1.Memo.Approval.StateR\x05state\x12K\n" +
+	"\x14requested_visibility\x18\x02 \x01(\x0e2\x18.memos.api.v1.VisibilityR\x13requestedVisibility\x12=\n" +
+	"\frequest_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestTime\x12\x1a\n" +
+	"\breviewer\x18\x04 \x01(\tR\breviewer\x12;\n" +
+	"\vreview_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewTime\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"G\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\f\n" +
+	"\bAPPROVED\x10\x02\x12\f\n" +
+	"\bREJECTED\x10\x03\x1ax\n" +
+	"\vSyndication\x12\x1a\n" +
+	"\bplatform\x18\x01 \x01(\tR\bplatform\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12;\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x1a\x9f\x01\n" +
+	"\x13AISummaryRefinement\x12 \n" +
+	"\vinstruction\x18\x01 \x01(\tR\vinstruction\x12)\n" +
+	"\x10previous_summary\x18\x02 \x01(\tR\x0fpreviousSummary\x12;\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"F\n" +
+	"\fExpiryAction\x12\x1d\n" +
+	"\x19EXPIRY_ACTION_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aARCHIVE\x10\x01\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x02:7\xeaA4\n" +
 	"\x11memos.api.v1/Memo\x12\fmemos/{memo}\x1a\x04name*\x05memos2\x04memoB\t\n" +
 	"\a_parentB\v\n" +
 	"\t_location\"u\n" +
 	"\bLocation\x12%\n" +
 	"\vplaceholder\x18\x01 \x01(\tB\x03\xe0A\x01R\vplaceholder\x12\x1f\n" +
 	"\blatitude\x18\x02 \x01(\x01B\x03\xe0A\x01R\blatitude\x12!\n" +
-	"\tlongitude\x18\x03 \x01(\x01B\x03\xe0A\x01R\tlongitude\"\xac\x01\n" +
+	"\tlongitude\x18\x03 \x01(\x01B\x03\xe0A\x01R\tlongitude\"\xda\x01\n" +
 	"\x11CreateMemoRequest\x12+\n" +
 	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoB\x03\xe0A\x02R\x04memo\x12\x1c\n" +
 	"\amemo_id\x18\x02 \x01(\tB\x03\xe0A\x01R\x06memoId\x12(\n" +
 	"\rvalidate_only\x18\x03 \x01(\bB\x03\xe0A\x01R\fvalidateOnly\x12\"\n" +
 	"\n" +
-	"request_id\x18\x04 \x01(\tB\x03\xe0A\x01R\trequestId\"\xed\x01\n" +
+	"request_id\x18\x04 \x01(\tB\x03\xe0A\x01R\trequestId\x12,\n" +
+	"\x0fskip_formatting\x18\x05 \x01(\bB\x03\xe0A\x01R\x0eskipFormatting\"s\n" +
+	"\x1aMemoContentSplitSuggestion\x120\n" +
+	"\x14content_length_limit\x18\x01 \x01(\x05R\x12contentLengthLimit\x12#\n" +
+	"\rsplit_offsets\x18\x02 \x03(\x05R\fsplitOffsets\"\xa1\x02\n" +
 	"\x10ListMemosRequest\x12 \n" +
 	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
 	"\n" +
@@ -2053,21 +7193,283 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x05state\x18\x03 \x01(\x0e2\x13.memos.api.v1.StateB\x03\xe0A\x01R\x05state\x12\x1e\n" +
 	"\border_by\x18\x04 \x01(\tB\x03\xe0A\x01R\aorderBy\x12\x1b\n" +
 	"\x06filter\x18\x05 \x01(\tB\x03\xe0A\x01R\x06filter\x12&\n" +
-	"\fshow_deleted\x18\x06 \x01(\bB\x03\xe0A\x01R\vshowDeleted\"\x84\x01\n" +
+	"\fshow_deleted\x18\x06 \x01(\bB\x03\xe0A\x01R\vshowDeleted\x122\n" +
+	"\x05scope\x18\a \x01(\x0e2\x17.memos.api.v1.MemoScopeB\x03\xe0A\x01R\x05scope\"\x84\x01\n" +
 	"\x11ListMemosResponse\x12(\n" +
 	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize\"}\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"g\n" +
+	"\x1fListMemosWithBrokenLinksRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\"t\n" +
+	" ListMemosWithBrokenLinksResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa4\x01\n" +
+	"\rMemoReadState\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12<\n" +
+	"\tread_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\breadTime\x12\x1f\n" +
+	"\bposition\x18\x03 \x01(\x05B\x03\xe0A\x01R\bposition\x12\x1b\n" +
+	"\x06unread\x18\x04 \x01(\bB\x03\xe0A\x03R\x06unread\"2\n" +
+	"\x17GetMemoReadStateRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\x9f\x01\n" +
+	"\x1aUpdateMemoReadStateRequest\x12?\n" +
+	"\n" +
+	"read_state\x18\x01 \x01(\v2\x1b.memos.api.v1.MemoReadStateB\x03\xe0A\x02R\treadState\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"\xd3\x01\n" +
+	"\tMemoStats\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x02 \x01(\x05R\tviewCount\x12R\n" +
+	"\x11daily_view_counts\x18\x03 \x03(\v2&.memos.api.v1.MemoStats.DailyViewCountR\x0fdailyViewCounts\x1a:\n" +
+	"\x0eDailyViewCount\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"D\n" +
+	"\x13GetMemoStatsRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\"\x8b\x01\n" +
+	"\x17GetCalendarMonthRequest\x12\x17\n" +
+	"\x04year\x18\x01 \x01(\x05B\x03\xe0A\x02R\x04year\x12\x19\n" +
+	"\x05month\x18\x02 \x01(\x05B\x03\xe0A\x02R\x05month\x12\x1f\n" +
+	"\btimezone\x18\x03 \x01(\tB\x03\xe0A\x01R\btimezone\x12\x1b\n" +
+	"\x06filter\x18\x04 \x01(\tB\x03\xe0A\x01R\x06filter\"\x92\x02\n" +
+	"\rCalendarMonth\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x123\n" +
+	"\x04days\x18\x04 \x03(\v2\x1f.memos.api.v1.CalendarMonth.DayR\x04days\x1a\x85\x01\n" +
+	"\x03Day\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x02 \x01(\x05R\tmemoCount\x12\x19\n" +
+	"\btop_tags\x18\x03 \x03(\tR\atopTags\x120\n" +
+	"\x14completed_task_count\x18\x04 \x01(\x05R\x12completedTaskCount\"\x98\x01\n" +
+	"\x14GetTimeReportRequest\x12\"\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tB\x03\xe0A\x02R\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x02 \x01(\tB\x03\xe0A\x02R\aendDate\x12\x1f\n" +
+	"\btimezone\x18\x03 \x01(\tB\x03\xe0A\x01R\btimezone\x12\x1b\n" +
+	"\x06filter\x18\x04 \x01(\tB\x03\xe0A\x01R\x06filter\"\xd2\x02\n" +
+	"\n" +
+	"TimeReport\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x123\n" +
+	"\x05weeks\x18\x02 \x03(\v2\x1d.memos.api.v1.TimeReport.WeekR\x05weeks\x12#\n" +
+	"\rtotal_minutes\x18\x03 \x01(\x05R\ftotalMinutes\x1a\x80\x01\n" +
+	"\x04Week\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x124\n" +
+	"\x04tags\x18\x02 \x03(\v2 .memos.api.v1.TimeReport.TagTimeR\x04tags\x12#\n" +
+	"\rtotal_minutes\x18\x03 \x01(\x05R\ftotalMinutes\x1aK\n" +
+	"\aTagTime\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x18\n" +
+	"\aminutes\x18\x02 \x01(\x05R\aminutes\x12\x14\n" +
+	"\x05hours\x18\x03 \x01(\x01R\x05hours\"\xaa\x02\n" +
+	"\x14SumPropertiesRequest\x12\"\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tB\x03\xe0A\x02R\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x02 \x01(\tB\x03\xe0A\x02R\aendDate\x12\x1f\n" +
+	"\btimezone\x18\x03 \x01(\tB\x03\xe0A\x01R\btimezone\x12\x1b\n" +
+	"\x06filter\x18\x04 \x01(\tB\x03\xe0A\x01R\x06filter\x12F\n" +
+	"\x06period\x18\x05 \x01(\x0e2).memos.api.v1.SumPropertiesRequest.PeriodB\x03\xe0A\x01R\x06period\"H\n" +
+	"\x06Period\x12\x16\n" +
+	"\x12PERIOD_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03DAY\x10\x01\x12\b\n" +
+	"\x04WEEK\x10\x02\x12\t\n" +
+	"\x05MONTH\x10\x03\x12\b\n" +
+	"\x04YEAR\x10\x04\"\x81\x03\n" +
+	"\x15SumPropertiesResponse\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x12D\n" +
+	"\aperiods\x18\x02 \x03(\v2*.memos.api.v1.SumPropertiesResponse.PeriodR\aperiods\x12?\n" +
+	"\x06totals\x18\x03 \x03(\v2'.memos.api.v1.SumPropertiesResponse.SumR\x06totals\x1ad\n" +
+	"\x06Period\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12;\n" +
+	"\x04sums\x18\x02 \x03(\v2'.memos.api.v1.SumPropertiesResponse.SumR\x04sums\x1a_\n" +
+	"\x03Sum\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x01R\x05total\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\"1\n" +
+	"\x12ListReadingRequest\x12\x1b\n" +
+	"\x06filter\x18\x01 \x01(\tB\x03\xe0A\x01R\x06filter\"\xa1\x01\n" +
+	"\x13ListReadingResponse\x12?\n" +
+	"\x06groups\x18\x01 \x03(\v2'.memos.api.v1.ListReadingResponse.GroupR\x06groups\x1aI\n" +
+	"\x05Group\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12(\n" +
+	"\x05memos\x18\x02 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\"u\n" +
+	"\x14GetPersonPageRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x85\x05\n" +
+	"\n" +
+	"PersonPage\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x03 \x01(\x05R\tmemoCount\x12H\n" +
+	"\x12first_mention_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x10firstMentionTime\x12F\n" +
+	"\x11last_mention_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0flastMentionTime\x12(\n" +
+	"\x05memos\x18\x06 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\a \x01(\tR\rnextPageToken\x12@\n" +
+	"\btimeline\x18\b \x03(\v2$.memos.api.v1.PersonPage.InteractionR\btimeline\x12M\n" +
+	"\x11co_occurring_tags\x18\t \x03(\v2!.memos.api.v1.PersonPage.TagCountR\x0fcoOccurringTags\x1a\x83\x01\n" +
+	"\vInteraction\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\x12\x16\n" +
+	"\x06people\x18\x04 \x03(\tR\x06people\x1a2\n" +
+	"\bTagCount\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"_\n" +
+	"\x17ListPendingMemosRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\"l\n" +
+	"\x18ListPendingMemosResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"C\n" +
+	"\x12ApproveMemoRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\"_\n" +
+	"\x11RejectMemoRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tB\x03\xe0A\x01R\x06reason\"\xd7\x01\n" +
+	"\x1bEvaluateTaggingRulesRequest\x12\x1d\n" +
+	"\acontent\x18\x01 \x01(\tB\x03\xe0A\x02R\acontent\x12@\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\n" +
+	"createTime\x12W\n" +
+	"\rtagging_rules\x18\x03 \x01(\v2-.memos.api.v1.UserSetting.TaggingRulesSettingB\x03\xe0A\x01R\ftaggingRules\"\x91\x01\n" +
+	"\x1cEvaluateTaggingRulesResponse\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x128\n" +
+	"\n" +
+	"visibility\x18\x02 \x01(\x0e2\x18.memos.api.v1.VisibilityR\n" +
+	"visibility\x12#\n" +
+	"\rmatched_rules\x18\x03 \x03(\x05R\fmatchedRules\"\x95\x02\n" +
+	"\x12ComputeDiffRequest\x124\n" +
+	"\bold_name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\aoldName\x124\n" +
+	"\bnew_name\x18\x02 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\anewName\x12S\n" +
+	"\vgranularity\x18\x03 \x01(\x0e2,.memos.api.v1.ComputeDiffRequest.GranularityB\x03\xe0A\x01R\vgranularity\">\n" +
+	"\vGranularity\x12\x1b\n" +
+	"\x17GRANULARITY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04LINE\x10\x01\x12\b\n" +
+	"\x04WORD\x10\x02\"\xbd\x04\n" +
+	"\x13ComputeDiffResponse\x12<\n" +
+	"\x05lines\x18\x01 \x03(\v2&.memos.api.v1.ComputeDiffResponse.LineR\x05lines\x12.\n" +
+	"\x13inserted_line_count\x18\x02 \x01(\x05R\x11insertedLineCount\x12,\n" +
+	"\x12deleted_line_count\x18\x03 \x01(\x05R\x10deletedLineCount\x1a\xed\x01\n" +
+	"\x04Line\x12:\n" +
+	"\x04type\x18\x01 \x01(\x0e2&.memos.api.v1.ComputeDiffResponse.TypeR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12&\n" +
+	"\x0fold_line_number\x18\x03 \x01(\x05R\roldLineNumber\x12&\n" +
+	"\x0fnew_line_number\x18\x04 \x01(\x05R\rnewLineNumber\x12E\n" +
+	"\bsegments\x18\x05 \x03(\v2).memos.api.v1.ComputeDiffResponse.SegmentR\bsegments\x1aY\n" +
+	"\aSegment\x12:\n" +
+	"\x04type\x18\x01 \x01(\x0e2&.memos.api.v1.ComputeDiffResponse.TypeR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"?\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05EQUAL\x10\x01\x12\n" +
+	"\n" +
+	"\x06INSERT\x10\x02\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x03\"\x92\x01\n" +
+	"\x10MemoSubscription\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12#\n" +
+	"\n" +
+	"subscribed\x18\x02 \x01(\bB\x03\xe0A\x01R\n" +
+	"subscribed\x12@\n" +
+	"\vupdate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"5\n" +
+	"\x1aGetMemoSubscriptionRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xaa\x01\n" +
+	"\x1dUpdateMemoSubscriptionRequest\x12G\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1e.memos.api.v1.MemoSubscriptionB\x03\xe0A\x02R\fsubscription\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"b\n" +
+	"\x1aListSubscribedMemosRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\"o\n" +
+	"\x1bListSubscribedMemosResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"^\n" +
+	"\x16ListUnreadMemosRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\"k\n" +
+	"\x17ListUnreadMemosResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"w\n" +
+	"\x14ListColdMemosRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x19\n" +
+	"\x05query\x18\x03 \x01(\tB\x03\xe0A\x01R\x05query\"i\n" +
+	"\x15ListColdMemosResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"G\n" +
+	"\x16RestoreColdMemoRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\"\xff\x03\n" +
+	"\x12SearchMemosRequest\x12\x19\n" +
+	"\x05query\x18\x01 \x01(\tB\x03\xe0A\x02R\x05query\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\x122\n" +
+	"\x05scope\x18\x04 \x01(\x0e2\x17.memos.api.v1.MemoScopeB\x03\xe0A\x01R\x05scope\x12G\n" +
+	"\aranking\x18\x05 \x01(\x0e2(.memos.api.v1.SearchMemosRequest.RankingB\x03\xe0A\x01R\aranking\x12S\n" +
+	"\n" +
+	"tag_boosts\x18\x06 \x03(\v2/.memos.api.v1.SearchMemosRequest.TagBoostsEntryB\x03\xe0A\x01R\ttagBoosts\x128\n" +
+	"\x16recency_half_life_days\x18\a \x01(\x05B\x03\xe0A\x01R\x13recencyHalfLifeDays\x1a<\n" +
+	"\x0eTagBoostsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\">\n" +
+	"\aRanking\x12\x17\n" +
+	"\x13RANKING_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tRELEVANCE\x10\x01\x12\v\n" +
+	"\aRECENCY\x10\x02\"\xdd\x02\n" +
+	"\x13SearchMemosResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12A\n" +
+	"\amatches\x18\x03 \x03(\v2'.memos.api.v1.SearchMemosResponse.MatchR\amatches\x1a^\n" +
+	"\x05Match\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12A\n" +
+	"\x05types\x18\x02 \x03(\x0e2+.memos.api.v1.SearchMemosResponse.MatchTypeR\x05types\"Q\n" +
+	"\tMatchType\x12\x1a\n" +
+	"\x16MATCH_TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCONTENT\x10\x01\x12\v\n" +
+	"\aCOMMENT\x10\x02\x12\x0e\n" +
+	"\n" +
+	"ATTACHMENT\x10\x03\"\x8d\x01\n" +
+	"\x1aSearchMemosSemanticRequest\x12\x19\n" +
+	"\x05query\x18\x01 \x01(\tB\x03\xe0A\x02R\x05query\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x122\n" +
+	"\x05scope\x18\x03 \x01(\x0e2\x17.memos.api.v1.MemoScopeB\x03\xe0A\x01R\x05scope\"\xb1\x01\n" +
+	"\x1bSearchMemosSemanticResponse\x12J\n" +
+	"\aresults\x18\x01 \x03(\v20.memos.api.v1.SearchMemosSemanticResponse.ResultR\aresults\x1aF\n" +
+	"\x06Result\x12&\n" +
+	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoR\x04memo\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x02R\x05score\"b\n" +
+	"\x14GetMemoBySlugRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12\x17\n" +
+	"\x04slug\x18\x02 \x01(\tB\x03\xe0A\x02R\x04slug\"}\n" +
 	"\x0eGetMemoRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12<\n" +
-	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\breadMask\"\xac\x01\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\breadMask\"\xda\x01\n" +
 	"\x11UpdateMemoRequest\x12+\n" +
 	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoB\x03\xe0A\x02R\x04memo\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
 	"updateMask\x12(\n" +
-	"\rallow_missing\x18\x03 \x01(\bB\x03\xe0A\x01R\fallowMissing\"]\n" +
+	"\rallow_missing\x18\x03 \x01(\bB\x03\xe0A\x01R\fallowMissing\x12,\n" +
+	"\x0fskip_formatting\x18\x04 \x01(\bB\x03\xe0A\x01R\x0eskipFormatting\"]\n" +
 	"\x11DeleteMemoRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12\x19\n" +
@@ -2096,7 +7498,7 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\vattachments\x18\x01 \x03(\v2\x18.memos.api.v1.AttachmentR\vattachments\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xdb\x02\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xc1\x03\n" +
 	"\fMemoRelation\x128\n" +
 	"\x04memo\x18\x01 \x01(\v2\x1f.memos.api.v1.MemoRelation.MemoB\x03\xe0A\x02R\x04memo\x12G\n" +
 	"\frelated_memo\x18\x02 \x01(\v2\x1f.memos.api.v1.MemoRelation.MemoB\x03\xe0A\x02R\vrelatedMemo\x128\n" +
@@ -2104,21 +7506,35 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x04Memo\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12\x1d\n" +
-	"\asnippet\x18\x02 \x01(\tB\x03\xe0A\x03R\asnippet\"8\n" +
+	"\asnippet\x18\x02 \x01(\tB\x03\xe0A\x03R\asnippet\"\x9d\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tREFERENCE\x10\x01\x12\v\n" +
-	"\aCOMMENT\x10\x02\"\x87\x01\n" +
+	"\aCOMMENT\x10\x02\x12\x12\n" +
+	"\x0eTRANSLATION_OF\x10\x03\x12\x0e\n" +
+	"\n" +
+	"SUMMARY_OF\x10\x04\x12\f\n" +
+	"\bREPLY_TO\x10\x05\x12\x10\n" +
+	"\fDUPLICATE_OF\x10\x06\x12\x0e\n" +
+	"\n" +
+	"BLOCKED_BY\x10\a\x12\x0f\n" +
+	"\vREVISION_OF\x10\b\"\x87\x01\n" +
 	"\x17SetMemoRelationsRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12=\n" +
-	"\trelations\x18\x02 \x03(\v2\x1a.memos.api.v1.MemoRelationB\x03\xe0A\x02R\trelations\"\x8f\x01\n" +
+	"\trelations\x18\x02 \x03(\v2\x1a.memos.api.v1.MemoRelationB\x03\xe0A\x02R\trelations\"\xe4\x02\n" +
 	"\x18ListMemoRelationsRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12 \n" +
 	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x9c\x01\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\x12S\n" +
+	"\tdirection\x18\x04 \x01(\x0e20.memos.api.v1.ListMemoRelationsRequest.DirectionB\x03\xe0A\x01R\tdirection\x12:\n" +
+	"\x05types\x18\x05 \x03(\x0e2\x1f.memos.api.v1.MemoRelation.TypeB\x03\xe0A\x01R\x05types\"B\n" +
+	"\tDirection\x12\x19\n" +
+	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bOUTGOING\x10\x01\x12\f\n" +
+	"\bINCOMING\x10\x02\"\x9c\x01\n" +
 	"\x19ListMemoRelationsResponse\x128\n" +
 	"\trelations\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoRelationR\trelations\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
@@ -2156,7 +7572,15 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x19UpsertMemoReactionRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x127\n" +
-	"\breaction\x18\x02 \x01(\v2\x16.memos.api.v1.ReactionB\x03\xe0A\x02R\breaction\"N\n" +
+	"\breaction\x18\x02 \x01(\v2\x16.memos.api.v1.ReactionB\x03\xe0A\x02R\breaction\"K\n" +
+	"\x1aListMemoWebmentionsRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\"Y\n" +
+	"\x1bListMemoWebmentionsResponse\x12:\n" +
+	"\vwebmentions\x18\x01 \x03(\v2\x18.memos.api.v1.WebmentionR\vwebmentions\"R\n" +
+	"\x1bDeleteMemoWebmentionRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/WebmentionR\x04name\"N\n" +
 	"\x19DeleteMemoReactionRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
 	"\x15memos.api.v1/ReactionR\x04name*P\n" +
@@ -2166,12 +7590,18 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\aPRIVATE\x10\x01\x12\r\n" +
 	"\tPROTECTED\x10\x02\x12\n" +
 	"\n" +
-	"\x06PUBLIC\x10\x032\xef\x10\n" +
+	"\x06PUBLIC\x10\x03*k\n" +
+	"\tMemoScope\x12\x1a\n" +
+	"\x16MEMO_SCOPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11MEMO_SCOPE_NORMAL\x10\x01\x12\x17\n" +
+	"\x13MEMO_SCOPE_ARCHIVED\x10\x02\x12\x12\n" +
+	"\x0eMEMO_SCOPE_ALL\x10\x032\xd1+\n" +
 	"\vMemoService\x12e\n" +
 	"\n" +
 	"CreateMemo\x12\x1f.memos.api.v1.CreateMemoRequest\x1a\x12.memos.api.v1.Memo\"\"\xdaA\x04memo\x82\xd3\xe4\x93\x02\x15:\x04memo\"\r/api/v1/memos\x12f\n" +
 	"\tListMemos\x12\x1e.memos.api.v1.ListMemosRequest\x1a\x1f.memos.api.v1.ListMemosResponse\"\x18\xdaA\x00\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/memos\x12b\n" +
-	"\aGetMemo\x12\x1c.memos.api.v1.GetMemoRequest\x1a\x12.memos.api.v1.Memo\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=memos/*}\x12\x7f\n" +
+	"\aGetMemo\x12\x1c.memos.api.v1.GetMemoRequest\x1a\x12.memos.api.v1.Memo\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=memos/*}\x12\x84\x01\n" +
+	"\rGetMemoBySlug\x12\".memos.api.v1.GetMemoBySlugRequest\x1a\x12.memos.api.v1.Memo\";\xdaA\vparent,slug\x82\xd3\xe4\x93\x02'\x12%/api/v1/{parent=users/*}/memos:bySlug\x12\x7f\n" +
 	"\n" +
 	"UpdateMemo\x12\x1f.memos.api.v1.UpdateMemoRequest\x1a\x12.memos.api.v1.Memo\"<\xdaA\x10memo,update_mask\x82\xd3\xe4\x93\x02#:\x04memo2\x1b/api/v1/{memo.name=memos/*}\x12l\n" +
 	"\n" +
@@ -2187,7 +7617,33 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x10ListMemoComments\x12%.memos.api.v1.ListMemoCommentsRequest\x1a&.memos.api.v1.ListMemoCommentsResponse\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=memos/*}/comments\x12\x95\x01\n" +
 	"\x11ListMemoReactions\x12&.memos.api.v1.ListMemoReactionsRequest\x1a'.memos.api.v1.ListMemoReactionsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/reactions\x12\x89\x01\n" +
 	"\x12UpsertMemoReaction\x12'.memos.api.v1.UpsertMemoReactionRequest\x1a\x16.memos.api.v1.Reaction\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/{name=memos/*}/reactions\x12\x80\x01\n" +
-	"\x12DeleteMemoReaction\x12'.memos.api.v1.DeleteMemoReactionRequest\x1a\x16.google.protobuf.Empty\")\xdaA\x04name\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/{name=reactions/*}B\xa8\x01\n" +
+	"\x12DeleteMemoReaction\x12'.memos.api.v1.DeleteMemoReactionRequest\x1a\x16.google.protobuf.Empty\")\xdaA\x04name\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/{name=reactions/*}\x12\x9d\x01\n" +
+	"\x13ListMemoWebmentions\x12(.memos.api.v1.ListMemoWebmentionsRequest\x1a).memos.api.v1.ListMemoWebmentionsResponse\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=memos/*}/webmentions\x12\x8e\x01\n" +
+	"\x14DeleteMemoWebmention\x12).memos.api.v1.DeleteMemoWebmentionRequest\x1a\x16.google.protobuf.Empty\"3\xdaA\x04name\x82\xd3\xe4\x93\x02&*$/api/v1/{name=memos/*/webmentions/*}\x12\x9c\x01\n" +
+	"\x18ListMemosWithBrokenLinks\x12-.memos.api.v1.ListMemosWithBrokenLinksRequest\x1a..memos.api.v1.ListMemosWithBrokenLinksResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/memos:brokenLinks\x12\x87\x01\n" +
+	"\x10GetMemoReadState\x12%.memos.api.v1.GetMemoReadStateRequest\x1a\x1b.memos.api.v1.MemoReadState\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*/readState}\x12\xb6\x01\n" +
+	"\x13UpdateMemoReadState\x12(.memos.api.v1.UpdateMemoReadStateRequest\x1a\x1b.memos.api.v1.MemoReadState\"X\xdaA\x16read_state,update_mask\x82\xd3\xe4\x93\x029:\n" +
+	"read_state2+/api/v1/{read_state.name=memos/*/readState}\x12z\n" +
+	"\fGetMemoStats\x12!.memos.api.v1.GetMemoStatsRequest\x1a\x17.memos.api.v1.MemoStats\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=memos/*}:getStats\x12{\n" +
+	"\x10GetCalendarMonth\x12%.memos.api.v1.GetCalendarMonthRequest\x1a\x1b.memos.api.v1.CalendarMonth\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/memos:calendarMonth\x12o\n" +
+	"\rGetTimeReport\x12\".memos.api.v1.GetTimeReportRequest\x1a\x18.memos.api.v1.TimeReport\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/memos:timeReport\x12}\n" +
+	"\rSumProperties\x12\".memos.api.v1.SumPropertiesRequest\x1a#.memos.api.v1.SumPropertiesResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/memos:sumProperties\x12q\n" +
+	"\vListReading\x12 .memos.api.v1.ListReadingRequest\x1a!.memos.api.v1.ListReadingResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/memos:reading\x12u\n" +
+	"\rGetPersonPage\x12\".memos.api.v1.GetPersonPageRequest\x1a\x18.memos.api.v1.PersonPage\"&\xdaA\x04name\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/{name=people/*}\x12\x80\x01\n" +
+	"\x10ListPendingMemos\x12%.memos.api.v1.ListPendingMemosRequest\x1a&.memos.api.v1.ListPendingMemosResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/memos:pending\x12u\n" +
+	"\vApproveMemo\x12 .memos.api.v1.ApproveMemoRequest\x1a\x12.memos.api.v1.Memo\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/{name=memos/*}:approve\x12r\n" +
+	"\n" +
+	"RejectMemo\x12\x1f.memos.api.v1.RejectMemoRequest\x1a\x12.memos.api.v1.Memo\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/{name=memos/*}:reject\x12\x9c\x01\n" +
+	"\x14EvaluateTaggingRules\x12).memos.api.v1.EvaluateTaggingRulesRequest\x1a*.memos.api.v1.EvaluateTaggingRulesResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/memos:evaluateTaggingRules\x12n\n" +
+	"\vComputeDiff\x12 .memos.api.v1.ComputeDiffRequest\x1a!.memos.api.v1.ComputeDiffResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/memos:diff\x12\x93\x01\n" +
+	"\x13GetMemoSubscription\x12(.memos.api.v1.GetMemoSubscriptionRequest\x1a\x1e.memos.api.v1.MemoSubscription\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%\x12#/api/v1/{name=memos/*/subscription}\x12\xc8\x01\n" +
+	"\x16UpdateMemoSubscription\x12+.memos.api.v1.UpdateMemoSubscriptionRequest\x1a\x1e.memos.api.v1.MemoSubscription\"a\xdaA\x18subscription,update_mask\x82\xd3\xe4\x93\x02@:\fsubscription20/api/v1/{subscription.name=memos/*/subscription}\x12\x8c\x01\n" +
+	"\x13ListSubscribedMemos\x12(.memos.api.v1.ListSubscribedMemosRequest\x1a).memos.api.v1.ListSubscribedMemosResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/memos:subscribed\x12|\n" +
+	"\x0fListUnreadMemos\x12$.memos.api.v1.ListUnreadMemosRequest\x1a%.memos.api.v1.ListUnreadMemosResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/memos:unread\x12t\n" +
+	"\rListColdMemos\x12\".memos.api.v1.ListColdMemosRequest\x1a#.memos.api.v1.ListColdMemosResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/memos:cold\x12}\n" +
+	"\x0fRestoreColdMemo\x12$.memos.api.v1.RestoreColdMemoRequest\x1a\x12.memos.api.v1.Memo\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/{name=memos/*}:restore\x12x\n" +
+	"\vSearchMemos\x12 .memos.api.v1.SearchMemosRequest\x1a!.memos.api.v1.SearchMemosResponse\"$\xdaA\x05query\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/memos:search\x12\x98\x01\n" +
+	"\x13SearchMemosSemantic\x12(.memos.api.v1.SearchMemosSemanticRequest\x1a).memos.api.v1.SearchMemosSemanticResponse\",\xdaA\x05query\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/memos:searchSemanticB\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10MemoServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -2202,110 +7658,313 @@ func file_api_v1_memo_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_memo_service_proto_rawDescData
 }
 
-var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 96)
 var file_api_v1_memo_service_proto_goTypes = []any{
-	(Visibility)(0),                     // 0: memos.api.v1.Visibility
-	(MemoRelation_Type)(0),              // 1: memos.api.v1.MemoRelation.Type
-	(*Reaction)(nil),                    // 2: memos.api.v1.Reaction
-	(*Memo)(nil),                        // 3: memos.api.v1.Memo
-	(*Location)(nil),                    // 4: memos.api.v1.Location
-	(*CreateMemoRequest)(nil),           // 5: memos.api.v1.CreateMemoRequest
-	(*ListMemosRequest)(nil),            // 6: memos.api.v1.ListMemosRequest
-	(*ListMemosResponse)(nil),           // 7: memos.api.v1.ListMemosResponse
-	(*GetMemoRequest)(nil),              // 8: memos.api.v1.GetMemoRequest
-	(*UpdateMemoRequest)(nil),           // 9: memos.api.v1.UpdateMemoRequest
-	(*DeleteMemoRequest)(nil),           // 10: memos.api.v1.DeleteMemoRequest
-	(*RenameMemoTagRequest)(nil),        // 11: memos.api.v1.RenameMemoTagRequest
-	(*DeleteMemoTagRequest)(nil),        // 12: memos.api.v1.DeleteMemoTagRequest
-	(*SetMemoAttachmentsRequest)(nil),   // 13: memos.api.v1.SetMemoAttachmentsRequest
-	(*ListMemoAttachmentsRequest)(nil),  // 14: memos.api.v1.ListMemoAttachmentsRequest
-	(*ListMemoAttachmentsResponse)(nil), // 15: memos.api.v1.ListMemoAttachmentsResponse
-	(*MemoRelation)(nil),                // 16: memos.api.v1.MemoRelation
-	(*SetMemoRelationsRequest)(nil),     // 17: memos.api.v1.SetMemoRelationsRequest
-	(*ListMemoRelationsRequest)(nil),    // 18: memos.api.v1.ListMemoRelationsRequest
-	(*ListMemoRelationsResponse)(nil),   // 19: memos.api.v1.ListMemoRelationsResponse
-	(*CreateMemoCommentRequest)(nil),    // 20: memos.api.v1.CreateMemoCommentRequest
-	(*ListMemoCommentsRequest)(nil),     // 21: memos.api.v1.ListMemoCommentsRequest
-	(*ListMemoCommentsResponse)(nil),    // 22: memos.api.v1.ListMemoCommentsResponse
-	(*ListMemoReactionsRequest)(nil),    // 23: memos.api.v1.ListMemoReactionsRequest
-	(*ListMemoReactionsResponse)(nil),   // 24: memos.api.v1.ListMemoReactionsResponse
-	(*UpsertMemoReactionRequest)(nil),   // 25: memos.api.v1.UpsertMemoReactionRequest
-	(*DeleteMemoReactionRequest)(nil),   // 26: memos.api.v1.DeleteMemoReactionRequest
-	(*Memo_Property)(nil),               // 27: memos.api.v1.Memo.Property
-	(*MemoRelation_Memo)(nil),           // 28: memos.api.v1.MemoRelation.Memo
-	(*timestamppb.Timestamp)(nil),       // 29: google.protobuf.Timestamp
-	(State)(0),                          // 30: memos.api.v1.State
-	(*Attachment)(nil),                  // 31: memos.api.v1.Attachment
-	(*fieldmaskpb.FieldMask)(nil),       // 32: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),               // 33: google.protobuf.Empty
+	(Visibility)(0),                            // 0: memos.api.v1.Visibility
+	(MemoScope)(0),                             // 1: memos.api.v1.MemoScope
+	(Webmention_Type)(0),                       // 2: memos.api.v1.Webmention.Type
+	(Memo_ExpiryAction)(0),                     // 3: memos.api.v1.Memo.ExpiryAction
+	(Memo_Approval_State)(0),                   // 4: memos.api.v1.Memo.Approval.State
+	(SumPropertiesRequest_Period)(0),           // 5: memos.api.v1.SumPropertiesRequest.Period
+	(ComputeDiffRequest_Granularity)(0),        // 6: memos.api.v1.ComputeDiffRequest.Granularity
+	(ComputeDiffResponse_Type)(0),              // 7: memos.api.v1.ComputeDiffResponse.Type
+	(SearchMemosRequest_Ranking)(0),            // 8: memos.api.v1.SearchMemosRequest.Ranking
+	(SearchMemosResponse_MatchType)(0),         // 9: memos.api.v1.SearchMemosResponse.MatchType
+	(MemoRelation_Type)(0),                     // 10: memos.api.v1.MemoRelation.Type
+	(ListMemoRelationsRequest_Direction)(0),    // 11: memos.api.v1.ListMemoRelationsRequest.Direction
+	(*Reaction)(nil),                           // 12: memos.api.v1.Reaction
+	(*Webmention)(nil),                         // 13: memos.api.v1.Webmention
+	(*Memo)(nil),                               // 14: memos.api.v1.Memo
+	(*Location)(nil),                           // 15: memos.api.v1.Location
+	(*CreateMemoRequest)(nil),                  // 16: memos.api.v1.CreateMemoRequest
+	(*MemoContentSplitSuggestion)(nil),         // 17: memos.api.v1.MemoContentSplitSuggestion
+	(*ListMemosRequest)(nil),                   // 18: memos.api.v1.ListMemosRequest
+	(*ListMemosResponse)(nil),                  // 19: memos.api.v1.ListMemosResponse
+	(*ListMemosWithBrokenLinksRequest)(nil),    // 20: memos.api.v1.ListMemosWithBrokenLinksRequest
+	(*ListMemosWithBrokenLinksResponse)(nil),   // 21: memos.api.v1.ListMemosWithBrokenLinksResponse
+	(*MemoReadState)(nil),                      // 22: memos.api.v1.MemoReadState
+	(*GetMemoReadStateRequest)(nil),            // 23: memos.api.v1.GetMemoReadStateRequest
+	(*UpdateMemoReadStateRequest)(nil),         // 24: memos.api.v1.UpdateMemoReadStateRequest
+	(*MemoStats)(nil),                          // 25: memos.api.v1.MemoStats
+	(*GetMemoStatsRequest)(nil),                // 26: memos.api.v1.GetMemoStatsRequest
+	(*GetCalendarMonthRequest)(nil),            // 27: memos.api.v1.GetCalendarMonthRequest
+	(*CalendarMonth)(nil),                      // 28: memos.api.v1.CalendarMonth
+	(*GetTimeReportRequest)(nil),               // 29: memos.api.v1.GetTimeReportRequest
+	(*TimeReport)(nil),                         // 30: memos.api.v1.TimeReport
+	(*SumPropertiesRequest)(nil),               // 31: memos.api.v1.SumPropertiesRequest
+	(*SumPropertiesResponse)(nil),              // 32: memos.api.v1.SumPropertiesResponse
+	(*ListReadingRequest)(nil),                 // 33: memos.api.v1.ListReadingRequest
+	(*ListReadingResponse)(nil),                // 34: memos.api.v1.ListReadingResponse
+	(*GetPersonPageRequest)(nil),               // 35: memos.api.v1.GetPersonPageRequest
+	(*PersonPage)(nil),                         // 36: memos.api.v1.PersonPage
+	(*ListPendingMemosRequest)(nil),            // 37: memos.api.v1.ListPendingMemosRequest
+	(*ListPendingMemosResponse)(nil),           // 38: memos.api.v1.ListPendingMemosResponse
+	(*ApproveMemoRequest)(nil),                 // 39: memos.api.v1.ApproveMemoRequest
+	(*RejectMemoRequest)(nil),                  // 40: memos.api.v1.RejectMemoRequest
+	(*EvaluateTaggingRulesRequest)(nil),        // 41: memos.api.v1.EvaluateTaggingRulesRequest
+	(*EvaluateTaggingRulesResponse)(nil),       // 42: memos.api.v1.EvaluateTaggingRulesResponse
+	(*ComputeDiffRequest)(nil),                 // 43: memos.api.v1.ComputeDiffRequest
+	(*ComputeDiffResponse)(nil),                // 44: memos.api.v1.ComputeDiffResponse
+	(*MemoSubscription)(nil),                   // 45: memos.api.v1.MemoSubscription
+	(*GetMemoSubscriptionRequest)(nil),         // 46: memos.api.v1.GetMemoSubscriptionRequest
+	(*UpdateMemoSubscriptionRequest)(nil),      // 47: memos.api.v1.UpdateMemoSubscriptionRequest
+	(*ListSubscribedMemosRequest)(nil),         // 48: memos.api.v1.ListSubscribedMemosRequest
+	(*ListSubscribedMemosResponse)(nil),        // 49: memos.api.v1.ListSubscribedMemosResponse
+	(*ListUnreadMemosRequest)(nil),             // 50: memos.api.v1.ListUnreadMemosRequest
+	(*ListUnreadMemosResponse)(nil),            // 51: memos.api.v1.ListUnreadMemosResponse
+	(*ListColdMemosRequest)(nil),               // 52: memos.api.v1.ListColdMemosRequest
+	(*ListColdMemosResponse)(nil),              // 53: memos.api.v1.ListColdMemosResponse
+	(*RestoreColdMemoRequest)(nil),             // 54: memos.api.v1.RestoreColdMemoRequest
+	(*SearchMemosRequest)(nil),                 // 55: memos.api.v1.SearchMemosRequest
+	(*SearchMemosResponse)(nil),                // 56: memos.api.v1.SearchMemosResponse
+	(*SearchMemosSemanticRequest)(nil),         // 57: memos.api.v1.SearchMemosSemanticRequest
+	(*SearchMemosSemanticResponse)(nil),        // 58: memos.api.v1.SearchMemosSemanticResponse
+	(*GetMemoBySlugRequest)(nil),               // 59: memos.api.v1.GetMemoBySlugRequest
+	(*GetMemoRequest)(nil),                     // 60: memos.api.v1.GetMemoRequest
+	(*UpdateMemoRequest)(nil),                  // 61: memos.api.v1.UpdateMemoRequest
+	(*DeleteMemoRequest)(nil),                  // 62: memos.api.v1.DeleteMemoRequest
+	(*RenameMemoTagRequest)(nil),               // 63: memos.api.v1.RenameMemoTagRequest
+	(*DeleteMemoTagRequest)(nil),               // 64: memos.api.v1.DeleteMemoTagRequest
+	(*SetMemoAttachmentsRequest)(nil),          // 65: memos.api.v1.SetMemoAttachmentsRequest
+	(*ListMemoAttachmentsRequest)(nil),         // 66: memos.api.v1.ListMemoAttachmentsRequest
+	(*ListMemoAttachmentsResponse)(nil),        // 67: memos.api.v1.ListMemoAttachmentsResponse
+	(*MemoRelation)(nil),                       // 68: memos.api.v1.MemoRelation
+	(*SetMemoRelationsRequest)(nil),            // 69: memos.api.v1.SetMemoRelationsRequest
+	(*ListMemoRelationsRequest)(nil),           // 70: memos.api.v1.ListMemoRelationsRequest
+	(*ListMemoRelationsResponse)(nil),          // 71: memos.api.v1.ListMemoRelationsResponse
+	(*CreateMemoCommentRequest)(nil),           // 72: memos.api.v1.CreateMemoCommentRequest
+	(*ListMemoCommentsRequest)(nil),            // 73: memos.api.v1.ListMemoCommentsRequest
+	(*ListMemoCommentsResponse)(nil),           // 74: memos.api.v1.ListMemoCommentsResponse
+	(*ListMemoReactionsRequest)(nil),           // 75: memos.api.v1.ListMemoReactionsRequest
+	(*ListMemoReactionsResponse)(nil),          // 76: memos.api.v1.ListMemoReactionsResponse
+	(*UpsertMemoReactionRequest)(nil),          // 77: memos.api.v1.UpsertMemoReactionRequest
+	(*ListMemoWebmentionsRequest)(nil),         // 78: memos.api.v1.ListMemoWebmentionsRequest
+	(*ListMemoWebmentionsResponse)(nil),        // 79: memos.api.v1.ListMemoWebmentionsResponse
+	(*DeleteMemoWebmentionRequest)(nil),        // 80: memos.api.v1.DeleteMemoWebmentionRequest
+	(*DeleteMemoReactionRequest)(nil),          // 81: memos.api.v1.DeleteMemoReactionRequest
+	(*Webmention_Author)(nil),                  // 82: memos.api.v1.Webmention.Author
+	(*Memo_Property)(nil),                      // 83: memos.api.v1.Memo.Property
+	(*Memo_Amount)(nil),                        // 84: memos.api.v1.Memo.Amount
+	(*Memo_TimeEntry)(nil),                     // 85: memos.api.v1.Memo.TimeEntry
+	(*Memo_BrokenLink)(nil),                    // 86: memos.api.v1.Memo.BrokenLink
+	(*Memo_LinkSnapshot)(nil),                  // 87: memos.api.v1.Memo.LinkSnapshot
+	(*Memo_Reading)(nil),                       // 88: memos.api.v1.Memo.Reading
+	(*Memo_Contributor)(nil),                   // 89: memos.api.v1.Memo.Contributor
+	(*Memo_Approval)(nil),                      // 90: memos.api.v1.Memo.Approval
+	(*Memo_Syndication)(nil),                   // 91: memos.api.v1.Memo.Syndication
+	(*Memo_AISummaryRefinement)(nil),           // 92: memos.api.v1.Memo.AISummaryRefinement
+	(*MemoStats_DailyViewCount)(nil),           // 93: memos.api.v1.MemoStats.DailyViewCount
+	(*CalendarMonth_Day)(nil),                  // 94: memos.api.v1.CalendarMonth.Day
+	(*TimeReport_Week)(nil),                    // 95: memos.api.v1.TimeReport.Week
+	(*TimeReport_TagTime)(nil),                 // 96: memos.api.v1.TimeReport.TagTime
+	(*SumPropertiesResponse_Period)(nil),       // 97: memos.api.v1.SumPropertiesResponse.Period
+	(*SumPropertiesResponse_Sum)(nil),          // 98: memos.api.v1.SumPropertiesResponse.Sum
+	(*ListReadingResponse_Group)(nil),          // 99: memos.api.v1.ListReadingResponse.Group
+	(*PersonPage_Interaction)(nil),             // 100: memos.api.v1.PersonPage.Interaction
+	(*PersonPage_TagCount)(nil),                // 101: memos.api.v1.PersonPage.TagCount
+	(*ComputeDiffResponse_Line)(nil),           // 102: memos.api.v1.ComputeDiffResponse.Line
+	(*ComputeDiffResponse_Segment)(nil),        // 103: memos.api.v1.ComputeDiffResponse.Segment
+	nil,                                        // 104: memos.api.v1.SearchMemosRequest.TagBoostsEntry
+	(*SearchMemosResponse_Match)(nil),          // 105: memos.api.v1.SearchMemosResponse.Match
+	(*SearchMemosSemanticResponse_Result)(nil), // 106: memos.api.v1.SearchMemosSemanticResponse.Result
+	(*MemoRelation_Memo)(nil),                  // 107: memos.api.v1.MemoRelation.Memo
+	(*timestamppb.Timestamp)(nil),              // 108: google.protobuf.Timestamp
+	(State)(0),                                 // 109: memos.api.v1.State
+	(*Attachment)(nil),                         // 110: memos.api.v1.Attachment
+	(*fieldmaskpb.FieldMask)(nil),              // 111: google.protobuf.FieldMask
+	(*UserSetting_TaggingRulesSetting)(nil),    // 112: memos.api.v1.UserSetting.TaggingRulesSetting
+	(*emptypb.Empty)(nil),                      // 113: google.protobuf.Empty
 }
 var file_api_v1_memo_service_proto_depIdxs = []int32{
-	29, // 0: memos.api.v1.Reaction.create_time:type_name -> google.protobuf.Timestamp
-	30, // 1: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
-	29, // 2: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
-	29, // 3: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
-	29, // 4: memos.api.v1.Memo.display_time:type_name -> google.protobuf.Timestamp
-	0,  // 5: memos.api.v1.Memo.visibility:type_name -> memos.api.v1.Visibility
-	31, // 6: memos.api.v1.Memo.attachments:type_name -> memos.api.v1.Attachment
-	16, // 7: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
-	2,  // 8: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
-	27, // 9: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
-	4,  // 10: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
-	3,  // 11: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	30, // 12: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
-	3,  // 13: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
-	32, // 14: memos.api.v1.GetMemoRequest.read_mask:type_name -> google.protobuf.FieldMask
-	3,  // 15: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	32, // 16: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
-	31, // 17: memos.api.v1.SetMemoAttachmentsRequest.attachments:type_name -> memos.api.v1.Attachment
-	31, // 18: memos.api.v1.ListMemoAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
-	28, // 19: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
-	28, // 20: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
-	1,  // 21: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
-	16, // 22: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
-	16, // 23: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
-	3,  // 24: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
-	3,  // 25: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
-	2,  // 26: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
-	2,  // 27: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
-	5,  // 28: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
-	6,  // 29: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
-	8,  // 30: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
-	9,  // 31: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
-	10, // 32: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
-	11, // 33: memos.api.v1.MemoService.RenameMemoTag:input_type -> memos.api.v1.RenameMemoTagRequest
-	12, // 34: memos.api.v1.MemoService.DeleteMemoTag:input_type -> memos.api.v1.DeleteMemoTagRequest
-	13, // 35: memos.api.v1.MemoService.SetMemoAttachments:input_type -> memos.api.v1.SetMemoAttachmentsRequest
-	14, // 36: memos.api.v1.MemoService.ListMemoAttachments:input_type -> memos.api.v1.ListMemoAttachmentsRequest
-	17, // 37: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
-	18, // 38: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
-	20, // 39: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
-	21, // 40: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
-	23, // 41: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
-	25, // 42: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
-	26, // 43: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
-	3,  // 44: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
-	7,  // 45: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
-	3,  // 46: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
-	3,  // 47: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
-	33, // 48: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
-	33, // 49: memos.api.v1.MemoService.RenameMemoTag:output_type -> google.protobuf.Empty
-	33, // 50: memos.api.v1.MemoService.DeleteMemoTag:output_type -> google.protobuf.Empty
-	33, // 51: memos.api.v1.MemoService.SetMemoAttachments:output_type -> google.protobuf.Empty
-	15, // 52: memos.api.v1.MemoService.ListMemoAttachments:output_type -> memos.api.v1.ListMemoAttachmentsResponse
-	33, // 53: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
-	19, // 54: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
-	3,  // 55: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
-	22, // 56: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
-	24, // 57: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
-	2,  // 58: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
-	33, // 59: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
-	44, // [44:60] is the sub-list for method output_type
-	28, // [28:44] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	108, // 0: memos.api.v1.Reaction.create_time:type_name -> google.protobuf.Timestamp
+	2,   // 1: memos.api.v1.Webmention.type:type_name -> memos.api.v1.Webmention.Type
+	82,  // 2: memos.api.v1.Webmention.author:type_name -> memos.api.v1.Webmention.Author
+	108, // 3: memos.api.v1.Webmention.publish_time:type_name -> google.protobuf.Timestamp
+	108, // 4: memos.api.v1.Webmention.create_time:type_name -> google.protobuf.Timestamp
+	108, // 5: memos.api.v1.Webmention.update_time:type_name -> google.protobuf.Timestamp
+	109, // 6: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
+	108, // 7: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
+	108, // 8: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
+	108, // 9: memos.api.v1.Memo.display_time:type_name -> google.protobuf.Timestamp
+	0,   // 10: memos.api.v1.Memo.visibility:type_name -> memos.api.v1.Visibility
+	110, // 11: memos.api.v1.Memo.attachments:type_name -> memos.api.v1.Attachment
+	68,  // 12: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
+	12,  // 13: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
+	83,  // 14: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
+	15,  // 15: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
+	108, // 16: memos.api.v1.Memo.expire_time:type_name -> google.protobuf.Timestamp
+	3,   // 17: memos.api.v1.Memo.expiry_action:type_name -> memos.api.v1.Memo.ExpiryAction
+	92,  // 18: memos.api.v1.Memo.ai_summary_refinements:type_name -> memos.api.v1.Memo.AISummaryRefinement
+	91,  // 19: memos.api.v1.Memo.syndications:type_name -> memos.api.v1.Memo.Syndication
+	88,  // 20: memos.api.v1.Memo.reading:type_name -> memos.api.v1.Memo.Reading
+	90,  // 21: memos.api.v1.Memo.approval:type_name -> memos.api.v1.Memo.Approval
+	89,  // 22: memos.api.v1.Memo.contributors:type_name -> memos.api.v1.Memo.Contributor
+	14,  // 23: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	109, // 24: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
+	1,   // 25: memos.api.v1.ListMemosRequest.scope:type_name -> memos.api.v1.MemoScope
+	14,  // 26: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
+	14,  // 27: memos.api.v1.ListMemosWithBrokenLinksResponse.memos:type_name -> memos.api.v1.Memo
+	108, // 28: memos.api.v1.MemoReadState.read_time:type_name -> google.protobuf.Timestamp
+	22,  // 29: memos.api.v1.UpdateMemoReadStateRequest.read_state:type_name -> memos.api.v1.MemoReadState
+	111, // 30: memos.api.v1.UpdateMemoReadStateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	93,  // 31: memos.api.v1.MemoStats.daily_view_counts:type_name -> memos.api.v1.MemoStats.DailyViewCount
+	94,  // 32: memos.api.v1.CalendarMonth.days:type_name -> memos.api.v1.CalendarMonth.Day
+	95,  // 33: memos.api.v1.TimeReport.weeks:type_name -> memos.api.v1.TimeReport.Week
+	5,   // 34: memos.api.v1.SumPropertiesRequest.period:type_name -> memos.api.v1.SumPropertiesRequest.Period
+	97,  // 35: memos.api.v1.SumPropertiesResponse.periods:type_name -> memos.api.v1.SumPropertiesResponse.Period
+	98,  // 36: memos.api.v1.SumPropertiesResponse.totals:type_name -> memos.api.v1.SumPropertiesResponse.Sum
+	99,  // 37: memos.api.v1.ListReadingResponse.groups:type_name -> memos.api.v1.ListReadingResponse.Group
+	108, // 38: memos.api.v1.PersonPage.first_mention_time:type_name -> google.protobuf.Timestamp
+	108, // 39: memos.api.v1.PersonPage.last_mention_time:type_name -> google.protobuf.Timestamp
+	14,  // 40: memos.api.v1.PersonPage.memos:type_name -> memos.api.v1.Memo
+	100, // 41: memos.api.v1.PersonPage.timeline:type_name -> memos.api.v1.PersonPage.Interaction
+	101, // 42: memos.api.v1.PersonPage.co_occurring_tags:type_name -> memos.api.v1.PersonPage.TagCount
+	14,  // 43: memos.api.v1.ListPendingMemosResponse.memos:type_name -> memos.api.v1.Memo
+	108, // 44: memos.api.v1.EvaluateTaggingRulesRequest.create_time:type_name -> google.protobuf.Timestamp
+	112, // 45: memos.api.v1.EvaluateTaggingRulesRequest.tagging_rules:type_name -> memos.api.v1.UserSetting.TaggingRulesSetting
+	0,   // 46: memos.api.v1.EvaluateTaggingRulesResponse.visibility:type_name -> memos.api.v1.Visibility
+	6,   // 47: memos.api.v1.ComputeDiffRequest.granularity:type_name -> memos.api.v1.ComputeDiffRequest.Granularity
+	102, // 48: memos.api.v1.ComputeDiffResponse.lines:type_name -> memos.api.v1.ComputeDiffResponse.Line
+	108, // 49: memos.api.v1.MemoSubscription.update_time:type_name -> google.protobuf.Timestamp
+	45,  // 50: memos.api.v1.UpdateMemoSubscriptionRequest.subscription:type_name -> memos.api.v1.MemoSubscription
+	111, // 51: memos.api.v1.UpdateMemoSubscriptionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	14,  // 52: memos.api.v1.ListSubscribedMemosResponse.memos:type_name -> memos.api.v1.Memo
+	14,  // 53: memos.api.v1.ListUnreadMemosResponse.memos:type_name -> memos.api.v1.Memo
+	14,  // 54: memos.api.v1.ListColdMemosResponse.memos:type_name -> memos.api.v1.Memo
+	1,   // 55: memos.api.v1.SearchMemosRequest.scope:type_name -> memos.api.v1.MemoScope
+	8,   // 56: memos.api.v1.SearchMemosRequest.ranking:type_name -> memos.api.v1.SearchMemosRequest.Ranking
+	104, // 57: memos.api.v1.SearchMemosRequest.tag_boosts:type_name -> memos.api.v1.SearchMemosRequest.TagBoostsEntry
+	14,  // 58: memos.api.v1.SearchMemosResponse.memos:type_name -> memos.api.v1.Memo
+	105, // 59: memos.api.v1.SearchMemosResponse.matches:type_name -> memos.api.v1.SearchMemosResponse.Match
+	1,   // 60: memos.api.v1.SearchMemosSemanticRequest.scope:type_name -> memos.api.v1.MemoScope
+	106, // 61: memos.api.v1.SearchMemosSemanticResponse.results:type_name -> memos.api.v1.SearchMemosSemanticResponse.Result
+	111, // 62: memos.api.v1.GetMemoRequest.read_mask:type_name -> google.protobuf.FieldMask
+	14,  // 63: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	111, // 64: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	110, // 65: memos.api.v1.SetMemoAttachmentsRequest.attachments:type_name -> memos.api.v1.Attachment
+	110, // 66: memos.api.v1.ListMemoAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
+	107, // 67: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
+	107, // 68: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
+	10,  // 69: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
+	68,  // 70: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
+	11,  // 71: memos.api.v1.ListMemoRelationsRequest.direction:type_name -> memos.api.v1.ListMemoRelationsRequest.Direction
+	10,  // 72: memos.api.v1.ListMemoRelationsRequest.types:type_name -> memos.api.v1.MemoRelation.Type
+	68,  // 73: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
+	14,  // 74: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
+	14,  // 75: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
+	12,  // 76: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
+	12,  // 77: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
+	13,  // 78: memos.api.v1.ListMemoWebmentionsResponse.webmentions:type_name -> memos.api.v1.Webmention
+	86,  // 79: memos.api.v1.Memo.Property.broken_links:type_name -> memos.api.v1.Memo.BrokenLink
+	87,  // 80: memos.api.v1.Memo.Property.link_snapshots:type_name -> memos.api.v1.Memo.LinkSnapshot
+	85,  // 81: memos.api.v1.Memo.Property.time_entries:type_name -> memos.api.v1.Memo.TimeEntry
+	84,  // 82: memos.api.v1.Memo.Property.amounts:type_name -> memos.api.v1.Memo.Amount
+	108, // 83: memos.api.v1.Memo.BrokenLink.check_time:type_name -> google.protobuf.Timestamp
+	108, // 84: memos.api.v1.Memo.LinkSnapshot.create_time:type_name -> google.protobuf.Timestamp
+	108, // 85: memos.api.v1.Memo.Contributor.last_edit_time:type_name -> google.protobuf.Timestamp
+	4,   // 86: memos.api.v1.Memo.Approval.state:type_name -> memos.api.v1.Memo.Approval.State
+	0,   // 87: memos.api.v1.Memo.Approval.requested_visibility:type_name -> memos.api.v1.Visibility
+	108, // 88: memos.api.v1.Memo.Approval.request_time:type_name -> google.protobuf.Timestamp
+	108, // 89: memos.api.v1.Memo.Approval.review_time:type_name -> google.protobuf.Timestamp
+	108, // 90: memos.api.v1.Memo.Syndication.create_time:type_name -> google.protobuf.Timestamp
+	108, // 91: memos.api.v1.Memo.AISummaryRefinement.create_time:type_name -> google.protobuf.Timestamp
+	96,  // 92: memos.api.v1.TimeReport.Week.tags:type_name -> memos.api.v1.TimeReport.TagTime
+	98,  // 93: memos.api.v1.SumPropertiesResponse.Period.sums:type_name -> memos.api.v1.SumPropertiesResponse.Sum
+	14,  // 94: memos.api.v1.ListReadingResponse.Group.memos:type_name -> memos.api.v1.Memo
+	108, // 95: memos.api.v1.PersonPage.Interaction.time:type_name -> google.protobuf.Timestamp
+	7,   // 96: memos.api.v1.ComputeDiffResponse.Line.type:type_name -> memos.api.v1.ComputeDiffResponse.Type
+	103, // 97: memos.api.v1.ComputeDiffResponse.Line.segments:type_name -> memos.api.v1.ComputeDiffResponse.Segment
+	7,   // 98: memos.api.v1.ComputeDiffResponse.Segment.type:type_name -> memos.api.v1.ComputeDiffResponse.Type
+	9,   // 99: memos.api.v1.SearchMemosResponse.Match.types:type_name -> memos.api.v1.SearchMemosResponse.MatchType
+	14,  // 100: memos.api.v1.SearchMemosSemanticResponse.Result.memo:type_name -> memos.api.v1.Memo
+	16,  // 101: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
+	18,  // 102: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
+	60,  // 103: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
+	59,  // 104: memos.api.v1.MemoService.GetMemoBySlug:input_type -> memos.api.v1.GetMemoBySlugRequest
+	61,  // 105: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
+	62,  // 106: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
+	63,  // 107: memos.api.v1.MemoService.RenameMemoTag:input_type -> memos.api.v1.RenameMemoTagRequest
+	64,  // 108: memos.api.v1.MemoService.DeleteMemoTag:input_type -> memos.api.v1.DeleteMemoTagRequest
+	65,  // 109: memos.api.v1.MemoService.SetMemoAttachments:input_type -> memos.api.v1.SetMemoAttachmentsRequest
+	66,  // 110: memos.api.v1.MemoService.ListMemoAttachments:input_type -> memos.api.v1.ListMemoAttachmentsRequest
+	69,  // 111: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
+	70,  // 112: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
+	72,  // 113: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
+	73,  // 114: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
+	75,  // 115: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
+	77,  // 116: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
+	81,  // 117: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
+	78,  // 118: memos.api.v1.MemoService.ListMemoWebmentions:input_type -> memos.api.v1.ListMemoWebmentionsRequest
+	80,  // 119: memos.api.v1.MemoService.DeleteMemoWebmention:input_type -> memos.api.v1.DeleteMemoWebmentionRequest
+	20,  // 120: memos.api.v1.MemoService.ListMemosWithBrokenLinks:input_type -> memos.api.v1.ListMemosWithBrokenLinksRequest
+	23,  // 121: memos.api.v1.MemoService.GetMemoReadState:input_type -> memos.api.v1.GetMemoReadStateRequest
+	24,  // 122: memos.api.v1.MemoService.UpdateMemoReadState:input_type -> memos.api.v1.UpdateMemoReadStateRequest
+	26,  // 123: memos.api.v1.MemoService.GetMemoStats:input_type -> memos.api.v1.GetMemoStatsRequest
+	27,  // 124: memos.api.v1.MemoService.GetCalendarMonth:input_type -> memos.api.v1.GetCalendarMonthRequest
+	29,  // 125: memos.api.v1.MemoService.GetTimeReport:input_type -> memos.api.v1.GetTimeReportRequest
+	31,  // 126: memos.api.v1.MemoService.SumProperties:input_type -> memos.api.v1.SumPropertiesRequest
+	33,  // 127: memos.api.v1.MemoService.ListReading:input_type -> memos.api.v1.ListReadingRequest
+	35,  // 128: memos.api.v1.MemoService.GetPersonPage:input_type -> memos.api.v1.GetPersonPageRequest
+	37,  // 129: memos.api.v1.MemoService.ListPendingMemos:input_type -> memos.api.v1.ListPendingMemosRequest
+	39,  // 130: memos.api.v1.MemoService.ApproveMemo:input_type -> memos.api.v1.ApproveMemoRequest
+	40,  // 131: memos.api.v1.MemoService.RejectMemo:input_type -> memos.api.v1.RejectMemoRequest
+	41,  // 132: memos.api.v1.MemoService.EvaluateTaggingRules:input_type -> memos.api.v1.EvaluateTaggingRulesRequest
+	43,  // 133: memos.api.v1.MemoService.ComputeDiff:input_type -> memos.api.v1.ComputeDiffRequest
+	46,  // 134: memos.api.v1.MemoService.GetMemoSubscription:input_type -> memos.api.v1.GetMemoSubscriptionRequest
+	47,  // 135: memos.api.v1.MemoService.UpdateMemoSubscription:input_type -> memos.api.v1.UpdateMemoSubscriptionRequest
+	48,  // 136: memos.api.v1.MemoService.ListSubscribedMemos:input_type -> memos.api.v1.ListSubscribedMemosRequest
+	50,  // 137: memos.api.v1.MemoService.ListUnreadMemos:input_type -> memos.api.v1.ListUnreadMemosRequest
+	52,  // 138: memos.api.v1.MemoService.ListColdMemos:input_type -> memos.api.v1.ListColdMemosRequest
+	54,  // 139: memos.api.v1.MemoService.RestoreColdMemo:input_type -> memos.api.v1.RestoreColdMemoRequest
+	55,  // 140: memos.api.v1.MemoService.SearchMemos:input_type -> memos.api.v1.SearchMemosRequest
+	57,  // 141: memos.api.v1.MemoService.SearchMemosSemantic:input_type -> memos.api.v1.SearchMemosSemanticRequest
+	14,  // 142: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
+	19,  // 143: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
+	14,  // 144: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
+	14,  // 145: memos.api.v1.MemoService.GetMemoBySlug:output_type -> memos.api.v1.Memo
+	14,  // 146: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
+	113, // 147: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
+	113, // 148: memos.api.v1.MemoService.RenameMemoTag:output_type -> google.protobuf.Empty
+	113, // 149: memos.api.v1.MemoService.DeleteMemoTag:output_type -> google.protobuf.Empty
+	113, // 150: memos.api.v1.MemoService.SetMemoAttachments:output_type -> google.protobuf.Empty
+	67,  // 151: memos.api.v1.MemoService.ListMemoAttachments:output_type -> memos.api.v1.ListMemoAttachmentsResponse
+	113, // 152: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
+	71,  // 153: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
+	14,  // 154: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
+	74,  // 155: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
+	76,  // 156: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
+	12,  // 157: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
+	113, // 158: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
+	79,  // 159: memos.api.v1.MemoService.ListMemoWebmentions:output_type -> memos.api.v1.ListMemoWebmentionsResponse
+	113, // 160: memos.api.v1.MemoService.DeleteMemoWebmention:output_type -> google.protobuf.Empty
+	21,  // 161: memos.api.v1.MemoService.ListMemosWithBrokenLinks:output_type -> memos.api.v1.ListMemosWithBrokenLinksResponse
+	22,  // 162: memos.api.v1.MemoService.GetMemoReadState:output_type -> memos.api.v1.MemoReadState
+	22,  // 163: memos.api.v1.MemoService.UpdateMemoReadState:output_type -> memos.api.v1.MemoReadState
+	25,  // 164: memos.api.v1.MemoService.GetMemoStats:output_type -> memos.api.v1.MemoStats
+	28,  // 165: memos.api.v1.MemoService.GetCalendarMonth:output_type -> memos.api.v1.CalendarMonth
+	30,  // 166: memos.api.v1.MemoService.GetTimeReport:output_type -> memos.api.v1.TimeReport
+	32,  // 167: memos.api.v1.MemoService.SumProperties:output_type -> memos.api.v1.SumPropertiesResponse
+	34,  // 168: memos.api.v1.MemoService.ListReading:output_type -> memos.api.v1.ListReadingResponse
+	36,  // 169: memos.api.v1.MemoService.GetPersonPage:output_type -> memos.api.v1.PersonPage
+	38,  // 170: memos.api.v1.MemoService.ListPendingMemos:output_type -> memos.api.v1.ListPendingMemosResponse
+	14,  // 171: memos.api.v1.MemoService.ApproveMemo:output_type -> memos.api.v1.Memo
+	14,  // 172: memos.api.v1.MemoService.RejectMemo:output_type -> memos.api.v1.Memo
+	42,  // 173: memos.api.v1.MemoService.EvaluateTaggingRules:output_type -> memos.api.v1.EvaluateTaggingRulesResponse
+	44,  // 174: memos.api.v1.MemoService.ComputeDiff:output_type -> memos.api.v1.ComputeDiffResponse
+	45,  // 175: memos.api.v1.MemoService.GetMemoSubscription:output_type -> memos.api.v1.MemoSubscription
+	45,  // 176: memos.api.v1.MemoService.UpdateMemoSubscription:output_type -> memos.api.v1.MemoSubscription
+	49,  // 177: memos.api.v1.MemoService.ListSubscribedMemos:output_type -> memos.api.v1.ListSubscribedMemosResponse
+	51,  // 178: memos.api.v1.MemoService.ListUnreadMemos:output_type -> memos.api.v1.ListUnreadMemosResponse
+	53,  // 179: memos.api.v1.MemoService.ListColdMemos:output_type -> memos.api.v1.ListColdMemosResponse
+	14,  // 180: memos.api.v1.MemoService.RestoreColdMemo:output_type -> memos.api.v1.Memo
+	56,  // 181: memos.api.v1.MemoService.SearchMemos:output_type -> memos.api.v1.SearchMemosResponse
+	58,  // 182: memos.api.v1.MemoService.SearchMemosSemantic:output_type -> memos.api.v1.SearchMemosSemanticResponse
+	142, // [142:183] is the sub-list for method output_type
+	101, // [101:142] is the sub-list for method input_type
+	101, // [101:101] is the sub-list for extension type_name
+	101, // [101:101] is the sub-list for extension extendee
+	0,   // [0:101] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_memo_service_proto_init() }
@@ -2315,14 +7974,15 @@ func file_api_v1_memo_service_proto_init() {
 	}
 	file_api_v1_attachment_service_proto_init()
 	file_api_v1_common_proto_init()
-	file_api_v1_memo_service_proto_msgTypes[1].OneofWrappers = []any{}
+	file_api_v1_user_service_proto_init()
+	file_api_v1_memo_service_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_memo_service_proto_rawDesc), len(file_api_v1_memo_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   27,
+			NumEnums:      12,
+			NumMessages:   96,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
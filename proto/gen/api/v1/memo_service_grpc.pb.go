@@ -20,22 +20,47 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MemoService_CreateMemo_FullMethodName          = "/memos.api.v1.MemoService/CreateMemo"
-	MemoService_ListMemos_FullMethodName           = "/memos.api.v1.MemoService/ListMemos"
-	MemoService_GetMemo_FullMethodName             = "/memos.api.v1.MemoService/GetMemo"
-	MemoService_UpdateMemo_FullMethodName          = "/memos.api.v1.MemoService/UpdateMemo"
-	MemoService_DeleteMemo_FullMethodName          = "/memos.api.v1.MemoService/DeleteMemo"
-	MemoService_RenameMemoTag_FullMethodName       = "/memos.api.v1.MemoService/RenameMemoTag"
-	MemoService_DeleteMemoTag_FullMethodName       = "/memos.api.v1.MemoService/DeleteMemoTag"
-	MemoService_SetMemoAttachments_FullMethodName  = "/memos.api.v1.MemoService/SetMemoAttachments"
-	MemoService_ListMemoAttachments_FullMethodName = "/memos.api.v1.MemoService/ListMemoAttachments"
-	MemoService_SetMemoRelations_FullMethodName    = "/memos.api.v1.MemoService/SetMemoRelations"
-	MemoService_ListMemoRelations_FullMethodName   = "/memos.api.v1.MemoService/ListMemoRelations"
-	MemoService_CreateMemoComment_FullMethodName   = "/memos.api.v1.MemoService/CreateMemoComment"
-	MemoService_ListMemoComments_FullMethodName    = "/memos.api.v1.MemoService/ListMemoComments"
-	MemoService_ListMemoReactions_FullMethodName   = "/memos.api.v1.MemoService/ListMemoReactions"
-	MemoService_UpsertMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/UpsertMemoReaction"
-	MemoService_DeleteMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_CreateMemo_FullMethodName               = "/memos.api.v1.MemoService/CreateMemo"
+	MemoService_ListMemos_FullMethodName                = "/memos.api.v1.MemoService/ListMemos"
+	MemoService_GetMemo_FullMethodName                  = "/memos.api.v1.MemoService/GetMemo"
+	MemoService_GetMemoBySlug_FullMethodName            = "/memos.api.v1.MemoService/GetMemoBySlug"
+	MemoService_UpdateMemo_FullMethodName               = "/memos.api.v1.MemoService/UpdateMemo"
+	MemoService_DeleteMemo_FullMethodName               = "/memos.api.v1.MemoService/DeleteMemo"
+	MemoService_RenameMemoTag_FullMethodName            = "/memos.api.v1.MemoService/RenameMemoTag"
+	MemoService_DeleteMemoTag_FullMethodName            = "/memos.api.v1.MemoService/DeleteMemoTag"
+	MemoService_SetMemoAttachments_FullMethodName       = "/memos.api.v1.MemoService/SetMemoAttachments"
+	MemoService_ListMemoAttachments_FullMethodName      = "/memos.api.v1.MemoService/ListMemoAttachments"
+	MemoService_SetMemoRelations_FullMethodName         = "/memos.api.v1.MemoService/SetMemoRelations"
+	MemoService_ListMemoRelations_FullMethodName        = "/memos.api.v1.MemoService/ListMemoRelations"
+	MemoService_CreateMemoComment_FullMethodName        = "/memos.api.v1.MemoService/CreateMemoComment"
+	MemoService_ListMemoComments_FullMethodName         = "/memos.api.v1.MemoService/ListMemoComments"
+	MemoService_ListMemoReactions_FullMethodName        = "/memos.api.v1.MemoService/ListMemoReactions"
+	MemoService_UpsertMemoReaction_FullMethodName       = "/memos.api.v1.MemoService/UpsertMemoReaction"
+	MemoService_DeleteMemoReaction_FullMethodName       = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_ListMemoWebmentions_FullMethodName      = "/memos.api.v1.MemoService/ListMemoWebmentions"
+	MemoService_DeleteMemoWebmention_FullMethodName     = "/memos.api.v1.MemoService/DeleteMemoWebmention"
+	MemoService_ListMemosWithBrokenLinks_FullMethodName = "/memos.api.v1.MemoService/ListMemosWithBrokenLinks"
+	MemoService_GetMemoReadState_FullMethodName         = "/memos.api.v1.MemoService/GetMemoReadState"
+	MemoService_UpdateMemoReadState_FullMethodName      = "/memos.api.v1.MemoService/UpdateMemoReadState"
+	MemoService_GetMemoStats_FullMethodName             = "/memos.api.v1.MemoService/GetMemoStats"
+	MemoService_GetCalendarMonth_FullMethodName         = "/memos.api.v1.MemoService/GetCalendarMonth"
+	MemoService_GetTimeReport_FullMethodName            = "/memos.api.v1.MemoService/GetTimeReport"
+	MemoService_SumProperties_FullMethodName            = "/memos.api.v1.MemoService/SumProperties"
+	MemoService_ListReading_FullMethodName              = "/memos.api.v1.MemoService/ListReading"
+	MemoService_GetPersonPage_FullMethodName            = "/memos.api.v1.MemoService/GetPersonPage"
+	MemoService_ListPendingMemos_FullMethodName         = "/memos.api.v1.MemoService/ListPendingMemos"
+	MemoService_ApproveMemo_FullMethodName              = "/memos.api.v1.MemoService/ApproveMemo"
+	MemoService_RejectMemo_FullMethodName               = "/memos.api.v1.MemoService/RejectMemo"
+	MemoService_EvaluateTaggingRules_FullMethodName     = "/memos.api.v1.MemoService/EvaluateTaggingRules"
+	MemoService_ComputeDiff_FullMethodName              = "/memos.api.v1.MemoService/ComputeDiff"
+	MemoService_GetMemoSubscription_FullMethodName      = "/memos.api.v1.MemoService/GetMemoSubscription"
+	MemoService_UpdateMemoSubscription_FullMethodName   = "/memos.api.v1.MemoService/UpdateMemoSubscription"
+	MemoService_ListSubscribedMemos_FullMethodName      = "/memos.api.v1.MemoService/ListSubscribedMemos"
+	MemoService_ListUnreadMemos_FullMethodName          = "/memos.api.v1.MemoService/ListUnreadMemos"
+	MemoService_ListColdMemos_FullMethodName            = "/memos.api.v1.MemoService/ListColdMemos"
+	MemoService_RestoreColdMemo_FullMethodName          = "/memos.api.v1.MemoService/RestoreColdMemo"
+	MemoService_SearchMemos_FullMethodName              = "/memos.api.v1.MemoService/SearchMemos"
+	MemoService_SearchMemosSemantic_FullMethodName      = "/memos.api.v1.MemoService/SearchMemosSemantic"
 )
 
 // MemoServiceClient is the client API for MemoService service.
@@ -48,6 +73,9 @@ type MemoServiceClient interface {
 	ListMemos(ctx context.Context, in *ListMemosRequest, opts ...grpc.CallOption) (*ListMemosResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// GetMemoBySlug gets a memo of a user by its slug, or by a slug it was renamed from.
+	// The slug of the returned memo differs from the requested one in the latter case, so that clients can redirect.
+	GetMemoBySlug(ctx context.Context, in *GetMemoBySlugRequest, opts ...grpc.CallOption) (*Memo, error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(ctx context.Context, in *UpdateMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// DeleteMemo deletes a memo.
@@ -74,6 +102,62 @@ type MemoServiceClient interface {
 	UpsertMemoReaction(ctx context.Context, in *UpsertMemoReactionRequest, opts ...grpc.CallOption) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(ctx context.Context, in *DeleteMemoReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListMemoWebmentions lists the Webmentions of a public memo, the verified responses to it on other sites.
+	ListMemoWebmentions(ctx context.Context, in *ListMemoWebmentionsRequest, opts ...grpc.CallOption) (*ListMemoWebmentionsResponse, error)
+	// DeleteMemoWebmention deletes a Webmention of a memo of the current user.
+	DeleteMemoWebmention(ctx context.Context, in *DeleteMemoWebmentionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListMemosWithBrokenLinks lists the current user's memos that contain broken links.
+	ListMemosWithBrokenLinks(ctx context.Context, in *ListMemosWithBrokenLinksRequest, opts ...grpc.CallOption) (*ListMemosWithBrokenLinksResponse, error)
+	// GetMemoReadState gets the current user's read state of a memo.
+	GetMemoReadState(ctx context.Context, in *GetMemoReadStateRequest, opts ...grpc.CallOption) (*MemoReadState, error)
+	// UpdateMemoReadState updates the current user's read state of a memo.
+	UpdateMemoReadState(ctx context.Context, in *UpdateMemoReadStateRequest, opts ...grpc.CallOption) (*MemoReadState, error)
+	// GetMemoStats returns the view statistics of a memo to its creator.
+	GetMemoStats(ctx context.Context, in *GetMemoStatsRequest, opts ...grpc.CallOption) (*MemoStats, error)
+	// GetCalendarMonth returns the summaries of the days of a month of the memos visible to the current user,
+	// so calendar views do not list the memos of each day.
+	GetCalendarMonth(ctx context.Context, in *GetCalendarMonthRequest, opts ...grpc.CallOption) (*CalendarMonth, error)
+	// GetTimeReport returns the time logged in the "@time" blocks of the memos of the current user,
+	// per tag and week.
+	GetTimeReport(ctx context.Context, in *GetTimeReportRequest, opts ...grpc.CallOption) (*TimeReport, error)
+	// SumProperties returns the sums of the amounts of the memos of the current user, e.g. "$12.50 #food",
+	// per tag, currency and period.
+	SumProperties(ctx context.Context, in *SumPropertiesRequest, opts ...grpc.CallOption) (*SumPropertiesResponse, error)
+	// ListReading lists the memos tracking books or articles visible to the current user, grouped by their
+	// reading status.
+	ListReading(ctx context.Context, in *ListReadingRequest, opts ...grpc.CallOption) (*ListReadingResponse, error)
+	// GetPersonPage returns the page of a person of the current user, aggregating the memos with the person tag,
+	// e.g. "#person/alice", like a lightweight contact manager.
+	GetPersonPage(ctx context.Context, in *GetPersonPageRequest, opts ...grpc.CallOption) (*PersonPage, error)
+	// ListPendingMemos lists the memos awaiting the approval of the admins, for the admins.
+	ListPendingMemos(ctx context.Context, in *ListPendingMemosRequest, opts ...grpc.CallOption) (*ListPendingMemosResponse, error)
+	// ApproveMemo approves a pending memo, giving it its requested visibility. Only for the admins.
+	ApproveMemo(ctx context.Context, in *ApproveMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// RejectMemo rejects a pending memo, keeping it private. Only for the admins.
+	RejectMemo(ctx context.Context, in *RejectMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// EvaluateTaggingRules evaluates the tagging rules of the current user against a memo without saving it, to test
+	// the rules. The rules default to the saved ones.
+	EvaluateTaggingRules(ctx context.Context, in *EvaluateTaggingRulesRequest, opts ...grpc.CallOption) (*EvaluateTaggingRulesResponse, error)
+	// ComputeDiff returns the line and word diff of the content of two memos, e.g. a memo and one of its revisions.
+	ComputeDiff(ctx context.Context, in *ComputeDiffRequest, opts ...grpc.CallOption) (*ComputeDiffResponse, error)
+	// GetMemoSubscription gets the current user's subscription to the comments of a memo.
+	GetMemoSubscription(ctx context.Context, in *GetMemoSubscriptionRequest, opts ...grpc.CallOption) (*MemoSubscription, error)
+	// UpdateMemoSubscription subscribes or unsubscribes the current user to the comments of a memo.
+	UpdateMemoSubscription(ctx context.Context, in *UpdateMemoSubscriptionRequest, opts ...grpc.CallOption) (*MemoSubscription, error)
+	// ListSubscribedMemos lists the memos of other users whose comments the current user subscribed to.
+	ListSubscribedMemos(ctx context.Context, in *ListSubscribedMemosRequest, opts ...grpc.CallOption) (*ListSubscribedMemosResponse, error)
+	// ListUnreadMemos lists the memos shared with the current user that are unread or updated since last read.
+	ListUnreadMemos(ctx context.Context, in *ListUnreadMemosRequest, opts ...grpc.CallOption) (*ListUnreadMemosResponse, error)
+	// ListColdMemos searches the current user's archived memos that were moved to cold storage.
+	ListColdMemos(ctx context.Context, in *ListColdMemosRequest, opts ...grpc.CallOption) (*ListColdMemosResponse, error)
+	// RestoreColdMemo moves a memo from cold storage back into the memo timeline.
+	RestoreColdMemo(ctx context.Context, in *RestoreColdMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// SearchMemos lists the memos visible to the user matching a search query, e.g.
+	// `"road trip" tag:travel after:2025-01-01 has:attachment -draft`.
+	SearchMemos(ctx context.Context, in *SearchMemosRequest, opts ...grpc.CallOption) (*SearchMemosResponse, error)
+	// SearchMemosSemantic finds the memos closest in meaning to the query, using the embeddings of their
+	// content. It requires an AI embedding model to be configured in the workspace settings.
+	SearchMemosSemantic(ctx context.Context, in *SearchMemosSemanticRequest, opts ...grpc.CallOption) (*SearchMemosSemanticResponse, error)
 }
 
 type memoServiceClient struct {
@@ -114,6 +198,16 @@ func (c *memoServiceClient) GetMemo(ctx context.Context, in *GetMemoRequest, opt
 	return out, nil
 }
 
+func (c *memoServiceClient) GetMemoBySlug(ctx context.Context, in *GetMemoBySlugRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) UpdateMemo(ctx context.Context, in *UpdateMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Memo)
@@ -244,6 +338,246 @@ func (c *memoServiceClient) DeleteMemoReaction(ctx context.Context, in *DeleteMe
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoWebmentions(ctx context.Context, in *ListMemoWebmentionsRequest, opts ...grpc.CallOption) (*ListMemoWebmentionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoWebmentionsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoWebmentions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DeleteMemoWebmention(ctx context.Context, in *DeleteMemoWebmentionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_DeleteMemoWebmention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemosWithBrokenLinks(ctx context.Context, in *ListMemosWithBrokenLinksRequest, opts ...grpc.CallOption) (*ListMemosWithBrokenLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemosWithBrokenLinksResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemosWithBrokenLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoReadState(ctx context.Context, in *GetMemoReadStateRequest, opts ...grpc.CallOption) (*MemoReadState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoReadState)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoReadState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) UpdateMemoReadState(ctx context.Context, in *UpdateMemoReadStateRequest, opts ...grpc.CallOption) (*MemoReadState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoReadState)
+	err := c.cc.Invoke(ctx, MemoService_UpdateMemoReadState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoStats(ctx context.Context, in *GetMemoStatsRequest, opts ...grpc.CallOption) (*MemoStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoStats)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetCalendarMonth(ctx context.Context, in *GetCalendarMonthRequest, opts ...grpc.CallOption) (*CalendarMonth, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarMonth)
+	err := c.cc.Invoke(ctx, MemoService_GetCalendarMonth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetTimeReport(ctx context.Context, in *GetTimeReportRequest, opts ...grpc.CallOption) (*TimeReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimeReport)
+	err := c.cc.Invoke(ctx, MemoService_GetTimeReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) SumProperties(ctx context.Context, in *SumPropertiesRequest, opts ...grpc.CallOption) (*SumPropertiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SumPropertiesResponse)
+	err := c.cc.Invoke(ctx, MemoService_SumProperties_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListReading(ctx context.Context, in *ListReadingRequest, opts ...grpc.CallOption) (*ListReadingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReadingResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListReading_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetPersonPage(ctx context.Context, in *GetPersonPageRequest, opts ...grpc.CallOption) (*PersonPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PersonPage)
+	err := c.cc.Invoke(ctx, MemoService_GetPersonPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListPendingMemos(ctx context.Context, in *ListPendingMemosRequest, opts ...grpc.CallOption) (*ListPendingMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListPendingMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ApproveMemo(ctx context.Context, in *ApproveMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_ApproveMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RejectMemo(ctx context.Context, in *RejectMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_RejectMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) EvaluateTaggingRules(ctx context.Context, in *EvaluateTaggingRulesRequest, opts ...grpc.CallOption) (*EvaluateTaggingRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateTaggingRulesResponse)
+	err := c.cc.Invoke(ctx, MemoService_EvaluateTaggingRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ComputeDiff(ctx context.Context, in *ComputeDiffRequest, opts ...grpc.CallOption) (*ComputeDiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ComputeDiffResponse)
+	err := c.cc.Invoke(ctx, MemoService_ComputeDiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoSubscription(ctx context.Context, in *GetMemoSubscriptionRequest, opts ...grpc.CallOption) (*MemoSubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoSubscription)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) UpdateMemoSubscription(ctx context.Context, in *UpdateMemoSubscriptionRequest, opts ...grpc.CallOption) (*MemoSubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoSubscription)
+	err := c.cc.Invoke(ctx, MemoService_UpdateMemoSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListSubscribedMemos(ctx context.Context, in *ListSubscribedMemosRequest, opts ...grpc.CallOption) (*ListSubscribedMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscribedMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListSubscribedMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListUnreadMemos(ctx context.Context, in *ListUnreadMemosRequest, opts ...grpc.CallOption) (*ListUnreadMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUnreadMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListUnreadMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListColdMemos(ctx context.Context, in *ListColdMemosRequest, opts ...grpc.CallOption) (*ListColdMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListColdMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListColdMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RestoreColdMemo(ctx context.Context, in *RestoreColdMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_RestoreColdMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) SearchMemos(ctx context.Context, in *SearchMemosRequest, opts ...grpc.CallOption) (*SearchMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_SearchMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) SearchMemosSemantic(ctx context.Context, in *SearchMemosSemanticRequest, opts ...grpc.CallOption) (*SearchMemosSemanticResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMemosSemanticResponse)
+	err := c.cc.Invoke(ctx, MemoService_SearchMemosSemantic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MemoServiceServer is the server API for MemoService service.
 // All implementations must embed UnimplementedMemoServiceServer
 // for forward compatibility.
@@ -254,6 +588,9 @@ type MemoServiceServer interface {
 	ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *GetMemoRequest) (*Memo, error)
+	// GetMemoBySlug gets a memo of a user by its slug, or by a slug it was renamed from.
+	// The slug of the returned memo differs from the requested one in the latter case, so that clients can redirect.
+	GetMemoBySlug(context.Context, *GetMemoBySlugRequest) (*Memo, error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(context.Context, *UpdateMemoRequest) (*Memo, error)
 	// DeleteMemo deletes a memo.
@@ -280,6 +617,62 @@ type MemoServiceServer interface {
 	UpsertMemoReaction(context.Context, *UpsertMemoReactionRequest) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error)
+	// ListMemoWebmentions lists the Webmentions of a public memo, the verified responses to it on other sites.
+	ListMemoWebmentions(context.Context, *ListMemoWebmentionsRequest) (*ListMemoWebmentionsResponse, error)
+	// DeleteMemoWebmention deletes a Webmention of a memo of the current user.
+	DeleteMemoWebmention(context.Context, *DeleteMemoWebmentionRequest) (*emptypb.Empty, error)
+	// ListMemosWithBrokenLinks lists the current user's memos that contain broken links.
+	ListMemosWithBrokenLinks(context.Context, *ListMemosWithBrokenLinksRequest) (*ListMemosWithBrokenLinksResponse, error)
+	// GetMemoReadState gets the current user's read state of a memo.
+	GetMemoReadState(context.Context, *GetMemoReadStateRequest) (*MemoReadState, error)
+	// UpdateMemoReadState updates the current user's read state of a memo.
+	UpdateMemoReadState(context.Context, *UpdateMemoReadStateRequest) (*MemoReadState, error)
+	// GetMemoStats returns the view statistics of a memo to its creator.
+	GetMemoStats(context.Context, *GetMemoStatsRequest) (*MemoStats, error)
+	// GetCalendarMonth returns the summaries of the days of a month of the memos visible to the current user,
+	// so calendar views do not list the memos of each day.
+	GetCalendarMonth(context.Context, *GetCalendarMonthRequest) (*CalendarMonth, error)
+	// GetTimeReport returns the time logged in the "@time" blocks of the memos of the current user,
+	// per tag and week.
+	GetTimeReport(context.Context, *GetTimeReportRequest) (*TimeReport, error)
+	// SumProperties returns the sums of the amounts of the memos of the current user, e.g. "$12.50 #food",
+	// per tag, currency and period.
+	SumProperties(context.Context, *SumPropertiesRequest) (*SumPropertiesResponse, error)
+	// ListReading lists the memos tracking books or articles visible to the current user, grouped by their
+	// reading status.
+	ListReading(context.Context, *ListReadingRequest) (*ListReadingResponse, error)
+	// GetPersonPage returns the page of a person of the current user, aggregating the memos with the person tag,
+	// e.g. "#person/alice", like a lightweight contact manager.
+	GetPersonPage(context.Context, *GetPersonPageRequest) (*PersonPage, error)
+	// ListPendingMemos lists the memos awaiting the approval of the admins, for the admins.
+	ListPendingMemos(context.Context, *ListPendingMemosRequest) (*ListPendingMemosResponse, error)
+	// ApproveMemo approves a pending memo, giving it its requested visibility. Only for the admins.
+	ApproveMemo(context.Context, *ApproveMemoRequest) (*Memo, error)
+	// RejectMemo rejects a pending memo, keeping it private. Only for the admins.
+	RejectMemo(context.Context, *RejectMemoRequest) (*Memo, error)
+	// EvaluateTaggingRules evaluates the tagging rules of the current user against a memo without saving it, to test
+	// the rules. The rules default to the saved ones.
+	EvaluateTaggingRules(context.Context, *EvaluateTaggingRulesRequest) (*EvaluateTaggingRulesResponse, error)
+	// ComputeDiff returns the line and word diff of the content of two memos, e.g. a memo and one of its revisions.
+	ComputeDiff(context.Context, *ComputeDiffRequest) (*ComputeDiffResponse, error)
+	// GetMemoSubscription gets the current user's subscription to the comments of a memo.
+	GetMemoSubscription(context.Context, *GetMemoSubscriptionRequest) (*MemoSubscription, error)
+	// UpdateMemoSubscription subscribes or unsubscribes the current user to the comments of a memo.
+	UpdateMemoSubscription(context.Context, *UpdateMemoSubscriptionRequest) (*MemoSubscription, error)
+	// ListSubscribedMemos lists the memos of other users whose comments the current user subscribed to.
+	ListSubscribedMemos(context.Context, *ListSubscribedMemosRequest) (*ListSubscribedMemosResponse, error)
+	// ListUnreadMemos lists the memos shared with the current user that are unread or updated since last read.
+	ListUnreadMemos(context.Context, *ListUnreadMemosRequest) (*ListUnreadMemosResponse, error)
+	// ListColdMemos searches the current user's archived memos that were moved to cold storage.
+	ListColdMemos(context.Context, *ListColdMemosRequest) (*ListColdMemosResponse, error)
+	// RestoreColdMemo moves a memo from cold storage back into the memo timeline.
+	RestoreColdMemo(context.Context, *RestoreColdMemoRequest) (*Memo, error)
+	// SearchMemos lists the memos visible to the user matching a search query, e.g.
+	// `"road trip" tag:travel after:2025-01-01 has:attachment -draft`.
+	SearchMemos(context.Context, *SearchMemosRequest) (*SearchMemosResponse, error)
+	// SearchMemosSemantic finds the memos closest in meaning to the query, using the embeddings of their
+	// content. It requires an AI embedding model to be configured in the workspace settings.
+	SearchMemosSemantic(context.Context, *SearchMemosSemanticRequest) (*SearchMemosSemanticResponse, error)
 	mustEmbedUnimplementedMemoServiceServer()
 }
 
@@ -299,6 +692,9 @@ func (UnimplementedMemoServiceServer) ListMemos(context.Context, *ListMemosReque
 func (UnimplementedMemoServiceServer) GetMemo(context.Context, *GetMemoRequest) (*Memo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMemo not implemented")
 }
+func (UnimplementedMemoServiceServer) GetMemoBySlug(context.Context, *GetMemoBySlugRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoBySlug not implemented")
+}
 func (UnimplementedMemoServiceServer) UpdateMemo(context.Context, *UpdateMemoRequest) (*Memo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemo not implemented")
 }
@@ -338,6 +734,78 @@ func (UnimplementedMemoServiceServer) UpsertMemoReaction(context.Context, *Upser
 func (UnimplementedMemoServiceServer) DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemoReaction not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoWebmentions(context.Context, *ListMemoWebmentionsRequest) (*ListMemoWebmentionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoWebmentions not implemented")
+}
+func (UnimplementedMemoServiceServer) DeleteMemoWebmention(context.Context, *DeleteMemoWebmentionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemoWebmention not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemosWithBrokenLinks(context.Context, *ListMemosWithBrokenLinksRequest) (*ListMemosWithBrokenLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemosWithBrokenLinks not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoReadState(context.Context, *GetMemoReadStateRequest) (*MemoReadState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoReadState not implemented")
+}
+func (UnimplementedMemoServiceServer) UpdateMemoReadState(context.Context, *UpdateMemoReadStateRequest) (*MemoReadState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemoReadState not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoStats(context.Context, *GetMemoStatsRequest) (*MemoStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoStats not implemented")
+}
+func (UnimplementedMemoServiceServer) GetCalendarMonth(context.Context, *GetCalendarMonthRequest) (*CalendarMonth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarMonth not implemented")
+}
+func (UnimplementedMemoServiceServer) GetTimeReport(context.Context, *GetTimeReportRequest) (*TimeReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeReport not implemented")
+}
+func (UnimplementedMemoServiceServer) SumProperties(context.Context, *SumPropertiesRequest) (*SumPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SumProperties not implemented")
+}
+func (UnimplementedMemoServiceServer) ListReading(context.Context, *ListReadingRequest) (*ListReadingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReading not implemented")
+}
+func (UnimplementedMemoServiceServer) GetPersonPage(context.Context, *GetPersonPageRequest) (*PersonPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersonPage not implemented")
+}
+func (UnimplementedMemoServiceServer) ListPendingMemos(context.Context, *ListPendingMemosRequest) (*ListPendingMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) ApproveMemo(context.Context, *ApproveMemoRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) RejectMemo(context.Context, *RejectMemoRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) EvaluateTaggingRules(context.Context, *EvaluateTaggingRulesRequest) (*EvaluateTaggingRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateTaggingRules not implemented")
+}
+func (UnimplementedMemoServiceServer) ComputeDiff(context.Context, *ComputeDiffRequest) (*ComputeDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeDiff not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoSubscription(context.Context, *GetMemoSubscriptionRequest) (*MemoSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoSubscription not implemented")
+}
+func (UnimplementedMemoServiceServer) UpdateMemoSubscription(context.Context, *UpdateMemoSubscriptionRequest) (*MemoSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemoSubscription not implemented")
+}
+func (UnimplementedMemoServiceServer) ListSubscribedMemos(context.Context, *ListSubscribedMemosRequest) (*ListSubscribedMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscribedMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) ListUnreadMemos(context.Context, *ListUnreadMemosRequest) (*ListUnreadMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnreadMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) ListColdMemos(context.Context, *ListColdMemosRequest) (*ListColdMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListColdMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) RestoreColdMemo(context.Context, *RestoreColdMemoRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreColdMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) SearchMemos(context.Context, *SearchMemosRequest) (*SearchMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) SearchMemosSemantic(context.Context, *SearchMemosSemanticRequest) (*SearchMemosSemanticResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMemosSemantic not implemented")
+}
 func (UnimplementedMemoServiceServer) mustEmbedUnimplementedMemoServiceServer() {}
 func (UnimplementedMemoServiceServer) testEmbeddedByValue()                     {}
 
@@ -413,6 +881,24 @@ func _MemoService_GetMemo_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_GetMemoBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoBySlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoBySlug(ctx, req.(*GetMemoBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_UpdateMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateMemoRequest)
 	if err := dec(in); err != nil {
@@ -647,44 +1133,480 @@ func _MemoService_DeleteMemoReaction_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-// MemoService_ServiceDesc is the grpc.ServiceDesc for MemoService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var MemoService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "memos.api.v1.MemoService",
-	HandlerType: (*MemoServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateMemo",
-			Handler:    _MemoService_CreateMemo_Handler,
-		},
-		{
-			MethodName: "ListMemos",
-			Handler:    _MemoService_ListMemos_Handler,
-		},
-		{
-			MethodName: "GetMemo",
-			Handler:    _MemoService_GetMemo_Handler,
-		},
-		{
-			MethodName: "UpdateMemo",
-			Handler:    _MemoService_UpdateMemo_Handler,
-		},
-		{
-			MethodName: "DeleteMemo",
-			Handler:    _MemoService_DeleteMemo_Handler,
-		},
-		{
-			MethodName: "RenameMemoTag",
-			Handler:    _MemoService_RenameMemoTag_Handler,
-		},
-		{
-			MethodName: "DeleteMemoTag",
-			Handler:    _MemoService_DeleteMemoTag_Handler,
-		},
-		{
-			MethodName: "SetMemoAttachments",
-			Handler:    _MemoService_SetMemoAttachments_Handler,
+func _MemoService_ListMemoWebmentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoWebmentionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoWebmentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoWebmentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoWebmentions(ctx, req.(*ListMemoWebmentionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DeleteMemoWebmention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoWebmentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DeleteMemoWebmention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DeleteMemoWebmention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DeleteMemoWebmention(ctx, req.(*DeleteMemoWebmentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemosWithBrokenLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemosWithBrokenLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemosWithBrokenLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemosWithBrokenLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemosWithBrokenLinks(ctx, req.(*ListMemosWithBrokenLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoReadState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoReadStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoReadState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoReadState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoReadState(ctx, req.(*GetMemoReadStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_UpdateMemoReadState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemoReadStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).UpdateMemoReadState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_UpdateMemoReadState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).UpdateMemoReadState(ctx, req.(*UpdateMemoReadStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoStats(ctx, req.(*GetMemoStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetCalendarMonth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarMonthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetCalendarMonth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetCalendarMonth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetCalendarMonth(ctx, req.(*GetCalendarMonthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetTimeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetTimeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetTimeReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetTimeReport(ctx, req.(*GetTimeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SumProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SumPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SumProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SumProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SumProperties(ctx, req.(*SumPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListReading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReadingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListReading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListReading_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListReading(ctx, req.(*ListReadingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetPersonPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetPersonPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetPersonPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetPersonPage(ctx, req.(*GetPersonPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListPendingMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListPendingMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListPendingMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListPendingMemos(ctx, req.(*ListPendingMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ApproveMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ApproveMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ApproveMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ApproveMemo(ctx, req.(*ApproveMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RejectMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RejectMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RejectMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RejectMemo(ctx, req.(*RejectMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_EvaluateTaggingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateTaggingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).EvaluateTaggingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_EvaluateTaggingRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).EvaluateTaggingRules(ctx, req.(*EvaluateTaggingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ComputeDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComputeDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ComputeDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ComputeDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ComputeDiff(ctx, req.(*ComputeDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoSubscription(ctx, req.(*GetMemoSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_UpdateMemoSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemoSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).UpdateMemoSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_UpdateMemoSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).UpdateMemoSubscription(ctx, req.(*UpdateMemoSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListSubscribedMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscribedMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListSubscribedMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListSubscribedMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListSubscribedMemos(ctx, req.(*ListSubscribedMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListUnreadMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnreadMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListUnreadMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListUnreadMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListUnreadMemos(ctx, req.(*ListUnreadMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListColdMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListColdMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListColdMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListColdMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListColdMemos(ctx, req.(*ListColdMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RestoreColdMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreColdMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RestoreColdMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RestoreColdMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RestoreColdMemo(ctx, req.(*RestoreColdMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SearchMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SearchMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SearchMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SearchMemos(ctx, req.(*SearchMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SearchMemosSemantic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMemosSemanticRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SearchMemosSemantic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SearchMemosSemantic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SearchMemosSemantic(ctx, req.(*SearchMemosSemanticRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemoService_ServiceDesc is the grpc.ServiceDesc for MemoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.MemoService",
+	HandlerType: (*MemoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMemo",
+			Handler:    _MemoService_CreateMemo_Handler,
+		},
+		{
+			MethodName: "ListMemos",
+			Handler:    _MemoService_ListMemos_Handler,
+		},
+		{
+			MethodName: "GetMemo",
+			Handler:    _MemoService_GetMemo_Handler,
+		},
+		{
+			MethodName: "GetMemoBySlug",
+			Handler:    _MemoService_GetMemoBySlug_Handler,
+		},
+		{
+			MethodName: "UpdateMemo",
+			Handler:    _MemoService_UpdateMemo_Handler,
+		},
+		{
+			MethodName: "DeleteMemo",
+			Handler:    _MemoService_DeleteMemo_Handler,
+		},
+		{
+			MethodName: "RenameMemoTag",
+			Handler:    _MemoService_RenameMemoTag_Handler,
+		},
+		{
+			MethodName: "DeleteMemoTag",
+			Handler:    _MemoService_DeleteMemoTag_Handler,
+		},
+		{
+			MethodName: "SetMemoAttachments",
+			Handler:    _MemoService_SetMemoAttachments_Handler,
 		},
 		{
 			MethodName: "ListMemoAttachments",
@@ -718,6 +1640,102 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMemoReaction",
 			Handler:    _MemoService_DeleteMemoReaction_Handler,
 		},
+		{
+			MethodName: "ListMemoWebmentions",
+			Handler:    _MemoService_ListMemoWebmentions_Handler,
+		},
+		{
+			MethodName: "DeleteMemoWebmention",
+			Handler:    _MemoService_DeleteMemoWebmention_Handler,
+		},
+		{
+			MethodName: "ListMemosWithBrokenLinks",
+			Handler:    _MemoService_ListMemosWithBrokenLinks_Handler,
+		},
+		{
+			MethodName: "GetMemoReadState",
+			Handler:    _MemoService_GetMemoReadState_Handler,
+		},
+		{
+			MethodName: "UpdateMemoReadState",
+			Handler:    _MemoService_UpdateMemoReadState_Handler,
+		},
+		{
+			MethodName: "GetMemoStats",
+			Handler:    _MemoService_GetMemoStats_Handler,
+		},
+		{
+			MethodName: "GetCalendarMonth",
+			Handler:    _MemoService_GetCalendarMonth_Handler,
+		},
+		{
+			MethodName: "GetTimeReport",
+			Handler:    _MemoService_GetTimeReport_Handler,
+		},
+		{
+			MethodName: "SumProperties",
+			Handler:    _MemoService_SumProperties_Handler,
+		},
+		{
+			MethodName: "ListReading",
+			Handler:    _MemoService_ListReading_Handler,
+		},
+		{
+			MethodName: "GetPersonPage",
+			Handler:    _MemoService_GetPersonPage_Handler,
+		},
+		{
+			MethodName: "ListPendingMemos",
+			Handler:    _MemoService_ListPendingMemos_Handler,
+		},
+		{
+			MethodName: "ApproveMemo",
+			Handler:    _MemoService_ApproveMemo_Handler,
+		},
+		{
+			MethodName: "RejectMemo",
+			Handler:    _MemoService_RejectMemo_Handler,
+		},
+		{
+			MethodName: "EvaluateTaggingRules",
+			Handler:    _MemoService_EvaluateTaggingRules_Handler,
+		},
+		{
+			MethodName: "ComputeDiff",
+			Handler:    _MemoService_ComputeDiff_Handler,
+		},
+		{
+			MethodName: "GetMemoSubscription",
+			Handler:    _MemoService_GetMemoSubscription_Handler,
+		},
+		{
+			MethodName: "UpdateMemoSubscription",
+			Handler:    _MemoService_UpdateMemoSubscription_Handler,
+		},
+		{
+			MethodName: "ListSubscribedMemos",
+			Handler:    _MemoService_ListSubscribedMemos_Handler,
+		},
+		{
+			MethodName: "ListUnreadMemos",
+			Handler:    _MemoService_ListUnreadMemos_Handler,
+		},
+		{
+			MethodName: "ListColdMemos",
+			Handler:    _MemoService_ListColdMemos_Handler,
+		},
+		{
+			MethodName: "RestoreColdMemo",
+			Handler:    _MemoService_RestoreColdMemo_Handler,
+		},
+		{
+			MethodName: "SearchMemos",
+			Handler:    _MemoService_SearchMemos_Handler,
+		},
+		{
+			MethodName: "SearchMemosSemantic",
+			Handler:    _MemoService_SearchMemosSemantic_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/memo_service.proto",
@@ -0,0 +1,717 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/v1/syndication_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SyndicationSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the subscription.
+	// Format: workspace/syndicationSubscriptions/{syndication_subscription}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The base URL of the other instance, e.g. "https://memos.example.com".
+	InstanceUrl string `protobuf:"bytes,2,opt,name=instance_url,json=instanceUrl,proto3" json:"instance_url,omitempty"`
+	// The users of the other instance whose public memos are mirrored, e.g. "users/1".
+	// All the users when empty.
+	Creators []string `protobuf:"bytes,3,rep,name=creators,proto3" json:"creators,omitempty"`
+	// The tags of the mirrored memos, including their subtags, without the leading "#".
+	// All the public memos when empty.
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The user who created the subscription.
+	// Format: users/{user}
+	Creator    string                 `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time of the last sync, unset before the first one.
+	LastSyncTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_sync_time,json=lastSyncTime,proto3" json:"last_sync_time,omitempty"`
+	// The error of the last sync, empty when it succeeded.
+	LastError     string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyndicationSubscription) Reset() {
+	*x = SyndicationSubscription{}
+	mi := &file_api_v1_syndication_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyndicationSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyndicationSubscription) ProtoMessage() {}
+
+func (x *SyndicationSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_syndication_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyndicationSubscription.ProtoReflect.Descriptor instead.
+func (*SyndicationSubscription) Descriptor() ([]byte, []int) {
+	return file_api_v1_syndication_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SyndicationSubscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SyndicationSubscription) GetInstanceUrl() string {
+	if x != nil {
+		return x.InstanceUrl
+	}
+	return ""
+}
+
+func (x *SyndicationSubscription) GetCreators() []string {
+	if x != nil {
+		return x.Creators
+	}
+	return nil
+}
+
+func (x *SyndicationSubscription) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SyndicationSubscription) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *SyndicationSubscription) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *SyndicationSubscription) GetLastSyncTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncTime
+	}
+	return nil
+}
+
+func (x *SyndicationSubscription) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type ListSyndicationSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyndicationSubscriptionsRequest) Reset() {
+	*x = ListSyndicationSubscriptionsRequest{}
+	mi := &file_api_v1_syndication_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyndicationSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyndicationSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSyndicationSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_syndication_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyndicationSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSyndicationSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_syndication_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ListSyndicationSubscriptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The subscriptions, the oldest first.
+	SyndicationSubscriptions []*SyndicationSubscription `protobuf:"bytes,1,rep,name=syndication_subscriptions,json=syndicationSubscriptions,proto3" json:"syndication_subscriptions,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ListSyndicationSubscriptionsResponse) Reset() {
+	*x = ListSyndicationSubscriptionsResponse{}
+	mi := &file_api_v1_syndication_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyndicationSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyndicationSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSyndicationSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_syndication_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyndicationSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSyndicationSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_syndication_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSyndicationSubscriptionsResponse) GetSyndicationSubscriptions() []*SyndicationSubscription {
+	if x != nil {
+		return x.SyndicationSubscriptions
+	}
+	return nil
+}
+
+type CreateSyndicationSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The subscription to create.
+	SyndicationSubscription *SyndicationSubscription `protobuf:"bytes,1,opt,name=syndication_subscription,json=syndicationSubscription,proto3" json:"syndication_subscription,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreateSyndicationSubscriptionRequest) Reset() {
+	*x = CreateSyndicationSubscriptionRequest{}
+	mi := &file_api_v1_syndication_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSyndicationSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSyndicationSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateSyndicationSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_syndication_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSyndicationSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSyndicationSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_syndication_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSyndicationSubscriptionRequest) GetSyndicationSubscription() *SyndicationSubscription {
+	if x != nil {
+		return x.SyndicationSubscription
+	}
+	return nil
+}
+
+type DeleteSyndicationSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the subscription to delete.
+	// Format: workspace/syndicationSubscriptions/{syndication_subscription}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSyndicationSubscriptionRequest) Reset() {
+	*x = DeleteSyndicationSubscriptionRequest{}
+	mi := &file_api_v1_syndication_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSyndicationSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSyndicationSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteSyndicationSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_syndication_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSyndicationSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSyndicationSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_syndication_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteSyndicationSubscriptionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// FederatedMemo is a public memo of another instance mirrored by a subscription. It is read-only.
+type FederatedMemo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the federated memo.
+	// Format: federatedMemos/{federated_memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The subscription mirroring the memo.
+	// Format: workspace/syndicationSubscriptions/{syndication_subscription}
+	Subscription string `protobuf:"bytes,2,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// The base URL of the origin instance of the memo.
+	InstanceUrl string `protobuf:"bytes,3,opt,name=instance_url,json=instanceUrl,proto3" json:"instance_url,omitempty"`
+	// The resource name of the memo on its origin instance, e.g. "memos/abc".
+	OriginName string `protobuf:"bytes,4,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
+	// The URL of the memo on its origin instance.
+	OriginUrl string `protobuf:"bytes,5,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`
+	// The resource name of the creator on the origin instance, e.g. "users/1".
+	Creator string `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The username of the creator on the origin instance.
+	CreatorUsername string `protobuf:"bytes,7,opt,name=creator_username,json=creatorUsername,proto3" json:"creator_username,omitempty"`
+	// The display name of the creator on the origin instance.
+	CreatorDisplayName string   `protobuf:"bytes,8,opt,name=creator_display_name,json=creatorDisplayName,proto3" json:"creator_display_name,omitempty"`
+	Content            string   `protobuf:"bytes,9,opt,name=content,proto3" json:"content,omitempty"`
+	Tags               []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The times of the memo on its origin instance.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// The time of the last sync the memo was seen in.
+	SyncTime      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=sync_time,json=syncTime,proto3" json:"sync_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FederatedMemo) Reset() {
+	*x = FederatedMemo{}
+	mi := &file_api_v1_syndication_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FederatedMemo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedMemo) ProtoMessage() {}
+
+func (x *FederatedMemo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_syndication_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedMemo.ProtoReflect.Descriptor instead.
+func (*FederatedMemo) Descriptor() ([]byte, []int) {
+	return file_api_v1_syndication_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FederatedMemo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FederatedMemo) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *FederatedMemo) GetInstanceUrl() string {
+	if x != nil {
+		return x.InstanceUrl
+	}
+	return ""
+}
+
+func (x *FederatedMemo) GetOriginName() string {
+	if x != nil {
+		return x.OriginName
+	}
+	return ""
+}
+
+func (x *FederatedMemo) GetOriginUrl() string {
+	if x != nil {
+		return x.OriginUrl
+	}
+	return ""
+}
+
+func (x *FederatedMemo) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *FederatedMemo) GetCreatorUsername() string {
+	if x != nil {
+		return x.CreatorUsername
+	}
+	return ""
+}
+
+func (x *FederatedMemo) GetCreatorDisplayName() string {
+	if x != nil {
+		return x.CreatorDisplayName
+	}
+	return ""
+}
+
+func (x *FederatedMemo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *FederatedMemo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *FederatedMemo) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *FederatedMemo) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *FederatedMemo) GetSyncTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SyncTime
+	}
+	return nil
+}
+
+type ListFederatedMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of federated memos to return.
+	// If unspecified, at most 50 federated memos will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListFederatedMemos` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Only lists the memos mirrored by the subscription.
+	// Format: workspace/syndicationSubscriptions/{syndication_subscription}
+	Subscription  string `protobuf:"bytes,3,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFederatedMemosRequest) Reset() {
+	*x = ListFederatedMemosRequest{}
+	mi := &file_api_v1_syndication_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFederatedMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFederatedMemosRequest) ProtoMessage() {}
+
+func (x *ListFederatedMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_syndication_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFederatedMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListFederatedMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_syndication_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListFederatedMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFederatedMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListFederatedMemosRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+type ListFederatedMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The federated memos, the ones created last on their origin first.
+	FederatedMemos []*FederatedMemo `protobuf:"bytes,1,rep,name=federated_memos,json=federatedMemos,proto3" json:"federated_memos,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFederatedMemosResponse) Reset() {
+	*x = ListFederatedMemosResponse{}
+	mi := &file_api_v1_syndication_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFederatedMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFederatedMemosResponse) ProtoMessage() {}
+
+func (x *ListFederatedMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_syndication_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFederatedMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListFederatedMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_syndication_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListFederatedMemosResponse) GetFederatedMemos() []*FederatedMemo {
+	if x != nil {
+		return x.FederatedMemos
+	}
+	return nil
+}
+
+func (x *ListFederatedMemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_api_v1_syndication_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_syndication_service_proto_rawDesc = "" +
+	"\n" +
+	" api/v1/syndication_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xff\x03\n" +
+	"\x17SyndicationSubscription\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12&\n" +
+	"\finstance_url\x18\x02 \x01(\tB\x03\xe0A\x02R\vinstanceUrl\x12\x1f\n" +
+	"\bcreators\x18\x03 \x03(\tB\x03\xe0A\x01R\bcreators\x12\x17\n" +
+	"\x04tags\x18\x04 \x03(\tB\x03\xe0A\x01R\x04tags\x12\x1d\n" +
+	"\acreator\x18\x05 \x01(\tB\x03\xe0A\x03R\acreator\x12@\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12E\n" +
+	"\x0elast_sync_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\flastSyncTime\x12\"\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tB\x03\xe0A\x03R\tlastError:\x9c\x01\xeaA\x98\x01\n" +
+	"$memos.api.v1/SyndicationSubscription\x12=workspace/syndicationSubscriptions/{syndication_subscription}*\x18syndicationSubscriptions2\x17syndicationSubscription\"%\n" +
+	"#ListSyndicationSubscriptionsRequest\"\x8a\x01\n" +
+	"$ListSyndicationSubscriptionsResponse\x12b\n" +
+	"\x19syndication_subscriptions\x18\x01 \x03(\v2%.memos.api.v1.SyndicationSubscriptionR\x18syndicationSubscriptions\"\x8d\x01\n" +
+	"$CreateSyndicationSubscriptionRequest\x12e\n" +
+	"\x18syndication_subscription\x18\x01 \x01(\v2%.memos.api.v1.SyndicationSubscriptionB\x03\xe0A\x02R\x17syndicationSubscription\"h\n" +
+	"$DeleteSyndicationSubscriptionRequest\x12@\n" +
+	"\x04name\x18\x01 \x01(\tB,\xe0A\x02\xfaA&\n" +
+	"$memos.api.v1/SyndicationSubscriptionR\x04name\"\xa4\x05\n" +
+	"\rFederatedMemo\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12'\n" +
+	"\fsubscription\x18\x02 \x01(\tB\x03\xe0A\x03R\fsubscription\x12&\n" +
+	"\finstance_url\x18\x03 \x01(\tB\x03\xe0A\x03R\vinstanceUrl\x12$\n" +
+	"\vorigin_name\x18\x04 \x01(\tB\x03\xe0A\x03R\n" +
+	"originName\x12\"\n" +
+	"\n" +
+	"origin_url\x18\x05 \x01(\tB\x03\xe0A\x03R\toriginUrl\x12\x1d\n" +
+	"\acreator\x18\x06 \x01(\tB\x03\xe0A\x03R\acreator\x12.\n" +
+	"\x10creator_username\x18\a \x01(\tB\x03\xe0A\x03R\x0fcreatorUsername\x125\n" +
+	"\x14creator_display_name\x18\b \x01(\tB\x03\xe0A\x03R\x12creatorDisplayName\x12\x1d\n" +
+	"\acontent\x18\t \x01(\tB\x03\xe0A\x03R\acontent\x12\x17\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tB\x03\xe0A\x03R\x04tags\x12@\n" +
+	"\vcreate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x12<\n" +
+	"\tsync_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\bsyncTime:_\xeaA\\\n" +
+	"\x1amemos.api.v1/FederatedMemo\x12\x1ffederatedMemos/{federated_memo}*\x0efederatedMemos2\rfederatedMemo\"\xb3\x01\n" +
+	"\x19ListFederatedMemosRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12P\n" +
+	"\fsubscription\x18\x03 \x01(\tB,\xe0A\x01\xfaA&\n" +
+	"$memos.api.v1/SyndicationSubscriptionR\fsubscription\"\x8a\x01\n" +
+	"\x1aListFederatedMemosResponse\x12D\n" +
+	"\x0ffederated_memos\x18\x01 \x03(\v2\x1b.memos.api.v1.FederatedMemoR\x0efederatedMemos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xf2\x05\n" +
+	"\x12SyndicationService\x12\xb9\x01\n" +
+	"\x1cListSyndicationSubscriptions\x121.memos.api.v1.ListSyndicationSubscriptionsRequest\x1a2.memos.api.v1.ListSyndicationSubscriptionsResponse\"2\x82\xd3\xe4\x93\x02,\x12*/api/v1/workspace/syndicationSubscriptions\x12\xe3\x01\n" +
+	"\x1dCreateSyndicationSubscription\x122.memos.api.v1.CreateSyndicationSubscriptionRequest\x1a%.memos.api.v1.SyndicationSubscription\"g\xdaA\x18syndication_subscription\x82\xd3\xe4\x93\x02F:\x18syndication_subscription\"*/api/v1/workspace/syndicationSubscriptions\x12\xaf\x01\n" +
+	"\x1dDeleteSyndicationSubscription\x122.memos.api.v1.DeleteSyndicationSubscriptionRequest\x1a\x16.google.protobuf.Empty\"B\xdaA\x04name\x82\xd3\xe4\x93\x025*3/api/v1/{name=workspace/syndicationSubscriptions/*}\x12\x87\x01\n" +
+	"\x12ListFederatedMemos\x12'.memos.api.v1.ListFederatedMemosRequest\x1a(.memos.api.v1.ListFederatedMemosResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/federatedMemosB\xaf\x01\n" +
+	"\x10com.memos.api.v1B\x17SyndicationServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_syndication_service_proto_rawDescOnce sync.Once
+	file_api_v1_syndication_service_proto_rawDescData []byte
+)
+
+func file_api_v1_syndication_service_proto_rawDescGZIP() []byte {
+	file_api_v1_syndication_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_syndication_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_syndication_service_proto_rawDesc), len(file_api_v1_syndication_service_proto_rawDesc)))
+	})
+	return file_api_v1_syndication_service_proto_rawDescData
+}
+
+var file_api_v1_syndication_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_v1_syndication_service_proto_goTypes = []any{
+	(*SyndicationSubscription)(nil),              // 0: memos.api.v1.SyndicationSubscription
+	(*ListSyndicationSubscriptionsRequest)(nil),  // 1: memos.api.v1.ListSyndicationSubscriptionsRequest
+	(*ListSyndicationSubscriptionsResponse)(nil), // 2: memos.api.v1.ListSyndicationSubscriptionsResponse
+	(*CreateSyndicationSubscriptionRequest)(nil), // 3: memos.api.v1.CreateSyndicationSubscriptionRequest
+	(*DeleteSyndicationSubscriptionRequest)(nil), // 4: memos.api.v1.DeleteSyndicationSubscriptionRequest
+	(*FederatedMemo)(nil),                        // 5: memos.api.v1.FederatedMemo
+	(*ListFederatedMemosRequest)(nil),            // 6: memos.api.v1.ListFederatedMemosRequest
+	(*ListFederatedMemosResponse)(nil),           // 7: memos.api.v1.ListFederatedMemosResponse
+	(*timestamppb.Timestamp)(nil),                // 8: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                        // 9: google.protobuf.Empty
+}
+var file_api_v1_syndication_service_proto_depIdxs = []int32{
+	8,  // 0: memos.api.v1.SyndicationSubscription.create_time:type_name -> google.protobuf.Timestamp
+	8,  // 1: memos.api.v1.SyndicationSubscription.last_sync_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: memos.api.v1.ListSyndicationSubscriptionsResponse.syndication_subscriptions:type_name -> memos.api.v1.SyndicationSubscription
+	0,  // 3: memos.api.v1.CreateSyndicationSubscriptionRequest.syndication_subscription:type_name -> memos.api.v1.SyndicationSubscription
+	8,  // 4: memos.api.v1.FederatedMemo.create_time:type_name -> google.protobuf.Timestamp
+	8,  // 5: memos.api.v1.FederatedMemo.update_time:type_name -> google.protobuf.Timestamp
+	8,  // 6: memos.api.v1.FederatedMemo.sync_time:type_name -> google.protobuf.Timestamp
+	5,  // 7: memos.api.v1.ListFederatedMemosResponse.federated_memos:type_name -> memos.api.v1.FederatedMemo
+	1,  // 8: memos.api.v1.SyndicationService.ListSyndicationSubscriptions:input_type -> memos.api.v1.ListSyndicationSubscriptionsRequest
+	3,  // 9: memos.api.v1.SyndicationService.CreateSyndicationSubscription:input_type -> memos.api.v1.CreateSyndicationSubscriptionRequest
+	4,  // 10: memos.api.v1.SyndicationService.DeleteSyndicationSubscription:input_type -> memos.api.v1.DeleteSyndicationSubscriptionRequest
+	6,  // 11: memos.api.v1.SyndicationService.ListFederatedMemos:input_type -> memos.api.v1.ListFederatedMemosRequest
+	2,  // 12: memos.api.v1.SyndicationService.ListSyndicationSubscriptions:output_type -> memos.api.v1.ListSyndicationSubscriptionsResponse
+	0,  // 13: memos.api.v1.SyndicationService.CreateSyndicationSubscription:output_type -> memos.api.v1.SyndicationSubscription
+	9,  // 14: memos.api.v1.SyndicationService.DeleteSyndicationSubscription:output_type -> google.protobuf.Empty
+	7,  // 15: memos.api.v1.SyndicationService.ListFederatedMemos:output_type -> memos.api.v1.ListFederatedMemosResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_syndication_service_proto_init() }
+func file_api_v1_syndication_service_proto_init() {
+	if File_api_v1_syndication_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_syndication_service_proto_rawDesc), len(file_api_v1_syndication_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_syndication_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_syndication_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_syndication_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_syndication_service_proto = out.File
+	file_api_v1_syndication_service_proto_goTypes = nil
+	file_api_v1_syndication_service_proto_depIdxs = nil
+}
@@ -0,0 +1,252 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/v1/syndication_service.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SyndicationService_ListSyndicationSubscriptions_FullMethodName  = "/memos.api.v1.SyndicationService/ListSyndicationSubscriptions"
+	SyndicationService_CreateSyndicationSubscription_FullMethodName = "/memos.api.v1.SyndicationService/CreateSyndicationSubscription"
+	SyndicationService_DeleteSyndicationSubscription_FullMethodName = "/memos.api.v1.SyndicationService/DeleteSyndicationSubscription"
+	SyndicationService_ListFederatedMemos_FullMethodName            = "/memos.api.v1.SyndicationService/ListFederatedMemos"
+)
+
+// SyndicationServiceClient is the client API for SyndicationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SyndicationService mirrors the public memos of other instances into a read-only federated section.
+type SyndicationServiceClient interface {
+	// ListSyndicationSubscriptions lists the subscriptions of the workspace to other instances. Admin only.
+	ListSyndicationSubscriptions(ctx context.Context, in *ListSyndicationSubscriptionsRequest, opts ...grpc.CallOption) (*ListSyndicationSubscriptionsResponse, error)
+	// CreateSyndicationSubscription subscribes the workspace to the public memos of another instance and syncs them
+	// right away. Admin only.
+	CreateSyndicationSubscription(ctx context.Context, in *CreateSyndicationSubscriptionRequest, opts ...grpc.CallOption) (*SyndicationSubscription, error)
+	// DeleteSyndicationSubscription unsubscribes the workspace and deletes the memos mirrored by the subscription.
+	// Admin only.
+	DeleteSyndicationSubscription(ctx context.Context, in *DeleteSyndicationSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListFederatedMemos lists the memos mirrored from the other instances, the ones created last first.
+	ListFederatedMemos(ctx context.Context, in *ListFederatedMemosRequest, opts ...grpc.CallOption) (*ListFederatedMemosResponse, error)
+}
+
+type syndicationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSyndicationServiceClient(cc grpc.ClientConnInterface) SyndicationServiceClient {
+	return &syndicationServiceClient{cc}
+}
+
+func (c *syndicationServiceClient) ListSyndicationSubscriptions(ctx context.Context, in *ListSyndicationSubscriptionsRequest, opts ...grpc.CallOption) (*ListSyndicationSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSyndicationSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, SyndicationService_ListSyndicationSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syndicationServiceClient) CreateSyndicationSubscription(ctx context.Context, in *CreateSyndicationSubscriptionRequest, opts ...grpc.CallOption) (*SyndicationSubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyndicationSubscription)
+	err := c.cc.Invoke(ctx, SyndicationService_CreateSyndicationSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syndicationServiceClient) DeleteSyndicationSubscription(ctx context.Context, in *DeleteSyndicationSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SyndicationService_DeleteSyndicationSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syndicationServiceClient) ListFederatedMemos(ctx context.Context, in *ListFederatedMemosRequest, opts ...grpc.CallOption) (*ListFederatedMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFederatedMemosResponse)
+	err := c.cc.Invoke(ctx, SyndicationService_ListFederatedMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SyndicationServiceServer is the server API for SyndicationService service.
+// All implementations must embed UnimplementedSyndicationServiceServer
+// for forward compatibility.
+//
+// SyndicationService mirrors the public memos of other instances into a read-only federated section.
+type SyndicationServiceServer interface {
+	// ListSyndicationSubscriptions lists the subscriptions of the workspace to other instances. Admin only.
+	ListSyndicationSubscriptions(context.Context, *ListSyndicationSubscriptionsRequest) (*ListSyndicationSubscriptionsResponse, error)
+	// CreateSyndicationSubscription subscribes the workspace to the public memos of another instance and syncs them
+	// right away. Admin only.
+	CreateSyndicationSubscription(context.Context, *CreateSyndicationSubscriptionRequest) (*SyndicationSubscription, error)
+	// DeleteSyndicationSubscription unsubscribes the workspace and deletes the memos mirrored by the subscription.
+	// Admin only.
+	DeleteSyndicationSubscription(context.Context, *DeleteSyndicationSubscriptionRequest) (*emptypb.Empty, error)
+	// ListFederatedMemos lists the memos mirrored from the other instances, the ones created last first.
+	ListFederatedMemos(context.Context, *ListFederatedMemosRequest) (*ListFederatedMemosResponse, error)
+	mustEmbedUnimplementedSyndicationServiceServer()
+}
+
+// UnimplementedSyndicationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSyndicationServiceServer struct{}
+
+func (UnimplementedSyndicationServiceServer) ListSyndicationSubscriptions(context.Context, *ListSyndicationSubscriptionsRequest) (*ListSyndicationSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSyndicationSubscriptions not implemented")
+}
+func (UnimplementedSyndicationServiceServer) CreateSyndicationSubscription(context.Context, *CreateSyndicationSubscriptionRequest) (*SyndicationSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSyndicationSubscription not implemented")
+}
+func (UnimplementedSyndicationServiceServer) DeleteSyndicationSubscription(context.Context, *DeleteSyndicationSubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSyndicationSubscription not implemented")
+}
+func (UnimplementedSyndicationServiceServer) ListFederatedMemos(context.Context, *ListFederatedMemosRequest) (*ListFederatedMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFederatedMemos not implemented")
+}
+func (UnimplementedSyndicationServiceServer) mustEmbedUnimplementedSyndicationServiceServer() {}
+func (UnimplementedSyndicationServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeSyndicationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SyndicationServiceServer will
+// result in compilation errors.
+type UnsafeSyndicationServiceServer interface {
+	mustEmbedUnimplementedSyndicationServiceServer()
+}
+
+func RegisterSyndicationServiceServer(s grpc.ServiceRegistrar, srv SyndicationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSyndicationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SyndicationService_ServiceDesc, srv)
+}
+
+func _SyndicationService_ListSyndicationSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSyndicationSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyndicationServiceServer).ListSyndicationSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyndicationService_ListSyndicationSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicationServiceServer).ListSyndicationSubscriptions(ctx, req.(*ListSyndicationSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyndicationService_CreateSyndicationSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSyndicationSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyndicationServiceServer).CreateSyndicationSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyndicationService_CreateSyndicationSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicationServiceServer).CreateSyndicationSubscription(ctx, req.(*CreateSyndicationSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyndicationService_DeleteSyndicationSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSyndicationSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyndicationServiceServer).DeleteSyndicationSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyndicationService_DeleteSyndicationSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicationServiceServer).DeleteSyndicationSubscription(ctx, req.(*DeleteSyndicationSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyndicationService_ListFederatedMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFederatedMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyndicationServiceServer).ListFederatedMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyndicationService_ListFederatedMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyndicationServiceServer).ListFederatedMemos(ctx, req.(*ListFederatedMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SyndicationService_ServiceDesc is the grpc.ServiceDesc for SyndicationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SyndicationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.SyndicationService",
+	HandlerType: (*SyndicationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSyndicationSubscriptions",
+			Handler:    _SyndicationService_ListSyndicationSubscriptions_Handler,
+		},
+		{
+			MethodName: "CreateSyndicationSubscription",
+			Handler:    _SyndicationService_CreateSyndicationSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteSyndicationSubscription",
+			Handler:    _SyndicationService_DeleteSyndicationSubscription_Handler,
+		},
+		{
+			MethodName: "ListFederatedMemos",
+			Handler:    _SyndicationService_ListFederatedMemos_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/syndication_service.proto",
+}
@@ -0,0 +1,519 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/v1/tag_meta_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TagMeta struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the tag metadata.
+	// Format: users/{user}/tagMetas/{tag_meta}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The tag the metadata is for, without the leading "#", e.g. "work/projects".
+	// Each tag has at most one metadata per user.
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The hex color of the tag, e.g. "#ff8800".
+	Color string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	// The emoji shown next to the tag.
+	Emoji string `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	// The position of the tag among the pinned tags, starting from 1.
+	// 0 when the tag is not pinned.
+	PinnedOrder int32 `protobuf:"varint,5,opt,name=pinned_order,json=pinnedOrder,proto3" json:"pinned_order,omitempty"`
+	// The description of the tag.
+	Description   string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagMeta) Reset() {
+	*x = TagMeta{}
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagMeta) ProtoMessage() {}
+
+func (x *TagMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagMeta.ProtoReflect.Descriptor instead.
+func (*TagMeta) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_meta_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TagMeta) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagMeta) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagMeta) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *TagMeta) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *TagMeta) GetPinnedOrder() int32 {
+	if x != nil {
+		return x.PinnedOrder
+	}
+	return 0
+}
+
+func (x *TagMeta) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListTagMetasRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource where tag metadata are listed.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagMetasRequest) Reset() {
+	*x = ListTagMetasRequest{}
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagMetasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagMetasRequest) ProtoMessage() {}
+
+func (x *ListTagMetasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagMetasRequest.ProtoReflect.Descriptor instead.
+func (*ListTagMetasRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_meta_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTagMetasRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListTagMetasResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of tag metadata, pinned tags first in their pinned order, then the other tags alphabetically.
+	TagMetas      []*TagMeta `protobuf:"bytes,1,rep,name=tag_metas,json=tagMetas,proto3" json:"tag_metas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagMetasResponse) Reset() {
+	*x = ListTagMetasResponse{}
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagMetasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagMetasResponse) ProtoMessage() {}
+
+func (x *ListTagMetasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagMetasResponse.ProtoReflect.Descriptor instead.
+func (*ListTagMetasResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_meta_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTagMetasResponse) GetTagMetas() []*TagMeta {
+	if x != nil {
+		return x.TagMetas
+	}
+	return nil
+}
+
+type GetTagMetaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the tag metadata to retrieve.
+	// Format: users/{user}/tagMetas/{tag_meta}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagMetaRequest) Reset() {
+	*x = GetTagMetaRequest{}
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagMetaRequest) ProtoMessage() {}
+
+func (x *GetTagMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetTagMetaRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_meta_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTagMetaRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateTagMetaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource where this tag metadata will be created.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The tag metadata to create.
+	TagMeta       *TagMeta `protobuf:"bytes,2,opt,name=tag_meta,json=tagMeta,proto3" json:"tag_meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagMetaRequest) Reset() {
+	*x = CreateTagMetaRequest{}
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagMetaRequest) ProtoMessage() {}
+
+func (x *CreateTagMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagMetaRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagMetaRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_meta_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTagMetaRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateTagMetaRequest) GetTagMeta() *TagMeta {
+	if x != nil {
+		return x.TagMeta
+	}
+	return nil
+}
+
+type UpdateTagMetaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The tag metadata resource which replaces the resource on the server.
+	TagMeta *TagMeta `protobuf:"bytes,1,opt,name=tag_meta,json=tagMeta,proto3" json:"tag_meta,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagMetaRequest) Reset() {
+	*x = UpdateTagMetaRequest{}
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagMetaRequest) ProtoMessage() {}
+
+func (x *UpdateTagMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagMetaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagMetaRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_meta_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateTagMetaRequest) GetTagMeta() *TagMeta {
+	if x != nil {
+		return x.TagMeta
+	}
+	return nil
+}
+
+func (x *UpdateTagMetaRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteTagMetaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the tag metadata to delete.
+	// Format: users/{user}/tagMetas/{tag_meta}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagMetaRequest) Reset() {
+	*x = DeleteTagMetaRequest{}
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagMetaRequest) ProtoMessage() {}
+
+func (x *DeleteTagMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_meta_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagMetaRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagMetaRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_meta_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteTagMetaRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_api_v1_tag_meta_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_tag_meta_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dapi/v1/tag_meta_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\x8e\x02\n" +
+	"\aTagMeta\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x15\n" +
+	"\x03tag\x18\x02 \x01(\tB\x03\xe0A\x02R\x03tag\x12\x19\n" +
+	"\x05color\x18\x03 \x01(\tB\x03\xe0A\x01R\x05color\x12\x19\n" +
+	"\x05emoji\x18\x04 \x01(\tB\x03\xe0A\x01R\x05emoji\x12&\n" +
+	"\fpinned_order\x18\x05 \x01(\x05B\x03\xe0A\x01R\vpinnedOrder\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tB\x03\xe0A\x01R\vdescription:N\xeaAK\n" +
+	"\x14memos.api.v1/TagMeta\x12 users/{user}/tagMetas/{tag_meta}*\btagMetas2\atagMeta\"K\n" +
+	"\x13ListTagMetasRequest\x124\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\x12\x14memos.api.v1/TagMetaR\x06parent\"J\n" +
+	"\x14ListTagMetasResponse\x122\n" +
+	"\ttag_metas\x18\x01 \x03(\v2\x15.memos.api.v1.TagMetaR\btagMetas\"E\n" +
+	"\x11GetTagMetaRequest\x120\n" +
+	"\x04name\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
+	"\x14memos.api.v1/TagMetaR\x04name\"\x83\x01\n" +
+	"\x14CreateTagMetaRequest\x124\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\x12\x14memos.api.v1/TagMetaR\x06parent\x125\n" +
+	"\btag_meta\x18\x02 \x01(\v2\x15.memos.api.v1.TagMetaB\x03\xe0A\x02R\atagMeta\"\x8f\x01\n" +
+	"\x14UpdateTagMetaRequest\x125\n" +
+	"\btag_meta\x18\x01 \x01(\v2\x15.memos.api.v1.TagMetaB\x03\xe0A\x02R\atagMeta\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"H\n" +
+	"\x14DeleteTagMetaRequest\x120\n" +
+	"\x04name\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
+	"\x14memos.api.v1/TagMetaR\x04name2\xc9\x05\n" +
+	"\x0eTagMetaService\x12\x89\x01\n" +
+	"\fListTagMetas\x12!.memos.api.v1.ListTagMetasRequest\x1a\".memos.api.v1.ListTagMetasResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/tagMetas\x12v\n" +
+	"\n" +
+	"GetTagMeta\x12\x1f.memos.api.v1.GetTagMetaRequest\x1a\x15.memos.api.v1.TagMeta\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#\x12!/api/v1/{name=users/*/tagMetas/*}\x12\x91\x01\n" +
+	"\rCreateTagMeta\x12\".memos.api.v1.CreateTagMetaRequest\x1a\x15.memos.api.v1.TagMeta\"E\xdaA\x0fparent,tag_meta\x82\xd3\xe4\x93\x02-:\btag_meta\"!/api/v1/{parent=users/*}/tagMetas\x12\x9f\x01\n" +
+	"\rUpdateTagMeta\x12\".memos.api.v1.UpdateTagMetaRequest\x1a\x15.memos.api.v1.TagMeta\"S\xdaA\x14tag_meta,update_mask\x82\xd3\xe4\x93\x026:\btag_meta2*/api/v1/{tag_meta.name=users/*/tagMetas/*}\x12}\n" +
+	"\rDeleteTagMeta\x12\".memos.api.v1.DeleteTagMetaRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/tagMetas/*}B\xab\x01\n" +
+	"\x10com.memos.api.v1B\x13TagMetaServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_tag_meta_service_proto_rawDescOnce sync.Once
+	file_api_v1_tag_meta_service_proto_rawDescData []byte
+)
+
+func file_api_v1_tag_meta_service_proto_rawDescGZIP() []byte {
+	file_api_v1_tag_meta_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_tag_meta_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_tag_meta_service_proto_rawDesc), len(file_api_v1_tag_meta_service_proto_rawDesc)))
+	})
+	return file_api_v1_tag_meta_service_proto_rawDescData
+}
+
+var file_api_v1_tag_meta_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_v1_tag_meta_service_proto_goTypes = []any{
+	(*TagMeta)(nil),               // 0: memos.api.v1.TagMeta
+	(*ListTagMetasRequest)(nil),   // 1: memos.api.v1.ListTagMetasRequest
+	(*ListTagMetasResponse)(nil),  // 2: memos.api.v1.ListTagMetasResponse
+	(*GetTagMetaRequest)(nil),     // 3: memos.api.v1.GetTagMetaRequest
+	(*CreateTagMetaRequest)(nil),  // 4: memos.api.v1.CreateTagMetaRequest
+	(*UpdateTagMetaRequest)(nil),  // 5: memos.api.v1.UpdateTagMetaRequest
+	(*DeleteTagMetaRequest)(nil),  // 6: memos.api.v1.DeleteTagMetaRequest
+	(*fieldmaskpb.FieldMask)(nil), // 7: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+}
+var file_api_v1_tag_meta_service_proto_depIdxs = []int32{
+	0, // 0: memos.api.v1.ListTagMetasResponse.tag_metas:type_name -> memos.api.v1.TagMeta
+	0, // 1: memos.api.v1.CreateTagMetaRequest.tag_meta:type_name -> memos.api.v1.TagMeta
+	0, // 2: memos.api.v1.UpdateTagMetaRequest.tag_meta:type_name -> memos.api.v1.TagMeta
+	7, // 3: memos.api.v1.UpdateTagMetaRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1, // 4: memos.api.v1.TagMetaService.ListTagMetas:input_type -> memos.api.v1.ListTagMetasRequest
+	3, // 5: memos.api.v1.TagMetaService.GetTagMeta:input_type -> memos.api.v1.GetTagMetaRequest
+	4, // 6: memos.api.v1.TagMetaService.CreateTagMeta:input_type -> memos.api.v1.CreateTagMetaRequest
+	5, // 7: memos.api.v1.TagMetaService.UpdateTagMeta:input_type -> memos.api.v1.UpdateTagMetaRequest
+	6, // 8: memos.api.v1.TagMetaService.DeleteTagMeta:input_type -> memos.api.v1.DeleteTagMetaRequest
+	2, // 9: memos.api.v1.TagMetaService.ListTagMetas:output_type -> memos.api.v1.ListTagMetasResponse
+	0, // 10: memos.api.v1.TagMetaService.GetTagMeta:output_type -> memos.api.v1.TagMeta
+	0, // 11: memos.api.v1.TagMetaService.CreateTagMeta:output_type -> memos.api.v1.TagMeta
+	0, // 12: memos.api.v1.TagMetaService.UpdateTagMeta:output_type -> memos.api.v1.TagMeta
+	8, // 13: memos.api.v1.TagMetaService.DeleteTagMeta:output_type -> google.protobuf.Empty
+	9, // [9:14] is the sub-list for method output_type
+	4, // [4:9] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_tag_meta_service_proto_init() }
+func file_api_v1_tag_meta_service_proto_init() {
+	if File_api_v1_tag_meta_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_tag_meta_service_proto_rawDesc), len(file_api_v1_tag_meta_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_tag_meta_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_tag_meta_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_tag_meta_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_tag_meta_service_proto = out.File
+	file_api_v1_tag_meta_service_proto_goTypes = nil
+	file_api_v1_tag_meta_service_proto_depIdxs = nil
+}
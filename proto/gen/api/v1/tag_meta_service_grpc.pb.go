@@ -0,0 +1,284 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/v1/tag_meta_service.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TagMetaService_ListTagMetas_FullMethodName  = "/memos.api.v1.TagMetaService/ListTagMetas"
+	TagMetaService_GetTagMeta_FullMethodName    = "/memos.api.v1.TagMetaService/GetTagMeta"
+	TagMetaService_CreateTagMeta_FullMethodName = "/memos.api.v1.TagMetaService/CreateTagMeta"
+	TagMetaService_UpdateTagMeta_FullMethodName = "/memos.api.v1.TagMetaService/UpdateTagMeta"
+	TagMetaService_DeleteTagMeta_FullMethodName = "/memos.api.v1.TagMetaService/DeleteTagMeta"
+)
+
+// TagMetaServiceClient is the client API for TagMetaService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TagMetaServiceClient interface {
+	// ListTagMetas returns the tag metadata of a user, pinned tags first in their pinned order.
+	ListTagMetas(ctx context.Context, in *ListTagMetasRequest, opts ...grpc.CallOption) (*ListTagMetasResponse, error)
+	// GetTagMeta gets a tag metadata by name.
+	GetTagMeta(ctx context.Context, in *GetTagMetaRequest, opts ...grpc.CallOption) (*TagMeta, error)
+	// CreateTagMeta creates the metadata of a tag for a user.
+	CreateTagMeta(ctx context.Context, in *CreateTagMetaRequest, opts ...grpc.CallOption) (*TagMeta, error)
+	// UpdateTagMeta updates the metadata of a tag for a user.
+	UpdateTagMeta(ctx context.Context, in *UpdateTagMetaRequest, opts ...grpc.CallOption) (*TagMeta, error)
+	// DeleteTagMeta deletes the metadata of a tag for a user.
+	DeleteTagMeta(ctx context.Context, in *DeleteTagMetaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type tagMetaServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTagMetaServiceClient(cc grpc.ClientConnInterface) TagMetaServiceClient {
+	return &tagMetaServiceClient{cc}
+}
+
+func (c *tagMetaServiceClient) ListTagMetas(ctx context.Context, in *ListTagMetasRequest, opts ...grpc.CallOption) (*ListTagMetasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagMetasResponse)
+	err := c.cc.Invoke(ctx, TagMetaService_ListTagMetas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagMetaServiceClient) GetTagMeta(ctx context.Context, in *GetTagMetaRequest, opts ...grpc.CallOption) (*TagMeta, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagMeta)
+	err := c.cc.Invoke(ctx, TagMetaService_GetTagMeta_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagMetaServiceClient) CreateTagMeta(ctx context.Context, in *CreateTagMetaRequest, opts ...grpc.CallOption) (*TagMeta, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagMeta)
+	err := c.cc.Invoke(ctx, TagMetaService_CreateTagMeta_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagMetaServiceClient) UpdateTagMeta(ctx context.Context, in *UpdateTagMetaRequest, opts ...grpc.CallOption) (*TagMeta, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagMeta)
+	err := c.cc.Invoke(ctx, TagMetaService_UpdateTagMeta_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagMetaServiceClient) DeleteTagMeta(ctx context.Context, in *DeleteTagMetaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TagMetaService_DeleteTagMeta_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TagMetaServiceServer is the server API for TagMetaService service.
+// All implementations must embed UnimplementedTagMetaServiceServer
+// for forward compatibility.
+type TagMetaServiceServer interface {
+	// ListTagMetas returns the tag metadata of a user, pinned tags first in their pinned order.
+	ListTagMetas(context.Context, *ListTagMetasRequest) (*ListTagMetasResponse, error)
+	// GetTagMeta gets a tag metadata by name.
+	GetTagMeta(context.Context, *GetTagMetaRequest) (*TagMeta, error)
+	// CreateTagMeta creates the metadata of a tag for a user.
+	CreateTagMeta(context.Context, *CreateTagMetaRequest) (*TagMeta, error)
+	// UpdateTagMeta updates the metadata of a tag for a user.
+	UpdateTagMeta(context.Context, *UpdateTagMetaRequest) (*TagMeta, error)
+	// DeleteTagMeta deletes the metadata of a tag for a user.
+	DeleteTagMeta(context.Context, *DeleteTagMetaRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedTagMetaServiceServer()
+}
+
+// UnimplementedTagMetaServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTagMetaServiceServer struct{}
+
+func (UnimplementedTagMetaServiceServer) ListTagMetas(context.Context, *ListTagMetasRequest) (*ListTagMetasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTagMetas not implemented")
+}
+func (UnimplementedTagMetaServiceServer) GetTagMeta(context.Context, *GetTagMetaRequest) (*TagMeta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagMeta not implemented")
+}
+func (UnimplementedTagMetaServiceServer) CreateTagMeta(context.Context, *CreateTagMetaRequest) (*TagMeta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTagMeta not implemented")
+}
+func (UnimplementedTagMetaServiceServer) UpdateTagMeta(context.Context, *UpdateTagMetaRequest) (*TagMeta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTagMeta not implemented")
+}
+func (UnimplementedTagMetaServiceServer) DeleteTagMeta(context.Context, *DeleteTagMetaRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTagMeta not implemented")
+}
+func (UnimplementedTagMetaServiceServer) mustEmbedUnimplementedTagMetaServiceServer() {}
+func (UnimplementedTagMetaServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeTagMetaServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TagMetaServiceServer will
+// result in compilation errors.
+type UnsafeTagMetaServiceServer interface {
+	mustEmbedUnimplementedTagMetaServiceServer()
+}
+
+func RegisterTagMetaServiceServer(s grpc.ServiceRegistrar, srv TagMetaServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTagMetaServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TagMetaService_ServiceDesc, srv)
+}
+
+func _TagMetaService_ListTagMetas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagMetasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagMetaServiceServer).ListTagMetas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagMetaService_ListTagMetas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagMetaServiceServer).ListTagMetas(ctx, req.(*ListTagMetasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagMetaService_GetTagMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagMetaServiceServer).GetTagMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagMetaService_GetTagMeta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagMetaServiceServer).GetTagMeta(ctx, req.(*GetTagMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagMetaService_CreateTagMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagMetaServiceServer).CreateTagMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagMetaService_CreateTagMeta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagMetaServiceServer).CreateTagMeta(ctx, req.(*CreateTagMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagMetaService_UpdateTagMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagMetaServiceServer).UpdateTagMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagMetaService_UpdateTagMeta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagMetaServiceServer).UpdateTagMeta(ctx, req.(*UpdateTagMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagMetaService_DeleteTagMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagMetaServiceServer).DeleteTagMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagMetaService_DeleteTagMeta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagMetaServiceServer).DeleteTagMeta(ctx, req.(*DeleteTagMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TagMetaService_ServiceDesc is the grpc.ServiceDesc for TagMetaService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TagMetaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.TagMetaService",
+	HandlerType: (*TagMetaServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTagMetas",
+			Handler:    _TagMetaService_ListTagMetas_Handler,
+		},
+		{
+			MethodName: "GetTagMeta",
+			Handler:    _TagMetaService_GetTagMeta_Handler,
+		},
+		{
+			MethodName: "CreateTagMeta",
+			Handler:    _TagMetaService_CreateTagMeta_Handler,
+		},
+		{
+			MethodName: "UpdateTagMeta",
+			Handler:    _TagMetaService_UpdateTagMeta_Handler,
+		},
+		{
+			MethodName: "DeleteTagMeta",
+			Handler:    _TagMetaService_DeleteTagMeta_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/tag_meta_service.proto",
+}
@@ -83,6 +83,63 @@ func (User_Role) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{0, 0}
 }
 
+// Who a field of the profile is shown to, besides the user and the admins.
+type User_Profile_Visibility int32
+
+const (
+	// Unspecified is public.
+	User_Profile_VISIBILITY_UNSPECIFIED User_Profile_Visibility = 0
+	// Shown to everyone.
+	User_Profile_PUBLIC User_Profile_Visibility = 1
+	// Shown to the signed-in users.
+	User_Profile_PROTECTED User_Profile_Visibility = 2
+	// Shown to nobody else.
+	User_Profile_PRIVATE User_Profile_Visibility = 3
+)
+
+// Enum value maps for User_Profile_Visibility.
+var (
+	User_Profile_Visibility_name = map[int32]string{
+		0: "VISIBILITY_UNSPECIFIED",
+		1: "PUBLIC",
+		2: "PROTECTED",
+		3: "PRIVATE",
+	}
+	User_Profile_Visibility_value = map[string]int32{
+		"VISIBILITY_UNSPECIFIED": 0,
+		"PUBLIC":                 1,
+		"PROTECTED":              2,
+		"PRIVATE":                3,
+	}
+)
+
+func (x User_Profile_Visibility) Enum() *User_Profile_Visibility {
+	p := new(User_Profile_Visibility)
+	*p = x
+	return p
+}
+
+func (x User_Profile_Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (User_Profile_Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[1].Descriptor()
+}
+
+func (User_Profile_Visibility) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[1]
+}
+
+func (x User_Profile_Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use User_Profile_Visibility.Descriptor instead.
+func (User_Profile_Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
 // Enumeration of user setting keys.
 type UserSetting_Key int32
 
@@ -98,17 +155,38 @@ const (
 	UserSetting_WEBHOOKS UserSetting_Key = 4
 	// AI_AUTO_SUMMARY is the key for AI auto summary settings.
 	UserSetting_AI_AUTO_SUMMARY UserSetting_Key = 5
+	// NOSTR is the key for the publishing of the public memos to Nostr.
+	UserSetting_NOSTR UserSetting_Key = 6
+	// BLUESKY is the key for the cross-posting of the public memos to Bluesky.
+	UserSetting_BLUESKY UserSetting_Key = 7
+	// MASTODON is the key for the cross-posting of the public memos to Mastodon.
+	UserSetting_MASTODON UserSetting_Key = 8
+	// MICROPUB is the key for the cross-posting of the public memos to a Micropub endpoint.
+	UserSetting_MICROPUB UserSetting_Key = 9
+	// INDIEAUTH is the key for the IndieAuth identity the Micropub clients authenticate with.
+	UserSetting_INDIEAUTH UserSetting_Key = 10
+	// TAGGING_RULES is the key for the rules tagging the memos when they are saved.
+	UserSetting_TAGGING_RULES UserSetting_Key = 11
+	// FORMATTING is the key for the formatting of the content of the memos when they are saved.
+	UserSetting_FORMATTING UserSetting_Key = 12
 )
 
 // Enum value maps for UserSetting_Key.
 var (
 	UserSetting_Key_name = map[int32]string{
-		0: "KEY_UNSPECIFIED",
-		1: "GENERAL",
-		2: "SESSIONS",
-		3: "ACCESS_TOKENS",
-		4: "WEBHOOKS",
-		5: "AI_AUTO_SUMMARY",
+		0:  "KEY_UNSPECIFIED",
+		1:  "GENERAL",
+		2:  "SESSIONS",
+		3:  "ACCESS_TOKENS",
+		4:  "WEBHOOKS",
+		5:  "AI_AUTO_SUMMARY",
+		6:  "NOSTR",
+		7:  "BLUESKY",
+		8:  "MASTODON",
+		9:  "MICROPUB",
+		10: "INDIEAUTH",
+		11: "TAGGING_RULES",
+		12: "FORMATTING",
 	}
 	UserSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED": 0,
@@ -117,6 +195,13 @@ var (
 		"ACCESS_TOKENS":   3,
 		"WEBHOOKS":        4,
 		"AI_AUTO_SUMMARY": 5,
+		"NOSTR":           6,
+		"BLUESKY":         7,
+		"MASTODON":        8,
+		"MICROPUB":        9,
+		"INDIEAUTH":       10,
+		"TAGGING_RULES":   11,
+		"FORMATTING":      12,
 	}
 )
 
@@ -131,11 +216,11 @@ func (x UserSetting_Key) String() string {
 }
 
 func (UserSetting_Key) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_user_service_proto_enumTypes[1].Descriptor()
+	return file_api_v1_user_service_proto_enumTypes[2].Descriptor()
 }
 
 func (UserSetting_Key) Type() protoreflect.EnumType {
-	return &file_api_v1_user_service_proto_enumTypes[1]
+	return &file_api_v1_user_service_proto_enumTypes[2]
 }
 
 func (x UserSetting_Key) Number() protoreflect.EnumNumber {
@@ -144,7 +229,63 @@ func (x UserSetting_Key) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserSetting_Key.Descriptor instead.
 func (UserSetting_Key) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12, 0}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 0}
+}
+
+// Import job state enumeration.
+type UserImportJob_State int32
+
+const (
+	UserImportJob_STATE_UNSPECIFIED UserImportJob_State = 0
+	// The import job is running.
+	UserImportJob_RUNNING UserImportJob_State = 1
+	// The import job finished successfully.
+	UserImportJob_SUCCEEDED UserImportJob_State = 2
+	// The import job failed.
+	UserImportJob_FAILED UserImportJob_State = 3
+)
+
+// Enum value maps for UserImportJob_State.
+var (
+	UserImportJob_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "RUNNING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	UserImportJob_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"RUNNING":           1,
+		"SUCCEEDED":         2,
+		"FAILED":            3,
+	}
+)
+
+func (x UserImportJob_State) Enum() *UserImportJob_State {
+	p := new(UserImportJob_State)
+	*p = x
+	return p
+}
+
+func (x UserImportJob_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserImportJob_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[3].Descriptor()
+}
+
+func (UserImportJob_State) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[3]
+}
+
+func (x UserImportJob_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserImportJob_State.Descriptor instead.
+func (UserImportJob_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{44, 0}
 }
 
 type User struct {
@@ -171,7 +312,13 @@ type User struct {
 	// Output only. The creation timestamp.
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// Output only. The last update timestamp.
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Optional. The public profile of the user.
+	// The fields the viewer is not allowed to see are left empty.
+	Profile *User_Profile `protobuf:"bytes,12,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Optional. The checksum of the user, changed by every update. Set it on update to have the update fail with
+	// ABORTED if the user changed since it was read.
+	Etag          string `protobuf:"bytes,13,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -283,6 +430,20 @@ func (x *User) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetProfile() *User_Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *User) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type ListUsersRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional. The maximum number of users to return.
@@ -498,9 +659,12 @@ type CreateUserRequest struct {
 	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
 	// Optional. An idempotency token that can be used to ensure that multiple
 	// requests to create a user have the same result.
-	RequestId     string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	RequestId string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Optional. The version of the legal pages of the workspace the user consents to.
+	// Required to sign up when the workspace legal setting has a version, FAILED_PRECONDITION is returned otherwise.
+	LegalConsentVersion string `protobuf:"bytes,5,opt,name=legal_consent_version,json=legalConsentVersion,proto3" json:"legal_consent_version,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *CreateUserRequest) Reset() {
@@ -561,6 +725,13 @@ func (x *CreateUserRequest) GetRequestId() string {
 	return ""
 }
 
+func (x *CreateUserRequest) GetLegalConsentVersion() string {
+	if x != nil {
+		return x.LegalConsentVersion
+	}
+	return ""
+}
+
 type UpdateUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The user to update.
@@ -624,31 +795,31 @@ func (x *UpdateUserRequest) GetAllowMissing() bool {
 	return false
 }
 
-type DeleteUserRequest struct {
+type ChangeUsernameRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the user to delete.
+	// Required. The resource name of the user.
 	// Format: users/{user}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. If set to true, the user will be deleted even if they have associated data.
-	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// Required. The new username. The reserved names, e.g. "admin", "api" or "explore", are not allowed.
+	NewUsername   string `protobuf:"bytes,2,opt,name=new_username,json=newUsername,proto3" json:"new_username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserRequest) Reset() {
-	*x = DeleteUserRequest{}
+func (x *ChangeUsernameRequest) Reset() {
+	*x = ChangeUsernameRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserRequest) String() string {
+func (x *ChangeUsernameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserRequest) ProtoMessage() {}
+func (*ChangeUsernameRequest) ProtoMessage() {}
 
-func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangeUsernameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -660,48 +831,54 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeUsernameRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUsernameRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DeleteUserRequest) GetName() string {
+func (x *ChangeUsernameRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *DeleteUserRequest) GetForce() bool {
+func (x *ChangeUsernameRequest) GetNewUsername() string {
 	if x != nil {
-		return x.Force
+		return x.NewUsername
 	}
-	return false
+	return ""
 }
 
-type GetUserAvatarRequest struct {
+type DeleteUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the user.
+	// Required. The resource name of the user to delete.
 	// Format: users/{user}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. If set to true, the user will be deleted even if they have associated data.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// Optional. The etag of the user, the deletion fails with ABORTED if the user changed since.
+	Etag string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Optional. If set to true, deleting a user that does not exist succeeds.
+	AllowMissing  bool `protobuf:"varint,4,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserAvatarRequest) Reset() {
-	*x = GetUserAvatarRequest{}
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserAvatarRequest) String() string {
+func (x *DeleteUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserAvatarRequest) ProtoMessage() {}
+func (*DeleteUserRequest) ProtoMessage() {}
 
-func (x *GetUserAvatarRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -713,52 +890,62 @@ func (x *GetUserAvatarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserAvatarRequest.ProtoReflect.Descriptor instead.
-func (*GetUserAvatarRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetUserAvatarRequest) GetName() string {
+func (x *DeleteUserRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-// User statistics messages
-type UserStats struct {
+func (x *DeleteUserRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteUserRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *DeleteUserRequest) GetAllowMissing() bool {
+	if x != nil {
+		return x.AllowMissing
+	}
+	return false
+}
+
+type ApproveUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The resource name of the user whose stats these are.
+	// Required. The resource name of the user to approve.
 	// Format: users/{user}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The timestamps when the memos were displayed.
-	MemoDisplayTimestamps []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=memo_display_timestamps,json=memoDisplayTimestamps,proto3" json:"memo_display_timestamps,omitempty"`
-	// The stats of memo types.
-	MemoTypeStats *UserStats_MemoTypeStats `protobuf:"bytes,3,opt,name=memo_type_stats,json=memoTypeStats,proto3" json:"memo_type_stats,omitempty"`
-	// The count of tags.
-	TagCount map[string]int32 `protobuf:"bytes,4,rep,name=tag_count,json=tagCount,proto3" json:"tag_count,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	// The pinned memos of the user.
-	PinnedMemos []string `protobuf:"bytes,5,rep,name=pinned_memos,json=pinnedMemos,proto3" json:"pinned_memos,omitempty"`
-	// Total memo count.
-	TotalMemoCount int32 `protobuf:"varint,6,opt,name=total_memo_count,json=totalMemoCount,proto3" json:"total_memo_count,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserStats) Reset() {
-	*x = UserStats{}
+func (x *ApproveUserRequest) Reset() {
+	*x = ApproveUserRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserStats) String() string {
+func (x *ApproveUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserStats) ProtoMessage() {}
+func (*ApproveUserRequest) ProtoMessage() {}
 
-func (x *UserStats) ProtoReflect() protoreflect.Message {
+func (x *ApproveUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -770,76 +957,46 @@ func (x *UserStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserStats.ProtoReflect.Descriptor instead.
-func (*UserStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApproveUserRequest.ProtoReflect.Descriptor instead.
+func (*ApproveUserRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UserStats) GetName() string {
+func (x *ApproveUserRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UserStats) GetMemoDisplayTimestamps() []*timestamppb.Timestamp {
-	if x != nil {
-		return x.MemoDisplayTimestamps
-	}
-	return nil
-}
-
-func (x *UserStats) GetMemoTypeStats() *UserStats_MemoTypeStats {
-	if x != nil {
-		return x.MemoTypeStats
-	}
-	return nil
-}
-
-func (x *UserStats) GetTagCount() map[string]int32 {
-	if x != nil {
-		return x.TagCount
-	}
-	return nil
-}
-
-func (x *UserStats) GetPinnedMemos() []string {
-	if x != nil {
-		return x.PinnedMemos
-	}
-	return nil
-}
-
-func (x *UserStats) GetTotalMemoCount() int32 {
-	if x != nil {
-		return x.TotalMemoCount
-	}
-	return 0
-}
-
-type GetUserStatsRequest struct {
+type SetUserFeatureFlagRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the user.
 	// Format: users/{user}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The name of the feature flag.
+	Flag string `protobuf:"bytes,2,opt,name=flag,proto3" json:"flag,omitempty"`
+	// Optional. Whether the feature is enabled for the user.
+	// The override is removed when it is not set.
+	Enabled       *bool `protobuf:"varint,3,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserStatsRequest) Reset() {
-	*x = GetUserStatsRequest{}
+func (x *SetUserFeatureFlagRequest) Reset() {
+	*x = SetUserFeatureFlagRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserStatsRequest) String() string {
+func (x *SetUserFeatureFlagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserStatsRequest) ProtoMessage() {}
+func (*SetUserFeatureFlagRequest) ProtoMessage() {}
 
-func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
+func (x *SetUserFeatureFlagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -851,38 +1008,58 @@ func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserStatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetUserFeatureFlagRequest.ProtoReflect.Descriptor instead.
+func (*SetUserFeatureFlagRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetUserStatsRequest) GetName() string {
+func (x *SetUserFeatureFlagRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type ListAllUserStatsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *SetUserFeatureFlagRequest) GetFlag() string {
+	if x != nil {
+		return x.Flag
+	}
+	return ""
+}
+
+func (x *SetUserFeatureFlagRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type GetUserAvatarRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the user.
+	// Format: users/{user}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The size in pixels of the avatar.
+	// The smallest standard size not smaller than it is served, and the largest one when it is not set.
+	Size          int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllUserStatsRequest) Reset() {
-	*x = ListAllUserStatsRequest{}
+func (x *GetUserAvatarRequest) Reset() {
+	*x = GetUserAvatarRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllUserStatsRequest) String() string {
+func (x *GetUserAvatarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllUserStatsRequest) ProtoMessage() {}
+func (*GetUserAvatarRequest) ProtoMessage() {}
 
-func (x *ListAllUserStatsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserAvatarRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -894,33 +1071,50 @@ func (x *ListAllUserStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllUserStatsRequest.ProtoReflect.Descriptor instead.
-func (*ListAllUserStatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserAvatarRequest.ProtoReflect.Descriptor instead.
+func (*GetUserAvatarRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
 }
 
-type ListAllUserStatsResponse struct {
+func (x *GetUserAvatarRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetUserAvatarRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type UploadUserAvatarRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of user statistics.
-	Stats         []*UserStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	// Required. The resource name of the user.
+	// Format: users/{user}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The content of the image, in PNG, JPEG or GIF format.
+	Content       []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllUserStatsResponse) Reset() {
-	*x = ListAllUserStatsResponse{}
+func (x *UploadUserAvatarRequest) Reset() {
+	*x = UploadUserAvatarRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllUserStatsResponse) String() string {
+func (x *UploadUserAvatarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllUserStatsResponse) ProtoMessage() {}
+func (*UploadUserAvatarRequest) ProtoMessage() {}
 
-func (x *ListAllUserStatsResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadUserAvatarRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -932,51 +1126,59 @@ func (x *ListAllUserStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllUserStatsResponse.ProtoReflect.Descriptor instead.
-func (*ListAllUserStatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadUserAvatarRequest.ProtoReflect.Descriptor instead.
+func (*UploadUserAvatarRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListAllUserStatsResponse) GetStats() []*UserStats {
+func (x *UploadUserAvatarRequest) GetName() string {
 	if x != nil {
-		return x.Stats
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadUserAvatarRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
 	}
 	return nil
 }
 
-// User settings message
-type UserSetting struct {
+// User statistics messages
+type UserStats struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the user setting.
-	// Format: users/{user}/settings/{setting}, {setting} is the key for the setting.
-	// For example, "users/123/settings/GENERAL" for general settings.
+	// The resource name of the user whose stats these are.
+	// Format: users/{user}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Types that are valid to be assigned to Value:
-	//
-	//	*UserSetting_GeneralSetting_
-	//	*UserSetting_SessionsSetting_
-	//	*UserSetting_AccessTokensSetting_
-	//	*UserSetting_WebhooksSetting_
-	//	*UserSetting_AiAutoSummarySetting
-	Value         isUserSetting_Value `protobuf_oneof:"value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The timestamps when the memos were displayed.
+	MemoDisplayTimestamps []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=memo_display_timestamps,json=memoDisplayTimestamps,proto3" json:"memo_display_timestamps,omitempty"`
+	// The stats of memo types.
+	MemoTypeStats *UserStats_MemoTypeStats `protobuf:"bytes,3,opt,name=memo_type_stats,json=memoTypeStats,proto3" json:"memo_type_stats,omitempty"`
+	// The count of tags.
+	TagCount map[string]int32 `protobuf:"bytes,4,rep,name=tag_count,json=tagCount,proto3" json:"tag_count,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The pinned memos of the user.
+	PinnedMemos []string `protobuf:"bytes,5,rep,name=pinned_memos,json=pinnedMemos,proto3" json:"pinned_memos,omitempty"`
+	// Total memo count.
+	TotalMemoCount int32 `protobuf:"varint,6,opt,name=total_memo_count,json=totalMemoCount,proto3" json:"total_memo_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UserSetting) Reset() {
-	*x = UserSetting{}
+func (x *UserStats) Reset() {
+	*x = UserStats{}
 	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSetting) String() string {
+func (x *UserStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSetting) ProtoMessage() {}
+func (*UserStats) ProtoMessage() {}
 
-func (x *UserSetting) ProtoReflect() protoreflect.Message {
+func (x *UserStats) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -988,127 +1190,76 @@ func (x *UserSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSetting.ProtoReflect.Descriptor instead.
-func (*UserSetting) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserStats.ProtoReflect.Descriptor instead.
+func (*UserStats) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UserSetting) GetName() string {
+func (x *UserStats) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UserSetting) GetValue() isUserSetting_Value {
-	if x != nil {
-		return x.Value
-	}
-	return nil
-}
-
-func (x *UserSetting) GetGeneralSetting() *UserSetting_GeneralSetting {
+func (x *UserStats) GetMemoDisplayTimestamps() []*timestamppb.Timestamp {
 	if x != nil {
-		if x, ok := x.Value.(*UserSetting_GeneralSetting_); ok {
-			return x.GeneralSetting
-		}
+		return x.MemoDisplayTimestamps
 	}
 	return nil
 }
 
-func (x *UserSetting) GetSessionsSetting() *UserSetting_SessionsSetting {
+func (x *UserStats) GetMemoTypeStats() *UserStats_MemoTypeStats {
 	if x != nil {
-		if x, ok := x.Value.(*UserSetting_SessionsSetting_); ok {
-			return x.SessionsSetting
-		}
+		return x.MemoTypeStats
 	}
 	return nil
 }
 
-func (x *UserSetting) GetAccessTokensSetting() *UserSetting_AccessTokensSetting {
+func (x *UserStats) GetTagCount() map[string]int32 {
 	if x != nil {
-		if x, ok := x.Value.(*UserSetting_AccessTokensSetting_); ok {
-			return x.AccessTokensSetting
-		}
+		return x.TagCount
 	}
 	return nil
 }
 
-func (x *UserSetting) GetWebhooksSetting() *UserSetting_WebhooksSetting {
+func (x *UserStats) GetPinnedMemos() []string {
 	if x != nil {
-		if x, ok := x.Value.(*UserSetting_WebhooksSetting_); ok {
-			return x.WebhooksSetting
-		}
+		return x.PinnedMemos
 	}
 	return nil
 }
 
-func (x *UserSetting) GetAiAutoSummarySetting() *UserSetting_AIAutoSummarySetting {
+func (x *UserStats) GetTotalMemoCount() int32 {
 	if x != nil {
-		if x, ok := x.Value.(*UserSetting_AiAutoSummarySetting); ok {
-			return x.AiAutoSummarySetting
-		}
+		return x.TotalMemoCount
 	}
-	return nil
-}
-
-type isUserSetting_Value interface {
-	isUserSetting_Value()
-}
-
-type UserSetting_GeneralSetting_ struct {
-	GeneralSetting *UserSetting_GeneralSetting `protobuf:"bytes,2,opt,name=general_setting,json=generalSetting,proto3,oneof"`
-}
-
-type UserSetting_SessionsSetting_ struct {
-	SessionsSetting *UserSetting_SessionsSetting `protobuf:"bytes,3,opt,name=sessions_setting,json=sessionsSetting,proto3,oneof"`
-}
-
-type UserSetting_AccessTokensSetting_ struct {
-	AccessTokensSetting *UserSetting_AccessTokensSetting `protobuf:"bytes,4,opt,name=access_tokens_setting,json=accessTokensSetting,proto3,oneof"`
-}
-
-type UserSetting_WebhooksSetting_ struct {
-	WebhooksSetting *UserSetting_WebhooksSetting `protobuf:"bytes,5,opt,name=webhooks_setting,json=webhooksSetting,proto3,oneof"`
-}
-
-type UserSetting_AiAutoSummarySetting struct {
-	AiAutoSummarySetting *UserSetting_AIAutoSummarySetting `protobuf:"bytes,6,opt,name=ai_auto_summary_setting,json=aiAutoSummarySetting,proto3,oneof"`
+	return 0
 }
 
-func (*UserSetting_GeneralSetting_) isUserSetting_Value() {}
-
-func (*UserSetting_SessionsSetting_) isUserSetting_Value() {}
-
-func (*UserSetting_AccessTokensSetting_) isUserSetting_Value() {}
-
-func (*UserSetting_WebhooksSetting_) isUserSetting_Value() {}
-
-func (*UserSetting_AiAutoSummarySetting) isUserSetting_Value() {}
-
-type GetUserSettingRequest struct {
+type GetUserStatsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the user setting.
-	// Format: users/{user}/settings/{setting}
+	// Required. The resource name of the user.
+	// Format: users/{user}
 	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserSettingRequest) Reset() {
-	*x = GetUserSettingRequest{}
+func (x *GetUserStatsRequest) Reset() {
+	*x = GetUserStatsRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserSettingRequest) String() string {
+func (x *GetUserStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserSettingRequest) ProtoMessage() {}
+func (*GetUserStatsRequest) ProtoMessage() {}
 
-func (x *GetUserSettingRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1120,42 +1271,43 @@ func (x *GetUserSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserSettingRequest.ProtoReflect.Descriptor instead.
-func (*GetUserSettingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserStatsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetUserSettingRequest) GetName() string {
+func (x *GetUserStatsRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type UpdateUserSettingRequest struct {
+type GetUserActivityCalendarRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The user setting to update.
-	Setting *UserSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
-	// Required. The list of fields to update.
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Required. The resource name of the user.
+	// Format: users/{user}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The number of weeks of the calendar, ending with the current week. 53 when 0, at most 106.
+	Weeks         int32 `protobuf:"varint,2,opt,name=weeks,proto3" json:"weeks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserSettingRequest) Reset() {
-	*x = UpdateUserSettingRequest{}
+func (x *GetUserActivityCalendarRequest) Reset() {
+	*x = GetUserActivityCalendarRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserSettingRequest) String() string {
+func (x *GetUserActivityCalendarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserSettingRequest) ProtoMessage() {}
+func (*GetUserActivityCalendarRequest) ProtoMessage() {}
 
-func (x *UpdateUserSettingRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserActivityCalendarRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1167,57 +1319,57 @@ func (x *UpdateUserSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserSettingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserSettingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserActivityCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetUserActivityCalendarRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UpdateUserSettingRequest) GetSetting() *UserSetting {
+func (x *GetUserActivityCalendarRequest) GetName() string {
 	if x != nil {
-		return x.Setting
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateUserSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *GetUserActivityCalendarRequest) GetWeeks() int32 {
 	if x != nil {
-		return x.UpdateMask
+		return x.Weeks
 	}
-	return nil
+	return 0
 }
 
-// Request message for ListUserSettings method.
-type ListUserSettingsRequest struct {
+// The activity calendar of a user, in the timezone and with the week start of the user.
+type UserActivityCalendar struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The parent resource whose settings will be listed.
+	// The resource name of the user whose activity this is.
 	// Format: users/{user}
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// Optional. The maximum number of settings to return.
-	// The service may return fewer than this value.
-	// If unspecified, at most 50 settings will be returned.
-	// The maximum value is 1000; values above 1000 will be coerced to 1000.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token, received from a previous `ListUserSettings` call.
-	// Provide this to retrieve the subsequent page.
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The IANA timezone the days are in.
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// The first day of the weeks, from 0 for Sunday to 6 for Saturday. The calendar starts on this day.
+	WeekStartDay int32 `protobuf:"varint,3,opt,name=week_start_day,json=weekStartDay,proto3" json:"week_start_day,omitempty"`
+	// The days of the calendar, from the oldest to today, the days of the first week included.
+	Days []*UserActivityCalendar_Day `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	// The highest intensity level.
+	MaxLevel      int32 `protobuf:"varint,5,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserSettingsRequest) Reset() {
-	*x = ListUserSettingsRequest{}
+func (x *UserActivityCalendar) Reset() {
+	*x = UserActivityCalendar{}
 	mi := &file_api_v1_user_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserSettingsRequest) String() string {
+func (x *UserActivityCalendar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserSettingsRequest) ProtoMessage() {}
+func (*UserActivityCalendar) ProtoMessage() {}
 
-func (x *ListUserSettingsRequest) ProtoReflect() protoreflect.Message {
+func (x *UserActivityCalendar) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1229,60 +1381,66 @@ func (x *ListUserSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserSettingsRequest.ProtoReflect.Descriptor instead.
-func (*ListUserSettingsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserActivityCalendar.ProtoReflect.Descriptor instead.
+func (*UserActivityCalendar) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListUserSettingsRequest) GetParent() string {
+func (x *UserActivityCalendar) GetName() string {
 	if x != nil {
-		return x.Parent
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListUserSettingsRequest) GetPageSize() int32 {
+func (x *UserActivityCalendar) GetTimezone() string {
 	if x != nil {
-		return x.PageSize
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UserActivityCalendar) GetWeekStartDay() int32 {
+	if x != nil {
+		return x.WeekStartDay
 	}
 	return 0
 }
 
-func (x *ListUserSettingsRequest) GetPageToken() string {
+func (x *UserActivityCalendar) GetDays() []*UserActivityCalendar_Day {
 	if x != nil {
-		return x.PageToken
+		return x.Days
 	}
-	return ""
+	return nil
 }
 
-// Response message for ListUserSettings method.
-type ListUserSettingsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of user settings.
-	Settings []*UserSetting `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
-	// A token that can be sent as `page_token` to retrieve the next page.
-	// If this field is omitted, there are no subsequent pages.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of settings (may be approximate).
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+func (x *UserActivityCalendar) GetMaxLevel() int32 {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return 0
+}
+
+type ListAllUserStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserSettingsResponse) Reset() {
-	*x = ListUserSettingsResponse{}
+func (x *ListAllUserStatsRequest) Reset() {
+	*x = ListAllUserStatsRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserSettingsResponse) String() string {
+func (x *ListAllUserStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserSettingsResponse) ProtoMessage() {}
+func (*ListAllUserStatsRequest) ProtoMessage() {}
 
-func (x *ListUserSettingsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListAllUserStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1294,65 +1452,1824 @@ func (x *ListUserSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserSettingsResponse.ProtoReflect.Descriptor instead.
-func (*ListUserSettingsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAllUserStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllUserStatsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListUserSettingsResponse) GetSettings() []*UserSetting {
-	if x != nil {
-		return x.Settings
-	}
-	return nil
-}
-
-func (x *ListUserSettingsResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
+type ListAllUserStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of user statistics.
+	Stats         []*UserStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserSettingsResponse) GetTotalSize() int32 {
+func (x *ListAllUserStatsResponse) Reset() {
+	*x = ListAllUserStatsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllUserStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllUserStatsResponse) ProtoMessage() {}
+
+func (x *ListAllUserStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllUserStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllUserStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListAllUserStatsResponse) GetStats() []*UserStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// User settings message
+type UserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user setting.
+	// Format: users/{user}/settings/{setting}, {setting} is the key for the setting.
+	// For example, "users/123/settings/GENERAL" for general settings.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*UserSetting_GeneralSetting_
+	//	*UserSetting_SessionsSetting_
+	//	*UserSetting_AccessTokensSetting_
+	//	*UserSetting_WebhooksSetting_
+	//	*UserSetting_AiAutoSummarySetting
+	//	*UserSetting_NostrSetting_
+	//	*UserSetting_BlueskySetting_
+	//	*UserSetting_MastodonSetting_
+	//	*UserSetting_MicropubSetting_
+	//	*UserSetting_IndieAuthSetting_
+	//	*UserSetting_TaggingRulesSetting_
+	//	*UserSetting_FormattingSetting_
+	Value         isUserSetting_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetting) Reset() {
+	*x = UserSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetting) ProtoMessage() {}
+
+func (x *UserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UserSetting) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserSetting) GetValue() isUserSetting_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *UserSetting) GetGeneralSetting() *UserSetting_GeneralSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_GeneralSetting_); ok {
+			return x.GeneralSetting
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetSessionsSetting() *UserSetting_SessionsSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_SessionsSetting_); ok {
+			return x.SessionsSetting
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetAccessTokensSetting() *UserSetting_AccessTokensSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_AccessTokensSetting_); ok {
+			return x.AccessTokensSetting
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetWebhooksSetting() *UserSetting_WebhooksSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_WebhooksSetting_); ok {
+			return x.WebhooksSetting
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetAiAutoSummarySetting() *UserSetting_AIAutoSummarySetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_AiAutoSummarySetting); ok {
+			return x.AiAutoSummarySetting
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetNostrSetting() *UserSetting_NostrSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_NostrSetting_); ok {
+			return x.NostrSetting
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetBlueskySetting() *UserSetting_BlueskySetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_BlueskySetting_); ok {
+			return x.BlueskySetting
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetMastodonSetting() *UserSetting_MastodonSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_MastodonSetting_); ok {
+			return x.MastodonSetting
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetMicropubSetting() *UserSetting_MicropubSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_MicropubSetting_); ok {
+			return x.MicropubSetting
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetIndieAuthSetting() *UserSetting_IndieAuthSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_IndieAuthSetting_); ok {
+			return x.IndieAuthSetting
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetTaggingRulesSetting() *UserSetting_TaggingRulesSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_TaggingRulesSetting_); ok {
+			return x.TaggingRulesSetting
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetFormattingSetting() *UserSetting_FormattingSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_FormattingSetting_); ok {
+			return x.FormattingSetting
+		}
+	}
+	return nil
+}
+
+type isUserSetting_Value interface {
+	isUserSetting_Value()
+}
+
+type UserSetting_GeneralSetting_ struct {
+	GeneralSetting *UserSetting_GeneralSetting `protobuf:"bytes,2,opt,name=general_setting,json=generalSetting,proto3,oneof"`
+}
+
+type UserSetting_SessionsSetting_ struct {
+	SessionsSetting *UserSetting_SessionsSetting `protobuf:"bytes,3,opt,name=sessions_setting,json=sessionsSetting,proto3,oneof"`
+}
+
+type UserSetting_AccessTokensSetting_ struct {
+	AccessTokensSetting *UserSetting_AccessTokensSetting `protobuf:"bytes,4,opt,name=access_tokens_setting,json=accessTokensSetting,proto3,oneof"`
+}
+
+type UserSetting_WebhooksSetting_ struct {
+	WebhooksSetting *UserSetting_WebhooksSetting `protobuf:"bytes,5,opt,name=webhooks_setting,json=webhooksSetting,proto3,oneof"`
+}
+
+type UserSetting_AiAutoSummarySetting struct {
+	AiAutoSummarySetting *UserSetting_AIAutoSummarySetting `protobuf:"bytes,6,opt,name=ai_auto_summary_setting,json=aiAutoSummarySetting,proto3,oneof"`
+}
+
+type UserSetting_NostrSetting_ struct {
+	NostrSetting *UserSetting_NostrSetting `protobuf:"bytes,7,opt,name=nostr_setting,json=nostrSetting,proto3,oneof"`
+}
+
+type UserSetting_BlueskySetting_ struct {
+	BlueskySetting *UserSetting_BlueskySetting `protobuf:"bytes,8,opt,name=bluesky_setting,json=blueskySetting,proto3,oneof"`
+}
+
+type UserSetting_MastodonSetting_ struct {
+	MastodonSetting *UserSetting_MastodonSetting `protobuf:"bytes,9,opt,name=mastodon_setting,json=mastodonSetting,proto3,oneof"`
+}
+
+type UserSetting_MicropubSetting_ struct {
+	MicropubSetting *UserSetting_MicropubSetting `protobuf:"bytes,10,opt,name=micropub_setting,json=micropubSetting,proto3,oneof"`
+}
+
+type UserSetting_IndieAuthSetting_ struct {
+	IndieAuthSetting *UserSetting_IndieAuthSetting `protobuf:"bytes,11,opt,name=indie_auth_setting,json=indieAuthSetting,proto3,oneof"`
+}
+
+type UserSetting_TaggingRulesSetting_ struct {
+	TaggingRulesSetting *UserSetting_TaggingRulesSetting `protobuf:"bytes,12,opt,name=tagging_rules_setting,json=taggingRulesSetting,proto3,oneof"`
+}
+
+type UserSetting_FormattingSetting_ struct {
+	FormattingSetting *UserSetting_FormattingSetting `protobuf:"bytes,13,opt,name=formatting_setting,json=formattingSetting,proto3,oneof"`
+}
+
+func (*UserSetting_GeneralSetting_) isUserSetting_Value() {}
+
+func (*UserSetting_SessionsSetting_) isUserSetting_Value() {}
+
+func (*UserSetting_AccessTokensSetting_) isUserSetting_Value() {}
+
+func (*UserSetting_WebhooksSetting_) isUserSetting_Value() {}
+
+func (*UserSetting_AiAutoSummarySetting) isUserSetting_Value() {}
+
+func (*UserSetting_NostrSetting_) isUserSetting_Value() {}
+
+func (*UserSetting_BlueskySetting_) isUserSetting_Value() {}
+
+func (*UserSetting_MastodonSetting_) isUserSetting_Value() {}
+
+func (*UserSetting_MicropubSetting_) isUserSetting_Value() {}
+
+func (*UserSetting_IndieAuthSetting_) isUserSetting_Value() {}
+
+func (*UserSetting_TaggingRulesSetting_) isUserSetting_Value() {}
+
+func (*UserSetting_FormattingSetting_) isUserSetting_Value() {}
+
+type GetUserSettingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the user setting.
+	// Format: users/{user}/settings/{setting}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSettingRequest) Reset() {
+	*x = GetUserSettingRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSettingRequest) ProtoMessage() {}
+
+func (x *GetUserSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSettingRequest.ProtoReflect.Descriptor instead.
+func (*GetUserSettingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUserSettingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateUserSettingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user setting to update.
+	Setting *UserSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserSettingRequest) Reset() {
+	*x = UpdateUserSettingRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserSettingRequest) ProtoMessage() {}
+
+func (x *UpdateUserSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserSettingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateUserSettingRequest) GetSetting() *UserSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+func (x *UpdateUserSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Request message for ListUserSettings method.
+type ListUserSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource whose settings will be listed.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The maximum number of settings to return.
+	// The service may return fewer than this value.
+	// If unspecified, at most 50 settings will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListUserSettings` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSettingsRequest) Reset() {
+	*x = ListUserSettingsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSettingsRequest) ProtoMessage() {}
+
+func (x *ListUserSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSettingsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListUserSettingsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListUserSettingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserSettingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response message for ListUserSettings method.
+type ListUserSettingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of user settings.
+	Settings []*UserSetting `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of settings (may be approximate).
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSettingsResponse) Reset() {
+	*x = ListUserSettingsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSettingsResponse) ProtoMessage() {}
+
+func (x *ListUserSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSettingsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListUserSettingsResponse) GetSettings() []*UserSetting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *ListUserSettingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListUserSettingsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// User access token message
+type UserAccessToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the access token.
+	// Format: users/{user}/accessTokens/{access_token}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Output only. The access token value.
+	AccessToken string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The description of the access token.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Output only. The issued timestamp.
+	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// Optional. The expiration timestamp.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserAccessToken) Reset() {
+	*x = UserAccessToken{}
+	mi := &file_api_v1_user_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserAccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAccessToken) ProtoMessage() {}
+
+func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAccessToken.ProtoReflect.Descriptor instead.
+func (*UserAccessToken) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UserAccessToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserAccessToken) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *UserAccessToken) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UserAccessToken) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *UserAccessToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ListUserAccessTokensRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource whose access tokens will be listed.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The maximum number of access tokens to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserAccessTokensRequest) Reset() {
+	*x = ListUserAccessTokensRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserAccessTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserAccessTokensRequest) ProtoMessage() {}
+
+func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserAccessTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListUserAccessTokensRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListUserAccessTokensRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListUserAccessTokensRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserAccessTokensRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUserAccessTokensResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of access tokens.
+	AccessTokens []*UserAccessToken `protobuf:"bytes,1,rep,name=access_tokens,json=accessTokens,proto3" json:"access_tokens,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of access tokens.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserAccessTokensResponse) Reset() {
+	*x = ListUserAccessTokensResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserAccessTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserAccessTokensResponse) ProtoMessage() {}
+
+func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserAccessTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListUserAccessTokensResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListUserAccessTokensResponse) GetAccessTokens() []*UserAccessToken {
+	if x != nil {
+		return x.AccessTokens
+	}
+	return nil
+}
+
+func (x *ListUserAccessTokensResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListUserAccessTokensResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type CreateUserAccessTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource where this access token will be created.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The access token to create.
+	AccessToken *UserAccessToken `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// Optional. The access token ID to use.
+	AccessTokenId string `protobuf:"bytes,3,opt,name=access_token_id,json=accessTokenId,proto3" json:"access_token_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserAccessTokenRequest) Reset() {
+	*x = CreateUserAccessTokenRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserAccessTokenRequest) ProtoMessage() {}
+
+func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateUserAccessTokenRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateUserAccessTokenRequest) GetAccessToken() *UserAccessToken {
+	if x != nil {
+		return x.AccessToken
+	}
+	return nil
+}
+
+func (x *CreateUserAccessTokenRequest) GetAccessTokenId() string {
+	if x != nil {
+		return x.AccessTokenId
+	}
+	return ""
+}
+
+type DeleteUserAccessTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the access token to delete.
+	// Format: users/{user}/accessTokens/{access_token}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. If set to true, deleting an access token that does not exist succeeds.
+	AllowMissing  bool `protobuf:"varint,2,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserAccessTokenRequest) Reset() {
+	*x = DeleteUserAccessTokenRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserAccessTokenRequest) ProtoMessage() {}
+
+func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteUserAccessTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteUserAccessTokenRequest) GetAllowMissing() bool {
+	if x != nil {
+		return x.AllowMissing
+	}
+	return false
+}
+
+type UserSession struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the session.
+	// Format: users/{user}/sessions/{session}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The session ID.
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The timestamp when the session was created.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The timestamp when the session was last accessed.
+	// Used for sliding expiration calculation (last_accessed_time + 2 weeks).
+	LastAccessedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_accessed_time,json=lastAccessedTime,proto3" json:"last_accessed_time,omitempty"`
+	// Client information associated with this session.
+	ClientInfo    *UserSession_ClientInfo `protobuf:"bytes,5,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSession) Reset() {
+	*x = UserSession{}
+	mi := &file_api_v1_user_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSession) ProtoMessage() {}
+
+func (x *UserSession) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSession.ProtoReflect.Descriptor instead.
+func (*UserSession) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UserSession) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserSession) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *UserSession) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserSession) GetLastAccessedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedTime
+	}
+	return nil
+}
+
+func (x *UserSession) GetClientInfo() *UserSession_ClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
+type ListUserSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the parent.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSessionsRequest) Reset() {
+	*x = ListUserSessionsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSessionsRequest) ProtoMessage() {}
+
+func (x *ListUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListUserSessionsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListUserSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of user sessions.
+	Sessions      []*UserSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSessionsResponse) Reset() {
+	*x = ListUserSessionsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSessionsResponse) ProtoMessage() {}
+
+func (x *ListUserSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListUserSessionsResponse) GetSessions() []*UserSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeUserSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the session to revoke.
+	// Format: users/{user}/sessions/{session}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeUserSessionRequest) Reset() {
+	*x = RevokeUserSessionRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserSessionRequest) ProtoMessage() {}
+
+func (x *RevokeUserSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RevokeUserSessionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// UserWebhook represents a webhook owned by a user.
+type UserWebhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the webhook.
+	// Format: users/{user}/webhooks/{webhook}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The URL to send the webhook to.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Optional. Human-readable name for the webhook.
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// The creation time of the webhook.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The last update time of the webhook.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// The activity types the webhook is sent for, e.g. "memos.memo.created".
+	// An empty list sends the webhook for all activity types.
+	Events []string `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	// The secret used to sign the request bodies. The signature is sent in the X-Memos-Signature
+	// header as "sha256=" followed by the HMAC-SHA256 hex digest of the body.
+	// Only returned when the webhook is created and when the secret is rotated.
+	Secret string `protobuf:"bytes,7,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Optional. The checksum of the webhook, changed by every update. Set it on update to have the update fail
+	// with ABORTED if the webhook changed since it was read.
+	Etag          string `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserWebhook) Reset() {
+	*x = UserWebhook{}
+	mi := &file_api_v1_user_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWebhook) ProtoMessage() {}
+
+func (x *UserWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWebhook.ProtoReflect.Descriptor instead.
+func (*UserWebhook) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UserWebhook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserWebhook) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *UserWebhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *UserWebhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// UserWebhookDelivery is a request sent to a user webhook.
+type UserWebhookDelivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the delivery.
+	// Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The activity type of the request, e.g. "memos.memo.created".
+	ActivityType string `protobuf:"bytes,2,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	// The HTTP status code of the response, 0 if no response was received.
+	ResponseCode int32 `protobuf:"varint,3,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"`
+	// The error of the delivery, empty when it succeeded.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// The time the request was sent.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserWebhookDelivery) Reset() {
+	*x = UserWebhookDelivery{}
+	mi := &file_api_v1_user_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserWebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWebhookDelivery) ProtoMessage() {}
+
+func (x *UserWebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWebhookDelivery.ProtoReflect.Descriptor instead.
+func (*UserWebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UserWebhookDelivery) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetResponseCode() int32 {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return 0
+}
+
+func (x *UserWebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListUserWebhooksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent user resource.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhooksRequest) Reset() {
+	*x = ListUserWebhooksRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhooksRequest) ProtoMessage() {}
+
+func (x *ListUserWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListUserWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListUserWebhooksRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListUserWebhooksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of webhooks.
+	Webhooks      []*UserWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhooksResponse) Reset() {
+	*x = ListUserWebhooksResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhooksResponse) ProtoMessage() {}
+
+func (x *ListUserWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListUserWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListUserWebhooksResponse) GetWebhooks() []*UserWebhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type CreateUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent user resource.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The webhook to create.
+	Webhook       *UserWebhook `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserWebhookRequest) Reset() {
+	*x = CreateUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserWebhookRequest) ProtoMessage() {}
+
+func (x *CreateUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateUserWebhookRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateUserWebhookRequest) GetWebhook() *UserWebhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type UpdateUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The webhook to update.
+	Webhook *UserWebhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserWebhookRequest) Reset() {
+	*x = UpdateUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateUserWebhookRequest) GetWebhook() *UserWebhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *UpdateUserWebhookRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the webhook to delete.
+	// Format: users/{user}/webhooks/{webhook}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The etag of the webhook, the deletion fails with ABORTED if the webhook changed since.
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Optional. If set to true, deleting a webhook that does not exist succeeds.
+	AllowMissing  bool `protobuf:"varint,3,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserWebhookRequest) Reset() {
+	*x = DeleteUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteUserWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteUserWebhookRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *DeleteUserWebhookRequest) GetAllowMissing() bool {
+	if x != nil {
+		return x.AllowMissing
+	}
+	return false
+}
+
+type GetUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the webhook.
+	// Format: users/{user}/webhooks/{webhook}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserWebhookRequest) Reset() {
+	*x = GetUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserWebhookRequest) ProtoMessage() {}
+
+func (x *GetUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetUserWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RotateUserWebhookSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the webhook.
+	// Format: users/{user}/webhooks/{webhook}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateUserWebhookSecretRequest) Reset() {
+	*x = RotateUserWebhookSecretRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateUserWebhookSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateUserWebhookSecretRequest) ProtoMessage() {}
+
+func (x *RotateUserWebhookSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateUserWebhookSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateUserWebhookSecretRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RotateUserWebhookSecretRequest) GetName() string {
 	if x != nil {
-		return x.TotalSize
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-// User access token message
-type UserAccessToken struct {
+type TestUserWebhookRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The resource name of the access token.
-	// Format: users/{user}/accessTokens/{access_token}
+	// The name of the webhook to test.
+	// Format: users/{user}/webhooks/{webhook}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestUserWebhookRequest) Reset() {
+	*x = TestUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestUserWebhookRequest) ProtoMessage() {}
+
+func (x *TestUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*TestUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TestUserWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListUserWebhookDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent webhook.
+	// Format: users/{user}/webhooks/{webhook}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhookDeliveriesRequest) Reset() {
+	*x = ListUserWebhookDeliveriesRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListUserWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListUserWebhookDeliveriesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListUserWebhookDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The recent deliveries of the webhook.
+	Deliveries    []*UserWebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhookDeliveriesResponse) Reset() {
+	*x = ListUserWebhookDeliveriesResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListUserWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListUserWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListUserWebhookDeliveriesResponse) GetDeliveries() []*UserWebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// UserImportJob tracks an import of user data from another memos instance.
+type UserImportJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the import job.
+	// Format: users/{user}/importJob
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Output only. The access token value.
-	AccessToken string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	// The description of the access token.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Output only. The issued timestamp.
-	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
-	// Optional. The expiration timestamp.
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// The URL of the source memos instance.
+	SourceUrl string `protobuf:"bytes,2,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	// The state of the import job.
+	State UserImportJob_State `protobuf:"varint,3,opt,name=state,proto3,enum=memos.api.v1.UserImportJob_State" json:"state,omitempty"`
+	// The number of imported memos, including comments.
+	ImportedMemoCount int32 `protobuf:"varint,4,opt,name=imported_memo_count,json=importedMemoCount,proto3" json:"imported_memo_count,omitempty"`
+	// The number of imported attachments.
+	ImportedAttachmentCount int32 `protobuf:"varint,5,opt,name=imported_attachment_count,json=importedAttachmentCount,proto3" json:"imported_attachment_count,omitempty"`
+	// The number of imported memo relations.
+	ImportedRelationCount int32 `protobuf:"varint,6,opt,name=imported_relation_count,json=importedRelationCount,proto3" json:"imported_relation_count,omitempty"`
+	// The error message if the import job failed.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// The time when the import job was started.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time when the import job finished.
+	FinishTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserAccessToken) Reset() {
-	*x = UserAccessToken{}
-	mi := &file_api_v1_user_service_proto_msgTypes[17]
+func (x *UserImportJob) Reset() {
+	*x = UserImportJob{}
+	mi := &file_api_v1_user_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserAccessToken) String() string {
+func (x *UserImportJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAccessToken) ProtoMessage() {}
+func (*UserImportJob) ProtoMessage() {}
 
-func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[17]
+func (x *UserImportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1363,74 +3280,223 @@ func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAccessToken.ProtoReflect.Descriptor instead.
-func (*UserAccessToken) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UserImportJob.ProtoReflect.Descriptor instead.
+func (*UserImportJob) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UserAccessToken) GetName() string {
+func (x *UserImportJob) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UserAccessToken) GetAccessToken() string {
+func (x *UserImportJob) GetSourceUrl() string {
 	if x != nil {
-		return x.AccessToken
+		return x.SourceUrl
 	}
 	return ""
 }
 
-func (x *UserAccessToken) GetDescription() string {
+func (x *UserImportJob) GetState() UserImportJob_State {
 	if x != nil {
-		return x.Description
+		return x.State
+	}
+	return UserImportJob_STATE_UNSPECIFIED
+}
+
+func (x *UserImportJob) GetImportedMemoCount() int32 {
+	if x != nil {
+		return x.ImportedMemoCount
+	}
+	return 0
+}
+
+func (x *UserImportJob) GetImportedAttachmentCount() int32 {
+	if x != nil {
+		return x.ImportedAttachmentCount
+	}
+	return 0
+}
+
+func (x *UserImportJob) GetImportedRelationCount() int32 {
+	if x != nil {
+		return x.ImportedRelationCount
+	}
+	return 0
+}
+
+func (x *UserImportJob) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-func (x *UserAccessToken) GetIssuedAt() *timestamppb.Timestamp {
+func (x *UserImportJob) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IssuedAt
+		return x.CreateTime
 	}
 	return nil
 }
 
-func (x *UserAccessToken) GetExpiresAt() *timestamppb.Timestamp {
+func (x *UserImportJob) GetFinishTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ExpiresAt
+		return x.FinishTime
 	}
 	return nil
 }
 
-type ListUserAccessTokensRequest struct {
+type CreateUserImportJobRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The parent resource whose access tokens will be listed.
+	// Required. The user to import data for.
 	// Format: users/{user}
 	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// Optional. The maximum number of access tokens to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Required. The URL of the source memos instance.
+	// e.g. https://memos.example.com
+	SourceUrl string `protobuf:"bytes,2,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	// Required. An access token of the account on the source instance.
+	AccessToken   string `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserAccessTokensRequest) Reset() {
-	*x = ListUserAccessTokensRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[18]
+func (x *CreateUserImportJobRequest) Reset() {
+	*x = CreateUserImportJobRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserAccessTokensRequest) String() string {
+func (x *CreateUserImportJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserAccessTokensRequest) ProtoMessage() {}
+func (*CreateUserImportJobRequest) ProtoMessage() {}
 
-func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[18]
+func (x *CreateUserImportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserImportJobRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserImportJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateUserImportJobRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateUserImportJobRequest) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *CreateUserImportJobRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type GetUserImportJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the import job.
+	// Format: users/{user}/importJob
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserImportJobRequest) Reset() {
+	*x = GetUserImportJobRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserImportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserImportJobRequest) ProtoMessage() {}
+
+func (x *GetUserImportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserImportJobRequest.ProtoReflect.Descriptor instead.
+func (*GetUserImportJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetUserImportJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// The public profile of a user.
+type User_Profile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. A short introduction of the user, at most 1000 characters.
+	Bio string `protobuf:"bytes,1,opt,name=bio,proto3" json:"bio,omitempty"`
+	// Optional. Who the bio is shown to.
+	BioVisibility User_Profile_Visibility `protobuf:"varint,2,opt,name=bio_visibility,json=bioVisibility,proto3,enum=memos.api.v1.User_Profile_Visibility" json:"bio_visibility,omitempty"`
+	// Optional. The pronouns of the user, e.g. "they/them".
+	Pronouns string `protobuf:"bytes,3,opt,name=pronouns,proto3" json:"pronouns,omitempty"`
+	// Optional. Who the pronouns are shown to.
+	PronounsVisibility User_Profile_Visibility `protobuf:"varint,4,opt,name=pronouns_visibility,json=pronounsVisibility,proto3,enum=memos.api.v1.User_Profile_Visibility" json:"pronouns_visibility,omitempty"`
+	// Optional. The links of the user, e.g. to their website, at most 10.
+	Links []*User_Profile_Link `protobuf:"bytes,5,rep,name=links,proto3" json:"links,omitempty"`
+	// Optional. Who the links are shown to.
+	LinksVisibility User_Profile_Visibility `protobuf:"varint,6,opt,name=links_visibility,json=linksVisibility,proto3,enum=memos.api.v1.User_Profile_Visibility" json:"links_visibility,omitempty"`
+	// Optional. Whether the memo statistics of the user are shown to the other users. Unset is public.
+	PublicStats *bool `protobuf:"varint,7,opt,name=public_stats,json=publicStats,proto3,oneof" json:"public_stats,omitempty"`
+	// Optional. Whether the activity calendar of the user is shared with the other users.
+	PublicActivityCalendar bool `protobuf:"varint,8,opt,name=public_activity_calendar,json=publicActivityCalendar,proto3" json:"public_activity_calendar,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *User_Profile) Reset() {
+	*x = User_Profile{}
+	mi := &file_api_v1_user_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User_Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User_Profile) ProtoMessage() {}
+
+func (x *User_Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1438,62 +3504,96 @@ func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User_Profile.ProtoReflect.Descriptor instead.
+func (*User_Profile) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *User_Profile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *User_Profile) GetBioVisibility() User_Profile_Visibility {
+	if x != nil {
+		return x.BioVisibility
+	}
+	return User_Profile_VISIBILITY_UNSPECIFIED
+}
+
+func (x *User_Profile) GetPronouns() string {
+	if x != nil {
+		return x.Pronouns
+	}
+	return ""
 }
 
-// Deprecated: Use ListUserAccessTokensRequest.ProtoReflect.Descriptor instead.
-func (*ListUserAccessTokensRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18}
+func (x *User_Profile) GetPronounsVisibility() User_Profile_Visibility {
+	if x != nil {
+		return x.PronounsVisibility
+	}
+	return User_Profile_VISIBILITY_UNSPECIFIED
 }
 
-func (x *ListUserAccessTokensRequest) GetParent() string {
+func (x *User_Profile) GetLinks() []*User_Profile_Link {
 	if x != nil {
-		return x.Parent
+		return x.Links
 	}
-	return ""
+	return nil
 }
 
-func (x *ListUserAccessTokensRequest) GetPageSize() int32 {
+func (x *User_Profile) GetLinksVisibility() User_Profile_Visibility {
 	if x != nil {
-		return x.PageSize
+		return x.LinksVisibility
 	}
-	return 0
+	return User_Profile_VISIBILITY_UNSPECIFIED
 }
 
-func (x *ListUserAccessTokensRequest) GetPageToken() string {
+func (x *User_Profile) GetPublicStats() bool {
+	if x != nil && x.PublicStats != nil {
+		return *x.PublicStats
+	}
+	return false
+}
+
+func (x *User_Profile) GetPublicActivityCalendar() bool {
 	if x != nil {
-		return x.PageToken
+		return x.PublicActivityCalendar
 	}
-	return ""
+	return false
 }
 
-type ListUserAccessTokensResponse struct {
+// A link of the profile.
+type User_Profile_Link struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of access tokens.
-	AccessTokens []*UserAccessToken `protobuf:"bytes,1,rep,name=access_tokens,json=accessTokens,proto3" json:"access_tokens,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of access tokens.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// Optional. The title of the link.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Required. The http or https URL of the link.
+	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserAccessTokensResponse) Reset() {
-	*x = ListUserAccessTokensResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[19]
+func (x *User_Profile_Link) Reset() {
+	*x = User_Profile_Link{}
+	mi := &file_api_v1_user_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserAccessTokensResponse) String() string {
+func (x *User_Profile_Link) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserAccessTokensResponse) ProtoMessage() {}
+func (*User_Profile_Link) ProtoMessage() {}
 
-func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[19]
+func (x *User_Profile_Link) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1504,60 +3604,51 @@ func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserAccessTokensResponse.ProtoReflect.Descriptor instead.
-func (*ListUserAccessTokensResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *ListUserAccessTokensResponse) GetAccessTokens() []*UserAccessToken {
-	if x != nil {
-		return x.AccessTokens
-	}
-	return nil
+// Deprecated: Use User_Profile_Link.ProtoReflect.Descriptor instead.
+func (*User_Profile_Link) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{0, 0, 0}
 }
 
-func (x *ListUserAccessTokensResponse) GetNextPageToken() string {
+func (x *User_Profile_Link) GetTitle() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Title
 	}
 	return ""
 }
 
-func (x *ListUserAccessTokensResponse) GetTotalSize() int32 {
+func (x *User_Profile_Link) GetUrl() string {
 	if x != nil {
-		return x.TotalSize
+		return x.Url
 	}
-	return 0
+	return ""
 }
 
-type CreateUserAccessTokenRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The parent resource where this access token will be created.
-	// Format: users/{user}
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// Required. The access token to create.
-	AccessToken *UserAccessToken `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	// Optional. The access token ID to use.
-	AccessTokenId string `protobuf:"bytes,3,opt,name=access_token_id,json=accessTokenId,proto3" json:"access_token_id,omitempty"`
+// Memo type statistics.
+type UserStats_MemoTypeStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkCount     int32                  `protobuf:"varint,1,opt,name=link_count,json=linkCount,proto3" json:"link_count,omitempty"`
+	CodeCount     int32                  `protobuf:"varint,2,opt,name=code_count,json=codeCount,proto3" json:"code_count,omitempty"`
+	TodoCount     int32                  `protobuf:"varint,3,opt,name=todo_count,json=todoCount,proto3" json:"todo_count,omitempty"`
+	UndoCount     int32                  `protobuf:"varint,4,opt,name=undo_count,json=undoCount,proto3" json:"undo_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserAccessTokenRequest) Reset() {
-	*x = CreateUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[20]
+func (x *UserStats_MemoTypeStats) Reset() {
+	*x = UserStats_MemoTypeStats{}
+	mi := &file_api_v1_user_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserAccessTokenRequest) String() string {
+func (x *UserStats_MemoTypeStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserAccessTokenRequest) ProtoMessage() {}
+func (*UserStats_MemoTypeStats) ProtoMessage() {}
 
-func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[20]
+func (x *UserStats_MemoTypeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1568,56 +3659,64 @@ func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserAccessTokenRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use UserStats_MemoTypeStats.ProtoReflect.Descriptor instead.
+func (*UserStats_MemoTypeStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12, 1}
 }
 
-func (x *CreateUserAccessTokenRequest) GetParent() string {
+func (x *UserStats_MemoTypeStats) GetLinkCount() int32 {
 	if x != nil {
-		return x.Parent
+		return x.LinkCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateUserAccessTokenRequest) GetAccessToken() *UserAccessToken {
+func (x *UserStats_MemoTypeStats) GetCodeCount() int32 {
 	if x != nil {
-		return x.AccessToken
+		return x.CodeCount
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateUserAccessTokenRequest) GetAccessTokenId() string {
+func (x *UserStats_MemoTypeStats) GetTodoCount() int32 {
 	if x != nil {
-		return x.AccessTokenId
+		return x.TodoCount
 	}
-	return ""
+	return 0
 }
 
-type DeleteUserAccessTokenRequest struct {
+func (x *UserStats_MemoTypeStats) GetUndoCount() int32 {
+	if x != nil {
+		return x.UndoCount
+	}
+	return 0
+}
+
+type UserActivityCalendar_Day struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the access token to delete.
-	// Format: users/{user}/accessTokens/{access_token}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The date of the day, in the format "YYYY-MM-DD".
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// The intensity level of the memos of the day, from 0 for none to max_level for the busiest days of the calendar.
+	Level         int32 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserAccessTokenRequest) Reset() {
-	*x = DeleteUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[21]
+func (x *UserActivityCalendar_Day) Reset() {
+	*x = UserActivityCalendar_Day{}
+	mi := &file_api_v1_user_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserAccessTokenRequest) String() string {
+func (x *UserActivityCalendar_Day) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserAccessTokenRequest) ProtoMessage() {}
+func (*UserActivityCalendar_Day) ProtoMessage() {}
 
-func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[21]
+func (x *UserActivityCalendar_Day) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1628,51 +3727,70 @@ func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserAccessTokenRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use UserActivityCalendar_Day.ProtoReflect.Descriptor instead.
+func (*UserActivityCalendar_Day) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{15, 0}
 }
 
-func (x *DeleteUserAccessTokenRequest) GetName() string {
+func (x *UserActivityCalendar_Day) GetDate() string {
 	if x != nil {
-		return x.Name
+		return x.Date
 	}
 	return ""
 }
 
-type UserSession struct {
+func (x *UserActivityCalendar_Day) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// General user settings configuration.
+type UserSetting_GeneralSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The resource name of the session.
-	// Format: users/{user}/sessions/{session}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The session ID.
-	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	// The timestamp when the session was created.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// The timestamp when the session was last accessed.
-	// Used for sliding expiration calculation (last_accessed_time + 2 weeks).
-	LastAccessedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_accessed_time,json=lastAccessedTime,proto3" json:"last_accessed_time,omitempty"`
-	// Client information associated with this session.
-	ClientInfo    *UserSession_ClientInfo `protobuf:"bytes,5,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
+	// The preferred locale of the user.
+	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	// The default visibility of the memo.
+	MemoVisibility string `protobuf:"bytes,3,opt,name=memo_visibility,json=memoVisibility,proto3" json:"memo_visibility,omitempty"`
+	// The preferred theme of the user.
+	// This references a CSS file in the web/public/themes/ directory.
+	// If not set, the default theme will be used.
+	Theme string `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
+	// Whether links in the user's memos are submitted to the Wayback Machine
+	// so referenced content survives link rot.
+	ArchiveLinks bool `protobuf:"varint,5,opt,name=archive_links,json=archiveLinks,proto3" json:"archive_links,omitempty"`
+	// The number of reactions to a memo received at once from which they are notified
+	// as a single aggregated notification, e.g. "5 people reacted to your memo".
+	// If not set, the default threshold will be used.
+	ReactionNotificationThreshold int32 `protobuf:"varint,6,opt,name=reaction_notification_threshold,json=reactionNotificationThreshold,proto3" json:"reaction_notification_threshold,omitempty"`
+	// The IANA timezone of the user, e.g. "Europe/Paris".
+	// If not set, UTC will be used.
+	Timezone string `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// The first day of the week of the user, from 0 for Sunday to 6 for Saturday.
+	WeekStartDay int32 `protobuf:"varint,8,opt,name=week_start_day,json=weekStartDay,proto3" json:"week_start_day,omitempty"`
+	// Whether the user opts in to the AI processing of their content in the AI operations of the other users,
+	// e.g. the workspace summaries. The AI operations of the user on their own memos do not need it.
+	AiOptIn       bool `protobuf:"varint,9,opt,name=ai_opt_in,json=aiOptIn,proto3" json:"ai_opt_in,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserSession) Reset() {
-	*x = UserSession{}
-	mi := &file_api_v1_user_service_proto_msgTypes[22]
+func (x *UserSetting_GeneralSetting) Reset() {
+	*x = UserSetting_GeneralSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSession) String() string {
+func (x *UserSetting_GeneralSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSession) ProtoMessage() {}
+func (*UserSetting_GeneralSetting) ProtoMessage() {}
 
-func (x *UserSession) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[22]
+func (x *UserSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1683,70 +3801,91 @@ func (x *UserSession) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSession.ProtoReflect.Descriptor instead.
-func (*UserSession) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UserSetting_GeneralSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_GeneralSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 0}
 }
 
-func (x *UserSession) GetName() string {
+func (x *UserSetting_GeneralSetting) GetLocale() string {
 	if x != nil {
-		return x.Name
+		return x.Locale
 	}
 	return ""
 }
 
-func (x *UserSession) GetSessionId() string {
+func (x *UserSetting_GeneralSetting) GetMemoVisibility() string {
 	if x != nil {
-		return x.SessionId
+		return x.MemoVisibility
 	}
 	return ""
 }
 
-func (x *UserSession) GetCreateTime() *timestamppb.Timestamp {
+func (x *UserSetting_GeneralSetting) GetTheme() string {
 	if x != nil {
-		return x.CreateTime
+		return x.Theme
 	}
-	return nil
+	return ""
 }
 
-func (x *UserSession) GetLastAccessedTime() *timestamppb.Timestamp {
+func (x *UserSetting_GeneralSetting) GetArchiveLinks() bool {
 	if x != nil {
-		return x.LastAccessedTime
+		return x.ArchiveLinks
 	}
-	return nil
+	return false
 }
 
-func (x *UserSession) GetClientInfo() *UserSession_ClientInfo {
+func (x *UserSetting_GeneralSetting) GetReactionNotificationThreshold() int32 {
 	if x != nil {
-		return x.ClientInfo
+		return x.ReactionNotificationThreshold
 	}
-	return nil
+	return 0
 }
 
-type ListUserSessionsRequest struct {
+func (x *UserSetting_GeneralSetting) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UserSetting_GeneralSetting) GetWeekStartDay() int32 {
+	if x != nil {
+		return x.WeekStartDay
+	}
+	return 0
+}
+
+func (x *UserSetting_GeneralSetting) GetAiOptIn() bool {
+	if x != nil {
+		return x.AiOptIn
+	}
+	return false
+}
+
+// User authentication sessions configuration.
+type UserSetting_SessionsSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the parent.
-	// Format: users/{user}
-	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// List of active user sessions.
+	Sessions      []*UserSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserSessionsRequest) Reset() {
-	*x = ListUserSessionsRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[23]
+func (x *UserSetting_SessionsSetting) Reset() {
+	*x = UserSetting_SessionsSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserSessionsRequest) String() string {
+func (x *UserSetting_SessionsSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserSessionsRequest) ProtoMessage() {}
+func (*UserSetting_SessionsSetting) ProtoMessage() {}
 
-func (x *ListUserSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[23]
+func (x *UserSetting_SessionsSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1757,41 +3896,42 @@ func (x *ListUserSessionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserSessionsRequest.ProtoReflect.Descriptor instead.
-func (*ListUserSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UserSetting_SessionsSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_SessionsSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 1}
 }
 
-func (x *ListUserSessionsRequest) GetParent() string {
+func (x *UserSetting_SessionsSetting) GetSessions() []*UserSession {
 	if x != nil {
-		return x.Parent
+		return x.Sessions
 	}
-	return ""
+	return nil
 }
 
-type ListUserSessionsResponse struct {
+// User access tokens configuration.
+type UserSetting_AccessTokensSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of user sessions.
-	Sessions      []*UserSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// List of user access tokens.
+	AccessTokens  []*UserAccessToken `protobuf:"bytes,1,rep,name=access_tokens,json=accessTokens,proto3" json:"access_tokens,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserSessionsResponse) Reset() {
-	*x = ListUserSessionsResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[24]
+func (x *UserSetting_AccessTokensSetting) Reset() {
+	*x = UserSetting_AccessTokensSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserSessionsResponse) String() string {
+func (x *UserSetting_AccessTokensSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserSessionsResponse) ProtoMessage() {}
+func (*UserSetting_AccessTokensSetting) ProtoMessage() {}
 
-func (x *ListUserSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[24]
+func (x *UserSetting_AccessTokensSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1802,42 +3942,42 @@ func (x *ListUserSessionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserSessionsResponse.ProtoReflect.Descriptor instead.
-func (*ListUserSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use UserSetting_AccessTokensSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_AccessTokensSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 2}
 }
 
-func (x *ListUserSessionsResponse) GetSessions() []*UserSession {
+func (x *UserSetting_AccessTokensSetting) GetAccessTokens() []*UserAccessToken {
 	if x != nil {
-		return x.Sessions
+		return x.AccessTokens
 	}
 	return nil
 }
 
-type RevokeUserSessionRequest struct {
+// User webhooks configuration.
+type UserSetting_WebhooksSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the session to revoke.
-	// Format: users/{user}/sessions/{session}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// List of user webhooks.
+	Webhooks      []*UserWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeUserSessionRequest) Reset() {
-	*x = RevokeUserSessionRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[25]
+func (x *UserSetting_WebhooksSetting) Reset() {
+	*x = UserSetting_WebhooksSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeUserSessionRequest) String() string {
+func (x *UserSetting_WebhooksSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeUserSessionRequest) ProtoMessage() {}
+func (*UserSetting_WebhooksSetting) ProtoMessage() {}
 
-func (x *RevokeUserSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[25]
+func (x *UserSetting_WebhooksSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1848,51 +3988,67 @@ func (x *RevokeUserSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeUserSessionRequest.ProtoReflect.Descriptor instead.
-func (*RevokeUserSessionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use UserSetting_WebhooksSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_WebhooksSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 3}
 }
 
-func (x *RevokeUserSessionRequest) GetName() string {
+func (x *UserSetting_WebhooksSetting) GetWebhooks() []*UserWebhook {
 	if x != nil {
-		return x.Name
+		return x.Webhooks
 	}
-	return ""
+	return nil
 }
 
-// UserWebhook represents a webhook owned by a user.
-type UserWebhook struct {
+// AI auto summary configuration.
+type UserSetting_AIAutoSummarySetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the webhook.
-	// Format: users/{user}/webhooks/{webhook}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The URL to send the webhook to.
-	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	// Optional. Human-readable name for the webhook.
-	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// The creation time of the webhook.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// The last update time of the webhook.
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// The frequency in days for automatic summary generation.
+	// Minimum value is 1 day.
+	FrequencyDays int32 `protobuf:"varint,1,opt,name=frequency_days,json=frequencyDays,proto3" json:"frequency_days,omitempty"`
+	// Whether automatic summary generation is enabled.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The count of consecutive failures.
+	// When this reaches 3, automatic summary will be disabled.
+	FailureCount int32 `protobuf:"varint,3,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// The hour of the day in the timezone, from 0 to 23, from which the summaries are generated.
+	Hour int32 `protobuf:"varint,4,opt,name=hour,proto3" json:"hour,omitempty"`
+	// The day of the week of the summaries, from 1 for Monday to 7 for Sunday.
+	// If not set, the summaries are generated on any day.
+	DayOfWeek int32 `protobuf:"varint,5,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	// The number of days before the summary whose memos are summarized.
+	// If not set, the frequency is used, e.g. the last 7 days for a weekly digest.
+	TimeRangeDays int32 `protobuf:"varint,6,opt,name=time_range_days,json=timeRangeDays,proto3" json:"time_range_days,omitempty"`
+	// The tags the summarized memos are restricted to, without the leading "#".
+	// If empty, all memos are summarized.
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The IANA timezone of the hour, e.g. "Europe/Paris".
+	// If not set, UTC is used.
+	Timezone string `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Output only. The time of the last summary attempt.
+	LastRunTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	// The visibility of the summary memos, "PUBLIC", "PROTECTED" or "PRIVATE".
+	// If not set, the summaries are private.
+	Visibility    string `protobuf:"bytes,10,opt,name=visibility,proto3" json:"visibility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserWebhook) Reset() {
-	*x = UserWebhook{}
-	mi := &file_api_v1_user_service_proto_msgTypes[26]
+func (x *UserSetting_AIAutoSummarySetting) Reset() {
+	*x = UserSetting_AIAutoSummarySetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserWebhook) String() string {
+func (x *UserSetting_AIAutoSummarySetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserWebhook) ProtoMessage() {}
+func (*UserSetting_AIAutoSummarySetting) ProtoMessage() {}
 
-func (x *UserWebhook) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[26]
+func (x *UserSetting_AIAutoSummarySetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1903,115 +4059,115 @@ func (x *UserWebhook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserWebhook.ProtoReflect.Descriptor instead.
-func (*UserWebhook) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use UserSetting_AIAutoSummarySetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_AIAutoSummarySetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 4}
 }
 
-func (x *UserWebhook) GetName() string {
+func (x *UserSetting_AIAutoSummarySetting) GetFrequencyDays() int32 {
 	if x != nil {
-		return x.Name
+		return x.FrequencyDays
 	}
-	return ""
+	return 0
 }
 
-func (x *UserWebhook) GetUrl() string {
+func (x *UserSetting_AIAutoSummarySetting) GetEnabled() bool {
 	if x != nil {
-		return x.Url
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *UserWebhook) GetDisplayName() string {
+func (x *UserSetting_AIAutoSummarySetting) GetFailureCount() int32 {
 	if x != nil {
-		return x.DisplayName
+		return x.FailureCount
 	}
-	return ""
+	return 0
 }
 
-func (x *UserWebhook) GetCreateTime() *timestamppb.Timestamp {
+func (x *UserSetting_AIAutoSummarySetting) GetHour() int32 {
 	if x != nil {
-		return x.CreateTime
+		return x.Hour
 	}
-	return nil
+	return 0
 }
 
-func (x *UserWebhook) GetUpdateTime() *timestamppb.Timestamp {
+func (x *UserSetting_AIAutoSummarySetting) GetDayOfWeek() int32 {
 	if x != nil {
-		return x.UpdateTime
+		return x.DayOfWeek
 	}
-	return nil
-}
-
-type ListUserWebhooksRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent user resource.
-	// Format: users/{user}
-	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *ListUserWebhooksRequest) Reset() {
-	*x = ListUserWebhooksRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UserSetting_AIAutoSummarySetting) GetTimeRangeDays() int32 {
+	if x != nil {
+		return x.TimeRangeDays
+	}
+	return 0
 }
 
-func (x *ListUserWebhooksRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserSetting_AIAutoSummarySetting) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
 }
 
-func (*ListUserWebhooksRequest) ProtoMessage() {}
-
-func (x *ListUserWebhooksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[27]
+func (x *UserSetting_AIAutoSummarySetting) GetTimezone() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Timezone
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListUserWebhooksRequest.ProtoReflect.Descriptor instead.
-func (*ListUserWebhooksRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{27}
+func (x *UserSetting_AIAutoSummarySetting) GetLastRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunTime
+	}
+	return nil
 }
 
-func (x *ListUserWebhooksRequest) GetParent() string {
+func (x *UserSetting_AIAutoSummarySetting) GetVisibility() string {
 	if x != nil {
-		return x.Parent
+		return x.Visibility
 	}
 	return ""
 }
 
-type ListUserWebhooksResponse struct {
+// Nostr publishing configuration.
+// The public memos are published as notes signed with the key of the user, their edits replace the notes
+// and their deletions delete them.
+type UserSetting_NostrSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of webhooks.
-	Webhooks      []*UserWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	// Whether the public memos are published to the relays.
+	// A key is generated when the publishing is enabled without one.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The URLs of the relays, e.g. "wss://relay.example.com".
+	Relays []string `protobuf:"bytes,2,rep,name=relays,proto3" json:"relays,omitempty"`
+	// Input only. A private key to sign the notes with instead of a generated one, as "nsec1..." or hex.
+	PrivateKey string `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// Output only. The public key the notes are signed with, as "npub1...".
+	// Empty before a key is generated or set.
+	PublicKey     string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserWebhooksResponse) Reset() {
-	*x = ListUserWebhooksResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[28]
+func (x *UserSetting_NostrSetting) Reset() {
+	*x = UserSetting_NostrSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserWebhooksResponse) String() string {
+func (x *UserSetting_NostrSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserWebhooksResponse) ProtoMessage() {}
+func (*UserSetting_NostrSetting) ProtoMessage() {}
 
-func (x *ListUserWebhooksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[28]
+func (x *UserSetting_NostrSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2022,98 +4178,76 @@ func (x *ListUserWebhooksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserWebhooksResponse.ProtoReflect.Descriptor instead.
-func (*ListUserWebhooksResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UserSetting_NostrSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_NostrSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 5}
 }
 
-func (x *ListUserWebhooksResponse) GetWebhooks() []*UserWebhook {
+func (x *UserSetting_NostrSetting) GetEnabled() bool {
 	if x != nil {
-		return x.Webhooks
+		return x.Enabled
 	}
-	return nil
-}
-
-type CreateUserWebhookRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent user resource.
-	// Format: users/{user}
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The webhook to create.
-	Webhook       *UserWebhook `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateUserWebhookRequest) Reset() {
-	*x = CreateUserWebhookRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[29]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateUserWebhookRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return false
 }
 
-func (*CreateUserWebhookRequest) ProtoMessage() {}
-
-func (x *CreateUserWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[29]
+func (x *UserSetting_NostrSetting) GetRelays() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Relays
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateUserWebhookRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{29}
+	return nil
 }
 
-func (x *CreateUserWebhookRequest) GetParent() string {
+func (x *UserSetting_NostrSetting) GetPrivateKey() string {
 	if x != nil {
-		return x.Parent
+		return x.PrivateKey
 	}
 	return ""
 }
 
-func (x *CreateUserWebhookRequest) GetWebhook() *UserWebhook {
+func (x *UserSetting_NostrSetting) GetPublicKey() string {
 	if x != nil {
-		return x.Webhook
+		return x.PublicKey
 	}
-	return nil
+	return ""
 }
 
-type UpdateUserWebhookRequest struct {
+// Bluesky cross-posting configuration.
+// A public memo is posted once, truncated to fit with a link back to the memo.
+// Its post is deleted when the memo is deleted, archived, no longer public or no longer has the tag.
+type UserSetting_BlueskySetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The webhook to update.
-	Webhook *UserWebhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
-	// The list of fields to update.
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Whether the public memos are cross-posted.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The URL of the server hosting the account. Default to "https://bsky.social".
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// The handle, email or DID of the account, e.g. "alice.bsky.social".
+	Identifier string `protobuf:"bytes,3,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// Input only. An app password of the account.
+	AppPassword string `protobuf:"bytes,4,opt,name=app_password,json=appPassword,proto3" json:"app_password,omitempty"`
+	// The tag the memos must have to be cross-posted, including its subtags, e.g. "blog".
+	// Empty to cross-post all public memos.
+	Tag string `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Output only. The DID of the account, set once the credentials are verified.
+	Did           string `protobuf:"bytes,6,opt,name=did,proto3" json:"did,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserWebhookRequest) Reset() {
-	*x = UpdateUserWebhookRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[30]
+func (x *UserSetting_BlueskySetting) Reset() {
+	*x = UserSetting_BlueskySetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserWebhookRequest) String() string {
+func (x *UserSetting_BlueskySetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserWebhookRequest) ProtoMessage() {}
+func (*UserSetting_BlueskySetting) ProtoMessage() {}
 
-func (x *UpdateUserWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[30]
+func (x *UserSetting_BlueskySetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2124,97 +4258,91 @@ func (x *UpdateUserWebhookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserWebhookRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *UpdateUserWebhookRequest) GetWebhook() *UserWebhook {
-	if x != nil {
-		return x.Webhook
-	}
-	return nil
+// Deprecated: Use UserSetting_BlueskySetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_BlueskySetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 6}
 }
 
-func (x *UpdateUserWebhookRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *UserSetting_BlueskySetting) GetEnabled() bool {
 	if x != nil {
-		return x.UpdateMask
+		return x.Enabled
 	}
-	return nil
-}
-
-type DeleteUserWebhookRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the webhook to delete.
-	// Format: users/{user}/webhooks/{webhook}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteUserWebhookRequest) Reset() {
-	*x = DeleteUserWebhookRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return false
 }
 
-func (x *DeleteUserWebhookRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserSetting_BlueskySetting) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
 }
 
-func (*DeleteUserWebhookRequest) ProtoMessage() {}
+func (x *UserSetting_BlueskySetting) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
 
-func (x *DeleteUserWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[31]
+func (x *UserSetting_BlueskySetting) GetAppPassword() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AppPassword
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteUserWebhookRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31}
+func (x *UserSetting_BlueskySetting) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
 }
 
-func (x *DeleteUserWebhookRequest) GetName() string {
+func (x *UserSetting_BlueskySetting) GetDid() string {
 	if x != nil {
-		return x.Name
+		return x.Did
 	}
 	return ""
 }
 
-// Memo type statistics.
-type UserStats_MemoTypeStats struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LinkCount     int32                  `protobuf:"varint,1,opt,name=link_count,json=linkCount,proto3" json:"link_count,omitempty"`
-	CodeCount     int32                  `protobuf:"varint,2,opt,name=code_count,json=codeCount,proto3" json:"code_count,omitempty"`
-	TodoCount     int32                  `protobuf:"varint,3,opt,name=todo_count,json=todoCount,proto3" json:"todo_count,omitempty"`
-	UndoCount     int32                  `protobuf:"varint,4,opt,name=undo_count,json=undoCount,proto3" json:"undo_count,omitempty"`
+// Mastodon cross-posting configuration.
+// A public memo is posted once with its attachments, its content warning as the content warning
+// of the post and a link back to the memo. The URL of the post is recorded in the syndications
+// of the memo, and the post is deleted when the memo is deleted, archived, no longer public or
+// no longer has the tag.
+type UserSetting_MastodonSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the public memos are cross-posted.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The base URL of the Mastodon instance of the account, e.g. "https://mastodon.social".
+	InstanceUrl string `protobuf:"bytes,2,opt,name=instance_url,json=instanceUrl,proto3" json:"instance_url,omitempty"`
+	// Input only. An access token of the account with the write:statuses and write:media scopes.
+	AccessToken string `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The tag the memos must have to be cross-posted, including its subtags, e.g. "blog".
+	// Empty to cross-post all public memos.
+	Tag string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Output only. The address of the account, e.g. "alice@mastodon.social", set once the
+	// credentials are verified.
+	Account       string `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserStats_MemoTypeStats) Reset() {
-	*x = UserStats_MemoTypeStats{}
-	mi := &file_api_v1_user_service_proto_msgTypes[33]
+func (x *UserSetting_MastodonSetting) Reset() {
+	*x = UserSetting_MastodonSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserStats_MemoTypeStats) String() string {
+func (x *UserSetting_MastodonSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserStats_MemoTypeStats) ProtoMessage() {}
+func (*UserSetting_MastodonSetting) ProtoMessage() {}
 
-func (x *UserStats_MemoTypeStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[33]
+func (x *UserSetting_MastodonSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,69 +4353,82 @@ func (x *UserStats_MemoTypeStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserStats_MemoTypeStats.ProtoReflect.Descriptor instead.
-func (*UserStats_MemoTypeStats) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{8, 1}
+// Deprecated: Use UserSetting_MastodonSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_MastodonSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 7}
 }
 
-func (x *UserStats_MemoTypeStats) GetLinkCount() int32 {
+func (x *UserSetting_MastodonSetting) GetEnabled() bool {
 	if x != nil {
-		return x.LinkCount
+		return x.Enabled
 	}
-	return 0
+	return false
 }
 
-func (x *UserStats_MemoTypeStats) GetCodeCount() int32 {
+func (x *UserSetting_MastodonSetting) GetInstanceUrl() string {
 	if x != nil {
-		return x.CodeCount
+		return x.InstanceUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *UserStats_MemoTypeStats) GetTodoCount() int32 {
+func (x *UserSetting_MastodonSetting) GetAccessToken() string {
 	if x != nil {
-		return x.TodoCount
+		return x.AccessToken
 	}
-	return 0
+	return ""
 }
 
-func (x *UserStats_MemoTypeStats) GetUndoCount() int32 {
+func (x *UserSetting_MastodonSetting) GetTag() string {
 	if x != nil {
-		return x.UndoCount
+		return x.Tag
 	}
-	return 0
+	return ""
 }
 
-// General user settings configuration.
-type UserSetting_GeneralSetting struct {
+func (x *UserSetting_MastodonSetting) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+// Micropub cross-posting configuration, e.g. to a personal website.
+// A public memo is posted once as an h-entry with its tags as categories and its attachments
+// uploaded to the media endpoint, if any. The URL of the entry is recorded in the syndications
+// of the memo, and the entry is deleted like the Mastodon posts.
+type UserSetting_MicropubSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The preferred locale of the user.
-	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
-	// The default visibility of the memo.
-	MemoVisibility string `protobuf:"bytes,3,opt,name=memo_visibility,json=memoVisibility,proto3" json:"memo_visibility,omitempty"`
-	// The preferred theme of the user.
-	// This references a CSS file in the web/public/themes/ directory.
-	// If not set, the default theme will be used.
-	Theme         string `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
+	// Whether the public memos are cross-posted.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The URL of the Micropub endpoint.
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Input only. An access token of the endpoint with the create, delete and media scopes.
+	AccessToken string `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The tag the memos must have to be cross-posted, including its subtags, e.g. "blog".
+	// Empty to cross-post all public memos.
+	Tag string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Output only. The URL of the media endpoint, discovered once the credentials are verified.
+	MediaEndpoint string `protobuf:"bytes,5,opt,name=media_endpoint,json=mediaEndpoint,proto3" json:"media_endpoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserSetting_GeneralSetting) Reset() {
-	*x = UserSetting_GeneralSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[34]
+func (x *UserSetting_MicropubSetting) Reset() {
+	*x = UserSetting_MicropubSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSetting_GeneralSetting) String() string {
+func (x *UserSetting_MicropubSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSetting_GeneralSetting) ProtoMessage() {}
+func (*UserSetting_MicropubSetting) ProtoMessage() {}
 
-func (x *UserSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[34]
+func (x *UserSetting_MicropubSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2298,56 +4439,76 @@ func (x *UserSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSetting_GeneralSetting.ProtoReflect.Descriptor instead.
-func (*UserSetting_GeneralSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12, 0}
+// Deprecated: Use UserSetting_MicropubSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_MicropubSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 8}
 }
 
-func (x *UserSetting_GeneralSetting) GetLocale() string {
+func (x *UserSetting_MicropubSetting) GetEnabled() bool {
 	if x != nil {
-		return x.Locale
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UserSetting_MicropubSetting) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
 	}
 	return ""
 }
 
-func (x *UserSetting_GeneralSetting) GetMemoVisibility() string {
+func (x *UserSetting_MicropubSetting) GetAccessToken() string {
 	if x != nil {
-		return x.MemoVisibility
+		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *UserSetting_GeneralSetting) GetTheme() string {
+func (x *UserSetting_MicropubSetting) GetTag() string {
 	if x != nil {
-		return x.Theme
+		return x.Tag
 	}
 	return ""
 }
 
-// User authentication sessions configuration.
-type UserSetting_SessionsSetting struct {
+func (x *UserSetting_MicropubSetting) GetMediaEndpoint() string {
+	if x != nil {
+		return x.MediaEndpoint
+	}
+	return ""
+}
+
+// The IndieAuth identity of the user. The Micropub endpoint of the user, /micropub/users/{id}, accepts the
+// access tokens issued for the URL of the identity, e.g. by an editor like Quill, which are verified by the
+// token endpoint of the identity. The page of the URL must advertise the Micropub endpoint of the user with
+// rel="micropub", proving the user owns it, and its token endpoint with rel="token_endpoint".
+type UserSetting_IndieAuthSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of active user sessions.
-	Sessions      []*UserSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// The URL identifying the user, e.g. "https://example.com/". Empty to not accept IndieAuth tokens.
+	Me string `protobuf:"bytes,1,opt,name=me,proto3" json:"me,omitempty"`
+	// The token endpoint verifying the access tokens, e.g. "https://tokens.indieauth.com/token". It is
+	// discovered on the page of the URL, and must be the advertised one when set.
+	TokenEndpoint string `protobuf:"bytes,2,opt,name=token_endpoint,json=tokenEndpoint,proto3" json:"token_endpoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserSetting_SessionsSetting) Reset() {
-	*x = UserSetting_SessionsSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[35]
+func (x *UserSetting_IndieAuthSetting) Reset() {
+	*x = UserSetting_IndieAuthSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSetting_SessionsSetting) String() string {
+func (x *UserSetting_IndieAuthSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSetting_SessionsSetting) ProtoMessage() {}
+func (*UserSetting_IndieAuthSetting) ProtoMessage() {}
 
-func (x *UserSetting_SessionsSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[35]
+func (x *UserSetting_IndieAuthSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2358,42 +4519,50 @@ func (x *UserSetting_SessionsSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSetting_SessionsSetting.ProtoReflect.Descriptor instead.
-func (*UserSetting_SessionsSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12, 1}
+// Deprecated: Use UserSetting_IndieAuthSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_IndieAuthSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 9}
 }
 
-func (x *UserSetting_SessionsSetting) GetSessions() []*UserSession {
+func (x *UserSetting_IndieAuthSetting) GetMe() string {
 	if x != nil {
-		return x.Sessions
+		return x.Me
 	}
-	return nil
+	return ""
 }
 
-// User access tokens configuration.
-type UserSetting_AccessTokensSetting struct {
+func (x *UserSetting_IndieAuthSetting) GetTokenEndpoint() string {
+	if x != nil {
+		return x.TokenEndpoint
+	}
+	return ""
+}
+
+// The rules tagging the memos of the user when they are created or updated, e.g. adding "#work" to the memos
+// created between 9 and 17 on weekdays. The times are in the timezone of the general setting of the user.
+type UserSetting_TaggingRulesSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of user access tokens.
-	AccessTokens  []*UserAccessToken `protobuf:"bytes,1,rep,name=access_tokens,json=accessTokens,proto3" json:"access_tokens,omitempty"`
+	// The rules, evaluated in order. All the conditions of a rule must hold for its actions to apply.
+	Rules         []*UserSetting_TaggingRulesSetting_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserSetting_AccessTokensSetting) Reset() {
-	*x = UserSetting_AccessTokensSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[36]
+func (x *UserSetting_TaggingRulesSetting) Reset() {
+	*x = UserSetting_TaggingRulesSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSetting_AccessTokensSetting) String() string {
+func (x *UserSetting_TaggingRulesSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSetting_AccessTokensSetting) ProtoMessage() {}
+func (*UserSetting_TaggingRulesSetting) ProtoMessage() {}
 
-func (x *UserSetting_AccessTokensSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[36]
+func (x *UserSetting_TaggingRulesSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2404,42 +4573,50 @@ func (x *UserSetting_AccessTokensSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSetting_AccessTokensSetting.ProtoReflect.Descriptor instead.
-func (*UserSetting_AccessTokensSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12, 2}
+// Deprecated: Use UserSetting_TaggingRulesSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_TaggingRulesSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 10}
 }
 
-func (x *UserSetting_AccessTokensSetting) GetAccessTokens() []*UserAccessToken {
+func (x *UserSetting_TaggingRulesSetting) GetRules() []*UserSetting_TaggingRulesSetting_Rule {
 	if x != nil {
-		return x.AccessTokens
+		return x.Rules
 	}
 	return nil
 }
 
-// User webhooks configuration.
-type UserSetting_WebhooksSetting struct {
+// The formatters applied to the content of the memos of the user when they are created or updated, unless the
+// request skips them. The code blocks, the code spans and the front matter are left as is.
+type UserSetting_FormattingSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of user webhooks.
-	Webhooks      []*UserWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UserSetting_WebhooksSetting) Reset() {
-	*x = UserSetting_WebhooksSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	// Whether the headings are written as "# Heading", without closing hashes or setext underlines.
+	NormalizeHeadings bool `protobuf:"varint,1,opt,name=normalize_headings,json=normalizeHeadings,proto3" json:"normalize_headings,omitempty"`
+	// Whether the bare URLs are written as links, e.g. "<https://example.com>".
+	LinkUrls bool `protobuf:"varint,2,opt,name=link_urls,json=linkUrls,proto3" json:"link_urls,omitempty"`
+	// Whether the tags and the mentions are written consistently: a tag with the case of its first occurrence in
+	// the content, e.g. "#Work" for "#work", and a mention of a user with their username.
+	NormalizeReferences bool `protobuf:"varint,3,opt,name=normalize_references,json=normalizeReferences,proto3" json:"normalize_references,omitempty"`
+	// Whether the trailing whitespace of the lines and the content is trimmed, the hard line breaks excepted.
+	TrimTrailingWhitespace bool `protobuf:"varint,4,opt,name=trim_trailing_whitespace,json=trimTrailingWhitespace,proto3" json:"trim_trailing_whitespace,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UserSetting_FormattingSetting) Reset() {
+	*x = UserSetting_FormattingSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSetting_WebhooksSetting) String() string {
+func (x *UserSetting_FormattingSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSetting_WebhooksSetting) ProtoMessage() {}
+func (*UserSetting_FormattingSetting) ProtoMessage() {}
 
-func (x *UserSetting_WebhooksSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[37]
+func (x *UserSetting_FormattingSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2450,48 +4627,77 @@ func (x *UserSetting_WebhooksSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSetting_WebhooksSetting.ProtoReflect.Descriptor instead.
-func (*UserSetting_WebhooksSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12, 3}
+// Deprecated: Use UserSetting_FormattingSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_FormattingSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 11}
 }
 
-func (x *UserSetting_WebhooksSetting) GetWebhooks() []*UserWebhook {
+func (x *UserSetting_FormattingSetting) GetNormalizeHeadings() bool {
 	if x != nil {
-		return x.Webhooks
+		return x.NormalizeHeadings
 	}
-	return nil
+	return false
 }
 
-// AI auto summary configuration.
-type UserSetting_AIAutoSummarySetting struct {
+func (x *UserSetting_FormattingSetting) GetLinkUrls() bool {
+	if x != nil {
+		return x.LinkUrls
+	}
+	return false
+}
+
+func (x *UserSetting_FormattingSetting) GetNormalizeReferences() bool {
+	if x != nil {
+		return x.NormalizeReferences
+	}
+	return false
+}
+
+func (x *UserSetting_FormattingSetting) GetTrimTrailingWhitespace() bool {
+	if x != nil {
+		return x.TrimTrailingWhitespace
+	}
+	return false
+}
+
+type UserSetting_TaggingRulesSetting_Rule struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The frequency in days for automatic summary generation.
-	// Minimum value is 1 day.
-	FrequencyDays int32 `protobuf:"varint,1,opt,name=frequency_days,json=frequencyDays,proto3" json:"frequency_days,omitempty"`
-	// Whether automatic summary generation is enabled.
-	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	// The count of consecutive failures.
-	// When this reaches 3, automatic summary will be disabled.
-	FailureCount  int32 `protobuf:"varint,3,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// The title of the rule.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// A regular expression the content must match, e.g. "(?i)meeting". Empty for any content.
+	ContentPattern string `protobuf:"bytes,2,opt,name=content_pattern,json=contentPattern,proto3" json:"content_pattern,omitempty"`
+	// The domain a link of the content must be on, including its subdomains, e.g. "github.com".
+	// Empty for any links.
+	LinkDomain string `protobuf:"bytes,3,opt,name=link_domain,json=linkDomain,proto3" json:"link_domain,omitempty"`
+	// The days of the week the memo must be created on, from 0 for Sunday to 6 for Saturday. Empty for any day.
+	Weekdays []int32 `protobuf:"varint,4,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// The hours of the day the memo must be created between, from start_hour included to end_hour excluded,
+	// wrapping around midnight when start_hour is after end_hour. Both 0 for any hour.
+	StartHour int32 `protobuf:"varint,5,opt,name=start_hour,json=startHour,proto3" json:"start_hour,omitempty"`
+	EndHour   int32 `protobuf:"varint,6,opt,name=end_hour,json=endHour,proto3" json:"end_hour,omitempty"`
+	// The tags added to the memo, e.g. "work".
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The visibility set on the memo when it is created, e.g. "PRIVATE". Empty to keep it.
+	Visibility    string `protobuf:"bytes,8,opt,name=visibility,proto3" json:"visibility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserSetting_AIAutoSummarySetting) Reset() {
-	*x = UserSetting_AIAutoSummarySetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[38]
+func (x *UserSetting_TaggingRulesSetting_Rule) Reset() {
+	*x = UserSetting_TaggingRulesSetting_Rule{}
+	mi := &file_api_v1_user_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSetting_AIAutoSummarySetting) String() string {
+func (x *UserSetting_TaggingRulesSetting_Rule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSetting_AIAutoSummarySetting) ProtoMessage() {}
+func (*UserSetting_TaggingRulesSetting_Rule) ProtoMessage() {}
 
-func (x *UserSetting_AIAutoSummarySetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[38]
+func (x *UserSetting_TaggingRulesSetting_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2502,32 +4708,67 @@ func (x *UserSetting_AIAutoSummarySetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSetting_AIAutoSummarySetting.ProtoReflect.Descriptor instead.
-func (*UserSetting_AIAutoSummarySetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12, 4}
+// Deprecated: Use UserSetting_TaggingRulesSetting_Rule.ProtoReflect.Descriptor instead.
+func (*UserSetting_TaggingRulesSetting_Rule) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18, 10, 0}
 }
 
-func (x *UserSetting_AIAutoSummarySetting) GetFrequencyDays() int32 {
+func (x *UserSetting_TaggingRulesSetting_Rule) GetTitle() string {
 	if x != nil {
-		return x.FrequencyDays
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *UserSetting_AIAutoSummarySetting) GetEnabled() bool {
+func (x *UserSetting_TaggingRulesSetting_Rule) GetContentPattern() string {
 	if x != nil {
-		return x.Enabled
+		return x.ContentPattern
 	}
-	return false
+	return ""
 }
 
-func (x *UserSetting_AIAutoSummarySetting) GetFailureCount() int32 {
+func (x *UserSetting_TaggingRulesSetting_Rule) GetLinkDomain() string {
 	if x != nil {
-		return x.FailureCount
+		return x.LinkDomain
+	}
+	return ""
+}
+
+func (x *UserSetting_TaggingRulesSetting_Rule) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *UserSetting_TaggingRulesSetting_Rule) GetStartHour() int32 {
+	if x != nil {
+		return x.StartHour
+	}
+	return 0
+}
+
+func (x *UserSetting_TaggingRulesSetting_Rule) GetEndHour() int32 {
+	if x != nil {
+		return x.EndHour
 	}
 	return 0
 }
 
+func (x *UserSetting_TaggingRulesSetting_Rule) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UserSetting_TaggingRulesSetting_Rule) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
 type UserSession_ClientInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// User agent string of the client.
@@ -2546,7 +4787,7 @@ type UserSession_ClientInfo struct {
 
 func (x *UserSession_ClientInfo) Reset() {
 	*x = UserSession_ClientInfo{}
-	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	mi := &file_api_v1_user_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2558,7 +4799,7 @@ func (x *UserSession_ClientInfo) String() string {
 func (*UserSession_ClientInfo) ProtoMessage() {}
 
 func (x *UserSession_ClientInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	mi := &file_api_v1_user_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2571,7 +4812,7 @@ func (x *UserSession_ClientInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSession_ClientInfo.ProtoReflect.Descriptor instead.
 func (*UserSession_ClientInfo) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{22, 0}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28, 0}
 }
 
 func (x *UserSession_ClientInfo) GetUserAgent() string {
@@ -2613,7 +4854,8 @@ var File_api_v1_user_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_user_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19api/v1/user_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcb\x04\n" +
+	"\x19api/v1/user_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaf\n" +
+	"\n" +
 	"\x04User\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x120\n" +
 	"\x04role\x18\x02 \x01(\x0e2\x17.memos.api.v1.User.RoleB\x03\xe0A\x02R\x04role\x12\x1f\n" +
@@ -2629,7 +4871,29 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"updateTime\";\n" +
+	"updateTime\x129\n" +
+	"\aprofile\x18\f \x01(\v2\x1a.memos.api.v1.User.ProfileB\x03\xe0A\x01R\aprofile\x12\x17\n" +
+	"\x04etag\x18\r \x01(\tB\x03\xe0A\x01R\x04etag\x1a\x8d\x05\n" +
+	"\aProfile\x12\x15\n" +
+	"\x03bio\x18\x01 \x01(\tB\x03\xe0A\x01R\x03bio\x12Q\n" +
+	"\x0ebio_visibility\x18\x02 \x01(\x0e2%.memos.api.v1.User.Profile.VisibilityB\x03\xe0A\x01R\rbioVisibility\x12\x1f\n" +
+	"\bpronouns\x18\x03 \x01(\tB\x03\xe0A\x01R\bpronouns\x12[\n" +
+	"\x13pronouns_visibility\x18\x04 \x01(\x0e2%.memos.api.v1.User.Profile.VisibilityB\x03\xe0A\x01R\x12pronounsVisibility\x12:\n" +
+	"\x05links\x18\x05 \x03(\v2\x1f.memos.api.v1.User.Profile.LinkB\x03\xe0A\x01R\x05links\x12U\n" +
+	"\x10links_visibility\x18\x06 \x01(\x0e2%.memos.api.v1.User.Profile.VisibilityB\x03\xe0A\x01R\x0flinksVisibility\x12+\n" +
+	"\fpublic_stats\x18\a \x01(\bB\x03\xe0A\x01H\x00R\vpublicStats\x88\x01\x01\x12=\n" +
+	"\x18public_activity_calendar\x18\b \x01(\bB\x03\xe0A\x01R\x16publicActivityCalendar\x1a8\n" +
+	"\x04Link\x12\x19\n" +
+	"\x05title\x18\x01 \x01(\tB\x03\xe0A\x01R\x05title\x12\x15\n" +
+	"\x03url\x18\x02 \x01(\tB\x03\xe0A\x02R\x03url\"P\n" +
+	"\n" +
+	"Visibility\x12\x1a\n" +
+	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06PUBLIC\x10\x01\x12\r\n" +
+	"\tPROTECTED\x10\x02\x12\v\n" +
+	"\aPRIVATE\x10\x03B\x0f\n" +
+	"\r_public_stats\";\n" +
 	"\x04Role\x12\x14\n" +
 	"\x10ROLE_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04HOST\x10\x01\x12\t\n" +
@@ -2650,25 +4914,47 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x0eGetUserRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/UserR\x04name\x12<\n" +
-	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\breadMask\"\xaf\x01\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\breadMask\"\xe8\x01\n" +
 	"\x11CreateUserRequest\x12.\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserB\x06\xe0A\x02\xe0A\x04R\x04user\x12\x1c\n" +
 	"\auser_id\x18\x02 \x01(\tB\x03\xe0A\x01R\x06userId\x12(\n" +
 	"\rvalidate_only\x18\x03 \x01(\bB\x03\xe0A\x01R\fvalidateOnly\x12\"\n" +
 	"\n" +
-	"request_id\x18\x04 \x01(\tB\x03\xe0A\x01R\trequestId\"\xac\x01\n" +
+	"request_id\x18\x04 \x01(\tB\x03\xe0A\x01R\trequestId\x127\n" +
+	"\x15legal_consent_version\x18\x05 \x01(\tB\x03\xe0A\x01R\x13legalConsentVersion\"\xac\x01\n" +
 	"\x11UpdateUserRequest\x12+\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserB\x03\xe0A\x02R\x04user\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
 	"updateMask\x12(\n" +
-	"\rallow_missing\x18\x03 \x01(\bB\x03\xe0A\x01R\fallowMissing\"]\n" +
+	"\rallow_missing\x18\x03 \x01(\bB\x03\xe0A\x01R\fallowMissing\"n\n" +
+	"\x15ChangeUsernameRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\x12&\n" +
+	"\fnew_username\x18\x02 \x01(\tB\x03\xe0A\x02R\vnewUsername\"\xa0\x01\n" +
 	"\x11DeleteUserRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/UserR\x04name\x12\x19\n" +
-	"\x05force\x18\x02 \x01(\bB\x03\xe0A\x01R\x05force\"E\n" +
+	"\x05force\x18\x02 \x01(\bB\x03\xe0A\x01R\x05force\x12\x17\n" +
+	"\x04etag\x18\x03 \x01(\tB\x03\xe0A\x01R\x04etag\x12(\n" +
+	"\rallow_missing\x18\x04 \x01(\bB\x03\xe0A\x01R\fallowMissing\"C\n" +
+	"\x12ApproveUserRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\"\x93\x01\n" +
+	"\x19SetUserFeatureFlagRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\x12\x17\n" +
+	"\x04flag\x18\x02 \x01(\tB\x03\xe0A\x02R\x04flag\x12\"\n" +
+	"\aenabled\x18\x03 \x01(\bB\x03\xe0A\x01H\x00R\aenabled\x88\x01\x01B\n" +
+	"\n" +
+	"\b_enabled\"^\n" +
 	"\x14GetUserAvatarRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
-	"\x11memos.api.v1/UserR\x04name\"\xe4\x04\n" +
+	"\x11memos.api.v1/UserR\x04name\x12\x17\n" +
+	"\x04size\x18\x02 \x01(\x05B\x03\xe0A\x01R\x04size\"g\n" +
+	"\x17UploadUserAvatarRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\x12\x1d\n" +
+	"\acontent\x18\x02 \x01(\fB\x03\xe0A\x02R\acontent\"\xe4\x04\n" +
 	"\tUserStats\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12R\n" +
 	"\x17memo_display_timestamps\x18\x02 \x03(\v2\x1a.google.protobuf.TimestampR\x15memoDisplayTimestamps\x12M\n" +
@@ -2691,38 +4977,134 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x16memos.api.v1/UserStats\x12\fusers/{user}*\tuserStats2\tuserStats\"D\n" +
 	"\x13GetUserStatsRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
-	"\x11memos.api.v1/UserR\x04name\"\x19\n" +
+	"\x11memos.api.v1/UserR\x04name\"j\n" +
+	"\x1eGetUserActivityCalendarRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\x12\x19\n" +
+	"\x05weeks\x18\x02 \x01(\x05B\x03\xe0A\x01R\x05weeks\"\xfb\x01\n" +
+	"\x14UserActivityCalendar\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12$\n" +
+	"\x0eweek_start_day\x18\x03 \x01(\x05R\fweekStartDay\x12:\n" +
+	"\x04days\x18\x04 \x03(\v2&.memos.api.v1.UserActivityCalendar.DayR\x04days\x12\x1b\n" +
+	"\tmax_level\x18\x05 \x01(\x05R\bmaxLevel\x1a/\n" +
+	"\x03Day\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\"\x19\n" +
 	"\x17ListAllUserStatsRequest\"I\n" +
 	"\x18ListAllUserStatsResponse\x12-\n" +
-	"\x05stats\x18\x01 \x03(\v2\x17.memos.api.v1.UserStatsR\x05stats\"\xbf\t\n" +
+	"\x05stats\x18\x01 \x03(\v2\x17.memos.api.v1.UserStatsR\x05stats\"\xa2\x1e\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12S\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2(.memos.api.v1.UserSetting.GeneralSettingH\x00R\x0egeneralSetting\x12V\n" +
 	"\x10sessions_setting\x18\x03 \x01(\v2).memos.api.v1.UserSetting.SessionsSettingH\x00R\x0fsessionsSetting\x12c\n" +
 	"\x15access_tokens_setting\x18\x04 \x01(\v2-.memos.api.v1.UserSetting.AccessTokensSettingH\x00R\x13accessTokensSetting\x12V\n" +
 	"\x10webhooks_setting\x18\x05 \x01(\v2).memos.api.v1.UserSetting.WebhooksSettingH\x00R\x0fwebhooksSetting\x12g\n" +
-	"\x17ai_auto_summary_setting\x18\x06 \x01(\v2..memos.api.v1.UserSetting.AIAutoSummarySettingH\x00R\x14aiAutoSummarySetting\x1av\n" +
+	"\x17ai_auto_summary_setting\x18\x06 \x01(\v2..memos.api.v1.UserSetting.AIAutoSummarySettingH\x00R\x14aiAutoSummarySetting\x12M\n" +
+	"\rnostr_setting\x18\a \x01(\v2&.memos.api.v1.UserSetting.NostrSettingH\x00R\fnostrSetting\x12S\n" +
+	"\x0fbluesky_setting\x18\b \x01(\v2(.memos.api.v1.UserSetting.BlueskySettingH\x00R\x0eblueskySetting\x12V\n" +
+	"\x10mastodon_setting\x18\t \x01(\v2).memos.api.v1.UserSetting.MastodonSettingH\x00R\x0fmastodonSetting\x12V\n" +
+	"\x10micropub_setting\x18\n" +
+	" \x01(\v2).memos.api.v1.UserSetting.MicropubSettingH\x00R\x0fmicropubSetting\x12Z\n" +
+	"\x12indie_auth_setting\x18\v \x01(\v2*.memos.api.v1.UserSetting.IndieAuthSettingH\x00R\x10indieAuthSetting\x12c\n" +
+	"\x15tagging_rules_setting\x18\f \x01(\v2-.memos.api.v1.UserSetting.TaggingRulesSettingH\x00R\x13taggingRulesSetting\x12\\\n" +
+	"\x12formatting_setting\x18\r \x01(\v2+.memos.api.v1.UserSetting.FormattingSettingH\x00R\x11formattingSetting\x1a\xda\x02\n" +
 	"\x0eGeneralSetting\x12\x1b\n" +
 	"\x06locale\x18\x01 \x01(\tB\x03\xe0A\x01R\x06locale\x12,\n" +
 	"\x0fmemo_visibility\x18\x03 \x01(\tB\x03\xe0A\x01R\x0ememoVisibility\x12\x19\n" +
-	"\x05theme\x18\x04 \x01(\tB\x03\xe0A\x01R\x05theme\x1aH\n" +
+	"\x05theme\x18\x04 \x01(\tB\x03\xe0A\x01R\x05theme\x12(\n" +
+	"\rarchive_links\x18\x05 \x01(\bB\x03\xe0A\x01R\farchiveLinks\x12K\n" +
+	"\x1freaction_notification_threshold\x18\x06 \x01(\x05B\x03\xe0A\x01R\x1dreactionNotificationThreshold\x12\x1f\n" +
+	"\btimezone\x18\a \x01(\tB\x03\xe0A\x01R\btimezone\x12)\n" +
+	"\x0eweek_start_day\x18\b \x01(\x05B\x03\xe0A\x01R\fweekStartDay\x12\x1f\n" +
+	"\tai_opt_in\x18\t \x01(\bB\x03\xe0A\x01R\aaiOptIn\x1aH\n" +
 	"\x0fSessionsSetting\x125\n" +
 	"\bsessions\x18\x01 \x03(\v2\x19.memos.api.v1.UserSessionR\bsessions\x1aY\n" +
 	"\x13AccessTokensSetting\x12B\n" +
 	"\raccess_tokens\x18\x01 \x03(\v2\x1d.memos.api.v1.UserAccessTokenR\faccessTokens\x1aH\n" +
 	"\x0fWebhooksSetting\x125\n" +
-	"\bwebhooks\x18\x01 \x03(\v2\x19.memos.api.v1.UserWebhookR\bwebhooks\x1a\x8b\x01\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x19.memos.api.v1.UserWebhookR\bwebhooks\x1a\x9a\x03\n" +
 	"\x14AIAutoSummarySetting\x12*\n" +
 	"\x0efrequency_days\x18\x01 \x01(\x05B\x03\xe0A\x01R\rfrequencyDays\x12\x1d\n" +
 	"\aenabled\x18\x02 \x01(\bB\x03\xe0A\x01R\aenabled\x12(\n" +
-	"\rfailure_count\x18\x03 \x01(\x05B\x03\xe0A\x01R\ffailureCount\"k\n" +
+	"\rfailure_count\x18\x03 \x01(\x05B\x03\xe0A\x01R\ffailureCount\x12\x17\n" +
+	"\x04hour\x18\x04 \x01(\x05B\x03\xe0A\x01R\x04hour\x12#\n" +
+	"\vday_of_week\x18\x05 \x01(\x05B\x03\xe0A\x01R\tdayOfWeek\x12+\n" +
+	"\x0ftime_range_days\x18\x06 \x01(\x05B\x03\xe0A\x01R\rtimeRangeDays\x12\x17\n" +
+	"\x04tags\x18\a \x03(\tB\x03\xe0A\x01R\x04tags\x12\x1f\n" +
+	"\btimezone\x18\b \x01(\tB\x03\xe0A\x01R\btimezone\x12C\n" +
+	"\rlast_run_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vlastRunTime\x12#\n" +
+	"\n" +
+	"visibility\x18\n" +
+	" \x01(\tB\x03\xe0A\x01R\n" +
+	"visibility\x1a\x94\x01\n" +
+	"\fNostrSetting\x12\x1d\n" +
+	"\aenabled\x18\x01 \x01(\bB\x03\xe0A\x01R\aenabled\x12\x1b\n" +
+	"\x06relays\x18\x02 \x03(\tB\x03\xe0A\x01R\x06relays\x12$\n" +
+	"\vprivate_key\x18\x03 \x01(\tB\x03\xe0A\x04R\n" +
+	"privateKey\x12\"\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tB\x03\xe0A\x03R\tpublicKey\x1a\xc9\x01\n" +
+	"\x0eBlueskySetting\x12\x1d\n" +
+	"\aenabled\x18\x01 \x01(\bB\x03\xe0A\x01R\aenabled\x12\x1d\n" +
+	"\aservice\x18\x02 \x01(\tB\x03\xe0A\x01R\aservice\x12#\n" +
+	"\n" +
+	"identifier\x18\x03 \x01(\tB\x03\xe0A\x01R\n" +
+	"identifier\x12&\n" +
+	"\fapp_password\x18\x04 \x01(\tB\x03\xe0A\x04R\vappPassword\x12\x15\n" +
+	"\x03tag\x18\x05 \x01(\tB\x03\xe0A\x01R\x03tag\x12\x15\n" +
+	"\x03did\x18\x06 \x01(\tB\x03\xe0A\x03R\x03did\x1a\xb6\x01\n" +
+	"\x0fMastodonSetting\x12\x1d\n" +
+	"\aenabled\x18\x01 \x01(\bB\x03\xe0A\x01R\aenabled\x12&\n" +
+	"\finstance_url\x18\x02 \x01(\tB\x03\xe0A\x01R\vinstanceUrl\x12&\n" +
+	"\faccess_token\x18\x03 \x01(\tB\x03\xe0A\x04R\vaccessToken\x12\x15\n" +
+	"\x03tag\x18\x04 \x01(\tB\x03\xe0A\x01R\x03tag\x12\x1d\n" +
+	"\aaccount\x18\x05 \x01(\tB\x03\xe0A\x03R\aaccount\x1a\xbc\x01\n" +
+	"\x0fMicropubSetting\x12\x1d\n" +
+	"\aenabled\x18\x01 \x01(\bB\x03\xe0A\x01R\aenabled\x12\x1f\n" +
+	"\bendpoint\x18\x02 \x01(\tB\x03\xe0A\x01R\bendpoint\x12&\n" +
+	"\faccess_token\x18\x03 \x01(\tB\x03\xe0A\x04R\vaccessToken\x12\x15\n" +
+	"\x03tag\x18\x04 \x01(\tB\x03\xe0A\x01R\x03tag\x12*\n" +
+	"\x0emedia_endpoint\x18\x05 \x01(\tB\x03\xe0A\x03R\rmediaEndpoint\x1aS\n" +
+	"\x10IndieAuthSetting\x12\x13\n" +
+	"\x02me\x18\x01 \x01(\tB\x03\xe0A\x01R\x02me\x12*\n" +
+	"\x0etoken_endpoint\x18\x02 \x01(\tB\x03\xe0A\x01R\rtokenEndpoint\x1a\xff\x02\n" +
+	"\x13TaggingRulesSetting\x12M\n" +
+	"\x05rules\x18\x01 \x03(\v22.memos.api.v1.UserSetting.TaggingRulesSetting.RuleB\x03\xe0A\x01R\x05rules\x1a\x98\x02\n" +
+	"\x04Rule\x12\x19\n" +
+	"\x05title\x18\x01 \x01(\tB\x03\xe0A\x01R\x05title\x12,\n" +
+	"\x0fcontent_pattern\x18\x02 \x01(\tB\x03\xe0A\x01R\x0econtentPattern\x12$\n" +
+	"\vlink_domain\x18\x03 \x01(\tB\x03\xe0A\x01R\n" +
+	"linkDomain\x12\x1f\n" +
+	"\bweekdays\x18\x04 \x03(\x05B\x03\xe0A\x01R\bweekdays\x12\"\n" +
+	"\n" +
+	"start_hour\x18\x05 \x01(\x05B\x03\xe0A\x01R\tstartHour\x12\x1e\n" +
+	"\bend_hour\x18\x06 \x01(\x05B\x03\xe0A\x01R\aendHour\x12\x17\n" +
+	"\x04tags\x18\a \x03(\tB\x03\xe0A\x01R\x04tags\x12#\n" +
+	"\n" +
+	"visibility\x18\b \x01(\tB\x03\xe0A\x01R\n" +
+	"visibility\x1a\xe0\x01\n" +
+	"\x11FormattingSetting\x122\n" +
+	"\x12normalize_headings\x18\x01 \x01(\bB\x03\xe0A\x01R\x11normalizeHeadings\x12 \n" +
+	"\tlink_urls\x18\x02 \x01(\bB\x03\xe0A\x01R\blinkUrls\x126\n" +
+	"\x14normalize_references\x18\x03 \x01(\bB\x03\xe0A\x01R\x13normalizeReferences\x12=\n" +
+	"\x18trim_trailing_whitespace\x18\x04 \x01(\bB\x03\xe0A\x01R\x16trimTrailingWhitespace\"\xd1\x01\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\f\n" +
 	"\bSESSIONS\x10\x02\x12\x11\n" +
 	"\rACCESS_TOKENS\x10\x03\x12\f\n" +
 	"\bWEBHOOKS\x10\x04\x12\x13\n" +
-	"\x0fAI_AUTO_SUMMARY\x10\x05:Y\xeaAV\n" +
+	"\x0fAI_AUTO_SUMMARY\x10\x05\x12\t\n" +
+	"\x05NOSTR\x10\x06\x12\v\n" +
+	"\aBLUESKY\x10\a\x12\f\n" +
+	"\bMASTODON\x10\b\x12\f\n" +
+	"\bMICROPUB\x10\t\x12\r\n" +
+	"\tINDIEAUTH\x10\n" +
+	"\x12\x11\n" +
+	"\rTAGGING_RULES\x10\v\x12\x0e\n" +
+	"\n" +
+	"FORMATTING\x10\f:Y\xeaAV\n" +
 	"\x18memos.api.v1/UserSetting\x12\x1fusers/{user}/settings/{setting}*\fuserSettings2\vuserSettingB\a\n" +
 	"\x05value\"M\n" +
 	"\x15GetUserSettingRequest\x124\n" +
@@ -2766,10 +5148,11 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/UserR\x06parent\x12E\n" +
 	"\faccess_token\x18\x02 \x01(\v2\x1d.memos.api.v1.UserAccessTokenB\x03\xe0A\x02R\vaccessToken\x12+\n" +
-	"\x0faccess_token_id\x18\x03 \x01(\tB\x03\xe0A\x01R\raccessTokenId\"X\n" +
+	"\x0faccess_token_id\x18\x03 \x01(\tB\x03\xe0A\x01R\raccessTokenId\"\x82\x01\n" +
 	"\x1cDeleteUserAccessTokenRequest\x128\n" +
 	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
-	"\x1cmemos.api.v1/UserAccessTokenR\x04name\"\x94\x04\n" +
+	"\x1cmemos.api.v1/UserAccessTokenR\x04name\x12(\n" +
+	"\rallow_missing\x18\x02 \x01(\bB\x03\xe0A\x01R\fallowMissing\"\x94\x04\n" +
 	"\vUserSession\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\"\n" +
 	"\n" +
@@ -2796,7 +5179,7 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x18ListUserSessionsResponse\x125\n" +
 	"\bsessions\x18\x01 \x03(\v2\x19.memos.api.v1.UserSessionR\bsessions\"3\n" +
 	"\x18RevokeUserSessionRequest\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xda\x01\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xa8\x02\n" +
 	"\vUserWebhook\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12!\n" +
@@ -2804,7 +5187,17 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"updateTime\"6\n" +
+	"updateTime\x12\x16\n" +
+	"\x06events\x18\x06 \x03(\tR\x06events\x12\x1b\n" +
+	"\x06secret\x18\a \x01(\tB\x03\xe0A\x03R\x06secret\x12\x17\n" +
+	"\x04etag\x18\b \x01(\tB\x03\xe0A\x01R\x04etag\"\xd0\x01\n" +
+	"\x13UserWebhookDelivery\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12#\n" +
+	"\ractivity_type\x18\x02 \x01(\tR\factivityType\x12#\n" +
+	"\rresponse_code\x18\x03 \x01(\x05R\fresponseCode\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12@\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\"6\n" +
 	"\x17ListUserWebhooksRequest\x12\x1b\n" +
 	"\x06parent\x18\x01 \x01(\tB\x03\xe0A\x02R\x06parent\"Q\n" +
 	"\x18ListUserWebhooksResponse\x125\n" +
@@ -2815,21 +5208,67 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x18UpdateUserWebhookRequest\x128\n" +
 	"\awebhook\x18\x01 \x01(\v2\x19.memos.api.v1.UserWebhookB\x03\xe0A\x02R\awebhook\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"3\n" +
+	"updateMask\"v\n" +
 	"\x18DeleteUserWebhookRequest\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name2\xe6\x15\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12\x17\n" +
+	"\x04etag\x18\x02 \x01(\tB\x03\xe0A\x01R\x04etag\x12(\n" +
+	"\rallow_missing\x18\x03 \x01(\bB\x03\xe0A\x01R\fallowMissing\"0\n" +
+	"\x15GetUserWebhookRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"9\n" +
+	"\x1eRotateUserWebhookSecretRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"1\n" +
+	"\x16TestUserWebhookRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"?\n" +
+	" ListUserWebhookDeliveriesRequest\x12\x1b\n" +
+	"\x06parent\x18\x01 \x01(\tB\x03\xe0A\x02R\x06parent\"f\n" +
+	"!ListUserWebhookDeliveriesResponse\x12A\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2!.memos.api.v1.UserWebhookDeliveryR\n" +
+	"deliveries\"\xa4\x04\n" +
+	"\rUserImportJob\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\"\n" +
+	"\n" +
+	"source_url\x18\x02 \x01(\tB\x03\xe0A\x03R\tsourceUrl\x12<\n" +
+	"\x05state\x18\x03 \x01(\x0e2!.memos.api.v1.UserImportJob.StateB\x03\xe0A\x03R\x05state\x123\n" +
+	"\x13imported_memo_count\x18\x04 \x01(\x05B\x03\xe0A\x03R\x11importedMemoCount\x12?\n" +
+	"\x19imported_attachment_count\x18\x05 \x01(\x05B\x03\xe0A\x03R\x17importedAttachmentCount\x12;\n" +
+	"\x17imported_relation_count\x18\x06 \x01(\x05B\x03\xe0A\x03R\x15importedRelationCount\x12\x19\n" +
+	"\x05error\x18\a \x01(\tB\x03\xe0A\x03R\x05error\x12@\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vfinish_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"finishTime\"F\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aRUNNING\x10\x01\x12\r\n" +
+	"\tSUCCEEDED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03\"\x9e\x01\n" +
+	"\x1aCreateUserImportJobRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12\"\n" +
+	"\n" +
+	"source_url\x18\x02 \x01(\tB\x03\xe0A\x02R\tsourceUrl\x12)\n" +
+	"\faccess_token\x18\x03 \x01(\tB\x06\xe0A\x02\xe0A\x04R\vaccessToken\"2\n" +
+	"\x17GetUserImportJobRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name2\xf3\"\n" +
 	"\vUserService\x12c\n" +
 	"\tListUsers\x12\x1e.memos.api.v1.ListUsersRequest\x1a\x1f.memos.api.v1.ListUsersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x12b\n" +
 	"\aGetUser\x12\x1c.memos.api.v1.GetUserRequest\x1a\x12.memos.api.v1.User\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=users/*}\x12e\n" +
 	"\n" +
 	"CreateUser\x12\x1f.memos.api.v1.CreateUserRequest\x1a\x12.memos.api.v1.User\"\"\xdaA\x04user\x82\xd3\xe4\x93\x02\x15:\x04user\"\r/api/v1/users\x12\x7f\n" +
 	"\n" +
-	"UpdateUser\x12\x1f.memos.api.v1.UpdateUserRequest\x1a\x12.memos.api.v1.User\"<\xdaA\x10user,update_mask\x82\xd3\xe4\x93\x02#:\x04user2\x1b/api/v1/{user.name=users/*}\x12l\n" +
+	"UpdateUser\x12\x1f.memos.api.v1.UpdateUserRequest\x1a\x12.memos.api.v1.User\"<\xdaA\x10user,update_mask\x82\xd3\xe4\x93\x02#:\x04user2\x1b/api/v1/{user.name=users/*}\x12\x8f\x01\n" +
+	"\x0eChangeUsername\x12#.memos.api.v1.ChangeUsernameRequest\x1a\x12.memos.api.v1.User\"D\xdaA\x11name,new_username\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/{name=users/*}:changeUsername\x12l\n" +
 	"\n" +
-	"DeleteUser\x12\x1f.memos.api.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=users/*}\x12w\n" +
-	"\rGetUserAvatar\x12\".memos.api.v1.GetUserAvatarRequest\x1a\x14.google.api.HttpBody\",\xdaA\x04name\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/{name=users/*}/avatar\x12~\n" +
+	"DeleteUser\x12\x1f.memos.api.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=users/*}\x12u\n" +
+	"\vApproveUser\x12 .memos.api.v1.ApproveUserRequest\x1a\x12.memos.api.v1.User\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/{name=users/*}:approve\x12\x93\x01\n" +
+	"\x12SetUserFeatureFlag\x12'.memos.api.v1.SetUserFeatureFlagRequest\x1a\x16.google.protobuf.Empty\"<\xdaA\tname,flag\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/{name=users/*}:setFeatureFlag\x12w\n" +
+	"\rGetUserAvatar\x12\".memos.api.v1.GetUserAvatarRequest\x1a\x14.google.api.HttpBody\",\xdaA\x04name\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/{name=users/*}/avatar\x12\x8c\x01\n" +
+	"\x10UploadUserAvatar\x12%.memos.api.v1.UploadUserAvatarRequest\x1a\x12.memos.api.v1.User\"=\xdaA\fname,content\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/{name=users/*}:uploadAvatar\x12~\n" +
 	"\x10ListAllUserStats\x12%.memos.api.v1.ListAllUserStatsRequest\x1a&.memos.api.v1.ListAllUserStatsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/users:stats\x12z\n" +
-	"\fGetUserStats\x12!.memos.api.v1.GetUserStatsRequest\x1a\x17.memos.api.v1.UserStats\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=users/*}:getStats\x12\x82\x01\n" +
+	"\fGetUserStats\x12!.memos.api.v1.GetUserStatsRequest\x1a\x17.memos.api.v1.UserStats\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=users/*}:getStats\x12\xa6\x01\n" +
+	"\x17GetUserActivityCalendar\x12,.memos.api.v1.GetUserActivityCalendarRequest\x1a\".memos.api.v1.UserActivityCalendar\"9\xdaA\x04name\x82\xd3\xe4\x93\x02,\x12*/api/v1/{name=users/*}:getActivityCalendar\x12\x82\x01\n" +
 	"\x0eGetUserSetting\x12#.memos.api.v1.GetUserSettingRequest\x1a\x19.memos.api.v1.UserSetting\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#\x12!/api/v1/{name=users/*/settings/*}\x12\xa8\x01\n" +
 	"\x11UpdateUserSetting\x12&.memos.api.v1.UpdateUserSettingRequest\x1a\x19.memos.api.v1.UserSetting\"P\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x024:\asetting2)/api/v1/{setting.name=users/*/settings/*}\x12\x95\x01\n" +
 	"\x10ListUserSettings\x12%.memos.api.v1.ListUserSettingsRequest\x1a&.memos.api.v1.ListUserSettingsResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/settings\x12\xa5\x01\n" +
@@ -2838,10 +5277,16 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x15DeleteUserAccessToken\x12*.memos.api.v1.DeleteUserAccessTokenRequest\x1a\x16.google.protobuf.Empty\"4\xdaA\x04name\x82\xd3\xe4\x93\x02'*%/api/v1/{name=users/*/accessTokens/*}\x12\x95\x01\n" +
 	"\x10ListUserSessions\x12%.memos.api.v1.ListUserSessionsRequest\x1a&.memos.api.v1.ListUserSessionsResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/sessions\x12\x85\x01\n" +
 	"\x11RevokeUserSession\x12&.memos.api.v1.RevokeUserSessionRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/sessions/*}\x12\x95\x01\n" +
-	"\x10ListUserWebhooks\x12%.memos.api.v1.ListUserWebhooksRequest\x1a&.memos.api.v1.ListUserWebhooksResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/webhooks\x12\x9b\x01\n" +
+	"\x10ListUserWebhooks\x12%.memos.api.v1.ListUserWebhooksRequest\x1a&.memos.api.v1.ListUserWebhooksResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/webhooks\x12\x82\x01\n" +
+	"\x0eGetUserWebhook\x12#.memos.api.v1.GetUserWebhookRequest\x1a\x19.memos.api.v1.UserWebhook\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#\x12!/api/v1/{name=users/*/webhooks/*}\x12\x9b\x01\n" +
 	"\x11CreateUserWebhook\x12&.memos.api.v1.CreateUserWebhookRequest\x1a\x19.memos.api.v1.UserWebhook\"C\xdaA\x0eparent,webhook\x82\xd3\xe4\x93\x02,:\awebhook\"!/api/v1/{parent=users/*}/webhooks\x12\xa8\x01\n" +
 	"\x11UpdateUserWebhook\x12&.memos.api.v1.UpdateUserWebhookRequest\x1a\x19.memos.api.v1.UserWebhook\"P\xdaA\x13webhook,update_mask\x82\xd3\xe4\x93\x024:\awebhook2)/api/v1/{webhook.name=users/*/webhooks/*}\x12\x85\x01\n" +
-	"\x11DeleteUserWebhook\x12&.memos.api.v1.DeleteUserWebhookRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/webhooks/*}B\xa8\x01\n" +
+	"\x11DeleteUserWebhook\x12&.memos.api.v1.DeleteUserWebhookRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/webhooks/*}\x12\xa1\x01\n" +
+	"\x17RotateUserWebhookSecret\x12,.memos.api.v1.RotateUserWebhookSecretRequest\x1a\x19.memos.api.v1.UserWebhook\"=\xdaA\x04name\x82\xd3\xe4\x93\x020\"./api/v1/{name=users/*/webhooks/*}:rotateSecret\x12\x91\x01\n" +
+	"\x0fTestUserWebhook\x12$.memos.api.v1.TestUserWebhookRequest\x1a!.memos.api.v1.UserWebhookDelivery\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(\"&/api/v1/{name=users/*/webhooks/*}:test\x12\xbd\x01\n" +
+	"\x19ListUserWebhookDeliveries\x12..memos.api.v1.ListUserWebhookDeliveriesRequest\x1a/.memos.api.v1.ListUserWebhookDeliveriesResponse\"?\xdaA\x06parent\x82\xd3\xe4\x93\x020\x12./api/v1/{parent=users/*/webhooks/*}/deliveries\x12\xac\x01\n" +
+	"\x13CreateUserImportJob\x12(.memos.api.v1.CreateUserImportJobRequest\x1a\x1b.memos.api.v1.UserImportJob\"N\xdaA\x1eparent,source_url,access_token\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/{parent=users/*}/importJob\x12\x87\x01\n" +
+	"\x10GetUserImportJob\x12%.memos.api.v1.GetUserImportJobRequest\x1a\x1b.memos.api.v1.UserImportJob\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=users/*/importJob}B\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10UserServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -2856,141 +5301,211 @@ func file_api_v1_user_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_user_service_proto_rawDescData
 }
 
-var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_api_v1_user_service_proto_goTypes = []any{
-	(User_Role)(0),                           // 0: memos.api.v1.User.Role
-	(UserSetting_Key)(0),                     // 1: memos.api.v1.UserSetting.Key
-	(*User)(nil),                             // 2: memos.api.v1.User
-	(*ListUsersRequest)(nil),                 // 3: memos.api.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),                // 4: memos.api.v1.ListUsersResponse
-	(*GetUserRequest)(nil),                   // 5: memos.api.v1.GetUserRequest
-	(*CreateUserRequest)(nil),                // 6: memos.api.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),                // 7: memos.api.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),                // 8: memos.api.v1.DeleteUserRequest
-	(*GetUserAvatarRequest)(nil),             // 9: memos.api.v1.GetUserAvatarRequest
-	(*UserStats)(nil),                        // 10: memos.api.v1.UserStats
-	(*GetUserStatsRequest)(nil),              // 11: memos.api.v1.GetUserStatsRequest
-	(*ListAllUserStatsRequest)(nil),          // 12: memos.api.v1.ListAllUserStatsRequest
-	(*ListAllUserStatsResponse)(nil),         // 13: memos.api.v1.ListAllUserStatsResponse
-	(*UserSetting)(nil),                      // 14: memos.api.v1.UserSetting
-	(*GetUserSettingRequest)(nil),            // 15: memos.api.v1.GetUserSettingRequest
-	(*UpdateUserSettingRequest)(nil),         // 16: memos.api.v1.UpdateUserSettingRequest
-	(*ListUserSettingsRequest)(nil),          // 17: memos.api.v1.ListUserSettingsRequest
-	(*ListUserSettingsResponse)(nil),         // 18: memos.api.v1.ListUserSettingsResponse
-	(*UserAccessToken)(nil),                  // 19: memos.api.v1.UserAccessToken
-	(*ListUserAccessTokensRequest)(nil),      // 20: memos.api.v1.ListUserAccessTokensRequest
-	(*ListUserAccessTokensResponse)(nil),     // 21: memos.api.v1.ListUserAccessTokensResponse
-	(*CreateUserAccessTokenRequest)(nil),     // 22: memos.api.v1.CreateUserAccessTokenRequest
-	(*DeleteUserAccessTokenRequest)(nil),     // 23: memos.api.v1.DeleteUserAccessTokenRequest
-	(*UserSession)(nil),                      // 24: memos.api.v1.UserSession
-	(*ListUserSessionsRequest)(nil),          // 25: memos.api.v1.ListUserSessionsRequest
-	(*ListUserSessionsResponse)(nil),         // 26: memos.api.v1.ListUserSessionsResponse
-	(*RevokeUserSessionRequest)(nil),         // 27: memos.api.v1.RevokeUserSessionRequest
-	(*UserWebhook)(nil),                      // 28: memos.api.v1.UserWebhook
-	(*ListUserWebhooksRequest)(nil),          // 29: memos.api.v1.ListUserWebhooksRequest
-	(*ListUserWebhooksResponse)(nil),         // 30: memos.api.v1.ListUserWebhooksResponse
-	(*CreateUserWebhookRequest)(nil),         // 31: memos.api.v1.CreateUserWebhookRequest
-	(*UpdateUserWebhookRequest)(nil),         // 32: memos.api.v1.UpdateUserWebhookRequest
-	(*DeleteUserWebhookRequest)(nil),         // 33: memos.api.v1.DeleteUserWebhookRequest
-	nil,                                      // 34: memos.api.v1.UserStats.TagCountEntry
-	(*UserStats_MemoTypeStats)(nil),          // 35: memos.api.v1.UserStats.MemoTypeStats
-	(*UserSetting_GeneralSetting)(nil),       // 36: memos.api.v1.UserSetting.GeneralSetting
-	(*UserSetting_SessionsSetting)(nil),      // 37: memos.api.v1.UserSetting.SessionsSetting
-	(*UserSetting_AccessTokensSetting)(nil),  // 38: memos.api.v1.UserSetting.AccessTokensSetting
-	(*UserSetting_WebhooksSetting)(nil),      // 39: memos.api.v1.UserSetting.WebhooksSetting
-	(*UserSetting_AIAutoSummarySetting)(nil), // 40: memos.api.v1.UserSetting.AIAutoSummarySetting
-	(*UserSession_ClientInfo)(nil),           // 41: memos.api.v1.UserSession.ClientInfo
-	(State)(0),                               // 42: memos.api.v1.State
-	(*timestamppb.Timestamp)(nil),            // 43: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),            // 44: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                    // 45: google.protobuf.Empty
-	(*httpbody.HttpBody)(nil),                // 46: google.api.HttpBody
+	(User_Role)(0),                               // 0: memos.api.v1.User.Role
+	(User_Profile_Visibility)(0),                 // 1: memos.api.v1.User.Profile.Visibility
+	(UserSetting_Key)(0),                         // 2: memos.api.v1.UserSetting.Key
+	(UserImportJob_State)(0),                     // 3: memos.api.v1.UserImportJob.State
+	(*User)(nil),                                 // 4: memos.api.v1.User
+	(*ListUsersRequest)(nil),                     // 5: memos.api.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                    // 6: memos.api.v1.ListUsersResponse
+	(*GetUserRequest)(nil),                       // 7: memos.api.v1.GetUserRequest
+	(*CreateUserRequest)(nil),                    // 8: memos.api.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),                    // 9: memos.api.v1.UpdateUserRequest
+	(*ChangeUsernameRequest)(nil),                // 10: memos.api.v1.ChangeUsernameRequest
+	(*DeleteUserRequest)(nil),                    // 11: memos.api.v1.DeleteUserRequest
+	(*ApproveUserRequest)(nil),                   // 12: memos.api.v1.ApproveUserRequest
+	(*SetUserFeatureFlagRequest)(nil),            // 13: memos.api.v1.SetUserFeatureFlagRequest
+	(*GetUserAvatarRequest)(nil),                 // 14: memos.api.v1.GetUserAvatarRequest
+	(*UploadUserAvatarRequest)(nil),              // 15: memos.api.v1.UploadUserAvatarRequest
+	(*UserStats)(nil),                            // 16: memos.api.v1.UserStats
+	(*GetUserStatsRequest)(nil),                  // 17: memos.api.v1.GetUserStatsRequest
+	(*GetUserActivityCalendarRequest)(nil),       // 18: memos.api.v1.GetUserActivityCalendarRequest
+	(*UserActivityCalendar)(nil),                 // 19: memos.api.v1.UserActivityCalendar
+	(*ListAllUserStatsRequest)(nil),              // 20: memos.api.v1.ListAllUserStatsRequest
+	(*ListAllUserStatsResponse)(nil),             // 21: memos.api.v1.ListAllUserStatsResponse
+	(*UserSetting)(nil),                          // 22: memos.api.v1.UserSetting
+	(*GetUserSettingRequest)(nil),                // 23: memos.api.v1.GetUserSettingRequest
+	(*UpdateUserSettingRequest)(nil),             // 24: memos.api.v1.UpdateUserSettingRequest
+	(*ListUserSettingsRequest)(nil),              // 25: memos.api.v1.ListUserSettingsRequest
+	(*ListUserSettingsResponse)(nil),             // 26: memos.api.v1.ListUserSettingsResponse
+	(*UserAccessToken)(nil),                      // 27: memos.api.v1.UserAccessToken
+	(*ListUserAccessTokensRequest)(nil),          // 28: memos.api.v1.ListUserAccessTokensRequest
+	(*ListUserAccessTokensResponse)(nil),         // 29: memos.api.v1.ListUserAccessTokensResponse
+	(*CreateUserAccessTokenRequest)(nil),         // 30: memos.api.v1.CreateUserAccessTokenRequest
+	(*DeleteUserAccessTokenRequest)(nil),         // 31: memos.api.v1.DeleteUserAccessTokenRequest
+	(*UserSession)(nil),                          // 32: memos.api.v1.UserSession
+	(*ListUserSessionsRequest)(nil),              // 33: memos.api.v1.ListUserSessionsRequest
+	(*ListUserSessionsResponse)(nil),             // 34: memos.api.v1.ListUserSessionsResponse
+	(*RevokeUserSessionRequest)(nil),             // 35: memos.api.v1.RevokeUserSessionRequest
+	(*UserWebhook)(nil),                          // 36: memos.api.v1.UserWebhook
+	(*UserWebhookDelivery)(nil),                  // 37: memos.api.v1.UserWebhookDelivery
+	(*ListUserWebhooksRequest)(nil),              // 38: memos.api.v1.ListUserWebhooksRequest
+	(*ListUserWebhooksResponse)(nil),             // 39: memos.api.v1.ListUserWebhooksResponse
+	(*CreateUserWebhookRequest)(nil),             // 40: memos.api.v1.CreateUserWebhookRequest
+	(*UpdateUserWebhookRequest)(nil),             // 41: memos.api.v1.UpdateUserWebhookRequest
+	(*DeleteUserWebhookRequest)(nil),             // 42: memos.api.v1.DeleteUserWebhookRequest
+	(*GetUserWebhookRequest)(nil),                // 43: memos.api.v1.GetUserWebhookRequest
+	(*RotateUserWebhookSecretRequest)(nil),       // 44: memos.api.v1.RotateUserWebhookSecretRequest
+	(*TestUserWebhookRequest)(nil),               // 45: memos.api.v1.TestUserWebhookRequest
+	(*ListUserWebhookDeliveriesRequest)(nil),     // 46: memos.api.v1.ListUserWebhookDeliveriesRequest
+	(*ListUserWebhookDeliveriesResponse)(nil),    // 47: memos.api.v1.ListUserWebhookDeliveriesResponse
+	(*UserImportJob)(nil),                        // 48: memos.api.v1.UserImportJob
+	(*CreateUserImportJobRequest)(nil),           // 49: memos.api.v1.CreateUserImportJobRequest
+	(*GetUserImportJobRequest)(nil),              // 50: memos.api.v1.GetUserImportJobRequest
+	(*User_Profile)(nil),                         // 51: memos.api.v1.User.Profile
+	(*User_Profile_Link)(nil),                    // 52: memos.api.v1.User.Profile.Link
+	nil,                                          // 53: memos.api.v1.UserStats.TagCountEntry
+	(*UserStats_MemoTypeStats)(nil),              // 54: memos.api.v1.UserStats.MemoTypeStats
+	(*UserActivityCalendar_Day)(nil),             // 55: memos.api.v1.UserActivityCalendar.Day
+	(*UserSetting_GeneralSetting)(nil),           // 56: memos.api.v1.UserSetting.GeneralSetting
+	(*UserSetting_SessionsSetting)(nil),          // 57: memos.api.v1.UserSetting.SessionsSetting
+	(*UserSetting_AccessTokensSetting)(nil),      // 58: memos.api.v1.UserSetting.AccessTokensSetting
+	(*UserSetting_WebhooksSetting)(nil),          // 59: memos.api.v1.UserSetting.WebhooksSetting
+	(*UserSetting_AIAutoSummarySetting)(nil),     // 60: memos.api.v1.UserSetting.AIAutoSummarySetting
+	(*UserSetting_NostrSetting)(nil),             // 61: memos.api.v1.UserSetting.NostrSetting
+	(*UserSetting_BlueskySetting)(nil),           // 62: memos.api.v1.UserSetting.BlueskySetting
+	(*UserSetting_MastodonSetting)(nil),          // 63: memos.api.v1.UserSetting.MastodonSetting
+	(*UserSetting_MicropubSetting)(nil),          // 64: memos.api.v1.UserSetting.MicropubSetting
+	(*UserSetting_IndieAuthSetting)(nil),         // 65: memos.api.v1.UserSetting.IndieAuthSetting
+	(*UserSetting_TaggingRulesSetting)(nil),      // 66: memos.api.v1.UserSetting.TaggingRulesSetting
+	(*UserSetting_FormattingSetting)(nil),        // 67: memos.api.v1.UserSetting.FormattingSetting
+	(*UserSetting_TaggingRulesSetting_Rule)(nil), // 68: memos.api.v1.UserSetting.TaggingRulesSetting.Rule
+	(*UserSession_ClientInfo)(nil),               // 69: memos.api.v1.UserSession.ClientInfo
+	(State)(0),                                   // 70: memos.api.v1.State
+	(*timestamppb.Timestamp)(nil),                // 71: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                // 72: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                        // 73: google.protobuf.Empty
+	(*httpbody.HttpBody)(nil),                    // 74: google.api.HttpBody
 }
 var file_api_v1_user_service_proto_depIdxs = []int32{
 	0,  // 0: memos.api.v1.User.role:type_name -> memos.api.v1.User.Role
-	42, // 1: memos.api.v1.User.state:type_name -> memos.api.v1.State
-	43, // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
-	43, // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
-	2,  // 4: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
-	44, // 5: memos.api.v1.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
-	2,  // 6: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
-	2,  // 7: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
-	44, // 8: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	43, // 9: memos.api.v1.UserStats.memo_display_timestamps:type_name -> google.protobuf.Timestamp
-	35, // 10: memos.api.v1.UserStats.memo_type_stats:type_name -> memos.api.v1.UserStats.MemoTypeStats
-	34, // 11: memos.api.v1.UserStats.tag_count:type_name -> memos.api.v1.UserStats.TagCountEntry
-	10, // 12: memos.api.v1.ListAllUserStatsResponse.stats:type_name -> memos.api.v1.UserStats
-	36, // 13: memos.api.v1.UserSetting.general_setting:type_name -> memos.api.v1.UserSetting.GeneralSetting
-	37, // 14: memos.api.v1.UserSetting.sessions_setting:type_name -> memos.api.v1.UserSetting.SessionsSetting
-	38, // 15: memos.api.v1.UserSetting.access_tokens_setting:type_name -> memos.api.v1.UserSetting.AccessTokensSetting
-	39, // 16: memos.api.v1.UserSetting.webhooks_setting:type_name -> memos.api.v1.UserSetting.WebhooksSetting
-	40, // 17: memos.api.v1.UserSetting.ai_auto_summary_setting:type_name -> memos.api.v1.UserSetting.AIAutoSummarySetting
-	14, // 18: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
-	44, // 19: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	14, // 20: memos.api.v1.ListUserSettingsResponse.settings:type_name -> memos.api.v1.UserSetting
-	43, // 21: memos.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
-	43, // 22: memos.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
-	19, // 23: memos.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> memos.api.v1.UserAccessToken
-	19, // 24: memos.api.v1.CreateUserAccessTokenRequest.access_token:type_name -> memos.api.v1.UserAccessToken
-	43, // 25: memos.api.v1.UserSession.create_time:type_name -> google.protobuf.Timestamp
-	43, // 26: memos.api.v1.UserSession.last_accessed_time:type_name -> google.protobuf.Timestamp
-	41, // 27: memos.api.v1.UserSession.client_info:type_name -> memos.api.v1.UserSession.ClientInfo
-	24, // 28: memos.api.v1.ListUserSessionsResponse.sessions:type_name -> memos.api.v1.UserSession
-	43, // 29: memos.api.v1.UserWebhook.create_time:type_name -> google.protobuf.Timestamp
-	43, // 30: memos.api.v1.UserWebhook.update_time:type_name -> google.protobuf.Timestamp
-	28, // 31: memos.api.v1.ListUserWebhooksResponse.webhooks:type_name -> memos.api.v1.UserWebhook
-	28, // 32: memos.api.v1.CreateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
-	28, // 33: memos.api.v1.UpdateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
-	44, // 34: memos.api.v1.UpdateUserWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
-	24, // 35: memos.api.v1.UserSetting.SessionsSetting.sessions:type_name -> memos.api.v1.UserSession
-	19, // 36: memos.api.v1.UserSetting.AccessTokensSetting.access_tokens:type_name -> memos.api.v1.UserAccessToken
-	28, // 37: memos.api.v1.UserSetting.WebhooksSetting.webhooks:type_name -> memos.api.v1.UserWebhook
-	3,  // 38: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
-	5,  // 39: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
-	6,  // 40: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
-	7,  // 41: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
-	8,  // 42: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
-	9,  // 43: memos.api.v1.UserService.GetUserAvatar:input_type -> memos.api.v1.GetUserAvatarRequest
-	12, // 44: memos.api.v1.UserService.ListAllUserStats:input_type -> memos.api.v1.ListAllUserStatsRequest
-	11, // 45: memos.api.v1.UserService.GetUserStats:input_type -> memos.api.v1.GetUserStatsRequest
-	15, // 46: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
-	16, // 47: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
-	17, // 48: memos.api.v1.UserService.ListUserSettings:input_type -> memos.api.v1.ListUserSettingsRequest
-	20, // 49: memos.api.v1.UserService.ListUserAccessTokens:input_type -> memos.api.v1.ListUserAccessTokensRequest
-	22, // 50: memos.api.v1.UserService.CreateUserAccessToken:input_type -> memos.api.v1.CreateUserAccessTokenRequest
-	23, // 51: memos.api.v1.UserService.DeleteUserAccessToken:input_type -> memos.api.v1.DeleteUserAccessTokenRequest
-	25, // 52: memos.api.v1.UserService.ListUserSessions:input_type -> memos.api.v1.ListUserSessionsRequest
-	27, // 53: memos.api.v1.UserService.RevokeUserSession:input_type -> memos.api.v1.RevokeUserSessionRequest
-	29, // 54: memos.api.v1.UserService.ListUserWebhooks:input_type -> memos.api.v1.ListUserWebhooksRequest
-	31, // 55: memos.api.v1.UserService.CreateUserWebhook:input_type -> memos.api.v1.CreateUserWebhookRequest
-	32, // 56: memos.api.v1.UserService.UpdateUserWebhook:input_type -> memos.api.v1.UpdateUserWebhookRequest
-	33, // 57: memos.api.v1.UserService.DeleteUserWebhook:input_type -> memos.api.v1.DeleteUserWebhookRequest
-	4,  // 58: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
-	2,  // 59: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
-	2,  // 60: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
-	2,  // 61: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
-	45, // 62: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	46, // 63: memos.api.v1.UserService.GetUserAvatar:output_type -> google.api.HttpBody
-	13, // 64: memos.api.v1.UserService.ListAllUserStats:output_type -> memos.api.v1.ListAllUserStatsResponse
-	10, // 65: memos.api.v1.UserService.GetUserStats:output_type -> memos.api.v1.UserStats
-	14, // 66: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
-	14, // 67: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
-	18, // 68: memos.api.v1.UserService.ListUserSettings:output_type -> memos.api.v1.ListUserSettingsResponse
-	21, // 69: memos.api.v1.UserService.ListUserAccessTokens:output_type -> memos.api.v1.ListUserAccessTokensResponse
-	19, // 70: memos.api.v1.UserService.CreateUserAccessToken:output_type -> memos.api.v1.UserAccessToken
-	45, // 71: memos.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
-	26, // 72: memos.api.v1.UserService.ListUserSessions:output_type -> memos.api.v1.ListUserSessionsResponse
-	45, // 73: memos.api.v1.UserService.RevokeUserSession:output_type -> google.protobuf.Empty
-	30, // 74: memos.api.v1.UserService.ListUserWebhooks:output_type -> memos.api.v1.ListUserWebhooksResponse
-	28, // 75: memos.api.v1.UserService.CreateUserWebhook:output_type -> memos.api.v1.UserWebhook
-	28, // 76: memos.api.v1.UserService.UpdateUserWebhook:output_type -> memos.api.v1.UserWebhook
-	45, // 77: memos.api.v1.UserService.DeleteUserWebhook:output_type -> google.protobuf.Empty
-	58, // [58:78] is the sub-list for method output_type
-	38, // [38:58] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	38, // [38:38] is the sub-list for extension extendee
-	0,  // [0:38] is the sub-list for field type_name
+	70, // 1: memos.api.v1.User.state:type_name -> memos.api.v1.State
+	71, // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
+	71, // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
+	51, // 4: memos.api.v1.User.profile:type_name -> memos.api.v1.User.Profile
+	4,  // 5: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
+	72, // 6: memos.api.v1.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
+	4,  // 7: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
+	4,  // 8: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
+	72, // 9: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	71, // 10: memos.api.v1.UserStats.memo_display_timestamps:type_name -> google.protobuf.Timestamp
+	54, // 11: memos.api.v1.UserStats.memo_type_stats:type_name -> memos.api.v1.UserStats.MemoTypeStats
+	53, // 12: memos.api.v1.UserStats.tag_count:type_name -> memos.api.v1.UserStats.TagCountEntry
+	55, // 13: memos.api.v1.UserActivityCalendar.days:type_name -> memos.api.v1.UserActivityCalendar.Day
+	16, // 14: memos.api.v1.ListAllUserStatsResponse.stats:type_name -> memos.api.v1.UserStats
+	56, // 15: memos.api.v1.UserSetting.general_setting:type_name -> memos.api.v1.UserSetting.GeneralSetting
+	57, // 16: memos.api.v1.UserSetting.sessions_setting:type_name -> memos.api.v1.UserSetting.SessionsSetting
+	58, // 17: memos.api.v1.UserSetting.access_tokens_setting:type_name -> memos.api.v1.UserSetting.AccessTokensSetting
+	59, // 18: memos.api.v1.UserSetting.webhooks_setting:type_name -> memos.api.v1.UserSetting.WebhooksSetting
+	60, // 19: memos.api.v1.UserSetting.ai_auto_summary_setting:type_name -> memos.api.v1.UserSetting.AIAutoSummarySetting
+	61, // 20: memos.api.v1.UserSetting.nostr_setting:type_name -> memos.api.v1.UserSetting.NostrSetting
+	62, // 21: memos.api.v1.UserSetting.bluesky_setting:type_name -> memos.api.v1.UserSetting.BlueskySetting
+	63, // 22: memos.api.v1.UserSetting.mastodon_setting:type_name -> memos.api.v1.UserSetting.MastodonSetting
+	64, // 23: memos.api.v1.UserSetting.micropub_setting:type_name -> memos.api.v1.UserSetting.MicropubSetting
+	65, // 24: memos.api.v1.UserSetting.indie_auth_setting:type_name -> memos.api.v1.UserSetting.IndieAuthSetting
+	66, // 25: memos.api.v1.UserSetting.tagging_rules_setting:type_name -> memos.api.v1.UserSetting.TaggingRulesSetting
+	67, // 26: memos.api.v1.UserSetting.formatting_setting:type_name -> memos.api.v1.UserSetting.FormattingSetting
+	22, // 27: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
+	72, // 28: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	22, // 29: memos.api.v1.ListUserSettingsResponse.settings:type_name -> memos.api.v1.UserSetting
+	71, // 30: memos.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
+	71, // 31: memos.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	27, // 32: memos.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> memos.api.v1.UserAccessToken
+	27, // 33: memos.api.v1.CreateUserAccessTokenRequest.access_token:type_name -> memos.api.v1.UserAccessToken
+	71, // 34: memos.api.v1.UserSession.create_time:type_name -> google.protobuf.Timestamp
+	71, // 35: memos.api.v1.UserSession.last_accessed_time:type_name -> google.protobuf.Timestamp
+	69, // 36: memos.api.v1.UserSession.client_info:type_name -> memos.api.v1.UserSession.ClientInfo
+	32, // 37: memos.api.v1.ListUserSessionsResponse.sessions:type_name -> memos.api.v1.UserSession
+	71, // 38: memos.api.v1.UserWebhook.create_time:type_name -> google.protobuf.Timestamp
+	71, // 39: memos.api.v1.UserWebhook.update_time:type_name -> google.protobuf.Timestamp
+	71, // 40: memos.api.v1.UserWebhookDelivery.create_time:type_name -> google.protobuf.Timestamp
+	36, // 41: memos.api.v1.ListUserWebhooksResponse.webhooks:type_name -> memos.api.v1.UserWebhook
+	36, // 42: memos.api.v1.CreateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
+	36, // 43: memos.api.v1.UpdateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
+	72, // 44: memos.api.v1.UpdateUserWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
+	37, // 45: memos.api.v1.ListUserWebhookDeliveriesResponse.deliveries:type_name -> memos.api.v1.UserWebhookDelivery
+	3,  // 46: memos.api.v1.UserImportJob.state:type_name -> memos.api.v1.UserImportJob.State
+	71, // 47: memos.api.v1.UserImportJob.create_time:type_name -> google.protobuf.Timestamp
+	71, // 48: memos.api.v1.UserImportJob.finish_time:type_name -> google.protobuf.Timestamp
+	1,  // 49: memos.api.v1.User.Profile.bio_visibility:type_name -> memos.api.v1.User.Profile.Visibility
+	1,  // 50: memos.api.v1.User.Profile.pronouns_visibility:type_name -> memos.api.v1.User.Profile.Visibility
+	52, // 51: memos.api.v1.User.Profile.links:type_name -> memos.api.v1.User.Profile.Link
+	1,  // 52: memos.api.v1.User.Profile.links_visibility:type_name -> memos.api.v1.User.Profile.Visibility
+	32, // 53: memos.api.v1.UserSetting.SessionsSetting.sessions:type_name -> memos.api.v1.UserSession
+	27, // 54: memos.api.v1.UserSetting.AccessTokensSetting.access_tokens:type_name -> memos.api.v1.UserAccessToken
+	36, // 55: memos.api.v1.UserSetting.WebhooksSetting.webhooks:type_name -> memos.api.v1.UserWebhook
+	71, // 56: memos.api.v1.UserSetting.AIAutoSummarySetting.last_run_time:type_name -> google.protobuf.Timestamp
+	68, // 57: memos.api.v1.UserSetting.TaggingRulesSetting.rules:type_name -> memos.api.v1.UserSetting.TaggingRulesSetting.Rule
+	5,  // 58: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
+	7,  // 59: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
+	8,  // 60: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
+	9,  // 61: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
+	10, // 62: memos.api.v1.UserService.ChangeUsername:input_type -> memos.api.v1.ChangeUsernameRequest
+	11, // 63: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
+	12, // 64: memos.api.v1.UserService.ApproveUser:input_type -> memos.api.v1.ApproveUserRequest
+	13, // 65: memos.api.v1.UserService.SetUserFeatureFlag:input_type -> memos.api.v1.SetUserFeatureFlagRequest
+	14, // 66: memos.api.v1.UserService.GetUserAvatar:input_type -> memos.api.v1.GetUserAvatarRequest
+	15, // 67: memos.api.v1.UserService.UploadUserAvatar:input_type -> memos.api.v1.UploadUserAvatarRequest
+	20, // 68: memos.api.v1.UserService.ListAllUserStats:input_type -> memos.api.v1.ListAllUserStatsRequest
+	17, // 69: memos.api.v1.UserService.GetUserStats:input_type -> memos.api.v1.GetUserStatsRequest
+	18, // 70: memos.api.v1.UserService.GetUserActivityCalendar:input_type -> memos.api.v1.GetUserActivityCalendarRequest
+	23, // 71: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
+	24, // 72: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
+	25, // 73: memos.api.v1.UserService.ListUserSettings:input_type -> memos.api.v1.ListUserSettingsRequest
+	28, // 74: memos.api.v1.UserService.ListUserAccessTokens:input_type -> memos.api.v1.ListUserAccessTokensRequest
+	30, // 75: memos.api.v1.UserService.CreateUserAccessToken:input_type -> memos.api.v1.CreateUserAccessTokenRequest
+	31, // 76: memos.api.v1.UserService.DeleteUserAccessToken:input_type -> memos.api.v1.DeleteUserAccessTokenRequest
+	33, // 77: memos.api.v1.UserService.ListUserSessions:input_type -> memos.api.v1.ListUserSessionsRequest
+	35, // 78: memos.api.v1.UserService.RevokeUserSession:input_type -> memos.api.v1.RevokeUserSessionRequest
+	38, // 79: memos.api.v1.UserService.ListUserWebhooks:input_type -> memos.api.v1.ListUserWebhooksRequest
+	43, // 80: memos.api.v1.UserService.GetUserWebhook:input_type -> memos.api.v1.GetUserWebhookRequest
+	40, // 81: memos.api.v1.UserService.CreateUserWebhook:input_type -> memos.api.v1.CreateUserWebhookRequest
+	41, // 82: memos.api.v1.UserService.UpdateUserWebhook:input_type -> memos.api.v1.UpdateUserWebhookRequest
+	42, // 83: memos.api.v1.UserService.DeleteUserWebhook:input_type -> memos.api.v1.DeleteUserWebhookRequest
+	44, // 84: memos.api.v1.UserService.RotateUserWebhookSecret:input_type -> memos.api.v1.RotateUserWebhookSecretRequest
+	45, // 85: memos.api.v1.UserService.TestUserWebhook:input_type -> memos.api.v1.TestUserWebhookRequest
+	46, // 86: memos.api.v1.UserService.ListUserWebhookDeliveries:input_type -> memos.api.v1.ListUserWebhookDeliveriesRequest
+	49, // 87: memos.api.v1.UserService.CreateUserImportJob:input_type -> memos.api.v1.CreateUserImportJobRequest
+	50, // 88: memos.api.v1.UserService.GetUserImportJob:input_type -> memos.api.v1.GetUserImportJobRequest
+	6,  // 89: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
+	4,  // 90: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
+	4,  // 91: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
+	4,  // 92: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
+	4,  // 93: memos.api.v1.UserService.ChangeUsername:output_type -> memos.api.v1.User
+	73, // 94: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	4,  // 95: memos.api.v1.UserService.ApproveUser:output_type -> memos.api.v1.User
+	73, // 96: memos.api.v1.UserService.SetUserFeatureFlag:output_type -> google.protobuf.Empty
+	74, // 97: memos.api.v1.UserService.GetUserAvatar:output_type -> google.api.HttpBody
+	4,  // 98: memos.api.v1.UserService.UploadUserAvatar:output_type -> memos.api.v1.User
+	21, // 99: memos.api.v1.UserService.ListAllUserStats:output_type -> memos.api.v1.ListAllUserStatsResponse
+	16, // 100: memos.api.v1.UserService.GetUserStats:output_type -> memos.api.v1.UserStats
+	19, // 101: memos.api.v1.UserService.GetUserActivityCalendar:output_type -> memos.api.v1.UserActivityCalendar
+	22, // 102: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
+	22, // 103: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
+	26, // 104: memos.api.v1.UserService.ListUserSettings:output_type -> memos.api.v1.ListUserSettingsResponse
+	29, // 105: memos.api.v1.UserService.ListUserAccessTokens:output_type -> memos.api.v1.ListUserAccessTokensResponse
+	27, // 106: memos.api.v1.UserService.CreateUserAccessToken:output_type -> memos.api.v1.UserAccessToken
+	73, // 107: memos.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
+	34, // 108: memos.api.v1.UserService.ListUserSessions:output_type -> memos.api.v1.ListUserSessionsResponse
+	73, // 109: memos.api.v1.UserService.RevokeUserSession:output_type -> google.protobuf.Empty
+	39, // 110: memos.api.v1.UserService.ListUserWebhooks:output_type -> memos.api.v1.ListUserWebhooksResponse
+	36, // 111: memos.api.v1.UserService.GetUserWebhook:output_type -> memos.api.v1.UserWebhook
+	36, // 112: memos.api.v1.UserService.CreateUserWebhook:output_type -> memos.api.v1.UserWebhook
+	36, // 113: memos.api.v1.UserService.UpdateUserWebhook:output_type -> memos.api.v1.UserWebhook
+	73, // 114: memos.api.v1.UserService.DeleteUserWebhook:output_type -> google.protobuf.Empty
+	36, // 115: memos.api.v1.UserService.RotateUserWebhookSecret:output_type -> memos.api.v1.UserWebhook
+	37, // 116: memos.api.v1.UserService.TestUserWebhook:output_type -> memos.api.v1.UserWebhookDelivery
+	47, // 117: memos.api.v1.UserService.ListUserWebhookDeliveries:output_type -> memos.api.v1.ListUserWebhookDeliveriesResponse
+	48, // 118: memos.api.v1.UserService.CreateUserImportJob:output_type -> memos.api.v1.UserImportJob
+	48, // 119: memos.api.v1.UserService.GetUserImportJob:output_type -> memos.api.v1.UserImportJob
+	89, // [89:120] is the sub-list for method output_type
+	58, // [58:89] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_user_service_proto_init() }
@@ -2999,20 +5514,29 @@ func file_api_v1_user_service_proto_init() {
 		return
 	}
 	file_api_v1_common_proto_init()
-	file_api_v1_user_service_proto_msgTypes[12].OneofWrappers = []any{
+	file_api_v1_user_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_api_v1_user_service_proto_msgTypes[18].OneofWrappers = []any{
 		(*UserSetting_GeneralSetting_)(nil),
 		(*UserSetting_SessionsSetting_)(nil),
 		(*UserSetting_AccessTokensSetting_)(nil),
 		(*UserSetting_WebhooksSetting_)(nil),
 		(*UserSetting_AiAutoSummarySetting)(nil),
-	}
+		(*UserSetting_NostrSetting_)(nil),
+		(*UserSetting_BlueskySetting_)(nil),
+		(*UserSetting_MastodonSetting_)(nil),
+		(*UserSetting_MicropubSetting_)(nil),
+		(*UserSetting_IndieAuthSetting_)(nil),
+		(*UserSetting_TaggingRulesSetting_)(nil),
+		(*UserSetting_FormattingSetting_)(nil),
+	}
+	file_api_v1_user_service_proto_msgTypes[47].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_user_service_proto_rawDesc), len(file_api_v1_user_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   40,
+			NumEnums:      4,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
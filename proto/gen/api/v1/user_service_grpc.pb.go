@@ -21,26 +21,37 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_ListUsers_FullMethodName             = "/memos.api.v1.UserService/ListUsers"
-	UserService_GetUser_FullMethodName               = "/memos.api.v1.UserService/GetUser"
-	UserService_CreateUser_FullMethodName            = "/memos.api.v1.UserService/CreateUser"
-	UserService_UpdateUser_FullMethodName            = "/memos.api.v1.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName            = "/memos.api.v1.UserService/DeleteUser"
-	UserService_GetUserAvatar_FullMethodName         = "/memos.api.v1.UserService/GetUserAvatar"
-	UserService_ListAllUserStats_FullMethodName      = "/memos.api.v1.UserService/ListAllUserStats"
-	UserService_GetUserStats_FullMethodName          = "/memos.api.v1.UserService/GetUserStats"
-	UserService_GetUserSetting_FullMethodName        = "/memos.api.v1.UserService/GetUserSetting"
-	UserService_UpdateUserSetting_FullMethodName     = "/memos.api.v1.UserService/UpdateUserSetting"
-	UserService_ListUserSettings_FullMethodName      = "/memos.api.v1.UserService/ListUserSettings"
-	UserService_ListUserAccessTokens_FullMethodName  = "/memos.api.v1.UserService/ListUserAccessTokens"
-	UserService_CreateUserAccessToken_FullMethodName = "/memos.api.v1.UserService/CreateUserAccessToken"
-	UserService_DeleteUserAccessToken_FullMethodName = "/memos.api.v1.UserService/DeleteUserAccessToken"
-	UserService_ListUserSessions_FullMethodName      = "/memos.api.v1.UserService/ListUserSessions"
-	UserService_RevokeUserSession_FullMethodName     = "/memos.api.v1.UserService/RevokeUserSession"
-	UserService_ListUserWebhooks_FullMethodName      = "/memos.api.v1.UserService/ListUserWebhooks"
-	UserService_CreateUserWebhook_FullMethodName     = "/memos.api.v1.UserService/CreateUserWebhook"
-	UserService_UpdateUserWebhook_FullMethodName     = "/memos.api.v1.UserService/UpdateUserWebhook"
-	UserService_DeleteUserWebhook_FullMethodName     = "/memos.api.v1.UserService/DeleteUserWebhook"
+	UserService_ListUsers_FullMethodName                 = "/memos.api.v1.UserService/ListUsers"
+	UserService_GetUser_FullMethodName                   = "/memos.api.v1.UserService/GetUser"
+	UserService_CreateUser_FullMethodName                = "/memos.api.v1.UserService/CreateUser"
+	UserService_UpdateUser_FullMethodName                = "/memos.api.v1.UserService/UpdateUser"
+	UserService_ChangeUsername_FullMethodName            = "/memos.api.v1.UserService/ChangeUsername"
+	UserService_DeleteUser_FullMethodName                = "/memos.api.v1.UserService/DeleteUser"
+	UserService_ApproveUser_FullMethodName               = "/memos.api.v1.UserService/ApproveUser"
+	UserService_SetUserFeatureFlag_FullMethodName        = "/memos.api.v1.UserService/SetUserFeatureFlag"
+	UserService_GetUserAvatar_FullMethodName             = "/memos.api.v1.UserService/GetUserAvatar"
+	UserService_UploadUserAvatar_FullMethodName          = "/memos.api.v1.UserService/UploadUserAvatar"
+	UserService_ListAllUserStats_FullMethodName          = "/memos.api.v1.UserService/ListAllUserStats"
+	UserService_GetUserStats_FullMethodName              = "/memos.api.v1.UserService/GetUserStats"
+	UserService_GetUserActivityCalendar_FullMethodName   = "/memos.api.v1.UserService/GetUserActivityCalendar"
+	UserService_GetUserSetting_FullMethodName            = "/memos.api.v1.UserService/GetUserSetting"
+	UserService_UpdateUserSetting_FullMethodName         = "/memos.api.v1.UserService/UpdateUserSetting"
+	UserService_ListUserSettings_FullMethodName          = "/memos.api.v1.UserService/ListUserSettings"
+	UserService_ListUserAccessTokens_FullMethodName      = "/memos.api.v1.UserService/ListUserAccessTokens"
+	UserService_CreateUserAccessToken_FullMethodName     = "/memos.api.v1.UserService/CreateUserAccessToken"
+	UserService_DeleteUserAccessToken_FullMethodName     = "/memos.api.v1.UserService/DeleteUserAccessToken"
+	UserService_ListUserSessions_FullMethodName          = "/memos.api.v1.UserService/ListUserSessions"
+	UserService_RevokeUserSession_FullMethodName         = "/memos.api.v1.UserService/RevokeUserSession"
+	UserService_ListUserWebhooks_FullMethodName          = "/memos.api.v1.UserService/ListUserWebhooks"
+	UserService_GetUserWebhook_FullMethodName            = "/memos.api.v1.UserService/GetUserWebhook"
+	UserService_CreateUserWebhook_FullMethodName         = "/memos.api.v1.UserService/CreateUserWebhook"
+	UserService_UpdateUserWebhook_FullMethodName         = "/memos.api.v1.UserService/UpdateUserWebhook"
+	UserService_DeleteUserWebhook_FullMethodName         = "/memos.api.v1.UserService/DeleteUserWebhook"
+	UserService_RotateUserWebhookSecret_FullMethodName   = "/memos.api.v1.UserService/RotateUserWebhookSecret"
+	UserService_TestUserWebhook_FullMethodName           = "/memos.api.v1.UserService/TestUserWebhook"
+	UserService_ListUserWebhookDeliveries_FullMethodName = "/memos.api.v1.UserService/ListUserWebhookDeliveries"
+	UserService_CreateUserImportJob_FullMethodName       = "/memos.api.v1.UserService/CreateUserImportJob"
+	UserService_GetUserImportJob_FullMethodName          = "/memos.api.v1.UserService/GetUserImportJob"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -58,14 +69,27 @@ type UserServiceClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
 	// UpdateUser updates a user.
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
+	// ChangeUsername changes the username of a user. The memos mentioning the previous username are updated, and the
+	// previous username keeps resolving to the user for a grace period, during which other users cannot take it.
+	ChangeUsername(ctx context.Context, in *ChangeUsernameRequest, opts ...grpc.CallOption) (*User, error)
 	// DeleteUser deletes a user.
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ApproveUser lifts the new user limits of a user. Only admins can approve users.
+	ApproveUser(ctx context.Context, in *ApproveUserRequest, opts ...grpc.CallOption) (*User, error)
+	// SetUserFeatureFlag overrides a workspace feature flag for a user. Only admins can set overrides.
+	SetUserFeatureFlag(ctx context.Context, in *SetUserFeatureFlagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// GetUserAvatar gets the avatar of a user.
+	// The uploaded avatars are served with an ETag, and conditional requests with a matching ETag get no content.
 	GetUserAvatar(ctx context.Context, in *GetUserAvatarRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// UploadUserAvatar uploads an avatar image of a user. The image is cropped to a square, resized to the standard
+	// sizes and persisted with the workspace storage, and the avatar_url of the user is set to its serving URL.
+	UploadUserAvatar(ctx context.Context, in *UploadUserAvatarRequest, opts ...grpc.CallOption) (*User, error)
 	// ListAllUserStats returns statistics for all users.
 	ListAllUserStats(ctx context.Context, in *ListAllUserStatsRequest, opts ...grpc.CallOption) (*ListAllUserStatsResponse, error)
 	// GetUserStats returns statistics for a specific user.
 	GetUserStats(ctx context.Context, in *GetUserStatsRequest, opts ...grpc.CallOption) (*UserStats, error)
+	// GetUserActivityCalendar returns the activity calendar of a user, the memos of each day as intensity levels.
+	GetUserActivityCalendar(ctx context.Context, in *GetUserActivityCalendarRequest, opts ...grpc.CallOption) (*UserActivityCalendar, error)
 	// GetUserSetting returns the user setting.
 	GetUserSetting(ctx context.Context, in *GetUserSettingRequest, opts ...grpc.CallOption) (*UserSetting, error)
 	// UpdateUserSetting updates the user setting.
@@ -84,12 +108,25 @@ type UserServiceClient interface {
 	RevokeUserSession(ctx context.Context, in *RevokeUserSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListUserWebhooks returns a list of webhooks for a user.
 	ListUserWebhooks(ctx context.Context, in *ListUserWebhooksRequest, opts ...grpc.CallOption) (*ListUserWebhooksResponse, error)
+	// GetUserWebhook gets a webhook of a user.
+	GetUserWebhook(ctx context.Context, in *GetUserWebhookRequest, opts ...grpc.CallOption) (*UserWebhook, error)
 	// CreateUserWebhook creates a new webhook for a user.
 	CreateUserWebhook(ctx context.Context, in *CreateUserWebhookRequest, opts ...grpc.CallOption) (*UserWebhook, error)
 	// UpdateUserWebhook updates an existing webhook for a user.
 	UpdateUserWebhook(ctx context.Context, in *UpdateUserWebhookRequest, opts ...grpc.CallOption) (*UserWebhook, error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(ctx context.Context, in *DeleteUserWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RotateUserWebhookSecret replaces the signing secret of a webhook.
+	// The new secret is only returned in the response.
+	RotateUserWebhookSecret(ctx context.Context, in *RotateUserWebhookSecretRequest, opts ...grpc.CallOption) (*UserWebhook, error)
+	// TestUserWebhook sends a test request to a webhook and returns the delivery.
+	TestUserWebhook(ctx context.Context, in *TestUserWebhookRequest, opts ...grpc.CallOption) (*UserWebhookDelivery, error)
+	// ListUserWebhookDeliveries returns the recent deliveries of a webhook, most recent first.
+	ListUserWebhookDeliveries(ctx context.Context, in *ListUserWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListUserWebhookDeliveriesResponse, error)
+	// CreateUserImportJob starts importing memos, attachments and relations from another memos instance.
+	CreateUserImportJob(ctx context.Context, in *CreateUserImportJobRequest, opts ...grpc.CallOption) (*UserImportJob, error)
+	// GetUserImportJob gets the latest import job of a user.
+	GetUserImportJob(ctx context.Context, in *GetUserImportJobRequest, opts ...grpc.CallOption) (*UserImportJob, error)
 }
 
 type userServiceClient struct {
@@ -140,6 +177,16 @@ func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) ChangeUsername(ctx context.Context, in *ChangeUsernameRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_ChangeUsername_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -150,6 +197,26 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) ApproveUser(ctx context.Context, in *ApproveUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_ApproveUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetUserFeatureFlag(ctx context.Context, in *SetUserFeatureFlagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_SetUserFeatureFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUserAvatar(ctx context.Context, in *GetUserAvatarRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(httpbody.HttpBody)
@@ -160,6 +227,16 @@ func (c *userServiceClient) GetUserAvatar(ctx context.Context, in *GetUserAvatar
 	return out, nil
 }
 
+func (c *userServiceClient) UploadUserAvatar(ctx context.Context, in *UploadUserAvatarRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_UploadUserAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListAllUserStats(ctx context.Context, in *ListAllUserStatsRequest, opts ...grpc.CallOption) (*ListAllUserStatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAllUserStatsResponse)
@@ -180,6 +257,16 @@ func (c *userServiceClient) GetUserStats(ctx context.Context, in *GetUserStatsRe
 	return out, nil
 }
 
+func (c *userServiceClient) GetUserActivityCalendar(ctx context.Context, in *GetUserActivityCalendarRequest, opts ...grpc.CallOption) (*UserActivityCalendar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserActivityCalendar)
+	err := c.cc.Invoke(ctx, UserService_GetUserActivityCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUserSetting(ctx context.Context, in *GetUserSettingRequest, opts ...grpc.CallOption) (*UserSetting, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UserSetting)
@@ -270,6 +357,16 @@ func (c *userServiceClient) ListUserWebhooks(ctx context.Context, in *ListUserWe
 	return out, nil
 }
 
+func (c *userServiceClient) GetUserWebhook(ctx context.Context, in *GetUserWebhookRequest, opts ...grpc.CallOption) (*UserWebhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserWebhook)
+	err := c.cc.Invoke(ctx, UserService_GetUserWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) CreateUserWebhook(ctx context.Context, in *CreateUserWebhookRequest, opts ...grpc.CallOption) (*UserWebhook, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UserWebhook)
@@ -300,6 +397,56 @@ func (c *userServiceClient) DeleteUserWebhook(ctx context.Context, in *DeleteUse
 	return out, nil
 }
 
+func (c *userServiceClient) RotateUserWebhookSecret(ctx context.Context, in *RotateUserWebhookSecretRequest, opts ...grpc.CallOption) (*UserWebhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserWebhook)
+	err := c.cc.Invoke(ctx, UserService_RotateUserWebhookSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) TestUserWebhook(ctx context.Context, in *TestUserWebhookRequest, opts ...grpc.CallOption) (*UserWebhookDelivery, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserWebhookDelivery)
+	err := c.cc.Invoke(ctx, UserService_TestUserWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserWebhookDeliveries(ctx context.Context, in *ListUserWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListUserWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateUserImportJob(ctx context.Context, in *CreateUserImportJobRequest, opts ...grpc.CallOption) (*UserImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserImportJob)
+	err := c.cc.Invoke(ctx, UserService_CreateUserImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserImportJob(ctx context.Context, in *GetUserImportJobRequest, opts ...grpc.CallOption) (*UserImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserImportJob)
+	err := c.cc.Invoke(ctx, UserService_GetUserImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -315,14 +462,27 @@ type UserServiceServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*User, error)
 	// UpdateUser updates a user.
 	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
+	// ChangeUsername changes the username of a user. The memos mentioning the previous username are updated, and the
+	// previous username keeps resolving to the user for a grace period, during which other users cannot take it.
+	ChangeUsername(context.Context, *ChangeUsernameRequest) (*User, error)
 	// DeleteUser deletes a user.
 	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	// ApproveUser lifts the new user limits of a user. Only admins can approve users.
+	ApproveUser(context.Context, *ApproveUserRequest) (*User, error)
+	// SetUserFeatureFlag overrides a workspace feature flag for a user. Only admins can set overrides.
+	SetUserFeatureFlag(context.Context, *SetUserFeatureFlagRequest) (*emptypb.Empty, error)
 	// GetUserAvatar gets the avatar of a user.
+	// The uploaded avatars are served with an ETag, and conditional requests with a matching ETag get no content.
 	GetUserAvatar(context.Context, *GetUserAvatarRequest) (*httpbody.HttpBody, error)
+	// UploadUserAvatar uploads an avatar image of a user. The image is cropped to a square, resized to the standard
+	// sizes and persisted with the workspace storage, and the avatar_url of the user is set to its serving URL.
+	UploadUserAvatar(context.Context, *UploadUserAvatarRequest) (*User, error)
 	// ListAllUserStats returns statistics for all users.
 	ListAllUserStats(context.Context, *ListAllUserStatsRequest) (*ListAllUserStatsResponse, error)
 	// GetUserStats returns statistics for a specific user.
 	GetUserStats(context.Context, *GetUserStatsRequest) (*UserStats, error)
+	// GetUserActivityCalendar returns the activity calendar of a user, the memos of each day as intensity levels.
+	GetUserActivityCalendar(context.Context, *GetUserActivityCalendarRequest) (*UserActivityCalendar, error)
 	// GetUserSetting returns the user setting.
 	GetUserSetting(context.Context, *GetUserSettingRequest) (*UserSetting, error)
 	// UpdateUserSetting updates the user setting.
@@ -341,12 +501,25 @@ type UserServiceServer interface {
 	RevokeUserSession(context.Context, *RevokeUserSessionRequest) (*emptypb.Empty, error)
 	// ListUserWebhooks returns a list of webhooks for a user.
 	ListUserWebhooks(context.Context, *ListUserWebhooksRequest) (*ListUserWebhooksResponse, error)
+	// GetUserWebhook gets a webhook of a user.
+	GetUserWebhook(context.Context, *GetUserWebhookRequest) (*UserWebhook, error)
 	// CreateUserWebhook creates a new webhook for a user.
 	CreateUserWebhook(context.Context, *CreateUserWebhookRequest) (*UserWebhook, error)
 	// UpdateUserWebhook updates an existing webhook for a user.
 	UpdateUserWebhook(context.Context, *UpdateUserWebhookRequest) (*UserWebhook, error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *DeleteUserWebhookRequest) (*emptypb.Empty, error)
+	// RotateUserWebhookSecret replaces the signing secret of a webhook.
+	// The new secret is only returned in the response.
+	RotateUserWebhookSecret(context.Context, *RotateUserWebhookSecretRequest) (*UserWebhook, error)
+	// TestUserWebhook sends a test request to a webhook and returns the delivery.
+	TestUserWebhook(context.Context, *TestUserWebhookRequest) (*UserWebhookDelivery, error)
+	// ListUserWebhookDeliveries returns the recent deliveries of a webhook, most recent first.
+	ListUserWebhookDeliveries(context.Context, *ListUserWebhookDeliveriesRequest) (*ListUserWebhookDeliveriesResponse, error)
+	// CreateUserImportJob starts importing memos, attachments and relations from another memos instance.
+	CreateUserImportJob(context.Context, *CreateUserImportJobRequest) (*UserImportJob, error)
+	// GetUserImportJob gets the latest import job of a user.
+	GetUserImportJob(context.Context, *GetUserImportJobRequest) (*UserImportJob, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -369,18 +542,33 @@ func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserReq
 func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
 }
+func (UnimplementedUserServiceServer) ChangeUsername(context.Context, *ChangeUsernameRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeUsername not implemented")
+}
 func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedUserServiceServer) ApproveUser(context.Context, *ApproveUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveUser not implemented")
+}
+func (UnimplementedUserServiceServer) SetUserFeatureFlag(context.Context, *SetUserFeatureFlagRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserFeatureFlag not implemented")
+}
 func (UnimplementedUserServiceServer) GetUserAvatar(context.Context, *GetUserAvatarRequest) (*httpbody.HttpBody, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserAvatar not implemented")
 }
+func (UnimplementedUserServiceServer) UploadUserAvatar(context.Context, *UploadUserAvatarRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadUserAvatar not implemented")
+}
 func (UnimplementedUserServiceServer) ListAllUserStats(context.Context, *ListAllUserStatsRequest) (*ListAllUserStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllUserStats not implemented")
 }
 func (UnimplementedUserServiceServer) GetUserStats(context.Context, *GetUserStatsRequest) (*UserStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserStats not implemented")
 }
+func (UnimplementedUserServiceServer) GetUserActivityCalendar(context.Context, *GetUserActivityCalendarRequest) (*UserActivityCalendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserActivityCalendar not implemented")
+}
 func (UnimplementedUserServiceServer) GetUserSetting(context.Context, *GetUserSettingRequest) (*UserSetting, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserSetting not implemented")
 }
@@ -408,6 +596,9 @@ func (UnimplementedUserServiceServer) RevokeUserSession(context.Context, *Revoke
 func (UnimplementedUserServiceServer) ListUserWebhooks(context.Context, *ListUserWebhooksRequest) (*ListUserWebhooksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUserWebhooks not implemented")
 }
+func (UnimplementedUserServiceServer) GetUserWebhook(context.Context, *GetUserWebhookRequest) (*UserWebhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserWebhook not implemented")
+}
 func (UnimplementedUserServiceServer) CreateUserWebhook(context.Context, *CreateUserWebhookRequest) (*UserWebhook, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUserWebhook not implemented")
 }
@@ -417,6 +608,21 @@ func (UnimplementedUserServiceServer) UpdateUserWebhook(context.Context, *Update
 func (UnimplementedUserServiceServer) DeleteUserWebhook(context.Context, *DeleteUserWebhookRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserWebhook not implemented")
 }
+func (UnimplementedUserServiceServer) RotateUserWebhookSecret(context.Context, *RotateUserWebhookSecretRequest) (*UserWebhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateUserWebhookSecret not implemented")
+}
+func (UnimplementedUserServiceServer) TestUserWebhook(context.Context, *TestUserWebhookRequest) (*UserWebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestUserWebhook not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserWebhookDeliveries(context.Context, *ListUserWebhookDeliveriesRequest) (*ListUserWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserWebhookDeliveries not implemented")
+}
+func (UnimplementedUserServiceServer) CreateUserImportJob(context.Context, *CreateUserImportJobRequest) (*UserImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserImportJob not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserImportJob(context.Context, *GetUserImportJobRequest) (*UserImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserImportJob not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -510,6 +716,24 @@ func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ChangeUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUsernameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangeUsername(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ChangeUsername_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangeUsername(ctx, req.(*ChangeUsernameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteUserRequest)
 	if err := dec(in); err != nil {
@@ -528,6 +752,42 @@ func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ApproveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ApproveUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ApproveUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ApproveUser(ctx, req.(*ApproveUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetUserFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserFeatureFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetUserFeatureFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetUserFeatureFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetUserFeatureFlag(ctx, req.(*SetUserFeatureFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetUserAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserAvatarRequest)
 	if err := dec(in); err != nil {
@@ -546,6 +806,24 @@ func _UserService_GetUserAvatar_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UploadUserAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadUserAvatarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UploadUserAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UploadUserAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UploadUserAvatar(ctx, req.(*UploadUserAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ListAllUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAllUserStatsRequest)
 	if err := dec(in); err != nil {
@@ -582,6 +860,24 @@ func _UserService_GetUserStats_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUserActivityCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserActivityCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserActivityCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserActivityCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserActivityCalendar(ctx, req.(*GetUserActivityCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetUserSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserSettingRequest)
 	if err := dec(in); err != nil {
@@ -744,6 +1040,24 @@ func _UserService_ListUserWebhooks_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUserWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserWebhook(ctx, req.(*GetUserWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_CreateUserWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUserWebhookRequest)
 	if err := dec(in); err != nil {
@@ -798,6 +1112,96 @@ func _UserService_DeleteUserWebhook_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_RotateUserWebhookSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateUserWebhookSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RotateUserWebhookSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RotateUserWebhookSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RotateUserWebhookSecret(ctx, req.(*RotateUserWebhookSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_TestUserWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestUserWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).TestUserWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_TestUserWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).TestUserWebhook(ctx, req.(*TestUserWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserWebhookDeliveries(ctx, req.(*ListUserWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateUserImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUserImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUserImportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUserImportJob(ctx, req.(*CreateUserImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserImportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserImportJob(ctx, req.(*GetUserImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -821,14 +1225,30 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUser",
 			Handler:    _UserService_UpdateUser_Handler,
 		},
+		{
+			MethodName: "ChangeUsername",
+			Handler:    _UserService_ChangeUsername_Handler,
+		},
 		{
 			MethodName: "DeleteUser",
 			Handler:    _UserService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "ApproveUser",
+			Handler:    _UserService_ApproveUser_Handler,
+		},
+		{
+			MethodName: "SetUserFeatureFlag",
+			Handler:    _UserService_SetUserFeatureFlag_Handler,
+		},
 		{
 			MethodName: "GetUserAvatar",
 			Handler:    _UserService_GetUserAvatar_Handler,
 		},
+		{
+			MethodName: "UploadUserAvatar",
+			Handler:    _UserService_UploadUserAvatar_Handler,
+		},
 		{
 			MethodName: "ListAllUserStats",
 			Handler:    _UserService_ListAllUserStats_Handler,
@@ -837,6 +1257,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserStats",
 			Handler:    _UserService_GetUserStats_Handler,
 		},
+		{
+			MethodName: "GetUserActivityCalendar",
+			Handler:    _UserService_GetUserActivityCalendar_Handler,
+		},
 		{
 			MethodName: "GetUserSetting",
 			Handler:    _UserService_GetUserSetting_Handler,
@@ -873,6 +1297,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUserWebhooks",
 			Handler:    _UserService_ListUserWebhooks_Handler,
 		},
+		{
+			MethodName: "GetUserWebhook",
+			Handler:    _UserService_GetUserWebhook_Handler,
+		},
 		{
 			MethodName: "CreateUserWebhook",
 			Handler:    _UserService_CreateUserWebhook_Handler,
@@ -885,6 +1313,26 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUserWebhook",
 			Handler:    _UserService_DeleteUserWebhook_Handler,
 		},
+		{
+			MethodName: "RotateUserWebhookSecret",
+			Handler:    _UserService_RotateUserWebhookSecret_Handler,
+		},
+		{
+			MethodName: "TestUserWebhook",
+			Handler:    _UserService_TestUserWebhook_Handler,
+		},
+		{
+			MethodName: "ListUserWebhookDeliveries",
+			Handler:    _UserService_ListUserWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "CreateUserImportJob",
+			Handler:    _UserService_CreateUserImportJob_Handler,
+		},
+		{
+			MethodName: "GetUserImportJob",
+			Handler:    _UserService_GetUserImportJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/user_service.proto",
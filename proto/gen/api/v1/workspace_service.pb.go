@@ -10,7 +10,10 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,6 +26,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The formats of a workspace settings document.
+type WorkspaceSettingsDocumentFormat int32
+
+const (
+	WorkspaceSettingsDocumentFormat_WORKSPACE_SETTINGS_DOCUMENT_FORMAT_UNSPECIFIED WorkspaceSettingsDocumentFormat = 0
+	WorkspaceSettingsDocumentFormat_JSON                                           WorkspaceSettingsDocumentFormat = 1
+	WorkspaceSettingsDocumentFormat_YAML                                           WorkspaceSettingsDocumentFormat = 2
+)
+
+// Enum value maps for WorkspaceSettingsDocumentFormat.
+var (
+	WorkspaceSettingsDocumentFormat_name = map[int32]string{
+		0: "WORKSPACE_SETTINGS_DOCUMENT_FORMAT_UNSPECIFIED",
+		1: "JSON",
+		2: "YAML",
+	}
+	WorkspaceSettingsDocumentFormat_value = map[string]int32{
+		"WORKSPACE_SETTINGS_DOCUMENT_FORMAT_UNSPECIFIED": 0,
+		"JSON": 1,
+		"YAML": 2,
+	}
+)
+
+func (x WorkspaceSettingsDocumentFormat) Enum() *WorkspaceSettingsDocumentFormat {
+	p := new(WorkspaceSettingsDocumentFormat)
+	*p = x
+	return p
+}
+
+func (x WorkspaceSettingsDocumentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceSettingsDocumentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[0].Descriptor()
+}
+
+func (WorkspaceSettingsDocumentFormat) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[0]
+}
+
+func (x WorkspaceSettingsDocumentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceSettingsDocumentFormat.Descriptor instead.
+func (WorkspaceSettingsDocumentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{0}
+}
+
 // Enumeration of workspace setting keys.
 type WorkspaceSetting_Key int32
 
@@ -38,25 +91,57 @@ const (
 	WorkspaceSetting_AI_CONFIG WorkspaceSetting_Key = 4
 	// AI_RATE_LIMIT is the key for AI rate limit settings.
 	WorkspaceSetting_AI_RATE_LIMIT WorkspaceSetting_Key = 5
+	// ONBOARDING is the key for onboarding settings.
+	WorkspaceSetting_ONBOARDING WorkspaceSetting_Key = 6
+	// NEW_USER_LIMIT is the key for new user limit settings.
+	WorkspaceSetting_NEW_USER_LIMIT WorkspaceSetting_Key = 7
+	// FEATURE_FLAGS is the key for feature flag settings.
+	WorkspaceSetting_FEATURE_FLAGS WorkspaceSetting_Key = 8
+	// USAGE_LIMIT is the key for workspace usage limit settings.
+	WorkspaceSetting_USAGE_LIMIT WorkspaceSetting_Key = 9
+	// SENSITIVE_CONTENT is the key for sensitive content classification settings.
+	WorkspaceSetting_SENSITIVE_CONTENT WorkspaceSetting_Key = 10
+	// OUTBOUND_FETCH is the key for the restrictions of the server-initiated requests.
+	WorkspaceSetting_OUTBOUND_FETCH WorkspaceSetting_Key = 11
+	// LEGAL is the key for the legal pages of the workspace.
+	WorkspaceSetting_LEGAL WorkspaceSetting_Key = 12
+	// ALERTING is the key for the channels the operational failures are alerted on.
+	WorkspaceSetting_ALERTING WorkspaceSetting_Key = 13
 )
 
 // Enum value maps for WorkspaceSetting_Key.
 var (
 	WorkspaceSetting_Key_name = map[int32]string{
-		0: "KEY_UNSPECIFIED",
-		1: "GENERAL",
-		2: "STORAGE",
-		3: "MEMO_RELATED",
-		4: "AI_CONFIG",
-		5: "AI_RATE_LIMIT",
+		0:  "KEY_UNSPECIFIED",
+		1:  "GENERAL",
+		2:  "STORAGE",
+		3:  "MEMO_RELATED",
+		4:  "AI_CONFIG",
+		5:  "AI_RATE_LIMIT",
+		6:  "ONBOARDING",
+		7:  "NEW_USER_LIMIT",
+		8:  "FEATURE_FLAGS",
+		9:  "USAGE_LIMIT",
+		10: "SENSITIVE_CONTENT",
+		11: "OUTBOUND_FETCH",
+		12: "LEGAL",
+		13: "ALERTING",
 	}
 	WorkspaceSetting_Key_value = map[string]int32{
-		"KEY_UNSPECIFIED": 0,
-		"GENERAL":         1,
-		"STORAGE":         2,
-		"MEMO_RELATED":    3,
-		"AI_CONFIG":       4,
-		"AI_RATE_LIMIT":   5,
+		"KEY_UNSPECIFIED":   0,
+		"GENERAL":           1,
+		"STORAGE":           2,
+		"MEMO_RELATED":      3,
+		"AI_CONFIG":         4,
+		"AI_RATE_LIMIT":     5,
+		"ONBOARDING":        6,
+		"NEW_USER_LIMIT":    7,
+		"FEATURE_FLAGS":     8,
+		"USAGE_LIMIT":       9,
+		"SENSITIVE_CONTENT": 10,
+		"OUTBOUND_FETCH":    11,
+		"LEGAL":             12,
+		"ALERTING":          13,
 	}
 )
 
@@ -71,11 +156,11 @@ func (x WorkspaceSetting_Key) String() string {
 }
 
 func (WorkspaceSetting_Key) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_workspace_service_proto_enumTypes[0].Descriptor()
+	return file_api_v1_workspace_service_proto_enumTypes[1].Descriptor()
 }
 
 func (WorkspaceSetting_Key) Type() protoreflect.EnumType {
-	return &file_api_v1_workspace_service_proto_enumTypes[0]
+	return &file_api_v1_workspace_service_proto_enumTypes[1]
 }
 
 func (x WorkspaceSetting_Key) Number() protoreflect.EnumNumber {
@@ -84,7 +169,7 @@ func (x WorkspaceSetting_Key) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WorkspaceSetting_Key.Descriptor instead.
 func (WorkspaceSetting_Key) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 0}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 0}
 }
 
 // Storage type enumeration for different storage backends.
@@ -127,11 +212,11 @@ func (x WorkspaceSetting_StorageSetting_StorageType) String() string {
 }
 
 func (WorkspaceSetting_StorageSetting_StorageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_workspace_service_proto_enumTypes[1].Descriptor()
+	return file_api_v1_workspace_service_proto_enumTypes[2].Descriptor()
 }
 
 func (WorkspaceSetting_StorageSetting_StorageType) Type() protoreflect.EnumType {
-	return &file_api_v1_workspace_service_proto_enumTypes[1]
+	return &file_api_v1_workspace_service_proto_enumTypes[2]
 }
 
 func (x WorkspaceSetting_StorageSetting_StorageType) Number() protoreflect.EnumNumber {
@@ -140,270 +225,5606 @@ func (x WorkspaceSetting_StorageSetting_StorageType) Number() protoreflect.EnumN
 
 // Deprecated: Use WorkspaceSetting_StorageSetting_StorageType.Descriptor instead.
 func (WorkspaceSetting_StorageSetting_StorageType) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 1, 0}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 1, 0}
 }
 
-// Workspace profile message containing basic workspace information.
-type WorkspaceProfile struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of instance owner.
-	// Format: users/{user}
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// Version is the current version of instance.
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	// Mode is the instance mode (e.g. "prod", "dev" or "demo").
-	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
-	// Instance URL is the URL of the instance.
-	InstanceUrl   string `protobuf:"bytes,6,opt,name=instance_url,json=instanceUrl,proto3" json:"instance_url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+// Provider is the API spoken by the AI provider.
+type WorkspaceSetting_AISetting_Provider int32
 
-func (x *WorkspaceProfile) Reset() {
-	*x = WorkspaceProfile{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+const (
+	// The OpenAI API, spoken by OpenAI and many compatible services.
+	WorkspaceSetting_AISetting_PROVIDER_UNSPECIFIED WorkspaceSetting_AISetting_Provider = 0
+	WorkspaceSetting_AISetting_OPENAI               WorkspaceSetting_AISetting_Provider = 1
+	// Azure OpenAI, the model is the name of the deployment.
+	WorkspaceSetting_AISetting_AZURE_OPENAI WorkspaceSetting_AISetting_Provider = 2
+	WorkspaceSetting_AISetting_ANTHROPIC    WorkspaceSetting_AISetting_Provider = 3
+	// The native API of an Ollama server, which needs no API key.
+	WorkspaceSetting_AISetting_OLLAMA WorkspaceSetting_AISetting_Provider = 4
+	WorkspaceSetting_AISetting_GEMINI WorkspaceSetting_AISetting_Provider = 5
+	// A test-mode provider replying canned responses without an API key, for the integration tests and the
+	// development of the clients. It is not available in the prod mode.
+	WorkspaceSetting_AISetting_FAKE WorkspaceSetting_AISetting_Provider = 6
+)
+
+// Enum value maps for WorkspaceSetting_AISetting_Provider.
+var (
+	WorkspaceSetting_AISetting_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "OPENAI",
+		2: "AZURE_OPENAI",
+		3: "ANTHROPIC",
+		4: "OLLAMA",
+		5: "GEMINI",
+		6: "FAKE",
+	}
+	WorkspaceSetting_AISetting_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"OPENAI":               1,
+		"AZURE_OPENAI":         2,
+		"ANTHROPIC":            3,
+		"OLLAMA":               4,
+		"GEMINI":               5,
+		"FAKE":                 6,
+	}
+)
+
+func (x WorkspaceSetting_AISetting_Provider) Enum() *WorkspaceSetting_AISetting_Provider {
+	p := new(WorkspaceSetting_AISetting_Provider)
+	*p = x
+	return p
 }
 
-func (x *WorkspaceProfile) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x WorkspaceSetting_AISetting_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*WorkspaceProfile) ProtoMessage() {}
+func (WorkspaceSetting_AISetting_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[3].Descriptor()
+}
 
-func (x *WorkspaceProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (WorkspaceSetting_AISetting_Provider) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[3]
 }
 
-// Deprecated: Use WorkspaceProfile.ProtoReflect.Descriptor instead.
-func (*WorkspaceProfile) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{0}
+func (x WorkspaceSetting_AISetting_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *WorkspaceProfile) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
+// Deprecated: Use WorkspaceSetting_AISetting_Provider.Descriptor instead.
+func (WorkspaceSetting_AISetting_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 3, 0}
 }
 
-func (x *WorkspaceProfile) GetVersion() string {
-	if x != nil {
-		return x.Version
+type WorkspaceSetting_SensitiveContentSetting_Policy int32
+
+const (
+	// POLICY_UNSPECIFIED only flags sensitive attachments.
+	WorkspaceSetting_SensitiveContentSetting_POLICY_UNSPECIFIED WorkspaceSetting_SensitiveContentSetting_Policy = 0
+	// BLUR serves sensitive images blurred unless the viewer chooses to reveal them.
+	WorkspaceSetting_SensitiveContentSetting_BLUR WorkspaceSetting_SensitiveContentSetting_Policy = 1
+	// RESTRICT serves sensitive attachments to signed-in users only and hides them from visitors.
+	WorkspaceSetting_SensitiveContentSetting_RESTRICT WorkspaceSetting_SensitiveContentSetting_Policy = 2
+)
+
+// Enum value maps for WorkspaceSetting_SensitiveContentSetting_Policy.
+var (
+	WorkspaceSetting_SensitiveContentSetting_Policy_name = map[int32]string{
+		0: "POLICY_UNSPECIFIED",
+		1: "BLUR",
+		2: "RESTRICT",
 	}
-	return ""
+	WorkspaceSetting_SensitiveContentSetting_Policy_value = map[string]int32{
+		"POLICY_UNSPECIFIED": 0,
+		"BLUR":               1,
+		"RESTRICT":           2,
+	}
+)
+
+func (x WorkspaceSetting_SensitiveContentSetting_Policy) Enum() *WorkspaceSetting_SensitiveContentSetting_Policy {
+	p := new(WorkspaceSetting_SensitiveContentSetting_Policy)
+	*p = x
+	return p
 }
 
-func (x *WorkspaceProfile) GetMode() string {
-	if x != nil {
-		return x.Mode
-	}
-	return ""
+func (x WorkspaceSetting_SensitiveContentSetting_Policy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *WorkspaceProfile) GetInstanceUrl() string {
-	if x != nil {
-		return x.InstanceUrl
-	}
-	return ""
+func (WorkspaceSetting_SensitiveContentSetting_Policy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[4].Descriptor()
 }
 
-// Request for workspace profile.
-type GetWorkspaceProfileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (WorkspaceSetting_SensitiveContentSetting_Policy) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[4]
 }
 
-func (x *GetWorkspaceProfileRequest) Reset() {
-	*x = GetWorkspaceProfileRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x WorkspaceSetting_SensitiveContentSetting_Policy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetWorkspaceProfileRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use WorkspaceSetting_SensitiveContentSetting_Policy.Descriptor instead.
+func (WorkspaceSetting_SensitiveContentSetting_Policy) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 9, 0}
 }
 
-func (*GetWorkspaceProfileRequest) ProtoMessage() {}
+type WorkspaceSetting_AlertingSetting_AlertChannel_Type int32
 
-func (x *GetWorkspaceProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	WorkspaceSetting_AlertingSetting_AlertChannel_TYPE_UNSPECIFIED WorkspaceSetting_AlertingSetting_AlertChannel_Type = 0
+	WorkspaceSetting_AlertingSetting_AlertChannel_WEBHOOK          WorkspaceSetting_AlertingSetting_AlertChannel_Type = 1
+	WorkspaceSetting_AlertingSetting_AlertChannel_SLACK            WorkspaceSetting_AlertingSetting_AlertChannel_Type = 2
+	WorkspaceSetting_AlertingSetting_AlertChannel_EMAIL            WorkspaceSetting_AlertingSetting_AlertChannel_Type = 3
+)
+
+// Enum value maps for WorkspaceSetting_AlertingSetting_AlertChannel_Type.
+var (
+	WorkspaceSetting_AlertingSetting_AlertChannel_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "WEBHOOK",
+		2: "SLACK",
+		3: "EMAIL",
 	}
-	return mi.MessageOf(x)
+	WorkspaceSetting_AlertingSetting_AlertChannel_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"WEBHOOK":          1,
+		"SLACK":            2,
+		"EMAIL":            3,
+	}
+)
+
+func (x WorkspaceSetting_AlertingSetting_AlertChannel_Type) Enum() *WorkspaceSetting_AlertingSetting_AlertChannel_Type {
+	p := new(WorkspaceSetting_AlertingSetting_AlertChannel_Type)
+	*p = x
+	return p
 }
 
-// Deprecated: Use GetWorkspaceProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkspaceProfileRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{1}
+func (x WorkspaceSetting_AlertingSetting_AlertChannel_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// A workspace setting resource.
-type WorkspaceSetting struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the workspace setting.
-	// Format: workspace/settings/{setting}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Types that are valid to be assigned to Value:
-	//
-	//	*WorkspaceSetting_GeneralSetting_
-	//	*WorkspaceSetting_StorageSetting_
-	//	*WorkspaceSetting_MemoRelatedSetting_
-	//	*WorkspaceSetting_AiSetting
-	Value         isWorkspaceSetting_Value `protobuf_oneof:"value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (WorkspaceSetting_AlertingSetting_AlertChannel_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[5].Descriptor()
 }
 
-func (x *WorkspaceSetting) Reset() {
-	*x = WorkspaceSetting{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (WorkspaceSetting_AlertingSetting_AlertChannel_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[5]
 }
 
-func (x *WorkspaceSetting) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x WorkspaceSetting_AlertingSetting_AlertChannel_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*WorkspaceSetting) ProtoMessage() {}
+// Deprecated: Use WorkspaceSetting_AlertingSetting_AlertChannel_Type.Descriptor instead.
+func (WorkspaceSetting_AlertingSetting_AlertChannel_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 12, 0, 0}
+}
 
-func (x *WorkspaceSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+// The reasons the visibility of a public memo may be unintended.
+type AuditMemoVisibilityResponse_Reason int32
+
+const (
+	AuditMemoVisibilityResponse_REASON_UNSPECIFIED AuditMemoVisibilityResponse_Reason = 0
+	// The content has an email address.
+	AuditMemoVisibilityResponse_EMAIL AuditMemoVisibilityResponse_Reason = 1
+	// The content has a phone number.
+	AuditMemoVisibilityResponse_PHONE_NUMBER AuditMemoVisibilityResponse_Reason = 2
+	// The content has a secret, e.g. an API key, a private key or a password.
+	AuditMemoVisibilityResponse_SECRET AuditMemoVisibilityResponse_Reason = 3
+	// The creator of the memo is deactivated.
+	AuditMemoVisibilityResponse_DEACTIVATED_CREATOR AuditMemoVisibilityResponse_Reason = 4
+)
+
+// Enum value maps for AuditMemoVisibilityResponse_Reason.
+var (
+	AuditMemoVisibilityResponse_Reason_name = map[int32]string{
+		0: "REASON_UNSPECIFIED",
+		1: "EMAIL",
+		2: "PHONE_NUMBER",
+		3: "SECRET",
+		4: "DEACTIVATED_CREATOR",
 	}
-	return mi.MessageOf(x)
-}
+	AuditMemoVisibilityResponse_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED":  0,
+		"EMAIL":               1,
+		"PHONE_NUMBER":        2,
+		"SECRET":              3,
+		"DEACTIVATED_CREATOR": 4,
+	}
+)
 
-// Deprecated: Use WorkspaceSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2}
+func (x AuditMemoVisibilityResponse_Reason) Enum() *AuditMemoVisibilityResponse_Reason {
+	p := new(AuditMemoVisibilityResponse_Reason)
+	*p = x
+	return p
 }
 
-func (x *WorkspaceSetting) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x AuditMemoVisibilityResponse_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *WorkspaceSetting) GetValue() isWorkspaceSetting_Value {
-	if x != nil {
-		return x.Value
-	}
-	return nil
+func (AuditMemoVisibilityResponse_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[6].Descriptor()
 }
 
-func (x *WorkspaceSetting) GetGeneralSetting() *WorkspaceSetting_GeneralSetting {
-	if x != nil {
-		if x, ok := x.Value.(*WorkspaceSetting_GeneralSetting_); ok {
-			return x.GeneralSetting
-		}
-	}
-	return nil
+func (AuditMemoVisibilityResponse_Reason) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[6]
 }
 
-func (x *WorkspaceSetting) GetStorageSetting() *WorkspaceSetting_StorageSetting {
-	if x != nil {
-		if x, ok := x.Value.(*WorkspaceSetting_StorageSetting_); ok {
-			return x.StorageSetting
-		}
-	}
-	return nil
+func (x AuditMemoVisibilityResponse_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *WorkspaceSetting) GetMemoRelatedSetting() *WorkspaceSetting_MemoRelatedSetting {
-	if x != nil {
-		if x, ok := x.Value.(*WorkspaceSetting_MemoRelatedSetting_); ok {
-			return x.MemoRelatedSetting
-		}
-	}
-	return nil
+// Deprecated: Use AuditMemoVisibilityResponse_Reason.Descriptor instead.
+func (AuditMemoVisibilityResponse_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{15, 0}
 }
 
-func (x *WorkspaceSetting) GetAiSetting() *WorkspaceSetting_AISetting {
-	if x != nil {
-		if x, ok := x.Value.(*WorkspaceSetting_AiSetting); ok {
-			return x.AiSetting
-		}
+// Rebuild job state enumeration.
+type MemoPayloadRebuildJob_State int32
+
+const (
+	MemoPayloadRebuildJob_STATE_UNSPECIFIED MemoPayloadRebuildJob_State = 0
+	// The rebuild job is running.
+	MemoPayloadRebuildJob_RUNNING MemoPayloadRebuildJob_State = 1
+	// The rebuild job finished successfully.
+	MemoPayloadRebuildJob_SUCCEEDED MemoPayloadRebuildJob_State = 2
+	// The rebuild job failed.
+	MemoPayloadRebuildJob_FAILED MemoPayloadRebuildJob_State = 3
+)
+
+// Enum value maps for MemoPayloadRebuildJob_State.
+var (
+	MemoPayloadRebuildJob_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "RUNNING",
+		2: "SUCCEEDED",
+		3: "FAILED",
 	}
-	return nil
+	MemoPayloadRebuildJob_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"RUNNING":           1,
+		"SUCCEEDED":         2,
+		"FAILED":            3,
+	}
+)
+
+func (x MemoPayloadRebuildJob_State) Enum() *MemoPayloadRebuildJob_State {
+	p := new(MemoPayloadRebuildJob_State)
+	*p = x
+	return p
 }
 
-type isWorkspaceSetting_Value interface {
-	isWorkspaceSetting_Value()
+func (x MemoPayloadRebuildJob_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type WorkspaceSetting_GeneralSetting_ struct {
-	GeneralSetting *WorkspaceSetting_GeneralSetting `protobuf:"bytes,2,opt,name=general_setting,json=generalSetting,proto3,oneof"`
+func (MemoPayloadRebuildJob_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[7].Descriptor()
 }
 
-type WorkspaceSetting_StorageSetting_ struct {
-	StorageSetting *WorkspaceSetting_StorageSetting `protobuf:"bytes,3,opt,name=storage_setting,json=storageSetting,proto3,oneof"`
+func (MemoPayloadRebuildJob_State) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[7]
 }
 
-type WorkspaceSetting_MemoRelatedSetting_ struct {
-	MemoRelatedSetting *WorkspaceSetting_MemoRelatedSetting `protobuf:"bytes,4,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
+func (x MemoPayloadRebuildJob_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-type WorkspaceSetting_AiSetting struct {
-	AiSetting *WorkspaceSetting_AISetting `protobuf:"bytes,5,opt,name=ai_setting,json=aiSetting,proto3,oneof"`
+// Deprecated: Use MemoPayloadRebuildJob_State.Descriptor instead.
+func (MemoPayloadRebuildJob_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{18, 0}
+}
+
+// Run state enumeration.
+type Runner_RunState int32
+
+const (
+	Runner_RUN_STATE_UNSPECIFIED Runner_RunState = 0
+	// The runner is running.
+	Runner_RUNNING Runner_RunState = 1
+	// The last run finished successfully.
+	Runner_SUCCEEDED Runner_RunState = 2
+	// The last run failed.
+	Runner_FAILED Runner_RunState = 3
+)
+
+// Enum value maps for Runner_RunState.
+var (
+	Runner_RunState_name = map[int32]string{
+		0: "RUN_STATE_UNSPECIFIED",
+		1: "RUNNING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	Runner_RunState_value = map[string]int32{
+		"RUN_STATE_UNSPECIFIED": 0,
+		"RUNNING":               1,
+		"SUCCEEDED":             2,
+		"FAILED":                3,
+	}
+)
+
+func (x Runner_RunState) Enum() *Runner_RunState {
+	p := new(Runner_RunState)
+	*p = x
+	return p
+}
+
+func (x Runner_RunState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Runner_RunState) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[8].Descriptor()
+}
+
+func (Runner_RunState) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[8]
+}
+
+func (x Runner_RunState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Runner_RunState.Descriptor instead.
+func (Runner_RunState) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{23, 0}
+}
+
+// Job type enumeration.
+type DeadLetter_JobType int32
+
+const (
+	DeadLetter_JOB_TYPE_UNSPECIFIED DeadLetter_JobType = 0
+	// A webhook dispatch.
+	DeadLetter_WEBHOOK DeadLetter_JobType = 1
+	// An AI summary generation.
+	DeadLetter_AI_SUMMARY DeadLetter_JobType = 2
+	// A user data import.
+	DeadLetter_USER_IMPORT DeadLetter_JobType = 3
+)
+
+// Enum value maps for DeadLetter_JobType.
+var (
+	DeadLetter_JobType_name = map[int32]string{
+		0: "JOB_TYPE_UNSPECIFIED",
+		1: "WEBHOOK",
+		2: "AI_SUMMARY",
+		3: "USER_IMPORT",
+	}
+	DeadLetter_JobType_value = map[string]int32{
+		"JOB_TYPE_UNSPECIFIED": 0,
+		"WEBHOOK":              1,
+		"AI_SUMMARY":           2,
+		"USER_IMPORT":          3,
+	}
+)
+
+func (x DeadLetter_JobType) Enum() *DeadLetter_JobType {
+	p := new(DeadLetter_JobType)
+	*p = x
+	return p
+}
+
+func (x DeadLetter_JobType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeadLetter_JobType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[9].Descriptor()
+}
+
+func (DeadLetter_JobType) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[9]
+}
+
+func (x DeadLetter_JobType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeadLetter_JobType.Descriptor instead.
+func (DeadLetter_JobType) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{36, 0}
+}
+
+// Severity enumeration.
+type Announcement_Severity int32
+
+const (
+	Announcement_SEVERITY_UNSPECIFIED Announcement_Severity = 0
+	Announcement_INFO                 Announcement_Severity = 1
+	Announcement_WARNING              Announcement_Severity = 2
+	// A critical announcement, e.g. of an imminent downtime.
+	Announcement_CRITICAL Announcement_Severity = 3
+)
+
+// Enum value maps for Announcement_Severity.
+var (
+	Announcement_Severity_name = map[int32]string{
+		0: "SEVERITY_UNSPECIFIED",
+		1: "INFO",
+		2: "WARNING",
+		3: "CRITICAL",
+	}
+	Announcement_Severity_value = map[string]int32{
+		"SEVERITY_UNSPECIFIED": 0,
+		"INFO":                 1,
+		"WARNING":              2,
+		"CRITICAL":             3,
+	}
+)
+
+func (x Announcement_Severity) Enum() *Announcement_Severity {
+	p := new(Announcement_Severity)
+	*p = x
+	return p
+}
+
+func (x Announcement_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Announcement_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[10].Descriptor()
+}
+
+func (Announcement_Severity) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[10]
+}
+
+func (x Announcement_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Announcement_Severity.Descriptor instead.
+func (Announcement_Severity) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{44, 0}
+}
+
+// State enumeration.
+type MaintenanceWindow_State int32
+
+const (
+	MaintenanceWindow_STATE_UNSPECIFIED MaintenanceWindow_State = 0
+	MaintenanceWindow_SCHEDULED         MaintenanceWindow_State = 1
+	// The workspace is read-only until the end of the maintenance.
+	MaintenanceWindow_IN_PROGRESS MaintenanceWindow_State = 2
+	MaintenanceWindow_COMPLETED   MaintenanceWindow_State = 3
+)
+
+// Enum value maps for MaintenanceWindow_State.
+var (
+	MaintenanceWindow_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "SCHEDULED",
+		2: "IN_PROGRESS",
+		3: "COMPLETED",
+	}
+	MaintenanceWindow_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"SCHEDULED":         1,
+		"IN_PROGRESS":       2,
+		"COMPLETED":         3,
+	}
+)
+
+func (x MaintenanceWindow_State) Enum() *MaintenanceWindow_State {
+	p := new(MaintenanceWindow_State)
+	*p = x
+	return p
+}
+
+func (x MaintenanceWindow_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenanceWindow_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[11].Descriptor()
+}
+
+func (MaintenanceWindow_State) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[11]
+}
+
+func (x MaintenanceWindow_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenanceWindow_State.Descriptor instead.
+func (MaintenanceWindow_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{51, 0}
+}
+
+// Workspace profile message containing basic workspace information.
+type WorkspaceProfile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of instance owner.
+	// Format: users/{user}
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Version is the current version of instance.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Mode is the instance mode (e.g. "prod", "dev" or "demo").
+	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Instance URL is the URL of the instance.
+	InstanceUrl string `protobuf:"bytes,6,opt,name=instance_url,json=instanceUrl,proto3" json:"instance_url,omitempty"`
+	// Whether the workspace is read-only during a maintenance window.
+	ReadOnly      bool `protobuf:"varint,7,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceProfile) Reset() {
+	*x = WorkspaceProfile{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceProfile) ProtoMessage() {}
+
+func (x *WorkspaceProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceProfile.ProtoReflect.Descriptor instead.
+func (*WorkspaceProfile) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorkspaceProfile) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *WorkspaceProfile) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *WorkspaceProfile) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *WorkspaceProfile) GetInstanceUrl() string {
+	if x != nil {
+		return x.InstanceUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceProfile) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+// Request for workspace profile.
+type GetWorkspaceProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceProfileRequest) Reset() {
+	*x = GetWorkspaceProfileRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceProfileRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{1}
+}
+
+// The optional subsystems of the workspace enabled for the current user, and their limits.
+type WorkspaceCapabilities struct {
+	state protoimpl.MessageState    `protogen:"open.v1"`
+	Ai    *WorkspaceCapabilities_AI `protobuf:"bytes,1,opt,name=ai,proto3" json:"ai,omitempty"`
+	// Whether the memos can be searched by meaning, with the embedding model of the AI setting.
+	SemanticSearch bool                              `protobuf:"varint,2,opt,name=semantic_search,json=semanticSearch,proto3" json:"semantic_search,omitempty"`
+	Federation     *WorkspaceCapabilities_Federation `protobuf:"bytes,3,opt,name=federation,proto3" json:"federation,omitempty"`
+	Limits         *WorkspaceCapabilities_Limits     `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkspaceCapabilities) Reset() {
+	*x = WorkspaceCapabilities{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceCapabilities) ProtoMessage() {}
+
+func (x *WorkspaceCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceCapabilities.ProtoReflect.Descriptor instead.
+func (*WorkspaceCapabilities) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorkspaceCapabilities) GetAi() *WorkspaceCapabilities_AI {
+	if x != nil {
+		return x.Ai
+	}
+	return nil
+}
+
+func (x *WorkspaceCapabilities) GetSemanticSearch() bool {
+	if x != nil {
+		return x.SemanticSearch
+	}
+	return false
+}
+
+func (x *WorkspaceCapabilities) GetFederation() *WorkspaceCapabilities_Federation {
+	if x != nil {
+		return x.Federation
+	}
+	return nil
+}
+
+func (x *WorkspaceCapabilities) GetLimits() *WorkspaceCapabilities_Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type GetWorkspaceCapabilitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceCapabilitiesRequest) Reset() {
+	*x = GetWorkspaceCapabilitiesRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceCapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceCapabilitiesRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceCapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceCapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3}
+}
+
+// A workspace setting resource.
+type WorkspaceSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the workspace setting.
+	// Format: workspace/settings/{setting}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*WorkspaceSetting_GeneralSetting_
+	//	*WorkspaceSetting_StorageSetting_
+	//	*WorkspaceSetting_MemoRelatedSetting_
+	//	*WorkspaceSetting_AiSetting
+	//	*WorkspaceSetting_OnboardingSetting_
+	//	*WorkspaceSetting_NewUserLimitSetting_
+	//	*WorkspaceSetting_FeatureFlagSetting_
+	//	*WorkspaceSetting_UsageLimitSetting_
+	//	*WorkspaceSetting_SensitiveContentSetting_
+	//	*WorkspaceSetting_OutboundFetchSetting_
+	//	*WorkspaceSetting_LegalSetting_
+	//	*WorkspaceSetting_AlertingSetting_
+	Value isWorkspaceSetting_Value `protobuf_oneof:"value"`
+	// Optional. The checksum of the setting, changed by every update. Set it on update to have the update fail with
+	// ABORTED if the setting changed since it was read.
+	Etag          string `protobuf:"bytes,13,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting) Reset() {
+	*x = WorkspaceSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WorkspaceSetting) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting) GetValue() isWorkspaceSetting_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetGeneralSetting() *WorkspaceSetting_GeneralSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_GeneralSetting_); ok {
+			return x.GeneralSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetStorageSetting() *WorkspaceSetting_StorageSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_StorageSetting_); ok {
+			return x.StorageSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetMemoRelatedSetting() *WorkspaceSetting_MemoRelatedSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_MemoRelatedSetting_); ok {
+			return x.MemoRelatedSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetAiSetting() *WorkspaceSetting_AISetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_AiSetting); ok {
+			return x.AiSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetOnboardingSetting() *WorkspaceSetting_OnboardingSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_OnboardingSetting_); ok {
+			return x.OnboardingSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetNewUserLimitSetting() *WorkspaceSetting_NewUserLimitSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_NewUserLimitSetting_); ok {
+			return x.NewUserLimitSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetFeatureFlagSetting() *WorkspaceSetting_FeatureFlagSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_FeatureFlagSetting_); ok {
+			return x.FeatureFlagSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetUsageLimitSetting() *WorkspaceSetting_UsageLimitSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_UsageLimitSetting_); ok {
+			return x.UsageLimitSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetSensitiveContentSetting() *WorkspaceSetting_SensitiveContentSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_SensitiveContentSetting_); ok {
+			return x.SensitiveContentSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetOutboundFetchSetting() *WorkspaceSetting_OutboundFetchSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_OutboundFetchSetting_); ok {
+			return x.OutboundFetchSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetLegalSetting() *WorkspaceSetting_LegalSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_LegalSetting_); ok {
+			return x.LegalSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetAlertingSetting() *WorkspaceSetting_AlertingSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_AlertingSetting_); ok {
+			return x.AlertingSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type isWorkspaceSetting_Value interface {
+	isWorkspaceSetting_Value()
+}
+
+type WorkspaceSetting_GeneralSetting_ struct {
+	GeneralSetting *WorkspaceSetting_GeneralSetting `protobuf:"bytes,2,opt,name=general_setting,json=generalSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_StorageSetting_ struct {
+	StorageSetting *WorkspaceSetting_StorageSetting `protobuf:"bytes,3,opt,name=storage_setting,json=storageSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_MemoRelatedSetting_ struct {
+	MemoRelatedSetting *WorkspaceSetting_MemoRelatedSetting `protobuf:"bytes,4,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_AiSetting struct {
+	AiSetting *WorkspaceSetting_AISetting `protobuf:"bytes,5,opt,name=ai_setting,json=aiSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_OnboardingSetting_ struct {
+	OnboardingSetting *WorkspaceSetting_OnboardingSetting `protobuf:"bytes,6,opt,name=onboarding_setting,json=onboardingSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_NewUserLimitSetting_ struct {
+	NewUserLimitSetting *WorkspaceSetting_NewUserLimitSetting `protobuf:"bytes,7,opt,name=new_user_limit_setting,json=newUserLimitSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_FeatureFlagSetting_ struct {
+	FeatureFlagSetting *WorkspaceSetting_FeatureFlagSetting `protobuf:"bytes,8,opt,name=feature_flag_setting,json=featureFlagSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_UsageLimitSetting_ struct {
+	UsageLimitSetting *WorkspaceSetting_UsageLimitSetting `protobuf:"bytes,9,opt,name=usage_limit_setting,json=usageLimitSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_SensitiveContentSetting_ struct {
+	SensitiveContentSetting *WorkspaceSetting_SensitiveContentSetting `protobuf:"bytes,10,opt,name=sensitive_content_setting,json=sensitiveContentSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_OutboundFetchSetting_ struct {
+	OutboundFetchSetting *WorkspaceSetting_OutboundFetchSetting `protobuf:"bytes,11,opt,name=outbound_fetch_setting,json=outboundFetchSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_LegalSetting_ struct {
+	LegalSetting *WorkspaceSetting_LegalSetting `protobuf:"bytes,12,opt,name=legal_setting,json=legalSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_AlertingSetting_ struct {
+	AlertingSetting *WorkspaceSetting_AlertingSetting `protobuf:"bytes,14,opt,name=alerting_setting,json=alertingSetting,proto3,oneof"`
 }
 
 func (*WorkspaceSetting_GeneralSetting_) isWorkspaceSetting_Value() {}
 
-func (*WorkspaceSetting_StorageSetting_) isWorkspaceSetting_Value() {}
+func (*WorkspaceSetting_StorageSetting_) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_MemoRelatedSetting_) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_AiSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_OnboardingSetting_) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_NewUserLimitSetting_) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_FeatureFlagSetting_) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_UsageLimitSetting_) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_SensitiveContentSetting_) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_OutboundFetchSetting_) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_LegalSetting_) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_AlertingSetting_) isWorkspaceSetting_Value() {}
+
+// Request message for GetWorkspaceSetting method.
+type GetWorkspaceSettingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the workspace setting.
+	// Format: workspace/settings/{setting}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceSettingRequest) Reset() {
+	*x = GetWorkspaceSettingRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceSettingRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetWorkspaceSettingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Request message for UpdateWorkspaceSetting method.
+type UpdateWorkspaceSettingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The workspace setting resource which replaces the resource on the server.
+	Setting *WorkspaceSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	// The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkspaceSettingRequest) Reset() {
+	*x = UpdateWorkspaceSettingRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkspaceSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceSettingRequest) ProtoMessage() {}
+
+func (x *UpdateWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateWorkspaceSettingRequest) GetSetting() *WorkspaceSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+func (x *UpdateWorkspaceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Request message for DowngradePublicMemos method.
+type DowngradePublicMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The visibility to downgrade public memos to, either "PROTECTED" or "PRIVATE".
+	// Defaults to "PROTECTED".
+	Visibility string `protobuf:"bytes,1,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// The public memos to downgrade, e.g. the memos reported by AuditMemoVisibility. All the public memos are
+	// downgraded when empty.
+	// Format: memos/{memo}
+	Memos         []string `protobuf:"bytes,2,rep,name=memos,proto3" json:"memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DowngradePublicMemosRequest) Reset() {
+	*x = DowngradePublicMemosRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DowngradePublicMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DowngradePublicMemosRequest) ProtoMessage() {}
+
+func (x *DowngradePublicMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DowngradePublicMemosRequest.ProtoReflect.Descriptor instead.
+func (*DowngradePublicMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DowngradePublicMemosRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *DowngradePublicMemosRequest) GetMemos() []string {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+// Response message for DowngradePublicMemos method.
+type DowngradePublicMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos that were downgraded.
+	DowngradedCount int32 `protobuf:"varint,1,opt,name=downgraded_count,json=downgradedCount,proto3" json:"downgraded_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DowngradePublicMemosResponse) Reset() {
+	*x = DowngradePublicMemosResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DowngradePublicMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DowngradePublicMemosResponse) ProtoMessage() {}
+
+func (x *DowngradePublicMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DowngradePublicMemosResponse.ProtoReflect.Descriptor instead.
+func (*DowngradePublicMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DowngradePublicMemosResponse) GetDowngradedCount() int32 {
+	if x != nil {
+		return x.DowngradedCount
+	}
+	return 0
+}
+
+// The workspace settings and identity providers of an instance, the document of ExportWorkspaceSettings.
+type WorkspaceSettingsDocument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The version of the document format, 1.
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The workspace settings: general, storage, memo related, AI, onboarding, new user limit, feature flags, usage
+	// limit, sensitive content, outbound fetch and legal.
+	Settings []*WorkspaceSetting `protobuf:"bytes,2,rep,name=settings,proto3" json:"settings,omitempty"`
+	// The identity providers for SSO, matched by title when applied. Their names are ignored.
+	IdentityProviders []*IdentityProvider `protobuf:"bytes,3,rep,name=identity_providers,json=identityProviders,proto3" json:"identity_providers,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WorkspaceSettingsDocument) Reset() {
+	*x = WorkspaceSettingsDocument{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSettingsDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSettingsDocument) ProtoMessage() {}
+
+func (x *WorkspaceSettingsDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSettingsDocument.ProtoReflect.Descriptor instead.
+func (*WorkspaceSettingsDocument) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WorkspaceSettingsDocument) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *WorkspaceSettingsDocument) GetSettings() []*WorkspaceSetting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *WorkspaceSettingsDocument) GetIdentityProviders() []*IdentityProvider {
+	if x != nil {
+		return x.IdentityProviders
+	}
+	return nil
+}
+
+// Request message for ExportWorkspaceSettings method.
+type ExportWorkspaceSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The format of the document, JSON by default.
+	Format        WorkspaceSettingsDocumentFormat `protobuf:"varint,1,opt,name=format,proto3,enum=memos.api.v1.WorkspaceSettingsDocumentFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportWorkspaceSettingsRequest) Reset() {
+	*x = ExportWorkspaceSettingsRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportWorkspaceSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWorkspaceSettingsRequest) ProtoMessage() {}
+
+func (x *ExportWorkspaceSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorkspaceSettingsRequest.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExportWorkspaceSettingsRequest) GetFormat() WorkspaceSettingsDocumentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return WorkspaceSettingsDocumentFormat_WORKSPACE_SETTINGS_DOCUMENT_FORMAT_UNSPECIFIED
+}
+
+// Response message for ExportWorkspaceSettings method.
+type ExportWorkspaceSettingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The WorkspaceSettingsDocument in the requested format.
+	Document      string `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportWorkspaceSettingsResponse) Reset() {
+	*x = ExportWorkspaceSettingsResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportWorkspaceSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWorkspaceSettingsResponse) ProtoMessage() {}
+
+func (x *ExportWorkspaceSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorkspaceSettingsResponse.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ExportWorkspaceSettingsResponse) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+// Request message for ApplyWorkspaceSettings method.
+type ApplyWorkspaceSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The WorkspaceSettingsDocument, in JSON or YAML.
+	Document string `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	// Optional. If set, the changes are reported without being applied.
+	ValidateOnly  bool `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyWorkspaceSettingsRequest) Reset() {
+	*x = ApplyWorkspaceSettingsRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyWorkspaceSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyWorkspaceSettingsRequest) ProtoMessage() {}
+
+func (x *ApplyWorkspaceSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyWorkspaceSettingsRequest.ProtoReflect.Descriptor instead.
+func (*ApplyWorkspaceSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ApplyWorkspaceSettingsRequest) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+func (x *ApplyWorkspaceSettingsRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+// Response message for ApplyWorkspaceSettings method.
+type ApplyWorkspaceSettingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The names of the settings updated, e.g. "workspace/settings/GENERAL".
+	UpdatedSettings []string `protobuf:"bytes,1,rep,name=updated_settings,json=updatedSettings,proto3" json:"updated_settings,omitempty"`
+	// The titles of the identity providers created.
+	CreatedIdentityProviders []string `protobuf:"bytes,2,rep,name=created_identity_providers,json=createdIdentityProviders,proto3" json:"created_identity_providers,omitempty"`
+	// The titles of the identity providers updated.
+	UpdatedIdentityProviders []string `protobuf:"bytes,3,rep,name=updated_identity_providers,json=updatedIdentityProviders,proto3" json:"updated_identity_providers,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ApplyWorkspaceSettingsResponse) Reset() {
+	*x = ApplyWorkspaceSettingsResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyWorkspaceSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyWorkspaceSettingsResponse) ProtoMessage() {}
+
+func (x *ApplyWorkspaceSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyWorkspaceSettingsResponse.ProtoReflect.Descriptor instead.
+func (*ApplyWorkspaceSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApplyWorkspaceSettingsResponse) GetUpdatedSettings() []string {
+	if x != nil {
+		return x.UpdatedSettings
+	}
+	return nil
+}
+
+func (x *ApplyWorkspaceSettingsResponse) GetCreatedIdentityProviders() []string {
+	if x != nil {
+		return x.CreatedIdentityProviders
+	}
+	return nil
+}
+
+func (x *ApplyWorkspaceSettingsResponse) GetUpdatedIdentityProviders() []string {
+	if x != nil {
+		return x.UpdatedIdentityProviders
+	}
+	return nil
+}
+
+// Request message for AuditMemoVisibility method.
+type AuditMemoVisibilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditMemoVisibilityRequest) Reset() {
+	*x = AuditMemoVisibilityRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditMemoVisibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditMemoVisibilityRequest) ProtoMessage() {}
+
+func (x *AuditMemoVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditMemoVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*AuditMemoVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{14}
+}
+
+// Response message for AuditMemoVisibility method.
+type AuditMemoVisibilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The public memos whose visibility may be unintended, newest first, at most 1000.
+	Findings []*AuditMemoVisibilityResponse_Finding `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	// The number of public memos audited.
+	AuditedCount  int32 `protobuf:"varint,2,opt,name=audited_count,json=auditedCount,proto3" json:"audited_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditMemoVisibilityResponse) Reset() {
+	*x = AuditMemoVisibilityResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditMemoVisibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditMemoVisibilityResponse) ProtoMessage() {}
+
+func (x *AuditMemoVisibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditMemoVisibilityResponse.ProtoReflect.Descriptor instead.
+func (*AuditMemoVisibilityResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AuditMemoVisibilityResponse) GetFindings() []*AuditMemoVisibilityResponse_Finding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *AuditMemoVisibilityResponse) GetAuditedCount() int32 {
+	if x != nil {
+		return x.AuditedCount
+	}
+	return 0
+}
+
+// Request message for BackupDatabase method.
+type BackupDatabaseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. How the backup is encrypted, not encrypted when unspecified.
+	// A ZIP_AES backup is a ZIP archive of the database, an AGE backup is the database encrypted in the age format.
+	Encryption ArchiveEncryption `protobuf:"varint,1,opt,name=encryption,proto3,enum=memos.api.v1.ArchiveEncryption" json:"encryption,omitempty"`
+	// The passphrase the backup is encrypted with, required with an encryption. It is not stored.
+	Passphrase    string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupDatabaseRequest) Reset() {
+	*x = BackupDatabaseRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupDatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupDatabaseRequest) ProtoMessage() {}
+
+func (x *BackupDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BackupDatabaseRequest) GetEncryption() ArchiveEncryption {
+	if x != nil {
+		return x.Encryption
+	}
+	return ArchiveEncryption_ARCHIVE_ENCRYPTION_UNSPECIFIED
+}
+
+func (x *BackupDatabaseRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+// Response message for BackupDatabase method.
+type BackupDatabaseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The location of the backup: a path relative to the data directory for
+	// local storage, or the object key for S3 storage.
+	Location string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// The size of the backup in bytes.
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// The time the backup was created.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupDatabaseResponse) Reset() {
+	*x = BackupDatabaseResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupDatabaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupDatabaseResponse) ProtoMessage() {}
+
+func (x *BackupDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BackupDatabaseResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *BackupDatabaseResponse) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *BackupDatabaseResponse) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// MemoPayloadRebuildJob tracks a rebuild of the payloads of all memos.
+type MemoPayloadRebuildJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the rebuild job.
+	// Format: workspace/memoPayloadRebuildJob
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The state of the rebuild job.
+	State MemoPayloadRebuildJob_State `protobuf:"varint,2,opt,name=state,proto3,enum=memos.api.v1.MemoPayloadRebuildJob_State" json:"state,omitempty"`
+	// The number of memos processed so far.
+	ProcessedMemoCount int32 `protobuf:"varint,3,opt,name=processed_memo_count,json=processedMemoCount,proto3" json:"processed_memo_count,omitempty"`
+	// The number of memos whose payload changed.
+	UpdatedMemoCount int32 `protobuf:"varint,4,opt,name=updated_memo_count,json=updatedMemoCount,proto3" json:"updated_memo_count,omitempty"`
+	// The number of memos whose payload could not be rebuilt.
+	FailedMemoCount int32 `protobuf:"varint,5,opt,name=failed_memo_count,json=failedMemoCount,proto3" json:"failed_memo_count,omitempty"`
+	// The error message if the rebuild job failed.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// The time when the rebuild job was started.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time when the rebuild job finished.
+	FinishTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayloadRebuildJob) Reset() {
+	*x = MemoPayloadRebuildJob{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayloadRebuildJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayloadRebuildJob) ProtoMessage() {}
+
+func (x *MemoPayloadRebuildJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayloadRebuildJob.ProtoReflect.Descriptor instead.
+func (*MemoPayloadRebuildJob) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MemoPayloadRebuildJob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoPayloadRebuildJob) GetState() MemoPayloadRebuildJob_State {
+	if x != nil {
+		return x.State
+	}
+	return MemoPayloadRebuildJob_STATE_UNSPECIFIED
+}
+
+func (x *MemoPayloadRebuildJob) GetProcessedMemoCount() int32 {
+	if x != nil {
+		return x.ProcessedMemoCount
+	}
+	return 0
+}
+
+func (x *MemoPayloadRebuildJob) GetUpdatedMemoCount() int32 {
+	if x != nil {
+		return x.UpdatedMemoCount
+	}
+	return 0
+}
+
+func (x *MemoPayloadRebuildJob) GetFailedMemoCount() int32 {
+	if x != nil {
+		return x.FailedMemoCount
+	}
+	return 0
+}
+
+func (x *MemoPayloadRebuildJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MemoPayloadRebuildJob) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *MemoPayloadRebuildJob) GetFinishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishTime
+	}
+	return nil
+}
+
+// Request message for CreateMemoPayloadRebuildJob method.
+type CreateMemoPayloadRebuildJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoPayloadRebuildJobRequest) Reset() {
+	*x = CreateMemoPayloadRebuildJobRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoPayloadRebuildJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoPayloadRebuildJobRequest) ProtoMessage() {}
+
+func (x *CreateMemoPayloadRebuildJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoPayloadRebuildJobRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoPayloadRebuildJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{19}
+}
+
+// Request message for GetMemoPayloadRebuildJob method.
+type GetMemoPayloadRebuildJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoPayloadRebuildJobRequest) Reset() {
+	*x = GetMemoPayloadRebuildJobRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoPayloadRebuildJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoPayloadRebuildJobRequest) ProtoMessage() {}
+
+func (x *GetMemoPayloadRebuildJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoPayloadRebuildJobRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoPayloadRebuildJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{20}
+}
+
+type ListFeatureFlagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The user to evaluate the flags for, only admins can evaluate them for other users.
+	// Defaults to the current user. Anonymous requests only see flags enabled for every user.
+	// Format: users/{user}
+	User          string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeatureFlagsRequest) Reset() {
+	*x = ListFeatureFlagsRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeatureFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeatureFlagsRequest) ProtoMessage() {}
+
+func (x *ListFeatureFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeatureFlagsRequest.ProtoReflect.Descriptor instead.
+func (*ListFeatureFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListFeatureFlagsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type ListFeatureFlagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The state of every defined feature flag, keyed by flag name.
+	Flags         map[string]bool `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeatureFlagsResponse) Reset() {
+	*x = ListFeatureFlagsResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeatureFlagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeatureFlagsResponse) ProtoMessage() {}
+
+func (x *ListFeatureFlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeatureFlagsResponse.ProtoReflect.Descriptor instead.
+func (*ListFeatureFlagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListFeatureFlagsResponse) GetFlags() map[string]bool {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+// A background runner, such as the link checker or the cold storage mover.
+type Runner struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the runner.
+	// Format: workspace/runners/{runner}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// What the runner does.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Whether the runner runs on schedule.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The cron expression of the runner, e.g. "0 3 * * *" or "@every 12h".
+	// Set it to an empty string to restore the default schedule.
+	Schedule string `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// Whether the runner is running.
+	Running bool `protobuf:"varint,5,opt,name=running,proto3" json:"running,omitempty"`
+	// The state of the last run.
+	LastRunState Runner_RunState `protobuf:"varint,6,opt,name=last_run_state,json=lastRunState,proto3,enum=memos.api.v1.Runner_RunState" json:"last_run_state,omitempty"`
+	// The error message of the last run if it failed.
+	LastError string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The time when the last run started.
+	LastRunTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	// The time when the last run finished.
+	LastFinishTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_finish_time,json=lastFinishTime,proto3" json:"last_finish_time,omitempty"`
+	// The time of the next scheduled run, unset when the runner is disabled.
+	NextRunTime   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Runner) Reset() {
+	*x = Runner{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Runner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Runner) ProtoMessage() {}
+
+func (x *Runner) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Runner.ProtoReflect.Descriptor instead.
+func (*Runner) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Runner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Runner) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Runner) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Runner) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *Runner) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *Runner) GetLastRunState() Runner_RunState {
+	if x != nil {
+		return x.LastRunState
+	}
+	return Runner_RUN_STATE_UNSPECIFIED
+}
+
+func (x *Runner) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Runner) GetLastRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunTime
+	}
+	return nil
+}
+
+func (x *Runner) GetLastFinishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFinishTime
+	}
+	return nil
+}
+
+func (x *Runner) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+type GetWorkspaceUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceUsageRequest) Reset() {
+	*x = GetWorkspaceUsageRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceUsageRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceUsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{24}
+}
+
+// The usage of the workspace against its usage limits.
+type WorkspaceUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of users.
+	UserCount int32 `protobuf:"varint,1,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	// The number of memos, comments and archived memos included.
+	MemoCount int32 `protobuf:"varint,2,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The total size of the attachments in bytes.
+	StorageBytes int64 `protobuf:"varint,3,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	// The number of AI tokens used in the current calendar month.
+	AiMonthlyTokens int64 `protobuf:"varint,4,opt,name=ai_monthly_tokens,json=aiMonthlyTokens,proto3" json:"ai_monthly_tokens,omitempty"`
+	// The usage limits set by the host.
+	Limits        *WorkspaceSetting_UsageLimitSetting `protobuf:"bytes,5,opt,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceUsage) Reset() {
+	*x = WorkspaceUsage{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceUsage) ProtoMessage() {}
+
+func (x *WorkspaceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceUsage.ProtoReflect.Descriptor instead.
+func (*WorkspaceUsage) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WorkspaceUsage) GetUserCount() int32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *WorkspaceUsage) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *WorkspaceUsage) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *WorkspaceUsage) GetAiMonthlyTokens() int64 {
+	if x != nil {
+		return x.AiMonthlyTokens
+	}
+	return 0
+}
+
+func (x *WorkspaceUsage) GetLimits() *WorkspaceSetting_UsageLimitSetting {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type SendTestAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestAlertRequest) Reset() {
+	*x = SendTestAlertRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestAlertRequest) ProtoMessage() {}
+
+func (x *SendTestAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestAlertRequest.ProtoReflect.Descriptor instead.
+func (*SendTestAlertRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{26}
+}
+
+type SendTestAlertResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The errors of the channels, in the order of the alerting setting, empty for the channels the alert was sent on.
+	Errors        []string `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestAlertResponse) Reset() {
+	*x = SendTestAlertResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestAlertResponse) ProtoMessage() {}
+
+func (x *SendTestAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestAlertResponse.ProtoReflect.Descriptor instead.
+func (*SendTestAlertResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SendTestAlertResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type SeedTestFixtureRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of regular users to create, 1 if 0. The existing fixture users are reused.
+	UserCount int32 `protobuf:"varint,1,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	// The number of memos to create for each user, one per day back from today, 10 if 0.
+	MemoCount int32 `protobuf:"varint,2,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The password of the users, for the clients to sign in with. The users have no password if empty.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// Whether to set the AI setting to the FAKE provider.
+	ConfigureFakeAi bool `protobuf:"varint,4,opt,name=configure_fake_ai,json=configureFakeAi,proto3" json:"configure_fake_ai,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SeedTestFixtureRequest) Reset() {
+	*x = SeedTestFixtureRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeedTestFixtureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeedTestFixtureRequest) ProtoMessage() {}
+
+func (x *SeedTestFixtureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeedTestFixtureRequest.ProtoReflect.Descriptor instead.
+func (*SeedTestFixtureRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SeedTestFixtureRequest) GetUserCount() int32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *SeedTestFixtureRequest) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *SeedTestFixtureRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SeedTestFixtureRequest) GetConfigureFakeAi() bool {
+	if x != nil {
+		return x.ConfigureFakeAi
+	}
+	return false
+}
+
+type SeedTestFixtureResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The names of the fixture users.
+	// Format: users/{user}
+	Users []string `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// The names of the created memos.
+	// Format: memos/{memo}
+	Memos         []string `protobuf:"bytes,2,rep,name=memos,proto3" json:"memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeedTestFixtureResponse) Reset() {
+	*x = SeedTestFixtureResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeedTestFixtureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeedTestFixtureResponse) ProtoMessage() {}
+
+func (x *SeedTestFixtureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeedTestFixtureResponse.ProtoReflect.Descriptor instead.
+func (*SeedTestFixtureResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SeedTestFixtureResponse) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SeedTestFixtureResponse) GetMemos() []string {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+type GetRuntimeStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to include the stacks of the goroutines, grouped by stack.
+	IncludeGoroutineStacks bool `protobuf:"varint,1,opt,name=include_goroutine_stacks,json=includeGoroutineStacks,proto3" json:"include_goroutine_stacks,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetRuntimeStatsRequest) Reset() {
+	*x = GetRuntimeStatsRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuntimeStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuntimeStatsRequest) ProtoMessage() {}
+
+func (x *GetRuntimeStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuntimeStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetRuntimeStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetRuntimeStatsRequest) GetIncludeGoroutineStacks() bool {
+	if x != nil {
+		return x.IncludeGoroutineStacks
+	}
+	return false
+}
+
+// A snapshot of the goroutines and memory of the server.
+type RuntimeStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The version of Go the server is built with.
+	GoVersion string `protobuf:"bytes,1,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	// The number of logical CPUs usable by the server.
+	CpuCount int32 `protobuf:"varint,2,opt,name=cpu_count,json=cpuCount,proto3" json:"cpu_count,omitempty"`
+	// The number of goroutines.
+	GoroutineCount int32 `protobuf:"varint,3,opt,name=goroutine_count,json=goroutineCount,proto3" json:"goroutine_count,omitempty"`
+	// The goroutine stacks, in the debug format of pprof, if requested.
+	GoroutineStacks string `protobuf:"bytes,4,opt,name=goroutine_stacks,json=goroutineStacks,proto3" json:"goroutine_stacks,omitempty"`
+	// The memory statistics of the Go runtime.
+	Memory        *RuntimeStats_Memory `protobuf:"bytes,5,opt,name=memory,proto3" json:"memory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuntimeStats) Reset() {
+	*x = RuntimeStats{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuntimeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeStats) ProtoMessage() {}
+
+func (x *RuntimeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeStats.ProtoReflect.Descriptor instead.
+func (*RuntimeStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RuntimeStats) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *RuntimeStats) GetCpuCount() int32 {
+	if x != nil {
+		return x.CpuCount
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetGoroutineCount() int32 {
+	if x != nil {
+		return x.GoroutineCount
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetGoroutineStacks() string {
+	if x != nil {
+		return x.GoroutineStacks
+	}
+	return ""
+}
+
+func (x *RuntimeStats) GetMemory() *RuntimeStats_Memory {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+type ListRunnersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunnersRequest) Reset() {
+	*x = ListRunnersRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunnersRequest) ProtoMessage() {}
+
+func (x *ListRunnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunnersRequest.ProtoReflect.Descriptor instead.
+func (*ListRunnersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{32}
+}
+
+type ListRunnersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The background runners, sorted by name.
+	Runners       []*Runner `protobuf:"bytes,1,rep,name=runners,proto3" json:"runners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunnersResponse) Reset() {
+	*x = ListRunnersResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunnersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunnersResponse) ProtoMessage() {}
+
+func (x *ListRunnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunnersResponse.ProtoReflect.Descriptor instead.
+func (*ListRunnersResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListRunnersResponse) GetRunners() []*Runner {
+	if x != nil {
+		return x.Runners
+	}
+	return nil
+}
+
+type UpdateRunnerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The runner to update.
+	Runner *Runner `protobuf:"bytes,1,opt,name=runner,proto3" json:"runner,omitempty"`
+	// Required. The list of fields to update, "enabled" and "schedule" are supported.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRunnerRequest) Reset() {
+	*x = UpdateRunnerRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRunnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRunnerRequest) ProtoMessage() {}
+
+func (x *UpdateRunnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRunnerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRunnerRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateRunnerRequest) GetRunner() *Runner {
+	if x != nil {
+		return x.Runner
+	}
+	return nil
+}
+
+func (x *UpdateRunnerRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type RunRunnerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the runner.
+	// Format: workspace/runners/{runner}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunRunnerRequest) Reset() {
+	*x = RunRunnerRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunRunnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunRunnerRequest) ProtoMessage() {}
+
+func (x *RunRunnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunRunnerRequest.ProtoReflect.Descriptor instead.
+func (*RunRunnerRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RunRunnerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeadLetter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the dead letter.
+	// Format: workspace/deadLetters/{dead_letter}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The type of the failed job.
+	JobType DeadLetter_JobType `protobuf:"varint,2,opt,name=job_type,json=jobType,proto3,enum=memos.api.v1.DeadLetter_JobType" json:"job_type,omitempty"`
+	// The user the job ran for.
+	// Format: users/{user}
+	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// What the job targeted: the webhook URL, the summary time range or the import source URL.
+	Target string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	// The error of the last attempt.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// The number of failed attempts.
+	Attempts int32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// The time of the first failure.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time of the last failure.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeadLetter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetJobType() DeadLetter_JobType {
+	if x != nil {
+		return x.JobType
+	}
+	return DeadLetter_JOB_TYPE_UNSPECIFIED
+}
+
+func (x *DeadLetter) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type ListDeadLettersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of dead letters to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous call.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Only list the dead letters of the given job type.
+	JobType       DeadLetter_JobType `protobuf:"varint,3,opt,name=job_type,json=jobType,proto3,enum=memos.api.v1.DeadLetter_JobType" json:"job_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListDeadLettersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDeadLettersRequest) GetJobType() DeadLetter_JobType {
+	if x != nil {
+		return x.JobType
+	}
+	return DeadLetter_JOB_TYPE_UNSPECIFIED
+}
+
+type ListDeadLettersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The dead letters, most recent failures first.
+	DeadLetters []*DeadLetter `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	// A token to retrieve the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+func (x *ListDeadLettersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RetryDeadLetterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the dead letter.
+	// Format: workspace/deadLetters/{dead_letter}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryDeadLetterRequest) Reset() {
+	*x = RetryDeadLetterRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryDeadLetterRequest) ProtoMessage() {}
+
+func (x *RetryDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*RetryDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RetryDeadLetterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteDeadLetterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the dead letter.
+	// Format: workspace/deadLetters/{dead_letter}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDeadLetterRequest) Reset() {
+	*x = DeleteDeadLetterRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeadLetterRequest) ProtoMessage() {}
+
+func (x *DeleteDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteDeadLetterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RotateAccessTokenSigningKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. How long the tokens signed with the previous keys are still accepted, 7 days when unset. Zero stops
+	// accepting them right away, e.g. when a key leaked.
+	GracePeriod   *durationpb.Duration `protobuf:"bytes,1,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateAccessTokenSigningKeyRequest) Reset() {
+	*x = RotateAccessTokenSigningKeyRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAccessTokenSigningKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAccessTokenSigningKeyRequest) ProtoMessage() {}
+
+func (x *RotateAccessTokenSigningKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAccessTokenSigningKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateAccessTokenSigningKeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RotateAccessTokenSigningKeyRequest) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+type RotateAccessTokenSigningKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The keys accepted to sign the access tokens, the current one last.
+	Keys          []*AccessTokenSigningKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateAccessTokenSigningKeyResponse) Reset() {
+	*x = RotateAccessTokenSigningKeyResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAccessTokenSigningKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAccessTokenSigningKeyResponse) ProtoMessage() {}
+
+func (x *RotateAccessTokenSigningKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAccessTokenSigningKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateAccessTokenSigningKeyResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RotateAccessTokenSigningKeyResponse) GetKeys() []*AccessTokenSigningKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// A key signing the access tokens. Its secret is never returned.
+type AccessTokenSigningKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The key ID in the header of the tokens signed with the key.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The time the key was created, unset for the "v1" key of the server.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time the tokens signed with the key stop being accepted, unset while the key does not expire.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Whether the key signs the new tokens.
+	Current       bool `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessTokenSigningKey) Reset() {
+	*x = AccessTokenSigningKey{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessTokenSigningKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessTokenSigningKey) ProtoMessage() {}
+
+func (x *AccessTokenSigningKey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessTokenSigningKey.ProtoReflect.Descriptor instead.
+func (*AccessTokenSigningKey) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AccessTokenSigningKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccessTokenSigningKey) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *AccessTokenSigningKey) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *AccessTokenSigningKey) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type Announcement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the announcement.
+	// Format: workspace/announcements/{announcement}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The message in Markdown.
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// The severity of the announcement, INFO when unspecified.
+	Severity Announcement_Severity `protobuf:"varint,3,opt,name=severity,proto3,enum=memos.api.v1.Announcement_Severity" json:"severity,omitempty"`
+	// Optional. The time the announcement is shown from, the creation when unset.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Optional. The time the announcement is shown until, no end when unset.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Whether the users can dismiss the announcement.
+	Dismissible bool `protobuf:"varint,6,opt,name=dismissible,proto3" json:"dismissible,omitempty"`
+	// The admin who created the announcement.
+	// Format: users/{user}
+	Creator       string                 `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Announcement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Announcement) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Announcement) GetSeverity() Announcement_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Announcement_SEVERITY_UNSPECIFIED
+}
+
+func (x *Announcement) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Announcement) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Announcement) GetDismissible() bool {
+	if x != nil {
+		return x.Dismissible
+	}
+	return false
+}
+
+func (x *Announcement) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Announcement) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Announcement) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type ListAnnouncementsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Whether to list all the announcements, including the scheduled, ended and dismissed ones.
+	// Only for admins.
+	ShowAll       bool `protobuf:"varint,1,opt,name=show_all,json=showAll,proto3" json:"show_all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnouncementsRequest) Reset() {
+	*x = ListAnnouncementsRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsRequest) ProtoMessage() {}
+
+func (x *ListAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListAnnouncementsRequest) GetShowAll() bool {
+	if x != nil {
+		return x.ShowAll
+	}
+	return false
+}
+
+type ListAnnouncementsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The announcements, the ones starting last first.
+	Announcements []*Announcement `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnouncementsResponse) Reset() {
+	*x = ListAnnouncementsResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsResponse) ProtoMessage() {}
+
+func (x *ListAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListAnnouncementsResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+type CreateAnnouncementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The announcement to create.
+	Announcement  *Announcement `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAnnouncementRequest) Reset() {
+	*x = CreateAnnouncementRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnnouncementRequest) ProtoMessage() {}
+
+func (x *CreateAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*CreateAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateAnnouncementRequest) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+type UpdateAnnouncementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The announcement to update.
+	Announcement *Announcement `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	// Required. The fields to update: content, severity, start_time, end_time or dismissible.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAnnouncementRequest) Reset() {
+	*x = UpdateAnnouncementRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAnnouncementRequest) ProtoMessage() {}
+
+func (x *UpdateAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateAnnouncementRequest) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *UpdateAnnouncementRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteAnnouncementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the announcement.
+	// Format: workspace/announcements/{announcement}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAnnouncementRequest) Reset() {
+	*x = DeleteAnnouncementRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnouncementRequest) ProtoMessage() {}
+
+func (x *DeleteAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteAnnouncementRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DismissAnnouncementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the announcement.
+	// Format: workspace/announcements/{announcement}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissAnnouncementRequest) Reset() {
+	*x = DismissAnnouncementRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissAnnouncementRequest) ProtoMessage() {}
+
+func (x *DismissAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*DismissAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DismissAnnouncementRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MaintenanceWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the maintenance window.
+	// Format: workspace/maintenanceWindows/{maintenance_window}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The time the maintenance starts.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Required. The time the maintenance ends.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Optional. The message of the announcement published at the start of the maintenance.
+	Message string                  `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	State   MaintenanceWindow_State `protobuf:"varint,5,opt,name=state,proto3,enum=memos.api.v1.MaintenanceWindow_State" json:"state,omitempty"`
+	// The announcement published at the start of the maintenance.
+	// Format: workspace/announcements/{announcement}
+	Announcement string `protobuf:"bytes,6,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	// The admin who scheduled the maintenance window.
+	// Format: users/{user}
+	Creator       string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MaintenanceWindow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetState() MaintenanceWindow_State {
+	if x != nil {
+		return x.State
+	}
+	return MaintenanceWindow_STATE_UNSPECIFIED
+}
+
+func (x *MaintenanceWindow) GetAnnouncement() string {
+	if x != nil {
+		return x.Announcement
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+type ListMaintenanceWindowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaintenanceWindowsRequest) Reset() {
+	*x = ListMaintenanceWindowsRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaintenanceWindowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsRequest) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsRequest.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{52}
+}
+
+type ListMaintenanceWindowsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maintenance windows, sorted by start time.
+	MaintenanceWindows []*MaintenanceWindow `protobuf:"bytes,1,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListMaintenanceWindowsResponse) Reset() {
+	*x = ListMaintenanceWindowsResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaintenanceWindowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsResponse) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListMaintenanceWindowsResponse) GetMaintenanceWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindows
+	}
+	return nil
+}
+
+type CreateMaintenanceWindowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The maintenance window to schedule.
+	MaintenanceWindow *MaintenanceWindow `protobuf:"bytes,1,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateMaintenanceWindowRequest) Reset() {
+	*x = CreateMaintenanceWindowRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *CreateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*CreateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateMaintenanceWindowRequest) GetMaintenanceWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindow
+	}
+	return nil
+}
+
+type DeleteMaintenanceWindowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the maintenance window.
+	// Format: workspace/maintenanceWindows/{maintenance_window}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMaintenanceWindowRequest) Reset() {
+	*x = DeleteMaintenanceWindowRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *DeleteMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteMaintenanceWindowRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WorkspaceCapabilities_AI struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether an AI provider with a chat model is configured and the user is signed in.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The AI features the role of the user may use: "summary", "speech", "voice_memo", "chat", "tag_suggestion"
+	// and "transform".
+	Features []string `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty"`
+	// The number of AI requests the user may make per hour, 0 for unlimited.
+	HourlyRequestLimit int32 `protobuf:"varint,3,opt,name=hourly_request_limit,json=hourlyRequestLimit,proto3" json:"hourly_request_limit,omitempty"`
+	// The number of AI requests the user may make per UTC day, 0 for unlimited.
+	DailyRequestLimit int32 `protobuf:"varint,4,opt,name=daily_request_limit,json=dailyRequestLimit,proto3" json:"daily_request_limit,omitempty"`
+	// The number of AI tokens the user may use per month, 0 for unlimited.
+	MonthlyTokenBudget int64 `protobuf:"varint,5,opt,name=monthly_token_budget,json=monthlyTokenBudget,proto3" json:"monthly_token_budget,omitempty"`
+	// The disclosure of the processing of the content of the users by the AI providers, empty if none.
+	DataProcessingDisclosure string `protobuf:"bytes,6,opt,name=data_processing_disclosure,json=dataProcessingDisclosure,proto3" json:"data_processing_disclosure,omitempty"`
+	// Whether the user opted in to the AI processing of their content in the AI operations of the other users.
+	OptedIn       bool `protobuf:"varint,7,opt,name=opted_in,json=optedIn,proto3" json:"opted_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceCapabilities_AI) Reset() {
+	*x = WorkspaceCapabilities_AI{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceCapabilities_AI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceCapabilities_AI) ProtoMessage() {}
+
+func (x *WorkspaceCapabilities_AI) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceCapabilities_AI.ProtoReflect.Descriptor instead.
+func (*WorkspaceCapabilities_AI) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *WorkspaceCapabilities_AI) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceCapabilities_AI) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *WorkspaceCapabilities_AI) GetHourlyRequestLimit() int32 {
+	if x != nil {
+		return x.HourlyRequestLimit
+	}
+	return 0
+}
+
+func (x *WorkspaceCapabilities_AI) GetDailyRequestLimit() int32 {
+	if x != nil {
+		return x.DailyRequestLimit
+	}
+	return 0
+}
+
+func (x *WorkspaceCapabilities_AI) GetMonthlyTokenBudget() int64 {
+	if x != nil {
+		return x.MonthlyTokenBudget
+	}
+	return 0
+}
+
+func (x *WorkspaceCapabilities_AI) GetDataProcessingDisclosure() string {
+	if x != nil {
+		return x.DataProcessingDisclosure
+	}
+	return ""
+}
+
+func (x *WorkspaceCapabilities_AI) GetOptedIn() bool {
+	if x != nil {
+		return x.OptedIn
+	}
+	return false
+}
+
+type WorkspaceCapabilities_Federation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the workspace mirrors the public memos of other instances, listed by ListFederatedMemos.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Whether the user may manage the syndication subscriptions.
+	CanSubscribe  bool `protobuf:"varint,2,opt,name=can_subscribe,json=canSubscribe,proto3" json:"can_subscribe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceCapabilities_Federation) Reset() {
+	*x = WorkspaceCapabilities_Federation{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceCapabilities_Federation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceCapabilities_Federation) ProtoMessage() {}
+
+func (x *WorkspaceCapabilities_Federation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceCapabilities_Federation.ProtoReflect.Descriptor instead.
+func (*WorkspaceCapabilities_Federation) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *WorkspaceCapabilities_Federation) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceCapabilities_Federation) GetCanSubscribe() bool {
+	if x != nil {
+		return x.CanSubscribe
+	}
+	return false
+}
+
+type WorkspaceCapabilities_Limits struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of characters of the content of a memo.
+	MemoContentLength int32 `protobuf:"varint,1,opt,name=memo_content_length,json=memoContentLength,proto3" json:"memo_content_length,omitempty"`
+	// The maximum size in bytes of an uploaded attachment.
+	UploadSizeBytes int64 `protobuf:"varint,2,opt,name=upload_size_bytes,json=uploadSizeBytes,proto3" json:"upload_size_bytes,omitempty"`
+	// The maximum size in bytes of an API request, 0 for unlimited.
+	RequestSizeBytes int64 `protobuf:"varint,3,opt,name=request_size_bytes,json=requestSizeBytes,proto3" json:"request_size_bytes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WorkspaceCapabilities_Limits) Reset() {
+	*x = WorkspaceCapabilities_Limits{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceCapabilities_Limits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceCapabilities_Limits) ProtoMessage() {}
+
+func (x *WorkspaceCapabilities_Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceCapabilities_Limits.ProtoReflect.Descriptor instead.
+func (*WorkspaceCapabilities_Limits) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *WorkspaceCapabilities_Limits) GetMemoContentLength() int32 {
+	if x != nil {
+		return x.MemoContentLength
+	}
+	return 0
+}
+
+func (x *WorkspaceCapabilities_Limits) GetUploadSizeBytes() int64 {
+	if x != nil {
+		return x.UploadSizeBytes
+	}
+	return 0
+}
+
+func (x *WorkspaceCapabilities_Limits) GetRequestSizeBytes() int64 {
+	if x != nil {
+		return x.RequestSizeBytes
+	}
+	return 0
+}
+
+// General workspace settings configuration.
+type WorkspaceSetting_GeneralSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// theme is the name of the selected theme.
+	// This references a CSS file in the web/public/themes/ directory.
+	Theme string `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
+	// disallow_user_registration disallows user registration.
+	DisallowUserRegistration bool `protobuf:"varint,2,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
+	// disallow_password_auth disallows password authentication.
+	DisallowPasswordAuth bool `protobuf:"varint,3,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
+	// additional_script is the additional script.
+	AdditionalScript string `protobuf:"bytes,4,opt,name=additional_script,json=additionalScript,proto3" json:"additional_script,omitempty"`
+	// additional_style is the additional style.
+	AdditionalStyle string `protobuf:"bytes,5,opt,name=additional_style,json=additionalStyle,proto3" json:"additional_style,omitempty"`
+	// custom_profile is the custom profile.
+	CustomProfile *WorkspaceSetting_GeneralSetting_CustomProfile `protobuf:"bytes,6,opt,name=custom_profile,json=customProfile,proto3" json:"custom_profile,omitempty"`
+	// week_start_day_offset is the week start day offset from Sunday.
+	// 0: Sunday, 1: Monday, 2: Tuesday, 3: Wednesday, 4: Thursday, 5: Friday, 6: Saturday
+	// Default is Sunday.
+	WeekStartDayOffset int32 `protobuf:"varint,7,opt,name=week_start_day_offset,json=weekStartDayOffset,proto3" json:"week_start_day_offset,omitempty"`
+	// disallow_change_username disallows changing username.
+	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
+	// disallow_change_nickname disallows changing nickname.
+	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_GeneralSetting) Reset() {
+	*x = WorkspaceSetting_GeneralSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_GeneralSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_GeneralSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_GeneralSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_GeneralSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *WorkspaceSetting_GeneralSetting) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GeneralSetting) GetDisallowUserRegistration() bool {
+	if x != nil {
+		return x.DisallowUserRegistration
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_GeneralSetting) GetDisallowPasswordAuth() bool {
+	if x != nil {
+		return x.DisallowPasswordAuth
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_GeneralSetting) GetAdditionalScript() string {
+	if x != nil {
+		return x.AdditionalScript
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GeneralSetting) GetAdditionalStyle() string {
+	if x != nil {
+		return x.AdditionalStyle
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GeneralSetting) GetCustomProfile() *WorkspaceSetting_GeneralSetting_CustomProfile {
+	if x != nil {
+		return x.CustomProfile
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_GeneralSetting) GetWeekStartDayOffset() int32 {
+	if x != nil {
+		return x.WeekStartDayOffset
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_GeneralSetting) GetDisallowChangeUsername() bool {
+	if x != nil {
+		return x.DisallowChangeUsername
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_GeneralSetting) GetDisallowChangeNickname() bool {
+	if x != nil {
+		return x.DisallowChangeNickname
+	}
+	return false
+}
+
+// Storage configuration settings for workspace attachments.
+type WorkspaceSetting_StorageSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// storage_type is the storage type.
+	StorageType WorkspaceSetting_StorageSetting_StorageType `protobuf:"varint,1,opt,name=storage_type,json=storageType,proto3,enum=memos.api.v1.WorkspaceSetting_StorageSetting_StorageType" json:"storage_type,omitempty"`
+	// The template of file path.
+	// e.g. assets/{timestamp}_{filename}
+	FilepathTemplate string `protobuf:"bytes,2,opt,name=filepath_template,json=filepathTemplate,proto3" json:"filepath_template,omitempty"`
+	// The max upload size in megabytes.
+	UploadSizeLimitMb int64 `protobuf:"varint,3,opt,name=upload_size_limit_mb,json=uploadSizeLimitMb,proto3" json:"upload_size_limit_mb,omitempty"`
+	// The S3 config.
+	S3Config      *WorkspaceSetting_StorageSetting_S3Config `protobuf:"bytes,4,opt,name=s3_config,json=s3Config,proto3" json:"s3_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_StorageSetting) Reset() {
+	*x = WorkspaceSetting_StorageSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_StorageSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_StorageSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_StorageSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_StorageSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_StorageSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *WorkspaceSetting_StorageSetting) GetStorageType() WorkspaceSetting_StorageSetting_StorageType {
+	if x != nil {
+		return x.StorageType
+	}
+	return WorkspaceSetting_StorageSetting_STORAGE_TYPE_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_StorageSetting) GetFilepathTemplate() string {
+	if x != nil {
+		return x.FilepathTemplate
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_StorageSetting) GetUploadSizeLimitMb() int64 {
+	if x != nil {
+		return x.UploadSizeLimitMb
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_StorageSetting) GetS3Config() *WorkspaceSetting_StorageSetting_S3Config {
+	if x != nil {
+		return x.S3Config
+	}
+	return nil
+}
+
+// Memo-related workspace settings and policies.
+type WorkspaceSetting_MemoRelatedSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// disallow_public_visibility disallows set memo as public visibility.
+	DisallowPublicVisibility bool `protobuf:"varint,1,opt,name=disallow_public_visibility,json=disallowPublicVisibility,proto3" json:"disallow_public_visibility,omitempty"`
+	// display_with_update_time orders and displays memo with update time.
+	DisplayWithUpdateTime bool `protobuf:"varint,2,opt,name=display_with_update_time,json=displayWithUpdateTime,proto3" json:"display_with_update_time,omitempty"`
+	// content_length_limit is the limit of content length. Unit is byte.
+	ContentLengthLimit int32 `protobuf:"varint,3,opt,name=content_length_limit,json=contentLengthLimit,proto3" json:"content_length_limit,omitempty"`
+	// enable_double_click_edit enables editing on double click.
+	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
+	// enable_link_preview enables links preview.
+	EnableLinkPreview bool `protobuf:"varint,5,opt,name=enable_link_preview,json=enableLinkPreview,proto3" json:"enable_link_preview,omitempty"`
+	// reactions is the list of reactions.
+	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// disable_markdown_shortcuts disallow the registration of markdown shortcuts.
+	DisableMarkdownShortcuts bool `protobuf:"varint,8,opt,name=disable_markdown_shortcuts,json=disableMarkdownShortcuts,proto3" json:"disable_markdown_shortcuts,omitempty"`
+	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
+	EnableBlurNsfwContent bool `protobuf:"varint,9,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
+	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
+	NsfwTags []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	// role_default_visibilities maps a user role (HOST, ADMIN, USER) to the visibility
+	// applied to memos created without an explicit visibility, e.g. {"USER": "PRIVATE"}.
+	RoleDefaultVisibilities map[string]string `protobuf:"bytes,11,rep,name=role_default_visibilities,json=roleDefaultVisibilities,proto3" json:"role_default_visibilities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// cold_storage_after_days moves archived memos untouched for this many days into cold storage.
+	// 0 disables cold storage.
+	ColdStorageAfterDays int32 `protobuf:"varint,12,opt,name=cold_storage_after_days,json=coldStorageAfterDays,proto3" json:"cold_storage_after_days,omitempty"`
+	// disable_view_tracking stops counting the views of the memos by the visitors other than their creators.
+	DisableViewTracking bool `protobuf:"varint,13,opt,name=disable_view_tracking,json=disableViewTracking,proto3" json:"disable_view_tracking,omitempty"`
+	// protected_visibility_roles restricts the protected memos to the users of these roles (HOST, ADMIN, USER),
+	// besides their creators. Empty allows all the signed-in users.
+	ProtectedVisibilityRoles []string `protobuf:"bytes,14,rep,name=protected_visibility_roles,json=protectedVisibilityRoles,proto3" json:"protected_visibility_roles,omitempty"`
+	// enable_fuzzy_search makes the words of the memo searches also match the words spelled alike, e.g. "kuberentes"
+	// for "kubernetes", at the cost of comparing the words of every memo. It creates the pg_trgm extension on PostgreSQL,
+	// and is not supported on MySQL.
+	EnableFuzzySearch bool `protobuf:"varint,15,opt,name=enable_fuzzy_search,json=enableFuzzySearch,proto3" json:"enable_fuzzy_search,omitempty"`
+	// approval_required_tags holds back the public and protected memos of the users with these tags, or their subtags,
+	// as private until an admin approves them. The memos of the admins need no approval.
+	ApprovalRequiredTags []string `protobuf:"bytes,16,rep,name=approval_required_tags,json=approvalRequiredTags,proto3" json:"approval_required_tags,omitempty"`
+	// tag_templates binds templates and required properties to tags, at most one per tag.
+	TagTemplates []*WorkspaceSetting_MemoRelatedSetting_TagTemplate `protobuf:"bytes,17,rep,name=tag_templates,json=tagTemplates,proto3" json:"tag_templates,omitempty"`
+	// expand_shortcodes expands the shortcodes of the memos on save, e.g. ":tada:" to "🎉", with the built-in
+	// shortcodes and the workspace shortcodes. The code and the links are left as is.
+	ExpandShortcodes bool `protobuf:"varint,18,opt,name=expand_shortcodes,json=expandShortcodes,proto3" json:"expand_shortcodes,omitempty"`
+	// shortcodes maps the names of the workspace shortcodes to their text, e.g. {"ship": "🚢"} for ":ship:",
+	// overriding the built-in shortcodes of the same name. The names are made of lowercase letters, digits, "_",
+	// "+" and "-".
+	Shortcodes    map[string]string `protobuf:"bytes,19,rep,name=shortcodes,proto3" json:"shortcodes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) Reset() {
+	*x = WorkspaceSetting_MemoRelatedSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_MemoRelatedSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_MemoRelatedSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_MemoRelatedSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 2}
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetDisallowPublicVisibility() bool {
+	if x != nil {
+		return x.DisallowPublicVisibility
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetDisplayWithUpdateTime() bool {
+	if x != nil {
+		return x.DisplayWithUpdateTime
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetContentLengthLimit() int32 {
+	if x != nil {
+		return x.ContentLengthLimit
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetEnableDoubleClickEdit() bool {
+	if x != nil {
+		return x.EnableDoubleClickEdit
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetEnableLinkPreview() bool {
+	if x != nil {
+		return x.EnableLinkPreview
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetReactions() []string {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetDisableMarkdownShortcuts() bool {
+	if x != nil {
+		return x.DisableMarkdownShortcuts
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetEnableBlurNsfwContent() bool {
+	if x != nil {
+		return x.EnableBlurNsfwContent
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetNsfwTags() []string {
+	if x != nil {
+		return x.NsfwTags
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetRoleDefaultVisibilities() map[string]string {
+	if x != nil {
+		return x.RoleDefaultVisibilities
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetColdStorageAfterDays() int32 {
+	if x != nil {
+		return x.ColdStorageAfterDays
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetDisableViewTracking() bool {
+	if x != nil {
+		return x.DisableViewTracking
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetProtectedVisibilityRoles() []string {
+	if x != nil {
+		return x.ProtectedVisibilityRoles
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetEnableFuzzySearch() bool {
+	if x != nil {
+		return x.EnableFuzzySearch
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetApprovalRequiredTags() []string {
+	if x != nil {
+		return x.ApprovalRequiredTags
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetTagTemplates() []*WorkspaceSetting_MemoRelatedSetting_TagTemplate {
+	if x != nil {
+		return x.TagTemplates
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetExpandShortcodes() bool {
+	if x != nil {
+		return x.ExpandShortcodes
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetShortcodes() map[string]string {
+	if x != nil {
+		return x.Shortcodes
+	}
+	return nil
+}
+
+// AI configuration settings for workspace.
+type WorkspaceSetting_AISetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// endpoint is the API endpoint URL for the AI provider.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// api_key is the API key for authentication with the AI provider.
+	ApiKey string `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// model is the AI model name to use (e.g., "gpt-4o-mini").
+	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	// system_prompt is the system prompt template for AI requests.
+	SystemPrompt string `protobuf:"bytes,4,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`
+	// tts_model is the text-to-speech model name to use (e.g., "tts-1"). Speech synthesis is disabled when empty.
+	TtsModel string `protobuf:"bytes,5,opt,name=tts_model,json=ttsModel,proto3" json:"tts_model,omitempty"`
+	// tts_voice is the voice used for speech synthesis (e.g., "alloy").
+	TtsVoice string `protobuf:"bytes,6,opt,name=tts_voice,json=ttsVoice,proto3" json:"tts_voice,omitempty"`
+	// tts_endpoint is the API endpoint URL of an OpenAI compatible text-to-speech engine, e.g. a local one.
+	// The AI provider endpoint is used when empty.
+	TtsEndpoint string `protobuf:"bytes,7,opt,name=tts_endpoint,json=ttsEndpoint,proto3" json:"tts_endpoint,omitempty"`
+	// transcription_model is the speech-to-text model name to use (e.g., "whisper-1"). Voice memos are disabled when empty.
+	TranscriptionModel string `protobuf:"bytes,8,opt,name=transcription_model,json=transcriptionModel,proto3" json:"transcription_model,omitempty"`
+	// role_permissions maps a user role (HOST, ADMIN, USER) to its AI permissions and rate limits.
+	// Roles without an entry can use all AI features, with the default rate limit of the server.
+	RolePermissions map[string]*WorkspaceSetting_AISetting_RolePermission `protobuf:"bytes,9,rep,name=role_permissions,json=rolePermissions,proto3" json:"role_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// disallow_protected_memos keeps Protected memos from being sent to the AI provider.
+	DisallowProtectedMemos bool `protobuf:"varint,10,opt,name=disallow_protected_memos,json=disallowProtectedMemos,proto3" json:"disallow_protected_memos,omitempty"`
+	// prompt_token_price is the price of one million prompt tokens, used to estimate the cost of AI requests.
+	PromptTokenPrice float64 `protobuf:"fixed64,11,opt,name=prompt_token_price,json=promptTokenPrice,proto3" json:"prompt_token_price,omitempty"`
+	// completion_token_price is the price of one million completion tokens, used to estimate the cost of AI requests.
+	CompletionTokenPrice float64 `protobuf:"fixed64,12,opt,name=completion_token_price,json=completionTokenPrice,proto3" json:"completion_token_price,omitempty"`
+	// provider is the AI provider the chat features are sent to. Speech synthesis and voice memos
+	// always use the OpenAI API.
+	Provider WorkspaceSetting_AISetting_Provider `protobuf:"varint,13,opt,name=provider,proto3,enum=memos.api.v1.WorkspaceSetting_AISetting_Provider" json:"provider,omitempty"`
+	// api_version is the API version of Azure OpenAI, e.g. "2024-10-21".
+	ApiVersion string `protobuf:"bytes,14,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// redaction is applied to the memo content of the prompts.
+	Redaction *WorkspaceSetting_AISetting_Redaction `protobuf:"bytes,15,opt,name=redaction,proto3" json:"redaction,omitempty"`
+	// embedding_model is the model embedding the memos for semantic search (e.g., "text-embedding-3-small").
+	// Semantic search is disabled when empty.
+	EmbeddingModel string `protobuf:"bytes,16,opt,name=embedding_model,json=embeddingModel,proto3" json:"embedding_model,omitempty"`
+	// auto_tag applies the suggested tags to the memos when they are created or their content is updated.
+	AutoTag bool `protobuf:"varint,17,opt,name=auto_tag,json=autoTag,proto3" json:"auto_tag,omitempty"`
+	// profiles are the named AI provider configurations the features can be routed to.
+	Profiles []*WorkspaceSetting_AISetting_Profile `protobuf:"bytes,18,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	// feature_profiles maps an AI feature to the name of the profile it is sent to: SUMMARY (summaries and their
+	// refinements), CHAT, TAG_SUGGESTION, VOICE_MEMO, TRANSFORM (the translations and rewrites of the memos),
+	// ATTACHMENT_EXTRACTION (the descriptions of the images and the transcripts of the audio attachments) and
+	// EMBEDDING (the memo embeddings, semantic search, the retrieval of the chat and tag merges). The features without an entry use the provider configured above.
+	FeatureProfiles map[string]string `protobuf:"bytes,19,rep,name=feature_profiles,json=featureProfiles,proto3" json:"feature_profiles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// debug_logging stores the exact prompts and raw responses of the AI calls, encrypted, for the admins to
+	// investigate bad generations. Turning it off deletes the stored prompts.
+	DebugLogging bool `protobuf:"varint,20,opt,name=debug_logging,json=debugLogging,proto3" json:"debug_logging,omitempty"`
+	// debug_log_retention_days is the number of days the prompts are kept, 7 when 0 and at most 30.
+	DebugLogRetentionDays int32 `protobuf:"varint,21,opt,name=debug_log_retention_days,json=debugLogRetentionDays,proto3" json:"debug_log_retention_days,omitempty"`
+	// summary_chunk_size is the maximum number of characters of memo content sent in a summary request, none when 0:
+	// the memos then fill the context window of the model. The memos of a summary exceeding it are split into chunks
+	// that are summarized first, and the summary is generated from the summaries of the chunks.
+	SummaryChunkSize int32 `protobuf:"varint,22,opt,name=summary_chunk_size,json=summaryChunkSize,proto3" json:"summary_chunk_size,omitempty"`
+	// summary_max_chunks is the maximum number of chunks the memos of a summary are split into, 10 when 0 and at
+	// most 50. The oldest memos beyond them are left out.
+	SummaryMaxChunks int32 `protobuf:"varint,23,opt,name=summary_max_chunks,json=summaryMaxChunks,proto3" json:"summary_max_chunks,omitempty"`
+	// attachment_extraction configures the extraction of the attachments, which are not extracted when it is unset.
+	AttachmentExtraction *WorkspaceSetting_AISetting_AttachmentExtraction `protobuf:"bytes,24,opt,name=attachment_extraction,json=attachmentExtraction,proto3" json:"attachment_extraction,omitempty"`
+	// summary_model is the model generating the summaries and their refinements, model when empty.
+	SummaryModel string `protobuf:"bytes,25,opt,name=summary_model,json=summaryModel,proto3" json:"summary_model,omitempty"`
+	// chat_model is the model answering the chat, model when empty.
+	ChatModel string `protobuf:"bytes,26,opt,name=chat_model,json=chatModel,proto3" json:"chat_model,omitempty"`
+	// vision_model is the model describing the image attachments, model when empty.
+	VisionModel string `protobuf:"bytes,27,opt,name=vision_model,json=visionModel,proto3" json:"vision_model,omitempty"`
+	// temperature is the sampling temperature of the completions, the default of the provider when unset.
+	Temperature *float64 `protobuf:"fixed64,28,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	// max_tokens caps the number of tokens of each completion, the default of the provider when 0.
+	MaxTokens int32 `protobuf:"varint,29,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	// workspace_summary_daily_limit is the maximum number of workspace summaries generated per UTC day, 0 for no limit.
+	WorkspaceSummaryDailyLimit int32 `protobuf:"varint,30,opt,name=workspace_summary_daily_limit,json=workspaceSummaryDailyLimit,proto3" json:"workspace_summary_daily_limit,omitempty"`
+	// context_windows overrides the context window, in tokens, of the models by model name. The prompts are fitted in
+	// the context window of the model, the known one of its family or 8192 tokens when not set.
+	ContextWindows map[string]int32 `protobuf:"bytes,31,rep,name=context_windows,json=contextWindows,proto3" json:"context_windows,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// summary_min_length is the minimum number of characters of a generated summary, none when 0.
+	SummaryMinLength int32 `protobuf:"varint,32,opt,name=summary_min_length,json=summaryMinLength,proto3" json:"summary_min_length,omitempty"`
+	// summary_max_length is the number of characters the generated summaries are truncated to, none when 0.
+	SummaryMaxLength int32 `protobuf:"varint,33,opt,name=summary_max_length,json=summaryMaxLength,proto3" json:"summary_max_length,omitempty"`
+	// summary_cache_ttl_minutes is how long the AI memo of a summary is returned again for the identical requests of the
+	// user, on the same source memos unchanged, instead of calling the provider. The cache is disabled when 0.
+	SummaryCacheTtlMinutes int32 `protobuf:"varint,34,opt,name=summary_cache_ttl_minutes,json=summaryCacheTtlMinutes,proto3" json:"summary_cache_ttl_minutes,omitempty"`
+	// circuit_breaker_failures is the number of consecutive failed calls to a provider that open its circuit breaker,
+	// failing the AI requests fast until the cooldown is over. It is 5 when 0.
+	CircuitBreakerFailures int32 `protobuf:"varint,35,opt,name=circuit_breaker_failures,json=circuitBreakerFailures,proto3" json:"circuit_breaker_failures,omitempty"`
+	// circuit_breaker_cooldown_seconds is how long the circuit breaker of a provider stays open before a trial call is
+	// let through. It is 30 seconds when 0.
+	CircuitBreakerCooldownSeconds int32 `protobuf:"varint,36,opt,name=circuit_breaker_cooldown_seconds,json=circuitBreakerCooldownSeconds,proto3" json:"circuit_breaker_cooldown_seconds,omitempty"`
+	// max_concurrent_requests is the maximum number of AI requests in flight to all the providers, the others wait
+	// for a slot a few seconds before failing. There is no limit when 0.
+	MaxConcurrentRequests int32 `protobuf:"varint,37,opt,name=max_concurrent_requests,json=maxConcurrentRequests,proto3" json:"max_concurrent_requests,omitempty"`
+	// disabled turns off all the AI features of the workspace, whatever the rest of the setting: the AI endpoints are
+	// rejected, the background AI tasks are skipped and the AI settings are hidden from the users.
+	Disabled bool `protobuf:"varint,38,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// data_processing_disclosure tells the users which providers process their content and how, shown to them before
+	// they opt in to the AI processing of their content in the AI operations of the other users.
+	DataProcessingDisclosure string `protobuf:"bytes,39,opt,name=data_processing_disclosure,json=dataProcessingDisclosure,proto3" json:"data_processing_disclosure,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_AISetting) Reset() {
+	*x = WorkspaceSetting_AISetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_AISetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_AISetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_AISetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_AISetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_AISetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 3}
+}
+
+func (x *WorkspaceSetting_AISetting) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting) GetSystemPrompt() string {
+	if x != nil {
+		return x.SystemPrompt
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting) GetTtsModel() string {
+	if x != nil {
+		return x.TtsModel
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting) GetTtsVoice() string {
+	if x != nil {
+		return x.TtsVoice
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting) GetTtsEndpoint() string {
+	if x != nil {
+		return x.TtsEndpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting) GetTranscriptionModel() string {
+	if x != nil {
+		return x.TranscriptionModel
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting) GetRolePermissions() map[string]*WorkspaceSetting_AISetting_RolePermission {
+	if x != nil {
+		return x.RolePermissions
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_AISetting) GetDisallowProtectedMemos() bool {
+	if x != nil {
+		return x.DisallowProtectedMemos
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_AISetting) GetPromptTokenPrice() float64 {
+	if x != nil {
+		return x.PromptTokenPrice
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetCompletionTokenPrice() float64 {
+	if x != nil {
+		return x.CompletionTokenPrice
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetProvider() WorkspaceSetting_AISetting_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return WorkspaceSetting_AISetting_PROVIDER_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_AISetting) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting) GetRedaction() *WorkspaceSetting_AISetting_Redaction {
+	if x != nil {
+		return x.Redaction
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_AISetting) GetEmbeddingModel() string {
+	if x != nil {
+		return x.EmbeddingModel
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting) GetAutoTag() bool {
+	if x != nil {
+		return x.AutoTag
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_AISetting) GetProfiles() []*WorkspaceSetting_AISetting_Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_AISetting) GetFeatureProfiles() map[string]string {
+	if x != nil {
+		return x.FeatureProfiles
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_AISetting) GetDebugLogging() bool {
+	if x != nil {
+		return x.DebugLogging
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_AISetting) GetDebugLogRetentionDays() int32 {
+	if x != nil {
+		return x.DebugLogRetentionDays
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetSummaryChunkSize() int32 {
+	if x != nil {
+		return x.SummaryChunkSize
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetSummaryMaxChunks() int32 {
+	if x != nil {
+		return x.SummaryMaxChunks
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetAttachmentExtraction() *WorkspaceSetting_AISetting_AttachmentExtraction {
+	if x != nil {
+		return x.AttachmentExtraction
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_AISetting) GetSummaryModel() string {
+	if x != nil {
+		return x.SummaryModel
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting) GetChatModel() string {
+	if x != nil {
+		return x.ChatModel
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting) GetVisionModel() string {
+	if x != nil {
+		return x.VisionModel
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting) GetTemperature() float64 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetMaxTokens() int32 {
+	if x != nil {
+		return x.MaxTokens
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetWorkspaceSummaryDailyLimit() int32 {
+	if x != nil {
+		return x.WorkspaceSummaryDailyLimit
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetContextWindows() map[string]int32 {
+	if x != nil {
+		return x.ContextWindows
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_AISetting) GetSummaryMinLength() int32 {
+	if x != nil {
+		return x.SummaryMinLength
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetSummaryMaxLength() int32 {
+	if x != nil {
+		return x.SummaryMaxLength
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetSummaryCacheTtlMinutes() int32 {
+	if x != nil {
+		return x.SummaryCacheTtlMinutes
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetCircuitBreakerFailures() int32 {
+	if x != nil {
+		return x.CircuitBreakerFailures
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetCircuitBreakerCooldownSeconds() int32 {
+	if x != nil {
+		return x.CircuitBreakerCooldownSeconds
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetMaxConcurrentRequests() int32 {
+	if x != nil {
+		return x.MaxConcurrentRequests
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_AISetting) GetDataProcessingDisclosure() string {
+	if x != nil {
+		return x.DataProcessingDisclosure
+	}
+	return ""
+}
+
+// Onboarding pack applied to each newly created user.
+type WorkspaceSetting_OnboardingSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// welcome_memo_content is the content of the welcome memo created for each new user.
+	// Leave it empty to skip creating the welcome memo.
+	WelcomeMemoContent string `protobuf:"bytes,1,opt,name=welcome_memo_content,json=welcomeMemoContent,proto3" json:"welcome_memo_content,omitempty"`
+	// template_memos is the list of template memo contents created for each new user.
+	TemplateMemos []string `protobuf:"bytes,2,rep,name=template_memos,json=templateMemos,proto3" json:"template_memos,omitempty"`
+	// default_tags is the list of tags appended to each onboarding memo.
+	DefaultTags   []string `protobuf:"bytes,3,rep,name=default_tags,json=defaultTags,proto3" json:"default_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_OnboardingSetting) Reset() {
+	*x = WorkspaceSetting_OnboardingSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_OnboardingSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_OnboardingSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_OnboardingSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_OnboardingSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_OnboardingSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 4}
+}
+
+func (x *WorkspaceSetting_OnboardingSetting) GetWelcomeMemoContent() string {
+	if x != nil {
+		return x.WelcomeMemoContent
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_OnboardingSetting) GetTemplateMemos() []string {
+	if x != nil {
+		return x.TemplateMemos
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_OnboardingSetting) GetDefaultTags() []string {
+	if x != nil {
+		return x.DefaultTags
+	}
+	return nil
+}
+
+// Soft limits applied to new accounts on open-registration instances.
+type WorkspaceSetting_NewUserLimitSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// probation_days is the account age in days before the limits are lifted.
+	// Limits are disabled when it is 0.
+	ProbationDays int32 `protobuf:"varint,1,opt,name=probation_days,json=probationDays,proto3" json:"probation_days,omitempty"`
+	// memos_per_day is the max number of memos a new user can create per day. 0 means unlimited.
+	MemosPerDay int32 `protobuf:"varint,2,opt,name=memos_per_day,json=memosPerDay,proto3" json:"memos_per_day,omitempty"`
+	// attachments_per_day is the max number of attachments a new user can upload per day. 0 means unlimited.
+	AttachmentsPerDay int32 `protobuf:"varint,3,opt,name=attachments_per_day,json=attachmentsPerDay,proto3" json:"attachments_per_day,omitempty"`
+	// disallow_public_memos disallows new users to create public memos.
+	DisallowPublicMemos bool `protobuf:"varint,4,opt,name=disallow_public_memos,json=disallowPublicMemos,proto3" json:"disallow_public_memos,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_NewUserLimitSetting) Reset() {
+	*x = WorkspaceSetting_NewUserLimitSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_NewUserLimitSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_NewUserLimitSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_NewUserLimitSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_NewUserLimitSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_NewUserLimitSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 5}
+}
+
+func (x *WorkspaceSetting_NewUserLimitSetting) GetProbationDays() int32 {
+	if x != nil {
+		return x.ProbationDays
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_NewUserLimitSetting) GetMemosPerDay() int32 {
+	if x != nil {
+		return x.MemosPerDay
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_NewUserLimitSetting) GetAttachmentsPerDay() int32 {
+	if x != nil {
+		return x.AttachmentsPerDay
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_NewUserLimitSetting) GetDisallowPublicMemos() bool {
+	if x != nil {
+		return x.DisallowPublicMemos
+	}
+	return false
+}
+
+// Feature flags used to ship features dark and enable them per instance or per cohort.
+type WorkspaceSetting_FeatureFlagSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// flags is the list of feature flags defined for the workspace.
+	Flags         []*WorkspaceSetting_FeatureFlag `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_FeatureFlagSetting) Reset() {
+	*x = WorkspaceSetting_FeatureFlagSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_FeatureFlagSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_FeatureFlagSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_FeatureFlagSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_FeatureFlagSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_FeatureFlagSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 6}
+}
+
+func (x *WorkspaceSetting_FeatureFlagSetting) GetFlags() []*WorkspaceSetting_FeatureFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+// A feature flag definition.
+type WorkspaceSetting_FeatureFlag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the unique name of the flag, e.g. "ai-chat".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// enabled enables the feature for every user.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// rollout_percentage enables the feature for a stable share of users, from 0 to 100.
+	RolloutPercentage int32 `protobuf:"varint,3,opt,name=rollout_percentage,json=rolloutPercentage,proto3" json:"rollout_percentage,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_FeatureFlag) Reset() {
+	*x = WorkspaceSetting_FeatureFlag{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_FeatureFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_FeatureFlag) ProtoMessage() {}
+
+func (x *WorkspaceSetting_FeatureFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_FeatureFlag.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_FeatureFlag) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 7}
+}
+
+func (x *WorkspaceSetting_FeatureFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_FeatureFlag) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_FeatureFlag) GetRolloutPercentage() int32 {
+	if x != nil {
+		return x.RolloutPercentage
+	}
+	return 0
+}
+
+// Usage limits of the whole workspace, set by the host. 0 means unlimited.
+type WorkspaceSetting_UsageLimitSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_users is the maximum number of users.
+	MaxUsers int32 `protobuf:"varint,1,opt,name=max_users,json=maxUsers,proto3" json:"max_users,omitempty"`
+	// max_memos is the maximum number of memos, comments and archived memos included.
+	MaxMemos int32 `protobuf:"varint,2,opt,name=max_memos,json=maxMemos,proto3" json:"max_memos,omitempty"`
+	// max_storage_bytes is the maximum total size of the attachments.
+	MaxStorageBytes int64 `protobuf:"varint,3,opt,name=max_storage_bytes,json=maxStorageBytes,proto3" json:"max_storage_bytes,omitempty"`
+	// max_ai_monthly_tokens is the maximum number of AI tokens used per calendar month.
+	MaxAiMonthlyTokens int64 `protobuf:"varint,4,opt,name=max_ai_monthly_tokens,json=maxAiMonthlyTokens,proto3" json:"max_ai_monthly_tokens,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_UsageLimitSetting) Reset() {
+	*x = WorkspaceSetting_UsageLimitSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_UsageLimitSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_UsageLimitSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_UsageLimitSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_UsageLimitSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_UsageLimitSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 8}
+}
+
+func (x *WorkspaceSetting_UsageLimitSetting) GetMaxUsers() int32 {
+	if x != nil {
+		return x.MaxUsers
+	}
+	return 0
+}
 
-func (*WorkspaceSetting_MemoRelatedSetting_) isWorkspaceSetting_Value() {}
+func (x *WorkspaceSetting_UsageLimitSetting) GetMaxMemos() int32 {
+	if x != nil {
+		return x.MaxMemos
+	}
+	return 0
+}
 
-func (*WorkspaceSetting_AiSetting) isWorkspaceSetting_Value() {}
+func (x *WorkspaceSetting_UsageLimitSetting) GetMaxStorageBytes() int64 {
+	if x != nil {
+		return x.MaxStorageBytes
+	}
+	return 0
+}
 
-// Request message for GetWorkspaceSetting method.
-type GetWorkspaceSettingRequest struct {
+func (x *WorkspaceSetting_UsageLimitSetting) GetMaxAiMonthlyTokens() int64 {
+	if x != nil {
+		return x.MaxAiMonthlyTokens
+	}
+	return 0
+}
+
+// Sensitive content classification of image attachments.
+type WorkspaceSetting_SensitiveContentSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The resource name of the workspace setting.
-	// Format: workspace/settings/{setting}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// classifier_endpoint is the URL of the classifier the image attachments are posted to,
+	// either an external API or a local model served over HTTP. Leave it empty to disable classification.
+	// Only returned to admins.
+	ClassifierEndpoint string `protobuf:"bytes,1,opt,name=classifier_endpoint,json=classifierEndpoint,proto3" json:"classifier_endpoint,omitempty"`
+	// classifier_api_key is the API key sent as a bearer token to the classifier.
+	// Only returned to admins.
+	ClassifierApiKey string `protobuf:"bytes,2,opt,name=classifier_api_key,json=classifierApiKey,proto3" json:"classifier_api_key,omitempty"`
+	// policy is how sensitive attachments are served to users other than their creator.
+	Policy        WorkspaceSetting_SensitiveContentSetting_Policy `protobuf:"varint,3,opt,name=policy,proto3,enum=memos.api.v1.WorkspaceSetting_SensitiveContentSetting_Policy" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_SensitiveContentSetting) Reset() {
+	*x = WorkspaceSetting_SensitiveContentSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_SensitiveContentSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_SensitiveContentSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_SensitiveContentSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_SensitiveContentSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_SensitiveContentSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 9}
+}
+
+func (x *WorkspaceSetting_SensitiveContentSetting) GetClassifierEndpoint() string {
+	if x != nil {
+		return x.ClassifierEndpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_SensitiveContentSetting) GetClassifierApiKey() string {
+	if x != nil {
+		return x.ClassifierApiKey
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_SensitiveContentSetting) GetPolicy() WorkspaceSetting_SensitiveContentSetting_Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return WorkspaceSetting_SensitiveContentSetting_POLICY_UNSPECIFIED
+}
+
+// Restrictions of the requests sent by the server, e.g. to unfurl links, to post webhooks or to call the AI provider.
+// Only http and https are allowed, and internal addresses, e.g. loopback or private ones, are blocked.
+type WorkspaceSetting_OutboundFetchSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// allowed_hosts are reached whatever their address and port: host names, "*.example.com" for the subdomains
+	// of a domain, IP addresses or CIDR ranges, e.g. "10.0.0.0/8" for an AI model served on the local network.
+	AllowedHosts []string `protobuf:"bytes,1,rep,name=allowed_hosts,json=allowedHosts,proto3" json:"allowed_hosts,omitempty"`
+	// allowed_ports are the ports the other hosts are reached on. When empty, 80, 443 and the ports from 1024 are allowed.
+	AllowedPorts []int32 `protobuf:"varint,2,rep,packed,name=allowed_ports,json=allowedPorts,proto3" json:"allowed_ports,omitempty"`
+	// max_response_bytes is the maximum size of the fetched responses, 0 for the default of 10 MiB.
+	MaxResponseBytes int64 `protobuf:"varint,3,opt,name=max_response_bytes,json=maxResponseBytes,proto3" json:"max_response_bytes,omitempty"`
+	// timeout_seconds is the time limit of the requests, 0 for the default of 30 seconds.
+	TimeoutSeconds int32 `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_OutboundFetchSetting) Reset() {
+	*x = WorkspaceSetting_OutboundFetchSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_OutboundFetchSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_OutboundFetchSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_OutboundFetchSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_OutboundFetchSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_OutboundFetchSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 10}
+}
+
+func (x *WorkspaceSetting_OutboundFetchSetting) GetAllowedHosts() []string {
+	if x != nil {
+		return x.AllowedHosts
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_OutboundFetchSetting) GetAllowedPorts() []int32 {
+	if x != nil {
+		return x.AllowedPorts
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_OutboundFetchSetting) GetMaxResponseBytes() int64 {
+	if x != nil {
+		return x.MaxResponseBytes
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_OutboundFetchSetting) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+// The legal pages of the workspace, returned to everyone, e.g. to be shown on the sign-in and sign-up pages.
+type WorkspaceSetting_LegalSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// terms_of_service is the terms of service in Markdown.
+	TermsOfService string `protobuf:"bytes,1,opt,name=terms_of_service,json=termsOfService,proto3" json:"terms_of_service,omitempty"`
+	// privacy_policy is the privacy policy in Markdown.
+	PrivacyPolicy string `protobuf:"bytes,2,opt,name=privacy_policy,json=privacyPolicy,proto3" json:"privacy_policy,omitempty"`
+	// version is the version of the legal pages the users consent to, e.g. "2026-10". When it changes, the users
+	// must consent to the new version at their next sign-in, with the legal_consent_version of the sign-in request.
+	// Empty does not require the consent of the users.
+	Version       string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_LegalSetting) Reset() {
+	*x = WorkspaceSetting_LegalSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_LegalSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_LegalSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_LegalSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_LegalSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_LegalSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 11}
+}
+
+func (x *WorkspaceSetting_LegalSetting) GetTermsOfService() string {
+	if x != nil {
+		return x.TermsOfService
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_LegalSetting) GetPrivacyPolicy() string {
+	if x != nil {
+		return x.PrivacyPolicy
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_LegalSetting) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// The channels the admins are alerted on of the operational failures: the webhooks failing repeatedly, the AI
+// provider failing until its circuit breaker opens, the failed attachment storage writes and the disk usage over
+// the threshold. The same failure is alerted at most once an hour. Only the host can get it.
+type WorkspaceSetting_AlertingSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// channels are the channels the alerts are sent to.
+	Channels []*WorkspaceSetting_AlertingSetting_AlertChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// disk_usage_threshold_percent alerts when the usage of the disk of the data directory reaches it, 0 disables it.
+	DiskUsageThresholdPercent int32 `protobuf:"varint,2,opt,name=disk_usage_threshold_percent,json=diskUsageThresholdPercent,proto3" json:"disk_usage_threshold_percent,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_AlertingSetting) Reset() {
+	*x = WorkspaceSetting_AlertingSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_AlertingSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_AlertingSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_AlertingSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_AlertingSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_AlertingSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 12}
+}
+
+func (x *WorkspaceSetting_AlertingSetting) GetChannels() []*WorkspaceSetting_AlertingSetting_AlertChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_AlertingSetting) GetDiskUsageThresholdPercent() int32 {
+	if x != nil {
+		return x.DiskUsageThresholdPercent
+	}
+	return 0
+}
+
+// Custom profile configuration for workspace branding.
+type WorkspaceSetting_GeneralSetting_CustomProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	Locale        string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkspaceSettingRequest) Reset() {
-	*x = GetWorkspaceSettingRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
+func (x *WorkspaceSetting_GeneralSetting_CustomProfile) Reset() {
+	*x = WorkspaceSetting_GeneralSetting_CustomProfile{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_GeneralSetting_CustomProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_GeneralSetting_CustomProfile) ProtoMessage() {}
+
+func (x *WorkspaceSetting_GeneralSetting_CustomProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_GeneralSetting_CustomProfile.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_GeneralSetting_CustomProfile) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 0, 0}
+}
+
+func (x *WorkspaceSetting_GeneralSetting_CustomProfile) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GeneralSetting_CustomProfile) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GeneralSetting_CustomProfile) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GeneralSetting_CustomProfile) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// S3 configuration for cloud storage backend.
+// Reference: https://developers.cloudflare.com/r2/examples/aws/aws-sdk-go/
+type WorkspaceSetting_StorageSetting_S3Config struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccessKeyId     string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	AccessKeySecret string                 `protobuf:"bytes,2,opt,name=access_key_secret,json=accessKeySecret,proto3" json:"access_key_secret,omitempty"`
+	Endpoint        string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Region          string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket          string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	UsePathStyle    bool                   `protobuf:"varint,6,opt,name=use_path_style,json=usePathStyle,proto3" json:"use_path_style,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_StorageSetting_S3Config) Reset() {
+	*x = WorkspaceSetting_StorageSetting_S3Config{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkspaceSettingRequest) String() string {
+func (x *WorkspaceSetting_StorageSetting_S3Config) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkspaceSettingRequest) ProtoMessage() {}
+func (*WorkspaceSetting_StorageSetting_S3Config) ProtoMessage() {}
 
-func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
+func (x *WorkspaceSetting_StorageSetting_S3Config) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,44 +5835,82 @@ func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use WorkspaceSetting_StorageSetting_S3Config.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_StorageSetting_S3Config) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 1, 0}
 }
 
-func (x *GetWorkspaceSettingRequest) GetName() string {
+func (x *WorkspaceSetting_StorageSetting_S3Config) GetAccessKeyId() string {
 	if x != nil {
-		return x.Name
+		return x.AccessKeyId
 	}
 	return ""
 }
 
-// Request message for UpdateWorkspaceSetting method.
-type UpdateWorkspaceSettingRequest struct {
+func (x *WorkspaceSetting_StorageSetting_S3Config) GetAccessKeySecret() string {
+	if x != nil {
+		return x.AccessKeySecret
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_StorageSetting_S3Config) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_StorageSetting_S3Config) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_StorageSetting_S3Config) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_StorageSetting_S3Config) GetUsePathStyle() bool {
+	if x != nil {
+		return x.UsePathStyle
+	}
+	return false
+}
+
+// A template and the required properties of the memos with a tag.
+type WorkspaceSetting_MemoRelatedSetting_TagTemplate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The workspace setting resource which replaces the resource on the server.
-	Setting *WorkspaceSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
-	// The list of fields to update.
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The tag without "#", e.g. "incident". The template also applies to its subtags.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The content the memos with the tag start from, e.g. a front matter with the required properties.
+	Template string `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	// The front matter properties the memos with the tag must set, e.g. "severity" and "status". The memos
+	// without them are rejected on create and update, with a field violation for each missing property.
+	RequiredProperties []string `protobuf:"bytes,3,rep,name=required_properties,json=requiredProperties,proto3" json:"required_properties,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *UpdateWorkspaceSettingRequest) Reset() {
-	*x = UpdateWorkspaceSettingRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
+func (x *WorkspaceSetting_MemoRelatedSetting_TagTemplate) Reset() {
+	*x = WorkspaceSetting_MemoRelatedSetting_TagTemplate{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateWorkspaceSettingRequest) String() string {
+func (x *WorkspaceSetting_MemoRelatedSetting_TagTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkspaceSettingRequest) ProtoMessage() {}
+func (*WorkspaceSetting_MemoRelatedSetting_TagTemplate) ProtoMessage() {}
 
-func (x *UpdateWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
+func (x *WorkspaceSetting_MemoRelatedSetting_TagTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,68 +5921,73 @@ func (x *UpdateWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use WorkspaceSetting_MemoRelatedSetting_TagTemplate.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_MemoRelatedSetting_TagTemplate) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 2, 2}
 }
 
-func (x *UpdateWorkspaceSettingRequest) GetSetting() *WorkspaceSetting {
+func (x *WorkspaceSetting_MemoRelatedSetting_TagTemplate) GetTag() string {
 	if x != nil {
-		return x.Setting
+		return x.Tag
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateWorkspaceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *WorkspaceSetting_MemoRelatedSetting_TagTemplate) GetTemplate() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Template
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting_TagTemplate) GetRequiredProperties() []string {
+	if x != nil {
+		return x.RequiredProperties
 	}
 	return nil
 }
 
-// General workspace settings configuration.
-type WorkspaceSetting_GeneralSetting struct {
+// RolePermission restricts the AI features a user role can use.
+type WorkspaceSetting_AISetting_RolePermission struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// theme is the name of the selected theme.
-	// This references a CSS file in the web/public/themes/ directory.
-	Theme string `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
-	// disallow_user_registration disallows user registration.
-	DisallowUserRegistration bool `protobuf:"varint,2,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
-	// disallow_password_auth disallows password authentication.
-	DisallowPasswordAuth bool `protobuf:"varint,3,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
-	// additional_script is the additional script.
-	AdditionalScript string `protobuf:"bytes,4,opt,name=additional_script,json=additionalScript,proto3" json:"additional_script,omitempty"`
-	// additional_style is the additional style.
-	AdditionalStyle string `protobuf:"bytes,5,opt,name=additional_style,json=additionalStyle,proto3" json:"additional_style,omitempty"`
-	// custom_profile is the custom profile.
-	CustomProfile *WorkspaceSetting_GeneralSetting_CustomProfile `protobuf:"bytes,6,opt,name=custom_profile,json=customProfile,proto3" json:"custom_profile,omitempty"`
-	// week_start_day_offset is the week start day offset from Sunday.
-	// 0: Sunday, 1: Monday, 2: Tuesday, 3: Wednesday, 4: Thursday, 5: Friday, 6: Saturday
-	// Default is Sunday.
-	WeekStartDayOffset int32 `protobuf:"varint,7,opt,name=week_start_day_offset,json=weekStartDayOffset,proto3" json:"week_start_day_offset,omitempty"`
-	// disallow_change_username disallows changing username.
-	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
-	// disallow_change_nickname disallows changing nickname.
-	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// disable_summary disallows generating AI summaries.
+	DisableSummary bool `protobuf:"varint,1,opt,name=disable_summary,json=disableSummary,proto3" json:"disable_summary,omitempty"`
+	// disable_speech disallows synthesizing memos and digests to speech.
+	DisableSpeech bool `protobuf:"varint,2,opt,name=disable_speech,json=disableSpeech,proto3" json:"disable_speech,omitempty"`
+	// disable_voice_memo disallows creating memos from voice recordings.
+	DisableVoiceMemo bool `protobuf:"varint,3,opt,name=disable_voice_memo,json=disableVoiceMemo,proto3" json:"disable_voice_memo,omitempty"`
+	// disable_chat disallows asking questions about the memos.
+	DisableChat bool `protobuf:"varint,4,opt,name=disable_chat,json=disableChat,proto3" json:"disable_chat,omitempty"`
+	// disable_tag_suggestion disallows suggesting tags for the memos, and applying them automatically.
+	DisableTagSuggestion bool `protobuf:"varint,5,opt,name=disable_tag_suggestion,json=disableTagSuggestion,proto3" json:"disable_tag_suggestion,omitempty"`
+	// hourly_request_limit is the maximum number of AI requests per user per hour,
+	// 0 for the default of the server and negative for no limit.
+	HourlyRequestLimit int32 `protobuf:"varint,6,opt,name=hourly_request_limit,json=hourlyRequestLimit,proto3" json:"hourly_request_limit,omitempty"`
+	// daily_request_limit is the maximum number of AI requests per user per UTC day, 0 for no limit.
+	DailyRequestLimit int32 `protobuf:"varint,7,opt,name=daily_request_limit,json=dailyRequestLimit,proto3" json:"daily_request_limit,omitempty"`
+	// monthly_token_budget is the maximum number of AI tokens per user per UTC month, 0 for no limit.
+	MonthlyTokenBudget int64 `protobuf:"varint,8,opt,name=monthly_token_budget,json=monthlyTokenBudget,proto3" json:"monthly_token_budget,omitempty"`
+	// disable_transform disallows translating and rewriting the memos.
+	DisableTransform bool `protobuf:"varint,9,opt,name=disable_transform,json=disableTransform,proto3" json:"disable_transform,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *WorkspaceSetting_GeneralSetting) Reset() {
-	*x = WorkspaceSetting_GeneralSetting{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+func (x *WorkspaceSetting_AISetting_RolePermission) Reset() {
+	*x = WorkspaceSetting_AISetting_RolePermission{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceSetting_GeneralSetting) String() string {
+func (x *WorkspaceSetting_AISetting_RolePermission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceSetting_GeneralSetting) ProtoMessage() {}
+func (*WorkspaceSetting_AISetting_RolePermission) ProtoMessage() {}
 
-func (x *WorkspaceSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+func (x *WorkspaceSetting_AISetting_RolePermission) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,105 +5998,207 @@ func (x *WorkspaceSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceSetting_GeneralSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceSetting_GeneralSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use WorkspaceSetting_AISetting_RolePermission.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_AISetting_RolePermission) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 3, 0}
 }
 
-func (x *WorkspaceSetting_GeneralSetting) GetTheme() string {
+func (x *WorkspaceSetting_AISetting_RolePermission) GetDisableSummary() bool {
 	if x != nil {
-		return x.Theme
+		return x.DisableSummary
 	}
-	return ""
+	return false
 }
 
-func (x *WorkspaceSetting_GeneralSetting) GetDisallowUserRegistration() bool {
+func (x *WorkspaceSetting_AISetting_RolePermission) GetDisableSpeech() bool {
 	if x != nil {
-		return x.DisallowUserRegistration
+		return x.DisableSpeech
 	}
 	return false
 }
 
-func (x *WorkspaceSetting_GeneralSetting) GetDisallowPasswordAuth() bool {
+func (x *WorkspaceSetting_AISetting_RolePermission) GetDisableVoiceMemo() bool {
 	if x != nil {
-		return x.DisallowPasswordAuth
+		return x.DisableVoiceMemo
 	}
 	return false
 }
 
-func (x *WorkspaceSetting_GeneralSetting) GetAdditionalScript() string {
+func (x *WorkspaceSetting_AISetting_RolePermission) GetDisableChat() bool {
 	if x != nil {
-		return x.AdditionalScript
+		return x.DisableChat
 	}
-	return ""
+	return false
 }
 
-func (x *WorkspaceSetting_GeneralSetting) GetAdditionalStyle() string {
+func (x *WorkspaceSetting_AISetting_RolePermission) GetDisableTagSuggestion() bool {
 	if x != nil {
-		return x.AdditionalStyle
+		return x.DisableTagSuggestion
 	}
-	return ""
+	return false
 }
 
-func (x *WorkspaceSetting_GeneralSetting) GetCustomProfile() *WorkspaceSetting_GeneralSetting_CustomProfile {
+func (x *WorkspaceSetting_AISetting_RolePermission) GetHourlyRequestLimit() int32 {
 	if x != nil {
-		return x.CustomProfile
+		return x.HourlyRequestLimit
 	}
-	return nil
+	return 0
 }
 
-func (x *WorkspaceSetting_GeneralSetting) GetWeekStartDayOffset() int32 {
+func (x *WorkspaceSetting_AISetting_RolePermission) GetDailyRequestLimit() int32 {
 	if x != nil {
-		return x.WeekStartDayOffset
+		return x.DailyRequestLimit
 	}
 	return 0
 }
 
-func (x *WorkspaceSetting_GeneralSetting) GetDisallowChangeUsername() bool {
+func (x *WorkspaceSetting_AISetting_RolePermission) GetMonthlyTokenBudget() int64 {
 	if x != nil {
-		return x.DisallowChangeUsername
+		return x.MonthlyTokenBudget
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AISetting_RolePermission) GetDisableTransform() bool {
+	if x != nil {
+		return x.DisableTransform
 	}
 	return false
 }
 
-func (x *WorkspaceSetting_GeneralSetting) GetDisallowChangeNickname() bool {
+// Redaction replaces sensitive content of the memos before they are sent to the AI provider.
+// Each distinct value is replaced with a numbered placeholder, e.g. [EMAIL_1], so the summary still reads coherently.
+type WorkspaceSetting_AISetting_Redaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// redact_emails replaces email addresses.
+	RedactEmails bool `protobuf:"varint,1,opt,name=redact_emails,json=redactEmails,proto3" json:"redact_emails,omitempty"`
+	// redact_phone_numbers replaces phone numbers.
+	RedactPhoneNumbers bool `protobuf:"varint,2,opt,name=redact_phone_numbers,json=redactPhoneNumbers,proto3" json:"redact_phone_numbers,omitempty"`
+	// patterns are regular expressions (RE2 syntax) whose matches are replaced.
+	Patterns []string `protobuf:"bytes,3,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	// tags are the tags, without the leading '#', replaced along with their child tags.
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// redact_secrets replaces API keys, access tokens, private keys and the values assigned to passwords and secrets.
+	RedactSecrets bool `protobuf:"varint,5,opt,name=redact_secrets,json=redactSecrets,proto3" json:"redact_secrets,omitempty"`
+	// restore_placeholders puts the replaced values back in place of the placeholders in the generated summaries.
+	// The content streamed while a summary is generated keeps the placeholders.
+	RestorePlaceholders bool `protobuf:"varint,6,opt,name=restore_placeholders,json=restorePlaceholders,proto3" json:"restore_placeholders,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_AISetting_Redaction) Reset() {
+	*x = WorkspaceSetting_AISetting_Redaction{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_AISetting_Redaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_AISetting_Redaction) ProtoMessage() {}
+
+func (x *WorkspaceSetting_AISetting_Redaction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[79]
 	if x != nil {
-		return x.DisallowChangeNickname
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_AISetting_Redaction.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_AISetting_Redaction) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 3, 2}
+}
+
+func (x *WorkspaceSetting_AISetting_Redaction) GetRedactEmails() bool {
+	if x != nil {
+		return x.RedactEmails
 	}
 	return false
 }
 
-// Storage configuration settings for workspace attachments.
-type WorkspaceSetting_StorageSetting struct {
+func (x *WorkspaceSetting_AISetting_Redaction) GetRedactPhoneNumbers() bool {
+	if x != nil {
+		return x.RedactPhoneNumbers
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_AISetting_Redaction) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_AISetting_Redaction) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_AISetting_Redaction) GetRedactSecrets() bool {
+	if x != nil {
+		return x.RedactSecrets
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_AISetting_Redaction) GetRestorePlaceholders() bool {
+	if x != nil {
+		return x.RestorePlaceholders
+	}
+	return false
+}
+
+// Profile is a named configuration of an AI provider, e.g. a cheap model for tagging or a local one for embeddings.
+type WorkspaceSetting_AISetting_Profile struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// storage_type is the storage type.
-	StorageType WorkspaceSetting_StorageSetting_StorageType `protobuf:"varint,1,opt,name=storage_type,json=storageType,proto3,enum=memos.api.v1.WorkspaceSetting_StorageSetting_StorageType" json:"storage_type,omitempty"`
-	// The template of file path.
-	// e.g. assets/{timestamp}_{filename}
-	FilepathTemplate string `protobuf:"bytes,2,opt,name=filepath_template,json=filepathTemplate,proto3" json:"filepath_template,omitempty"`
-	// The max upload size in megabytes.
-	UploadSizeLimitMb int64 `protobuf:"varint,3,opt,name=upload_size_limit_mb,json=uploadSizeLimitMb,proto3" json:"upload_size_limit_mb,omitempty"`
-	// The S3 config.
-	S3Config      *WorkspaceSetting_StorageSetting_S3Config `protobuf:"bytes,4,opt,name=s3_config,json=s3Config,proto3" json:"s3_config,omitempty"`
+	// name identifies the profile in feature_profiles.
+	Name     string                              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Provider WorkspaceSetting_AISetting_Provider `protobuf:"varint,2,opt,name=provider,proto3,enum=memos.api.v1.WorkspaceSetting_AISetting_Provider" json:"provider,omitempty"`
+	Endpoint string                              `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	ApiKey   string                              `protobuf:"bytes,4,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// api_version is the API version of Azure OpenAI.
+	ApiVersion string `protobuf:"bytes,5,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// model is the chat model of the profile.
+	Model string `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	// embedding_model is the embedding model of the profile, used when the embeddings are routed to it.
+	EmbeddingModel string `protobuf:"bytes,7,opt,name=embedding_model,json=embeddingModel,proto3" json:"embedding_model,omitempty"`
+	// transcription_model is the speech-to-text model of the profile, used when voice memos are routed to it.
+	TranscriptionModel string `protobuf:"bytes,8,opt,name=transcription_model,json=transcriptionModel,proto3" json:"transcription_model,omitempty"`
+	// summary_model, chat_model and vision_model are the models of the profile for the summaries, the chat and
+	// the attachment extraction of images, model when empty.
+	SummaryModel  string `protobuf:"bytes,9,opt,name=summary_model,json=summaryModel,proto3" json:"summary_model,omitempty"`
+	ChatModel     string `protobuf:"bytes,10,opt,name=chat_model,json=chatModel,proto3" json:"chat_model,omitempty"`
+	VisionModel   string `protobuf:"bytes,11,opt,name=vision_model,json=visionModel,proto3" json:"vision_model,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceSetting_StorageSetting) Reset() {
-	*x = WorkspaceSetting_StorageSetting{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+func (x *WorkspaceSetting_AISetting_Profile) Reset() {
+	*x = WorkspaceSetting_AISetting_Profile{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceSetting_StorageSetting) String() string {
+func (x *WorkspaceSetting_AISetting_Profile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceSetting_StorageSetting) ProtoMessage() {}
+func (*WorkspaceSetting_AISetting_Profile) ProtoMessage() {}
 
-func (x *WorkspaceSetting_StorageSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+func (x *WorkspaceSetting_AISetting_Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,79 +6209,120 @@ func (x *WorkspaceSetting_StorageSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceSetting_StorageSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceSetting_StorageSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use WorkspaceSetting_AISetting_Profile.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_AISetting_Profile) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 3, 3}
+}
+
+func (x *WorkspaceSetting_AISetting_Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting_Profile) GetProvider() WorkspaceSetting_AISetting_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return WorkspaceSetting_AISetting_PROVIDER_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_AISetting_Profile) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting_Profile) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting_Profile) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting_Profile) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_AISetting_Profile) GetEmbeddingModel() string {
+	if x != nil {
+		return x.EmbeddingModel
+	}
+	return ""
 }
 
-func (x *WorkspaceSetting_StorageSetting) GetStorageType() WorkspaceSetting_StorageSetting_StorageType {
+func (x *WorkspaceSetting_AISetting_Profile) GetTranscriptionModel() string {
 	if x != nil {
-		return x.StorageType
+		return x.TranscriptionModel
 	}
-	return WorkspaceSetting_StorageSetting_STORAGE_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *WorkspaceSetting_StorageSetting) GetFilepathTemplate() string {
+func (x *WorkspaceSetting_AISetting_Profile) GetSummaryModel() string {
 	if x != nil {
-		return x.FilepathTemplate
+		return x.SummaryModel
 	}
 	return ""
 }
 
-func (x *WorkspaceSetting_StorageSetting) GetUploadSizeLimitMb() int64 {
+func (x *WorkspaceSetting_AISetting_Profile) GetChatModel() string {
 	if x != nil {
-		return x.UploadSizeLimitMb
+		return x.ChatModel
 	}
-	return 0
+	return ""
 }
 
-func (x *WorkspaceSetting_StorageSetting) GetS3Config() *WorkspaceSetting_StorageSetting_S3Config {
+func (x *WorkspaceSetting_AISetting_Profile) GetVisionModel() string {
 	if x != nil {
-		return x.S3Config
+		return x.VisionModel
 	}
-	return nil
+	return ""
 }
 
-// Memo-related workspace settings and policies.
-type WorkspaceSetting_MemoRelatedSetting struct {
+// AttachmentExtraction extracts the text of the image and audio attachments with the AI provider, for the
+// summaries and the search. The text of the documents, e.g. PDFs, is extracted at upload without it.
+type WorkspaceSetting_AISetting_AttachmentExtraction struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// disallow_public_visibility disallows set memo as public visibility.
-	DisallowPublicVisibility bool `protobuf:"varint,1,opt,name=disallow_public_visibility,json=disallowPublicVisibility,proto3" json:"disallow_public_visibility,omitempty"`
-	// display_with_update_time orders and displays memo with update time.
-	DisplayWithUpdateTime bool `protobuf:"varint,2,opt,name=display_with_update_time,json=displayWithUpdateTime,proto3" json:"display_with_update_time,omitempty"`
-	// content_length_limit is the limit of content length. Unit is byte.
-	ContentLengthLimit int32 `protobuf:"varint,3,opt,name=content_length_limit,json=contentLengthLimit,proto3" json:"content_length_limit,omitempty"`
-	// enable_double_click_edit enables editing on double click.
-	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
-	// enable_link_preview enables links preview.
-	EnableLinkPreview bool `protobuf:"varint,5,opt,name=enable_link_preview,json=enableLinkPreview,proto3" json:"enable_link_preview,omitempty"`
-	// reactions is the list of reactions.
-	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	// disable_markdown_shortcuts disallow the registration of markdown shortcuts.
-	DisableMarkdownShortcuts bool `protobuf:"varint,8,opt,name=disable_markdown_shortcuts,json=disableMarkdownShortcuts,proto3" json:"disable_markdown_shortcuts,omitempty"`
-	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
-	EnableBlurNsfwContent bool `protobuf:"varint,9,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
-	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
-	NsfwTags      []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// images describes the images and reads their text with the vision model, which must support vision.
+	Images bool `protobuf:"varint,1,opt,name=images,proto3" json:"images,omitempty"`
+	// audio transcribes the audio recordings with the transcription model, with an OpenAI compatible provider.
+	Audio bool `protobuf:"varint,2,opt,name=audio,proto3" json:"audio,omitempty"`
+	// include_in_summaries adds the text of the attachments of the memos, documents included, to the summary prompts.
+	IncludeInSummaries bool `protobuf:"varint,3,opt,name=include_in_summaries,json=includeInSummaries,proto3" json:"include_in_summaries,omitempty"`
+	// include_in_search indexes the extracted text for memo search, like the text of the documents.
+	// It applies to the attachments extracted afterwards.
+	IncludeInSearch bool `protobuf:"varint,4,opt,name=include_in_search,json=includeInSearch,proto3" json:"include_in_search,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *WorkspaceSetting_MemoRelatedSetting) Reset() {
-	*x = WorkspaceSetting_MemoRelatedSetting{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+func (x *WorkspaceSetting_AISetting_AttachmentExtraction) Reset() {
+	*x = WorkspaceSetting_AISetting_AttachmentExtraction{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceSetting_MemoRelatedSetting) String() string {
+func (x *WorkspaceSetting_AISetting_AttachmentExtraction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceSetting_MemoRelatedSetting) ProtoMessage() {}
+func (*WorkspaceSetting_AISetting_AttachmentExtraction) ProtoMessage() {}
 
-func (x *WorkspaceSetting_MemoRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+func (x *WorkspaceSetting_AISetting_AttachmentExtraction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,104 +6333,142 @@ func (x *WorkspaceSetting_MemoRelatedSetting) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceSetting_MemoRelatedSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceSetting_MemoRelatedSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 2}
+// Deprecated: Use WorkspaceSetting_AISetting_AttachmentExtraction.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_AISetting_AttachmentExtraction) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 3, 5}
 }
 
-func (x *WorkspaceSetting_MemoRelatedSetting) GetDisallowPublicVisibility() bool {
+func (x *WorkspaceSetting_AISetting_AttachmentExtraction) GetImages() bool {
 	if x != nil {
-		return x.DisallowPublicVisibility
+		return x.Images
 	}
 	return false
 }
 
-func (x *WorkspaceSetting_MemoRelatedSetting) GetDisplayWithUpdateTime() bool {
+func (x *WorkspaceSetting_AISetting_AttachmentExtraction) GetAudio() bool {
 	if x != nil {
-		return x.DisplayWithUpdateTime
+		return x.Audio
 	}
 	return false
 }
 
-func (x *WorkspaceSetting_MemoRelatedSetting) GetContentLengthLimit() int32 {
+func (x *WorkspaceSetting_AISetting_AttachmentExtraction) GetIncludeInSummaries() bool {
 	if x != nil {
-		return x.ContentLengthLimit
+		return x.IncludeInSummaries
 	}
-	return 0
+	return false
 }
 
-func (x *WorkspaceSetting_MemoRelatedSetting) GetEnableDoubleClickEdit() bool {
+func (x *WorkspaceSetting_AISetting_AttachmentExtraction) GetIncludeInSearch() bool {
 	if x != nil {
-		return x.EnableDoubleClickEdit
+		return x.IncludeInSearch
 	}
 	return false
 }
 
-func (x *WorkspaceSetting_MemoRelatedSetting) GetEnableLinkPreview() bool {
+// A channel the alerts are sent to.
+type WorkspaceSetting_AlertingSetting_AlertChannel struct {
+	state protoimpl.MessageState                             `protogen:"open.v1"`
+	Type  WorkspaceSetting_AlertingSetting_AlertChannel_Type `protobuf:"varint,1,opt,name=type,proto3,enum=memos.api.v1.WorkspaceSetting_AlertingSetting_AlertChannel_Type" json:"type,omitempty"`
+	// url is the URL the alerts are posted to, for the webhook and Slack channels. The webhooks receive the alert
+	// as JSON with its kind, title, message and time, Slack an incoming webhook message.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// smtp is the server the alerts are sent with, for the email channel.
+	Smtp *WorkspaceSetting_AlertingSetting_SMTPConfig `protobuf:"bytes,3,opt,name=smtp,proto3" json:"smtp,omitempty"`
+	// recipients are the email addresses the alerts are sent to, for the email channel.
+	Recipients    []string `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_AlertingSetting_AlertChannel) Reset() {
+	*x = WorkspaceSetting_AlertingSetting_AlertChannel{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_AlertingSetting_AlertChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_AlertingSetting_AlertChannel) ProtoMessage() {}
+
+func (x *WorkspaceSetting_AlertingSetting_AlertChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[84]
 	if x != nil {
-		return x.EnableLinkPreview
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *WorkspaceSetting_MemoRelatedSetting) GetReactions() []string {
+// Deprecated: Use WorkspaceSetting_AlertingSetting_AlertChannel.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_AlertingSetting_AlertChannel) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 12, 0}
+}
+
+func (x *WorkspaceSetting_AlertingSetting_AlertChannel) GetType() WorkspaceSetting_AlertingSetting_AlertChannel_Type {
 	if x != nil {
-		return x.Reactions
+		return x.Type
 	}
-	return nil
+	return WorkspaceSetting_AlertingSetting_AlertChannel_TYPE_UNSPECIFIED
 }
 
-func (x *WorkspaceSetting_MemoRelatedSetting) GetDisableMarkdownShortcuts() bool {
+func (x *WorkspaceSetting_AlertingSetting_AlertChannel) GetUrl() string {
 	if x != nil {
-		return x.DisableMarkdownShortcuts
+		return x.Url
 	}
-	return false
+	return ""
 }
 
-func (x *WorkspaceSetting_MemoRelatedSetting) GetEnableBlurNsfwContent() bool {
+func (x *WorkspaceSetting_AlertingSetting_AlertChannel) GetSmtp() *WorkspaceSetting_AlertingSetting_SMTPConfig {
 	if x != nil {
-		return x.EnableBlurNsfwContent
+		return x.Smtp
 	}
-	return false
+	return nil
 }
 
-func (x *WorkspaceSetting_MemoRelatedSetting) GetNsfwTags() []string {
+func (x *WorkspaceSetting_AlertingSetting_AlertChannel) GetRecipients() []string {
 	if x != nil {
-		return x.NsfwTags
+		return x.Recipients
 	}
 	return nil
 }
 
-// AI configuration settings for workspace.
-type WorkspaceSetting_AISetting struct {
+// The SMTP server the alert emails are sent with.
+type WorkspaceSetting_AlertingSetting_SMTPConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// endpoint is the API endpoint URL for the AI provider.
-	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	// api_key is the API key for authentication with the AI provider.
-	ApiKey string `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
-	// model is the AI model name to use (e.g., "gpt-4o-mini").
-	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
-	// system_prompt is the system prompt template for AI requests.
-	SystemPrompt  string `protobuf:"bytes,4,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`
+	Host  string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// port is the port of the server, 587 if unset.
+	Port int32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// username and password authenticate to the server, if set.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// from is the sender address of the emails.
+	From          string `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceSetting_AISetting) Reset() {
-	*x = WorkspaceSetting_AISetting{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+func (x *WorkspaceSetting_AlertingSetting_SMTPConfig) Reset() {
+	*x = WorkspaceSetting_AlertingSetting_SMTPConfig{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceSetting_AISetting) String() string {
+func (x *WorkspaceSetting_AlertingSetting_SMTPConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceSetting_AISetting) ProtoMessage() {}
+func (*WorkspaceSetting_AlertingSetting_SMTPConfig) ProtoMessage() {}
 
-func (x *WorkspaceSetting_AISetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+func (x *WorkspaceSetting_AlertingSetting_SMTPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,65 +6479,76 @@ func (x *WorkspaceSetting_AISetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceSetting_AISetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceSetting_AISetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 3}
+// Deprecated: Use WorkspaceSetting_AlertingSetting_SMTPConfig.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_AlertingSetting_SMTPConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 12, 1}
 }
 
-func (x *WorkspaceSetting_AISetting) GetEndpoint() string {
+func (x *WorkspaceSetting_AlertingSetting_SMTPConfig) GetHost() string {
 	if x != nil {
-		return x.Endpoint
+		return x.Host
 	}
 	return ""
 }
 
-func (x *WorkspaceSetting_AISetting) GetApiKey() string {
+func (x *WorkspaceSetting_AlertingSetting_SMTPConfig) GetPort() int32 {
 	if x != nil {
-		return x.ApiKey
+		return x.Port
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_AlertingSetting_SMTPConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
 	return ""
 }
 
-func (x *WorkspaceSetting_AISetting) GetModel() string {
+func (x *WorkspaceSetting_AlertingSetting_SMTPConfig) GetPassword() string {
 	if x != nil {
-		return x.Model
+		return x.Password
 	}
 	return ""
 }
 
-func (x *WorkspaceSetting_AISetting) GetSystemPrompt() string {
+func (x *WorkspaceSetting_AlertingSetting_SMTPConfig) GetFrom() string {
 	if x != nil {
-		return x.SystemPrompt
+		return x.From
 	}
 	return ""
 }
 
-// Custom profile configuration for workspace branding.
-type WorkspaceSetting_GeneralSetting_CustomProfile struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	LogoUrl       string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
-	Locale        string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+// A public memo whose visibility may be unintended.
+type AuditMemoVisibilityResponse_Finding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The creator of the memo.
+	// Format: users/{user}
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The reasons the visibility may be unintended.
+	Reasons       []AuditMemoVisibilityResponse_Reason `protobuf:"varint,3,rep,packed,name=reasons,proto3,enum=memos.api.v1.AuditMemoVisibilityResponse_Reason" json:"reasons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceSetting_GeneralSetting_CustomProfile) Reset() {
-	*x = WorkspaceSetting_GeneralSetting_CustomProfile{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
+func (x *AuditMemoVisibilityResponse_Finding) Reset() {
+	*x = AuditMemoVisibilityResponse_Finding{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceSetting_GeneralSetting_CustomProfile) String() string {
+func (x *AuditMemoVisibilityResponse_Finding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceSetting_GeneralSetting_CustomProfile) ProtoMessage() {}
+func (*AuditMemoVisibilityResponse_Finding) ProtoMessage() {}
 
-func (x *WorkspaceSetting_GeneralSetting_CustomProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
+func (x *AuditMemoVisibilityResponse_Finding) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,68 +6559,71 @@ func (x *WorkspaceSetting_GeneralSetting_CustomProfile) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceSetting_GeneralSetting_CustomProfile.ProtoReflect.Descriptor instead.
-func (*WorkspaceSetting_GeneralSetting_CustomProfile) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 0, 0}
-}
-
-func (x *WorkspaceSetting_GeneralSetting_CustomProfile) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use AuditMemoVisibilityResponse_Finding.ProtoReflect.Descriptor instead.
+func (*AuditMemoVisibilityResponse_Finding) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{15, 0}
 }
 
-func (x *WorkspaceSetting_GeneralSetting_CustomProfile) GetDescription() string {
+func (x *AuditMemoVisibilityResponse_Finding) GetMemo() string {
 	if x != nil {
-		return x.Description
+		return x.Memo
 	}
 	return ""
 }
 
-func (x *WorkspaceSetting_GeneralSetting_CustomProfile) GetLogoUrl() string {
+func (x *AuditMemoVisibilityResponse_Finding) GetCreator() string {
 	if x != nil {
-		return x.LogoUrl
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *WorkspaceSetting_GeneralSetting_CustomProfile) GetLocale() string {
+func (x *AuditMemoVisibilityResponse_Finding) GetReasons() []AuditMemoVisibilityResponse_Reason {
 	if x != nil {
-		return x.Locale
+		return x.Reasons
 	}
-	return ""
+	return nil
 }
 
-// S3 configuration for cloud storage backend.
-// Reference: https://developers.cloudflare.com/r2/examples/aws/aws-sdk-go/
-type WorkspaceSetting_StorageSetting_S3Config struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	AccessKeyId     string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
-	AccessKeySecret string                 `protobuf:"bytes,2,opt,name=access_key_secret,json=accessKeySecret,proto3" json:"access_key_secret,omitempty"`
-	Endpoint        string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Region          string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
-	Bucket          string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	UsePathStyle    bool                   `protobuf:"varint,6,opt,name=use_path_style,json=usePathStyle,proto3" json:"use_path_style,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type RuntimeStats_Memory struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The bytes of allocated heap objects.
+	HeapAllocBytes uint64 `protobuf:"varint,1,opt,name=heap_alloc_bytes,json=heapAllocBytes,proto3" json:"heap_alloc_bytes,omitempty"`
+	// The bytes of heap memory in use, including the free space of the spans in use.
+	HeapInuseBytes uint64 `protobuf:"varint,2,opt,name=heap_inuse_bytes,json=heapInuseBytes,proto3" json:"heap_inuse_bytes,omitempty"`
+	// The bytes of heap memory obtained from the operating system.
+	HeapSysBytes uint64 `protobuf:"varint,3,opt,name=heap_sys_bytes,json=heapSysBytes,proto3" json:"heap_sys_bytes,omitempty"`
+	// The number of allocated heap objects.
+	HeapObjects uint64 `protobuf:"varint,4,opt,name=heap_objects,json=heapObjects,proto3" json:"heap_objects,omitempty"`
+	// The cumulative bytes allocated for heap objects.
+	TotalAllocBytes uint64 `protobuf:"varint,5,opt,name=total_alloc_bytes,json=totalAllocBytes,proto3" json:"total_alloc_bytes,omitempty"`
+	// The total bytes of memory obtained from the operating system.
+	SysBytes uint64 `protobuf:"varint,6,opt,name=sys_bytes,json=sysBytes,proto3" json:"sys_bytes,omitempty"`
+	// The number of completed garbage collections.
+	GcCount uint32 `protobuf:"varint,7,opt,name=gc_count,json=gcCount,proto3" json:"gc_count,omitempty"`
+	// The cumulative time spent in garbage collection pauses.
+	GcPauseTotal *durationpb.Duration `protobuf:"bytes,8,opt,name=gc_pause_total,json=gcPauseTotal,proto3" json:"gc_pause_total,omitempty"`
+	// The time the last garbage collection finished, unset if none ran.
+	LastGcTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_gc_time,json=lastGcTime,proto3" json:"last_gc_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceSetting_StorageSetting_S3Config) Reset() {
-	*x = WorkspaceSetting_StorageSetting_S3Config{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+func (x *RuntimeStats_Memory) Reset() {
+	*x = RuntimeStats_Memory{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceSetting_StorageSetting_S3Config) String() string {
+func (x *RuntimeStats_Memory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceSetting_StorageSetting_S3Config) ProtoMessage() {}
+func (*RuntimeStats_Memory) ProtoMessage() {}
 
-func (x *WorkspaceSetting_StorageSetting_S3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+func (x *RuntimeStats_Memory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,71 +6634,127 @@ func (x *WorkspaceSetting_StorageSetting_S3Config) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceSetting_StorageSetting_S3Config.ProtoReflect.Descriptor instead.
-func (*WorkspaceSetting_StorageSetting_S3Config) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 1, 0}
+// Deprecated: Use RuntimeStats_Memory.ProtoReflect.Descriptor instead.
+func (*RuntimeStats_Memory) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{31, 0}
 }
 
-func (x *WorkspaceSetting_StorageSetting_S3Config) GetAccessKeyId() string {
+func (x *RuntimeStats_Memory) GetHeapAllocBytes() uint64 {
 	if x != nil {
-		return x.AccessKeyId
+		return x.HeapAllocBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *WorkspaceSetting_StorageSetting_S3Config) GetAccessKeySecret() string {
+func (x *RuntimeStats_Memory) GetHeapInuseBytes() uint64 {
 	if x != nil {
-		return x.AccessKeySecret
+		return x.HeapInuseBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *WorkspaceSetting_StorageSetting_S3Config) GetEndpoint() string {
+func (x *RuntimeStats_Memory) GetHeapSysBytes() uint64 {
 	if x != nil {
-		return x.Endpoint
+		return x.HeapSysBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *WorkspaceSetting_StorageSetting_S3Config) GetRegion() string {
+func (x *RuntimeStats_Memory) GetHeapObjects() uint64 {
 	if x != nil {
-		return x.Region
+		return x.HeapObjects
 	}
-	return ""
+	return 0
 }
 
-func (x *WorkspaceSetting_StorageSetting_S3Config) GetBucket() string {
+func (x *RuntimeStats_Memory) GetTotalAllocBytes() uint64 {
 	if x != nil {
-		return x.Bucket
+		return x.TotalAllocBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *WorkspaceSetting_StorageSetting_S3Config) GetUsePathStyle() bool {
+func (x *RuntimeStats_Memory) GetSysBytes() uint64 {
 	if x != nil {
-		return x.UsePathStyle
+		return x.SysBytes
 	}
-	return false
+	return 0
+}
+
+func (x *RuntimeStats_Memory) GetGcCount() uint32 {
+	if x != nil {
+		return x.GcCount
+	}
+	return 0
+}
+
+func (x *RuntimeStats_Memory) GetGcPauseTotal() *durationpb.Duration {
+	if x != nil {
+		return x.GcPauseTotal
+	}
+	return nil
+}
+
+func (x *RuntimeStats_Memory) GetLastGcTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastGcTime
+	}
+	return nil
 }
 
 var File_api_v1_workspace_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1eapi/v1/workspace_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\"y\n" +
+	"\x1eapi/v1/workspace_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x18api/v1/idp_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x96\x01\n" +
 	"\x10WorkspaceProfile\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x12\n" +
 	"\x04mode\x18\x03 \x01(\tR\x04mode\x12!\n" +
-	"\finstance_url\x18\x06 \x01(\tR\vinstanceUrl\"\x1c\n" +
-	"\x1aGetWorkspaceProfileRequest\"\x81\x13\n" +
+	"\finstance_url\x18\x06 \x01(\tR\vinstanceUrl\x12\x1b\n" +
+	"\tread_only\x18\a \x01(\bR\breadOnly\"\x1c\n" +
+	"\x1aGetWorkspaceProfileRequest\"\x98\x06\n" +
+	"\x15WorkspaceCapabilities\x126\n" +
+	"\x02ai\x18\x01 \x01(\v2&.memos.api.v1.WorkspaceCapabilities.AIR\x02ai\x12'\n" +
+	"\x0fsemantic_search\x18\x02 \x01(\bR\x0esemanticSearch\x12N\n" +
+	"\n" +
+	"federation\x18\x03 \x01(\v2..memos.api.v1.WorkspaceCapabilities.FederationR\n" +
+	"federation\x12B\n" +
+	"\x06limits\x18\x04 \x01(\v2*.memos.api.v1.WorkspaceCapabilities.LimitsR\x06limits\x1a\xa7\x02\n" +
+	"\x02AI\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\bfeatures\x18\x02 \x03(\tR\bfeatures\x120\n" +
+	"\x14hourly_request_limit\x18\x03 \x01(\x05R\x12hourlyRequestLimit\x12.\n" +
+	"\x13daily_request_limit\x18\x04 \x01(\x05R\x11dailyRequestLimit\x120\n" +
+	"\x14monthly_token_budget\x18\x05 \x01(\x03R\x12monthlyTokenBudget\x12<\n" +
+	"\x1adata_processing_disclosure\x18\x06 \x01(\tR\x18dataProcessingDisclosure\x12\x19\n" +
+	"\bopted_in\x18\a \x01(\bR\aoptedIn\x1aK\n" +
+	"\n" +
+	"Federation\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
+	"\rcan_subscribe\x18\x02 \x01(\bR\fcanSubscribe\x1a\x92\x01\n" +
+	"\x06Limits\x12.\n" +
+	"\x13memo_content_length\x18\x01 \x01(\x05R\x11memoContentLength\x12*\n" +
+	"\x11upload_size_bytes\x18\x02 \x01(\x03R\x0fuploadSizeBytes\x12,\n" +
+	"\x12request_size_bytes\x18\x03 \x01(\x03R\x10requestSizeBytes\"!\n" +
+	"\x1fGetWorkspaceCapabilitiesRequest\"\xaeL\n" +
 	"\x10WorkspaceSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12X\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2-.memos.api.v1.WorkspaceSetting.GeneralSettingH\x00R\x0egeneralSetting\x12X\n" +
 	"\x0fstorage_setting\x18\x03 \x01(\v2-.memos.api.v1.WorkspaceSetting.StorageSettingH\x00R\x0estorageSetting\x12e\n" +
 	"\x14memo_related_setting\x18\x04 \x01(\v21.memos.api.v1.WorkspaceSetting.MemoRelatedSettingH\x00R\x12memoRelatedSetting\x12I\n" +
 	"\n" +
-	"ai_setting\x18\x05 \x01(\v2(.memos.api.v1.WorkspaceSetting.AISettingH\x00R\taiSetting\x1a\xf9\x04\n" +
+	"ai_setting\x18\x05 \x01(\v2(.memos.api.v1.WorkspaceSetting.AISettingH\x00R\taiSetting\x12a\n" +
+	"\x12onboarding_setting\x18\x06 \x01(\v20.memos.api.v1.WorkspaceSetting.OnboardingSettingH\x00R\x11onboardingSetting\x12i\n" +
+	"\x16new_user_limit_setting\x18\a \x01(\v22.memos.api.v1.WorkspaceSetting.NewUserLimitSettingH\x00R\x13newUserLimitSetting\x12e\n" +
+	"\x14feature_flag_setting\x18\b \x01(\v21.memos.api.v1.WorkspaceSetting.FeatureFlagSettingH\x00R\x12featureFlagSetting\x12b\n" +
+	"\x13usage_limit_setting\x18\t \x01(\v20.memos.api.v1.WorkspaceSetting.UsageLimitSettingH\x00R\x11usageLimitSetting\x12t\n" +
+	"\x19sensitive_content_setting\x18\n" +
+	" \x01(\v26.memos.api.v1.WorkspaceSetting.SensitiveContentSettingH\x00R\x17sensitiveContentSetting\x12k\n" +
+	"\x16outbound_fetch_setting\x18\v \x01(\v23.memos.api.v1.WorkspaceSetting.OutboundFetchSettingH\x00R\x14outboundFetchSetting\x12R\n" +
+	"\rlegal_setting\x18\f \x01(\v2+.memos.api.v1.WorkspaceSetting.LegalSettingH\x00R\flegalSetting\x12[\n" +
+	"\x10alerting_setting\x18\x0e \x01(\v2..memos.api.v1.WorkspaceSetting.AlertingSettingH\x00R\x0falertingSetting\x12\x17\n" +
+	"\x04etag\x18\r \x01(\tB\x03\xe0A\x01R\x04etag\x1a\xf9\x04\n" +
 	"\x0eGeneralSetting\x12\x14\n" +
 	"\x05theme\x18\x01 \x01(\tR\x05theme\x12<\n" +
 	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
@@ -1071,7 +6786,8 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\x18STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bDATABASE\x10\x01\x12\t\n" +
 	"\x05LOCAL\x10\x02\x12\x06\n" +
-	"\x02S3\x10\x03\x1a\xd8\x03\n" +
+	"\x02S3\x10\x03\x1a\xe1\n" +
+	"\n" +
 	"\x12MemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
@@ -1082,19 +6798,206 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\x1adisable_markdown_shortcuts\x18\b \x01(\bR\x18disableMarkdownShortcuts\x127\n" +
 	"\x18enable_blur_nsfw_content\x18\t \x01(\bR\x15enableBlurNsfwContent\x12\x1b\n" +
 	"\tnsfw_tags\x18\n" +
-	" \x03(\tR\bnsfwTags\x1a{\n" +
+	" \x03(\tR\bnsfwTags\x12\x8a\x01\n" +
+	"\x19role_default_visibilities\x18\v \x03(\v2N.memos.api.v1.WorkspaceSetting.MemoRelatedSetting.RoleDefaultVisibilitiesEntryR\x17roleDefaultVisibilities\x125\n" +
+	"\x17cold_storage_after_days\x18\f \x01(\x05R\x14coldStorageAfterDays\x122\n" +
+	"\x15disable_view_tracking\x18\r \x01(\bR\x13disableViewTracking\x12<\n" +
+	"\x1aprotected_visibility_roles\x18\x0e \x03(\tR\x18protectedVisibilityRoles\x12.\n" +
+	"\x13enable_fuzzy_search\x18\x0f \x01(\bR\x11enableFuzzySearch\x124\n" +
+	"\x16approval_required_tags\x18\x10 \x03(\tR\x14approvalRequiredTags\x12b\n" +
+	"\rtag_templates\x18\x11 \x03(\v2=.memos.api.v1.WorkspaceSetting.MemoRelatedSetting.TagTemplateR\ftagTemplates\x12+\n" +
+	"\x11expand_shortcodes\x18\x12 \x01(\bR\x10expandShortcodes\x12a\n" +
+	"\n" +
+	"shortcodes\x18\x13 \x03(\v2A.memos.api.v1.WorkspaceSetting.MemoRelatedSetting.ShortcodesEntryR\n" +
+	"shortcodes\x1aJ\n" +
+	"\x1cRoleDefaultVisibilitiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n" +
+	"\x0fShortcodesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1al\n" +
+	"\vTagTemplate\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1a\n" +
+	"\btemplate\x18\x02 \x01(\tR\btemplate\x12/\n" +
+	"\x13required_properties\x18\x03 \x03(\tR\x12requiredProperties\x1a\xe4\x1c\n" +
 	"\tAISetting\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x17\n" +
 	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x14\n" +
 	"\x05model\x18\x03 \x01(\tR\x05model\x12#\n" +
-	"\rsystem_prompt\x18\x04 \x01(\tR\fsystemPrompt\"h\n" +
+	"\rsystem_prompt\x18\x04 \x01(\tR\fsystemPrompt\x12\x1b\n" +
+	"\ttts_model\x18\x05 \x01(\tR\bttsModel\x12\x1b\n" +
+	"\ttts_voice\x18\x06 \x01(\tR\bttsVoice\x12!\n" +
+	"\ftts_endpoint\x18\a \x01(\tR\vttsEndpoint\x12/\n" +
+	"\x13transcription_model\x18\b \x01(\tR\x12transcriptionModel\x12h\n" +
+	"\x10role_permissions\x18\t \x03(\v2=.memos.api.v1.WorkspaceSetting.AISetting.RolePermissionsEntryR\x0frolePermissions\x128\n" +
+	"\x18disallow_protected_memos\x18\n" +
+	" \x01(\bR\x16disallowProtectedMemos\x12,\n" +
+	"\x12prompt_token_price\x18\v \x01(\x01R\x10promptTokenPrice\x124\n" +
+	"\x16completion_token_price\x18\f \x01(\x01R\x14completionTokenPrice\x12M\n" +
+	"\bprovider\x18\r \x01(\x0e21.memos.api.v1.WorkspaceSetting.AISetting.ProviderR\bprovider\x12\x1f\n" +
+	"\vapi_version\x18\x0e \x01(\tR\n" +
+	"apiVersion\x12P\n" +
+	"\tredaction\x18\x0f \x01(\v22.memos.api.v1.WorkspaceSetting.AISetting.RedactionR\tredaction\x12'\n" +
+	"\x0fembedding_model\x18\x10 \x01(\tR\x0eembeddingModel\x12\x19\n" +
+	"\bauto_tag\x18\x11 \x01(\bR\aautoTag\x12L\n" +
+	"\bprofiles\x18\x12 \x03(\v20.memos.api.v1.WorkspaceSetting.AISetting.ProfileR\bprofiles\x12h\n" +
+	"\x10feature_profiles\x18\x13 \x03(\v2=.memos.api.v1.WorkspaceSetting.AISetting.FeatureProfilesEntryR\x0ffeatureProfiles\x12#\n" +
+	"\rdebug_logging\x18\x14 \x01(\bR\fdebugLogging\x127\n" +
+	"\x18debug_log_retention_days\x18\x15 \x01(\x05R\x15debugLogRetentionDays\x12,\n" +
+	"\x12summary_chunk_size\x18\x16 \x01(\x05R\x10summaryChunkSize\x12,\n" +
+	"\x12summary_max_chunks\x18\x17 \x01(\x05R\x10summaryMaxChunks\x12r\n" +
+	"\x15attachment_extraction\x18\x18 \x01(\v2=.memos.api.v1.WorkspaceSetting.AISetting.AttachmentExtractionR\x14attachmentExtraction\x12#\n" +
+	"\rsummary_model\x18\x19 \x01(\tR\fsummaryModel\x12\x1d\n" +
+	"\n" +
+	"chat_model\x18\x1a \x01(\tR\tchatModel\x12!\n" +
+	"\fvision_model\x18\x1b \x01(\tR\vvisionModel\x12%\n" +
+	"\vtemperature\x18\x1c \x01(\x01H\x00R\vtemperature\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"max_tokens\x18\x1d \x01(\x05R\tmaxTokens\x12A\n" +
+	"\x1dworkspace_summary_daily_limit\x18\x1e \x01(\x05R\x1aworkspaceSummaryDailyLimit\x12e\n" +
+	"\x0fcontext_windows\x18\x1f \x03(\v2<.memos.api.v1.WorkspaceSetting.AISetting.ContextWindowsEntryR\x0econtextWindows\x12,\n" +
+	"\x12summary_min_length\x18  \x01(\x05R\x10summaryMinLength\x12,\n" +
+	"\x12summary_max_length\x18! \x01(\x05R\x10summaryMaxLength\x129\n" +
+	"\x19summary_cache_ttl_minutes\x18\" \x01(\x05R\x16summaryCacheTtlMinutes\x128\n" +
+	"\x18circuit_breaker_failures\x18# \x01(\x05R\x16circuitBreakerFailures\x12G\n" +
+	" circuit_breaker_cooldown_seconds\x18$ \x01(\x05R\x1dcircuitBreakerCooldownSeconds\x126\n" +
+	"\x17max_concurrent_requests\x18% \x01(\x05R\x15maxConcurrentRequests\x12\x1a\n" +
+	"\bdisabled\x18& \x01(\bR\bdisabled\x12<\n" +
+	"\x1adata_processing_disclosure\x18' \x01(\tR\x18dataProcessingDisclosure\x1a\xa8\x03\n" +
+	"\x0eRolePermission\x12'\n" +
+	"\x0fdisable_summary\x18\x01 \x01(\bR\x0edisableSummary\x12%\n" +
+	"\x0edisable_speech\x18\x02 \x01(\bR\rdisableSpeech\x12,\n" +
+	"\x12disable_voice_memo\x18\x03 \x01(\bR\x10disableVoiceMemo\x12!\n" +
+	"\fdisable_chat\x18\x04 \x01(\bR\vdisableChat\x124\n" +
+	"\x16disable_tag_suggestion\x18\x05 \x01(\bR\x14disableTagSuggestion\x120\n" +
+	"\x14hourly_request_limit\x18\x06 \x01(\x05R\x12hourlyRequestLimit\x12.\n" +
+	"\x13daily_request_limit\x18\a \x01(\x05R\x11dailyRequestLimit\x120\n" +
+	"\x14monthly_token_budget\x18\b \x01(\x03R\x12monthlyTokenBudget\x12+\n" +
+	"\x11disable_transform\x18\t \x01(\bR\x10disableTransform\x1a{\n" +
+	"\x14RolePermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12M\n" +
+	"\x05value\x18\x02 \x01(\v27.memos.api.v1.WorkspaceSetting.AISetting.RolePermissionR\x05value:\x028\x01\x1a\xec\x01\n" +
+	"\tRedaction\x12#\n" +
+	"\rredact_emails\x18\x01 \x01(\bR\fredactEmails\x120\n" +
+	"\x14redact_phone_numbers\x18\x02 \x01(\bR\x12redactPhoneNumbers\x12\x1a\n" +
+	"\bpatterns\x18\x03 \x03(\tR\bpatterns\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12%\n" +
+	"\x0eredact_secrets\x18\x05 \x01(\bR\rredactSecrets\x121\n" +
+	"\x14restore_placeholders\x18\x06 \x01(\bR\x13restorePlaceholders\x1a\x99\x03\n" +
+	"\aProfile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12M\n" +
+	"\bprovider\x18\x02 \x01(\x0e21.memos.api.v1.WorkspaceSetting.AISetting.ProviderR\bprovider\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x04 \x01(\tR\x06apiKey\x12\x1f\n" +
+	"\vapi_version\x18\x05 \x01(\tR\n" +
+	"apiVersion\x12\x14\n" +
+	"\x05model\x18\x06 \x01(\tR\x05model\x12'\n" +
+	"\x0fembedding_model\x18\a \x01(\tR\x0eembeddingModel\x12/\n" +
+	"\x13transcription_model\x18\b \x01(\tR\x12transcriptionModel\x12#\n" +
+	"\rsummary_model\x18\t \x01(\tR\fsummaryModel\x12\x1d\n" +
+	"\n" +
+	"chat_model\x18\n" +
+	" \x01(\tR\tchatModel\x12!\n" +
+	"\fvision_model\x18\v \x01(\tR\vvisionModel\x1aB\n" +
+	"\x14FeatureProfilesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xa2\x01\n" +
+	"\x14AttachmentExtraction\x12\x16\n" +
+	"\x06images\x18\x01 \x01(\bR\x06images\x12\x14\n" +
+	"\x05audio\x18\x02 \x01(\bR\x05audio\x120\n" +
+	"\x14include_in_summaries\x18\x03 \x01(\bR\x12includeInSummaries\x12*\n" +
+	"\x11include_in_search\x18\x04 \x01(\bR\x0fincludeInSearch\x1aA\n" +
+	"\x13ContextWindowsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"s\n" +
+	"\bProvider\x12\x18\n" +
+	"\x14PROVIDER_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06OPENAI\x10\x01\x12\x10\n" +
+	"\fAZURE_OPENAI\x10\x02\x12\r\n" +
+	"\tANTHROPIC\x10\x03\x12\n" +
+	"\n" +
+	"\x06OLLAMA\x10\x04\x12\n" +
+	"\n" +
+	"\x06GEMINI\x10\x05\x12\b\n" +
+	"\x04FAKE\x10\x06B\x0e\n" +
+	"\f_temperature\x1a\x8f\x01\n" +
+	"\x11OnboardingSetting\x120\n" +
+	"\x14welcome_memo_content\x18\x01 \x01(\tR\x12welcomeMemoContent\x12%\n" +
+	"\x0etemplate_memos\x18\x02 \x03(\tR\rtemplateMemos\x12!\n" +
+	"\fdefault_tags\x18\x03 \x03(\tR\vdefaultTags\x1a\xc4\x01\n" +
+	"\x13NewUserLimitSetting\x12%\n" +
+	"\x0eprobation_days\x18\x01 \x01(\x05R\rprobationDays\x12\"\n" +
+	"\rmemos_per_day\x18\x02 \x01(\x05R\vmemosPerDay\x12.\n" +
+	"\x13attachments_per_day\x18\x03 \x01(\x05R\x11attachmentsPerDay\x122\n" +
+	"\x15disallow_public_memos\x18\x04 \x01(\bR\x13disallowPublicMemos\x1aV\n" +
+	"\x12FeatureFlagSetting\x12@\n" +
+	"\x05flags\x18\x01 \x03(\v2*.memos.api.v1.WorkspaceSetting.FeatureFlagR\x05flags\x1aj\n" +
+	"\vFeatureFlag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12-\n" +
+	"\x12rollout_percentage\x18\x03 \x01(\x05R\x11rolloutPercentage\x1a\xac\x01\n" +
+	"\x11UsageLimitSetting\x12\x1b\n" +
+	"\tmax_users\x18\x01 \x01(\x05R\bmaxUsers\x12\x1b\n" +
+	"\tmax_memos\x18\x02 \x01(\x05R\bmaxMemos\x12*\n" +
+	"\x11max_storage_bytes\x18\x03 \x01(\x03R\x0fmaxStorageBytes\x121\n" +
+	"\x15max_ai_monthly_tokens\x18\x04 \x01(\x03R\x12maxAiMonthlyTokens\x1a\x89\x02\n" +
+	"\x17SensitiveContentSetting\x12/\n" +
+	"\x13classifier_endpoint\x18\x01 \x01(\tR\x12classifierEndpoint\x12,\n" +
+	"\x12classifier_api_key\x18\x02 \x01(\tR\x10classifierApiKey\x12U\n" +
+	"\x06policy\x18\x03 \x01(\x0e2=.memos.api.v1.WorkspaceSetting.SensitiveContentSetting.PolicyR\x06policy\"8\n" +
+	"\x06Policy\x12\x16\n" +
+	"\x12POLICY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04BLUR\x10\x01\x12\f\n" +
+	"\bRESTRICT\x10\x02\x1a\xb7\x01\n" +
+	"\x14OutboundFetchSetting\x12#\n" +
+	"\rallowed_hosts\x18\x01 \x03(\tR\fallowedHosts\x12#\n" +
+	"\rallowed_ports\x18\x02 \x03(\x05R\fallowedPorts\x12,\n" +
+	"\x12max_response_bytes\x18\x03 \x01(\x03R\x10maxResponseBytes\x12'\n" +
+	"\x0ftimeout_seconds\x18\x04 \x01(\x05R\x0etimeoutSeconds\x1ay\n" +
+	"\fLegalSetting\x12(\n" +
+	"\x10terms_of_service\x18\x01 \x01(\tR\x0etermsOfService\x12%\n" +
+	"\x0eprivacy_policy\x18\x02 \x01(\tR\rprivacyPolicy\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x1a\xd7\x04\n" +
+	"\x0fAlertingSetting\x12W\n" +
+	"\bchannels\x18\x01 \x03(\v2;.memos.api.v1.WorkspaceSetting.AlertingSetting.AlertChannelR\bchannels\x12?\n" +
+	"\x1cdisk_usage_threshold_percent\x18\x02 \x01(\x05R\x19diskUsageThresholdPercent\x1a\xa6\x02\n" +
+	"\fAlertChannel\x12T\n" +
+	"\x04type\x18\x01 \x01(\x0e2@.memos.api.v1.WorkspaceSetting.AlertingSetting.AlertChannel.TypeR\x04type\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12M\n" +
+	"\x04smtp\x18\x03 \x01(\v29.memos.api.v1.WorkspaceSetting.AlertingSetting.SMTPConfigR\x04smtp\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\x04 \x03(\tR\n" +
+	"recipients\"?\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aWEBHOOK\x10\x01\x12\t\n" +
+	"\x05SLACK\x10\x02\x12\t\n" +
+	"\x05EMAIL\x10\x03\x1a\x80\x01\n" +
+	"\n" +
+	"SMTPConfig\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\"\xf4\x01\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\v\n" +
 	"\aSTORAGE\x10\x02\x12\x10\n" +
 	"\fMEMO_RELATED\x10\x03\x12\r\n" +
 	"\tAI_CONFIG\x10\x04\x12\x11\n" +
-	"\rAI_RATE_LIMIT\x10\x05:f\xeaAc\n" +
+	"\rAI_RATE_LIMIT\x10\x05\x12\x0e\n" +
+	"\n" +
+	"ONBOARDING\x10\x06\x12\x12\n" +
+	"\x0eNEW_USER_LIMIT\x10\a\x12\x11\n" +
+	"\rFEATURE_FLAGS\x10\b\x12\x0f\n" +
+	"\vUSAGE_LIMIT\x10\t\x12\x15\n" +
+	"\x11SENSITIVE_CONTENT\x10\n" +
+	"\x12\x12\n" +
+	"\x0eOUTBOUND_FETCH\x10\v\x12\t\n" +
+	"\x05LEGAL\x10\f\x12\f\n" +
+	"\bALERTING\x10\r:f\xeaAc\n" +
 	"\x1eapi.memos.dev/WorkspaceSetting\x12\x1cworkspace/settings/{setting}*\x11workspaceSettings2\x10workspaceSettingB\a\n" +
 	"\x05value\"X\n" +
 	"\x1aGetWorkspaceSettingRequest\x12:\n" +
@@ -1103,11 +7006,295 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\x1dUpdateWorkspaceSettingRequest\x12=\n" +
 	"\asetting\x18\x01 \x01(\v2\x1e.memos.api.v1.WorkspaceSettingB\x03\xe0A\x02R\asetting\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
-	"updateMask2\xe9\x03\n" +
+	"updateMask\"]\n" +
+	"\x1bDowngradePublicMemosRequest\x12#\n" +
+	"\n" +
+	"visibility\x18\x01 \x01(\tB\x03\xe0A\x01R\n" +
+	"visibility\x12\x19\n" +
+	"\x05memos\x18\x02 \x03(\tB\x03\xe0A\x01R\x05memos\"I\n" +
+	"\x1cDowngradePublicMemosResponse\x12)\n" +
+	"\x10downgraded_count\x18\x01 \x01(\x05R\x0fdowngradedCount\"\xc0\x01\n" +
+	"\x19WorkspaceSettingsDocument\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12:\n" +
+	"\bsettings\x18\x02 \x03(\v2\x1e.memos.api.v1.WorkspaceSettingR\bsettings\x12M\n" +
+	"\x12identity_providers\x18\x03 \x03(\v2\x1e.memos.api.v1.IdentityProviderR\x11identityProviders\"l\n" +
+	"\x1eExportWorkspaceSettingsRequest\x12J\n" +
+	"\x06format\x18\x01 \x01(\x0e2-.memos.api.v1.WorkspaceSettingsDocumentFormatB\x03\xe0A\x01R\x06format\"=\n" +
+	"\x1fExportWorkspaceSettingsResponse\x12\x1a\n" +
+	"\bdocument\x18\x01 \x01(\tR\bdocument\"j\n" +
+	"\x1dApplyWorkspaceSettingsRequest\x12\x1f\n" +
+	"\bdocument\x18\x01 \x01(\tB\x03\xe0A\x02R\bdocument\x12(\n" +
+	"\rvalidate_only\x18\x02 \x01(\bB\x03\xe0A\x01R\fvalidateOnly\"\xc7\x01\n" +
+	"\x1eApplyWorkspaceSettingsResponse\x12)\n" +
+	"\x10updated_settings\x18\x01 \x03(\tR\x0fupdatedSettings\x12<\n" +
+	"\x1acreated_identity_providers\x18\x02 \x03(\tR\x18createdIdentityProviders\x12<\n" +
+	"\x1aupdated_identity_providers\x18\x03 \x03(\tR\x18updatedIdentityProviders\"\x1c\n" +
+	"\x1aAuditMemoVisibilityRequest\"\xfb\x02\n" +
+	"\x1bAuditMemoVisibilityResponse\x12M\n" +
+	"\bfindings\x18\x01 \x03(\v21.memos.api.v1.AuditMemoVisibilityResponse.FindingR\bfindings\x12#\n" +
+	"\raudited_count\x18\x02 \x01(\x05R\fauditedCount\x1a\x83\x01\n" +
+	"\aFinding\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12\x18\n" +
+	"\acreator\x18\x02 \x01(\tR\acreator\x12J\n" +
+	"\areasons\x18\x03 \x03(\x0e20.memos.api.v1.AuditMemoVisibilityResponse.ReasonR\areasons\"b\n" +
+	"\x06Reason\x12\x16\n" +
+	"\x12REASON_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05EMAIL\x10\x01\x12\x10\n" +
+	"\fPHONE_NUMBER\x10\x02\x12\n" +
+	"\n" +
+	"\x06SECRET\x10\x03\x12\x17\n" +
+	"\x13DEACTIVATED_CREATOR\x10\x04\"\x82\x01\n" +
+	"\x15BackupDatabaseRequest\x12D\n" +
+	"\n" +
+	"encryption\x18\x01 \x01(\x0e2\x1f.memos.api.v1.ArchiveEncryptionB\x03\xe0A\x01R\n" +
+	"encryption\x12#\n" +
+	"\n" +
+	"passphrase\x18\x02 \x01(\tB\x03\xe0A\x01R\n" +
+	"passphrase\"\x90\x01\n" +
+	"\x16BackupDatabaseResponse\x12\x1a\n" +
+	"\blocation\x18\x01 \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x03R\tsizeBytes\x12;\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\xf8\x03\n" +
+	"\x15MemoPayloadRebuildJob\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12D\n" +
+	"\x05state\x18\x02 \x01(\x0e2).memos.api.v1.MemoPayloadRebuildJob.StateB\x03\xe0A\x03R\x05state\x125\n" +
+	"\x14processed_memo_count\x18\x03 \x01(\x05B\x03\xe0A\x03R\x12processedMemoCount\x121\n" +
+	"\x12updated_memo_count\x18\x04 \x01(\x05B\x03\xe0A\x03R\x10updatedMemoCount\x12/\n" +
+	"\x11failed_memo_count\x18\x05 \x01(\x05B\x03\xe0A\x03R\x0ffailedMemoCount\x12\x19\n" +
+	"\x05error\x18\x06 \x01(\tB\x03\xe0A\x03R\x05error\x12@\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vfinish_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"finishTime\"F\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aRUNNING\x10\x01\x12\r\n" +
+	"\tSUCCEEDED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03\"$\n" +
+	"\"CreateMemoPayloadRebuildJobRequest\"!\n" +
+	"\x1fGetMemoPayloadRebuildJobRequest\"2\n" +
+	"\x17ListFeatureFlagsRequest\x12\x17\n" +
+	"\x04user\x18\x01 \x01(\tB\x03\xe0A\x01R\x04user\"\x9d\x01\n" +
+	"\x18ListFeatureFlagsResponse\x12G\n" +
+	"\x05flags\x18\x01 \x03(\v21.memos.api.v1.ListFeatureFlagsResponse.FlagsEntryR\x05flags\x1a8\n" +
+	"\n" +
+	"FlagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\xf6\x04\n" +
+	"\x06Runner\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tB\x03\xe0A\x03R\vdescription\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x1a\n" +
+	"\bschedule\x18\x04 \x01(\tR\bschedule\x12\x1d\n" +
+	"\arunning\x18\x05 \x01(\bB\x03\xe0A\x03R\arunning\x12H\n" +
+	"\x0elast_run_state\x18\x06 \x01(\x0e2\x1d.memos.api.v1.Runner.RunStateB\x03\xe0A\x03R\flastRunState\x12\"\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tB\x03\xe0A\x03R\tlastError\x12C\n" +
+	"\rlast_run_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vlastRunTime\x12I\n" +
+	"\x10last_finish_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x0elastFinishTime\x12C\n" +
+	"\rnext_run_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vnextRunTime\"M\n" +
+	"\bRunState\x12\x19\n" +
+	"\x15RUN_STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aRUNNING\x10\x01\x12\r\n" +
+	"\tSUCCEEDED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03:E\xeaAB\n" +
+	"\x13memos.api.v1/Runner\x12\x1aworkspace/runners/{runner}*\arunners2\x06runner\"\x1a\n" +
+	"\x18GetWorkspaceUsageRequest\"\xe9\x01\n" +
+	"\x0eWorkspaceUsage\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\x01 \x01(\x05R\tuserCount\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x02 \x01(\x05R\tmemoCount\x12#\n" +
+	"\rstorage_bytes\x18\x03 \x01(\x03R\fstorageBytes\x12*\n" +
+	"\x11ai_monthly_tokens\x18\x04 \x01(\x03R\x0faiMonthlyTokens\x12H\n" +
+	"\x06limits\x18\x05 \x01(\v20.memos.api.v1.WorkspaceSetting.UsageLimitSettingR\x06limits\"\x16\n" +
+	"\x14SendTestAlertRequest\"/\n" +
+	"\x15SendTestAlertResponse\x12\x16\n" +
+	"\x06errors\x18\x01 \x03(\tR\x06errors\"\x9e\x01\n" +
+	"\x16SeedTestFixtureRequest\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\x01 \x01(\x05R\tuserCount\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x02 \x01(\x05R\tmemoCount\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12*\n" +
+	"\x11configure_fake_ai\x18\x04 \x01(\bR\x0fconfigureFakeAi\"E\n" +
+	"\x17SeedTestFixtureResponse\x12\x14\n" +
+	"\x05users\x18\x01 \x03(\tR\x05users\x12\x14\n" +
+	"\x05memos\x18\x02 \x03(\tR\x05memos\"R\n" +
+	"\x16GetRuntimeStatsRequest\x128\n" +
+	"\x18include_goroutine_stacks\x18\x01 \x01(\bR\x16includeGoroutineStacks\"\xe4\x04\n" +
+	"\fRuntimeStats\x12\x1d\n" +
+	"\n" +
+	"go_version\x18\x01 \x01(\tR\tgoVersion\x12\x1b\n" +
+	"\tcpu_count\x18\x02 \x01(\x05R\bcpuCount\x12'\n" +
+	"\x0fgoroutine_count\x18\x03 \x01(\x05R\x0egoroutineCount\x12)\n" +
+	"\x10goroutine_stacks\x18\x04 \x01(\tR\x0fgoroutineStacks\x129\n" +
+	"\x06memory\x18\x05 \x01(\v2!.memos.api.v1.RuntimeStats.MemoryR\x06memory\x1a\x88\x03\n" +
+	"\x06Memory\x12(\n" +
+	"\x10heap_alloc_bytes\x18\x01 \x01(\x04R\x0eheapAllocBytes\x12(\n" +
+	"\x10heap_inuse_bytes\x18\x02 \x01(\x04R\x0eheapInuseBytes\x12$\n" +
+	"\x0eheap_sys_bytes\x18\x03 \x01(\x04R\fheapSysBytes\x12!\n" +
+	"\fheap_objects\x18\x04 \x01(\x04R\vheapObjects\x12*\n" +
+	"\x11total_alloc_bytes\x18\x05 \x01(\x04R\x0ftotalAllocBytes\x12\x1b\n" +
+	"\tsys_bytes\x18\x06 \x01(\x04R\bsysBytes\x12\x19\n" +
+	"\bgc_count\x18\a \x01(\rR\agcCount\x12?\n" +
+	"\x0egc_pause_total\x18\b \x01(\v2\x19.google.protobuf.DurationR\fgcPauseTotal\x12<\n" +
+	"\flast_gc_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastGcTime\"\x14\n" +
+	"\x12ListRunnersRequest\"E\n" +
+	"\x13ListRunnersResponse\x12.\n" +
+	"\arunners\x18\x01 \x03(\v2\x14.memos.api.v1.RunnerR\arunners\"\x8a\x01\n" +
+	"\x13UpdateRunnerRequest\x121\n" +
+	"\x06runner\x18\x01 \x01(\v2\x14.memos.api.v1.RunnerB\x03\xe0A\x02R\x06runner\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"C\n" +
+	"\x10RunRunnerRequest\x12/\n" +
+	"\x04name\x18\x01 \x01(\tB\x1b\xe0A\x02\xfaA\x15\n" +
+	"\x13memos.api.v1/RunnerR\x04name\"\x8c\x04\n" +
+	"\n" +
+	"DeadLetter\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12@\n" +
+	"\bjob_type\x18\x02 \x01(\x0e2 .memos.api.v1.DeadLetter.JobTypeB\x03\xe0A\x03R\ajobType\x12\x17\n" +
+	"\x04user\x18\x03 \x01(\tB\x03\xe0A\x03R\x04user\x12\x1b\n" +
+	"\x06target\x18\x04 \x01(\tB\x03\xe0A\x03R\x06target\x12\x19\n" +
+	"\x05error\x18\x05 \x01(\tB\x03\xe0A\x03R\x05error\x12\x1f\n" +
+	"\battempts\x18\x06 \x01(\x05B\x03\xe0A\x03R\battempts\x12@\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"Q\n" +
+	"\aJobType\x12\x18\n" +
+	"\x14JOB_TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aWEBHOOK\x10\x01\x12\x0e\n" +
+	"\n" +
+	"AI_SUMMARY\x10\x02\x12\x0f\n" +
+	"\vUSER_IMPORT\x10\x03:Z\xeaAW\n" +
+	"\x17memos.api.v1/DeadLetter\x12#workspace/deadLetters/{dead_letter}*\vdeadLetters2\n" +
+	"deadLetter\"\xa0\x01\n" +
+	"\x16ListDeadLettersRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12@\n" +
+	"\bjob_type\x18\x03 \x01(\x0e2 .memos.api.v1.DeadLetter.JobTypeB\x03\xe0A\x01R\ajobType\"~\n" +
+	"\x17ListDeadLettersResponse\x12;\n" +
+	"\fdead_letters\x18\x01 \x03(\v2\x18.memos.api.v1.DeadLetterR\vdeadLetters\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"M\n" +
+	"\x16RetryDeadLetterRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/DeadLetterR\x04name\"N\n" +
+	"\x17DeleteDeadLetterRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/DeadLetterR\x04name\"g\n" +
+	"\"RotateAccessTokenSigningKeyRequest\x12A\n" +
+	"\fgrace_period\x18\x01 \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x01R\vgracePeriod\"^\n" +
+	"#RotateAccessTokenSigningKeyResponse\x127\n" +
+	"\x04keys\x18\x01 \x03(\v2#.memos.api.v1.AccessTokenSigningKeyR\x04keys\"\xbb\x01\n" +
+	"\x15AccessTokenSigningKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vexpire_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12\x18\n" +
+	"\acurrent\x18\x04 \x01(\bR\acurrent\"\x82\x05\n" +
+	"\fAnnouncement\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1d\n" +
+	"\acontent\x18\x02 \x01(\tB\x03\xe0A\x02R\acontent\x12D\n" +
+	"\bseverity\x18\x03 \x01(\x0e2#.memos.api.v1.Announcement.SeverityB\x03\xe0A\x01R\bseverity\x12>\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\tstartTime\x12:\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\aendTime\x12%\n" +
+	"\vdismissible\x18\x06 \x01(\bB\x03\xe0A\x01R\vdismissible\x12\x1d\n" +
+	"\acreator\x18\a \x01(\tB\x03\xe0A\x03R\acreator\x12@\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"I\n" +
+	"\bSeverity\x12\x18\n" +
+	"\x14SEVERITY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04INFO\x10\x01\x12\v\n" +
+	"\aWARNING\x10\x02\x12\f\n" +
+	"\bCRITICAL\x10\x03:c\xeaA`\n" +
+	"\x19memos.api.v1/Announcement\x12&workspace/announcements/{announcement}*\rannouncements2\fannouncement\":\n" +
+	"\x18ListAnnouncementsRequest\x12\x1e\n" +
+	"\bshow_all\x18\x01 \x01(\bB\x03\xe0A\x01R\ashowAll\"]\n" +
+	"\x19ListAnnouncementsResponse\x12@\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x1a.memos.api.v1.AnnouncementR\rannouncements\"`\n" +
+	"\x19CreateAnnouncementRequest\x12C\n" +
+	"\fannouncement\x18\x01 \x01(\v2\x1a.memos.api.v1.AnnouncementB\x03\xe0A\x02R\fannouncement\"\xa2\x01\n" +
+	"\x19UpdateAnnouncementRequest\x12C\n" +
+	"\fannouncement\x18\x01 \x01(\v2\x1a.memos.api.v1.AnnouncementB\x03\xe0A\x02R\fannouncement\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"R\n" +
+	"\x19DeleteAnnouncementRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/AnnouncementR\x04name\"S\n" +
+	"\x1aDismissAnnouncementRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/AnnouncementR\x04name\"\x9f\x04\n" +
+	"\x11MaintenanceWindow\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12>\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\tstartTime\x12:\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\aendTime\x12\x1d\n" +
+	"\amessage\x18\x04 \x01(\tB\x03\xe0A\x01R\amessage\x12@\n" +
+	"\x05state\x18\x05 \x01(\x0e2%.memos.api.v1.MaintenanceWindow.StateB\x03\xe0A\x03R\x05state\x12'\n" +
+	"\fannouncement\x18\x06 \x01(\tB\x03\xe0A\x03R\fannouncement\x12\x1d\n" +
+	"\acreator\x18\a \x01(\tB\x03\xe0A\x03R\acreator\"M\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tSCHEDULED\x10\x01\x12\x0f\n" +
+	"\vIN_PROGRESS\x10\x02\x12\r\n" +
+	"\tCOMPLETED\x10\x03:}\xeaAz\n" +
+	"\x1ememos.api.v1/MaintenanceWindow\x121workspace/maintenanceWindows/{maintenance_window}*\x12maintenanceWindows2\x11maintenanceWindow\"\x1f\n" +
+	"\x1dListMaintenanceWindowsRequest\"r\n" +
+	"\x1eListMaintenanceWindowsResponse\x12P\n" +
+	"\x13maintenance_windows\x18\x01 \x03(\v2\x1f.memos.api.v1.MaintenanceWindowR\x12maintenanceWindows\"u\n" +
+	"\x1eCreateMaintenanceWindowRequest\x12S\n" +
+	"\x12maintenance_window\x18\x01 \x01(\v2\x1f.memos.api.v1.MaintenanceWindowB\x03\xe0A\x02R\x11maintenanceWindow\"\\\n" +
+	"\x1eDeleteMaintenanceWindowRequest\x12:\n" +
+	"\x04name\x18\x01 \x01(\tB&\xe0A\x02\xfaA \n" +
+	"\x1ememos.api.v1/MaintenanceWindowR\x04name*i\n" +
+	"\x1fWorkspaceSettingsDocumentFormat\x122\n" +
+	".WORKSPACE_SETTINGS_DOCUMENT_FORMAT_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04JSON\x10\x01\x12\b\n" +
+	"\x04YAML\x10\x022\x9a%\n" +
 	"\x10WorkspaceService\x12\x82\x01\n" +
-	"\x13GetWorkspaceProfile\x12(.memos.api.v1.GetWorkspaceProfileRequest\x1a\x1e.memos.api.v1.WorkspaceProfile\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/workspace/profile\x12\x93\x01\n" +
+	"\x13GetWorkspaceProfile\x12(.memos.api.v1.GetWorkspaceProfileRequest\x1a\x1e.memos.api.v1.WorkspaceProfile\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/workspace/profile\x12\x96\x01\n" +
+	"\x18GetWorkspaceCapabilities\x12-.memos.api.v1.GetWorkspaceCapabilitiesRequest\x1a#.memos.api.v1.WorkspaceCapabilities\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/workspace/capabilities\x12\x93\x01\n" +
 	"\x13GetWorkspaceSetting\x12(.memos.api.v1.GetWorkspaceSettingRequest\x1a\x1e.memos.api.v1.WorkspaceSetting\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%\x12#/api/v1/{name=workspace/settings/*}\x12\xb9\x01\n" +
-	"\x16UpdateWorkspaceSetting\x12+.memos.api.v1.UpdateWorkspaceSettingRequest\x1a\x1e.memos.api.v1.WorkspaceSetting\"R\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x026:\asetting2+/api/v1/{setting.name=workspace/settings/*}B\xad\x01\n" +
+	"\x16UpdateWorkspaceSetting\x12+.memos.api.v1.UpdateWorkspaceSettingRequest\x1a\x1e.memos.api.v1.WorkspaceSetting\"R\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x026:\asetting2+/api/v1/{setting.name=workspace/settings/*}\x12\xa1\x01\n" +
+	"\x17ExportWorkspaceSettings\x12,.memos.api.v1.ExportWorkspaceSettingsRequest\x1a-.memos.api.v1.ExportWorkspaceSettingsResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/workspace/settings:export\x12\xa0\x01\n" +
+	"\x16ApplyWorkspaceSettings\x12+.memos.api.v1.ApplyWorkspaceSettingsRequest\x1a,.memos.api.v1.ApplyWorkspaceSettingsResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/workspace/settings:apply\x12\xa1\x01\n" +
+	"\x14DowngradePublicMemos\x12).memos.api.v1.DowngradePublicMemosRequest\x1a*.memos.api.v1.DowngradePublicMemosResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/workspace/memos:downgradePublic\x12\x9b\x01\n" +
+	"\x13AuditMemoVisibility\x12(.memos.api.v1.AuditMemoVisibilityRequest\x1a).memos.api.v1.AuditMemoVisibilityResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/workspace/memos:auditVisibility\x12\x89\x01\n" +
+	"\x0eBackupDatabase\x12#.memos.api.v1.BackupDatabaseRequest\x1a$.memos.api.v1.BackupDatabaseResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/workspace/database:backup\x12\xa8\x01\n" +
+	"\x1bCreateMemoPayloadRebuildJob\x120.memos.api.v1.CreateMemoPayloadRebuildJobRequest\x1a#.memos.api.v1.MemoPayloadRebuildJob\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/workspace/memoPayloadRebuildJob\x12\x9f\x01\n" +
+	"\x18GetMemoPayloadRebuildJob\x12-.memos.api.v1.GetMemoPayloadRebuildJobRequest\x1a#.memos.api.v1.MemoPayloadRebuildJob\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/workspace/memoPayloadRebuildJob\x12\x89\x01\n" +
+	"\x10ListFeatureFlags\x12%.memos.api.v1.ListFeatureFlagsRequest\x1a&.memos.api.v1.ListFeatureFlagsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/workspace/featureFlags\x12z\n" +
+	"\x11GetWorkspaceUsage\x12&.memos.api.v1.GetWorkspaceUsageRequest\x1a\x1c.memos.api.v1.WorkspaceUsage\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/workspace/usage\x12\x82\x01\n" +
+	"\rSendTestAlert\x12\".memos.api.v1.SendTestAlertRequest\x1a#.memos.api.v1.SendTestAlertResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/workspace/alerts:test\x12\x8a\x01\n" +
+	"\x0fSeedTestFixture\x12$.memos.api.v1.SeedTestFixtureRequest\x1a%.memos.api.v1.SeedTestFixtureResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/workspace/fixtures:seed\x12{\n" +
+	"\x0fGetRuntimeStats\x12$.memos.api.v1.GetRuntimeStatsRequest\x1a\x1a.memos.api.v1.RuntimeStats\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/workspace/runtimeStats\x12u\n" +
+	"\vListRunners\x12 .memos.api.v1.ListRunnersRequest\x1a!.memos.api.v1.ListRunnersResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/workspace/runners\x12\x97\x01\n" +
+	"\fUpdateRunner\x12!.memos.api.v1.UpdateRunnerRequest\x1a\x14.memos.api.v1.Runner\"N\xdaA\x12runner,update_mask\x82\xd3\xe4\x93\x023:\x06runner2)/api/v1/{runner.name=workspace/runners/*}\x12{\n" +
+	"\tRunRunner\x12\x1e.memos.api.v1.RunRunnerRequest\x1a\x14.memos.api.v1.Runner\"8\xdaA\x04name\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/{name=workspace/runners/*}:run\x12\x85\x01\n" +
+	"\x0fListDeadLetters\x12$.memos.api.v1.ListDeadLettersRequest\x1a%.memos.api.v1.ListDeadLettersResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/workspace/deadLetters\x12\x8f\x01\n" +
+	"\x0fRetryDeadLetter\x12$.memos.api.v1.RetryDeadLetterRequest\x1a\x16.google.protobuf.Empty\">\xdaA\x04name\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/{name=workspace/deadLetters/*}:retry\x12\x88\x01\n" +
+	"\x10DeleteDeadLetter\x12%.memos.api.v1.DeleteDeadLetterRequest\x1a\x16.google.protobuf.Empty\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(*&/api/v1/{name=workspace/deadLetters/*}\x12\xbe\x01\n" +
+	"\x1bRotateAccessTokenSigningKey\x120.memos.api.v1.RotateAccessTokenSigningKeyRequest\x1a1.memos.api.v1.RotateAccessTokenSigningKeyResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//api/v1/workspace/accessTokenSigningKeys:rotate\x12\x8d\x01\n" +
+	"\x11ListAnnouncements\x12&.memos.api.v1.ListAnnouncementsRequest\x1a'.memos.api.v1.ListAnnouncementsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/workspace/announcements\x12\x9f\x01\n" +
+	"\x12CreateAnnouncement\x12'.memos.api.v1.CreateAnnouncementRequest\x1a\x1a.memos.api.v1.Announcement\"D\xdaA\fannouncement\x82\xd3\xe4\x93\x02/:\fannouncement\"\x1f/api/v1/workspace/announcements\x12\xc1\x01\n" +
+	"\x12UpdateAnnouncement\x12'.memos.api.v1.UpdateAnnouncementRequest\x1a\x1a.memos.api.v1.Announcement\"f\xdaA\x18announcement,update_mask\x82\xd3\xe4\x93\x02E:\fannouncement25/api/v1/{announcement.name=workspace/announcements/*}\x12\x8e\x01\n" +
+	"\x12DeleteAnnouncement\x12'.memos.api.v1.DeleteAnnouncementRequest\x1a\x16.google.protobuf.Empty\"7\xdaA\x04name\x82\xd3\xe4\x93\x02**(/api/v1/{name=workspace/announcements/*}\x12\x9b\x01\n" +
+	"\x13DismissAnnouncement\x12(.memos.api.v1.DismissAnnouncementRequest\x1a\x16.google.protobuf.Empty\"B\xdaA\x04name\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/{name=workspace/announcements/*}:dismiss\x12\xa1\x01\n" +
+	"\x16ListMaintenanceWindows\x12+.memos.api.v1.ListMaintenanceWindowsRequest\x1a,.memos.api.v1.ListMaintenanceWindowsResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/workspace/maintenanceWindows\x12\xbf\x01\n" +
+	"\x17CreateMaintenanceWindow\x12,.memos.api.v1.CreateMaintenanceWindowRequest\x1a\x1f.memos.api.v1.MaintenanceWindow\"U\xdaA\x12maintenance_window\x82\xd3\xe4\x93\x02::\x12maintenance_window\"$/api/v1/workspace/maintenanceWindows\x12\x9d\x01\n" +
+	"\x17DeleteMaintenanceWindow\x12,.memos.api.v1.DeleteMaintenanceWindowRequest\x1a\x16.google.protobuf.Empty\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/*-/api/v1/{name=workspace/maintenanceWindows/*}B\xad\x01\n" +
 	"\x10com.memos.api.v1B\x15WorkspaceServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -1122,45 +7309,267 @@ func file_api_v1_workspace_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_workspace_service_proto_rawDescData
 }
 
-var file_api_v1_workspace_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_v1_workspace_service_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_api_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
 var file_api_v1_workspace_service_proto_goTypes = []any{
-	(WorkspaceSetting_Key)(0),                             // 0: memos.api.v1.WorkspaceSetting.Key
-	(WorkspaceSetting_StorageSetting_StorageType)(0),      // 1: memos.api.v1.WorkspaceSetting.StorageSetting.StorageType
-	(*WorkspaceProfile)(nil),                              // 2: memos.api.v1.WorkspaceProfile
-	(*GetWorkspaceProfileRequest)(nil),                    // 3: memos.api.v1.GetWorkspaceProfileRequest
-	(*WorkspaceSetting)(nil),                              // 4: memos.api.v1.WorkspaceSetting
-	(*GetWorkspaceSettingRequest)(nil),                    // 5: memos.api.v1.GetWorkspaceSettingRequest
-	(*UpdateWorkspaceSettingRequest)(nil),                 // 6: memos.api.v1.UpdateWorkspaceSettingRequest
-	(*WorkspaceSetting_GeneralSetting)(nil),               // 7: memos.api.v1.WorkspaceSetting.GeneralSetting
-	(*WorkspaceSetting_StorageSetting)(nil),               // 8: memos.api.v1.WorkspaceSetting.StorageSetting
-	(*WorkspaceSetting_MemoRelatedSetting)(nil),           // 9: memos.api.v1.WorkspaceSetting.MemoRelatedSetting
-	(*WorkspaceSetting_AISetting)(nil),                    // 10: memos.api.v1.WorkspaceSetting.AISetting
-	(*WorkspaceSetting_GeneralSetting_CustomProfile)(nil), // 11: memos.api.v1.WorkspaceSetting.GeneralSetting.CustomProfile
-	(*WorkspaceSetting_StorageSetting_S3Config)(nil),      // 12: memos.api.v1.WorkspaceSetting.StorageSetting.S3Config
-	(*fieldmaskpb.FieldMask)(nil),                         // 13: google.protobuf.FieldMask
+	(WorkspaceSettingsDocumentFormat)(0),                    // 0: memos.api.v1.WorkspaceSettingsDocumentFormat
+	(WorkspaceSetting_Key)(0),                               // 1: memos.api.v1.WorkspaceSetting.Key
+	(WorkspaceSetting_StorageSetting_StorageType)(0),        // 2: memos.api.v1.WorkspaceSetting.StorageSetting.StorageType
+	(WorkspaceSetting_AISetting_Provider)(0),                // 3: memos.api.v1.WorkspaceSetting.AISetting.Provider
+	(WorkspaceSetting_SensitiveContentSetting_Policy)(0),    // 4: memos.api.v1.WorkspaceSetting.SensitiveContentSetting.Policy
+	(WorkspaceSetting_AlertingSetting_AlertChannel_Type)(0), // 5: memos.api.v1.WorkspaceSetting.AlertingSetting.AlertChannel.Type
+	(AuditMemoVisibilityResponse_Reason)(0),                 // 6: memos.api.v1.AuditMemoVisibilityResponse.Reason
+	(MemoPayloadRebuildJob_State)(0),                        // 7: memos.api.v1.MemoPayloadRebuildJob.State
+	(Runner_RunState)(0),                                    // 8: memos.api.v1.Runner.RunState
+	(DeadLetter_JobType)(0),                                 // 9: memos.api.v1.DeadLetter.JobType
+	(Announcement_Severity)(0),                              // 10: memos.api.v1.Announcement.Severity
+	(MaintenanceWindow_State)(0),                            // 11: memos.api.v1.MaintenanceWindow.State
+	(*WorkspaceProfile)(nil),                                // 12: memos.api.v1.WorkspaceProfile
+	(*GetWorkspaceProfileRequest)(nil),                      // 13: memos.api.v1.GetWorkspaceProfileRequest
+	(*WorkspaceCapabilities)(nil),                           // 14: memos.api.v1.WorkspaceCapabilities
+	(*GetWorkspaceCapabilitiesRequest)(nil),                 // 15: memos.api.v1.GetWorkspaceCapabilitiesRequest
+	(*WorkspaceSetting)(nil),                                // 16: memos.api.v1.WorkspaceSetting
+	(*GetWorkspaceSettingRequest)(nil),                      // 17: memos.api.v1.GetWorkspaceSettingRequest
+	(*UpdateWorkspaceSettingRequest)(nil),                   // 18: memos.api.v1.UpdateWorkspaceSettingRequest
+	(*DowngradePublicMemosRequest)(nil),                     // 19: memos.api.v1.DowngradePublicMemosRequest
+	(*DowngradePublicMemosResponse)(nil),                    // 20: memos.api.v1.DowngradePublicMemosResponse
+	(*WorkspaceSettingsDocument)(nil),                       // 21: memos.api.v1.WorkspaceSettingsDocument
+	(*ExportWorkspaceSettingsRequest)(nil),                  // 22: memos.api.v1.ExportWorkspaceSettingsRequest
+	(*ExportWorkspaceSettingsResponse)(nil),                 // 23: memos.api.v1.ExportWorkspaceSettingsResponse
+	(*ApplyWorkspaceSettingsRequest)(nil),                   // 24: memos.api.v1.ApplyWorkspaceSettingsRequest
+	(*ApplyWorkspaceSettingsResponse)(nil),                  // 25: memos.api.v1.ApplyWorkspaceSettingsResponse
+	(*AuditMemoVisibilityRequest)(nil),                      // 26: memos.api.v1.AuditMemoVisibilityRequest
+	(*AuditMemoVisibilityResponse)(nil),                     // 27: memos.api.v1.AuditMemoVisibilityResponse
+	(*BackupDatabaseRequest)(nil),                           // 28: memos.api.v1.BackupDatabaseRequest
+	(*BackupDatabaseResponse)(nil),                          // 29: memos.api.v1.BackupDatabaseResponse
+	(*MemoPayloadRebuildJob)(nil),                           // 30: memos.api.v1.MemoPayloadRebuildJob
+	(*CreateMemoPayloadRebuildJobRequest)(nil),              // 31: memos.api.v1.CreateMemoPayloadRebuildJobRequest
+	(*GetMemoPayloadRebuildJobRequest)(nil),                 // 32: memos.api.v1.GetMemoPayloadRebuildJobRequest
+	(*ListFeatureFlagsRequest)(nil),                         // 33: memos.api.v1.ListFeatureFlagsRequest
+	(*ListFeatureFlagsResponse)(nil),                        // 34: memos.api.v1.ListFeatureFlagsResponse
+	(*Runner)(nil),                                          // 35: memos.api.v1.Runner
+	(*GetWorkspaceUsageRequest)(nil),                        // 36: memos.api.v1.GetWorkspaceUsageRequest
+	(*WorkspaceUsage)(nil),                                  // 37: memos.api.v1.WorkspaceUsage
+	(*SendTestAlertRequest)(nil),                            // 38: memos.api.v1.SendTestAlertRequest
+	(*SendTestAlertResponse)(nil),                           // 39: memos.api.v1.SendTestAlertResponse
+	(*SeedTestFixtureRequest)(nil),                          // 40: memos.api.v1.SeedTestFixtureRequest
+	(*SeedTestFixtureResponse)(nil),                         // 41: memos.api.v1.SeedTestFixtureResponse
+	(*GetRuntimeStatsRequest)(nil),                          // 42: memos.api.v1.GetRuntimeStatsRequest
+	(*RuntimeStats)(nil),                                    // 43: memos.api.v1.RuntimeStats
+	(*ListRunnersRequest)(nil),                              // 44: memos.api.v1.ListRunnersRequest
+	(*ListRunnersResponse)(nil),                             // 45: memos.api.v1.ListRunnersResponse
+	(*UpdateRunnerRequest)(nil),                             // 46: memos.api.v1.UpdateRunnerRequest
+	(*RunRunnerRequest)(nil),                                // 47: memos.api.v1.RunRunnerRequest
+	(*DeadLetter)(nil),                                      // 48: memos.api.v1.DeadLetter
+	(*ListDeadLettersRequest)(nil),                          // 49: memos.api.v1.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),                         // 50: memos.api.v1.ListDeadLettersResponse
+	(*RetryDeadLetterRequest)(nil),                          // 51: memos.api.v1.RetryDeadLetterRequest
+	(*DeleteDeadLetterRequest)(nil),                         // 52: memos.api.v1.DeleteDeadLetterRequest
+	(*RotateAccessTokenSigningKeyRequest)(nil),              // 53: memos.api.v1.RotateAccessTokenSigningKeyRequest
+	(*RotateAccessTokenSigningKeyResponse)(nil),             // 54: memos.api.v1.RotateAccessTokenSigningKeyResponse
+	(*AccessTokenSigningKey)(nil),                           // 55: memos.api.v1.AccessTokenSigningKey
+	(*Announcement)(nil),                                    // 56: memos.api.v1.Announcement
+	(*ListAnnouncementsRequest)(nil),                        // 57: memos.api.v1.ListAnnouncementsRequest
+	(*ListAnnouncementsResponse)(nil),                       // 58: memos.api.v1.ListAnnouncementsResponse
+	(*CreateAnnouncementRequest)(nil),                       // 59: memos.api.v1.CreateAnnouncementRequest
+	(*UpdateAnnouncementRequest)(nil),                       // 60: memos.api.v1.UpdateAnnouncementRequest
+	(*DeleteAnnouncementRequest)(nil),                       // 61: memos.api.v1.DeleteAnnouncementRequest
+	(*DismissAnnouncementRequest)(nil),                      // 62: memos.api.v1.DismissAnnouncementRequest
+	(*MaintenanceWindow)(nil),                               // 63: memos.api.v1.MaintenanceWindow
+	(*ListMaintenanceWindowsRequest)(nil),                   // 64: memos.api.v1.ListMaintenanceWindowsRequest
+	(*ListMaintenanceWindowsResponse)(nil),                  // 65: memos.api.v1.ListMaintenanceWindowsResponse
+	(*CreateMaintenanceWindowRequest)(nil),                  // 66: memos.api.v1.CreateMaintenanceWindowRequest
+	(*DeleteMaintenanceWindowRequest)(nil),                  // 67: memos.api.v1.DeleteMaintenanceWindowRequest
+	(*WorkspaceCapabilities_AI)(nil),                        // 68: memos.api.v1.WorkspaceCapabilities.AI
+	(*WorkspaceCapabilities_Federation)(nil),                // 69: memos.api.v1.WorkspaceCapabilities.Federation
+	(*WorkspaceCapabilities_Limits)(nil),                    // 70: memos.api.v1.WorkspaceCapabilities.Limits
+	(*WorkspaceSetting_GeneralSetting)(nil),                 // 71: memos.api.v1.WorkspaceSetting.GeneralSetting
+	(*WorkspaceSetting_StorageSetting)(nil),                 // 72: memos.api.v1.WorkspaceSetting.StorageSetting
+	(*WorkspaceSetting_MemoRelatedSetting)(nil),             // 73: memos.api.v1.WorkspaceSetting.MemoRelatedSetting
+	(*WorkspaceSetting_AISetting)(nil),                      // 74: memos.api.v1.WorkspaceSetting.AISetting
+	(*WorkspaceSetting_OnboardingSetting)(nil),              // 75: memos.api.v1.WorkspaceSetting.OnboardingSetting
+	(*WorkspaceSetting_NewUserLimitSetting)(nil),            // 76: memos.api.v1.WorkspaceSetting.NewUserLimitSetting
+	(*WorkspaceSetting_FeatureFlagSetting)(nil),             // 77: memos.api.v1.WorkspaceSetting.FeatureFlagSetting
+	(*WorkspaceSetting_FeatureFlag)(nil),                    // 78: memos.api.v1.WorkspaceSetting.FeatureFlag
+	(*WorkspaceSetting_UsageLimitSetting)(nil),              // 79: memos.api.v1.WorkspaceSetting.UsageLimitSetting
+	(*WorkspaceSetting_SensitiveContentSetting)(nil),        // 80: memos.api.v1.WorkspaceSetting.SensitiveContentSetting
+	(*WorkspaceSetting_OutboundFetchSetting)(nil),           // 81: memos.api.v1.WorkspaceSetting.OutboundFetchSetting
+	(*WorkspaceSetting_LegalSetting)(nil),                   // 82: memos.api.v1.WorkspaceSetting.LegalSetting
+	(*WorkspaceSetting_AlertingSetting)(nil),                // 83: memos.api.v1.WorkspaceSetting.AlertingSetting
+	(*WorkspaceSetting_GeneralSetting_CustomProfile)(nil),   // 84: memos.api.v1.WorkspaceSetting.GeneralSetting.CustomProfile
+	(*WorkspaceSetting_StorageSetting_S3Config)(nil),        // 85: memos.api.v1.WorkspaceSetting.StorageSetting.S3Config
+	nil, // 86: memos.api.v1.WorkspaceSetting.MemoRelatedSetting.RoleDefaultVisibilitiesEntry
+	nil, // 87: memos.api.v1.WorkspaceSetting.MemoRelatedSetting.ShortcodesEntry
+	(*WorkspaceSetting_MemoRelatedSetting_TagTemplate)(nil), // 88: memos.api.v1.WorkspaceSetting.MemoRelatedSetting.TagTemplate
+	(*WorkspaceSetting_AISetting_RolePermission)(nil),       // 89: memos.api.v1.WorkspaceSetting.AISetting.RolePermission
+	nil, // 90: memos.api.v1.WorkspaceSetting.AISetting.RolePermissionsEntry
+	(*WorkspaceSetting_AISetting_Redaction)(nil), // 91: memos.api.v1.WorkspaceSetting.AISetting.Redaction
+	(*WorkspaceSetting_AISetting_Profile)(nil),   // 92: memos.api.v1.WorkspaceSetting.AISetting.Profile
+	nil, // 93: memos.api.v1.WorkspaceSetting.AISetting.FeatureProfilesEntry
+	(*WorkspaceSetting_AISetting_AttachmentExtraction)(nil), // 94: memos.api.v1.WorkspaceSetting.AISetting.AttachmentExtraction
+	nil, // 95: memos.api.v1.WorkspaceSetting.AISetting.ContextWindowsEntry
+	(*WorkspaceSetting_AlertingSetting_AlertChannel)(nil), // 96: memos.api.v1.WorkspaceSetting.AlertingSetting.AlertChannel
+	(*WorkspaceSetting_AlertingSetting_SMTPConfig)(nil),   // 97: memos.api.v1.WorkspaceSetting.AlertingSetting.SMTPConfig
+	(*AuditMemoVisibilityResponse_Finding)(nil),           // 98: memos.api.v1.AuditMemoVisibilityResponse.Finding
+	nil,                           // 99: memos.api.v1.ListFeatureFlagsResponse.FlagsEntry
+	(*RuntimeStats_Memory)(nil),   // 100: memos.api.v1.RuntimeStats.Memory
+	(*fieldmaskpb.FieldMask)(nil), // 101: google.protobuf.FieldMask
+	(*IdentityProvider)(nil),      // 102: memos.api.v1.IdentityProvider
+	(ArchiveEncryption)(0),        // 103: memos.api.v1.ArchiveEncryption
+	(*timestamppb.Timestamp)(nil), // 104: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 105: google.protobuf.Duration
+	(*emptypb.Empty)(nil),         // 106: google.protobuf.Empty
 }
 var file_api_v1_workspace_service_proto_depIdxs = []int32{
-	7,  // 0: memos.api.v1.WorkspaceSetting.general_setting:type_name -> memos.api.v1.WorkspaceSetting.GeneralSetting
-	8,  // 1: memos.api.v1.WorkspaceSetting.storage_setting:type_name -> memos.api.v1.WorkspaceSetting.StorageSetting
-	9,  // 2: memos.api.v1.WorkspaceSetting.memo_related_setting:type_name -> memos.api.v1.WorkspaceSetting.MemoRelatedSetting
-	10, // 3: memos.api.v1.WorkspaceSetting.ai_setting:type_name -> memos.api.v1.WorkspaceSetting.AISetting
-	4,  // 4: memos.api.v1.UpdateWorkspaceSettingRequest.setting:type_name -> memos.api.v1.WorkspaceSetting
-	13, // 5: memos.api.v1.UpdateWorkspaceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	11, // 6: memos.api.v1.WorkspaceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.WorkspaceSetting.GeneralSetting.CustomProfile
-	1,  // 7: memos.api.v1.WorkspaceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.WorkspaceSetting.StorageSetting.StorageType
-	12, // 8: memos.api.v1.WorkspaceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.WorkspaceSetting.StorageSetting.S3Config
-	3,  // 9: memos.api.v1.WorkspaceService.GetWorkspaceProfile:input_type -> memos.api.v1.GetWorkspaceProfileRequest
-	5,  // 10: memos.api.v1.WorkspaceService.GetWorkspaceSetting:input_type -> memos.api.v1.GetWorkspaceSettingRequest
-	6,  // 11: memos.api.v1.WorkspaceService.UpdateWorkspaceSetting:input_type -> memos.api.v1.UpdateWorkspaceSettingRequest
-	2,  // 12: memos.api.v1.WorkspaceService.GetWorkspaceProfile:output_type -> memos.api.v1.WorkspaceProfile
-	4,  // 13: memos.api.v1.WorkspaceService.GetWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
-	4,  // 14: memos.api.v1.WorkspaceService.UpdateWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
-	12, // [12:15] is the sub-list for method output_type
-	9,  // [9:12] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	68,  // 0: memos.api.v1.WorkspaceCapabilities.ai:type_name -> memos.api.v1.WorkspaceCapabilities.AI
+	69,  // 1: memos.api.v1.WorkspaceCapabilities.federation:type_name -> memos.api.v1.WorkspaceCapabilities.Federation
+	70,  // 2: memos.api.v1.WorkspaceCapabilities.limits:type_name -> memos.api.v1.WorkspaceCapabilities.Limits
+	71,  // 3: memos.api.v1.WorkspaceSetting.general_setting:type_name -> memos.api.v1.WorkspaceSetting.GeneralSetting
+	72,  // 4: memos.api.v1.WorkspaceSetting.storage_setting:type_name -> memos.api.v1.WorkspaceSetting.StorageSetting
+	73,  // 5: memos.api.v1.WorkspaceSetting.memo_related_setting:type_name -> memos.api.v1.WorkspaceSetting.MemoRelatedSetting
+	74,  // 6: memos.api.v1.WorkspaceSetting.ai_setting:type_name -> memos.api.v1.WorkspaceSetting.AISetting
+	75,  // 7: memos.api.v1.WorkspaceSetting.onboarding_setting:type_name -> memos.api.v1.WorkspaceSetting.OnboardingSetting
+	76,  // 8: memos.api.v1.WorkspaceSetting.new_user_limit_setting:type_name -> memos.api.v1.WorkspaceSetting.NewUserLimitSetting
+	77,  // 9: memos.api.v1.WorkspaceSetting.feature_flag_setting:type_name -> memos.api.v1.WorkspaceSetting.FeatureFlagSetting
+	79,  // 10: memos.api.v1.WorkspaceSetting.usage_limit_setting:type_name -> memos.api.v1.WorkspaceSetting.UsageLimitSetting
+	80,  // 11: memos.api.v1.WorkspaceSetting.sensitive_content_setting:type_name -> memos.api.v1.WorkspaceSetting.SensitiveContentSetting
+	81,  // 12: memos.api.v1.WorkspaceSetting.outbound_fetch_setting:type_name -> memos.api.v1.WorkspaceSetting.OutboundFetchSetting
+	82,  // 13: memos.api.v1.WorkspaceSetting.legal_setting:type_name -> memos.api.v1.WorkspaceSetting.LegalSetting
+	83,  // 14: memos.api.v1.WorkspaceSetting.alerting_setting:type_name -> memos.api.v1.WorkspaceSetting.AlertingSetting
+	16,  // 15: memos.api.v1.UpdateWorkspaceSettingRequest.setting:type_name -> memos.api.v1.WorkspaceSetting
+	101, // 16: memos.api.v1.UpdateWorkspaceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 17: memos.api.v1.WorkspaceSettingsDocument.settings:type_name -> memos.api.v1.WorkspaceSetting
+	102, // 18: memos.api.v1.WorkspaceSettingsDocument.identity_providers:type_name -> memos.api.v1.IdentityProvider
+	0,   // 19: memos.api.v1.ExportWorkspaceSettingsRequest.format:type_name -> memos.api.v1.WorkspaceSettingsDocumentFormat
+	98,  // 20: memos.api.v1.AuditMemoVisibilityResponse.findings:type_name -> memos.api.v1.AuditMemoVisibilityResponse.Finding
+	103, // 21: memos.api.v1.BackupDatabaseRequest.encryption:type_name -> memos.api.v1.ArchiveEncryption
+	104, // 22: memos.api.v1.BackupDatabaseResponse.create_time:type_name -> google.protobuf.Timestamp
+	7,   // 23: memos.api.v1.MemoPayloadRebuildJob.state:type_name -> memos.api.v1.MemoPayloadRebuildJob.State
+	104, // 24: memos.api.v1.MemoPayloadRebuildJob.create_time:type_name -> google.protobuf.Timestamp
+	104, // 25: memos.api.v1.MemoPayloadRebuildJob.finish_time:type_name -> google.protobuf.Timestamp
+	99,  // 26: memos.api.v1.ListFeatureFlagsResponse.flags:type_name -> memos.api.v1.ListFeatureFlagsResponse.FlagsEntry
+	8,   // 27: memos.api.v1.Runner.last_run_state:type_name -> memos.api.v1.Runner.RunState
+	104, // 28: memos.api.v1.Runner.last_run_time:type_name -> google.protobuf.Timestamp
+	104, // 29: memos.api.v1.Runner.last_finish_time:type_name -> google.protobuf.Timestamp
+	104, // 30: memos.api.v1.Runner.next_run_time:type_name -> google.protobuf.Timestamp
+	79,  // 31: memos.api.v1.WorkspaceUsage.limits:type_name -> memos.api.v1.WorkspaceSetting.UsageLimitSetting
+	100, // 32: memos.api.v1.RuntimeStats.memory:type_name -> memos.api.v1.RuntimeStats.Memory
+	35,  // 33: memos.api.v1.ListRunnersResponse.runners:type_name -> memos.api.v1.Runner
+	35,  // 34: memos.api.v1.UpdateRunnerRequest.runner:type_name -> memos.api.v1.Runner
+	101, // 35: memos.api.v1.UpdateRunnerRequest.update_mask:type_name -> google.protobuf.FieldMask
+	9,   // 36: memos.api.v1.DeadLetter.job_type:type_name -> memos.api.v1.DeadLetter.JobType
+	104, // 37: memos.api.v1.DeadLetter.create_time:type_name -> google.protobuf.Timestamp
+	104, // 38: memos.api.v1.DeadLetter.update_time:type_name -> google.protobuf.Timestamp
+	9,   // 39: memos.api.v1.ListDeadLettersRequest.job_type:type_name -> memos.api.v1.DeadLetter.JobType
+	48,  // 40: memos.api.v1.ListDeadLettersResponse.dead_letters:type_name -> memos.api.v1.DeadLetter
+	105, // 41: memos.api.v1.RotateAccessTokenSigningKeyRequest.grace_period:type_name -> google.protobuf.Duration
+	55,  // 42: memos.api.v1.RotateAccessTokenSigningKeyResponse.keys:type_name -> memos.api.v1.AccessTokenSigningKey
+	104, // 43: memos.api.v1.AccessTokenSigningKey.create_time:type_name -> google.protobuf.Timestamp
+	104, // 44: memos.api.v1.AccessTokenSigningKey.expire_time:type_name -> google.protobuf.Timestamp
+	10,  // 45: memos.api.v1.Announcement.severity:type_name -> memos.api.v1.Announcement.Severity
+	104, // 46: memos.api.v1.Announcement.start_time:type_name -> google.protobuf.Timestamp
+	104, // 47: memos.api.v1.Announcement.end_time:type_name -> google.protobuf.Timestamp
+	104, // 48: memos.api.v1.Announcement.create_time:type_name -> google.protobuf.Timestamp
+	104, // 49: memos.api.v1.Announcement.update_time:type_name -> google.protobuf.Timestamp
+	56,  // 50: memos.api.v1.ListAnnouncementsResponse.announcements:type_name -> memos.api.v1.Announcement
+	56,  // 51: memos.api.v1.CreateAnnouncementRequest.announcement:type_name -> memos.api.v1.Announcement
+	56,  // 52: memos.api.v1.UpdateAnnouncementRequest.announcement:type_name -> memos.api.v1.Announcement
+	101, // 53: memos.api.v1.UpdateAnnouncementRequest.update_mask:type_name -> google.protobuf.FieldMask
+	104, // 54: memos.api.v1.MaintenanceWindow.start_time:type_name -> google.protobuf.Timestamp
+	104, // 55: memos.api.v1.MaintenanceWindow.end_time:type_name -> google.protobuf.Timestamp
+	11,  // 56: memos.api.v1.MaintenanceWindow.state:type_name -> memos.api.v1.MaintenanceWindow.State
+	63,  // 57: memos.api.v1.ListMaintenanceWindowsResponse.maintenance_windows:type_name -> memos.api.v1.MaintenanceWindow
+	63,  // 58: memos.api.v1.CreateMaintenanceWindowRequest.maintenance_window:type_name -> memos.api.v1.MaintenanceWindow
+	84,  // 59: memos.api.v1.WorkspaceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.WorkspaceSetting.GeneralSetting.CustomProfile
+	2,   // 60: memos.api.v1.WorkspaceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.WorkspaceSetting.StorageSetting.StorageType
+	85,  // 61: memos.api.v1.WorkspaceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.WorkspaceSetting.StorageSetting.S3Config
+	86,  // 62: memos.api.v1.WorkspaceSetting.MemoRelatedSetting.role_default_visibilities:type_name -> memos.api.v1.WorkspaceSetting.MemoRelatedSetting.RoleDefaultVisibilitiesEntry
+	88,  // 63: memos.api.v1.WorkspaceSetting.MemoRelatedSetting.tag_templates:type_name -> memos.api.v1.WorkspaceSetting.MemoRelatedSetting.TagTemplate
+	87,  // 64: memos.api.v1.WorkspaceSetting.MemoRelatedSetting.shortcodes:type_name -> memos.api.v1.WorkspaceSetting.MemoRelatedSetting.ShortcodesEntry
+	90,  // 65: memos.api.v1.WorkspaceSetting.AISetting.role_permissions:type_name -> memos.api.v1.WorkspaceSetting.AISetting.RolePermissionsEntry
+	3,   // 66: memos.api.v1.WorkspaceSetting.AISetting.provider:type_name -> memos.api.v1.WorkspaceSetting.AISetting.Provider
+	91,  // 67: memos.api.v1.WorkspaceSetting.AISetting.redaction:type_name -> memos.api.v1.WorkspaceSetting.AISetting.Redaction
+	92,  // 68: memos.api.v1.WorkspaceSetting.AISetting.profiles:type_name -> memos.api.v1.WorkspaceSetting.AISetting.Profile
+	93,  // 69: memos.api.v1.WorkspaceSetting.AISetting.feature_profiles:type_name -> memos.api.v1.WorkspaceSetting.AISetting.FeatureProfilesEntry
+	94,  // 70: memos.api.v1.WorkspaceSetting.AISetting.attachment_extraction:type_name -> memos.api.v1.WorkspaceSetting.AISetting.AttachmentExtraction
+	95,  // 71: memos.api.v1.WorkspaceSetting.AISetting.context_windows:type_name -> memos.api.v1.WorkspaceSetting.AISetting.ContextWindowsEntry
+	78,  // 72: memos.api.v1.WorkspaceSetting.FeatureFlagSetting.flags:type_name -> memos.api.v1.WorkspaceSetting.FeatureFlag
+	4,   // 73: memos.api.v1.WorkspaceSetting.SensitiveContentSetting.policy:type_name -> memos.api.v1.WorkspaceSetting.SensitiveContentSetting.Policy
+	96,  // 74: memos.api.v1.WorkspaceSetting.AlertingSetting.channels:type_name -> memos.api.v1.WorkspaceSetting.AlertingSetting.AlertChannel
+	89,  // 75: memos.api.v1.WorkspaceSetting.AISetting.RolePermissionsEntry.value:type_name -> memos.api.v1.WorkspaceSetting.AISetting.RolePermission
+	3,   // 76: memos.api.v1.WorkspaceSetting.AISetting.Profile.provider:type_name -> memos.api.v1.WorkspaceSetting.AISetting.Provider
+	5,   // 77: memos.api.v1.WorkspaceSetting.AlertingSetting.AlertChannel.type:type_name -> memos.api.v1.WorkspaceSetting.AlertingSetting.AlertChannel.Type
+	97,  // 78: memos.api.v1.WorkspaceSetting.AlertingSetting.AlertChannel.smtp:type_name -> memos.api.v1.WorkspaceSetting.AlertingSetting.SMTPConfig
+	6,   // 79: memos.api.v1.AuditMemoVisibilityResponse.Finding.reasons:type_name -> memos.api.v1.AuditMemoVisibilityResponse.Reason
+	105, // 80: memos.api.v1.RuntimeStats.Memory.gc_pause_total:type_name -> google.protobuf.Duration
+	104, // 81: memos.api.v1.RuntimeStats.Memory.last_gc_time:type_name -> google.protobuf.Timestamp
+	13,  // 82: memos.api.v1.WorkspaceService.GetWorkspaceProfile:input_type -> memos.api.v1.GetWorkspaceProfileRequest
+	15,  // 83: memos.api.v1.WorkspaceService.GetWorkspaceCapabilities:input_type -> memos.api.v1.GetWorkspaceCapabilitiesRequest
+	17,  // 84: memos.api.v1.WorkspaceService.GetWorkspaceSetting:input_type -> memos.api.v1.GetWorkspaceSettingRequest
+	18,  // 85: memos.api.v1.WorkspaceService.UpdateWorkspaceSetting:input_type -> memos.api.v1.UpdateWorkspaceSettingRequest
+	22,  // 86: memos.api.v1.WorkspaceService.ExportWorkspaceSettings:input_type -> memos.api.v1.ExportWorkspaceSettingsRequest
+	24,  // 87: memos.api.v1.WorkspaceService.ApplyWorkspaceSettings:input_type -> memos.api.v1.ApplyWorkspaceSettingsRequest
+	19,  // 88: memos.api.v1.WorkspaceService.DowngradePublicMemos:input_type -> memos.api.v1.DowngradePublicMemosRequest
+	26,  // 89: memos.api.v1.WorkspaceService.AuditMemoVisibility:input_type -> memos.api.v1.AuditMemoVisibilityRequest
+	28,  // 90: memos.api.v1.WorkspaceService.BackupDatabase:input_type -> memos.api.v1.BackupDatabaseRequest
+	31,  // 91: memos.api.v1.WorkspaceService.CreateMemoPayloadRebuildJob:input_type -> memos.api.v1.CreateMemoPayloadRebuildJobRequest
+	32,  // 92: memos.api.v1.WorkspaceService.GetMemoPayloadRebuildJob:input_type -> memos.api.v1.GetMemoPayloadRebuildJobRequest
+	33,  // 93: memos.api.v1.WorkspaceService.ListFeatureFlags:input_type -> memos.api.v1.ListFeatureFlagsRequest
+	36,  // 94: memos.api.v1.WorkspaceService.GetWorkspaceUsage:input_type -> memos.api.v1.GetWorkspaceUsageRequest
+	38,  // 95: memos.api.v1.WorkspaceService.SendTestAlert:input_type -> memos.api.v1.SendTestAlertRequest
+	40,  // 96: memos.api.v1.WorkspaceService.SeedTestFixture:input_type -> memos.api.v1.SeedTestFixtureRequest
+	42,  // 97: memos.api.v1.WorkspaceService.GetRuntimeStats:input_type -> memos.api.v1.GetRuntimeStatsRequest
+	44,  // 98: memos.api.v1.WorkspaceService.ListRunners:input_type -> memos.api.v1.ListRunnersRequest
+	46,  // 99: memos.api.v1.WorkspaceService.UpdateRunner:input_type -> memos.api.v1.UpdateRunnerRequest
+	47,  // 100: memos.api.v1.WorkspaceService.RunRunner:input_type -> memos.api.v1.RunRunnerRequest
+	49,  // 101: memos.api.v1.WorkspaceService.ListDeadLetters:input_type -> memos.api.v1.ListDeadLettersRequest
+	51,  // 102: memos.api.v1.WorkspaceService.RetryDeadLetter:input_type -> memos.api.v1.RetryDeadLetterRequest
+	52,  // 103: memos.api.v1.WorkspaceService.DeleteDeadLetter:input_type -> memos.api.v1.DeleteDeadLetterRequest
+	53,  // 104: memos.api.v1.WorkspaceService.RotateAccessTokenSigningKey:input_type -> memos.api.v1.RotateAccessTokenSigningKeyRequest
+	57,  // 105: memos.api.v1.WorkspaceService.ListAnnouncements:input_type -> memos.api.v1.ListAnnouncementsRequest
+	59,  // 106: memos.api.v1.WorkspaceService.CreateAnnouncement:input_type -> memos.api.v1.CreateAnnouncementRequest
+	60,  // 107: memos.api.v1.WorkspaceService.UpdateAnnouncement:input_type -> memos.api.v1.UpdateAnnouncementRequest
+	61,  // 108: memos.api.v1.WorkspaceService.DeleteAnnouncement:input_type -> memos.api.v1.DeleteAnnouncementRequest
+	62,  // 109: memos.api.v1.WorkspaceService.DismissAnnouncement:input_type -> memos.api.v1.DismissAnnouncementRequest
+	64,  // 110: memos.api.v1.WorkspaceService.ListMaintenanceWindows:input_type -> memos.api.v1.ListMaintenanceWindowsRequest
+	66,  // 111: memos.api.v1.WorkspaceService.CreateMaintenanceWindow:input_type -> memos.api.v1.CreateMaintenanceWindowRequest
+	67,  // 112: memos.api.v1.WorkspaceService.DeleteMaintenanceWindow:input_type -> memos.api.v1.DeleteMaintenanceWindowRequest
+	12,  // 113: memos.api.v1.WorkspaceService.GetWorkspaceProfile:output_type -> memos.api.v1.WorkspaceProfile
+	14,  // 114: memos.api.v1.WorkspaceService.GetWorkspaceCapabilities:output_type -> memos.api.v1.WorkspaceCapabilities
+	16,  // 115: memos.api.v1.WorkspaceService.GetWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
+	16,  // 116: memos.api.v1.WorkspaceService.UpdateWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
+	23,  // 117: memos.api.v1.WorkspaceService.ExportWorkspaceSettings:output_type -> memos.api.v1.ExportWorkspaceSettingsResponse
+	25,  // 118: memos.api.v1.WorkspaceService.ApplyWorkspaceSettings:output_type -> memos.api.v1.ApplyWorkspaceSettingsResponse
+	20,  // 119: memos.api.v1.WorkspaceService.DowngradePublicMemos:output_type -> memos.api.v1.DowngradePublicMemosResponse
+	27,  // 120: memos.api.v1.WorkspaceService.AuditMemoVisibility:output_type -> memos.api.v1.AuditMemoVisibilityResponse
+	29,  // 121: memos.api.v1.WorkspaceService.BackupDatabase:output_type -> memos.api.v1.BackupDatabaseResponse
+	30,  // 122: memos.api.v1.WorkspaceService.CreateMemoPayloadRebuildJob:output_type -> memos.api.v1.MemoPayloadRebuildJob
+	30,  // 123: memos.api.v1.WorkspaceService.GetMemoPayloadRebuildJob:output_type -> memos.api.v1.MemoPayloadRebuildJob
+	34,  // 124: memos.api.v1.WorkspaceService.ListFeatureFlags:output_type -> memos.api.v1.ListFeatureFlagsResponse
+	37,  // 125: memos.api.v1.WorkspaceService.GetWorkspaceUsage:output_type -> memos.api.v1.WorkspaceUsage
+	39,  // 126: memos.api.v1.WorkspaceService.SendTestAlert:output_type -> memos.api.v1.SendTestAlertResponse
+	41,  // 127: memos.api.v1.WorkspaceService.SeedTestFixture:output_type -> memos.api.v1.SeedTestFixtureResponse
+	43,  // 128: memos.api.v1.WorkspaceService.GetRuntimeStats:output_type -> memos.api.v1.RuntimeStats
+	45,  // 129: memos.api.v1.WorkspaceService.ListRunners:output_type -> memos.api.v1.ListRunnersResponse
+	35,  // 130: memos.api.v1.WorkspaceService.UpdateRunner:output_type -> memos.api.v1.Runner
+	35,  // 131: memos.api.v1.WorkspaceService.RunRunner:output_type -> memos.api.v1.Runner
+	50,  // 132: memos.api.v1.WorkspaceService.ListDeadLetters:output_type -> memos.api.v1.ListDeadLettersResponse
+	106, // 133: memos.api.v1.WorkspaceService.RetryDeadLetter:output_type -> google.protobuf.Empty
+	106, // 134: memos.api.v1.WorkspaceService.DeleteDeadLetter:output_type -> google.protobuf.Empty
+	54,  // 135: memos.api.v1.WorkspaceService.RotateAccessTokenSigningKey:output_type -> memos.api.v1.RotateAccessTokenSigningKeyResponse
+	58,  // 136: memos.api.v1.WorkspaceService.ListAnnouncements:output_type -> memos.api.v1.ListAnnouncementsResponse
+	56,  // 137: memos.api.v1.WorkspaceService.CreateAnnouncement:output_type -> memos.api.v1.Announcement
+	56,  // 138: memos.api.v1.WorkspaceService.UpdateAnnouncement:output_type -> memos.api.v1.Announcement
+	106, // 139: memos.api.v1.WorkspaceService.DeleteAnnouncement:output_type -> google.protobuf.Empty
+	106, // 140: memos.api.v1.WorkspaceService.DismissAnnouncement:output_type -> google.protobuf.Empty
+	65,  // 141: memos.api.v1.WorkspaceService.ListMaintenanceWindows:output_type -> memos.api.v1.ListMaintenanceWindowsResponse
+	63,  // 142: memos.api.v1.WorkspaceService.CreateMaintenanceWindow:output_type -> memos.api.v1.MaintenanceWindow
+	106, // 143: memos.api.v1.WorkspaceService.DeleteMaintenanceWindow:output_type -> google.protobuf.Empty
+	113, // [113:144] is the sub-list for method output_type
+	82,  // [82:113] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_workspace_service_proto_init() }
@@ -1168,19 +7577,30 @@ func file_api_v1_workspace_service_proto_init() {
 	if File_api_v1_workspace_service_proto != nil {
 		return
 	}
-	file_api_v1_workspace_service_proto_msgTypes[2].OneofWrappers = []any{
+	file_api_v1_common_proto_init()
+	file_api_v1_idp_service_proto_init()
+	file_api_v1_workspace_service_proto_msgTypes[4].OneofWrappers = []any{
 		(*WorkspaceSetting_GeneralSetting_)(nil),
 		(*WorkspaceSetting_StorageSetting_)(nil),
 		(*WorkspaceSetting_MemoRelatedSetting_)(nil),
 		(*WorkspaceSetting_AiSetting)(nil),
+		(*WorkspaceSetting_OnboardingSetting_)(nil),
+		(*WorkspaceSetting_NewUserLimitSetting_)(nil),
+		(*WorkspaceSetting_FeatureFlagSetting_)(nil),
+		(*WorkspaceSetting_UsageLimitSetting_)(nil),
+		(*WorkspaceSetting_SensitiveContentSetting_)(nil),
+		(*WorkspaceSetting_OutboundFetchSetting_)(nil),
+		(*WorkspaceSetting_LegalSetting_)(nil),
+		(*WorkspaceSetting_AlertingSetting_)(nil),
 	}
+	file_api_v1_workspace_service_proto_msgTypes[62].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_workspace_service_proto_rawDesc), len(file_api_v1_workspace_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   11,
+			NumEnums:      12,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,9 +20,37 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WorkspaceService_GetWorkspaceProfile_FullMethodName    = "/memos.api.v1.WorkspaceService/GetWorkspaceProfile"
-	WorkspaceService_GetWorkspaceSetting_FullMethodName    = "/memos.api.v1.WorkspaceService/GetWorkspaceSetting"
-	WorkspaceService_UpdateWorkspaceSetting_FullMethodName = "/memos.api.v1.WorkspaceService/UpdateWorkspaceSetting"
+	WorkspaceService_GetWorkspaceProfile_FullMethodName         = "/memos.api.v1.WorkspaceService/GetWorkspaceProfile"
+	WorkspaceService_GetWorkspaceCapabilities_FullMethodName    = "/memos.api.v1.WorkspaceService/GetWorkspaceCapabilities"
+	WorkspaceService_GetWorkspaceSetting_FullMethodName         = "/memos.api.v1.WorkspaceService/GetWorkspaceSetting"
+	WorkspaceService_UpdateWorkspaceSetting_FullMethodName      = "/memos.api.v1.WorkspaceService/UpdateWorkspaceSetting"
+	WorkspaceService_ExportWorkspaceSettings_FullMethodName     = "/memos.api.v1.WorkspaceService/ExportWorkspaceSettings"
+	WorkspaceService_ApplyWorkspaceSettings_FullMethodName      = "/memos.api.v1.WorkspaceService/ApplyWorkspaceSettings"
+	WorkspaceService_DowngradePublicMemos_FullMethodName        = "/memos.api.v1.WorkspaceService/DowngradePublicMemos"
+	WorkspaceService_AuditMemoVisibility_FullMethodName         = "/memos.api.v1.WorkspaceService/AuditMemoVisibility"
+	WorkspaceService_BackupDatabase_FullMethodName              = "/memos.api.v1.WorkspaceService/BackupDatabase"
+	WorkspaceService_CreateMemoPayloadRebuildJob_FullMethodName = "/memos.api.v1.WorkspaceService/CreateMemoPayloadRebuildJob"
+	WorkspaceService_GetMemoPayloadRebuildJob_FullMethodName    = "/memos.api.v1.WorkspaceService/GetMemoPayloadRebuildJob"
+	WorkspaceService_ListFeatureFlags_FullMethodName            = "/memos.api.v1.WorkspaceService/ListFeatureFlags"
+	WorkspaceService_GetWorkspaceUsage_FullMethodName           = "/memos.api.v1.WorkspaceService/GetWorkspaceUsage"
+	WorkspaceService_SendTestAlert_FullMethodName               = "/memos.api.v1.WorkspaceService/SendTestAlert"
+	WorkspaceService_SeedTestFixture_FullMethodName             = "/memos.api.v1.WorkspaceService/SeedTestFixture"
+	WorkspaceService_GetRuntimeStats_FullMethodName             = "/memos.api.v1.WorkspaceService/GetRuntimeStats"
+	WorkspaceService_ListRunners_FullMethodName                 = "/memos.api.v1.WorkspaceService/ListRunners"
+	WorkspaceService_UpdateRunner_FullMethodName                = "/memos.api.v1.WorkspaceService/UpdateRunner"
+	WorkspaceService_RunRunner_FullMethodName                   = "/memos.api.v1.WorkspaceService/RunRunner"
+	WorkspaceService_ListDeadLetters_FullMethodName             = "/memos.api.v1.WorkspaceService/ListDeadLetters"
+	WorkspaceService_RetryDeadLetter_FullMethodName             = "/memos.api.v1.WorkspaceService/RetryDeadLetter"
+	WorkspaceService_DeleteDeadLetter_FullMethodName            = "/memos.api.v1.WorkspaceService/DeleteDeadLetter"
+	WorkspaceService_RotateAccessTokenSigningKey_FullMethodName = "/memos.api.v1.WorkspaceService/RotateAccessTokenSigningKey"
+	WorkspaceService_ListAnnouncements_FullMethodName           = "/memos.api.v1.WorkspaceService/ListAnnouncements"
+	WorkspaceService_CreateAnnouncement_FullMethodName          = "/memos.api.v1.WorkspaceService/CreateAnnouncement"
+	WorkspaceService_UpdateAnnouncement_FullMethodName          = "/memos.api.v1.WorkspaceService/UpdateAnnouncement"
+	WorkspaceService_DeleteAnnouncement_FullMethodName          = "/memos.api.v1.WorkspaceService/DeleteAnnouncement"
+	WorkspaceService_DismissAnnouncement_FullMethodName         = "/memos.api.v1.WorkspaceService/DismissAnnouncement"
+	WorkspaceService_ListMaintenanceWindows_FullMethodName      = "/memos.api.v1.WorkspaceService/ListMaintenanceWindows"
+	WorkspaceService_CreateMaintenanceWindow_FullMethodName     = "/memos.api.v1.WorkspaceService/CreateMaintenanceWindow"
+	WorkspaceService_DeleteMaintenanceWindow_FullMethodName     = "/memos.api.v1.WorkspaceService/DeleteMaintenanceWindow"
 )
 
 // WorkspaceServiceClient is the client API for WorkspaceService service.
@@ -30,10 +59,73 @@ const (
 type WorkspaceServiceClient interface {
 	// Gets the workspace profile.
 	GetWorkspaceProfile(ctx context.Context, in *GetWorkspaceProfileRequest, opts ...grpc.CallOption) (*WorkspaceProfile, error)
+	// Gets the optional subsystems enabled for the current user and their limits, for the clients to adapt their
+	// interface instead of probing the endpoints.
+	GetWorkspaceCapabilities(ctx context.Context, in *GetWorkspaceCapabilitiesRequest, opts ...grpc.CallOption) (*WorkspaceCapabilities, error)
 	// Gets a workspace setting.
 	GetWorkspaceSetting(ctx context.Context, in *GetWorkspaceSettingRequest, opts ...grpc.CallOption) (*WorkspaceSetting, error)
 	// Updates a workspace setting.
 	UpdateWorkspaceSetting(ctx context.Context, in *UpdateWorkspaceSettingRequest, opts ...grpc.CallOption) (*WorkspaceSetting, error)
+	// Exports the workspace settings and the identity providers as a versioned document, to set up another instance
+	// with ApplyWorkspaceSettings. The document has the secrets of the settings. Only for the host.
+	ExportWorkspaceSettings(ctx context.Context, in *ExportWorkspaceSettingsRequest, opts ...grpc.CallOption) (*ExportWorkspaceSettingsResponse, error)
+	// Applies a document exported by ExportWorkspaceSettings, updating the settings and the identity providers that
+	// differ from it. Applying the same document again changes nothing. Only for the host.
+	ApplyWorkspaceSettings(ctx context.Context, in *ApplyWorkspaceSettingsRequest, opts ...grpc.CallOption) (*ApplyWorkspaceSettingsResponse, error)
+	// Downgrades the visibility of all public memos in the workspace.
+	DowngradePublicMemos(ctx context.Context, in *DowngradePublicMemosRequest, opts ...grpc.CallOption) (*DowngradePublicMemosResponse, error)
+	// Reports the public memos whose visibility may be unintended, to be downgraded with DowngradePublicMemos.
+	// Only for the admins.
+	AuditMemoVisibility(ctx context.Context, in *AuditMemoVisibilityRequest, opts ...grpc.CallOption) (*AuditMemoVisibilityResponse, error)
+	// Creates a consistent online backup of the database and stores it in the configured storage.
+	BackupDatabase(ctx context.Context, in *BackupDatabaseRequest, opts ...grpc.CallOption) (*BackupDatabaseResponse, error)
+	// Starts rebuilding the payloads (tags, properties, links) of all memos in the background.
+	CreateMemoPayloadRebuildJob(ctx context.Context, in *CreateMemoPayloadRebuildJobRequest, opts ...grpc.CallOption) (*MemoPayloadRebuildJob, error)
+	// Gets the latest memo payload rebuild job.
+	GetMemoPayloadRebuildJob(ctx context.Context, in *GetMemoPayloadRebuildJobRequest, opts ...grpc.CallOption) (*MemoPayloadRebuildJob, error)
+	// Lists the feature flags evaluated for a user.
+	ListFeatureFlags(ctx context.Context, in *ListFeatureFlagsRequest, opts ...grpc.CallOption) (*ListFeatureFlagsResponse, error)
+	// Gets the usage of the workspace against its usage limits.
+	GetWorkspaceUsage(ctx context.Context, in *GetWorkspaceUsageRequest, opts ...grpc.CallOption) (*WorkspaceUsage, error)
+	// Sends a test alert on the channels of the alerting setting.
+	SendTestAlert(ctx context.Context, in *SendTestAlertRequest, opts ...grpc.CallOption) (*SendTestAlertResponse, error)
+	// Seeds the workspace with the fixture of the integration tests: users with memos, and optionally the FAKE AI
+	// provider. It is only available in the builds with the testfixtures build tag.
+	SeedTestFixture(ctx context.Context, in *SeedTestFixtureRequest, opts ...grpc.CallOption) (*SeedTestFixtureResponse, error)
+	// Gets a snapshot of the goroutines and memory of the server, for diagnosing performance issues.
+	GetRuntimeStats(ctx context.Context, in *GetRuntimeStatsRequest, opts ...grpc.CallOption) (*RuntimeStats, error)
+	// Lists the background runners with their schedule and last run.
+	ListRunners(ctx context.Context, in *ListRunnersRequest, opts ...grpc.CallOption) (*ListRunnersResponse, error)
+	// Updates the schedule or enabled state of a background runner.
+	UpdateRunner(ctx context.Context, in *UpdateRunnerRequest, opts ...grpc.CallOption) (*Runner, error)
+	// Runs a background runner now, regardless of its schedule.
+	RunRunner(ctx context.Context, in *RunRunnerRequest, opts ...grpc.CallOption) (*Runner, error)
+	// Lists the failed async jobs kept for retry.
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error)
+	// Retries a failed async job. The dead letter is removed when the retry succeeds.
+	RetryDeadLetter(ctx context.Context, in *RetryDeadLetterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Discards a failed async job without retrying it.
+	DeleteDeadLetter(ctx context.Context, in *DeleteDeadLetterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Replaces the key signing the access tokens with a new one. The tokens signed with the previous keys are accepted
+	// until the end of the grace period, the users have to create new ones after it.
+	RotateAccessTokenSigningKey(ctx context.Context, in *RotateAccessTokenSigningKeyRequest, opts ...grpc.CallOption) (*RotateAccessTokenSigningKeyResponse, error)
+	// Lists the announcements shown now and not dismissed by the current user. The admins can list all of them.
+	ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error)
+	// Creates an announcement. The users are notified of it in their inbox.
+	CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error)
+	// Updates an announcement.
+	UpdateAnnouncement(ctx context.Context, in *UpdateAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error)
+	// Deletes an announcement.
+	DeleteAnnouncement(ctx context.Context, in *DeleteAnnouncementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Hides a dismissible announcement from the current user.
+	DismissAnnouncement(ctx context.Context, in *DismissAnnouncementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the scheduled, in progress and recently completed maintenance windows.
+	ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error)
+	// Schedules a maintenance window. During the window the workspace is read-only, the background runners are
+	// paused and the webhooks are delivered after its end.
+	CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error)
+	// Cancels a scheduled maintenance window, or ends a maintenance window in progress now.
+	DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type workspaceServiceClient struct {
@@ -54,6 +146,16 @@ func (c *workspaceServiceClient) GetWorkspaceProfile(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *workspaceServiceClient) GetWorkspaceCapabilities(ctx context.Context, in *GetWorkspaceCapabilitiesRequest, opts ...grpc.CallOption) (*WorkspaceCapabilities, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkspaceCapabilities)
+	err := c.cc.Invoke(ctx, WorkspaceService_GetWorkspaceCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workspaceServiceClient) GetWorkspaceSetting(ctx context.Context, in *GetWorkspaceSettingRequest, opts ...grpc.CallOption) (*WorkspaceSetting, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(WorkspaceSetting)
@@ -74,16 +176,349 @@ func (c *workspaceServiceClient) UpdateWorkspaceSetting(ctx context.Context, in
 	return out, nil
 }
 
+func (c *workspaceServiceClient) ExportWorkspaceSettings(ctx context.Context, in *ExportWorkspaceSettingsRequest, opts ...grpc.CallOption) (*ExportWorkspaceSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportWorkspaceSettingsResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ExportWorkspaceSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ApplyWorkspaceSettings(ctx context.Context, in *ApplyWorkspaceSettingsRequest, opts ...grpc.CallOption) (*ApplyWorkspaceSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyWorkspaceSettingsResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ApplyWorkspaceSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) DowngradePublicMemos(ctx context.Context, in *DowngradePublicMemosRequest, opts ...grpc.CallOption) (*DowngradePublicMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DowngradePublicMemosResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_DowngradePublicMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) AuditMemoVisibility(ctx context.Context, in *AuditMemoVisibilityRequest, opts ...grpc.CallOption) (*AuditMemoVisibilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditMemoVisibilityResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_AuditMemoVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) BackupDatabase(ctx context.Context, in *BackupDatabaseRequest, opts ...grpc.CallOption) (*BackupDatabaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BackupDatabaseResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_BackupDatabase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) CreateMemoPayloadRebuildJob(ctx context.Context, in *CreateMemoPayloadRebuildJobRequest, opts ...grpc.CallOption) (*MemoPayloadRebuildJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoPayloadRebuildJob)
+	err := c.cc.Invoke(ctx, WorkspaceService_CreateMemoPayloadRebuildJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) GetMemoPayloadRebuildJob(ctx context.Context, in *GetMemoPayloadRebuildJobRequest, opts ...grpc.CallOption) (*MemoPayloadRebuildJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoPayloadRebuildJob)
+	err := c.cc.Invoke(ctx, WorkspaceService_GetMemoPayloadRebuildJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListFeatureFlags(ctx context.Context, in *ListFeatureFlagsRequest, opts ...grpc.CallOption) (*ListFeatureFlagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFeatureFlagsResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListFeatureFlags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) GetWorkspaceUsage(ctx context.Context, in *GetWorkspaceUsageRequest, opts ...grpc.CallOption) (*WorkspaceUsage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkspaceUsage)
+	err := c.cc.Invoke(ctx, WorkspaceService_GetWorkspaceUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) SendTestAlert(ctx context.Context, in *SendTestAlertRequest, opts ...grpc.CallOption) (*SendTestAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendTestAlertResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_SendTestAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) SeedTestFixture(ctx context.Context, in *SeedTestFixtureRequest, opts ...grpc.CallOption) (*SeedTestFixtureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeedTestFixtureResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_SeedTestFixture_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) GetRuntimeStats(ctx context.Context, in *GetRuntimeStatsRequest, opts ...grpc.CallOption) (*RuntimeStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuntimeStats)
+	err := c.cc.Invoke(ctx, WorkspaceService_GetRuntimeStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListRunners(ctx context.Context, in *ListRunnersRequest, opts ...grpc.CallOption) (*ListRunnersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRunnersResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListRunners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) UpdateRunner(ctx context.Context, in *UpdateRunnerRequest, opts ...grpc.CallOption) (*Runner, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Runner)
+	err := c.cc.Invoke(ctx, WorkspaceService_UpdateRunner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) RunRunner(ctx context.Context, in *RunRunnerRequest, opts ...grpc.CallOption) (*Runner, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Runner)
+	err := c.cc.Invoke(ctx, WorkspaceService_RunRunner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) RetryDeadLetter(ctx context.Context, in *RetryDeadLetterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkspaceService_RetryDeadLetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) DeleteDeadLetter(ctx context.Context, in *DeleteDeadLetterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkspaceService_DeleteDeadLetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) RotateAccessTokenSigningKey(ctx context.Context, in *RotateAccessTokenSigningKeyRequest, opts ...grpc.CallOption) (*RotateAccessTokenSigningKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateAccessTokenSigningKeyResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_RotateAccessTokenSigningKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcement)
+	err := c.cc.Invoke(ctx, WorkspaceService_CreateAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) UpdateAnnouncement(ctx context.Context, in *UpdateAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcement)
+	err := c.cc.Invoke(ctx, WorkspaceService_UpdateAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) DeleteAnnouncement(ctx context.Context, in *DeleteAnnouncementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkspaceService_DeleteAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) DismissAnnouncement(ctx context.Context, in *DismissAnnouncementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkspaceService_DismissAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListMaintenanceWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceWindow)
+	err := c.cc.Invoke(ctx, WorkspaceService_CreateMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkspaceService_DeleteMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceServiceServer is the server API for WorkspaceService service.
 // All implementations must embed UnimplementedWorkspaceServiceServer
 // for forward compatibility.
 type WorkspaceServiceServer interface {
 	// Gets the workspace profile.
 	GetWorkspaceProfile(context.Context, *GetWorkspaceProfileRequest) (*WorkspaceProfile, error)
+	// Gets the optional subsystems enabled for the current user and their limits, for the clients to adapt their
+	// interface instead of probing the endpoints.
+	GetWorkspaceCapabilities(context.Context, *GetWorkspaceCapabilitiesRequest) (*WorkspaceCapabilities, error)
 	// Gets a workspace setting.
 	GetWorkspaceSetting(context.Context, *GetWorkspaceSettingRequest) (*WorkspaceSetting, error)
 	// Updates a workspace setting.
 	UpdateWorkspaceSetting(context.Context, *UpdateWorkspaceSettingRequest) (*WorkspaceSetting, error)
+	// Exports the workspace settings and the identity providers as a versioned document, to set up another instance
+	// with ApplyWorkspaceSettings. The document has the secrets of the settings. Only for the host.
+	ExportWorkspaceSettings(context.Context, *ExportWorkspaceSettingsRequest) (*ExportWorkspaceSettingsResponse, error)
+	// Applies a document exported by ExportWorkspaceSettings, updating the settings and the identity providers that
+	// differ from it. Applying the same document again changes nothing. Only for the host.
+	ApplyWorkspaceSettings(context.Context, *ApplyWorkspaceSettingsRequest) (*ApplyWorkspaceSettingsResponse, error)
+	// Downgrades the visibility of all public memos in the workspace.
+	DowngradePublicMemos(context.Context, *DowngradePublicMemosRequest) (*DowngradePublicMemosResponse, error)
+	// Reports the public memos whose visibility may be unintended, to be downgraded with DowngradePublicMemos.
+	// Only for the admins.
+	AuditMemoVisibility(context.Context, *AuditMemoVisibilityRequest) (*AuditMemoVisibilityResponse, error)
+	// Creates a consistent online backup of the database and stores it in the configured storage.
+	BackupDatabase(context.Context, *BackupDatabaseRequest) (*BackupDatabaseResponse, error)
+	// Starts rebuilding the payloads (tags, properties, links) of all memos in the background.
+	CreateMemoPayloadRebuildJob(context.Context, *CreateMemoPayloadRebuildJobRequest) (*MemoPayloadRebuildJob, error)
+	// Gets the latest memo payload rebuild job.
+	GetMemoPayloadRebuildJob(context.Context, *GetMemoPayloadRebuildJobRequest) (*MemoPayloadRebuildJob, error)
+	// Lists the feature flags evaluated for a user.
+	ListFeatureFlags(context.Context, *ListFeatureFlagsRequest) (*ListFeatureFlagsResponse, error)
+	// Gets the usage of the workspace against its usage limits.
+	GetWorkspaceUsage(context.Context, *GetWorkspaceUsageRequest) (*WorkspaceUsage, error)
+	// Sends a test alert on the channels of the alerting setting.
+	SendTestAlert(context.Context, *SendTestAlertRequest) (*SendTestAlertResponse, error)
+	// Seeds the workspace with the fixture of the integration tests: users with memos, and optionally the FAKE AI
+	// provider. It is only available in the builds with the testfixtures build tag.
+	SeedTestFixture(context.Context, *SeedTestFixtureRequest) (*SeedTestFixtureResponse, error)
+	// Gets a snapshot of the goroutines and memory of the server, for diagnosing performance issues.
+	GetRuntimeStats(context.Context, *GetRuntimeStatsRequest) (*RuntimeStats, error)
+	// Lists the background runners with their schedule and last run.
+	ListRunners(context.Context, *ListRunnersRequest) (*ListRunnersResponse, error)
+	// Updates the schedule or enabled state of a background runner.
+	UpdateRunner(context.Context, *UpdateRunnerRequest) (*Runner, error)
+	// Runs a background runner now, regardless of its schedule.
+	RunRunner(context.Context, *RunRunnerRequest) (*Runner, error)
+	// Lists the failed async jobs kept for retry.
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error)
+	// Retries a failed async job. The dead letter is removed when the retry succeeds.
+	RetryDeadLetter(context.Context, *RetryDeadLetterRequest) (*emptypb.Empty, error)
+	// Discards a failed async job without retrying it.
+	DeleteDeadLetter(context.Context, *DeleteDeadLetterRequest) (*emptypb.Empty, error)
+	// Replaces the key signing the access tokens with a new one. The tokens signed with the previous keys are accepted
+	// until the end of the grace period, the users have to create new ones after it.
+	RotateAccessTokenSigningKey(context.Context, *RotateAccessTokenSigningKeyRequest) (*RotateAccessTokenSigningKeyResponse, error)
+	// Lists the announcements shown now and not dismissed by the current user. The admins can list all of them.
+	ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*ListAnnouncementsResponse, error)
+	// Creates an announcement. The users are notified of it in their inbox.
+	CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*Announcement, error)
+	// Updates an announcement.
+	UpdateAnnouncement(context.Context, *UpdateAnnouncementRequest) (*Announcement, error)
+	// Deletes an announcement.
+	DeleteAnnouncement(context.Context, *DeleteAnnouncementRequest) (*emptypb.Empty, error)
+	// Hides a dismissible announcement from the current user.
+	DismissAnnouncement(context.Context, *DismissAnnouncementRequest) (*emptypb.Empty, error)
+	// Lists the scheduled, in progress and recently completed maintenance windows.
+	ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error)
+	// Schedules a maintenance window. During the window the workspace is read-only, the background runners are
+	// paused and the webhooks are delivered after its end.
+	CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*MaintenanceWindow, error)
+	// Cancels a scheduled maintenance window, or ends a maintenance window in progress now.
+	DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedWorkspaceServiceServer()
 }
 
@@ -97,12 +532,96 @@ type UnimplementedWorkspaceServiceServer struct{}
 func (UnimplementedWorkspaceServiceServer) GetWorkspaceProfile(context.Context, *GetWorkspaceProfileRequest) (*WorkspaceProfile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceProfile not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) GetWorkspaceCapabilities(context.Context, *GetWorkspaceCapabilitiesRequest) (*WorkspaceCapabilities, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceCapabilities not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) GetWorkspaceSetting(context.Context, *GetWorkspaceSettingRequest) (*WorkspaceSetting, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceSetting not implemented")
 }
 func (UnimplementedWorkspaceServiceServer) UpdateWorkspaceSetting(context.Context, *UpdateWorkspaceSettingRequest) (*WorkspaceSetting, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkspaceSetting not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) ExportWorkspaceSettings(context.Context, *ExportWorkspaceSettingsRequest) (*ExportWorkspaceSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWorkspaceSettings not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ApplyWorkspaceSettings(context.Context, *ApplyWorkspaceSettingsRequest) (*ApplyWorkspaceSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyWorkspaceSettings not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) DowngradePublicMemos(context.Context, *DowngradePublicMemosRequest) (*DowngradePublicMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowngradePublicMemos not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) AuditMemoVisibility(context.Context, *AuditMemoVisibilityRequest) (*AuditMemoVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditMemoVisibility not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) BackupDatabase(context.Context, *BackupDatabaseRequest) (*BackupDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupDatabase not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) CreateMemoPayloadRebuildJob(context.Context, *CreateMemoPayloadRebuildJobRequest) (*MemoPayloadRebuildJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemoPayloadRebuildJob not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) GetMemoPayloadRebuildJob(context.Context, *GetMemoPayloadRebuildJobRequest) (*MemoPayloadRebuildJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoPayloadRebuildJob not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListFeatureFlags(context.Context, *ListFeatureFlagsRequest) (*ListFeatureFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeatureFlags not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) GetWorkspaceUsage(context.Context, *GetWorkspaceUsageRequest) (*WorkspaceUsage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceUsage not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) SendTestAlert(context.Context, *SendTestAlertRequest) (*SendTestAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTestAlert not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) SeedTestFixture(context.Context, *SeedTestFixtureRequest) (*SeedTestFixtureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeedTestFixture not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) GetRuntimeStats(context.Context, *GetRuntimeStatsRequest) (*RuntimeStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuntimeStats not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListRunners(context.Context, *ListRunnersRequest) (*ListRunnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRunners not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) UpdateRunner(context.Context, *UpdateRunnerRequest) (*Runner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRunner not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) RunRunner(context.Context, *RunRunnerRequest) (*Runner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunRunner not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) RetryDeadLetter(context.Context, *RetryDeadLetterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDeadLetter not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) DeleteDeadLetter(context.Context, *DeleteDeadLetterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeadLetter not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) RotateAccessTokenSigningKey(context.Context, *RotateAccessTokenSigningKeyRequest) (*RotateAccessTokenSigningKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAccessTokenSigningKey not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*ListAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnnouncements not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*Announcement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnnouncement not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) UpdateAnnouncement(context.Context, *UpdateAnnouncementRequest) (*Announcement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnnouncement not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) DeleteAnnouncement(context.Context, *DeleteAnnouncementRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAnnouncement not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) DismissAnnouncement(context.Context, *DismissAnnouncementRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissAnnouncement not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaintenanceWindows not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMaintenanceWindow not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMaintenanceWindow not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) mustEmbedUnimplementedWorkspaceServiceServer() {}
 func (UnimplementedWorkspaceServiceServer) testEmbeddedByValue()                          {}
 
@@ -142,6 +661,24 @@ func _WorkspaceService_GetWorkspaceProfile_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_GetWorkspaceCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetWorkspaceCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_GetWorkspaceCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetWorkspaceCapabilities(ctx, req.(*GetWorkspaceCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkspaceService_GetWorkspaceSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetWorkspaceSettingRequest)
 	if err := dec(in); err != nil {
@@ -178,24 +715,622 @@ func _WorkspaceService_UpdateWorkspaceSetting_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
-// WorkspaceService_ServiceDesc is the grpc.ServiceDesc for WorkspaceService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "memos.api.v1.WorkspaceService",
-	HandlerType: (*WorkspaceServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetWorkspaceProfile",
-			Handler:    _WorkspaceService_GetWorkspaceProfile_Handler,
-		},
-		{
-			MethodName: "GetWorkspaceSetting",
-			Handler:    _WorkspaceService_GetWorkspaceSetting_Handler,
-		},
-		{
-			MethodName: "UpdateWorkspaceSetting",
-			Handler:    _WorkspaceService_UpdateWorkspaceSetting_Handler,
+func _WorkspaceService_ExportWorkspaceSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWorkspaceSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ExportWorkspaceSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ExportWorkspaceSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ExportWorkspaceSettings(ctx, req.(*ExportWorkspaceSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ApplyWorkspaceSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyWorkspaceSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ApplyWorkspaceSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ApplyWorkspaceSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ApplyWorkspaceSettings(ctx, req.(*ApplyWorkspaceSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_DowngradePublicMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DowngradePublicMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).DowngradePublicMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_DowngradePublicMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).DowngradePublicMemos(ctx, req.(*DowngradePublicMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_AuditMemoVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditMemoVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).AuditMemoVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_AuditMemoVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).AuditMemoVisibility(ctx, req.(*AuditMemoVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_BackupDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).BackupDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_BackupDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).BackupDatabase(ctx, req.(*BackupDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_CreateMemoPayloadRebuildJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoPayloadRebuildJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).CreateMemoPayloadRebuildJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_CreateMemoPayloadRebuildJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).CreateMemoPayloadRebuildJob(ctx, req.(*CreateMemoPayloadRebuildJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_GetMemoPayloadRebuildJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoPayloadRebuildJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetMemoPayloadRebuildJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_GetMemoPayloadRebuildJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetMemoPayloadRebuildJob(ctx, req.(*GetMemoPayloadRebuildJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListFeatureFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeatureFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListFeatureFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListFeatureFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListFeatureFlags(ctx, req.(*ListFeatureFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_GetWorkspaceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetWorkspaceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_GetWorkspaceUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetWorkspaceUsage(ctx, req.(*GetWorkspaceUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_SendTestAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTestAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).SendTestAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_SendTestAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).SendTestAlert(ctx, req.(*SendTestAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_SeedTestFixture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeedTestFixtureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).SeedTestFixture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_SeedTestFixture_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).SeedTestFixture(ctx, req.(*SeedTestFixtureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_GetRuntimeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuntimeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetRuntimeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_GetRuntimeStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetRuntimeStats(ctx, req.(*GetRuntimeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListRunners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListRunners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListRunners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListRunners(ctx, req.(*ListRunnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_UpdateRunner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRunnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).UpdateRunner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_UpdateRunner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).UpdateRunner(ctx, req.(*UpdateRunnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_RunRunner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunRunnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).RunRunner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_RunRunner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).RunRunner(ctx, req.(*RunRunnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_RetryDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).RetryDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_RetryDeadLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).RetryDeadLetter(ctx, req.(*RetryDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_DeleteDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).DeleteDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_DeleteDeadLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).DeleteDeadLetter(ctx, req.(*DeleteDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_RotateAccessTokenSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAccessTokenSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).RotateAccessTokenSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_RotateAccessTokenSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).RotateAccessTokenSigningKey(ctx, req.(*RotateAccessTokenSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListAnnouncements(ctx, req.(*ListAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_CreateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).CreateAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_CreateAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).CreateAnnouncement(ctx, req.(*CreateAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_UpdateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).UpdateAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_UpdateAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).UpdateAnnouncement(ctx, req.(*UpdateAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_DeleteAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).DeleteAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_DeleteAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).DeleteAnnouncement(ctx, req.(*DeleteAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_DismissAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).DismissAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_DismissAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).DismissAnnouncement(ctx, req.(*DismissAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListMaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListMaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListMaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListMaintenanceWindows(ctx, req.(*ListMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_CreateMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).CreateMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_CreateMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).CreateMaintenanceWindow(ctx, req.(*CreateMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_DeleteMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).DeleteMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_DeleteMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).DeleteMaintenanceWindow(ctx, req.(*DeleteMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkspaceService_ServiceDesc is the grpc.ServiceDesc for WorkspaceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.WorkspaceService",
+	HandlerType: (*WorkspaceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWorkspaceProfile",
+			Handler:    _WorkspaceService_GetWorkspaceProfile_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceCapabilities",
+			Handler:    _WorkspaceService_GetWorkspaceCapabilities_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceSetting",
+			Handler:    _WorkspaceService_GetWorkspaceSetting_Handler,
+		},
+		{
+			MethodName: "UpdateWorkspaceSetting",
+			Handler:    _WorkspaceService_UpdateWorkspaceSetting_Handler,
+		},
+		{
+			MethodName: "ExportWorkspaceSettings",
+			Handler:    _WorkspaceService_ExportWorkspaceSettings_Handler,
+		},
+		{
+			MethodName: "ApplyWorkspaceSettings",
+			Handler:    _WorkspaceService_ApplyWorkspaceSettings_Handler,
+		},
+		{
+			MethodName: "DowngradePublicMemos",
+			Handler:    _WorkspaceService_DowngradePublicMemos_Handler,
+		},
+		{
+			MethodName: "AuditMemoVisibility",
+			Handler:    _WorkspaceService_AuditMemoVisibility_Handler,
+		},
+		{
+			MethodName: "BackupDatabase",
+			Handler:    _WorkspaceService_BackupDatabase_Handler,
+		},
+		{
+			MethodName: "CreateMemoPayloadRebuildJob",
+			Handler:    _WorkspaceService_CreateMemoPayloadRebuildJob_Handler,
+		},
+		{
+			MethodName: "GetMemoPayloadRebuildJob",
+			Handler:    _WorkspaceService_GetMemoPayloadRebuildJob_Handler,
+		},
+		{
+			MethodName: "ListFeatureFlags",
+			Handler:    _WorkspaceService_ListFeatureFlags_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceUsage",
+			Handler:    _WorkspaceService_GetWorkspaceUsage_Handler,
+		},
+		{
+			MethodName: "SendTestAlert",
+			Handler:    _WorkspaceService_SendTestAlert_Handler,
+		},
+		{
+			MethodName: "SeedTestFixture",
+			Handler:    _WorkspaceService_SeedTestFixture_Handler,
+		},
+		{
+			MethodName: "GetRuntimeStats",
+			Handler:    _WorkspaceService_GetRuntimeStats_Handler,
+		},
+		{
+			MethodName: "ListRunners",
+			Handler:    _WorkspaceService_ListRunners_Handler,
+		},
+		{
+			MethodName: "UpdateRunner",
+			Handler:    _WorkspaceService_UpdateRunner_Handler,
+		},
+		{
+			MethodName: "RunRunner",
+			Handler:    _WorkspaceService_RunRunner_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _WorkspaceService_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "RetryDeadLetter",
+			Handler:    _WorkspaceService_RetryDeadLetter_Handler,
+		},
+		{
+			MethodName: "DeleteDeadLetter",
+			Handler:    _WorkspaceService_DeleteDeadLetter_Handler,
+		},
+		{
+			MethodName: "RotateAccessTokenSigningKey",
+			Handler:    _WorkspaceService_RotateAccessTokenSigningKey_Handler,
+		},
+		{
+			MethodName: "ListAnnouncements",
+			Handler:    _WorkspaceService_ListAnnouncements_Handler,
+		},
+		{
+			MethodName: "CreateAnnouncement",
+			Handler:    _WorkspaceService_CreateAnnouncement_Handler,
+		},
+		{
+			MethodName: "UpdateAnnouncement",
+			Handler:    _WorkspaceService_UpdateAnnouncement_Handler,
+		},
+		{
+			MethodName: "DeleteAnnouncement",
+			Handler:    _WorkspaceService_DeleteAnnouncement_Handler,
+		},
+		{
+			MethodName: "DismissAnnouncement",
+			Handler:    _WorkspaceService_DismissAnnouncement_Handler,
+		},
+		{
+			MethodName: "ListMaintenanceWindows",
+			Handler:    _WorkspaceService_ListMaintenanceWindows_Handler,
+		},
+		{
+			MethodName: "CreateMaintenanceWindow",
+			Handler:    _WorkspaceService_CreateMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "DeleteMaintenanceWindow",
+			Handler:    _WorkspaceService_DeleteMaintenanceWindow_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,152 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: store/ai_audit_log.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AIAuditLogPayload is the detail of an audited AI action.
+type AIAuditLogPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// parameters are the parameters of the action, e.g. the model and the time range of a summary.
+	Parameters map[string]string `protobuf:"bytes,1,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// source_memo_ids are the memos whose content was sent to the AI provider.
+	SourceMemoIds []int32 `protobuf:"varint,2,rep,packed,name=source_memo_ids,json=sourceMemoIds,proto3" json:"source_memo_ids,omitempty"`
+	// source_memo_uids are the uids of the source memos, kept after the memos are deleted.
+	SourceMemoUids []string `protobuf:"bytes,3,rep,name=source_memo_uids,json=sourceMemoUids,proto3" json:"source_memo_uids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AIAuditLogPayload) Reset() {
+	*x = AIAuditLogPayload{}
+	mi := &file_store_ai_audit_log_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIAuditLogPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIAuditLogPayload) ProtoMessage() {}
+
+func (x *AIAuditLogPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_ai_audit_log_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIAuditLogPayload.ProtoReflect.Descriptor instead.
+func (*AIAuditLogPayload) Descriptor() ([]byte, []int) {
+	return file_store_ai_audit_log_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AIAuditLogPayload) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *AIAuditLogPayload) GetSourceMemoIds() []int32 {
+	if x != nil {
+		return x.SourceMemoIds
+	}
+	return nil
+}
+
+func (x *AIAuditLogPayload) GetSourceMemoUids() []string {
+	if x != nil {
+		return x.SourceMemoUids
+	}
+	return nil
+}
+
+var File_store_ai_audit_log_proto protoreflect.FileDescriptor
+
+const file_store_ai_audit_log_proto_rawDesc = "" +
+	"\n" +
+	"\x18store/ai_audit_log.proto\x12\vmemos.store\"\xf4\x01\n" +
+	"\x11AIAuditLogPayload\x12N\n" +
+	"\n" +
+	"parameters\x18\x01 \x03(\v2..memos.store.AIAuditLogPayload.ParametersEntryR\n" +
+	"parameters\x12&\n" +
+	"\x0fsource_memo_ids\x18\x02 \x03(\x05R\rsourceMemoIds\x12(\n" +
+	"\x10source_memo_uids\x18\x03 \x03(\tR\x0esourceMemoUids\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x9a\x01\n" +
+	"\x0fcom.memos.storeB\x0fAiAuditLogProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
+
+var (
+	file_store_ai_audit_log_proto_rawDescOnce sync.Once
+	file_store_ai_audit_log_proto_rawDescData []byte
+)
+
+func file_store_ai_audit_log_proto_rawDescGZIP() []byte {
+	file_store_ai_audit_log_proto_rawDescOnce.Do(func() {
+		file_store_ai_audit_log_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_store_ai_audit_log_proto_rawDesc), len(file_store_ai_audit_log_proto_rawDesc)))
+	})
+	return file_store_ai_audit_log_proto_rawDescData
+}
+
+var file_store_ai_audit_log_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_ai_audit_log_proto_goTypes = []any{
+	(*AIAuditLogPayload)(nil), // 0: memos.store.AIAuditLogPayload
+	nil,                       // 1: memos.store.AIAuditLogPayload.ParametersEntry
+}
+var file_store_ai_audit_log_proto_depIdxs = []int32{
+	1, // 0: memos.store.AIAuditLogPayload.parameters:type_name -> memos.store.AIAuditLogPayload.ParametersEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_store_ai_audit_log_proto_init() }
+func file_store_ai_audit_log_proto_init() {
+	if File_store_ai_audit_log_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_ai_audit_log_proto_rawDesc), len(file_store_ai_audit_log_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_ai_audit_log_proto_goTypes,
+		DependencyIndexes: file_store_ai_audit_log_proto_depIdxs,
+		MessageInfos:      file_store_ai_audit_log_proto_msgTypes,
+	}.Build()
+	File_store_ai_audit_log_proto = out.File
+	file_store_ai_audit_log_proto_goTypes = nil
+	file_store_ai_audit_log_proto_depIdxs = nil
+}
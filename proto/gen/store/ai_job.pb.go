@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: store/ai_job.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AIJobPayload is the input of a queued AI job.
+type AIJobPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*AIJobPayload_AiSummary
+	Payload       isAIJobPayload_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIJobPayload) Reset() {
+	*x = AIJobPayload{}
+	mi := &file_store_ai_job_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIJobPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIJobPayload) ProtoMessage() {}
+
+func (x *AIJobPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_ai_job_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIJobPayload.ProtoReflect.Descriptor instead.
+func (*AIJobPayload) Descriptor() ([]byte, []int) {
+	return file_store_ai_job_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AIJobPayload) GetPayload() isAIJobPayload_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *AIJobPayload) GetAiSummary() *AIJobPayload_AISummary {
+	if x != nil {
+		if x, ok := x.Payload.(*AIJobPayload_AiSummary); ok {
+			return x.AiSummary
+		}
+	}
+	return nil
+}
+
+type isAIJobPayload_Payload interface {
+	isAIJobPayload_Payload()
+}
+
+type AIJobPayload_AiSummary struct {
+	AiSummary *AIJobPayload_AISummary `protobuf:"bytes,1,opt,name=ai_summary,json=aiSummary,proto3,oneof"`
+}
+
+func (*AIJobPayload_AiSummary) isAIJobPayload_Payload() {}
+
+type AIJobPayload_AISummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TimeRange       string                 `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Tags            []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	StartDate       string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	ComparePrevious bool                   `protobuf:"varint,5,opt,name=compare_previous,json=comparePrevious,proto3" json:"compare_previous,omitempty"`
+	PromptTemplate  string                 `protobuf:"bytes,6,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
+	MemoNames       []string               `protobuf:"bytes,7,rep,name=memo_names,json=memoNames,proto3" json:"memo_names,omitempty"`
+	Filter          string                 `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+	// timezone is the IANA timezone of the dates of the custom time range, empty for UTC.
+	Timezone string `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// visibility is the visibility of the summary memo, empty for PRIVATE.
+	Visibility    string `protobuf:"bytes,10,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIJobPayload_AISummary) Reset() {
+	*x = AIJobPayload_AISummary{}
+	mi := &file_store_ai_job_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIJobPayload_AISummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIJobPayload_AISummary) ProtoMessage() {}
+
+func (x *AIJobPayload_AISummary) ProtoReflect() protoreflect.Message {
+	mi := &file_store_ai_job_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIJobPayload_AISummary.ProtoReflect.Descriptor instead.
+func (*AIJobPayload_AISummary) Descriptor() ([]byte, []int) {
+	return file_store_ai_job_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AIJobPayload_AISummary) GetTimeRange() string {
+	if x != nil {
+		return x.TimeRange
+	}
+	return ""
+}
+
+func (x *AIJobPayload_AISummary) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *AIJobPayload_AISummary) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AIJobPayload_AISummary) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AIJobPayload_AISummary) GetComparePrevious() bool {
+	if x != nil {
+		return x.ComparePrevious
+	}
+	return false
+}
+
+func (x *AIJobPayload_AISummary) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *AIJobPayload_AISummary) GetMemoNames() []string {
+	if x != nil {
+		return x.MemoNames
+	}
+	return nil
+}
+
+func (x *AIJobPayload_AISummary) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *AIJobPayload_AISummary) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *AIJobPayload_AISummary) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+var File_store_ai_job_proto protoreflect.FileDescriptor
+
+const file_store_ai_job_proto_rawDesc = "" +
+	"\n" +
+	"\x12store/ai_job.proto\x12\vmemos.store\"\xa1\x03\n" +
+	"\fAIJobPayload\x12D\n" +
+	"\n" +
+	"ai_summary\x18\x01 \x01(\v2#.memos.store.AIJobPayload.AISummaryH\x00R\taiSummary\x1a\xbf\x02\n" +
+	"\tAISummary\x12\x1d\n" +
+	"\n" +
+	"time_range\x18\x01 \x01(\tR\ttimeRange\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12)\n" +
+	"\x10compare_previous\x18\x05 \x01(\bR\x0fcomparePrevious\x12'\n" +
+	"\x0fprompt_template\x18\x06 \x01(\tR\x0epromptTemplate\x12\x1d\n" +
+	"\n" +
+	"memo_names\x18\a \x03(\tR\tmemoNames\x12\x16\n" +
+	"\x06filter\x18\b \x01(\tR\x06filter\x12\x1a\n" +
+	"\btimezone\x18\t \x01(\tR\btimezone\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\n" +
+	" \x01(\tR\n" +
+	"visibilityB\t\n" +
+	"\apayloadB\x95\x01\n" +
+	"\x0fcom.memos.storeB\n" +
+	"AiJobProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
+
+var (
+	file_store_ai_job_proto_rawDescOnce sync.Once
+	file_store_ai_job_proto_rawDescData []byte
+)
+
+func file_store_ai_job_proto_rawDescGZIP() []byte {
+	file_store_ai_job_proto_rawDescOnce.Do(func() {
+		file_store_ai_job_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_store_ai_job_proto_rawDesc), len(file_store_ai_job_proto_rawDesc)))
+	})
+	return file_store_ai_job_proto_rawDescData
+}
+
+var file_store_ai_job_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_ai_job_proto_goTypes = []any{
+	(*AIJobPayload)(nil),           // 0: memos.store.AIJobPayload
+	(*AIJobPayload_AISummary)(nil), // 1: memos.store.AIJobPayload.AISummary
+}
+var file_store_ai_job_proto_depIdxs = []int32{
+	1, // 0: memos.store.AIJobPayload.ai_summary:type_name -> memos.store.AIJobPayload.AISummary
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_store_ai_job_proto_init() }
+func file_store_ai_job_proto_init() {
+	if File_store_ai_job_proto != nil {
+		return
+	}
+	file_store_ai_job_proto_msgTypes[0].OneofWrappers = []any{
+		(*AIJobPayload_AiSummary)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_ai_job_proto_rawDesc), len(file_store_ai_job_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_ai_job_proto_goTypes,
+		DependencyIndexes: file_store_ai_job_proto_depIdxs,
+		MessageInfos:      file_store_ai_job_proto_msgTypes,
+	}.Build()
+	File_store_ai_job_proto = out.File
+	file_store_ai_job_proto_goTypes = nil
+	file_store_ai_job_proto_depIdxs = nil
+}
@@ -82,7 +82,20 @@ type AttachmentPayload struct {
 	// Types that are valid to be assigned to Payload:
 	//
 	//	*AttachmentPayload_S3Object_
-	Payload       isAttachmentPayload_Payload `protobuf_oneof:"payload"`
+	Payload isAttachmentPayload_Payload `protobuf_oneof:"payload"`
+	// classification is the result of the sensitive content classifier, unset until the attachment is classified.
+	Classification *AttachmentPayload_Classification `protobuf:"bytes,2,opt,name=classification,proto3" json:"classification,omitempty"`
+	// metadata is the file metadata extracted at upload, unset when none could be extracted.
+	Metadata *AttachmentPayload_Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// extracted_text is the text extracted from a document at upload, indexed for memo search.
+	ExtractedText string `protobuf:"bytes,4,opt,name=extracted_text,json=extractedText,proto3" json:"extracted_text,omitempty"`
+	// extraction is the text extracted from an image or audio attachment with the AI provider, unset until the
+	// attachment is extracted.
+	Extraction *AttachmentPayload_Extraction `protobuf:"bytes,5,opt,name=extraction,proto3" json:"extraction,omitempty"`
+	// caption is the caption the user wrote for the attachment, e.g. the caption of a figure.
+	Caption string `protobuf:"bytes,6,opt,name=caption,proto3" json:"caption,omitempty"`
+	// alt_text is the text alternative of an image or video attachment for the assistive technologies.
+	AltText       string `protobuf:"bytes,7,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -133,6 +146,48 @@ func (x *AttachmentPayload) GetS3Object() *AttachmentPayload_S3Object {
 	return nil
 }
 
+func (x *AttachmentPayload) GetClassification() *AttachmentPayload_Classification {
+	if x != nil {
+		return x.Classification
+	}
+	return nil
+}
+
+func (x *AttachmentPayload) GetMetadata() *AttachmentPayload_Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AttachmentPayload) GetExtractedText() string {
+	if x != nil {
+		return x.ExtractedText
+	}
+	return ""
+}
+
+func (x *AttachmentPayload) GetExtraction() *AttachmentPayload_Extraction {
+	if x != nil {
+		return x.Extraction
+	}
+	return nil
+}
+
+func (x *AttachmentPayload) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *AttachmentPayload) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
 type isAttachmentPayload_Payload interface {
 	isAttachmentPayload_Payload()
 }
@@ -206,17 +261,224 @@ func (x *AttachmentPayload_S3Object) GetLastPresignedTime() *timestamppb.Timesta
 	return nil
 }
 
+type AttachmentPayload_Classification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sensitive is whether the classifier tagged the attachment as sensitive.
+	Sensitive     bool  `protobuf:"varint,1,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	ClassifiedTs  int64 `protobuf:"varint,2,opt,name=classified_ts,json=classifiedTs,proto3" json:"classified_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentPayload_Classification) Reset() {
+	*x = AttachmentPayload_Classification{}
+	mi := &file_store_attachment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentPayload_Classification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentPayload_Classification) ProtoMessage() {}
+
+func (x *AttachmentPayload_Classification) ProtoReflect() protoreflect.Message {
+	mi := &file_store_attachment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentPayload_Classification.ProtoReflect.Descriptor instead.
+func (*AttachmentPayload_Classification) Descriptor() ([]byte, []int) {
+	return file_store_attachment_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *AttachmentPayload_Classification) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+func (x *AttachmentPayload_Classification) GetClassifiedTs() int64 {
+	if x != nil {
+		return x.ClassifiedTs
+	}
+	return 0
+}
+
+type AttachmentPayload_Extraction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// text is the description and the text of an image, or the transcript of an audio recording.
+	Text          string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	ExtractedTs   int64  `protobuf:"varint,2,opt,name=extracted_ts,json=extractedTs,proto3" json:"extracted_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentPayload_Extraction) Reset() {
+	*x = AttachmentPayload_Extraction{}
+	mi := &file_store_attachment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentPayload_Extraction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentPayload_Extraction) ProtoMessage() {}
+
+func (x *AttachmentPayload_Extraction) ProtoReflect() protoreflect.Message {
+	mi := &file_store_attachment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentPayload_Extraction.ProtoReflect.Descriptor instead.
+func (*AttachmentPayload_Extraction) Descriptor() ([]byte, []int) {
+	return file_store_attachment_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *AttachmentPayload_Extraction) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AttachmentPayload_Extraction) GetExtractedTs() int64 {
+	if x != nil {
+		return x.ExtractedTs
+	}
+	return 0
+}
+
+type AttachmentPayload_Metadata struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Width      int32                  `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height     int32                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	DurationMs int64                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	PageCount  int32                  `protobuf:"varint,4,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	// original_ts is when the content was originally created, e.g. when a photo was taken.
+	OriginalTs    int64 `protobuf:"varint,5,opt,name=original_ts,json=originalTs,proto3" json:"original_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentPayload_Metadata) Reset() {
+	*x = AttachmentPayload_Metadata{}
+	mi := &file_store_attachment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentPayload_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentPayload_Metadata) ProtoMessage() {}
+
+func (x *AttachmentPayload_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_store_attachment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentPayload_Metadata.ProtoReflect.Descriptor instead.
+func (*AttachmentPayload_Metadata) Descriptor() ([]byte, []int) {
+	return file_store_attachment_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *AttachmentPayload_Metadata) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *AttachmentPayload_Metadata) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AttachmentPayload_Metadata) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *AttachmentPayload_Metadata) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *AttachmentPayload_Metadata) GetOriginalTs() int64 {
+	if x != nil {
+		return x.OriginalTs
+	}
+	return 0
+}
+
 var File_store_attachment_proto protoreflect.FileDescriptor
 
 const file_store_attachment_proto_rawDesc = "" +
 	"\n" +
-	"\x16store/attachment.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1dstore/workspace_setting.proto\"\x8c\x02\n" +
+	"\x16store/attachment.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1dstore/workspace_setting.proto\"\x85\a\n" +
 	"\x11AttachmentPayload\x12F\n" +
-	"\ts3_object\x18\x01 \x01(\v2'.memos.store.AttachmentPayload.S3ObjectH\x00R\bs3Object\x1a\xa3\x01\n" +
+	"\ts3_object\x18\x01 \x01(\v2'.memos.store.AttachmentPayload.S3ObjectH\x00R\bs3Object\x12U\n" +
+	"\x0eclassification\x18\x02 \x01(\v2-.memos.store.AttachmentPayload.ClassificationR\x0eclassification\x12C\n" +
+	"\bmetadata\x18\x03 \x01(\v2'.memos.store.AttachmentPayload.MetadataR\bmetadata\x12%\n" +
+	"\x0eextracted_text\x18\x04 \x01(\tR\rextractedText\x12I\n" +
+	"\n" +
+	"extraction\x18\x05 \x01(\v2).memos.store.AttachmentPayload.ExtractionR\n" +
+	"extraction\x12\x18\n" +
+	"\acaption\x18\x06 \x01(\tR\acaption\x12\x19\n" +
+	"\balt_text\x18\a \x01(\tR\aaltText\x1a\xa3\x01\n" +
 	"\bS3Object\x129\n" +
 	"\ts3_config\x18\x01 \x01(\v2\x1c.memos.store.StorageS3ConfigR\bs3Config\x12\x10\n" +
 	"\x03key\x18\x02 \x01(\tR\x03key\x12J\n" +
-	"\x13last_presigned_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastPresignedTimeB\t\n" +
+	"\x13last_presigned_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastPresignedTime\x1aS\n" +
+	"\x0eClassification\x12\x1c\n" +
+	"\tsensitive\x18\x01 \x01(\bR\tsensitive\x12#\n" +
+	"\rclassified_ts\x18\x02 \x01(\x03R\fclassifiedTs\x1aC\n" +
+	"\n" +
+	"Extraction\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12!\n" +
+	"\fextracted_ts\x18\x02 \x01(\x03R\vextractedTs\x1a\x99\x01\n" +
+	"\bMetadata\x12\x14\n" +
+	"\x05width\x18\x01 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x05R\x06height\x12\x1f\n" +
+	"\vduration_ms\x18\x03 \x01(\x03R\n" +
+	"durationMs\x12\x1d\n" +
+	"\n" +
+	"page_count\x18\x04 \x01(\x05R\tpageCount\x12\x1f\n" +
+	"\voriginal_ts\x18\x05 \x01(\x03R\n" +
+	"originalTsB\t\n" +
 	"\apayload*a\n" +
 	"\x15AttachmentStorageType\x12'\n" +
 	"#ATTACHMENT_STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
@@ -238,23 +500,29 @@ func file_store_attachment_proto_rawDescGZIP() []byte {
 }
 
 var file_store_attachment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_attachment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_attachment_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_store_attachment_proto_goTypes = []any{
-	(AttachmentStorageType)(0),         // 0: memos.store.AttachmentStorageType
-	(*AttachmentPayload)(nil),          // 1: memos.store.AttachmentPayload
-	(*AttachmentPayload_S3Object)(nil), // 2: memos.store.AttachmentPayload.S3Object
-	(*StorageS3Config)(nil),            // 3: memos.store.StorageS3Config
-	(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
+	(AttachmentStorageType)(0),               // 0: memos.store.AttachmentStorageType
+	(*AttachmentPayload)(nil),                // 1: memos.store.AttachmentPayload
+	(*AttachmentPayload_S3Object)(nil),       // 2: memos.store.AttachmentPayload.S3Object
+	(*AttachmentPayload_Classification)(nil), // 3: memos.store.AttachmentPayload.Classification
+	(*AttachmentPayload_Extraction)(nil),     // 4: memos.store.AttachmentPayload.Extraction
+	(*AttachmentPayload_Metadata)(nil),       // 5: memos.store.AttachmentPayload.Metadata
+	(*StorageS3Config)(nil),                  // 6: memos.store.StorageS3Config
+	(*timestamppb.Timestamp)(nil),            // 7: google.protobuf.Timestamp
 }
 var file_store_attachment_proto_depIdxs = []int32{
 	2, // 0: memos.store.AttachmentPayload.s3_object:type_name -> memos.store.AttachmentPayload.S3Object
-	3, // 1: memos.store.AttachmentPayload.S3Object.s3_config:type_name -> memos.store.StorageS3Config
-	4, // 2: memos.store.AttachmentPayload.S3Object.last_presigned_time:type_name -> google.protobuf.Timestamp
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 1: memos.store.AttachmentPayload.classification:type_name -> memos.store.AttachmentPayload.Classification
+	5, // 2: memos.store.AttachmentPayload.metadata:type_name -> memos.store.AttachmentPayload.Metadata
+	4, // 3: memos.store.AttachmentPayload.extraction:type_name -> memos.store.AttachmentPayload.Extraction
+	6, // 4: memos.store.AttachmentPayload.S3Object.s3_config:type_name -> memos.store.StorageS3Config
+	7, // 5: memos.store.AttachmentPayload.S3Object.last_presigned_time:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_store_attachment_proto_init() }
@@ -272,7 +540,7 @@ func file_store_attachment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_attachment_proto_rawDesc), len(file_store_attachment_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
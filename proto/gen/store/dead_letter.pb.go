@@ -0,0 +1,446 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: store/dead_letter.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DeadLetterPayload is the input of a failed async job, kept so that the job can be retried.
+type DeadLetterPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DeadLetterPayload_Webhook_
+	//	*DeadLetterPayload_AiSummary
+	//	*DeadLetterPayload_UserImport_
+	Payload       isDeadLetterPayload_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterPayload) Reset() {
+	*x = DeadLetterPayload{}
+	mi := &file_store_dead_letter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterPayload) ProtoMessage() {}
+
+func (x *DeadLetterPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_dead_letter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterPayload.ProtoReflect.Descriptor instead.
+func (*DeadLetterPayload) Descriptor() ([]byte, []int) {
+	return file_store_dead_letter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeadLetterPayload) GetPayload() isDeadLetterPayload_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DeadLetterPayload) GetWebhook() *DeadLetterPayload_Webhook {
+	if x != nil {
+		if x, ok := x.Payload.(*DeadLetterPayload_Webhook_); ok {
+			return x.Webhook
+		}
+	}
+	return nil
+}
+
+func (x *DeadLetterPayload) GetAiSummary() *DeadLetterPayload_AISummary {
+	if x != nil {
+		if x, ok := x.Payload.(*DeadLetterPayload_AiSummary); ok {
+			return x.AiSummary
+		}
+	}
+	return nil
+}
+
+func (x *DeadLetterPayload) GetUserImport() *DeadLetterPayload_UserImport {
+	if x != nil {
+		if x, ok := x.Payload.(*DeadLetterPayload_UserImport_); ok {
+			return x.UserImport
+		}
+	}
+	return nil
+}
+
+type isDeadLetterPayload_Payload interface {
+	isDeadLetterPayload_Payload()
+}
+
+type DeadLetterPayload_Webhook_ struct {
+	Webhook *DeadLetterPayload_Webhook `protobuf:"bytes,1,opt,name=webhook,proto3,oneof"`
+}
+
+type DeadLetterPayload_AiSummary struct {
+	AiSummary *DeadLetterPayload_AISummary `protobuf:"bytes,2,opt,name=ai_summary,json=aiSummary,proto3,oneof"`
+}
+
+type DeadLetterPayload_UserImport_ struct {
+	UserImport *DeadLetterPayload_UserImport `protobuf:"bytes,3,opt,name=user_import,json=userImport,proto3,oneof"`
+}
+
+func (*DeadLetterPayload_Webhook_) isDeadLetterPayload_Payload() {}
+
+func (*DeadLetterPayload_AiSummary) isDeadLetterPayload_Payload() {}
+
+func (*DeadLetterPayload_UserImport_) isDeadLetterPayload_Payload() {}
+
+type DeadLetterPayload_Webhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// url is the webhook endpoint.
+	Url          string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ActivityType string `protobuf:"bytes,2,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	// body is the JSON request body posted to the endpoint.
+	Body string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// webhook_id is the id of the user webhook the request was sent for.
+	WebhookId     string `protobuf:"bytes,4,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterPayload_Webhook) Reset() {
+	*x = DeadLetterPayload_Webhook{}
+	mi := &file_store_dead_letter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterPayload_Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterPayload_Webhook) ProtoMessage() {}
+
+func (x *DeadLetterPayload_Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_store_dead_letter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterPayload_Webhook.ProtoReflect.Descriptor instead.
+func (*DeadLetterPayload_Webhook) Descriptor() ([]byte, []int) {
+	return file_store_dead_letter_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *DeadLetterPayload_Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DeadLetterPayload_Webhook) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (x *DeadLetterPayload_Webhook) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *DeadLetterPayload_Webhook) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type DeadLetterPayload_AISummary struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TimeRange string                 `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Tags      []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	StartDate string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	MemoNames []string               `protobuf:"bytes,5,rep,name=memo_names,json=memoNames,proto3" json:"memo_names,omitempty"`
+	Filter    string                 `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	// timezone is the IANA timezone of the dates of the custom time range, empty for UTC.
+	Timezone string `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// visibility is the visibility of the summary memo, empty for PRIVATE.
+	Visibility    string `protobuf:"bytes,8,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterPayload_AISummary) Reset() {
+	*x = DeadLetterPayload_AISummary{}
+	mi := &file_store_dead_letter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterPayload_AISummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterPayload_AISummary) ProtoMessage() {}
+
+func (x *DeadLetterPayload_AISummary) ProtoReflect() protoreflect.Message {
+	mi := &file_store_dead_letter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterPayload_AISummary.ProtoReflect.Descriptor instead.
+func (*DeadLetterPayload_AISummary) Descriptor() ([]byte, []int) {
+	return file_store_dead_letter_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *DeadLetterPayload_AISummary) GetTimeRange() string {
+	if x != nil {
+		return x.TimeRange
+	}
+	return ""
+}
+
+func (x *DeadLetterPayload_AISummary) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *DeadLetterPayload_AISummary) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *DeadLetterPayload_AISummary) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *DeadLetterPayload_AISummary) GetMemoNames() []string {
+	if x != nil {
+		return x.MemoNames
+	}
+	return nil
+}
+
+func (x *DeadLetterPayload_AISummary) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *DeadLetterPayload_AISummary) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *DeadLetterPayload_AISummary) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type DeadLetterPayload_UserImport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// source_url is the URL of the memos instance the data is imported from.
+	SourceUrl string `protobuf:"bytes,1,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	// encrypted_access_token is the access token of the remote instance, sealed with the secret of the workspace.
+	EncryptedAccessToken []byte `protobuf:"bytes,3,opt,name=encrypted_access_token,json=encryptedAccessToken,proto3" json:"encrypted_access_token,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DeadLetterPayload_UserImport) Reset() {
+	*x = DeadLetterPayload_UserImport{}
+	mi := &file_store_dead_letter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterPayload_UserImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterPayload_UserImport) ProtoMessage() {}
+
+func (x *DeadLetterPayload_UserImport) ProtoReflect() protoreflect.Message {
+	mi := &file_store_dead_letter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterPayload_UserImport.ProtoReflect.Descriptor instead.
+func (*DeadLetterPayload_UserImport) Descriptor() ([]byte, []int) {
+	return file_store_dead_letter_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *DeadLetterPayload_UserImport) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *DeadLetterPayload_UserImport) GetEncryptedAccessToken() []byte {
+	if x != nil {
+		return x.EncryptedAccessToken
+	}
+	return nil
+}
+
+var File_store_dead_letter_proto protoreflect.FileDescriptor
+
+const file_store_dead_letter_proto_rawDesc = "" +
+	"\n" +
+	"\x17store/dead_letter.proto\x12\vmemos.store\"\xd5\x05\n" +
+	"\x11DeadLetterPayload\x12B\n" +
+	"\awebhook\x18\x01 \x01(\v2&.memos.store.DeadLetterPayload.WebhookH\x00R\awebhook\x12I\n" +
+	"\n" +
+	"ai_summary\x18\x02 \x01(\v2(.memos.store.DeadLetterPayload.AISummaryH\x00R\taiSummary\x12L\n" +
+	"\vuser_import\x18\x03 \x01(\v2).memos.store.DeadLetterPayload.UserImportH\x00R\n" +
+	"userImport\x1as\n" +
+	"\aWebhook\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12#\n" +
+	"\ractivity_type\x18\x02 \x01(\tR\factivityType\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x04 \x01(\tR\twebhookId\x1a\xeb\x01\n" +
+	"\tAISummary\x12\x1d\n" +
+	"\n" +
+	"time_range\x18\x01 \x01(\tR\ttimeRange\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x1d\n" +
+	"\n" +
+	"memo_names\x18\x05 \x03(\tR\tmemoNames\x12\x16\n" +
+	"\x06filter\x18\x06 \x01(\tR\x06filter\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\b \x01(\tR\n" +
+	"visibility\x1au\n" +
+	"\n" +
+	"UserImport\x12\x1d\n" +
+	"\n" +
+	"source_url\x18\x01 \x01(\tR\tsourceUrl\x124\n" +
+	"\x16encrypted_access_token\x18\x03 \x01(\fR\x14encryptedAccessTokenJ\x04\b\x02\x10\x03R\faccess_tokenB\t\n" +
+	"\apayloadB\x9a\x01\n" +
+	"\x0fcom.memos.storeB\x0fDeadLetterProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
+
+var (
+	file_store_dead_letter_proto_rawDescOnce sync.Once
+	file_store_dead_letter_proto_rawDescData []byte
+)
+
+func file_store_dead_letter_proto_rawDescGZIP() []byte {
+	file_store_dead_letter_proto_rawDescOnce.Do(func() {
+		file_store_dead_letter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_store_dead_letter_proto_rawDesc), len(file_store_dead_letter_proto_rawDesc)))
+	})
+	return file_store_dead_letter_proto_rawDescData
+}
+
+var file_store_dead_letter_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_store_dead_letter_proto_goTypes = []any{
+	(*DeadLetterPayload)(nil),            // 0: memos.store.DeadLetterPayload
+	(*DeadLetterPayload_Webhook)(nil),    // 1: memos.store.DeadLetterPayload.Webhook
+	(*DeadLetterPayload_AISummary)(nil),  // 2: memos.store.DeadLetterPayload.AISummary
+	(*DeadLetterPayload_UserImport)(nil), // 3: memos.store.DeadLetterPayload.UserImport
+}
+var file_store_dead_letter_proto_depIdxs = []int32{
+	1, // 0: memos.store.DeadLetterPayload.webhook:type_name -> memos.store.DeadLetterPayload.Webhook
+	2, // 1: memos.store.DeadLetterPayload.ai_summary:type_name -> memos.store.DeadLetterPayload.AISummary
+	3, // 2: memos.store.DeadLetterPayload.user_import:type_name -> memos.store.DeadLetterPayload.UserImport
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_store_dead_letter_proto_init() }
+func file_store_dead_letter_proto_init() {
+	if File_store_dead_letter_proto != nil {
+		return
+	}
+	file_store_dead_letter_proto_msgTypes[0].OneofWrappers = []any{
+		(*DeadLetterPayload_Webhook_)(nil),
+		(*DeadLetterPayload_AiSummary)(nil),
+		(*DeadLetterPayload_UserImport_)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_dead_letter_proto_rawDesc), len(file_store_dead_letter_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_dead_letter_proto_goTypes,
+		DependencyIndexes: file_store_dead_letter_proto_depIdxs,
+		MessageInfos:      file_store_dead_letter_proto_msgTypes,
+	}.Build()
+	File_store_dead_letter_proto = out.File
+	file_store_dead_letter_proto_goTypes = nil
+	file_store_dead_letter_proto_depIdxs = nil
+}
@@ -24,9 +24,13 @@ const (
 type InboxMessage_Type int32
 
 const (
-	InboxMessage_TYPE_UNSPECIFIED InboxMessage_Type = 0
-	InboxMessage_MEMO_COMMENT     InboxMessage_Type = 1
-	InboxMessage_VERSION_UPDATE   InboxMessage_Type = 2
+	InboxMessage_TYPE_UNSPECIFIED  InboxMessage_Type = 0
+	InboxMessage_MEMO_COMMENT      InboxMessage_Type = 1
+	InboxMessage_VERSION_UPDATE    InboxMessage_Type = 2
+	InboxMessage_DEAD_LETTER_ALERT InboxMessage_Type = 3
+	InboxMessage_MEMO_REACTION     InboxMessage_Type = 4
+	InboxMessage_ANNOUNCEMENT      InboxMessage_Type = 5
+	InboxMessage_MEMO_APPROVAL     InboxMessage_Type = 6
 )
 
 // Enum value maps for InboxMessage_Type.
@@ -35,11 +39,19 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
 		2: "VERSION_UPDATE",
+		3: "DEAD_LETTER_ALERT",
+		4: "MEMO_REACTION",
+		5: "ANNOUNCEMENT",
+		6: "MEMO_APPROVAL",
 	}
 	InboxMessage_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"MEMO_COMMENT":     1,
-		"VERSION_UPDATE":   2,
+		"TYPE_UNSPECIFIED":  0,
+		"MEMO_COMMENT":      1,
+		"VERSION_UPDATE":    2,
+		"DEAD_LETTER_ALERT": 3,
+		"MEMO_REACTION":     4,
+		"ANNOUNCEMENT":      5,
+		"MEMO_APPROVAL":     6,
 	}
 )
 
@@ -71,11 +83,12 @@ func (InboxMessage_Type) EnumDescriptor() ([]byte, []int) {
 }
 
 type InboxMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          InboxMessage_Type      `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.InboxMessage_Type" json:"type,omitempty"`
-	ActivityId    *int32                 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           InboxMessage_Type      `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.InboxMessage_Type" json:"type,omitempty"`
+	ActivityId     *int32                 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	AnnouncementId *int32                 `protobuf:"varint,3,opt,name=announcement_id,json=announcementId,proto3,oneof" json:"announcement_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *InboxMessage) Reset() {
@@ -122,20 +135,33 @@ func (x *InboxMessage) GetActivityId() int32 {
 	return 0
 }
 
+func (x *InboxMessage) GetAnnouncementId() int32 {
+	if x != nil && x.AnnouncementId != nil {
+		return *x.AnnouncementId
+	}
+	return 0
+}
+
 var File_store_inbox_proto protoreflect.FileDescriptor
 
 const file_store_inbox_proto_rawDesc = "" +
 	"\n" +
-	"\x11store/inbox.proto\x12\vmemos.store\"\xbc\x01\n" +
+	"\x11store/inbox.proto\x12\vmemos.store\"\xce\x02\n" +
 	"\fInboxMessage\x122\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1e.memos.store.InboxMessage.TypeR\x04type\x12$\n" +
 	"\vactivity_id\x18\x02 \x01(\x05H\x00R\n" +
-	"activityId\x88\x01\x01\"B\n" +
+	"activityId\x88\x01\x01\x12,\n" +
+	"\x0fannouncement_id\x18\x03 \x01(\x05H\x01R\x0eannouncementId\x88\x01\x01\"\x91\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fMEMO_COMMENT\x10\x01\x12\x12\n" +
-	"\x0eVERSION_UPDATE\x10\x02B\x0e\n" +
-	"\f_activity_idB\x95\x01\n" +
+	"\x0eVERSION_UPDATE\x10\x02\x12\x15\n" +
+	"\x11DEAD_LETTER_ALERT\x10\x03\x12\x11\n" +
+	"\rMEMO_REACTION\x10\x04\x12\x10\n" +
+	"\fANNOUNCEMENT\x10\x05\x12\x11\n" +
+	"\rMEMO_APPROVAL\x10\x06B\x0e\n" +
+	"\f_activity_idB\x12\n" +
+	"\x10_announcement_idB\x95\x01\n" +
 	"\x0fcom.memos.storeB\n" +
 	"InboxProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
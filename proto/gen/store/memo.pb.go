@@ -21,11 +21,149 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MemoPayload_ExpiryAction int32
+
+const (
+	// Unspecified actions archive the memo.
+	MemoPayload_EXPIRY_ACTION_UNSPECIFIED MemoPayload_ExpiryAction = 0
+	MemoPayload_ARCHIVE                   MemoPayload_ExpiryAction = 1
+	MemoPayload_DELETE                    MemoPayload_ExpiryAction = 2
+)
+
+// Enum value maps for MemoPayload_ExpiryAction.
+var (
+	MemoPayload_ExpiryAction_name = map[int32]string{
+		0: "EXPIRY_ACTION_UNSPECIFIED",
+		1: "ARCHIVE",
+		2: "DELETE",
+	}
+	MemoPayload_ExpiryAction_value = map[string]int32{
+		"EXPIRY_ACTION_UNSPECIFIED": 0,
+		"ARCHIVE":                   1,
+		"DELETE":                    2,
+	}
+)
+
+func (x MemoPayload_ExpiryAction) Enum() *MemoPayload_ExpiryAction {
+	p := new(MemoPayload_ExpiryAction)
+	*p = x
+	return p
+}
+
+func (x MemoPayload_ExpiryAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoPayload_ExpiryAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_memo_proto_enumTypes[0].Descriptor()
+}
+
+func (MemoPayload_ExpiryAction) Type() protoreflect.EnumType {
+	return &file_store_memo_proto_enumTypes[0]
+}
+
+func (x MemoPayload_ExpiryAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoPayload_ExpiryAction.Descriptor instead.
+func (MemoPayload_ExpiryAction) EnumDescriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type MemoPayload_Approval_State int32
+
+const (
+	MemoPayload_Approval_STATE_UNSPECIFIED MemoPayload_Approval_State = 0
+	MemoPayload_Approval_PENDING           MemoPayload_Approval_State = 1
+	MemoPayload_Approval_APPROVED          MemoPayload_Approval_State = 2
+	MemoPayload_Approval_REJECTED          MemoPayload_Approval_State = 3
+)
+
+// Enum value maps for MemoPayload_Approval_State.
+var (
+	MemoPayload_Approval_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "APPROVED",
+		3: "REJECTED",
+	}
+	MemoPayload_Approval_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"APPROVED":          2,
+		"REJECTED":          3,
+	}
+)
+
+func (x MemoPayload_Approval_State) Enum() *MemoPayload_Approval_State {
+	p := new(MemoPayload_Approval_State)
+	*p = x
+	return p
+}
+
+func (x MemoPayload_Approval_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoPayload_Approval_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_memo_proto_enumTypes[1].Descriptor()
+}
+
+func (MemoPayload_Approval_State) Type() protoreflect.EnumType {
+	return &file_store_memo_proto_enumTypes[1]
+}
+
+func (x MemoPayload_Approval_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoPayload_Approval_State.Descriptor instead.
+func (MemoPayload_Approval_State) EnumDescriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 11, 0}
+}
+
 type MemoPayload struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Property      *MemoPayload_Property  `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
-	Location      *MemoPayload_Location  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Property *MemoPayload_Property  `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Location *MemoPayload_Location  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Tags     []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The links found unreachable by the link checker.
+	BrokenLinks []*MemoPayload_BrokenLink `protobuf:"bytes,4,rep,name=broken_links,json=brokenLinks,proto3" json:"broken_links,omitempty"`
+	// The web archive snapshots of the links in the memo content.
+	LinkSnapshots []*MemoPayload_LinkSnapshot `protobuf:"bytes,5,rep,name=link_snapshots,json=linkSnapshots,proto3" json:"link_snapshots,omitempty"`
+	// The content warning shown in place of the content until the reader chooses to reveal it.
+	ContentWarning string `protobuf:"bytes,6,opt,name=content_warning,json=contentWarning,proto3" json:"content_warning,omitempty"`
+	// The expiry of the memo, enforced by the memo expiry runner.
+	Expiry *MemoPayload_Expiry `protobuf:"bytes,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// The language of the content as a BCP 47 tag, e.g. "en" or "pt-BR".
+	Language string `protobuf:"bytes,8,opt,name=language,proto3" json:"language,omitempty"`
+	// The language detected from the content as an ISO 639-1 code, empty if unknown.
+	DetectedLanguage string `protobuf:"bytes,9,opt,name=detected_language,json=detectedLanguage,proto3" json:"detected_language,omitempty"`
+	// The refinements of an AI summary, oldest first.
+	AiSummaryRefinements []*MemoPayload_AISummaryRefinement `protobuf:"bytes,10,rep,name=ai_summary_refinements,json=aiSummaryRefinements,proto3" json:"ai_summary_refinements,omitempty"`
+	// The tags suggested by the AI and applied automatically, kept in the tags when the payload is rebuilt.
+	AiTags []string `protobuf:"bytes,11,rep,name=ai_tags,json=aiTags,proto3" json:"ai_tags,omitempty"`
+	// The previous versions of an AI summary replaced by its regenerations, oldest first.
+	AiSummaryVersions []*MemoPayload_AISummaryVersion `protobuf:"bytes,12,rep,name=ai_summary_versions,json=aiSummaryVersions,proto3" json:"ai_summary_versions,omitempty"`
+	// The request an AI summary was generated with, to regenerate it from the memos of the same time range.
+	AiSummarySource *MemoPayload_AISummarySource `protobuf:"bytes,13,opt,name=ai_summary_source,json=aiSummarySource,proto3" json:"ai_summary_source,omitempty"`
+	// The slug of the memo in its public URLs, also recorded in the memo_slug table with the previous ones.
+	Slug string `protobuf:"bytes,14,opt,name=slug,proto3" json:"slug,omitempty"`
+	// The copies of the memo cross-posted to other platforms, at most one per platform.
+	Syndications []*MemoPayload_Syndication `protobuf:"bytes,15,rep,name=syndications,proto3" json:"syndications,omitempty"`
+	// The time entries of the "@time" blocks of the memo content, e.g. "@time 2h #project".
+	TimeEntries []*MemoPayload_TimeEntry `protobuf:"bytes,16,rep,name=time_entries,json=timeEntries,proto3" json:"time_entries,omitempty"`
+	// The amounts of the memo content, e.g. "$12.50 #food".
+	Amounts []*MemoPayload_Amount `protobuf:"bytes,17,rep,name=amounts,proto3" json:"amounts,omitempty"`
+	// The reading properties of the front matter of the memo content, set when it tracks a book or an article.
+	Reading *MemoPayload_Reading `protobuf:"bytes,18,opt,name=reading,proto3" json:"reading,omitempty"`
+	// The approval of the memo, set when its tags require one before it gets its visibility.
+	Approval *MemoPayload_Approval `protobuf:"bytes,19,opt,name=approval,proto3" json:"approval,omitempty"`
+	// The users who edited the content of the memo, in the order of their first edit.
+	Contributors []*MemoPayload_Contributor `protobuf:"bytes,20,rep,name=contributors,proto3" json:"contributors,omitempty"`
+	// The tags added by the tagging rules of the creator, kept in the tags when the payload is rebuilt.
+	RuleTags      []string `protobuf:"bytes,21,rep,name=rule_tags,json=ruleTags,proto3" json:"rule_tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +219,132 @@ func (x *MemoPayload) GetTags() []string {
 	return nil
 }
 
+func (x *MemoPayload) GetBrokenLinks() []*MemoPayload_BrokenLink {
+	if x != nil {
+		return x.BrokenLinks
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetLinkSnapshots() []*MemoPayload_LinkSnapshot {
+	if x != nil {
+		return x.LinkSnapshots
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetContentWarning() string {
+	if x != nil {
+		return x.ContentWarning
+	}
+	return ""
+}
+
+func (x *MemoPayload) GetExpiry() *MemoPayload_Expiry {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *MemoPayload) GetDetectedLanguage() string {
+	if x != nil {
+		return x.DetectedLanguage
+	}
+	return ""
+}
+
+func (x *MemoPayload) GetAiSummaryRefinements() []*MemoPayload_AISummaryRefinement {
+	if x != nil {
+		return x.AiSummaryRefinements
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetAiTags() []string {
+	if x != nil {
+		return x.AiTags
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetAiSummaryVersions() []*MemoPayload_AISummaryVersion {
+	if x != nil {
+		return x.AiSummaryVersions
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetAiSummarySource() *MemoPayload_AISummarySource {
+	if x != nil {
+		return x.AiSummarySource
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *MemoPayload) GetSyndications() []*MemoPayload_Syndication {
+	if x != nil {
+		return x.Syndications
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetTimeEntries() []*MemoPayload_TimeEntry {
+	if x != nil {
+		return x.TimeEntries
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetAmounts() []*MemoPayload_Amount {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetReading() *MemoPayload_Reading {
+	if x != nil {
+		return x.Reading
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetApproval() *MemoPayload_Approval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetContributors() []*MemoPayload_Contributor {
+	if x != nil {
+		return x.Contributors
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetRuleTags() []string {
+	if x != nil {
+		return x.RuleTags
+	}
+	return nil
+}
+
 // The calculated properties from the memo content.
 type MemoPayload_Property struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -88,6 +352,11 @@ type MemoPayload_Property struct {
 	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
 	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
 	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
+	HasBrokenLink      bool                   `protobuf:"varint,5,opt,name=has_broken_link,json=hasBrokenLink,proto3" json:"has_broken_link,omitempty"`
+	// Whether the memo was generated by the AI, set when it is created and kept when the payload is rebuilt.
+	IsAiGenerated bool `protobuf:"varint,6,opt,name=is_ai_generated,json=isAiGenerated,proto3" json:"is_ai_generated,omitempty"`
+	// The number of the completed tasks of the content.
+	CompletedTaskCount int32 `protobuf:"varint,7,opt,name=completed_task_count,json=completedTaskCount,proto3" json:"completed_task_count,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -150,6 +419,27 @@ func (x *MemoPayload_Property) GetHasIncompleteTasks() bool {
 	return false
 }
 
+func (x *MemoPayload_Property) GetHasBrokenLink() bool {
+	if x != nil {
+		return x.HasBrokenLink
+	}
+	return false
+}
+
+func (x *MemoPayload_Property) GetIsAiGenerated() bool {
+	if x != nil {
+		return x.IsAiGenerated
+	}
+	return false
+}
+
+func (x *MemoPayload_Property) GetCompletedTaskCount() int32 {
+	if x != nil {
+		return x.CompletedTaskCount
+	}
+	return 0
+}
+
 type MemoPayload_Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Placeholder   string                 `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
@@ -210,24 +500,943 @@ func (x *MemoPayload_Location) GetLongitude() float64 {
 	return 0
 }
 
-var File_store_memo_proto protoreflect.FileDescriptor
+type MemoPayload_BrokenLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The HTTP status code of the last check, 0 if the request failed.
+	StatusCode    int32 `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	CheckedTs     int64 `protobuf:"varint,3,opt,name=checked_ts,json=checkedTs,proto3" json:"checked_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_store_memo_proto_rawDesc = "" +
-	"\n" +
-	"\x10store/memo.proto\x12\vmemos.store\"\xa0\x03\n" +
-	"\vMemoPayload\x12=\n" +
-	"\bproperty\x18\x01 \x01(\v2!.memos.store.MemoPayload.PropertyR\bproperty\x12=\n" +
-	"\blocation\x18\x02 \x01(\v2!.memos.store.MemoPayload.LocationR\blocation\x12\x12\n" +
-	"\x04tags\x18\x03 \x03(\tR\x04tags\x1a\x96\x01\n" +
-	"\bProperty\x12\x19\n" +
-	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
-	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
-	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x120\n" +
-	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x1af\n" +
-	"\bLocation\x12 \n" +
-	"\vplaceholder\x18\x01 \x01(\tR\vplaceholder\x12\x1a\n" +
-	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x03 \x01(\x01R\tlongitudeB\x94\x01\n" +
+func (x *MemoPayload_BrokenLink) Reset() {
+	*x = MemoPayload_BrokenLink{}
+	mi := &file_store_memo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_BrokenLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_BrokenLink) ProtoMessage() {}
+
+func (x *MemoPayload_BrokenLink) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_BrokenLink.ProtoReflect.Descriptor instead.
+func (*MemoPayload_BrokenLink) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *MemoPayload_BrokenLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MemoPayload_BrokenLink) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *MemoPayload_BrokenLink) GetCheckedTs() int64 {
+	if x != nil {
+		return x.CheckedTs
+	}
+	return 0
+}
+
+type MemoPayload_LinkSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	SnapshotUrl   string                 `protobuf:"bytes,2,opt,name=snapshot_url,json=snapshotUrl,proto3" json:"snapshot_url,omitempty"`
+	CreatedTs     int64                  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_LinkSnapshot) Reset() {
+	*x = MemoPayload_LinkSnapshot{}
+	mi := &file_store_memo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_LinkSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_LinkSnapshot) ProtoMessage() {}
+
+func (x *MemoPayload_LinkSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_LinkSnapshot.ProtoReflect.Descriptor instead.
+func (*MemoPayload_LinkSnapshot) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *MemoPayload_LinkSnapshot) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MemoPayload_LinkSnapshot) GetSnapshotUrl() string {
+	if x != nil {
+		return x.SnapshotUrl
+	}
+	return ""
+}
+
+func (x *MemoPayload_LinkSnapshot) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+type MemoPayload_AISummaryRefinement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The follow-up instruction the summary was refined with.
+	Instruction string `protobuf:"bytes,1,opt,name=instruction,proto3" json:"instruction,omitempty"`
+	// The summary before the refinement.
+	PreviousSummary string `protobuf:"bytes,2,opt,name=previous_summary,json=previousSummary,proto3" json:"previous_summary,omitempty"`
+	CreatedTs       int64  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MemoPayload_AISummaryRefinement) Reset() {
+	*x = MemoPayload_AISummaryRefinement{}
+	mi := &file_store_memo_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_AISummaryRefinement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_AISummaryRefinement) ProtoMessage() {}
+
+func (x *MemoPayload_AISummaryRefinement) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_AISummaryRefinement.ProtoReflect.Descriptor instead.
+func (*MemoPayload_AISummaryRefinement) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *MemoPayload_AISummaryRefinement) GetInstruction() string {
+	if x != nil {
+		return x.Instruction
+	}
+	return ""
+}
+
+func (x *MemoPayload_AISummaryRefinement) GetPreviousSummary() string {
+	if x != nil {
+		return x.PreviousSummary
+	}
+	return ""
+}
+
+func (x *MemoPayload_AISummaryRefinement) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+type MemoPayload_AISummaryVersion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The content of the memo before the regeneration.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// The time the version was replaced.
+	ReplacedTs    int64 `protobuf:"varint,2,opt,name=replaced_ts,json=replacedTs,proto3" json:"replaced_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_AISummaryVersion) Reset() {
+	*x = MemoPayload_AISummaryVersion{}
+	mi := &file_store_memo_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_AISummaryVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_AISummaryVersion) ProtoMessage() {}
+
+func (x *MemoPayload_AISummaryVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_AISummaryVersion.ProtoReflect.Descriptor instead.
+func (*MemoPayload_AISummaryVersion) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *MemoPayload_AISummaryVersion) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MemoPayload_AISummaryVersion) GetReplacedTs() int64 {
+	if x != nil {
+		return x.ReplacedTs
+	}
+	return 0
+}
+
+type MemoPayload_AISummarySource struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TimeRange       string                 `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Tags            []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	StartDate       string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	ComparePrevious bool                   `protobuf:"varint,5,opt,name=compare_previous,json=comparePrevious,proto3" json:"compare_previous,omitempty"`
+	PromptTemplate  string                 `protobuf:"bytes,6,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
+	// memo_names are the names of the memos the summary was requested for, if any.
+	MemoNames []string `protobuf:"bytes,7,rep,name=memo_names,json=memoNames,proto3" json:"memo_names,omitempty"`
+	// filter is the CEL filter of the memos the summary was requested for, if any.
+	Filter string `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+	// model is the model the summary was generated with, empty for the summaries generated before it was recorded.
+	Model string `protobuf:"bytes,9,opt,name=model,proto3" json:"model,omitempty"`
+	// timezone is the IANA timezone of the dates of the custom time range, empty for UTC.
+	Timezone      string `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_AISummarySource) Reset() {
+	*x = MemoPayload_AISummarySource{}
+	mi := &file_store_memo_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_AISummarySource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_AISummarySource) ProtoMessage() {}
+
+func (x *MemoPayload_AISummarySource) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_AISummarySource.ProtoReflect.Descriptor instead.
+func (*MemoPayload_AISummarySource) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (x *MemoPayload_AISummarySource) GetTimeRange() string {
+	if x != nil {
+		return x.TimeRange
+	}
+	return ""
+}
+
+func (x *MemoPayload_AISummarySource) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MemoPayload_AISummarySource) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *MemoPayload_AISummarySource) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *MemoPayload_AISummarySource) GetComparePrevious() bool {
+	if x != nil {
+		return x.ComparePrevious
+	}
+	return false
+}
+
+func (x *MemoPayload_AISummarySource) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *MemoPayload_AISummarySource) GetMemoNames() []string {
+	if x != nil {
+		return x.MemoNames
+	}
+	return nil
+}
+
+func (x *MemoPayload_AISummarySource) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *MemoPayload_AISummarySource) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *MemoPayload_AISummarySource) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type MemoPayload_TimeEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// minutes is the time spent.
+	Minutes int32 `protobuf:"varint,1,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	// tags are the tags on the line of the entry, lowercased.
+	Tags          []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_TimeEntry) Reset() {
+	*x = MemoPayload_TimeEntry{}
+	mi := &file_store_memo_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_TimeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_TimeEntry) ProtoMessage() {}
+
+func (x *MemoPayload_TimeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_TimeEntry.ProtoReflect.Descriptor instead.
+func (*MemoPayload_TimeEntry) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 7}
+}
+
+func (x *MemoPayload_TimeEntry) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+func (x *MemoPayload_TimeEntry) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type MemoPayload_Amount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// value is negative for the amounts written with a minus sign.
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	// currency is the currency symbol of the amount, e.g. "$".
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// tags are the tags on the line of the amount, lowercased.
+	Tags          []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Amount) Reset() {
+	*x = MemoPayload_Amount{}
+	mi := &file_store_memo_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Amount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Amount) ProtoMessage() {}
+
+func (x *MemoPayload_Amount) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Amount.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Amount) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 8}
+}
+
+func (x *MemoPayload_Amount) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *MemoPayload_Amount) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *MemoPayload_Amount) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type MemoPayload_Reading struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Author string                 `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	// status is lowercase with underscores, e.g. "to_read", "reading" or "finished".
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// rating is from 1 to 10, 0 if unrated.
+	Rating int32 `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	// finished_date is in the format "YYYY-MM-DD".
+	FinishedDate  string `protobuf:"bytes,4,opt,name=finished_date,json=finishedDate,proto3" json:"finished_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Reading) Reset() {
+	*x = MemoPayload_Reading{}
+	mi := &file_store_memo_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Reading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Reading) ProtoMessage() {}
+
+func (x *MemoPayload_Reading) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Reading.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Reading) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 9}
+}
+
+func (x *MemoPayload_Reading) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *MemoPayload_Reading) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MemoPayload_Reading) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *MemoPayload_Reading) GetFinishedDate() string {
+	if x != nil {
+		return x.FinishedDate
+	}
+	return ""
+}
+
+type MemoPayload_Contributor struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LastEditTs int64                  `protobuf:"varint,2,opt,name=last_edit_ts,json=lastEditTs,proto3" json:"last_edit_ts,omitempty"`
+	// edit_count is the number of the edits of the content by the user, the creation included.
+	EditCount     int32 `protobuf:"varint,3,opt,name=edit_count,json=editCount,proto3" json:"edit_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Contributor) Reset() {
+	*x = MemoPayload_Contributor{}
+	mi := &file_store_memo_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Contributor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Contributor) ProtoMessage() {}
+
+func (x *MemoPayload_Contributor) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Contributor.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Contributor) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 10}
+}
+
+func (x *MemoPayload_Contributor) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MemoPayload_Contributor) GetLastEditTs() int64 {
+	if x != nil {
+		return x.LastEditTs
+	}
+	return 0
+}
+
+func (x *MemoPayload_Contributor) GetEditCount() int32 {
+	if x != nil {
+		return x.EditCount
+	}
+	return 0
+}
+
+type MemoPayload_Approval struct {
+	state protoimpl.MessageState     `protogen:"open.v1"`
+	State MemoPayload_Approval_State `protobuf:"varint,1,opt,name=state,proto3,enum=memos.store.MemoPayload_Approval_State" json:"state,omitempty"`
+	// requested_visibility is the visibility the memo gets when approved, it is private until then.
+	RequestedVisibility string `protobuf:"bytes,2,opt,name=requested_visibility,json=requestedVisibility,proto3" json:"requested_visibility,omitempty"`
+	RequestedTs         int64  `protobuf:"varint,3,opt,name=requested_ts,json=requestedTs,proto3" json:"requested_ts,omitempty"`
+	ReviewerId          int32  `protobuf:"varint,4,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	ReviewedTs          int64  `protobuf:"varint,5,opt,name=reviewed_ts,json=reviewedTs,proto3" json:"reviewed_ts,omitempty"`
+	// reason is the reason of a rejection.
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Approval) Reset() {
+	*x = MemoPayload_Approval{}
+	mi := &file_store_memo_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Approval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Approval) ProtoMessage() {}
+
+func (x *MemoPayload_Approval) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Approval.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Approval) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 11}
+}
+
+func (x *MemoPayload_Approval) GetState() MemoPayload_Approval_State {
+	if x != nil {
+		return x.State
+	}
+	return MemoPayload_Approval_STATE_UNSPECIFIED
+}
+
+func (x *MemoPayload_Approval) GetRequestedVisibility() string {
+	if x != nil {
+		return x.RequestedVisibility
+	}
+	return ""
+}
+
+func (x *MemoPayload_Approval) GetRequestedTs() int64 {
+	if x != nil {
+		return x.RequestedTs
+	}
+	return 0
+}
+
+func (x *MemoPayload_Approval) GetReviewerId() int32 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *MemoPayload_Approval) GetReviewedTs() int64 {
+	if x != nil {
+		return x.ReviewedTs
+	}
+	return 0
+}
+
+func (x *MemoPayload_Approval) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MemoPayload_Syndication struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// platform is the platform the memo was cross-posted to, e.g. "mastodon".
+	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	// url is the URL of the copy on the platform.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// remote_id is the ID of the copy on the platform, to delete it.
+	RemoteId      string `protobuf:"bytes,3,opt,name=remote_id,json=remoteId,proto3" json:"remote_id,omitempty"`
+	CreatedTs     int64  `protobuf:"varint,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Syndication) Reset() {
+	*x = MemoPayload_Syndication{}
+	mi := &file_store_memo_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Syndication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Syndication) ProtoMessage() {}
+
+func (x *MemoPayload_Syndication) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Syndication.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Syndication) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 12}
+}
+
+func (x *MemoPayload_Syndication) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *MemoPayload_Syndication) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MemoPayload_Syndication) GetRemoteId() string {
+	if x != nil {
+		return x.RemoteId
+	}
+	return ""
+}
+
+func (x *MemoPayload_Syndication) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+type MemoPayload_Expiry struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	ExpireTs      int64                    `protobuf:"varint,1,opt,name=expire_ts,json=expireTs,proto3" json:"expire_ts,omitempty"`
+	Action        MemoPayload_ExpiryAction `protobuf:"varint,2,opt,name=action,proto3,enum=memos.store.MemoPayload_ExpiryAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Expiry) Reset() {
+	*x = MemoPayload_Expiry{}
+	mi := &file_store_memo_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Expiry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Expiry) ProtoMessage() {}
+
+func (x *MemoPayload_Expiry) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Expiry.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Expiry) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 13}
+}
+
+func (x *MemoPayload_Expiry) GetExpireTs() int64 {
+	if x != nil {
+		return x.ExpireTs
+	}
+	return 0
+}
+
+func (x *MemoPayload_Expiry) GetAction() MemoPayload_ExpiryAction {
+	if x != nil {
+		return x.Action
+	}
+	return MemoPayload_EXPIRY_ACTION_UNSPECIFIED
+}
+
+var File_store_memo_proto protoreflect.FileDescriptor
+
+const file_store_memo_proto_rawDesc = "" +
+	"\n" +
+	"\x10store/memo.proto\x12\vmemos.store\"\x80\x1a\n" +
+	"\vMemoPayload\x12=\n" +
+	"\bproperty\x18\x01 \x01(\v2!.memos.store.MemoPayload.PropertyR\bproperty\x12=\n" +
+	"\blocation\x18\x02 \x01(\v2!.memos.store.MemoPayload.LocationR\blocation\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12F\n" +
+	"\fbroken_links\x18\x04 \x03(\v2#.memos.store.MemoPayload.BrokenLinkR\vbrokenLinks\x12L\n" +
+	"\x0elink_snapshots\x18\x05 \x03(\v2%.memos.store.MemoPayload.LinkSnapshotR\rlinkSnapshots\x12'\n" +
+	"\x0fcontent_warning\x18\x06 \x01(\tR\x0econtentWarning\x127\n" +
+	"\x06expiry\x18\a \x01(\v2\x1f.memos.store.MemoPayload.ExpiryR\x06expiry\x12\x1a\n" +
+	"\blanguage\x18\b \x01(\tR\blanguage\x12+\n" +
+	"\x11detected_language\x18\t \x01(\tR\x10detectedLanguage\x12b\n" +
+	"\x16ai_summary_refinements\x18\n" +
+	" \x03(\v2,.memos.store.MemoPayload.AISummaryRefinementR\x14aiSummaryRefinements\x12\x17\n" +
+	"\aai_tags\x18\v \x03(\tR\x06aiTags\x12Y\n" +
+	"\x13ai_summary_versions\x18\f \x03(\v2).memos.store.MemoPayload.AISummaryVersionR\x11aiSummaryVersions\x12T\n" +
+	"\x11ai_summary_source\x18\r \x01(\v2(.memos.store.MemoPayload.AISummarySourceR\x0faiSummarySource\x12\x12\n" +
+	"\x04slug\x18\x0e \x01(\tR\x04slug\x12H\n" +
+	"\fsyndications\x18\x0f \x03(\v2$.memos.store.MemoPayload.SyndicationR\fsyndications\x12E\n" +
+	"\ftime_entries\x18\x10 \x03(\v2\".memos.store.MemoPayload.TimeEntryR\vtimeEntries\x129\n" +
+	"\aamounts\x18\x11 \x03(\v2\x1f.memos.store.MemoPayload.AmountR\aamounts\x12:\n" +
+	"\areading\x18\x12 \x01(\v2 .memos.store.MemoPayload.ReadingR\areading\x12=\n" +
+	"\bapproval\x18\x13 \x01(\v2!.memos.store.MemoPayload.ApprovalR\bapproval\x12H\n" +
+	"\fcontributors\x18\x14 \x03(\v2$.memos.store.MemoPayload.ContributorR\fcontributors\x12\x1b\n" +
+	"\trule_tags\x18\x15 \x03(\tR\bruleTags\x1a\x98\x02\n" +
+	"\bProperty\x12\x19\n" +
+	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
+	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
+	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x120\n" +
+	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x12&\n" +
+	"\x0fhas_broken_link\x18\x05 \x01(\bR\rhasBrokenLink\x12&\n" +
+	"\x0fis_ai_generated\x18\x06 \x01(\bR\risAiGenerated\x120\n" +
+	"\x14completed_task_count\x18\a \x01(\x05R\x12completedTaskCount\x1af\n" +
+	"\bLocation\x12 \n" +
+	"\vplaceholder\x18\x01 \x01(\tR\vplaceholder\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x1a^\n" +
+	"\n" +
+	"BrokenLink\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x12\x1d\n" +
+	"\n" +
+	"checked_ts\x18\x03 \x01(\x03R\tcheckedTs\x1ab\n" +
+	"\fLinkSnapshot\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12!\n" +
+	"\fsnapshot_url\x18\x02 \x01(\tR\vsnapshotUrl\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x03 \x01(\x03R\tcreatedTs\x1a\x81\x01\n" +
+	"\x13AISummaryRefinement\x12 \n" +
+	"\vinstruction\x18\x01 \x01(\tR\vinstruction\x12)\n" +
+	"\x10previous_summary\x18\x02 \x01(\tR\x0fpreviousSummary\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x03 \x01(\x03R\tcreatedTs\x1aM\n" +
+	"\x10AISummaryVersion\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1f\n" +
+	"\vreplaced_ts\x18\x02 \x01(\x03R\n" +
+	"replacedTs\x1a\xbb\x02\n" +
+	"\x0fAISummarySource\x12\x1d\n" +
+	"\n" +
+	"time_range\x18\x01 \x01(\tR\ttimeRange\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12)\n" +
+	"\x10compare_previous\x18\x05 \x01(\bR\x0fcomparePrevious\x12'\n" +
+	"\x0fprompt_template\x18\x06 \x01(\tR\x0epromptTemplate\x12\x1d\n" +
+	"\n" +
+	"memo_names\x18\a \x03(\tR\tmemoNames\x12\x16\n" +
+	"\x06filter\x18\b \x01(\tR\x06filter\x12\x14\n" +
+	"\x05model\x18\t \x01(\tR\x05model\x12\x1a\n" +
+	"\btimezone\x18\n" +
+	" \x01(\tR\btimezone\x1a9\n" +
+	"\tTimeEntry\x12\x18\n" +
+	"\aminutes\x18\x01 \x01(\x05R\aminutes\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x1aN\n" +
+	"\x06Amount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x1av\n" +
+	"\aReading\x12\x16\n" +
+	"\x06author\x18\x01 \x01(\tR\x06author\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12#\n" +
+	"\rfinished_date\x18\x04 \x01(\tR\ffinishedDate\x1ag\n" +
+	"\vContributor\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12 \n" +
+	"\flast_edit_ts\x18\x02 \x01(\x03R\n" +
+	"lastEditTs\x12\x1d\n" +
+	"\n" +
+	"edit_count\x18\x03 \x01(\x05R\teditCount\x1a\xc2\x02\n" +
+	"\bApproval\x12=\n" +
+	"\x05state\x18\x01 \x01(\x0e2'.memos.store.MemoPayload.Approval.StateR\x05state\x121\n" +
+	"\x14requested_visibility\x18\x02 \x01(\tR\x13requestedVisibility\x12!\n" +
+	"\frequested_ts\x18\x03 \x01(\x03R\vrequestedTs\x12\x1f\n" +
+	"\vreviewer_id\x18\x04 \x01(\x05R\n" +
+	"reviewerId\x12\x1f\n" +
+	"\vreviewed_ts\x18\x05 \x01(\x03R\n" +
+	"reviewedTs\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"G\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\f\n" +
+	"\bAPPROVED\x10\x02\x12\f\n" +
+	"\bREJECTED\x10\x03\x1aw\n" +
+	"\vSyndication\x12\x1a\n" +
+	"\bplatform\x18\x01 \x01(\tR\bplatform\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1b\n" +
+	"\tremote_id\x18\x03 \x01(\tR\bremoteId\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x04 \x01(\x03R\tcreatedTs\x1ad\n" +
+	"\x06Expiry\x12\x1b\n" +
+	"\texpire_ts\x18\x01 \x01(\x03R\bexpireTs\x12=\n" +
+	"\x06action\x18\x02 \x01(\x0e2%.memos.store.MemoPayload.ExpiryActionR\x06action\"F\n" +
+	"\fExpiryAction\x12\x1d\n" +
+	"\x19EXPIRY_ACTION_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aARCHIVE\x10\x01\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x02B\x94\x01\n" +
 	"\x0fcom.memos.storeB\tMemoProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -242,20 +1451,49 @@ func file_store_memo_proto_rawDescGZIP() []byte {
 	return file_store_memo_proto_rawDescData
 }
 
-var file_store_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_store_memo_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_store_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_store_memo_proto_goTypes = []any{
-	(*MemoPayload)(nil),          // 0: memos.store.MemoPayload
-	(*MemoPayload_Property)(nil), // 1: memos.store.MemoPayload.Property
-	(*MemoPayload_Location)(nil), // 2: memos.store.MemoPayload.Location
+	(MemoPayload_ExpiryAction)(0),           // 0: memos.store.MemoPayload.ExpiryAction
+	(MemoPayload_Approval_State)(0),         // 1: memos.store.MemoPayload.Approval.State
+	(*MemoPayload)(nil),                     // 2: memos.store.MemoPayload
+	(*MemoPayload_Property)(nil),            // 3: memos.store.MemoPayload.Property
+	(*MemoPayload_Location)(nil),            // 4: memos.store.MemoPayload.Location
+	(*MemoPayload_BrokenLink)(nil),          // 5: memos.store.MemoPayload.BrokenLink
+	(*MemoPayload_LinkSnapshot)(nil),        // 6: memos.store.MemoPayload.LinkSnapshot
+	(*MemoPayload_AISummaryRefinement)(nil), // 7: memos.store.MemoPayload.AISummaryRefinement
+	(*MemoPayload_AISummaryVersion)(nil),    // 8: memos.store.MemoPayload.AISummaryVersion
+	(*MemoPayload_AISummarySource)(nil),     // 9: memos.store.MemoPayload.AISummarySource
+	(*MemoPayload_TimeEntry)(nil),           // 10: memos.store.MemoPayload.TimeEntry
+	(*MemoPayload_Amount)(nil),              // 11: memos.store.MemoPayload.Amount
+	(*MemoPayload_Reading)(nil),             // 12: memos.store.MemoPayload.Reading
+	(*MemoPayload_Contributor)(nil),         // 13: memos.store.MemoPayload.Contributor
+	(*MemoPayload_Approval)(nil),            // 14: memos.store.MemoPayload.Approval
+	(*MemoPayload_Syndication)(nil),         // 15: memos.store.MemoPayload.Syndication
+	(*MemoPayload_Expiry)(nil),              // 16: memos.store.MemoPayload.Expiry
 }
 var file_store_memo_proto_depIdxs = []int32{
-	1, // 0: memos.store.MemoPayload.property:type_name -> memos.store.MemoPayload.Property
-	2, // 1: memos.store.MemoPayload.location:type_name -> memos.store.MemoPayload.Location
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3,  // 0: memos.store.MemoPayload.property:type_name -> memos.store.MemoPayload.Property
+	4,  // 1: memos.store.MemoPayload.location:type_name -> memos.store.MemoPayload.Location
+	5,  // 2: memos.store.MemoPayload.broken_links:type_name -> memos.store.MemoPayload.BrokenLink
+	6,  // 3: memos.store.MemoPayload.link_snapshots:type_name -> memos.store.MemoPayload.LinkSnapshot
+	16, // 4: memos.store.MemoPayload.expiry:type_name -> memos.store.MemoPayload.Expiry
+	7,  // 5: memos.store.MemoPayload.ai_summary_refinements:type_name -> memos.store.MemoPayload.AISummaryRefinement
+	8,  // 6: memos.store.MemoPayload.ai_summary_versions:type_name -> memos.store.MemoPayload.AISummaryVersion
+	9,  // 7: memos.store.MemoPayload.ai_summary_source:type_name -> memos.store.MemoPayload.AISummarySource
+	15, // 8: memos.store.MemoPayload.syndications:type_name -> memos.store.MemoPayload.Syndication
+	10, // 9: memos.store.MemoPayload.time_entries:type_name -> memos.store.MemoPayload.TimeEntry
+	11, // 10: memos.store.MemoPayload.amounts:type_name -> memos.store.MemoPayload.Amount
+	12, // 11: memos.store.MemoPayload.reading:type_name -> memos.store.MemoPayload.Reading
+	14, // 12: memos.store.MemoPayload.approval:type_name -> memos.store.MemoPayload.Approval
+	13, // 13: memos.store.MemoPayload.contributors:type_name -> memos.store.MemoPayload.Contributor
+	1,  // 14: memos.store.MemoPayload.Approval.state:type_name -> memos.store.MemoPayload.Approval.State
+	0,  // 15: memos.store.MemoPayload.Expiry.action:type_name -> memos.store.MemoPayload.ExpiryAction
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_store_memo_proto_init() }
@@ -268,13 +1506,14 @@ func file_store_memo_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_memo_proto_rawDesc), len(file_store_memo_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_memo_proto_goTypes,
 		DependencyIndexes: file_store_memo_proto_depIdxs,
+		EnumInfos:         file_store_memo_proto_enumTypes,
 		MessageInfos:      file_store_memo_proto_msgTypes,
 	}.Build()
 	File_store_memo_proto = out.File
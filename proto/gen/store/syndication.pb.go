@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: store/syndication.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SyndicationSubscriptionPayload selects the public memos of another instance that are mirrored.
+type SyndicationSubscriptionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// creators are the users of the other instance whose memos are mirrored, e.g. "users/1". All the users when empty.
+	Creators []string `protobuf:"bytes,1,rep,name=creators,proto3" json:"creators,omitempty"`
+	// tags restrict the mirrored memos to the ones with one of the tags. All the memos when empty.
+	Tags          []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyndicationSubscriptionPayload) Reset() {
+	*x = SyndicationSubscriptionPayload{}
+	mi := &file_store_syndication_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyndicationSubscriptionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyndicationSubscriptionPayload) ProtoMessage() {}
+
+func (x *SyndicationSubscriptionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_syndication_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyndicationSubscriptionPayload.ProtoReflect.Descriptor instead.
+func (*SyndicationSubscriptionPayload) Descriptor() ([]byte, []int) {
+	return file_store_syndication_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SyndicationSubscriptionPayload) GetCreators() []string {
+	if x != nil {
+		return x.Creators
+	}
+	return nil
+}
+
+func (x *SyndicationSubscriptionPayload) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// FederatedMemoPayload attributes a mirrored memo to its creator on its origin instance.
+type FederatedMemoPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// creator is the resource name of the creator on the origin instance, e.g. "users/1".
+	Creator            string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreatorUsername    string   `protobuf:"bytes,2,opt,name=creator_username,json=creatorUsername,proto3" json:"creator_username,omitempty"`
+	CreatorDisplayName string   `protobuf:"bytes,3,opt,name=creator_display_name,json=creatorDisplayName,proto3" json:"creator_display_name,omitempty"`
+	Tags               []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FederatedMemoPayload) Reset() {
+	*x = FederatedMemoPayload{}
+	mi := &file_store_syndication_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FederatedMemoPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedMemoPayload) ProtoMessage() {}
+
+func (x *FederatedMemoPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_syndication_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedMemoPayload.ProtoReflect.Descriptor instead.
+func (*FederatedMemoPayload) Descriptor() ([]byte, []int) {
+	return file_store_syndication_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FederatedMemoPayload) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *FederatedMemoPayload) GetCreatorUsername() string {
+	if x != nil {
+		return x.CreatorUsername
+	}
+	return ""
+}
+
+func (x *FederatedMemoPayload) GetCreatorDisplayName() string {
+	if x != nil {
+		return x.CreatorDisplayName
+	}
+	return ""
+}
+
+func (x *FederatedMemoPayload) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+var File_store_syndication_proto protoreflect.FileDescriptor
+
+const file_store_syndication_proto_rawDesc = "" +
+	"\n" +
+	"\x17store/syndication.proto\x12\vmemos.store\"P\n" +
+	"\x1eSyndicationSubscriptionPayload\x12\x1a\n" +
+	"\bcreators\x18\x01 \x03(\tR\bcreators\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"\xa1\x01\n" +
+	"\x14FederatedMemoPayload\x12\x18\n" +
+	"\acreator\x18\x01 \x01(\tR\acreator\x12)\n" +
+	"\x10creator_username\x18\x02 \x01(\tR\x0fcreatorUsername\x120\n" +
+	"\x14creator_display_name\x18\x03 \x01(\tR\x12creatorDisplayName\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tagsB\x9b\x01\n" +
+	"\x0fcom.memos.storeB\x10SyndicationProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
+
+var (
+	file_store_syndication_proto_rawDescOnce sync.Once
+	file_store_syndication_proto_rawDescData []byte
+)
+
+func file_store_syndication_proto_rawDescGZIP() []byte {
+	file_store_syndication_proto_rawDescOnce.Do(func() {
+		file_store_syndication_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_store_syndication_proto_rawDesc), len(file_store_syndication_proto_rawDesc)))
+	})
+	return file_store_syndication_proto_rawDescData
+}
+
+var file_store_syndication_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_syndication_proto_goTypes = []any{
+	(*SyndicationSubscriptionPayload)(nil), // 0: memos.store.SyndicationSubscriptionPayload
+	(*FederatedMemoPayload)(nil),           // 1: memos.store.FederatedMemoPayload
+}
+var file_store_syndication_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_store_syndication_proto_init() }
+func file_store_syndication_proto_init() {
+	if File_store_syndication_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_syndication_proto_rawDesc), len(file_store_syndication_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_syndication_proto_goTypes,
+		DependencyIndexes: file_store_syndication_proto_depIdxs,
+		MessageInfos:      file_store_syndication_proto_msgTypes,
+	}.Build()
+	File_store_syndication_proto = out.File
+	file_store_syndication_proto_goTypes = nil
+	file_store_syndication_proto_depIdxs = nil
+}
@@ -36,25 +36,85 @@ const (
 	UserSetting_SHORTCUTS UserSetting_Key = 4
 	// The webhooks of the user.
 	UserSetting_WEBHOOKS UserSetting_Key = 5
+	// The admin approval of the user.
+	UserSetting_APPROVAL UserSetting_Key = 6
+	// The feature flag overrides of the user.
+	UserSetting_FEATURE_FLAGS UserSetting_Key = 7
+	// The metadata of the user's tags.
+	UserSetting_TAG_METAS UserSetting_Key = 8
+	// The conversations of the user with the AI about their memos.
+	UserSetting_AI_CONVERSATIONS UserSetting_Key = 9
+	// The schedule of the user's recurring AI summaries.
+	UserSetting_AI_AUTO_SUMMARY UserSetting_Key = 10
+	// The public profile of the user.
+	UserSetting_PROFILE UserSetting_Key = 11
+	// The consent of the user to the legal pages of the workspace.
+	UserSetting_LEGAL_CONSENT UserSetting_Key = 12
+	// The announcements dismissed by the user.
+	UserSetting_DISMISSED_ANNOUNCEMENTS UserSetting_Key = 13
+	// The publishing of the user's public memos to Nostr.
+	UserSetting_NOSTR UserSetting_Key = 14
+	// The cross-posting of the user's public memos to Bluesky.
+	UserSetting_BLUESKY UserSetting_Key = 15
+	// The cross-posting of the user's public memos to Mastodon.
+	UserSetting_MASTODON UserSetting_Key = 16
+	// The cross-posting of the user's public memos to a Micropub endpoint.
+	UserSetting_MICROPUB UserSetting_Key = 17
+	// The IndieAuth identity the Micropub clients of the user authenticate with.
+	UserSetting_INDIEAUTH UserSetting_Key = 18
+	// The rules tagging the user's memos when they are saved.
+	UserSetting_TAGGING_RULES UserSetting_Key = 19
+	// The formatting of the content of the user's memos when they are saved.
+	UserSetting_FORMATTING UserSetting_Key = 20
 )
 
 // Enum value maps for UserSetting_Key.
 var (
 	UserSetting_Key_name = map[int32]string{
-		0: "KEY_UNSPECIFIED",
-		1: "GENERAL",
-		2: "SESSIONS",
-		3: "ACCESS_TOKENS",
-		4: "SHORTCUTS",
-		5: "WEBHOOKS",
+		0:  "KEY_UNSPECIFIED",
+		1:  "GENERAL",
+		2:  "SESSIONS",
+		3:  "ACCESS_TOKENS",
+		4:  "SHORTCUTS",
+		5:  "WEBHOOKS",
+		6:  "APPROVAL",
+		7:  "FEATURE_FLAGS",
+		8:  "TAG_METAS",
+		9:  "AI_CONVERSATIONS",
+		10: "AI_AUTO_SUMMARY",
+		11: "PROFILE",
+		12: "LEGAL_CONSENT",
+		13: "DISMISSED_ANNOUNCEMENTS",
+		14: "NOSTR",
+		15: "BLUESKY",
+		16: "MASTODON",
+		17: "MICROPUB",
+		18: "INDIEAUTH",
+		19: "TAGGING_RULES",
+		20: "FORMATTING",
 	}
 	UserSetting_Key_value = map[string]int32{
-		"KEY_UNSPECIFIED": 0,
-		"GENERAL":         1,
-		"SESSIONS":        2,
-		"ACCESS_TOKENS":   3,
-		"SHORTCUTS":       4,
-		"WEBHOOKS":        5,
+		"KEY_UNSPECIFIED":         0,
+		"GENERAL":                 1,
+		"SESSIONS":                2,
+		"ACCESS_TOKENS":           3,
+		"SHORTCUTS":               4,
+		"WEBHOOKS":                5,
+		"APPROVAL":                6,
+		"FEATURE_FLAGS":           7,
+		"TAG_METAS":               8,
+		"AI_CONVERSATIONS":        9,
+		"AI_AUTO_SUMMARY":         10,
+		"PROFILE":                 11,
+		"LEGAL_CONSENT":           12,
+		"DISMISSED_ANNOUNCEMENTS": 13,
+		"NOSTR":                   14,
+		"BLUESKY":                 15,
+		"MASTODON":                16,
+		"MICROPUB":                17,
+		"INDIEAUTH":               18,
+		"TAGGING_RULES":           19,
+		"FORMATTING":              20,
 	}
 )
 
@@ -85,6 +145,61 @@ func (UserSetting_Key) EnumDescriptor() ([]byte, []int) {
 	return file_store_user_setting_proto_rawDescGZIP(), []int{0, 0}
 }
 
+// Visibility is who the fields of the profile are shown to, besides the user and the admins.
+type ProfileUserSetting_Visibility int32
+
+const (
+	// Unspecified is public.
+	ProfileUserSetting_VISIBILITY_UNSPECIFIED ProfileUserSetting_Visibility = 0
+	ProfileUserSetting_PUBLIC                 ProfileUserSetting_Visibility = 1
+	// Protected fields are shown to the signed-in users.
+	ProfileUserSetting_PROTECTED ProfileUserSetting_Visibility = 2
+	ProfileUserSetting_PRIVATE   ProfileUserSetting_Visibility = 3
+)
+
+// Enum value maps for ProfileUserSetting_Visibility.
+var (
+	ProfileUserSetting_Visibility_name = map[int32]string{
+		0: "VISIBILITY_UNSPECIFIED",
+		1: "PUBLIC",
+		2: "PROTECTED",
+		3: "PRIVATE",
+	}
+	ProfileUserSetting_Visibility_value = map[string]int32{
+		"VISIBILITY_UNSPECIFIED": 0,
+		"PUBLIC":                 1,
+		"PROTECTED":              2,
+		"PRIVATE":                3,
+	}
+)
+
+func (x ProfileUserSetting_Visibility) Enum() *ProfileUserSetting_Visibility {
+	p := new(ProfileUserSetting_Visibility)
+	*p = x
+	return p
+}
+
+func (x ProfileUserSetting_Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProfileUserSetting_Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[1].Descriptor()
+}
+
+func (ProfileUserSetting_Visibility) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[1]
+}
+
+func (x ProfileUserSetting_Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProfileUserSetting_Visibility.Descriptor instead.
+func (ProfileUserSetting_Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{11, 0}
+}
+
 type UserSetting struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -96,6 +211,21 @@ type UserSetting struct {
 	//	*UserSetting_AccessTokens
 	//	*UserSetting_Shortcuts
 	//	*UserSetting_Webhooks
+	//	*UserSetting_Approval
+	//	*UserSetting_FeatureFlags
+	//	*UserSetting_TagMetas
+	//	*UserSetting_AiConversations
+	//	*UserSetting_AiAutoSummary
+	//	*UserSetting_Profile
+	//	*UserSetting_LegalConsent
+	//	*UserSetting_DismissedAnnouncements
+	//	*UserSetting_Nostr
+	//	*UserSetting_Bluesky
+	//	*UserSetting_Mastodon
+	//	*UserSetting_Micropub
+	//	*UserSetting_IndieAuth
+	//	*UserSetting_TaggingRules
+	//	*UserSetting_Formatting
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -197,6 +327,141 @@ func (x *UserSetting) GetWebhooks() *WebhooksUserSetting {
 	return nil
 }
 
+func (x *UserSetting) GetApproval() *ApprovalUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Approval); ok {
+			return x.Approval
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetFeatureFlags() *FeatureFlagsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_FeatureFlags); ok {
+			return x.FeatureFlags
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetTagMetas() *TagMetasUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_TagMetas); ok {
+			return x.TagMetas
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetAiConversations() *AIConversationsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_AiConversations); ok {
+			return x.AiConversations
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetAiAutoSummary() *AIAutoSummaryUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_AiAutoSummary); ok {
+			return x.AiAutoSummary
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetProfile() *ProfileUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Profile); ok {
+			return x.Profile
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetLegalConsent() *LegalConsentUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_LegalConsent); ok {
+			return x.LegalConsent
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetDismissedAnnouncements() *DismissedAnnouncementsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_DismissedAnnouncements); ok {
+			return x.DismissedAnnouncements
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetNostr() *NostrUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Nostr); ok {
+			return x.Nostr
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetBluesky() *BlueskyUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Bluesky); ok {
+			return x.Bluesky
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetMastodon() *MastodonUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Mastodon); ok {
+			return x.Mastodon
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetMicropub() *MicropubUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Micropub); ok {
+			return x.Micropub
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetIndieAuth() *IndieAuthUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_IndieAuth); ok {
+			return x.IndieAuth
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetTaggingRules() *TaggingRulesUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_TaggingRules); ok {
+			return x.TaggingRules
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetFormatting() *FormattingUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Formatting); ok {
+			return x.Formatting
+		}
+	}
+	return nil
+}
+
 type isUserSetting_Value interface {
 	isUserSetting_Value()
 }
@@ -221,6 +486,66 @@ type UserSetting_Webhooks struct {
 	Webhooks *WebhooksUserSetting `protobuf:"bytes,7,opt,name=webhooks,proto3,oneof"`
 }
 
+type UserSetting_Approval struct {
+	Approval *ApprovalUserSetting `protobuf:"bytes,8,opt,name=approval,proto3,oneof"`
+}
+
+type UserSetting_FeatureFlags struct {
+	FeatureFlags *FeatureFlagsUserSetting `protobuf:"bytes,9,opt,name=feature_flags,json=featureFlags,proto3,oneof"`
+}
+
+type UserSetting_TagMetas struct {
+	TagMetas *TagMetasUserSetting `protobuf:"bytes,10,opt,name=tag_metas,json=tagMetas,proto3,oneof"`
+}
+
+type UserSetting_AiConversations struct {
+	AiConversations *AIConversationsUserSetting `protobuf:"bytes,11,opt,name=ai_conversations,json=aiConversations,proto3,oneof"`
+}
+
+type UserSetting_AiAutoSummary struct {
+	AiAutoSummary *AIAutoSummaryUserSetting `protobuf:"bytes,12,opt,name=ai_auto_summary,json=aiAutoSummary,proto3,oneof"`
+}
+
+type UserSetting_Profile struct {
+	Profile *ProfileUserSetting `protobuf:"bytes,13,opt,name=profile,proto3,oneof"`
+}
+
+type UserSetting_LegalConsent struct {
+	LegalConsent *LegalConsentUserSetting `protobuf:"bytes,14,opt,name=legal_consent,json=legalConsent,proto3,oneof"`
+}
+
+type UserSetting_DismissedAnnouncements struct {
+	DismissedAnnouncements *DismissedAnnouncementsUserSetting `protobuf:"bytes,15,opt,name=dismissed_announcements,json=dismissedAnnouncements,proto3,oneof"`
+}
+
+type UserSetting_Nostr struct {
+	Nostr *NostrUserSetting `protobuf:"bytes,16,opt,name=nostr,proto3,oneof"`
+}
+
+type UserSetting_Bluesky struct {
+	Bluesky *BlueskyUserSetting `protobuf:"bytes,17,opt,name=bluesky,proto3,oneof"`
+}
+
+type UserSetting_Mastodon struct {
+	Mastodon *MastodonUserSetting `protobuf:"bytes,18,opt,name=mastodon,proto3,oneof"`
+}
+
+type UserSetting_Micropub struct {
+	Micropub *MicropubUserSetting `protobuf:"bytes,19,opt,name=micropub,proto3,oneof"`
+}
+
+type UserSetting_IndieAuth struct {
+	IndieAuth *IndieAuthUserSetting `protobuf:"bytes,20,opt,name=indie_auth,json=indieAuth,proto3,oneof"`
+}
+
+type UserSetting_TaggingRules struct {
+	TaggingRules *TaggingRulesUserSetting `protobuf:"bytes,21,opt,name=tagging_rules,json=taggingRules,proto3,oneof"`
+}
+
+type UserSetting_Formatting struct {
+	Formatting *FormattingUserSetting `protobuf:"bytes,22,opt,name=formatting,proto3,oneof"`
+}
+
 func (*UserSetting_General) isUserSetting_Value() {}
 
 func (*UserSetting_Sessions) isUserSetting_Value() {}
@@ -231,6 +556,36 @@ func (*UserSetting_Shortcuts) isUserSetting_Value() {}
 
 func (*UserSetting_Webhooks) isUserSetting_Value() {}
 
+func (*UserSetting_Approval) isUserSetting_Value() {}
+
+func (*UserSetting_FeatureFlags) isUserSetting_Value() {}
+
+func (*UserSetting_TagMetas) isUserSetting_Value() {}
+
+func (*UserSetting_AiConversations) isUserSetting_Value() {}
+
+func (*UserSetting_AiAutoSummary) isUserSetting_Value() {}
+
+func (*UserSetting_Profile) isUserSetting_Value() {}
+
+func (*UserSetting_LegalConsent) isUserSetting_Value() {}
+
+func (*UserSetting_DismissedAnnouncements) isUserSetting_Value() {}
+
+func (*UserSetting_Nostr) isUserSetting_Value() {}
+
+func (*UserSetting_Bluesky) isUserSetting_Value() {}
+
+func (*UserSetting_Mastodon) isUserSetting_Value() {}
+
+func (*UserSetting_Micropub) isUserSetting_Value() {}
+
+func (*UserSetting_IndieAuth) isUserSetting_Value() {}
+
+func (*UserSetting_TaggingRules) isUserSetting_Value() {}
+
+func (*UserSetting_Formatting) isUserSetting_Value() {}
+
 type GeneralUserSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The user's locale.
@@ -239,7 +594,19 @@ type GeneralUserSetting struct {
 	MemoVisibility string `protobuf:"bytes,2,opt,name=memo_visibility,json=memoVisibility,proto3" json:"memo_visibility,omitempty"`
 	// The user's theme preference.
 	// This references a CSS file in the web/public/themes/ directory.
-	Theme         string `protobuf:"bytes,3,opt,name=theme,proto3" json:"theme,omitempty"`
+	Theme string `protobuf:"bytes,3,opt,name=theme,proto3" json:"theme,omitempty"`
+	// Whether links in the user's memos are submitted to the Wayback Machine.
+	ArchiveLinks bool `protobuf:"varint,4,opt,name=archive_links,json=archiveLinks,proto3" json:"archive_links,omitempty"`
+	// The number of reactions to a memo received at once from which they are notified
+	// as a single aggregated notification. 0 uses the default threshold.
+	ReactionNotificationThreshold int32 `protobuf:"varint,5,opt,name=reaction_notification_threshold,json=reactionNotificationThreshold,proto3" json:"reaction_notification_threshold,omitempty"`
+	// The IANA timezone of the user, e.g. "Europe/Paris". Empty is UTC.
+	Timezone string `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// The first day of the week of the user, from 0 for Sunday to 6 for Saturday.
+	WeekStartDay int32 `protobuf:"varint,7,opt,name=week_start_day,json=weekStartDay,proto3" json:"week_start_day,omitempty"`
+	// Whether the user opted in to the AI processing of their content in the AI operations of the other users,
+	// e.g. the workspace summaries. The AI operations of the user on their own memos do not need it.
+	AiOptIn       bool `protobuf:"varint,8,opt,name=ai_opt_in,json=aiOptIn,proto3" json:"ai_opt_in,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -295,6 +662,41 @@ func (x *GeneralUserSetting) GetTheme() string {
 	return ""
 }
 
+func (x *GeneralUserSetting) GetArchiveLinks() bool {
+	if x != nil {
+		return x.ArchiveLinks
+	}
+	return false
+}
+
+func (x *GeneralUserSetting) GetReactionNotificationThreshold() int32 {
+	if x != nil {
+		return x.ReactionNotificationThreshold
+	}
+	return 0
+}
+
+func (x *GeneralUserSetting) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GeneralUserSetting) GetWeekStartDay() int32 {
+	if x != nil {
+		return x.WeekStartDay
+	}
+	return 0
+}
+
+func (x *GeneralUserSetting) GetAiOptIn() bool {
+	if x != nil {
+		return x.AiOptIn
+	}
+	return false
+}
+
 type SessionsUserSetting struct {
 	state         protoimpl.MessageState         `protogen:"open.v1"`
 	Sessions      []*SessionsUserSetting_Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
@@ -471,35 +873,31 @@ func (x *WebhooksUserSetting) GetWebhooks() []*WebhooksUserSetting_Webhook {
 	return nil
 }
 
-type SessionsUserSetting_Session struct {
+type ApprovalUserSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique session identifier.
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	// Timestamp when the session was created.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// Timestamp when the session was last accessed.
-	// Used for sliding expiration calculation (last_accessed_time + 2 weeks).
-	LastAccessedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_accessed_time,json=lastAccessedTime,proto3" json:"last_accessed_time,omitempty"`
-	// Client information associated with this session.
-	ClientInfo    *SessionsUserSetting_ClientInfo `protobuf:"bytes,4,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
+	// Whether the user has been approved by an admin.
+	// Approved users are not subject to new user limits.
+	Approved bool `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"`
+	// Timestamp when the user was approved.
+	ApproveTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=approve_time,json=approveTime,proto3" json:"approve_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionsUserSetting_Session) Reset() {
-	*x = SessionsUserSetting_Session{}
+func (x *ApprovalUserSetting) Reset() {
+	*x = ApprovalUserSetting{}
 	mi := &file_store_user_setting_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionsUserSetting_Session) String() string {
+func (x *ApprovalUserSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionsUserSetting_Session) ProtoMessage() {}
+func (*ApprovalUserSetting) ProtoMessage() {}
 
-func (x *SessionsUserSetting_Session) ProtoReflect() protoreflect.Message {
+func (x *ApprovalUserSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_user_setting_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -511,69 +909,48 @@ func (x *SessionsUserSetting_Session) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionsUserSetting_Session.ProtoReflect.Descriptor instead.
-func (*SessionsUserSetting_Session) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 0}
-}
-
-func (x *SessionsUserSetting_Session) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *SessionsUserSetting_Session) GetCreateTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreateTime
-	}
-	return nil
+// Deprecated: Use ApprovalUserSetting.ProtoReflect.Descriptor instead.
+func (*ApprovalUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SessionsUserSetting_Session) GetLastAccessedTime() *timestamppb.Timestamp {
+func (x *ApprovalUserSetting) GetApproved() bool {
 	if x != nil {
-		return x.LastAccessedTime
+		return x.Approved
 	}
-	return nil
+	return false
 }
 
-func (x *SessionsUserSetting_Session) GetClientInfo() *SessionsUserSetting_ClientInfo {
+func (x *ApprovalUserSetting) GetApproveTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ClientInfo
+		return x.ApproveTime
 	}
 	return nil
 }
 
-type SessionsUserSetting_ClientInfo struct {
+type FeatureFlagsUserSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User agent string of the client.
-	UserAgent string `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	// IP address of the client.
-	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	// Optional. Device type (e.g., "mobile", "desktop", "tablet").
-	DeviceType string `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
-	// Optional. Operating system (e.g., "iOS 17.0", "Windows 11").
-	Os string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
-	// Optional. Browser name and version (e.g., "Chrome 119.0").
-	Browser       string `protobuf:"bytes,5,opt,name=browser,proto3" json:"browser,omitempty"`
+	// Overrides of workspace feature flags for the user, keyed by flag name.
+	// They take precedence over the workspace rollout.
+	Overrides     map[string]bool `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionsUserSetting_ClientInfo) Reset() {
-	*x = SessionsUserSetting_ClientInfo{}
+func (x *FeatureFlagsUserSetting) Reset() {
+	*x = FeatureFlagsUserSetting{}
 	mi := &file_store_user_setting_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionsUserSetting_ClientInfo) String() string {
+func (x *FeatureFlagsUserSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionsUserSetting_ClientInfo) ProtoMessage() {}
+func (*FeatureFlagsUserSetting) ProtoMessage() {}
 
-func (x *SessionsUserSetting_ClientInfo) ProtoReflect() protoreflect.Message {
+func (x *FeatureFlagsUserSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_user_setting_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -585,72 +962,1320 @@ func (x *SessionsUserSetting_ClientInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionsUserSetting_ClientInfo.ProtoReflect.Descriptor instead.
-func (*SessionsUserSetting_ClientInfo) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use FeatureFlagsUserSetting.ProtoReflect.Descriptor instead.
+func (*FeatureFlagsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SessionsUserSetting_ClientInfo) GetUserAgent() string {
+func (x *FeatureFlagsUserSetting) GetOverrides() map[string]bool {
 	if x != nil {
-		return x.UserAgent
+		return x.Overrides
 	}
-	return ""
+	return nil
 }
 
-func (x *SessionsUserSetting_ClientInfo) GetIpAddress() string {
-	if x != nil {
-		return x.IpAddress
-	}
-	return ""
+type TagMetasUserSetting struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	TagMetas      []*TagMetasUserSetting_TagMeta `protobuf:"bytes,1,rep,name=tag_metas,json=tagMetas,proto3" json:"tag_metas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionsUserSetting_ClientInfo) GetDeviceType() string {
-	if x != nil {
-		return x.DeviceType
-	}
-	return ""
+func (x *TagMetasUserSetting) Reset() {
+	*x = TagMetasUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagMetasUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagMetasUserSetting) ProtoMessage() {}
+
+func (x *TagMetasUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagMetasUserSetting.ProtoReflect.Descriptor instead.
+func (*TagMetasUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TagMetasUserSetting) GetTagMetas() []*TagMetasUserSetting_TagMeta {
+	if x != nil {
+		return x.TagMetas
+	}
+	return nil
+}
+
+type AIConversationsUserSetting struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	Conversations []*AIConversationsUserSetting_Conversation `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIConversationsUserSetting) Reset() {
+	*x = AIConversationsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIConversationsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIConversationsUserSetting) ProtoMessage() {}
+
+func (x *AIConversationsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIConversationsUserSetting.ProtoReflect.Descriptor instead.
+func (*AIConversationsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AIConversationsUserSetting) GetConversations() []*AIConversationsUserSetting_Conversation {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+type AIAutoSummaryUserSetting struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The number of days between two summaries, e.g. 7 for a weekly digest. 0 is daily.
+	FrequencyDays int32 `protobuf:"varint,2,opt,name=frequency_days,json=frequencyDays,proto3" json:"frequency_days,omitempty"`
+	// The hour of the day in the timezone, from 0 to 23, from which the summaries are generated.
+	Hour int32 `protobuf:"varint,3,opt,name=hour,proto3" json:"hour,omitempty"`
+	// The day of the week of the summaries, from 1 for Monday to 7 for Sunday. 0 is any day.
+	DayOfWeek int32 `protobuf:"varint,4,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	// The number of days before the summary whose memos are summarized. 0 is the frequency.
+	TimeRangeDays int32 `protobuf:"varint,5,opt,name=time_range_days,json=timeRangeDays,proto3" json:"time_range_days,omitempty"`
+	// The tags the summarized memos are restricted to, without the leading "#". Empty is all memos.
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The IANA timezone of the hour, e.g. "Europe/Paris". Empty is UTC.
+	Timezone string `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// The count of consecutive failures, the summaries are disabled when it reaches 3.
+	FailureCount int32 `protobuf:"varint,8,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// The time of the last summary attempt.
+	LastRunTs int64 `protobuf:"varint,9,opt,name=last_run_ts,json=lastRunTs,proto3" json:"last_run_ts,omitempty"`
+	// The visibility of the summary memos, "PUBLIC", "PROTECTED" or "PRIVATE". Empty is PRIVATE.
+	Visibility    string `protobuf:"bytes,10,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIAutoSummaryUserSetting) Reset() {
+	*x = AIAutoSummaryUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIAutoSummaryUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIAutoSummaryUserSetting) ProtoMessage() {}
+
+func (x *AIAutoSummaryUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIAutoSummaryUserSetting.ProtoReflect.Descriptor instead.
+func (*AIAutoSummaryUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AIAutoSummaryUserSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AIAutoSummaryUserSetting) GetFrequencyDays() int32 {
+	if x != nil {
+		return x.FrequencyDays
+	}
+	return 0
+}
+
+func (x *AIAutoSummaryUserSetting) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *AIAutoSummaryUserSetting) GetDayOfWeek() int32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *AIAutoSummaryUserSetting) GetTimeRangeDays() int32 {
+	if x != nil {
+		return x.TimeRangeDays
+	}
+	return 0
+}
+
+func (x *AIAutoSummaryUserSetting) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *AIAutoSummaryUserSetting) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *AIAutoSummaryUserSetting) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *AIAutoSummaryUserSetting) GetLastRunTs() int64 {
+	if x != nil {
+		return x.LastRunTs
+	}
+	return 0
+}
+
+func (x *AIAutoSummaryUserSetting) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type ProfileUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A short introduction of the user.
+	Bio           string                        `protobuf:"bytes,1,opt,name=bio,proto3" json:"bio,omitempty"`
+	BioVisibility ProfileUserSetting_Visibility `protobuf:"varint,2,opt,name=bio_visibility,json=bioVisibility,proto3,enum=memos.store.ProfileUserSetting_Visibility" json:"bio_visibility,omitempty"`
+	// The pronouns of the user, e.g. "they/them".
+	Pronouns           string                        `protobuf:"bytes,3,opt,name=pronouns,proto3" json:"pronouns,omitempty"`
+	PronounsVisibility ProfileUserSetting_Visibility `protobuf:"varint,4,opt,name=pronouns_visibility,json=pronounsVisibility,proto3,enum=memos.store.ProfileUserSetting_Visibility" json:"pronouns_visibility,omitempty"`
+	// The links of the user, e.g. to their website.
+	Links           []*ProfileUserSetting_Link    `protobuf:"bytes,5,rep,name=links,proto3" json:"links,omitempty"`
+	LinksVisibility ProfileUserSetting_Visibility `protobuf:"varint,6,opt,name=links_visibility,json=linksVisibility,proto3,enum=memos.store.ProfileUserSetting_Visibility" json:"links_visibility,omitempty"`
+	// Whether the memo statistics of the user are shown to the other users. Unset is public.
+	PublicStats *bool `protobuf:"varint,7,opt,name=public_stats,json=publicStats,proto3,oneof" json:"public_stats,omitempty"`
+	// Whether the activity calendar of the user is shared with the other users.
+	PublicActivityCalendar bool `protobuf:"varint,8,opt,name=public_activity_calendar,json=publicActivityCalendar,proto3" json:"public_activity_calendar,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ProfileUserSetting) Reset() {
+	*x = ProfileUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileUserSetting) ProtoMessage() {}
+
+func (x *ProfileUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileUserSetting.ProtoReflect.Descriptor instead.
+func (*ProfileUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProfileUserSetting) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *ProfileUserSetting) GetBioVisibility() ProfileUserSetting_Visibility {
+	if x != nil {
+		return x.BioVisibility
+	}
+	return ProfileUserSetting_VISIBILITY_UNSPECIFIED
+}
+
+func (x *ProfileUserSetting) GetPronouns() string {
+	if x != nil {
+		return x.Pronouns
+	}
+	return ""
+}
+
+func (x *ProfileUserSetting) GetPronounsVisibility() ProfileUserSetting_Visibility {
+	if x != nil {
+		return x.PronounsVisibility
+	}
+	return ProfileUserSetting_VISIBILITY_UNSPECIFIED
+}
+
+func (x *ProfileUserSetting) GetLinks() []*ProfileUserSetting_Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *ProfileUserSetting) GetLinksVisibility() ProfileUserSetting_Visibility {
+	if x != nil {
+		return x.LinksVisibility
+	}
+	return ProfileUserSetting_VISIBILITY_UNSPECIFIED
+}
+
+func (x *ProfileUserSetting) GetPublicStats() bool {
+	if x != nil && x.PublicStats != nil {
+		return *x.PublicStats
+	}
+	return false
+}
+
+func (x *ProfileUserSetting) GetPublicActivityCalendar() bool {
+	if x != nil {
+		return x.PublicActivityCalendar
+	}
+	return false
+}
+
+type LegalConsentUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The version of the legal pages the user consented to.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Timestamp when the user consented to the version.
+	ConsentTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=consent_time,json=consentTime,proto3" json:"consent_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegalConsentUserSetting) Reset() {
+	*x = LegalConsentUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegalConsentUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegalConsentUserSetting) ProtoMessage() {}
+
+func (x *LegalConsentUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegalConsentUserSetting.ProtoReflect.Descriptor instead.
+func (*LegalConsentUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LegalConsentUserSetting) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *LegalConsentUserSetting) GetConsentTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConsentTime
+	}
+	return nil
+}
+
+type DismissedAnnouncementsUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IDs of the announcements dismissed by the user.
+	AnnouncementIds []int32 `protobuf:"varint,1,rep,packed,name=announcement_ids,json=announcementIds,proto3" json:"announcement_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DismissedAnnouncementsUserSetting) Reset() {
+	*x = DismissedAnnouncementsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissedAnnouncementsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissedAnnouncementsUserSetting) ProtoMessage() {}
+
+func (x *DismissedAnnouncementsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissedAnnouncementsUserSetting.ProtoReflect.Descriptor instead.
+func (*DismissedAnnouncementsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DismissedAnnouncementsUserSetting) GetAnnouncementIds() []int32 {
+	if x != nil {
+		return x.AnnouncementIds
+	}
+	return nil
+}
+
+type NostrUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the public memos of the user are published to the relays.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The hex-encoded private key the notes are signed with, generated when the publishing is enabled first.
+	PrivateKey string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// The URLs of the relays the notes are published to, e.g. "wss://relay.example.com".
+	Relays        []string `protobuf:"bytes,3,rep,name=relays,proto3" json:"relays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NostrUserSetting) Reset() {
+	*x = NostrUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NostrUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NostrUserSetting) ProtoMessage() {}
+
+func (x *NostrUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NostrUserSetting.ProtoReflect.Descriptor instead.
+func (*NostrUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NostrUserSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *NostrUserSetting) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *NostrUserSetting) GetRelays() []string {
+	if x != nil {
+		return x.Relays
+	}
+	return nil
+}
+
+type BlueskyUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the public memos of the user are cross-posted.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The URL of the server hosting the account, e.g. "https://bsky.social".
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// The handle, email or DID the account is signed in with.
+	Identifier string `protobuf:"bytes,3,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// The app password the account is signed in with.
+	AppPassword string `protobuf:"bytes,4,opt,name=app_password,json=appPassword,proto3" json:"app_password,omitempty"`
+	// The DID of the account, resolved when the credentials are set.
+	Did string `protobuf:"bytes,5,opt,name=did,proto3" json:"did,omitempty"`
+	// The tag the memos must have to be cross-posted, including its subtags, e.g. "blog". Empty for all public memos.
+	Tag           string `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlueskyUserSetting) Reset() {
+	*x = BlueskyUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlueskyUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlueskyUserSetting) ProtoMessage() {}
+
+func (x *BlueskyUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlueskyUserSetting.ProtoReflect.Descriptor instead.
+func (*BlueskyUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BlueskyUserSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *BlueskyUserSetting) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *BlueskyUserSetting) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *BlueskyUserSetting) GetAppPassword() string {
+	if x != nil {
+		return x.AppPassword
+	}
+	return ""
+}
+
+func (x *BlueskyUserSetting) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *BlueskyUserSetting) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type MastodonUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the public memos of the user are cross-posted.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The base URL of the Mastodon instance of the account, e.g. "https://mastodon.social".
+	InstanceUrl string `protobuf:"bytes,2,opt,name=instance_url,json=instanceUrl,proto3" json:"instance_url,omitempty"`
+	// The access token of an application of the account with the write:statuses and write:media scopes.
+	AccessToken string `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The address of the account, e.g. "alice@mastodon.social", resolved when the credentials are set.
+	Account string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	// The tag the memos must have to be cross-posted, including its subtags. Empty for all public memos.
+	Tag           string `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MastodonUserSetting) Reset() {
+	*x = MastodonUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MastodonUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MastodonUserSetting) ProtoMessage() {}
+
+func (x *MastodonUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MastodonUserSetting.ProtoReflect.Descriptor instead.
+func (*MastodonUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MastodonUserSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MastodonUserSetting) GetInstanceUrl() string {
+	if x != nil {
+		return x.InstanceUrl
+	}
+	return ""
+}
+
+func (x *MastodonUserSetting) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *MastodonUserSetting) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *MastodonUserSetting) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type IndieAuthUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL identifying the user, e.g. their website, empty when IndieAuth is not used. It is only set once its
+	// page advertises the Micropub endpoint of the user.
+	Me string `protobuf:"bytes,1,opt,name=me,proto3" json:"me,omitempty"`
+	// The token endpoint verifying the access tokens issued for the URL, advertised by its page.
+	TokenEndpoint string `protobuf:"bytes,2,opt,name=token_endpoint,json=tokenEndpoint,proto3" json:"token_endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndieAuthUserSetting) Reset() {
+	*x = IndieAuthUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndieAuthUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndieAuthUserSetting) ProtoMessage() {}
+
+func (x *IndieAuthUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndieAuthUserSetting.ProtoReflect.Descriptor instead.
+func (*IndieAuthUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *IndieAuthUserSetting) GetMe() string {
+	if x != nil {
+		return x.Me
+	}
+	return ""
+}
+
+func (x *IndieAuthUserSetting) GetTokenEndpoint() string {
+	if x != nil {
+		return x.TokenEndpoint
+	}
+	return ""
+}
+
+type TaggingRulesUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rules, evaluated in order. All the conditions of a rule must hold for its actions to apply.
+	Rules         []*TaggingRulesUserSetting_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaggingRulesUserSetting) Reset() {
+	*x = TaggingRulesUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaggingRulesUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaggingRulesUserSetting) ProtoMessage() {}
+
+func (x *TaggingRulesUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaggingRulesUserSetting.ProtoReflect.Descriptor instead.
+func (*TaggingRulesUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TaggingRulesUserSetting) GetRules() []*TaggingRulesUserSetting_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type FormattingUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the headings are written as "# Heading", without closing hashes or underlines.
+	NormalizeHeadings bool `protobuf:"varint,1,opt,name=normalize_headings,json=normalizeHeadings,proto3" json:"normalize_headings,omitempty"`
+	// Whether the bare URLs are written as links, e.g. "<https://example.com>".
+	LinkUrls bool `protobuf:"varint,2,opt,name=link_urls,json=linkUrls,proto3" json:"link_urls,omitempty"`
+	// Whether the tags and the mentions are written with a consistent case, the case of the first occurrence of a
+	// tag and the username of a mentioned user.
+	NormalizeReferences bool `protobuf:"varint,3,opt,name=normalize_references,json=normalizeReferences,proto3" json:"normalize_references,omitempty"`
+	// Whether the trailing whitespace of the lines and the content is trimmed, the hard line breaks excepted.
+	TrimTrailingWhitespace bool `protobuf:"varint,4,opt,name=trim_trailing_whitespace,json=trimTrailingWhitespace,proto3" json:"trim_trailing_whitespace,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *FormattingUserSetting) Reset() {
+	*x = FormattingUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormattingUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormattingUserSetting) ProtoMessage() {}
+
+func (x *FormattingUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormattingUserSetting.ProtoReflect.Descriptor instead.
+func (*FormattingUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FormattingUserSetting) GetNormalizeHeadings() bool {
+	if x != nil {
+		return x.NormalizeHeadings
+	}
+	return false
+}
+
+func (x *FormattingUserSetting) GetLinkUrls() bool {
+	if x != nil {
+		return x.LinkUrls
+	}
+	return false
+}
+
+func (x *FormattingUserSetting) GetNormalizeReferences() bool {
+	if x != nil {
+		return x.NormalizeReferences
+	}
+	return false
+}
+
+func (x *FormattingUserSetting) GetTrimTrailingWhitespace() bool {
+	if x != nil {
+		return x.TrimTrailingWhitespace
+	}
+	return false
+}
+
+type MicropubUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the public memos of the user are cross-posted.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The URL of the Micropub endpoint.
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The access token of the endpoint with the create, delete and media scopes.
+	AccessToken string `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The URL of the media endpoint of the endpoint, if any, discovered when the credentials are set.
+	MediaEndpoint string `protobuf:"bytes,4,opt,name=media_endpoint,json=mediaEndpoint,proto3" json:"media_endpoint,omitempty"`
+	// The tag the memos must have to be cross-posted, including its subtags. Empty for all public memos.
+	Tag           string `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MicropubUserSetting) Reset() {
+	*x = MicropubUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MicropubUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MicropubUserSetting) ProtoMessage() {}
+
+func (x *MicropubUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MicropubUserSetting.ProtoReflect.Descriptor instead.
+func (*MicropubUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MicropubUserSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MicropubUserSetting) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *MicropubUserSetting) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *MicropubUserSetting) GetMediaEndpoint() string {
+	if x != nil {
+		return x.MediaEndpoint
+	}
+	return ""
+}
+
+func (x *MicropubUserSetting) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type SessionsUserSetting_Session struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique session identifier.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Timestamp when the session was created.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Timestamp when the session was last accessed.
+	// Used for sliding expiration calculation (last_accessed_time + 2 weeks).
+	LastAccessedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_accessed_time,json=lastAccessedTime,proto3" json:"last_accessed_time,omitempty"`
+	// Client information associated with this session.
+	ClientInfo    *SessionsUserSetting_ClientInfo `protobuf:"bytes,4,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionsUserSetting_Session) Reset() {
+	*x = SessionsUserSetting_Session{}
+	mi := &file_store_user_setting_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionsUserSetting_Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionsUserSetting_Session) ProtoMessage() {}
+
+func (x *SessionsUserSetting_Session) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionsUserSetting_Session.ProtoReflect.Descriptor instead.
+func (*SessionsUserSetting_Session) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *SessionsUserSetting_Session) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionsUserSetting_Session) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *SessionsUserSetting_Session) GetLastAccessedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedTime
+	}
+	return nil
+}
+
+func (x *SessionsUserSetting_Session) GetClientInfo() *SessionsUserSetting_ClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
+type SessionsUserSetting_ClientInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User agent string of the client.
+	UserAgent string `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// IP address of the client.
+	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// Optional. Device type (e.g., "mobile", "desktop", "tablet").
+	DeviceType string `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	// Optional. Operating system (e.g., "iOS 17.0", "Windows 11").
+	Os string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
+	// Optional. Browser name and version (e.g., "Chrome 119.0").
+	Browser       string `protobuf:"bytes,5,opt,name=browser,proto3" json:"browser,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionsUserSetting_ClientInfo) Reset() {
+	*x = SessionsUserSetting_ClientInfo{}
+	mi := &file_store_user_setting_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionsUserSetting_ClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionsUserSetting_ClientInfo) ProtoMessage() {}
+
+func (x *SessionsUserSetting_ClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionsUserSetting_ClientInfo.ProtoReflect.Descriptor instead.
+func (*SessionsUserSetting_ClientInfo) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *SessionsUserSetting_ClientInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SessionsUserSetting_ClientInfo) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *SessionsUserSetting_ClientInfo) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *SessionsUserSetting_ClientInfo) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *SessionsUserSetting_ClientInfo) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+type AccessTokensUserSetting_AccessToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The access token is a JWT token.
+	// Including expiration time, issuer, etc.
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// A description for the access token.
+	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessTokensUserSetting_AccessToken) Reset() {
+	*x = AccessTokensUserSetting_AccessToken{}
+	mi := &file_store_user_setting_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessTokensUserSetting_AccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessTokensUserSetting_AccessToken) ProtoMessage() {}
+
+func (x *AccessTokensUserSetting_AccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessTokensUserSetting_AccessToken.ProtoReflect.Descriptor instead.
+func (*AccessTokensUserSetting_AccessToken) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *AccessTokensUserSetting_AccessToken) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *AccessTokensUserSetting_AccessToken) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ShortcutsUserSetting_Shortcut struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutsUserSetting_Shortcut) Reset() {
+	*x = ShortcutsUserSetting_Shortcut{}
+	mi := &file_store_user_setting_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutsUserSetting_Shortcut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutsUserSetting_Shortcut) ProtoMessage() {}
+
+func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutsUserSetting_Shortcut.ProtoReflect.Descriptor instead.
+func (*ShortcutsUserSetting_Shortcut) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type WebhooksUserSetting_Webhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the webhook
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Descriptive title for the webhook
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The webhook URL endpoint
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// The activity types the webhook is subscribed to, e.g. "memos.memo.created".
+	// An empty list subscribes to all activity types.
+	Events []string `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	// The secret used to sign the request bodies.
+	Secret        string `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreatedTs     int64  `protobuf:"varint,6,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	UpdatedTs     int64  `protobuf:"varint,7,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhooksUserSetting_Webhook) Reset() {
+	*x = WebhooksUserSetting_Webhook{}
+	mi := &file_store_user_setting_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhooksUserSetting_Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhooksUserSetting_Webhook) ProtoMessage() {}
+
+func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *SessionsUserSetting_ClientInfo) GetOs() string {
+// Deprecated: Use WebhooksUserSetting_Webhook.ProtoReflect.Descriptor instead.
+func (*WebhooksUserSetting_Webhook) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *WebhooksUserSetting_Webhook) GetId() string {
 	if x != nil {
-		return x.Os
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SessionsUserSetting_ClientInfo) GetBrowser() string {
+func (x *WebhooksUserSetting_Webhook) GetTitle() string {
 	if x != nil {
-		return x.Browser
+		return x.Title
 	}
 	return ""
 }
 
-type AccessTokensUserSetting_AccessToken struct {
+func (x *WebhooksUserSetting_Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhooksUserSetting_Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WebhooksUserSetting_Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhooksUserSetting_Webhook) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *WebhooksUserSetting_Webhook) GetUpdatedTs() int64 {
+	if x != nil {
+		return x.UpdatedTs
+	}
+	return 0
+}
+
+type TagMetasUserSetting_TagMeta struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The access token is a JWT token.
-	// Including expiration time, issuer, etc.
-	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	// A description for the access token.
-	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The tag without the leading "#".
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The hex color of the tag, e.g. "#ff8800".
+	Color string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Emoji string `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	// The position of the tag among the pinned tags, 0 when the tag is not pinned.
+	PinnedOrder   int32  `protobuf:"varint,5,opt,name=pinned_order,json=pinnedOrder,proto3" json:"pinned_order,omitempty"`
+	Description   string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AccessTokensUserSetting_AccessToken) Reset() {
-	*x = AccessTokensUserSetting_AccessToken{}
-	mi := &file_store_user_setting_proto_msgTypes[8]
+func (x *TagMetasUserSetting_TagMeta) Reset() {
+	*x = TagMetasUserSetting_TagMeta{}
+	mi := &file_store_user_setting_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AccessTokensUserSetting_AccessToken) String() string {
+func (x *TagMetasUserSetting_TagMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessTokensUserSetting_AccessToken) ProtoMessage() {}
+func (*TagMetasUserSetting_TagMeta) ProtoMessage() {}
 
-func (x *AccessTokensUserSetting_AccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[8]
+func (x *TagMetasUserSetting_TagMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -661,49 +2286,149 @@ func (x *AccessTokensUserSetting_AccessToken) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessTokensUserSetting_AccessToken.ProtoReflect.Descriptor instead.
-func (*AccessTokensUserSetting_AccessToken) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use TagMetasUserSetting_TagMeta.ProtoReflect.Descriptor instead.
+func (*TagMetasUserSetting_TagMeta) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{8, 0}
 }
 
-func (x *AccessTokensUserSetting_AccessToken) GetAccessToken() string {
+func (x *TagMetasUserSetting_TagMeta) GetId() string {
 	if x != nil {
-		return x.AccessToken
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AccessTokensUserSetting_AccessToken) GetDescription() string {
+func (x *TagMetasUserSetting_TagMeta) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagMetasUserSetting_TagMeta) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *TagMetasUserSetting_TagMeta) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *TagMetasUserSetting_TagMeta) GetPinnedOrder() int32 {
+	if x != nil {
+		return x.PinnedOrder
+	}
+	return 0
+}
+
+func (x *TagMetasUserSetting_TagMeta) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-type ShortcutsUserSetting_Shortcut struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+type AIConversationsUserSetting_Message struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The author of the message, "user" or "assistant".
+	Role    string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// The IDs of the memos cited by an answer.
+	CitationMemoIds []int32 `protobuf:"varint,3,rep,packed,name=citation_memo_ids,json=citationMemoIds,proto3" json:"citation_memo_ids,omitempty"`
+	CreatedTs       int64   `protobuf:"varint,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AIConversationsUserSetting_Message) Reset() {
+	*x = AIConversationsUserSetting_Message{}
+	mi := &file_store_user_setting_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIConversationsUserSetting_Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIConversationsUserSetting_Message) ProtoMessage() {}
+
+func (x *AIConversationsUserSetting_Message) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIConversationsUserSetting_Message.ProtoReflect.Descriptor instead.
+func (*AIConversationsUserSetting_Message) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *AIConversationsUserSetting_Message) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AIConversationsUserSetting_Message) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AIConversationsUserSetting_Message) GetCitationMemoIds() []int32 {
+	if x != nil {
+		return x.CitationMemoIds
+	}
+	return nil
+}
+
+func (x *AIConversationsUserSetting_Message) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+type AIConversationsUserSetting_Conversation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The messages of the conversation, oldest first.
+	Messages      []*AIConversationsUserSetting_Message `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	CreatedTs     int64                                 `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	UpdatedTs     int64                                 `protobuf:"varint,4,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShortcutsUserSetting_Shortcut) Reset() {
-	*x = ShortcutsUserSetting_Shortcut{}
-	mi := &file_store_user_setting_proto_msgTypes[9]
+func (x *AIConversationsUserSetting_Conversation) Reset() {
+	*x = AIConversationsUserSetting_Conversation{}
+	mi := &file_store_user_setting_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShortcutsUserSetting_Shortcut) String() string {
+func (x *AIConversationsUserSetting_Conversation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortcutsUserSetting_Shortcut) ProtoMessage() {}
+func (*AIConversationsUserSetting_Conversation) ProtoMessage() {}
 
-func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[9]
+func (x *AIConversationsUserSetting_Conversation) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,59 +2439,128 @@ func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortcutsUserSetting_Shortcut.ProtoReflect.Descriptor instead.
-func (*ShortcutsUserSetting_Shortcut) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use AIConversationsUserSetting_Conversation.ProtoReflect.Descriptor instead.
+func (*AIConversationsUserSetting_Conversation) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{9, 1}
 }
 
-func (x *ShortcutsUserSetting_Shortcut) GetId() string {
+func (x *AIConversationsUserSetting_Conversation) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ShortcutsUserSetting_Shortcut) GetTitle() string {
+func (x *AIConversationsUserSetting_Conversation) GetMessages() []*AIConversationsUserSetting_Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *AIConversationsUserSetting_Conversation) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *AIConversationsUserSetting_Conversation) GetUpdatedTs() int64 {
+	if x != nil {
+		return x.UpdatedTs
+	}
+	return 0
+}
+
+type ProfileUserSetting_Link struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileUserSetting_Link) Reset() {
+	*x = ProfileUserSetting_Link{}
+	mi := &file_store_user_setting_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileUserSetting_Link) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileUserSetting_Link) ProtoMessage() {}
+
+func (x *ProfileUserSetting_Link) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileUserSetting_Link.ProtoReflect.Descriptor instead.
+func (*ProfileUserSetting_Link) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *ProfileUserSetting_Link) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *ShortcutsUserSetting_Shortcut) GetFilter() string {
+func (x *ProfileUserSetting_Link) GetUrl() string {
 	if x != nil {
-		return x.Filter
+		return x.Url
 	}
 	return ""
 }
 
-type WebhooksUserSetting_Webhook struct {
+type TaggingRulesUserSetting_Rule struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier for the webhook
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Descriptive title for the webhook
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// The webhook URL endpoint
-	Url           string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// content_pattern is a regular expression the content must match, empty for any content.
+	ContentPattern string `protobuf:"bytes,2,opt,name=content_pattern,json=contentPattern,proto3" json:"content_pattern,omitempty"`
+	// link_domain is the domain a link of the content must be on, including its subdomains, empty for any links.
+	LinkDomain string `protobuf:"bytes,3,opt,name=link_domain,json=linkDomain,proto3" json:"link_domain,omitempty"`
+	// weekdays are the days of the week the memo must be created on, from 0 for Sunday to 6 for Saturday.
+	// Empty for any day.
+	Weekdays []int32 `protobuf:"varint,4,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// start_hour and end_hour are the hours of the day the memo must be created between, from start_hour
+	// included to end_hour excluded, wrapping around midnight when start_hour is after end_hour. Both 0 for any hour.
+	StartHour int32 `protobuf:"varint,5,opt,name=start_hour,json=startHour,proto3" json:"start_hour,omitempty"`
+	EndHour   int32 `protobuf:"varint,6,opt,name=end_hour,json=endHour,proto3" json:"end_hour,omitempty"`
+	// tags are the tags added to the memo, without the leading "#".
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// visibility is the visibility set on the memo when it is created, e.g. "PRIVATE". Empty to keep it.
+	Visibility    string `protobuf:"bytes,8,opt,name=visibility,proto3" json:"visibility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebhooksUserSetting_Webhook) Reset() {
-	*x = WebhooksUserSetting_Webhook{}
-	mi := &file_store_user_setting_proto_msgTypes[10]
+func (x *TaggingRulesUserSetting_Rule) Reset() {
+	*x = TaggingRulesUserSetting_Rule{}
+	mi := &file_store_user_setting_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebhooksUserSetting_Webhook) String() string {
+func (x *TaggingRulesUserSetting_Rule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebhooksUserSetting_Webhook) ProtoMessage() {}
+func (*TaggingRulesUserSetting_Rule) ProtoMessage() {}
 
-func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[10]
+func (x *TaggingRulesUserSetting_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,28 +2571,63 @@ func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebhooksUserSetting_Webhook.ProtoReflect.Descriptor instead.
-func (*WebhooksUserSetting_Webhook) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use TaggingRulesUserSetting_Rule.ProtoReflect.Descriptor instead.
+func (*TaggingRulesUserSetting_Rule) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{18, 0}
 }
 
-func (x *WebhooksUserSetting_Webhook) GetId() string {
+func (x *TaggingRulesUserSetting_Rule) GetTitle() string {
 	if x != nil {
-		return x.Id
+		return x.Title
 	}
 	return ""
 }
 
-func (x *WebhooksUserSetting_Webhook) GetTitle() string {
+func (x *TaggingRulesUserSetting_Rule) GetContentPattern() string {
 	if x != nil {
-		return x.Title
+		return x.ContentPattern
 	}
 	return ""
 }
 
-func (x *WebhooksUserSetting_Webhook) GetUrl() string {
+func (x *TaggingRulesUserSetting_Rule) GetLinkDomain() string {
 	if x != nil {
-		return x.Url
+		return x.LinkDomain
+	}
+	return ""
+}
+
+func (x *TaggingRulesUserSetting_Rule) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *TaggingRulesUserSetting_Rule) GetStartHour() int32 {
+	if x != nil {
+		return x.StartHour
+	}
+	return 0
+}
+
+func (x *TaggingRulesUserSetting_Rule) GetEndHour() int32 {
+	if x != nil {
+		return x.EndHour
+	}
+	return 0
+}
+
+func (x *TaggingRulesUserSetting_Rule) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TaggingRulesUserSetting_Rule) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
 	}
 	return ""
 }
@@ -807,7 +2636,7 @@ var File_store_user_setting_proto protoreflect.FileDescriptor
 
 const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\x93\x04\n" +
+	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc7\x0e\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12.\n" +
 	"\x03key\x18\x02 \x01(\x0e2\x1c.memos.store.UserSetting.KeyR\x03key\x12;\n" +
@@ -815,19 +2644,60 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\bsessions\x18\x04 \x01(\v2 .memos.store.SessionsUserSettingH\x00R\bsessions\x12K\n" +
 	"\raccess_tokens\x18\x05 \x01(\v2$.memos.store.AccessTokensUserSettingH\x00R\faccessTokens\x12A\n" +
 	"\tshortcuts\x18\x06 \x01(\v2!.memos.store.ShortcutsUserSettingH\x00R\tshortcuts\x12>\n" +
-	"\bwebhooks\x18\a \x01(\v2 .memos.store.WebhooksUserSettingH\x00R\bwebhooks\"e\n" +
+	"\bwebhooks\x18\a \x01(\v2 .memos.store.WebhooksUserSettingH\x00R\bwebhooks\x12>\n" +
+	"\bapproval\x18\b \x01(\v2 .memos.store.ApprovalUserSettingH\x00R\bapproval\x12K\n" +
+	"\rfeature_flags\x18\t \x01(\v2$.memos.store.FeatureFlagsUserSettingH\x00R\ffeatureFlags\x12?\n" +
+	"\ttag_metas\x18\n" +
+	" \x01(\v2 .memos.store.TagMetasUserSettingH\x00R\btagMetas\x12T\n" +
+	"\x10ai_conversations\x18\v \x01(\v2'.memos.store.AIConversationsUserSettingH\x00R\x0faiConversations\x12O\n" +
+	"\x0fai_auto_summary\x18\f \x01(\v2%.memos.store.AIAutoSummaryUserSettingH\x00R\raiAutoSummary\x12;\n" +
+	"\aprofile\x18\r \x01(\v2\x1f.memos.store.ProfileUserSettingH\x00R\aprofile\x12K\n" +
+	"\rlegal_consent\x18\x0e \x01(\v2$.memos.store.LegalConsentUserSettingH\x00R\flegalConsent\x12i\n" +
+	"\x17dismissed_announcements\x18\x0f \x01(\v2..memos.store.DismissedAnnouncementsUserSettingH\x00R\x16dismissedAnnouncements\x125\n" +
+	"\x05nostr\x18\x10 \x01(\v2\x1d.memos.store.NostrUserSettingH\x00R\x05nostr\x12;\n" +
+	"\abluesky\x18\x11 \x01(\v2\x1f.memos.store.BlueskyUserSettingH\x00R\abluesky\x12>\n" +
+	"\bmastodon\x18\x12 \x01(\v2 .memos.store.MastodonUserSettingH\x00R\bmastodon\x12>\n" +
+	"\bmicropub\x18\x13 \x01(\v2 .memos.store.MicropubUserSettingH\x00R\bmicropub\x12B\n" +
+	"\n" +
+	"indie_auth\x18\x14 \x01(\v2!.memos.store.IndieAuthUserSettingH\x00R\tindieAuth\x12K\n" +
+	"\rtagging_rules\x18\x15 \x01(\v2$.memos.store.TaggingRulesUserSettingH\x00R\ftaggingRules\x12D\n" +
+	"\n" +
+	"formatting\x18\x16 \x01(\v2\".memos.store.FormattingUserSettingH\x00R\n" +
+	"formatting\"\xe3\x02\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\f\n" +
 	"\bSESSIONS\x10\x02\x12\x11\n" +
 	"\rACCESS_TOKENS\x10\x03\x12\r\n" +
 	"\tSHORTCUTS\x10\x04\x12\f\n" +
-	"\bWEBHOOKS\x10\x05B\a\n" +
-	"\x05value\"k\n" +
+	"\bWEBHOOKS\x10\x05\x12\f\n" +
+	"\bAPPROVAL\x10\x06\x12\x11\n" +
+	"\rFEATURE_FLAGS\x10\a\x12\r\n" +
+	"\tTAG_METAS\x10\b\x12\x14\n" +
+	"\x10AI_CONVERSATIONS\x10\t\x12\x13\n" +
+	"\x0fAI_AUTO_SUMMARY\x10\n" +
+	"\x12\v\n" +
+	"\aPROFILE\x10\v\x12\x11\n" +
+	"\rLEGAL_CONSENT\x10\f\x12\x1b\n" +
+	"\x17DISMISSED_ANNOUNCEMENTS\x10\r\x12\t\n" +
+	"\x05NOSTR\x10\x0e\x12\v\n" +
+	"\aBLUESKY\x10\x0f\x12\f\n" +
+	"\bMASTODON\x10\x10\x12\f\n" +
+	"\bMICROPUB\x10\x11\x12\r\n" +
+	"\tINDIEAUTH\x10\x12\x12\x11\n" +
+	"\rTAGGING_RULES\x10\x13\x12\x0e\n" +
+	"\n" +
+	"FORMATTING\x10\x14B\a\n" +
+	"\x05value\"\xb6\x02\n" +
 	"\x12GeneralUserSetting\x12\x16\n" +
 	"\x06locale\x18\x01 \x01(\tR\x06locale\x12'\n" +
 	"\x0fmemo_visibility\x18\x02 \x01(\tR\x0ememoVisibility\x12\x14\n" +
-	"\x05theme\x18\x03 \x01(\tR\x05theme\"\xf3\x03\n" +
+	"\x05theme\x18\x03 \x01(\tR\x05theme\x12#\n" +
+	"\rarchive_links\x18\x04 \x01(\bR\farchiveLinks\x12F\n" +
+	"\x1freaction_notification_threshold\x18\x05 \x01(\x05R\x1dreactionNotificationThreshold\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12$\n" +
+	"\x0eweek_start_day\x18\a \x01(\x05R\fweekStartDay\x12\x1a\n" +
+	"\tai_opt_in\x18\b \x01(\bR\aaiOptIn\"\xf3\x03\n" +
 	"\x13SessionsUserSetting\x12D\n" +
 	"\bsessions\x18\x01 \x03(\v2(.memos.store.SessionsUserSetting.SessionR\bsessions\x1a\xfd\x01\n" +
 	"\aSession\x12\x1d\n" +
@@ -858,13 +2728,139 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\bShortcut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
-	"\x06filter\x18\x03 \x01(\tR\x06filter\"\x9e\x01\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\"\x8d\x02\n" +
 	"\x13WebhooksUserSetting\x12D\n" +
-	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks\x1aA\n" +
+	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks\x1a\xaf\x01\n" +
 	"\aWebhook\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x10\n" +
-	"\x03url\x18\x03 \x01(\tR\x03urlB\x9b\x01\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x04 \x03(\tR\x06events\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\tR\x06secret\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x06 \x01(\x03R\tcreatedTs\x12\x1d\n" +
+	"\n" +
+	"updated_ts\x18\a \x01(\x03R\tupdatedTs\"p\n" +
+	"\x13ApprovalUserSetting\x12\x1a\n" +
+	"\bapproved\x18\x01 \x01(\bR\bapproved\x12=\n" +
+	"\fapprove_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vapproveTime\"\xaa\x01\n" +
+	"\x17FeatureFlagsUserSetting\x12Q\n" +
+	"\toverrides\x18\x01 \x03(\v23.memos.store.FeatureFlagsUserSetting.OverridesEntryR\toverrides\x1a<\n" +
+	"\x0eOverridesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\xfb\x01\n" +
+	"\x13TagMetasUserSetting\x12E\n" +
+	"\ttag_metas\x18\x01 \x03(\v2(.memos.store.TagMetasUserSetting.TagMetaR\btagMetas\x1a\x9c\x01\n" +
+	"\aTagMeta\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x14\n" +
+	"\x05emoji\x18\x04 \x01(\tR\x05emoji\x12!\n" +
+	"\fpinned_order\x18\x05 \x01(\x05R\vpinnedOrder\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"\xa9\x03\n" +
+	"\x1aAIConversationsUserSetting\x12Z\n" +
+	"\rconversations\x18\x01 \x03(\v24.memos.store.AIConversationsUserSetting.ConversationR\rconversations\x1a\x82\x01\n" +
+	"\aMessage\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12*\n" +
+	"\x11citation_memo_ids\x18\x03 \x03(\x05R\x0fcitationMemoIds\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x04 \x01(\x03R\tcreatedTs\x1a\xa9\x01\n" +
+	"\fConversation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12K\n" +
+	"\bmessages\x18\x02 \x03(\v2/.memos.store.AIConversationsUserSetting.MessageR\bmessages\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x03 \x01(\x03R\tcreatedTs\x12\x1d\n" +
+	"\n" +
+	"updated_ts\x18\x04 \x01(\x03R\tupdatedTs\"\xcc\x02\n" +
+	"\x18AIAutoSummaryUserSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12%\n" +
+	"\x0efrequency_days\x18\x02 \x01(\x05R\rfrequencyDays\x12\x12\n" +
+	"\x04hour\x18\x03 \x01(\x05R\x04hour\x12\x1e\n" +
+	"\vday_of_week\x18\x04 \x01(\x05R\tdayOfWeek\x12&\n" +
+	"\x0ftime_range_days\x18\x05 \x01(\x05R\rtimeRangeDays\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\x12#\n" +
+	"\rfailure_count\x18\b \x01(\x05R\ffailureCount\x12\x1e\n" +
+	"\vlast_run_ts\x18\t \x01(\x03R\tlastRunTs\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\n" +
+	" \x01(\tR\n" +
+	"visibility\"\xfa\x04\n" +
+	"\x12ProfileUserSetting\x12\x10\n" +
+	"\x03bio\x18\x01 \x01(\tR\x03bio\x12Q\n" +
+	"\x0ebio_visibility\x18\x02 \x01(\x0e2*.memos.store.ProfileUserSetting.VisibilityR\rbioVisibility\x12\x1a\n" +
+	"\bpronouns\x18\x03 \x01(\tR\bpronouns\x12[\n" +
+	"\x13pronouns_visibility\x18\x04 \x01(\x0e2*.memos.store.ProfileUserSetting.VisibilityR\x12pronounsVisibility\x12:\n" +
+	"\x05links\x18\x05 \x03(\v2$.memos.store.ProfileUserSetting.LinkR\x05links\x12U\n" +
+	"\x10links_visibility\x18\x06 \x01(\x0e2*.memos.store.ProfileUserSetting.VisibilityR\x0flinksVisibility\x12&\n" +
+	"\fpublic_stats\x18\a \x01(\bH\x00R\vpublicStats\x88\x01\x01\x128\n" +
+	"\x18public_activity_calendar\x18\b \x01(\bR\x16publicActivityCalendar\x1a.\n" +
+	"\x04Link\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"P\n" +
+	"\n" +
+	"Visibility\x12\x1a\n" +
+	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06PUBLIC\x10\x01\x12\r\n" +
+	"\tPROTECTED\x10\x02\x12\v\n" +
+	"\aPRIVATE\x10\x03B\x0f\n" +
+	"\r_public_stats\"r\n" +
+	"\x17LegalConsentUserSetting\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12=\n" +
+	"\fconsent_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vconsentTime\"N\n" +
+	"!DismissedAnnouncementsUserSetting\x12)\n" +
+	"\x10announcement_ids\x18\x01 \x03(\x05R\x0fannouncementIds\"e\n" +
+	"\x10NostrUserSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vprivate_key\x18\x02 \x01(\tR\n" +
+	"privateKey\x12\x16\n" +
+	"\x06relays\x18\x03 \x03(\tR\x06relays\"\xaf\x01\n" +
+	"\x12BlueskyUserSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x03 \x01(\tR\n" +
+	"identifier\x12!\n" +
+	"\fapp_password\x18\x04 \x01(\tR\vappPassword\x12\x10\n" +
+	"\x03did\x18\x05 \x01(\tR\x03did\x12\x10\n" +
+	"\x03tag\x18\x06 \x01(\tR\x03tag\"\xa1\x01\n" +
+	"\x13MastodonUserSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12!\n" +
+	"\finstance_url\x18\x02 \x01(\tR\vinstanceUrl\x12!\n" +
+	"\faccess_token\x18\x03 \x01(\tR\vaccessToken\x12\x18\n" +
+	"\aaccount\x18\x04 \x01(\tR\aaccount\x12\x10\n" +
+	"\x03tag\x18\x05 \x01(\tR\x03tag\"M\n" +
+	"\x14IndieAuthUserSetting\x12\x0e\n" +
+	"\x02me\x18\x01 \x01(\tR\x02me\x12%\n" +
+	"\x0etoken_endpoint\x18\x02 \x01(\tR\rtokenEndpoint\"\xcd\x02\n" +
+	"\x17TaggingRulesUserSetting\x12?\n" +
+	"\x05rules\x18\x01 \x03(\v2).memos.store.TaggingRulesUserSetting.RuleR\x05rules\x1a\xf0\x01\n" +
+	"\x04Rule\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12'\n" +
+	"\x0fcontent_pattern\x18\x02 \x01(\tR\x0econtentPattern\x12\x1f\n" +
+	"\vlink_domain\x18\x03 \x01(\tR\n" +
+	"linkDomain\x12\x1a\n" +
+	"\bweekdays\x18\x04 \x03(\x05R\bweekdays\x12\x1d\n" +
+	"\n" +
+	"start_hour\x18\x05 \x01(\x05R\tstartHour\x12\x19\n" +
+	"\bend_hour\x18\x06 \x01(\x05R\aendHour\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\b \x01(\tR\n" +
+	"visibility\"\xd0\x01\n" +
+	"\x15FormattingUserSetting\x12-\n" +
+	"\x12normalize_headings\x18\x01 \x01(\bR\x11normalizeHeadings\x12\x1b\n" +
+	"\tlink_urls\x18\x02 \x01(\bR\blinkUrls\x121\n" +
+	"\x14normalize_references\x18\x03 \x01(\bR\x13normalizeReferences\x128\n" +
+	"\x18trim_trailing_whitespace\x18\x04 \x01(\bR\x16trimTrailingWhitespace\"\xa7\x01\n" +
+	"\x13MicropubUserSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12!\n" +
+	"\faccess_token\x18\x03 \x01(\tR\vaccessToken\x12%\n" +
+	"\x0emedia_endpoint\x18\x04 \x01(\tR\rmediaEndpoint\x12\x10\n" +
+	"\x03tag\x18\x05 \x01(\tR\x03tagB\x9b\x01\n" +
 	"\x0fcom.memos.storeB\x10UserSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -879,42 +2875,90 @@ func file_store_user_setting_proto_rawDescGZIP() []byte {
 	return file_store_user_setting_proto_rawDescData
 }
 
-var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_store_user_setting_proto_goTypes = []any{
-	(UserSetting_Key)(0),                        // 0: memos.store.UserSetting.Key
-	(*UserSetting)(nil),                         // 1: memos.store.UserSetting
-	(*GeneralUserSetting)(nil),                  // 2: memos.store.GeneralUserSetting
-	(*SessionsUserSetting)(nil),                 // 3: memos.store.SessionsUserSetting
-	(*AccessTokensUserSetting)(nil),             // 4: memos.store.AccessTokensUserSetting
-	(*ShortcutsUserSetting)(nil),                // 5: memos.store.ShortcutsUserSetting
-	(*WebhooksUserSetting)(nil),                 // 6: memos.store.WebhooksUserSetting
-	(*SessionsUserSetting_Session)(nil),         // 7: memos.store.SessionsUserSetting.Session
-	(*SessionsUserSetting_ClientInfo)(nil),      // 8: memos.store.SessionsUserSetting.ClientInfo
-	(*AccessTokensUserSetting_AccessToken)(nil), // 9: memos.store.AccessTokensUserSetting.AccessToken
-	(*ShortcutsUserSetting_Shortcut)(nil),       // 10: memos.store.ShortcutsUserSetting.Shortcut
-	(*WebhooksUserSetting_Webhook)(nil),         // 11: memos.store.WebhooksUserSetting.Webhook
-	(*timestamppb.Timestamp)(nil),               // 12: google.protobuf.Timestamp
+	(UserSetting_Key)(0),                            // 0: memos.store.UserSetting.Key
+	(ProfileUserSetting_Visibility)(0),              // 1: memos.store.ProfileUserSetting.Visibility
+	(*UserSetting)(nil),                             // 2: memos.store.UserSetting
+	(*GeneralUserSetting)(nil),                      // 3: memos.store.GeneralUserSetting
+	(*SessionsUserSetting)(nil),                     // 4: memos.store.SessionsUserSetting
+	(*AccessTokensUserSetting)(nil),                 // 5: memos.store.AccessTokensUserSetting
+	(*ShortcutsUserSetting)(nil),                    // 6: memos.store.ShortcutsUserSetting
+	(*WebhooksUserSetting)(nil),                     // 7: memos.store.WebhooksUserSetting
+	(*ApprovalUserSetting)(nil),                     // 8: memos.store.ApprovalUserSetting
+	(*FeatureFlagsUserSetting)(nil),                 // 9: memos.store.FeatureFlagsUserSetting
+	(*TagMetasUserSetting)(nil),                     // 10: memos.store.TagMetasUserSetting
+	(*AIConversationsUserSetting)(nil),              // 11: memos.store.AIConversationsUserSetting
+	(*AIAutoSummaryUserSetting)(nil),                // 12: memos.store.AIAutoSummaryUserSetting
+	(*ProfileUserSetting)(nil),                      // 13: memos.store.ProfileUserSetting
+	(*LegalConsentUserSetting)(nil),                 // 14: memos.store.LegalConsentUserSetting
+	(*DismissedAnnouncementsUserSetting)(nil),       // 15: memos.store.DismissedAnnouncementsUserSetting
+	(*NostrUserSetting)(nil),                        // 16: memos.store.NostrUserSetting
+	(*BlueskyUserSetting)(nil),                      // 17: memos.store.BlueskyUserSetting
+	(*MastodonUserSetting)(nil),                     // 18: memos.store.MastodonUserSetting
+	(*IndieAuthUserSetting)(nil),                    // 19: memos.store.IndieAuthUserSetting
+	(*TaggingRulesUserSetting)(nil),                 // 20: memos.store.TaggingRulesUserSetting
+	(*FormattingUserSetting)(nil),                   // 21: memos.store.FormattingUserSetting
+	(*MicropubUserSetting)(nil),                     // 22: memos.store.MicropubUserSetting
+	(*SessionsUserSetting_Session)(nil),             // 23: memos.store.SessionsUserSetting.Session
+	(*SessionsUserSetting_ClientInfo)(nil),          // 24: memos.store.SessionsUserSetting.ClientInfo
+	(*AccessTokensUserSetting_AccessToken)(nil),     // 25: memos.store.AccessTokensUserSetting.AccessToken
+	(*ShortcutsUserSetting_Shortcut)(nil),           // 26: memos.store.ShortcutsUserSetting.Shortcut
+	(*WebhooksUserSetting_Webhook)(nil),             // 27: memos.store.WebhooksUserSetting.Webhook
+	nil,                                             // 28: memos.store.FeatureFlagsUserSetting.OverridesEntry
+	(*TagMetasUserSetting_TagMeta)(nil),             // 29: memos.store.TagMetasUserSetting.TagMeta
+	(*AIConversationsUserSetting_Message)(nil),      // 30: memos.store.AIConversationsUserSetting.Message
+	(*AIConversationsUserSetting_Conversation)(nil), // 31: memos.store.AIConversationsUserSetting.Conversation
+	(*ProfileUserSetting_Link)(nil),                 // 32: memos.store.ProfileUserSetting.Link
+	(*TaggingRulesUserSetting_Rule)(nil),            // 33: memos.store.TaggingRulesUserSetting.Rule
+	(*timestamppb.Timestamp)(nil),                   // 34: google.protobuf.Timestamp
 }
 var file_store_user_setting_proto_depIdxs = []int32{
 	0,  // 0: memos.store.UserSetting.key:type_name -> memos.store.UserSetting.Key
-	2,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
-	3,  // 2: memos.store.UserSetting.sessions:type_name -> memos.store.SessionsUserSetting
-	4,  // 3: memos.store.UserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting
-	5,  // 4: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
-	6,  // 5: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
-	7,  // 6: memos.store.SessionsUserSetting.sessions:type_name -> memos.store.SessionsUserSetting.Session
-	9,  // 7: memos.store.AccessTokensUserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting.AccessToken
-	10, // 8: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
-	11, // 9: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
-	12, // 10: memos.store.SessionsUserSetting.Session.create_time:type_name -> google.protobuf.Timestamp
-	12, // 11: memos.store.SessionsUserSetting.Session.last_accessed_time:type_name -> google.protobuf.Timestamp
-	8,  // 12: memos.store.SessionsUserSetting.Session.client_info:type_name -> memos.store.SessionsUserSetting.ClientInfo
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	3,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
+	4,  // 2: memos.store.UserSetting.sessions:type_name -> memos.store.SessionsUserSetting
+	5,  // 3: memos.store.UserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting
+	6,  // 4: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
+	7,  // 5: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
+	8,  // 6: memos.store.UserSetting.approval:type_name -> memos.store.ApprovalUserSetting
+	9,  // 7: memos.store.UserSetting.feature_flags:type_name -> memos.store.FeatureFlagsUserSetting
+	10, // 8: memos.store.UserSetting.tag_metas:type_name -> memos.store.TagMetasUserSetting
+	11, // 9: memos.store.UserSetting.ai_conversations:type_name -> memos.store.AIConversationsUserSetting
+	12, // 10: memos.store.UserSetting.ai_auto_summary:type_name -> memos.store.AIAutoSummaryUserSetting
+	13, // 11: memos.store.UserSetting.profile:type_name -> memos.store.ProfileUserSetting
+	14, // 12: memos.store.UserSetting.legal_consent:type_name -> memos.store.LegalConsentUserSetting
+	15, // 13: memos.store.UserSetting.dismissed_announcements:type_name -> memos.store.DismissedAnnouncementsUserSetting
+	16, // 14: memos.store.UserSetting.nostr:type_name -> memos.store.NostrUserSetting
+	17, // 15: memos.store.UserSetting.bluesky:type_name -> memos.store.BlueskyUserSetting
+	18, // 16: memos.store.UserSetting.mastodon:type_name -> memos.store.MastodonUserSetting
+	22, // 17: memos.store.UserSetting.micropub:type_name -> memos.store.MicropubUserSetting
+	19, // 18: memos.store.UserSetting.indie_auth:type_name -> memos.store.IndieAuthUserSetting
+	20, // 19: memos.store.UserSetting.tagging_rules:type_name -> memos.store.TaggingRulesUserSetting
+	21, // 20: memos.store.UserSetting.formatting:type_name -> memos.store.FormattingUserSetting
+	23, // 21: memos.store.SessionsUserSetting.sessions:type_name -> memos.store.SessionsUserSetting.Session
+	25, // 22: memos.store.AccessTokensUserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting.AccessToken
+	26, // 23: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
+	27, // 24: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
+	34, // 25: memos.store.ApprovalUserSetting.approve_time:type_name -> google.protobuf.Timestamp
+	28, // 26: memos.store.FeatureFlagsUserSetting.overrides:type_name -> memos.store.FeatureFlagsUserSetting.OverridesEntry
+	29, // 27: memos.store.TagMetasUserSetting.tag_metas:type_name -> memos.store.TagMetasUserSetting.TagMeta
+	31, // 28: memos.store.AIConversationsUserSetting.conversations:type_name -> memos.store.AIConversationsUserSetting.Conversation
+	1,  // 29: memos.store.ProfileUserSetting.bio_visibility:type_name -> memos.store.ProfileUserSetting.Visibility
+	1,  // 30: memos.store.ProfileUserSetting.pronouns_visibility:type_name -> memos.store.ProfileUserSetting.Visibility
+	32, // 31: memos.store.ProfileUserSetting.links:type_name -> memos.store.ProfileUserSetting.Link
+	1,  // 32: memos.store.ProfileUserSetting.links_visibility:type_name -> memos.store.ProfileUserSetting.Visibility
+	34, // 33: memos.store.LegalConsentUserSetting.consent_time:type_name -> google.protobuf.Timestamp
+	33, // 34: memos.store.TaggingRulesUserSetting.rules:type_name -> memos.store.TaggingRulesUserSetting.Rule
+	34, // 35: memos.store.SessionsUserSetting.Session.create_time:type_name -> google.protobuf.Timestamp
+	34, // 36: memos.store.SessionsUserSetting.Session.last_accessed_time:type_name -> google.protobuf.Timestamp
+	24, // 37: memos.store.SessionsUserSetting.Session.client_info:type_name -> memos.store.SessionsUserSetting.ClientInfo
+	30, // 38: memos.store.AIConversationsUserSetting.Conversation.messages:type_name -> memos.store.AIConversationsUserSetting.Message
+	39, // [39:39] is the sub-list for method output_type
+	39, // [39:39] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_store_user_setting_proto_init() }
@@ -928,14 +2972,30 @@ func file_store_user_setting_proto_init() {
 		(*UserSetting_AccessTokens)(nil),
 		(*UserSetting_Shortcuts)(nil),
 		(*UserSetting_Webhooks)(nil),
+		(*UserSetting_Approval)(nil),
+		(*UserSetting_FeatureFlags)(nil),
+		(*UserSetting_TagMetas)(nil),
+		(*UserSetting_AiConversations)(nil),
+		(*UserSetting_AiAutoSummary)(nil),
+		(*UserSetting_Profile)(nil),
+		(*UserSetting_LegalConsent)(nil),
+		(*UserSetting_DismissedAnnouncements)(nil),
+		(*UserSetting_Nostr)(nil),
+		(*UserSetting_Bluesky)(nil),
+		(*UserSetting_Mastodon)(nil),
+		(*UserSetting_Micropub)(nil),
+		(*UserSetting_IndieAuth)(nil),
+		(*UserSetting_TaggingRules)(nil),
+		(*UserSetting_Formatting)(nil),
 	}
+	file_store_user_setting_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_user_setting_proto_rawDesc), len(file_store_user_setting_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -35,20 +35,53 @@ const (
 	WorkspaceSettingKey_MEMO_RELATED WorkspaceSettingKey = 4
 	// AI_CONFIG is the key for AI configuration settings.
 	WorkspaceSettingKey_AI_CONFIG WorkspaceSettingKey = 5
-	// AI_RATE_LIMIT is the key for AI rate limit tracking.
+	// AI_RATE_LIMIT was the key for AI rate limit tracking, the counts are now kept in the ai_request_count table.
 	WorkspaceSettingKey_AI_RATE_LIMIT WorkspaceSettingKey = 6
+	// ONBOARDING is the key for onboarding settings.
+	WorkspaceSettingKey_ONBOARDING WorkspaceSettingKey = 7
+	// NEW_USER_LIMIT is the key for new user limit settings.
+	WorkspaceSettingKey_NEW_USER_LIMIT WorkspaceSettingKey = 8
+	// FEATURE_FLAGS is the key for feature flag settings.
+	WorkspaceSettingKey_FEATURE_FLAGS WorkspaceSettingKey = 9
+	// RUNNERS is the key for background runner settings.
+	WorkspaceSettingKey_RUNNERS WorkspaceSettingKey = 10
+	// USAGE_LIMIT is the key for workspace usage limit settings.
+	WorkspaceSettingKey_USAGE_LIMIT WorkspaceSettingKey = 11
+	// AI_USAGE is the key for AI usage tracking.
+	WorkspaceSettingKey_AI_USAGE WorkspaceSettingKey = 12
+	// SENSITIVE_CONTENT is the key for sensitive content classification settings.
+	WorkspaceSettingKey_SENSITIVE_CONTENT WorkspaceSettingKey = 13
+	// OUTBOUND_FETCH is the key for the restrictions of the server-initiated requests.
+	WorkspaceSettingKey_OUTBOUND_FETCH WorkspaceSettingKey = 14
+	// LEGAL is the key for the legal pages of the workspace.
+	WorkspaceSettingKey_LEGAL WorkspaceSettingKey = 15
+	// MAINTENANCE is the key for the maintenance windows scheduled by the admins.
+	WorkspaceSettingKey_MAINTENANCE WorkspaceSettingKey = 16
+	// ALERTING is the key for the channels the operational failures are alerted on.
+	WorkspaceSettingKey_ALERTING WorkspaceSettingKey = 17
 )
 
 // Enum value maps for WorkspaceSettingKey.
 var (
 	WorkspaceSettingKey_name = map[int32]string{
-		0: "WORKSPACE_SETTING_KEY_UNSPECIFIED",
-		1: "BASIC",
-		2: "GENERAL",
-		3: "STORAGE",
-		4: "MEMO_RELATED",
-		5: "AI_CONFIG",
-		6: "AI_RATE_LIMIT",
+		0:  "WORKSPACE_SETTING_KEY_UNSPECIFIED",
+		1:  "BASIC",
+		2:  "GENERAL",
+		3:  "STORAGE",
+		4:  "MEMO_RELATED",
+		5:  "AI_CONFIG",
+		6:  "AI_RATE_LIMIT",
+		7:  "ONBOARDING",
+		8:  "NEW_USER_LIMIT",
+		9:  "FEATURE_FLAGS",
+		10: "RUNNERS",
+		11: "USAGE_LIMIT",
+		12: "AI_USAGE",
+		13: "SENSITIVE_CONTENT",
+		14: "OUTBOUND_FETCH",
+		15: "LEGAL",
+		16: "MAINTENANCE",
+		17: "ALERTING",
 	}
 	WorkspaceSettingKey_value = map[string]int32{
 		"WORKSPACE_SETTING_KEY_UNSPECIFIED": 0,
@@ -58,6 +91,17 @@ var (
 		"MEMO_RELATED":                      4,
 		"AI_CONFIG":                         5,
 		"AI_RATE_LIMIT":                     6,
+		"ONBOARDING":                        7,
+		"NEW_USER_LIMIT":                    8,
+		"FEATURE_FLAGS":                     9,
+		"RUNNERS":                           10,
+		"USAGE_LIMIT":                       11,
+		"AI_USAGE":                          12,
+		"SENSITIVE_CONTENT":                 13,
+		"OUTBOUND_FETCH":                    14,
+		"LEGAL":                             15,
+		"MAINTENANCE":                       16,
+		"ALERTING":                          17,
 	}
 )
 
@@ -88,6 +132,110 @@ func (WorkspaceSettingKey) EnumDescriptor() ([]byte, []int) {
 	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0}
 }
 
+type SensitiveContentPolicy int32
+
+const (
+	// SENSITIVE_CONTENT_POLICY_UNSPECIFIED only flags sensitive attachments.
+	SensitiveContentPolicy_SENSITIVE_CONTENT_POLICY_UNSPECIFIED SensitiveContentPolicy = 0
+	// BLUR serves sensitive images blurred unless the viewer chooses to reveal them.
+	SensitiveContentPolicy_BLUR SensitiveContentPolicy = 1
+	// RESTRICT serves sensitive attachments to signed-in users only and hides them from visitors.
+	SensitiveContentPolicy_RESTRICT SensitiveContentPolicy = 2
+)
+
+// Enum value maps for SensitiveContentPolicy.
+var (
+	SensitiveContentPolicy_name = map[int32]string{
+		0: "SENSITIVE_CONTENT_POLICY_UNSPECIFIED",
+		1: "BLUR",
+		2: "RESTRICT",
+	}
+	SensitiveContentPolicy_value = map[string]int32{
+		"SENSITIVE_CONTENT_POLICY_UNSPECIFIED": 0,
+		"BLUR":                                 1,
+		"RESTRICT":                             2,
+	}
+)
+
+func (x SensitiveContentPolicy) Enum() *SensitiveContentPolicy {
+	p := new(SensitiveContentPolicy)
+	*p = x
+	return p
+}
+
+func (x SensitiveContentPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SensitiveContentPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[1].Descriptor()
+}
+
+func (SensitiveContentPolicy) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[1]
+}
+
+func (x SensitiveContentPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SensitiveContentPolicy.Descriptor instead.
+func (SensitiveContentPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{1}
+}
+
+type MaintenanceWindowState int32
+
+const (
+	// MAINTENANCE_WINDOW_STATE_UNSPECIFIED is a window not started yet.
+	MaintenanceWindowState_MAINTENANCE_WINDOW_STATE_UNSPECIFIED MaintenanceWindowState = 0
+	// IN_PROGRESS is a started window: the workspace is read-only, the runners and the webhook deliveries are paused.
+	MaintenanceWindowState_IN_PROGRESS MaintenanceWindowState = 1
+	// COMPLETED is a window whose end was processed, kept for a while for the admins.
+	MaintenanceWindowState_COMPLETED MaintenanceWindowState = 2
+)
+
+// Enum value maps for MaintenanceWindowState.
+var (
+	MaintenanceWindowState_name = map[int32]string{
+		0: "MAINTENANCE_WINDOW_STATE_UNSPECIFIED",
+		1: "IN_PROGRESS",
+		2: "COMPLETED",
+	}
+	MaintenanceWindowState_value = map[string]int32{
+		"MAINTENANCE_WINDOW_STATE_UNSPECIFIED": 0,
+		"IN_PROGRESS":                          1,
+		"COMPLETED":                            2,
+	}
+)
+
+func (x MaintenanceWindowState) Enum() *MaintenanceWindowState {
+	p := new(MaintenanceWindowState)
+	*p = x
+	return p
+}
+
+func (x MaintenanceWindowState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenanceWindowState) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (MaintenanceWindowState) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[2]
+}
+
+func (x MaintenanceWindowState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenanceWindowState.Descriptor instead.
+func (MaintenanceWindowState) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{2}
+}
+
 type WorkspaceStorageSetting_StorageType int32
 
 const (
@@ -127,11 +275,11 @@ func (x WorkspaceStorageSetting_StorageType) String() string {
 }
 
 func (WorkspaceStorageSetting_StorageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_workspace_setting_proto_enumTypes[1].Descriptor()
+	return file_store_workspace_setting_proto_enumTypes[3].Descriptor()
 }
 
 func (WorkspaceStorageSetting_StorageType) Type() protoreflect.EnumType {
-	return &file_store_workspace_setting_proto_enumTypes[1]
+	return &file_store_workspace_setting_proto_enumTypes[3]
 }
 
 func (x WorkspaceStorageSetting_StorageType) Number() protoreflect.EnumNumber {
@@ -140,7 +288,126 @@ func (x WorkspaceStorageSetting_StorageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WorkspaceStorageSetting_StorageType.Descriptor instead.
 func (WorkspaceStorageSetting_StorageType) EnumDescriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{4, 0}
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{5, 0}
+}
+
+// Provider is the API spoken by the AI provider.
+type WorkspaceAISetting_Provider int32
+
+const (
+	// The OpenAI API, spoken by OpenAI and many compatible services.
+	WorkspaceAISetting_PROVIDER_UNSPECIFIED WorkspaceAISetting_Provider = 0
+	WorkspaceAISetting_OPENAI               WorkspaceAISetting_Provider = 1
+	// Azure OpenAI, the model is the name of the deployment.
+	WorkspaceAISetting_AZURE_OPENAI WorkspaceAISetting_Provider = 2
+	WorkspaceAISetting_ANTHROPIC    WorkspaceAISetting_Provider = 3
+	// The native API of an Ollama server, which needs no API key.
+	WorkspaceAISetting_OLLAMA WorkspaceAISetting_Provider = 4
+	WorkspaceAISetting_GEMINI WorkspaceAISetting_Provider = 5
+	// A test-mode provider replying canned responses without an API key, for the integration tests and the
+	// development of the clients. It is not available in the prod mode.
+	WorkspaceAISetting_FAKE WorkspaceAISetting_Provider = 6
+)
+
+// Enum value maps for WorkspaceAISetting_Provider.
+var (
+	WorkspaceAISetting_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "OPENAI",
+		2: "AZURE_OPENAI",
+		3: "ANTHROPIC",
+		4: "OLLAMA",
+		5: "GEMINI",
+		6: "FAKE",
+	}
+	WorkspaceAISetting_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"OPENAI":               1,
+		"AZURE_OPENAI":         2,
+		"ANTHROPIC":            3,
+		"OLLAMA":               4,
+		"GEMINI":               5,
+		"FAKE":                 6,
+	}
+)
+
+func (x WorkspaceAISetting_Provider) Enum() *WorkspaceAISetting_Provider {
+	p := new(WorkspaceAISetting_Provider)
+	*p = x
+	return p
+}
+
+func (x WorkspaceAISetting_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceAISetting_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[4].Descriptor()
+}
+
+func (WorkspaceAISetting_Provider) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[4]
+}
+
+func (x WorkspaceAISetting_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceAISetting_Provider.Descriptor instead.
+func (WorkspaceAISetting_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type WorkspaceAlertingSetting_AlertChannel_Type int32
+
+const (
+	WorkspaceAlertingSetting_AlertChannel_TYPE_UNSPECIFIED WorkspaceAlertingSetting_AlertChannel_Type = 0
+	WorkspaceAlertingSetting_AlertChannel_WEBHOOK          WorkspaceAlertingSetting_AlertChannel_Type = 1
+	WorkspaceAlertingSetting_AlertChannel_SLACK            WorkspaceAlertingSetting_AlertChannel_Type = 2
+	WorkspaceAlertingSetting_AlertChannel_EMAIL            WorkspaceAlertingSetting_AlertChannel_Type = 3
+)
+
+// Enum value maps for WorkspaceAlertingSetting_AlertChannel_Type.
+var (
+	WorkspaceAlertingSetting_AlertChannel_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "WEBHOOK",
+		2: "SLACK",
+		3: "EMAIL",
+	}
+	WorkspaceAlertingSetting_AlertChannel_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"WEBHOOK":          1,
+		"SLACK":            2,
+		"EMAIL":            3,
+	}
+)
+
+func (x WorkspaceAlertingSetting_AlertChannel_Type) Enum() *WorkspaceAlertingSetting_AlertChannel_Type {
+	p := new(WorkspaceAlertingSetting_AlertChannel_Type)
+	*p = x
+	return p
+}
+
+func (x WorkspaceAlertingSetting_AlertChannel_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceAlertingSetting_AlertChannel_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[5].Descriptor()
+}
+
+func (WorkspaceAlertingSetting_AlertChannel_Type) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[5]
+}
+
+func (x WorkspaceAlertingSetting_AlertChannel_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceAlertingSetting_AlertChannel_Type.Descriptor instead.
+func (WorkspaceAlertingSetting_AlertChannel_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{22, 0, 0}
 }
 
 type WorkspaceSetting struct {
@@ -154,6 +421,17 @@ type WorkspaceSetting struct {
 	//	*WorkspaceSetting_MemoRelatedSetting
 	//	*WorkspaceSetting_AiSetting
 	//	*WorkspaceSetting_AiRateLimit
+	//	*WorkspaceSetting_OnboardingSetting
+	//	*WorkspaceSetting_NewUserLimitSetting
+	//	*WorkspaceSetting_FeatureFlagSetting
+	//	*WorkspaceSetting_RunnerSetting
+	//	*WorkspaceSetting_UsageLimitSetting
+	//	*WorkspaceSetting_AiUsage
+	//	*WorkspaceSetting_SensitiveContentSetting
+	//	*WorkspaceSetting_OutboundFetchSetting
+	//	*WorkspaceSetting_LegalSetting
+	//	*WorkspaceSetting_MaintenanceSetting
+	//	*WorkspaceSetting_AlertingSetting
 	Value         isWorkspaceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -257,6 +535,105 @@ func (x *WorkspaceSetting) GetAiRateLimit() string {
 	return ""
 }
 
+func (x *WorkspaceSetting) GetOnboardingSetting() *WorkspaceOnboardingSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_OnboardingSetting); ok {
+			return x.OnboardingSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetNewUserLimitSetting() *WorkspaceNewUserLimitSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_NewUserLimitSetting); ok {
+			return x.NewUserLimitSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetFeatureFlagSetting() *WorkspaceFeatureFlagSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_FeatureFlagSetting); ok {
+			return x.FeatureFlagSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetRunnerSetting() *WorkspaceRunnerSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_RunnerSetting); ok {
+			return x.RunnerSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetUsageLimitSetting() *WorkspaceUsageLimitSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_UsageLimitSetting); ok {
+			return x.UsageLimitSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetAiUsage() *WorkspaceAIUsage {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_AiUsage); ok {
+			return x.AiUsage
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetSensitiveContentSetting() *WorkspaceSensitiveContentSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_SensitiveContentSetting); ok {
+			return x.SensitiveContentSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetOutboundFetchSetting() *WorkspaceOutboundFetchSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_OutboundFetchSetting); ok {
+			return x.OutboundFetchSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetLegalSetting() *WorkspaceLegalSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_LegalSetting); ok {
+			return x.LegalSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetMaintenanceSetting() *WorkspaceMaintenanceSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_MaintenanceSetting); ok {
+			return x.MaintenanceSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetAlertingSetting() *WorkspaceAlertingSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_AlertingSetting); ok {
+			return x.AlertingSetting
+		}
+	}
+	return nil
+}
+
 type isWorkspaceSetting_Value interface {
 	isWorkspaceSetting_Value()
 }
@@ -285,6 +662,50 @@ type WorkspaceSetting_AiRateLimit struct {
 	AiRateLimit string `protobuf:"bytes,7,opt,name=ai_rate_limit,json=aiRateLimit,proto3,oneof"`
 }
 
+type WorkspaceSetting_OnboardingSetting struct {
+	OnboardingSetting *WorkspaceOnboardingSetting `protobuf:"bytes,8,opt,name=onboarding_setting,json=onboardingSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_NewUserLimitSetting struct {
+	NewUserLimitSetting *WorkspaceNewUserLimitSetting `protobuf:"bytes,9,opt,name=new_user_limit_setting,json=newUserLimitSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_FeatureFlagSetting struct {
+	FeatureFlagSetting *WorkspaceFeatureFlagSetting `protobuf:"bytes,10,opt,name=feature_flag_setting,json=featureFlagSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_RunnerSetting struct {
+	RunnerSetting *WorkspaceRunnerSetting `protobuf:"bytes,11,opt,name=runner_setting,json=runnerSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_UsageLimitSetting struct {
+	UsageLimitSetting *WorkspaceUsageLimitSetting `protobuf:"bytes,12,opt,name=usage_limit_setting,json=usageLimitSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_AiUsage struct {
+	AiUsage *WorkspaceAIUsage `protobuf:"bytes,13,opt,name=ai_usage,json=aiUsage,proto3,oneof"`
+}
+
+type WorkspaceSetting_SensitiveContentSetting struct {
+	SensitiveContentSetting *WorkspaceSensitiveContentSetting `protobuf:"bytes,14,opt,name=sensitive_content_setting,json=sensitiveContentSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_OutboundFetchSetting struct {
+	OutboundFetchSetting *WorkspaceOutboundFetchSetting `protobuf:"bytes,15,opt,name=outbound_fetch_setting,json=outboundFetchSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_LegalSetting struct {
+	LegalSetting *WorkspaceLegalSetting `protobuf:"bytes,16,opt,name=legal_setting,json=legalSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_MaintenanceSetting struct {
+	MaintenanceSetting *WorkspaceMaintenanceSetting `protobuf:"bytes,17,opt,name=maintenance_setting,json=maintenanceSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_AlertingSetting struct {
+	AlertingSetting *WorkspaceAlertingSetting `protobuf:"bytes,18,opt,name=alerting_setting,json=alertingSetting,proto3,oneof"`
+}
+
 func (*WorkspaceSetting_BasicSetting) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_GeneralSetting) isWorkspaceSetting_Value() {}
@@ -297,14 +718,39 @@ func (*WorkspaceSetting_AiSetting) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_AiRateLimit) isWorkspaceSetting_Value() {}
 
+func (*WorkspaceSetting_OnboardingSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_NewUserLimitSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_FeatureFlagSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_RunnerSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_UsageLimitSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_AiUsage) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_SensitiveContentSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_OutboundFetchSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_LegalSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_MaintenanceSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_AlertingSetting) isWorkspaceSetting_Value() {}
+
 type WorkspaceBasicSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The secret key for workspace. Mainly used for session management.
 	SecretKey string `protobuf:"bytes,1,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
 	// The current schema version of database.
 	SchemaVersion string `protobuf:"bytes,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The keys signing the access tokens, the last one signs the new tokens. Without keys, the tokens are signed with
+	// the secret key of the server under the "v1" key ID.
+	AccessTokenSigningKeys []*AccessTokenSigningKey `protobuf:"bytes,3,rep,name=access_token_signing_keys,json=accessTokenSigningKeys,proto3" json:"access_token_signing_keys,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *WorkspaceBasicSetting) Reset() {
@@ -351,47 +797,40 @@ func (x *WorkspaceBasicSetting) GetSchemaVersion() string {
 	return ""
 }
 
-type WorkspaceGeneralSetting struct {
+func (x *WorkspaceBasicSetting) GetAccessTokenSigningKeys() []*AccessTokenSigningKey {
+	if x != nil {
+		return x.AccessTokenSigningKeys
+	}
+	return nil
+}
+
+type AccessTokenSigningKey struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// theme is the name of the selected theme.
-	// This references a CSS file in the web/public/themes/ directory.
-	Theme string `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
-	// disallow_user_registration disallows user registration.
-	DisallowUserRegistration bool `protobuf:"varint,2,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
-	// disallow_password_auth disallows password authentication.
-	DisallowPasswordAuth bool `protobuf:"varint,3,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
-	// additional_script is the additional script.
-	AdditionalScript string `protobuf:"bytes,4,opt,name=additional_script,json=additionalScript,proto3" json:"additional_script,omitempty"`
-	// additional_style is the additional style.
-	AdditionalStyle string `protobuf:"bytes,5,opt,name=additional_style,json=additionalStyle,proto3" json:"additional_style,omitempty"`
-	// custom_profile is the custom profile.
-	CustomProfile *WorkspaceCustomProfile `protobuf:"bytes,6,opt,name=custom_profile,json=customProfile,proto3" json:"custom_profile,omitempty"`
-	// week_start_day_offset is the week start day offset from Sunday.
-	// 0: Sunday, 1: Monday, 2: Tuesday, 3: Wednesday, 4: Thursday, 5: Friday, 6: Saturday
-	// Default is Sunday.
-	WeekStartDayOffset int32 `protobuf:"varint,7,opt,name=week_start_day_offset,json=weekStartDayOffset,proto3" json:"week_start_day_offset,omitempty"`
-	// disallow_change_username disallows changing username.
-	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
-	// disallow_change_nickname disallows changing nickname.
-	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// id is the key ID in the header of the tokens signed with the key.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// secret signs the tokens, empty for the "v1" key, which is the secret key of the server.
+	Secret    string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreatedTs int64  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// expires_ts is when the tokens signed with the key stop being accepted, 0 while the key does not expire.
+	ExpiresTs     int64 `protobuf:"varint,4,opt,name=expires_ts,json=expiresTs,proto3" json:"expires_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceGeneralSetting) Reset() {
-	*x = WorkspaceGeneralSetting{}
+func (x *AccessTokenSigningKey) Reset() {
+	*x = AccessTokenSigningKey{}
 	mi := &file_store_workspace_setting_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceGeneralSetting) String() string {
+func (x *AccessTokenSigningKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceGeneralSetting) ProtoMessage() {}
+func (*AccessTokenSigningKey) ProtoMessage() {}
 
-func (x *WorkspaceGeneralSetting) ProtoReflect() protoreflect.Message {
+func (x *AccessTokenSigningKey) ProtoReflect() protoreflect.Message {
 	mi := &file_store_workspace_setting_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -403,30 +842,115 @@ func (x *WorkspaceGeneralSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceGeneralSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceGeneralSetting) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccessTokenSigningKey.ProtoReflect.Descriptor instead.
+func (*AccessTokenSigningKey) Descriptor() ([]byte, []int) {
 	return file_store_workspace_setting_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *WorkspaceGeneralSetting) GetTheme() string {
+func (x *AccessTokenSigningKey) GetId() string {
 	if x != nil {
-		return x.Theme
+		return x.Id
 	}
 	return ""
 }
 
-func (x *WorkspaceGeneralSetting) GetDisallowUserRegistration() bool {
+func (x *AccessTokenSigningKey) GetSecret() string {
 	if x != nil {
-		return x.DisallowUserRegistration
+		return x.Secret
 	}
-	return false
+	return ""
 }
 
-func (x *WorkspaceGeneralSetting) GetDisallowPasswordAuth() bool {
+func (x *AccessTokenSigningKey) GetCreatedTs() int64 {
 	if x != nil {
-		return x.DisallowPasswordAuth
+		return x.CreatedTs
 	}
-	return false
+	return 0
+}
+
+func (x *AccessTokenSigningKey) GetExpiresTs() int64 {
+	if x != nil {
+		return x.ExpiresTs
+	}
+	return 0
+}
+
+type WorkspaceGeneralSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// theme is the name of the selected theme.
+	// This references a CSS file in the web/public/themes/ directory.
+	Theme string `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
+	// disallow_user_registration disallows user registration.
+	DisallowUserRegistration bool `protobuf:"varint,2,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
+	// disallow_password_auth disallows password authentication.
+	DisallowPasswordAuth bool `protobuf:"varint,3,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
+	// additional_script is the additional script.
+	AdditionalScript string `protobuf:"bytes,4,opt,name=additional_script,json=additionalScript,proto3" json:"additional_script,omitempty"`
+	// additional_style is the additional style.
+	AdditionalStyle string `protobuf:"bytes,5,opt,name=additional_style,json=additionalStyle,proto3" json:"additional_style,omitempty"`
+	// custom_profile is the custom profile.
+	CustomProfile *WorkspaceCustomProfile `protobuf:"bytes,6,opt,name=custom_profile,json=customProfile,proto3" json:"custom_profile,omitempty"`
+	// week_start_day_offset is the week start day offset from Sunday.
+	// 0: Sunday, 1: Monday, 2: Tuesday, 3: Wednesday, 4: Thursday, 5: Friday, 6: Saturday
+	// Default is Sunday.
+	WeekStartDayOffset int32 `protobuf:"varint,7,opt,name=week_start_day_offset,json=weekStartDayOffset,proto3" json:"week_start_day_offset,omitempty"`
+	// disallow_change_username disallows changing username.
+	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
+	// disallow_change_nickname disallows changing nickname.
+	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *WorkspaceGeneralSetting) Reset() {
+	*x = WorkspaceGeneralSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceGeneralSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceGeneralSetting) ProtoMessage() {}
+
+func (x *WorkspaceGeneralSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceGeneralSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceGeneralSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WorkspaceGeneralSetting) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *WorkspaceGeneralSetting) GetDisallowUserRegistration() bool {
+	if x != nil {
+		return x.DisallowUserRegistration
+	}
+	return false
+}
+
+func (x *WorkspaceGeneralSetting) GetDisallowPasswordAuth() bool {
+	if x != nil {
+		return x.DisallowPasswordAuth
+	}
+	return false
 }
 
 func (x *WorkspaceGeneralSetting) GetAdditionalScript() string {
@@ -483,7 +1007,7 @@ type WorkspaceCustomProfile struct {
 
 func (x *WorkspaceCustomProfile) Reset() {
 	*x = WorkspaceCustomProfile{}
-	mi := &file_store_workspace_setting_proto_msgTypes[3]
+	mi := &file_store_workspace_setting_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -495,7 +1019,7 @@ func (x *WorkspaceCustomProfile) String() string {
 func (*WorkspaceCustomProfile) ProtoMessage() {}
 
 func (x *WorkspaceCustomProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[3]
+	mi := &file_store_workspace_setting_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,7 +1032,7 @@ func (x *WorkspaceCustomProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkspaceCustomProfile.ProtoReflect.Descriptor instead.
 func (*WorkspaceCustomProfile) Descriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{3}
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *WorkspaceCustomProfile) GetTitle() string {
@@ -556,7 +1080,7 @@ type WorkspaceStorageSetting struct {
 
 func (x *WorkspaceStorageSetting) Reset() {
 	*x = WorkspaceStorageSetting{}
-	mi := &file_store_workspace_setting_proto_msgTypes[4]
+	mi := &file_store_workspace_setting_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -568,7 +1092,7 @@ func (x *WorkspaceStorageSetting) String() string {
 func (*WorkspaceStorageSetting) ProtoMessage() {}
 
 func (x *WorkspaceStorageSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[4]
+	mi := &file_store_workspace_setting_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +1105,7 @@ func (x *WorkspaceStorageSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkspaceStorageSetting.ProtoReflect.Descriptor instead.
 func (*WorkspaceStorageSetting) Descriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{4}
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *WorkspaceStorageSetting) GetStorageType() WorkspaceStorageSetting_StorageType {
@@ -627,7 +1151,7 @@ type StorageS3Config struct {
 
 func (x *StorageS3Config) Reset() {
 	*x = StorageS3Config{}
-	mi := &file_store_workspace_setting_proto_msgTypes[5]
+	mi := &file_store_workspace_setting_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -639,7 +1163,7 @@ func (x *StorageS3Config) String() string {
 func (*StorageS3Config) ProtoMessage() {}
 
 func (x *StorageS3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[5]
+	mi := &file_store_workspace_setting_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +1176,7 @@ func (x *StorageS3Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StorageS3Config.ProtoReflect.Descriptor instead.
 func (*StorageS3Config) Descriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{5}
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *StorageS3Config) GetAccessKeyId() string {
@@ -716,14 +1240,40 @@ type WorkspaceMemoRelatedSetting struct {
 	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
 	EnableBlurNsfwContent bool `protobuf:"varint,9,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
 	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
-	NsfwTags      []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	NsfwTags []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	// role_default_visibilities maps a user role (HOST, ADMIN, USER) to the visibility
+	// applied to memos created without an explicit visibility, e.g. {"USER": "PRIVATE"}.
+	RoleDefaultVisibilities map[string]string `protobuf:"bytes,11,rep,name=role_default_visibilities,json=roleDefaultVisibilities,proto3" json:"role_default_visibilities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// cold_storage_after_days moves archived memos untouched for this many days into cold storage.
+	// 0 disables cold storage.
+	ColdStorageAfterDays int32 `protobuf:"varint,12,opt,name=cold_storage_after_days,json=coldStorageAfterDays,proto3" json:"cold_storage_after_days,omitempty"`
+	// disable_view_tracking stops counting the views of the memos by the visitors other than their creators.
+	DisableViewTracking bool `protobuf:"varint,13,opt,name=disable_view_tracking,json=disableViewTracking,proto3" json:"disable_view_tracking,omitempty"`
+	// protected_visibility_roles restricts the protected memos to the users of these roles (HOST, ADMIN, USER),
+	// besides their creators. Empty allows all the signed-in users.
+	ProtectedVisibilityRoles []string `protobuf:"bytes,14,rep,name=protected_visibility_roles,json=protectedVisibilityRoles,proto3" json:"protected_visibility_roles,omitempty"`
+	// enable_fuzzy_search makes the words of the memo searches also match the words spelled alike, e.g. "kuberentes"
+	// for "kubernetes", at the cost of comparing the words of every memo. It creates the pg_trgm extension on PostgreSQL,
+	// and is not supported on MySQL.
+	EnableFuzzySearch bool `protobuf:"varint,15,opt,name=enable_fuzzy_search,json=enableFuzzySearch,proto3" json:"enable_fuzzy_search,omitempty"`
+	// approval_required_tags holds back the public and protected memos of the users with these tags, or their subtags,
+	// as private until an admin approves them. The memos of the admins need no approval.
+	ApprovalRequiredTags []string `protobuf:"bytes,16,rep,name=approval_required_tags,json=approvalRequiredTags,proto3" json:"approval_required_tags,omitempty"`
+	// tag_templates binds templates and required properties to tags, at most one per tag.
+	TagTemplates []*WorkspaceMemoRelatedSetting_TagTemplate `protobuf:"bytes,17,rep,name=tag_templates,json=tagTemplates,proto3" json:"tag_templates,omitempty"`
+	// expand_shortcodes expands the shortcodes of the memos on save, e.g. ":shrug:", with the built-in shortcodes and
+	// the shortcodes below.
+	ExpandShortcodes bool `protobuf:"varint,18,opt,name=expand_shortcodes,json=expandShortcodes,proto3" json:"expand_shortcodes,omitempty"`
+	// shortcodes maps the names of the workspace shortcodes to their text, e.g. {"ship": "🚢"}, overriding the built-in
+	// shortcodes of the same name.
+	Shortcodes    map[string]string `protobuf:"bytes,19,rep,name=shortcodes,proto3" json:"shortcodes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WorkspaceMemoRelatedSetting) Reset() {
 	*x = WorkspaceMemoRelatedSetting{}
-	mi := &file_store_workspace_setting_proto_msgTypes[6]
+	mi := &file_store_workspace_setting_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -735,7 +1285,7 @@ func (x *WorkspaceMemoRelatedSetting) String() string {
 func (*WorkspaceMemoRelatedSetting) ProtoMessage() {}
 
 func (x *WorkspaceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[6]
+	mi := &file_store_workspace_setting_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +1298,7 @@ func (x *WorkspaceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkspaceMemoRelatedSetting.ProtoReflect.Descriptor instead.
 func (*WorkspaceMemoRelatedSetting) Descriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{6}
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *WorkspaceMemoRelatedSetting) GetDisallowPublicVisibility() bool {
@@ -814,6 +1364,69 @@ func (x *WorkspaceMemoRelatedSetting) GetNsfwTags() []string {
 	return nil
 }
 
+func (x *WorkspaceMemoRelatedSetting) GetRoleDefaultVisibilities() map[string]string {
+	if x != nil {
+		return x.RoleDefaultVisibilities
+	}
+	return nil
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetColdStorageAfterDays() int32 {
+	if x != nil {
+		return x.ColdStorageAfterDays
+	}
+	return 0
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetDisableViewTracking() bool {
+	if x != nil {
+		return x.DisableViewTracking
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetProtectedVisibilityRoles() []string {
+	if x != nil {
+		return x.ProtectedVisibilityRoles
+	}
+	return nil
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetEnableFuzzySearch() bool {
+	if x != nil {
+		return x.EnableFuzzySearch
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetApprovalRequiredTags() []string {
+	if x != nil {
+		return x.ApprovalRequiredTags
+	}
+	return nil
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetTagTemplates() []*WorkspaceMemoRelatedSetting_TagTemplate {
+	if x != nil {
+		return x.TagTemplates
+	}
+	return nil
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetExpandShortcodes() bool {
+	if x != nil {
+		return x.ExpandShortcodes
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetShortcodes() map[string]string {
+	if x != nil {
+		return x.Shortcodes
+	}
+	return nil
+}
+
 type WorkspaceAISetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// endpoint is the API endpoint URL for the AI provider.
@@ -823,14 +1436,102 @@ type WorkspaceAISetting struct {
 	// model is the AI model name to use (e.g., "gpt-4o-mini").
 	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
 	// system_prompt is the system prompt template for AI requests.
-	SystemPrompt  string `protobuf:"bytes,4,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	SystemPrompt string `protobuf:"bytes,4,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`
+	// tts_model is the text-to-speech model name to use (e.g., "tts-1"). Speech synthesis is disabled when empty.
+	TtsModel string `protobuf:"bytes,5,opt,name=tts_model,json=ttsModel,proto3" json:"tts_model,omitempty"`
+	// tts_voice is the voice used for speech synthesis (e.g., "alloy").
+	TtsVoice string `protobuf:"bytes,6,opt,name=tts_voice,json=ttsVoice,proto3" json:"tts_voice,omitempty"`
+	// tts_endpoint is the API endpoint URL of an OpenAI compatible text-to-speech engine, e.g. a local one.
+	// The AI provider endpoint is used when empty.
+	TtsEndpoint string `protobuf:"bytes,7,opt,name=tts_endpoint,json=ttsEndpoint,proto3" json:"tts_endpoint,omitempty"`
+	// transcription_model is the speech-to-text model name to use (e.g., "whisper-1"). Voice memos are disabled when empty.
+	TranscriptionModel string `protobuf:"bytes,8,opt,name=transcription_model,json=transcriptionModel,proto3" json:"transcription_model,omitempty"`
+	// role_permissions maps a user role (HOST, ADMIN, USER) to its AI permissions and rate limits.
+	// Roles without an entry can use all AI features, with the default rate limit of the server.
+	RolePermissions map[string]*WorkspaceAISetting_RolePermission `protobuf:"bytes,9,rep,name=role_permissions,json=rolePermissions,proto3" json:"role_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// disallow_protected_memos keeps Protected memos from being sent to the AI provider.
+	DisallowProtectedMemos bool `protobuf:"varint,10,opt,name=disallow_protected_memos,json=disallowProtectedMemos,proto3" json:"disallow_protected_memos,omitempty"`
+	// prompt_token_price is the price of one million prompt tokens, used to estimate the cost of AI requests.
+	PromptTokenPrice float64 `protobuf:"fixed64,11,opt,name=prompt_token_price,json=promptTokenPrice,proto3" json:"prompt_token_price,omitempty"`
+	// completion_token_price is the price of one million completion tokens, used to estimate the cost of AI requests.
+	CompletionTokenPrice float64 `protobuf:"fixed64,12,opt,name=completion_token_price,json=completionTokenPrice,proto3" json:"completion_token_price,omitempty"`
+	// provider is the AI provider the chat features are sent to. Speech synthesis and voice memos
+	// always use the OpenAI API.
+	Provider WorkspaceAISetting_Provider `protobuf:"varint,13,opt,name=provider,proto3,enum=memos.store.WorkspaceAISetting_Provider" json:"provider,omitempty"`
+	// api_version is the API version of Azure OpenAI, e.g. "2024-10-21".
+	ApiVersion string `protobuf:"bytes,14,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// redaction is applied to the memo content of the prompts.
+	Redaction *WorkspaceAISetting_Redaction `protobuf:"bytes,15,opt,name=redaction,proto3" json:"redaction,omitempty"`
+	// embedding_model is the model embedding the memos for semantic search (e.g., "text-embedding-3-small").
+	// Semantic search is disabled when empty.
+	EmbeddingModel string `protobuf:"bytes,16,opt,name=embedding_model,json=embeddingModel,proto3" json:"embedding_model,omitempty"`
+	// auto_tag applies the suggested tags to the memos when they are created or their content is updated.
+	AutoTag bool `protobuf:"varint,17,opt,name=auto_tag,json=autoTag,proto3" json:"auto_tag,omitempty"`
+	// profiles are the named AI provider configurations the features can be routed to.
+	Profiles []*WorkspaceAISetting_Profile `protobuf:"bytes,18,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	// feature_profiles maps an AI feature to the name of the profile it is sent to: SUMMARY (summaries and their
+	// refinements), CHAT, TAG_SUGGESTION, VOICE_MEMO, TRANSFORM (the translations and rewrites of the memos),
+	// ATTACHMENT_EXTRACTION (the descriptions of the images and the transcripts of the audio attachments) and
+	// EMBEDDING (the memo embeddings, semantic search, the retrieval of the chat and tag merges). The features without an entry use the provider configured above.
+	FeatureProfiles map[string]string `protobuf:"bytes,19,rep,name=feature_profiles,json=featureProfiles,proto3" json:"feature_profiles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// debug_logging stores the exact prompts and raw responses of the AI calls, encrypted, for the admins to
+	// investigate bad generations. Turning it off deletes the stored prompts.
+	DebugLogging bool `protobuf:"varint,20,opt,name=debug_logging,json=debugLogging,proto3" json:"debug_logging,omitempty"`
+	// debug_log_retention_days is the number of days the prompts are kept, 7 when 0 and at most 30.
+	DebugLogRetentionDays int32 `protobuf:"varint,21,opt,name=debug_log_retention_days,json=debugLogRetentionDays,proto3" json:"debug_log_retention_days,omitempty"`
+	// summary_chunk_size is the maximum number of characters of memo content sent in a summary request, none when 0:
+	// the memos then fill the context window of the model. The memos of a summary exceeding it are split into chunks
+	// that are summarized first, and the summary is generated from the summaries of the chunks.
+	SummaryChunkSize int32 `protobuf:"varint,22,opt,name=summary_chunk_size,json=summaryChunkSize,proto3" json:"summary_chunk_size,omitempty"`
+	// summary_max_chunks is the maximum number of chunks the memos of a summary are split into, 10 when 0 and at
+	// most 50. The oldest memos beyond them are left out.
+	SummaryMaxChunks int32 `protobuf:"varint,23,opt,name=summary_max_chunks,json=summaryMaxChunks,proto3" json:"summary_max_chunks,omitempty"`
+	// attachment_extraction configures the extraction of the attachments, which are not extracted when it is unset.
+	AttachmentExtraction *WorkspaceAISetting_AttachmentExtraction `protobuf:"bytes,24,opt,name=attachment_extraction,json=attachmentExtraction,proto3" json:"attachment_extraction,omitempty"`
+	// summary_model is the model generating the summaries and their refinements, model when empty.
+	SummaryModel string `protobuf:"bytes,25,opt,name=summary_model,json=summaryModel,proto3" json:"summary_model,omitempty"`
+	// chat_model is the model answering the chat, model when empty.
+	ChatModel string `protobuf:"bytes,26,opt,name=chat_model,json=chatModel,proto3" json:"chat_model,omitempty"`
+	// vision_model is the model describing the image attachments, model when empty.
+	VisionModel string `protobuf:"bytes,27,opt,name=vision_model,json=visionModel,proto3" json:"vision_model,omitempty"`
+	// temperature is the sampling temperature of the completions, the default of the provider when unset.
+	Temperature *float64 `protobuf:"fixed64,28,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	// max_tokens caps the number of tokens of each completion, the default of the provider when 0.
+	MaxTokens int32 `protobuf:"varint,29,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	// workspace_summary_daily_limit is the maximum number of workspace summaries generated per UTC day, 0 for no limit.
+	WorkspaceSummaryDailyLimit int32 `protobuf:"varint,30,opt,name=workspace_summary_daily_limit,json=workspaceSummaryDailyLimit,proto3" json:"workspace_summary_daily_limit,omitempty"`
+	// context_windows overrides the context window, in tokens, of the models by model name. The prompts are fitted in
+	// the context window of the model, the known one of its family or 8192 tokens when not set.
+	ContextWindows map[string]int32 `protobuf:"bytes,31,rep,name=context_windows,json=contextWindows,proto3" json:"context_windows,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// summary_min_length is the minimum number of characters of a generated summary, none when 0.
+	SummaryMinLength int32 `protobuf:"varint,32,opt,name=summary_min_length,json=summaryMinLength,proto3" json:"summary_min_length,omitempty"`
+	// summary_max_length is the number of characters the generated summaries are truncated to, none when 0.
+	SummaryMaxLength int32 `protobuf:"varint,33,opt,name=summary_max_length,json=summaryMaxLength,proto3" json:"summary_max_length,omitempty"`
+	// summary_cache_ttl_minutes is how long the AI memo of a summary is returned again for the identical requests of the
+	// user, on the same source memos unchanged, instead of calling the provider. The cache is disabled when 0.
+	SummaryCacheTtlMinutes int32 `protobuf:"varint,34,opt,name=summary_cache_ttl_minutes,json=summaryCacheTtlMinutes,proto3" json:"summary_cache_ttl_minutes,omitempty"`
+	// circuit_breaker_failures is the number of consecutive failed calls to a provider that open its circuit breaker,
+	// failing the AI requests fast until the cooldown is over. It is 5 when 0.
+	CircuitBreakerFailures int32 `protobuf:"varint,35,opt,name=circuit_breaker_failures,json=circuitBreakerFailures,proto3" json:"circuit_breaker_failures,omitempty"`
+	// circuit_breaker_cooldown_seconds is how long the circuit breaker of a provider stays open before a trial call is
+	// let through. It is 30 seconds when 0.
+	CircuitBreakerCooldownSeconds int32 `protobuf:"varint,36,opt,name=circuit_breaker_cooldown_seconds,json=circuitBreakerCooldownSeconds,proto3" json:"circuit_breaker_cooldown_seconds,omitempty"`
+	// max_concurrent_requests is the maximum number of AI requests in flight to all the providers, the others wait
+	// for a slot a few seconds before failing. There is no limit when 0.
+	MaxConcurrentRequests int32 `protobuf:"varint,37,opt,name=max_concurrent_requests,json=maxConcurrentRequests,proto3" json:"max_concurrent_requests,omitempty"`
+	// disabled turns off all the AI features of the workspace, whatever the rest of the setting: the AI endpoints are
+	// rejected, the background AI tasks are skipped and the AI settings are hidden from the users.
+	Disabled bool `protobuf:"varint,38,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// data_processing_disclosure tells the users which providers process their content and how, shown to them before
+	// they opt in to the AI processing of their content in the AI operations of the other users.
+	DataProcessingDisclosure string `protobuf:"bytes,39,opt,name=data_processing_disclosure,json=dataProcessingDisclosure,proto3" json:"data_processing_disclosure,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *WorkspaceAISetting) Reset() {
 	*x = WorkspaceAISetting{}
-	mi := &file_store_workspace_setting_proto_msgTypes[7]
+	mi := &file_store_workspace_setting_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -842,7 +1543,7 @@ func (x *WorkspaceAISetting) String() string {
 func (*WorkspaceAISetting) ProtoMessage() {}
 
 func (x *WorkspaceAISetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[7]
+	mi := &file_store_workspace_setting_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +1556,7 @@ func (x *WorkspaceAISetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkspaceAISetting.ProtoReflect.Descriptor instead.
 func (*WorkspaceAISetting) Descriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{7}
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *WorkspaceAISetting) GetEndpoint() string {
@@ -886,81 +1587,2068 @@ func (x *WorkspaceAISetting) GetSystemPrompt() string {
 	return ""
 }
 
-var File_store_workspace_setting_proto protoreflect.FileDescriptor
+func (x *WorkspaceAISetting) GetTtsModel() string {
+	if x != nil {
+		return x.TtsModel
+	}
+	return ""
+}
 
-const file_store_workspace_setting_proto_rawDesc = "" +
-	"\n" +
-	"\x1dstore/workspace_setting.proto\x12\vmemos.store\"\x82\x04\n" +
-	"\x10WorkspaceSetting\x122\n" +
-	"\x03key\x18\x01 \x01(\x0e2 .memos.store.WorkspaceSettingKeyR\x03key\x12I\n" +
-	"\rbasic_setting\x18\x02 \x01(\v2\".memos.store.WorkspaceBasicSettingH\x00R\fbasicSetting\x12O\n" +
-	"\x0fgeneral_setting\x18\x03 \x01(\v2$.memos.store.WorkspaceGeneralSettingH\x00R\x0egeneralSetting\x12O\n" +
-	"\x0fstorage_setting\x18\x04 \x01(\v2$.memos.store.WorkspaceStorageSettingH\x00R\x0estorageSetting\x12\\\n" +
-	"\x14memo_related_setting\x18\x05 \x01(\v2(.memos.store.WorkspaceMemoRelatedSettingH\x00R\x12memoRelatedSetting\x12@\n" +
-	"\n" +
-	"ai_setting\x18\x06 \x01(\v2\x1f.memos.store.WorkspaceAISettingH\x00R\taiSetting\x12$\n" +
-	"\rai_rate_limit\x18\a \x01(\tH\x00R\vaiRateLimitB\a\n" +
-	"\x05value\"]\n" +
-	"\x15WorkspaceBasicSetting\x12\x1d\n" +
-	"\n" +
-	"secret_key\x18\x01 \x01(\tR\tsecretKey\x12%\n" +
-	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\"\xee\x03\n" +
-	"\x17WorkspaceGeneralSetting\x12\x14\n" +
-	"\x05theme\x18\x01 \x01(\tR\x05theme\x12<\n" +
-	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
-	"\x16disallow_password_auth\x18\x03 \x01(\bR\x14disallowPasswordAuth\x12+\n" +
-	"\x11additional_script\x18\x04 \x01(\tR\x10additionalScript\x12)\n" +
-	"\x10additional_style\x18\x05 \x01(\tR\x0fadditionalStyle\x12J\n" +
-	"\x0ecustom_profile\x18\x06 \x01(\v2#.memos.store.WorkspaceCustomProfileR\rcustomProfile\x121\n" +
-	"\x15week_start_day_offset\x18\a \x01(\x05R\x12weekStartDayOffset\x128\n" +
-	"\x18disallow_change_username\x18\b \x01(\bR\x16disallowChangeUsername\x128\n" +
-	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\"\x83\x01\n" +
-	"\x16WorkspaceCustomProfile\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
-	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\x12\x16\n" +
-	"\x06locale\x18\x04 \x01(\tR\x06locale\"\xd5\x02\n" +
-	"\x17WorkspaceStorageSetting\x12S\n" +
-	"\fstorage_type\x18\x01 \x01(\x0e20.memos.store.WorkspaceStorageSetting.StorageTypeR\vstorageType\x12+\n" +
-	"\x11filepath_template\x18\x02 \x01(\tR\x10filepathTemplate\x12/\n" +
-	"\x14upload_size_limit_mb\x18\x03 \x01(\x03R\x11uploadSizeLimitMb\x129\n" +
-	"\ts3_config\x18\x04 \x01(\v2\x1c.memos.store.StorageS3ConfigR\bs3Config\"L\n" +
-	"\vStorageType\x12\x1c\n" +
-	"\x18STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
-	"\bDATABASE\x10\x01\x12\t\n" +
-	"\x05LOCAL\x10\x02\x12\x06\n" +
-	"\x02S3\x10\x03\"\xd3\x01\n" +
-	"\x0fStorageS3Config\x12\"\n" +
-	"\raccess_key_id\x18\x01 \x01(\tR\vaccessKeyId\x12*\n" +
-	"\x11access_key_secret\x18\x02 \x01(\tR\x0faccessKeySecret\x12\x1a\n" +
-	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x16\n" +
-	"\x06region\x18\x04 \x01(\tR\x06region\x12\x16\n" +
-	"\x06bucket\x18\x05 \x01(\tR\x06bucket\x12$\n" +
-	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\xe1\x03\n" +
-	"\x1bWorkspaceMemoRelatedSetting\x12<\n" +
-	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
-	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
-	"\x14content_length_limit\x18\x03 \x01(\x05R\x12contentLengthLimit\x127\n" +
-	"\x18enable_double_click_edit\x18\x04 \x01(\bR\x15enableDoubleClickEdit\x12.\n" +
-	"\x13enable_link_preview\x18\x05 \x01(\bR\x11enableLinkPreview\x12\x1c\n" +
-	"\treactions\x18\a \x03(\tR\treactions\x12<\n" +
-	"\x1adisable_markdown_shortcuts\x18\b \x01(\bR\x18disableMarkdownShortcuts\x127\n" +
-	"\x18enable_blur_nsfw_content\x18\t \x01(\bR\x15enableBlurNsfwContent\x12\x1b\n" +
-	"\tnsfw_tags\x18\n" +
-	" \x03(\tR\bnsfwTags\"\x84\x01\n" +
-	"\x12WorkspaceAISetting\x12\x1a\n" +
-	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x17\n" +
-	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x14\n" +
-	"\x05model\x18\x03 \x01(\tR\x05model\x12#\n" +
-	"\rsystem_prompt\x18\x04 \x01(\tR\fsystemPrompt*\x95\x01\n" +
-	"\x13WorkspaceSettingKey\x12%\n" +
-	"!WORKSPACE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\t\n" +
-	"\x05BASIC\x10\x01\x12\v\n" +
-	"\aGENERAL\x10\x02\x12\v\n" +
-	"\aSTORAGE\x10\x03\x12\x10\n" +
-	"\fMEMO_RELATED\x10\x04\x12\r\n" +
-	"\tAI_CONFIG\x10\x05\x12\x11\n" +
-	"\rAI_RATE_LIMIT\x10\x06B\xa0\x01\n" +
+func (x *WorkspaceAISetting) GetTtsVoice() string {
+	if x != nil {
+		return x.TtsVoice
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting) GetTtsEndpoint() string {
+	if x != nil {
+		return x.TtsEndpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting) GetTranscriptionModel() string {
+	if x != nil {
+		return x.TranscriptionModel
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting) GetRolePermissions() map[string]*WorkspaceAISetting_RolePermission {
+	if x != nil {
+		return x.RolePermissions
+	}
+	return nil
+}
+
+func (x *WorkspaceAISetting) GetDisallowProtectedMemos() bool {
+	if x != nil {
+		return x.DisallowProtectedMemos
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting) GetPromptTokenPrice() float64 {
+	if x != nil {
+		return x.PromptTokenPrice
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetCompletionTokenPrice() float64 {
+	if x != nil {
+		return x.CompletionTokenPrice
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetProvider() WorkspaceAISetting_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return WorkspaceAISetting_PROVIDER_UNSPECIFIED
+}
+
+func (x *WorkspaceAISetting) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting) GetRedaction() *WorkspaceAISetting_Redaction {
+	if x != nil {
+		return x.Redaction
+	}
+	return nil
+}
+
+func (x *WorkspaceAISetting) GetEmbeddingModel() string {
+	if x != nil {
+		return x.EmbeddingModel
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting) GetAutoTag() bool {
+	if x != nil {
+		return x.AutoTag
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting) GetProfiles() []*WorkspaceAISetting_Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *WorkspaceAISetting) GetFeatureProfiles() map[string]string {
+	if x != nil {
+		return x.FeatureProfiles
+	}
+	return nil
+}
+
+func (x *WorkspaceAISetting) GetDebugLogging() bool {
+	if x != nil {
+		return x.DebugLogging
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting) GetDebugLogRetentionDays() int32 {
+	if x != nil {
+		return x.DebugLogRetentionDays
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetSummaryChunkSize() int32 {
+	if x != nil {
+		return x.SummaryChunkSize
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetSummaryMaxChunks() int32 {
+	if x != nil {
+		return x.SummaryMaxChunks
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetAttachmentExtraction() *WorkspaceAISetting_AttachmentExtraction {
+	if x != nil {
+		return x.AttachmentExtraction
+	}
+	return nil
+}
+
+func (x *WorkspaceAISetting) GetSummaryModel() string {
+	if x != nil {
+		return x.SummaryModel
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting) GetChatModel() string {
+	if x != nil {
+		return x.ChatModel
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting) GetVisionModel() string {
+	if x != nil {
+		return x.VisionModel
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting) GetTemperature() float64 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetMaxTokens() int32 {
+	if x != nil {
+		return x.MaxTokens
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetWorkspaceSummaryDailyLimit() int32 {
+	if x != nil {
+		return x.WorkspaceSummaryDailyLimit
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetContextWindows() map[string]int32 {
+	if x != nil {
+		return x.ContextWindows
+	}
+	return nil
+}
+
+func (x *WorkspaceAISetting) GetSummaryMinLength() int32 {
+	if x != nil {
+		return x.SummaryMinLength
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetSummaryMaxLength() int32 {
+	if x != nil {
+		return x.SummaryMaxLength
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetSummaryCacheTtlMinutes() int32 {
+	if x != nil {
+		return x.SummaryCacheTtlMinutes
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetCircuitBreakerFailures() int32 {
+	if x != nil {
+		return x.CircuitBreakerFailures
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetCircuitBreakerCooldownSeconds() int32 {
+	if x != nil {
+		return x.CircuitBreakerCooldownSeconds
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetMaxConcurrentRequests() int32 {
+	if x != nil {
+		return x.MaxConcurrentRequests
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting) GetDataProcessingDisclosure() string {
+	if x != nil {
+		return x.DataProcessingDisclosure
+	}
+	return ""
+}
+
+type WorkspaceOnboardingSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// welcome_memo_content is the content of the welcome memo created for each new user.
+	// Leave it empty to skip creating the welcome memo.
+	WelcomeMemoContent string `protobuf:"bytes,1,opt,name=welcome_memo_content,json=welcomeMemoContent,proto3" json:"welcome_memo_content,omitempty"`
+	// template_memos is the list of template memo contents created for each new user.
+	TemplateMemos []string `protobuf:"bytes,2,rep,name=template_memos,json=templateMemos,proto3" json:"template_memos,omitempty"`
+	// default_tags is the list of tags appended to each onboarding memo.
+	DefaultTags   []string `protobuf:"bytes,3,rep,name=default_tags,json=defaultTags,proto3" json:"default_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceOnboardingSetting) Reset() {
+	*x = WorkspaceOnboardingSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceOnboardingSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceOnboardingSetting) ProtoMessage() {}
+
+func (x *WorkspaceOnboardingSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceOnboardingSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceOnboardingSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WorkspaceOnboardingSetting) GetWelcomeMemoContent() string {
+	if x != nil {
+		return x.WelcomeMemoContent
+	}
+	return ""
+}
+
+func (x *WorkspaceOnboardingSetting) GetTemplateMemos() []string {
+	if x != nil {
+		return x.TemplateMemos
+	}
+	return nil
+}
+
+func (x *WorkspaceOnboardingSetting) GetDefaultTags() []string {
+	if x != nil {
+		return x.DefaultTags
+	}
+	return nil
+}
+
+type WorkspaceNewUserLimitSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// probation_days is the account age in days before the limits are lifted.
+	// Limits are disabled when it is 0.
+	ProbationDays int32 `protobuf:"varint,1,opt,name=probation_days,json=probationDays,proto3" json:"probation_days,omitempty"`
+	// memos_per_day is the max number of memos a new user can create per day. 0 means unlimited.
+	MemosPerDay int32 `protobuf:"varint,2,opt,name=memos_per_day,json=memosPerDay,proto3" json:"memos_per_day,omitempty"`
+	// attachments_per_day is the max number of attachments a new user can upload per day. 0 means unlimited.
+	AttachmentsPerDay int32 `protobuf:"varint,3,opt,name=attachments_per_day,json=attachmentsPerDay,proto3" json:"attachments_per_day,omitempty"`
+	// disallow_public_memos disallows new users to create public memos.
+	DisallowPublicMemos bool `protobuf:"varint,4,opt,name=disallow_public_memos,json=disallowPublicMemos,proto3" json:"disallow_public_memos,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *WorkspaceNewUserLimitSetting) Reset() {
+	*x = WorkspaceNewUserLimitSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceNewUserLimitSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceNewUserLimitSetting) ProtoMessage() {}
+
+func (x *WorkspaceNewUserLimitSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceNewUserLimitSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceNewUserLimitSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WorkspaceNewUserLimitSetting) GetProbationDays() int32 {
+	if x != nil {
+		return x.ProbationDays
+	}
+	return 0
+}
+
+func (x *WorkspaceNewUserLimitSetting) GetMemosPerDay() int32 {
+	if x != nil {
+		return x.MemosPerDay
+	}
+	return 0
+}
+
+func (x *WorkspaceNewUserLimitSetting) GetAttachmentsPerDay() int32 {
+	if x != nil {
+		return x.AttachmentsPerDay
+	}
+	return 0
+}
+
+func (x *WorkspaceNewUserLimitSetting) GetDisallowPublicMemos() bool {
+	if x != nil {
+		return x.DisallowPublicMemos
+	}
+	return false
+}
+
+type WorkspaceFeatureFlagSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// flags is the list of feature flags defined for the workspace.
+	Flags         []*FeatureFlag `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceFeatureFlagSetting) Reset() {
+	*x = WorkspaceFeatureFlagSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceFeatureFlagSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceFeatureFlagSetting) ProtoMessage() {}
+
+func (x *WorkspaceFeatureFlagSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceFeatureFlagSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceFeatureFlagSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WorkspaceFeatureFlagSetting) GetFlags() []*FeatureFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type FeatureFlag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the unique name of the flag, e.g. "ai-chat".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// enabled enables the feature for every user.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// rollout_percentage enables the feature for a stable share of users, from 0 to 100.
+	RolloutPercentage int32 `protobuf:"varint,3,opt,name=rollout_percentage,json=rolloutPercentage,proto3" json:"rollout_percentage,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FeatureFlag) Reset() {
+	*x = FeatureFlag{}
+	mi := &file_store_workspace_setting_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureFlag) ProtoMessage() {}
+
+func (x *FeatureFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureFlag.ProtoReflect.Descriptor instead.
+func (*FeatureFlag) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FeatureFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FeatureFlag) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *FeatureFlag) GetRolloutPercentage() int32 {
+	if x != nil {
+		return x.RolloutPercentage
+	}
+	return 0
+}
+
+type WorkspaceRunnerSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// runners overrides the default configuration of background runners.
+	Runners       []*RunnerConfig `protobuf:"bytes,1,rep,name=runners,proto3" json:"runners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceRunnerSetting) Reset() {
+	*x = WorkspaceRunnerSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceRunnerSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceRunnerSetting) ProtoMessage() {}
+
+func (x *WorkspaceRunnerSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceRunnerSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceRunnerSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WorkspaceRunnerSetting) GetRunners() []*RunnerConfig {
+	if x != nil {
+		return x.Runners
+	}
+	return nil
+}
+
+type RunnerConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the runner, e.g. "link-check".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// disabled stops running the runner on schedule, it can still be run manually.
+	Disabled bool `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// schedule is the cron expression of the runner, the default schedule is used when it is empty.
+	Schedule      string `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunnerConfig) Reset() {
+	*x = RunnerConfig{}
+	mi := &file_store_workspace_setting_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunnerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunnerConfig) ProtoMessage() {}
+
+func (x *RunnerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunnerConfig.ProtoReflect.Descriptor instead.
+func (*RunnerConfig) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RunnerConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RunnerConfig) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RunnerConfig) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+// WorkspaceUsageLimitSetting limits the usage of the whole workspace, 0 meaning unlimited.
+type WorkspaceUsageLimitSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_users is the maximum number of users.
+	MaxUsers int32 `protobuf:"varint,1,opt,name=max_users,json=maxUsers,proto3" json:"max_users,omitempty"`
+	// max_memos is the maximum number of memos, comments and archived memos included.
+	MaxMemos int32 `protobuf:"varint,2,opt,name=max_memos,json=maxMemos,proto3" json:"max_memos,omitempty"`
+	// max_storage_bytes is the maximum total size of the attachments.
+	MaxStorageBytes int64 `protobuf:"varint,3,opt,name=max_storage_bytes,json=maxStorageBytes,proto3" json:"max_storage_bytes,omitempty"`
+	// max_ai_monthly_tokens is the maximum number of AI tokens used per calendar month.
+	MaxAiMonthlyTokens int64 `protobuf:"varint,4,opt,name=max_ai_monthly_tokens,json=maxAiMonthlyTokens,proto3" json:"max_ai_monthly_tokens,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WorkspaceUsageLimitSetting) Reset() {
+	*x = WorkspaceUsageLimitSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceUsageLimitSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceUsageLimitSetting) ProtoMessage() {}
+
+func (x *WorkspaceUsageLimitSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceUsageLimitSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceUsageLimitSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WorkspaceUsageLimitSetting) GetMaxUsers() int32 {
+	if x != nil {
+		return x.MaxUsers
+	}
+	return 0
+}
+
+func (x *WorkspaceUsageLimitSetting) GetMaxMemos() int32 {
+	if x != nil {
+		return x.MaxMemos
+	}
+	return 0
+}
+
+func (x *WorkspaceUsageLimitSetting) GetMaxStorageBytes() int64 {
+	if x != nil {
+		return x.MaxStorageBytes
+	}
+	return 0
+}
+
+func (x *WorkspaceUsageLimitSetting) GetMaxAiMonthlyTokens() int64 {
+	if x != nil {
+		return x.MaxAiMonthlyTokens
+	}
+	return 0
+}
+
+type WorkspaceAIUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// month is the month the tokens were used in, e.g. "2025-06".
+	Month string `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	// tokens is the number of AI tokens used in the month.
+	Tokens        int64 `protobuf:"varint,2,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceAIUsage) Reset() {
+	*x = WorkspaceAIUsage{}
+	mi := &file_store_workspace_setting_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceAIUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceAIUsage) ProtoMessage() {}
+
+func (x *WorkspaceAIUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceAIUsage.ProtoReflect.Descriptor instead.
+func (*WorkspaceAIUsage) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WorkspaceAIUsage) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *WorkspaceAIUsage) GetTokens() int64 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+type WorkspaceSensitiveContentSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classifier_endpoint is the URL of the classifier the image attachments are posted to,
+	// either an external API or a local model served over HTTP. Leave it empty to disable classification.
+	ClassifierEndpoint string `protobuf:"bytes,1,opt,name=classifier_endpoint,json=classifierEndpoint,proto3" json:"classifier_endpoint,omitempty"`
+	// classifier_api_key is the API key sent as a bearer token to the classifier.
+	ClassifierApiKey string `protobuf:"bytes,2,opt,name=classifier_api_key,json=classifierApiKey,proto3" json:"classifier_api_key,omitempty"`
+	// policy is how sensitive attachments are served to users other than their creator.
+	Policy        SensitiveContentPolicy `protobuf:"varint,3,opt,name=policy,proto3,enum=memos.store.SensitiveContentPolicy" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSensitiveContentSetting) Reset() {
+	*x = WorkspaceSensitiveContentSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSensitiveContentSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSensitiveContentSetting) ProtoMessage() {}
+
+func (x *WorkspaceSensitiveContentSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSensitiveContentSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSensitiveContentSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WorkspaceSensitiveContentSetting) GetClassifierEndpoint() string {
+	if x != nil {
+		return x.ClassifierEndpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceSensitiveContentSetting) GetClassifierApiKey() string {
+	if x != nil {
+		return x.ClassifierApiKey
+	}
+	return ""
+}
+
+func (x *WorkspaceSensitiveContentSetting) GetPolicy() SensitiveContentPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return SensitiveContentPolicy_SENSITIVE_CONTENT_POLICY_UNSPECIFIED
+}
+
+type WorkspaceOutboundFetchSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// allowed_hosts are reached whatever their address and port: host names, "*.example.com" for the subdomains
+	// of a domain, IP addresses or CIDR ranges.
+	AllowedHosts []string `protobuf:"bytes,1,rep,name=allowed_hosts,json=allowedHosts,proto3" json:"allowed_hosts,omitempty"`
+	// allowed_ports are the ports the other hosts are reached on. When empty, 80, 443 and the ports from 1024 are allowed.
+	AllowedPorts []int32 `protobuf:"varint,2,rep,packed,name=allowed_ports,json=allowedPorts,proto3" json:"allowed_ports,omitempty"`
+	// max_response_bytes is the maximum size of the fetched responses, 0 for the default.
+	MaxResponseBytes int64 `protobuf:"varint,3,opt,name=max_response_bytes,json=maxResponseBytes,proto3" json:"max_response_bytes,omitempty"`
+	// timeout_seconds is the time limit of the requests, 0 for the default.
+	TimeoutSeconds int32 `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkspaceOutboundFetchSetting) Reset() {
+	*x = WorkspaceOutboundFetchSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceOutboundFetchSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceOutboundFetchSetting) ProtoMessage() {}
+
+func (x *WorkspaceOutboundFetchSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceOutboundFetchSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceOutboundFetchSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WorkspaceOutboundFetchSetting) GetAllowedHosts() []string {
+	if x != nil {
+		return x.AllowedHosts
+	}
+	return nil
+}
+
+func (x *WorkspaceOutboundFetchSetting) GetAllowedPorts() []int32 {
+	if x != nil {
+		return x.AllowedPorts
+	}
+	return nil
+}
+
+func (x *WorkspaceOutboundFetchSetting) GetMaxResponseBytes() int64 {
+	if x != nil {
+		return x.MaxResponseBytes
+	}
+	return 0
+}
+
+func (x *WorkspaceOutboundFetchSetting) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type WorkspaceLegalSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The terms of service in Markdown.
+	TermsOfService string `protobuf:"bytes,1,opt,name=terms_of_service,json=termsOfService,proto3" json:"terms_of_service,omitempty"`
+	// The privacy policy in Markdown.
+	PrivacyPolicy string `protobuf:"bytes,2,opt,name=privacy_policy,json=privacyPolicy,proto3" json:"privacy_policy,omitempty"`
+	// The version of the legal pages the users consent to. The users consent again at their next sign-in when it
+	// changes. Empty does not require the consent of the users.
+	Version       string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceLegalSetting) Reset() {
+	*x = WorkspaceLegalSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceLegalSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceLegalSetting) ProtoMessage() {}
+
+func (x *WorkspaceLegalSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceLegalSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceLegalSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WorkspaceLegalSetting) GetTermsOfService() string {
+	if x != nil {
+		return x.TermsOfService
+	}
+	return ""
+}
+
+func (x *WorkspaceLegalSetting) GetPrivacyPolicy() string {
+	if x != nil {
+		return x.PrivacyPolicy
+	}
+	return ""
+}
+
+func (x *WorkspaceLegalSetting) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type WorkspaceMaintenanceSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// windows are the scheduled, in progress and recently completed maintenance windows, sorted by start time.
+	Windows       []*MaintenanceWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceMaintenanceSetting) Reset() {
+	*x = WorkspaceMaintenanceSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceMaintenanceSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceMaintenanceSetting) ProtoMessage() {}
+
+func (x *WorkspaceMaintenanceSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceMaintenanceSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceMaintenanceSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WorkspaceMaintenanceSetting) GetWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type MaintenanceWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the window.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// start_ts and end_ts are the unix timestamps in seconds of the start and the end of the window.
+	StartTs int64 `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	EndTs   int64 `protobuf:"varint,3,opt,name=end_ts,json=endTs,proto3" json:"end_ts,omitempty"`
+	// message is the content of the announcement published at the start of the window.
+	Message string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	State   MaintenanceWindowState `protobuf:"varint,5,opt,name=state,proto3,enum=memos.store.MaintenanceWindowState" json:"state,omitempty"`
+	// announcement_id is the announcement published at the start of the window, 0 before it.
+	AnnouncementId int32 `protobuf:"varint,6,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"`
+	// creator_id is the admin who scheduled the window.
+	CreatorId     int32 `protobuf:"varint,7,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_store_workspace_setting_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MaintenanceWindow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetStartTs() int64 {
+	if x != nil {
+		return x.StartTs
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetEndTs() int64 {
+	if x != nil {
+		return x.EndTs
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetState() MaintenanceWindowState {
+	if x != nil {
+		return x.State
+	}
+	return MaintenanceWindowState_MAINTENANCE_WINDOW_STATE_UNSPECIFIED
+}
+
+func (x *MaintenanceWindow) GetAnnouncementId() int32 {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+type WorkspaceAlertingSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// channels are the channels the alerts are sent to.
+	Channels []*WorkspaceAlertingSetting_AlertChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// disk_usage_threshold_percent alerts when the usage of the disk of the data directory reaches it, 0 disables it.
+	DiskUsageThresholdPercent int32 `protobuf:"varint,2,opt,name=disk_usage_threshold_percent,json=diskUsageThresholdPercent,proto3" json:"disk_usage_threshold_percent,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *WorkspaceAlertingSetting) Reset() {
+	*x = WorkspaceAlertingSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceAlertingSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceAlertingSetting) ProtoMessage() {}
+
+func (x *WorkspaceAlertingSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceAlertingSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceAlertingSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WorkspaceAlertingSetting) GetChannels() []*WorkspaceAlertingSetting_AlertChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *WorkspaceAlertingSetting) GetDiskUsageThresholdPercent() int32 {
+	if x != nil {
+		return x.DiskUsageThresholdPercent
+	}
+	return 0
+}
+
+type WorkspaceMemoRelatedSetting_TagTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tag is the tag without "#", e.g. "incident". The template also applies to its subtags.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// template is the content the memos with the tag start from.
+	Template string `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	// required_properties are the front matter properties the memos with the tag must set, e.g. "severity".
+	RequiredProperties []string `protobuf:"bytes,3,rep,name=required_properties,json=requiredProperties,proto3" json:"required_properties,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WorkspaceMemoRelatedSetting_TagTemplate) Reset() {
+	*x = WorkspaceMemoRelatedSetting_TagTemplate{}
+	mi := &file_store_workspace_setting_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceMemoRelatedSetting_TagTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceMemoRelatedSetting_TagTemplate) ProtoMessage() {}
+
+func (x *WorkspaceMemoRelatedSetting_TagTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceMemoRelatedSetting_TagTemplate.ProtoReflect.Descriptor instead.
+func (*WorkspaceMemoRelatedSetting_TagTemplate) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{7, 2}
+}
+
+func (x *WorkspaceMemoRelatedSetting_TagTemplate) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *WorkspaceMemoRelatedSetting_TagTemplate) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *WorkspaceMemoRelatedSetting_TagTemplate) GetRequiredProperties() []string {
+	if x != nil {
+		return x.RequiredProperties
+	}
+	return nil
+}
+
+// RolePermission restricts the AI features a user role can use.
+type WorkspaceAISetting_RolePermission struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// disable_summary disallows generating AI summaries.
+	DisableSummary bool `protobuf:"varint,1,opt,name=disable_summary,json=disableSummary,proto3" json:"disable_summary,omitempty"`
+	// disable_speech disallows synthesizing memos and digests to speech.
+	DisableSpeech bool `protobuf:"varint,2,opt,name=disable_speech,json=disableSpeech,proto3" json:"disable_speech,omitempty"`
+	// disable_voice_memo disallows creating memos from voice recordings.
+	DisableVoiceMemo bool `protobuf:"varint,3,opt,name=disable_voice_memo,json=disableVoiceMemo,proto3" json:"disable_voice_memo,omitempty"`
+	// disable_chat disallows asking questions about the memos.
+	DisableChat bool `protobuf:"varint,4,opt,name=disable_chat,json=disableChat,proto3" json:"disable_chat,omitempty"`
+	// disable_tag_suggestion disallows suggesting tags for the memos, and applying them automatically.
+	DisableTagSuggestion bool `protobuf:"varint,5,opt,name=disable_tag_suggestion,json=disableTagSuggestion,proto3" json:"disable_tag_suggestion,omitempty"`
+	// hourly_request_limit is the maximum number of AI requests per user per hour,
+	// 0 for the default of the server and negative for no limit.
+	HourlyRequestLimit int32 `protobuf:"varint,6,opt,name=hourly_request_limit,json=hourlyRequestLimit,proto3" json:"hourly_request_limit,omitempty"`
+	// daily_request_limit is the maximum number of AI requests per user per UTC day, 0 for no limit.
+	DailyRequestLimit int32 `protobuf:"varint,7,opt,name=daily_request_limit,json=dailyRequestLimit,proto3" json:"daily_request_limit,omitempty"`
+	// monthly_token_budget is the maximum number of AI tokens per user per UTC month, 0 for no limit.
+	MonthlyTokenBudget int64 `protobuf:"varint,8,opt,name=monthly_token_budget,json=monthlyTokenBudget,proto3" json:"monthly_token_budget,omitempty"`
+	// disable_transform disallows translating and rewriting the memos.
+	DisableTransform bool `protobuf:"varint,9,opt,name=disable_transform,json=disableTransform,proto3" json:"disable_transform,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WorkspaceAISetting_RolePermission) Reset() {
+	*x = WorkspaceAISetting_RolePermission{}
+	mi := &file_store_workspace_setting_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceAISetting_RolePermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceAISetting_RolePermission) ProtoMessage() {}
+
+func (x *WorkspaceAISetting_RolePermission) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceAISetting_RolePermission.ProtoReflect.Descriptor instead.
+func (*WorkspaceAISetting_RolePermission) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *WorkspaceAISetting_RolePermission) GetDisableSummary() bool {
+	if x != nil {
+		return x.DisableSummary
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting_RolePermission) GetDisableSpeech() bool {
+	if x != nil {
+		return x.DisableSpeech
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting_RolePermission) GetDisableVoiceMemo() bool {
+	if x != nil {
+		return x.DisableVoiceMemo
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting_RolePermission) GetDisableChat() bool {
+	if x != nil {
+		return x.DisableChat
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting_RolePermission) GetDisableTagSuggestion() bool {
+	if x != nil {
+		return x.DisableTagSuggestion
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting_RolePermission) GetHourlyRequestLimit() int32 {
+	if x != nil {
+		return x.HourlyRequestLimit
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting_RolePermission) GetDailyRequestLimit() int32 {
+	if x != nil {
+		return x.DailyRequestLimit
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting_RolePermission) GetMonthlyTokenBudget() int64 {
+	if x != nil {
+		return x.MonthlyTokenBudget
+	}
+	return 0
+}
+
+func (x *WorkspaceAISetting_RolePermission) GetDisableTransform() bool {
+	if x != nil {
+		return x.DisableTransform
+	}
+	return false
+}
+
+// Redaction replaces sensitive content of the memos before they are sent to the AI provider.
+// Each distinct value is replaced with a numbered placeholder, e.g. [EMAIL_1], so the summary still reads coherently.
+type WorkspaceAISetting_Redaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// redact_emails replaces email addresses.
+	RedactEmails bool `protobuf:"varint,1,opt,name=redact_emails,json=redactEmails,proto3" json:"redact_emails,omitempty"`
+	// redact_phone_numbers replaces phone numbers.
+	RedactPhoneNumbers bool `protobuf:"varint,2,opt,name=redact_phone_numbers,json=redactPhoneNumbers,proto3" json:"redact_phone_numbers,omitempty"`
+	// patterns are regular expressions (RE2 syntax) whose matches are replaced.
+	Patterns []string `protobuf:"bytes,3,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	// tags are the tags, without the leading '#', replaced along with their child tags.
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// redact_secrets replaces API keys, access tokens, private keys and the values assigned to passwords and secrets.
+	RedactSecrets bool `protobuf:"varint,5,opt,name=redact_secrets,json=redactSecrets,proto3" json:"redact_secrets,omitempty"`
+	// restore_placeholders puts the replaced values back in place of the placeholders in the generated summaries.
+	// The content streamed while a summary is generated keeps the placeholders.
+	RestorePlaceholders bool `protobuf:"varint,6,opt,name=restore_placeholders,json=restorePlaceholders,proto3" json:"restore_placeholders,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *WorkspaceAISetting_Redaction) Reset() {
+	*x = WorkspaceAISetting_Redaction{}
+	mi := &file_store_workspace_setting_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceAISetting_Redaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceAISetting_Redaction) ProtoMessage() {}
+
+func (x *WorkspaceAISetting_Redaction) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceAISetting_Redaction.ProtoReflect.Descriptor instead.
+func (*WorkspaceAISetting_Redaction) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{8, 2}
+}
+
+func (x *WorkspaceAISetting_Redaction) GetRedactEmails() bool {
+	if x != nil {
+		return x.RedactEmails
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting_Redaction) GetRedactPhoneNumbers() bool {
+	if x != nil {
+		return x.RedactPhoneNumbers
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting_Redaction) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+func (x *WorkspaceAISetting_Redaction) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *WorkspaceAISetting_Redaction) GetRedactSecrets() bool {
+	if x != nil {
+		return x.RedactSecrets
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting_Redaction) GetRestorePlaceholders() bool {
+	if x != nil {
+		return x.RestorePlaceholders
+	}
+	return false
+}
+
+// Profile is a named configuration of an AI provider, e.g. a cheap model for tagging or a local one for embeddings.
+type WorkspaceAISetting_Profile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the profile in feature_profiles.
+	Name     string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Provider WorkspaceAISetting_Provider `protobuf:"varint,2,opt,name=provider,proto3,enum=memos.store.WorkspaceAISetting_Provider" json:"provider,omitempty"`
+	Endpoint string                      `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	ApiKey   string                      `protobuf:"bytes,4,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// api_version is the API version of Azure OpenAI.
+	ApiVersion string `protobuf:"bytes,5,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// model is the chat model of the profile.
+	Model string `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	// embedding_model is the embedding model of the profile, used when the embeddings are routed to it.
+	EmbeddingModel string `protobuf:"bytes,7,opt,name=embedding_model,json=embeddingModel,proto3" json:"embedding_model,omitempty"`
+	// transcription_model is the speech-to-text model of the profile, used when voice memos are routed to it.
+	TranscriptionModel string `protobuf:"bytes,8,opt,name=transcription_model,json=transcriptionModel,proto3" json:"transcription_model,omitempty"`
+	// summary_model, chat_model and vision_model are the models of the profile for the summaries, the chat and
+	// the attachment extraction of images, model when empty.
+	SummaryModel  string `protobuf:"bytes,9,opt,name=summary_model,json=summaryModel,proto3" json:"summary_model,omitempty"`
+	ChatModel     string `protobuf:"bytes,10,opt,name=chat_model,json=chatModel,proto3" json:"chat_model,omitempty"`
+	VisionModel   string `protobuf:"bytes,11,opt,name=vision_model,json=visionModel,proto3" json:"vision_model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceAISetting_Profile) Reset() {
+	*x = WorkspaceAISetting_Profile{}
+	mi := &file_store_workspace_setting_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceAISetting_Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceAISetting_Profile) ProtoMessage() {}
+
+func (x *WorkspaceAISetting_Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceAISetting_Profile.ProtoReflect.Descriptor instead.
+func (*WorkspaceAISetting_Profile) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{8, 3}
+}
+
+func (x *WorkspaceAISetting_Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting_Profile) GetProvider() WorkspaceAISetting_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return WorkspaceAISetting_PROVIDER_UNSPECIFIED
+}
+
+func (x *WorkspaceAISetting_Profile) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting_Profile) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting_Profile) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting_Profile) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting_Profile) GetEmbeddingModel() string {
+	if x != nil {
+		return x.EmbeddingModel
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting_Profile) GetTranscriptionModel() string {
+	if x != nil {
+		return x.TranscriptionModel
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting_Profile) GetSummaryModel() string {
+	if x != nil {
+		return x.SummaryModel
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting_Profile) GetChatModel() string {
+	if x != nil {
+		return x.ChatModel
+	}
+	return ""
+}
+
+func (x *WorkspaceAISetting_Profile) GetVisionModel() string {
+	if x != nil {
+		return x.VisionModel
+	}
+	return ""
+}
+
+// AttachmentExtraction extracts the text of the image and audio attachments with the AI provider, for the
+// summaries and the search. The text of the documents, e.g. PDFs, is extracted at upload without it.
+type WorkspaceAISetting_AttachmentExtraction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// images describes the images and reads their text with the vision model, which must support vision.
+	Images bool `protobuf:"varint,1,opt,name=images,proto3" json:"images,omitempty"`
+	// audio transcribes the audio recordings with the transcription model, with an OpenAI compatible provider.
+	Audio bool `protobuf:"varint,2,opt,name=audio,proto3" json:"audio,omitempty"`
+	// include_in_summaries adds the text of the attachments of the memos, documents included, to the summary prompts.
+	IncludeInSummaries bool `protobuf:"varint,3,opt,name=include_in_summaries,json=includeInSummaries,proto3" json:"include_in_summaries,omitempty"`
+	// include_in_search indexes the extracted text for memo search, like the text of the documents.
+	// It applies to the attachments extracted afterwards.
+	IncludeInSearch bool `protobuf:"varint,4,opt,name=include_in_search,json=includeInSearch,proto3" json:"include_in_search,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WorkspaceAISetting_AttachmentExtraction) Reset() {
+	*x = WorkspaceAISetting_AttachmentExtraction{}
+	mi := &file_store_workspace_setting_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceAISetting_AttachmentExtraction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceAISetting_AttachmentExtraction) ProtoMessage() {}
+
+func (x *WorkspaceAISetting_AttachmentExtraction) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceAISetting_AttachmentExtraction.ProtoReflect.Descriptor instead.
+func (*WorkspaceAISetting_AttachmentExtraction) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{8, 5}
+}
+
+func (x *WorkspaceAISetting_AttachmentExtraction) GetImages() bool {
+	if x != nil {
+		return x.Images
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting_AttachmentExtraction) GetAudio() bool {
+	if x != nil {
+		return x.Audio
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting_AttachmentExtraction) GetIncludeInSummaries() bool {
+	if x != nil {
+		return x.IncludeInSummaries
+	}
+	return false
+}
+
+func (x *WorkspaceAISetting_AttachmentExtraction) GetIncludeInSearch() bool {
+	if x != nil {
+		return x.IncludeInSearch
+	}
+	return false
+}
+
+type WorkspaceAlertingSetting_AlertChannel struct {
+	state protoimpl.MessageState                     `protogen:"open.v1"`
+	Type  WorkspaceAlertingSetting_AlertChannel_Type `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.WorkspaceAlertingSetting_AlertChannel_Type" json:"type,omitempty"`
+	// url is the URL the alerts are posted to, for the webhook and Slack channels.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// smtp is the server the alerts are sent with, for the email channel.
+	Smtp *WorkspaceAlertingSetting_SMTPConfig `protobuf:"bytes,3,opt,name=smtp,proto3" json:"smtp,omitempty"`
+	// recipients are the email addresses the alerts are sent to, for the email channel.
+	Recipients    []string `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceAlertingSetting_AlertChannel) Reset() {
+	*x = WorkspaceAlertingSetting_AlertChannel{}
+	mi := &file_store_workspace_setting_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceAlertingSetting_AlertChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceAlertingSetting_AlertChannel) ProtoMessage() {}
+
+func (x *WorkspaceAlertingSetting_AlertChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceAlertingSetting_AlertChannel.ProtoReflect.Descriptor instead.
+func (*WorkspaceAlertingSetting_AlertChannel) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *WorkspaceAlertingSetting_AlertChannel) GetType() WorkspaceAlertingSetting_AlertChannel_Type {
+	if x != nil {
+		return x.Type
+	}
+	return WorkspaceAlertingSetting_AlertChannel_TYPE_UNSPECIFIED
+}
+
+func (x *WorkspaceAlertingSetting_AlertChannel) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WorkspaceAlertingSetting_AlertChannel) GetSmtp() *WorkspaceAlertingSetting_SMTPConfig {
+	if x != nil {
+		return x.Smtp
+	}
+	return nil
+}
+
+func (x *WorkspaceAlertingSetting_AlertChannel) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+type WorkspaceAlertingSetting_SMTPConfig struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Host     string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port     int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Username string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// from is the sender address of the emails.
+	From          string `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceAlertingSetting_SMTPConfig) Reset() {
+	*x = WorkspaceAlertingSetting_SMTPConfig{}
+	mi := &file_store_workspace_setting_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceAlertingSetting_SMTPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceAlertingSetting_SMTPConfig) ProtoMessage() {}
+
+func (x *WorkspaceAlertingSetting_SMTPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceAlertingSetting_SMTPConfig.ProtoReflect.Descriptor instead.
+func (*WorkspaceAlertingSetting_SMTPConfig) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{22, 1}
+}
+
+func (x *WorkspaceAlertingSetting_SMTPConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *WorkspaceAlertingSetting_SMTPConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *WorkspaceAlertingSetting_SMTPConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *WorkspaceAlertingSetting_SMTPConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *WorkspaceAlertingSetting_SMTPConfig) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+var File_store_workspace_setting_proto protoreflect.FileDescriptor
+
+const file_store_workspace_setting_proto_rawDesc = "" +
+	"\n" +
+	"\x1dstore/workspace_setting.proto\x12\vmemos.store\"\xce\v\n" +
+	"\x10WorkspaceSetting\x122\n" +
+	"\x03key\x18\x01 \x01(\x0e2 .memos.store.WorkspaceSettingKeyR\x03key\x12I\n" +
+	"\rbasic_setting\x18\x02 \x01(\v2\".memos.store.WorkspaceBasicSettingH\x00R\fbasicSetting\x12O\n" +
+	"\x0fgeneral_setting\x18\x03 \x01(\v2$.memos.store.WorkspaceGeneralSettingH\x00R\x0egeneralSetting\x12O\n" +
+	"\x0fstorage_setting\x18\x04 \x01(\v2$.memos.store.WorkspaceStorageSettingH\x00R\x0estorageSetting\x12\\\n" +
+	"\x14memo_related_setting\x18\x05 \x01(\v2(.memos.store.WorkspaceMemoRelatedSettingH\x00R\x12memoRelatedSetting\x12@\n" +
+	"\n" +
+	"ai_setting\x18\x06 \x01(\v2\x1f.memos.store.WorkspaceAISettingH\x00R\taiSetting\x12$\n" +
+	"\rai_rate_limit\x18\a \x01(\tH\x00R\vaiRateLimit\x12X\n" +
+	"\x12onboarding_setting\x18\b \x01(\v2'.memos.store.WorkspaceOnboardingSettingH\x00R\x11onboardingSetting\x12`\n" +
+	"\x16new_user_limit_setting\x18\t \x01(\v2).memos.store.WorkspaceNewUserLimitSettingH\x00R\x13newUserLimitSetting\x12\\\n" +
+	"\x14feature_flag_setting\x18\n" +
+	" \x01(\v2(.memos.store.WorkspaceFeatureFlagSettingH\x00R\x12featureFlagSetting\x12L\n" +
+	"\x0erunner_setting\x18\v \x01(\v2#.memos.store.WorkspaceRunnerSettingH\x00R\rrunnerSetting\x12Y\n" +
+	"\x13usage_limit_setting\x18\f \x01(\v2'.memos.store.WorkspaceUsageLimitSettingH\x00R\x11usageLimitSetting\x12:\n" +
+	"\bai_usage\x18\r \x01(\v2\x1d.memos.store.WorkspaceAIUsageH\x00R\aaiUsage\x12k\n" +
+	"\x19sensitive_content_setting\x18\x0e \x01(\v2-.memos.store.WorkspaceSensitiveContentSettingH\x00R\x17sensitiveContentSetting\x12b\n" +
+	"\x16outbound_fetch_setting\x18\x0f \x01(\v2*.memos.store.WorkspaceOutboundFetchSettingH\x00R\x14outboundFetchSetting\x12I\n" +
+	"\rlegal_setting\x18\x10 \x01(\v2\".memos.store.WorkspaceLegalSettingH\x00R\flegalSetting\x12[\n" +
+	"\x13maintenance_setting\x18\x11 \x01(\v2(.memos.store.WorkspaceMaintenanceSettingH\x00R\x12maintenanceSetting\x12R\n" +
+	"\x10alerting_setting\x18\x12 \x01(\v2%.memos.store.WorkspaceAlertingSettingH\x00R\x0falertingSettingB\a\n" +
+	"\x05value\"\xbc\x01\n" +
+	"\x15WorkspaceBasicSetting\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x01 \x01(\tR\tsecretKey\x12%\n" +
+	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\x12]\n" +
+	"\x19access_token_signing_keys\x18\x03 \x03(\v2\".memos.store.AccessTokenSigningKeyR\x16accessTokenSigningKeys\"}\n" +
+	"\x15AccessTokenSigningKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x03 \x01(\x03R\tcreatedTs\x12\x1d\n" +
+	"\n" +
+	"expires_ts\x18\x04 \x01(\x03R\texpiresTs\"\xee\x03\n" +
+	"\x17WorkspaceGeneralSetting\x12\x14\n" +
+	"\x05theme\x18\x01 \x01(\tR\x05theme\x12<\n" +
+	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
+	"\x16disallow_password_auth\x18\x03 \x01(\bR\x14disallowPasswordAuth\x12+\n" +
+	"\x11additional_script\x18\x04 \x01(\tR\x10additionalScript\x12)\n" +
+	"\x10additional_style\x18\x05 \x01(\tR\x0fadditionalStyle\x12J\n" +
+	"\x0ecustom_profile\x18\x06 \x01(\v2#.memos.store.WorkspaceCustomProfileR\rcustomProfile\x121\n" +
+	"\x15week_start_day_offset\x18\a \x01(\x05R\x12weekStartDayOffset\x128\n" +
+	"\x18disallow_change_username\x18\b \x01(\bR\x16disallowChangeUsername\x128\n" +
+	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\"\x83\x01\n" +
+	"\x16WorkspaceCustomProfile\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
+	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\x12\x16\n" +
+	"\x06locale\x18\x04 \x01(\tR\x06locale\"\xd5\x02\n" +
+	"\x17WorkspaceStorageSetting\x12S\n" +
+	"\fstorage_type\x18\x01 \x01(\x0e20.memos.store.WorkspaceStorageSetting.StorageTypeR\vstorageType\x12+\n" +
+	"\x11filepath_template\x18\x02 \x01(\tR\x10filepathTemplate\x12/\n" +
+	"\x14upload_size_limit_mb\x18\x03 \x01(\x03R\x11uploadSizeLimitMb\x129\n" +
+	"\ts3_config\x18\x04 \x01(\v2\x1c.memos.store.StorageS3ConfigR\bs3Config\"L\n" +
+	"\vStorageType\x12\x1c\n" +
+	"\x18STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bDATABASE\x10\x01\x12\t\n" +
+	"\x05LOCAL\x10\x02\x12\x06\n" +
+	"\x02S3\x10\x03\"\xd3\x01\n" +
+	"\x0fStorageS3Config\x12\"\n" +
+	"\raccess_key_id\x18\x01 \x01(\tR\vaccessKeyId\x12*\n" +
+	"\x11access_key_secret\x18\x02 \x01(\tR\x0faccessKeySecret\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x16\n" +
+	"\x06bucket\x18\x05 \x01(\tR\x06bucket\x12$\n" +
+	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\xcf\n" +
+	"\n" +
+	"\x1bWorkspaceMemoRelatedSetting\x12<\n" +
+	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
+	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
+	"\x14content_length_limit\x18\x03 \x01(\x05R\x12contentLengthLimit\x127\n" +
+	"\x18enable_double_click_edit\x18\x04 \x01(\bR\x15enableDoubleClickEdit\x12.\n" +
+	"\x13enable_link_preview\x18\x05 \x01(\bR\x11enableLinkPreview\x12\x1c\n" +
+	"\treactions\x18\a \x03(\tR\treactions\x12<\n" +
+	"\x1adisable_markdown_shortcuts\x18\b \x01(\bR\x18disableMarkdownShortcuts\x127\n" +
+	"\x18enable_blur_nsfw_content\x18\t \x01(\bR\x15enableBlurNsfwContent\x12\x1b\n" +
+	"\tnsfw_tags\x18\n" +
+	" \x03(\tR\bnsfwTags\x12\x81\x01\n" +
+	"\x19role_default_visibilities\x18\v \x03(\v2E.memos.store.WorkspaceMemoRelatedSetting.RoleDefaultVisibilitiesEntryR\x17roleDefaultVisibilities\x125\n" +
+	"\x17cold_storage_after_days\x18\f \x01(\x05R\x14coldStorageAfterDays\x122\n" +
+	"\x15disable_view_tracking\x18\r \x01(\bR\x13disableViewTracking\x12<\n" +
+	"\x1aprotected_visibility_roles\x18\x0e \x03(\tR\x18protectedVisibilityRoles\x12.\n" +
+	"\x13enable_fuzzy_search\x18\x0f \x01(\bR\x11enableFuzzySearch\x124\n" +
+	"\x16approval_required_tags\x18\x10 \x03(\tR\x14approvalRequiredTags\x12Y\n" +
+	"\rtag_templates\x18\x11 \x03(\v24.memos.store.WorkspaceMemoRelatedSetting.TagTemplateR\ftagTemplates\x12+\n" +
+	"\x11expand_shortcodes\x18\x12 \x01(\bR\x10expandShortcodes\x12X\n" +
+	"\n" +
+	"shortcodes\x18\x13 \x03(\v28.memos.store.WorkspaceMemoRelatedSetting.ShortcodesEntryR\n" +
+	"shortcodes\x1aJ\n" +
+	"\x1cRoleDefaultVisibilitiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n" +
+	"\x0fShortcodesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1al\n" +
+	"\vTagTemplate\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1a\n" +
+	"\btemplate\x18\x02 \x01(\tR\btemplate\x12/\n" +
+	"\x13required_properties\x18\x03 \x03(\tR\x12requiredProperties\"\x9c\x1c\n" +
+	"\x12WorkspaceAISetting\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12#\n" +
+	"\rsystem_prompt\x18\x04 \x01(\tR\fsystemPrompt\x12\x1b\n" +
+	"\ttts_model\x18\x05 \x01(\tR\bttsModel\x12\x1b\n" +
+	"\ttts_voice\x18\x06 \x01(\tR\bttsVoice\x12!\n" +
+	"\ftts_endpoint\x18\a \x01(\tR\vttsEndpoint\x12/\n" +
+	"\x13transcription_model\x18\b \x01(\tR\x12transcriptionModel\x12_\n" +
+	"\x10role_permissions\x18\t \x03(\v24.memos.store.WorkspaceAISetting.RolePermissionsEntryR\x0frolePermissions\x128\n" +
+	"\x18disallow_protected_memos\x18\n" +
+	" \x01(\bR\x16disallowProtectedMemos\x12,\n" +
+	"\x12prompt_token_price\x18\v \x01(\x01R\x10promptTokenPrice\x124\n" +
+	"\x16completion_token_price\x18\f \x01(\x01R\x14completionTokenPrice\x12D\n" +
+	"\bprovider\x18\r \x01(\x0e2(.memos.store.WorkspaceAISetting.ProviderR\bprovider\x12\x1f\n" +
+	"\vapi_version\x18\x0e \x01(\tR\n" +
+	"apiVersion\x12G\n" +
+	"\tredaction\x18\x0f \x01(\v2).memos.store.WorkspaceAISetting.RedactionR\tredaction\x12'\n" +
+	"\x0fembedding_model\x18\x10 \x01(\tR\x0eembeddingModel\x12\x19\n" +
+	"\bauto_tag\x18\x11 \x01(\bR\aautoTag\x12C\n" +
+	"\bprofiles\x18\x12 \x03(\v2'.memos.store.WorkspaceAISetting.ProfileR\bprofiles\x12_\n" +
+	"\x10feature_profiles\x18\x13 \x03(\v24.memos.store.WorkspaceAISetting.FeatureProfilesEntryR\x0ffeatureProfiles\x12#\n" +
+	"\rdebug_logging\x18\x14 \x01(\bR\fdebugLogging\x127\n" +
+	"\x18debug_log_retention_days\x18\x15 \x01(\x05R\x15debugLogRetentionDays\x12,\n" +
+	"\x12summary_chunk_size\x18\x16 \x01(\x05R\x10summaryChunkSize\x12,\n" +
+	"\x12summary_max_chunks\x18\x17 \x01(\x05R\x10summaryMaxChunks\x12i\n" +
+	"\x15attachment_extraction\x18\x18 \x01(\v24.memos.store.WorkspaceAISetting.AttachmentExtractionR\x14attachmentExtraction\x12#\n" +
+	"\rsummary_model\x18\x19 \x01(\tR\fsummaryModel\x12\x1d\n" +
+	"\n" +
+	"chat_model\x18\x1a \x01(\tR\tchatModel\x12!\n" +
+	"\fvision_model\x18\x1b \x01(\tR\vvisionModel\x12%\n" +
+	"\vtemperature\x18\x1c \x01(\x01H\x00R\vtemperature\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"max_tokens\x18\x1d \x01(\x05R\tmaxTokens\x12A\n" +
+	"\x1dworkspace_summary_daily_limit\x18\x1e \x01(\x05R\x1aworkspaceSummaryDailyLimit\x12\\\n" +
+	"\x0fcontext_windows\x18\x1f \x03(\v23.memos.store.WorkspaceAISetting.ContextWindowsEntryR\x0econtextWindows\x12,\n" +
+	"\x12summary_min_length\x18  \x01(\x05R\x10summaryMinLength\x12,\n" +
+	"\x12summary_max_length\x18! \x01(\x05R\x10summaryMaxLength\x129\n" +
+	"\x19summary_cache_ttl_minutes\x18\" \x01(\x05R\x16summaryCacheTtlMinutes\x128\n" +
+	"\x18circuit_breaker_failures\x18# \x01(\x05R\x16circuitBreakerFailures\x12G\n" +
+	" circuit_breaker_cooldown_seconds\x18$ \x01(\x05R\x1dcircuitBreakerCooldownSeconds\x126\n" +
+	"\x17max_concurrent_requests\x18% \x01(\x05R\x15maxConcurrentRequests\x12\x1a\n" +
+	"\bdisabled\x18& \x01(\bR\bdisabled\x12<\n" +
+	"\x1adata_processing_disclosure\x18' \x01(\tR\x18dataProcessingDisclosure\x1a\xa8\x03\n" +
+	"\x0eRolePermission\x12'\n" +
+	"\x0fdisable_summary\x18\x01 \x01(\bR\x0edisableSummary\x12%\n" +
+	"\x0edisable_speech\x18\x02 \x01(\bR\rdisableSpeech\x12,\n" +
+	"\x12disable_voice_memo\x18\x03 \x01(\bR\x10disableVoiceMemo\x12!\n" +
+	"\fdisable_chat\x18\x04 \x01(\bR\vdisableChat\x124\n" +
+	"\x16disable_tag_suggestion\x18\x05 \x01(\bR\x14disableTagSuggestion\x120\n" +
+	"\x14hourly_request_limit\x18\x06 \x01(\x05R\x12hourlyRequestLimit\x12.\n" +
+	"\x13daily_request_limit\x18\a \x01(\x05R\x11dailyRequestLimit\x120\n" +
+	"\x14monthly_token_budget\x18\b \x01(\x03R\x12monthlyTokenBudget\x12+\n" +
+	"\x11disable_transform\x18\t \x01(\bR\x10disableTransform\x1ar\n" +
+	"\x14RolePermissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12D\n" +
+	"\x05value\x18\x02 \x01(\v2..memos.store.WorkspaceAISetting.RolePermissionR\x05value:\x028\x01\x1a\xec\x01\n" +
+	"\tRedaction\x12#\n" +
+	"\rredact_emails\x18\x01 \x01(\bR\fredactEmails\x120\n" +
+	"\x14redact_phone_numbers\x18\x02 \x01(\bR\x12redactPhoneNumbers\x12\x1a\n" +
+	"\bpatterns\x18\x03 \x03(\tR\bpatterns\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12%\n" +
+	"\x0eredact_secrets\x18\x05 \x01(\bR\rredactSecrets\x121\n" +
+	"\x14restore_placeholders\x18\x06 \x01(\bR\x13restorePlaceholders\x1a\x90\x03\n" +
+	"\aProfile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12D\n" +
+	"\bprovider\x18\x02 \x01(\x0e2(.memos.store.WorkspaceAISetting.ProviderR\bprovider\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x04 \x01(\tR\x06apiKey\x12\x1f\n" +
+	"\vapi_version\x18\x05 \x01(\tR\n" +
+	"apiVersion\x12\x14\n" +
+	"\x05model\x18\x06 \x01(\tR\x05model\x12'\n" +
+	"\x0fembedding_model\x18\a \x01(\tR\x0eembeddingModel\x12/\n" +
+	"\x13transcription_model\x18\b \x01(\tR\x12transcriptionModel\x12#\n" +
+	"\rsummary_model\x18\t \x01(\tR\fsummaryModel\x12\x1d\n" +
+	"\n" +
+	"chat_model\x18\n" +
+	" \x01(\tR\tchatModel\x12!\n" +
+	"\fvision_model\x18\v \x01(\tR\vvisionModel\x1aB\n" +
+	"\x14FeatureProfilesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xa2\x01\n" +
+	"\x14AttachmentExtraction\x12\x16\n" +
+	"\x06images\x18\x01 \x01(\bR\x06images\x12\x14\n" +
+	"\x05audio\x18\x02 \x01(\bR\x05audio\x120\n" +
+	"\x14include_in_summaries\x18\x03 \x01(\bR\x12includeInSummaries\x12*\n" +
+	"\x11include_in_search\x18\x04 \x01(\bR\x0fincludeInSearch\x1aA\n" +
+	"\x13ContextWindowsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"s\n" +
+	"\bProvider\x12\x18\n" +
+	"\x14PROVIDER_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06OPENAI\x10\x01\x12\x10\n" +
+	"\fAZURE_OPENAI\x10\x02\x12\r\n" +
+	"\tANTHROPIC\x10\x03\x12\n" +
+	"\n" +
+	"\x06OLLAMA\x10\x04\x12\n" +
+	"\n" +
+	"\x06GEMINI\x10\x05\x12\b\n" +
+	"\x04FAKE\x10\x06B\x0e\n" +
+	"\f_temperature\"\x98\x01\n" +
+	"\x1aWorkspaceOnboardingSetting\x120\n" +
+	"\x14welcome_memo_content\x18\x01 \x01(\tR\x12welcomeMemoContent\x12%\n" +
+	"\x0etemplate_memos\x18\x02 \x03(\tR\rtemplateMemos\x12!\n" +
+	"\fdefault_tags\x18\x03 \x03(\tR\vdefaultTags\"\xcd\x01\n" +
+	"\x1cWorkspaceNewUserLimitSetting\x12%\n" +
+	"\x0eprobation_days\x18\x01 \x01(\x05R\rprobationDays\x12\"\n" +
+	"\rmemos_per_day\x18\x02 \x01(\x05R\vmemosPerDay\x12.\n" +
+	"\x13attachments_per_day\x18\x03 \x01(\x05R\x11attachmentsPerDay\x122\n" +
+	"\x15disallow_public_memos\x18\x04 \x01(\bR\x13disallowPublicMemos\"M\n" +
+	"\x1bWorkspaceFeatureFlagSetting\x12.\n" +
+	"\x05flags\x18\x01 \x03(\v2\x18.memos.store.FeatureFlagR\x05flags\"j\n" +
+	"\vFeatureFlag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12-\n" +
+	"\x12rollout_percentage\x18\x03 \x01(\x05R\x11rolloutPercentage\"M\n" +
+	"\x16WorkspaceRunnerSetting\x123\n" +
+	"\arunners\x18\x01 \x03(\v2\x19.memos.store.RunnerConfigR\arunners\"Z\n" +
+	"\fRunnerConfig\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bdisabled\x18\x02 \x01(\bR\bdisabled\x12\x1a\n" +
+	"\bschedule\x18\x03 \x01(\tR\bschedule\"\xb5\x01\n" +
+	"\x1aWorkspaceUsageLimitSetting\x12\x1b\n" +
+	"\tmax_users\x18\x01 \x01(\x05R\bmaxUsers\x12\x1b\n" +
+	"\tmax_memos\x18\x02 \x01(\x05R\bmaxMemos\x12*\n" +
+	"\x11max_storage_bytes\x18\x03 \x01(\x03R\x0fmaxStorageBytes\x121\n" +
+	"\x15max_ai_monthly_tokens\x18\x04 \x01(\x03R\x12maxAiMonthlyTokens\"@\n" +
+	"\x10WorkspaceAIUsage\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12\x16\n" +
+	"\x06tokens\x18\x02 \x01(\x03R\x06tokens\"\xbe\x01\n" +
+	" WorkspaceSensitiveContentSetting\x12/\n" +
+	"\x13classifier_endpoint\x18\x01 \x01(\tR\x12classifierEndpoint\x12,\n" +
+	"\x12classifier_api_key\x18\x02 \x01(\tR\x10classifierApiKey\x12;\n" +
+	"\x06policy\x18\x03 \x01(\x0e2#.memos.store.SensitiveContentPolicyR\x06policy\"\xc0\x01\n" +
+	"\x1dWorkspaceOutboundFetchSetting\x12#\n" +
+	"\rallowed_hosts\x18\x01 \x03(\tR\fallowedHosts\x12#\n" +
+	"\rallowed_ports\x18\x02 \x03(\x05R\fallowedPorts\x12,\n" +
+	"\x12max_response_bytes\x18\x03 \x01(\x03R\x10maxResponseBytes\x12'\n" +
+	"\x0ftimeout_seconds\x18\x04 \x01(\x05R\x0etimeoutSeconds\"\x82\x01\n" +
+	"\x15WorkspaceLegalSetting\x12(\n" +
+	"\x10terms_of_service\x18\x01 \x01(\tR\x0etermsOfService\x12%\n" +
+	"\x0eprivacy_policy\x18\x02 \x01(\tR\rprivacyPolicy\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\"W\n" +
+	"\x1bWorkspaceMaintenanceSetting\x128\n" +
+	"\awindows\x18\x01 \x03(\v2\x1e.memos.store.MaintenanceWindowR\awindows\"\xf2\x01\n" +
+	"\x11MaintenanceWindow\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bstart_ts\x18\x02 \x01(\x03R\astartTs\x12\x15\n" +
+	"\x06end_ts\x18\x03 \x01(\x03R\x05endTs\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x129\n" +
+	"\x05state\x18\x05 \x01(\x0e2#.memos.store.MaintenanceWindowStateR\x05state\x12'\n" +
+	"\x0fannouncement_id\x18\x06 \x01(\x05R\x0eannouncementId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\a \x01(\x05R\tcreatorId\"\xc5\x04\n" +
+	"\x18WorkspaceAlertingSetting\x12N\n" +
+	"\bchannels\x18\x01 \x03(\v22.memos.store.WorkspaceAlertingSetting.AlertChannelR\bchannels\x12?\n" +
+	"\x1cdisk_usage_threshold_percent\x18\x02 \x01(\x05R\x19diskUsageThresholdPercent\x1a\x94\x02\n" +
+	"\fAlertChannel\x12K\n" +
+	"\x04type\x18\x01 \x01(\x0e27.memos.store.WorkspaceAlertingSetting.AlertChannel.TypeR\x04type\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12D\n" +
+	"\x04smtp\x18\x03 \x01(\v20.memos.store.WorkspaceAlertingSetting.SMTPConfigR\x04smtp\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\x04 \x03(\tR\n" +
+	"recipients\"?\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aWEBHOOK\x10\x01\x12\t\n" +
+	"\x05SLACK\x10\x02\x12\t\n" +
+	"\x05EMAIL\x10\x03\x1a\x80\x01\n" +
+	"\n" +
+	"SMTPConfig\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from*\xcd\x02\n" +
+	"\x13WorkspaceSettingKey\x12%\n" +
+	"!WORKSPACE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05BASIC\x10\x01\x12\v\n" +
+	"\aGENERAL\x10\x02\x12\v\n" +
+	"\aSTORAGE\x10\x03\x12\x10\n" +
+	"\fMEMO_RELATED\x10\x04\x12\r\n" +
+	"\tAI_CONFIG\x10\x05\x12\x11\n" +
+	"\rAI_RATE_LIMIT\x10\x06\x12\x0e\n" +
+	"\n" +
+	"ONBOARDING\x10\a\x12\x12\n" +
+	"\x0eNEW_USER_LIMIT\x10\b\x12\x11\n" +
+	"\rFEATURE_FLAGS\x10\t\x12\v\n" +
+	"\aRUNNERS\x10\n" +
+	"\x12\x0f\n" +
+	"\vUSAGE_LIMIT\x10\v\x12\f\n" +
+	"\bAI_USAGE\x10\f\x12\x15\n" +
+	"\x11SENSITIVE_CONTENT\x10\r\x12\x12\n" +
+	"\x0eOUTBOUND_FETCH\x10\x0e\x12\t\n" +
+	"\x05LEGAL\x10\x0f\x12\x0f\n" +
+	"\vMAINTENANCE\x10\x10\x12\f\n" +
+	"\bALERTING\x10\x11*Z\n" +
+	"\x16SensitiveContentPolicy\x12(\n" +
+	"$SENSITIVE_CONTENT_POLICY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04BLUR\x10\x01\x12\f\n" +
+	"\bRESTRICT\x10\x02*b\n" +
+	"\x16MaintenanceWindowState\x12(\n" +
+	"$MAINTENANCE_WINDOW_STATE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vIN_PROGRESS\x10\x01\x12\r\n" +
+	"\tCOMPLETED\x10\x02B\xa0\x01\n" +
 	"\x0fcom.memos.storeB\x15WorkspaceSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -975,35 +3663,98 @@ func file_store_workspace_setting_proto_rawDescGZIP() []byte {
 	return file_store_workspace_setting_proto_rawDescData
 }
 
-var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_store_workspace_setting_proto_goTypes = []any{
-	(WorkspaceSettingKey)(0),                 // 0: memos.store.WorkspaceSettingKey
-	(WorkspaceStorageSetting_StorageType)(0), // 1: memos.store.WorkspaceStorageSetting.StorageType
-	(*WorkspaceSetting)(nil),                 // 2: memos.store.WorkspaceSetting
-	(*WorkspaceBasicSetting)(nil),            // 3: memos.store.WorkspaceBasicSetting
-	(*WorkspaceGeneralSetting)(nil),          // 4: memos.store.WorkspaceGeneralSetting
-	(*WorkspaceCustomProfile)(nil),           // 5: memos.store.WorkspaceCustomProfile
-	(*WorkspaceStorageSetting)(nil),          // 6: memos.store.WorkspaceStorageSetting
-	(*StorageS3Config)(nil),                  // 7: memos.store.StorageS3Config
-	(*WorkspaceMemoRelatedSetting)(nil),      // 8: memos.store.WorkspaceMemoRelatedSetting
-	(*WorkspaceAISetting)(nil),               // 9: memos.store.WorkspaceAISetting
+	(WorkspaceSettingKey)(0),                        // 0: memos.store.WorkspaceSettingKey
+	(SensitiveContentPolicy)(0),                     // 1: memos.store.SensitiveContentPolicy
+	(MaintenanceWindowState)(0),                     // 2: memos.store.MaintenanceWindowState
+	(WorkspaceStorageSetting_StorageType)(0),        // 3: memos.store.WorkspaceStorageSetting.StorageType
+	(WorkspaceAISetting_Provider)(0),                // 4: memos.store.WorkspaceAISetting.Provider
+	(WorkspaceAlertingSetting_AlertChannel_Type)(0), // 5: memos.store.WorkspaceAlertingSetting.AlertChannel.Type
+	(*WorkspaceSetting)(nil),                        // 6: memos.store.WorkspaceSetting
+	(*WorkspaceBasicSetting)(nil),                   // 7: memos.store.WorkspaceBasicSetting
+	(*AccessTokenSigningKey)(nil),                   // 8: memos.store.AccessTokenSigningKey
+	(*WorkspaceGeneralSetting)(nil),                 // 9: memos.store.WorkspaceGeneralSetting
+	(*WorkspaceCustomProfile)(nil),                  // 10: memos.store.WorkspaceCustomProfile
+	(*WorkspaceStorageSetting)(nil),                 // 11: memos.store.WorkspaceStorageSetting
+	(*StorageS3Config)(nil),                         // 12: memos.store.StorageS3Config
+	(*WorkspaceMemoRelatedSetting)(nil),             // 13: memos.store.WorkspaceMemoRelatedSetting
+	(*WorkspaceAISetting)(nil),                      // 14: memos.store.WorkspaceAISetting
+	(*WorkspaceOnboardingSetting)(nil),              // 15: memos.store.WorkspaceOnboardingSetting
+	(*WorkspaceNewUserLimitSetting)(nil),            // 16: memos.store.WorkspaceNewUserLimitSetting
+	(*WorkspaceFeatureFlagSetting)(nil),             // 17: memos.store.WorkspaceFeatureFlagSetting
+	(*FeatureFlag)(nil),                             // 18: memos.store.FeatureFlag
+	(*WorkspaceRunnerSetting)(nil),                  // 19: memos.store.WorkspaceRunnerSetting
+	(*RunnerConfig)(nil),                            // 20: memos.store.RunnerConfig
+	(*WorkspaceUsageLimitSetting)(nil),              // 21: memos.store.WorkspaceUsageLimitSetting
+	(*WorkspaceAIUsage)(nil),                        // 22: memos.store.WorkspaceAIUsage
+	(*WorkspaceSensitiveContentSetting)(nil),        // 23: memos.store.WorkspaceSensitiveContentSetting
+	(*WorkspaceOutboundFetchSetting)(nil),           // 24: memos.store.WorkspaceOutboundFetchSetting
+	(*WorkspaceLegalSetting)(nil),                   // 25: memos.store.WorkspaceLegalSetting
+	(*WorkspaceMaintenanceSetting)(nil),             // 26: memos.store.WorkspaceMaintenanceSetting
+	(*MaintenanceWindow)(nil),                       // 27: memos.store.MaintenanceWindow
+	(*WorkspaceAlertingSetting)(nil),                // 28: memos.store.WorkspaceAlertingSetting
+	nil,                                             // 29: memos.store.WorkspaceMemoRelatedSetting.RoleDefaultVisibilitiesEntry
+	nil,                                             // 30: memos.store.WorkspaceMemoRelatedSetting.ShortcodesEntry
+	(*WorkspaceMemoRelatedSetting_TagTemplate)(nil), // 31: memos.store.WorkspaceMemoRelatedSetting.TagTemplate
+	(*WorkspaceAISetting_RolePermission)(nil),       // 32: memos.store.WorkspaceAISetting.RolePermission
+	nil,                                  // 33: memos.store.WorkspaceAISetting.RolePermissionsEntry
+	(*WorkspaceAISetting_Redaction)(nil), // 34: memos.store.WorkspaceAISetting.Redaction
+	(*WorkspaceAISetting_Profile)(nil),   // 35: memos.store.WorkspaceAISetting.Profile
+	nil,                                  // 36: memos.store.WorkspaceAISetting.FeatureProfilesEntry
+	(*WorkspaceAISetting_AttachmentExtraction)(nil), // 37: memos.store.WorkspaceAISetting.AttachmentExtraction
+	nil, // 38: memos.store.WorkspaceAISetting.ContextWindowsEntry
+	(*WorkspaceAlertingSetting_AlertChannel)(nil), // 39: memos.store.WorkspaceAlertingSetting.AlertChannel
+	(*WorkspaceAlertingSetting_SMTPConfig)(nil),   // 40: memos.store.WorkspaceAlertingSetting.SMTPConfig
 }
 var file_store_workspace_setting_proto_depIdxs = []int32{
-	0, // 0: memos.store.WorkspaceSetting.key:type_name -> memos.store.WorkspaceSettingKey
-	3, // 1: memos.store.WorkspaceSetting.basic_setting:type_name -> memos.store.WorkspaceBasicSetting
-	4, // 2: memos.store.WorkspaceSetting.general_setting:type_name -> memos.store.WorkspaceGeneralSetting
-	6, // 3: memos.store.WorkspaceSetting.storage_setting:type_name -> memos.store.WorkspaceStorageSetting
-	8, // 4: memos.store.WorkspaceSetting.memo_related_setting:type_name -> memos.store.WorkspaceMemoRelatedSetting
-	9, // 5: memos.store.WorkspaceSetting.ai_setting:type_name -> memos.store.WorkspaceAISetting
-	5, // 6: memos.store.WorkspaceGeneralSetting.custom_profile:type_name -> memos.store.WorkspaceCustomProfile
-	1, // 7: memos.store.WorkspaceStorageSetting.storage_type:type_name -> memos.store.WorkspaceStorageSetting.StorageType
-	7, // 8: memos.store.WorkspaceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	0,  // 0: memos.store.WorkspaceSetting.key:type_name -> memos.store.WorkspaceSettingKey
+	7,  // 1: memos.store.WorkspaceSetting.basic_setting:type_name -> memos.store.WorkspaceBasicSetting
+	9,  // 2: memos.store.WorkspaceSetting.general_setting:type_name -> memos.store.WorkspaceGeneralSetting
+	11, // 3: memos.store.WorkspaceSetting.storage_setting:type_name -> memos.store.WorkspaceStorageSetting
+	13, // 4: memos.store.WorkspaceSetting.memo_related_setting:type_name -> memos.store.WorkspaceMemoRelatedSetting
+	14, // 5: memos.store.WorkspaceSetting.ai_setting:type_name -> memos.store.WorkspaceAISetting
+	15, // 6: memos.store.WorkspaceSetting.onboarding_setting:type_name -> memos.store.WorkspaceOnboardingSetting
+	16, // 7: memos.store.WorkspaceSetting.new_user_limit_setting:type_name -> memos.store.WorkspaceNewUserLimitSetting
+	17, // 8: memos.store.WorkspaceSetting.feature_flag_setting:type_name -> memos.store.WorkspaceFeatureFlagSetting
+	19, // 9: memos.store.WorkspaceSetting.runner_setting:type_name -> memos.store.WorkspaceRunnerSetting
+	21, // 10: memos.store.WorkspaceSetting.usage_limit_setting:type_name -> memos.store.WorkspaceUsageLimitSetting
+	22, // 11: memos.store.WorkspaceSetting.ai_usage:type_name -> memos.store.WorkspaceAIUsage
+	23, // 12: memos.store.WorkspaceSetting.sensitive_content_setting:type_name -> memos.store.WorkspaceSensitiveContentSetting
+	24, // 13: memos.store.WorkspaceSetting.outbound_fetch_setting:type_name -> memos.store.WorkspaceOutboundFetchSetting
+	25, // 14: memos.store.WorkspaceSetting.legal_setting:type_name -> memos.store.WorkspaceLegalSetting
+	26, // 15: memos.store.WorkspaceSetting.maintenance_setting:type_name -> memos.store.WorkspaceMaintenanceSetting
+	28, // 16: memos.store.WorkspaceSetting.alerting_setting:type_name -> memos.store.WorkspaceAlertingSetting
+	8,  // 17: memos.store.WorkspaceBasicSetting.access_token_signing_keys:type_name -> memos.store.AccessTokenSigningKey
+	10, // 18: memos.store.WorkspaceGeneralSetting.custom_profile:type_name -> memos.store.WorkspaceCustomProfile
+	3,  // 19: memos.store.WorkspaceStorageSetting.storage_type:type_name -> memos.store.WorkspaceStorageSetting.StorageType
+	12, // 20: memos.store.WorkspaceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
+	29, // 21: memos.store.WorkspaceMemoRelatedSetting.role_default_visibilities:type_name -> memos.store.WorkspaceMemoRelatedSetting.RoleDefaultVisibilitiesEntry
+	31, // 22: memos.store.WorkspaceMemoRelatedSetting.tag_templates:type_name -> memos.store.WorkspaceMemoRelatedSetting.TagTemplate
+	30, // 23: memos.store.WorkspaceMemoRelatedSetting.shortcodes:type_name -> memos.store.WorkspaceMemoRelatedSetting.ShortcodesEntry
+	33, // 24: memos.store.WorkspaceAISetting.role_permissions:type_name -> memos.store.WorkspaceAISetting.RolePermissionsEntry
+	4,  // 25: memos.store.WorkspaceAISetting.provider:type_name -> memos.store.WorkspaceAISetting.Provider
+	34, // 26: memos.store.WorkspaceAISetting.redaction:type_name -> memos.store.WorkspaceAISetting.Redaction
+	35, // 27: memos.store.WorkspaceAISetting.profiles:type_name -> memos.store.WorkspaceAISetting.Profile
+	36, // 28: memos.store.WorkspaceAISetting.feature_profiles:type_name -> memos.store.WorkspaceAISetting.FeatureProfilesEntry
+	37, // 29: memos.store.WorkspaceAISetting.attachment_extraction:type_name -> memos.store.WorkspaceAISetting.AttachmentExtraction
+	38, // 30: memos.store.WorkspaceAISetting.context_windows:type_name -> memos.store.WorkspaceAISetting.ContextWindowsEntry
+	18, // 31: memos.store.WorkspaceFeatureFlagSetting.flags:type_name -> memos.store.FeatureFlag
+	20, // 32: memos.store.WorkspaceRunnerSetting.runners:type_name -> memos.store.RunnerConfig
+	1,  // 33: memos.store.WorkspaceSensitiveContentSetting.policy:type_name -> memos.store.SensitiveContentPolicy
+	27, // 34: memos.store.WorkspaceMaintenanceSetting.windows:type_name -> memos.store.MaintenanceWindow
+	2,  // 35: memos.store.MaintenanceWindow.state:type_name -> memos.store.MaintenanceWindowState
+	39, // 36: memos.store.WorkspaceAlertingSetting.channels:type_name -> memos.store.WorkspaceAlertingSetting.AlertChannel
+	32, // 37: memos.store.WorkspaceAISetting.RolePermissionsEntry.value:type_name -> memos.store.WorkspaceAISetting.RolePermission
+	4,  // 38: memos.store.WorkspaceAISetting.Profile.provider:type_name -> memos.store.WorkspaceAISetting.Provider
+	5,  // 39: memos.store.WorkspaceAlertingSetting.AlertChannel.type:type_name -> memos.store.WorkspaceAlertingSetting.AlertChannel.Type
+	40, // 40: memos.store.WorkspaceAlertingSetting.AlertChannel.smtp:type_name -> memos.store.WorkspaceAlertingSetting.SMTPConfig
+	41, // [41:41] is the sub-list for method output_type
+	41, // [41:41] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_store_workspace_setting_proto_init() }
@@ -1018,14 +3769,26 @@ func file_store_workspace_setting_proto_init() {
 		(*WorkspaceSetting_MemoRelatedSetting)(nil),
 		(*WorkspaceSetting_AiSetting)(nil),
 		(*WorkspaceSetting_AiRateLimit)(nil),
+		(*WorkspaceSetting_OnboardingSetting)(nil),
+		(*WorkspaceSetting_NewUserLimitSetting)(nil),
+		(*WorkspaceSetting_FeatureFlagSetting)(nil),
+		(*WorkspaceSetting_RunnerSetting)(nil),
+		(*WorkspaceSetting_UsageLimitSetting)(nil),
+		(*WorkspaceSetting_AiUsage)(nil),
+		(*WorkspaceSetting_SensitiveContentSetting)(nil),
+		(*WorkspaceSetting_OutboundFetchSetting)(nil),
+		(*WorkspaceSetting_LegalSetting)(nil),
+		(*WorkspaceSetting_MaintenanceSetting)(nil),
+		(*WorkspaceSetting_AlertingSetting)(nil),
 	}
+	file_store_workspace_setting_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_workspace_setting_proto_rawDesc), len(file_store_workspace_setting_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   8,
+			NumEnums:      6,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -24,10 +24,10 @@ func NewProfiler() *Profiler {
 	}
 }
 
-// RegisterRoutes adds profiling endpoints to the Echo server.
-func (*Profiler) RegisterRoutes(e *echo.Echo) {
+// RegisterRoutes adds profiling endpoints to the Echo server, behind the middlewares.
+func (*Profiler) RegisterRoutes(e *echo.Echo, middlewares ...echo.MiddlewareFunc) {
 	// Register pprof handlers
-	g := e.Group("/debug/pprof")
+	g := e.Group("/debug/pprof", middlewares...)
 	g.GET("", echo.WrapHandler(http.HandlerFunc(pprof.Index)))
 	g.GET("/cmdline", echo.WrapHandler(http.HandlerFunc(pprof.Cmdline)))
 	g.GET("/profile", echo.WrapHandler(http.HandlerFunc(pprof.Profile)))
@@ -0,0 +1,159 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// defaultAccessTokenSigningKeyGracePeriod is how long the tokens signed with the previous keys are accepted after a
+// rotation by default.
+const defaultAccessTokenSigningKeyGracePeriod = 7 * 24 * time.Hour
+
+// RotateAccessTokenSigningKey replaces the key signing the access tokens. The previous keys expire at the end of the
+// grace period, or earlier if they already expire before it, and the expired keys are removed.
+func (s *APIV1Service) RotateAccessTokenSigningKey(ctx context.Context, request *v1pb.RotateAccessTokenSigningKeyRequest) (*v1pb.RotateAccessTokenSigningKeyResponse, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+	gracePeriod := defaultAccessTokenSigningKeyGracePeriod
+	if request.GracePeriod != nil {
+		gracePeriod = request.GracePeriod.AsDuration()
+		if gracePeriod < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "grace period must not be negative")
+		}
+	}
+
+	basicSetting, err := s.Store.GetWorkspaceBasicSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace basic setting: %v", err)
+	}
+	now := time.Now()
+	expiresTs := now.Add(gracePeriod).Unix()
+	keys := []*storepb.AccessTokenSigningKey{}
+	lastVersion := 0
+	for _, key := range listAccessTokenSigningKeys(basicSetting) {
+		if version, err := strconv.Atoi(strings.TrimPrefix(key.Id, "v")); err == nil {
+			lastVersion = max(lastVersion, version)
+		}
+		// The expired keys are removed, and so are all the previous keys without a grace period.
+		if expiresTs <= now.Unix() || (key.ExpiresTs != 0 && key.ExpiresTs <= now.Unix()) {
+			continue
+		}
+		key = proto.CloneOf(key)
+		if key.ExpiresTs == 0 || expiresTs < key.ExpiresTs {
+			key.ExpiresTs = expiresTs
+		}
+		keys = append(keys, key)
+	}
+	secret, err := generateAccessTokenSigningSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate signing key: %v", err)
+	}
+	keys = append(keys, &storepb.AccessTokenSigningKey{
+		Id:        fmt.Sprintf("v%d", lastVersion+1),
+		Secret:    secret,
+		CreatedTs: now.Unix(),
+	})
+
+	basicSetting = proto.CloneOf(basicSetting)
+	basicSetting.AccessTokenSigningKeys = keys
+	if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_BASIC,
+		Value: &storepb.WorkspaceSetting_BasicSetting{BasicSetting: basicSetting},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update workspace basic setting: %v", err)
+	}
+
+	response := &v1pb.RotateAccessTokenSigningKeyResponse{}
+	for i, key := range keys {
+		signingKey := &v1pb.AccessTokenSigningKey{
+			Id:      key.Id,
+			Current: i == len(keys)-1,
+		}
+		if key.CreatedTs != 0 {
+			signingKey.CreateTime = timestamppb.New(time.Unix(key.CreatedTs, 0))
+		}
+		if key.ExpiresTs != 0 {
+			signingKey.ExpireTime = timestamppb.New(time.Unix(key.ExpiresTs, 0))
+		}
+		response.Keys = append(response.Keys, signingKey)
+	}
+	return response, nil
+}
+
+// listAccessTokenSigningKeys returns the keys signing the access tokens, the current one last, expired or not.
+func listAccessTokenSigningKeys(basicSetting *storepb.WorkspaceBasicSetting) []*storepb.AccessTokenSigningKey {
+	if keys := basicSetting.GetAccessTokenSigningKeys(); len(keys) > 0 {
+		return keys
+	}
+	return []*storepb.AccessTokenSigningKey{{Id: KeyID}}
+}
+
+// getAccessTokenSigningKey returns the ID and the secret of the key signing the new access tokens.
+func getAccessTokenSigningKey(ctx context.Context, stores *store.Store, serverSecret string) (string, []byte, error) {
+	basicSetting, err := stores.GetWorkspaceBasicSetting(ctx)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to get workspace basic setting")
+	}
+	keys := listAccessTokenSigningKeys(basicSetting)
+	key := keys[len(keys)-1]
+	return key.Id, getAccessTokenSigningSecret(key, serverSecret), nil
+}
+
+// newAccessTokenKeyfunc returns the function giving the secret verifying an access token: the secret of the
+// unexpired key with the ID of the token.
+func newAccessTokenKeyfunc(ctx context.Context, stores *store.Store, serverSecret string) jwt.Keyfunc {
+	return func(t *jwt.Token) (any, error) {
+		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
+			return nil, errors.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+		}
+		kid, ok := t.Header["kid"].(string)
+		if !ok {
+			return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
+		}
+		basicSetting, err := stores.GetWorkspaceBasicSetting(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get workspace basic setting")
+		}
+		now := time.Now().Unix()
+		for _, key := range listAccessTokenSigningKeys(basicSetting) {
+			if key.Id == kid && (key.ExpiresTs == 0 || now < key.ExpiresTs) {
+				return getAccessTokenSigningSecret(key, serverSecret), nil
+			}
+		}
+		return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
+	}
+}
+
+// getAccessTokenSigningSecret returns the secret of the key, the secret key of the server for the "v1" key.
+func getAccessTokenSigningSecret(key *storepb.AccessTokenSigningKey, serverSecret string) []byte {
+	if key.Secret == "" {
+		return []byte(serverSecret)
+	}
+	return []byte(key.Secret)
+}
+
+// generateAccessTokenSigningSecret generates the secret of a key signing the access tokens.
+func generateAccessTokenSigningSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to read random bytes")
+	}
+	return hex.EncodeToString(b), nil
+}
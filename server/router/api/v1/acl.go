@@ -34,6 +34,12 @@ const (
 	// accessTokenContextKey stores the JWT access token in the context.
 	// Only set for token-based authentication (Bearer token).
 	accessTokenContextKey
+
+	// requestLogContextKey stores the *requestLog filled in while serving a request.
+	requestLogContextKey
+
+	// aiUsageScopeContextKey stores the aiUsageScope the calls to the AI provider are recorded for.
+	aiUsageScopeContextKey
 )
 
 // GRPCAuthInterceptor is the auth interceptor for gRPC server.
@@ -104,6 +110,25 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 	return nil, status.Errorf(codes.Unauthenticated, "authentication required")
 }
 
+// AuthenticationStreamInterceptor is the stream interceptor for gRPC API, it authenticates the
+// requests the same way as AuthenticationInterceptor.
+func (in *GRPCAuthInterceptor) AuthenticationStreamInterceptor(srv any, serverStream grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	_, err := in.AuthenticationInterceptor(serverStream.Context(), nil, &grpc.UnaryServerInfo{Server: srv, FullMethod: serverInfo.FullMethod}, func(ctx context.Context, _ any) (any, error) {
+		return nil, handler(srv, &authenticatedServerStream{ServerStream: serverStream, ctx: ctx})
+	})
+	return err
+}
+
+// authenticatedServerStream is a server stream carrying the context of the authenticated request.
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
+}
+
 // handleAuthenticatedRequest processes an authenticated request with the given user and auth info.
 func (in *GRPCAuthInterceptor) handleAuthenticatedRequest(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler, user *store.User, sessionID, accessToken string) (any, error) {
 	// Check user status
@@ -116,6 +141,9 @@ func (in *GRPCAuthInterceptor) handleAuthenticatedRequest(ctx context.Context, r
 
 	// Set context values
 	ctx = context.WithValue(ctx, userIDContextKey, user.ID)
+	if log, ok := ctx.Value(requestLogContextKey).(*requestLog); ok {
+		log.userID = user.ID
+	}
 
 	if sessionID != "" {
 		// Session-based authentication
@@ -133,7 +161,7 @@ func (in *GRPCAuthInterceptor) handleAuthenticatedRequest(ctx context.Context, r
 // authenticateByJWT authenticates a user using JWT access token from Authorization header.
 //
 // Validation steps:
-// 1. Parse and verify JWT signature using server secret
+// 1. Parse and verify JWT signature using the unexpired signing key of the token
 // 2. Extract user ID from JWT claims (subject field)
 // 3. Verify user exists and is not archived
 // 4. Verify token exists in user's access_tokens list (for revocation support)
@@ -144,17 +172,7 @@ func (in *GRPCAuthInterceptor) authenticateByJWT(ctx context.Context, accessToke
 		return nil, status.Errorf(codes.Unauthenticated, "access token not found")
 	}
 	claims := &ClaimsMessage{}
-	_, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
-		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
-			return nil, status.Errorf(codes.Unauthenticated, "unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
-		}
-		if kid, ok := t.Header["kid"].(string); ok {
-			if kid == "v1" {
-				return []byte(in.secret), nil
-			}
-		}
-		return nil, status.Errorf(codes.Unauthenticated, "unexpected access token kid=%v", t.Header["kid"])
-	})
+	_, err := jwt.ParseWithClaims(accessToken, claims, newAccessTokenKeyfunc(ctx, in.Store, in.secret))
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "Invalid or expired access token")
 	}
@@ -1,8 +1,13 @@
 package v1
 
+import "strings"
+
 var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v1.WorkspaceService/GetWorkspaceProfile":          true,
 	"/memos.api.v1.WorkspaceService/GetWorkspaceSetting":          true,
+	"/memos.api.v1.WorkspaceService/GetWorkspaceCapabilities":     true,
+	"/memos.api.v1.WorkspaceService/ListFeatureFlags":             true,
+	"/memos.api.v1.WorkspaceService/ListAnnouncements":            true,
 	"/memos.api.v1.IdentityProviderService/ListIdentityProviders": true,
 	"/memos.api.v1.AuthService/CreateSession":                     true,
 	"/memos.api.v1.AuthService/GetCurrentSession":                 true,
@@ -10,11 +15,19 @@ var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v1.UserService/GetUser":                           true,
 	"/memos.api.v1.UserService/GetUserAvatar":                     true,
 	"/memos.api.v1.UserService/GetUserStats":                      true,
+	"/memos.api.v1.UserService/GetUserActivityCalendar":           true,
 	"/memos.api.v1.UserService/ListAllUserStats":                  true,
 	"/memos.api.v1.UserService/SearchUsers":                       true,
 	"/memos.api.v1.MemoService/GetMemo":                           true,
+	"/memos.api.v1.MemoService/GetMemoBySlug":                     true,
 	"/memos.api.v1.MemoService/ListMemos":                         true,
+	"/memos.api.v1.MemoService/GetCalendarMonth":                  true,
+	"/memos.api.v1.MemoService/ListReading":                       true,
+	"/memos.api.v1.MemoService/SearchMemos":                       true,
+	"/memos.api.v1.MemoService/ListMemoWebmentions":               true,
 	"/memos.api.v1.AttachmentService/GetAttachmentBinary":         true,
+	"/memos.api.v1.AttachmentService/ListMediaAttachments":        true,
+	"/memos.api.v1.AttachmentService/GetAttachmentText":           true,
 }
 
 // isUnauthorizeAllowedMethod returns whether the method is exempted from authentication.
@@ -23,11 +36,109 @@ func isUnauthorizeAllowedMethod(fullMethodName string) bool {
 }
 
 var allowedMethodsOnlyForAdmin = map[string]bool{
-	"/memos.api.v1.UserService/CreateUser":                  true,
-	"/memos.api.v1.WorkspaceService/UpdateWorkspaceSetting": true,
+	"/memos.api.v1.UserService/CreateUser":                           true,
+	"/memos.api.v1.UserService/ApproveUser":                          true,
+	"/memos.api.v1.UserService/SetUserFeatureFlag":                   true,
+	"/memos.api.v1.WorkspaceService/UpdateWorkspaceSetting":          true,
+	"/memos.api.v1.WorkspaceService/DowngradePublicMemos":            true,
+	"/memos.api.v1.WorkspaceService/BackupDatabase":                  true,
+	"/memos.api.v1.WorkspaceService/CreateMemoPayloadRebuildJob":     true,
+	"/memos.api.v1.WorkspaceService/GetMemoPayloadRebuildJob":        true,
+	"/memos.api.v1.WorkspaceService/ListRunners":                     true,
+	"/memos.api.v1.WorkspaceService/UpdateRunner":                    true,
+	"/memos.api.v1.WorkspaceService/RunRunner":                       true,
+	"/memos.api.v1.WorkspaceService/ListDeadLetters":                 true,
+	"/memos.api.v1.WorkspaceService/RetryDeadLetter":                 true,
+	"/memos.api.v1.WorkspaceService/DeleteDeadLetter":                true,
+	"/memos.api.v1.WorkspaceService/RotateAccessTokenSigningKey":     true,
+	"/memos.api.v1.WorkspaceService/CreateAnnouncement":              true,
+	"/memos.api.v1.WorkspaceService/UpdateAnnouncement":              true,
+	"/memos.api.v1.WorkspaceService/DeleteAnnouncement":              true,
+	"/memos.api.v1.WorkspaceService/ListMaintenanceWindows":          true,
+	"/memos.api.v1.WorkspaceService/CreateMaintenanceWindow":         true,
+	"/memos.api.v1.WorkspaceService/DeleteMaintenanceWindow":         true,
+	"/memos.api.v1.SyndicationService/ListSyndicationSubscriptions":  true,
+	"/memos.api.v1.SyndicationService/CreateSyndicationSubscription": true,
+	"/memos.api.v1.SyndicationService/DeleteSyndicationSubscription": true,
 }
 
 // isOnlyForAdminAllowedMethod returns true if the method is allowed to be called only by admin.
 func isOnlyForAdminAllowedMethod(methodName string) bool {
 	return allowedMethodsOnlyForAdmin[methodName]
 }
+
+var blockedMethodsInDemoMode = map[string]bool{
+	"/memos.api.v1.WorkspaceService/UpdateWorkspaceSetting":          true,
+	"/memos.api.v1.WorkspaceService/ApplyWorkspaceSettings":          true,
+	"/memos.api.v1.WorkspaceService/DowngradePublicMemos":            true,
+	"/memos.api.v1.WorkspaceService/BackupDatabase":                  true,
+	"/memos.api.v1.WorkspaceService/CreateMemoPayloadRebuildJob":     true,
+	"/memos.api.v1.WorkspaceService/UpdateRunner":                    true,
+	"/memos.api.v1.WorkspaceService/RunRunner":                       true,
+	"/memos.api.v1.WorkspaceService/RetryDeadLetter":                 true,
+	"/memos.api.v1.WorkspaceService/DeleteDeadLetter":                true,
+	"/memos.api.v1.WorkspaceService/RotateAccessTokenSigningKey":     true,
+	"/memos.api.v1.WorkspaceService/CreateAnnouncement":              true,
+	"/memos.api.v1.WorkspaceService/UpdateAnnouncement":              true,
+	"/memos.api.v1.WorkspaceService/DeleteAnnouncement":              true,
+	"/memos.api.v1.WorkspaceService/CreateMaintenanceWindow":         true,
+	"/memos.api.v1.WorkspaceService/DeleteMaintenanceWindow":         true,
+	"/memos.api.v1.SyndicationService/CreateSyndicationSubscription": true,
+	"/memos.api.v1.SyndicationService/DeleteSyndicationSubscription": true,
+	"/memos.api.v1.IdentityProviderService/CreateIdentityProvider":   true,
+	"/memos.api.v1.IdentityProviderService/UpdateIdentityProvider":   true,
+	"/memos.api.v1.IdentityProviderService/DeleteIdentityProvider":   true,
+	"/memos.api.v1.UserService/UpdateUser":                           true,
+	"/memos.api.v1.UserService/ChangeUsername":                       true,
+	"/memos.api.v1.UserService/UploadUserAvatar":                     true,
+	"/memos.api.v1.UserService/SetUserFeatureFlag":                   true,
+	"/memos.api.v1.UserService/DeleteUser":                           true,
+	"/memos.api.v1.UserService/CreateUserWebhook":                    true,
+	"/memos.api.v1.UserService/UpdateUserWebhook":                    true,
+	"/memos.api.v1.UserService/RotateUserWebhookSecret":              true,
+	"/memos.api.v1.UserService/TestUserWebhook":                      true,
+}
+
+// isBlockedInDemoModeMethod returns true if the method is disabled when running in demo mode.
+func isBlockedInDemoModeMethod(methodName string) bool {
+	return blockedMethodsInDemoMode[methodName]
+}
+
+// allowedMethodsInReadOnlyMode are the methods allowed during a maintenance window besides the ones reading data:
+// signing in and out, exporting data, backing up the database and managing the maintenance windows.
+var allowedMethodsInReadOnlyMode = map[string]bool{
+	"/memos.api.v1.AIService/ExportAISummaries":              true,
+	"/memos.api.v1.AIService/ExportAIInteractions":           true,
+	"/memos.api.v1.AuthService/CreateSession":                true,
+	"/memos.api.v1.AuthService/DeleteSession":                true,
+	"/memos.api.v1.WorkspaceService/BackupDatabase":          true,
+	"/memos.api.v1.WorkspaceService/CreateMaintenanceWindow": true,
+	"/memos.api.v1.WorkspaceService/DeleteMaintenanceWindow": true,
+}
+
+// isAllowedInReadOnlyMode returns true if the method is allowed while the workspace is read-only. The methods
+// reading data are the ones named Get, List or Search.
+func isAllowedInReadOnlyMode(methodName string) bool {
+	if allowedMethodsInReadOnlyMode[methodName] {
+		return true
+	}
+	method := methodName[strings.LastIndex(methodName, "/")+1:]
+	return strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "List") || strings.HasPrefix(method, "Search")
+}
+
+// allowedMethodsWithAIDisabled are the AI service methods still allowed when the workspace disabled its AI features:
+// exporting the past AI interactions and summaries, auditing them and purging the stored prompts.
+var allowedMethodsWithAIDisabled = map[string]bool{
+	"/memos.api.v1.AIService/ExportAISummaries":    true,
+	"/memos.api.v1.AIService/ExportAIInteractions": true,
+	"/memos.api.v1.AIService/ListAIAuditLogs":      true,
+	"/memos.api.v1.AIService/PurgeAIDebugLogs":     true,
+}
+
+// isAIMethod returns true if the method is an AI feature, disabled with the AI features of the workspace.
+func isAIMethod(methodName string) bool {
+	if allowedMethodsWithAIDisabled[methodName] {
+		return false
+	}
+	return strings.HasPrefix(methodName, "/memos.api.v1.AIService/") || methodName == "/memos.api.v1.MemoService/SearchMemosSemantic"
+}
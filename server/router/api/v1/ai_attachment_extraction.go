@@ -0,0 +1,192 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/disintegration/imaging"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/plugin/ai"
+	"github.com/usememos/memos/plugin/filemeta"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/attachmentextract"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// extractionImageMaxSize is the maximum size in pixels of the largest dimension of the images sent to the
+	// AI provider, which keeps their text readable for fewer tokens.
+	extractionImageMaxSize = 1536
+	// maxPromptAttachmentTextLength is the maximum number of characters of the text of an attachment in the
+	// summary prompts.
+	maxPromptAttachmentTextLength = 2000
+)
+
+// attachmentImagePrompt asks the model for the text and the description of an image.
+const attachmentImagePrompt = `You describe an image attached to a note, for the search and the summaries of the notes.
+Rules:
+- Transcribe the text visible in the image first, in its language.
+- Then describe what the image shows in two or three sentences: scene, people, objects, charts or diagrams.
+- Reply with plain text only, without any introduction.`
+
+// ExtractAttachment extracts the text of the image or audio attachment with the AI provider, as configured by the
+// attachment extraction of the workspace AI setting, and stores it in the payload of the attachment.
+// The calls are made for the creator of the attachment, within their AI token budget.
+func (s *APIV1Service) ExtractAttachment(ctx context.Context, attachment *store.Attachment) error {
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace AI setting")
+	}
+	extraction := aiSetting.GetAttachmentExtraction()
+	if aiSetting.Disabled || !attachmentextract.IsExtractable(extraction, attachment) {
+		return nil
+	}
+	if aiSetting.DisallowProtectedMemos && attachment.MemoID != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: attachment.MemoID})
+		if err != nil {
+			return errors.Wrap(err, "failed to get memo")
+		}
+		if memo != nil && memo.Visibility == store.Protected {
+			return nil
+		}
+	}
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &attachment.CreatorID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get attachment creator")
+	}
+	if creator == nil {
+		return nil
+	}
+	if err := s.checkAITokenBudget(ctx, creator); err != nil {
+		return err
+	}
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return err
+	}
+	ctx = withAIUsageScope(ctx, creator.ID, aiOperationAttachmentExtract)
+	config, err := s.getAIConfig(ctx, store.AIFeatureAttachmentExtraction)
+	if err != nil {
+		return err
+	}
+
+	// Attachments are listed without their blob, which is needed for the ones stored in the database.
+	attachment, err = s.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID, GetBlob: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to get attachment")
+	}
+	if attachment == nil {
+		return nil
+	}
+	blob, err := s.GetAttachmentBlob(attachment)
+	if err != nil {
+		return errors.Wrap(err, "failed to get attachment blob")
+	}
+	var text string
+	if attachmentextract.IsImage(attachment) {
+		text, err = s.describeImage(ctx, config, blob)
+	} else {
+		if !config.isOpenAICompatible() {
+			return status.Errorf(codes.FailedPrecondition, "audio transcription requires an OpenAI compatible AI provider")
+		}
+		if config.TranscriptionModel == "" {
+			return status.Errorf(codes.FailedPrecondition, "AI transcription model is not configured")
+		}
+		text, err = s.transcribeAudio(ctx, config, &v1pb.Attachment{Filename: attachment.Filename, Type: attachment.Type, Content: blob}, "")
+	}
+	if err != nil {
+		return err
+	}
+	text = truncateAttachmentText(text, filemeta.MaxTextLength)
+
+	payload := &storepb.AttachmentPayload{}
+	if attachment.Payload != nil {
+		payload = proto.Clone(attachment.Payload).(*storepb.AttachmentPayload)
+	}
+	payload.Extraction = &storepb.AttachmentPayload_Extraction{
+		Text:        text,
+		ExtractedTs: time.Now().Unix(),
+	}
+	if extraction.GetIncludeInSearch() && payload.ExtractedText == "" {
+		payload.ExtractedText = text
+	}
+	if err := s.Store.UpdateAttachment(ctx, &store.UpdateAttachment{
+		ID:      attachment.ID,
+		Payload: payload,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update attachment")
+	}
+	return nil
+}
+
+// describeImage asks the model with vision for the text and the description of the image, downsized as a JPEG.
+func (s *APIV1Service) describeImage(ctx context.Context, config *AIConfig, blob []byte) (string, error) {
+	img, err := imaging.Decode(bytes.NewReader(blob), imaging.AutoOrientation(true))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decode image")
+	}
+	img = imaging.Fit(img, extractionImageMaxSize, extractionImageMaxSize, imaging.Lanczos)
+	var buffer bytes.Buffer
+	if err := imaging.Encode(&buffer, img, imaging.JPEG); err != nil {
+		return "", errors.Wrap(err, "failed to encode image")
+	}
+	content, err := s.completeAIWithRetry(ctx, config, []ai.Message{
+		{Role: ai.RoleSystem, Content: attachmentImagePrompt},
+		{Role: ai.RoleUser, Content: "Describe this image.", Images: []ai.Image{{Type: "image/jpeg", Data: buffer.Bytes()}}},
+	})
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(content), nil
+}
+
+// getAttachmentText returns the text of the attachment: the text extracted from the document at upload, or the
+// text extracted with the AI provider.
+func getAttachmentText(attachment *store.Attachment) string {
+	if text := attachment.Payload.GetExtractedText(); text != "" {
+		return text
+	}
+	return attachment.Payload.GetExtraction().GetText()
+}
+
+// listPromptAttachmentTexts returns the text of the attachments of the memos by memo ID, formatted for the summary
+// prompts, when the attachment extraction of the workspace AI setting includes them in the summaries.
+func (s *APIV1Service) listPromptAttachmentTexts(ctx context.Context, aiSetting *storepb.WorkspaceAISetting, memos []*store.Memo) (map[int32]string, error) {
+	if !aiSetting.GetAttachmentExtraction().GetIncludeInSummaries() || len(memos) == 0 {
+		return nil, nil
+	}
+	memoIDs := make([]int32, 0, len(memos))
+	for _, memo := range memos {
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoIDList: memoIDs})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachments: %v", err)
+	}
+	texts := map[int32]string{}
+	for _, attachment := range attachments {
+		text := strings.TrimSpace(getAttachmentText(attachment))
+		if text == "" || attachment.MemoID == nil {
+			continue
+		}
+		text = truncateAttachmentText(text, maxPromptAttachmentTextLength)
+		texts[*attachment.MemoID] += fmt.Sprintf("\n\n[Attachment %s]\n%s", attachment.Filename, text)
+	}
+	return texts, nil
+}
+
+// truncateAttachmentText truncates the text to the number of characters.
+func truncateAttachmentText(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit])
+}
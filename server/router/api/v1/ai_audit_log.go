@@ -0,0 +1,202 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// aiAuditActionConfigUpdate is the audited action of an update of the AI configuration. The other audited actions are
+// the AI operations sending content to the provider.
+const aiAuditActionConfigUpdate = "config_update"
+
+// aiAuditLog is an AI action being audited, recorded once its outcome is known.
+type aiAuditLog struct {
+	userID     int32
+	action     string
+	parameters map[string]string
+	// sourceMemos are the memos whose content was sent to the AI provider.
+	sourceMemos []*store.Memo
+}
+
+func newAIAuditLog(userID int32, action string, parameters map[string]string) *aiAuditLog {
+	if parameters == nil {
+		parameters = map[string]string{}
+	}
+	return &aiAuditLog{userID: userID, action: action, parameters: parameters}
+}
+
+// setParameter sets the parameter, unless its value is empty.
+func (l *aiAuditLog) setParameter(key, value string) {
+	if value != "" {
+		l.parameters[key] = value
+	}
+}
+
+// setProvider sets the endpoint and the model of the AI provider the content is sent to.
+func (l *aiAuditLog) setProvider(config *AIConfig) {
+	l.setParameter("endpoint", config.Endpoint)
+	l.setParameter("model", config.Model)
+}
+
+// newAIConfigAuditLog returns the audit log of an update of the AI configuration, with the provider and the models the
+// content is sent to as parameters. The API keys are never recorded.
+func newAIConfigAuditLog(userID int32, aiSetting *storepb.WorkspaceAISetting) *aiAuditLog {
+	auditLog := newAIAuditLog(userID, aiAuditActionConfigUpdate, nil)
+	auditLog.setParameter("endpoint", aiSetting.GetEndpoint())
+	auditLog.setParameter("model", aiSetting.GetModel())
+	auditLog.setParameter("summary_model", aiSetting.GetSummaryModel())
+	auditLog.setParameter("chat_model", aiSetting.GetChatModel())
+	auditLog.setParameter("vision_model", aiSetting.GetVisionModel())
+	auditLog.setParameter("embedding_model", aiSetting.GetEmbeddingModel())
+	profiles := make([]string, 0, len(aiSetting.GetProfiles()))
+	for _, profile := range aiSetting.GetProfiles() {
+		profiles = append(profiles, profile.GetName())
+	}
+	auditLog.setParameter("profiles", strings.Join(profiles, ","))
+	auditLog.setParameter("debug_logging", strconv.FormatBool(aiSetting.GetDebugLogging()))
+	return auditLog
+}
+
+// newAISummaryAuditLog returns the audit log of a summary of the user's memos, with the parameters of the request.
+func newAISummaryAuditLog(userID int32, action string, request *v1pb.GenerateAISummaryRequest) *aiAuditLog {
+	auditLog := newAIAuditLog(userID, action, nil)
+	auditLog.setParameter("time_range", request.TimeRange)
+	auditLog.setParameter("start_date", request.StartDate)
+	auditLog.setParameter("end_date", request.EndDate)
+	auditLog.setParameter("tags", strings.Join(request.Tags, ","))
+	auditLog.setParameter("filter", request.Filter)
+	auditLog.setParameter("prompt_template", request.PromptTemplate)
+	auditLog.setParameter("memo_names", strings.Join(request.MemoNames, ","))
+	if request.ComparePrevious {
+		auditLog.setParameter("compare_previous", "true")
+	}
+	return auditLog
+}
+
+// recordAIAuditLog stores the audit log with the outcome of its action. It is best effort.
+func (s *APIV1Service) recordAIAuditLog(ctx context.Context, auditLog *aiAuditLog, err error) {
+	// The action may have been performed with a context canceled since, e.g. by its timeout.
+	ctx = context.WithoutCancel(ctx)
+	payload := &storepb.AIAuditLogPayload{Parameters: auditLog.parameters}
+	for _, memo := range auditLog.sourceMemos {
+		payload.SourceMemoIds = append(payload.SourceMemoIds, memo.ID)
+		payload.SourceMemoUids = append(payload.SourceMemoUids, memo.UID)
+	}
+	create := &store.AIAuditLog{
+		UserID:  auditLog.userID,
+		Action:  auditLog.action,
+		Success: err == nil,
+		Payload: payload,
+	}
+	if err != nil {
+		create.Error = err.Error()
+		if st, ok := status.FromError(err); ok {
+			create.Error = st.Message()
+		}
+		if len(create.Error) > maxAIUsageErrorLength {
+			create.Error = create.Error[:maxAIUsageErrorLength]
+		}
+	}
+	if _, err := s.Store.CreateAIAuditLog(ctx, create); err != nil {
+		slog.WarnContext(ctx, "failed to record AI audit log", "action", auditLog.action, "error", err)
+	}
+}
+
+// ListAIAuditLogs lists the audited AI actions, most recent first.
+func (s *APIV1Service) ListAIAuditLogs(ctx context.Context, request *v1pb.ListAIAuditLogsRequest) (*v1pb.ListAIAuditLogsResponse, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+
+	findAIAuditLog := &store.FindAIAuditLog{
+		Success: request.Success,
+		Limit:   &limitPlusOne,
+		Offset:  &offset,
+	}
+	if request.User != "" {
+		userID, err := ExtractUserIDFromName(request.User)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+		}
+		findAIAuditLog.UserID = &userID
+	}
+	if request.Action != "" {
+		findAIAuditLog.Action = &request.Action
+	}
+	if request.StartTime != nil {
+		createdTsAfter := request.StartTime.AsTime().Unix()
+		findAIAuditLog.CreatedTsAfter = &createdTsAfter
+	}
+	if request.EndTime != nil {
+		createdTsBefore := request.EndTime.AsTime().Unix()
+		findAIAuditLog.CreatedTsBefore = &createdTsBefore
+	}
+	auditLogs, err := s.Store.ListAIAuditLogs(ctx, findAIAuditLog)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list AI audit logs: %v", err)
+	}
+
+	response := &v1pb.ListAIAuditLogsResponse{
+		AuditLogs: []*v1pb.AIAuditLog{},
+	}
+	if len(auditLogs) == limitPlusOne {
+		auditLogs = auditLogs[:limit]
+		nextPageToken, err := getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+		response.NextPageToken = nextPageToken
+	}
+	for _, auditLog := range auditLogs {
+		response.AuditLogs = append(response.AuditLogs, convertAIAuditLogFromStore(auditLog))
+	}
+	return response, nil
+}
+
+func convertAIAuditLogFromStore(auditLog *store.AIAuditLog) *v1pb.AIAuditLog {
+	auditLogMessage := &v1pb.AIAuditLog{
+		CreateTime: timestamppb.New(time.Unix(auditLog.CreatedTs, 0)),
+		Action:     auditLog.Action,
+		Parameters: auditLog.Payload.GetParameters(),
+		Success:    auditLog.Success,
+		Error:      auditLog.Error,
+	}
+	if auditLog.UserID != 0 {
+		auditLogMessage.User = fmt.Sprintf("%s%d", UserNamePrefix, auditLog.UserID)
+	}
+	for _, uid := range auditLog.Payload.GetSourceMemoUids() {
+		auditLogMessage.SourceMemos = append(auditLogMessage.SourceMemos, fmt.Sprintf("%s%s", MemoNamePrefix, uid))
+	}
+	return auditLogMessage
+}
@@ -0,0 +1,192 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// maxAIAutoSummaryDays is the maximum frequency and time range in days of the recurring AI summaries.
+const maxAIAutoSummaryDays = 365
+
+// updateAIAutoSummarySetting updates the fields of the user's AI auto summary setting in the update mask.
+func (s *APIV1Service) updateAIAutoSummarySetting(ctx context.Context, userID int32, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
+	aiDisabled, err := s.isAIDisabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if aiDisabled {
+		return nil, aiDisabledError()
+	}
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_AI_AUTO_SUMMARY,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	aiAutoSummary := &storepb.AIAutoSummaryUserSetting{}
+	if existing := userSetting.GetAiAutoSummary(); existing != nil {
+		aiAutoSummary = proto.Clone(existing).(*storepb.AIAutoSummaryUserSetting)
+	}
+
+	incoming := request.Setting.GetAiAutoSummarySetting()
+	if incoming == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "AI auto summary setting is required")
+	}
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "enabled":
+			// Enabling the summaries again gives them a fresh start after they were disabled by failures.
+			if incoming.Enabled && !aiAutoSummary.Enabled {
+				aiAutoSummary.FailureCount = 0
+			}
+			aiAutoSummary.Enabled = incoming.Enabled
+		case "frequencyDays":
+			if incoming.FrequencyDays < 1 || incoming.FrequencyDays > maxAIAutoSummaryDays {
+				return nil, status.Errorf(codes.InvalidArgument, "frequency days must be between 1 and %d", maxAIAutoSummaryDays)
+			}
+			aiAutoSummary.FrequencyDays = incoming.FrequencyDays
+		case "hour":
+			if incoming.Hour < 0 || incoming.Hour > 23 {
+				return nil, status.Errorf(codes.InvalidArgument, "hour must be between 0 and 23")
+			}
+			aiAutoSummary.Hour = incoming.Hour
+		case "dayOfWeek":
+			if incoming.DayOfWeek < 0 || incoming.DayOfWeek > 7 {
+				return nil, status.Errorf(codes.InvalidArgument, "day of week must be between 1 and 7, or 0 for any day")
+			}
+			aiAutoSummary.DayOfWeek = incoming.DayOfWeek
+		case "timeRangeDays":
+			if incoming.TimeRangeDays < 0 || incoming.TimeRangeDays > maxAIAutoSummaryDays {
+				return nil, status.Errorf(codes.InvalidArgument, "time range days must be between 1 and %d, or 0 for the frequency", maxAIAutoSummaryDays)
+			}
+			aiAutoSummary.TimeRangeDays = incoming.TimeRangeDays
+		case "tags":
+			tags := []string{}
+			for _, tag := range incoming.Tags {
+				if tag = strings.TrimPrefix(strings.TrimSpace(tag), "#"); tag != "" {
+					tags = append(tags, tag)
+				}
+			}
+			aiAutoSummary.Tags = tags
+		case "timezone":
+			if _, err := time.LoadLocation(incoming.Timezone); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid timezone %q", incoming.Timezone)
+			}
+			aiAutoSummary.Timezone = incoming.Timezone
+		case "visibility":
+			switch incoming.Visibility {
+			case "", string(store.Public), string(store.Protected), string(store.Private):
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "invalid visibility %q", incoming.Visibility)
+			}
+			aiAutoSummary.Visibility = incoming.Visibility
+		default:
+			// Ignore unsupported fields
+		}
+	}
+
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_AI_AUTO_SUMMARY,
+		Value:  &storepb.UserSetting_AiAutoSummary{AiAutoSummary: aiAutoSummary},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
+// GenerateScheduledAISummary generates the AI summary of the user's memos created from the start date to the end
+// date included, as scheduled by their AI auto summary setting. A period without memos is skipped.
+func (s *APIV1Service) GenerateScheduledAISummary(ctx context.Context, user *store.User, startDate, endDate string, tags []string) error {
+	reservation, err := s.reserveAIRequest(ctx, user)
+	if err != nil {
+		return err
+	}
+	defer reservation.release(ctx)
+	aiAutoSummary, err := s.getAIAutoSummarySetting(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	request := &v1pb.GenerateAISummaryRequest{
+		TimeRange:  "custom",
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Tags:       tags,
+		Visibility: convertAISummaryVisibilityFromStore(aiAutoSummary.GetVisibility()),
+	}
+	if _, err := s.generateAISummary(ctx, user, request); err != nil {
+		if status.Code(err) == codes.NotFound {
+			slog.Info("no memos to summarize for scheduled AI summary", "user_id", user.ID)
+			return nil
+		}
+		// Keep failures of the generation itself for retry, not the invalid requests.
+		if status.Code(err) == codes.Internal {
+			s.recordAISummaryDeadLetter(context.WithoutCancel(ctx), user.ID, request, err)
+		}
+		return err
+	}
+
+	reservation.commit()
+	return nil
+}
+
+// getAIAutoSummarySetting returns the AI auto summary setting of the user, nil if the user has none.
+func (s *APIV1Service) getAIAutoSummarySetting(ctx context.Context, userID int32) (*storepb.AIAutoSummaryUserSetting, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_AI_AUTO_SUMMARY,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	return userSetting.GetAiAutoSummary(), nil
+}
+
+func convertAIAutoSummarySettingFromStore(aiAutoSummary *storepb.AIAutoSummaryUserSetting) *v1pb.UserSetting_AIAutoSummarySetting {
+	setting := &v1pb.UserSetting_AIAutoSummarySetting{
+		Enabled:       aiAutoSummary.GetEnabled(),
+		FrequencyDays: aiAutoSummary.GetFrequencyDays(),
+		FailureCount:  aiAutoSummary.GetFailureCount(),
+		Hour:          aiAutoSummary.GetHour(),
+		DayOfWeek:     aiAutoSummary.GetDayOfWeek(),
+		TimeRangeDays: aiAutoSummary.GetTimeRangeDays(),
+		Tags:          append([]string{}, aiAutoSummary.GetTags()...),
+		Timezone:      aiAutoSummary.GetTimezone(),
+		Visibility:    aiAutoSummary.GetVisibility(),
+	}
+	if aiAutoSummary.GetLastRunTs() > 0 {
+		setting.LastRunTime = timestamppb.New(time.Unix(aiAutoSummary.GetLastRunTs(), 0))
+	}
+	return setting
+}
+
+func convertAIAutoSummarySettingToStore(setting *v1pb.UserSetting_AIAutoSummarySetting) *storepb.AIAutoSummaryUserSetting {
+	aiAutoSummary := &storepb.AIAutoSummaryUserSetting{
+		Enabled:       setting.Enabled,
+		FrequencyDays: setting.FrequencyDays,
+		Hour:          setting.Hour,
+		DayOfWeek:     setting.DayOfWeek,
+		TimeRangeDays: setting.TimeRangeDays,
+		Tags:          append([]string{}, setting.Tags...),
+		Timezone:      setting.Timezone,
+		FailureCount:  setting.FailureCount,
+		Visibility:    setting.Visibility,
+	}
+	if setting.LastRunTime != nil {
+		aiAutoSummary.LastRunTs = setting.LastRunTime.AsTime().Unix()
+	}
+	return aiAutoSummary
+}
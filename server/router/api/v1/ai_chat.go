@@ -0,0 +1,333 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/plugin/ai"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// Maximum length of a chat question
+	maxChatQuestionLength = 1000
+	// Maximum number of memos an answer is grounded in
+	maxChatContextMemos = 10
+	// Maximum number of previous messages sent back to the model with a follow-up question
+	maxChatHistoryMessages = 20
+	// Maximum number of messages kept per conversation, the oldest are removed first
+	maxChatConversationMessages = 100
+	// Maximum number of conversations kept per user, the least recently updated are removed first
+	maxChatConversations = 20
+)
+
+// chatSystemPrompt grounds the answers in the memos of the question and asks for citations.
+const chatSystemPrompt = `You answer questions about the user's personal notes, called memos.
+Answer only from the memos provided with the question and the previous messages of the conversation. If they do not contain the answer, say so.
+Cite the memos the answer relies on with their name in brackets, e.g. [memos/abc123].
+Answer in the language of the question.`
+
+var chatCitationPattern = regexp.MustCompile(`\[(memos/[^\[\]\s]+)\]`)
+
+// ChatWithMemos answers a question about the user's memos, grounded in the most relevant ones.
+func (s *APIV1Service) ChatWithMemos(ctx context.Context, request *v1pb.ChatWithMemosRequest) (_ *v1pb.ChatWithMemosResponse, err error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	question := strings.TrimSpace(request.Question)
+	if question == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "question is required")
+	}
+	if len(question) > maxChatQuestionLength {
+		return nil, status.Errorf(codes.InvalidArgument, "question must not exceed %d characters", maxChatQuestionLength)
+	}
+	startTime, endTime, err := parseChatDateRange(request.StartDate, request.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAIFeaturePermission(ctx, user, aiFeatureChat); err != nil {
+		return nil, err
+	}
+
+	conversations, err := s.getAIConversationsUserSetting(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	var conversation *storepb.AIConversationsUserSetting_Conversation
+	if request.ConversationId != "" {
+		conversation = findAIConversation(conversations, request.ConversationId)
+		if conversation == nil {
+			return nil, status.Errorf(codes.NotFound, "conversation not found")
+		}
+	}
+
+	reservation, err := s.reserveAIRequest(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	defer reservation.release(ctx)
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationChat)
+	// The question is not recorded, only the memos sent along with it.
+	auditLog := newAIAuditLog(user.ID, aiOperationChat, nil)
+	auditLog.setParameter("conversation_id", request.ConversationId)
+	auditLog.setParameter("start_date", request.StartDate)
+	auditLog.setParameter("end_date", request.EndDate)
+	auditLog.setParameter("tags", strings.Join(request.Tags, ","))
+	defer func() {
+		s.recordAIAuditLog(ctx, auditLog, err)
+	}()
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return nil, err
+	}
+	config, err := s.getAIConfig(ctx, store.AIFeatureChat)
+	if err != nil {
+		return nil, err
+	}
+
+	memos, err := s.retrieveChatMemos(ctx, user.ID, question, startTime, endTime, request.Tags)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := s.buildChatMessages(ctx, config, conversation, memos, question)
+	if err != nil {
+		return nil, err
+	}
+	auditLog.sourceMemos = memos
+	auditLog.setProvider(config)
+	answer, err := s.completeAIWithRetry(ctx, config, messages)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to answer chat question",
+			"user_id", user.ID,
+			"error", err)
+		return nil, aiCallError(err, "failed to answer question")
+	}
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return nil, status.Errorf(codes.Internal, "AI API returned empty content")
+	}
+
+	// Only the memos the answer was grounded in can be cited.
+	memoNames := make(map[string]*store.Memo, len(memos))
+	for _, memo := range memos {
+		memoNames[fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)] = memo
+	}
+	citations, citationMemoIDs := []string{}, []int32{}
+	for _, match := range chatCitationPattern.FindAllStringSubmatch(answer, -1) {
+		memo, ok := memoNames[match[1]]
+		if !ok || slices.Contains(citationMemoIDs, memo.ID) {
+			continue
+		}
+		citations, citationMemoIDs = append(citations, match[1]), append(citationMemoIDs, memo.ID)
+	}
+
+	now := time.Now().Unix()
+	if conversation == nil {
+		conversation = &storepb.AIConversationsUserSetting_Conversation{
+			Id:        shortuuid.New(),
+			CreatedTs: now,
+		}
+		conversations.Conversations = append(conversations.Conversations, conversation)
+	}
+	conversation.Messages = append(conversation.Messages,
+		&storepb.AIConversationsUserSetting_Message{Role: string(ai.RoleUser), Content: question, CreatedTs: now},
+		&storepb.AIConversationsUserSetting_Message{Role: string(ai.RoleAssistant), Content: answer, CitationMemoIds: citationMemoIDs, CreatedTs: now},
+	)
+	if len(conversation.Messages) > maxChatConversationMessages {
+		conversation.Messages = conversation.Messages[len(conversation.Messages)-maxChatConversationMessages:]
+	}
+	conversation.UpdatedTs = now
+	if len(conversations.Conversations) > maxChatConversations {
+		sort.SliceStable(conversations.Conversations, func(i, j int) bool {
+			return conversations.Conversations[i].UpdatedTs > conversations.Conversations[j].UpdatedTs
+		})
+		conversations.Conversations = conversations.Conversations[:maxChatConversations]
+	}
+	if err := s.upsertAIConversationsUserSetting(ctx, user.ID, conversations); err != nil {
+		return nil, err
+	}
+	reservation.commit()
+
+	return &v1pb.ChatWithMemosResponse{
+		ConversationId: conversation.Id,
+		Answer:         answer,
+		Citations:      citations,
+	}, nil
+}
+
+// retrieveChatMemos returns the memos of the user in the date range and with the tags the most relevant to the question:
+// the closest ones by embedding if an embedding model is configured, the most recent ones otherwise.
+// The embeddings may be routed to another AI profile than the chat.
+func (s *APIV1Service) retrieveChatMemos(ctx context.Context, userID int32, question string, startTime, endTime int64, tags []string) ([]*store.Memo, error) {
+	if config, err := s.getAIConfig(ctx, store.AIFeatureEmbedding); err == nil && config.EmbeddingModel != "" {
+		embedding, err := s.embedQuery(ctx, config, question)
+		if err != nil {
+			return nil, err
+		}
+		matches, err := s.Store.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{
+			Model:     config.EmbeddingModel,
+			Embedding: embedding,
+			ViewerID:  &userID,
+			Limit:     maxSourceMemos,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to search memo embeddings: %v", err)
+		}
+		if len(matches) > 0 {
+			memoIDs := make([]int32, 0, len(matches))
+			for _, match := range matches {
+				memoIDs = append(memoIDs, match.MemoID)
+			}
+			// The matches are filtered like the other source memos, and keep their order.
+			memos, err := s.listSourceMemos(ctx, userID, memoIDs, startTime, endTime, tags, maxSourceMemos)
+			if err != nil {
+				return nil, err
+			}
+			sort.SliceStable(memos, func(i, j int) bool {
+				return slices.Index(memoIDs, memos[i].ID) < slices.Index(memoIDs, memos[j].ID)
+			})
+			if len(memos) > 0 {
+				return memos[:min(len(memos), maxChatContextMemos)], nil
+			}
+		}
+	}
+
+	memos, err := s.listSourceMemos(ctx, userID, nil, startTime, endTime, tags, maxSourceMemos)
+	if err != nil {
+		return nil, err
+	}
+	return memos[:min(len(memos), maxChatContextMemos)], nil
+}
+
+// buildChatMessages returns the conversation sent to the model: the previous messages followed by the question
+// with the redacted content of the memos fitting in the context window of the model.
+func (s *APIV1Service) buildChatMessages(ctx context.Context, config *AIConfig, conversation *storepb.AIConversationsUserSetting_Conversation, memos []*store.Memo, question string) ([]ai.Message, error) {
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	redactor, err := newAIRedactor(aiSetting.Redaction)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid AI redaction setting: %v", err)
+	}
+
+	history := []*storepb.AIConversationsUserSetting_Message{}
+	if conversation != nil {
+		history = conversation.Messages[max(0, len(conversation.Messages)-maxChatHistoryMessages):]
+	}
+	// The memos fill the rest of the context window left by the instructions, the history and the question.
+	instructions := []string{chatSystemPrompt, question}
+	for _, message := range history {
+		instructions = append(instructions, message.Content)
+	}
+	tokenBudget := config.promptTokens(instructions...)
+
+	var contentBuilder strings.Builder
+	if len(memos) == 0 {
+		contentBuilder.WriteString("No memos match the question.\n\n")
+	}
+	totalTokens := 0
+	for _, memo := range memos {
+		content := strings.TrimSpace(memo.Content)
+		if content == "" {
+			continue
+		}
+		content = redactor.redact(content)
+		createdTime := time.Unix(memo.CreatedTs, 0).UTC().Format("2006-01-02")
+		content = fmt.Sprintf("[%s%s] (%s)\n%s\n\n", MemoNamePrefix, memo.UID, createdTime, content)
+		totalTokens += ai.EstimateTokens(content)
+		if totalTokens > tokenBudget {
+			break
+		}
+		contentBuilder.WriteString(content)
+	}
+	contentBuilder.WriteString("Question: " + question)
+
+	systemPrompt := chatSystemPrompt
+	if redactor.redacted() {
+		systemPrompt += "\n\nSome content has been replaced with placeholders such as [EMAIL_1]. Keep the placeholders unchanged."
+	}
+	messages := []ai.Message{{Role: ai.RoleSystem, Content: systemPrompt}}
+	for _, message := range history {
+		messages = append(messages, ai.Message{Role: ai.Role(message.Role), Content: message.Content})
+	}
+	return append(messages, ai.Message{Role: ai.RoleUser, Content: contentBuilder.String()}), nil
+}
+
+// parseChatDateRange returns the start and end timestamps of the optional dates, the end excluded.
+func parseChatDateRange(startDate, endDate string) (int64, int64, error) {
+	startTime, endTime := int64(0), int64(math.MaxInt64)
+	if startDate != "" {
+		date, err := time.Parse("2006-01-02", startDate)
+		if err != nil {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "invalid start_date format, expected YYYY-MM-DD")
+		}
+		startTime = date.Unix()
+	}
+	if endDate != "" {
+		date, err := time.Parse("2006-01-02", endDate)
+		if err != nil {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "invalid end_date format, expected YYYY-MM-DD")
+		}
+		endTime = date.Add(24 * time.Hour).Unix()
+	}
+	if endTime <= startTime {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "end_date must be after start_date")
+	}
+	return startTime, endTime, nil
+}
+
+// getAIConversationsUserSetting returns a copy of the AI conversations of the user, safe to modify.
+func (s *APIV1Service) getAIConversationsUserSetting(ctx context.Context, userID int32) (*storepb.AIConversationsUserSetting, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_AI_CONVERSATIONS,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get AI conversations user setting: %v", err)
+	}
+	aiConversationsUserSetting := &storepb.AIConversationsUserSetting{}
+	if cached := userSetting.GetAiConversations(); cached != nil {
+		aiConversationsUserSetting = proto.Clone(cached).(*storepb.AIConversationsUserSetting)
+	}
+	return aiConversationsUserSetting, nil
+}
+
+func (s *APIV1Service) upsertAIConversationsUserSetting(ctx context.Context, userID int32, aiConversationsUserSetting *storepb.AIConversationsUserSetting) error {
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_AI_CONVERSATIONS,
+		Value: &storepb.UserSetting_AiConversations{
+			AiConversations: aiConversationsUserSetting,
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert AI conversations user setting: %v", err)
+	}
+	return nil
+}
+
+func findAIConversation(aiConversationsUserSetting *storepb.AIConversationsUserSetting, id string) *storepb.AIConversationsUserSetting_Conversation {
+	for _, conversation := range aiConversationsUserSetting.Conversations {
+		if conversation.Id == id {
+			return conversation
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/store"
+)
+
+// isAIDisabled reports whether the workspace turned off all its AI features.
+func (s *APIV1Service) isAIDisabled(ctx context.Context) (bool, error) {
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	return aiSetting.Disabled, nil
+}
+
+// aiDisabledError is returned by the AI features when the workspace turned them off.
+func aiDisabledError() error {
+	return status.Errorf(codes.FailedPrecondition, "AI features are disabled in this workspace")
+}
+
+// getAIRequesterID returns the ID of the user the AI operation runs for: the current user, or ownerID for the
+// operations without one, e.g. the scheduled summaries of the user.
+func (s *APIV1Service) getAIRequesterID(ctx context.Context, ownerID int32) (int32, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return ownerID, nil
+	}
+	return user.ID, nil
+}
+
+// filterAIConsentedMemos keeps the memos that may be sent to the AI provider in an AI operation of the requester: the
+// memos of the requester, and the memos of the other users who opted in to the AI processing of their content. Every
+// AI operation including the content of other users must filter it with it.
+func (s *APIV1Service) filterAIConsentedMemos(ctx context.Context, requesterID int32, memos []*store.Memo) ([]*store.Memo, error) {
+	optIns := map[int32]bool{requesterID: true}
+	consented := make([]*store.Memo, 0, len(memos))
+	for _, memo := range memos {
+		optIn, ok := optIns[memo.CreatorID]
+		if !ok {
+			var err error
+			if optIn, err = s.Store.GetUserAIOptIn(ctx, memo.CreatorID); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user AI opt-in: %v", err)
+			}
+			optIns[memo.CreatorID] = optIn
+		}
+		if optIn {
+			consented = append(consented, memo)
+		}
+	}
+	return consented, nil
+}
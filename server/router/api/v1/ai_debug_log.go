@@ -0,0 +1,237 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/ai"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// defaultAIDebugLogRetentionDays is the number of days the prompts are kept when the retention is not set.
+	defaultAIDebugLogRetentionDays = 7
+	// maxAIDebugLogRetentionDays is the maximum number of days the prompts may be kept.
+	maxAIDebugLogRetentionDays = 30
+	// aiDebugLogSecretPurpose derives the key sealing the prompts and the responses.
+	aiDebugLogSecretPurpose = "memos-ai-debug-log"
+)
+
+// ListAIDebugLogs lists the prompts and responses stored while the AI debug logging is on, most recent first.
+func (s *APIV1Service) ListAIDebugLogs(ctx context.Context, request *v1pb.ListAIDebugLogsRequest) (*v1pb.ListAIDebugLogsResponse, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+
+	findAIDebugLog := &store.FindAIDebugLog{
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	}
+	if request.User != "" {
+		userID, err := ExtractUserIDFromName(request.User)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+		}
+		findAIDebugLog.UserID = &userID
+	}
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	// The expired logs are never listed, even if no call was logged since they expired.
+	if err := s.deleteExpiredAIDebugLogs(ctx, aiSetting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete expired AI debug logs: %v", err)
+	}
+	debugLogs, err := s.Store.ListAIDebugLogs(ctx, findAIDebugLog)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list AI debug logs: %v", err)
+	}
+
+	response := &v1pb.ListAIDebugLogsResponse{
+		DebugLogs: []*v1pb.AIDebugLog{},
+	}
+	if len(debugLogs) == limitPlusOne {
+		debugLogs = debugLogs[:limit]
+		nextPageToken, err := getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+		response.NextPageToken = nextPageToken
+	}
+	for _, debugLog := range debugLogs {
+		debugLogMessage, err := s.convertAIDebugLogFromStore(debugLog)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decrypt AI debug log: %v", err)
+		}
+		response.DebugLogs = append(response.DebugLogs, debugLogMessage)
+	}
+	return response, nil
+}
+
+// PurgeAIDebugLogs deletes the stored prompts and responses, all of them unless a time is given.
+func (s *APIV1Service) PurgeAIDebugLogs(ctx context.Context, request *v1pb.PurgeAIDebugLogsRequest) (*v1pb.PurgeAIDebugLogsResponse, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	deleteAIDebugLog := &store.DeleteAIDebugLog{}
+	if request.BeforeTime != nil {
+		createdTsBefore := request.BeforeTime.AsTime().Unix()
+		deleteAIDebugLog.CreatedTsBefore = &createdTsBefore
+	}
+	purged, err := s.Store.DeleteAIDebugLogs(ctx, deleteAIDebugLog)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge AI debug logs: %v", err)
+	}
+	return &v1pb.PurgeAIDebugLogsResponse{PurgedCount: purged}, nil
+}
+
+func (s *APIV1Service) convertAIDebugLogFromStore(debugLog *store.AIDebugLog) (*v1pb.AIDebugLog, error) {
+	prompt, err := s.openWithWorkspaceSecret(aiDebugLogSecretPurpose, debugLog.Prompt)
+	if err != nil {
+		return nil, err
+	}
+	response, err := s.openWithWorkspaceSecret(aiDebugLogSecretPurpose, debugLog.Response)
+	if err != nil {
+		return nil, err
+	}
+	debugLogMessage := &v1pb.AIDebugLog{
+		CreateTime: timestamppb.New(time.Unix(debugLog.CreatedTs, 0)),
+		Operation:  debugLog.Operation,
+		Model:      debugLog.Model,
+		Success:    debugLog.Success,
+		Prompt:     string(prompt),
+		Response:   string(response),
+	}
+	if debugLog.UserID != 0 {
+		debugLogMessage.User = fmt.Sprintf("%s%d", UserNamePrefix, debugLog.UserID)
+	}
+	return debugLogMessage, nil
+}
+
+// recordAIDebugLog stores the prompt and the response of a call to the AI provider made for the usage scope of the
+// context, if the debug logging is on. It is best effort.
+func (s *APIV1Service) recordAIDebugLog(ctx context.Context, model string, prompt any, response string, err error) {
+	// The calls failing fast on an open circuit never reached the provider.
+	if errors.Is(err, ai.ErrUnavailable) {
+		return
+	}
+	// The call may have been made with a context canceled since, e.g. by its timeout.
+	ctx = context.WithoutCancel(ctx)
+	aiSetting, settingErr := s.Store.GetWorkspaceAISetting(ctx)
+	if settingErr != nil {
+		slog.WarnContext(ctx, "failed to get workspace AI setting", "error", settingErr)
+		return
+	}
+	if !aiSetting.GetDebugLogging() {
+		return
+	}
+	if err != nil {
+		response = err.Error()
+	}
+	if err := s.createAIDebugLog(ctx, aiSetting, model, prompt, response, err == nil); err != nil {
+		slog.WarnContext(ctx, "failed to record AI debug log", "error", err)
+	}
+}
+
+func (s *APIV1Service) createAIDebugLog(ctx context.Context, aiSetting *storepb.WorkspaceAISetting, model string, prompt any, response string, success bool) error {
+	promptJSON, err := json.Marshal(prompt)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal prompt")
+	}
+	encryptedPrompt, err := s.sealWithWorkspaceSecret(aiDebugLogSecretPurpose, promptJSON)
+	if err != nil {
+		return err
+	}
+	encryptedResponse, err := s.sealWithWorkspaceSecret(aiDebugLogSecretPurpose, []byte(response))
+	if err != nil {
+		return err
+	}
+	if err := s.deleteExpiredAIDebugLogs(ctx, aiSetting); err != nil {
+		return err
+	}
+	scope, _ := ctx.Value(aiUsageScopeContextKey).(aiUsageScope)
+	_, err = s.Store.CreateAIDebugLog(ctx, &store.AIDebugLog{
+		UserID:    scope.userID,
+		Operation: scope.operation,
+		Model:     model,
+		Success:   success,
+		Prompt:    encryptedPrompt,
+		Response:  encryptedResponse,
+	})
+	return err
+}
+
+// deleteExpiredAIDebugLogs deletes the logs stored before the retention period of the AI setting.
+func (s *APIV1Service) deleteExpiredAIDebugLogs(ctx context.Context, aiSetting *storepb.WorkspaceAISetting) error {
+	retentionDays := int(aiSetting.GetDebugLogRetentionDays())
+	if retentionDays <= 0 {
+		retentionDays = defaultAIDebugLogRetentionDays
+	}
+	retentionDays = min(retentionDays, maxAIDebugLogRetentionDays)
+	createdTsBefore := time.Now().AddDate(0, 0, -retentionDays).Unix()
+	_, err := s.Store.DeleteAIDebugLogs(ctx, &store.DeleteAIDebugLog{CreatedTsBefore: &createdTsBefore})
+	return err
+}
+
+// aiDebugLogMessage is a message of the prompt of a debug log.
+type aiDebugLogMessage struct {
+	Role    ai.Role `json:"role"`
+	Content string  `json:"content"`
+}
+
+// aiDebugLogPrompt is the prompt of a completion in a debug log.
+type aiDebugLogPrompt struct {
+	Model          string              `json:"model"`
+	Messages       []aiDebugLogMessage `json:"messages"`
+	ResponseSchema string              `json:"responseSchema,omitempty"`
+}
+
+func newAIDebugLogPrompt(request *ai.CompletionRequest) *aiDebugLogPrompt {
+	prompt := &aiDebugLogPrompt{
+		Model:    request.Model,
+		Messages: make([]aiDebugLogMessage, 0, len(request.Messages)),
+	}
+	for _, message := range request.Messages {
+		prompt.Messages = append(prompt.Messages, aiDebugLogMessage{Role: message.Role, Content: message.Content})
+	}
+	if request.ResponseSchema != nil {
+		prompt.ResponseSchema = request.ResponseSchema.Name
+	}
+	return prompt
+}
+
+// aiDebugLogEmbeddingPrompt is the prompt of an embedding in a debug log.
+type aiDebugLogEmbeddingPrompt struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/store"
+)
+
+// AIDisabledInterceptor rejects the AI methods when the workspace disabled its AI features, including the ones not
+// calling the AI provider, e.g. the conversations and the prompt templates.
+type AIDisabledInterceptor struct {
+	Store *store.Store
+}
+
+func NewAIDisabledInterceptor(store *store.Store) *AIDisabledInterceptor {
+	return &AIDisabledInterceptor{
+		Store: store,
+	}
+}
+
+func (in *AIDisabledInterceptor) AIDisabledInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := in.checkAIEnabled(ctx, serverInfo.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+func (in *AIDisabledInterceptor) AIDisabledStreamInterceptor(server any, stream grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := in.checkAIEnabled(stream.Context(), serverInfo.FullMethod); err != nil {
+		return err
+	}
+	return handler(server, stream)
+}
+
+func (in *AIDisabledInterceptor) checkAIEnabled(ctx context.Context, methodName string) error {
+	if !isAIMethod(methodName) {
+		return nil
+	}
+	aiSetting, err := in.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	if aiSetting.Disabled {
+		return aiDisabledError()
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/usememos/memos/plugin/ai"
+)
+
+const (
+	// aiRetryBaseDelay is the delay before the first retry of a failed AI call, doubled for each retry.
+	aiRetryBaseDelay = 2 * time.Second
+	// aiRetryMaxDelay is the longest delay before a retry, the calls are not retried if the provider asks to wait longer.
+	aiRetryMaxDelay = 60 * time.Second
+)
+
+// aiErrorCodes are the codes of the errors of the failed AI calls by the kind of their failure.
+var aiErrorCodes = map[ai.ErrorKind]codes.Code{
+	ai.ErrorKindRateLimit:      codes.ResourceExhausted,
+	ai.ErrorKindAuthentication: codes.FailedPrecondition,
+	ai.ErrorKindNotFound:       codes.FailedPrecondition,
+	ai.ErrorKindInvalidRequest: codes.InvalidArgument,
+	ai.ErrorKindTimeout:        codes.DeadlineExceeded,
+	ai.ErrorKindUnavailable:    codes.Unavailable,
+}
+
+// aiCallError returns the error of a failed call to the AI provider, with the code of the kind of its failure.
+// The retry delay the provider asked for, if any, is in the details of the error.
+func aiCallError(err error, message string) error {
+	if errors.Is(err, ai.ErrUnavailable) || status.Code(err) == codes.Unavailable {
+		// Keep the retry details of the providers failing fast.
+		return withStatusDetails(status.Newf(codes.Unavailable, "%s: %v", message, err), statusDetails(err)...)
+	}
+	classified := ai.ClassifyError(err)
+	code, ok := aiErrorCodes[classified.Kind]
+	if !ok {
+		code = codes.Internal
+	}
+	st := status.Newf(code, "%s: %v", message, err)
+	if classified.RetryAfter > 0 {
+		return withStatusDetails(st, &errdetails.RetryInfo{RetryDelay: durationpb.New(classified.RetryAfter)})
+	}
+	return st.Err()
+}
+
+// aiRetryDelay returns the delay before the retry of the failed AI call, the delay the provider asked for or an
+// exponential backoff with jitter, and false if the call must not be retried. The attempt starts at 1.
+func aiRetryDelay(classified *ai.Error, attempt int) (time.Duration, bool) {
+	if !classified.Retryable() {
+		return 0, false
+	}
+	if classified.RetryAfter > 0 {
+		return classified.RetryAfter, classified.RetryAfter <= aiRetryMaxDelay
+	}
+	backoff := min(aiRetryBaseDelay<<(attempt-1), aiRetryMaxDelay)
+	// Half of the backoff is random, so that the calls failed together are not retried together.
+	return backoff/2 + rand.N(backoff/2+1), true
+}
+
+// waitAIRetry waits for the delay, returning the error of the context if it is done first.
+func waitAIRetry(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// aiInteractionExport is the log of the AI interactions of a user.
+type aiInteractionExport struct {
+	User       string `json:"user"`
+	ExportTime string `json:"export_time"`
+	// Interactions are the audited actions sending memo content to the AI provider, oldest first.
+	Interactions []*aiInteractionExportAction `json:"interactions"`
+	// ProviderCalls are the calls to the AI provider made for the user, oldest first.
+	ProviderCalls []*aiInteractionExportCall `json:"provider_calls"`
+}
+
+type aiInteractionExportAction struct {
+	Time     string `json:"time"`
+	Action   string `json:"action"`
+	Endpoint string `json:"endpoint,omitempty"`
+	Model    string `json:"model,omitempty"`
+	// SourceMemos are the memos whose content was sent, including the ones deleted since.
+	SourceMemos []string          `json:"source_memos"`
+	Parameters  map[string]string `json:"parameters"`
+	Success     bool              `json:"success"`
+	Error       string            `json:"error,omitempty"`
+}
+
+type aiInteractionExportCall struct {
+	Time             string `json:"time"`
+	Operation        string `json:"operation"`
+	Model            string `json:"model"`
+	PromptTokens     int64  `json:"prompt_tokens"`
+	CompletionTokens int64  `json:"completion_tokens"`
+	Success          bool   `json:"success"`
+	Error            string `json:"error,omitempty"`
+}
+
+// ExportAIInteractions exports the log of the AI interactions of the current user as JSON, from the audit logs and the
+// AI usage, so that the users can verify what content was sent to the AI provider and when.
+func (s *APIV1Service) ExportAIInteractions(ctx context.Context, request *v1pb.ExportAIInteractionsRequest) (*httpbody.HttpBody, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	findAIAuditLog := &store.FindAIAuditLog{UserID: &user.ID}
+	findAIUsage := &store.FindAIUsage{UserID: &user.ID}
+	if request.StartTime != nil {
+		createdTsAfter := request.StartTime.AsTime().Unix()
+		findAIAuditLog.CreatedTsAfter = &createdTsAfter
+		findAIUsage.CreatedTsAfter = &createdTsAfter
+	}
+	if request.EndTime != nil {
+		createdTsBefore := request.EndTime.AsTime().Unix()
+		findAIAuditLog.CreatedTsBefore = &createdTsBefore
+		findAIUsage.CreatedTsBefore = &createdTsBefore
+	}
+	auditLogs, err := s.Store.ListAIAuditLogs(ctx, findAIAuditLog)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list AI audit logs: %v", err)
+	}
+	usages, err := s.Store.ListAIUsages(ctx, findAIUsage)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list AI usage: %v", err)
+	}
+
+	export := &aiInteractionExport{
+		User:          fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+		ExportTime:    time.Now().UTC().Format(time.RFC3339),
+		Interactions:  []*aiInteractionExportAction{},
+		ProviderCalls: []*aiInteractionExportCall{},
+	}
+	for _, auditLog := range slices.Backward(auditLogs) {
+		// The changes of the AI configuration send no content.
+		if auditLog.Action == aiAuditActionConfigUpdate {
+			continue
+		}
+		parameters := auditLog.Payload.GetParameters()
+		action := &aiInteractionExportAction{
+			Time:        formatAIInteractionExportTime(auditLog.CreatedTs),
+			Action:      auditLog.Action,
+			Endpoint:    parameters["endpoint"],
+			Model:       parameters["model"],
+			SourceMemos: []string{},
+			Parameters:  parameters,
+			Success:     auditLog.Success,
+			Error:       auditLog.Error,
+		}
+		if action.Parameters == nil {
+			action.Parameters = map[string]string{}
+		}
+		for _, uid := range auditLog.Payload.GetSourceMemoUids() {
+			action.SourceMemos = append(action.SourceMemos, fmt.Sprintf("%s%s", MemoNamePrefix, uid))
+		}
+		export.Interactions = append(export.Interactions, action)
+	}
+	for _, usage := range slices.Backward(usages) {
+		export.ProviderCalls = append(export.ProviderCalls, &aiInteractionExportCall{
+			Time:             formatAIInteractionExportTime(usage.CreatedTs),
+			Operation:        usage.Operation,
+			Model:            usage.Model,
+			PromptTokens:     usage.PromptTokens,
+			CompletionTokens: usage.CompletionTokens,
+			Success:          usage.Success,
+			Error:            usage.Error,
+		})
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal the export: %v", err)
+	}
+	return &httpbody.HttpBody{
+		ContentType: "application/json",
+		Data:        append(data, '\n'),
+	}, nil
+}
+
+func formatAIInteractionExportTime(ts int64) string {
+	return time.Unix(ts, 0).UTC().Format(time.RFC3339)
+}
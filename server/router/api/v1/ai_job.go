@@ -0,0 +1,255 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// maxQueuedAIJobsPerUser is the number of AI jobs a user may have queued or running at once.
+const maxQueuedAIJobsPerUser = 3
+
+// EnqueueAISummary queues an AI summary of the user's memos, generated in the background.
+func (s *APIV1Service) EnqueueAISummary(ctx context.Context, request *v1pb.GenerateAISummaryRequest) (*v1pb.AIJob, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	// Reject the requests that would fail anyway before queuing them.
+	if _, _, err := parseAISummaryTimeRange(request); err != nil {
+		return nil, err
+	}
+	if request.Filter != "" {
+		if err := s.validateFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+	}
+	if err := s.checkAIFeaturePermission(ctx, user, aiFeatureSummary); err != nil {
+		return nil, err
+	}
+	if _, err := s.getAIConfig(ctx, store.AIFeatureSummary); err != nil {
+		return nil, err
+	}
+	// The job is counted in the rate limits when it is queued.
+	reservation, err := s.reserveAIRequest(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	defer reservation.release(ctx)
+	// The jobs run one at a time for all the users, a user cannot fill the queue.
+	queuedJobs, err := s.Store.ListAIJobs(ctx, &store.FindAIJob{
+		UserID:     &user.ID,
+		StatusList: []store.AIJobStatus{store.AIJobStatusQueued, store.AIJobStatusRunning},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list AI jobs: %v", err)
+	}
+	if len(queuedJobs) >= maxQueuedAIJobsPerUser {
+		return nil, workPoolBusyError(workPoolAI)
+	}
+
+	job, err := s.Store.CreateAIJob(ctx, &store.AIJob{
+		JobType: store.AIJobTypeSummary,
+		UserID:  user.ID,
+		Payload: &storepb.AIJobPayload{
+			Payload: &storepb.AIJobPayload_AiSummary{
+				AiSummary: &storepb.AIJobPayload_AISummary{
+					TimeRange:       request.TimeRange,
+					Tags:            request.Tags,
+					StartDate:       request.StartDate,
+					EndDate:         request.EndDate,
+					ComparePrevious: request.ComparePrevious,
+					PromptTemplate:  request.PromptTemplate,
+					MemoNames:       request.MemoNames,
+					Filter:          request.Filter,
+					Timezone:        request.Timezone,
+					Visibility:      convertAISummaryVisibilityToStore(request.Visibility),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create AI job: %v", err)
+	}
+	reservation.commit()
+	if s.AIJobRunner != nil {
+		s.AIJobRunner.Trigger()
+	}
+	return convertAIJobFromStore(job), nil
+}
+
+// GetAIJob gets an AI job of the current user.
+func (s *APIV1Service) GetAIJob(ctx context.Context, request *v1pb.GetAIJobRequest) (*v1pb.AIJob, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	jobID, err := ExtractAIJobIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid AI job name: %v", err)
+	}
+	job, err := s.Store.GetAIJob(ctx, &store.FindAIJob{ID: &jobID, UserID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get AI job: %v", err)
+	}
+	if job == nil {
+		return nil, status.Errorf(codes.NotFound, "AI job not found")
+	}
+	return convertAIJobFromStore(job), nil
+}
+
+// ListAIJobs lists the AI jobs of the current user, most recent first.
+func (s *APIV1Service) ListAIJobs(ctx context.Context, request *v1pb.ListAIJobsRequest) (*v1pb.ListAIJobsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+
+	jobs, err := s.Store.ListAIJobs(ctx, &store.FindAIJob{
+		UserID: &user.ID,
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list AI jobs: %v", err)
+	}
+
+	response := &v1pb.ListAIJobsResponse{
+		Jobs: []*v1pb.AIJob{},
+	}
+	if len(jobs) == limitPlusOne {
+		jobs = jobs[:limit]
+		nextPageToken, err := getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+		response.NextPageToken = nextPageToken
+	}
+	for _, job := range jobs {
+		response.Jobs = append(response.Jobs, convertAIJobFromStore(job))
+	}
+	return response, nil
+}
+
+// RunAIJob runs a queued AI job on behalf of its user and returns the uid of the memo it created.
+func (s *APIV1Service) RunAIJob(ctx context.Context, job *store.AIJob) (string, error) {
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &job.UserID})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus != store.Normal {
+		return "", errors.New("user not found")
+	}
+	ctx = context.WithValue(ctx, userIDContextKey, user.ID)
+
+	switch job.JobType {
+	case store.AIJobTypeSummary:
+		summary := job.Payload.GetAiSummary()
+		if summary == nil {
+			return "", errors.New("missing AI summary payload")
+		}
+		request := &v1pb.GenerateAISummaryRequest{
+			TimeRange:       summary.TimeRange,
+			Tags:            summary.Tags,
+			StartDate:       summary.StartDate,
+			EndDate:         summary.EndDate,
+			ComparePrevious: summary.ComparePrevious,
+			PromptTemplate:  summary.PromptTemplate,
+			MemoNames:       summary.MemoNames,
+			Filter:          summary.Filter,
+			Timezone:        summary.Timezone,
+			Visibility:      convertAISummaryVisibilityFromStore(summary.Visibility),
+		}
+		memoMessage, err := s.generateAISummary(ctx, user, request)
+		if err != nil {
+			return "", err
+		}
+		memoUID, err := ExtractMemoUIDFromName(memoMessage.Name)
+		if err != nil {
+			return "", errors.Wrap(err, "invalid memo name")
+		}
+		return memoUID, nil
+	default:
+		return "", errors.Errorf("unsupported AI job type %q", job.JobType)
+	}
+}
+
+func convertAIJobFromStore(job *store.AIJob) *v1pb.AIJob {
+	aiJob := &v1pb.AIJob{
+		Name:       fmt.Sprintf("%s%d", AIJobNamePrefix, job.ID),
+		State:      convertAIJobStatusFromStore(job.Status),
+		Error:      job.Error,
+		CreateTime: timestamppb.New(time.Unix(job.CreatedTs, 0)),
+		UpdateTime: timestamppb.New(time.Unix(job.UpdatedTs, 0)),
+	}
+	if job.MemoUID != "" {
+		aiJob.Memo = fmt.Sprintf("%s%s", MemoNamePrefix, job.MemoUID)
+	}
+	return aiJob
+}
+
+func convertAIJobStatusFromStore(jobStatus store.AIJobStatus) v1pb.AIJob_State {
+	switch jobStatus {
+	case store.AIJobStatusQueued:
+		return v1pb.AIJob_QUEUED
+	case store.AIJobStatusRunning:
+		return v1pb.AIJob_RUNNING
+	case store.AIJobStatusDone:
+		return v1pb.AIJob_DONE
+	case store.AIJobStatusFailed:
+		return v1pb.AIJob_FAILED
+	default:
+		return v1pb.AIJob_STATE_UNSPECIFIED
+	}
+}
+
+// convertAISummaryVisibilityToStore returns the visibility of the summary memo kept in the payloads of the jobs, empty
+// when unspecified.
+func convertAISummaryVisibilityToStore(visibility v1pb.Visibility) string {
+	if visibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		return ""
+	}
+	return visibility.String()
+}
+
+func convertAISummaryVisibilityFromStore(visibility string) v1pb.Visibility {
+	return v1pb.Visibility(v1pb.Visibility_value[visibility])
+}
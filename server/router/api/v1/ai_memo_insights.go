@@ -0,0 +1,209 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/ai"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// memoInsightsSystemPrompt asks for the insights of the memos, replied in the structure of memoInsightsSchema.
+const memoInsightsSystemPrompt = `You extract structured insights from the user's personal notes, called memos.
+Each memo starts with its number, e.g. [Memo 3]. Reference the memos each item comes from by their numbers.
+List:
+- the action items, the tasks still to be done, with when they are due if the memos say so, e.g. "by Friday", or else an empty due hint;
+- the decisions that were made;
+- the open questions that are not answered in the memos;
+- the topics several memos are about, with a short description of what the memos say about them.
+Only list what the memos state, leave a list empty rather than guessing. Write in the language of the memos.`
+
+// memoInsightsSchema is the JSON schema of the reply of the model to memoInsightsSystemPrompt.
+var memoInsightsSchema = &ai.JSONSchema{
+	Name: "memo_insights",
+	Schema: map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"action_items":   memoInsightsListSchema(map[string]any{"text": map[string]any{"type": "string"}, "due_hint": map[string]any{"type": "string"}}),
+			"decisions":      memoInsightsListSchema(map[string]any{"text": map[string]any{"type": "string"}}),
+			"open_questions": memoInsightsListSchema(map[string]any{"text": map[string]any{"type": "string"}}),
+			"topics":         memoInsightsListSchema(map[string]any{"name": map[string]any{"type": "string"}, "description": map[string]any{"type": "string"}}),
+		},
+		"required":             []string{"action_items", "decisions", "open_questions", "topics"},
+		"additionalProperties": false,
+	},
+}
+
+// memoInsightsListSchema returns the schema of a list of insights with the properties, and the numbers of their memos.
+func memoInsightsListSchema(properties map[string]any) map[string]any {
+	required := append(slices.Sorted(maps.Keys(properties)), "memos")
+	properties["memos"] = map[string]any{"type": "array", "items": map[string]any{"type": "integer"}}
+	return map[string]any{
+		"type": "array",
+		"items": map[string]any{
+			"type":                 "object",
+			"properties":           properties,
+			"required":             required,
+			"additionalProperties": false,
+		},
+	}
+}
+
+// memoInsightsReply is the reply of the model matching memoInsightsSchema.
+type memoInsightsReply struct {
+	ActionItems []struct {
+		Text    string `json:"text"`
+		DueHint string `json:"due_hint"`
+		Memos   []int  `json:"memos"`
+	} `json:"action_items"`
+	Decisions []struct {
+		Text  string `json:"text"`
+		Memos []int  `json:"memos"`
+	} `json:"decisions"`
+	OpenQuestions []struct {
+		Text  string `json:"text"`
+		Memos []int  `json:"memos"`
+	} `json:"open_questions"`
+	Topics []struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Memos       []int  `json:"memos"`
+	} `json:"topics"`
+}
+
+// GenerateMemoInsights extracts the action items, decisions, open questions and topics of the user's memos. The
+// insights are extracted from the most recent source memos that fit in a single request.
+func (s *APIV1Service) GenerateMemoInsights(ctx context.Context, request *v1pb.GenerateMemoInsightsRequest) (*v1pb.MemoInsights, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := s.checkAIFeaturePermission(ctx, user, aiFeatureSummary); err != nil {
+		return nil, err
+	}
+	reservation, err := s.reserveAIRequest(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	defer reservation.release(ctx)
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationInsights)
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return nil, err
+	}
+	config, err := s.getAIConfig(ctx, store.AIFeatureSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceMemos, err := s.querySourceMemos(ctx, user.ID, &v1pb.GenerateAISummaryRequest{
+		TimeRange: request.TimeRange,
+		Tags:      request.Tags,
+		StartDate: request.StartDate,
+		EndDate:   request.EndDate,
+		MemoNames: request.MemoNames,
+		Filter:    request.Filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	prompter, err := s.newAISummaryPrompter(ctx, config, sourceMemos)
+	if err != nil {
+		return nil, err
+	}
+	chunks := prompter.chunk(sourceMemos)
+	if len(chunks) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "all memos are empty")
+	}
+	// The memos are numbered by their position in the source memos, the first chunk starts with the first memo.
+	sourceMemos = chunks[0].memos
+	systemPrompt := memoInsightsSystemPrompt
+	if prompter.redactor.redacted() {
+		systemPrompt += "\n\nSome content has been replaced with placeholders such as [EMAIL_1]. Keep the placeholders unchanged."
+	}
+
+	provider, err := s.createAIProvider(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, aiRequestTimeout)
+	defer cancel()
+	var reply memoInsightsReply
+	completionRequest := config.summaryConfig().completionRequest([]ai.Message{
+		{Role: ai.RoleSystem, Content: systemPrompt},
+		{Role: ai.RoleUser, Content: chunks[0].content},
+	})
+	completionRequest.ResponseSchema = memoInsightsSchema
+	completion, err := ai.CompleteJSON(timeoutCtx, provider, completionRequest, &reply)
+	// The tokens of the failed repair attempts are used too.
+	if completion != nil {
+		if err := s.AddAITokenUsage(ctx, completion.TotalTokens); err != nil {
+			slog.WarnContext(ctx, "failed to update AI usage", "error", err)
+		}
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to generate memo insights", "user_id", user.ID, "error", err)
+		return nil, aiCallError(err, "failed to generate memo insights")
+	}
+	reservation.commit()
+	return convertMemoInsights(&reply, sourceMemos), nil
+}
+
+// convertMemoInsights converts the reply of the model, resolving the numbers of the memos to their names. The numbers
+// out of the source memos are dropped, and so are the items without text.
+func convertMemoInsights(reply *memoInsightsReply, sourceMemos []*store.Memo) *v1pb.MemoInsights {
+	memoNames := func(numbers []int) []string {
+		names := []string{}
+		seen := map[int]bool{}
+		for _, number := range numbers {
+			if number < 1 || number > len(sourceMemos) || seen[number] {
+				continue
+			}
+			seen[number] = true
+			names = append(names, fmt.Sprintf("%s%s", MemoNamePrefix, sourceMemos[number-1].UID))
+		}
+		return names
+	}
+
+	insights := &v1pb.MemoInsights{
+		ActionItems:   []*v1pb.MemoInsights_ActionItem{},
+		Decisions:     []*v1pb.MemoInsights_Decision{},
+		OpenQuestions: []*v1pb.MemoInsights_OpenQuestion{},
+		Topics:        []*v1pb.MemoInsights_Topic{},
+		SourceMemos:   make([]string, 0, len(sourceMemos)),
+	}
+	for _, item := range reply.ActionItems {
+		if text := strings.TrimSpace(item.Text); text != "" {
+			insights.ActionItems = append(insights.ActionItems, &v1pb.MemoInsights_ActionItem{Text: text, DueHint: strings.TrimSpace(item.DueHint), SourceMemos: memoNames(item.Memos)})
+		}
+	}
+	for _, item := range reply.Decisions {
+		if text := strings.TrimSpace(item.Text); text != "" {
+			insights.Decisions = append(insights.Decisions, &v1pb.MemoInsights_Decision{Text: text, SourceMemos: memoNames(item.Memos)})
+		}
+	}
+	for _, item := range reply.OpenQuestions {
+		if text := strings.TrimSpace(item.Text); text != "" {
+			insights.OpenQuestions = append(insights.OpenQuestions, &v1pb.MemoInsights_OpenQuestion{Text: text, SourceMemos: memoNames(item.Memos)})
+		}
+	}
+	for _, item := range reply.Topics {
+		if name := strings.TrimSpace(item.Name); name != "" {
+			insights.Topics = append(insights.Topics, &v1pb.MemoInsights_Topic{Name: name, Description: strings.TrimSpace(item.Description), SourceMemos: memoNames(item.Memos)})
+		}
+	}
+	for _, memo := range sourceMemos {
+		insights.SourceMemos = append(insights.SourceMemos, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID))
+	}
+	return insights
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/store"
+)
+
+// aiFeature is an AI feature the workspace can restrict per role.
+type aiFeature string
+
+const (
+	aiFeatureSummary       aiFeature = "summary"
+	aiFeatureSpeech        aiFeature = "speech"
+	aiFeatureVoiceMemo     aiFeature = "voice memo"
+	aiFeatureChat          aiFeature = "chat"
+	aiFeatureTagSuggestion aiFeature = "tag suggestion"
+	aiFeatureTransform     aiFeature = "transform"
+)
+
+// aiFeatures are the AI features the workspace can restrict per role.
+var aiFeatures = []aiFeature{aiFeatureSummary, aiFeatureSpeech, aiFeatureVoiceMemo, aiFeatureChat, aiFeatureTagSuggestion, aiFeatureTransform}
+
+// checkAIFeaturePermission returns a PermissionDenied error if the role of the user is not allowed
+// to use the AI feature. It must be called before any request to the AI provider.
+func (s *APIV1Service) checkAIFeaturePermission(ctx context.Context, user *store.User, feature aiFeature) error {
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	permission, ok := aiSetting.RolePermissions[user.Role.String()]
+	if !ok {
+		return nil
+	}
+	disabled := false
+	switch feature {
+	case aiFeatureSummary:
+		disabled = permission.GetDisableSummary()
+	case aiFeatureSpeech:
+		disabled = permission.GetDisableSpeech()
+	case aiFeatureVoiceMemo:
+		disabled = permission.GetDisableVoiceMemo()
+	case aiFeatureChat:
+		disabled = permission.GetDisableChat()
+	case aiFeatureTagSuggestion:
+		disabled = permission.GetDisableTagSuggestion()
+	case aiFeatureTransform:
+		disabled = permission.GetDisableTransform()
+	}
+	if disabled {
+		return status.Errorf(codes.PermissionDenied, "the %s AI feature is disabled for your role", feature)
+	}
+	return nil
+}
+
+// getAIMemoVisibilities returns the visibilities of the memos that can be sent to the AI provider.
+func (s *APIV1Service) getAIMemoVisibilities(ctx context.Context) ([]store.Visibility, error) {
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	if aiSetting.DisallowProtectedMemos {
+		return []store.Visibility{store.Public, store.Private}, nil
+	}
+	return []store.Visibility{store.Public, store.Protected, store.Private}, nil
+}
@@ -0,0 +1,193 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// The variables of the prompt templates.
+const (
+	aiPromptVariableDateRange = "date_range"
+	aiPromptVariableTagList   = "tag_list"
+	aiPromptVariableMemoCount = "memo_count"
+)
+
+var (
+	// aiPromptTemplateNamePattern is the pattern of the names of the prompt templates, which appear in URLs.
+	aiPromptTemplateNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+	// aiPromptVariablePattern matches the variables of the prompt templates, e.g. {{memo_count}}.
+	aiPromptVariablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z_]+)\s*\}\}`)
+)
+
+// ListPromptTemplates lists the prompt templates of the current user, then the workspace templates.
+func (s *APIV1Service) ListPromptTemplates(ctx context.Context, _ *v1pb.ListPromptTemplatesRequest) (*v1pb.ListPromptTemplatesResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	response := &v1pb.ListPromptTemplatesResponse{
+		Templates: []*v1pb.PromptTemplate{},
+	}
+	for _, creatorID := range []int32{user.ID, 0} {
+		templates, err := s.Store.ListAIPromptTemplates(ctx, &store.FindAIPromptTemplate{CreatorID: &creatorID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list prompt templates: %v", err)
+		}
+		for _, template := range templates {
+			response.Templates = append(response.Templates, convertAIPromptTemplateFromStore(template))
+		}
+	}
+	return response, nil
+}
+
+// UpsertPromptTemplate creates a prompt template or replaces the one with the same name.
+func (s *APIV1Service) UpsertPromptTemplate(ctx context.Context, request *v1pb.UpsertPromptTemplateRequest) (*v1pb.PromptTemplate, error) {
+	creatorID, err := s.getAIPromptTemplateCreatorID(ctx, request.GetTemplate().GetWorkspace())
+	if err != nil {
+		return nil, err
+	}
+	template := request.GetTemplate()
+	if err := validateAIPromptTemplate(template); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid prompt template: %v", err)
+	}
+
+	upserted, err := s.Store.UpsertAIPromptTemplate(ctx, &store.AIPromptTemplate{
+		CreatorID:   creatorID,
+		Name:        template.Name,
+		Description: template.Description,
+		Content:     template.Content,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert prompt template: %v", err)
+	}
+	return convertAIPromptTemplateFromStore(upserted), nil
+}
+
+// DeletePromptTemplate deletes a prompt template.
+func (s *APIV1Service) DeletePromptTemplate(ctx context.Context, request *v1pb.DeletePromptTemplateRequest) (*emptypb.Empty, error) {
+	creatorID, err := s.getAIPromptTemplateCreatorID(ctx, request.Workspace)
+	if err != nil {
+		return nil, err
+	}
+	template, err := s.Store.GetAIPromptTemplate(ctx, &store.FindAIPromptTemplate{CreatorID: &creatorID, Name: &request.Name})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get prompt template: %v", err)
+	}
+	if template == nil {
+		return nil, status.Errorf(codes.NotFound, "prompt template %q not found", request.Name)
+	}
+	if err := s.Store.DeleteAIPromptTemplate(ctx, &store.DeleteAIPromptTemplate{CreatorID: creatorID, Name: request.Name}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete prompt template: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getAIPromptTemplateCreatorID returns the creator of the templates the current user manages: the current user,
+// or the workspace for the admins.
+func (s *APIV1Service) getAIPromptTemplateCreatorID(ctx context.Context, workspace bool) (int32, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return 0, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !workspace {
+		return user.ID, nil
+	}
+	if !isSuperUser(user) {
+		return 0, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return 0, nil
+}
+
+// validateAIPromptTemplate checks the name of the template and the variables of its content.
+func validateAIPromptTemplate(template *v1pb.PromptTemplate) error {
+	if !aiPromptTemplateNamePattern.MatchString(template.GetName()) {
+		return errors.New("name must be 1 to 64 letters, digits, '-' or '_'")
+	}
+	if strings.TrimSpace(template.GetContent()) == "" {
+		return errors.New("content is required")
+	}
+	for _, match := range aiPromptVariablePattern.FindAllStringSubmatch(template.GetContent(), -1) {
+		switch match[1] {
+		case aiPromptVariableDateRange, aiPromptVariableTagList, aiPromptVariableMemoCount:
+		default:
+			return errors.Errorf("unknown variable %q", match[0])
+		}
+	}
+	return nil
+}
+
+// applyAIPromptTemplate returns the AI configuration whose system prompt is the prompt template of the summary
+// request, if any, with its variables replaced.
+func (s *APIV1Service) applyAIPromptTemplate(ctx context.Context, config *AIConfig, userID int32, request *v1pb.GenerateAISummaryRequest, sourceMemos []*store.Memo) (*AIConfig, error) {
+	if request.PromptTemplate == "" {
+		return config, nil
+	}
+	var template *store.AIPromptTemplate
+	// The templates of the user take precedence over the workspace ones.
+	for _, creatorID := range []int32{userID, 0} {
+		found, err := s.Store.GetAIPromptTemplate(ctx, &store.FindAIPromptTemplate{CreatorID: &creatorID, Name: &request.PromptTemplate})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get prompt template: %v", err)
+		}
+		if found != nil {
+			template = found
+			break
+		}
+	}
+	if template == nil {
+		return nil, status.Errorf(codes.NotFound, "prompt template %q not found", request.PromptTemplate)
+	}
+	startTime, endTime, err := parseAISummaryTimeRange(request)
+	if err != nil {
+		return nil, err
+	}
+	// The memos selected by name or filter span the dates of the oldest and the most recent ones.
+	if endTime == 0 && len(sourceMemos) > 0 {
+		startTime, endTime = sourceMemos[len(sourceMemos)-1].CreatedTs, sourceMemos[0].CreatedTs+1
+	}
+	tags := make([]string, 0, len(request.Tags))
+	for _, tag := range request.Tags {
+		tags = append(tags, "#"+strings.TrimPrefix(tag, "#"))
+	}
+	variables := map[string]string{
+		aiPromptVariableDateRange: fmt.Sprintf("%s to %s", time.Unix(startTime, 0).UTC().Format("2006-01-02"), time.Unix(endTime-1, 0).UTC().Format("2006-01-02")),
+		aiPromptVariableTagList:   strings.Join(tags, ", "),
+		aiPromptVariableMemoCount: strconv.Itoa(len(sourceMemos)),
+	}
+
+	templateConfig := *config
+	templateConfig.SystemPrompt = aiPromptVariablePattern.ReplaceAllStringFunc(template.Content, func(variable string) string {
+		return variables[aiPromptVariablePattern.FindStringSubmatch(variable)[1]]
+	})
+	return &templateConfig, nil
+}
+
+func convertAIPromptTemplateFromStore(template *store.AIPromptTemplate) *v1pb.PromptTemplate {
+	return &v1pb.PromptTemplate{
+		Name:        template.Name,
+		Workspace:   template.CreatorID == 0,
+		Description: template.Description,
+		Content:     template.Content,
+		UpdateTime:  timestamppb.New(time.Unix(template.UpdatedTs, 0)),
+	}
+}
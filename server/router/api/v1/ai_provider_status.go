@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/ai"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// GetAIProviderStatus returns the health of the AI provider from the recent calls to it, and the AI requests in flight.
+func (s *APIV1Service) GetAIProviderStatus(ctx context.Context, request *v1pb.GetAIProviderStatusRequest) (*v1pb.AIProviderStatus, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+	if request.Profile != "" {
+		aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+		}
+		if store.GetAIProfileSetting(aiSetting, request.Profile) == nil {
+			return nil, status.Errorf(codes.NotFound, "AI profile %q not found", request.Profile)
+		}
+	}
+	providerStatus := convertAIProviderStatusFromHealth(s.getAIMonitor(request.Profile).Health())
+	inFlight, limit := s.aiLimiter.Stats()
+	providerStatus.InFlightRequests, providerStatus.MaxConcurrentRequests = int32(inFlight), int32(limit)
+	return providerStatus, nil
+}
+
+func convertAIProviderStatusFromHealth(health ai.Health) *v1pb.AIProviderStatus {
+	providerStatus := &v1pb.AIProviderStatus{
+		RecentCalls:         int32(health.Calls),
+		RecentFailures:      int32(health.Failures),
+		ConsecutiveFailures: int32(health.ConsecutiveFailures),
+		AverageLatency:      durationpb.New(health.AverageLatency),
+		LastError:           health.LastError,
+	}
+	switch health.State {
+	case ai.CircuitOpen:
+		providerStatus.CircuitState = v1pb.AIProviderStatus_OPEN
+	case ai.CircuitHalfOpen:
+		providerStatus.CircuitState = v1pb.AIProviderStatus_HALF_OPEN
+	default:
+		providerStatus.CircuitState = v1pb.AIProviderStatus_CLOSED
+	}
+	if !health.LastErrorTime.IsZero() {
+		providerStatus.LastErrorTime = timestamppb.New(health.LastErrorTime)
+	}
+	if !health.RetryTime.IsZero() {
+		providerStatus.RetryTime = timestamppb.New(health.RetryTime)
+	}
+	return providerStatus
+}
+
+// aiUnavailableError returns the error of the AI requests failed fast while the circuit of the provider is open.
+func aiUnavailableError(retryTime time.Time) error {
+	return retryAfterError(codes.Unavailable, retryTime, "AI provider unavailable: it failed repeatedly, try again after %s", retryTime.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,218 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// aiRequestCountRetention is how long the hourly AI request counts are kept, enough for the daily limit.
+const aiRequestCountRetention = 48 * time.Hour
+
+// aiRateLimits are the maximum numbers of AI requests and tokens of a user, 0 for no limit.
+type aiRateLimits struct {
+	hourly        int32
+	daily         int32
+	monthlyTokens int64
+}
+
+// aiRequestCounts are the numbers of AI requests a user made in the current hour and UTC day.
+type aiRequestCounts struct {
+	hourly int32
+	daily  int32
+}
+
+// GetAIUsage returns the AI requests the current user made and may still make in the hour and in the day,
+// and the AI tokens they used in the month.
+func (s *APIV1Service) GetAIUsage(ctx context.Context, _ *v1pb.GetAIUsageRequest) (*v1pb.AIUsage, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	now := time.Now()
+	limits, err := s.getAIRateLimits(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := s.countAIRequests(ctx, user.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	monthlyTokens, err := s.countAIMonthlyTokens(ctx, user.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.AIUsage{
+		Hourly:             newAIUsageWindow(limits.hourly, counts.hourly, now.Truncate(time.Hour).Add(time.Hour)),
+		Daily:              newAIUsageWindow(limits.daily, counts.daily, now.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)),
+		MonthlyTokenBudget: limits.monthlyTokens,
+		MonthlyTokensUsed:  monthlyTokens,
+	}, nil
+}
+
+func newAIUsageWindow(limit, used int32, resetTime time.Time) *v1pb.AIUsage_Window {
+	window := &v1pb.AIUsage_Window{
+		Limit:     limit,
+		Used:      used,
+		ResetTime: timestamppb.New(resetTime),
+	}
+	if limit > 0 {
+		window.Remaining = max(limit-used, 0)
+	}
+	return window
+}
+
+// aiRequestReservation is an AI request counted in the limits of the user before the provider is called, so that
+// concurrent requests cannot exceed the limits. The request stays counted once committed, and is refunded when it is
+// released without being committed, e.g. when the call to the provider failed.
+type aiRequestReservation struct {
+	store  *store.Store
+	userID int32
+	hourTs int64
+	done   bool
+}
+
+// reserveAIRequest counts an AI request of the user, returning a ResourceExhausted error if the user has reached the
+// hourly or daily AI request limit or the monthly AI token budget of their role.
+func (s *APIV1Service) reserveAIRequest(ctx context.Context, user *store.User) (*aiRequestReservation, error) {
+	limits, err := s.getAIRateLimits(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAITokenBudget(ctx, user); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	subject := fmt.Sprintf("%s%d", UserNamePrefix, user.ID)
+	hourlyExceeded := quotaExceededError(quotaViolation{
+		subject:   subject,
+		id:        "ai_requests_per_hour",
+		limit:     int64(limits.hourly),
+		resetTime: now.Truncate(time.Hour).Add(time.Hour),
+	}, "rate limit exceeded: maximum %d requests per hour allowed", limits.hourly)
+	dailyExceeded := quotaExceededError(quotaViolation{
+		subject:   subject,
+		id:        "ai_requests_per_day",
+		limit:     int64(limits.daily),
+		resetTime: now.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1),
+	}, "rate limit exceeded: maximum %d requests per day allowed", limits.daily)
+
+	// The requests are counted per hour, the count of the hour is incremented only while it is below its limit.
+	// The counts of the previous hours of the day only decrease, so the daily limit caps the one of the hour.
+	limit, limitExceeded := limits.hourly, hourlyExceeded
+	if limits.daily > 0 {
+		counts, err := s.countAIRequests(ctx, user.ID, now)
+		if err != nil {
+			return nil, err
+		}
+		remaining := limits.daily - (counts.daily - counts.hourly)
+		if remaining <= 0 {
+			return nil, dailyExceeded
+		}
+		if limit == 0 || remaining < limit {
+			limit, limitExceeded = remaining, dailyExceeded
+		}
+	}
+	reservation := &aiRequestReservation{
+		store:  s.Store,
+		userID: user.ID,
+		hourTs: now.Truncate(time.Hour).Unix(),
+	}
+	added, err := s.Store.IncrementAIRequestCount(ctx, user.ID, reservation.hourTs, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to increment AI request count: %v", err)
+	}
+	if !added {
+		return nil, limitExceeded
+	}
+	s.deleteExpiredAIRequestCounts(ctx, now)
+	return reservation, nil
+}
+
+// commit keeps the request counted.
+func (r *aiRequestReservation) commit() {
+	r.done = true
+}
+
+// release refunds the request unless it was committed.
+func (r *aiRequestReservation) release(ctx context.Context) {
+	if r.done {
+		return
+	}
+	r.done = true
+	// The request may have been canceled, the refund must still be stored.
+	if err := r.store.DecrementAIRequestCount(context.WithoutCancel(ctx), r.userID, r.hourTs); err != nil {
+		slog.Warn("failed to refund AI request count", "user", r.userID, "error", err)
+	}
+}
+
+// updateRateLimit counts an AI request of the user regardless of the limits, e.g. the requests of the system bot.
+func (s *APIV1Service) updateRateLimit(ctx context.Context, userID int32) error {
+	now := time.Now()
+	if _, err := s.Store.IncrementAIRequestCount(ctx, userID, now.Truncate(time.Hour).Unix(), 0); err != nil {
+		return errors.Wrap(err, "failed to increment AI request count")
+	}
+	s.deleteExpiredAIRequestCounts(ctx, now)
+	return nil
+}
+
+// deleteExpiredAIRequestCounts cleans up the counts no limit looks at anymore.
+func (s *APIV1Service) deleteExpiredAIRequestCounts(ctx context.Context, now time.Time) {
+	if err := s.Store.DeleteAIRequestCounts(ctx, &store.DeleteAIRequestCount{
+		HourTsBefore: now.Add(-aiRequestCountRetention).Unix(),
+	}); err != nil {
+		slog.Warn("failed to delete expired AI request counts", "error", err)
+	}
+}
+
+// getAIRateLimits returns the AI request limits and token budget of the role of the user.
+// The hourly limit defaults to the one of the server, which is reloadable at runtime.
+func (s *APIV1Service) getAIRateLimits(ctx context.Context, user *store.User) (aiRateLimits, error) {
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return aiRateLimits{}, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	limits := aiRateLimits{hourly: int32(s.runtimeSettings().AIRateLimit)}
+	if permission, ok := aiSetting.RolePermissions[user.Role.String()]; ok {
+		if permission.HourlyRequestLimit != 0 {
+			limits.hourly = max(permission.HourlyRequestLimit, 0)
+		}
+		limits.daily = max(permission.DailyRequestLimit, 0)
+		limits.monthlyTokens = max(permission.MonthlyTokenBudget, 0)
+	}
+	return limits, nil
+}
+
+// countAIRequests returns the numbers of AI requests the user made in the hour and the UTC day of the time.
+func (s *APIV1Service) countAIRequests(ctx context.Context, userID int32, now time.Time) (aiRequestCounts, error) {
+	hourTs := now.Truncate(time.Hour).Unix()
+	dayTs := now.UTC().Truncate(24 * time.Hour).Unix()
+	requestCounts, err := s.Store.ListAIRequestCounts(ctx, &store.FindAIRequestCount{
+		UserID:      userID,
+		HourTsAfter: &dayTs,
+	})
+	if err != nil {
+		return aiRequestCounts{}, status.Errorf(codes.Internal, "failed to list AI request counts: %v", err)
+	}
+	counts := aiRequestCounts{}
+	for _, requestCount := range requestCounts {
+		counts.daily += requestCount.Count
+		if requestCount.HourTs == hourTs {
+			counts.hourly = requestCount.Count
+		}
+	}
+	return counts, nil
+}
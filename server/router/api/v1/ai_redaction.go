@@ -0,0 +1,208 @@
+package v1
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	// phoneNumberPattern requires separated digit groups, so dates and plain numbers are left alone.
+	phoneNumberPattern = regexp.MustCompile(`(?:\+\d{1,3}[\s.\-]?)?(?:\(\d{2,4}\)[\s.\-]?|\b\d{2,4}[\s.\-])\d{3,4}[\s.\-]?\d{3,4}\b|\+\d{8,15}\b`)
+	redactedTagPattern = regexp.MustCompile(`#[\p{L}\p{N}_\-/&]+`)
+	// secretPattern matches private keys, JSON web tokens and the API keys and access tokens with a well-known prefix.
+	secretPattern = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----|` +
+		`\beyJ[A-Za-z0-9_\-]+\.eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+|` +
+		`\b(?:sk|pk|rk)-[A-Za-z0-9_\-]{20,}|\bgh[pousr]_[A-Za-z0-9]{36,}|\bgithub_pat_[A-Za-z0-9_]{22,}|` +
+		`\bAKIA[0-9A-Z]{16}\b|\bxox[abprs]-[A-Za-z0-9\-]{10,}|\bAIza[0-9A-Za-z_\-]{35}`)
+	// secretAssignmentPattern matches the values assigned to passwords, secrets, tokens and keys, e.g.
+	// "password: hunter22", its first group being the value.
+	secretAssignmentPattern = regexp.MustCompile(`(?i)\b(?:api[_\-]?key|secret|token|password|passwd|pwd)\s*[:=]\s*["']?([^\s"',;]{4,})`)
+)
+
+// aiRedactionRule replaces the matches of its pattern with placeholders of its kind.
+type aiRedactionRule struct {
+	kind    string
+	pattern *regexp.Regexp
+	// accept filters the matches, all of them are replaced when nil.
+	accept func(match string) bool
+	// group is the group of the pattern replaced, the whole match when 0.
+	group int
+}
+
+// aiRedactor replaces sensitive content with numbered placeholders. The same value is always
+// replaced with the same placeholder, so the memos of a prompt still refer to each other.
+type aiRedactor struct {
+	rules        []aiRedactionRule
+	placeholders map[string]string
+	counts       map[string]int
+	// restorePlaceholders puts the values back in the generated summaries.
+	restorePlaceholders bool
+}
+
+// newAIRedactor returns the redactor of the redaction setting, which may be nil.
+func newAIRedactor(setting *storepb.WorkspaceAISetting_Redaction) (*aiRedactor, error) {
+	redactor := &aiRedactor{
+		placeholders:        map[string]string{},
+		counts:              map[string]int{},
+		restorePlaceholders: setting.GetRestorePlaceholders(),
+	}
+	if setting.GetRedactEmails() {
+		redactor.rules = append(redactor.rules, aiRedactionRule{kind: "EMAIL", pattern: emailPattern})
+	}
+	if setting.GetRedactPhoneNumbers() {
+		redactor.rules = append(redactor.rules, aiRedactionRule{kind: "PHONE", pattern: phoneNumberPattern})
+	}
+	if setting.GetRedactSecrets() {
+		redactor.rules = append(redactor.rules,
+			aiRedactionRule{kind: "SECRET", pattern: secretPattern},
+			aiRedactionRule{kind: "SECRET", pattern: secretAssignmentPattern, group: 1},
+		)
+	}
+	for _, expr := range setting.GetPatterns() {
+		if expr == "" {
+			return nil, errors.New("redaction pattern must not be empty")
+		}
+		pattern, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid redaction pattern %q", expr)
+		}
+		redactor.rules = append(redactor.rules, aiRedactionRule{kind: "REDACTED", pattern: pattern})
+	}
+	if len(setting.GetTags()) > 0 {
+		tags := make([]string, 0, len(setting.GetTags()))
+		for _, tag := range setting.GetTags() {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+			if tag == "" {
+				return nil, errors.New("redacted tag must not be empty")
+			}
+			tags = append(tags, tag)
+		}
+		redactor.rules = append(redactor.rules, aiRedactionRule{
+			kind:    "TAG",
+			pattern: redactedTagPattern,
+			accept: func(match string) bool {
+				name := strings.TrimPrefix(match, "#")
+				for _, tag := range tags {
+					if name == tag || strings.HasPrefix(name, tag+"/") {
+						return true
+					}
+				}
+				return false
+			},
+		})
+	}
+	return redactor, nil
+}
+
+// redacted reports whether any content has been replaced so far.
+func (r *aiRedactor) redacted() bool {
+	return len(r.placeholders) > 0
+}
+
+// redact replaces the sensitive content of the text. Overlapping matches are resolved in favor of
+// the earliest one, then of the rule listed first.
+func (r *aiRedactor) redact(text string) string {
+	type match struct {
+		start, end int
+		rule       int
+	}
+	matches := []match{}
+	for i, rule := range r.rules {
+		for _, loc := range rule.pattern.FindAllStringSubmatchIndex(text, -1) {
+			start, end := loc[2*rule.group], loc[2*rule.group+1]
+			if start < 0 || start == end {
+				continue
+			}
+			if rule.accept != nil && !rule.accept(text[start:end]) {
+				continue
+			}
+			matches = append(matches, match{start: start, end: end, rule: i})
+		}
+	}
+	if len(matches) == 0 {
+		return text
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].start != matches[j].start {
+			return matches[i].start < matches[j].start
+		}
+		return matches[i].rule < matches[j].rule
+	})
+
+	var builder strings.Builder
+	last := 0
+	for _, m := range matches {
+		if m.start < last {
+			continue
+		}
+		builder.WriteString(text[last:m.start])
+		builder.WriteString(r.placeholder(r.rules[m.rule].kind, text[m.start:m.end]))
+		last = m.end
+	}
+	builder.WriteString(text[last:])
+	return builder.String()
+}
+
+// placeholder returns the placeholder of the value, numbering the values of each kind in order of appearance.
+func (r *aiRedactor) placeholder(kind, value string) string {
+	key := kind + "\x00" + value
+	if placeholder, ok := r.placeholders[key]; ok {
+		return placeholder
+	}
+	r.counts[kind]++
+	placeholder := fmt.Sprintf("[%s_%d]", kind, r.counts[kind])
+	r.placeholders[key] = placeholder
+	return placeholder
+}
+
+// unredact restores the values replaced with placeholders in the text, e.g. in a reply returned to the user.
+func (r *aiRedactor) unredact(text string) string {
+	if !r.redacted() {
+		return text
+	}
+	replacements := make([]string, 0, 2*len(r.placeholders))
+	for key, placeholder := range r.placeholders {
+		_, value, _ := strings.Cut(key, "\x00")
+		replacements = append(replacements, placeholder, value)
+	}
+	return strings.NewReplacer(replacements...).Replace(text)
+}
+
+// redactSummary replaces the sensitive content of a generated summary sent back to the provider. The values restored
+// in the summary are replaced with their placeholders again, even out of the context their patterns match in.
+func (r *aiRedactor) redactSummary(summary string) string {
+	summary = r.redact(summary)
+	if !r.redacted() {
+		return summary
+	}
+	keys := make([]string, 0, len(r.placeholders))
+	for key := range r.placeholders {
+		keys = append(keys, key)
+	}
+	// The longest values come first, so that a value is not replaced within a longer one.
+	sort.Slice(keys, func(i, j int) bool {
+		return len(keys[i]) > len(keys[j])
+	})
+	replacements := make([]string, 0, 2*len(keys))
+	for _, key := range keys {
+		_, value, _ := strings.Cut(key, "\x00")
+		replacements = append(replacements, value, r.placeholders[key])
+	}
+	return strings.NewReplacer(replacements...).Replace(summary)
+}
+
+// restore restores the values replaced with placeholders in a generated summary when the redaction setting restores
+// the placeholders, and returns the summary as is otherwise.
+func (r *aiRedactor) restore(summary string) string {
+	if !r.restorePlaceholders {
+		return summary
+	}
+	return r.unredact(summary)
+}
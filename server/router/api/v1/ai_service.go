@@ -1,12 +1,16 @@
 package v1
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
+	"image"
+	"image/png"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/lithammer/shortuuid/v4"
 	"github.com/openai/openai-go/v2"
@@ -15,6 +19,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/usememos/memos/internal/logging"
+	"github.com/usememos/memos/plugin/ai"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/runner/memopayload"
@@ -23,38 +29,74 @@ import (
 
 // AIConfig represents the AI configuration from workspace settings.
 type AIConfig struct {
-	Endpoint     string
-	APIKey       string
-	Model        string
-	SystemPrompt string
-}
-
-// RateLimitData represents the rate limit tracking data.
-type RateLimitData struct {
-	// Key format: "user_{userID}_{hourTimestamp}"
-	// Value: request count
-	Counts map[string]int `json:"counts"`
+	// Profile is the name of the AI profile of the configuration, empty for the default provider configuration.
+	Profile            string
+	Provider           ai.ProviderType
+	Endpoint           string
+	APIVersion         string
+	APIKey             string
+	Model              string
+	SystemPrompt       string
+	TranscriptionModel string
+	EmbeddingModel     string
+	// Temperature and MaxTokens are the generation options of the completions, the defaults of the provider when unset.
+	Temperature *float64
+	MaxTokens   int
+	// ContextWindow is the number of tokens of the context window of the model the prompts are fitted in.
+	ContextWindow int
+	// SummaryMinLength and SummaryMaxLength bound the number of characters of the generated summaries, none when 0.
+	SummaryMinLength int
+	SummaryMaxLength int
+	// Breaker configures the circuit breaker of the provider, shared by all the profiles.
+	Breaker ai.BreakerConfig
+	// MaxConcurrentRequests is the maximum number of AI requests in flight to all the providers, 0 for no limit.
+	MaxConcurrentRequests int
 }
 
 const (
-	// Rate limit: 5 requests per user per hour
-	maxRequestsPerHour = 5
 	// Maximum source memos per request
 	maxSourceMemos = 50
-	// Maximum total characters per request
-	maxTotalChars = 10000
+	// Minimum context window, in tokens, the AI setting may set for a model
+	minAIContextWindow = 1024
+	// Tokens of the completions the prompts leave room for when the AI setting does not cap them
+	defaultAICompletionTokens = 1250
+	// Tokens of the prompts reserved for the instructions around the memo content
+	aiPromptReserveTokens = 500
+	// Minimum tokens of memo content per request, whatever the context window
+	minAIPromptTokens = 256
 	// AI request timeout
 	aiRequestTimeout = 30 * time.Second
-	// Retry wait time for 429 errors
-	retryWaitTime = 60 * time.Second
 	// Maximum retry attempts
 	maxRetries = 2
+	// Maximum sampling temperature of the completions
+	maxAITemperature = 2.0
 	// AI tag identifier
 	aiTag = "#AI"
+	// Marker starting the content of AI summary memos
+	aiSummaryMarker = "<!-- AI Generated Summary -->"
 )
 
-// getAIConfig retrieves AI configuration from workspace settings.
-func (s *APIV1Service) getAIConfig(ctx context.Context) (*AIConfig, error) {
+// getAIConfig retrieves the AI configuration the feature is routed to from workspace settings,
+// one of store.AIFeatures.
+func (s *APIV1Service) getAIConfig(ctx context.Context, feature string) (*AIConfig, error) {
+	aiSetting, err := s.getAISettingForConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newAIConfig(aiSetting, aiSetting.FeatureProfiles[feature], feature)
+}
+
+// getAIProfileConfig retrieves the AI configuration of the named profile from workspace settings,
+// the default provider configuration if the name is empty.
+func (s *APIV1Service) getAIProfileConfig(ctx context.Context, profile string) (*AIConfig, error) {
+	aiSetting, err := s.getAISettingForConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newAIConfig(aiSetting, profile, "")
+}
+
+func (s *APIV1Service) getAISettingForConfig(ctx context.Context) (*storepb.WorkspaceAISetting, error) {
 	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
 		Name: storepb.WorkspaceSettingKey_AI_CONFIG.String(),
 	})
@@ -69,28 +111,119 @@ func (s *APIV1Service) getAIConfig(ctx context.Context) (*AIConfig, error) {
 	if aiSetting == nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "AI configuration is empty")
 	}
+	if aiSetting.Disabled {
+		return nil, aiDisabledError()
+	}
+	return aiSetting, nil
+}
+
+// newAIConfig returns the AI configuration of the named profile of the AI setting. The chat model is
+// required unless the configuration is only used for the embeddings.
+func newAIConfig(workspaceAISetting *storepb.WorkspaceAISetting, profile string, feature string) (*AIConfig, error) {
+	aiSetting := store.GetAIProfileSetting(workspaceAISetting, profile)
+	if aiSetting == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "AI profile %q not found", profile)
+	}
 
-	// Validate required fields
-	if aiSetting.Endpoint == "" {
+	provider := ai.ProviderOpenAI
+	if aiSetting.Provider != storepb.WorkspaceAISetting_PROVIDER_UNSPECIFIED {
+		provider = ai.ProviderType(aiSetting.Provider.String())
+	}
+
+	// Validate required fields. Providers other than OpenAI default to their public endpoint,
+	// and a local Ollama server and the fake provider need no API key.
+	if aiSetting.Endpoint == "" && (provider == ai.ProviderOpenAI || provider == ai.ProviderAzureOpenAI) {
 		return nil, status.Errorf(codes.FailedPrecondition, "AI endpoint is not configured")
 	}
-	if aiSetting.ApiKey == "" {
+	if aiSetting.ApiKey == "" && provider != ai.ProviderOllama && provider != ai.ProviderFake {
 		return nil, status.Errorf(codes.FailedPrecondition, "AI API key is not configured")
 	}
-	if aiSetting.Model == "" {
+	model := aiSetting.Model
+	if featureModel := getAIFeatureModel(aiSetting, feature); featureModel != "" {
+		model = featureModel
+	}
+	if model == "" && feature != store.AIFeatureEmbedding {
 		return nil, status.Errorf(codes.FailedPrecondition, "AI model is not configured")
 	}
 
 	config := &AIConfig{
-		Endpoint:     aiSetting.Endpoint,
-		APIKey:       aiSetting.ApiKey,
-		Model:        aiSetting.Model,
-		SystemPrompt: aiSetting.SystemPrompt,
+		Profile:            profile,
+		Provider:           provider,
+		Endpoint:           aiSetting.Endpoint,
+		APIVersion:         aiSetting.ApiVersion,
+		APIKey:             aiSetting.ApiKey,
+		Model:              model,
+		SystemPrompt:       aiSetting.SystemPrompt,
+		TranscriptionModel: aiSetting.TranscriptionModel,
+		EmbeddingModel:     aiSetting.EmbeddingModel,
+		Temperature:        aiSetting.Temperature,
+		MaxTokens:          int(aiSetting.MaxTokens),
+		ContextWindow:      ai.ContextWindow(model),
+		SummaryMinLength:   int(aiSetting.SummaryMinLength),
+		SummaryMaxLength:   int(aiSetting.SummaryMaxLength),
+	}
+	if window := workspaceAISetting.ContextWindows[model]; window > 0 {
+		config.ContextWindow = int(window)
+	}
+	config.Breaker = ai.BreakerConfig{
+		ConsecutiveFailures: int(workspaceAISetting.CircuitBreakerFailures),
+		Cooldown:            time.Duration(workspaceAISetting.CircuitBreakerCooldownSeconds) * time.Second,
 	}
+	config.MaxConcurrentRequests = int(workspaceAISetting.MaxConcurrentRequests)
 
 	return config, nil
 }
 
+// getAIFeatureModel returns the model the AI setting configures for the feature, empty if the feature uses the chat model.
+func getAIFeatureModel(aiSetting *storepb.WorkspaceAISetting, feature string) string {
+	switch feature {
+	case store.AIFeatureSummary:
+		return aiSetting.SummaryModel
+	case store.AIFeatureChat:
+		return aiSetting.ChatModel
+	case store.AIFeatureAttachmentExtraction:
+		return aiSetting.VisionModel
+	default:
+		return ""
+	}
+}
+
+// completionRequest returns the completion request of the messages with the model and generation options of the configuration.
+func (c *AIConfig) completionRequest(messages []ai.Message) *ai.CompletionRequest {
+	return &ai.CompletionRequest{
+		Model:       c.Model,
+		Messages:    messages,
+		Temperature: c.Temperature,
+		MaxTokens:   c.MaxTokens,
+	}
+}
+
+// completionTokens returns the number of tokens the prompts leave room for in the context window for the completion:
+// the max tokens of the AI setting, or defaultAICompletionTokens, at most half of the context window.
+func (c *AIConfig) completionTokens() int {
+	tokens := c.MaxTokens
+	if tokens <= 0 {
+		tokens = defaultAICompletionTokens
+	}
+	return min(tokens, c.ContextWindow/2)
+}
+
+// promptTokens returns the number of tokens of memo content that fit in a request along with the instructions.
+func (c *AIConfig) promptTokens(instructions ...string) int {
+	tokens := c.ContextWindow - c.completionTokens() - aiPromptReserveTokens
+	for _, instruction := range instructions {
+		tokens -= ai.EstimateTokens(instruction)
+	}
+	return max(tokens, minAIPromptTokens)
+}
+
+// summaryConfig returns the configuration of the summary requests, capping their completions at completionTokens.
+func (c *AIConfig) summaryConfig() *AIConfig {
+	config := *c
+	config.MaxTokens = c.completionTokens()
+	return &config
+}
+
 // createOpenAIClient creates a new OpenAI client with the given configuration.
 func createOpenAIClient(config *AIConfig) *openai.Client {
 	opts := []option.RequestOption{
@@ -106,50 +239,113 @@ func createOpenAIClient(config *AIConfig) *openai.Client {
 	return &client
 }
 
-// buildPrompt constructs the AI request prompt from source memos.
-func (s *APIV1Service) buildPrompt(ctx context.Context, memos []*store.Memo, systemPrompt string) (string, error) {
+// createAIProvider creates the AI provider of the given configuration, with its calls limited by the concurrency
+// limiter of the workspace, guarded by the circuit breaker of the provider and recorded in the AI usage. It fails fast
+// with an Unavailable error while the circuit is open.
+func (s *APIV1Service) createAIProvider(ctx context.Context, config *AIConfig) (ai.Provider, error) {
+	monitor := s.getAIMonitor(config.Profile)
+	monitor.Configure(config.Breaker)
+	monitor.SetOpenHandler(func(health ai.Health) {
+		s.raiseAlert(ctx, alertKindAIProvider, "AI provider failing",
+			fmt.Sprintf("The calls to the AI provider%s fail and are stopped until %s, %d of the %d recent calls failed, the last error: %s.",
+				aiProfileLabel(config.Profile), health.RetryTime.Format(time.RFC3339), health.Failures, health.Calls, health.LastError))
+	})
+	if retryTime, open := monitor.Open(); open {
+		return nil, aiUnavailableError(retryTime)
+	}
+	provider, err := newAIProvider(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	s.aiLimiter.SetLimit(config.MaxConcurrentRequests)
+	// The calls waiting for a slot are neither held against the provider nor recorded.
+	return s.recordAIUsageOf(s.aiLimiter.Wrap(monitor.Wrap(provider))), nil
+}
+
+// getAIMonitor returns the monitor of the provider of the named AI profile, the one of the default provider
+// configuration if the name is empty.
+func (s *APIV1Service) getAIMonitor(profile string) *ai.Monitor {
+	if profile == "" {
+		return &s.aiMonitor
+	}
+	monitor, _ := s.aiProfileMonitors.LoadOrStore(profile, &ai.Monitor{})
+	return monitor.(*ai.Monitor)
+}
+
+// newAIProvider creates the AI provider of the given configuration, forwarding the request ID of the incoming call.
+func newAIProvider(ctx context.Context, config *AIConfig) (ai.Provider, error) {
+	providerConfig := ai.Config{
+		Type:       config.Provider,
+		Endpoint:   config.Endpoint,
+		APIKey:     config.APIKey,
+		APIVersion: config.APIVersion,
+	}
+	if requestID := logging.RequestIDFromContext(ctx); requestID != "" {
+		providerConfig.Headers = map[string]string{logging.RequestIDHeader: requestID}
+	}
+	provider, err := ai.NewProvider(providerConfig)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid AI provider: %v", err)
+	}
+	return provider, nil
+}
+
+// isOpenAICompatible reports whether the configured provider speaks the OpenAI API,
+// which the audio features (transcription and speech) require.
+func (c *AIConfig) isOpenAICompatible() bool {
+	return c.Provider == ai.ProviderOpenAI
+}
+
+// buildPrompt constructs the AI request prompt from source memos, keeping the memos that fit in a single chunk.
+// The previous memos, if any, are the memos of the previous period the summary highlights the changes against.
+// The redactor of the prompt is returned to redact the rest of the conversation and restore the placeholders.
+func (s *APIV1Service) buildPrompt(ctx context.Context, config *AIConfig, memos []*store.Memo, previousMemos []*store.Memo) (string, *aiRedactor, error) {
 	if len(memos) == 0 {
-		return "", status.Errorf(codes.InvalidArgument, "no memos provided for summarization")
+		return "", nil, status.Errorf(codes.InvalidArgument, "no memos provided for summarization")
+	}
+	prompter, err := s.newAISummaryPrompter(ctx, config, slices.Concat(memos, previousMemos))
+	if err != nil {
+		return "", nil, err
 	}
 
-	// Build memo content list
-	var contentBuilder strings.Builder
-	totalChars := 0
+	// The current memos come first so that they are kept when the content exceeds the size of a chunk.
+	totalSize := aiPromptSize{}
+	memoContent := prompter.formatMemos(memos, &totalSize)
+	if memoContent == "" {
+		return "", nil, status.Errorf(codes.InvalidArgument, "all memos are empty")
+	}
+	return prompter.prompt(config.SystemPrompt, memos, memoContent, previousMemos, &totalSize), prompter.redactor, nil
+}
 
+// formatMemos formats the redacted content of the memos for the prompt, stopping before the total
+// size of a chunk is exceeded.
+func (p *aiSummaryPrompter) formatMemos(memos []*store.Memo, totalSize *aiPromptSize) string {
+	var contentBuilder strings.Builder
 	for i, memo := range memos {
-		content := strings.TrimSpace(memo.Content)
+		content := p.memoContent(memo)
 		if content == "" {
 			continue
 		}
+		// Redact the content before it leaves the server.
+		content = p.redactor.redact(content)
 
-		// Check total character limit
-		totalChars += len(content)
-		if totalChars > maxTotalChars {
-			slog.Warn("Total memo content exceeds character limit", 
-				"limit", maxTotalChars, 
-				"actual", totalChars,
+		// Format: [Memo N] content
+		content = fmt.Sprintf("[Memo %d]\n%s\n\n", i+1, content)
+
+		// Check the total size limit
+		size := totalSize.add(newAIPromptSize(content))
+		if !p.fits(size) {
+			slog.Warn("Total memo content exceeds the size of a chunk",
+				"max_chars", p.chunkSize,
+				"max_tokens", p.chunkTokens,
+				"tokens", size.tokens,
 				"memos_processed", i)
 			break
 		}
-
-		// Format: [Memo N] content
-		contentBuilder.WriteString(fmt.Sprintf("[Memo %d]\n%s\n\n", i+1, content))
+		*totalSize = size
+		contentBuilder.WriteString(content)
 	}
-
-	memoContent := contentBuilder.String()
-	if memoContent == "" {
-		return "", status.Errorf(codes.InvalidArgument, "all memos are empty")
-	}
-
-	// Use custom system prompt if provided, otherwise use default
-	if systemPrompt == "" {
-		systemPrompt = getDefaultSystemPrompt()
-	}
-
-	// Build final prompt
-	prompt := fmt.Sprintf("%s\n\n%s", systemPrompt, memoContent)
-	
-	return prompt, nil
+	return contentBuilder.String()
 }
 
 // getDefaultSystemPrompt returns the default system prompt for AI summarization.
@@ -169,103 +365,53 @@ Guidelines:
 Please provide a summary of the following memos:`
 }
 
-// checkRateLimit checks if the user has exceeded the rate limit.
-func (s *APIV1Service) checkRateLimit(ctx context.Context, userID int32) error {
-	// Get current hour timestamp
-	now := time.Now()
-	hourTimestamp := now.Truncate(time.Hour).Unix()
-	rateLimitKey := fmt.Sprintf("user_%d_%d", userID, hourTimestamp)
-
-	// Get rate limit data from workspace setting
-	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
-		Name: storepb.WorkspaceSettingKey_AI_RATE_LIMIT.String(),
-	})
+// querySourceMemos retrieves source memos for AI summarization: the memos of the time range, or the memos of the
+// request's memo names and filter.
+func (s *APIV1Service) querySourceMemos(ctx context.Context, userID int32, request *v1pb.GenerateAISummaryRequest) ([]*store.Memo, error) {
+	startTime, endTime, err := parseAISummaryTimeRange(request)
 	if err != nil {
-		return errors.Wrap(err, "failed to get rate limit data")
-	}
-
-	var rateLimitData RateLimitData
-	if workspaceSetting != nil && workspaceSetting.GetAiRateLimit() != "" {
-		if err := json.Unmarshal([]byte(workspaceSetting.GetAiRateLimit()), &rateLimitData); err != nil {
-			slog.Warn("failed to unmarshal rate limit data, resetting", "error", err)
-			rateLimitData = RateLimitData{Counts: make(map[string]int)}
-		}
-	} else {
-		rateLimitData = RateLimitData{Counts: make(map[string]int)}
-	}
-
-	// Check current count
-	currentCount := rateLimitData.Counts[rateLimitKey]
-	if currentCount >= maxRequestsPerHour {
-		return status.Errorf(codes.ResourceExhausted, 
-			"rate limit exceeded: maximum %d requests per hour allowed", maxRequestsPerHour)
+		return nil, err
 	}
-
-	return nil
-}
-
-// updateRateLimit increments the rate limit counter for the user.
-func (s *APIV1Service) updateRateLimit(ctx context.Context, userID int32) error {
-	// Get current hour timestamp
-	now := time.Now()
-	hourTimestamp := now.Truncate(time.Hour).Unix()
-	rateLimitKey := fmt.Sprintf("user_%d_%d", userID, hourTimestamp)
-
-	// Get rate limit data from workspace setting
-	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
-		Name: storepb.WorkspaceSettingKey_AI_RATE_LIMIT.String(),
-	})
+	idList, err := s.getAISummaryMemoIDs(ctx, userID, request.MemoNames)
 	if err != nil {
-		return errors.Wrap(err, "failed to get rate limit data")
+		return nil, err
 	}
-
-	var rateLimitData RateLimitData
-	if workspaceSetting != nil && workspaceSetting.GetAiRateLimit() != "" {
-		if err := json.Unmarshal([]byte(workspaceSetting.GetAiRateLimit()), &rateLimitData); err != nil {
-			slog.Warn("failed to unmarshal rate limit data, resetting", "error", err)
-			rateLimitData = RateLimitData{Counts: make(map[string]int)}
-		}
-	} else {
-		rateLimitData = RateLimitData{Counts: make(map[string]int)}
-	}
-
-	// Clean up expired data (older than 24 hours)
-	cutoffTimestamp := now.Add(-24 * time.Hour).Truncate(time.Hour).Unix()
-	for key := range rateLimitData.Counts {
-		var keyUserID int32
-		var keyTimestamp int64
-		if _, err := fmt.Sscanf(key, "user_%d_%d", &keyUserID, &keyTimestamp); err == nil {
-			if keyTimestamp < cutoffTimestamp {
-				delete(rateLimitData.Counts, key)
-			}
+	filters := []string{}
+	if request.Filter != "" {
+		if err := s.validateFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 		}
+		filters = append(filters, request.Filter)
 	}
-
-	// Increment counter
-	rateLimitData.Counts[rateLimitKey]++
-
-	// Save back to workspace setting
-	rateLimitJSON, err := json.Marshal(rateLimitData)
+	// The summaries cover more memos than a single request takes, they are summarized in chunks.
+	memos, err := s.listSourceMemos(ctx, userID, idList, startTime, endTime, request.Tags, maxSummarySourceMemos, filters...)
 	if err != nil {
-		return errors.Wrap(err, "failed to marshal rate limit data")
+		return nil, err
 	}
 
-	_, err = s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
-		Key:   storepb.WorkspaceSettingKey_AI_RATE_LIMIT,
-		Value: &storepb.WorkspaceSetting_AiRateLimit{AiRateLimit: string(rateLimitJSON)},
-	})
-	if err != nil {
-		return errors.Wrap(err, "failed to update rate limit data")
+	if len(memos) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no memos found in the specified time range")
 	}
 
-	return nil
+	return memos, nil
 }
 
-// querySourceMemos retrieves source memos for AI summarization.
-func (s *APIV1Service) querySourceMemos(ctx context.Context, userID int32, request *v1pb.GenerateAISummaryRequest) ([]*store.Memo, error) {
-	// Parse time range
+// parseAISummaryTimeRange returns the start and end timestamps of the time range of the request, the end excluded.
+// They are 0 when the request selects its memos by name or filter without a time range. The dates of the custom
+// time range are in the timezone of the request.
+func parseAISummaryTimeRange(request *v1pb.GenerateAISummaryRequest) (int64, int64, error) {
+	if request.TimeRange == "" && (len(request.MemoNames) > 0 || request.Filter != "") {
+		return 0, 0, nil
+	}
 	var startTime, endTime int64
 	now := time.Now()
+	location := time.UTC
+	if request.Timezone != "" {
+		var err error
+		if location, err = time.LoadLocation(request.Timezone); err != nil {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "invalid timezone %q", request.Timezone)
+		}
+	}
 
 	switch request.TimeRange {
 	case "7d":
@@ -279,54 +425,103 @@ func (s *APIV1Service) querySourceMemos(ctx context.Context, userID int32, reque
 		endTime = now.Unix()
 	case "custom":
 		if request.StartDate == "" || request.EndDate == "" {
-			return nil, status.Errorf(codes.InvalidArgument, "start_date and end_date are required for custom time range")
+			return 0, 0, status.Errorf(codes.InvalidArgument, "start_date and end_date are required for custom time range")
 		}
 		
-		startDate, err := time.Parse("2006-01-02", request.StartDate)
+		startDate, err := time.ParseInLocation("2006-01-02", request.StartDate, location)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid start_date format, expected YYYY-MM-DD")
+			return 0, 0, status.Errorf(codes.InvalidArgument, "invalid start_date format, expected YYYY-MM-DD")
 		}
-		endDate, err := time.Parse("2006-01-02", request.EndDate)
+		endDate, err := time.ParseInLocation("2006-01-02", request.EndDate, location)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid end_date format, expected YYYY-MM-DD")
+			return 0, 0, status.Errorf(codes.InvalidArgument, "invalid end_date format, expected YYYY-MM-DD")
 		}
 		
 		if endDate.Before(startDate) {
-			return nil, status.Errorf(codes.InvalidArgument, "end_date must be after start_date")
+			return 0, 0, status.Errorf(codes.InvalidArgument, "end_date must be after start_date")
 		}
 		
 		startTime = startDate.Unix()
-		endTime = endDate.Add(24 * time.Hour).Unix() // Include the entire end date
+		endTime = endDate.AddDate(0, 0, 1).Unix() // Include the entire end date
 	default:
-		return nil, status.Errorf(codes.InvalidArgument, "invalid time_range: must be one of 7d, 30d, 90d, or custom")
+		return 0, 0, status.Errorf(codes.InvalidArgument, "invalid time_range: must be one of 7d, 30d, 90d, or custom")
+	}
+
+	return startTime, endTime, nil
+}
+
+// getAISummaryMemoIDs returns the IDs of the memos of the user with the names, nil without names. The memos must
+// exist and belong to the user.
+func (s *APIV1Service) getAISummaryMemoIDs(ctx context.Context, userID int32, memoNames []string) ([]int32, error) {
+	if len(memoNames) == 0 {
+		return nil, nil
+	}
+	if len(memoNames) > maxSummarySourceMemos {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d memos can be summarized", maxSummarySourceMemos)
+	}
+	uids := make([]string, 0, len(memoNames))
+	for _, name := range memoNames {
+		uid, err := ExtractMemoUIDFromName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name %q: %v", name, err)
+		}
+		uids = append(uids, uid)
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{UIDList: uids, CreatorID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	idList := make([]int32, 0, len(memos))
+	foundUIDs := map[string]bool{}
+	for _, memo := range memos {
+		idList = append(idList, memo.ID)
+		foundUIDs[memo.UID] = true
+	}
+	for i, uid := range uids {
+		if !foundUIDs[uid] {
+			return nil, status.Errorf(codes.NotFound, "memo not found: %s", memoNames[i])
+		}
 	}
+	return idList, nil
+}
 
+// listSourceMemos lists at most limit memos of the user created in the time range that can be sent to the AI provider,
+// restricted to the given IDs, tags and filters if any. The bounds of the time range that are 0 are not applied.
+// They are only listed for the AI operations of another user if the user opted in to it.
+func (s *APIV1Service) listSourceMemos(ctx context.Context, userID int32, idList []int32, startTime, endTime int64, tags []string, limit int, extraFilters ...string) ([]*store.Memo, error) {
 	// Build filters
 	filters := []string{
-		fmt.Sprintf("created_ts >= %d", startTime),
-		fmt.Sprintf("created_ts < %d", endTime),
-		fmt.Sprintf("content_search != ['%s']", aiTag), // Exclude AI memos
+		"!is_ai_generated", // Exclude AI memos
+	}
+	if startTime > 0 {
+		filters = append(filters, fmt.Sprintf("created_ts >= %d", startTime))
 	}
+	if endTime > 0 {
+		filters = append(filters, fmt.Sprintf("created_ts < %d", endTime))
+	}
+	filters = append(filters, extraFilters...)
 
 	// Add tag filters if specified
-	if len(request.Tags) > 0 {
-		tagFilters := make([]string, len(request.Tags))
-		for i, tag := range request.Tags {
-			// Ensure tag starts with #
-			if !strings.HasPrefix(tag, "#") {
-				tag = "#" + tag
-			}
-			tagFilters[i] = fmt.Sprintf("'%s'", tag)
+	if len(tags) > 0 {
+		tagFilters := make([]string, len(tags))
+		for i, tag := range tags {
+			// Tags are stored without the leading #
+			tagFilters[i] = fmt.Sprintf("%q", strings.TrimPrefix(tag, "#"))
 		}
-		filters = append(filters, fmt.Sprintf("content_search == [%s]", strings.Join(tagFilters, " || ")))
+		filters = append(filters, fmt.Sprintf("tag in [%s]", strings.Join(tagFilters, ", ")))
 	}
 
 	// Query memos
-	limit := maxSourceMemos
+	visibilities, err := s.getAIMemoVisibilities(ctx)
+	if err != nil {
+		return nil, err
+	}
 	normalStatus := store.Normal
 	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		IDList:           idList,
 		CreatorID:        &userID,
 		RowStatus:        &normalStatus,
+		VisibilityList:   visibilities,
 		Filters:          filters,
 		Limit:            &limit,
 		OrderByUpdatedTs: false,
@@ -336,116 +531,137 @@ func (s *APIV1Service) querySourceMemos(ctx context.Context, userID int32, reque
 		return nil, errors.Wrap(err, "failed to query source memos")
 	}
 
-	if len(memos) == 0 {
-		return nil, status.Errorf(codes.NotFound, "no memos found in the specified time range")
+	// The memos of the user are only sent in the AI operations of another user if the user opted in.
+	requesterID, err := s.getAIRequesterID(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
+	return s.filterAIConsentedMemos(ctx, requesterID, memos)
+}
 
-	return memos, nil
+// requestIDOptions forwards the request ID of the incoming call to the AI provider.
+func requestIDOptions(ctx context.Context) []option.RequestOption {
+	requestID := logging.RequestIDFromContext(ctx)
+	if requestID == "" {
+		return nil
+	}
+	return []option.RequestOption{option.WithHeader(logging.RequestIDHeader, requestID)}
 }
 
-// callAIWithRetry calls the AI API with retry logic for 429 errors.
-func (s *APIV1Service) callAIWithRetry(ctx context.Context, config *AIConfig, prompt string) (string, error) {
-	client := createOpenAIClient(config)
-	
-	var lastErr error
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		if attempt > 0 {
-			slog.Info("retrying AI API call", "attempt", attempt, "max_retries", maxRetries)
-			time.Sleep(retryWaitTime)
-		}
+// newAISummaryMessages returns the conversation asking the model to summarize the prompt.
+func newAISummaryMessages(config *AIConfig, prompt string) []ai.Message {
+	return []ai.Message{
+		{Role: ai.RoleSystem, Content: config.SystemPrompt},
+		{Role: ai.RoleUser, Content: prompt},
+	}
+}
+
+// callAIWithRetry calls the AI API with retry logic for 429 errors and validates the generated summary.
+func (s *APIV1Service) callAIWithRetry(ctx context.Context, config *AIConfig, messages []ai.Message) (string, error) {
+	content, err := s.completeAIWithRetry(ctx, config.summaryConfig(), messages)
+	if err != nil {
+		return "", err
+	}
+	return validateAISummary(config, content)
+}
+
+// completeAIWithRetry calls the AI API, retrying the calls failed on rate limits or on the unavailability of the
+// provider after the delay it asked for, or with an exponential backoff.
+func (s *APIV1Service) completeAIWithRetry(ctx context.Context, config *AIConfig, messages []ai.Message) (string, error) {
+	provider, err := s.createAIProvider(ctx, config)
+	if err != nil {
+		return "", err
+	}
 
+	for attempt := 0; ; attempt++ {
 		// Create context with timeout
 		timeoutCtx, cancel := context.WithTimeout(ctx, aiRequestTimeout)
-		defer cancel()
-
-		// Build messages
-		messages := []openai.ChatCompletionMessageParamUnion{
-			openai.SystemMessage(config.SystemPrompt),
-			openai.UserMessage(prompt),
+		completion, err := provider.Complete(timeoutCtx, config.completionRequest(messages))
+		cancel()
+		if err == nil {
+			// Count the tokens against the workspace usage, whatever the content is.
+			if err := s.AddAITokenUsage(ctx, completion.TotalTokens); err != nil {
+				slog.WarnContext(ctx, "failed to update AI usage", "error", err)
+			}
+			return completion.Content, nil
 		}
 
-		// Call OpenAI API
-		chatCompletion, err := client.Chat.Completions.New(timeoutCtx, openai.ChatCompletionNewParams{
-			Messages: messages,
-			Model:    openai.ChatModel(config.Model),
-		})
-
-		if err != nil {
-			lastErr = err
-			
-			// Check if it's a rate limit error (429)
-			if strings.Contains(err.Error(), "429") || strings.Contains(err.Error(), "rate_limit") {
-				slog.Warn("AI API rate limit exceeded, will retry", 
-					"attempt", attempt, 
-					"wait_time", retryWaitTime)
-				continue
+		// The request already waited for a slot of the limiter, it is not held any longer.
+		if errors.Is(err, ai.ErrBusy) {
+			return "", err
+		}
+		classified := ai.ClassifyError(err)
+		delay, retry := aiRetryDelay(classified, attempt+1)
+		if !retry || attempt == maxRetries {
+			if attempt > 0 {
+				return "", errors.Wrapf(err, "AI API call failed after %d retries", attempt)
 			}
-			
-			// For other errors, don't retry
 			return "", errors.Wrap(err, "AI API call failed")
 		}
-
-		// Extract content from response
-		if len(chatCompletion.Choices) == 0 {
-			return "", status.Errorf(codes.Internal, "AI API returned no choices")
+		// Waiting for a retry is pointless once the provider is deemed unavailable.
+		if retryTime, open := s.getAIMonitor(config.Profile).Open(); open {
+			return "", aiUnavailableError(retryTime)
 		}
-
-		content := chatCompletion.Choices[0].Message.Content
-		if content == "" {
-			return "", status.Errorf(codes.Internal, "AI API returned empty content")
+		slog.WarnContext(ctx, "AI API call failed, will retry",
+			"kind", classified.Kind,
+			"attempt", attempt+1,
+			"max_retries", maxRetries,
+			"wait_time", delay)
+		if err := waitAIRetry(ctx, delay); err != nil {
+			return "", err
 		}
+	}
+}
 
-		// Validate content length (100-5000 characters)
-		if len(content) < 100 {
-			return "", status.Errorf(codes.InvalidArgument, 
-				"AI generated summary is too short (minimum 100 characters)")
-		}
-		if len(content) > 5000 {
-			slog.Warn("AI generated summary exceeds maximum length, truncating", 
-				"length", len(content), 
-				"max", 5000)
-			content = content[:5000]
-		}
+// validateAISummary checks the length of a generated summary against the bounds of the configuration, truncating it
+// to the max length.
+func validateAISummary(config *AIConfig, content string) (string, error) {
+	if strings.TrimSpace(content) == "" {
+		return "", status.Errorf(codes.Internal, "AI API returned empty content")
+	}
 
-		return content, nil
+	length := utf8.RuneCountInString(content)
+	if config.SummaryMinLength > 0 && length < config.SummaryMinLength {
+		return "", status.Errorf(codes.InvalidArgument,
+			"AI generated summary is too short (minimum %d characters)", config.SummaryMinLength)
+	}
+	if config.SummaryMaxLength > 0 && length > config.SummaryMaxLength {
+		slog.Warn("AI generated summary exceeds maximum length, truncating",
+			"length", length,
+			"max", config.SummaryMaxLength)
+		content = string([]rune(content)[:config.SummaryMaxLength])
 	}
 
-	// All retries exhausted
-	return "", errors.Wrapf(lastErr, "AI API call failed after %d retries", maxRetries)
+	return content, nil
 }
 
-// createAIMemo creates a new AI memo with the generated summary.
-func (s *APIV1Service) createAIMemo(ctx context.Context, userID int32, summary string, timeRange string, startDate string, endDate string) (*store.Memo, error) {
-	// Build memo content with metadata
-	var contentBuilder strings.Builder
-	
-	// Add generation metadata
-	contentBuilder.WriteString(fmt.Sprintf("<!-- AI Generated Summary -->\n"))
-	contentBuilder.WriteString(fmt.Sprintf("**Generated:** %s\n", time.Now().Format("2006-01-02 15:04:05")))
-	
-	// Add time range info
-	if timeRange == "custom" && startDate != "" && endDate != "" {
-		contentBuilder.WriteString(fmt.Sprintf("**Time Range:** %s to %s\n\n", startDate, endDate))
-	} else {
-		contentBuilder.WriteString(fmt.Sprintf("**Time Range:** Last %s\n\n", timeRange))
-	}
-	
-	contentBuilder.WriteString("---\n\n")
-	contentBuilder.WriteString(summary)
-	
-	// Ensure content includes #AI tag
-	content := contentBuilder.String()
-	if !strings.Contains(content, aiTag) {
-		content = content + "\n\n" + aiTag
-	}
+// createAIMemo creates a new AI memo with the summary generated by the model, referencing the source memos
+// in the same transaction.
+func (s *APIV1Service) createAIMemo(ctx context.Context, userID int32, summary string, model string, request *v1pb.GenerateAISummaryRequest, sourceMemos []*store.Memo) (*store.Memo, error) {
+	content := formatAISummaryContent(summary, request)
 
 	// Create memo
 	create := &store.Memo{
 		UID:        shortuuid.New(),
 		CreatorID:  userID,
 		Content:    content,
-		Visibility: store.Private, // AI memos are private by default
+		Visibility: convertVisibilityToStore(request.Visibility), // AI memos are private by default
 		Pinned:     false,
+		Payload: &storepb.MemoPayload{
+			Property: &storepb.MemoPayload_Property{IsAiGenerated: true},
+			AiSummarySource: &storepb.MemoPayload_AISummarySource{
+				TimeRange:       request.TimeRange,
+				Tags:            request.Tags,
+				StartDate:       request.StartDate,
+				EndDate:         request.EndDate,
+				ComparePrevious: request.ComparePrevious,
+				PromptTemplate:  request.PromptTemplate,
+				MemoNames:       request.MemoNames,
+				Filter:          request.Filter,
+				Model:           model,
+				Timezone:        request.Timezone,
+			},
+		},
 	}
 
 	// Rebuild payload to extract tags and properties
@@ -453,8 +669,15 @@ func (s *APIV1Service) createAIMemo(ctx context.Context, userID int32, summary s
 		return nil, errors.Wrap(err, "failed to rebuild memo payload")
 	}
 
-	// Create the memo
-	memo, err := s.Store.CreateMemo(ctx, create)
+	// Create the memo along with its relations to the source memos
+	associations := &store.MemoAssociations{}
+	for _, sourceMemo := range sourceMemos {
+		associations.Relations = append(associations.Relations, &store.MemoRelation{
+			RelatedMemoID: sourceMemo.ID,
+			Type:          store.MemoRelationSummaryOf,
+		})
+	}
+	memo, err := s.Store.CreateMemoWithAssociations(ctx, create, associations)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create AI memo")
 	}
@@ -462,29 +685,68 @@ func (s *APIV1Service) createAIMemo(ctx context.Context, userID int32, summary s
 	return memo, nil
 }
 
-// createMemoRelations creates memo relations between AI memo and source memos.
-func (s *APIV1Service) createMemoRelations(ctx context.Context, aiMemoID int32, sourceMemos []*store.Memo) error {
-	for _, sourceMemo := range sourceMemos {
-		relation := &store.MemoRelation{
-			MemoID:        aiMemoID,
-			RelatedMemoID: sourceMemo.ID,
-			Type:          store.MemoRelationReference,
-		}
-		
-		if _, err := s.Store.UpsertMemoRelation(ctx, relation); err != nil {
-			slog.Error("failed to create memo relation", 
-				"ai_memo_id", aiMemoID, 
-				"source_memo_id", sourceMemo.ID, 
-				"error", err)
-			// Continue with other relations even if one fails
-		}
+// formatAISummaryContent returns the content of the AI memo of the summary: the generation metadata, then the summary
+// with the #AI tag.
+func formatAISummaryContent(summary string, request *v1pb.GenerateAISummaryRequest) string {
+	var contentBuilder strings.Builder
+
+	// Add generation metadata
+	contentBuilder.WriteString(aiSummaryMarker + "\n")
+	contentBuilder.WriteString(fmt.Sprintf("**Generated:** %s\n", time.Now().Format("2006-01-02 15:04:05")))
+
+	// Add time range info
+	if request.TimeRange == "custom" && request.StartDate != "" && request.EndDate != "" {
+		contentBuilder.WriteString(fmt.Sprintf("**Time Range:** %s to %s\n", request.StartDate, request.EndDate))
+	} else if request.TimeRange != "" {
+		contentBuilder.WriteString(fmt.Sprintf("**Time Range:** Last %s\n", request.TimeRange))
 	}
-	
-	return nil
+	if len(request.MemoNames) > 0 {
+		contentBuilder.WriteString(fmt.Sprintf("**Selected Memos:** %d\n", len(request.MemoNames)))
+	}
+	if request.Filter != "" {
+		contentBuilder.WriteString(fmt.Sprintf("**Filter:** `%s`\n", request.Filter))
+	}
+	if request.ComparePrevious {
+		contentBuilder.WriteString("**Compared With:** Previous period\n")
+	}
+	contentBuilder.WriteString("\n")
+
+	contentBuilder.WriteString(aiSummarySeparator)
+	contentBuilder.WriteString(summary)
+
+	// Ensure content includes #AI tag
+	content := contentBuilder.String()
+	if !strings.Contains(content, aiTag) {
+		content = content + "\n\n" + aiTag
+	}
+	return content
 }
 
-// TestAIConfig tests the AI configuration by sending a simple test request.
-func (s *APIV1Service) TestAIConfig(ctx context.Context, request *v1pb.TestAIConfigRequest) (*v1pb.TestAIConfigResponse, error) {
+// aiModelTest is the test of a model configured for an operation.
+type aiModelTest struct {
+	operation string
+	model     string
+	// kind is the kind of the test request: aiModelTestCompletion, aiModelTestVision or aiModelTestEmbedding.
+	kind string
+}
+
+const (
+	aiModelTestCompletion = "completion"
+	aiModelTestVision     = "vision"
+	aiModelTestEmbedding  = "embedding"
+	// aiTestPrompt is the prompt of the test requests.
+	aiTestPrompt = "Hello! This is a test message. Please respond with 'Test successful' if you receive this."
+)
+
+// aiTestImage is the image of the test requests of the vision models, a blank 8x8 PNG.
+var aiTestImage = func() []byte {
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, image.NewGray(image.Rect(0, 0, 8, 8)))
+	return buf.Bytes()
+}()
+
+// TestAIConfig tests the AI configuration by sending a minimal test request to each configured model.
+func (s *APIV1Service) TestAIConfig(ctx context.Context, request *v1pb.TestAIConfigRequest) (response *v1pb.TestAIConfigResponse, err error) {
 	// Get current user (must be authenticated)
 	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
@@ -493,9 +755,23 @@ func (s *APIV1Service) TestAIConfig(ctx context.Context, request *v1pb.TestAICon
 	if user == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
+	auditLog := newAIAuditLog(user.ID, aiOperationConfigTest, nil)
+	auditLog.setParameter("profile", request.Profile)
+	defer func() {
+		// The failed tests are answered without an error.
+		auditErr := err
+		if auditErr == nil && !response.Success {
+			auditErr = errors.New(response.ErrorMessage)
+		}
+		s.recordAIAuditLog(ctx, auditLog, auditErr)
+	}()
 
 	// Get AI configuration
-	config, err := s.getAIConfig(ctx)
+	aiSetting, err := s.getAISettingForConfig(ctx)
+	var config *AIConfig
+	if err == nil {
+		config, err = newAIConfig(aiSetting, request.Profile, "")
+	}
 	if err != nil {
 		return &v1pb.TestAIConfigResponse{
 			Success:      false,
@@ -503,6 +779,8 @@ func (s *APIV1Service) TestAIConfig(ctx context.Context, request *v1pb.TestAICon
 			Details:      "Please ensure AI configuration is properly set in workspace settings.",
 		}, nil
 	}
+	profileSetting := store.GetAIProfileSetting(aiSetting, request.Profile)
+	auditLog.setProvider(config)
 
 	// Log test configuration (without sensitive data)
 	slog.Info("Testing AI configuration",
@@ -510,89 +788,135 @@ func (s *APIV1Service) TestAIConfig(ctx context.Context, request *v1pb.TestAICon
 		"endpoint", config.Endpoint,
 		"model", config.Model)
 
-	// Create OpenAI client
-	client := createOpenAIClient(config)
+	// Create the AI provider
+	// The test calls the provider even while its circuit is open, to find out whether it recovered.
+	provider, err := newAIProvider(ctx, config)
+	if err != nil {
+		return &v1pb.TestAIConfigResponse{
+			Success:      false,
+			ErrorMessage: err.Error(),
+			Details:      "Please check the AI provider in workspace settings.",
+		}, nil
+	}
+	provider = s.recordAIUsageOf(provider)
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationConfigTest)
+
+	tests := []aiModelTest{
+		{operation: "default", model: config.Model, kind: aiModelTestCompletion},
+		{operation: "summary", model: profileSetting.SummaryModel, kind: aiModelTestCompletion},
+		{operation: "chat", model: profileSetting.ChatModel, kind: aiModelTestCompletion},
+		{operation: "vision", model: profileSetting.VisionModel, kind: aiModelTestVision},
+		{operation: "embedding", model: profileSetting.EmbeddingModel, kind: aiModelTestEmbedding},
+	}
+	response = &v1pb.TestAIConfigResponse{Success: true}
+	// Each model is tested once per kind of request, even if it is configured for several operations.
+	tested := map[aiModelTest]*v1pb.TestAIConfigResponse_ModelResult{}
+	for _, test := range tests {
+		if test.model == "" {
+			continue
+		}
+		key := aiModelTest{model: test.model, kind: test.kind}
+		result, ok := tested[key]
+		if !ok {
+			result = s.testAIModel(ctx, provider, config, test)
+			tested[key] = result
+		}
+		response.ModelResults = append(response.ModelResults, &v1pb.TestAIConfigResponse_ModelResult{
+			Operation:    test.operation,
+			Model:        result.Model,
+			Success:      result.Success,
+			ErrorMessage: result.ErrorMessage,
+			Details:      result.Details,
+		})
+		if !result.Success && response.Success {
+			response.Success = false
+			response.ErrorMessage = result.ErrorMessage
+			response.Details = result.Details
+		}
+	}
 
-	// Create a simple test message
-	testPrompt := "Hello! This is a test message. Please respond with 'Test successful' if you receive this."
+	if response.Success {
+		slog.InfoContext(ctx, "AI config test successful",
+			"user_id", user.ID,
+			"endpoint", config.Endpoint,
+			"models", len(tested))
+		response.Details = fmt.Sprintf("Successfully connected to AI provider. Tested %d model(s).", len(tested))
+	}
+	return response, nil
+}
+
+// testAIModel sends a minimal test request to the model, an embedding request for the embedding models and a
+// request with an image for the vision models.
+func (s *APIV1Service) testAIModel(ctx context.Context, provider ai.Provider, config *AIConfig, test aiModelTest) *v1pb.TestAIConfigResponse_ModelResult {
+	result := &v1pb.TestAIConfigResponse_ModelResult{Operation: test.operation, Model: test.model}
 
 	// Create context with timeout (30 seconds for test to accommodate slower providers)
 	testCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	// Build messages
-	messages := []openai.ChatCompletionMessageParamUnion{
-		openai.UserMessage(testPrompt),
-	}
-
 	// Send test request to AI provider
-	slog.Info("Sending test request to AI provider", "endpoint", config.Endpoint)
-	chatCompletion, err := client.Chat.Completions.New(testCtx, openai.ChatCompletionNewParams{
-		Messages: messages,
-		Model:    openai.ChatModel(config.Model),
-	})
-
-	if err != nil {
-		// Parse error details
-		errorMsg := err.Error()
-		details := "Failed to connect to AI provider. Please check your configuration."
-
-		// Provide more specific error messages
-		if strings.Contains(errorMsg, "timeout") || strings.Contains(errorMsg, "deadline exceeded") {
-			details = "Request timed out. Please check your network connection and endpoint URL."
-		} else if strings.Contains(errorMsg, "401") || strings.Contains(errorMsg, "unauthorized") {
-			details = "Authentication failed. Please check your API key."
-		} else if strings.Contains(errorMsg, "404") || strings.Contains(errorMsg, "not found") {
-			details = "Endpoint not found. Please check your endpoint URL."
-		} else if strings.Contains(errorMsg, "429") || strings.Contains(errorMsg, "rate_limit") {
-			details = "Rate limit exceeded. Please try again later."
-		} else if strings.Contains(errorMsg, "model") {
-			details = "Invalid model name. Please check your model configuration."
+	slog.InfoContext(ctx, "Sending test request to AI provider", "provider", config.Provider, "endpoint", config.Endpoint, "model", test.model)
+	var responseLength int
+	var err error
+	switch test.kind {
+	case aiModelTestEmbedding:
+		var embeddings *ai.Embeddings
+		embeddings, err = provider.Embed(testCtx, &ai.EmbeddingRequest{Model: test.model, Input: []string{aiTestPrompt}})
+		if err == nil && len(embeddings.Vectors) > 0 {
+			responseLength = len(embeddings.Vectors[0])
+		}
+	default:
+		message := ai.Message{Role: ai.RoleUser, Content: aiTestPrompt}
+		if test.kind == aiModelTestVision {
+			message.Images = []ai.Image{{Type: "image/png", Data: aiTestImage}}
 		}
+		completionRequest := config.completionRequest([]ai.Message{message})
+		completionRequest.Model = test.model
+		var completion *ai.Completion
+		completion, err = provider.Complete(testCtx, completionRequest)
+		if err == nil {
+			responseLength = len(completion.Content)
+		}
+	}
 
-		slog.Error("AI config test failed", 
-			"user_id", user.ID, 
+	if err != nil {
+		slog.ErrorContext(ctx, "AI config test failed",
 			"endpoint", config.Endpoint,
-			"model", config.Model,
+			"model", test.model,
 			"error", err)
-
-		return &v1pb.TestAIConfigResponse{
-			Success:      false,
-			ErrorMessage: errorMsg,
-			Details:      details,
-		}, nil
+		result.ErrorMessage = err.Error()
+		result.Details = describeAITestError(err)
+		return result
 	}
 
 	// Validate response
-	if len(chatCompletion.Choices) == 0 {
-		return &v1pb.TestAIConfigResponse{
-			Success:      false,
-			ErrorMessage: "AI provider returned no response",
-			Details:      "The AI provider responded but did not return any content. This may indicate a configuration issue.",
-		}, nil
-	}
-
-	responseContent := chatCompletion.Choices[0].Message.Content
-	if responseContent == "" {
-		return &v1pb.TestAIConfigResponse{
-			Success:      false,
-			ErrorMessage: "AI provider returned empty content",
-			Details:      "The AI provider responded but the content was empty. This may indicate a configuration issue.",
-		}, nil
+	if responseLength == 0 {
+		result.ErrorMessage = "AI provider returned empty content"
+		result.Details = "The AI provider responded but the content was empty. This may indicate a configuration issue."
+		return result
 	}
 
-	// Test successful
-	slog.Info("AI config test successful", 
-		"user_id", user.ID, 
-		"endpoint", config.Endpoint,
-		"model", config.Model,
-		"response_length", len(responseContent))
+	result.Success = true
+	result.Details = fmt.Sprintf("Model: %s, Response length: %d", test.model, responseLength)
+	return result
+}
 
-	return &v1pb.TestAIConfigResponse{
-		Success: true,
-		Details: fmt.Sprintf("Successfully connected to AI provider. Model: %s, Response length: %d characters", 
-			config.Model, len(responseContent)),
-	}, nil
+// describeAITestError returns the likely cause of the error of a test request.
+func describeAITestError(err error) string {
+	switch ai.ClassifyError(err).Kind {
+	case ai.ErrorKindTimeout:
+		return "Request timed out. Please check your network connection and endpoint URL."
+	case ai.ErrorKindAuthentication:
+		return "Authentication failed. Please check your API key."
+	case ai.ErrorKindNotFound:
+		return "Endpoint or model not found. Please check your endpoint URL and model name."
+	case ai.ErrorKindRateLimit:
+		return "Rate limit exceeded. Please try again later."
+	case ai.ErrorKindInvalidRequest:
+		return "The request was refused. Please check your model configuration."
+	default:
+		return "Failed to connect to AI provider. Please check your configuration."
+	}
 }
 
 // GetMemoSourceMemos retrieves the source memos that were used to generate an AI summary.
@@ -619,23 +943,18 @@ func (s *APIV1Service) GetMemoSourceMemos(ctx context.Context, request *v1pb.Get
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
-	if currentUser == nil {
-		// For unauthenticated users, only allow public memos
-		if memo.Visibility != store.Public {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
-		}
-	} else if memo.CreatorID != currentUser.ID {
-		// For authenticated users, check visibility
-		if memo.Visibility == store.Private {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
-		}
+	canView, err := s.canViewMemo(ctx, currentUser, memo)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
 	// Query memo relations to get source memo IDs
-	referenceType := store.MemoRelationReference
 	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
-		MemoID: &memo.ID,
-		Type:   &referenceType,
+		MemoID:   &memo.ID,
+		TypeList: store.MemoRelationSourceTypes,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list memo relations")
@@ -657,7 +976,7 @@ func (s *APIV1Service) GetMemoSourceMemos(ctx context.Context, request *v1pb.Get
 
 	// Batch query source memos
 	normalStatus := store.Normal
-	sourceMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+	relatedMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{
 		IDList:    sourceMemoIDs,
 		RowStatus: &normalStatus,
 	})
@@ -665,54 +984,28 @@ func (s *APIV1Service) GetMemoSourceMemos(ctx context.Context, request *v1pb.Get
 		return nil, errors.Wrap(err, "failed to query source memos")
 	}
 
-	// Get reactions and attachments for all source memos
-	reactionMap := make(map[int32][]*store.Reaction)
-	attachmentMap := make(map[int32][]*store.Attachment)
-
-	if len(sourceMemos) > 0 {
-		// Query reactions for each source memo
-		for _, m := range sourceMemos {
-			memoName := fmt.Sprintf("%s%s", MemoNamePrefix, m.UID)
-			reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
-				ContentID: &memoName,
-			})
-			if err != nil {
-				slog.Warn("failed to list reactions", "memo_id", m.ID, "error", err)
-			} else {
-				reactionMap[m.ID] = reactions
-			}
+	// Seeing the AI memo does not grant access to its sources, they are filtered by the access of the user.
+	sourceMemos := make([]*store.Memo, 0, len(relatedMemos))
+	for _, relatedMemo := range relatedMemos {
+		canView, err := s.canViewMemo(ctx, currentUser, relatedMemo)
+		if err != nil {
+			return nil, err
 		}
-
-		// Query attachments for each source memo
-		for _, m := range sourceMemos {
-			attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
-				MemoID: &m.ID,
-			})
-			if err != nil {
-				slog.Warn("failed to list attachments", "memo_id", m.ID, "error", err)
-				continue
-			}
-			attachmentMap[m.ID] = attachments
+		if canView {
+			sourceMemos = append(sourceMemos, relatedMemo)
 		}
 	}
+	hiddenCount := int32(len(relatedMemos) - len(sourceMemos))
 
-	// Convert to protobuf messages
-	memoMessages := make([]*v1pb.Memo, 0, len(sourceMemos))
-	for _, sourceMemo := range sourceMemos {
-		reactions := reactionMap[sourceMemo.ID]
-		attachments := attachmentMap[sourceMemo.ID]
-
-		memoMessage, err := s.convertMemoFromStore(ctx, sourceMemo, reactions, attachments)
-		if err != nil {
-			slog.Warn("failed to convert memo", "memo_id", sourceMemo.ID, "error", err)
-			continue
-		}
-		memoMessages = append(memoMessages, memoMessage)
+	memoMessages, err := s.convertMemosFromStore(ctx, sourceMemos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert source memos")
 	}
 
 	return &v1pb.GetMemoSourceMemosResponse{
-		Memos:     memoMessages,
-		TotalSize: int32(len(memoMessages)),
+		Memos:       memoMessages,
+		TotalSize:   int32(len(memoMessages)),
+		HiddenCount: hiddenCount,
 	}, nil
 }
 
@@ -727,63 +1020,190 @@ func (s *APIV1Service) GenerateAISummary(ctx context.Context, request *v1pb.Gene
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
 
-	// Check rate limit
-	if err := s.checkRateLimit(ctx, user.ID); err != nil {
-		return nil, err
+	// The retries of a request return its memo, without counting against the rate limit.
+	if request.IdempotencyKey != "" {
+		memo, err := s.claimAISummaryIdempotencyKey(ctx, user.ID, request)
+		if err != nil {
+			return nil, err
+		}
+		if memo != nil {
+			memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, nil)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to convert memo: %v", err)
+			}
+			return memoMessage, nil
+		}
 	}
 
-	// Get AI configuration
-	config, err := s.getAIConfig(ctx)
+	// Reserve the request in the rate limits before calling the provider
+	reservation, err := s.reserveAIRequest(ctx, user)
 	if err != nil {
+		if request.IdempotencyKey != "" {
+			s.completeAISummaryIdempotencyKey(ctx, user.ID, request.IdempotencyKey, nil, err)
+		}
 		return nil, err
 	}
+	defer reservation.release(ctx)
 
-	// Query source memos
-	sourceMemos, err := s.querySourceMemos(ctx, user.ID, request)
+	memoMessage, err := s.generateAISummary(ctx, user, request)
+	if request.IdempotencyKey != "" {
+		s.completeAISummaryIdempotencyKey(ctx, user.ID, request.IdempotencyKey, memoMessage, err)
+	}
 	if err != nil {
+		// Keep failures of the generation itself for retry, not the invalid requests.
+		if status.Code(err) == codes.Internal {
+			s.recordAISummaryDeadLetter(context.WithoutCancel(ctx), user.ID, request, err)
+		}
 		return nil, err
 	}
+	// The summaries returned from the cache do not call the provider.
+	if memoMessage.AiSummaryCached {
+		return memoMessage, nil
+	}
 
-	slog.Info("queried source memos for AI summary", 
-		"user_id", user.ID, 
-		"count", len(sourceMemos),
-		"time_range", request.TimeRange)
+	// Keep the request counted in the rate limits
+	reservation.commit()
+
+	return memoMessage, nil
+}
 
-	// Build prompt
-	prompt, err := s.buildPrompt(ctx, sourceMemos, config.SystemPrompt)
+// generateAISummary summarizes the user's memos selected by the request into a new AI memo.
+func (s *APIV1Service) generateAISummary(ctx context.Context, user *store.User, request *v1pb.GenerateAISummaryRequest) (_ *v1pb.Memo, err error) {
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationSummary)
+	auditLog := newAISummaryAuditLog(user.ID, aiOperationSummary, request)
+	defer func() {
+		s.recordAIAuditLog(ctx, auditLog, err)
+	}()
+	prepared, err := s.prepareAISummary(ctx, user, request)
 	if err != nil {
 		return nil, err
 	}
+	auditLog.sourceMemos = prepared.sourceMemos
+	auditLog.setProvider(prepared.config)
+	if prepared.cached != nil {
+		auditLog.setParameter("cached", "true")
+		return s.convertCachedAISummary(ctx, prepared.cached)
+	}
+	config, sourceMemos, prompt := prepared.config, prepared.sourceMemos, prepared.prompt
 
 	// Call AI API with retry logic
-	summary, err := s.callAIWithRetry(ctx, config, prompt)
+	summary, err := s.callAIWithRetry(ctx, config, newAISummaryMessages(config, prompt))
 	if err != nil {
-		slog.Error("failed to generate AI summary", 
+		slog.ErrorContext(ctx, "failed to generate AI summary", 
 			"user_id", user.ID, 
 			"error", err)
-		return nil, status.Errorf(codes.Internal, "failed to generate AI summary: %v", err)
+		err = aiCallError(err, "failed to generate AI summary")
+		s.dispatchAISummaryWebhook(ctx, user.ID, aiSummaryFailedActivityType, request, len(sourceMemos), nil, err)
+		return nil, err
 	}
 
-	slog.Info("AI summary generated successfully", 
+	slog.InfoContext(ctx, "AI summary generated successfully", 
 		"user_id", user.ID, 
 		"summary_length", len(summary))
 
-	// Create AI memo
-	aiMemo, err := s.createAIMemo(ctx, user.ID, summary, request.TimeRange, request.StartDate, request.EndDate)
+	summary = prepared.redactor.restore(summary)
+	memoMessage, err := s.saveAISummary(ctx, user, request, summary, config.Model, sourceMemos, prepared.cacheKey)
+	if err != nil {
+		s.dispatchAISummaryWebhook(ctx, user.ID, aiSummaryFailedActivityType, request, len(sourceMemos), nil, err)
+		return nil, err
+	}
+	return memoMessage, nil
+}
+
+// preparedAISummary is a summary request ready to be sent to the AI provider, or answered from the cache.
+type preparedAISummary struct {
+	config *AIConfig
+	// sourceMemos are the source memos the prompt covers.
+	sourceMemos []*store.Memo
+	prompt      string
+	// redactor redacted the memos of the prompt, it restores the placeholders of the summary.
+	redactor *aiRedactor
+	// cacheKey is the key of the request in the cache of the summaries, empty when the cache is disabled.
+	cacheKey string
+	// cached is the AI memo of an identical request found in the cache, if any. The prompt is not built then.
+	cached *store.Memo
+}
+
+// prepareAISummary checks the user can generate the summary and builds its prompt from the source memos, unless the
+// summary of an identical request is in the cache.
+func (s *APIV1Service) prepareAISummary(ctx context.Context, user *store.User, request *v1pb.GenerateAISummaryRequest) (*preparedAISummary, error) {
+	if err := s.checkAIFeaturePermission(ctx, user, aiFeatureSummary); err != nil {
+		return nil, err
+	}
+	if err := s.checkAISummaryVisibility(ctx, request.Visibility); err != nil {
+		return nil, err
+	}
+	// Check the workspace usage limits, the summary uses AI tokens and creates a memo.
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return nil, err
+	}
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceMemos, 1); err != nil {
+		return nil, err
+	}
+
+	// Get AI configuration
+	config, err := s.getAIConfig(ctx, store.AIFeatureSummary)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create memo relations
-	if err := s.createMemoRelations(ctx, aiMemo.ID, sourceMemos); err != nil {
-		slog.Warn("failed to create some memo relations", "error", err)
-		// Don't fail the entire operation if relations fail
+	// Query source memos
+	sourceMemos, previousMemos, err := s.querySummaryMemos(ctx, user.ID, request)
+	if err != nil {
+		return nil, err
 	}
 
-	// Update rate limit counter
-	if err := s.updateRateLimit(ctx, user.ID); err != nil {
-		slog.Warn("failed to update rate limit counter", "error", err)
-		// Don't fail the operation if rate limit update fails
+	slog.Info("queried source memos for AI summary", 
+		"user_id", user.ID, 
+		"count", len(sourceMemos),
+		"time_range", request.TimeRange)
+
+	config, err = s.applyAIPromptTemplate(ctx, config, user.ID, request, sourceMemos)
+	if err != nil {
+		return nil, err
+	}
+	cacheKey, cached, err := s.getCachedAISummary(ctx, user.ID, config, request, sourceMemos, previousMemos)
+	if err != nil {
+		return nil, err
+	}
+	if cached != nil {
+		return &preparedAISummary{config: config, sourceMemos: sourceMemos, cacheKey: cacheKey, cached: cached}, nil
+	}
+
+	// Build prompt, summarizing the memos in chunks first if they do not fit in a single request
+	prompt, sourceMemos, redactor, err := s.buildSummaryPrompt(ctx, config, sourceMemos, previousMemos)
+	if err != nil {
+		return nil, err
+	}
+	s.dispatchAISummaryWebhook(ctx, user.ID, aiSummaryStartedActivityType, request, len(sourceMemos), nil, nil)
+	return &preparedAISummary{config: config, sourceMemos: sourceMemos, prompt: prompt, redactor: redactor, cacheKey: cacheKey}, nil
+}
+
+// checkAISummaryVisibility returns a PermissionDenied error for a public summary memo when the workspace disallows
+// the public memos.
+func (s *APIV1Service) checkAISummaryVisibility(ctx context.Context, visibility v1pb.Visibility) error {
+	if visibility != v1pb.Visibility_PUBLIC {
+		return nil
+	}
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace memo related setting: %v", err)
+	}
+	if workspaceMemoRelatedSetting.DisallowPublicVisibility {
+		return status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+	}
+	return nil
+}
+
+// saveAISummary creates the AI memo of the summary generated by the model, keeping it in the cache under the key, if any.
+func (s *APIV1Service) saveAISummary(ctx context.Context, user *store.User, request *v1pb.GenerateAISummaryRequest, summary string, model string, sourceMemos []*store.Memo, cacheKey string) (*v1pb.Memo, error) {
+	// Create AI memo
+	aiMemo, err := s.createAIMemo(ctx, user.ID, summary, model, request, sourceMemos)
+	if err != nil {
+		return nil, err
+	}
+	if cacheKey != "" {
+		s.cacheAISummary(ctx, user.ID, cacheKey, aiMemo.ID)
 	}
 
 	// Convert to protobuf and return
@@ -791,6 +1211,8 @@ func (s *APIV1Service) GenerateAISummary(ctx context.Context, request *v1pb.Gene
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert memo")
 	}
+	s.recordEvent(ctx, store.EventTypeAISummaryGenerated, user.ID, memoMessage.Name, memoMessage)
+	s.dispatchAISummaryWebhook(ctx, user.ID, aiSummarySucceededActivityType, request, len(sourceMemos), memoMessage, nil)
 
 	return memoMessage, nil
 }
@@ -0,0 +1,310 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/openai/openai-go/v2"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/ai"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// Maximum characters per speech request, the limit of the OpenAI speech API
+	maxSpeechChunkChars = 4096
+	// Maximum speech requests per synthesis
+	maxSpeechChunks = 10
+	// Speech request timeout
+	speechRequestTimeout = 60 * time.Second
+	// Voice used when the workspace has no default voice
+	defaultSpeechVoice = "alloy"
+)
+
+// getTTSConfig retrieves the text-to-speech configuration from workspace settings. An API key is
+// only required from the AI provider, local engines configured with their own endpoint may not need one.
+func (s *APIV1Service) getTTSConfig(ctx context.Context) (*AIConfig, string, error) {
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_AI_CONFIG.String(),
+	})
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get AI config from workspace setting")
+	}
+	aiSetting := workspaceSetting.GetAiSetting()
+	if aiSetting.GetTtsModel() == "" {
+		return nil, "", status.Errorf(codes.FailedPrecondition, "text-to-speech model is not configured")
+	}
+
+	config := &AIConfig{
+		Provider: ai.ProviderOpenAI,
+		Endpoint: aiSetting.TtsEndpoint,
+		APIKey:   aiSetting.ApiKey,
+		Model:    aiSetting.TtsModel,
+	}
+	if config.Endpoint == "" {
+		// Speech is synthesized through the OpenAI API, other providers need a separate endpoint.
+		if aiSetting.Provider != storepb.WorkspaceAISetting_PROVIDER_UNSPECIFIED && aiSetting.Provider != storepb.WorkspaceAISetting_OPENAI {
+			return nil, "", status.Errorf(codes.FailedPrecondition, "text-to-speech endpoint is required when the AI provider is not OpenAI compatible")
+		}
+		if aiSetting.Endpoint == "" {
+			return nil, "", status.Errorf(codes.FailedPrecondition, "AI endpoint is not configured")
+		}
+		if aiSetting.ApiKey == "" {
+			return nil, "", status.Errorf(codes.FailedPrecondition, "AI API key is not configured")
+		}
+		config.Endpoint = aiSetting.Endpoint
+	}
+	voice := aiSetting.TtsVoice
+	if voice == "" {
+		voice = defaultSpeechVoice
+	}
+	return config, voice, nil
+}
+
+// SynthesizeMemoAudio renders a memo or a daily digest to an audio attachment.
+func (s *APIV1Service) SynthesizeMemoAudio(ctx context.Context, request *v1pb.SynthesizeMemoAudioRequest) (*v1pb.Attachment, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if (request.Memo == "") == (request.DigestDate == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "exactly one of memo and digest_date is required")
+	}
+	if err := s.checkAIFeaturePermission(ctx, user, aiFeatureSpeech); err != nil {
+		return nil, err
+	}
+
+	reservation, err := s.reserveAIRequest(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	defer reservation.release(ctx)
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationSpeech)
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return nil, err
+	}
+	config, voice, err := s.getTTSConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if request.Voice != "" {
+		voice = request.Voice
+	}
+
+	var memo *store.Memo
+	var text, filename string
+	if request.Memo != "" {
+		memo, err = s.getAISourceMemo(ctx, user, request.Memo)
+		if err != nil {
+			return nil, err
+		}
+		if text, err = s.MarkdownService.GenerateSnippet([]byte(memo.Content), maxSpeechChunkChars*maxSpeechChunks); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to render memo content: %v", err)
+		}
+		filename = fmt.Sprintf("%s.mp3", memo.UID)
+	} else {
+		if text, err = s.buildDigestSpeechText(ctx, user.ID, request.DigestDate); err != nil {
+			return nil, err
+		}
+		filename = fmt.Sprintf("digest-%s.mp3", request.DigestDate)
+	}
+	if strings.TrimSpace(text) == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "there is no text to synthesize")
+	}
+	chunks := splitSpeechText(text, maxSpeechChunkChars)
+	if len(chunks) > maxSpeechChunks {
+		return nil, status.Errorf(codes.InvalidArgument, "text is too long to synthesize")
+	}
+
+	audio, err := s.synthesizeSpeech(ctx, config, voice, chunks)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to synthesize speech", "user_id", user.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to synthesize speech: %v", err)
+	}
+
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceStorage, int64(len(audio))); err != nil {
+		return nil, err
+	}
+	create := &store.Attachment{
+		UID:       shortuuid.New(),
+		CreatorID: user.ID,
+		Filename:  filename,
+		Type:      "audio/mpeg",
+		Size:      int64(len(audio)),
+		Blob:      audio,
+	}
+	if memo != nil && memo.CreatorID == user.ID {
+		create.MemoID = &memo.ID
+	}
+	if err := s.saveAttachmentBlob(ctx, create); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save attachment blob: %v", err)
+	}
+	attachment, err := s.Store.CreateAttachment(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
+	}
+	if memo != nil && create.MemoID != nil {
+		attachment.MemoUID = &memo.UID
+	}
+
+	reservation.commit()
+	return convertAttachmentFromStore(attachment), nil
+}
+
+// getAISourceMemo returns the memo sent to the AI provider, which must be visible to the user.
+func (s *APIV1Service) getAISourceMemo(ctx context.Context, user *store.User, name string) (*store.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	canView, err := s.canViewMemo(ctx, user, memo)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	visibilities, err := s.getAIMemoVisibilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(visibilities, memo.Visibility) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s memos cannot be sent to the AI provider", strings.ToLower(memo.Visibility.String()))
+	}
+	return memo, nil
+}
+
+// buildDigestSpeechText builds the text of a digest of the memos the user created on the date.
+func (s *APIV1Service) buildDigestSpeechText(ctx context.Context, userID int32, date string) (string, error) {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid digest_date format, expected YYYY-MM-DD")
+	}
+	visibilities, err := s.getAIMemoVisibilities(ctx)
+	if err != nil {
+		return "", err
+	}
+	normalStatus := store.Normal
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &normalStatus,
+		VisibilityList:  visibilities,
+		ExcludeComments: true,
+		Filters: []string{
+			fmt.Sprintf("created_ts >= %d", day.Unix()),
+			fmt.Sprintf("created_ts < %d", day.AddDate(0, 0, 1).Unix()),
+		},
+		OrderByTimeAsc: true,
+	})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	if len(memos) == 0 {
+		return "", status.Errorf(codes.NotFound, "no memos found on %s", date)
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("Your memos of %s.", day.Format("Monday, January 2, 2006")))
+	for i, memo := range memos {
+		snippet, err := s.MarkdownService.GenerateSnippet([]byte(memo.Content), maxSpeechChunkChars)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to render memo content: %v", err)
+		}
+		if snippet == "" {
+			continue
+		}
+		builder.WriteString(fmt.Sprintf("\n\nMemo %d. %s", i+1, snippet))
+	}
+	return builder.String(), nil
+}
+
+// synthesizeSpeech renders each chunk of text to MP3 and joins the results, MP3 frames can be concatenated.
+func (s *APIV1Service) synthesizeSpeech(ctx context.Context, config *AIConfig, voice string, chunks []string) ([]byte, error) {
+	client := createOpenAIClient(config)
+	audio := []byte{}
+	for _, chunk := range chunks {
+		data, err := func() ([]byte, error) {
+			timeoutCtx, cancel := context.WithTimeout(ctx, speechRequestTimeout)
+			defer cancel()
+			start := time.Now()
+			response, err := client.Audio.Speech.New(timeoutCtx, openai.AudioSpeechNewParams{
+				Input:          chunk,
+				Model:          openai.SpeechModel(config.Model),
+				Voice:          openai.AudioSpeechNewParamsVoice(voice),
+				ResponseFormat: openai.AudioSpeechNewParamsResponseFormatMP3,
+			}, requestIDOptions(ctx)...)
+			// The speech API does not report tokens.
+			s.recordAIUsage(ctx, config.Model, start, 0, 0, err)
+			if err != nil {
+				return nil, errors.Wrap(err, "speech API call failed")
+			}
+			defer response.Body.Close()
+			return io.ReadAll(io.LimitReader(response.Body, MaxUploadBufferSizeBytes))
+		}()
+		if err != nil {
+			return nil, err
+		}
+		audio = append(audio, data...)
+	}
+	if len(audio) == 0 {
+		return nil, errors.New("speech API returned no audio")
+	}
+	return audio, nil
+}
+
+// splitSpeechText splits the text into chunks of at most maxChars characters, at sentence ends
+// when possible and otherwise at spaces.
+func splitSpeechText(text string, maxChars int) []string {
+	chunks := []string{}
+	runes := []rune(strings.TrimSpace(text))
+	for len(runes) > maxChars {
+		cut := -1
+		for i := maxChars; i > maxChars/2; i-- {
+			if r := runes[i-1]; (r == '.' || r == '!' || r == '?' || r == '\n') && unicode.IsSpace(runes[i]) {
+				cut = i
+				break
+			}
+		}
+		if cut < 0 {
+			for i := maxChars; i > 0; i-- {
+				if unicode.IsSpace(runes[i]) {
+					cut = i
+					break
+				}
+			}
+		}
+		if cut <= 0 {
+			cut = maxChars
+		}
+		if chunk := strings.TrimSpace(string(runes[:cut])); chunk != "" {
+			chunks = append(chunks, chunk)
+		}
+		runes = []rune(strings.TrimSpace(string(runes[cut:])))
+	}
+	if len(runes) > 0 {
+		chunks = append(chunks, string(runes))
+	}
+	return chunks
+}
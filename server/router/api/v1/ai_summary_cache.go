@@ -0,0 +1,117 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// aiSummaryCacheKey returns the key of the summary request in the cache: the hash of the user, the model and prompt
+// of the configuration, the options of the request and the versions of the memos the prompt is built from.
+func aiSummaryCacheKey(userID int32, config *AIConfig, request *v1pb.GenerateAISummaryRequest, memoGroups ...[]*store.Memo) (string, error) {
+	// The idempotency keys identify the retries of a request, not its options.
+	request = proto.Clone(request).(*v1pb.GenerateAISummaryRequest)
+	request.IdempotencyKey = ""
+	requestBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request")
+	}
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%d\n%s\n%s\n%s\n%d\n", userID, config.Profile, config.Model, config.SystemPrompt, config.MaxTokens)
+	if config.Temperature != nil {
+		fmt.Fprintf(hash, "%g\n", *config.Temperature)
+	}
+	hash.Write(requestBytes)
+	for i, memos := range memoGroups {
+		fmt.Fprintf(hash, "\n%d:", i)
+		for _, memo := range memos {
+			// The update timestamps are in seconds, the content catches the edits made within the same second.
+			fmt.Fprintf(hash, "%d@%d:%q,", memo.ID, memo.UpdatedTs, memo.Content)
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// getCachedAISummary returns the cache key of the summary request and the AI memo of an identical request cached
+// within the TTL of the workspace, if any. The key is empty when the cache is disabled.
+func (s *APIV1Service) getCachedAISummary(ctx context.Context, userID int32, config *AIConfig, request *v1pb.GenerateAISummaryRequest, sourceMemos, previousMemos []*store.Memo) (string, *store.Memo, error) {
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	ttl := time.Duration(aiSetting.SummaryCacheTtlMinutes) * time.Minute
+	if ttl <= 0 {
+		return "", nil, nil
+	}
+	cacheKey, err := aiSummaryCacheKey(userID, config, request, sourceMemos, previousMemos)
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to build AI summary cache key: %v", err)
+	}
+
+	createdTsAfter := time.Now().Add(-ttl).Unix()
+	cache, err := s.Store.GetAISummaryCache(ctx, &store.FindAISummaryCache{
+		UserID:         userID,
+		CacheKey:       cacheKey,
+		CreatedTsAfter: &createdTsAfter,
+	})
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to get AI summary cache: %v", err)
+	}
+	if cache == nil {
+		return cacheKey, nil, nil
+	}
+	// The cached AI memo may have been archived or deleted since, the summary is generated again then.
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &cache.MemoID})
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to get cached AI memo: %v", err)
+	}
+	if memo == nil || memo.CreatorID != userID || memo.RowStatus != store.Normal {
+		return cacheKey, nil, nil
+	}
+	return cacheKey, memo, nil
+}
+
+// cacheAISummary keeps the AI memo generated for the request under its key, and drops the expired entries.
+// Failures are only logged, the summary has been generated already.
+func (s *APIV1Service) cacheAISummary(ctx context.Context, userID int32, cacheKey string, memoID int32) {
+	if err := s.Store.UpsertAISummaryCache(ctx, &store.AISummaryCache{
+		UserID:   userID,
+		CacheKey: cacheKey,
+		MemoID:   memoID,
+	}); err != nil {
+		slog.WarnContext(ctx, "failed to cache AI summary", "user_id", userID, "error", err)
+		return
+	}
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to get workspace AI setting", "error", err)
+		return
+	}
+	ttl := time.Duration(aiSetting.SummaryCacheTtlMinutes) * time.Minute
+	if err := s.Store.DeleteAISummaryCaches(ctx, &store.DeleteAISummaryCache{
+		CreatedTsBefore: time.Now().Add(-ttl).Unix(),
+	}); err != nil {
+		slog.WarnContext(ctx, "failed to delete expired AI summary caches", "error", err)
+	}
+}
+
+// convertCachedAISummary converts the cached AI memo of a summary request, flagged as cached.
+func (s *APIV1Service) convertCachedAISummary(ctx context.Context, memo *store.Memo) (*v1pb.Memo, error) {
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	memoMessage.AiSummaryCached = true
+	return memoMessage, nil
+}
@@ -0,0 +1,308 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/ai"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// Maximum source memos of a summary, summarized in chunks
+	maxSummarySourceMemos = 1000
+	// Minimum characters of memo content per summary request the AI setting may set
+	minAISummaryChunkSize = 1000
+	// Chunks the memos of a summary are split into when the AI setting does not set them
+	defaultAISummaryMaxChunks = 10
+	// Maximum chunks the AI setting may split the memos of a summary into
+	maxAISummaryChunks = 50
+)
+
+// aiSummaryChunkPrompt asks the model to summarize a chunk of the memos of a summary.
+const aiSummaryChunkPrompt = "You are summarizing a part of a larger set of memos, the parts are combined afterwards. " +
+	"List the main topics, key insights, decisions and action items of the following memos concisely, keeping the dates, " +
+	"names and figures. Write in the language the memos are written in, and keep placeholders such as [EMAIL_1] unchanged."
+
+// aiSummaryMergePrompt asks the model to merge the summaries of several chunks of the memos of a summary.
+const aiSummaryMergePrompt = "You are combining the summaries of parts of a larger set of memos. " +
+	"Merge the following summaries into a single concise list of the main topics, key insights, decisions and action items, " +
+	"keeping the dates, names and figures. Write in the language of the summaries, and keep placeholders such as [EMAIL_1] unchanged."
+
+// aiSummaryChunkedPrompt tells the model the summary is generated from the summaries of the parts of the memos.
+const aiSummaryChunkedPrompt = "The memos were too many to be sent at once: they were summarized in parts, from the most recent. " +
+	"Write the summary of all the memos from the summaries of the parts below."
+
+// aiSummaryPrompter builds the prompts of the summaries with the redaction and the chunking of the AI setting.
+type aiSummaryPrompter struct {
+	redactor *aiRedactor
+	// chunkSize is the maximum number of characters of memo content per request, none when 0.
+	chunkSize int
+	// chunkTokens is the maximum number of tokens of memo content per request, fitting in the context window.
+	chunkTokens int
+	// maxChunks is the maximum number of chunks the memos are split into.
+	maxChunks int
+	// attachmentTexts are the formatted texts of the attachments of the memos by memo ID, when the summaries
+	// include them.
+	attachmentTexts map[int32]string
+}
+
+// aiSummaryChunk is a part of the memos of a summary that fits in a single request.
+type aiSummaryChunk struct {
+	content string
+	// size is the size of the memo content of the chunk.
+	size  aiPromptSize
+	memos []*store.Memo
+}
+
+// aiPromptSize is the size of memo content in a prompt, in characters and in estimated tokens.
+type aiPromptSize struct {
+	chars  int
+	tokens int
+}
+
+func newAIPromptSize(content string) aiPromptSize {
+	return aiPromptSize{chars: len(content), tokens: ai.EstimateTokens(content)}
+}
+
+func (s aiPromptSize) add(other aiPromptSize) aiPromptSize {
+	return aiPromptSize{chars: s.chars + other.chars, tokens: s.tokens + other.tokens}
+}
+
+// newAISummaryPrompter returns the prompter of the summaries of the memos, the previous ones included, fitting the
+// memo content in the context window of the model of the configuration.
+func (s *APIV1Service) newAISummaryPrompter(ctx context.Context, config *AIConfig, memos []*store.Memo) (*aiSummaryPrompter, error) {
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	redactor, err := newAIRedactor(aiSetting.Redaction)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid AI redaction setting: %v", err)
+	}
+	attachmentTexts, err := s.listPromptAttachmentTexts(ctx, aiSetting, memos)
+	if err != nil {
+		return nil, err
+	}
+	prompter := &aiSummaryPrompter{
+		redactor:        redactor,
+		chunkSize:       int(aiSetting.SummaryChunkSize),
+		chunkTokens:     config.promptTokens(config.SystemPrompt, getDefaultSystemPrompt()),
+		maxChunks:       int(aiSetting.SummaryMaxChunks),
+		attachmentTexts: attachmentTexts,
+	}
+	if prompter.maxChunks <= 0 {
+		prompter.maxChunks = defaultAISummaryMaxChunks
+	}
+	prompter.maxChunks = min(prompter.maxChunks, maxAISummaryChunks)
+	return prompter, nil
+}
+
+// fits reports whether memo content of the size fits in a single request.
+func (p *aiSummaryPrompter) fits(size aiPromptSize) bool {
+	return (p.chunkSize <= 0 || size.chars <= p.chunkSize) && size.tokens <= p.chunkTokens
+}
+
+// truncate truncates the content to fit in a single request, on a character boundary.
+func (p *aiSummaryPrompter) truncate(content string) string {
+	if p.chunkSize > 0 {
+		content = truncateAISummaryContent(content, p.chunkSize)
+	}
+	// Cut the content in proportion to its excess of tokens until it fits.
+	for tokens := ai.EstimateTokens(content); tokens > p.chunkTokens; tokens = ai.EstimateTokens(content) {
+		content = truncateAISummaryContent(content, len(content)*p.chunkTokens/tokens)
+	}
+	return content
+}
+
+// chunk splits the redacted content of the memos, most recent first, into chunks fitting in a single request
+// keeping the memos whole, except the ones larger than a chunk which are truncated. The chunks beyond maxChunks,
+// the ones of the oldest memos, are left out.
+func (p *aiSummaryPrompter) chunk(memos []*store.Memo) []*aiSummaryChunk {
+	chunks := []*aiSummaryChunk{}
+	current := &aiSummaryChunk{}
+	var contentBuilder strings.Builder
+	for i, memo := range memos {
+		content := p.memoContent(memo)
+		if content == "" {
+			current.memos = append(current.memos, memo)
+			continue
+		}
+		// Redact the content before it leaves the server.
+		content = fmt.Sprintf("[Memo %d]\n%s\n\n", i+1, p.truncate(p.redactor.redact(content)))
+		size := newAIPromptSize(content)
+		if current.size.chars > 0 && !p.fits(current.size.add(size)) {
+			current.content = contentBuilder.String()
+			chunks = append(chunks, current)
+			current = &aiSummaryChunk{}
+			contentBuilder.Reset()
+		}
+		current.size = current.size.add(size)
+		current.memos = append(current.memos, memo)
+		contentBuilder.WriteString(content)
+	}
+	if current.size.chars > 0 {
+		current.content = contentBuilder.String()
+		chunks = append(chunks, current)
+	}
+	if len(chunks) > p.maxChunks {
+		slog.Warn("AI summary memos exceed the maximum number of chunks, leaving out the oldest ones",
+			"max_chunks", p.maxChunks,
+			"chunks", len(chunks))
+		chunks = chunks[:p.maxChunks]
+	}
+	return chunks
+}
+
+// memoContent returns the content of the memo for the prompts, followed by the text of its attachments when the
+// summaries include them.
+func (p *aiSummaryPrompter) memoContent(memo *store.Memo) string {
+	return strings.TrimSpace(strings.TrimSpace(memo.Content) + p.attachmentTexts[memo.ID])
+}
+
+// prompt returns the prompt of the summary of the memos from their formatted content, with the previous memos,
+// if any, fitting in the rest of the chunk.
+func (p *aiSummaryPrompter) prompt(systemPrompt string, memos []*store.Memo, memoContent string, previousMemos []*store.Memo, totalSize *aiPromptSize) string {
+	if len(previousMemos) > 0 {
+		previousMemoContent := p.formatMemos(previousMemos, totalSize)
+		memoContent = fmt.Sprintf("Memos of the previous period:\n\n%sMemos of the current period:\n\n%s", previousMemoContent, memoContent)
+	}
+
+	// Use custom system prompt if provided, otherwise use default
+	if systemPrompt == "" {
+		systemPrompt = getDefaultSystemPrompt()
+	}
+	// Answer in the language the memos are written in rather than the language of the prompt.
+	if memoLanguage := getDominantMemoLanguage(memos); memoLanguage != "" {
+		systemPrompt = fmt.Sprintf("%s\n\nWrite the summary in %s.", systemPrompt, memoLanguage)
+	}
+	if len(previousMemos) > 0 {
+		systemPrompt += "\n\n" + aiSummaryComparePrompt
+	}
+	if p.redactor.redacted() {
+		systemPrompt += "\n\nSome content has been replaced with placeholders such as [EMAIL_1]. Keep the placeholders unchanged."
+	}
+
+	return fmt.Sprintf("%s\n\n%s", systemPrompt, memoContent)
+}
+
+// buildSummaryPrompt builds the prompt of the summary of the source memos, and returns the memos it covers. The memos
+// that do not fit in a single chunk are summarized chunk by chunk first, then the summaries of the chunks are merged
+// until they fit in one, and the prompt asks for the summary of the summaries. The redactor of the prompt is returned
+// to restore the placeholders of the summary.
+func (s *APIV1Service) buildSummaryPrompt(ctx context.Context, config *AIConfig, memos []*store.Memo, previousMemos []*store.Memo) (string, []*store.Memo, *aiRedactor, error) {
+	if len(memos) == 0 {
+		return "", nil, nil, status.Errorf(codes.InvalidArgument, "no memos provided for summarization")
+	}
+	prompter, err := s.newAISummaryPrompter(ctx, config, slices.Concat(memos, previousMemos))
+	if err != nil {
+		return "", nil, nil, err
+	}
+	chunks := prompter.chunk(memos)
+	if len(chunks) == 0 {
+		return "", nil, nil, status.Errorf(codes.InvalidArgument, "all memos are empty")
+	}
+	if len(chunks) == 1 {
+		totalSize := chunks[0].size
+		return prompter.prompt(config.SystemPrompt, chunks[0].memos, chunks[0].content, previousMemos, &totalSize), chunks[0].memos, prompter.redactor, nil
+	}
+
+	coveredMemos := []*store.Memo{}
+	summaries := make([]string, 0, len(chunks))
+	for _, chunk := range chunks {
+		summary, err := s.summarizeAISummaryPart(ctx, config, aiSummaryChunkPrompt, chunk.content)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		summaries = append(summaries, summary)
+		coveredMemos = append(coveredMemos, chunk.memos...)
+	}
+	slog.InfoContext(ctx, "summarized AI summary memos in chunks", "chunks", len(chunks), "memos", len(coveredMemos))
+
+	// Merge the summaries of the chunks until they fit in a single one.
+	for len(summaries) > 1 && !prompter.fits(newAIPromptSize(formatAISummaryParts(summaries))) {
+		merged := []string{}
+		for _, group := range prompter.groupParts(summaries) {
+			if len(group) == 1 {
+				merged = append(merged, group[0])
+				continue
+			}
+			summary, err := s.summarizeAISummaryPart(ctx, config, aiSummaryMergePrompt, formatAISummaryParts(group))
+			if err != nil {
+				return "", nil, nil, err
+			}
+			merged = append(merged, summary)
+		}
+		summaries = merged
+	}
+
+	memoContent := aiSummaryChunkedPrompt + "\n\n" + formatAISummaryParts(summaries)
+	totalSize := newAIPromptSize(memoContent)
+	return prompter.prompt(config.SystemPrompt, coveredMemos, memoContent, previousMemos, &totalSize), coveredMemos, prompter.redactor, nil
+}
+
+// summarizeAISummaryPart summarizes a chunk of the memos of a summary, or the summaries of several chunks.
+func (s *APIV1Service) summarizeAISummaryPart(ctx context.Context, config *AIConfig, systemPrompt string, content string) (string, error) {
+	summary, err := s.completeAIWithRetry(ctx, config.summaryConfig(), []ai.Message{
+		{Role: ai.RoleSystem, Content: systemPrompt},
+		{Role: ai.RoleUser, Content: content},
+	})
+	if err != nil {
+		return "", err
+	}
+	summary = strings.TrimSpace(summary)
+	if summary == "" {
+		return "", status.Errorf(codes.Internal, "AI API returned empty content")
+	}
+	return summary, nil
+}
+
+// groupParts groups the summaries in groups fitting in a chunk, of at least two summaries so that merging them
+// always makes progress.
+func (p *aiSummaryPrompter) groupParts(summaries []string) [][]string {
+	groups := [][]string{}
+	group, size := []string{}, aiPromptSize{}
+	for _, summary := range summaries {
+		summarySize := newAIPromptSize(summary)
+		if len(group) >= 2 && !p.fits(size.add(summarySize)) {
+			groups = append(groups, group)
+			group, size = []string{}, aiPromptSize{}
+		}
+		group = append(group, summary)
+		size = size.add(summarySize)
+	}
+	if len(group) == 1 && len(groups) > 0 {
+		groups[len(groups)-1] = append(groups[len(groups)-1], group[0])
+	} else if len(group) > 0 {
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// formatAISummaryParts formats the summaries of the chunks for the prompt.
+func formatAISummaryParts(summaries []string) string {
+	var contentBuilder strings.Builder
+	for i, summary := range summaries {
+		contentBuilder.WriteString(fmt.Sprintf("[Part %d]\n%s\n\n", i+1, summary))
+	}
+	return contentBuilder.String()
+}
+
+// truncateAISummaryContent truncates the content to at most limit bytes, on a character boundary.
+func truncateAISummaryContent(content string, limit int) string {
+	if len(content) <= limit {
+		return content
+	}
+	content = content[:limit]
+	for len(content) > 0 && !utf8.ValidString(content) {
+		content = content[:len(content)-1]
+	}
+	return content
+}
@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// aiSummaryComparePrompt asks the model to contrast the memos of the current period with the previous ones.
+const aiSummaryComparePrompt = "Summarize the memos of the current period and highlight what changed since the previous period: " +
+	"the new topics, the items resolved or completed since, and the items still ongoing. " +
+	"The memos of the previous period are only context, do not summarize them on their own."
+
+// querySummaryMemos returns the source memos of the summary requested and, in compare mode, the memos of the previous period.
+func (s *APIV1Service) querySummaryMemos(ctx context.Context, userID int32, request *v1pb.GenerateAISummaryRequest) ([]*store.Memo, []*store.Memo, error) {
+	sourceMemos, err := s.querySourceMemos(ctx, userID, request)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !request.ComparePrevious {
+		return sourceMemos, nil, nil
+	}
+	startTime, endTime, err := parseAISummaryTimeRange(request)
+	if err != nil {
+		return nil, nil, err
+	}
+	if endTime == 0 {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "compare_previous requires a time range")
+	}
+	previousMemos, err := s.queryPreviousSourceMemos(ctx, userID, startTime, endTime, request.Tags)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(previousMemos) == 0 {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "no memos found in the previous period to compare with")
+	}
+	return sourceMemos, previousMemos, nil
+}
+
+// queryPreviousSourceMemos returns the memos a summary of the time range is compared with: the source memos of the
+// latest AI summary created before its end that precede the time range, or the memos of the period of the same
+// length preceding it if there are none.
+func (s *APIV1Service) queryPreviousSourceMemos(ctx context.Context, userID int32, startTime, endTime int64, tags []string) ([]*store.Memo, error) {
+	limit := 1
+	normalStatus := store.Normal
+	previousSummaries, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &userID,
+		RowStatus: &normalStatus,
+		Filters: []string{
+			fmt.Sprintf("content.contains(%q)", aiSummaryMarker),
+			fmt.Sprintf("created_ts < %d", endTime),
+		},
+		Limit: &limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list AI summaries: %v", err)
+	}
+	if len(previousSummaries) > 0 {
+		relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+			MemoID:   &previousSummaries[0].ID,
+			TypeList: store.MemoRelationSourceTypes,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+		}
+		sourceMemoIDs := make([]int32, 0, len(relations))
+		for _, relation := range relations {
+			sourceMemoIDs = append(sourceMemoIDs, relation.RelatedMemoID)
+		}
+		// Only the source memos preceding the time range are previous ones, the summary may cover the same period.
+		if len(sourceMemoIDs) > 0 {
+			previousMemos, err := s.listSourceMemos(ctx, userID, sourceMemoIDs, 0, startTime, tags, maxSourceMemos)
+			if err != nil {
+				return nil, err
+			}
+			if len(previousMemos) > 0 {
+				return previousMemos, nil
+			}
+		}
+	}
+	return s.listSourceMemos(ctx, userID, nil, 2*startTime-endTime, startTime, tags, maxSourceMemos)
+}
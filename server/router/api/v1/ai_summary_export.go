@@ -0,0 +1,258 @@
+package v1
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/archivecrypt"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// Maximum AI summaries per export
+	maxExportedAISummaries = 500
+	// Name of the file of an export mapping the AI summaries to their source memos
+	aiSummaryExportRelationsFile = "relations.json"
+)
+
+// aiSummaryExportRelation is an entry of the relations file of an export: an AI summary with its source memos.
+type aiSummaryExportRelation struct {
+	Summary     string                `json:"summary"`
+	File        string                `json:"file"`
+	SourceMemos []aiSummaryExportFile `json:"source_memos"`
+}
+
+type aiSummaryExportFile struct {
+	Name string `json:"name"`
+	File string `json:"file"`
+}
+
+// ExportAISummaries exports the AI summaries of the current user with their source memos as a zip: a Markdown file
+// with front matter per summary in summaries/, one per source memo in memos/, and the relations file. The zip is
+// encrypted with the passphrase of the request if any.
+func (s *APIV1Service) ExportAISummaries(ctx context.Context, request *v1pb.ExportAISummariesRequest) (*httpbody.HttpBody, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := validateArchiveEncryption(request.Encryption, request.Passphrase); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid encryption: %v", err)
+	}
+
+	summaryFind := &store.FindMemo{
+		CreatorID: &user.ID,
+		Filters:   []string{"is_ai_generated"},
+	}
+	if request.Filter != "" {
+		if err := s.validateFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		summaryFind.Filters = append(summaryFind.Filters, request.Filter)
+	}
+	for _, name := range request.Names {
+		uid, err := ExtractMemoUIDFromName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+		}
+		summaryFind.UIDList = append(summaryFind.UIDList, uid)
+	}
+	limit := maxExportedAISummaries + 1
+	summaryFind.Limit = &limit
+	summaries, err := s.Store.ListMemos(ctx, summaryFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list AI summaries: %v", err)
+	}
+	if len(summaries) > maxExportedAISummaries {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d AI summaries can be exported at once, narrow them down with a filter", maxExportedAISummaries)
+	}
+	if len(request.Names) > 0 && len(summaries) < len(summaryFind.UIDList) {
+		found := map[string]bool{}
+		for _, summary := range summaries {
+			found[summary.UID] = true
+		}
+		for i, uid := range summaryFind.UIDList {
+			if !found[uid] {
+				return nil, status.Errorf(codes.NotFound, "AI summary not found: %s", request.Names[i])
+			}
+		}
+	}
+
+	var buffer bytes.Buffer
+	zipPassphrase := ""
+	if request.Encryption == v1pb.ArchiveEncryption_ZIP_AES {
+		zipPassphrase = request.Passphrase
+	}
+	archive := archivecrypt.NewZipWriter(&buffer, zipPassphrase)
+	relations := make([]*aiSummaryExportRelation, 0, len(summaries))
+	exportedMemos := map[int32]bool{}
+	for _, summary := range summaries {
+		sourceMemos, err := s.listAISummaryExportSourceMemos(ctx, summary)
+		if err != nil {
+			return nil, err
+		}
+		relation := &aiSummaryExportRelation{
+			Summary:     fmt.Sprintf("%s%s", MemoNamePrefix, summary.UID),
+			File:        fmt.Sprintf("summaries/%s.md", summary.UID),
+			SourceMemos: make([]aiSummaryExportFile, 0, len(sourceMemos)),
+		}
+		for _, memo := range sourceMemos {
+			file := aiSummaryExportFile{Name: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID), File: fmt.Sprintf("memos/%s.md", memo.UID)}
+			relation.SourceMemos = append(relation.SourceMemos, file)
+			if exportedMemos[memo.ID] {
+				continue
+			}
+			exportedMemos[memo.ID] = true
+			if err := writeAISummaryExportFile(archive, file.File, memo.UpdatedTs, formatExportedSourceMemo(memo)); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to write the export: %v", err)
+			}
+		}
+		if err := writeAISummaryExportFile(archive, relation.File, summary.UpdatedTs, formatExportedAISummary(summary, relation.SourceMemos)); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to write the export: %v", err)
+		}
+		relations = append(relations, relation)
+	}
+	relationsJSON, err := json.MarshalIndent(relations, "", "  ")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal the relations: %v", err)
+	}
+	if err := writeAISummaryExportFile(archive, aiSummaryExportRelationsFile, time.Now().Unix(), string(relationsJSON)+"\n"); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write the export: %v", err)
+	}
+	if err := archive.Close(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write the export: %v", err)
+	}
+	if request.Encryption == v1pb.ArchiveEncryption_AGE {
+		data, err := encryptAgeArchive(buffer.Bytes(), request.Passphrase)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encrypt the export: %v", err)
+		}
+		return &httpbody.HttpBody{
+			ContentType: "application/octet-stream",
+			Data:        data,
+		}, nil
+	}
+	return &httpbody.HttpBody{
+		ContentType: "application/zip",
+		Data:        buffer.Bytes(),
+	}, nil
+}
+
+// listAISummaryExportSourceMemos lists the source memos of the AI summary, the archived ones included.
+func (s *APIV1Service) listAISummaryExportSourceMemos(ctx context.Context, summary *store.Memo) ([]*store.Memo, error) {
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoID:   &summary.ID,
+		TypeList: store.MemoRelationSourceTypes,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+	}
+	if len(relations) == 0 {
+		return nil, nil
+	}
+	sourceMemoIDs := make([]int32, 0, len(relations))
+	for _, relation := range relations {
+		sourceMemoIDs = append(sourceMemoIDs, relation.RelatedMemoID)
+	}
+	// The summaries only have memos of their creator as sources, the others are not exported.
+	sourceMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		IDList:    sourceMemoIDs,
+		CreatorID: &summary.CreatorID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list source memos: %v", err)
+	}
+	return sourceMemos, nil
+}
+
+// formatExportedAISummary returns the Markdown file of an exported AI summary: its generation metadata as front
+// matter, then the summary.
+func formatExportedAISummary(summary *store.Memo, sourceMemos []aiSummaryExportFile) string {
+	fields := [][2]any{
+		{"name", fmt.Sprintf("%s%s", MemoNamePrefix, summary.UID)},
+		{"generated", formatExportTime(summary.CreatedTs)},
+		{"updated", formatExportTime(summary.UpdatedTs)},
+	}
+	if source := summary.Payload.GetAiSummarySource(); source != nil {
+		if source.TimeRange != "" {
+			fields = append(fields, [2]any{"time_range", source.TimeRange})
+		}
+		if source.StartDate != "" || source.EndDate != "" {
+			fields = append(fields, [2]any{"start_date", source.StartDate}, [2]any{"end_date", source.EndDate})
+		}
+		if len(source.Tags) > 0 {
+			fields = append(fields, [2]any{"tags", source.Tags})
+		}
+		if source.Filter != "" {
+			fields = append(fields, [2]any{"filter", source.Filter})
+		}
+		if source.Model != "" {
+			fields = append(fields, [2]any{"model", source.Model})
+		}
+	}
+	names := make([]string, 0, len(sourceMemos))
+	for _, memo := range sourceMemos {
+		names = append(names, memo.Name)
+	}
+	fields = append(fields, [2]any{"source_memos", names})
+
+	content := summary.Content
+	if _, body, ok := splitAISummaryContent(summary.Content); ok {
+		content = body
+	}
+	return formatExportFrontMatter(fields) + strings.TrimSpace(content) + "\n"
+}
+
+// formatExportedSourceMemo returns the Markdown file of an exported source memo, with its metadata as front matter.
+func formatExportedSourceMemo(memo *store.Memo) string {
+	fields := [][2]any{
+		{"name", fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)},
+		{"created", formatExportTime(memo.CreatedTs)},
+		{"updated", formatExportTime(memo.UpdatedTs)},
+		{"visibility", string(memo.Visibility)},
+		{"state", string(memo.RowStatus)},
+		{"tags", append([]string{}, memo.Payload.GetTags()...)},
+	}
+	return formatExportFrontMatter(fields) + strings.TrimSpace(memo.Content) + "\n"
+}
+
+// formatExportFrontMatter returns the YAML front matter of the fields, with their values in JSON, which YAML reads.
+func formatExportFrontMatter(fields [][2]any) string {
+	var builder strings.Builder
+	builder.WriteString("---\n")
+	for _, field := range fields {
+		value, _ := json.Marshal(field[1])
+		builder.WriteString(fmt.Sprintf("%s: %s\n", field[0], value))
+	}
+	builder.WriteString("---\n\n")
+	return builder.String()
+}
+
+func formatExportTime(ts int64) string {
+	return time.Unix(ts, 0).UTC().Format(time.RFC3339)
+}
+
+func writeAISummaryExportFile(archive *archivecrypt.ZipWriter, name string, modifiedTs int64, content string) error {
+	writer, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Unix(modifiedTs, 0).UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write([]byte(content))
+	return err
+}
@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// aiIdempotencyKeyTTL is how long the idempotency keys of the summary requests are kept.
+	aiIdempotencyKeyTTL = 24 * time.Hour
+	// aiIdempotencyKeyPendingTimeout is the time after which a request still in progress is considered interrupted,
+	// e.g. by a restart of the server, and its key may be claimed again.
+	aiIdempotencyKeyPendingTimeout = 10 * time.Minute
+	aiIdempotencyKeyMaxLength      = 255
+)
+
+// aiSummaryRequestFingerprint returns the hash of the parameters of the summary request, without its idempotency key.
+func aiSummaryRequestFingerprint(request *v1pb.GenerateAISummaryRequest) (string, error) {
+	request = proto.Clone(request).(*v1pb.GenerateAISummaryRequest)
+	request.IdempotencyKey = ""
+	requestBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(requestBytes)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// claimAISummaryIdempotencyKey saves the idempotency key of the summary request as in progress. When the user sent a
+// request with the key already, it returns the memo of that request instead, or an error if the request is still in
+// progress or has other parameters.
+func (s *APIV1Service) claimAISummaryIdempotencyKey(ctx context.Context, userID int32, request *v1pb.GenerateAISummaryRequest) (*store.Memo, error) {
+	if len(request.IdempotencyKey) > aiIdempotencyKeyMaxLength {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency key must be at most %d characters", aiIdempotencyKeyMaxLength)
+	}
+	fingerprint, err := aiSummaryRequestFingerprint(request)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fingerprint request: %v", err)
+	}
+	expiredBefore := time.Now().Add(-aiIdempotencyKeyTTL).Unix()
+	if err := s.Store.DeleteAIIdempotencyKeys(ctx, &store.DeleteAIIdempotencyKey{CreatedTsBefore: &expiredBefore}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete expired idempotency keys: %v", err)
+	}
+
+	// A key released in between is claimed on the second attempt.
+	for range 2 {
+		created, err := s.Store.CreateAIIdempotencyKey(ctx, &store.AIIdempotencyKey{
+			UserID:      userID,
+			Key:         request.IdempotencyKey,
+			Fingerprint: fingerprint,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to save idempotency key: %v", err)
+		}
+		if created {
+			return nil, nil
+		}
+		key, err := s.Store.GetAIIdempotencyKey(ctx, &store.FindAIIdempotencyKey{UserID: userID, Key: request.IdempotencyKey})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get idempotency key: %v", err)
+		}
+		if key == nil {
+			continue
+		}
+		if key.Fingerprint != fingerprint {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key was used for a request with other parameters")
+		}
+		if key.MemoID == 0 {
+			if time.Since(time.Unix(key.CreatedTs, 0)) < aiIdempotencyKeyPendingTimeout {
+				return nil, status.Errorf(codes.Aborted, "a request with the idempotency key is in progress")
+			}
+			if err := s.deleteAIIdempotencyKey(ctx, userID, request.IdempotencyKey); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &key.MemoID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get AI memo: %v", err)
+		}
+		// The summary is generated again when its memo was deleted since.
+		if memo == nil || memo.CreatorID != userID {
+			if err := s.deleteAIIdempotencyKey(ctx, userID, request.IdempotencyKey); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		return memo, nil
+	}
+	return nil, status.Errorf(codes.Aborted, "a request with the idempotency key is in progress")
+}
+
+// completeAISummaryIdempotencyKey records the AI memo of the request of the idempotency key, or releases the key when
+// the request failed so that it can be retried.
+func (s *APIV1Service) completeAISummaryIdempotencyKey(ctx context.Context, userID int32, key string, memoMessage *v1pb.Memo, requestErr error) {
+	// The key is completed even if the client went away, its retry is the point.
+	ctx = context.WithoutCancel(ctx)
+	if requestErr != nil {
+		if err := s.deleteAIIdempotencyKey(ctx, userID, key); err != nil {
+			slog.Warn("failed to release idempotency key", "user_id", userID, "error", err)
+		}
+		return
+	}
+	memoUID, err := ExtractMemoUIDFromName(memoMessage.Name)
+	if err == nil {
+		var memo *store.Memo
+		memo, err = s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		if err == nil && memo != nil {
+			err = s.Store.UpdateAIIdempotencyKey(ctx, &store.UpdateAIIdempotencyKey{UserID: userID, Key: key, MemoID: memo.ID})
+		}
+	}
+	if err != nil {
+		slog.Warn("failed to complete idempotency key", "user_id", userID, "error", err)
+	}
+}
+
+func (s *APIV1Service) deleteAIIdempotencyKey(ctx context.Context, userID int32, key string) error {
+	if err := s.Store.DeleteAIIdempotencyKeys(ctx, &store.DeleteAIIdempotencyKey{UserID: &userID, Key: &key}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete idempotency key: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/ai"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// PreviewAISummary builds the prompt of an AI summary and estimates its cost without calling the AI provider.
+func (s *APIV1Service) PreviewAISummary(ctx context.Context, request *v1pb.GenerateAISummaryRequest) (*v1pb.AISummaryPreview, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := s.checkAIFeaturePermission(ctx, user, aiFeatureSummary); err != nil {
+		return nil, err
+	}
+
+	config, err := s.getAIConfig(ctx, store.AIFeatureSummary)
+	if err != nil {
+		return nil, err
+	}
+	sourceMemos, previousMemos, err := s.querySummaryMemos(ctx, user.ID, request)
+	if err != nil {
+		return nil, err
+	}
+	config, err = s.applyAIPromptTemplate(ctx, config, user.ID, request, sourceMemos)
+	if err != nil {
+		return nil, err
+	}
+	prompter, err := s.newAISummaryPrompter(ctx, config, slices.Concat(sourceMemos, previousMemos))
+	if err != nil {
+		return nil, err
+	}
+	var prompt string
+	var promptTokens, completionTokens int
+	summaryCompletionTokens := config.completionTokens()
+	chunks := prompter.chunk(sourceMemos)
+	if len(chunks) > 1 {
+		// The chunks are summarized first, the summary is then generated from their summaries.
+		chunkPrompts := make([]string, 0, len(chunks))
+		sourceMemos = []*store.Memo{}
+		for _, chunk := range chunks {
+			chunkPrompt := fmt.Sprintf("%s\n\n%s", aiSummaryChunkPrompt, chunk.content)
+			chunkPrompts = append(chunkPrompts, chunkPrompt)
+			promptTokens += ai.EstimateTokens(chunkPrompt)
+			sourceMemos = append(sourceMemos, chunk.memos...)
+		}
+		prompt = strings.Join(chunkPrompts, "\n\n---\n\n")
+		promptTokens += ai.EstimateTokens(config.SystemPrompt+getDefaultSystemPrompt()+aiSummaryChunkedPrompt) + len(chunks)*summaryCompletionTokens
+		completionTokens = (len(chunks) + 1) * summaryCompletionTokens
+	} else {
+		prompt, _, err = s.buildPrompt(ctx, config, sourceMemos, previousMemos)
+		if err != nil {
+			return nil, err
+		}
+		// The system prompt is sent as its own message too.
+		if config.SystemPrompt != "" {
+			prompt = fmt.Sprintf("%s\n\n%s", config.SystemPrompt, prompt)
+		}
+		promptTokens, completionTokens = ai.EstimateTokens(prompt), summaryCompletionTokens
+	}
+
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	preview := &v1pb.AISummaryPreview{
+		Prompt:                    prompt,
+		SourceMemos:               make([]string, 0, len(sourceMemos)),
+		EstimatedPromptTokens:     int32(promptTokens),
+		EstimatedCompletionTokens: int32(completionTokens),
+		EstimatedCost:             (float64(promptTokens)*aiSetting.PromptTokenPrice + float64(completionTokens)*aiSetting.CompletionTokenPrice) / 1_000_000,
+	}
+	if len(chunks) > 1 {
+		preview.ChunkCount = int32(len(chunks))
+	}
+	for _, memo := range sourceMemos {
+		preview.SourceMemos = append(preview.SourceMemos, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID))
+	}
+	return preview, nil
+}
@@ -0,0 +1,212 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/ai"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// Maximum length of a refinement instruction
+	maxRefinementInstructionLength = 1000
+	// Maximum number of refinements of a summary, which keeps the conversation within the context of the model
+	maxAISummaryRefinements = 10
+	// Separator between the metadata and the summary of AI summary memos
+	aiSummarySeparator = "---\n\n"
+)
+
+// RefineAISummary re-generates an AI summary memo with a follow-up instruction.
+func (s *APIV1Service) RefineAISummary(ctx context.Context, request *v1pb.RefineAISummaryRequest) (_ *v1pb.Memo, err error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	instruction := strings.TrimSpace(request.Instruction)
+	if instruction == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "instruction is required")
+	}
+	if len(instruction) > maxRefinementInstructionLength {
+		return nil, status.Errorf(codes.InvalidArgument, "instruction must not exceed %d characters", maxRefinementInstructionLength)
+	}
+	if err := s.checkAIFeaturePermission(ctx, user, aiFeatureSummary); err != nil {
+		return nil, err
+	}
+
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	header, summary, ok := splitAISummaryContent(memo.Content)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "memo is not an AI summary")
+	}
+	if memo.Payload == nil {
+		memo.Payload = &storepb.MemoPayload{}
+	}
+	if len(memo.Payload.AiSummaryRefinements) >= maxAISummaryRefinements {
+		return nil, status.Errorf(codes.FailedPrecondition, "the summary cannot be refined more than %d times", maxAISummaryRefinements)
+	}
+
+	reservation, err := s.reserveAIRequest(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	defer reservation.release(ctx)
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationSummaryRefine)
+	auditLog := newAIAuditLog(user.ID, aiOperationSummaryRefine, map[string]string{"memo": request.Name})
+	defer func() {
+		s.recordAIAuditLog(ctx, auditLog, err)
+	}()
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return nil, err
+	}
+	config, err := s.getAIConfig(ctx, store.AIFeatureSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rebuild the original prompt from the source memos, which may have changed since.
+	sourceMemos, err := s.getAISummarySourceMemos(ctx, memo)
+	if err != nil {
+		return nil, err
+	}
+	if len(sourceMemos) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "the source memos of the summary no longer exist")
+	}
+	prompt, redactor, err := s.buildPrompt(ctx, config, sourceMemos, nil)
+	if err != nil {
+		return nil, err
+	}
+	auditLog.sourceMemos = sourceMemos
+	auditLog.setProvider(config)
+
+	// Replay the conversation: each previous summary followed by the instruction that refined it. The summaries are
+	// redacted too, their placeholders may have been restored.
+	messages := newAISummaryMessages(config, prompt)
+	for _, refinement := range memo.Payload.AiSummaryRefinements {
+		messages = append(messages,
+			ai.Message{Role: ai.RoleAssistant, Content: redactor.redactSummary(refinement.PreviousSummary)},
+			ai.Message{Role: ai.RoleUser, Content: refinement.Instruction},
+		)
+	}
+	messages = append(messages,
+		ai.Message{Role: ai.RoleAssistant, Content: redactor.redactSummary(summary)},
+		ai.Message{Role: ai.RoleUser, Content: instruction},
+	)
+	refined, err := s.callAIWithRetry(ctx, config, messages)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to refine AI summary",
+			"user_id", user.ID,
+			"memo", request.Name,
+			"error", err)
+		return nil, aiCallError(err, "failed to refine AI summary")
+	}
+	refined = redactor.restore(refined)
+
+	content := header + aiSummarySeparator + refined
+	if !strings.Contains(content, aiTag) {
+		content = content + "\n\n" + aiTag
+	}
+	memo.Content = content
+	memo.Payload.AiSummaryRefinements = append(memo.Payload.AiSummaryRefinements, &storepb.MemoPayload_AISummaryRefinement{
+		Instruction:     instruction,
+		PreviousSummary: summary,
+		CreatedTs:       time.Now().Unix(),
+	})
+	if memo.Payload.AiSummarySource != nil {
+		memo.Payload.AiSummarySource.Model = config.Model
+	}
+	if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+	}
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Content: &memo.Content,
+		Payload: memo.Payload,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+	}
+	reservation.commit()
+
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	s.recordEvent(ctx, store.EventTypeMemoUpdated, user.ID, memoMessage.Name, memoMessage)
+	return memoMessage, nil
+}
+
+// getAISummarySourceMemos returns the source memos referenced by the AI summary memo that can still be sent to the AI provider.
+func (s *APIV1Service) getAISummarySourceMemos(ctx context.Context, memo *store.Memo) ([]*store.Memo, error) {
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoID:   &memo.ID,
+		TypeList: store.MemoRelationSourceTypes,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+	}
+	if len(relations) == 0 {
+		return nil, nil
+	}
+	sourceMemoIDs := make([]int32, 0, len(relations))
+	for _, relation := range relations {
+		sourceMemoIDs = append(sourceMemoIDs, relation.RelatedMemoID)
+	}
+	visibilities, err := s.getAIMemoVisibilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	normalStatus := store.Normal
+	sourceMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		IDList:         sourceMemoIDs,
+		CreatorID:      &memo.CreatorID,
+		RowStatus:      &normalStatus,
+		VisibilityList: visibilities,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list source memos: %v", err)
+	}
+	return sourceMemos, nil
+}
+
+// splitAISummaryContent splits the content of an AI summary memo into its metadata header, ending before
+// the separator, and its summary without the AI tag. It reports false if the content is not an AI summary.
+func splitAISummaryContent(content string) (string, string, bool) {
+	if !strings.HasPrefix(content, aiSummaryMarker) {
+		return "", "", false
+	}
+	header, summary, found := strings.Cut(content, aiSummarySeparator)
+	if !found {
+		return "", "", false
+	}
+	summary = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(summary), aiTag))
+	return header, summary, true
+}
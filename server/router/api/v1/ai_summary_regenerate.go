@@ -0,0 +1,242 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// Maximum number of previous versions kept of a summary, the oldest ones are dropped
+const maxAISummaryVersions = 10
+
+// RegenerateAISummary re-runs an AI summary memo and keeps its previous content as a version.
+func (s *APIV1Service) RegenerateAISummary(ctx context.Context, request *v1pb.RegenerateAISummaryRequest) (_ *v1pb.Memo, err error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := s.checkAIFeaturePermission(ctx, user, aiFeatureSummary); err != nil {
+		return nil, err
+	}
+	memo, err := s.getAISummaryMemo(ctx, user, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	header, _, _ := splitAISummaryContent(memo.Content)
+	summaryRequest := newAISummaryRequestFromSource(memo.Payload.AiSummarySource)
+	if request.RefreshSourceMemos && summaryRequest == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "the time range of the summary is unknown, it can only be regenerated from its source memos")
+	}
+
+	reservation, err := s.reserveAIRequest(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	defer reservation.release(ctx)
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationSummaryRegenerate)
+	auditLog := newAIAuditLog(user.ID, aiOperationSummaryRegenerate, map[string]string{"memo": request.Name})
+	if request.RefreshSourceMemos {
+		auditLog.setParameter("refresh_source_memos", "true")
+	}
+	defer func() {
+		s.recordAIAuditLog(ctx, auditLog, err)
+	}()
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return nil, err
+	}
+	config, err := s.getAIConfig(ctx, store.AIFeatureSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	var sourceMemos, previousMemos []*store.Memo
+	if request.RefreshSourceMemos {
+		sourceMemos, previousMemos, err = s.querySummaryMemos(ctx, user.ID, summaryRequest)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		sourceMemos, err = s.getAISummarySourceMemos(ctx, memo)
+		if err != nil {
+			return nil, err
+		}
+		if len(sourceMemos) == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "the source memos of the summary no longer exist")
+		}
+	}
+	if summaryRequest != nil {
+		config, err = s.applyAIPromptTemplate(ctx, config, user.ID, summaryRequest, sourceMemos)
+		if err != nil {
+			return nil, err
+		}
+	}
+	prompt, sourceMemos, redactor, err := s.buildSummaryPrompt(ctx, config, sourceMemos, previousMemos)
+	if err != nil {
+		return nil, err
+	}
+	auditLog.sourceMemos = sourceMemos
+	auditLog.setProvider(config)
+
+	summary, err := s.callAIWithRetry(ctx, config, newAISummaryMessages(config, prompt))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to regenerate AI summary",
+			"user_id", user.ID,
+			"memo", request.Name,
+			"error", err)
+		return nil, aiCallError(err, "failed to regenerate AI summary")
+	}
+	summary = redactor.restore(summary)
+
+	// The summaries of the refreshed source memos get a new header, the others keep theirs.
+	content := header + aiSummarySeparator + summary
+	if request.RefreshSourceMemos {
+		content = formatAISummaryContent(summary, summaryRequest)
+	} else if !strings.Contains(content, aiTag) {
+		content = content + "\n\n" + aiTag
+	}
+	memo.Payload.AiSummaryVersions = append(memo.Payload.AiSummaryVersions, &storepb.MemoPayload_AISummaryVersion{
+		Content:    memo.Content,
+		ReplacedTs: time.Now().Unix(),
+	})
+	if len(memo.Payload.AiSummaryVersions) > maxAISummaryVersions {
+		memo.Payload.AiSummaryVersions = memo.Payload.AiSummaryVersions[len(memo.Payload.AiSummaryVersions)-maxAISummaryVersions:]
+	}
+	// The refinements were made on the previous summary, they are kept in its version.
+	memo.Payload.AiSummaryRefinements = nil
+	if memo.Payload.AiSummarySource != nil {
+		memo.Payload.AiSummarySource.Model = config.Model
+	}
+	memo.Content = content
+	if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+	}
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Content: &memo.Content,
+		Payload: memo.Payload,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+	}
+	if request.RefreshSourceMemos {
+		if err := s.replaceAISummarySourceMemos(ctx, memo, sourceMemos); err != nil {
+			return nil, err
+		}
+	}
+	reservation.commit()
+
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	s.recordEvent(ctx, store.EventTypeMemoUpdated, user.ID, memoMessage.Name, memoMessage)
+	return memoMessage, nil
+}
+
+// ListAIMemoVersions lists the previous versions of an AI summary memo, most recent first.
+func (s *APIV1Service) ListAIMemoVersions(ctx context.Context, request *v1pb.ListAIMemoVersionsRequest) (*v1pb.ListAIMemoVersionsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memo, err := s.getAISummaryMemo(ctx, user, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := memo.Payload.AiSummaryVersions
+	response := &v1pb.ListAIMemoVersionsResponse{
+		Versions: make([]*v1pb.AIMemoVersion, 0, len(versions)),
+	}
+	for i := len(versions) - 1; i >= 0; i-- {
+		response.Versions = append(response.Versions, &v1pb.AIMemoVersion{
+			Version:     int32(i + 1),
+			Content:     versions[i].Content,
+			ReplaceTime: timestamppb.New(time.Unix(versions[i].ReplacedTs, 0)),
+		})
+	}
+	return response, nil
+}
+
+// getAISummaryMemo returns the AI summary memo of the name, which must belong to the user.
+func (s *APIV1Service) getAISummaryMemo(ctx context.Context, user *store.User, name string) (*store.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if _, _, ok := splitAISummaryContent(memo.Content); !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "memo is not an AI summary")
+	}
+	if memo.Payload == nil {
+		memo.Payload = &storepb.MemoPayload{}
+	}
+	return memo, nil
+}
+
+// replaceAISummarySourceMemos replaces the relations of the AI summary memo with the source memos it now covers.
+func (s *APIV1Service) replaceAISummarySourceMemos(ctx context.Context, memo *store.Memo, sourceMemos []*store.Memo) error {
+	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
+		MemoID:   &memo.ID,
+		TypeList: store.MemoRelationSourceTypes,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete memo relations: %v", err)
+	}
+	for _, sourceMemo := range sourceMemos {
+		if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memo.ID,
+			RelatedMemoID: sourceMemo.ID,
+			Type:          store.MemoRelationSummaryOf,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to upsert memo relation: %v", err)
+		}
+	}
+	return nil
+}
+
+// newAISummaryRequestFromSource returns the request an AI summary was generated with, nil if it was not recorded.
+func newAISummaryRequestFromSource(source *storepb.MemoPayload_AISummarySource) *v1pb.GenerateAISummaryRequest {
+	if source == nil || (source.TimeRange == "" && len(source.MemoNames) == 0 && source.Filter == "") {
+		return nil
+	}
+	return &v1pb.GenerateAISummaryRequest{
+		TimeRange:       source.TimeRange,
+		Tags:            source.Tags,
+		StartDate:       source.StartDate,
+		EndDate:         source.EndDate,
+		ComparePrevious: source.ComparePrevious,
+		PromptTemplate:  source.PromptTemplate,
+		MemoNames:       source.MemoNames,
+		Filter:          source.Filter,
+		Timezone:        source.Timezone,
+	}
+}
@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// Streaming AI request timeout, the stream keeps the request alive while the provider generates
+const aiStreamRequestTimeout = 5 * time.Minute
+
+// StreamAISummary generates an AI summary of user's memos, sending the summary text as it is generated.
+func (s *APIV1Service) StreamAISummary(request *v1pb.GenerateAISummaryRequest, stream v1pb.AIService_StreamAISummaryServer) (err error) {
+	ctx := stream.Context()
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	reservation, err := s.reserveAIRequest(ctx, user)
+	if err != nil {
+		return err
+	}
+	defer reservation.release(ctx)
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationSummary)
+	auditLog := newAISummaryAuditLog(user.ID, aiOperationSummary, request)
+	defer func() {
+		s.recordAIAuditLog(ctx, auditLog, err)
+	}()
+
+	prepared, err := s.prepareAISummary(ctx, user, request)
+	if err != nil {
+		return err
+	}
+	auditLog.sourceMemos = prepared.sourceMemos
+	auditLog.setProvider(prepared.config)
+	if prepared.cached != nil {
+		auditLog.setParameter("cached", "true")
+		memoMessage, err := s.convertCachedAISummary(ctx, prepared.cached)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&v1pb.StreamAISummaryResponse{Memo: memoMessage})
+	}
+	config, sourceMemos, prompt := prepared.config, prepared.sourceMemos, prepared.prompt
+	summary, err := s.streamAICompletion(ctx, config, prompt, func(delta string) error {
+		return stream.Send(&v1pb.StreamAISummaryResponse{Delta: delta})
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to stream AI summary", "user_id", user.ID, "error", err)
+		err = status.Errorf(codes.Internal, "failed to generate AI summary: %v", err)
+		// Keep failures of the provider for retry, not the streams the client went away from.
+		if ctx.Err() == nil {
+			s.recordAISummaryDeadLetter(context.WithoutCancel(ctx), user.ID, request, err)
+		}
+		s.dispatchAISummaryWebhook(ctx, user.ID, aiSummaryFailedActivityType, request, len(sourceMemos), nil, err)
+		return err
+	}
+	if summary, err = validateAISummary(config, summary); err != nil {
+		s.dispatchAISummaryWebhook(ctx, user.ID, aiSummaryFailedActivityType, request, len(sourceMemos), nil, err)
+		return err
+	}
+
+	// The memo is only created once the whole summary has been received.
+	summary = prepared.redactor.restore(summary)
+	memoMessage, err := s.saveAISummary(ctx, user, request, summary, config.Model, sourceMemos, prepared.cacheKey)
+	if err != nil {
+		s.dispatchAISummaryWebhook(ctx, user.ID, aiSummaryFailedActivityType, request, len(sourceMemos), nil, err)
+		return err
+	}
+	reservation.commit()
+	return stream.Send(&v1pb.StreamAISummaryResponse{Memo: memoMessage})
+}
+
+// streamAICompletion streams the completion of the prompt, calling onDelta with each part of the
+// content as it arrives, and returns the whole content.
+func (s *APIV1Service) streamAICompletion(ctx context.Context, config *AIConfig, prompt string, onDelta func(string) error) (string, error) {
+	provider, err := s.createAIProvider(ctx, config)
+	if err != nil {
+		return "", err
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, aiStreamRequestTimeout)
+	defer cancel()
+
+	var sendErr error
+	completion, err := provider.Stream(timeoutCtx, config.summaryConfig().completionRequest(newAISummaryMessages(config, prompt)), func(delta string) error {
+		sendErr = onDelta(delta)
+		return sendErr
+	})
+	// Count the tokens against the workspace usage, even if the stream was interrupted.
+	if completion != nil {
+		if err := s.AddAITokenUsage(ctx, completion.TotalTokens); err != nil {
+			slog.WarnContext(ctx, "failed to update AI usage", "error", err)
+		}
+	}
+	if sendErr != nil {
+		return "", errors.Wrap(sendErr, "failed to send summary delta")
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "AI API stream failed")
+	}
+	return completion.Content, nil
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// SummarizeToday generates the AI summary of the memos the current user created today, from midnight in the timezone
+// of the request or of the user, with the tags and the visibility of their AI auto summary setting.
+func (s *APIV1Service) SummarizeToday(ctx context.Context, request *v1pb.SummarizeTodayRequest) (*v1pb.Memo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	timezone, location, err := s.getRequestLocation(ctx, request.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	aiAutoSummary, err := s.getAIAutoSummarySetting(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	today := time.Now().In(location).Format("2006-01-02")
+	return s.GenerateAISummary(ctx, &v1pb.GenerateAISummaryRequest{
+		TimeRange:      "custom",
+		StartDate:      today,
+		EndDate:        today,
+		Timezone:       timezone,
+		Tags:           aiAutoSummary.GetTags(),
+		Visibility:     convertAISummaryVisibilityFromStore(aiAutoSummary.GetVisibility()),
+		IdempotencyKey: request.IdempotencyKey,
+	})
+}
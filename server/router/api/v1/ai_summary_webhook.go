@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// The activity types of the webhooks of the AI summary generations.
+const (
+	aiSummaryStartedActivityType   = "memos.ai.summary.started"
+	aiSummarySucceededActivityType = "memos.ai.summary.succeeded"
+	aiSummaryFailedActivityType    = "memos.ai.summary.failed"
+)
+
+// dispatchAISummaryWebhook dispatches the webhooks of the user for a step of the generation of an AI summary: its
+// start once the source memos are selected, its success with the AI memo, or its failure with the error.
+// Dispatching is best effort, the generation does not fail on it.
+func (s *APIV1Service) dispatchAISummaryWebhook(ctx context.Context, userID int32, activityType string, request *v1pb.GenerateAISummaryRequest, sourceMemoCount int, memo *v1pb.Memo, generationErr error) {
+	// The generation may have failed on the cancellation of its context.
+	ctx = context.WithoutCancel(ctx)
+	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to get user webhooks", "user_id", userID, "error", err)
+		return
+	}
+	payload := &webhook.WebhookRequestPayload{
+		ActivityType: activityType,
+		Creator:      fmt.Sprintf("%s%d", UserNamePrefix, userID),
+		Memo:         memo,
+		AISummary: &webhook.AISummaryPayload{
+			TimeRange:       request.TimeRange,
+			StartDate:       request.StartDate,
+			EndDate:         request.EndDate,
+			Tags:            request.Tags,
+			SourceMemoCount: sourceMemoCount,
+		},
+	}
+	if generationErr != nil {
+		payload.AISummary.Error = generationErr.Error()
+	}
+	s.postUserWebhooks(ctx, userID, webhooks, payload)
+}
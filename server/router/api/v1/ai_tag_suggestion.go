@@ -0,0 +1,388 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/ai"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// Default and maximum number of tags suggested for a memo
+	defaultMemoTagSuggestions = 5
+	maxMemoTagSuggestions     = 10
+	// Maximum number of existing tags of the user sent with the memo, the most used ones
+	maxTagSuggestionExistingTags = 100
+	// Maximum length of the memo content sent for tag suggestions
+	maxTagSuggestionContentLength = 4000
+	// Timeout of the tag suggestions applied automatically, which delay saving the memo
+	autoTagTimeout = 15 * time.Second
+	// Default minimum similarity of two tags to suggest merging them
+	defaultTagMergeSimilarityThreshold = 0.85
+	// Maximum number of tags compared, the most used ones
+	maxTagMergeTags = 500
+	// Number of tags embedded by a single request to the provider
+	tagEmbeddingBatchSize = 100
+)
+
+// SuggestTagMerges suggests merging the near-duplicate tags of the user's memos into their most used one.
+func (s *APIV1Service) SuggestTagMerges(ctx context.Context, request *v1pb.SuggestTagMergesRequest) (*v1pb.SuggestTagMergesResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	threshold := request.SimilarityThreshold
+	if threshold < 0 || threshold > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "similarity threshold must be between 0 and 1")
+	}
+	if threshold == 0 {
+		threshold = defaultTagMergeSimilarityThreshold
+	}
+
+	config, err := s.getAIConfig(ctx, store.AIFeatureEmbedding)
+	if err != nil {
+		return nil, err
+	}
+	if config.EmbeddingModel == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "AI embedding model is not configured")
+	}
+	if err := s.checkAITokenBudget(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return nil, err
+	}
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationTagMerge)
+
+	tagCounts, err := s.listAITagCounts(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]string, 0, len(tagCounts))
+	for tag := range tagCounts {
+		tags = append(tags, tag)
+	}
+	// The most used tag of near-duplicates is kept, the shortest one on a tie.
+	slices.SortFunc(tags, func(a, b string) int {
+		return cmp.Or(cmp.Compare(tagCounts[b], tagCounts[a]), cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+	})
+	tags = tags[:min(len(tags), maxTagMergeTags)]
+	if len(tags) < 2 {
+		return &v1pb.SuggestTagMergesResponse{Suggestions: []*v1pb.SuggestTagMergesResponse_Suggestion{}}, nil
+	}
+
+	vectors := make([][]float32, 0, len(tags))
+	for start := 0; start < len(tags); start += tagEmbeddingBatchSize {
+		batch, err := s.embedTexts(ctx, config, tags[start:min(start+tagEmbeddingBatchSize, len(tags))])
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, batch...)
+	}
+
+	// Each tag is merged into the first, thus most used, tag similar enough to it that is not merged itself.
+	suggestions := []*v1pb.SuggestTagMergesResponse_Suggestion{}
+	merged := make([]bool, len(tags))
+	for i, target := range tags {
+		if merged[i] {
+			continue
+		}
+		for j := i + 1; j < len(tags); j++ {
+			tag := tags[j]
+			// A parent tag and its children are kept apart on purpose.
+			if merged[j] || isTagAncestor(target, tag) || isTagAncestor(tag, target) {
+				continue
+			}
+			similarity := store.CosineSimilarity(vectors[i], vectors[j])
+			if similarity < threshold {
+				continue
+			}
+			merged[j] = true
+			suggestions = append(suggestions, &v1pb.SuggestTagMergesResponse_Suggestion{
+				Tag:             tag,
+				TargetTag:       target,
+				Similarity:      similarity,
+				MemoCount:       tagCounts[tag],
+				TargetMemoCount: tagCounts[target],
+			})
+		}
+	}
+	slices.SortStableFunc(suggestions, func(a, b *v1pb.SuggestTagMergesResponse_Suggestion) int {
+		return cmp.Compare(b.Similarity, a.Similarity)
+	})
+	return &v1pb.SuggestTagMergesResponse{Suggestions: suggestions}, nil
+}
+
+// isTagAncestor reports whether the tag is an ancestor of the other one in the tag hierarchy, e.g. "work" of "work/meeting".
+func isTagAncestor(tag, other string) bool {
+	return strings.HasPrefix(other, tag+"/")
+}
+
+// tagSuggestionSystemPrompt asks for the tags of a memo, replied in the structure of tagSuggestionSchema.
+const tagSuggestionSystemPrompt = `You suggest tags for the user's personal notes, called memos.
+List the tags the most relevant first, without the leading "#".
+A tag is a word or words joined with "-", nested tags are separated with "/", e.g. "project/memos".
+Prefer the existing tags of the user when they fit the memo.`
+
+// tagSuggestionSchema is the JSON schema of the reply of the model to tagSuggestionSystemPrompt.
+var tagSuggestionSchema = &ai.JSONSchema{
+	Name: "memo_tags",
+	Schema: map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"tags": map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+		},
+		"required":             []string{"tags"},
+		"additionalProperties": false,
+	},
+}
+
+// SuggestMemoTags suggests tags for the content of a memo, preferring the tags the user already uses.
+func (s *APIV1Service) SuggestMemoTags(ctx context.Context, request *v1pb.SuggestMemoTagsRequest) (*v1pb.SuggestMemoTagsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if strings.TrimSpace(request.Content) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content is required")
+	}
+	limit := int(request.MaxSuggestions)
+	if limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max suggestions must not be negative")
+	}
+	if limit == 0 {
+		limit = defaultMemoTagSuggestions
+	}
+	limit = min(limit, maxMemoTagSuggestions)
+	if err := s.checkAIFeaturePermission(ctx, user, aiFeatureTagSuggestion); err != nil {
+		return nil, err
+	}
+	reservation, err := s.reserveAIRequest(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	defer reservation.release(ctx)
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationTagSuggestion)
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return nil, err
+	}
+	config, err := s.getAIConfig(ctx, store.AIFeatureTagSuggestion)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions, err := s.suggestMemoTags(ctx, config, user.ID, request.Content, limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to suggest memo tags",
+			"user_id", user.ID,
+			"error", err)
+		return nil, err
+	}
+	reservation.commit()
+	return &v1pb.SuggestMemoTagsResponse{Suggestions: suggestions}, nil
+}
+
+// applyAutoTags sets the AI tags of the memo saved by the user to the tags suggested for its content when the workspace
+// applies them automatically. It is best effort: the memo keeps its AI tags if the suggestion fails, so that saving it never fails.
+func (s *APIV1Service) applyAutoTags(ctx context.Context, user *store.User, memo *store.Memo) {
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil || !aiSetting.AutoTag || aiSetting.Disabled {
+		return
+	}
+	if memo.Payload == nil {
+		memo.Payload = &storepb.MemoPayload{}
+	}
+	if aiSetting.DisallowProtectedMemos && memo.Visibility == store.Protected {
+		return
+	}
+	if strings.TrimSpace(memo.Content) == "" {
+		memo.Payload.AiTags = nil
+		return
+	}
+	if err := s.checkAIFeaturePermission(ctx, user, aiFeatureTagSuggestion); err != nil {
+		return
+	}
+	if err := s.checkAITokenBudget(ctx, user); err != nil {
+		return
+	}
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return
+	}
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationAutoTag)
+	config, err := s.getAIConfig(ctx, store.AIFeatureTagSuggestion)
+	if err != nil {
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, autoTagTimeout)
+	defer cancel()
+	suggestions, err := s.suggestMemoTags(timeoutCtx, config, memo.CreatorID, memo.Content, defaultMemoTagSuggestions)
+	if err != nil {
+		slog.Warn("failed to suggest memo tags automatically", slog.Int("userID", int(user.ID)), slog.Any("err", err))
+		return
+	}
+	tags := make([]string, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		tags = append(tags, suggestion.Tag)
+	}
+	memo.Payload.AiTags = tags
+}
+
+// suggestMemoTags asks the model for the tags of the redacted content, given the existing tags of the user.
+// The suggestions exclude the tags already in the content, and list the existing tags first.
+func (s *APIV1Service) suggestMemoTags(ctx context.Context, config *AIConfig, userID int32, content string, limit int) ([]*v1pb.SuggestMemoTagsResponse_Suggestion, error) {
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	redactor, err := newAIRedactor(aiSetting.Redaction)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid AI redaction setting: %v", err)
+	}
+	tagCounts, err := s.listAITagCounts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	existingTags := make([]string, 0, len(tagCounts))
+	for tag := range tagCounts {
+		existingTags = append(existingTags, tag)
+	}
+	slices.SortFunc(existingTags, func(a, b string) int {
+		return cmp.Or(cmp.Compare(tagCounts[b], tagCounts[a]), strings.Compare(a, b))
+	})
+	existingTags = existingTags[:min(len(existingTags), maxTagSuggestionExistingTags)]
+	contentTags, err := s.MarkdownService.ExtractTags([]byte(content))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to extract tags: %v", err)
+	}
+
+	text := redactor.redact(strings.TrimSpace(content))
+	if len(text) > maxTagSuggestionContentLength {
+		text = text[:maxTagSuggestionContentLength]
+	}
+	var promptBuilder strings.Builder
+	if len(existingTags) > 0 {
+		promptBuilder.WriteString(fmt.Sprintf("Existing tags: %s\n\n", strings.Join(existingTags, ", ")))
+	}
+	promptBuilder.WriteString(fmt.Sprintf("Memo:\n%s\n\nSuggest up to %d tags.", text, limit))
+
+	provider, err := s.createAIProvider(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, aiRequestTimeout)
+	defer cancel()
+	var reply struct {
+		Tags []string `json:"tags"`
+	}
+	request := config.completionRequest([]ai.Message{
+		{Role: ai.RoleSystem, Content: tagSuggestionSystemPrompt},
+		{Role: ai.RoleUser, Content: promptBuilder.String()},
+	})
+	request.ResponseSchema = tagSuggestionSchema
+	completion, err := ai.CompleteJSON(timeoutCtx, provider, request, &reply)
+	// The tokens of the failed repair attempts are used too.
+	if completion != nil {
+		if err := s.AddAITokenUsage(ctx, completion.TotalTokens); err != nil {
+			slog.WarnContext(ctx, "failed to update AI usage", "error", err)
+		}
+	}
+	if err != nil {
+		return nil, aiCallError(err, "failed to suggest tags")
+	}
+
+	seen := map[string]bool{}
+	for _, tag := range contentTags {
+		seen[tag] = true
+	}
+	suggestions := []*v1pb.SuggestMemoTagsResponse_Suggestion{}
+	for _, suggested := range reply.Tags {
+		tag := s.normalizeSuggestedTag(suggested)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		_, existing := tagCounts[tag]
+		suggestions = append(suggestions, &v1pb.SuggestMemoTagsResponse_Suggestion{Tag: tag, Existing: existing})
+	}
+	slices.SortStableFunc(suggestions, func(a, b *v1pb.SuggestMemoTagsResponse_Suggestion) int {
+		if a.Existing == b.Existing {
+			return 0
+		}
+		if a.Existing {
+			return -1
+		}
+		return 1
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// normalizeSuggestedTag returns a tag suggested by the model as the memo content parser would extract it,
+// or an empty string if it is not a valid tag.
+func (s *APIV1Service) normalizeSuggestedTag(tag string) string {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+	if tag == "" || strings.ContainsAny(tag, " \t") {
+		return ""
+	}
+	tags, err := s.MarkdownService.ExtractTags([]byte("#" + tag))
+	if err != nil || len(tags) != 1 || !strings.EqualFold(tags[0], tag) {
+		return ""
+	}
+	return tags[0]
+}
+
+// listAITagCounts returns the number of memos of the user per tag, for the memos and tags that can be sent to the AI provider.
+func (s *APIV1Service) listAITagCounts(ctx context.Context, userID int32) (map[string]int32, error) {
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	redactor, err := newAIRedactor(aiSetting.Redaction)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid AI redaction setting: %v", err)
+	}
+	visibilities, err := s.getAIMemoVisibilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	normalStatus := store.Normal
+	memoTagCounts, err := s.Store.ListMemoTagCounts(ctx, &store.FindMemoTagCount{
+		CreatorID:      &userID,
+		RowStatus:      &normalStatus,
+		VisibilityList: visibilities,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo tag counts: %v", err)
+	}
+	tagCounts := map[string]int32{}
+	for _, memoTagCount := range memoTagCounts {
+		tagCounts[memoTagCount.Tag] = memoTagCount.Count
+	}
+	// The redacted tags are not sent to the AI provider.
+	for tag := range tagCounts {
+		if redactor.redact("#"+tag) != "#"+tag {
+			delete(tagCounts, tag)
+		}
+	}
+	return tagCounts, nil
+}
@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/ai"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// aiTransformFormatPrompt asks the model to keep the structure of the memo and to reply with the result only.
+const aiTransformFormatPrompt = "Keep the Markdown formatting, the #tags, the links and the placeholders such as [EMAIL_1] unchanged. " +
+	"Reply with the resulting memo only, without any introduction or comment."
+
+// aiTransformPrompts are the system prompts of the rewriting actions, the translation one depends on its language.
+var aiTransformPrompts = map[v1pb.TransformMemoRequest_Action]string{
+	v1pb.TransformMemoRequest_FIX_GRAMMAR: "Fix the grammar and spelling mistakes of the following memo without changing its meaning, tone or language.",
+	v1pb.TransformMemoRequest_EXPAND:      "Expand the following memo with more detail and explanation, keeping its meaning, tone and language.",
+	v1pb.TransformMemoRequest_CONDENSE:    "Condense the following memo to its essentials, keeping its meaning, tone and language.",
+}
+
+// TransformMemo translates or rewrites the content of a memo, and saves the result as a new memo if requested.
+func (s *APIV1Service) TransformMemo(ctx context.Context, request *v1pb.TransformMemoRequest) (*v1pb.TransformMemoResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	systemPrompt, targetLanguage, err := getAITransformPrompt(request)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAIFeaturePermission(ctx, user, aiFeatureTransform); err != nil {
+		return nil, err
+	}
+	memo, err := s.getAISourceMemo(ctx, user, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(memo.Content) == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "memo content is empty")
+	}
+
+	reservation, err := s.reserveAIRequest(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	defer reservation.release(ctx)
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationTransform)
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return nil, err
+	}
+	config, err := s.getAIConfig(ctx, store.AIFeatureTransform)
+	if err != nil {
+		return nil, err
+	}
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	redactor, err := newAIRedactor(aiSetting.Redaction)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid AI redaction setting: %v", err)
+	}
+
+	// Redact the content before it leaves the server, the reply is for the user so its placeholders are restored.
+	content, err := s.completeAIWithRetry(ctx, config, []ai.Message{
+		{Role: ai.RoleSystem, Content: systemPrompt + " " + aiTransformFormatPrompt},
+		{Role: ai.RoleUser, Content: redactor.redact(memo.Content)},
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to transform memo",
+			"user_id", user.ID,
+			"memo", request.Name,
+			"action", request.Action.String(),
+			"error", err)
+		return nil, aiCallError(err, "failed to transform memo")
+	}
+	content = redactor.unredact(strings.TrimSpace(content))
+	if content == "" {
+		return nil, status.Errorf(codes.Internal, "AI API returned empty content")
+	}
+	reservation.commit()
+
+	response := &v1pb.TransformMemoResponse{Content: content}
+	if !request.CreateRevision {
+		return response, nil
+	}
+	relationType := v1pb.MemoRelation_REVISION_OF
+	if request.Action == v1pb.TransformMemoRequest_TRANSLATE {
+		relationType = v1pb.MemoRelation_TRANSLATION_OF
+	}
+	response.Memo, err = s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    content,
+			Visibility: convertVisibilityFromStore(memo.Visibility),
+			Language:   targetLanguage,
+			Relations: []*v1pb.MemoRelation{{
+				RelatedMemo: &v1pb.MemoRelation_Memo{Name: request.Name},
+				Type:        relationType,
+			}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// getAITransformPrompt returns the system prompt of the action of the request, and the canonical target language
+// of the translations.
+func getAITransformPrompt(request *v1pb.TransformMemoRequest) (string, string, error) {
+	if request.Action != v1pb.TransformMemoRequest_TRANSLATE {
+		systemPrompt, ok := aiTransformPrompts[request.Action]
+		if !ok {
+			return "", "", status.Errorf(codes.InvalidArgument, "unsupported action %s", request.Action)
+		}
+		return systemPrompt, "", nil
+	}
+	targetLanguage, err := normalizeMemoLanguage(request.TargetLanguage)
+	if err != nil {
+		return "", "", err
+	}
+	if targetLanguage == "" {
+		return "", "", status.Errorf(codes.InvalidArgument, "target language is required for translations")
+	}
+	name := display.English.Tags().Name(language.Make(targetLanguage))
+	return fmt.Sprintf("Translate the following memo to %s.", name), targetLanguage, nil
+}
@@ -0,0 +1,369 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/ai"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// The AI features the calls to the provider are recorded for.
+const (
+	aiOperationSummary           = "summary"
+	aiOperationSummaryRefine     = "summary_refine"
+	aiOperationSummaryRegenerate = "summary_regenerate"
+	aiOperationChat              = "chat"
+	aiOperationTagSuggestion     = "tag_suggestion"
+	aiOperationAutoTag           = "auto_tag"
+	aiOperationTagMerge          = "tag_merge"
+	aiOperationVoiceMemo         = "voice_memo"
+	aiOperationSpeech            = "speech"
+	aiOperationSemanticSearch    = "semantic_search"
+	aiOperationConfigTest        = "config_test"
+	aiOperationTransform         = "transform"
+	aiOperationInsights          = "insights"
+	aiOperationAttachmentExtract = "attachment_extraction"
+	// aiOperationWorkspaceSummary is recorded for the system bot rather than for the host.
+	aiOperationWorkspaceSummary = "workspace_summary"
+)
+
+// maxAIUsageErrorLength is the maximum length of the error recorded for a failed call.
+const maxAIUsageErrorLength = 1000
+
+// aiUsageScope is the user and the AI feature the calls to the provider are made for.
+type aiUsageScope struct {
+	userID    int32
+	operation string
+}
+
+// withAIUsageScope returns the context whose calls to the AI provider are recorded for the user and the operation.
+func withAIUsageScope(ctx context.Context, userID int32, operation string) context.Context {
+	return context.WithValue(ctx, aiUsageScopeContextKey, aiUsageScope{userID: userID, operation: operation})
+}
+
+// ListAIUsage lists the calls to the AI provider, most recent first.
+func (s *APIV1Service) ListAIUsage(ctx context.Context, request *v1pb.ListAIUsageRequest) (*v1pb.ListAIUsageResponse, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+
+	findAIUsage := &store.FindAIUsage{
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	}
+	if request.User != "" {
+		userID, err := ExtractUserIDFromName(request.User)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+		}
+		findAIUsage.UserID = &userID
+	}
+	if request.Operation != "" {
+		findAIUsage.Operation = &request.Operation
+	}
+	if request.StartTime != nil {
+		createdTsAfter := request.StartTime.AsTime().Unix()
+		findAIUsage.CreatedTsAfter = &createdTsAfter
+	}
+	if request.EndTime != nil {
+		createdTsBefore := request.EndTime.AsTime().Unix()
+		findAIUsage.CreatedTsBefore = &createdTsBefore
+	}
+	usages, err := s.Store.ListAIUsages(ctx, findAIUsage)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list AI usage: %v", err)
+	}
+
+	response := &v1pb.ListAIUsageResponse{
+		Records: []*v1pb.AIUsageRecord{},
+	}
+	if len(usages) == limitPlusOne {
+		usages = usages[:limit]
+		nextPageToken, err := getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+		response.NextPageToken = nextPageToken
+	}
+	for _, usage := range usages {
+		response.Records = append(response.Records, convertAIUsageRecordFromStore(usage))
+	}
+	return response, nil
+}
+
+// GetAIUsageStats sums up the calls to the AI provider in the period per user and per operation,
+// with their cost estimated from the token prices of the workspace AI setting.
+func (s *APIV1Service) GetAIUsageStats(ctx context.Context, request *v1pb.GetAIUsageStatsRequest) (*v1pb.AIUsageStats, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	startTime, endTime := startOfUsageMonth(now), now
+	if request.StartTime != nil {
+		startTime = request.StartTime.AsTime()
+	}
+	createdTsAfter := startTime.Unix()
+	findAIUsage := &store.FindAIUsage{CreatedTsAfter: &createdTsAfter}
+	if request.EndTime != nil {
+		endTime = request.EndTime.AsTime()
+		createdTsBefore := endTime.Unix()
+		findAIUsage.CreatedTsBefore = &createdTsBefore
+	}
+	if !endTime.After(startTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "end time must be after start time")
+	}
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	stats, err := s.Store.ListAIUsageStats(ctx, findAIUsage)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list AI usage stats: %v", err)
+	}
+
+	total := &store.AIUsageStats{}
+	users, operations := map[string]*store.AIUsageStats{}, map[string]*store.AIUsageStats{}
+	for _, stat := range stats {
+		user := ""
+		if stat.UserID != 0 {
+			user = fmt.Sprintf("%s%d", UserNamePrefix, stat.UserID)
+		}
+		for _, sum := range []*store.AIUsageStats{total, getAIUsageStatsEntry(users, user), getAIUsageStatsEntry(operations, stat.Operation)} {
+			sum.Calls += stat.Calls
+			sum.Failures += stat.Failures
+			sum.PromptTokens += stat.PromptTokens
+			sum.CompletionTokens += stat.CompletionTokens
+			sum.LatencyMs += stat.LatencyMs
+		}
+	}
+	return &v1pb.AIUsageStats{
+		StartTime:  timestamppb.New(startTime),
+		EndTime:    timestamppb.New(endTime),
+		Total:      convertAIUsageStatsEntry("", total, aiSetting),
+		Users:      convertAIUsageStatsEntries(users, aiSetting),
+		Operations: convertAIUsageStatsEntries(operations, aiSetting),
+	}, nil
+}
+
+func getAIUsageStatsEntry(entries map[string]*store.AIUsageStats, key string) *store.AIUsageStats {
+	if entries[key] == nil {
+		entries[key] = &store.AIUsageStats{}
+	}
+	return entries[key]
+}
+
+// convertAIUsageStatsEntries converts the sums of the calls by key, most tokens first.
+func convertAIUsageStatsEntries(entries map[string]*store.AIUsageStats, aiSetting *storepb.WorkspaceAISetting) []*v1pb.AIUsageStats_Entry {
+	list := make([]*v1pb.AIUsageStats_Entry, 0, len(entries))
+	for key, stats := range entries {
+		list = append(list, convertAIUsageStatsEntry(key, stats, aiSetting))
+	}
+	slices.SortFunc(list, func(a, b *v1pb.AIUsageStats_Entry) int {
+		return cmp.Or(cmp.Compare(b.PromptTokens+b.CompletionTokens, a.PromptTokens+a.CompletionTokens), strings.Compare(a.Key, b.Key))
+	})
+	return list
+}
+
+func convertAIUsageStatsEntry(key string, stats *store.AIUsageStats, aiSetting *storepb.WorkspaceAISetting) *v1pb.AIUsageStats_Entry {
+	entry := &v1pb.AIUsageStats_Entry{
+		Key:              key,
+		Calls:            stats.Calls,
+		Failures:         stats.Failures,
+		PromptTokens:     stats.PromptTokens,
+		CompletionTokens: stats.CompletionTokens,
+		AverageLatency:   durationpb.New(0),
+		EstimatedCost:    (float64(stats.PromptTokens)*aiSetting.PromptTokenPrice + float64(stats.CompletionTokens)*aiSetting.CompletionTokenPrice) / 1_000_000,
+	}
+	if stats.Calls > 0 {
+		entry.AverageLatency = durationpb.New(time.Duration(stats.LatencyMs/stats.Calls) * time.Millisecond)
+	}
+	return entry
+}
+
+func convertAIUsageRecordFromStore(usage *store.AIUsage) *v1pb.AIUsageRecord {
+	record := &v1pb.AIUsageRecord{
+		CreateTime:       timestamppb.New(time.Unix(usage.CreatedTs, 0)),
+		Operation:        usage.Operation,
+		Model:            usage.Model,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		Latency:          durationpb.New(time.Duration(usage.LatencyMs) * time.Millisecond),
+		Success:          usage.Success,
+		Error:            usage.Error,
+	}
+	if usage.UserID != 0 {
+		record.User = fmt.Sprintf("%s%d", UserNamePrefix, usage.UserID)
+	}
+	return record
+}
+
+// checkAITokenBudget returns a ResourceExhausted error if the user has used the monthly AI token budget of their role.
+func (s *APIV1Service) checkAITokenBudget(ctx context.Context, user *store.User) error {
+	limits, err := s.getAIRateLimits(ctx, user)
+	if err != nil {
+		return err
+	}
+	if limits.monthlyTokens == 0 {
+		return nil
+	}
+	now := time.Now()
+	used, err := s.countAIMonthlyTokens(ctx, user.ID, now)
+	if err != nil {
+		return err
+	}
+	if used >= limits.monthlyTokens {
+		return quotaExceededError(quotaViolation{
+			subject:   fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+			id:        "ai_tokens_per_month",
+			limit:     limits.monthlyTokens,
+			resetTime: startOfUsageMonth(now).AddDate(0, 1, 0),
+		}, "token budget exceeded: maximum %d AI tokens per month allowed", limits.monthlyTokens)
+	}
+	return nil
+}
+
+// countAIMonthlyTokens returns the number of AI tokens the user used in the UTC month of the time.
+func (s *APIV1Service) countAIMonthlyTokens(ctx context.Context, userID int32, now time.Time) (int64, error) {
+	createdTsAfter := startOfUsageMonth(now).Unix()
+	stats, err := s.Store.ListAIUsageStats(ctx, &store.FindAIUsage{
+		UserID:         &userID,
+		CreatedTsAfter: &createdTsAfter,
+	})
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to list AI usage stats: %v", err)
+	}
+	var tokens int64
+	for _, stat := range stats {
+		tokens += stat.PromptTokens + stat.CompletionTokens
+	}
+	return tokens, nil
+}
+
+// startOfUsageMonth returns the start of the UTC month of the time.
+func startOfUsageMonth(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// recordAIUsage records a call to the AI provider made for the usage scope of the context. It is best effort.
+func (s *APIV1Service) recordAIUsage(ctx context.Context, model string, start time.Time, promptTokens, completionTokens int64, err error) {
+	// The calls failing fast on an open circuit never reached the provider.
+	if errors.Is(err, ai.ErrUnavailable) {
+		return
+	}
+	scope, _ := ctx.Value(aiUsageScopeContextKey).(aiUsageScope)
+	usage := &store.AIUsage{
+		UserID:           scope.userID,
+		Operation:        scope.operation,
+		Model:            model,
+		PromptTokens:     promptTokens,
+		CompletionTokens: completionTokens,
+		LatencyMs:        time.Since(start).Milliseconds(),
+		Success:          err == nil,
+	}
+	if err != nil {
+		usage.Error = truncateAIUsageError(err.Error())
+	}
+	// The call may have been made with a context canceled since, e.g. by its timeout.
+	if _, err := s.Store.CreateAIUsage(context.WithoutCancel(ctx), usage); err != nil {
+		slog.WarnContext(ctx, "failed to record AI usage", "error", err)
+	}
+}
+
+func truncateAIUsageError(message string) string {
+	runes := []rune(message)
+	if len(runes) <= maxAIUsageErrorLength {
+		return message
+	}
+	return string(runes[:maxAIUsageErrorLength])
+}
+
+// aiUsageProvider records the calls to the provider in the AI usage, and in the debug logs while the debug logging is on.
+type aiUsageProvider struct {
+	ai.Provider
+	service *APIV1Service
+}
+
+// recordAIUsageOf returns the provider with its calls recorded in the AI usage.
+func (s *APIV1Service) recordAIUsageOf(provider ai.Provider) ai.Provider {
+	return &aiUsageProvider{Provider: provider, service: s}
+}
+
+func (p *aiUsageProvider) Complete(ctx context.Context, request *ai.CompletionRequest) (*ai.Completion, error) {
+	start := time.Now()
+	completion, err := p.Provider.Complete(ctx, request)
+	p.recordCompletion(ctx, request, start, completion, err)
+	return completion, err
+}
+
+func (p *aiUsageProvider) Stream(ctx context.Context, request *ai.CompletionRequest, onDelta func(string) error) (*ai.Completion, error) {
+	start := time.Now()
+	completion, err := p.Provider.Stream(ctx, request, onDelta)
+	p.recordCompletion(ctx, request, start, completion, err)
+	return completion, err
+}
+
+func (p *aiUsageProvider) Embed(ctx context.Context, request *ai.EmbeddingRequest) (*ai.Embeddings, error) {
+	start := time.Now()
+	embeddings, err := p.Provider.Embed(ctx, request)
+	var tokens int64
+	if embeddings != nil {
+		tokens = embeddings.TotalTokens
+	}
+	p.service.recordAIUsage(ctx, request.Model, start, tokens, 0, err)
+	var response string
+	if embeddings != nil {
+		response = fmt.Sprintf("%d embeddings", len(embeddings.Vectors))
+	}
+	p.service.recordAIDebugLog(ctx, request.Model, &aiDebugLogEmbeddingPrompt{Model: request.Model, Input: request.Input}, response, err)
+	return embeddings, err
+}
+
+func (p *aiUsageProvider) recordCompletion(ctx context.Context, request *ai.CompletionRequest, start time.Time, completion *ai.Completion, err error) {
+	var promptTokens, completionTokens int64
+	var response string
+	if completion != nil {
+		response = completion.Content
+		promptTokens, completionTokens = completion.PromptTokens, completion.CompletionTokens
+		// Count the tokens of the providers only reporting the total as prompt tokens.
+		if promptTokens == 0 && completionTokens == 0 {
+			promptTokens = completion.TotalTokens
+		}
+	}
+	p.service.recordAIUsage(ctx, request.Model, start, promptTokens, completionTokens, err)
+	p.service.recordAIDebugLog(ctx, request.Model, newAIDebugLogPrompt(request), response, err)
+}
@@ -0,0 +1,199 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/openai/openai-go/v2"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/ai"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// Transcription request timeout
+	transcriptionRequestTimeout = 2 * time.Minute
+	// Tag added to the memos created from voice recordings
+	voiceMemoTag = "#voice"
+)
+
+// voiceMemoSystemPrompt instructs the model to turn a transcript into a memo.
+const voiceMemoSystemPrompt = `You turn the transcript of a voice memo into a well structured note.
+Rules:
+- Write in the language of the transcript and keep its meaning, do not add information.
+- Remove filler words, false starts and repetitions.
+- Use Markdown: short paragraphs, lists for enumerations and task lists ("- [ ] ") for things to do.
+- End the note with one to three relevant tags on the last line, e.g. "#meeting #project".
+- Reply with the note only, without any introduction.`
+
+// CreateVoiceMemo transcribes an audio recording and creates a structured memo with the recording attached.
+func (s *APIV1Service) CreateVoiceMemo(ctx context.Context, request *v1pb.CreateVoiceMemoRequest) (*v1pb.Memo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := s.checkAIFeaturePermission(ctx, user, aiFeatureVoiceMemo); err != nil {
+		return nil, err
+	}
+	audio := request.Audio
+	if audio == nil || len(audio.Content) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "audio content is required")
+	}
+	if audio.Filename == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "filename is required")
+	}
+	if !strings.HasPrefix(audio.Type, "audio/") && !strings.HasPrefix(audio.Type, "video/") {
+		return nil, status.Errorf(codes.InvalidArgument, "audio type is required")
+	}
+
+	// Check the limits before calling the AI provider, the recording is only saved with the memo.
+	workspaceStorageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace storage setting: %v", err)
+	}
+	if binary.Size(audio.Content) > getUploadSizeLimit(workspaceStorageSetting) {
+		return nil, status.Errorf(codes.InvalidArgument, "file size exceeds the limit")
+	}
+	reservation, err := s.reserveAIRequest(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	defer reservation.release(ctx)
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationVoiceMemo)
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return nil, err
+	}
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceMemos, 1); err != nil {
+		return nil, err
+	}
+	config, err := s.getAIConfig(ctx, store.AIFeatureVoiceMemo)
+	if err != nil {
+		return nil, err
+	}
+	if !config.isOpenAICompatible() {
+		return nil, status.Errorf(codes.FailedPrecondition, "voice memos require an OpenAI compatible AI provider")
+	}
+	if config.TranscriptionModel == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "AI transcription model is not configured")
+	}
+
+	transcript, err := s.transcribeAudio(ctx, config, audio, request.Language)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to transcribe voice memo", "user_id", user.ID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to transcribe audio: %v", err)
+	}
+	if transcript == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "no speech found in the recording")
+	}
+	content, err := s.structureTranscript(ctx, config, transcript)
+	if err != nil {
+		// The transcript is kept as is rather than losing the recording.
+		slog.WarnContext(ctx, "failed to structure voice memo, using the raw transcript", "user_id", user.ID, "error", err)
+		content = transcript
+	}
+	if !strings.Contains(content, voiceMemoTag) {
+		content = content + "\n\n" + voiceMemoTag
+	}
+
+	attachment, err := s.CreateAttachment(ctx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{
+			Filename: audio.Filename,
+			Type:     audio.Type,
+			Content:  audio.Content,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	memo, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:     content,
+			Visibility:  request.Visibility,
+			Attachments: []*v1pb.Attachment{{Name: attachment.Name}},
+		},
+	})
+	if err != nil {
+		if _, deleteErr := s.DeleteAttachment(ctx, &v1pb.DeleteAttachmentRequest{Name: attachment.Name}); deleteErr != nil {
+			slog.WarnContext(ctx, "failed to delete voice memo attachment", "attachment", attachment.Name, "error", deleteErr)
+		}
+		return nil, err
+	}
+
+	reservation.commit()
+	return memo, nil
+}
+
+// transcribeAudio converts the speech of the recording to text.
+func (s *APIV1Service) transcribeAudio(ctx context.Context, config *AIConfig, audio *v1pb.Attachment, language string) (string, error) {
+	client := createOpenAIClient(config)
+	timeoutCtx, cancel := context.WithTimeout(ctx, transcriptionRequestTimeout)
+	defer cancel()
+
+	params := openai.AudioTranscriptionNewParams{
+		File:  openai.File(bytes.NewReader(audio.Content), audio.Filename, audio.Type),
+		Model: openai.AudioModel(config.TranscriptionModel),
+	}
+	if language != "" {
+		params.Language = openai.String(language)
+	}
+	start := time.Now()
+	transcription, err := client.Audio.Transcriptions.New(timeoutCtx, params, requestIDOptions(ctx)...)
+	if err != nil {
+		s.recordAIUsage(ctx, config.TranscriptionModel, start, 0, 0, err)
+		return "", errors.Wrap(err, "transcription API call failed")
+	}
+	s.recordAIUsage(ctx, config.TranscriptionModel, start, transcription.Usage.InputTokens, transcription.Usage.OutputTokens, nil)
+	if err := s.AddAITokenUsage(ctx, transcription.Usage.TotalTokens); err != nil {
+		slog.WarnContext(ctx, "failed to update AI usage", "error", err)
+	}
+	return strings.TrimSpace(transcription.Text), nil
+}
+
+// structureTranscript asks the model to turn the transcript into a Markdown note with tags.
+func (s *APIV1Service) structureTranscript(ctx context.Context, config *AIConfig, transcript string) (string, error) {
+	provider, err := s.createAIProvider(ctx, config)
+	if err != nil {
+		return "", err
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, aiRequestTimeout)
+	defer cancel()
+
+	completion, err := provider.Complete(timeoutCtx, config.completionRequest([]ai.Message{
+		{Role: ai.RoleSystem, Content: voiceMemoSystemPrompt},
+		{Role: ai.RoleUser, Content: transcript},
+	}))
+	if err != nil {
+		return "", errors.Wrap(err, "AI API call failed")
+	}
+	if err := s.AddAITokenUsage(ctx, completion.TotalTokens); err != nil {
+		slog.WarnContext(ctx, "failed to update AI usage", "error", err)
+	}
+	content := unwrapMarkdownFence(strings.TrimSpace(completion.Content))
+	if content == "" {
+		return "", errors.New("AI API returned empty content")
+	}
+	return content, nil
+}
+
+// unwrapMarkdownFence removes the code fence models sometimes wrap Markdown replies in.
+func unwrapMarkdownFence(content string) string {
+	if !strings.HasPrefix(content, "```") || !strings.HasSuffix(content, "```") {
+		return content
+	}
+	_, body, found := strings.Cut(content, "\n")
+	if !found {
+		return content
+	}
+	return strings.TrimSpace(strings.TrimSuffix(body, "```"))
+}
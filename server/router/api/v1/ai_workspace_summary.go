@@ -0,0 +1,329 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/ai"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// aiWorkspaceSummaryPrompt asks the model to write the digest of the memos the users shared with the workspace.
+const aiWorkspaceSummaryPrompt = `You are writing the digest of the memos the members of a team shared over a period. The memos are grouped by author, each with its date and tags.
+
+Please:
+1. Start with a short overview of the main topics of the team
+2. Summarize the contributions of each author under their name
+3. Group the related memos of the authors by tag or topic, and highlight the decisions and action items
+4. Use Markdown headings and bullet points, and keep the digest concise (aim for 300-600 words)
+5. Maintain a neutral, professional tone`
+
+// GenerateWorkspaceAISummary generates the digest of the memos the users shared with the workspace in the time range,
+// posted as a pinned memo of the system bot.
+func (s *APIV1Service) GenerateWorkspaceAISummary(ctx context.Context, request *v1pb.GenerateWorkspaceAISummaryRequest) (_ *v1pb.Memo, err error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if user.Role != store.RoleHost {
+		return nil, status.Errorf(codes.PermissionDenied, "only the host can generate workspace summaries")
+	}
+	sourceVisibilities, visibility, err := getWorkspaceAISummaryVisibilities(request)
+	if err != nil {
+		return nil, err
+	}
+	summaryRequest := &v1pb.GenerateAISummaryRequest{
+		TimeRange: request.TimeRange,
+		Tags:      request.Tags,
+		StartDate: request.StartDate,
+		EndDate:   request.EndDate,
+	}
+	startTime, endTime, err := parseAISummaryTimeRange(summaryRequest)
+	if err != nil {
+		return nil, err
+	}
+	auditLog := newAISummaryAuditLog(user.ID, aiOperationWorkspaceSummary, summaryRequest)
+	auditLog.setParameter("visibility", string(visibility))
+	defer func() {
+		s.recordAIAuditLog(ctx, auditLog, err)
+	}()
+
+	// The workspace summaries have their own limit, counted for the system bot rather than for the host.
+	if err := s.checkWorkspaceAISummaryLimit(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return nil, err
+	}
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceMemos, 1); err != nil {
+		return nil, err
+	}
+	config, err := s.getAIConfig(ctx, store.AIFeatureSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceMemos, err := s.listWorkspaceSourceMemos(ctx, sourceVisibilities, startTime, endTime, request.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(sourceMemos) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no memos found in the specified time range")
+	}
+	prompt, sourceMemos, redactor, err := s.buildWorkspaceSummaryPrompt(ctx, config, sourceMemos)
+	if err != nil {
+		return nil, err
+	}
+	auditLog.sourceMemos = sourceMemos
+	auditLog.setProvider(config)
+
+	ctx = withAIUsageScope(ctx, store.SystemBotID, aiOperationWorkspaceSummary)
+	summary, err := s.callAIWithRetry(ctx, config, []ai.Message{
+		{Role: ai.RoleSystem, Content: aiWorkspaceSummaryPrompt},
+		{Role: ai.RoleUser, Content: prompt},
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to generate workspace AI summary", "error", err)
+		return nil, aiCallError(err, "failed to generate workspace AI summary")
+	}
+	summary = redactor.restore(summary)
+	if err := s.updateRateLimit(ctx, store.SystemBotID); err != nil {
+		slog.Warn("failed to update workspace summary rate limit counter", "error", err)
+	}
+
+	memo, err := s.createWorkspaceAIMemo(ctx, summary, config.Model, summaryRequest, visibility, sourceMemos)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create workspace summary memo: %v", err)
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert memo: %v", err)
+	}
+	s.recordEvent(ctx, store.EventTypeAISummaryGenerated, store.SystemBotID, memoMessage.Name, memoMessage)
+	return memoMessage, nil
+}
+
+// getWorkspaceAISummaryVisibilities returns the visibilities of the source memos and of the summary memo of the
+// request. A public summary may only cover public memos, so that it does not disclose the protected ones.
+func getWorkspaceAISummaryVisibilities(request *v1pb.GenerateWorkspaceAISummaryRequest) ([]store.Visibility, store.Visibility, error) {
+	sourceVisibilities := []store.Visibility{}
+	for _, visibility := range request.SourceVisibilities {
+		if visibility != v1pb.Visibility_PUBLIC && visibility != v1pb.Visibility_PROTECTED {
+			return nil, "", status.Errorf(codes.InvalidArgument, "source visibilities must be PUBLIC or PROTECTED")
+		}
+		if !slices.Contains(sourceVisibilities, convertVisibilityToStore(visibility)) {
+			sourceVisibilities = append(sourceVisibilities, convertVisibilityToStore(visibility))
+		}
+	}
+	if len(sourceVisibilities) == 0 {
+		sourceVisibilities = []store.Visibility{store.Public, store.Protected}
+	}
+
+	switch request.Visibility {
+	case v1pb.Visibility_VISIBILITY_UNSPECIFIED, v1pb.Visibility_PROTECTED:
+		return sourceVisibilities, store.Protected, nil
+	case v1pb.Visibility_PUBLIC:
+		if slices.Contains(sourceVisibilities, store.Protected) {
+			return nil, "", status.Errorf(codes.InvalidArgument, "a public workspace summary may only cover public memos")
+		}
+		return sourceVisibilities, store.Public, nil
+	default:
+		return nil, "", status.Errorf(codes.InvalidArgument, "visibility must be PUBLIC or PROTECTED")
+	}
+}
+
+// checkWorkspaceAISummaryLimit returns a ResourceExhausted error if the workspace summaries of the UTC day reached the
+// daily limit of the workspace AI setting.
+func (s *APIV1Service) checkWorkspaceAISummaryLimit(ctx context.Context) error {
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	limit := aiSetting.WorkspaceSummaryDailyLimit
+	if limit <= 0 {
+		return nil
+	}
+	now := time.Now()
+	counts, err := s.countAIRequests(ctx, store.SystemBotID, now)
+	if err != nil {
+		return err
+	}
+	if counts.daily >= limit {
+		return quotaExceededError(quotaViolation{
+			subject:   "workspace",
+			id:        "workspace_summaries_per_day",
+			limit:     int64(limit),
+			resetTime: now.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1),
+		}, "rate limit exceeded: maximum %d workspace summaries per day allowed", limit)
+	}
+	return nil
+}
+
+// listWorkspaceSourceMemos lists the memos of all the users with the visibilities created in the time range that can
+// be sent to the AI provider, restricted to the given tags if any and to the users who opted in to it.
+func (s *APIV1Service) listWorkspaceSourceMemos(ctx context.Context, visibilities []store.Visibility, startTime, endTime int64, tags []string) ([]*store.Memo, error) {
+	filters := []string{
+		fmt.Sprintf("created_ts >= %d", startTime),
+		fmt.Sprintf("created_ts < %d", endTime),
+		"!is_ai_generated",
+	}
+	if len(tags) > 0 {
+		tagFilters := make([]string, len(tags))
+		for i, tag := range tags {
+			tagFilters[i] = fmt.Sprintf("%q", strings.TrimPrefix(tag, "#"))
+		}
+		filters = append(filters, fmt.Sprintf("tag in [%s]", strings.Join(tagFilters, ", ")))
+	}
+	allowedVisibilities, err := s.getAIMemoVisibilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	visibilities = slices.DeleteFunc(slices.Clone(visibilities), func(visibility store.Visibility) bool {
+		return !slices.Contains(allowedVisibilities, visibility)
+	})
+	if len(visibilities) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "the workspace AI setting does not allow sending these memos")
+	}
+
+	limit := maxSummarySourceMemos
+	normalStatus := store.Normal
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus:       &normalStatus,
+		VisibilityList:  visibilities,
+		ExcludeComments: true,
+		Filters:         filters,
+		Limit:           &limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	// The memos of the other users are only sent if they opted in to the AI processing of their content.
+	requesterID, err := s.getAIRequesterID(ctx, store.SystemBotID)
+	if err != nil {
+		return nil, err
+	}
+	return s.filterAIConsentedMemos(ctx, requesterID, memos)
+}
+
+// buildWorkspaceSummaryPrompt formats the redacted memos grouped by author, each with its date and tags, and returns
+// the memos that fit in a single request and the redactor restoring the placeholders of the summary.
+func (s *APIV1Service) buildWorkspaceSummaryPrompt(ctx context.Context, config *AIConfig, memos []*store.Memo) (string, []*store.Memo, *aiRedactor, error) {
+	prompter, err := s.newAISummaryPrompter(ctx, config, memos)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	// Group the memos by author, the authors in the order of their latest memo.
+	authorIDs := []int32{}
+	memosByAuthor := map[int32][]*store.Memo{}
+	for _, memo := range memos {
+		if _, ok := memosByAuthor[memo.CreatorID]; !ok {
+			authorIDs = append(authorIDs, memo.CreatorID)
+		}
+		memosByAuthor[memo.CreatorID] = append(memosByAuthor[memo.CreatorID], memo)
+	}
+
+	var promptBuilder strings.Builder
+	coveredMemos := []*store.Memo{}
+	totalSize := aiPromptSize{}
+	for _, authorID := range authorIDs {
+		author, err := s.Store.GetUser(ctx, &store.FindUser{ID: &authorID})
+		if err != nil {
+			return "", nil, nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if author == nil {
+			continue
+		}
+		authorName := author.Nickname
+		if authorName == "" {
+			authorName = author.Username
+		}
+		var authorBuilder strings.Builder
+		for _, memo := range memosByAuthor[authorID] {
+			content := prompter.memoContent(memo)
+			if content == "" {
+				continue
+			}
+			// Redact the content before it leaves the server.
+			content = prompter.redactor.redact(content)
+			size := totalSize.add(newAIPromptSize(content))
+			if !prompter.fits(size) {
+				slog.Warn("workspace summary memos exceed the size of a request, leaving out the oldest ones",
+					"max_tokens", prompter.chunkTokens,
+					"memos", len(coveredMemos))
+				break
+			}
+			totalSize = size
+			coveredMemos = append(coveredMemos, memo)
+			authorBuilder.WriteString(fmt.Sprintf("[Memo %d] %s", len(coveredMemos), time.Unix(memo.CreatedTs, 0).UTC().Format(time.DateOnly)))
+			for _, tag := range memo.Payload.GetTags() {
+				authorBuilder.WriteString(" #" + tag)
+			}
+			authorBuilder.WriteString("\n" + content + "\n\n")
+		}
+		if authorBuilder.Len() > 0 {
+			promptBuilder.WriteString(fmt.Sprintf("## %s (@%s)\n\n%s", authorName, author.Username, authorBuilder.String()))
+		}
+	}
+	if len(coveredMemos) == 0 {
+		return "", nil, nil, status.Errorf(codes.InvalidArgument, "all memos are empty")
+	}
+
+	prompt := promptBuilder.String()
+	if memoLanguage := getDominantMemoLanguage(coveredMemos); memoLanguage != "" {
+		prompt = fmt.Sprintf("Write the digest in %s.\n\n%s", memoLanguage, prompt)
+	}
+	if prompter.redactor.redacted() {
+		prompt = "Some content has been replaced with placeholders such as [EMAIL_1]. Keep the placeholders unchanged.\n\n" + prompt
+	}
+	return prompt, coveredMemos, prompter.redactor, nil
+}
+
+// createWorkspaceAIMemo creates the pinned memo of the system bot with the workspace summary, referencing the source
+// memos in the same transaction.
+func (s *APIV1Service) createWorkspaceAIMemo(ctx context.Context, summary string, model string, request *v1pb.GenerateAISummaryRequest, visibility store.Visibility, sourceMemos []*store.Memo) (*store.Memo, error) {
+	create := &store.Memo{
+		UID:        shortuuid.New(),
+		CreatorID:  store.SystemBotID,
+		Content:    formatAISummaryContent(summary, request),
+		Visibility: visibility,
+		Pinned:     true,
+		Payload: &storepb.MemoPayload{
+			Property: &storepb.MemoPayload_Property{IsAiGenerated: true},
+			AiSummarySource: &storepb.MemoPayload_AISummarySource{
+				TimeRange: request.TimeRange,
+				Tags:      request.Tags,
+				StartDate: request.StartDate,
+				EndDate:   request.EndDate,
+				Model:     model,
+			},
+		},
+	}
+	if err := memopayload.RebuildMemoPayload(create, s.MarkdownService); err != nil {
+		return nil, errors.Wrap(err, "failed to rebuild memo payload")
+	}
+
+	associations := &store.MemoAssociations{}
+	for _, sourceMemo := range sourceMemos {
+		associations.Relations = append(associations.Relations, &store.MemoRelation{
+			RelatedMemoID: sourceMemo.ID,
+			Type:          store.MemoRelationSummaryOf,
+		})
+	}
+	return s.Store.CreateMemoWithAssociations(ctx, create, associations)
+}
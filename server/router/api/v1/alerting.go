@@ -0,0 +1,180 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/alert"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// alertCooldown is the minimum time between two alerts of the same kind, so that a persistent failure does not flood
+// the channels.
+const alertCooldown = time.Hour
+
+// The kinds of the alerts, each one rate limited on its own.
+const (
+	alertKindWebhookDelivery = "webhook_delivery"
+	alertKindAIProvider      = "ai_provider"
+	alertKindStorageWrite    = "storage_write"
+	alertKindDiskUsage       = "disk_usage"
+	alertKindTest            = "test"
+)
+
+// SendTestAlert sends a test alert on every alerting channel, returning the errors of the channels it failed on.
+func (s *APIV1Service) SendTestAlert(ctx context.Context, _ *v1pb.SendTestAlertRequest) (*v1pb.SendTestAlertResponse, error) {
+	if err := s.checkHostUser(ctx); err != nil {
+		return nil, err
+	}
+	channels, err := s.getAlertChannels(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	if len(channels) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "no alerting channel is configured")
+	}
+
+	testAlert := alert.Alert{
+		Kind:    alertKindTest,
+		Title:   "Test alert",
+		Message: "The alerts of the memos workspace are sent to this channel.",
+		Time:    time.Now(),
+	}
+	response := &v1pb.SendTestAlertResponse{Errors: []string{}}
+	for i, channel := range channels {
+		if err := alert.Send(ctx, channel, testAlert); err != nil {
+			response.Errors = append(response.Errors, fmt.Sprintf("channel %d: %v", i+1, err))
+		}
+	}
+	return response, nil
+}
+
+// AlertDiskUsage alerts of the usage of the disk of the data directory crossing its threshold.
+func (s *APIV1Service) AlertDiskUsage(ctx context.Context, message string) {
+	s.raiseAlert(ctx, alertKindDiskUsage, "Disk usage over threshold", message)
+}
+
+// raiseAlert logs the operational failure and sends it on the alerting channels in the background, at most once per
+// alertCooldown for each kind.
+func (s *APIV1Service) raiseAlert(ctx context.Context, kind, title, message string) {
+	slog.WarnContext(ctx, title, "kind", kind, "message", message)
+	now := time.Now()
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		channels, err := s.getAlertChannels(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to get alerting channels", "error", err)
+			return
+		}
+		if len(channels) == 0 || !s.takeAlertSlot(kind, now) {
+			return
+		}
+		sent := alert.Alert{Kind: kind, Title: title, Message: message, Time: now}
+		for _, channel := range channels {
+			if err := alert.Send(ctx, channel, sent); err != nil {
+				slog.ErrorContext(ctx, "failed to send alert", "kind", kind, "error", err)
+			}
+		}
+	}()
+}
+
+// takeAlertSlot returns whether an alert of the kind can be sent at the time, recording it as the last one if so.
+func (s *APIV1Service) takeAlertSlot(kind string, now time.Time) bool {
+	for {
+		last, loaded := s.lastAlerts.LoadOrStore(kind, now)
+		if !loaded {
+			return true
+		}
+		if now.Sub(last.(time.Time)) < alertCooldown {
+			return false
+		}
+		if s.lastAlerts.CompareAndSwap(kind, last, now) {
+			return true
+		}
+	}
+}
+
+// getAlertChannels returns the alerting channels of the workspace.
+func (s *APIV1Service) getAlertChannels(ctx context.Context) ([]alert.Channel, error) {
+	alertingSetting, err := s.Store.GetWorkspaceAlertingSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace alerting setting")
+	}
+	channels := []alert.Channel{}
+	for _, channel := range alertingSetting.GetChannels() {
+		channels = append(channels, convertAlertChannelFromStore(channel))
+	}
+	return channels, nil
+}
+
+func convertAlertChannelFromStore(channel *storepb.WorkspaceAlertingSetting_AlertChannel) alert.Channel {
+	alertChannel := alert.Channel{
+		URL:        channel.Url,
+		Recipients: channel.Recipients,
+	}
+	switch channel.Type {
+	case storepb.WorkspaceAlertingSetting_AlertChannel_WEBHOOK:
+		alertChannel.Type = alert.Webhook
+	case storepb.WorkspaceAlertingSetting_AlertChannel_SLACK:
+		alertChannel.Type = alert.Slack
+	case storepb.WorkspaceAlertingSetting_AlertChannel_EMAIL:
+		alertChannel.Type = alert.Email
+	default:
+	}
+	if smtp := channel.Smtp; smtp != nil {
+		alertChannel.SMTP = alert.SMTPConfig{
+			Host:     smtp.Host,
+			Port:     int(smtp.Port),
+			Username: smtp.Username,
+			Password: smtp.Password,
+			From:     smtp.From,
+		}
+	}
+	return alertChannel
+}
+
+// validateWorkspaceAlertingSetting checks that the channels are complete and the disk usage threshold a percentage.
+func validateWorkspaceAlertingSetting(setting *storepb.WorkspaceAlertingSetting) error {
+	if setting == nil {
+		return errors.New("alerting setting is required")
+	}
+	if setting.DiskUsageThresholdPercent < 0 || setting.DiskUsageThresholdPercent > 100 {
+		return errors.New("disk usage threshold must be between 0 and 100 percent")
+	}
+	for i, channel := range setting.Channels {
+		switch channel.Type {
+		case storepb.WorkspaceAlertingSetting_AlertChannel_WEBHOOK, storepb.WorkspaceAlertingSetting_AlertChannel_SLACK:
+			u, err := url.Parse(channel.Url)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return errors.Errorf("channel %d: an http or https URL is required", i+1)
+			}
+		case storepb.WorkspaceAlertingSetting_AlertChannel_EMAIL:
+			smtp := channel.Smtp
+			if smtp == nil || smtp.Host == "" || smtp.From == "" || len(channel.Recipients) == 0 {
+				return errors.Errorf("channel %d: the SMTP host, the sender and the recipients are required", i+1)
+			}
+			if smtp.Port < 0 || smtp.Port > 65535 {
+				return errors.Errorf("channel %d: invalid SMTP port %d", i+1, smtp.Port)
+			}
+		default:
+			return errors.Errorf("channel %d: the channel type is required", i+1)
+		}
+	}
+	return nil
+}
+
+// aiProfileLabel returns the label of the AI profile in the alert messages, empty for the default provider.
+func aiProfileLabel(profile string) string {
+	if profile == "" {
+		return ""
+	}
+	return fmt.Sprintf(" of the profile %q", profile)
+}
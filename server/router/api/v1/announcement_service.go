@@ -0,0 +1,302 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// ListAnnouncements lists the announcements shown now, without the ones dismissed by the current user, or all of
+// them for the admins.
+func (s *APIV1Service) ListAnnouncements(ctx context.Context, request *v1pb.ListAnnouncementsRequest) (*v1pb.ListAnnouncementsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	findAnnouncement := &store.FindAnnouncement{}
+	if request.ShowAll {
+		if user == nil || !isSuperUser(user) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	} else {
+		now := time.Now().Unix()
+		findAnnouncement.ActiveTs = &now
+	}
+	announcements, err := s.Store.ListAnnouncements(ctx, findAnnouncement)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list announcements: %v", err)
+	}
+
+	dismissedIDs := []int32{}
+	if user != nil && !request.ShowAll {
+		dismissedIDs, err = s.Store.GetUserDismissedAnnouncementIDs(ctx, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get dismissed announcements: %v", err)
+		}
+	}
+	response := &v1pb.ListAnnouncementsResponse{
+		Announcements: []*v1pb.Announcement{},
+	}
+	for _, announcement := range announcements {
+		// An announcement made not dismissible since is shown again.
+		if announcement.Dismissible && slices.Contains(dismissedIDs, announcement.ID) {
+			continue
+		}
+		response.Announcements = append(response.Announcements, convertAnnouncementFromStore(announcement))
+	}
+	return response, nil
+}
+
+// CreateAnnouncement creates an announcement and notifies the users of it in their inbox.
+func (s *APIV1Service) CreateAnnouncement(ctx context.Context, request *v1pb.CreateAnnouncementRequest) (*v1pb.Announcement, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if request.Announcement == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "announcement is required")
+	}
+
+	create := &store.Announcement{
+		CreatorID:   user.ID,
+		Content:     request.Announcement.Content,
+		Severity:    convertAnnouncementSeverityToStore(request.Announcement.Severity),
+		StartTs:     time.Now().Unix(),
+		Dismissible: request.Announcement.Dismissible,
+	}
+	if request.Announcement.StartTime != nil {
+		create.StartTs = request.Announcement.StartTime.AsTime().Unix()
+	}
+	if request.Announcement.EndTime != nil {
+		create.EndTs = request.Announcement.EndTime.AsTime().Unix()
+	}
+	if err := validateAnnouncement(create); err != nil {
+		return nil, err
+	}
+	announcement, err := s.Store.CreateAnnouncement(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create announcement: %v", err)
+	}
+	if err := s.notifyAnnouncement(ctx, announcement); err != nil {
+		slog.Warn("failed to notify users of announcement", "announcement", announcement.ID, "error", err)
+	}
+	return convertAnnouncementFromStore(announcement), nil
+}
+
+// UpdateAnnouncement updates the content, severity, schedule or dismissibility of an announcement.
+func (s *APIV1Service) UpdateAnnouncement(ctx context.Context, request *v1pb.UpdateAnnouncementRequest) (*v1pb.Announcement, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+	if request.Announcement == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "announcement is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	announcement, err := s.getAnnouncementByName(ctx, request.Announcement.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	update := &store.UpdateAnnouncement{
+		ID:        announcement.ID,
+		UpdatedTs: &now,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "content":
+			announcement.Content = request.Announcement.Content
+			update.Content = &announcement.Content
+		case "severity":
+			announcement.Severity = convertAnnouncementSeverityToStore(request.Announcement.Severity)
+			update.Severity = &announcement.Severity
+		case "start_time":
+			announcement.StartTs = now
+			if request.Announcement.StartTime != nil {
+				announcement.StartTs = request.Announcement.StartTime.AsTime().Unix()
+			}
+			update.StartTs = &announcement.StartTs
+		case "end_time":
+			announcement.EndTs = 0
+			if request.Announcement.EndTime != nil {
+				announcement.EndTs = request.Announcement.EndTime.AsTime().Unix()
+			}
+			update.EndTs = &announcement.EndTs
+		case "dismissible":
+			announcement.Dismissible = request.Announcement.Dismissible
+			update.Dismissible = &announcement.Dismissible
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update path: %s", path)
+		}
+	}
+	if err := validateAnnouncement(announcement); err != nil {
+		return nil, err
+	}
+	if err := s.Store.UpdateAnnouncement(ctx, update); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update announcement: %v", err)
+	}
+	announcement.UpdatedTs = now
+	return convertAnnouncementFromStore(announcement), nil
+}
+
+// DeleteAnnouncement deletes an announcement.
+func (s *APIV1Service) DeleteAnnouncement(ctx context.Context, request *v1pb.DeleteAnnouncementRequest) (*emptypb.Empty, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+	announcement, err := s.getAnnouncementByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteAnnouncement(ctx, &store.DeleteAnnouncement{ID: announcement.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete announcement: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DismissAnnouncement hides a dismissible announcement from the current user.
+func (s *APIV1Service) DismissAnnouncement(ctx context.Context, request *v1pb.DismissAnnouncementRequest) (*emptypb.Empty, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	announcement, err := s.getAnnouncementByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !announcement.Dismissible {
+		return nil, status.Errorf(codes.FailedPrecondition, "announcement is not dismissible")
+	}
+
+	dismissedIDs, err := s.Store.GetUserDismissedAnnouncementIDs(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get dismissed announcements: %v", err)
+	}
+	if slices.Contains(dismissedIDs, announcement.ID) {
+		return &emptypb.Empty{}, nil
+	}
+	// The announcements deleted since are forgotten.
+	announcements, err := s.Store.ListAnnouncements(ctx, &store.FindAnnouncement{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list announcements: %v", err)
+	}
+	dismissedIDs = slices.DeleteFunc(dismissedIDs, func(id int32) bool {
+		return !slices.ContainsFunc(announcements, func(announcement *store.Announcement) bool { return announcement.ID == id })
+	})
+	if err := s.Store.SetUserDismissedAnnouncementIDs(ctx, user.ID, append(dismissedIDs, announcement.ID)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to dismiss announcement: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// notifyAnnouncement notifies the active users of the announcement in their inbox.
+func (s *APIV1Service) notifyAnnouncement(ctx context.Context, announcement *store.Announcement) error {
+	users, err := s.Store.ListUsers(ctx, &store.FindUser{})
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		if user.RowStatus == store.Archived {
+			continue
+		}
+		if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+			SenderID:   announcement.CreatorID,
+			ReceiverID: user.ID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:           storepb.InboxMessage_ANNOUNCEMENT,
+				AnnouncementId: &announcement.ID,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) getAnnouncementByName(ctx context.Context, name string) (*store.Announcement, error) {
+	announcementID, err := ExtractAnnouncementIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	announcement, err := s.Store.GetAnnouncement(ctx, &store.FindAnnouncement{ID: &announcementID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get announcement: %v", err)
+	}
+	if announcement == nil {
+		return nil, status.Errorf(codes.NotFound, "announcement not found")
+	}
+	return announcement, nil
+}
+
+func validateAnnouncement(announcement *store.Announcement) error {
+	if strings.TrimSpace(announcement.Content) == "" {
+		return status.Errorf(codes.InvalidArgument, "content is required")
+	}
+	if announcement.EndTs != 0 && announcement.EndTs <= announcement.StartTs {
+		return status.Errorf(codes.InvalidArgument, "end time must be after start time")
+	}
+	return nil
+}
+
+func convertAnnouncementFromStore(announcement *store.Announcement) *v1pb.Announcement {
+	announcementMessage := &v1pb.Announcement{
+		Name:        fmt.Sprintf("%s%d", AnnouncementNamePrefix, announcement.ID),
+		Content:     announcement.Content,
+		Severity:    convertAnnouncementSeverityFromStore(announcement.Severity),
+		StartTime:   timestamppb.New(time.Unix(announcement.StartTs, 0)),
+		Dismissible: announcement.Dismissible,
+		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, announcement.CreatorID),
+		CreateTime:  timestamppb.New(time.Unix(announcement.CreatedTs, 0)),
+		UpdateTime:  timestamppb.New(time.Unix(announcement.UpdatedTs, 0)),
+	}
+	if announcement.EndTs != 0 {
+		announcementMessage.EndTime = timestamppb.New(time.Unix(announcement.EndTs, 0))
+	}
+	return announcementMessage
+}
+
+func convertAnnouncementSeverityFromStore(severity store.AnnouncementSeverity) v1pb.Announcement_Severity {
+	switch severity {
+	case store.AnnouncementSeverityWarning:
+		return v1pb.Announcement_WARNING
+	case store.AnnouncementSeverityCritical:
+		return v1pb.Announcement_CRITICAL
+	default:
+		return v1pb.Announcement_INFO
+	}
+}
+
+func convertAnnouncementSeverityToStore(severity v1pb.Announcement_Severity) store.AnnouncementSeverity {
+	switch severity {
+	case v1pb.Announcement_WARNING:
+		return store.AnnouncementSeverityWarning
+	case v1pb.Announcement_CRITICAL:
+		return store.AnnouncementSeverityCritical
+	default:
+		return store.AnnouncementSeverityInfo
+	}
+}
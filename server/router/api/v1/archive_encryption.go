@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/archivecrypt"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// minArchivePassphraseLength is the minimum length of the passphrase of an encrypted export or backup.
+const minArchivePassphraseLength = 8
+
+func validateArchiveEncryption(encryption v1pb.ArchiveEncryption, passphrase string) error {
+	switch encryption {
+	case v1pb.ArchiveEncryption_ARCHIVE_ENCRYPTION_UNSPECIFIED:
+		if passphrase != "" {
+			return errors.New("a passphrase requires an encryption")
+		}
+		return nil
+	case v1pb.ArchiveEncryption_ZIP_AES, v1pb.ArchiveEncryption_AGE:
+	default:
+		return errors.Errorf("unknown encryption %d", encryption)
+	}
+	if utf8.RuneCountInString(passphrase) < minArchivePassphraseLength {
+		return errors.Errorf("the passphrase must have at least %d characters", minArchivePassphraseLength)
+	}
+	return nil
+}
+
+// archiveEncryptionExtension returns the extension added to the name of a file encrypted with the encryption.
+func archiveEncryptionExtension(encryption v1pb.ArchiveEncryption) string {
+	switch encryption {
+	case v1pb.ArchiveEncryption_ZIP_AES:
+		return ".zip"
+	case v1pb.ArchiveEncryption_AGE:
+		return ".age"
+	default:
+		return ""
+	}
+}
+
+// encryptArchiveFile writes the file at src encrypted with the passphrase to dst: in a ZIP archive under its name,
+// or in the age format.
+func encryptArchiveFile(src, dst string, encryption v1pb.ArchiveEncryption, passphrase string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	switch encryption {
+	case v1pb.ArchiveEncryption_ZIP_AES:
+		archive := archivecrypt.NewZipWriter(out, passphrase)
+		writer, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     filepath.Base(src),
+			Method:   zip.Deflate,
+			Modified: info.ModTime(),
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(writer, in); err != nil {
+			return err
+		}
+		if err := archive.Close(); err != nil {
+			return err
+		}
+	case v1pb.ArchiveEncryption_AGE:
+		writer, err := archivecrypt.NewAgeWriter(out, passphrase)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(writer, in); err != nil {
+			return err
+		}
+		if err := writer.Close(); err != nil {
+			return err
+		}
+	default:
+		return errors.Errorf("unknown encryption %d", encryption)
+	}
+	return out.Close()
+}
+
+// encryptAgeArchive returns the data encrypted with the passphrase in the age format.
+func encryptAgeArchive(data []byte, passphrase string) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer, err := archivecrypt.NewAgeWriter(&buffer, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
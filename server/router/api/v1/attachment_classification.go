@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+
+	"github.com/disintegration/imaging"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// sensitiveImageBlurSigma is the strength of the blur applied to sensitive images.
+const sensitiveImageBlurSigma = 24
+
+func isAttachmentSensitive(attachment *store.Attachment) bool {
+	return attachment.Payload.GetClassification().GetSensitive()
+}
+
+// checkSensitiveAttachmentAccess checks whether the user, nil if anonymous, can get the content of the attachment
+// under the workspace sensitive content policy, and returns whether the content must be blurred.
+func (s *APIV1Service) checkSensitiveAttachmentAccess(ctx context.Context, user *store.User, attachment *store.Attachment, reveal bool) (bool, error) {
+	if !isAttachmentSensitive(attachment) {
+		return false, nil
+	}
+	setting, err := s.Store.GetWorkspaceSensitiveContentSetting(ctx)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to get workspace sensitive content setting: %v", err)
+	}
+	if user != nil && user.ID == attachment.CreatorID {
+		return false, nil
+	}
+	switch setting.Policy {
+	case storepb.SensitiveContentPolicy_BLUR:
+		return !reveal, nil
+	case storepb.SensitiveContentPolicy_RESTRICT:
+		if user == nil {
+			return false, status.Errorf(codes.Unauthenticated, "sensitive attachments are only available to signed-in users")
+		}
+	}
+	return false, nil
+}
+
+// filterRestrictedAttachments drops the sensitive attachments the current user is not allowed to see
+// under the workspace sensitive content policy.
+func (s *APIV1Service) filterRestrictedAttachments(ctx context.Context, attachments []*store.Attachment) ([]*store.Attachment, error) {
+	hasSensitive := false
+	for _, attachment := range attachments {
+		if isAttachmentSensitive(attachment) {
+			hasSensitive = true
+			break
+		}
+	}
+	if !hasSensitive {
+		return attachments, nil
+	}
+	setting, err := s.Store.GetWorkspaceSensitiveContentSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace sensitive content setting")
+	}
+	if setting.Policy != storepb.SensitiveContentPolicy_RESTRICT {
+		return attachments, nil
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get current user")
+	}
+	if user != nil {
+		return attachments, nil
+	}
+	filtered := []*store.Attachment{}
+	for _, attachment := range attachments {
+		if !isAttachmentSensitive(attachment) {
+			filtered = append(filtered, attachment)
+		}
+	}
+	return filtered, nil
+}
+
+// blurImage returns a blurred JPEG version of the image, downsized like thumbnails.
+func blurImage(blob []byte) ([]byte, error) {
+	img, err := imaging.Decode(bytes.NewReader(blob), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode image")
+	}
+	img = imaging.Fit(img, thumbnailMaxSize, thumbnailMaxSize, imaging.Lanczos)
+	img = imaging.Blur(img, sensitiveImageBlurSigma)
+	var buffer bytes.Buffer
+	if err := imaging.Encode(&buffer, img, imaging.JPEG); err != nil {
+		return nil, errors.Wrap(err, "failed to encode image")
+	}
+	return buffer.Bytes(), nil
+}
+
+func validateWorkspaceSensitiveContentSetting(setting *storepb.WorkspaceSensitiveContentSetting) error {
+	if setting == nil {
+		return errors.New("sensitive content setting is required")
+	}
+	if _, ok := storepb.SensitiveContentPolicy_name[int32(setting.Policy)]; !ok {
+		return errors.Errorf("unknown policy %d", setting.Policy)
+	}
+	if setting.ClassifierEndpoint != "" {
+		u, err := url.Parse(setting.ClassifierEndpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("invalid classifier endpoint %q", setting.ClassifierEndpoint)
+		}
+	}
+	return nil
+}
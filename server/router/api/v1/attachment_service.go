@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/disintegration/imaging"
 	"github.com/lithammer/shortuuid/v4"
@@ -22,14 +23,19 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/filemeta"
+	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/plugin/storage/s3"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/attachmentclassify"
 	"github.com/usememos/memos/store"
 )
 
@@ -41,6 +47,13 @@ const (
 	MebiByte                 = 1024 * 1024
 	// ThumbnailCacheFolder is the folder name where the thumbnail images are stored.
 	ThumbnailCacheFolder = ".thumbnail_cache"
+
+	// maxAttachmentFilenameLength is the maximum number of characters of the filename of an attachment.
+	maxAttachmentFilenameLength = 255
+	// maxAttachmentCaptionLength is the maximum number of characters of the caption of an attachment.
+	maxAttachmentCaptionLength = 1000
+	// maxAttachmentAltTextLength is the maximum number of characters of the alt text of an attachment.
+	maxAttachmentAltTextLength = 1000
 )
 
 var SupportedThumbnailMimeTypes = []string{
@@ -64,9 +77,18 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 	if request.Attachment.Filename == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "filename is required")
 	}
+	if utf8.RuneCountInString(request.Attachment.Caption) > maxAttachmentCaptionLength {
+		return nil, status.Errorf(codes.InvalidArgument, "caption must be at most %d characters", maxAttachmentCaptionLength)
+	}
+	if utf8.RuneCountInString(request.Attachment.AltText) > maxAttachmentAltTextLength {
+		return nil, status.Errorf(codes.InvalidArgument, "alt text must be at most %d characters", maxAttachmentAltTextLength)
+	}
 	if request.Attachment.Type == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "type is required")
 	}
+	if err := s.checkNewUserLimit(ctx, user, newUserLimitActionCreateAttachment); err != nil {
+		return nil, err
+	}
 
 	// Use provided attachment_id or generate a new one
 	attachmentUID := request.AttachmentId
@@ -86,19 +108,38 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 		return nil, status.Errorf(codes.Internal, "failed to get workspace storage setting: %v", err)
 	}
 	size := binary.Size(request.Attachment.Content)
-	uploadSizeLimit := int(workspaceStorageSetting.UploadSizeLimitMb) * MebiByte
-	if uploadSizeLimit == 0 {
-		uploadSizeLimit = MaxUploadBufferSizeBytes
-	}
-	if size > uploadSizeLimit {
+	if size > getUploadSizeLimit(workspaceStorageSetting) {
 		return nil, status.Errorf(codes.InvalidArgument, "file size exceeds the limit")
 	}
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceStorage, int64(size)); err != nil {
+		return nil, err
+	}
 	create.Size = int64(size)
 	create.Blob = request.Attachment.Content
+	// The metadata and text are extracted before saving, as the blob is not kept in memory for external storages.
+	metadata := filemeta.Extract(create.Type, create.Blob)
+	extractedText := filemeta.ExtractText(create.Type, create.Blob)
 
-	if err := SaveAttachmentBlob(ctx, s.Profile, s.Store, create); err != nil {
+	if err := s.saveAttachmentBlob(ctx, create); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to save attachment blob: %v", err)
 	}
+	if metadata != nil || extractedText != "" {
+		if create.Payload == nil {
+			create.Payload = &storepb.AttachmentPayload{}
+		}
+		if metadata != nil {
+			create.Payload.Metadata = convertFileMetadataToStore(metadata)
+		}
+		create.Payload.ExtractedText = extractedText
+	}
+	caption, altText := strings.TrimSpace(request.Attachment.Caption), strings.TrimSpace(request.Attachment.AltText)
+	if caption != "" || altText != "" {
+		if create.Payload == nil {
+			create.Payload = &storepb.AttachmentPayload{}
+		}
+		create.Payload.Caption = caption
+		create.Payload.AltText = altText
+	}
 
 	if request.Attachment.Memo != nil {
 		memoUID, err := ExtractMemoUIDFromName(*request.Attachment.Memo)
@@ -118,10 +159,33 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
 	}
+	if s.AttachmentClassifier != nil && attachmentclassify.IsClassifiable(attachment) {
+		go func() {
+			if err := s.AttachmentClassifier.Classify(context.Background(), attachment); err != nil {
+				slog.Warn("failed to classify attachment", slog.Any("error", err))
+			}
+		}()
+	}
+	if s.AttachmentExtractor != nil {
+		go func() {
+			if err := s.AttachmentExtractor.Extract(context.Background(), attachment); err != nil {
+				slog.Warn("failed to extract attachment", slog.Any("error", err))
+			}
+		}()
+	}
 
 	return convertAttachmentFromStore(attachment), nil
 }
 
+// getUploadSizeLimit returns the maximum size in bytes of an attachment uploaded through the API.
+func getUploadSizeLimit(workspaceStorageSetting *storepb.WorkspaceStorageSetting) int {
+	uploadSizeLimit := int(workspaceStorageSetting.UploadSizeLimitMb) * MebiByte
+	if uploadSizeLimit == 0 {
+		uploadSizeLimit = MaxUploadBufferSizeBytes
+	}
+	return uploadSizeLimit
+}
+
 func (s *APIV1Service) ListAttachments(ctx context.Context, request *v1pb.ListAttachmentsRequest) (*v1pb.ListAttachmentsResponse, error) {
 	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
@@ -155,6 +219,12 @@ func (s *APIV1Service) ListAttachments(ctx context.Context, request *v1pb.ListAt
 		Limit:     &pageSize,
 		Offset:    &offset,
 	}
+	if request.Filter != "" {
+		if err := s.validateAttachmentFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		findAttachment.Filters = append(findAttachment.Filters, request.Filter)
+	}
 
 	attachments, err := s.Store.ListAttachments(ctx, findAttachment)
 	if err != nil {
@@ -179,6 +249,82 @@ func (s *APIV1Service) ListAttachments(ctx context.Context, request *v1pb.ListAt
 	return response, nil
 }
 
+func (s *APIV1Service) ListMediaAttachments(ctx context.Context, request *v1pb.ListMediaAttachmentsRequest) (*v1pb.ListMediaAttachmentsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 1000 {
+		pageSize = 1000
+	}
+	offset := 0
+	if request.PageToken != "" {
+		if parsed, err := fmt.Sscanf(request.PageToken, "%d", &offset); err != nil || parsed != 1 || offset < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
+		}
+	}
+
+	normalStatus := store.Normal
+	// Fetch one more attachment to know whether there is a next page.
+	limit := pageSize + 1
+	findAttachment := &store.FindAttachment{
+		TypePrefixList:     []string{"image/", "video/", "audio/"},
+		MemoRowStatus:      &normalStatus,
+		MemoVisibilityList: []store.Visibility{store.Public},
+		Limit:              &limit,
+		Offset:             &offset,
+	}
+	if user != nil {
+		visibilities, err := s.getVisibleMemoVisibilities(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		findAttachment.MemoVisibilityList = visibilities
+		findAttachment.MemoViewerID = &user.ID
+	}
+	if request.Filter != "" {
+		if err := s.validateAttachmentFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		findAttachment.Filters = append(findAttachment.Filters, request.Filter)
+	}
+	attachments, err := s.Store.ListAttachments(ctx, findAttachment)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachments: %v", err)
+	}
+
+	response := &v1pb.ListMediaAttachmentsResponse{}
+	if len(attachments) > pageSize {
+		attachments = attachments[:pageSize]
+		response.NextPageToken = fmt.Sprintf("%d", offset+pageSize)
+	}
+	attachments, err = s.filterRestrictedAttachments(ctx, attachments)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to filter attachments: %v", err)
+	}
+	for _, attachment := range attachments {
+		response.Attachments = append(response.Attachments, convertAttachmentFromStore(attachment))
+	}
+	return response, nil
+}
+
+// validateAttachmentFilter checks that the filter is a valid attachment filter.
+func (s *APIV1Service) validateAttachmentFilter(ctx context.Context, filterStr string) error {
+	engine, err := filter.AttachmentEngine()
+	if err != nil {
+		return err
+	}
+	if _, err := engine.CompileToStatement(ctx, filterStr, filter.RenderOptions{Dialect: s.filterDialect()}); err != nil {
+		return errors.Wrap(err, "failed to compile filter")
+	}
+	return nil
+}
+
 func (s *APIV1Service) GetAttachment(ctx context.Context, request *v1pb.GetAttachmentRequest) (*v1pb.Attachment, error) {
 	attachmentUID, err := ExtractAttachmentUIDFromName(request.Name)
 	if err != nil {
@@ -209,30 +355,37 @@ func (s *APIV1Service) GetAttachmentBinary(ctx context.Context, request *v1pb.Ge
 	if attachment == nil {
 		return nil, status.Errorf(codes.NotFound, "attachment not found")
 	}
-	// Check the related memo visibility.
-	if attachment.MemoID != nil {
-		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
-			ID: attachment.MemoID,
-		})
+	// A signed URL grants the access of its signer.
+	viewer, err := s.getAttachmentViewer(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAttachmentMemoAccess(ctx, viewer, attachment); err != nil {
+		return nil, err
+	}
+
+	blur, err := s.checkSensitiveAttachmentAccess(ctx, viewer, attachment, request.Reveal)
+	if err != nil {
+		return nil, err
+	}
+	if blur {
+		blob, err := s.GetAttachmentBlob(attachment)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to find memo by ID: %v", attachment.MemoID)
+			return nil, status.Errorf(codes.Internal, "failed to get attachment blob: %v", err)
 		}
-		if memo != nil && memo.Visibility != store.Public {
-			user, err := s.GetCurrentUser(ctx)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
-			}
-			if user == nil {
-				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
-			}
-			if memo.Visibility == store.Private && user.ID != attachment.CreatorID {
-				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
-			}
+		blurredBlob, err := blurImage(blob)
+		if err != nil {
+			// Sensitive attachments that cannot be blurred are only served when revealed.
+			return nil, status.Errorf(codes.PermissionDenied, "sensitive attachment must be revealed")
 		}
+		return &httpbody.HttpBody{
+			ContentType: "image/jpeg",
+			Data:        blurredBlob,
+		}, nil
 	}
 
 	if request.Thumbnail && util.HasPrefixes(attachment.Type, SupportedThumbnailMimeTypes...) {
-		thumbnailBlob, err := s.getOrGenerateThumbnail(attachment)
+		thumbnailBlob, err := s.getOrGenerateThumbnail(ctx, attachment)
 		if err != nil {
 			// thumbnail failures are logged as warnings and not cosidered critical failures as
 			// a attachment image can be used in its place.
@@ -305,6 +458,59 @@ func (s *APIV1Service) GetAttachmentBinary(ctx context.Context, request *v1pb.Ge
 	}, nil
 }
 
+func (s *APIV1Service) GetAttachmentText(ctx context.Context, request *v1pb.GetAttachmentTextRequest) (*v1pb.AttachmentText, error) {
+	attachmentUID, err := ExtractAttachmentUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment id: %v", err)
+	}
+	attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{UID: &attachmentUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+	}
+	if attachment == nil {
+		return nil, status.Errorf(codes.NotFound, "attachment not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkAttachmentMemoAccess(ctx, user, attachment); err != nil {
+		return nil, err
+	}
+	return &v1pb.AttachmentText{
+		Name: request.Name,
+		Text: getAttachmentText(attachment),
+	}, nil
+}
+
+// checkAttachmentMemoAccess checks whether the user, nil if anonymous, can see the memo the attachment belongs to.
+func (s *APIV1Service) checkAttachmentMemoAccess(ctx context.Context, user *store.User, attachment *store.Attachment) error {
+	if attachment.MemoID == nil {
+		return nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		ID: attachment.MemoID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to find memo by ID: %v", attachment.MemoID)
+	}
+	if memo != nil && memo.Visibility != store.Public {
+		if user == nil {
+			return status.Errorf(codes.Unauthenticated, "unauthorized access")
+		}
+		if user.ID != attachment.CreatorID {
+			canView, err := s.canViewMemo(ctx, user, memo)
+			if err != nil {
+				return err
+			}
+			if !canView {
+				return status.Errorf(codes.Unauthenticated, "unauthorized access")
+			}
+		}
+	}
+	return nil
+}
+
 func (s *APIV1Service) UpdateAttachment(ctx context.Context, request *v1pb.UpdateAttachmentRequest) (*v1pb.Attachment, error) {
 	attachmentUID, err := ExtractAttachmentUIDFromName(request.Attachment.Name)
 	if err != nil {
@@ -313,19 +519,54 @@ func (s *APIV1Service) UpdateAttachment(ctx context.Context, request *v1pb.Updat
 	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
 	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
 	attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{UID: &attachmentUID})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get attachment: %v", err)
 	}
+	if attachment == nil {
+		return nil, status.Errorf(codes.NotFound, "attachment not found")
+	}
+	if attachment.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
 
 	currentTs := time.Now().Unix()
 	update := &store.UpdateAttachment{
 		ID:        attachment.ID,
 		UpdatedTs: &currentTs,
 	}
+	payload := proto.CloneOf(attachment.Payload)
+	if payload == nil {
+		payload = &storepb.AttachmentPayload{}
+	}
 	for _, field := range request.UpdateMask.Paths {
-		if field == "filename" {
+		switch field {
+		case "filename":
+			if err := validateAttachmentFilename(request.Attachment.Filename); err != nil {
+				return nil, err
+			}
 			update.Filename = &request.Attachment.Filename
+		case "caption":
+			if utf8.RuneCountInString(request.Attachment.Caption) > maxAttachmentCaptionLength {
+				return nil, status.Errorf(codes.InvalidArgument, "caption must be at most %d characters", maxAttachmentCaptionLength)
+			}
+			payload.Caption = strings.TrimSpace(request.Attachment.Caption)
+			update.Payload = payload
+		case "alt_text":
+			if utf8.RuneCountInString(request.Attachment.AltText) > maxAttachmentAltTextLength {
+				return nil, status.Errorf(codes.InvalidArgument, "alt text must be at most %d characters", maxAttachmentAltTextLength)
+			}
+			payload.AltText = strings.TrimSpace(request.Attachment.AltText)
+			update.Payload = payload
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", field)
 		}
 	}
 
@@ -337,6 +578,20 @@ func (s *APIV1Service) UpdateAttachment(ctx context.Context, request *v1pb.Updat
 	})
 }
 
+// validateAttachmentFilename checks the new filename of an attachment, which is a single path segment of its URL.
+func validateAttachmentFilename(filename string) error {
+	if strings.TrimSpace(filename) == "" {
+		return status.Errorf(codes.InvalidArgument, "filename is required")
+	}
+	if utf8.RuneCountInString(filename) > maxAttachmentFilenameLength {
+		return status.Errorf(codes.InvalidArgument, "filename must be at most %d characters", maxAttachmentFilenameLength)
+	}
+	if strings.ContainsAny(filename, "/\\") || filename == "." || filename == ".." {
+		return status.Errorf(codes.InvalidArgument, "invalid filename: %s", filename)
+	}
+	return nil
+}
+
 func (s *APIV1Service) DeleteAttachment(ctx context.Context, request *v1pb.DeleteAttachmentRequest) (*emptypb.Empty, error) {
 	attachmentUID, err := ExtractAttachmentUIDFromName(request.Name)
 	if err != nil {
@@ -375,6 +630,9 @@ func convertAttachmentFromStore(attachment *store.Attachment) *v1pb.Attachment {
 		Filename:   attachment.Filename,
 		Type:       attachment.Type,
 		Size:       attachment.Size,
+		Sensitive:  isAttachmentSensitive(attachment),
+		Caption:    attachment.Payload.GetCaption(),
+		AltText:    attachment.Payload.GetAltText(),
 	}
 	if attachment.MemoUID != nil && *attachment.MemoUID != "" {
 		memoName := fmt.Sprintf("%s%s", MemoNamePrefix, *attachment.MemoUID)
@@ -383,10 +641,51 @@ func convertAttachmentFromStore(attachment *store.Attachment) *v1pb.Attachment {
 	if attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL || attachment.StorageType == storepb.AttachmentStorageType_S3 {
 		attachmentMessage.ExternalLink = attachment.Reference
 	}
+	if metadata := attachment.Payload.GetMetadata(); metadata != nil {
+		attachmentMessage.Metadata = convertFileMetadataFromStore(metadata)
+	}
 
 	return attachmentMessage
 }
 
+func convertFileMetadataToStore(metadata *filemeta.Metadata) *storepb.AttachmentPayload_Metadata {
+	storeMetadata := &storepb.AttachmentPayload_Metadata{
+		Width:      int32(metadata.Width),
+		Height:     int32(metadata.Height),
+		DurationMs: metadata.Duration.Milliseconds(),
+		PageCount:  int32(metadata.PageCount),
+	}
+	if !metadata.OriginalTime.IsZero() {
+		storeMetadata.OriginalTs = metadata.OriginalTime.Unix()
+	}
+	return storeMetadata
+}
+
+func convertFileMetadataFromStore(metadata *storepb.AttachmentPayload_Metadata) *v1pb.Attachment_Metadata {
+	metadataMessage := &v1pb.Attachment_Metadata{
+		Width:     metadata.Width,
+		Height:    metadata.Height,
+		PageCount: metadata.PageCount,
+	}
+	if metadata.DurationMs > 0 {
+		metadataMessage.Duration = durationpb.New(time.Duration(metadata.DurationMs) * time.Millisecond)
+	}
+	if metadata.OriginalTs != 0 {
+		metadataMessage.OriginalTime = timestamppb.New(time.Unix(metadata.OriginalTs, 0))
+	}
+	return metadataMessage
+}
+
+// saveAttachmentBlob saves the blob of the attachment with SaveAttachmentBlob, alerting of the failures of the storage.
+func (s *APIV1Service) saveAttachmentBlob(ctx context.Context, create *store.Attachment) error {
+	err := SaveAttachmentBlob(ctx, s.Profile, s.Store, create)
+	// The writes abandoned by the callers are not failures of the storage.
+	if err != nil && ctx.Err() == nil {
+		s.raiseAlert(ctx, alertKindStorageWrite, "Storage write failing", fmt.Sprintf("Failed to save the attachment %q: %v.", create.Filename, err))
+	}
+	return err
+}
+
 // SaveAttachmentBlob save the blob of attachment based on the storage config.
 func SaveAttachmentBlob(ctx context.Context, profile *profile.Profile, stores *store.Store, create *store.Attachment) error {
 	workspaceStorageSetting, err := stores.GetWorkspaceStorageSetting(ctx)
@@ -528,8 +827,9 @@ const (
 	thumbnailMaxSize = 600
 )
 
-// getOrGenerateThumbnail returns the thumbnail image of the attachment.
-func (s *APIV1Service) getOrGenerateThumbnail(attachment *store.Attachment) ([]byte, error) {
+// getOrGenerateThumbnail returns the thumbnail image of the attachment. The thumbnails are generated by the thumbnail
+// work pool, failing with workpool.ErrBusy when it is busy.
+func (s *APIV1Service) getOrGenerateThumbnail(ctx context.Context, attachment *store.Attachment) ([]byte, error) {
 	thumbnailCacheFolder := filepath.Join(s.Profile.Data, ThumbnailCacheFolder)
 	if err := os.MkdirAll(thumbnailCacheFolder, os.ModePerm); err != nil {
 		return nil, errors.Wrap(err, "failed to create thumbnail cache folder")
@@ -541,6 +841,11 @@ func (s *APIV1Service) getOrGenerateThumbnail(attachment *store.Attachment) ([]b
 		}
 
 		// If thumbnail image does not exist, generate and save the thumbnail image.
+		release, err := s.getWorkPool(workPoolThumbnail).Acquire(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to wait for the thumbnail generation")
+		}
+		defer release()
 		blob, err := s.GetAttachmentBlob(attachment)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to get attachment blob")
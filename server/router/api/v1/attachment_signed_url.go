@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// defaultAttachmentSignedURLTTL is how long a signed attachment URL is valid when the request does not set it.
+	defaultAttachmentSignedURLTTL = 15 * time.Minute
+	// maxAttachmentSignedURLTTL is the longest validity of a signed attachment URL.
+	maxAttachmentSignedURLTTL = 24 * time.Hour
+)
+
+// CreateAttachmentSignedUrl returns a URL of the attachment binary granting the access of the current user until it
+// expires, so that it can be loaded without the credentials of the user.
+func (s *APIV1Service) CreateAttachmentSignedUrl(ctx context.Context, request *v1pb.CreateAttachmentSignedUrlRequest) (*v1pb.AttachmentSignedUrl, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	attachmentUID, err := ExtractAttachmentUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment id: %v", err)
+	}
+	ttl := defaultAttachmentSignedURLTTL
+	if request.Ttl != nil {
+		ttl = request.Ttl.AsDuration()
+		if ttl <= 0 || ttl > maxAttachmentSignedURLTTL {
+			return nil, status.Errorf(codes.InvalidArgument, "ttl must be positive and at most %s", maxAttachmentSignedURLTTL)
+		}
+	}
+
+	attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{UID: &attachmentUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+	}
+	if attachment == nil {
+		return nil, status.Errorf(codes.NotFound, "attachment not found")
+	}
+	// Only the users who can see the attachment may share access to it.
+	if err := s.checkAttachmentMemoAccess(ctx, user, attachment); err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("%s%s", AttachmentNamePrefix, attachment.UID)
+	signer := fmt.Sprintf("%s%d", UserNamePrefix, user.ID)
+	expireTime := time.Now().Add(ttl).Truncate(time.Second)
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expireTime.Unix(), 10))
+	query.Set("signer", signer)
+	query.Set("signature", s.signAttachmentURL(name, signer, expireTime.Unix()))
+	return &v1pb.AttachmentSignedUrl{
+		Url:        fmt.Sprintf("/file/%s/%s?%s", name, url.PathEscape(attachment.Filename), query.Encode()),
+		ExpireTime: timestamppb.New(expireTime),
+	}, nil
+}
+
+// getAttachmentViewer returns the user whose access the request of the attachment binary has: the signer of a signed
+// URL, or else the current user. It is nil for anonymous requests.
+func (s *APIV1Service) getAttachmentViewer(ctx context.Context, request *v1pb.GetAttachmentBinaryRequest) (*store.User, error) {
+	if request.Signature == "" {
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		}
+		return user, nil
+	}
+
+	expected := s.signAttachmentURL(request.Name, request.Signer, request.Expires)
+	if !hmac.Equal([]byte(request.Signature), []byte(expected)) {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid signature")
+	}
+	if time.Now().Unix() > request.Expires {
+		return nil, status.Errorf(codes.Unauthenticated, "signed URL expired")
+	}
+	signerID, err := ExtractUserIDFromName(request.Signer)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid signer: %v", err)
+	}
+	// The URLs of the users who were deleted or archived since they signed them are not valid anymore.
+	signer, err := s.Store.GetUser(ctx, &store.FindUser{ID: &signerID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if signer == nil || signer.RowStatus == store.Archived {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid signer")
+	}
+	return signer, nil
+}
+
+// signAttachmentURL returns the signature of the URL of the attachment signed by the user until the expiration,
+// keyed with the workspace secret.
+func (s *APIV1Service) signAttachmentURL(name, signer string, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(s.Secret))
+	mac.Write([]byte(name + "\n" + signer + "\n" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
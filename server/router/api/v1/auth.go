@@ -16,9 +16,8 @@ const (
 	// This identifies tokens as issued by Memos.
 	Issuer = "memos"
 
-	// KeyID is the key identifier used in JWT header.
-	// Version "v1" allows for future key rotation while maintaining backward compatibility.
-	// If signing mechanism changes, add "v2", "v3", etc. and verify both versions.
+	// KeyID is the key identifier used in JWT header of the tokens signed with the server secret.
+	// Rotating the signing key adds the keys "v2", "v3", etc., see RotateAccessTokenSigningKey.
 	KeyID = "v1"
 
 	// AccessTokenAudienceName is the audience claim for JWT access tokens.
@@ -55,20 +54,21 @@ type ClaimsMessage struct {
 // - username: The user's username (stored in "name" claim)
 // - userID: The user's ID (stored in "sub" claim)
 // - expirationTime: When the token expires (pass zero time for no expiration)
-// - secret: Server secret used to sign the token
+// - keyID: ID of the key used to sign the token (stored in "kid" header)
+// - secret: Secret of the key used to sign the token
 //
 // Returns a signed JWT string or an error.
-func GenerateAccessToken(username string, userID int32, expirationTime time.Time, secret []byte) (string, error) {
-	return generateToken(username, userID, AccessTokenAudienceName, expirationTime, secret)
+func GenerateAccessToken(username string, userID int32, expirationTime time.Time, keyID string, secret []byte) (string, error) {
+	return generateToken(username, userID, AccessTokenAudienceName, expirationTime, keyID, secret)
 }
 
 // generateToken generates a JWT token with the given claims.
 //
 // Token structure:
-// Header: {"alg": "HS256", "kid": "v1", "typ": "JWT"}
+// Header: {"alg": "HS256", "kid": keyID, "typ": "JWT"}
 // Claims: {"name": username, "iss": "memos", "aud": [audience], "sub": userID, "iat": now, "exp": expiry}
 // Signature: HMACSHA256(base64UrlEncode(header) + "." + base64UrlEncode(payload), secret).
-func generateToken(username string, userID int32, audience string, expirationTime time.Time, secret []byte) (string, error) {
+func generateToken(username string, userID int32, audience string, expirationTime time.Time, keyID string, secret []byte) (string, error) {
 	registeredClaims := jwt.RegisteredClaims{
 		Issuer:   Issuer,
 		Audience: jwt.ClaimStrings{audience},
@@ -84,7 +84,7 @@ func generateToken(username string, userID int32, audience string, expirationTim
 		Name:             username,
 		RegisteredClaims: registeredClaims,
 	})
-	token.Header["kid"] = KeyID
+	token.Header["kid"] = keyID
 
 	// Create the JWT string.
 	tokenString, err := token.SignedString(secret)
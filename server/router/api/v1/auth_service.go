@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/netip"
 	"regexp"
 	"strings"
 	"time"
@@ -162,6 +163,12 @@ func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSe
 			if workspaceGeneralSetting.DisallowUserRegistration {
 				return nil, status.Errorf(codes.PermissionDenied, "user registration is not allowed")
 			}
+			if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceUsers, 1); err != nil {
+				return nil, err
+			}
+			if _, err := s.checkLegalConsent(ctx, 0, request.LegalConsentVersion); err != nil {
+				return nil, err
+			}
 
 			// Create a new user with the user info from the identity provider.
 			userCreate := &store.User{
@@ -185,6 +192,9 @@ func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSe
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to create user, error: %v", err)
 			}
+			if err := s.applyWorkspaceOnboarding(ctx, user); err != nil {
+				slog.Warn("failed to apply workspace onboarding", "user", user.ID, "error", err)
+			}
 		}
 		existingUser = user
 	}
@@ -195,6 +205,13 @@ func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSe
 	if existingUser.RowStatus == store.Archived {
 		return nil, status.Errorf(codes.PermissionDenied, "user has been archived with username %s", existingUser.Username)
 	}
+	legalConsentVersion, err := s.checkLegalConsent(ctx, existingUser.ID, request.LegalConsentVersion)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.recordLegalConsent(ctx, existingUser.ID, legalConsentVersion); err != nil {
+		return nil, err
+	}
 
 	// Default session expiration time is 100 year
 	expireTime := time.Now().Add(100 * 365 * 24 * time.Hour)
@@ -388,9 +405,7 @@ func (s *APIV1Service) extractClientInfo(ctx context.Context) *storepb.SessionsU
 			s.parseUserAgent(userAgent, clientInfo)
 		}
 		if forwardedFor := md.Get("x-forwarded-for"); len(forwardedFor) > 0 {
-			ipAddress := strings.Split(forwardedFor[0], ",")[0] // Get the first IP in case of multiple
-			ipAddress = strings.TrimSpace(ipAddress)
-			clientInfo.IpAddress = ipAddress
+			clientInfo.IpAddress = s.extractForwardedClientIP(strings.Join(forwardedFor, ","))
 		} else if realIP := md.Get("x-real-ip"); len(realIP) > 0 {
 			clientInfo.IpAddress = realIP[0]
 		}
@@ -399,6 +414,27 @@ func (s *APIV1Service) extractClientInfo(ctx context.Context) *storepb.SessionsU
 	return clientInfo
 }
 
+// extractForwardedClientIP returns the client IP from an X-Forwarded-For header.
+// Without trusted proxies the first address is used. Otherwise the addresses appended by
+// trusted proxies are skipped from the right, since only those can't be spoofed by the client.
+func (s *APIV1Service) extractForwardedClientIP(forwardedFor string) string {
+	addresses := strings.Split(forwardedFor, ",")
+	for i := range addresses {
+		addresses[i] = strings.TrimSpace(addresses[i])
+	}
+	settings := s.runtimeSettings()
+	if len(settings.TrustedProxies) == 0 {
+		return addresses[0]
+	}
+	for i := len(addresses) - 1; i > 0; i-- {
+		addr, err := netip.ParseAddr(addresses[i])
+		if err != nil || !settings.IsTrustedProxy(addr) {
+			return addresses[i]
+		}
+	}
+	return addresses[0]
+}
+
 // parseUserAgent extracts device type, OS, and browser information from user agent string.
 //
 // Detection logic:
@@ -6,6 +6,7 @@ import (
 
 	"google.golang.org/grpc/metadata"
 
+	"github.com/usememos/memos/internal/profile"
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
@@ -119,6 +120,35 @@ func TestExtractClientInfo(t *testing.T) {
 	}
 }
 
+func TestExtractClientInfoWithTrustedProxies(t *testing.T) {
+	settings, err := profile.ParseRuntimeSettings("", "", 0, []string{"10.0.0.0/8", "192.0.2.1"})
+	if err != nil {
+		t.Fatalf("Failed to parse runtime settings: %v", err)
+	}
+	service := &APIV1Service{Profile: &profile.Profile{Runtime: profile.NewRuntimeConfig(settings)}}
+
+	tests := []struct {
+		forwardedFor string
+		expectedIP   string
+	}{
+		// The spoofed address sent by the client is ignored.
+		{forwardedFor: "203.0.113.9, 198.51.100.1, 10.1.2.3", expectedIP: "198.51.100.1"},
+		{forwardedFor: "198.51.100.1, 10.1.2.3, 192.0.2.1", expectedIP: "198.51.100.1"},
+		// Requests not coming through a trusted proxy report their own address.
+		{forwardedFor: "203.0.113.9, 198.51.100.1", expectedIP: "198.51.100.1"},
+		{forwardedFor: "10.1.2.3", expectedIP: "10.1.2.3"},
+	}
+	for _, tt := range tests {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+			"x-forwarded-for": tt.forwardedFor,
+		}))
+		clientInfo := service.extractClientInfo(ctx)
+		if clientInfo.IpAddress != tt.expectedIP {
+			t.Errorf("Expected IP address %s for %q, got %s", tt.expectedIP, tt.forwardedFor, clientInfo.IpAddress)
+		}
+	}
+}
+
 // TestClientInfoExamples demonstrates the enhanced client info extraction with various user agents.
 func TestClientInfoExamples(t *testing.T) {
 	service := &APIV1Service{}
@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/plugin/bluesky"
+	"github.com/usememos/memos/plugin/outbound"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// blueskyPostMutex serializes the cross-posts, so that a memo is never posted twice.
+var blueskyPostMutex sync.Mutex
+
+// updateBlueskySetting updates the fields of the user's Bluesky setting in the update mask, verifying the
+// credentials of the account when they change.
+func (s *APIV1Service) updateBlueskySetting(ctx context.Context, userID int32, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
+	existing, err := s.Store.GetUserBlueskySetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	blueskySetting := proto.Clone(existing).(*storepb.BlueskyUserSetting)
+
+	incoming := request.Setting.GetBlueskySetting()
+	if incoming == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bluesky setting is required")
+	}
+	credentialsChanged := false
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "enabled":
+			blueskySetting.Enabled = incoming.Enabled
+		case "service":
+			service, err := bluesky.ParseServiceURL(incoming.Service)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid service: %v", err)
+			}
+			if err := outbound.ValidateURL(ctx, service); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid service: %v", err)
+			}
+			blueskySetting.Service = service
+			credentialsChanged = true
+		case "identifier":
+			blueskySetting.Identifier = strings.TrimPrefix(strings.TrimSpace(incoming.Identifier), "@")
+			credentialsChanged = true
+		case "appPassword":
+			blueskySetting.AppPassword = strings.TrimSpace(incoming.AppPassword)
+			credentialsChanged = true
+		case "tag":
+			blueskySetting.Tag = strings.TrimPrefix(strings.TrimSpace(incoming.Tag), "#")
+		default:
+			// Ignore unsupported fields
+		}
+	}
+	if credentialsChanged {
+		blueskySetting.Did = ""
+		if blueskySetting.Identifier != "" && blueskySetting.AppPassword != "" {
+			session, err := s.createBlueskySession(ctx, blueskySetting)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "failed to sign in to bluesky: %v", err)
+			}
+			blueskySetting.Did = session.DID
+		}
+	}
+	if blueskySetting.Enabled && blueskySetting.Did == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "the identifier and the app password of the account are required")
+	}
+
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_BLUESKY,
+		Value:  &storepb.UserSetting_Bluesky{Bluesky: blueskySetting},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
+// crossPostMemoToBlueskyAsync cross-posts the memo to Bluesky in the background when its creator enabled it.
+func (s *APIV1Service) crossPostMemoToBlueskyAsync(ctx context.Context, memo *store.Memo) {
+	blueskySetting, err := s.Store.GetUserBlueskySetting(ctx, memo.CreatorID)
+	if err != nil {
+		slog.Warn("Failed to get user bluesky setting", slog.Any("err", err))
+		return
+	}
+	if !blueskySetting.Enabled {
+		return
+	}
+
+	go func() {
+		if err := s.crossPostMemoToBluesky(context.Background(), memo.CreatorID, memo.ID); err != nil {
+			slog.Warn("Failed to cross-post memo to bluesky", slog.Int("memoID", int(memo.ID)), slog.Any("err", err))
+		}
+	}()
+}
+
+// crossPostMemoToBluesky posts the memo once it is public and has the tag of the setting, and deletes its post once
+// it no longer is. Posts cannot be edited, so the post of an edited memo is left as is.
+func (s *APIV1Service) crossPostMemoToBluesky(ctx context.Context, creatorID, memoID int32) error {
+	blueskyPostMutex.Lock()
+	defer blueskyPostMutex.Unlock()
+
+	blueskySetting, err := s.Store.GetUserBlueskySetting(ctx, creatorID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user bluesky setting")
+	}
+	if !blueskySetting.Enabled || blueskySetting.Identifier == "" || blueskySetting.AppPassword == "" {
+		return nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	post, err := s.Store.GetBlueskyPost(ctx, &store.FindBlueskyPost{MemoID: &memoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get bluesky post")
+	}
+
+	eligible := isCrossPostable(memo, blueskySetting.Tag)
+	if eligible == (post != nil) {
+		return nil
+	}
+	client, err := bluesky.NewClient(blueskySetting.Service)
+	if err != nil {
+		return err
+	}
+	session, err := client.CreateSession(ctx, blueskySetting.Identifier, blueskySetting.AppPassword)
+	if err != nil {
+		return err
+	}
+
+	if !eligible {
+		if err := client.DeletePost(ctx, session, post.URI); err != nil {
+			return err
+		}
+		if err := s.Store.DeleteBlueskyPost(ctx, &store.DeleteBlueskyPost{MemoID: memoID}); err != nil {
+			return err
+		}
+		return s.setMemoSyndication(ctx, memoID, crossPostPlatformBluesky, nil)
+	}
+
+	uri, err := client.CreatePost(ctx, session, bluesky.NewPost(memo.Content, s.getMemoLink(memo), time.Unix(memo.CreatedTs, 0)))
+	if err != nil {
+		return err
+	}
+	post, err = s.Store.CreateBlueskyPost(ctx, &store.BlueskyPost{
+		MemoID:    memo.ID,
+		CreatorID: memo.CreatorID,
+		URI:       uri,
+	})
+	if err != nil {
+		return err
+	}
+	postURL, err := bluesky.PostURL(uri)
+	if err != nil {
+		return err
+	}
+	return s.setMemoSyndication(ctx, memoID, crossPostPlatformBluesky, &storepb.MemoPayload_Syndication{
+		Url:       postURL,
+		RemoteId:  uri,
+		CreatedTs: post.CreatedTs,
+	})
+}
+
+// createBlueskySession signs in to the account of the setting.
+func (*APIV1Service) createBlueskySession(ctx context.Context, blueskySetting *storepb.BlueskyUserSetting) (*bluesky.Session, error) {
+	client, err := bluesky.NewClient(blueskySetting.Service)
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateSession(ctx, blueskySetting.Identifier, blueskySetting.AppPassword)
+}
+
+func convertBlueskySettingFromStore(blueskySetting *storepb.BlueskyUserSetting) *v1pb.UserSetting_BlueskySetting {
+	service := blueskySetting.GetService()
+	if service == "" {
+		service = bluesky.DefaultService
+	}
+	// The app password is never returned.
+	return &v1pb.UserSetting_BlueskySetting{
+		Enabled:    blueskySetting.GetEnabled(),
+		Service:    service,
+		Identifier: blueskySetting.GetIdentifier(),
+		Tag:        blueskySetting.GetTag(),
+		Did:        blueskySetting.GetDid(),
+	}
+}
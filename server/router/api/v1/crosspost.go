@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// The platforms the memos are cross-posted to, as recorded in their syndications.
+const (
+	crossPostPlatformBluesky  = "bluesky"
+	crossPostPlatformMastodon = "mastodon"
+	crossPostPlatformMicropub = "micropub"
+)
+
+// crossPostMemoAsync cross-posts the memo in the background to the platforms its creator enabled, or deletes its
+// copies once it is no longer to be cross-posted. The memo is the last known state of the memo, whose syndications
+// are used to delete its copies once it has been deleted.
+func (s *APIV1Service) crossPostMemoAsync(ctx context.Context, memo *store.Memo) {
+	s.crossPostMemoToBlueskyAsync(ctx, memo)
+	s.crossPostMemoToMastodonAsync(ctx, memo)
+	s.crossPostMemoToMicropubAsync(ctx, memo)
+}
+
+// isCrossPostable reports whether the memo is to be cross-posted to a platform cross-posting the memos with the tag.
+func isCrossPostable(memo *store.Memo, tag string) bool {
+	return memo != nil && memo.Visibility == store.Public && memo.RowStatus == store.Normal && memo.ParentUID == nil && hasCrossPostTag(memo, tag)
+}
+
+// hasCrossPostTag reports whether the memo has the tag or one of its subtags, any memo matching an empty tag.
+func hasCrossPostTag(memo *store.Memo, tag string) bool {
+	if tag == "" {
+		return true
+	}
+	return slices.ContainsFunc(memo.Payload.GetTags(), func(memoTag string) bool {
+		return strings.EqualFold(memoTag, tag) || strings.HasPrefix(strings.ToLower(memoTag), strings.ToLower(tag)+"/")
+	})
+}
+
+// getMemoLink returns the URL of the memo on the instance, empty if the instance URL is not set.
+func (s *APIV1Service) getMemoLink(memo *store.Memo) string {
+	if s.Profile.InstanceURL == "" {
+		return ""
+	}
+	return strings.TrimRight(s.Profile.InstanceURL, "/") + "/memos/" + memo.UID
+}
+
+// findMemoSyndication returns the syndication of the memo to the platform, nil if it was not cross-posted to it.
+func findMemoSyndication(memo *store.Memo, platform string) *storepb.MemoPayload_Syndication {
+	for _, syndication := range memo.Payload.GetSyndications() {
+		if syndication.Platform == platform {
+			return syndication
+		}
+	}
+	return nil
+}
+
+// setMemoSyndication replaces the syndication of the memo to the platform, removing it if syndication is nil.
+// Nothing is recorded for a deleted memo.
+func (s *APIV1Service) setMemoSyndication(ctx context.Context, memoID int32, platform string, syndication *storepb.MemoPayload_Syndication) error {
+	// Reload the memo since cross-posting can take a while.
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return nil
+	}
+	if memo.Payload == nil {
+		memo.Payload = &storepb.MemoPayload{}
+	}
+	memo.Payload.Syndications = slices.DeleteFunc(memo.Payload.Syndications, func(existing *storepb.MemoPayload_Syndication) bool {
+		return existing.Platform == platform
+	})
+	if syndication != nil {
+		syndication.Platform = platform
+		memo.Payload.Syndications = append(memo.Payload.Syndications, syndication)
+	}
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Payload: memo.Payload,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update memo")
+	}
+	return nil
+}
+
+// listCrossPostMedia returns the image, video and audio attachments of the memo, at most limit of them.
+func (s *APIV1Service) listCrossPostMedia(ctx context.Context, memo *store.Memo, limit int) ([]*store.Attachment, error) {
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list attachments")
+	}
+	media := []*store.Attachment{}
+	for _, attachment := range attachments {
+		if len(media) == limit {
+			break
+		}
+		if strings.HasPrefix(attachment.Type, "image/") || strings.HasPrefix(attachment.Type, "video/") || strings.HasPrefix(attachment.Type, "audio/") {
+			media = append(media, attachment)
+		}
+	}
+	return media, nil
+}
+
+// readCrossPostMedia reads the content of the attachment to upload it.
+func (s *APIV1Service) readCrossPostMedia(ctx context.Context, attachment *store.Attachment) ([]byte, error) {
+	attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID, GetBlob: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get attachment")
+	}
+	if attachment == nil {
+		return nil, errors.New("attachment not found")
+	}
+	return s.GetAttachmentBlob(attachment)
+}
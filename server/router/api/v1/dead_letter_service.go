@@ -0,0 +1,380 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/memosclient"
+	"github.com/usememos/memos/plugin/outbound"
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// deadLetterAlertThreshold is the number of dead letters at which the admins are notified.
+	deadLetterAlertThreshold = 10
+	// deadLetterSecretPurpose derives the key sealing the secrets of the jobs, e.g. the access tokens of the imports.
+	deadLetterSecretPurpose = "memos-dead-letter"
+)
+
+// ListDeadLetters lists the failed async jobs, most recent failures first.
+func (s *APIV1Service) ListDeadLetters(ctx context.Context, request *v1pb.ListDeadLettersRequest) (*v1pb.ListDeadLettersResponse, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+
+	findDeadLetter := &store.FindDeadLetter{
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	}
+	if request.JobType != v1pb.DeadLetter_JOB_TYPE_UNSPECIFIED {
+		jobType := convertDeadLetterJobTypeToStore(request.JobType)
+		findDeadLetter.JobType = &jobType
+	}
+	deadLetters, err := s.Store.ListDeadLetters(ctx, findDeadLetter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list dead letters: %v", err)
+	}
+
+	response := &v1pb.ListDeadLettersResponse{
+		DeadLetters: []*v1pb.DeadLetter{},
+	}
+	if len(deadLetters) == limitPlusOne {
+		deadLetters = deadLetters[:limit]
+		nextPageToken, err := getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+		response.NextPageToken = nextPageToken
+	}
+	for _, deadLetter := range deadLetters {
+		response.DeadLetters = append(response.DeadLetters, convertDeadLetterFromStore(deadLetter))
+	}
+	return response, nil
+}
+
+// RetryDeadLetter retries a failed async job. Webhooks and AI summaries are retried right away, the
+// dead letter being removed when the retry succeeds and updated when it fails again. Imports are
+// restarted in the background and recorded again if they fail.
+func (s *APIV1Service) RetryDeadLetter(ctx context.Context, request *v1pb.RetryDeadLetterRequest) (*emptypb.Empty, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+	deadLetter, err := s.getDeadLetterByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &deadLetter.UserID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "the user of the job no longer exists")
+	}
+
+	var retryErr error
+	switch payload := deadLetter.Payload.Payload.(type) {
+	case *storepb.DeadLetterPayload_Webhook_:
+		retryErr = s.retryWebhookDeadLetter(ctx, user.ID, payload.Webhook)
+	case *storepb.DeadLetterPayload_AiSummary:
+		// Generate the summary on behalf of the user the job ran for.
+		jobCtx := context.WithValue(ctx, userIDContextKey, user.ID)
+		_, retryErr = s.generateAISummary(jobCtx, user, &v1pb.GenerateAISummaryRequest{
+			TimeRange:  payload.AiSummary.TimeRange,
+			Tags:       payload.AiSummary.Tags,
+			StartDate:  payload.AiSummary.StartDate,
+			EndDate:    payload.AiSummary.EndDate,
+			MemoNames:  payload.AiSummary.MemoNames,
+			Filter:     payload.AiSummary.Filter,
+			Timezone:   payload.AiSummary.Timezone,
+			Visibility: convertAISummaryVisibilityFromStore(payload.AiSummary.Visibility),
+		})
+	case *storepb.DeadLetterPayload_UserImport_:
+		accessToken, err := s.openWithWorkspaceSecret(deadLetterSecretPurpose, payload.UserImport.EncryptedAccessToken)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "the access token of the import is not available, start a new import: %v", err)
+		}
+		client, err := memosclient.NewClient(payload.UserImport.SourceUrl, string(accessToken))
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "invalid source url: %v", err)
+		}
+		if err := outbound.ValidateURL(ctx, payload.UserImport.SourceUrl); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "invalid source url: %v", err)
+		}
+		if _, err := s.startUserImportJob(user, payload.UserImport.SourceUrl, string(accessToken), client, deadLetter.Attempts+1); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "unsupported dead letter job type: %s", deadLetter.JobType)
+	}
+
+	if retryErr != nil {
+		updatedTs := time.Now().Unix()
+		errorMessage := retryErr.Error()
+		attempts := deadLetter.Attempts + 1
+		if err := s.Store.UpdateDeadLetter(ctx, &store.UpdateDeadLetter{
+			ID:        deadLetter.ID,
+			UpdatedTs: &updatedTs,
+			Error:     &errorMessage,
+			Attempts:  &attempts,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update dead letter: %v", err)
+		}
+		return nil, status.Errorf(codes.Unavailable, "retry failed: %v", retryErr)
+	}
+	if err := s.Store.DeleteDeadLetter(ctx, &store.DeleteDeadLetter{ID: deadLetter.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete dead letter: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteDeadLetter discards a failed async job without retrying it.
+func (s *APIV1Service) DeleteDeadLetter(ctx context.Context, request *v1pb.DeleteDeadLetterRequest) (*emptypb.Empty, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+	deadLetter, err := s.getDeadLetterByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteDeadLetter(ctx, &store.DeleteDeadLetter{ID: deadLetter.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete dead letter: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) getDeadLetterByName(ctx context.Context, name string) (*store.DeadLetter, error) {
+	deadLetterID, err := ExtractDeadLetterIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	deadLetter, err := s.Store.GetDeadLetter(ctx, &store.FindDeadLetter{ID: &deadLetterID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get dead letter: %v", err)
+	}
+	if deadLetter == nil {
+		return nil, status.Errorf(codes.NotFound, "dead letter not found")
+	}
+	return deadLetter, nil
+}
+
+// retryWebhookDeadLetter posts the webhook again, signed with the current secret of the user webhook
+// and recorded in its deliveries when it still exists.
+func (s *APIV1Service) retryWebhookDeadLetter(ctx context.Context, userID int32, payload *storepb.DeadLetterPayload_Webhook) error {
+	if payload.WebhookId == "" {
+		return webhook.PostBody(payload.Url, []byte(payload.Body))
+	}
+	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user webhooks")
+	}
+	index := slices.IndexFunc(webhooks, func(hook *storepb.WebhooksUserSetting_Webhook) bool {
+		return hook.Id == payload.WebhookId
+	})
+	if index < 0 {
+		return webhook.PostBody(payload.Url, []byte(payload.Body))
+	}
+	statusCode, err := webhook.SendBody(payload.Url, []byte(payload.Body), webhooks[index].Secret)
+	s.recordWebhookDelivery(ctx, userID, payload.WebhookId, payload.ActivityType, statusCode, err)
+	return err
+}
+
+func (s *APIV1Service) recordWebhookDeadLetter(ctx context.Context, userID int32, webhookID string, payload *webhook.WebhookRequestPayload, err error) {
+	deadLetter, marshalErr := newWebhookDeadLetter(userID, webhookID, payload, err)
+	if marshalErr != nil {
+		slog.ErrorContext(ctx, "failed to marshal webhook payload", "url", payload.URL, "error", marshalErr)
+		return
+	}
+	s.recordDeadLetter(ctx, deadLetter)
+}
+
+// recordWebhookDeferred keeps a webhook deferred by a maintenance window, delivered by ResumeDeferredWebhooks.
+// The admins are not alerted of the deferred webhooks.
+func (s *APIV1Service) recordWebhookDeferred(ctx context.Context, userID int32, webhookID string, payload *webhook.WebhookRequestPayload) {
+	deadLetter, err := newWebhookDeadLetter(userID, webhookID, payload, errWebhookDeferred)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to marshal webhook payload", "url", payload.URL, "error", err)
+		return
+	}
+	if _, err := s.Store.CreateDeadLetter(ctx, deadLetter); err != nil {
+		slog.ErrorContext(ctx, "failed to defer webhook", "url", payload.URL, "error", err)
+	}
+}
+
+func newWebhookDeadLetter(userID int32, webhookID string, payload *webhook.WebhookRequestPayload, err error) (*store.DeadLetter, error) {
+	body, marshalErr := json.Marshal(payload)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	return &store.DeadLetter{
+		JobType: store.DeadLetterJobTypeWebhook,
+		UserID:  userID,
+		Payload: &storepb.DeadLetterPayload{
+			Payload: &storepb.DeadLetterPayload_Webhook_{
+				Webhook: &storepb.DeadLetterPayload_Webhook{
+					Url:          payload.URL,
+					ActivityType: payload.ActivityType,
+					Body:         string(body),
+					WebhookId:    webhookID,
+				},
+			},
+		},
+		Error: err.Error(),
+	}, nil
+}
+
+func (s *APIV1Service) recordAISummaryDeadLetter(ctx context.Context, userID int32, request *v1pb.GenerateAISummaryRequest, err error) {
+	s.recordDeadLetter(ctx, &store.DeadLetter{
+		JobType: store.DeadLetterJobTypeAISummary,
+		UserID:  userID,
+		Payload: &storepb.DeadLetterPayload{
+			Payload: &storepb.DeadLetterPayload_AiSummary{
+				AiSummary: &storepb.DeadLetterPayload_AISummary{
+					TimeRange:  request.TimeRange,
+					Tags:       request.Tags,
+					StartDate:  request.StartDate,
+					EndDate:    request.EndDate,
+					MemoNames:  request.MemoNames,
+					Filter:     request.Filter,
+					Timezone:   request.Timezone,
+					Visibility: convertAISummaryVisibilityToStore(request.Visibility),
+				},
+			},
+		},
+		Error: err.Error(),
+	})
+}
+
+// recordDeadLetter keeps a failed async job for retry. Failing to record it is only logged,
+// as the job already failed and its caller has nobody to report to.
+func (s *APIV1Service) recordDeadLetter(ctx context.Context, create *store.DeadLetter) {
+	if _, err := s.Store.CreateDeadLetter(ctx, create); err != nil {
+		slog.ErrorContext(ctx, "failed to record dead letter", "job_type", create.JobType, "user", create.UserID, "error", err)
+		return
+	}
+	if err := s.alertDeadLetters(ctx); err != nil {
+		slog.WarnContext(ctx, "failed to alert admins of dead letters", "error", err)
+	}
+}
+
+// alertDeadLetters notifies the admins in their inbox when the dead letters reach the alert threshold.
+// They are alerted once as the failures accumulate, and again only after the queue has been worked off.
+func (s *APIV1Service) alertDeadLetters(ctx context.Context) error {
+	limit := deadLetterAlertThreshold + 1
+	deadLetters, err := s.Store.ListDeadLetters(ctx, &store.FindDeadLetter{Limit: &limit})
+	if err != nil {
+		return err
+	}
+	if len(deadLetters) != deadLetterAlertThreshold {
+		return nil
+	}
+
+	for _, role := range []store.Role{store.RoleHost, store.RoleAdmin} {
+		users, err := s.Store.ListUsers(ctx, &store.FindUser{Role: &role})
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			if user.RowStatus == store.Archived {
+				continue
+			}
+			if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+				SenderID:   store.SystemBotID,
+				ReceiverID: user.ID,
+				Status:     store.UNREAD,
+				Message: &storepb.InboxMessage{
+					Type: storepb.InboxMessage_DEAD_LETTER_ALERT,
+				},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func convertDeadLetterFromStore(deadLetter *store.DeadLetter) *v1pb.DeadLetter {
+	deadLetterMessage := &v1pb.DeadLetter{
+		Name:       fmt.Sprintf("%s%d", DeadLetterNamePrefix, deadLetter.ID),
+		JobType:    convertDeadLetterJobTypeFromStore(deadLetter.JobType),
+		User:       fmt.Sprintf("%s%d", UserNamePrefix, deadLetter.UserID),
+		Error:      deadLetter.Error,
+		Attempts:   deadLetter.Attempts,
+		CreateTime: timestamppb.New(time.Unix(deadLetter.CreatedTs, 0)),
+		UpdateTime: timestamppb.New(time.Unix(deadLetter.UpdatedTs, 0)),
+	}
+	// The payload is not exposed as it may hold credentials, such as the access token of an import.
+	switch payload := deadLetter.Payload.GetPayload().(type) {
+	case *storepb.DeadLetterPayload_Webhook_:
+		deadLetterMessage.Target = payload.Webhook.Url
+	case *storepb.DeadLetterPayload_AiSummary:
+		deadLetterMessage.Target = payload.AiSummary.TimeRange
+		if payload.AiSummary.TimeRange == "custom" {
+			deadLetterMessage.Target = payload.AiSummary.StartDate + " - " + payload.AiSummary.EndDate
+		}
+		if len(payload.AiSummary.Tags) > 0 {
+			deadLetterMessage.Target += " #" + strings.Join(payload.AiSummary.Tags, " #")
+		}
+	case *storepb.DeadLetterPayload_UserImport_:
+		deadLetterMessage.Target = payload.UserImport.SourceUrl
+	}
+	return deadLetterMessage
+}
+
+func convertDeadLetterJobTypeFromStore(jobType store.DeadLetterJobType) v1pb.DeadLetter_JobType {
+	switch jobType {
+	case store.DeadLetterJobTypeWebhook:
+		return v1pb.DeadLetter_WEBHOOK
+	case store.DeadLetterJobTypeAISummary:
+		return v1pb.DeadLetter_AI_SUMMARY
+	case store.DeadLetterJobTypeUserImport:
+		return v1pb.DeadLetter_USER_IMPORT
+	default:
+		return v1pb.DeadLetter_JOB_TYPE_UNSPECIFIED
+	}
+}
+
+func convertDeadLetterJobTypeToStore(jobType v1pb.DeadLetter_JobType) store.DeadLetterJobType {
+	switch jobType {
+	case v1pb.DeadLetter_WEBHOOK:
+		return store.DeadLetterJobTypeWebhook
+	case v1pb.DeadLetter_AI_SUMMARY:
+		return store.DeadLetterJobTypeAISummary
+	case v1pb.DeadLetter_USER_IMPORT:
+		return store.DeadLetterJobTypeUserImport
+	default:
+		return ""
+	}
+}
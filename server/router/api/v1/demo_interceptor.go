@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DemoModeInterceptor rejects destructive methods on public demo instances.
+type DemoModeInterceptor struct{}
+
+func NewDemoModeInterceptor() *DemoModeInterceptor {
+	return &DemoModeInterceptor{}
+}
+
+func (*DemoModeInterceptor) DemoModeInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if isBlockedInDemoModeMethod(serverInfo.FullMethod) {
+		return nil, status.Errorf(codes.PermissionDenied, "method %s is disabled in demo mode", serverInfo.FullMethod)
+	}
+	return handler(ctx, request)
+}
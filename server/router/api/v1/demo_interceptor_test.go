@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func TestDemoModeInterceptorBlockedMethods(t *testing.T) {
+	interceptor := NewDemoModeInterceptor()
+	handled := false
+	handler := func(context.Context, any) (any, error) {
+		handled = true
+		return nil, nil
+	}
+
+	for method := range blockedMethodsInDemoMode {
+		// The blocked methods must exist, a typo would leave the real method open.
+		serviceName, methodName, ok := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+		require.True(t, ok, method)
+		descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+		require.NoError(t, err, method)
+		service, ok := descriptor.(protoreflect.ServiceDescriptor)
+		require.True(t, ok, method)
+		require.NotNil(t, service.Methods().ByName(protoreflect.Name(methodName)), method)
+
+		handled = false
+		_, err = interceptor.DemoModeInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		require.Equal(t, codes.PermissionDenied, status.Code(err), method)
+		require.False(t, handled, method)
+	}
+
+	// The other methods reach the handler.
+	_, err := interceptor.DemoModeInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.MemoService/ListMemos"}, handler)
+	require.NoError(t, err)
+	require.True(t, handled)
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// computeEtag returns the etag of a resource, the hash of its fields. The etag field of the resource is expected
+// unset.
+func computeEtag(resource proto.Message) string {
+	// The deterministic marshaling orders the map entries, for the same resource to have the same etag.
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(resource)
+	if err != nil {
+		return ""
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:16])
+}
+
+// checkEtag returns an ABORTED error if the etag of a request is set and differs from the etag of the current
+// resource.
+func checkEtag(etag, currentEtag string) error {
+	if etag != "" && etag != currentEtag {
+		return status.Errorf(codes.Aborted, "etag mismatch: the resource changed since it was read")
+	}
+	return nil
+}
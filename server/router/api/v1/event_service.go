@@ -0,0 +1,109 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	defaultEventPageSize = 100
+	maxEventPageSize     = 1000
+)
+
+func (s *APIV1Service) ListEvents(ctx context.Context, request *v1pb.ListEventsRequest) (*v1pb.ListEventsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	limit := int(request.PageSize)
+	if limit <= 0 {
+		limit = defaultEventPageSize
+	}
+	if limit > maxEventPageSize {
+		limit = maxEventPageSize
+	}
+	find := &store.FindEvent{
+		UserID: &user.ID,
+		Limit:  &limit,
+	}
+	if request.Cursor != "" {
+		cursor, err := util.ConvertStringToInt32(request.Cursor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		find.AfterID = &cursor
+	}
+
+	events, err := s.Store.ListEvents(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list events: %v", err)
+	}
+
+	response := &v1pb.ListEventsResponse{
+		Events:     []*v1pb.Event{},
+		NextCursor: request.Cursor,
+	}
+	for _, event := range events {
+		eventMessage, err := convertEventFromStore(event)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert event: %v", err)
+		}
+		response.Events = append(response.Events, eventMessage)
+		response.NextCursor = strconv.Itoa(int(event.ID))
+	}
+	return response, nil
+}
+
+// recordEvent appends an event for the given resource to the event log.
+// Failures are logged rather than returned so that the originating request still succeeds.
+func (s *APIV1Service) recordEvent(ctx context.Context, eventType string, userID int32, resource string, snapshot proto.Message) {
+	payload := "{}"
+	if snapshot != nil {
+		bytes, err := protojson.Marshal(snapshot)
+		if err != nil {
+			slog.Warn("Failed to marshal event payload", slog.String("type", eventType), slog.Any("err", err))
+			return
+		}
+		payload = string(bytes)
+	}
+	if _, err := s.Store.CreateEvent(ctx, &store.Event{
+		UserID:   userID,
+		Type:     eventType,
+		Resource: resource,
+		Payload:  payload,
+	}); err != nil {
+		slog.Warn("Failed to record event", slog.String("type", eventType), slog.Any("err", err))
+	}
+}
+
+func convertEventFromStore(event *store.Event) (*v1pb.Event, error) {
+	payload := &structpb.Struct{}
+	if err := protojson.Unmarshal([]byte(event.Payload), payload); err != nil {
+		return nil, err
+	}
+	return &v1pb.Event{
+		Name:       fmt.Sprintf("%s%d", EventNamePrefix, event.ID),
+		Type:       event.Type,
+		Resource:   event.Resource,
+		Payload:    payload,
+		CreateTime: timestamppb.New(time.Unix(event.CreatedTs, 0)),
+	}, nil
+}
@@ -0,0 +1,160 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+var featureFlagNameMatcher = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,62}[a-z0-9])?$`)
+
+// ListFeatureFlags lists the feature flags evaluated for the current user, or for another user when requested by an admin.
+func (s *APIV1Service) ListFeatureFlags(ctx context.Context, request *v1pb.ListFeatureFlagsRequest) (*v1pb.ListFeatureFlagsResponse, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	user := currentUser
+	if request.User != "" {
+		userID, err := ExtractUserIDFromName(request.User)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+		}
+		if currentUser == nil {
+			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+		}
+		if currentUser.ID != userID {
+			if !isSuperUser(currentUser) {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+			}
+			user, err = s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+			}
+			if user == nil {
+				return nil, status.Errorf(codes.NotFound, "user not found")
+			}
+		}
+	}
+
+	flags, err := s.evaluateFeatureFlags(ctx, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to evaluate feature flags: %v", err)
+	}
+	return &v1pb.ListFeatureFlagsResponse{
+		Flags: flags,
+	}, nil
+}
+
+// SetUserFeatureFlag overrides a workspace feature flag for a user.
+func (s *APIV1Service) SetUserFeatureFlag(ctx context.Context, request *v1pb.SetUserFeatureFlagRequest) (*emptypb.Empty, error) {
+	userID, err := ExtractUserIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if !featureFlagNameMatcher.MatchString(request.Flag) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid feature flag name: %s", request.Flag)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(currentUser) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	if err := s.Store.SetUserFeatureFlagOverride(ctx, user.ID, request.Flag, request.Enabled); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set feature flag override: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// IsFeatureEnabled returns whether the feature flag is enabled for the user, a nil user being anonymous.
+// Features without a flag definition are disabled, so that they can ship dark.
+func (s *APIV1Service) IsFeatureEnabled(ctx context.Context, user *store.User, flag string) (bool, error) {
+	flags, err := s.evaluateFeatureFlags(ctx, user)
+	if err != nil {
+		return false, err
+	}
+	return flags[flag], nil
+}
+
+// evaluateFeatureFlags evaluates every defined feature flag for the user, a nil user being anonymous.
+// User overrides take precedence over the workspace flags, which are enabled either for every user
+// or for a stable share of users given by the rollout percentage.
+func (s *APIV1Service) evaluateFeatureFlags(ctx context.Context, user *store.User) (map[string]bool, error) {
+	featureFlagSetting, err := s.Store.GetWorkspaceFeatureFlagSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	overrides := map[string]bool{}
+	if user != nil {
+		overrides, err = s.Store.GetUserFeatureFlagOverrides(ctx, user.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user feature flag overrides")
+		}
+	}
+
+	flags := make(map[string]bool, len(featureFlagSetting.Flags))
+	for _, flag := range featureFlagSetting.Flags {
+		if enabled, ok := overrides[flag.Name]; ok {
+			flags[flag.Name] = enabled
+			continue
+		}
+		flags[flag.Name] = flag.Enabled || (user != nil && isInFeatureRollout(flag.Name, user.ID, flag.RolloutPercentage))
+	}
+	return flags, nil
+}
+
+// isInFeatureRollout buckets users by a hash of the flag name and user ID, so that a user stays in the
+// rollout while the percentage grows and each flag rolls out to a different cohort.
+func isInFeatureRollout(flag string, userID int32, percentage int32) bool {
+	if percentage <= 0 {
+		return false
+	}
+	if percentage >= 100 {
+		return true
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(fmt.Sprintf("%s:%d", flag, userID)))
+	return int32(hash.Sum32()%100) < percentage
+}
+
+func validateFeatureFlagSetting(setting *storepb.WorkspaceFeatureFlagSetting) error {
+	names := make(map[string]bool, len(setting.GetFlags()))
+	for _, flag := range setting.GetFlags() {
+		if !featureFlagNameMatcher.MatchString(flag.Name) {
+			return errors.Errorf("invalid flag name %q", flag.Name)
+		}
+		if names[flag.Name] {
+			return errors.Errorf("duplicate flag %q", flag.Name)
+		}
+		names[flag.Name] = true
+		if flag.RolloutPercentage < 0 || flag.RolloutPercentage > 100 {
+			return errors.Errorf("rollout percentage of flag %q must be between 0 and 100", flag.Name)
+		}
+	}
+	return nil
+}
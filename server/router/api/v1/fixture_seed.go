@@ -0,0 +1,150 @@
+//go:build testfixtures
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	defaultFixtureUserCount = 1
+	defaultFixtureMemoCount = 10
+	maxFixtureUserCount     = 100
+	maxFixtureMemoCount     = 1000
+	// fixtureAIModel is the model of the AI setting of the FAKE provider.
+	fixtureAIModel = "fake"
+)
+
+// fixtureVisibilities are the visibilities the fixture memos rotate through.
+var fixtureVisibilities = []store.Visibility{store.Public, store.Protected, store.Private}
+
+// fixtureTopics are the tags the fixture memos rotate through, besides the #fixture tag of all of them.
+var fixtureTopics = []string{"work", "reading", "travel", "ideas", "health"}
+
+// SeedTestFixture seeds the workspace with the users and memos of the integration tests to the host, and sets the AI
+// setting to the FAKE provider if asked.
+func (s *APIV1Service) SeedTestFixture(ctx context.Context, request *v1pb.SeedTestFixtureRequest) (*v1pb.SeedTestFixtureResponse, error) {
+	if err := s.checkHostUser(ctx); err != nil {
+		return nil, err
+	}
+	userCount := int(request.UserCount)
+	if userCount == 0 {
+		userCount = defaultFixtureUserCount
+	}
+	memoCount := int(request.MemoCount)
+	if memoCount == 0 {
+		memoCount = defaultFixtureMemoCount
+	}
+	if userCount < 0 || userCount > maxFixtureUserCount {
+		return nil, status.Errorf(codes.InvalidArgument, "user count must be between 0 and %d", maxFixtureUserCount)
+	}
+	if memoCount < 0 || memoCount > maxFixtureMemoCount {
+		return nil, status.Errorf(codes.InvalidArgument, "memo count must be between 0 and %d", maxFixtureMemoCount)
+	}
+
+	response := &v1pb.SeedTestFixtureResponse{}
+	now := time.Now()
+	for i := range userCount {
+		user, err := s.getOrCreateFixtureUser(ctx, fmt.Sprintf("fixture%d", i+1), request.Password)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create fixture user: %v", err)
+		}
+		response.Users = append(response.Users, fmt.Sprintf("%s%d", UserNamePrefix, user.ID))
+		for j := range memoCount {
+			memo, err := s.createFixtureMemo(ctx, user, j, now.AddDate(0, 0, -j))
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to create fixture memo: %v", err)
+			}
+			response.Memos = append(response.Memos, MemoNamePrefix+memo.UID)
+		}
+	}
+
+	if request.ConfigureFakeAi {
+		if err := s.configureFixtureAI(ctx); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to configure the FAKE AI provider: %v", err)
+		}
+	}
+	return response, nil
+}
+
+// getOrCreateFixtureUser returns the regular user of the username, created with the password if it does not exist.
+func (s *APIV1Service) getOrCreateFixtureUser(ctx context.Context, username, password string) (*store.User, error) {
+	user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &username})
+	if err != nil || user != nil {
+		return user, err
+	}
+	create := &store.User{
+		Username: username,
+		Role:     store.RoleUser,
+		Email:    username + "@example.com",
+		Nickname: username,
+	}
+	if password != "" {
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, err
+		}
+		create.PasswordHash = string(passwordHash)
+	}
+	return s.Store.CreateUser(ctx, create)
+}
+
+// createFixtureMemo creates the index-th fixture memo of the user, created at the time.
+func (s *APIV1Service) createFixtureMemo(ctx context.Context, user *store.User, index int, createdTime time.Time) (*store.Memo, error) {
+	topic := fixtureTopics[index%len(fixtureTopics)]
+	create := &store.Memo{
+		UID:        shortuuid.New(),
+		CreatorID:  user.ID,
+		Content:    fmt.Sprintf("Fixture memo %d of %s about %s.\n\n#fixture #%s", index+1, user.Username, topic, topic),
+		Visibility: fixtureVisibilities[index%len(fixtureVisibilities)],
+	}
+	if err := memopayload.RebuildMemoPayload(create, s.MarkdownService); err != nil {
+		return nil, err
+	}
+	memo, err := s.Store.CreateMemo(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	createdTs := createdTime.Unix()
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, CreatedTs: &createdTs, UpdatedTs: &createdTs}); err != nil {
+		return nil, err
+	}
+	memo.CreatedTs, memo.UpdatedTs = createdTs, createdTs
+	return memo, nil
+}
+
+// configureFixtureAI sets the provider of the AI setting to the FAKE provider, keeping its other fields.
+func (s *APIV1Service) configureFixtureAI(ctx context.Context) error {
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_AI_CONFIG.String(),
+	})
+	if err != nil {
+		return err
+	}
+	aiSetting := &storepb.WorkspaceAISetting{}
+	if workspaceSetting.GetAiSetting() != nil {
+		aiSetting = proto.Clone(workspaceSetting.GetAiSetting()).(*storepb.WorkspaceAISetting)
+	}
+	aiSetting.Provider = storepb.WorkspaceAISetting_FAKE
+	aiSetting.Endpoint = ""
+	aiSetting.ApiKey = ""
+	aiSetting.Model = fixtureAIModel
+	_, err = s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: aiSetting},
+	})
+	return err
+}
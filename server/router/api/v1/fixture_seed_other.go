@@ -0,0 +1,17 @@
+//go:build !testfixtures
+
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// SeedTestFixture is only available in the builds with the testfixtures build tag.
+func (*APIV1Service) SeedTestFixture(context.Context, *v1pb.SeedTestFixtureRequest) (*v1pb.SeedTestFixtureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "test fixtures are only available in the builds with the testfixtures tag")
+}
@@ -70,6 +70,16 @@ func (s *APIV1Service) UpdateIdentityProvider(ctx context.Context, request *v1pb
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid identity provider name: %v", err)
 	}
+	current, err := s.Store.GetIdentityProvider(ctx, &store.FindIdentityProvider{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get identity provider, error: %+v", err)
+	}
+	if current == nil {
+		return nil, status.Errorf(codes.NotFound, "identity provider not found")
+	}
+	if err := checkEtag(request.IdentityProvider.Etag, convertIdentityProviderFromStore(current).Etag); err != nil {
+		return nil, err
+	}
 	update := &store.UpdateIdentityProviderV1{
 		ID:   id,
 		Type: storepb.IdentityProvider_Type(storepb.IdentityProvider_Type_value[request.IdentityProvider.Type.String()]),
@@ -106,8 +116,14 @@ func (s *APIV1Service) DeleteIdentityProvider(ctx context.Context, request *v1pb
 		return nil, status.Errorf(codes.Internal, "failed to check identity provider existence: %v", err)
 	}
 	if identityProvider == nil {
+		if request.AllowMissing {
+			return &emptypb.Empty{}, nil
+		}
 		return nil, status.Errorf(codes.NotFound, "identity provider not found")
 	}
+	if err := checkEtag(request.Etag, convertIdentityProviderFromStore(identityProvider).Etag); err != nil {
+		return nil, err
+	}
 
 	if err := s.Store.DeleteIdentityProvider(ctx, &store.DeleteIdentityProvider{ID: id}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete identity provider, error: %+v", err)
@@ -143,6 +159,7 @@ func convertIdentityProviderFromStore(identityProvider *storepb.IdentityProvider
 			},
 		}
 	}
+	temp.Etag = computeEtag(temp)
 	return temp
 }
 
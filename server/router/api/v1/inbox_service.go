@@ -193,13 +193,14 @@ func (s *APIV1Service) DeleteInbox(ctx context.Context, request *v1pb.DeleteInbo
 
 func convertInboxFromStore(inbox *store.Inbox) *v1pb.Inbox {
 	return &v1pb.Inbox{
-		Name:       fmt.Sprintf("%s%d", InboxNamePrefix, inbox.ID),
-		Sender:     fmt.Sprintf("%s%d", UserNamePrefix, inbox.SenderID),
-		Receiver:   fmt.Sprintf("%s%d", UserNamePrefix, inbox.ReceiverID),
-		Status:     convertInboxStatusFromStore(inbox.Status),
-		CreateTime: timestamppb.New(time.Unix(inbox.CreatedTs, 0)),
-		Type:       v1pb.Inbox_Type(inbox.Message.Type),
-		ActivityId: inbox.Message.ActivityId,
+		Name:           fmt.Sprintf("%s%d", InboxNamePrefix, inbox.ID),
+		Sender:         fmt.Sprintf("%s%d", UserNamePrefix, inbox.SenderID),
+		Receiver:       fmt.Sprintf("%s%d", UserNamePrefix, inbox.ReceiverID),
+		Status:         convertInboxStatusFromStore(inbox.Status),
+		CreateTime:     timestamppb.New(time.Unix(inbox.CreatedTs, 0)),
+		Type:           v1pb.Inbox_Type(inbox.Message.Type),
+		ActivityId:     inbox.Message.ActivityId,
+		AnnouncementId: inbox.Message.AnnouncementId,
 	}
 }
 
@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/plugin/indieauth"
+	"github.com/usememos/memos/plugin/outbound"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// updateIndieAuthSetting updates the fields of the user's IndieAuth identity in the update mask.
+func (s *APIV1Service) updateIndieAuthSetting(ctx context.Context, userID int32, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
+	existing, err := s.Store.GetUserIndieAuthSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	indieAuthSetting := proto.Clone(existing).(*storepb.IndieAuthUserSetting)
+
+	incoming := request.Setting.GetIndieAuthSetting()
+	if incoming == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "indieauth setting is required")
+	}
+	tokenEndpointUpdated := false
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "me":
+			me := ""
+			if strings.TrimSpace(incoming.Me) != "" {
+				me, err = indieauth.CanonicalizeURL(incoming.Me)
+				if err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid me: %v", err)
+				}
+			}
+			indieAuthSetting.Me = me
+		case "tokenEndpoint":
+			tokenEndpoint := strings.TrimSpace(incoming.TokenEndpoint)
+			if tokenEndpoint != "" {
+				tokenEndpoint, err = indieauth.CanonicalizeURL(tokenEndpoint)
+				if err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid token endpoint: %v", err)
+				}
+				if err := outbound.ValidateURL(ctx, tokenEndpoint); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid token endpoint: %v", err)
+				}
+			}
+			indieAuthSetting.TokenEndpoint = tokenEndpoint
+			tokenEndpointUpdated = true
+		default:
+			// Ignore unsupported fields
+		}
+	}
+	if indieAuthSetting.Me != existing.Me && !tokenEndpointUpdated {
+		// The token endpoint of the previous identity does not apply to the new one.
+		indieAuthSetting.TokenEndpoint = ""
+	}
+	if indieAuthSetting.Me == "" {
+		indieAuthSetting.TokenEndpoint = ""
+	} else if indieAuthSetting.Me != existing.Me || indieAuthSetting.TokenEndpoint != existing.TokenEndpoint {
+		if err := s.verifyIndieAuthIdentity(ctx, userID, indieAuthSetting); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_INDIEAUTH,
+		Value:  &storepb.UserSetting_IndieAuth{IndieAuth: indieAuthSetting},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
+// verifyIndieAuthIdentity verifies the user owns the URL of the identity, whose page must advertise the Micropub
+// endpoint of the user, and sets its token endpoint to the one advertised by the page. A token endpoint set by the user
+// must be the advertised one.
+func (s *APIV1Service) verifyIndieAuthIdentity(ctx context.Context, userID int32, indieAuthSetting *storepb.IndieAuthUserSetting) error {
+	if s.Profile.InstanceURL == "" {
+		return status.Errorf(codes.FailedPrecondition, "the instance URL is required to verify the identity")
+	}
+	micropubEndpoint := fmt.Sprintf("%s/micropub/users/%d", strings.TrimRight(s.Profile.InstanceURL, "/"), userID)
+	if err := outbound.ValidateURL(ctx, indieAuthSetting.Me); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid me: %v", err)
+	}
+	endpoints, err := indieauth.Discover(ctx, indieAuthSetting.Me)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "failed to discover the endpoints of the identity: %v", err)
+	}
+	if advertised, err := indieauth.CanonicalizeURL(endpoints.Micropub); err != nil || advertised != micropubEndpoint {
+		return status.Errorf(codes.FailedPrecondition, "the page of the identity must link to %s with rel=\"micropub\"", micropubEndpoint)
+	}
+	tokenEndpoint, err := indieauth.CanonicalizeURL(endpoints.TokenEndpoint)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "the page of the identity must link to its token endpoint with rel=\"token_endpoint\"")
+	}
+	if indieAuthSetting.TokenEndpoint != "" && indieAuthSetting.TokenEndpoint != tokenEndpoint {
+		return status.Errorf(codes.InvalidArgument, "the token endpoint is not the one advertised by the identity: %s", tokenEndpoint)
+	}
+	if err := outbound.ValidateURL(ctx, tokenEndpoint); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid token endpoint: %v", err)
+	}
+	indieAuthSetting.TokenEndpoint = tokenEndpoint
+	return nil
+}
+
+// authenticateByIndieAuth authenticates the user by an access token issued for their IndieAuth identity, returning the
+// scopes of the token. The token is only sent to the token endpoint of the identity of the user, and must be issued
+// for the verified URL of the identity.
+func (s *APIV1Service) authenticateByIndieAuth(ctx context.Context, user *store.User, accessToken string) (string, error) {
+	if user.RowStatus == store.Archived {
+		return "", errors.New("invalid access token")
+	}
+	indieAuthSetting, err := s.Store.GetUserIndieAuthSetting(ctx, user.ID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get user IndieAuth setting")
+	}
+	if indieAuthSetting.GetMe() == "" || indieAuthSetting.GetTokenEndpoint() == "" {
+		return "", errors.New("invalid access token")
+	}
+	token, err := indieauth.VerifyToken(ctx, indieAuthSetting.TokenEndpoint, accessToken)
+	if err != nil {
+		slog.Debug("Failed to verify indieauth token", slog.String("tokenEndpoint", indieAuthSetting.TokenEndpoint), slog.Any("err", err))
+		return "", errors.New("invalid access token")
+	}
+	if me, err := indieauth.CanonicalizeURL(token.Me); err != nil || me != indieAuthSetting.Me {
+		return "", errors.New("the access token was issued for another identity")
+	}
+	return token.Scope, nil
+}
+
+func convertIndieAuthSettingFromStore(indieAuthSetting *storepb.IndieAuthUserSetting) *v1pb.UserSetting_IndieAuthSetting {
+	return &v1pb.UserSetting_IndieAuthSetting{
+		Me:            indieAuthSetting.GetMe(),
+		TokenEndpoint: indieAuthSetting.GetTokenEndpoint(),
+	}
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+const maxLegalVersionLength = 64
+
+func validateWorkspaceLegalSetting(setting *storepb.WorkspaceLegalSetting) error {
+	if setting == nil {
+		return errors.New("legal setting is required")
+	}
+	if utf8.RuneCountInString(setting.Version) > maxLegalVersionLength {
+		return errors.Errorf("version must be at most %d characters", maxLegalVersionLength)
+	}
+	if setting.Version != "" && setting.TermsOfService == "" && setting.PrivacyPolicy == "" {
+		return errors.New("a version requires the terms of service or the privacy policy")
+	}
+	return nil
+}
+
+// checkLegalConsent returns the version of the legal pages of the workspace to record the consent of the user to,
+// empty if there is nothing to record, or an error if the user has not consented to it and does not with
+// consentVersion. userID is 0 for a sign-up.
+func (s *APIV1Service) checkLegalConsent(ctx context.Context, userID int32, consentVersion string) (string, error) {
+	legalSetting, err := s.Store.GetWorkspaceLegalSetting(ctx)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get workspace legal setting: %v", err)
+	}
+	version := legalSetting.GetVersion()
+	if version == "" {
+		return "", nil
+	}
+	if consentVersion == version {
+		return version, nil
+	}
+	if userID != 0 {
+		consentedVersion, err := s.Store.GetUserLegalConsentVersion(ctx, userID)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to get user legal consent: %v", err)
+		}
+		if consentedVersion == version {
+			return "", nil
+		}
+	}
+	return "", status.Errorf(codes.FailedPrecondition, "consent to version %q of the terms of service and privacy policy is required", version)
+}
+
+// recordLegalConsent records the consent of the user to the version returned by checkLegalConsent, if any.
+func (s *APIV1Service) recordLegalConsent(ctx context.Context, userID int32, version string) error {
+	if version == "" {
+		return nil
+	}
+	if err := s.Store.SetUserLegalConsentVersion(ctx, userID, version); err != nil {
+		return status.Errorf(codes.Internal, "failed to record user legal consent: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/usememos/memos/plugin/workpool"
+)
+
+// methodRequestTimeouts overrides the default request timeout of methods that are expected to run longer.
+var methodRequestTimeouts = map[string]time.Duration{
+	"/memos.api.v1.AIService/ChatWithMemos":               5 * time.Minute,
+	"/memos.api.v1.AIService/CreateVoiceMemo":             5 * time.Minute,
+	"/memos.api.v1.AIService/GenerateAISummary":           5 * time.Minute,
+	"/memos.api.v1.AIService/RefineAISummary":             5 * time.Minute,
+	"/memos.api.v1.AIService/RegenerateAISummary":         5 * time.Minute,
+	"/memos.api.v1.AIService/SynthesizeMemoAudio":         10 * time.Minute,
+	"/memos.api.v1.AIService/TestAIConfig":                time.Minute,
+	"/memos.api.v1.AttachmentService/CreateAttachment":    5 * time.Minute,
+	"/memos.api.v1.UserService/TestUserWebhook":           time.Minute,
+	"/memos.api.v1.WorkspaceService/BackupDatabase":       10 * time.Minute,
+	"/memos.api.v1.WorkspaceService/DowngradePublicMemos": 5 * time.Minute,
+	"/memos.api.v1.WorkspaceService/RetryDeadLetter":      5 * time.Minute,
+}
+
+// getMethodRequestTimeout returns the timeout of the method, 0 means no timeout.
+func getMethodRequestTimeout(fullMethodName string, defaultTimeout time.Duration) time.Duration {
+	if defaultTimeout <= 0 {
+		return 0
+	}
+	if timeout, ok := methodRequestTimeouts[fullMethodName]; ok && timeout > defaultTimeout {
+		return timeout
+	}
+	return defaultTimeout
+}
+
+// The work pools limiting the number of expensive requests and jobs run at once, so that they cannot starve the
+// interactive requests of CPU and memory on small machines.
+const (
+	workPoolExport    = "export"
+	workPoolImport    = "import"
+	workPoolAI        = "AI"
+	workPoolThumbnail = "thumbnail"
+	// workPoolWebmention verifies the webmentions received one at a time, so that the webmentions received at once
+	// do not flood the sources with requests.
+	workPoolWebmention = "webmention"
+)
+
+// workPoolSize is the number of tasks of a work pool run at once, and waiting for a worker beyond them.
+type workPoolSize struct {
+	workers int
+	queue   int
+}
+
+var workPoolSizes = map[string]workPoolSize{
+	workPoolExport:     {workers: 2, queue: 4},
+	workPoolImport:     {workers: 1, queue: 8},
+	workPoolAI:         {workers: 4, queue: 8},
+	workPoolThumbnail:  {workers: 2, queue: 16},
+	workPoolWebmention: {workers: 1, queue: 64},
+}
+
+// workPoolMaxWait is how long a request waits for a worker of its work pool before failing with RESOURCE_EXHAUSTED.
+const workPoolMaxWait = 10 * time.Second
+
+// workPoolRetryDelay is the delay the clients are told to retry the requests rejected by a busy work pool after.
+const workPoolRetryDelay = 30 * time.Second
+
+// methodWorkPools assigns the expensive methods to the work pool limiting them.
+var methodWorkPools = map[string]string{
+	"/memos.api.v1.AIService/ChatWithMemos":                  workPoolAI,
+	"/memos.api.v1.AIService/CreateVoiceMemo":                workPoolAI,
+	"/memos.api.v1.AIService/ExportAIInteractions":           workPoolExport,
+	"/memos.api.v1.AIService/ExportAISummaries":              workPoolExport,
+	"/memos.api.v1.AIService/GenerateAISummary":              workPoolAI,
+	"/memos.api.v1.AIService/RefineAISummary":                workPoolAI,
+	"/memos.api.v1.AIService/RegenerateAISummary":            workPoolAI,
+	"/memos.api.v1.AIService/SynthesizeMemoAudio":            workPoolAI,
+	"/memos.api.v1.WorkspaceService/BackupDatabase":          workPoolExport,
+	"/memos.api.v1.WorkspaceService/ExportWorkspaceSettings": workPoolExport,
+}
+
+// newWorkPool returns a work pool of the size of its name.
+func newWorkPool(name string) *workpool.Pool {
+	size := workPoolSizes[name]
+	return workpool.NewPool(size.workers, size.queue, workPoolMaxWait)
+}
+
+// workPoolBusyError returns the RESOURCE_EXHAUSTED error of a request rejected by a busy work pool.
+func workPoolBusyError(name string) error {
+	return retryAfterError(codes.ResourceExhausted, time.Now().Add(workPoolRetryDelay), "too many %s requests in progress, retry later", name)
+}
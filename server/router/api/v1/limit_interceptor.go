@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/workpool"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// LimitInterceptor enforces request timeouts, payload size limits and the number of expensive requests run at once
+// before requests reach the services, so that oversized, slow or excess requests fail with an explicit error.
+type LimitInterceptor struct {
+	Store   *store.Store
+	profile *profile.Profile
+	// workPools holds the work pool of each name of methodWorkPools.
+	workPools map[string]*workpool.Pool
+}
+
+func NewLimitInterceptor(store *store.Store, profile *profile.Profile) *LimitInterceptor {
+	workPools := map[string]*workpool.Pool{}
+	for _, name := range methodWorkPools {
+		if _, ok := workPools[name]; !ok {
+			workPools[name] = newWorkPool(name)
+		}
+	}
+	return &LimitInterceptor{
+		Store:     store,
+		profile:   profile,
+		workPools: workPools,
+	}
+}
+
+func (in *LimitInterceptor) LimitInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := in.checkRequestSize(ctx, request); err != nil {
+		return nil, err
+	}
+	if name, ok := methodWorkPools[serverInfo.FullMethod]; ok {
+		release, err := in.workPools[name].Acquire(ctx)
+		if err != nil {
+			if errors.Is(err, workpool.ErrBusy) {
+				return nil, workPoolBusyError(name)
+			}
+			return nil, status.FromContextError(err).Err()
+		}
+		defer release()
+	}
+
+	timeout := getMethodRequestTimeout(serverInfo.FullMethod, in.profile.RequestTimeout)
+	if timeout == 0 {
+		return handler(ctx, request)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	resp, err := handler(ctx, request)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, status.Errorf(codes.DeadlineExceeded, "request timed out after %s", timeout)
+	}
+	return resp, err
+}
+
+func (in *LimitInterceptor) checkRequestSize(ctx context.Context, request any) error {
+	if maxRequestSize := in.profile.MaxRequestSize; maxRequestSize > 0 {
+		if message, ok := request.(proto.Message); ok {
+			if size := proto.Size(message); int64(size) > maxRequestSize {
+				return status.Errorf(codes.InvalidArgument, "request size %d bytes exceeds the limit of %d bytes", size, maxRequestSize)
+			}
+		}
+	}
+
+	switch request := request.(type) {
+	case *v1pb.CreateMemoRequest:
+		return in.checkMemoContentLength(ctx, request.GetMemo().GetContent())
+	case *v1pb.UpdateMemoRequest:
+		return in.checkMemoContentLength(ctx, request.GetMemo().GetContent())
+	case *v1pb.CreateMemoCommentRequest:
+		return in.checkMemoContentLength(ctx, request.GetComment().GetContent())
+	case *v1pb.CreateAttachmentRequest:
+		return in.checkAttachmentSize(ctx, len(request.GetAttachment().GetContent()))
+	}
+	return nil
+}
+
+func (in *LimitInterceptor) checkMemoContentLength(ctx context.Context, content string) error {
+	if content == "" {
+		return nil
+	}
+	workspaceMemoRelatedSetting, err := in.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace memo related setting: %v", err)
+	}
+	return checkContentLength(content, int(workspaceMemoRelatedSetting.ContentLengthLimit))
+}
+
+func (in *LimitInterceptor) checkAttachmentSize(ctx context.Context, size int) error {
+	if size == 0 {
+		return nil
+	}
+	workspaceStorageSetting, err := in.Store.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace storage setting: %v", err)
+	}
+	if uploadSizeLimit := getUploadSizeLimit(workspaceStorageSetting); size > uploadSizeLimit {
+		return status.Errorf(codes.InvalidArgument, "file size %d bytes exceeds the limit of %d bytes", size, uploadSizeLimit)
+	}
+	return nil
+}
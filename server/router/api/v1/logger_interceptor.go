@@ -4,10 +4,16 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/logging"
 )
 
 type LoggerInterceptor struct {
@@ -18,13 +24,35 @@ func NewLoggerInterceptor(logStacktrace bool) *LoggerInterceptor {
 	return &LoggerInterceptor{logStacktrace: logStacktrace}
 }
 
+// requestLog collects the request details only known to inner interceptors.
+type requestLog struct {
+	userID int32
+}
+
+// LoggerInterceptor assigns a request ID to each call, which is carried by the context,
+// returned in the X-Request-Id response header and attached to error details.
 func (in *LoggerInterceptor) LoggerInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	requestID := getRequestIDFromMetadata(ctx)
+	if !logging.IsValidRequestID(requestID) {
+		requestID = logging.NewRequestID()
+	}
+	ctx = logging.WithRequestID(ctx, requestID)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(strings.ToLower(logging.RequestIDHeader), requestID)); err != nil {
+		slog.DebugContext(ctx, "failed to set request id header", "error", err)
+	}
+	log := &requestLog{}
+	ctx = context.WithValue(ctx, requestLogContextKey, log)
+
+	start := time.Now()
 	resp, err := handler(ctx, request)
-	in.loggerInterceptorDo(ctx, serverInfo.FullMethod, err)
+	in.loggerInterceptorDo(ctx, serverInfo.FullMethod, log, time.Since(start), err)
+	if err != nil {
+		err = withRequestIDDetail(err, requestID)
+	}
 	return resp, err
 }
 
-func (in *LoggerInterceptor) loggerInterceptorDo(ctx context.Context, fullMethod string, err error) {
+func (in *LoggerInterceptor) loggerInterceptorDo(ctx context.Context, fullMethod string, log *requestLog, latency time.Duration, err error) {
 	st := status.Convert(err)
 	var logLevel slog.Level
 	var logMsg string
@@ -42,7 +70,14 @@ func (in *LoggerInterceptor) loggerInterceptorDo(ctx context.Context, fullMethod
 		logLevel = slog.LevelError
 		logMsg = "unknown error"
 	}
-	logAttrs := []slog.Attr{slog.String("method", fullMethod)}
+	logAttrs := []slog.Attr{
+		slog.String("method", fullMethod),
+		slog.String("code", st.Code().String()),
+		slog.Int64("latency_ms", latency.Milliseconds()),
+	}
+	if log.userID != 0 {
+		logAttrs = append(logAttrs, slog.Int("user_id", int(log.userID)))
+	}
 	if err != nil {
 		logAttrs = append(logAttrs, slog.String("error", err.Error()))
 		if in.logStacktrace {
@@ -51,3 +86,30 @@ func (in *LoggerInterceptor) loggerInterceptorDo(ctx context.Context, fullMethod
 	}
 	slog.LogAttrs(ctx, logLevel, logMsg, logAttrs...)
 }
+
+func getRequestIDFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(logging.RequestIDHeader); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// withRequestIDDetail attaches the request ID to the error returned to the client,
+// so that users can report it alongside failures.
+func withRequestIDDetail(err error, requestID string) error {
+	st := status.Convert(err)
+	for _, detail := range st.Details() {
+		if _, ok := detail.(*errdetails.RequestInfo); ok {
+			return err
+		}
+	}
+	stWithDetails, detailErr := st.WithDetails(&errdetails.RequestInfo{RequestId: requestID})
+	if detailErr != nil {
+		return err
+	}
+	return stWithDetails.Err()
+}
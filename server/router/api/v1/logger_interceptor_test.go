@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/logging"
+)
+
+func TestLoggerInterceptorRequestID(t *testing.T) {
+	interceptor := NewLoggerInterceptor(false)
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.MemoService/GetMemo"}
+
+	t.Run("incoming request ID is reused", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "client-id-1"))
+		var handlerRequestID string
+		_, err := interceptor.LoggerInterceptor(ctx, nil, serverInfo, func(ctx context.Context, _ any) (any, error) {
+			handlerRequestID = logging.RequestIDFromContext(ctx)
+			return nil, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "client-id-1", handlerRequestID)
+	})
+
+	t.Run("request ID is generated and attached to errors", func(t *testing.T) {
+		var handlerRequestID string
+		_, err := interceptor.LoggerInterceptor(context.Background(), nil, serverInfo, func(ctx context.Context, _ any) (any, error) {
+			handlerRequestID = logging.RequestIDFromContext(ctx)
+			return nil, status.Errorf(codes.NotFound, "memo not found")
+		})
+		require.Error(t, err)
+		require.NotEmpty(t, handlerRequestID)
+
+		st := status.Convert(err)
+		require.Equal(t, codes.NotFound, st.Code())
+		require.Len(t, st.Details(), 1)
+		requestInfo, ok := st.Details()[0].(*errdetails.RequestInfo)
+		require.True(t, ok)
+		require.Equal(t, handlerRequestID, requestInfo.RequestId)
+	})
+}
@@ -0,0 +1,208 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/maintenance"
+	"github.com/usememos/memos/store"
+)
+
+// errWebhookDeferred is the error of the webhooks recorded as dead letters during a maintenance window, to be
+// delivered at its end.
+var errWebhookDeferred = errors.New("webhook delivery deferred by a maintenance window")
+
+// defaultMaintenanceMessage is the announcement of the maintenance windows scheduled without a message.
+const defaultMaintenanceMessage = "The workspace is under maintenance and read-only until it ends."
+
+// ListMaintenanceWindows lists the scheduled, in progress and recently completed maintenance windows.
+func (s *APIV1Service) ListMaintenanceWindows(ctx context.Context, _ *v1pb.ListMaintenanceWindowsRequest) (*v1pb.ListMaintenanceWindowsResponse, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+	maintenanceSetting, err := s.Store.GetWorkspaceMaintenanceSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get maintenance windows: %v", err)
+	}
+	response := &v1pb.ListMaintenanceWindowsResponse{
+		MaintenanceWindows: []*v1pb.MaintenanceWindow{},
+	}
+	for _, window := range maintenanceSetting.Windows {
+		response.MaintenanceWindows = append(response.MaintenanceWindows, convertMaintenanceWindowFromStore(window))
+	}
+	return response, nil
+}
+
+// CreateMaintenanceWindow schedules a maintenance window, started right away when its start time has passed.
+func (s *APIV1Service) CreateMaintenanceWindow(ctx context.Context, request *v1pb.CreateMaintenanceWindowRequest) (*v1pb.MaintenanceWindow, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if s.MaintenanceRunner == nil {
+		return nil, status.Errorf(codes.Unavailable, "maintenance windows are not available")
+	}
+	window := request.MaintenanceWindow
+	if window == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "maintenance window is required")
+	}
+	if window.StartTime == nil || window.EndTime == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start time and end time are required")
+	}
+	create := &storepb.MaintenanceWindow{
+		StartTs:   window.StartTime.AsTime().Unix(),
+		EndTs:     window.EndTime.AsTime().Unix(),
+		Message:   strings.TrimSpace(window.Message),
+		CreatorId: user.ID,
+	}
+	if create.EndTs <= create.StartTs {
+		return nil, status.Errorf(codes.InvalidArgument, "end time must be after start time")
+	}
+	if create.EndTs <= time.Now().Unix() {
+		return nil, status.Errorf(codes.InvalidArgument, "end time must be in the future")
+	}
+	created, err := s.MaintenanceRunner.Schedule(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to schedule maintenance window: %v", err)
+	}
+	// The window may have started, return its current state.
+	windows, err := s.MaintenanceRunner.List(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get maintenance windows: %v", err)
+	}
+	if index := slices.IndexFunc(windows, func(window *storepb.MaintenanceWindow) bool { return window.Id == created.Id }); index >= 0 {
+		created = windows[index]
+	}
+	return convertMaintenanceWindowFromStore(created), nil
+}
+
+// DeleteMaintenanceWindow cancels a scheduled maintenance window, or ends a maintenance window in progress now.
+func (s *APIV1Service) DeleteMaintenanceWindow(ctx context.Context, request *v1pb.DeleteMaintenanceWindowRequest) (*emptypb.Empty, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+	if s.MaintenanceRunner == nil {
+		return nil, status.Errorf(codes.Unavailable, "maintenance windows are not available")
+	}
+	id, ok := strings.CutPrefix(request.Name, MaintenanceWindowNamePrefix)
+	if !ok || id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid maintenance window name %q", request.Name)
+	}
+	if err := s.MaintenanceRunner.Cancel(ctx, id); err != nil {
+		if errors.Is(err, maintenance.ErrWindowNotFound) {
+			return nil, status.Errorf(codes.NotFound, "maintenance window not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel maintenance window: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// AnnounceMaintenance publishes the announcement of a starting maintenance window, shown until its end, and
+// notifies the users of it in their inbox.
+func (s *APIV1Service) AnnounceMaintenance(ctx context.Context, window *storepb.MaintenanceWindow) (int32, error) {
+	content := window.Message
+	if content == "" {
+		content = defaultMaintenanceMessage
+	}
+	creatorID := window.CreatorId
+	if creatorID == 0 {
+		creatorID = store.SystemBotID
+	}
+	announcement, err := s.Store.CreateAnnouncement(ctx, &store.Announcement{
+		CreatorID: creatorID,
+		Content:   content,
+		Severity:  store.AnnouncementSeverityWarning,
+		StartTs:   time.Now().Unix(),
+		EndTs:     window.EndTs,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to create announcement")
+	}
+	if err := s.notifyAnnouncement(ctx, announcement); err != nil {
+		return announcement.ID, errors.Wrap(err, "failed to notify users of announcement")
+	}
+	return announcement.ID, nil
+}
+
+// ResumeDeferredWebhooks delivers the webhooks deferred during the maintenance windows, in the order they were
+// deferred. The ones failing are kept as regular dead letters.
+func (s *APIV1Service) ResumeDeferredWebhooks(ctx context.Context) error {
+	jobType := store.DeadLetterJobTypeWebhook
+	deadLetters, err := s.Store.ListDeadLetters(ctx, &store.FindDeadLetter{JobType: &jobType})
+	if err != nil {
+		return errors.Wrap(err, "failed to list dead letters")
+	}
+	failed := false
+	for _, deadLetter := range slices.Backward(deadLetters) {
+		if deadLetter.Error != errWebhookDeferred.Error() || deadLetter.Payload.GetWebhook() == nil {
+			continue
+		}
+		if retryErr := s.retryWebhookDeadLetter(ctx, deadLetter.UserID, deadLetter.Payload.GetWebhook()); retryErr != nil {
+			failed = true
+			updatedTs := time.Now().Unix()
+			errorMessage := retryErr.Error()
+			attempts := deadLetter.Attempts + 1
+			if err := s.Store.UpdateDeadLetter(ctx, &store.UpdateDeadLetter{
+				ID:        deadLetter.ID,
+				UpdatedTs: &updatedTs,
+				Error:     &errorMessage,
+				Attempts:  &attempts,
+			}); err != nil {
+				return errors.Wrap(err, "failed to update dead letter")
+			}
+			continue
+		}
+		if err := s.Store.DeleteDeadLetter(ctx, &store.DeleteDeadLetter{ID: deadLetter.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete dead letter")
+		}
+	}
+	if failed {
+		return s.alertDeadLetters(ctx)
+	}
+	return nil
+}
+
+func convertMaintenanceWindowFromStore(window *storepb.MaintenanceWindow) *v1pb.MaintenanceWindow {
+	windowMessage := &v1pb.MaintenanceWindow{
+		Name:      MaintenanceWindowNamePrefix + window.Id,
+		StartTime: timestamppb.New(time.Unix(window.StartTs, 0)),
+		EndTime:   timestamppb.New(time.Unix(window.EndTs, 0)),
+		Message:   window.Message,
+		State:     convertMaintenanceWindowStateFromStore(window.State),
+	}
+	if window.AnnouncementId != 0 {
+		windowMessage.Announcement = fmt.Sprintf("%s%d", AnnouncementNamePrefix, window.AnnouncementId)
+	}
+	if window.CreatorId != 0 {
+		windowMessage.Creator = fmt.Sprintf("%s%d", UserNamePrefix, window.CreatorId)
+	}
+	return windowMessage
+}
+
+func convertMaintenanceWindowStateFromStore(state storepb.MaintenanceWindowState) v1pb.MaintenanceWindow_State {
+	switch state {
+	case storepb.MaintenanceWindowState_IN_PROGRESS:
+		return v1pb.MaintenanceWindow_IN_PROGRESS
+	case storepb.MaintenanceWindowState_COMPLETED:
+		return v1pb.MaintenanceWindow_COMPLETED
+	default:
+		return v1pb.MaintenanceWindow_SCHEDULED
+	}
+}
@@ -0,0 +1,204 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/plugin/mastodon"
+	"github.com/usememos/memos/plugin/outbound"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// mastodonPostMutex serializes the cross-posts, so that a memo is never posted twice.
+var mastodonPostMutex sync.Mutex
+
+// updateMastodonSetting updates the fields of the user's Mastodon setting in the update mask, verifying the
+// credentials of the account when they change.
+func (s *APIV1Service) updateMastodonSetting(ctx context.Context, userID int32, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
+	existing, err := s.Store.GetUserMastodonSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	mastodonSetting := proto.Clone(existing).(*storepb.MastodonUserSetting)
+
+	incoming := request.Setting.GetMastodonSetting()
+	if incoming == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "mastodon setting is required")
+	}
+	credentialsChanged := false
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "enabled":
+			mastodonSetting.Enabled = incoming.Enabled
+		case "instanceUrl":
+			instanceURL := ""
+			if strings.TrimSpace(incoming.InstanceUrl) != "" {
+				instanceURL, err = mastodon.ParseInstanceURL(incoming.InstanceUrl)
+				if err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid instance url: %v", err)
+				}
+				if err := outbound.ValidateURL(ctx, instanceURL); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid instance url: %v", err)
+				}
+			}
+			mastodonSetting.InstanceUrl = instanceURL
+			credentialsChanged = true
+		case "accessToken":
+			mastodonSetting.AccessToken = strings.TrimSpace(incoming.AccessToken)
+			credentialsChanged = true
+		case "tag":
+			mastodonSetting.Tag = strings.TrimPrefix(strings.TrimSpace(incoming.Tag), "#")
+		default:
+			// Ignore unsupported fields
+		}
+	}
+	if credentialsChanged {
+		mastodonSetting.Account = ""
+		if mastodonSetting.InstanceUrl != "" && mastodonSetting.AccessToken != "" {
+			client, err := mastodon.NewClient(mastodonSetting.InstanceUrl, mastodonSetting.AccessToken)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid instance url: %v", err)
+			}
+			account, err := client.VerifyCredentials(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "failed to verify mastodon credentials: %v", err)
+			}
+			mastodonSetting.Account = account.Acct
+			// The address of local accounts has no domain.
+			if !strings.Contains(account.Acct, "@") {
+				if u, err := url.Parse(mastodonSetting.InstanceUrl); err == nil {
+					mastodonSetting.Account = account.Acct + "@" + u.Host
+				}
+			}
+		}
+	}
+	if mastodonSetting.Enabled && mastodonSetting.Account == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "the instance url and the access token of the account are required")
+	}
+
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_MASTODON,
+		Value:  &storepb.UserSetting_Mastodon{Mastodon: mastodonSetting},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
+// crossPostMemoToMastodonAsync cross-posts the memo to Mastodon in the background when its creator enabled it.
+func (s *APIV1Service) crossPostMemoToMastodonAsync(ctx context.Context, memo *store.Memo) {
+	mastodonSetting, err := s.Store.GetUserMastodonSetting(ctx, memo.CreatorID)
+	if err != nil {
+		slog.Warn("Failed to get user mastodon setting", slog.Any("err", err))
+		return
+	}
+	if !mastodonSetting.Enabled {
+		return
+	}
+
+	go func() {
+		if err := s.crossPostMemoToMastodon(context.Background(), memo); err != nil {
+			slog.Warn("Failed to cross-post memo to mastodon", slog.Int("memoID", int(memo.ID)), slog.Any("err", err))
+		}
+	}()
+}
+
+// crossPostMemoToMastodon posts the memo once it is public and has the tag of the setting, and deletes its status
+// once it no longer is. The status of an edited memo is left as is.
+func (s *APIV1Service) crossPostMemoToMastodon(ctx context.Context, lastMemo *store.Memo) error {
+	mastodonPostMutex.Lock()
+	defer mastodonPostMutex.Unlock()
+
+	mastodonSetting, err := s.Store.GetUserMastodonSetting(ctx, lastMemo.CreatorID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user mastodon setting")
+	}
+	if !mastodonSetting.Enabled || mastodonSetting.InstanceUrl == "" || mastodonSetting.AccessToken == "" {
+		return nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &lastMemo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	syndication := findMemoSyndication(lastMemo, crossPostPlatformMastodon)
+	if memo != nil {
+		syndication = findMemoSyndication(memo, crossPostPlatformMastodon)
+	}
+
+	eligible := isCrossPostable(memo, mastodonSetting.Tag)
+	if eligible == (syndication != nil) {
+		return nil
+	}
+	client, err := mastodon.NewClient(mastodonSetting.InstanceUrl, mastodonSetting.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	if !eligible {
+		if err := client.DeleteStatus(ctx, syndication.RemoteId); err != nil {
+			return err
+		}
+		return s.setMemoSyndication(ctx, lastMemo.ID, crossPostPlatformMastodon, nil)
+	}
+
+	contentWarning := memo.Payload.GetContentWarning()
+	mastodonStatus := &mastodon.Status{
+		Status:      mastodon.StatusText(memo.Content, s.getMemoLink(memo)),
+		SpoilerText: contentWarning,
+		Sensitive:   contentWarning != "",
+		Visibility:  "public",
+	}
+	media, err := s.listCrossPostMedia(ctx, memo, mastodon.MaxMediaAttachments)
+	if err != nil {
+		return err
+	}
+	for _, attachment := range media {
+		// The status is posted without the attachments that cannot be uploaded, e.g. too large for the instance.
+		blob, err := s.readCrossPostMedia(ctx, attachment)
+		if err != nil {
+			slog.Warn("Failed to read attachment to cross-post", slog.Int("attachmentID", int(attachment.ID)), slog.Any("err", err))
+			continue
+		}
+		description := attachment.Payload.GetAltText()
+		if description == "" {
+			description = attachment.Payload.GetCaption()
+		}
+		mediaID, err := client.UploadMedia(ctx, attachment.Filename, blob, description)
+		if err != nil {
+			slog.Warn("Failed to upload attachment to mastodon", slog.Int("attachmentID", int(attachment.ID)), slog.Any("err", err))
+			continue
+		}
+		mastodonStatus.MediaIDs = append(mastodonStatus.MediaIDs, mediaID)
+	}
+	posted, err := client.PostStatus(ctx, mastodonStatus)
+	if err != nil {
+		return err
+	}
+	return s.setMemoSyndication(ctx, memo.ID, crossPostPlatformMastodon, &storepb.MemoPayload_Syndication{
+		Url:       posted.URL,
+		RemoteId:  posted.ID,
+		CreatedTs: time.Now().Unix(),
+	})
+}
+
+func convertMastodonSettingFromStore(mastodonSetting *storepb.MastodonUserSetting) *v1pb.UserSetting_MastodonSetting {
+	// The access token is never returned.
+	return &v1pb.UserSetting_MastodonSetting{
+		Enabled:     mastodonSetting.GetEnabled(),
+		InstanceUrl: mastodonSetting.GetInstanceUrl(),
+		Tag:         mastodonSetting.GetTag(),
+		Account:     mastodonSetting.GetAccount(),
+	}
+}
@@ -0,0 +1,230 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// pendingMemoFilter is the filter of the memos awaiting an approval.
+const pendingMemoFilter = `approval_state == "PENDING"`
+
+// ListPendingMemos lists the normal memos awaiting the approval of the admins.
+func (s *APIV1Service) ListPendingMemos(ctx context.Context, request *v1pb.ListPendingMemosRequest) (*v1pb.ListPendingMemosResponse, error) {
+	if _, err := s.getMemoApprover(ctx); err != nil {
+		return nil, err
+	}
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+		Filters:         []string{pendingMemoFilter},
+	}
+	listMemosResponse, err := s.listMemos(ctx, memoFind, request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.ListPendingMemosResponse{
+		Memos:         listMemosResponse.Memos,
+		NextPageToken: listMemosResponse.NextPageToken,
+	}, nil
+}
+
+// ApproveMemo approves the pending memo, giving it its requested visibility.
+func (s *APIV1Service) ApproveMemo(ctx context.Context, request *v1pb.ApproveMemoRequest) (*v1pb.Memo, error) {
+	approver, memo, err := s.getPendingMemo(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	approval := memo.Payload.Approval
+	approval.State = storepb.MemoPayload_Approval_APPROVED
+	approval.ReviewerId = approver.ID
+	approval.ReviewedTs = time.Now().Unix()
+	visibility := store.Visibility(approval.RequestedVisibility)
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:         memo.ID,
+		Visibility: &visibility,
+		Payload:    memo.Payload,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+	}
+	memo.Visibility = visibility
+	s.publishMemoToNostrAsync(ctx, memo)
+	s.crossPostMemoAsync(ctx, memo)
+	return s.GetMemo(ctx, &v1pb.GetMemoRequest{Name: request.Name})
+}
+
+// RejectMemo rejects the pending memo, keeping it private. Editing the memo requests a new approval.
+func (s *APIV1Service) RejectMemo(ctx context.Context, request *v1pb.RejectMemoRequest) (*v1pb.Memo, error) {
+	approver, memo, err := s.getPendingMemo(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	approval := memo.Payload.Approval
+	approval.State = storepb.MemoPayload_Approval_REJECTED
+	approval.ReviewerId = approver.ID
+	approval.ReviewedTs = time.Now().Unix()
+	approval.Reason = strings.TrimSpace(request.Reason)
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Payload: memo.Payload,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+	}
+	return s.GetMemo(ctx, &v1pb.GetMemoRequest{Name: request.Name})
+}
+
+// getMemoApprover returns the current user if they can approve the memos, one of the admins.
+func (s *APIV1Service) getMemoApprover(ctx context.Context) (*store.User, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return user, nil
+}
+
+// getPendingMemo returns the current user approving the memos and the memo of the name awaiting an approval.
+func (s *APIV1Service) getPendingMemo(ctx context.Context, name string) (*store.User, *store.Memo, error) {
+	approver, err := s.getMemoApprover(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.Payload.GetApproval().GetState() != storepb.MemoPayload_Approval_PENDING {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "memo is not awaiting an approval")
+	}
+	return approver, memo, nil
+}
+
+// applyMemoApproval returns the visibility to give to the memo of the creator requesting the visibility. The memos
+// requiring an approval are kept private with a pending approval until they are approved, and keep their approval
+// when edited afterwards. It reports whether the memo newly awaits an approval.
+func (s *APIV1Service) applyMemoApproval(ctx context.Context, creator *store.User, memo *store.Memo, visibility store.Visibility) (store.Visibility, bool, error) {
+	if memo.Payload == nil {
+		memo.Payload = &storepb.MemoPayload{}
+	}
+	approval := memo.Payload.Approval
+	if approval.GetState() == storepb.MemoPayload_Approval_APPROVED {
+		return visibility, false, nil
+	}
+	required := false
+	if visibility != store.Private && !isSuperUser(creator) {
+		workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+		if err != nil {
+			return "", false, status.Errorf(codes.Internal, "failed to get workspace memo related setting: %v", err)
+		}
+		for _, tag := range workspaceMemoRelatedSetting.ApprovalRequiredTags {
+			if tag = strings.TrimPrefix(strings.TrimSpace(tag), "#"); tag != "" && hasCrossPostTag(memo, tag) {
+				required = true
+				break
+			}
+		}
+	}
+	if !required {
+		// The memos no longer requiring an approval drop their pending or rejected one.
+		memo.Payload.Approval = nil
+		return visibility, false, nil
+	}
+	pending := approval.GetState() == storepb.MemoPayload_Approval_PENDING
+	if !pending {
+		approval = &storepb.MemoPayload_Approval{
+			State:       storepb.MemoPayload_Approval_PENDING,
+			RequestedTs: time.Now().Unix(),
+		}
+	}
+	approval.RequestedVisibility = visibility.String()
+	memo.Payload.Approval = approval
+	return store.Private, !pending, nil
+}
+
+// notifyMemoApprovers notifies the admins of the memo awaiting their approval.
+func (s *APIV1Service) notifyMemoApprovers(ctx context.Context, memo *store.Memo) error {
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: memo.CreatorID,
+		Type:      store.ActivityTypeMemoApproval,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoApproval: &storepb.ActivityMemoApprovalPayload{
+				MemoId: memo.ID,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	normalStatus := store.Normal
+	for _, role := range []store.Role{store.RoleHost, store.RoleAdmin} {
+		approvers, err := s.Store.ListUsers(ctx, &store.FindUser{
+			Role:      &role,
+			RowStatus: &normalStatus,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list approvers")
+		}
+		for _, approver := range approvers {
+			if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+				SenderID:   memo.CreatorID,
+				ReceiverID: approver.ID,
+				Status:     store.UNREAD,
+				Message: &storepb.InboxMessage{
+					Type:       storepb.InboxMessage_MEMO_APPROVAL,
+					ActivityId: &activity.ID,
+				},
+			}); err != nil {
+				return errors.Wrap(err, "failed to create inbox")
+			}
+		}
+	}
+	slog.Debug("notified memo approvers", "memo", memo.ID)
+	return nil
+}
+
+// isMemoUnderReview reports whether the memo awaits an approval or was rejected.
+func isMemoUnderReview(memo *store.Memo) bool {
+	state := memo.Payload.GetApproval().GetState()
+	return state == storepb.MemoPayload_Approval_PENDING || state == storepb.MemoPayload_Approval_REJECTED
+}
+
+func convertMemoApprovalFromStore(approval *storepb.MemoPayload_Approval) *v1pb.Memo_Approval {
+	if approval == nil {
+		return nil
+	}
+	memoApproval := &v1pb.Memo_Approval{
+		State:               v1pb.Memo_Approval_State(approval.State),
+		RequestedVisibility: convertVisibilityFromStore(store.Visibility(approval.RequestedVisibility)),
+		RequestTime:         timestamppb.New(time.Unix(approval.RequestedTs, 0)),
+		Reason:              approval.Reason,
+	}
+	if approval.ReviewerId != 0 {
+		memoApproval.Reviewer = fmt.Sprintf("%s%d", UserNamePrefix, approval.ReviewerId)
+		memoApproval.ReviewTime = timestamppb.New(time.Unix(approval.ReviewedTs, 0))
+	}
+	return memoApproval
+}
@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/webarchive"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// archiveMemoLinksAsync submits the links of the memo to the Wayback Machine in the background
+// when the memo creator has enabled link archiving.
+func (s *APIV1Service) archiveMemoLinksAsync(ctx context.Context, memo *store.Memo) {
+	if memo.Payload == nil || !memo.Payload.GetProperty().GetHasLink() {
+		return
+	}
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &memo.CreatorID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		slog.Warn("Failed to get user general setting", slog.Any("err", err))
+		return
+	}
+	if !userSetting.GetGeneral().GetArchiveLinks() {
+		return
+	}
+
+	go func() {
+		if err := s.archiveMemoLinks(context.Background(), memo.ID); err != nil {
+			slog.Warn("Failed to archive memo links", slog.Int("memoID", int(memo.ID)), slog.Any("err", err))
+		}
+	}()
+}
+
+// archiveMemoLinks saves a snapshot of every link in the memo that hasn't been archived yet.
+func (s *APIV1Service) archiveMemoLinks(ctx context.Context, memoID int32) error {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return nil
+	}
+	data, err := s.MarkdownService.ExtractAll([]byte(memo.Content))
+	if err != nil {
+		return errors.Wrap(err, "failed to extract markdown metadata")
+	}
+
+	archived := make(map[string]bool)
+	for _, linkSnapshot := range memo.Payload.GetLinkSnapshots() {
+		archived[linkSnapshot.Url] = true
+	}
+	linkSnapshots := []*storepb.MemoPayload_LinkSnapshot{}
+	for _, link := range data.Links {
+		if archived[link] {
+			continue
+		}
+		snapshotURL, err := webarchive.Save(link)
+		if err != nil {
+			slog.Warn("Failed to save link to web archive", slog.String("link", link), slog.Any("err", err))
+			continue
+		}
+		linkSnapshots = append(linkSnapshots, &storepb.MemoPayload_LinkSnapshot{
+			Url:         link,
+			SnapshotUrl: snapshotURL,
+			CreatedTs:   time.Now().Unix(),
+		})
+	}
+	if len(linkSnapshots) == 0 {
+		return nil
+	}
+
+	// Reload the memo since saving snapshots can take a while.
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return nil
+	}
+	if memo.Payload == nil {
+		memo.Payload = &storepb.MemoPayload{}
+	}
+	memo.Payload.LinkSnapshots = append(memo.Payload.LinkSnapshots, linkSnapshots...)
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Payload: memo.Payload,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update memo")
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// maxCalendarDayTopTags is the maximum number of tags of a day of a calendar month.
+const maxCalendarDayTopTags = 3
+
+// GetCalendarMonth returns the summaries of the days of the month, counting the memos the current user may see
+// like ListMemos, by their display time in the timezone of the request.
+func (s *APIV1Service) GetCalendarMonth(ctx context.Context, request *v1pb.GetCalendarMonthRequest) (*v1pb.CalendarMonth, error) {
+	if request.Year < 1 || request.Year > 9999 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid year: %d", request.Year)
+	}
+	if request.Month < 1 || request.Month > 12 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid month: %d", request.Month)
+	}
+	timezone, location, err := s.getRequestLocation(ctx, request.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	memoFind, err := s.buildListMemosFind(ctx, &v1pb.ListMemosRequest{Filter: request.Filter})
+	if err != nil {
+		return nil, err
+	}
+	start := time.Date(int(request.Year), time.Month(request.Month), 1, 0, 0, 0, 0, location)
+	end := start.AddDate(0, 1, 0)
+	timeField := "created_ts"
+	if memoFind.OrderByUpdatedTs {
+		timeField = "updated_ts"
+	}
+	memoFind.Filters = append(memoFind.Filters, fmt.Sprintf("%s >= %d && %s < %d", timeField, start.Unix(), timeField, end.Unix()))
+	memoFind.ExcludeContent = true
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	days := map[string]*v1pb.CalendarMonth_Day{}
+	tagCounts := map[string]map[string]int{}
+	for _, memo := range memos {
+		displayTs := memo.CreatedTs
+		if memoFind.OrderByUpdatedTs {
+			displayTs = memo.UpdatedTs
+		}
+		date := time.Unix(displayTs, 0).In(location).Format(time.DateOnly)
+		day, ok := days[date]
+		if !ok {
+			day = &v1pb.CalendarMonth_Day{Date: date}
+			days[date] = day
+			tagCounts[date] = map[string]int{}
+		}
+		day.MemoCount++
+		day.CompletedTaskCount += memo.Payload.GetProperty().GetCompletedTaskCount()
+		for _, tag := range memo.Payload.GetTags() {
+			tagCounts[date][tag]++
+		}
+	}
+
+	calendar := &v1pb.CalendarMonth{
+		Year:     request.Year,
+		Month:    request.Month,
+		Timezone: timezone,
+	}
+	for date := start; date.Before(end); date = date.AddDate(0, 0, 1) {
+		key := date.Format(time.DateOnly)
+		day, ok := days[key]
+		if !ok {
+			day = &v1pb.CalendarMonth_Day{Date: key}
+		}
+		day.TopTags = getTopTags(tagCounts[key], maxCalendarDayTopTags)
+		calendar.Days = append(calendar.Days, day)
+	}
+	return calendar, nil
+}
+
+// getRequestLocation returns the timezone of a request, the timezone of the current user when the request
+// does not set it, or UTC.
+func (s *APIV1Service) getRequestLocation(ctx context.Context, timezone string) (string, *time.Location, error) {
+	if timezone != "" {
+		location, err := time.LoadLocation(timezone)
+		if err != nil {
+			return "", nil, status.Errorf(codes.InvalidArgument, "invalid timezone: %v", err)
+		}
+		return timezone, location, nil
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if currentUser != nil {
+		return s.getUserLocation(ctx, currentUser.ID)
+	}
+	return "UTC", time.UTC, nil
+}
+
+// getUserLocation returns the timezone of the general setting of the user, or UTC.
+func (s *APIV1Service) getUserLocation(ctx context.Context, userID int32) (string, *time.Location, error) {
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &userID, Key: storepb.UserSetting_GENERAL})
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	if timezone := generalSetting.GetGeneral().GetTimezone(); timezone != "" {
+		if location, err := time.LoadLocation(timezone); err == nil {
+			return timezone, location, nil
+		}
+	}
+	return "UTC", time.UTC, nil
+}
+
+// getTopTags returns the most used tags by their count then name, at most limit.
+func getTopTags(tagCounts map[string]int, limit int) []string {
+	tags := []string{}
+	for tag := range tagCounts {
+		tags = append(tags, tag)
+	}
+	slices.SortFunc(tags, func(a, b string) int {
+		return cmp.Or(cmp.Compare(tagCounts[b], tagCounts[a]), cmp.Compare(a, b))
+	})
+	if len(tags) > limit {
+		tags = tags[:limit]
+	}
+	return tags
+}
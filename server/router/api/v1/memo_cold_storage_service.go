@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListColdMemos(ctx context.Context, request *v1pb.ListColdMemosRequest) (*v1pb.ListColdMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	find := &store.FindColdMemo{
+		CreatorID: &user.ID,
+		Limit:     &limitPlusOne,
+		Offset:    &offset,
+	}
+	if request.Query != "" {
+		find.ContentSearch = &request.Query
+	}
+	memos, err := s.Store.ListColdMemos(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list cold memos: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(memos) == limitPlusOne {
+		memos = memos[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
+	memoMessages := []*v1pb.Memo{}
+	for _, memo := range memos {
+		memoMessage, err := s.convertColdMemoFromStore(ctx, memo)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert memo: %v", err)
+		}
+		memoMessages = append(memoMessages, memoMessage)
+	}
+	return &v1pb.ListColdMemosResponse{
+		Memos:         memoMessages,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *APIV1Service) RestoreColdMemo(ctx context.Context, request *v1pb.RestoreColdMemoRequest) (*v1pb.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	coldMemo, err := s.Store.GetColdMemo(ctx, &store.FindColdMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get cold memo: %v", err)
+	}
+	if coldMemo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found in cold storage")
+	}
+	if coldMemo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	if err := s.Store.RestoreColdMemo(ctx, coldMemo.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore memo: %v", err)
+	}
+	// Touch the memo so that it is not moved back into cold storage on the next run.
+	updatedTs := time.Now().Unix()
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: coldMemo.ID, UpdatedTs: &updatedTs}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &coldMemo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	return s.convertColdMemoFromStore(ctx, memo)
+}
+
+// convertColdMemoFromStore loads the reactions and attachments of a memo and converts it.
+func (s *APIV1Service) convertColdMemoFromStore(ctx context.Context, memo *store.Memo) (*v1pb.Memo, error) {
+	contentID := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &contentID})
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return nil, err
+	}
+	return s.convertMemoFromStore(ctx, memo, reactions, attachments)
+}
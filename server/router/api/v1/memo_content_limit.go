@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// contentSplitSeparators are the separators a content over the length limit is split after, in order of preference.
+var contentSplitSeparators = []string{"\n\n", "\n", " "}
+
+// checkContentLength returns an INVALID_ARGUMENT error if the content is over the length limit, with a field
+// violation of the content and the proposed split of the content into parts within the limit.
+func checkContentLength(content string, contentLengthLimit int) error {
+	if len(content) <= contentLengthLimit {
+		return nil
+	}
+	st := status.Newf(codes.InvalidArgument, "content is %d bytes, over the limit of %d bytes; split it into several memos", len(content), contentLengthLimit)
+	return withStatusDetails(st,
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       "content",
+			Description: fmt.Sprintf("the content must be at most %d bytes", contentLengthLimit),
+		}}},
+		&v1pb.MemoContentSplitSuggestion{
+			ContentLengthLimit: int32(contentLengthLimit),
+			SplitOffsets:       suggestContentSplitOffsets(content, contentLengthLimit),
+		},
+	)
+}
+
+// suggestContentSplitOffsets returns the byte offsets where the parts of the content after the first one start, each
+// part at most limit bytes. A part ends after the last paragraph, line or word in its second half, or else at the last
+// character fitting in it.
+func suggestContentSplitOffsets(content string, limit int) []int32 {
+	limit = max(limit, 1)
+	offsets := []int32{}
+	start := 0
+	for len(content)-start > limit {
+		window := content[start : start+limit]
+		cut := -1
+		for _, separator := range contentSplitSeparators {
+			// The separators in the first half are ignored, for the parts not to get much smaller than the limit.
+			if index := strings.LastIndex(window, separator); index >= len(window)/2 {
+				cut = index + len(separator)
+				break
+			}
+		}
+		if cut < 0 {
+			cut = len(window)
+			for cut > 1 && !utf8.RuneStart(content[start+cut]) {
+				cut--
+			}
+		}
+		start += cut
+		offsets = append(offsets, int32(start))
+	}
+	return offsets
+}
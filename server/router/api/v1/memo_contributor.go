@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// recordMemoContributor records the edit of the content of the memo by the user. The memos edited before the
+// contributors were recorded get their creator first, with their last update time.
+func recordMemoContributor(memo *store.Memo, userID int32, editTs int64) {
+	if memo.Payload == nil {
+		memo.Payload = &storepb.MemoPayload{}
+	}
+	if len(memo.Payload.Contributors) == 0 && memo.ID != 0 {
+		memo.Payload.Contributors = []*storepb.MemoPayload_Contributor{{
+			UserId:     memo.CreatorID,
+			LastEditTs: memo.UpdatedTs,
+			EditCount:  1,
+		}}
+	}
+	for _, contributor := range memo.Payload.Contributors {
+		if contributor.UserId == userID {
+			contributor.LastEditTs = editTs
+			contributor.EditCount++
+			return
+		}
+	}
+	memo.Payload.Contributors = append(memo.Payload.Contributors, &storepb.MemoPayload_Contributor{
+		UserId:     userID,
+		LastEditTs: editTs,
+		EditCount:  1,
+	})
+}
+
+// convertMemoContributorsFromStore returns the contributors of the memo, its creator for the memos without any.
+func convertMemoContributorsFromStore(memo *store.Memo) []*v1pb.Memo_Contributor {
+	contributors := memo.Payload.GetContributors()
+	if len(contributors) == 0 {
+		contributors = []*storepb.MemoPayload_Contributor{{
+			UserId:     memo.CreatorID,
+			LastEditTs: memo.UpdatedTs,
+			EditCount:  1,
+		}}
+	}
+	result := make([]*v1pb.Memo_Contributor, 0, len(contributors))
+	for _, contributor := range contributors {
+		result = append(result, &v1pb.Memo_Contributor{
+			User:         fmt.Sprintf("%s%d", UserNamePrefix, contributor.UserId),
+			LastEditTime: timestamppb.New(time.Unix(contributor.LastEditTs, 0)),
+			EditCount:    contributor.EditCount,
+		})
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/diff"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// ComputeDiff returns the diff of the content of two memos visible to the current user, line by line, with the
+// words of the changed lines for the WORD granularity.
+func (s *APIV1Service) ComputeDiff(ctx context.Context, request *v1pb.ComputeDiffRequest) (*v1pb.ComputeDiffResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	oldMemo, err := s.getDiffMemo(ctx, user, request.OldName)
+	if err != nil {
+		return nil, err
+	}
+	newMemo, err := s.getDiffMemo(ctx, user, request.NewName)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1pb.ComputeDiffResponse{Lines: []*v1pb.ComputeDiffResponse_Line{}}
+	edits := diff.Lines(oldMemo.Content, newMemo.Content)
+	oldLineNumber, newLineNumber := 0, 0
+	for start := 0; start < len(edits); {
+		if edits[start].Op == diff.Equal {
+			oldLineNumber++
+			newLineNumber++
+			response.Lines = append(response.Lines, &v1pb.ComputeDiffResponse_Line{
+				Type:          v1pb.ComputeDiffResponse_EQUAL,
+				Text:          edits[start].Text,
+				OldLineNumber: int32(oldLineNumber),
+				NewLineNumber: int32(newLineNumber),
+			})
+			start++
+			continue
+		}
+		// A change is a run of deleted lines followed by a run of inserted lines, the lines at the same
+		// positions of both runs are the changed lines.
+		end := start
+		for end < len(edits) && edits[end].Op == diff.Delete {
+			end++
+		}
+		deleted := edits[start:end]
+		start = end
+		for end < len(edits) && edits[end].Op == diff.Insert {
+			end++
+		}
+		inserted := edits[start:end]
+		start = end
+
+		deletedLines := make([]*v1pb.ComputeDiffResponse_Line, 0, len(deleted))
+		for _, edit := range deleted {
+			oldLineNumber++
+			deletedLines = append(deletedLines, &v1pb.ComputeDiffResponse_Line{
+				Type:          v1pb.ComputeDiffResponse_DELETE,
+				Text:          edit.Text,
+				OldLineNumber: int32(oldLineNumber),
+			})
+		}
+		insertedLines := make([]*v1pb.ComputeDiffResponse_Line, 0, len(inserted))
+		for _, edit := range inserted {
+			newLineNumber++
+			insertedLines = append(insertedLines, &v1pb.ComputeDiffResponse_Line{
+				Type:          v1pb.ComputeDiffResponse_INSERT,
+				Text:          edit.Text,
+				NewLineNumber: int32(newLineNumber),
+			})
+		}
+		if request.Granularity == v1pb.ComputeDiffRequest_WORD {
+			for index := 0; index < len(deletedLines) && index < len(insertedLines); index++ {
+				for _, edit := range diff.Words(deletedLines[index].Text, insertedLines[index].Text) {
+					segment := &v1pb.ComputeDiffResponse_Segment{Type: convertDiffOpToType(edit.Op), Text: edit.Text}
+					if edit.Op != diff.Insert {
+						deletedLines[index].Segments = append(deletedLines[index].Segments, segment)
+					}
+					if edit.Op != diff.Delete {
+						insertedLines[index].Segments = append(insertedLines[index].Segments, segment)
+					}
+				}
+			}
+		}
+		response.Lines = append(response.Lines, deletedLines...)
+		response.Lines = append(response.Lines, insertedLines...)
+		response.DeletedLineCount += int32(len(deletedLines))
+		response.InsertedLineCount += int32(len(insertedLines))
+	}
+	return response, nil
+}
+
+// getDiffMemo returns the memo of the name if the user can view it.
+func (s *APIV1Service) getDiffMemo(ctx context.Context, user *store.User, name string) (*store.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo %s not found", name)
+	}
+	canView, err := s.canViewMemo(ctx, user, memo)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+func convertDiffOpToType(op diff.Op) v1pb.ComputeDiffResponse_Type {
+	switch op {
+	case diff.Insert:
+		return v1pb.ComputeDiffResponse_INSERT
+	case diff.Delete:
+		return v1pb.ComputeDiffResponse_DELETE
+	default:
+		return v1pb.ComputeDiffResponse_EQUAL
+	}
+}
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/plugin/markdown"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// updateFormattingSetting updates the formatters of the user's formatting setting in the update mask.
+func (s *APIV1Service) updateFormattingSetting(ctx context.Context, userID int32, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
+	existing, err := s.Store.GetUserFormattingSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	formattingSetting := proto.Clone(existing).(*storepb.FormattingUserSetting)
+
+	incoming := request.Setting.GetFormattingSetting()
+	if incoming == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "formatting setting is required")
+	}
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "normalizeHeadings":
+			formattingSetting.NormalizeHeadings = incoming.NormalizeHeadings
+		case "linkUrls":
+			formattingSetting.LinkUrls = incoming.LinkUrls
+		case "normalizeReferences":
+			formattingSetting.NormalizeReferences = incoming.NormalizeReferences
+		case "trimTrailingWhitespace":
+			formattingSetting.TrimTrailingWhitespace = incoming.TrimTrailingWhitespace
+		default:
+			// Ignore unsupported fields
+		}
+	}
+
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_FORMATTING,
+		Value:  &storepb.UserSetting_Formatting{Formatting: formattingSetting},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
+// formatMemoContent applies the formatters of the formatting setting of the user saving the content, and expands the
+// shortcodes of the workspace.
+func (s *APIV1Service) formatMemoContent(ctx context.Context, userID int32, content string) (string, error) {
+	formattingSetting, err := s.Store.GetUserFormattingSetting(ctx, userID)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	options := markdown.FormatOptions{
+		NormalizeHeadings:      formattingSetting.NormalizeHeadings,
+		LinkURLs:               formattingSetting.LinkUrls,
+		NormalizeReferences:    formattingSetting.NormalizeReferences,
+		TrimTrailingWhitespace: formattingSetting.TrimTrailingWhitespace,
+	}
+	if strings.Contains(content, ":") {
+		if options.Shortcodes, err = s.getShortcodes(ctx); err != nil {
+			return "", err
+		}
+	}
+	// The users are only listed for the content mentioning some.
+	if options.NormalizeReferences && strings.Contains(content, "@") {
+		users, err := s.Store.ListUsers(ctx, &store.FindUser{})
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to list users: %v", err)
+		}
+		for _, user := range users {
+			options.Usernames = append(options.Usernames, user.Username)
+		}
+	}
+	return markdown.Format(content, options), nil
+}
+
+func convertFormattingSettingFromStore(formattingSetting *storepb.FormattingUserSetting) *v1pb.UserSetting_FormattingSetting {
+	return &v1pb.UserSetting_FormattingSetting{
+		NormalizeHeadings:      formattingSetting.GetNormalizeHeadings(),
+		LinkUrls:               formattingSetting.GetLinkUrls(),
+		NormalizeReferences:    formattingSetting.GetNormalizeReferences(),
+		TrimTrailingWhitespace: formattingSetting.GetTrimTrailingWhitespace(),
+	}
+}
@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+)
+
+const memoPayloadRebuildJobName = "workspace/memoPayloadRebuildJob"
+
+// CreateMemoPayloadRebuildJob starts an async job rebuilding the payloads of all memos.
+func (s *APIV1Service) CreateMemoPayloadRebuildJob(ctx context.Context, _ *v1pb.CreateMemoPayloadRebuildJobRequest) (*v1pb.MemoPayloadRebuildJob, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	job := &v1pb.MemoPayloadRebuildJob{
+		Name:       memoPayloadRebuildJobName,
+		State:      v1pb.MemoPayloadRebuildJob_RUNNING,
+		CreateTime: timestamppb.Now(),
+	}
+	s.memoPayloadRebuildJobMutex.Lock()
+	if s.memoPayloadRebuildJob != nil && s.memoPayloadRebuildJob.State == v1pb.MemoPayloadRebuildJob_RUNNING {
+		s.memoPayloadRebuildJobMutex.Unlock()
+		return nil, status.Errorf(codes.FailedPrecondition, "a rebuild job is already running")
+	}
+	s.memoPayloadRebuildJob = job
+	snapshot := proto.Clone(job).(*v1pb.MemoPayloadRebuildJob)
+	s.memoPayloadRebuildJobMutex.Unlock()
+
+	go func() {
+		// Use a detached context so that the job outlives the request.
+		runner := memopayload.NewRunner(s.Store, s.MarkdownService)
+		progress, err := runner.Rebuild(context.Background(), func(progress memopayload.Progress) {
+			s.updateMemoPayloadRebuildJob(func(job *v1pb.MemoPayloadRebuildJob) {
+				setMemoPayloadRebuildJobProgress(job, progress)
+			})
+		})
+		s.updateMemoPayloadRebuildJob(func(job *v1pb.MemoPayloadRebuildJob) {
+			setMemoPayloadRebuildJobProgress(job, progress)
+			job.FinishTime = timestamppb.Now()
+			if err != nil {
+				job.State = v1pb.MemoPayloadRebuildJob_FAILED
+				job.Error = err.Error()
+			} else {
+				job.State = v1pb.MemoPayloadRebuildJob_SUCCEEDED
+			}
+		})
+		if err != nil {
+			slog.Warn("failed to rebuild memo payloads", "error", err)
+		}
+	}()
+
+	return snapshot, nil
+}
+
+// GetMemoPayloadRebuildJob returns the latest memo payload rebuild job.
+func (s *APIV1Service) GetMemoPayloadRebuildJob(ctx context.Context, _ *v1pb.GetMemoPayloadRebuildJobRequest) (*v1pb.MemoPayloadRebuildJob, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	s.memoPayloadRebuildJobMutex.Lock()
+	defer s.memoPayloadRebuildJobMutex.Unlock()
+	if s.memoPayloadRebuildJob == nil {
+		return nil, status.Errorf(codes.NotFound, "rebuild job not found")
+	}
+	return proto.Clone(s.memoPayloadRebuildJob).(*v1pb.MemoPayloadRebuildJob), nil
+}
+
+func (s *APIV1Service) updateMemoPayloadRebuildJob(update func(job *v1pb.MemoPayloadRebuildJob)) {
+	s.memoPayloadRebuildJobMutex.Lock()
+	defer s.memoPayloadRebuildJobMutex.Unlock()
+	if s.memoPayloadRebuildJob != nil {
+		update(s.memoPayloadRebuildJob)
+	}
+}
+
+func setMemoPayloadRebuildJobProgress(job *v1pb.MemoPayloadRebuildJob, progress memopayload.Progress) {
+	job.ProcessedMemoCount = int32(progress.Processed)
+	job.UpdatedMemoCount = int32(progress.Updated)
+	job.FailedMemoCount = int32(progress.Failed)
+}
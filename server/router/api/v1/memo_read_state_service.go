@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) GetMemoReadState(ctx context.Context, request *v1pb.GetMemoReadStateRequest) (*v1pb.MemoReadState, error) {
+	user, memo, err := s.getReadableMemoFromReadStateName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	readState, err := s.Store.GetMemoReadState(ctx, &store.FindMemoReadState{
+		UserID: &user.ID,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo read state: %v", err)
+	}
+	return convertMemoReadStateFromStore(memo, readState), nil
+}
+
+func (s *APIV1Service) UpdateMemoReadState(ctx context.Context, request *v1pb.UpdateMemoReadStateRequest) (*v1pb.MemoReadState, error) {
+	if request.ReadState == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "read state is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	user, memo, err := s.getReadableMemoFromReadStateName(ctx, request.ReadState.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	readState, err := s.Store.GetMemoReadState(ctx, &store.FindMemoReadState{
+		UserID: &user.ID,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo read state: %v", err)
+	}
+	if readState == nil {
+		readState = &store.MemoReadState{
+			UserID: user.ID,
+			MemoID: memo.ID,
+		}
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "read_time":
+			if request.ReadState.ReadTime != nil {
+				readState.ReadTs = request.ReadState.ReadTime.AsTime().Unix()
+			} else {
+				readState.ReadTs = time.Now().Unix()
+			}
+		case "position":
+			if request.ReadState.Position < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "position must not be negative")
+			}
+			readState.Position = request.ReadState.Position
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+
+	readState, err = s.Store.UpsertMemoReadState(ctx, readState)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert memo read state: %v", err)
+	}
+	return convertMemoReadStateFromStore(memo, readState), nil
+}
+
+// ListUnreadMemos lists the memos of other users visible to the current user that are unread or updated since last read.
+func (s *APIV1Service) ListUnreadMemos(ctx context.Context, request *v1pb.ListUnreadMemosRequest) (*v1pb.ListUnreadMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	visibilities, err := s.getVisibleMemoVisibilities(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		RowStatus:       &normalStatus,
+		VisibilityList:  visibilities,
+		ExcludeComments: true,
+		Filters:         []string{fmt.Sprintf("creator_id != %d", user.ID)},
+		UnreadByUserID:  &user.ID,
+	}
+	response, err := s.listMemos(ctx, memoFind, request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.ListUnreadMemosResponse{
+		Memos:         response.Memos,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// getReadableMemoFromReadStateName returns the current user and the memo of the read state if the user can read the memo.
+func (s *APIV1Service) getReadableMemoFromReadStateName(ctx context.Context, name string) (*store.User, *store.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromReadStateName(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid read state name: %v", err)
+	}
+	return s.getReadableMemo(ctx, memoUID)
+}
+
+func convertMemoReadStateFromStore(memo *store.Memo, readState *store.MemoReadState) *v1pb.MemoReadState {
+	memoReadState := &v1pb.MemoReadState{
+		Name:   fmt.Sprintf("%s%s%s", MemoNamePrefix, memo.UID, MemoReadStateNameSuffix),
+		Unread: true,
+	}
+	if readState != nil {
+		memoReadState.ReadTime = timestamppb.New(time.Unix(readState.ReadTs, 0))
+		memoReadState.Position = readState.Position
+		memoReadState.Unread = readState.ReadTs < memo.UpdatedTs
+	}
+	return memoReadState
+}
@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// maxReadingListSize is the maximum number of memos of a reading list.
+const maxReadingListSize = 1000
+
+// readingStatusOrder is the order of the groups of the known reading statuses, before the other statuses.
+var readingStatusOrder = []string{"reading", "to_read", "finished", "abandoned"}
+
+// ListReading lists the memos with reading properties visible to the current user like ListMemos, grouped by
+// their reading status, at most maxReadingListSize.
+func (s *APIV1Service) ListReading(ctx context.Context, request *v1pb.ListReadingRequest) (*v1pb.ListReadingResponse, error) {
+	memoFind, err := s.buildListMemosFind(ctx, &v1pb.ListMemosRequest{Filter: request.Filter})
+	if err != nil {
+		return nil, err
+	}
+	// The memos without reading properties have no status.
+	memoFind.Filters = append(memoFind.Filters, `reading_status != ""`)
+	listMemosResponse, err := s.listMemos(ctx, memoFind, maxReadingListSize, "")
+	if err != nil {
+		return nil, err
+	}
+
+	groups := map[string]*v1pb.ListReadingResponse_Group{}
+	for _, memo := range listMemosResponse.Memos {
+		status := memo.GetReading().GetStatus()
+		group, ok := groups[status]
+		if !ok {
+			group = &v1pb.ListReadingResponse_Group{Status: status}
+			groups[status] = group
+		}
+		group.Memos = append(group.Memos, memo)
+	}
+	response := &v1pb.ListReadingResponse{}
+	for _, group := range groups {
+		if group.Status == "finished" {
+			// The memos are by display time, the finished readings keep it among the same dates.
+			slices.SortStableFunc(group.Memos, func(a, b *v1pb.Memo) int {
+				return cmp.Compare(b.Reading.FinishedDate, a.Reading.FinishedDate)
+			})
+		}
+		response.Groups = append(response.Groups, group)
+	}
+	slices.SortFunc(response.Groups, func(a, b *v1pb.ListReadingResponse_Group) int {
+		return cmp.Or(compareReadingStatus(a.Status, b.Status), cmp.Compare(a.Status, b.Status))
+	})
+	return response, nil
+}
+
+// compareReadingStatus compares the statuses by readingStatusOrder, the other statuses after the known ones.
+func compareReadingStatus(a, b string) int {
+	indexOf := func(status string) int {
+		if index := slices.Index(readingStatusOrder, status); index >= 0 {
+			return index
+		}
+		return len(readingStatusOrder)
+	}
+	return cmp.Compare(indexOf(a), indexOf(b))
+}
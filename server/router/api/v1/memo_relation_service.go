@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -13,6 +14,17 @@ import (
 	"github.com/usememos/memos/store"
 )
 
+// settableMemoRelationTypes are the types of the relations the users set, the comments and the summaries manage
+// theirs.
+var settableMemoRelationTypes = []store.MemoRelationType{
+	store.MemoRelationReference,
+	store.MemoRelationTranslationOf,
+	store.MemoRelationReplyTo,
+	store.MemoRelationDuplicateOf,
+	store.MemoRelationBlockedBy,
+	store.MemoRelationRevisionOf,
+}
+
 func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMemoRelationsRequest) (*emptypb.Empty, error) {
 	memoUID, err := ExtractMemoUIDFromName(request.Name)
 	if err != nil {
@@ -22,11 +34,10 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
-	referenceType := store.MemoRelationReference
-	// Delete all reference relations first.
+	// Delete all the relations set by the users first.
 	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
-		MemoID: &memo.ID,
-		Type:   &referenceType,
+		MemoID:   &memo.ID,
+		TypeList: settableMemoRelationTypes,
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete memo relation")
 	}
@@ -36,9 +47,9 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 		if request.Name == relation.RelatedMemo.Name {
 			continue
 		}
-		// Ignore comment relations as there's no need to update a comment's relation.
-		// Inserting/Deleting a comment is handled elsewhere.
-		if relation.Type == v1pb.MemoRelation_COMMENT {
+		// Ignore comment and summary relations as there's no need to update them.
+		// Inserting/Deleting a comment or a summary is handled elsewhere.
+		if !slices.Contains(settableMemoRelationTypes, convertMemoRelationTypeToStore(relation.Type)) {
 			continue
 		}
 		relatedMemoUID, err := ExtractMemoUIDFromName(relation.RelatedMemo.Name)
@@ -70,45 +81,47 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
 
 	currentUser, err := s.GetCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
-	var memoFilter string
-	if currentUser == nil {
-		memoFilter = `visibility == "PUBLIC"`
-	} else {
-		memoFilter = fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
-	}
-	relationList := []*v1pb.MemoRelation{}
-	tempList, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
-		MemoID:     &memo.ID,
-		MemoFilter: &memoFilter,
-	})
+	memoFilter, err := s.getVisibleMemoFilter(ctx, currentUser)
 	if err != nil {
 		return nil, err
 	}
-	for _, raw := range tempList {
-		relation, err := s.convertMemoRelationFromStore(ctx, raw)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to convert memo relation")
+	typeList := make([]store.MemoRelationType, 0, len(request.Types))
+	for _, relationType := range request.Types {
+		if relationType == v1pb.MemoRelation_TYPE_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "unspecified relation type")
 		}
-		relationList = append(relationList, relation)
+		typeList = append(typeList, convertMemoRelationTypeToStore(relationType))
 	}
-	tempList, err = s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
-		RelatedMemoID: &memo.ID,
-		MemoFilter:    &memoFilter,
-	})
-	if err != nil {
-		return nil, err
+	finds := []*store.FindMemoRelation{}
+	if request.Direction != v1pb.ListMemoRelationsRequest_INCOMING {
+		finds = append(finds, &store.FindMemoRelation{MemoID: &memo.ID})
+	}
+	if request.Direction != v1pb.ListMemoRelationsRequest_OUTGOING {
+		finds = append(finds, &store.FindMemoRelation{RelatedMemoID: &memo.ID})
 	}
-	for _, raw := range tempList {
-		relation, err := s.convertMemoRelationFromStore(ctx, raw)
+	relationList := []*v1pb.MemoRelation{}
+	for _, find := range finds {
+		find.TypeList = typeList
+		find.MemoFilter = &memoFilter
+		tempList, err := s.Store.ListMemoRelations(ctx, find)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to convert memo relation")
+			return nil, err
+		}
+		for _, raw := range tempList {
+			relation, err := s.convertMemoRelationFromStore(ctx, raw)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to convert memo relation")
+			}
+			relationList = append(relationList, relation)
 		}
-		relationList = append(relationList, relation)
 	}
 
 	response := &v1pb.ListMemoRelationsResponse{
@@ -122,7 +135,7 @@ func (s *APIV1Service) convertMemoRelationFromStore(ctx context.Context, memoRel
 	if err != nil {
 		return nil, err
 	}
-	memoSnippet, err := s.getMemoContentSnippet(memo.Content)
+	memoSnippet, err := s.getMemoSnippet(memo)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get memo content snippet")
 	}
@@ -130,7 +143,7 @@ func (s *APIV1Service) convertMemoRelationFromStore(ctx context.Context, memoRel
 	if err != nil {
 		return nil, err
 	}
-	relatedMemoSnippet, err := s.getMemoContentSnippet(relatedMemo.Content)
+	relatedMemoSnippet, err := s.getMemoSnippet(relatedMemo)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get related memo content snippet")
 	}
@@ -153,6 +166,18 @@ func convertMemoRelationTypeFromStore(relationType store.MemoRelationType) v1pb.
 		return v1pb.MemoRelation_REFERENCE
 	case store.MemoRelationComment:
 		return v1pb.MemoRelation_COMMENT
+	case store.MemoRelationTranslationOf:
+		return v1pb.MemoRelation_TRANSLATION_OF
+	case store.MemoRelationSummaryOf:
+		return v1pb.MemoRelation_SUMMARY_OF
+	case store.MemoRelationReplyTo:
+		return v1pb.MemoRelation_REPLY_TO
+	case store.MemoRelationDuplicateOf:
+		return v1pb.MemoRelation_DUPLICATE_OF
+	case store.MemoRelationBlockedBy:
+		return v1pb.MemoRelation_BLOCKED_BY
+	case store.MemoRelationRevisionOf:
+		return v1pb.MemoRelation_REVISION_OF
 	default:
 		return v1pb.MemoRelation_TYPE_UNSPECIFIED
 	}
@@ -162,6 +187,18 @@ func convertMemoRelationTypeToStore(relationType v1pb.MemoRelation_Type) store.M
 	switch relationType {
 	case v1pb.MemoRelation_COMMENT:
 		return store.MemoRelationComment
+	case v1pb.MemoRelation_TRANSLATION_OF:
+		return store.MemoRelationTranslationOf
+	case v1pb.MemoRelation_SUMMARY_OF:
+		return store.MemoRelationSummaryOf
+	case v1pb.MemoRelation_REPLY_TO:
+		return store.MemoRelationReplyTo
+	case v1pb.MemoRelation_DUPLICATE_OF:
+		return store.MemoRelationDuplicateOf
+	case v1pb.MemoRelation_BLOCKED_BY:
+		return store.MemoRelationBlockedBy
+	case v1pb.MemoRelation_REVISION_OF:
+		return store.MemoRelationRevisionOf
 	default:
 		return store.MemoRelationReference
 	}
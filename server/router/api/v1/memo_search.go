@@ -0,0 +1,360 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// Maximum length of a search query
+	maxSearchQueryLength = 1000
+	// Default age in days that halves the relevance score of a memo
+	defaultSearchRecencyHalfLifeDays = 30
+)
+
+// memoSearchHasFilters are the filter fields of the values of the has: operator.
+var memoSearchHasFilters = map[string]string{
+	"attachment": "has_attachment",
+	"link":       "has_link",
+	"code":       "has_code",
+	"tasks":      "has_task_list",
+}
+
+// memoSearchTerm is a term of a search query: a word or phrase, or an operator with its value.
+type memoSearchTerm struct {
+	// Operator is the operator of the term, e.g. "tag", empty for the words and phrases.
+	Operator string
+	Value    string
+	// Negated excludes the memos matching the term.
+	Negated bool
+}
+
+// SearchMemos lists the memos visible to the user matching the search query.
+func (s *APIV1Service) SearchMemos(ctx context.Context, request *v1pb.SearchMemosRequest) (*v1pb.SearchMemosResponse, error) {
+	query := strings.TrimSpace(request.Query)
+	if query == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required")
+	}
+	if len(query) > maxSearchQueryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "query must not exceed %d characters", maxSearchQueryLength)
+	}
+	if request.RecencyHalfLifeDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "recency half-life must not be negative")
+	}
+	for tag, boost := range request.TagBoosts {
+		if strings.TrimSpace(tag) == "" || math.IsNaN(boost) || math.IsInf(boost, 0) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid boost %v of tag %q", boost, tag)
+		}
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	terms := parseMemoSearchQuery(query)
+	memoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting: %v", err)
+	}
+	filter, err := s.buildMemoSearchFilter(ctx, currentUser, terms, memoRelatedSetting.EnableFuzzySearch)
+	if err != nil {
+		return nil, err
+	}
+
+	memoFind, err := s.buildListMemosFind(ctx, &v1pb.ListMemosRequest{
+		Filter: filter,
+		Scope:  request.Scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch request.Ranking {
+	case v1pb.SearchMemosRequest_RANKING_UNSPECIFIED, v1pb.SearchMemosRequest_RELEVANCE:
+		memoFind.OrderBySearchScore = newMemoSearchScore(request, terms)
+	case v1pb.SearchMemosRequest_RECENCY:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ranking: %v", request.Ranking)
+	}
+	response, err := s.listMemos(ctx, memoFind, request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.listMemoSearchMatches(ctx, currentUser, response.Memos, terms)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.SearchMemosResponse{
+		Memos:         response.Memos,
+		NextPageToken: response.NextPageToken,
+		Matches:       matches,
+	}, nil
+}
+
+// listMemoSearchMatches returns where the words and phrases of the search terms were found in each of the memos. A
+// word only matched as spelled alike is found in the content.
+func (s *APIV1Service) listMemoSearchMatches(ctx context.Context, currentUser *store.User, memos []*v1pb.Memo, terms []memoSearchTerm) ([]*v1pb.SearchMemosResponse_Match, error) {
+	matches := make([]*v1pb.SearchMemosResponse_Match, 0, len(memos))
+	words := []string{}
+	for _, term := range terms {
+		if term.Operator == "" && !term.Negated {
+			words = append(words, strings.ToLower(term.Value))
+		}
+	}
+	if len(words) == 0 || len(memos) == 0 {
+		for _, memo := range memos {
+			matches = append(matches, &v1pb.SearchMemosResponse_Match{Memo: memo.Name})
+		}
+		return matches, nil
+	}
+
+	uids := make([]string, 0, len(memos))
+	for _, memo := range memos {
+		uid, err := ExtractMemoUIDFromName(memo.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid memo name: %v", err)
+		}
+		uids = append(uids, uid)
+	}
+	storeMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{UIDList: uids, ExcludeContent: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	memoIDs := map[string]int32{}
+	idList := make([]int32, 0, len(storeMemos))
+	for _, memo := range storeMemos {
+		memoIDs[memo.UID] = memo.ID
+		idList = append(idList, memo.ID)
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoIDList: idList})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachments: %v", err)
+	}
+	attachmentTexts := map[int32][]string{}
+	for _, attachment := range attachments {
+		if text := attachment.Payload.GetExtractedText(); text != "" && attachment.MemoID != nil {
+			attachmentTexts[*attachment.MemoID] = append(attachmentTexts[*attachment.MemoID], strings.ToLower(text))
+		}
+	}
+
+	for i, memo := range memos {
+		content := strings.ToLower(memo.Content)
+		comments, err := s.listMemoSearchCommentContents(ctx, currentUser, memoIDs[uids[i]])
+		if err != nil {
+			return nil, err
+		}
+		found := map[v1pb.SearchMemosResponse_MatchType]bool{}
+		for _, word := range words {
+			foundWord := false
+			if strings.Contains(content, word) {
+				found[v1pb.SearchMemosResponse_CONTENT], foundWord = true, true
+			}
+			for _, text := range attachmentTexts[memoIDs[uids[i]]] {
+				if strings.Contains(text, word) {
+					found[v1pb.SearchMemosResponse_ATTACHMENT], foundWord = true, true
+					break
+				}
+			}
+			for _, comment := range comments {
+				if strings.Contains(comment, word) {
+					found[v1pb.SearchMemosResponse_COMMENT], foundWord = true, true
+					break
+				}
+			}
+			if !foundWord {
+				found[v1pb.SearchMemosResponse_CONTENT] = true
+			}
+		}
+		match := &v1pb.SearchMemosResponse_Match{Memo: memo.Name}
+		for _, matchType := range []v1pb.SearchMemosResponse_MatchType{v1pb.SearchMemosResponse_CONTENT, v1pb.SearchMemosResponse_COMMENT, v1pb.SearchMemosResponse_ATTACHMENT} {
+			if found[matchType] {
+				match.Types = append(match.Types, matchType)
+			}
+		}
+		matches = append(matches, match)
+	}
+	return matches, nil
+}
+
+// listMemoSearchCommentContents returns the lower case content of the comments on the memo visible to the user.
+func (s *APIV1Service) listMemoSearchCommentContents(ctx context.Context, currentUser *store.User, memoID int32) ([]string, error) {
+	memoFilter, err := s.getVisibleMemoFilter(ctx, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	memoRelationComment := store.MemoRelationComment
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		RelatedMemoID: &memoID,
+		Type:          &memoRelationComment,
+		MemoFilter:    &memoFilter,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+	}
+	contents := []string{}
+	if len(relations) == 0 {
+		return contents, nil
+	}
+	commentIDs := make([]int32, 0, len(relations))
+	for _, relation := range relations {
+		commentIDs = append(commentIDs, relation.MemoID)
+	}
+	normal := store.Normal
+	comments, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: commentIDs, RowStatus: &normal})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo comments: %v", err)
+	}
+	for _, comment := range comments {
+		contents = append(contents, strings.ToLower(comment.Content))
+	}
+	return contents, nil
+}
+
+// newMemoSearchScore returns the relevance score of the memos matching the terms of the search request.
+func newMemoSearchScore(request *v1pb.SearchMemosRequest, terms []memoSearchTerm) *store.MemoSearchScore {
+	halfLifeDays := int64(request.RecencyHalfLifeDays)
+	if halfLifeDays == 0 {
+		halfLifeDays = defaultSearchRecencyHalfLifeDays
+	}
+	score := &store.MemoSearchScore{
+		TagBoosts:       map[string]float64{},
+		RecencyHalfLife: halfLifeDays * 24 * 60 * 60,
+		NowTs:           time.Now().Unix(),
+	}
+	// The excluded words and phrases are in none of the memos.
+	for _, term := range terms {
+		if term.Operator == "" && !term.Negated {
+			score.Terms = append(score.Terms, term.Value)
+		}
+	}
+	for tag, boost := range request.TagBoosts {
+		// Tags are stored without the leading #
+		score.TagBoosts[strings.TrimPrefix(strings.TrimSpace(tag), "#")] += boost
+	}
+	return score
+}
+
+// parseMemoSearchQuery splits the search query into its terms. The terms are separated by whitespace, a quoted
+// phrase is a single term, and so is the quoted value of an operator, e.g. `tag:"work"`. An unclosed quote runs to
+// the end of the query. The words with an unknown operator, e.g. URLs, are plain words.
+func parseMemoSearchQuery(query string) []memoSearchTerm {
+	terms := []memoSearchTerm{}
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+		term := memoSearchTerm{}
+		if runes[i] == '-' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			term.Negated = true
+			i++
+		}
+
+		var builder strings.Builder
+		quoted := false
+		for i < len(runes) && (quoted || !unicode.IsSpace(runes[i])) {
+			r := runes[i]
+			i++
+			if r == '"' {
+				// The quotes only group a phrase at the start of the term or of the value of an operator.
+				if quoted || builder.Len() == 0 || strings.HasSuffix(builder.String(), ":") {
+					quoted = !quoted
+					if !quoted {
+						break
+					}
+					continue
+				}
+			}
+			builder.WriteRune(r)
+		}
+		text := builder.String()
+		if operator, value, ok := strings.Cut(text, ":"); ok && isMemoSearchOperator(strings.ToLower(operator)) && value != "" {
+			term.Operator, term.Value = strings.ToLower(operator), value
+		} else {
+			term.Value = text
+		}
+		if term.Value != "" {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+func isMemoSearchOperator(operator string) bool {
+	switch operator {
+	case "tag", "before", "after", "has", "creator":
+		return true
+	default:
+		return false
+	}
+}
+
+// buildMemoSearchFilter converts the terms of a search query to a CEL filter of the memos matching all of them. The
+// words and phrases are searched in the content, the attachments and the comments of the memos. With fuzzy, they
+// also match the ones spelled alike in the content, but the excluded ones are only excluded as spelled.
+func (s *APIV1Service) buildMemoSearchFilter(ctx context.Context, currentUser *store.User, terms []memoSearchTerm, fuzzy bool) (string, error) {
+	if len(terms) == 0 {
+		return "", status.Errorf(codes.InvalidArgument, "query is required")
+	}
+	filters := make([]string, 0, len(terms))
+	for _, term := range terms {
+		var filter string
+		switch term.Operator {
+		case "":
+			// Searching the content also searches the text of the attachments.
+			value := strconv.Quote(term.Value)
+			if fuzzy && !term.Negated {
+				filter = fmt.Sprintf("(content.fuzzy_contains(%s) || comment_content.contains(%s))", value, value)
+			} else {
+				filter = fmt.Sprintf("(content.contains(%s) || comment_content.contains(%s))", value, value)
+			}
+		case "tag":
+			// Tags are stored without the leading #
+			filter = fmt.Sprintf("tag in [%s]", strconv.Quote(strings.TrimPrefix(term.Value, "#")))
+		case "before", "after":
+			date, err := time.Parse("2006-01-02", term.Value)
+			if err != nil {
+				return "", status.Errorf(codes.InvalidArgument, "invalid date %q of %s:, expected YYYY-MM-DD", term.Value, term.Operator)
+			}
+			if term.Operator == "before" {
+				filter = fmt.Sprintf("created_ts < %d", date.Unix())
+			} else {
+				filter = fmt.Sprintf("created_ts >= %d", date.Unix())
+			}
+		case "has":
+			field, ok := memoSearchHasFilters[strings.ToLower(term.Value)]
+			if !ok {
+				return "", status.Errorf(codes.InvalidArgument, "unsupported has:%s, must be one of attachment, link, code or tasks", term.Value)
+			}
+			filter = field
+		case "creator":
+			if currentUser == nil || !isSuperUser(currentUser) {
+				return "", status.Errorf(codes.PermissionDenied, "creator: is only available to admins")
+			}
+			username := strings.TrimPrefix(term.Value, "@")
+			creator, err := s.Store.GetUser(ctx, &store.FindUser{Username: &username})
+			if err != nil {
+				return "", status.Errorf(codes.Internal, "failed to get user: %v", err)
+			}
+			if creator == nil {
+				return "", status.Errorf(codes.NotFound, "user %q not found", username)
+			}
+			filter = fmt.Sprintf("creator_id == %d", creator.ID)
+		}
+		if term.Negated {
+			filter = fmt.Sprintf("!(%s)", filter)
+		}
+		filters = append(filters, filter)
+	}
+	return strings.Join(filters, " && "), nil
+}
@@ -0,0 +1,175 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/ai"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// Default and maximum number of memos returned by a semantic search
+	defaultSemanticSearchPageSize = 10
+	maxSemanticSearchPageSize     = 50
+	// Maximum length of a semantic search query
+	maxSemanticSearchQueryLength = 1000
+)
+
+// SearchMemosSemantic embeds the query and returns the memos visible to the user whose embedding is the closest.
+func (s *APIV1Service) SearchMemosSemantic(ctx context.Context, request *v1pb.SearchMemosSemanticRequest) (*v1pb.SearchMemosSemanticResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	query := strings.TrimSpace(request.Query)
+	if query == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required")
+	}
+	if len(query) > maxSemanticSearchQueryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "query must not exceed %d characters", maxSemanticSearchQueryLength)
+	}
+	pageSize := int(request.PageSize)
+	if pageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must not be negative")
+	}
+	if pageSize == 0 {
+		pageSize = defaultSemanticSearchPageSize
+	}
+	pageSize = min(pageSize, maxSemanticSearchPageSize)
+	rowStatuses, err := getMemoScopeRowStatuses(request.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := s.getAIConfig(ctx, store.AIFeatureEmbedding)
+	if err != nil {
+		return nil, err
+	}
+	if config.EmbeddingModel == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "AI embedding model is not configured")
+	}
+	if err := s.checkAITokenBudget(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceAITokens, 1); err != nil {
+		return nil, err
+	}
+	ctx = withAIUsageScope(ctx, user.ID, aiOperationSemanticSearch)
+	embedding, err := s.embedQuery(ctx, config, query)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to embed semantic search query",
+			"user_id", user.ID,
+			"error", err)
+		return nil, err
+	}
+
+	visibilities, err := s.getVisibleMemoVisibilities(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.Store.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{
+		Model:          config.EmbeddingModel,
+		Embedding:      embedding,
+		ViewerID:       &user.ID,
+		VisibilityList: visibilities,
+		RowStatusList:  rowStatuses,
+		Limit:          pageSize,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search memo embeddings: %v", err)
+	}
+	if len(matches) == 0 {
+		return &v1pb.SearchMemosSemanticResponse{Results: []*v1pb.SearchMemosSemanticResponse_Result{}}, nil
+	}
+
+	memoIDs := make([]int32, 0, len(matches))
+	for _, match := range matches {
+		memoIDs = append(memoIDs, match.MemoID)
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: memoIDs})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	// The memos of the users who opted out of the AI processing are left out, even if their embedding was not removed yet.
+	memos, err = s.filterAIConsentedMemos(ctx, user.ID, memos)
+	if err != nil {
+		return nil, err
+	}
+	memoMap := make(map[int32]*store.Memo, len(memos))
+	for _, memo := range memos {
+		memoMap[memo.ID] = memo
+	}
+
+	matchedMemos := make([]*store.Memo, 0, len(matches))
+	scores := make([]float32, 0, len(matches))
+	for _, match := range matches {
+		memo, ok := memoMap[match.MemoID]
+		if !ok {
+			continue
+		}
+		matchedMemos = append(matchedMemos, memo)
+		scores = append(scores, match.Score)
+	}
+	memoMessages, err := s.convertMemosFromStore(ctx, matchedMemos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
+	}
+	results := make([]*v1pb.SearchMemosSemanticResponse_Result, 0, len(memoMessages))
+	for i, memoMessage := range memoMessages {
+		results = append(results, &v1pb.SearchMemosSemanticResponse_Result{
+			Memo:  memoMessage,
+			Score: scores[i],
+		})
+	}
+	return &v1pb.SearchMemosSemanticResponse{Results: results}, nil
+}
+
+// embedQuery embeds the query with the embedding model of the configuration, counting the tokens used.
+func (s *APIV1Service) embedQuery(ctx context.Context, config *AIConfig, query string) ([]float32, error) {
+	vectors, err := s.embedTexts(ctx, config, []string{query})
+	if err != nil {
+		return nil, err
+	}
+	return vectors[0], nil
+}
+
+// embedTexts embeds the texts with the embedding model of the configuration, counting the tokens used.
+func (s *APIV1Service) embedTexts(ctx context.Context, config *AIConfig, texts []string) ([][]float32, error) {
+	provider, err := s.createAIProvider(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	embeddings, err := provider.Embed(ctx, &ai.EmbeddingRequest{Model: config.EmbeddingModel, Input: texts})
+	if err != nil {
+		return nil, aiCallError(err, "failed to embed texts")
+	}
+	if len(embeddings.Vectors) != len(texts) {
+		return nil, status.Errorf(codes.Internal, "failed to embed texts: expected %d embeddings, got %d", len(texts), len(embeddings.Vectors))
+	}
+	if err := s.AddAITokenUsage(ctx, embeddings.TotalTokens); err != nil {
+		slog.Warn("failed to record AI token usage", "error", err)
+	}
+	return embeddings.Vectors, nil
+}
+
+// embedMemoAsync updates the embedding of the memo in the background if an embedder is set.
+func (s *APIV1Service) embedMemoAsync(memo *store.Memo) {
+	if s.MemoEmbedder == nil {
+		return
+	}
+	go func() {
+		if err := s.MemoEmbedder.Embed(context.Background(), memo); err != nil {
+			slog.Warn("Failed to embed memo", slog.Int("memoID", int(memo.ID)), slog.Any("err", err))
+		}
+	}()
+}
@@ -4,23 +4,37 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/lithammer/shortuuid/v4"
 	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/maintenance"
 	"github.com/usememos/memos/server/runner/memopayload"
 	"github.com/usememos/memos/store"
 )
 
+// maxContentWarningLength is the maximum length of a memo content warning.
+const maxContentWarningLength = 256
+
 func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoRequest) (*v1pb.Memo, error) {
+	return s.createMemo(ctx, request, nil)
+}
+
+// createMemo creates the memo together with its relations and attachments. When parent is set,
+// the memo is created as a comment of it in the same transaction.
+func (s *APIV1Service) createMemo(ctx context.Context, request *v1pb.CreateMemoRequest, parent *store.Memo) (*v1pb.Memo, error) {
 	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
@@ -39,54 +53,114 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting")
 	}
+	// Apply the default visibility of the user's role when the visibility is not specified.
+	if request.Memo.Visibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		if visibility, ok := workspaceMemoRelatedSetting.RoleDefaultVisibilities[user.Role.String()]; ok {
+			create.Visibility = store.Visibility(visibility)
+		}
+	}
+	if !request.SkipFormatting {
+		if create.Content, err = s.formatMemoContent(ctx, user.ID, create.Content); err != nil {
+			return nil, err
+		}
+	}
+	// The comments follow the visibility of their memos, the tagging rules only tag them.
+	if err := s.applyTaggingRules(ctx, create, parent == nil); err != nil {
+		return nil, err
+	}
 	if workspaceMemoRelatedSetting.DisallowPublicVisibility && create.Visibility == store.Public {
 		return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
 	}
+	if err := s.checkNewUserLimit(ctx, user, newUserLimitActionCreateMemo); err != nil {
+		return nil, err
+	}
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceMemos, 1); err != nil {
+		return nil, err
+	}
+	if create.Visibility == store.Public {
+		if err := s.checkNewUserLimit(ctx, user, newUserLimitActionPublicMemo); err != nil {
+			return nil, err
+		}
+	}
 	contentLengthLimit, err := s.getContentLengthLimit(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get content length limit")
 	}
-	if len(create.Content) > contentLengthLimit {
-		return nil, status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
+	if err := checkContentLength(create.Content, contentLengthLimit); err != nil {
+		return nil, err
 	}
+	s.applyAutoTags(ctx, user, create)
 	if err := memopayload.RebuildMemoPayload(create, s.MarkdownService); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
 	}
 	if request.Memo.Location != nil {
 		create.Payload.Location = convertLocationToStore(request.Memo.Location)
 	}
-
-	memo, err := s.Store.CreateMemo(ctx, create)
+	contentWarning, err := normalizeContentWarning(request.Memo.ContentWarning)
 	if err != nil {
 		return nil, err
 	}
-
-	attachments := []*store.Attachment{}
-
-	if len(request.Memo.Attachments) > 0 {
-		_, err := s.SetMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
-			Name:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
-			Attachments: request.Memo.Attachments,
-		})
+	create.Payload.ContentWarning = contentWarning
+	if create.Payload.Language, err = normalizeMemoLanguage(request.Memo.Language); err != nil {
+		return nil, err
+	}
+	if request.Memo.Slug != "" {
+		if create.Payload.Slug, err = s.validateMemoSlug(ctx, user.ID, 0, request.Memo.Slug); err != nil {
+			return nil, err
+		}
+	}
+	if request.Memo.ExpireTime != nil {
+		expiry, err := convertMemoExpiryToStore(request.Memo.ExpireTime.AsTime(), request.Memo.ExpiryAction)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to set memo attachments")
+			return nil, err
+		}
+		create.Payload.Expiry = expiry
+	}
+	if err := s.validateMemoTagTemplates(ctx, create); err != nil {
+		return nil, err
+	}
+	recordMemoContributor(create, user.ID, time.Now().Unix())
+	// The comments follow the visibility of their memos, only the memos may require an approval.
+	awaitsApproval := false
+	if parent == nil {
+		if create.Visibility, awaitsApproval, err = s.applyMemoApproval(ctx, user, create, create.Visibility); err != nil {
+			return nil, err
 		}
+	}
 
-		a, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
-			MemoID: &memo.ID,
+	associations, err := s.buildMemoAssociations(ctx, request.Memo)
+	if err != nil {
+		return nil, err
+	}
+	if parent != nil {
+		associations.Relations = append(associations.Relations, &store.MemoRelation{
+			RelatedMemoID: parent.ID,
+			Type:          store.MemoRelationComment,
 		})
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get memo attachments")
+	}
+	memo, err := s.Store.CreateMemoWithAssociations(ctx, create, associations)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo: %v", err)
+	}
+	if parent != nil {
+		memo.ParentUID = &parent.UID
+	}
+	if err := s.recordMemoSlug(ctx, memo); err != nil {
+		return nil, err
+	}
+	if awaitsApproval {
+		if err := s.notifyMemoApprovers(ctx, memo); err != nil {
+			slog.Warn("Failed to notify memo approvers", slog.Any("err", err))
 		}
-		attachments = a
 	}
-	if len(request.Memo.Relations) > 0 {
-		_, err := s.SetMemoRelations(ctx, &v1pb.SetMemoRelationsRequest{
-			Name:      fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
-			Relations: request.Memo.Relations,
+
+	attachments := []*store.Attachment{}
+	if len(associations.AttachmentIDs) > 0 {
+		attachments, err = s.Store.ListAttachments(ctx, &store.FindAttachment{
+			MemoID: &memo.ID,
 		})
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to set memo relations")
+			return nil, errors.Wrap(err, "failed to get memo attachments")
 		}
 	}
 
@@ -98,21 +172,108 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
 		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
 	}
+	s.recordEvent(ctx, store.EventTypeMemoCreated, memo.CreatorID, memoMessage.Name, memoMessage)
+	s.archiveMemoLinksAsync(ctx, memo)
+	s.embedMemoAsync(memo)
+	s.publishMemoToNostrAsync(ctx, memo)
+	s.crossPostMemoAsync(ctx, memo)
 
 	return memoMessage, nil
 }
 
+// buildMemoAssociations resolves the relations and attachments of a memo to be created.
+func (s *APIV1Service) buildMemoAssociations(ctx context.Context, memo *v1pb.Memo) (*store.MemoAssociations, error) {
+	associations := &store.MemoAssociations{}
+	for _, relation := range memo.Relations {
+		// Comment relations are only created through CreateMemoComment.
+		if relation.Type == v1pb.MemoRelation_COMMENT || relation.RelatedMemo == nil {
+			continue
+		}
+		relatedMemoUID, err := ExtractMemoUIDFromName(relation.RelatedMemo.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid related memo name: %v", err)
+		}
+		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &relatedMemoUID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get related memo: %v", err)
+		}
+		if relatedMemo == nil {
+			return nil, status.Errorf(codes.NotFound, "related memo %s not found", relation.RelatedMemo.Name)
+		}
+		relationType := convertMemoRelationTypeToStore(relation.Type)
+		if slices.ContainsFunc(associations.Relations, func(r *store.MemoRelation) bool {
+			return r.RelatedMemoID == relatedMemo.ID && r.Type == relationType
+		}) {
+			continue
+		}
+		associations.Relations = append(associations.Relations, &store.MemoRelation{
+			RelatedMemoID: relatedMemo.ID,
+			Type:          relationType,
+		})
+	}
+	for _, attachment := range memo.Attachments {
+		attachmentUID, err := ExtractAttachmentUIDFromName(attachment.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid attachment name: %v", err)
+		}
+		storeAttachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{UID: &attachmentUID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+		}
+		if storeAttachment == nil {
+			return nil, status.Errorf(codes.NotFound, "attachment %s not found", attachment.Name)
+		}
+		associations.AttachmentIDs = append(associations.AttachmentIDs, storeAttachment.ID)
+	}
+	return associations, nil
+}
+
 func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosRequest) (*v1pb.ListMemosResponse, error) {
+	memoFind, err := s.buildListMemosFind(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return s.listMemos(ctx, memoFind, request.PageSize, request.PageToken)
+}
+
+// buildListMemosFind returns the find of the memos visible to the current user the request lists, without pagination.
+func (s *APIV1Service) buildListMemosFind(ctx context.Context, request *v1pb.ListMemosRequest) (*store.FindMemo, error) {
 	memoFind := &store.FindMemo{
 		// Exclude comments by default.
 		ExcludeComments: true,
 	}
-	if request.State == v1pb.State_ARCHIVED {
-		state := store.Archived
-		memoFind.RowStatus = &state
-	} else {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	switch request.Scope {
+	case v1pb.MemoScope_MEMO_SCOPE_UNSPECIFIED:
+		if request.State == v1pb.State_ARCHIVED {
+			state := store.Archived
+			memoFind.RowStatus = &state
+		} else {
+			state := store.Normal
+			memoFind.RowStatus = &state
+		}
+	case v1pb.MemoScope_MEMO_SCOPE_NORMAL:
 		state := store.Normal
 		memoFind.RowStatus = &state
+	case v1pb.MemoScope_MEMO_SCOPE_ARCHIVED:
+		if currentUser == nil {
+			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+		}
+		// The archived memos are only listed among the memos of the user.
+		state := store.Archived
+		memoFind.RowStatus = &state
+		memoFind.CreatorID = &currentUser.ID
+	case v1pb.MemoScope_MEMO_SCOPE_ALL:
+		if currentUser == nil {
+			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+		}
+		// The normal memos, and the archived memos of the user.
+		memoFind.Filters = append(memoFind.Filters, fmt.Sprintf("creator_id == %d || state == %q", currentUser.ID, store.Normal))
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid scope: %v", request.Scope)
 	}
 
 	// Parse order_by field (replaces the old sort and direction fields)
@@ -132,19 +293,18 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		memoFind.Filters = append(memoFind.Filters, request.Filter)
 	}
 
-	currentUser, err := s.GetCurrentUser(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user")
-	}
-	if currentUser == nil {
-		memoFind.VisibilityList = []store.Visibility{store.Public}
-	} else {
-		if memoFind.CreatorID == nil {
-			filter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
-			memoFind.Filters = append(memoFind.Filters, filter)
-		} else if *memoFind.CreatorID != currentUser.ID {
-			memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+	if currentUser == nil || (memoFind.CreatorID != nil && *memoFind.CreatorID != currentUser.ID) {
+		visibilities, err := s.getVisibleMemoVisibilities(ctx, currentUser)
+		if err != nil {
+			return nil, err
+		}
+		memoFind.VisibilityList = visibilities
+	} else if memoFind.CreatorID == nil {
+		filter, err := s.getVisibleMemoFilter(ctx, currentUser)
+		if err != nil {
+			return nil, err
 		}
+		memoFind.Filters = append(memoFind.Filters, filter)
 	}
 
 	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
@@ -154,17 +314,21 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
 		memoFind.OrderByUpdatedTs = true
 	}
+	return memoFind, nil
+}
 
+// listMemos lists a page of memos matching the given find and converts them to API memos.
+func (s *APIV1Service) listMemos(ctx context.Context, memoFind *store.FindMemo, pageSize int32, rawPageToken string) (*v1pb.ListMemosResponse, error) {
 	var limit, offset int
-	if request.PageToken != "" {
+	if rawPageToken != "" {
 		var pageToken v1pb.PageToken
-		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+		if err := unmarshalPageToken(rawPageToken, &pageToken); err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
 		}
 		limit = int(pageToken.Limit)
 		offset = int(pageToken.Offset)
 	} else {
-		limit = int(request.PageSize)
+		limit = int(pageSize)
 	}
 	if limit <= 0 {
 		limit = DefaultPageSize
@@ -195,53 +359,40 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		return response, nil
 	}
 
-	reactionMap := make(map[string][]*store.Reaction)
-	contentIDs := make([]string, 0, len(memos))
-
-	attachmentMap := make(map[int32][]*store.Attachment)
-	memoIDs := make([]int32, 0, len(memos))
-
-	for _, m := range memos {
-		contentIDs = append(contentIDs, fmt.Sprintf("%s%s", MemoNamePrefix, m.UID))
-		memoIDs = append(memoIDs, m.ID)
-	}
-
-	// REACTIONS
-	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentIDList: contentIDs})
+	memoMessages, err = s.convertMemosFromStore(ctx, memos)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list reactions")
+		return nil, errors.Wrap(err, "failed to convert memos")
 	}
-	for _, reaction := range reactions {
-		reactionMap[reaction.ContentID] = append(reactionMap[reaction.ContentID], reaction)
+
+	response := &v1pb.ListMemosResponse{
+		Memos:         memoMessages,
+		NextPageToken: nextPageToken,
 	}
+	return response, nil
+}
 
-	// ATTACHMENTS
-	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoIDList: memoIDs})
+// ListMemosWithBrokenLinks lists the current user's memos flagged by the link checker.
+func (s *APIV1Service) ListMemosWithBrokenLinks(ctx context.Context, request *v1pb.ListMemosWithBrokenLinksRequest) (*v1pb.ListMemosWithBrokenLinksResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list attachments")
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	for _, attachment := range attachments {
-		attachmentMap[*attachment.MemoID] = append(attachmentMap[*attachment.MemoID], attachment)
-	}
-
-	for _, memo := range memos {
-		memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
-		reactions := reactionMap[memoName]
-		attachments := attachmentMap[memo.ID]
-
-		memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert memo")
-		}
-
-		memoMessages = append(memoMessages, memoMessage)
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
 
-	response := &v1pb.ListMemosResponse{
-		Memos:         memoMessages,
-		NextPageToken: nextPageToken,
+	response, err := s.ListMemos(ctx, &v1pb.ListMemosRequest{
+		PageSize:  request.PageSize,
+		PageToken: request.PageToken,
+		Filter:    fmt.Sprintf("creator_id == %d && has_broken_link", user.ID),
+	})
+	if err != nil {
+		return nil, err
 	}
-	return response, nil
+	return &v1pb.ListMemosWithBrokenLinksResponse{
+		Memos:         response.Memos,
+		NextPageToken: response.NextPageToken,
+	}, nil
 }
 
 func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest) (*v1pb.Memo, error) {
@@ -266,7 +417,11 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 		if user == nil {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
-		if memo.Visibility == store.Private && memo.CreatorID != user.ID {
+		canView, err := s.canViewMemo(ctx, user, memo)
+		if err != nil {
+			return nil, err
+		}
+		if !canView {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 	}
@@ -289,6 +444,7 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert memo")
 	}
+	s.recordMemoView(ctx, memo)
 	return memoMessage, nil
 }
 
@@ -321,13 +477,21 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
-	// Check if memo has #AI tag - AI-generated memos cannot be edited.
-	if memo.Payload != nil && len(memo.Payload.Tags) > 0 {
-		for _, tag := range memo.Payload.Tags {
-			if tag == "AI" {
-				return nil, status.Errorf(codes.PermissionDenied, "AI-generated memos cannot be edited")
-			}
-		}
+	// AI-generated memos cannot be edited.
+	if memo.Payload.GetProperty().GetIsAiGenerated() {
+		return nil, status.Errorf(codes.PermissionDenied, "AI-generated memos cannot be edited")
+	}
+
+	// Keep a snapshot of the memo before the update for the memo updated webhook.
+	previousAttachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachments")
+	}
+	previousMemoMessage, err := s.convertMemoFromStore(ctx, memo, nil, previousAttachments)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
 	}
 
 	update := &store.UpdateMemo{
@@ -335,17 +499,31 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	}
 	for _, path := range request.UpdateMask.Paths {
 		if path == "content" {
+			content := request.Memo.Content
+			if !request.SkipFormatting {
+				if content, err = s.formatMemoContent(ctx, user.ID, content); err != nil {
+					return nil, err
+				}
+			}
 			contentLengthLimit, err := s.getContentLengthLimit(ctx)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to get content length limit")
 			}
-			if len(request.Memo.Content) > contentLengthLimit {
-				return nil, status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
+			if err := checkContentLength(content, contentLengthLimit); err != nil {
+				return nil, err
+			}
+			memo.Content = content
+			if err := s.applyTaggingRules(ctx, memo, false); err != nil {
+				return nil, err
 			}
-			memo.Content = request.Memo.Content
+			s.applyAutoTags(ctx, user, memo)
 			if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
 			}
+			if err := s.validateMemoTagTemplates(ctx, memo); err != nil {
+				return nil, err
+			}
+			recordMemoContributor(memo, user.ID, time.Now().Unix())
 			update.Content = &memo.Content
 			update.Payload = memo.Payload
 		} else if path == "visibility" {
@@ -357,6 +535,11 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			if workspaceMemoRelatedSetting.DisallowPublicVisibility && visibility == store.Public {
 				return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
 			}
+			if visibility == store.Public {
+				if err := s.checkNewUserLimit(ctx, user, newUserLimitActionPublicMemo); err != nil {
+					return nil, err
+				}
+			}
 			update.Visibility = &visibility
 		} else if path == "pinned" {
 			update.Pinned = &request.Memo.Pinned
@@ -387,6 +570,60 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			payload := memo.Payload
 			payload.Location = convertLocationToStore(request.Memo.Location)
 			update.Payload = payload
+		} else if path == "content_warning" {
+			contentWarning, err := normalizeContentWarning(request.Memo.ContentWarning)
+			if err != nil {
+				return nil, err
+			}
+			payload := memo.Payload
+			payload.ContentWarning = contentWarning
+			update.Payload = payload
+		} else if path == "language" {
+			memoLanguage, err := normalizeMemoLanguage(request.Memo.Language)
+			if err != nil {
+				return nil, err
+			}
+			payload := memo.Payload
+			payload.Language = memoLanguage
+			update.Payload = payload
+		} else if path == "slug" {
+			// An empty slug removes the slug of the memo, its previous slugs still resolve to it.
+			slug := ""
+			if request.Memo.Slug != "" {
+				slug, err = s.validateMemoSlug(ctx, memo.CreatorID, memo.ID, request.Memo.Slug)
+				if err != nil {
+					return nil, err
+				}
+			}
+			payload := memo.Payload
+			payload.Slug = slug
+			update.Payload = payload
+		} else if path == "expire_time" {
+			// A cleared expire time makes the memo never expire.
+			var expiry *storepb.MemoPayload_Expiry
+			if request.Memo.ExpireTime != nil {
+				action := request.Memo.ExpiryAction
+				if !slices.Contains(request.UpdateMask.Paths, "expiry_action") {
+					action = convertMemoExpiryActionFromStore(memo.Payload.GetExpiry().GetAction())
+				}
+				expiry, err = convertMemoExpiryToStore(request.Memo.ExpireTime.AsTime(), action)
+				if err != nil {
+					return nil, err
+				}
+			}
+			payload := memo.Payload
+			payload.Expiry = expiry
+			update.Payload = payload
+		} else if path == "expiry_action" {
+			if slices.Contains(request.UpdateMask.Paths, "expire_time") {
+				continue
+			}
+			if memo.Payload.GetExpiry() == nil {
+				return nil, status.Errorf(codes.FailedPrecondition, "memo has no expire time")
+			}
+			payload := memo.Payload
+			payload.Expiry.Action = convertMemoExpiryActionToStore(request.Memo.ExpiryAction)
+			update.Payload = payload
 		} else if path == "attachments" {
 			_, err := s.SetMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
 				Name:        request.Memo.Name,
@@ -406,6 +643,31 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 		}
 	}
 
+	awaitsApproval := false
+	if (update.Content != nil || update.Visibility != nil) && memo.ParentUID == nil {
+		// The memos under review are private, their requested visibility is kept in their approval.
+		visibility := memo.Visibility
+		if update.Visibility != nil {
+			visibility = *update.Visibility
+		} else if isMemoUnderReview(memo) {
+			visibility = store.Visibility(memo.Payload.Approval.RequestedVisibility)
+		}
+		creator := user
+		if memo.CreatorID != user.ID {
+			if creator, err = s.Store.GetUser(ctx, &store.FindUser{ID: &memo.CreatorID}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get memo creator: %v", err)
+			}
+			if creator == nil {
+				return nil, status.Errorf(codes.NotFound, "memo creator not found")
+			}
+		}
+		if visibility, awaitsApproval, err = s.applyMemoApproval(ctx, creator, memo, visibility); err != nil {
+			return nil, err
+		}
+		update.Visibility = &visibility
+		update.Payload = memo.Payload
+	}
+
 	if err = s.Store.UpdateMemo(ctx, update); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update memo")
 	}
@@ -416,6 +678,16 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get memo")
 	}
+	if memo.Payload.GetSlug() != previousMemoMessage.Slug {
+		if err := s.recordMemoSlug(ctx, memo); err != nil {
+			return nil, err
+		}
+	}
+	if awaitsApproval {
+		if err := s.notifyMemoApprovers(ctx, memo); err != nil {
+			slog.Warn("Failed to notify memo approvers", slog.Any("err", err))
+		}
+	}
 	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
 		ContentID: &request.Memo.Name,
 	})
@@ -434,9 +706,18 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 		return nil, errors.Wrap(err, "failed to convert memo")
 	}
 	// Try to dispatch webhook when memo is updated.
-	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
+	if err := s.DispatchMemoUpdatedWebhook(ctx, previousMemoMessage, memoMessage); err != nil {
 		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
 	}
+	s.recordEvent(ctx, store.EventTypeMemoUpdated, memo.CreatorID, memoMessage.Name, memoMessage)
+	if update.Content != nil {
+		s.archiveMemoLinksAsync(ctx, memo)
+	}
+	if update.Content != nil || update.Visibility != nil || update.RowStatus != nil {
+		s.embedMemoAsync(memo)
+		s.publishMemoToNostrAsync(ctx, memo)
+		s.crossPostMemoAsync(ctx, memo)
+	}
 
 	return memoMessage, nil
 }
@@ -468,21 +749,32 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
+	if err := s.PurgeMemo(ctx, memo); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// PurgeMemo deletes the memo together with its comments, attachments and related records,
+// dispatching the memo deleted webhooks.
+func (s *APIV1Service) PurgeMemo(ctx context.Context, memo *store.Memo) error {
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
 	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
-		ContentID: &request.Name,
+		ContentID: &memoName,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list reactions")
+		return status.Errorf(codes.Internal, "failed to list reactions")
 	}
 
 	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
 		MemoID: &memo.ID,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list attachments")
+		return status.Errorf(codes.Internal, "failed to list attachments")
 	}
 
-	if memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments); err == nil {
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
+	if err == nil {
 		// Try to dispatch webhook when memo is deleted.
 		if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
 			slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
@@ -490,18 +782,51 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 	}
 
 	if err = s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo")
+		return status.Errorf(codes.Internal, "failed to delete memo")
 	}
+	s.recordEvent(ctx, store.EventTypeMemoDeleted, memo.CreatorID, memoName, memoMessage)
+	s.publishMemoToNostrAsync(ctx, memo)
+	s.crossPostMemoAsync(ctx, memo)
 
 	// Delete memo relation
 	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &memo.ID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo relations")
+		return status.Errorf(codes.Internal, "failed to delete memo relations")
+	}
+
+	// Delete memo read states
+	if err := s.Store.DeleteMemoReadState(ctx, &store.DeleteMemoReadState{MemoID: &memo.ID}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete memo read states")
+	}
+
+	// Delete memo subscriptions
+	if err := s.Store.DeleteMemoSubscription(ctx, &store.DeleteMemoSubscription{MemoID: &memo.ID}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete memo subscriptions")
+	}
+
+	// Delete memo views
+	if err := s.Store.DeleteMemoViews(ctx, &store.DeleteMemoView{MemoID: memo.ID}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete memo views")
+	}
+
+	// Delete memo slugs
+	if err := s.Store.DeleteMemoSlug(ctx, &store.DeleteMemoSlug{MemoID: memo.ID}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete memo slugs")
+	}
+
+	// Delete memo embedding
+	if err := s.Store.DeleteMemoEmbedding(ctx, &store.DeleteMemoEmbedding{MemoID: &memo.ID}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete memo embedding")
+	}
+
+	// Delete memo webmentions
+	if err := s.Store.DeleteWebmention(ctx, &store.DeleteWebmention{MemoID: &memo.ID}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete memo webmentions")
 	}
 
 	// Delete related attachments.
 	for _, attachment := range attachments {
 		if err := s.Store.DeleteAttachment(ctx, &store.DeleteAttachment{ID: attachment.ID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete attachment")
+			return status.Errorf(codes.Internal, "failed to delete attachment")
 		}
 	}
 
@@ -509,21 +834,21 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 	commentType := store.MemoRelationComment
 	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID, Type: &commentType})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo comments")
+		return status.Errorf(codes.Internal, "failed to list memo comments")
 	}
 	for _, relation := range relations {
 		if err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: relation.MemoID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete memo comment")
+			return status.Errorf(codes.Internal, "failed to delete memo comment")
 		}
 	}
 
-	// Delete memo references
-	referenceType := store.MemoRelationReference
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{RelatedMemoID: &memo.ID, Type: &referenceType}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo references")
+	// Delete the relations of other memos to the memo
+	relationTypes := append([]store.MemoRelationType{store.MemoRelationSummaryOf}, settableMemoRelationTypes...)
+	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{RelatedMemoID: &memo.ID, TypeList: relationTypes}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete memo references")
 	}
 
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
 func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.CreateMemoCommentRequest) (*v1pb.Memo, error) {
@@ -535,11 +860,14 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if relatedMemo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
 
-	// Create the memo comment first.
-	memoComment, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: request.Comment})
+	// Create the memo comment along with its relation to the original memo.
+	memoComment, err := s.createMemo(ctx, &v1pb.CreateMemoRequest{Memo: request.Comment}, relatedMemo)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create memo")
+		return nil, err
 	}
 	memoUID, err = ExtractMemoUIDFromName(memoComment.Name)
 	if err != nil {
@@ -549,47 +877,19 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
-
-	// Build the relation between the comment memo and the original memo.
-	_, err = s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
-		MemoID:        memo.ID,
-		RelatedMemoID: relatedMemo.ID,
-		Type:          store.MemoRelationComment,
-	})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create memo relation")
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo comment not found")
 	}
-	creatorID, err := ExtractUserIDFromName(memoComment.Creator)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid memo creator")
+	// Commenting on a memo subscribes to its comments.
+	if err := s.subscribeMemoCommenter(ctx, relatedMemo, memo.CreatorID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to subscribe to memo comments")
 	}
-	if memoComment.Visibility != v1pb.Visibility_PRIVATE && creatorID != relatedMemo.CreatorID {
-		activity, err := s.Store.CreateActivity(ctx, &store.Activity{
-			CreatorID: creatorID,
-			Type:      store.ActivityTypeMemoComment,
-			Level:     store.ActivityLevelInfo,
-			Payload: &storepb.ActivityPayload{
-				MemoComment: &storepb.ActivityMemoCommentPayload{
-					MemoId:        memo.ID,
-					RelatedMemoId: relatedMemo.ID,
-				},
-			},
-		})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create activity")
-		}
-		if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
-			SenderID:   creatorID,
-			ReceiverID: relatedMemo.CreatorID,
-			Status:     store.UNREAD,
-			Message: &storepb.InboxMessage{
-				Type:       storepb.InboxMessage_MEMO_COMMENT,
-				ActivityId: &activity.ID,
-			},
-		}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create inbox")
+	if memo.Visibility != store.Private {
+		if err := s.notifyMemoComment(ctx, memo, relatedMemo); err != nil {
+			return nil, err
 		}
 	}
+	s.recordEvent(ctx, store.EventTypeMemoCommentCreated, relatedMemo.CreatorID, request.Name, memoComment)
 
 	return memoComment, nil
 }
@@ -608,11 +908,9 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
-	var memoFilter string
-	if currentUser == nil {
-		memoFilter = `visibility == "PUBLIC"`
-	} else {
-		memoFilter = fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
+	memoFilter, err := s.getVisibleMemoFilter(ctx, currentUser)
+	if err != nil {
+		return nil, err
 	}
 	memoRelationComment := store.MemoRelationComment
 	memoRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
@@ -640,46 +938,9 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 		return nil, status.Errorf(codes.Internal, "failed to list memos")
 	}
 
-	memoIDToNameMap := make(map[int32]string)
-	contentIDs := make([]string, 0, len(memos))
-	memoIDsForAttachments := make([]int32, 0, len(memos))
-
-	for _, memo := range memos {
-		memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
-		memoIDToNameMap[memo.ID] = memoName
-		contentIDs = append(contentIDs, memoName)
-		memoIDsForAttachments = append(memoIDsForAttachments, memo.ID)
-	}
-	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentIDList: contentIDs})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list reactions")
-	}
-
-	memoReactionsMap := make(map[string][]*store.Reaction)
-	for _, reaction := range reactions {
-		memoReactionsMap[reaction.ContentID] = append(memoReactionsMap[reaction.ContentID], reaction)
-	}
-
-	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoIDList: memoIDsForAttachments})
+	memosResponse, err := s.convertMemosFromStore(ctx, memos)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list attachments")
-	}
-	attachmentMap := make(map[int32][]*store.Attachment)
-	for _, attachment := range attachments {
-		attachmentMap[*attachment.MemoID] = append(attachmentMap[*attachment.MemoID], attachment)
-	}
-
-	var memosResponse []*v1pb.Memo
-	for _, m := range memos {
-		memoName := memoIDToNameMap[m.ID]
-		reactions := memoReactionsMap[memoName]
-		attachments := attachmentMap[m.ID]
-
-		memoMessage, err := s.convertMemoFromStore(ctx, m, reactions, attachments)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert memo")
-		}
-		memosResponse = append(memosResponse, memoMessage)
+		return nil, errors.Wrap(err, "failed to convert memos")
 	}
 
 	response := &v1pb.ListMemoCommentsResponse{
@@ -722,6 +983,12 @@ func (s *APIV1Service) RenameMemoTag(ctx context.Context, request *v1pb.RenameMe
 			return nil, status.Errorf(codes.Internal, "failed to rename tag: %v", err)
 		}
 		memo.Content = newContent
+		// The tags applied by the AI are renamed along the ones of the content.
+		for i, tag := range memo.Payload.GetAiTags() {
+			if tag == request.OldTag {
+				memo.Payload.AiTags[i] = request.NewTag
+			}
+		}
 
 		if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
@@ -797,20 +1064,21 @@ func (s *APIV1Service) getContentLengthLimit(ctx context.Context) (int, error) {
 
 // DispatchMemoCreatedWebhook dispatches webhook when memo is created.
 func (s *APIV1Service) DispatchMemoCreatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.created")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, nil, "memos.memo.created")
 }
 
 // DispatchMemoUpdatedWebhook dispatches webhook when memo is updated.
-func (s *APIV1Service) DispatchMemoUpdatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.updated")
+// The payload includes the memo before the update and the list of changed fields.
+func (s *APIV1Service) DispatchMemoUpdatedWebhook(ctx context.Context, previousMemo, memo *v1pb.Memo) error {
+	return s.dispatchMemoRelatedWebhook(ctx, memo, previousMemo, "memos.memo.updated")
 }
 
 // DispatchMemoDeletedWebhook dispatches webhook when memo is deleted.
 func (s *APIV1Service) DispatchMemoDeletedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.deleted")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, nil, "memos.memo.deleted")
 }
 
-func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *v1pb.Memo, activityType string) error {
+func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo, previousMemo *v1pb.Memo, activityType string) error {
 	creatorID, err := ExtractUserIDFromName(memo.Creator)
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, "invalid memo creator")
@@ -819,18 +1087,44 @@ func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *v1p
 	if err != nil {
 		return err
 	}
+	payload, err := convertMemoToWebhookPayload(memo)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo to webhook payload")
+	}
+	payload.ActivityType = activityType
+	if previousMemo != nil {
+		payload.PreviousMemo = previousMemo
+		payload.ChangedFields = getMemoChangedFields(previousMemo, memo)
+	}
+	s.postUserWebhooks(ctx, creatorID, webhooks, payload)
+	return nil
+}
+
+// postUserWebhooks posts the payload to each of the webhooks of the user subscribed to its activity type.
+func (s *APIV1Service) postUserWebhooks(ctx context.Context, userID int32, webhooks []*storepb.WebhooksUserSetting_Webhook, payload *webhook.WebhookRequestPayload) {
 	for _, hook := range webhooks {
-		payload, err := convertMemoToWebhookPayload(memo)
-		if err != nil {
-			return errors.Wrap(err, "failed to convert memo to webhook payload")
+		if !isUserWebhookSubscribed(hook, payload.ActivityType) {
+			continue
 		}
-		payload.ActivityType = activityType
-		payload.URL = hook.Url
+		hookPayload := *payload
+		hookPayload.URL = hook.Url
+		hookPayload.Secret = hook.Secret
 
-		// Use asynchronous webhook dispatch
-		webhook.PostAsync(payload)
+		// The webhooks are delivered at the end of the maintenance windows, without alerting the admins.
+		if maintenance.IsReadOnly() {
+			s.recordWebhookDeferred(ctx, userID, hook.Id, &hookPayload)
+			continue
+		}
+		// Use asynchronous webhook dispatch, failed webhooks are kept in the dead letter queue.
+		webhookID := hook.Id
+		webhook.PostAsync(&hookPayload, func(statusCode int, err error) {
+			ctx := context.WithoutCancel(ctx)
+			s.recordWebhookDelivery(ctx, userID, webhookID, hookPayload.ActivityType, statusCode, err)
+			if err != nil {
+				s.recordWebhookDeadLetter(ctx, userID, webhookID, &hookPayload, err)
+			}
+		})
 	}
-	return nil
 }
 
 func convertMemoToWebhookPayload(memo *v1pb.Memo) (*webhook.WebhookRequestPayload, error) {
@@ -844,6 +1138,75 @@ func convertMemoToWebhookPayload(memo *v1pb.Memo) (*webhook.WebhookRequestPayloa
 	}, nil
 }
 
+// getMemoChangedFields returns the memo fields that differ between the previous and the current memo.
+func getMemoChangedFields(previousMemo, memo *v1pb.Memo) []string {
+	changedFields := []string{}
+	if previousMemo.Content != memo.Content {
+		changedFields = append(changedFields, "content")
+	}
+	if previousMemo.Visibility != memo.Visibility {
+		changedFields = append(changedFields, "visibility")
+	}
+	if previousMemo.Pinned != memo.Pinned {
+		changedFields = append(changedFields, "pinned")
+	}
+	if previousMemo.State != memo.State {
+		changedFields = append(changedFields, "state")
+	}
+	if !slices.Equal(previousMemo.Tags, memo.Tags) {
+		changedFields = append(changedFields, "tags")
+	}
+	if !proto.Equal(previousMemo.CreateTime, memo.CreateTime) {
+		changedFields = append(changedFields, "create_time")
+	}
+	if !proto.Equal(previousMemo.DisplayTime, memo.DisplayTime) {
+		changedFields = append(changedFields, "display_time")
+	}
+	if !proto.Equal(previousMemo.Location, memo.Location) {
+		changedFields = append(changedFields, "location")
+	}
+	if previousMemo.ContentWarning != memo.ContentWarning {
+		changedFields = append(changedFields, "content_warning")
+	}
+	if previousMemo.Language != memo.Language {
+		changedFields = append(changedFields, "language")
+	}
+	if previousMemo.Slug != memo.Slug {
+		changedFields = append(changedFields, "slug")
+	}
+	if !proto.Equal(previousMemo.ExpireTime, memo.ExpireTime) {
+		changedFields = append(changedFields, "expire_time")
+	}
+	if previousMemo.ExpiryAction != memo.ExpiryAction {
+		changedFields = append(changedFields, "expiry_action")
+	}
+	previousAttachmentNames, attachmentNames := []string{}, []string{}
+	for _, attachment := range previousMemo.Attachments {
+		previousAttachmentNames = append(previousAttachmentNames, attachment.Name)
+	}
+	for _, attachment := range memo.Attachments {
+		attachmentNames = append(attachmentNames, attachment.Name)
+	}
+	if !slices.Equal(previousAttachmentNames, attachmentNames) {
+		changedFields = append(changedFields, "attachments")
+	}
+	if !slices.EqualFunc(previousMemo.Relations, memo.Relations, func(a, b *v1pb.MemoRelation) bool {
+		return proto.Equal(a, b)
+	}) {
+		changedFields = append(changedFields, "relations")
+	}
+	return changedFields
+}
+
+// getMemoSnippet returns the snippet of the memo, which is its content warning when it has one
+// so that previews do not reveal the content.
+func (s *APIV1Service) getMemoSnippet(memo *store.Memo) (string, error) {
+	if contentWarning := memo.Payload.GetContentWarning(); contentWarning != "" {
+		return contentWarning, nil
+	}
+	return s.getMemoContentSnippet(memo.Content)
+}
+
 func (s *APIV1Service) getMemoContentSnippet(content string) (string, error) {
 	// Use goldmark service for snippet generation
 	snippet, err := s.MarkdownService.GenerateSnippet([]byte(content), 64)
@@ -853,6 +1216,75 @@ func (s *APIV1Service) getMemoContentSnippet(content string) (string, error) {
 	return snippet, nil
 }
 
+// normalizeContentWarning trims the content warning and checks its length.
+func normalizeContentWarning(contentWarning string) (string, error) {
+	contentWarning = strings.TrimSpace(contentWarning)
+	if len(contentWarning) > maxContentWarningLength {
+		return "", status.Errorf(codes.InvalidArgument, "content warning too long (max %d characters)", maxContentWarningLength)
+	}
+	return contentWarning, nil
+}
+
+// normalizeMemoLanguage validates a memo language and returns its canonical BCP 47 form.
+func normalizeMemoLanguage(memoLanguage string) (string, error) {
+	memoLanguage = strings.TrimSpace(memoLanguage)
+	if memoLanguage == "" {
+		return "", nil
+	}
+	tag, err := language.Parse(memoLanguage)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid language %q: %v", memoLanguage, err)
+	}
+	return tag.String(), nil
+}
+
+// getMemoLanguage returns the language set on the memo, or the one detected from its content.
+func getMemoLanguage(memo *store.Memo) string {
+	if memo.Payload == nil {
+		return ""
+	}
+	if memo.Payload.Language != "" {
+		return memo.Payload.Language
+	}
+	return memo.Payload.DetectedLanguage
+}
+
+// getDominantMemoLanguage returns the English name of the language most of the memos are written in,
+// or an empty string if none of their languages is known.
+func getDominantMemoLanguage(memos []*store.Memo) string {
+	counts := make(map[string]int)
+	dominant := ""
+	for _, memo := range memos {
+		memoLanguage := getMemoLanguage(memo)
+		if memoLanguage == "" {
+			continue
+		}
+		counts[memoLanguage]++
+		if counts[memoLanguage] > counts[dominant] {
+			dominant = memoLanguage
+		}
+	}
+	if dominant == "" {
+		return ""
+	}
+	tag, err := language.Parse(dominant)
+	if err != nil {
+		return ""
+	}
+	return display.English.Tags().Name(tag)
+}
+
+// convertMemoExpiryToStore converts the expiry of a memo, which must be in the future.
+func convertMemoExpiryToStore(expireTime time.Time, action v1pb.Memo_ExpiryAction) (*storepb.MemoPayload_Expiry, error) {
+	if !expireTime.After(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+	}
+	return &storepb.MemoPayload_Expiry{
+		ExpireTs: expireTime.Unix(),
+		Action:   convertMemoExpiryActionToStore(action),
+	}, nil
+}
+
 // parseMemoOrderBy parses the order_by field and sets the appropriate ordering in memoFind.
 // Follows AIP-132: supports comma-separated list of fields with optional "desc" suffix.
 // Example: "pinned desc, display_time desc" or "create_time asc".
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
@@ -14,33 +16,238 @@ import (
 )
 
 func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Memo, reactions []*store.Reaction, attachments []*store.Attachment) (*v1pb.Memo, error) {
-	displayTs := memo.CreatedTs
+	attachments, err := s.filterRestrictedAttachments(ctx, attachments)
+	if err != nil {
+		return nil, err
+	}
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace memo related setting")
+	}
+
+	relations := []*v1pb.MemoRelation{}
+	listMemoRelationsResponse, err := s.ListMemoRelations(ctx, &v1pb.ListMemoRelationsRequest{Name: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)})
+	if err != nil {
+		// Memos in cold storage are not in the memo table, so their relations are not listed.
+		if status.Code(err) != codes.NotFound {
+			return nil, errors.Wrap(err, "failed to list memo relations")
+		}
+	} else {
+		relations = listMemoRelationsResponse.Relations
+	}
+
+	return s.buildMemoMessage(memo, reactions, attachments, relations, workspaceMemoRelatedSetting)
+}
+
+// convertMemosFromStore converts a page of memos, prefetching the reactions, attachments and relations of all the
+// memos in a handful of queries instead of looking them up memo by memo. The memos are returned in the given order.
+func (s *APIV1Service) convertMemosFromStore(ctx context.Context, memos []*store.Memo) ([]*v1pb.Memo, error) {
+	memoMessages := make([]*v1pb.Memo, 0, len(memos))
+	if len(memos) == 0 {
+		return memoMessages, nil
+	}
+
+	contentIDs := make([]string, 0, len(memos))
+	memoIDs := make([]int32, 0, len(memos))
+	for _, memo := range memos {
+		contentIDs = append(contentIDs, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID))
+		memoIDs = append(memoIDs, memo.ID)
+	}
+
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentIDList: contentIDs})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list reactions")
+	}
+	reactionMap := make(map[string][]*store.Reaction)
+	for _, reaction := range reactions {
+		reactionMap[reaction.ContentID] = append(reactionMap[reaction.ContentID], reaction)
+	}
+
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoIDList: memoIDs})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list attachments")
+	}
+	attachments, err = s.filterRestrictedAttachments(ctx, attachments)
+	if err != nil {
+		return nil, err
+	}
+	attachmentMap := make(map[int32][]*store.Attachment)
+	for _, attachment := range attachments {
+		attachmentMap[*attachment.MemoID] = append(attachmentMap[*attachment.MemoID], attachment)
+	}
+
+	relationMap, err := s.listMemoRelationsByMemoID(ctx, memos)
+	if err != nil {
+		return nil, err
+	}
+
 	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get workspace memo related setting")
 	}
+
+	for _, memo := range memos {
+		relations := relationMap[memo.ID]
+		if relations == nil {
+			relations = []*v1pb.MemoRelation{}
+		}
+		memoMessage, err := s.buildMemoMessage(memo, reactionMap[fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)], attachmentMap[memo.ID], relations, workspaceMemoRelatedSetting)
+		if err != nil {
+			return nil, err
+		}
+		memoMessages = append(memoMessages, memoMessage)
+	}
+	return memoMessages, nil
+}
+
+// listMemoRelationsByMemoID lists the relations visible to the current user of each of the memos, the outgoing
+// relations first, as ListMemoRelations does. The memos at both ends of the relations are fetched in one query.
+func (s *APIV1Service) listMemoRelationsByMemoID(ctx context.Context, memos []*store.Memo) (map[int32][]*v1pb.MemoRelation, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get current user")
+	}
+	memoFilter, err := s.getVisibleMemoFilter(ctx, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	memoIDs := make([]int32, 0, len(memos))
+	memoMap := make(map[int32]*store.Memo, len(memos))
+	for _, memo := range memos {
+		memoIDs = append(memoIDs, memo.ID)
+		memoMap[memo.ID] = memo
+	}
+
+	outgoing, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoIDList: memoIDs, MemoFilter: &memoFilter})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo relations")
+	}
+	incoming, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoIDList: memoIDs, MemoFilter: &memoFilter})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo relations")
+	}
+
+	missingIDs := []int32{}
+	for _, relation := range append(append([]*store.MemoRelation{}, outgoing...), incoming...) {
+		for _, id := range []int32{relation.MemoID, relation.RelatedMemoID} {
+			if _, ok := memoMap[id]; !ok {
+				memoMap[id] = nil
+				missingIDs = append(missingIDs, id)
+			}
+		}
+	}
+	if len(missingIDs) > 0 {
+		relatedMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: missingIDs})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list related memos")
+		}
+		for _, memo := range relatedMemos {
+			memoMap[memo.ID] = memo
+		}
+	}
+
+	snippets := make(map[int32]string, len(memoMap))
+	relationMemo := func(id int32) (*v1pb.MemoRelation_Memo, error) {
+		memo := memoMap[id]
+		if memo == nil {
+			return nil, nil
+		}
+		snippet, ok := snippets[id]
+		if !ok {
+			snippet, err = s.getMemoSnippet(memo)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get memo content snippet")
+			}
+			snippets[id] = snippet
+		}
+		return &v1pb.MemoRelation_Memo{
+			Name:    fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			Snippet: snippet,
+		}, nil
+	}
+	relationMap := make(map[int32][]*v1pb.MemoRelation, len(memos))
+	appendRelations := func(relations []*store.MemoRelation, incoming bool) error {
+		for _, relation := range relations {
+			memo, err := relationMemo(relation.MemoID)
+			if err != nil {
+				return err
+			}
+			relatedMemo, err := relationMemo(relation.RelatedMemoID)
+			if err != nil {
+				return err
+			}
+			if memo == nil || relatedMemo == nil {
+				continue
+			}
+			key := relation.MemoID
+			if incoming {
+				key = relation.RelatedMemoID
+			}
+			relationMap[key] = append(relationMap[key], &v1pb.MemoRelation{
+				Memo:        memo,
+				RelatedMemo: relatedMemo,
+				Type:        convertMemoRelationTypeFromStore(relation.Type),
+			})
+		}
+		return nil
+	}
+	if err := appendRelations(outgoing, false); err != nil {
+		return nil, err
+	}
+	if err := appendRelations(incoming, true); err != nil {
+		return nil, err
+	}
+	return relationMap, nil
+}
+
+// buildMemoMessage assembles the API memo from the store memo and its already fetched related records.
+func (s *APIV1Service) buildMemoMessage(memo *store.Memo, reactions []*store.Reaction, attachments []*store.Attachment, relations []*v1pb.MemoRelation, workspaceMemoRelatedSetting *storepb.WorkspaceMemoRelatedSetting) (*v1pb.Memo, error) {
+	displayTs := memo.CreatedTs
 	if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
 		displayTs = memo.UpdatedTs
 	}
 
 	name := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
 	memoMessage := &v1pb.Memo{
-		Name:        name,
-		State:       convertStateFromStore(memo.RowStatus),
-		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, memo.CreatorID),
-		CreateTime:  timestamppb.New(time.Unix(memo.CreatedTs, 0)),
-		UpdateTime:  timestamppb.New(time.Unix(memo.UpdatedTs, 0)),
-		DisplayTime: timestamppb.New(time.Unix(displayTs, 0)),
-		Content:     memo.Content,
-		Visibility:  convertVisibilityFromStore(memo.Visibility),
-		Pinned:      memo.Pinned,
+		Name:          name,
+		State:         convertStateFromStore(memo.RowStatus),
+		Creator:       fmt.Sprintf("%s%d", UserNamePrefix, memo.CreatorID),
+		CreateTime:    timestamppb.New(time.Unix(memo.CreatedTs, 0)),
+		UpdateTime:    timestamppb.New(time.Unix(memo.UpdatedTs, 0)),
+		DisplayTime:   timestamppb.New(time.Unix(displayTs, 0)),
+		Content:       memo.Content,
+		Visibility:    convertVisibilityFromStore(memo.Visibility),
+		Pinned:        memo.Pinned,
+		CommentCount:  memo.CommentCount,
+		ReactionCount: memo.ReactionCount,
+		RelationCount: memo.RelationCount,
 	}
 	if memo.Payload != nil {
 		memoMessage.Tags = memo.Payload.Tags
 		memoMessage.Property = convertMemoPropertyFromStore(memo.Payload.Property)
+		if memoMessage.Property != nil {
+			memoMessage.Property.BrokenLinks = convertBrokenLinksFromStore(memo.Payload.BrokenLinks)
+			memoMessage.Property.LinkSnapshots = convertLinkSnapshotsFromStore(memo.Payload.LinkSnapshots)
+			memoMessage.Property.TimeEntries = convertTimeEntriesFromStore(memo.Payload.TimeEntries)
+			memoMessage.Property.Amounts = convertAmountsFromStore(memo.Payload.Amounts)
+		}
 		memoMessage.Location = convertLocationFromStore(memo.Payload.Location)
+		memoMessage.ContentWarning = memo.Payload.ContentWarning
+		memoMessage.Language = memo.Payload.Language
+		memoMessage.Slug = memo.Payload.Slug
+		memoMessage.DetectedLanguage = memo.Payload.DetectedLanguage
+		memoMessage.AiSummaryRefinements = convertAISummaryRefinementsFromStore(memo.Payload.AiSummaryRefinements)
+		memoMessage.Syndications = convertSyndicationsFromStore(memo.Payload.Syndications)
+		memoMessage.Reading = convertReadingFromStore(memo.Payload.Reading)
+		memoMessage.Approval = convertMemoApprovalFromStore(memo.Payload.Approval)
+		if expiry := memo.Payload.Expiry; expiry != nil {
+			memoMessage.ExpireTime = timestamppb.New(time.Unix(expiry.ExpireTs, 0))
+			memoMessage.ExpiryAction = convertMemoExpiryActionFromStore(expiry.Action)
+		}
 	}
 
+	memoMessage.Contributors = convertMemoContributorsFromStore(memo)
+
 	if memo.ParentUID != nil {
 		parentName := fmt.Sprintf("%s%s", MemoNamePrefix, *memo.ParentUID)
 		memoMessage.Parent = &parentName
@@ -53,11 +260,7 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		memoMessage.Reactions = append(memoMessage.Reactions, reactionResponse)
 	}
 
-	listMemoRelationsResponse, err := s.ListMemoRelations(ctx, &v1pb.ListMemoRelationsRequest{Name: name})
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to list memo relations")
-	}
-	memoMessage.Relations = listMemoRelationsResponse.Relations
+	memoMessage.Relations = relations
 
 	memoMessage.Attachments = []*v1pb.Attachment{}
 
@@ -66,7 +269,7 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		memoMessage.Attachments = append(memoMessage.Attachments, attachmentResponse)
 	}
 
-	snippet, err := s.getMemoContentSnippet(memo.Content)
+	snippet, err := s.getMemoSnippet(memo)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get memo content snippet")
 	}
@@ -84,7 +287,91 @@ func convertMemoPropertyFromStore(property *storepb.MemoPayload_Property) *v1pb.
 		HasTaskList:        property.HasTaskList,
 		HasCode:            property.HasCode,
 		HasIncompleteTasks: property.HasIncompleteTasks,
+		IsAiGenerated:      property.IsAiGenerated,
+	}
+}
+
+func convertBrokenLinksFromStore(brokenLinks []*storepb.MemoPayload_BrokenLink) []*v1pb.Memo_BrokenLink {
+	result := make([]*v1pb.Memo_BrokenLink, 0, len(brokenLinks))
+	for _, brokenLink := range brokenLinks {
+		result = append(result, &v1pb.Memo_BrokenLink{
+			Url:        brokenLink.Url,
+			StatusCode: brokenLink.StatusCode,
+			CheckTime:  timestamppb.New(time.Unix(brokenLink.CheckedTs, 0)),
+		})
+	}
+	return result
+}
+
+func convertLinkSnapshotsFromStore(linkSnapshots []*storepb.MemoPayload_LinkSnapshot) []*v1pb.Memo_LinkSnapshot {
+	result := make([]*v1pb.Memo_LinkSnapshot, 0, len(linkSnapshots))
+	for _, linkSnapshot := range linkSnapshots {
+		result = append(result, &v1pb.Memo_LinkSnapshot{
+			Url:         linkSnapshot.Url,
+			SnapshotUrl: linkSnapshot.SnapshotUrl,
+			CreateTime:  timestamppb.New(time.Unix(linkSnapshot.CreatedTs, 0)),
+		})
 	}
+	return result
+}
+
+func convertTimeEntriesFromStore(timeEntries []*storepb.MemoPayload_TimeEntry) []*v1pb.Memo_TimeEntry {
+	result := make([]*v1pb.Memo_TimeEntry, 0, len(timeEntries))
+	for _, timeEntry := range timeEntries {
+		result = append(result, &v1pb.Memo_TimeEntry{
+			Minutes: timeEntry.Minutes,
+			Tags:    timeEntry.Tags,
+		})
+	}
+	return result
+}
+
+func convertAmountsFromStore(amounts []*storepb.MemoPayload_Amount) []*v1pb.Memo_Amount {
+	result := make([]*v1pb.Memo_Amount, 0, len(amounts))
+	for _, amount := range amounts {
+		result = append(result, &v1pb.Memo_Amount{
+			Value:    amount.Value,
+			Currency: amount.Currency,
+			Tags:     amount.Tags,
+		})
+	}
+	return result
+}
+
+func convertReadingFromStore(reading *storepb.MemoPayload_Reading) *v1pb.Memo_Reading {
+	if reading == nil {
+		return nil
+	}
+	return &v1pb.Memo_Reading{
+		Author:       reading.Author,
+		Status:       reading.Status,
+		Rating:       reading.Rating,
+		FinishedDate: reading.FinishedDate,
+	}
+}
+
+func convertSyndicationsFromStore(syndications []*storepb.MemoPayload_Syndication) []*v1pb.Memo_Syndication {
+	result := make([]*v1pb.Memo_Syndication, 0, len(syndications))
+	for _, syndication := range syndications {
+		result = append(result, &v1pb.Memo_Syndication{
+			Platform:   syndication.Platform,
+			Url:        syndication.Url,
+			CreateTime: timestamppb.New(time.Unix(syndication.CreatedTs, 0)),
+		})
+	}
+	return result
+}
+
+func convertAISummaryRefinementsFromStore(refinements []*storepb.MemoPayload_AISummaryRefinement) []*v1pb.Memo_AISummaryRefinement {
+	result := make([]*v1pb.Memo_AISummaryRefinement, 0, len(refinements))
+	for _, refinement := range refinements {
+		result = append(result, &v1pb.Memo_AISummaryRefinement{
+			Instruction:     refinement.Instruction,
+			PreviousSummary: refinement.PreviousSummary,
+			CreateTime:      timestamppb.New(time.Unix(refinement.CreatedTs, 0)),
+		})
+	}
+	return result
 }
 
 func convertLocationFromStore(location *storepb.MemoPayload_Location) *v1pb.Location {
@@ -132,3 +419,21 @@ func convertVisibilityToStore(visibility v1pb.Visibility) store.Visibility {
 		return store.Private
 	}
 }
+
+func convertMemoExpiryActionFromStore(action storepb.MemoPayload_ExpiryAction) v1pb.Memo_ExpiryAction {
+	switch action {
+	case storepb.MemoPayload_DELETE:
+		return v1pb.Memo_DELETE
+	default:
+		return v1pb.Memo_ARCHIVE
+	}
+}
+
+func convertMemoExpiryActionToStore(action v1pb.Memo_ExpiryAction) storepb.MemoPayload_ExpiryAction {
+	switch action {
+	case v1pb.Memo_DELETE:
+		return storepb.MemoPayload_DELETE
+	default:
+		return storepb.MemoPayload_ARCHIVE
+	}
+}
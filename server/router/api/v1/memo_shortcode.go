@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/markdown"
+)
+
+const (
+	// maxShortcodes is the maximum number of workspace shortcodes.
+	maxShortcodes = 500
+	// maxShortcodeTextLength is the maximum length of the text of a workspace shortcode in bytes.
+	maxShortcodeTextLength = 256
+)
+
+// getShortcodes returns the texts of the shortcodes expanded on save by their name, nil if the expansion is disabled.
+func (s *APIV1Service) getShortcodes(ctx context.Context) (map[string]string, error) {
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting: %v", err)
+	}
+	if !workspaceMemoRelatedSetting.ExpandShortcodes {
+		return nil, nil
+	}
+	shortcodes := maps.Clone(markdown.BuiltinShortcodes)
+	maps.Copy(shortcodes, workspaceMemoRelatedSetting.Shortcodes)
+	return shortcodes, nil
+}
+
+// validateShortcodes checks the names and texts of the workspace shortcodes.
+func validateShortcodes(shortcodes map[string]string) error {
+	if len(shortcodes) > maxShortcodes {
+		return errors.Errorf("at most %d shortcodes are allowed", maxShortcodes)
+	}
+	for name, text := range shortcodes {
+		if !markdown.ShortcodeNamePattern.MatchString(name) {
+			return errors.Errorf("invalid shortcode name %q, only lowercase letters, digits, \"_\", \"+\" and \"-\" are allowed", name)
+		}
+		if text == "" || strings.ContainsAny(text, "\r\n") {
+			return errors.Errorf("the text of shortcode %q must be a single non-empty line", name)
+		}
+		if len(text) > maxShortcodeTextLength {
+			return errors.Errorf("the text of shortcode %q must be at most %d bytes", name, maxShortcodeTextLength)
+		}
+	}
+	return nil
+}
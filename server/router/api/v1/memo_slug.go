@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// memoSlugPattern is the pattern of the memo slugs, which appear in URLs: lowercase words separated by hyphens.
+var memoSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// maxMemoSlugLength is the maximum length of a memo slug.
+const maxMemoSlugLength = 100
+
+// GetMemoBySlug gets a memo of a user by its slug, or by a slug it was renamed from.
+func (s *APIV1Service) GetMemoBySlug(ctx context.Context, request *v1pb.GetMemoBySlugRequest) (*v1pb.Memo, error) {
+	identifier := extractUserIdentifierFromName(request.Parent)
+	if identifier == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %s", request.Parent)
+	}
+	var creator *store.User
+	var err error
+	if userID, parseErr := strconv.ParseInt(identifier, 10, 32); parseErr == nil {
+		userID32 := int32(userID)
+		creator, err = s.Store.GetUser(ctx, &store.FindUser{ID: &userID32})
+	} else {
+		creator, err = s.Store.GetUserByUsername(ctx, identifier)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if creator == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	slug := strings.ToLower(strings.TrimSpace(request.Slug))
+	memoSlug, err := s.Store.GetMemoSlug(ctx, &store.FindMemoSlug{CreatorID: &creator.ID, Slug: &slug})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo slug: %v", err)
+	}
+	if memoSlug == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoSlug.MemoID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	return s.GetMemo(ctx, &v1pb.GetMemoRequest{Name: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)})
+}
+
+// validateMemoSlug normalizes the slug of a memo of the creator, 0 for a memo to be created, and checks it is not
+// the current slug of another memo of the creator. The slugs other memos were renamed from can be taken.
+func (s *APIV1Service) validateMemoSlug(ctx context.Context, creatorID int32, memoID int32, slug string) (string, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if len(slug) > maxMemoSlugLength || !memoSlugPattern.MatchString(slug) {
+		return "", status.Errorf(codes.InvalidArgument, "slug must be at most %d lowercase letters and digits separated by hyphens", maxMemoSlugLength)
+	}
+	memoSlug, err := s.Store.GetMemoSlug(ctx, &store.FindMemoSlug{CreatorID: &creatorID, Slug: &slug})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get memo slug: %v", err)
+	}
+	if memoSlug == nil || memoSlug.MemoID == memoID {
+		return slug, nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoSlug.MemoID})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo != nil && memo.Payload.GetSlug() == slug {
+		return "", status.Errorf(codes.AlreadyExists, "slug %q is already used by another memo", slug)
+	}
+	return slug, nil
+}
+
+// recordMemoSlug points the current slug of the memo, if any, to it.
+func (s *APIV1Service) recordMemoSlug(ctx context.Context, memo *store.Memo) error {
+	slug := memo.Payload.GetSlug()
+	if slug == "" {
+		return nil
+	}
+	if _, err := s.Store.UpsertMemoSlug(ctx, &store.MemoSlug{
+		CreatorID: memo.CreatorID,
+		Slug:      slug,
+		MemoID:    memo.ID,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert memo slug: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// memoViewDayLayout is the layout of the days views are counted on.
+	memoViewDayLayout = "2006-01-02"
+	// memoStatsDailyViewDays is the number of days the daily view counts are returned for.
+	memoStatsDailyViewDays = 30
+)
+
+// GetMemoStats returns the view statistics of a memo to its creator.
+func (s *APIV1Service) GetMemoStats(ctx context.Context, request *v1pb.GetMemoStatsRequest) (*v1pb.MemoStats, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	viewCounts, err := s.Store.ListMemoViewCounts(ctx, &store.FindMemoView{MemoID: memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo view counts: %v", err)
+	}
+	memoStats := &v1pb.MemoStats{
+		Name:            fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+		DailyViewCounts: []*v1pb.MemoStats_DailyViewCount{},
+	}
+	firstDay := time.Now().UTC().AddDate(0, 0, -(memoStatsDailyViewDays - 1)).Format(memoViewDayLayout)
+	for _, viewCount := range viewCounts {
+		memoStats.ViewCount += viewCount.Count
+		if viewCount.Day >= firstDay {
+			memoStats.DailyViewCounts = append(memoStats.DailyViewCounts, &v1pb.MemoStats_DailyViewCount{
+				Date:  viewCount.Day,
+				Count: viewCount.Count,
+			})
+		}
+	}
+	return memoStats, nil
+}
+
+// recordMemoView queues a view of a memo by a visitor other than its creator, unless view tracking is disabled. The
+// public memos and the protected ones opened through their link by the other members are counted, the private ones
+// are only seen by their creator. Failures are only logged since they must not prevent reading the memo.
+func (s *APIV1Service) recordMemoView(ctx context.Context, memo *store.Memo) {
+	if s.MemoViewRecorder == nil || memo.Visibility == store.Private {
+		return
+	}
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		slog.Warn("failed to get workspace memo related setting", slog.Any("error", err))
+		return
+	}
+	if workspaceMemoRelatedSetting.DisableViewTracking {
+		return
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		slog.Warn("failed to get current user", slog.Any("error", err))
+		return
+	}
+	if user != nil && user.ID == memo.CreatorID {
+		return
+	}
+
+	day := time.Now().UTC().Format(memoViewDayLayout)
+	clientInfo := s.extractClientInfo(ctx)
+	s.MemoViewRecorder.Add(store.MemoView{
+		MemoID:     memo.ID,
+		Day:        day,
+		ViewerHash: s.getMemoViewerHash(day, clientInfo.IpAddress, clientInfo.UserAgent),
+	})
+}
+
+// getMemoViewerHash identifies a visitor on a day. It is keyed with the workspace secret so that
+// the IP address and user agent cannot be recovered from it, and visitors cannot be followed across days.
+func (s *APIV1Service) getMemoViewerHash(day, ipAddress, userAgent string) string {
+	mac := hmac.New(sha256.New, []byte(s.Secret))
+	mac.Write([]byte(day + "\n" + ipAddress + "\n" + userAgent))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,256 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) GetMemoSubscription(ctx context.Context, request *v1pb.GetMemoSubscriptionRequest) (*v1pb.MemoSubscription, error) {
+	memoUID, err := ExtractMemoUIDFromSubscriptionName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid subscription name: %v", err)
+	}
+	user, memo, err := s.getReadableMemo(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.Store.GetMemoSubscription(ctx, &store.FindMemoSubscription{
+		UserID: &user.ID,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo subscription: %v", err)
+	}
+	return convertMemoSubscriptionFromStore(user, memo, subscription), nil
+}
+
+func (s *APIV1Service) UpdateMemoSubscription(ctx context.Context, request *v1pb.UpdateMemoSubscriptionRequest) (*v1pb.MemoSubscription, error) {
+	if request.Subscription == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "subscription is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	memoUID, err := ExtractMemoUIDFromSubscriptionName(request.Subscription.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid subscription name: %v", err)
+	}
+	user, memo, err := s.getReadableMemo(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &store.MemoSubscription{
+		UserID: user.ID,
+		MemoID: memo.ID,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "subscribed":
+			subscription.Subscribed = request.Subscription.Subscribed
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+
+	subscription, err = s.Store.UpsertMemoSubscription(ctx, subscription)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert memo subscription: %v", err)
+	}
+	return convertMemoSubscriptionFromStore(user, memo, subscription), nil
+}
+
+// ListSubscribedMemos lists the memos of other users visible to the current user whose comments the user subscribed to.
+func (s *APIV1Service) ListSubscribedMemos(ctx context.Context, request *v1pb.ListSubscribedMemosRequest) (*v1pb.ListSubscribedMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	visibilities, err := s.getVisibleMemoVisibilities(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		RowStatus:          &normalStatus,
+		VisibilityList:     visibilities,
+		ExcludeComments:    true,
+		Filters:            []string{fmt.Sprintf("creator_id != %d", user.ID)},
+		SubscribedByUserID: &user.ID,
+	}
+	response, err := s.listMemos(ctx, memoFind, request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.ListSubscribedMemosResponse{
+		Memos:         response.Memos,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// getReadableMemo returns the current user and the memo if the user can read the memo.
+func (s *APIV1Service) getReadableMemo(ctx context.Context, memoUID string) (*store.User, *store.Memo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	canView, err := s.canViewMemo(ctx, user, memo)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !canView {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return user, memo, nil
+}
+
+// subscribeMemoCommenter subscribes a user commenting on a memo of another user to its comments,
+// unless the user already subscribed or unsubscribed.
+func (s *APIV1Service) subscribeMemoCommenter(ctx context.Context, memo *store.Memo, userID int32) error {
+	if memo.CreatorID == userID {
+		return nil
+	}
+	subscription, err := s.Store.GetMemoSubscription(ctx, &store.FindMemoSubscription{
+		UserID: &userID,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if subscription != nil {
+		return nil
+	}
+	_, err = s.Store.UpsertMemoSubscription(ctx, &store.MemoSubscription{
+		UserID:     userID,
+		MemoID:     memo.ID,
+		Subscribed: true,
+	})
+	return err
+}
+
+// listMemoCommentRecipients returns the users to notify of a comment on the memo: the memo creator
+// unless they unsubscribed, and the subscribers allowed to read the memo, except the comment creator.
+func (s *APIV1Service) listMemoCommentRecipients(ctx context.Context, memo *store.Memo, commenterID int32) ([]int32, error) {
+	subscriptions, err := s.Store.ListMemoSubscriptions(ctx, &store.FindMemoSubscription{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recipientIDs := []int32{}
+	creatorSubscribed := true
+	for _, subscription := range subscriptions {
+		if subscription.UserID == memo.CreatorID {
+			creatorSubscribed = subscription.Subscribed
+		}
+	}
+	if creatorSubscribed && memo.CreatorID != commenterID {
+		recipientIDs = append(recipientIDs, memo.CreatorID)
+	}
+	if memo.Visibility == store.Private {
+		return recipientIDs, nil
+	}
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribed || subscription.UserID == memo.CreatorID || subscription.UserID == commenterID {
+			continue
+		}
+		// The subscribers of a protected memo may have lost access to it since, e.g. by a change of role.
+		if memo.Visibility == store.Protected {
+			subscriber, err := s.Store.GetUser(ctx, &store.FindUser{ID: &subscription.UserID})
+			if err != nil {
+				return nil, err
+			}
+			if subscriber == nil {
+				continue
+			}
+			canView, err := s.canViewMemo(ctx, subscriber, memo)
+			if err != nil {
+				return nil, err
+			}
+			if !canView {
+				continue
+			}
+		}
+		recipientIDs = append(recipientIDs, subscription.UserID)
+	}
+	return recipientIDs, nil
+}
+
+// notifyMemoComment records the comment as an activity and notifies each recipient of it.
+func (s *APIV1Service) notifyMemoComment(ctx context.Context, comment *store.Memo, relatedMemo *store.Memo) error {
+	recipientIDs, err := s.listMemoCommentRecipients(ctx, relatedMemo, comment.CreatorID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list memo subscriptions")
+	}
+	if len(recipientIDs) == 0 {
+		return nil
+	}
+
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: comment.CreatorID,
+		Type:      store.ActivityTypeMemoComment,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoComment: &storepb.ActivityMemoCommentPayload{
+				MemoId:        comment.ID,
+				RelatedMemoId: relatedMemo.ID,
+			},
+		},
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to create activity")
+	}
+	for _, recipientID := range recipientIDs {
+		if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+			SenderID:   comment.CreatorID,
+			ReceiverID: recipientID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:       storepb.InboxMessage_MEMO_COMMENT,
+				ActivityId: &activity.ID,
+			},
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to create inbox")
+		}
+	}
+	return nil
+}
+
+// convertMemoSubscriptionFromStore converts the subscription of the user to the memo, the memo
+// creator being subscribed unless they unsubscribed.
+func convertMemoSubscriptionFromStore(user *store.User, memo *store.Memo, subscription *store.MemoSubscription) *v1pb.MemoSubscription {
+	memoSubscription := &v1pb.MemoSubscription{
+		Name:       fmt.Sprintf("%s%s%s", MemoNamePrefix, memo.UID, MemoSubscriptionNameSuffix),
+		Subscribed: memo.CreatorID == user.ID,
+	}
+	if subscription != nil {
+		memoSubscription.Subscribed = subscription.Subscribed
+		memoSubscription.UpdateTime = timestamppb.New(time.Unix(subscription.UpdatedTs, 0))
+	}
+	return memoSubscription
+}
@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/markdown"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+var (
+	// tagTemplateTagPattern matches the tags of the tag templates, the tags of the memo content without "#".
+	tagTemplateTagPattern = regexp.MustCompile(`^[A-Za-z0-9_/-]+$`)
+	// tagTemplatePropertyPattern matches the required properties of the tag templates, the front matter keys.
+	tagTemplatePropertyPattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+)
+
+// validateMemoTagTemplates checks the memo has the required properties of the tag templates of its tags, reporting
+// a field violation of the content for each missing property.
+func (s *APIV1Service) validateMemoTagTemplates(ctx context.Context, memo *store.Memo) error {
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace memo related setting: %v", err)
+	}
+	if len(workspaceMemoRelatedSetting.TagTemplates) == 0 {
+		return nil
+	}
+	properties := markdown.ParseFrontMatter([]byte(memo.Content))
+	violations := []*errdetails.BadRequest_FieldViolation{}
+	for _, tagTemplate := range workspaceMemoRelatedSetting.TagTemplates {
+		if !hasCrossPostTag(memo, tagTemplate.Tag) {
+			continue
+		}
+		for _, property := range tagTemplate.RequiredProperties {
+			if strings.TrimSpace(properties[property]) != "" {
+				continue
+			}
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       "content",
+				Description: fmt.Sprintf("memos tagged #%s require the %q property in their front matter", tagTemplate.Tag, property),
+			})
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	descriptions := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		descriptions = append(descriptions, violation.Description)
+	}
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("missing required properties: %s", strings.Join(descriptions, "; ")))
+	stWithDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return stWithDetails.Err()
+}
+
+// validateTagTemplates checks the tag templates of the memo related setting, normalizing their tags and properties.
+func validateTagTemplates(setting *storepb.WorkspaceMemoRelatedSetting) error {
+	tags := []string{}
+	for _, tagTemplate := range setting.GetTagTemplates() {
+		tagTemplate.Tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tagTemplate.Tag), "#"))
+		if !tagTemplateTagPattern.MatchString(tagTemplate.Tag) {
+			return errors.Errorf("invalid tag template tag %q", tagTemplate.Tag)
+		}
+		if slices.Contains(tags, tagTemplate.Tag) {
+			return errors.Errorf("duplicate tag template tag %q", tagTemplate.Tag)
+		}
+		tags = append(tags, tagTemplate.Tag)
+		for i, property := range tagTemplate.RequiredProperties {
+			property = strings.ToLower(strings.TrimSpace(property))
+			if !tagTemplatePropertyPattern.MatchString(property) {
+				return errors.Errorf("invalid required property %q of tag template %q", property, tagTemplate.Tag)
+			}
+			tagTemplate.RequiredProperties[i] = property
+		}
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// maxTrackingDays is the maximum number of days of a time report or of sums.
+const maxTrackingDays = 366
+
+// trackedMemo is a memo of the current user with its display date.
+type trackedMemo struct {
+	memo *store.Memo
+	// day is the start of the display date of the memo.
+	day time.Time
+}
+
+// trackedMemos are the memos of the current user between dates, with the settings of the user.
+type trackedMemos struct {
+	timezone     string
+	location     *time.Location
+	weekStartDay int
+	memos        []trackedMemo
+}
+
+// listTrackedMemos returns the normal memos of the current user displayed between the dates, inclusive, in the
+// timezone of the request and matching the filter.
+func (s *APIV1Service) listTrackedMemos(ctx context.Context, startDate, endDate, timezone, filter string) (*trackedMemos, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	timezone, location, err := s.getRequestLocation(ctx, timezone)
+	if err != nil {
+		return nil, err
+	}
+	start, err := time.ParseInLocation(time.DateOnly, startDate, location)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start date: %v", err)
+	}
+	end, err := time.ParseInLocation(time.DateOnly, endDate, location)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid end date: %v", err)
+	}
+	end = end.AddDate(0, 0, 1)
+	if !end.After(start) || end.After(start.AddDate(0, 0, maxTrackingDays)) {
+		return nil, status.Errorf(codes.InvalidArgument, "the end date must be after the start date, at most %d days", maxTrackingDays)
+	}
+
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &user.ID, Key: storepb.UserSetting_GENERAL})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		CreatorID:       &user.ID,
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+		ExcludeContent:  true,
+	}
+	if filter != "" {
+		if err := s.validateFilter(ctx, filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		memoFind.Filters = append(memoFind.Filters, filter)
+	}
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting: %v", err)
+	}
+	timeField := "created_ts"
+	if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
+		timeField = "updated_ts"
+	}
+	memoFind.Filters = append(memoFind.Filters, fmt.Sprintf("%s >= %d && %s < %d", timeField, start.Unix(), timeField, end.Unix()))
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	tracked := &trackedMemos{
+		timezone:     timezone,
+		location:     location,
+		weekStartDay: int(generalSetting.GetGeneral().GetWeekStartDay()),
+	}
+	for _, memo := range memos {
+		displayTs := memo.CreatedTs
+		if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
+			displayTs = memo.UpdatedTs
+		}
+		displayTime := time.Unix(displayTs, 0).In(location)
+		tracked.memos = append(tracked.memos, trackedMemo{
+			memo: memo,
+			day:  time.Date(displayTime.Year(), displayTime.Month(), displayTime.Day(), 0, 0, 0, 0, location),
+		})
+	}
+	return tracked, nil
+}
+
+// getWeekStart returns the first day of the week of the day.
+func (t *trackedMemos) getWeekStart(day time.Time) time.Time {
+	return day.AddDate(0, 0, -((int(day.Weekday()) - t.weekStartDay + 7) % 7))
+}
+
+// GetTimeReport returns the time logged in the memos of the current user between the dates, per week and tag, by
+// the display time of the memos in the timezone of the request.
+func (s *APIV1Service) GetTimeReport(ctx context.Context, request *v1pb.GetTimeReportRequest) (*v1pb.TimeReport, error) {
+	tracked, err := s.listTrackedMemos(ctx, request.StartDate, request.EndDate, request.Timezone, request.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &v1pb.TimeReport{Timezone: tracked.timezone}
+	weeks := map[string]*v1pb.TimeReport_Week{}
+	tagMinutes := map[string]map[string]int32{}
+	for _, trackedMemo := range tracked.memos {
+		if len(trackedMemo.memo.Payload.GetTimeEntries()) == 0 {
+			continue
+		}
+		weekStart := tracked.getWeekStart(trackedMemo.day).Format(time.DateOnly)
+		week, ok := weeks[weekStart]
+		if !ok {
+			week = &v1pb.TimeReport_Week{StartDate: weekStart}
+			weeks[weekStart] = week
+			tagMinutes[weekStart] = map[string]int32{}
+		}
+		for _, entry := range trackedMemo.memo.Payload.TimeEntries {
+			week.TotalMinutes += entry.Minutes
+			report.TotalMinutes += entry.Minutes
+			if len(entry.Tags) == 0 {
+				tagMinutes[weekStart][""] += entry.Minutes
+			}
+			for _, tag := range entry.Tags {
+				tagMinutes[weekStart][tag] += entry.Minutes
+			}
+		}
+	}
+
+	for weekStart, week := range weeks {
+		for tag, minutes := range tagMinutes[weekStart] {
+			week.Tags = append(week.Tags, &v1pb.TimeReport_TagTime{
+				Tag:     tag,
+				Minutes: minutes,
+				Hours:   float64(minutes) / 60,
+			})
+		}
+		slices.SortFunc(week.Tags, func(a, b *v1pb.TimeReport_TagTime) int {
+			return cmp.Or(cmp.Compare(b.Minutes, a.Minutes), cmp.Compare(a.Tag, b.Tag))
+		})
+		report.Weeks = append(report.Weeks, week)
+	}
+	slices.SortFunc(report.Weeks, func(a, b *v1pb.TimeReport_Week) int {
+		return cmp.Compare(a.StartDate, b.StartDate)
+	})
+	return report, nil
+}
+
+// SumProperties returns the sums of the amounts of the memos of the current user between the dates, per period,
+// tag and currency, by the display time of the memos in the timezone of the request.
+func (s *APIV1Service) SumProperties(ctx context.Context, request *v1pb.SumPropertiesRequest) (*v1pb.SumPropertiesResponse, error) {
+	tracked, err := s.listTrackedMemos(ctx, request.StartDate, request.EndDate, request.Timezone, request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	var getPeriodStart func(day time.Time) time.Time
+	switch request.Period {
+	case v1pb.SumPropertiesRequest_DAY:
+		getPeriodStart = func(day time.Time) time.Time { return day }
+	case v1pb.SumPropertiesRequest_WEEK:
+		getPeriodStart = tracked.getWeekStart
+	case v1pb.SumPropertiesRequest_MONTH, v1pb.SumPropertiesRequest_PERIOD_UNSPECIFIED:
+		getPeriodStart = func(day time.Time) time.Time { return day.AddDate(0, 0, 1-day.Day()) }
+	case v1pb.SumPropertiesRequest_YEAR:
+		getPeriodStart = func(day time.Time) time.Time { return day.AddDate(0, 0, 1-day.YearDay()) }
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid period: %v", request.Period)
+	}
+
+	totals := map[sumKey]*v1pb.SumPropertiesResponse_Sum{}
+	periods := map[string]map[sumKey]*v1pb.SumPropertiesResponse_Sum{}
+	for _, trackedMemo := range tracked.memos {
+		if len(trackedMemo.memo.Payload.GetAmounts()) == 0 {
+			continue
+		}
+		periodStart := getPeriodStart(trackedMemo.day).Format(time.DateOnly)
+		if periods[periodStart] == nil {
+			periods[periodStart] = map[sumKey]*v1pb.SumPropertiesResponse_Sum{}
+		}
+		for _, amount := range trackedMemo.memo.Payload.Amounts {
+			tags := amount.Tags
+			if len(tags) == 0 {
+				tags = []string{""}
+			}
+			for _, tag := range tags {
+				key := sumKey{tag: tag, currency: amount.Currency}
+				addToSum(periods[periodStart], key, amount.Value)
+				addToSum(totals, key, amount.Value)
+			}
+		}
+	}
+
+	response := &v1pb.SumPropertiesResponse{
+		Timezone: tracked.timezone,
+		Totals:   sortSums(totals),
+	}
+	for periodStart, sums := range periods {
+		response.Periods = append(response.Periods, &v1pb.SumPropertiesResponse_Period{
+			StartDate: periodStart,
+			Sums:      sortSums(sums),
+		})
+	}
+	slices.SortFunc(response.Periods, func(a, b *v1pb.SumPropertiesResponse_Period) int {
+		return cmp.Compare(a.StartDate, b.StartDate)
+	})
+	return response, nil
+}
+
+// sumKey identifies the sum of the amounts of a tag in a currency.
+type sumKey struct {
+	tag      string
+	currency string
+}
+
+func addToSum(sums map[sumKey]*v1pb.SumPropertiesResponse_Sum, key sumKey, value float64) {
+	sum, ok := sums[key]
+	if !ok {
+		sum = &v1pb.SumPropertiesResponse_Sum{Tag: key.tag, Currency: key.currency}
+		sums[key] = sum
+	}
+	sum.Total += value
+	sum.Count++
+}
+
+// sortSums returns the sums rounded to two decimals, by their tag then currency.
+func sortSums(sums map[sumKey]*v1pb.SumPropertiesResponse_Sum) []*v1pb.SumPropertiesResponse_Sum {
+	result := []*v1pb.SumPropertiesResponse_Sum{}
+	for _, sum := range sums {
+		sum.Total = math.Round(sum.Total*100) / 100
+		result = append(result, sum)
+	}
+	slices.SortFunc(result, func(a, b *v1pb.SumPropertiesResponse_Sum) int {
+		return cmp.Or(cmp.Compare(a.Tag, b.Tag), cmp.Compare(a.Currency, b.Currency))
+	})
+	return result
+}
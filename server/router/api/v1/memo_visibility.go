@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// getVisibleMemoVisibilities returns the visibilities of the memos of other users the user can see: the public
+// memos for the visitors, and the protected ones too for the signed-in users of the roles the workspace allows.
+func (s *APIV1Service) getVisibleMemoVisibilities(ctx context.Context, user *store.User) ([]store.Visibility, error) {
+	if user == nil {
+		return []store.Visibility{store.Public}, nil
+	}
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting: %v", err)
+	}
+	roles := workspaceMemoRelatedSetting.ProtectedVisibilityRoles
+	if len(roles) > 0 && !slices.Contains(roles, user.Role.String()) {
+		return []store.Visibility{store.Public}, nil
+	}
+	return []store.Visibility{store.Public, store.Protected}, nil
+}
+
+// getVisibleMemoFilter returns the filter of the memos the user can see: its own memos and the visible memos of
+// other users.
+func (s *APIV1Service) getVisibleMemoFilter(ctx context.Context, user *store.User) (string, error) {
+	visibilities, err := s.getVisibleMemoVisibilities(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	quoted := make([]string, 0, len(visibilities))
+	for _, visibility := range visibilities {
+		quoted = append(quoted, fmt.Sprintf("%q", visibility))
+	}
+	filter := fmt.Sprintf("visibility in [%s]", strings.Join(quoted, ", "))
+	if user != nil {
+		filter = fmt.Sprintf("creator_id == %d || %s", user.ID, filter)
+	}
+	return filter, nil
+}
+
+// canViewMemo reports whether the user, nil for the visitors, can see the memo. The admins reviewing the memos
+// awaiting their approval can see them too.
+func (s *APIV1Service) canViewMemo(ctx context.Context, user *store.User, memo *store.Memo) (bool, error) {
+	if user != nil && memo.CreatorID == user.ID {
+		return true, nil
+	}
+	if user != nil && isSuperUser(user) && isMemoUnderReview(memo) {
+		return true, nil
+	}
+	visibilities, err := s.getVisibleMemoVisibilities(ctx, user)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(visibilities, memo.Visibility), nil
+}
+
+// getMemoScopeRowStatuses returns the states of the memos of the scope, the normal memos when unspecified.
+func getMemoScopeRowStatuses(scope v1pb.MemoScope) ([]store.RowStatus, error) {
+	switch scope {
+	case v1pb.MemoScope_MEMO_SCOPE_UNSPECIFIED, v1pb.MemoScope_MEMO_SCOPE_NORMAL:
+		return []store.RowStatus{store.Normal}, nil
+	case v1pb.MemoScope_MEMO_SCOPE_ARCHIVED:
+		return []store.RowStatus{store.Archived}, nil
+	case v1pb.MemoScope_MEMO_SCOPE_ALL:
+		return []store.RowStatus{store.Normal, store.Archived}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid scope: %v", scope)
+	}
+}
@@ -0,0 +1,199 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/plugin/micropub"
+	"github.com/usememos/memos/plugin/outbound"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// maxMicropubMedia is the maximum number of attachments uploaded with an entry.
+const maxMicropubMedia = 10
+
+// micropubPostMutex serializes the cross-posts, so that a memo is never posted twice.
+var micropubPostMutex sync.Mutex
+
+// updateMicropubSetting updates the fields of the user's Micropub setting in the update mask, verifying the
+// credentials of the endpoint when they change.
+func (s *APIV1Service) updateMicropubSetting(ctx context.Context, userID int32, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
+	existing, err := s.Store.GetUserMicropubSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	micropubSetting := proto.Clone(existing).(*storepb.MicropubUserSetting)
+
+	incoming := request.Setting.GetMicropubSetting()
+	if incoming == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "micropub setting is required")
+	}
+	credentialsChanged := false
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "enabled":
+			micropubSetting.Enabled = incoming.Enabled
+		case "endpoint":
+			endpoint := ""
+			if strings.TrimSpace(incoming.Endpoint) != "" {
+				endpoint, err = micropub.ParseEndpoint(incoming.Endpoint)
+				if err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid endpoint: %v", err)
+				}
+				if err := outbound.ValidateURL(ctx, endpoint); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid endpoint: %v", err)
+				}
+			}
+			micropubSetting.Endpoint = endpoint
+			credentialsChanged = true
+		case "accessToken":
+			micropubSetting.AccessToken = strings.TrimSpace(incoming.AccessToken)
+			credentialsChanged = true
+		case "tag":
+			micropubSetting.Tag = strings.TrimPrefix(strings.TrimSpace(incoming.Tag), "#")
+		default:
+			// Ignore unsupported fields
+		}
+	}
+	verified := micropubSetting.Endpoint != "" && micropubSetting.AccessToken != ""
+	if credentialsChanged {
+		micropubSetting.MediaEndpoint = ""
+		if verified {
+			client, err := micropub.NewClient(micropubSetting.Endpoint, micropubSetting.AccessToken)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid endpoint: %v", err)
+			}
+			config, err := client.GetConfig(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "failed to verify micropub credentials: %v", err)
+			}
+			if config.MediaEndpoint != "" {
+				if err := outbound.ValidateURL(ctx, config.MediaEndpoint); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid media endpoint: %v", err)
+				}
+				micropubSetting.MediaEndpoint = config.MediaEndpoint
+			}
+		}
+	}
+	if micropubSetting.Enabled && !verified {
+		return nil, status.Errorf(codes.FailedPrecondition, "the endpoint and its access token are required")
+	}
+
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_MICROPUB,
+		Value:  &storepb.UserSetting_Micropub{Micropub: micropubSetting},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
+// crossPostMemoToMicropubAsync cross-posts the memo to the Micropub endpoint in the background when its creator
+// enabled it.
+func (s *APIV1Service) crossPostMemoToMicropubAsync(ctx context.Context, memo *store.Memo) {
+	micropubSetting, err := s.Store.GetUserMicropubSetting(ctx, memo.CreatorID)
+	if err != nil {
+		slog.Warn("Failed to get user micropub setting", slog.Any("err", err))
+		return
+	}
+	if !micropubSetting.Enabled {
+		return
+	}
+
+	go func() {
+		if err := s.crossPostMemoToMicropub(context.Background(), memo); err != nil {
+			slog.Warn("Failed to cross-post memo to micropub", slog.Int("memoID", int(memo.ID)), slog.Any("err", err))
+		}
+	}()
+}
+
+// crossPostMemoToMicropub creates the entry of the memo once it is public and has the tag of the setting, and
+// deletes it once it no longer is. The entry of an edited memo is left as is.
+func (s *APIV1Service) crossPostMemoToMicropub(ctx context.Context, lastMemo *store.Memo) error {
+	micropubPostMutex.Lock()
+	defer micropubPostMutex.Unlock()
+
+	micropubSetting, err := s.Store.GetUserMicropubSetting(ctx, lastMemo.CreatorID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user micropub setting")
+	}
+	if !micropubSetting.Enabled || micropubSetting.Endpoint == "" || micropubSetting.AccessToken == "" {
+		return nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &lastMemo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	syndication := findMemoSyndication(lastMemo, crossPostPlatformMicropub)
+	if memo != nil {
+		syndication = findMemoSyndication(memo, crossPostPlatformMicropub)
+	}
+
+	eligible := isCrossPostable(memo, micropubSetting.Tag)
+	if eligible == (syndication != nil) {
+		return nil
+	}
+	client, err := micropub.NewClient(micropubSetting.Endpoint, micropubSetting.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	if !eligible {
+		if err := client.Delete(ctx, syndication.Url); err != nil {
+			return err
+		}
+		return s.setMemoSyndication(ctx, lastMemo.ID, crossPostPlatformMicropub, nil)
+	}
+
+	entry := micropub.NewEntry(memo.Content, memo.Payload.GetTags())
+	if micropubSetting.MediaEndpoint != "" {
+		media, err := s.listCrossPostMedia(ctx, memo, maxMicropubMedia)
+		if err != nil {
+			return err
+		}
+		for _, attachment := range media {
+			// The entry is created without the attachments that cannot be uploaded.
+			blob, err := s.readCrossPostMedia(ctx, attachment)
+			if err != nil {
+				slog.Warn("Failed to read attachment to cross-post", slog.Int("attachmentID", int(attachment.ID)), slog.Any("err", err))
+				continue
+			}
+			mediaURL, err := client.UploadMedia(ctx, micropubSetting.MediaEndpoint, attachment.Filename, blob)
+			if err != nil {
+				slog.Warn("Failed to upload attachment to micropub media endpoint", slog.Int("attachmentID", int(attachment.ID)), slog.Any("err", err))
+				continue
+			}
+			entry.AddMedia(mediaURL, attachment.Type)
+		}
+	}
+	entryURL, err := client.Create(ctx, entry)
+	if err != nil {
+		return err
+	}
+	return s.setMemoSyndication(ctx, memo.ID, crossPostPlatformMicropub, &storepb.MemoPayload_Syndication{
+		Url:       entryURL,
+		RemoteId:  entryURL,
+		CreatedTs: time.Now().Unix(),
+	})
+}
+
+func convertMicropubSettingFromStore(micropubSetting *storepb.MicropubUserSetting) *v1pb.UserSetting_MicropubSetting {
+	// The access token is never returned.
+	return &v1pb.UserSetting_MicropubSetting{
+		Enabled:       micropubSetting.GetEnabled(),
+		Endpoint:      micropubSetting.GetEndpoint(),
+		Tag:           micropubSetting.GetTag(),
+		MediaEndpoint: micropubSetting.GetMediaEndpoint(),
+	}
+}
@@ -0,0 +1,557 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/micropub"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/maintenance"
+	"github.com/usememos/memos/store"
+)
+
+// micropubAccessTokenScope is the scope of the access tokens of the users, which grant every action.
+const micropubAccessTokenScope = "create update delete media"
+
+// micropubMediaProperties are the properties of an entry whose values are media files.
+var micropubMediaProperties = []string{"photo", "video", "audio"}
+
+// RegisterMicropubRoutes registers the Micropub endpoints, see https://www.w3.org/TR/micropub/, and their media
+// endpoints. The memos are the entries of the endpoints, identified by their URL on the instance. The endpoint of the
+// instance accepts the access tokens of the users, the endpoint of a user also accepts the access tokens issued for
+// their IndieAuth identity.
+func (s *APIV1Service) RegisterMicropubRoutes(g *echo.Group) {
+	for _, path := range []string{"/micropub", "/micropub/users/:id"} {
+		g.GET(path, s.handleMicropubQuery)
+		g.POST(path, s.handleMicropubRequest)
+		g.POST(path+"/media", s.handleMicropubMedia)
+	}
+}
+
+// handleMicropubQuery answers the queries of the configuration of the endpoint and of the source of an entry.
+func (s *APIV1Service) handleMicropubQuery(c echo.Context) error {
+	ctx, user, _, err := s.authenticateMicropubRequest(c)
+	if err != nil {
+		return micropubError(c, http.StatusUnauthorized, "unauthorized", err.Error())
+	}
+
+	switch c.QueryParam("q") {
+	case "config":
+		return c.JSON(http.StatusOK, map[string]any{
+			"media-endpoint": s.getMicropubEndpointURL(c) + "/media",
+			"syndicate-to":   []string{},
+			"q":              []string{"config", "source", "syndicate-to"},
+		})
+	case "syndicate-to":
+		return c.JSON(http.StatusOK, map[string]any{"syndicate-to": []string{}})
+	case "source":
+		memo, err := s.findMicropubMemo(ctx, c, user, c.QueryParam("url"))
+		if err != nil {
+			return micropubStatusError(c, err)
+		}
+		properties := map[string][]string{
+			"content":    {memo.Content},
+			"category":   memo.Payload.GetTags(),
+			"visibility": {convertMicropubVisibilityFromStore(memo.Visibility)},
+		}
+		// Only the requested properties are returned, without the type.
+		if names := c.QueryParams()["properties[]"]; len(names) > 0 {
+			requested := map[string][]string{}
+			for _, name := range names {
+				if values, ok := properties[name]; ok {
+					requested[name] = values
+				}
+			}
+			return c.JSON(http.StatusOK, map[string]any{"properties": requested})
+		}
+		return c.JSON(http.StatusOK, map[string]any{
+			"type":       []string{"h-entry"},
+			"properties": properties,
+		})
+	default:
+		return micropubError(c, http.StatusBadRequest, "invalid_request", "unsupported query")
+	}
+}
+
+// handleMicropubRequest creates, updates or deletes an entry.
+func (s *APIV1Service) handleMicropubRequest(c echo.Context) error {
+	ctx, user, scope, err := s.authenticateMicropubRequest(c)
+	if err != nil {
+		return micropubError(c, http.StatusUnauthorized, "unauthorized", err.Error())
+	}
+	if maintenance.IsReadOnly() {
+		return micropubError(c, http.StatusServiceUnavailable, "server_error", "the workspace is read-only during maintenance")
+	}
+	request, err := micropub.ParseRequest(c.Request(), MaxUploadBufferSizeBytes)
+	if err != nil {
+		return micropubError(c, http.StatusBadRequest, "invalid_request", err.Error())
+	}
+	if request.Action == micropub.ActionUndelete {
+		return micropubError(c, http.StatusBadRequest, "invalid_request", "deleted memos cannot be restored")
+	}
+	if request.Action != micropub.ActionCreate && request.Action != micropub.ActionUpdate && request.Action != micropub.ActionDelete {
+		return micropubError(c, http.StatusBadRequest, "invalid_request", "unsupported action")
+	}
+	if !micropub.HasScope(scope, request.Action) {
+		return micropubError(c, http.StatusForbidden, "insufficient_scope", fmt.Sprintf("the %s scope is required", request.Action))
+	}
+
+	switch request.Action {
+	case micropub.ActionUpdate:
+		err = s.updateMicropubEntry(ctx, c, user, request)
+	case micropub.ActionDelete:
+		err = s.deleteMicropubEntry(ctx, c, user, request)
+	default:
+		err = s.createMicropubEntry(ctx, c, user, request)
+	}
+	if err != nil {
+		return micropubStatusError(c, err)
+	}
+	return nil
+}
+
+// handleMicropubMedia uploads the file of the request as an attachment, to be added to an entry by its URL.
+func (s *APIV1Service) handleMicropubMedia(c echo.Context) error {
+	ctx, _, scope, err := s.authenticateMicropubRequest(c)
+	if err != nil {
+		return micropubError(c, http.StatusUnauthorized, "unauthorized", err.Error())
+	}
+	if maintenance.IsReadOnly() {
+		return micropubError(c, http.StatusServiceUnavailable, "server_error", "the workspace is read-only during maintenance")
+	}
+	if !micropub.HasScope(scope, "media") && !micropub.HasScope(scope, micropub.ActionCreate) {
+		return micropubError(c, http.StatusForbidden, "insufficient_scope", "the media scope is required")
+	}
+	file, err := c.FormFile("file")
+	if err != nil {
+		return micropubError(c, http.StatusBadRequest, "invalid_request", "file is required")
+	}
+	attachment, err := s.createMicropubAttachment(ctx, file)
+	if err != nil {
+		return micropubStatusError(c, err)
+	}
+	c.Response().Header().Set(echo.HeaderLocation, fmt.Sprintf("%s/file/%s/%s", s.getRequestBaseURL(c), attachment.Name, url.PathEscape(attachment.Filename)))
+	return c.NoContent(http.StatusCreated)
+}
+
+// authenticateMicropubRequest authenticates the user of the access token of the request, sent in the Authorization
+// header or the access_token field of the form. The token is either an access token of the user, granting every
+// scope, or on the endpoint of the user an access token issued for their IndieAuth identity. It returns the context of
+// the user and the scopes.
+func (s *APIV1Service) authenticateMicropubRequest(c echo.Context) (context.Context, *store.User, string, error) {
+	accessToken, _ := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+	if accessToken == "" {
+		accessToken = c.FormValue("access_token")
+	}
+	if accessToken == "" {
+		return nil, nil, "", errors.New("access token not found")
+	}
+
+	ctx := c.Request().Context()
+	// The endpoint of a user only accepts the tokens of the user.
+	var endpointUser *store.User
+	if rawID := c.Param("id"); rawID != "" {
+		userID, err := strconv.ParseInt(rawID, 10, 32)
+		if err != nil {
+			return nil, nil, "", errors.New("invalid access token")
+		}
+		userID32 := int32(userID)
+		if endpointUser, err = s.Store.GetUser(ctx, &store.FindUser{ID: &userID32}); err != nil || endpointUser == nil {
+			return nil, nil, "", errors.New("invalid access token")
+		}
+	}
+
+	scope := micropubAccessTokenScope
+	var user *store.User
+	var err error
+	if isMemosAccessToken(accessToken) {
+		user, err = NewGRPCAuthInterceptor(s.Store, s.Secret).authenticateByJWT(ctx, accessToken)
+		if err == nil && endpointUser != nil && user.ID != endpointUser.ID {
+			err = errors.New("the access token belongs to another user")
+		}
+	} else if endpointUser != nil {
+		// The access tokens of the instance are never sent to the token endpoints.
+		user = endpointUser
+		scope, err = s.authenticateByIndieAuth(ctx, endpointUser, accessToken)
+	} else {
+		err = errors.New("the access tokens of the IndieAuth identities are only accepted by the endpoints of the users")
+	}
+	if err != nil {
+		slog.Debug("Failed to authenticate micropub request", slog.Any("err", err))
+		return nil, nil, "", errors.New("invalid access token")
+	}
+	return context.WithValue(ctx, userIDContextKey, user.ID), user, scope, nil
+}
+
+// createMicropubEntry creates the memo of the entry and responds with its URL.
+func (s *APIV1Service) createMicropubEntry(ctx context.Context, c echo.Context, user *store.User, request *micropub.Request) error {
+	if request.Type != "" && request.Type != "entry" {
+		return status.Errorf(codes.InvalidArgument, "unsupported type: %s", request.Type)
+	}
+	visibility, err := convertMicropubVisibilityToV1(micropub.First(request.Properties["visibility"]))
+	if err != nil {
+		return err
+	}
+
+	lines := []string{}
+	if name := micropub.First(request.Properties["name"]); name != "" {
+		lines = append(lines, "# "+name)
+	}
+	if content := micropub.First(request.Properties["content"]); content != "" {
+		lines = append(lines, content)
+	}
+	if bookmark := micropub.First(request.Properties["bookmark-of"]); bookmark != "" {
+		lines = append(lines, bookmark)
+	}
+	attachments := []*v1pb.Attachment{}
+	for _, property := range micropubMediaProperties {
+		for _, value := range request.Properties[property] {
+			// The files uploaded to the media endpoint are attached to the memo, the other ones are linked.
+			if attachment := s.findMicropubAttachment(ctx, c, user, value.Value); attachment != nil {
+				attachments = append(attachments, &v1pb.Attachment{Name: fmt.Sprintf("%s%s", AttachmentNamePrefix, attachment.UID)})
+				continue
+			}
+			lines = append(lines, formatMicropubMediaLink(property, value))
+		}
+		for _, file := range request.Files[property] {
+			attachment, err := s.createMicropubAttachment(ctx, file)
+			if err != nil {
+				return err
+			}
+			attachments = append(attachments, &v1pb.Attachment{Name: attachment.Name})
+		}
+	}
+	content := addMicropubCategories(strings.Join(lines, "\n\n"), request.Properties["category"])
+	if content == "" && len(attachments) == 0 {
+		return status.Errorf(codes.InvalidArgument, "content is required")
+	}
+
+	memo, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:     content,
+			Visibility:  visibility,
+			Slug:        micropub.First(request.Properties["mp-slug"]),
+			Attachments: attachments,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	memoUID, err := ExtractMemoUIDFromName(memo.Name)
+	if err != nil {
+		return err
+	}
+	c.Response().Header().Set(echo.HeaderLocation, s.getRequestBaseURL(c)+"/memos/"+memoUID)
+	return c.NoContent(http.StatusCreated)
+}
+
+// updateMicropubEntry replaces, adds and deletes the content, categories, media and visibility of the memo of the
+// entry. The categories of a memo are the tags of its content.
+func (s *APIV1Service) updateMicropubEntry(ctx context.Context, c echo.Context, user *store.User, request *micropub.Request) error {
+	memo, err := s.findMicropubMemo(ctx, c, user, request.URL)
+	if err != nil {
+		return err
+	}
+
+	content := memo.Content
+	visibility := v1pb.Visibility_VISIBILITY_UNSPECIFIED
+	for name, values := range request.Replace {
+		switch name {
+		case "content":
+			content = micropub.First(values)
+		case "category":
+			content = addMicropubCategories(removeMicropubCategories(content, memo.Payload.GetTags()), values)
+		case "visibility":
+			if visibility, err = convertMicropubVisibilityToV1(micropub.First(values)); err != nil {
+				return err
+			}
+		default:
+			// Ignore unsupported properties
+		}
+	}
+	for name, values := range request.Add {
+		switch name {
+		case "category":
+			content = addMicropubCategories(content, values)
+		case "photo", "video", "audio":
+			for _, value := range values {
+				content = strings.TrimSpace(content + "\n\n" + formatMicropubMediaLink(name, value))
+			}
+		default:
+			// Ignore unsupported properties
+		}
+	}
+	for name, values := range request.Delete {
+		if name != "category" {
+			continue
+		}
+		tags := memo.Payload.GetTags()
+		if values != nil {
+			tags = []string{}
+			for _, value := range values {
+				tags = append(tags, value.Value)
+			}
+		}
+		content = removeMicropubCategories(content, tags)
+	}
+
+	update := &v1pb.Memo{
+		Name:       fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+		Content:    content,
+		Visibility: visibility,
+	}
+	paths := []string{}
+	if content != memo.Content {
+		paths = append(paths, "content")
+	}
+	if visibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		paths = append(paths, "visibility")
+	}
+	if len(paths) > 0 {
+		if _, err := s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{Memo: update, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths}}); err != nil {
+			return err
+		}
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deleteMicropubEntry deletes the memo of the entry.
+func (s *APIV1Service) deleteMicropubEntry(ctx context.Context, c echo.Context, user *store.User, request *micropub.Request) error {
+	memo, err := s.findMicropubMemo(ctx, c, user, request.URL)
+	if err != nil {
+		return err
+	}
+	if _, err := s.DeleteMemo(ctx, &v1pb.DeleteMemoRequest{Name: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)}); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// findMicropubMemo returns the memo of the user with the URL on the instance.
+func (s *APIV1Service) findMicropubMemo(ctx context.Context, c echo.Context, user *store.User, entryURL string) (*store.Memo, error) {
+	u, err := url.Parse(entryURL)
+	if err != nil || entryURL == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid url: %s", entryURL)
+	}
+	memoUID, ok := strings.CutPrefix(u.Path, "/memos/")
+	if !ok || memoUID == "" || strings.Contains(memoUID, "/") || !s.isInstanceURL(c, u) {
+		return nil, status.Errorf(codes.InvalidArgument, "the url is not the url of a memo: %s", entryURL)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	// The entries of the users are only theirs, even for the admins.
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+// findMicropubAttachment returns the attachment of the user with the URL on the instance, uploaded to the media
+// endpoint and not yet attached to a memo. It returns nil for any other URL.
+func (s *APIV1Service) findMicropubAttachment(ctx context.Context, c echo.Context, user *store.User, mediaURL string) *store.Attachment {
+	u, err := url.Parse(mediaURL)
+	if err != nil || !s.isInstanceURL(c, u) {
+		return nil
+	}
+	parts := strings.Split(strings.TrimPrefix(u.Path, "/file/"+AttachmentNamePrefix), "/")
+	if !strings.HasPrefix(u.Path, "/file/"+AttachmentNamePrefix) || len(parts) != 2 || parts[0] == "" {
+		return nil
+	}
+	attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{UID: &parts[0], CreatorID: &user.ID})
+	if err != nil {
+		slog.Warn("Failed to get micropub attachment", slog.String("url", mediaURL), slog.Any("err", err))
+		return nil
+	}
+	if attachment == nil || attachment.MemoID != nil {
+		return nil
+	}
+	return attachment
+}
+
+// createMicropubAttachment creates an attachment of the uploaded file.
+func (s *APIV1Service) createMicropubAttachment(ctx context.Context, file *multipart.FileHeader) (*v1pb.Attachment, error) {
+	src, err := file.Open()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to open file: %v", err)
+	}
+	defer src.Close()
+	blob, err := io.ReadAll(src)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to read file: %v", err)
+	}
+	contentType := file.Header.Get(echo.HeaderContentType)
+	if contentType == "" || contentType == echo.MIMEOctetStream {
+		contentType = http.DetectContentType(blob)
+	}
+	filename := file.Filename
+	if filename == "" {
+		filename = "file"
+	}
+	return s.CreateAttachment(ctx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{
+			Filename: filename,
+			Type:     contentType,
+			Content:  blob,
+		},
+	})
+}
+
+// getRequestBaseURL returns the URL of the instance, the one of the request if the instance URL is not set.
+func (s *APIV1Service) getRequestBaseURL(c echo.Context) string {
+	if s.Profile.InstanceURL != "" {
+		return strings.TrimRight(s.Profile.InstanceURL, "/")
+	}
+	return c.Scheme() + "://" + c.Request().Host
+}
+
+// isMemosAccessToken reports whether the token is an access token issued by the instance, valid or not.
+func isMemosAccessToken(accessToken string) bool {
+	claims := &ClaimsMessage{}
+	if _, _, err := jwt.NewParser().ParseUnverified(accessToken, claims); err != nil {
+		return false
+	}
+	return claims.Issuer == Issuer
+}
+
+// getMicropubEndpointURL returns the URL of the Micropub endpoint of the request, the endpoint of the instance or of a user.
+func (s *APIV1Service) getMicropubEndpointURL(c echo.Context) string {
+	if id := c.Param("id"); id != "" {
+		return s.getRequestBaseURL(c) + "/micropub/users/" + id
+	}
+	return s.getRequestBaseURL(c) + "/micropub"
+}
+
+// isInstanceURL reports whether the URL is on the instance.
+func (s *APIV1Service) isInstanceURL(c echo.Context, u *url.URL) bool {
+	base, err := url.Parse(s.getRequestBaseURL(c))
+	return err == nil && strings.EqualFold(u.Host, base.Host)
+}
+
+// formatMicropubMediaLink returns the Markdown of a media URL added to the content of a memo.
+func formatMicropubMediaLink(property string, value micropub.Value) string {
+	if property == "photo" {
+		return fmt.Sprintf("![%s](%s)", value.Alt, value.Value)
+	}
+	return value.Value
+}
+
+// micropubCategoryPattern matches the tag in a content, but not its subtags.
+func micropubCategoryPattern(tag string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)(^|\s)#` + regexp.QuoteMeta(tag) + `(\s|$)`)
+}
+
+// addMicropubCategories appends the categories missing from the tags of the content as tags, on a last line.
+// The whitespace of a category is replaced by dashes, and the categories which are URLs, e.g. of people, are
+// ignored.
+func addMicropubCategories(content string, categories []micropub.Value) string {
+	tags := []string{}
+	for _, category := range categories {
+		tag := strings.Join(strings.Fields(strings.TrimPrefix(category.Value, "#")), "-")
+		if tag == "" || strings.Contains(tag, "://") || micropubCategoryPattern(tag).MatchString(content) {
+			continue
+		}
+		if !micropubCategoryPattern(tag).MatchString(strings.Join(tags, " ")) {
+			tags = append(tags, "#"+tag)
+		}
+	}
+	if len(tags) == 0 {
+		return content
+	}
+	return strings.TrimSpace(content + "\n\n" + strings.Join(tags, " "))
+}
+
+// removeMicropubCategories removes the tags from the content, keeping its line breaks.
+func removeMicropubCategories(content string, tags []string) string {
+	for _, tag := range tags {
+		pattern := micropubCategoryPattern(tag)
+		for pattern.MatchString(content) {
+			content = pattern.ReplaceAllStringFunc(content, func(match string) string {
+				if strings.HasSuffix(match, "\n") {
+					return "\n"
+				}
+				if match[0] == '#' {
+					return ""
+				}
+				return match[:1]
+			})
+		}
+	}
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// convertMicropubVisibilityToV1 returns the visibility of the memo of an entry with the visibility property, public,
+// unlisted or private. The memos without visibility get the default visibility of the user.
+func convertMicropubVisibilityToV1(visibility string) (v1pb.Visibility, error) {
+	switch visibility {
+	case "":
+		return v1pb.Visibility_VISIBILITY_UNSPECIFIED, nil
+	case "public":
+		return v1pb.Visibility_PUBLIC, nil
+	case "unlisted":
+		return v1pb.Visibility_PROTECTED, nil
+	case "private":
+		return v1pb.Visibility_PRIVATE, nil
+	default:
+		return v1pb.Visibility_VISIBILITY_UNSPECIFIED, status.Errorf(codes.InvalidArgument, "unsupported visibility: %s", visibility)
+	}
+}
+
+func convertMicropubVisibilityFromStore(visibility store.Visibility) string {
+	switch visibility {
+	case store.Public:
+		return "public"
+	case store.Protected:
+		return "unlisted"
+	default:
+		return "private"
+	}
+}
+
+// micropubError responds with the error of a Micropub request.
+func micropubError(c echo.Context, code int, err, description string) error {
+	return c.JSON(code, map[string]string{
+		"error":             err,
+		"error_description": description,
+	})
+}
+
+// micropubStatusError responds with the Micropub error of a status error.
+func micropubStatusError(c echo.Context, err error) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition, codes.AlreadyExists:
+		return micropubError(c, http.StatusBadRequest, "invalid_request", st.Message())
+	case codes.PermissionDenied:
+		return micropubError(c, http.StatusForbidden, "forbidden", st.Message())
+	case codes.Unauthenticated:
+		return micropubError(c, http.StatusUnauthorized, "unauthorized", st.Message())
+	case codes.ResourceExhausted:
+		return micropubError(c, http.StatusTooManyRequests, "invalid_request", st.Message())
+	default:
+		return micropubError(c, http.StatusInternalServerError, "server_error", st.Message())
+	}
+}
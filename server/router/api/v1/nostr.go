@@ -0,0 +1,237 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/plugin/nostr"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// maxNostrRelays is the maximum number of relays a user publishes to.
+const maxNostrRelays = 10
+
+// nostrPublishMutex serializes the publications, so that the notes of successive edits of a memo replace each other
+// in order.
+var nostrPublishMutex sync.Mutex
+
+// updateNostrSetting updates the fields of the user's Nostr setting in the update mask.
+func (s *APIV1Service) updateNostrSetting(ctx context.Context, userID int32, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
+	existing, err := s.Store.GetUserNostrSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	nostrSetting := proto.Clone(existing).(*storepb.NostrUserSetting)
+
+	incoming := request.Setting.GetNostrSetting()
+	if incoming == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nostr setting is required")
+	}
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "enabled":
+			nostrSetting.Enabled = incoming.Enabled
+		case "relays":
+			relays := []string{}
+			for _, relay := range incoming.Relays {
+				relay = strings.TrimSpace(relay)
+				if relay == "" || slices.Contains(relays, relay) {
+					continue
+				}
+				if err := nostr.ValidateRelayURL(ctx, relay); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid relay %q: %v", relay, err)
+				}
+				relays = append(relays, relay)
+			}
+			if len(relays) > maxNostrRelays {
+				return nil, status.Errorf(codes.InvalidArgument, "at most %d relays are allowed", maxNostrRelays)
+			}
+			nostrSetting.Relays = relays
+		case "privateKey":
+			privateKey, err := nostr.ParsePrivateKey(incoming.PrivateKey)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid private key: %v", err)
+			}
+			nostrSetting.PrivateKey = hex.EncodeToString(privateKey)
+		default:
+			// Ignore unsupported fields
+		}
+	}
+	if nostrSetting.Enabled && nostrSetting.PrivateKey == "" {
+		privateKey, err := nostr.GenerateKey()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate key: %v", err)
+		}
+		nostrSetting.PrivateKey = hex.EncodeToString(privateKey)
+	}
+
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_NOSTR,
+		Value:  &storepb.UserSetting_Nostr{Nostr: nostrSetting},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
+// publishMemoToNostrAsync publishes the changes of the memo to Nostr in the background when its creator enabled it.
+func (s *APIV1Service) publishMemoToNostrAsync(ctx context.Context, memo *store.Memo) {
+	nostrSetting, err := s.Store.GetUserNostrSetting(ctx, memo.CreatorID)
+	if err != nil {
+		slog.Warn("Failed to get user nostr setting", slog.Any("err", err))
+		return
+	}
+	if !nostrSetting.Enabled {
+		return
+	}
+
+	go func() {
+		if err := s.publishMemoToNostr(context.Background(), memo.CreatorID, memo.ID); err != nil {
+			slog.Warn("Failed to publish memo to nostr", slog.Int("memoID", int(memo.ID)), slog.Any("err", err))
+		}
+	}()
+}
+
+// publishMemoToNostr brings the note of the memo up to date: a public memo is published as a note, which replaces
+// the note of its previous content, and the note of a memo deleted, archived or no longer public is deleted.
+func (s *APIV1Service) publishMemoToNostr(ctx context.Context, creatorID, memoID int32) error {
+	nostrPublishMutex.Lock()
+	defer nostrPublishMutex.Unlock()
+
+	nostrSetting, err := s.Store.GetUserNostrSetting(ctx, creatorID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user nostr setting")
+	}
+	if !nostrSetting.Enabled || len(nostrSetting.Relays) == 0 {
+		return nil
+	}
+	privateKey, err := hex.DecodeString(nostrSetting.PrivateKey)
+	if err != nil {
+		return errors.Wrap(err, "invalid nostr private key")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	publication, err := s.Store.GetNostrPublication(ctx, &store.FindNostrPublication{MemoID: &memoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get nostr publication")
+	}
+
+	now := time.Now().Unix()
+	if memo == nil || memo.Visibility != store.Public || memo.RowStatus != store.Normal || memo.ParentUID != nil {
+		if publication == nil {
+			return nil
+		}
+		if err := publishNostrDeletion(ctx, nostrSetting.Relays, privateKey, publication.EventID, now); err != nil {
+			return err
+		}
+		return s.Store.DeleteNostrPublication(ctx, &store.DeleteNostrPublication{MemoID: memoID})
+	}
+
+	note := &nostr.Event{
+		CreatedAt: now,
+		Kind:      nostr.KindTextNote,
+		Tags:      [][]string{},
+		Content:   memo.Content,
+	}
+	for _, tag := range memo.Payload.GetTags() {
+		note.Tags = append(note.Tags, []string{"t", strings.ToLower(tag)})
+	}
+	contentHash, err := hashNostrNote(note)
+	if err != nil {
+		return err
+	}
+	if publication != nil && publication.ContentHash == contentHash {
+		return nil
+	}
+	if err := note.Sign(privateKey); err != nil {
+		return err
+	}
+	if err := publishNostrEvent(ctx, nostrSetting.Relays, note); err != nil {
+		return err
+	}
+	if publication != nil {
+		// The note of the previous content is replaced by the new one.
+		if err := publishNostrDeletion(ctx, nostrSetting.Relays, privateKey, publication.EventID, now); err != nil {
+			slog.Warn("Failed to delete replaced nostr note", slog.Int("memoID", int(memo.ID)), slog.Any("err", err))
+		}
+	}
+	return s.Store.UpsertNostrPublication(ctx, &store.NostrPublication{
+		MemoID:      memo.ID,
+		CreatorID:   memo.CreatorID,
+		EventID:     note.ID,
+		ContentHash: contentHash,
+		UpdatedTs:   now,
+	})
+}
+
+// publishNostrDeletion publishes the deletion request of the event.
+func publishNostrDeletion(ctx context.Context, relays []string, privateKey []byte, eventID string, createdAt int64) error {
+	deletion := &nostr.Event{
+		CreatedAt: createdAt,
+		Kind:      nostr.KindDeletion,
+		Tags:      [][]string{{"e", eventID}},
+	}
+	if err := deletion.Sign(privateKey); err != nil {
+		return err
+	}
+	return publishNostrEvent(ctx, relays, deletion)
+}
+
+// publishNostrEvent publishes the event to the relays, it fails only when no relay accepted it.
+func publishNostrEvent(ctx context.Context, relays []string, event *nostr.Event) error {
+	var publishErr error
+	published := false
+	for _, relay := range relays {
+		if err := nostr.Publish(ctx, relay, event); err != nil {
+			slog.Warn("Failed to publish nostr event", slog.String("relay", relay), slog.Any("err", err))
+			publishErr = err
+			continue
+		}
+		published = true
+	}
+	if !published {
+		return errors.Wrap(publishErr, "failed to publish nostr event to any relay")
+	}
+	return nil
+}
+
+// hashNostrNote returns the hash of the content and the tags of the note.
+func hashNostrNote(note *nostr.Event) (string, error) {
+	data, err := json.Marshal([]any{note.Content, note.Tags})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal nostr note")
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func convertNostrSettingFromStore(nostrSetting *storepb.NostrUserSetting) *v1pb.UserSetting_NostrSetting {
+	setting := &v1pb.UserSetting_NostrSetting{
+		Enabled: nostrSetting.GetEnabled(),
+		Relays:  append([]string{}, nostrSetting.GetRelays()...),
+	}
+	// The private key is never returned.
+	if privateKey, err := hex.DecodeString(nostrSetting.GetPrivateKey()); err == nil && len(privateKey) == nostr.KeySize {
+		if publicKey, err := nostr.PublicKey(privateKey); err == nil {
+			setting.PublicKey = nostr.EncodeKey(nostr.PublicKeyPrefix, publicKey)
+		}
+	}
+	return setting
+}
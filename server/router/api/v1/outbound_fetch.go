@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/outbound"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// LoadOutboundPolicy applies the outbound fetch setting of the workspace to the requests sent by the server.
+func (s *APIV1Service) LoadOutboundPolicy(ctx context.Context) error {
+	outboundFetchSetting, err := s.Store.GetWorkspaceOutboundFetchSetting(ctx)
+	if err != nil {
+		return err
+	}
+	outbound.SetPolicy(convertOutboundPolicyFromStore(outboundFetchSetting))
+	return nil
+}
+
+func convertOutboundPolicyFromStore(setting *storepb.WorkspaceOutboundFetchSetting) *outbound.Policy {
+	policy := &outbound.Policy{
+		AllowedHosts:    setting.GetAllowedHosts(),
+		MaxResponseSize: setting.GetMaxResponseBytes(),
+		Timeout:         time.Duration(setting.GetTimeoutSeconds()) * time.Second,
+	}
+	for _, port := range setting.GetAllowedPorts() {
+		policy.AllowedPorts = append(policy.AllowedPorts, int(port))
+	}
+	return policy
+}
+
+func validateWorkspaceOutboundFetchSetting(setting *storepb.WorkspaceOutboundFetchSetting) error {
+	if setting == nil {
+		return errors.New("outbound fetch setting is required")
+	}
+	if err := outbound.ValidateHosts(setting.AllowedHosts); err != nil {
+		return err
+	}
+	for _, port := range setting.AllowedPorts {
+		if port < 1 || port > 65535 {
+			return errors.Errorf("invalid port %d", port)
+		}
+	}
+	if setting.MaxResponseBytes < 0 {
+		return errors.New("max response bytes must not be negative")
+	}
+	if setting.TimeoutSeconds < 0 {
+		return errors.New("timeout seconds must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// personTagPrefix is the prefix of the person tags, e.g. "#person/alice".
+	personTagPrefix = "person/"
+	// maxPersonMemos is the maximum number of memos a person page aggregates.
+	maxPersonMemos = 1000
+	// maxPersonTimeline is the maximum number of interactions of the timeline of a person page.
+	maxPersonTimeline = 20
+	// maxPersonCoOccurringTags is the maximum number of co-occurring tags of a person page.
+	maxPersonCoOccurringTags = 20
+)
+
+// personPattern matches the people of the person tags, a single segment of tag characters.
+var personPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// GetPersonPage returns the page of the person from the normal memos of the current user with the person tag, the
+// aggregates over the newest maxPersonMemos of them.
+func (s *APIV1Service) GetPersonPage(ctx context.Context, request *v1pb.GetPersonPageRequest) (*v1pb.PersonPage, error) {
+	person, err := ExtractPersonFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid person name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting: %v", err)
+	}
+
+	tag := personTagPrefix + person
+	normalStatus := store.Normal
+	newPersonMemoFind := func() *store.FindMemo {
+		return &store.FindMemo{
+			CreatorID:        &user.ID,
+			RowStatus:        &normalStatus,
+			ExcludeComments:  true,
+			Filters:          []string{fmt.Sprintf("tag in [%q]", tag)},
+			OrderByUpdatedTs: workspaceMemoRelatedSetting.DisplayWithUpdateTime,
+		}
+	}
+	memoFind := newPersonMemoFind()
+	limit := maxPersonMemos
+	memoFind.Limit = &limit
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	listMemosResponse, err := s.listMemos(ctx, newPersonMemoFind(), request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &v1pb.PersonPage{
+		Name:          PersonNamePrefix + person,
+		Tag:           tag,
+		MemoCount:     int32(len(memos)),
+		Memos:         listMemosResponse.Memos,
+		NextPageToken: listMemosResponse.NextPageToken,
+		Timeline:      []*v1pb.PersonPage_Interaction{},
+	}
+	tagCounts := map[string]int{}
+	for index, memo := range memos {
+		displayTs := memo.CreatedTs
+		if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
+			displayTs = memo.UpdatedTs
+		}
+		displayTime := timestamppb.New(time.Unix(displayTs, 0))
+		// The memos are newest first.
+		if index == 0 {
+			page.LastMentionTime = displayTime
+		}
+		page.FirstMentionTime = displayTime
+
+		people := []string{}
+		for _, memoTag := range memo.Payload.GetTags() {
+			if memoTag == tag {
+				continue
+			}
+			tagCounts[memoTag]++
+			if other, ok := strings.CutPrefix(memoTag, personTagPrefix); ok && personPattern.MatchString(other) {
+				people = append(people, PersonNamePrefix+other)
+			}
+		}
+		if len(page.Timeline) < maxPersonTimeline {
+			snippet, err := s.getMemoSnippet(memo)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get memo snippet: %v", err)
+			}
+			page.Timeline = append(page.Timeline, &v1pb.PersonPage_Interaction{
+				Memo:    fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+				Time:    displayTime,
+				Snippet: snippet,
+				People:  people,
+			})
+		}
+	}
+
+	for memoTag, count := range tagCounts {
+		page.CoOccurringTags = append(page.CoOccurringTags, &v1pb.PersonPage_TagCount{Tag: memoTag, Count: int32(count)})
+	}
+	slices.SortFunc(page.CoOccurringTags, func(a, b *v1pb.PersonPage_TagCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Tag, b.Tag))
+	})
+	if len(page.CoOccurringTags) > maxPersonCoOccurringTags {
+		page.CoOccurringTags = page.CoOccurringTags[:maxPersonCoOccurringTags]
+	}
+	return page, nil
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// The headers of the HTTP responses of the requests failed on an exhausted quota.
+const (
+	rateLimitLimitHeader     = "RateLimit-Limit"
+	rateLimitRemainingHeader = "RateLimit-Remaining"
+	rateLimitResetHeader     = "RateLimit-Reset"
+	retryAfterHeader         = "Retry-After"
+)
+
+// quotaViolation is a quota a request exceeded.
+type quotaViolation struct {
+	// subject is what the quota applies to, e.g. "users/1" or "workspace".
+	subject string
+	// id identifies the quota, e.g. "ai_requests_per_hour".
+	id    string
+	limit int64
+	// resetTime is the time the quota is available again, zero if it only frees up when the usage decreases.
+	resetTime time.Time
+}
+
+// quotaExceededError returns a ResourceExhausted error with the details the clients back off with, instead of
+// parsing the message: a QuotaFailure naming the exceeded quota and, if it resets, a RetryInfo with the delay.
+func quotaExceededError(violation quotaViolation, format string, args ...any) error {
+	st := status.Newf(codes.ResourceExhausted, format, args...)
+	details := []protoadapt.MessageV1{&errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     violation.subject,
+			Description: st.Message(),
+			QuotaId:     violation.id,
+			QuotaValue:  violation.limit,
+		}},
+	}}
+	if !violation.resetTime.IsZero() {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(retryDelay(violation.resetTime))})
+	}
+	return withStatusDetails(st, details...)
+}
+
+// retryAfterError returns an error of the code with a RetryInfo detail of the delay until the retry time.
+func retryAfterError(code codes.Code, retryTime time.Time, format string, args ...any) error {
+	return withStatusDetails(status.Newf(code, format, args...), &errdetails.RetryInfo{RetryDelay: durationpb.New(retryDelay(retryTime))})
+}
+
+// withStatusDetails returns the error of the status with the details, or without them if they cannot be attached.
+func withStatusDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	stWithDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return stWithDetails.Err()
+}
+
+// retryDelay returns the delay until the time in whole seconds, at least one.
+func retryDelay(retryTime time.Time) time.Duration {
+	return max(time.Duration(math.Ceil(time.Until(retryTime).Seconds()))*time.Second, time.Second)
+}
+
+// statusDetails returns the details of the status of the error, to keep them on the error wrapping it.
+func statusDetails(err error) []protoadapt.MessageV1 {
+	details := []protoadapt.MessageV1{}
+	for _, detail := range status.Convert(err).Details() {
+		if message, ok := detail.(protoadapt.MessageV1); ok {
+			details = append(details, message)
+		}
+	}
+	return details
+}
+
+// gatewayErrorHandler writes the Retry-After header of the errors with a retry detail, and the RateLimit-* headers
+// of the ones with a quota detail too, before the error itself.
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	var retryInfo *errdetails.RetryInfo
+	var quotaFailure *errdetails.QuotaFailure
+	for _, detail := range status.Convert(err).Details() {
+		switch detail := detail.(type) {
+		case *errdetails.RetryInfo:
+			retryInfo = detail
+		case *errdetails.QuotaFailure:
+			quotaFailure = detail
+		}
+	}
+	if retryInfo != nil {
+		retryAfter := strconv.FormatInt(int64(math.Ceil(retryInfo.GetRetryDelay().AsDuration().Seconds())), 10)
+		w.Header().Set(retryAfterHeader, retryAfter)
+		if quotaFailure != nil {
+			w.Header().Set(rateLimitResetHeader, retryAfter)
+		}
+	}
+	if quotaFailure != nil && len(quotaFailure.GetViolations()) > 0 {
+		w.Header().Set(rateLimitLimitHeader, strconv.FormatInt(quotaFailure.GetViolations()[0].GetQuotaValue(), 10))
+		w.Header().Set(rateLimitRemainingHeader, "0")
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
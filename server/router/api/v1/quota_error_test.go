@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGatewayErrorHandlerHeaders(t *testing.T) {
+	mux := runtime.NewServeMux()
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/memos:suggestTags", nil)
+	handle := func(err error) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		gatewayErrorHandler(context.Background(), mux, &runtime.JSONPb{}, recorder, request, err)
+		return recorder
+	}
+
+	t.Run("exceeded quota with reset time", func(t *testing.T) {
+		recorder := handle(quotaExceededError(quotaViolation{
+			subject:   "users/1",
+			id:        "ai_requests_per_hour",
+			limit:     10,
+			resetTime: time.Now().Add(90 * time.Second),
+		}, "rate limit exceeded"))
+		require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+		require.Equal(t, "10", recorder.Header().Get(rateLimitLimitHeader))
+		require.Equal(t, "0", recorder.Header().Get(rateLimitRemainingHeader))
+		require.Contains(t, []string{"90", "91"}, recorder.Header().Get(rateLimitResetHeader))
+		require.Equal(t, recorder.Header().Get(rateLimitResetHeader), recorder.Header().Get(retryAfterHeader))
+	})
+
+	t.Run("exceeded quota without reset time", func(t *testing.T) {
+		recorder := handle(quotaExceededError(quotaViolation{subject: "workspace", id: "memos", limit: 100}, "memo limit reached"))
+		require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+		require.Equal(t, "100", recorder.Header().Get(rateLimitLimitHeader))
+		require.Equal(t, "0", recorder.Header().Get(rateLimitRemainingHeader))
+		require.Empty(t, recorder.Header().Get(rateLimitResetHeader))
+		require.Empty(t, recorder.Header().Get(retryAfterHeader))
+	})
+
+	t.Run("unavailable with retry time", func(t *testing.T) {
+		err := retryAfterError(codes.Unavailable, time.Now().Add(30*time.Second), "AI provider unavailable")
+		recorder := handle(aiCallError(err, "failed to suggest tags"))
+		require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+		require.Contains(t, []string{"30", "31"}, recorder.Header().Get(retryAfterHeader))
+		require.Empty(t, recorder.Header().Get(rateLimitLimitHeader))
+		require.Empty(t, recorder.Header().Get(rateLimitResetHeader))
+	})
+}
@@ -50,6 +50,12 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	}
 
 	reactionMessage := convertReactionFromStore(reaction)
+	if memo := s.getReactionMemo(ctx, reaction.ContentID); memo != nil {
+		s.recordEvent(ctx, store.EventTypeReactionCreated, memo.CreatorID, reaction.ContentID, reactionMessage)
+		if s.ReactionNotifier != nil {
+			s.ReactionNotifier.Add(memo, reaction.CreatorID, reaction.ReactionType)
+		}
+	}
 
 	return reactionMessage, nil
 }
@@ -60,15 +66,44 @@ func (s *APIV1Service) DeleteMemoReaction(ctx context.Context, request *v1pb.Del
 		return nil, status.Errorf(codes.InvalidArgument, "invalid reaction name: %v", err)
 	}
 
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
+		ID: &reactionID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reaction")
+	}
+
 	if err := s.Store.DeleteReaction(ctx, &store.DeleteReaction{
 		ID: reactionID,
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete reaction")
 	}
 
+	for _, reaction := range reactions {
+		if memo := s.getReactionMemo(ctx, reaction.ContentID); memo != nil {
+			s.recordEvent(ctx, store.EventTypeReactionDeleted, memo.CreatorID, reaction.ContentID, convertReactionFromStore(reaction))
+			if s.ReactionNotifier != nil {
+				s.ReactionNotifier.Remove(memo, reaction.CreatorID, reaction.ReactionType)
+			}
+		}
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
+// getReactionMemo returns the memo the reaction is for, nil if it is not for a memo.
+func (s *APIV1Service) getReactionMemo(ctx context.Context, contentID string) *store.Memo {
+	memoUID, err := ExtractMemoUIDFromName(contentID)
+	if err != nil {
+		return nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil
+	}
+	return memo
+}
+
 func convertReactionFromStore(reaction *store.Reaction) *v1pb.Reaction {
 	reactionUID := fmt.Sprintf("%d", reaction.ID)
 	return &v1pb.Reaction{
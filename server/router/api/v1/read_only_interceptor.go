@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/server/runner/maintenance"
+)
+
+// ReadOnlyInterceptor rejects the methods changing data while a maintenance window is in progress.
+type ReadOnlyInterceptor struct{}
+
+func NewReadOnlyInterceptor() *ReadOnlyInterceptor {
+	return &ReadOnlyInterceptor{}
+}
+
+func (*ReadOnlyInterceptor) ReadOnlyInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if maintenance.IsReadOnly() && !isAllowedInReadOnlyMode(serverInfo.FullMethod) {
+		return nil, status.Errorf(codes.Unavailable, "the workspace is read-only during maintenance")
+	}
+	return handler(ctx, request)
+}
+
+func (*ReadOnlyInterceptor) ReadOnlyStreamInterceptor(server any, stream grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if maintenance.IsReadOnly() && !isAllowedInReadOnlyMode(serverInfo.FullMethod) {
+		return status.Errorf(codes.Unavailable, "the workspace is read-only during maintenance")
+	}
+	return handler(server, stream)
+}
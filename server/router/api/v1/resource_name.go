@@ -10,15 +10,28 @@ import (
 )
 
 const (
-	WorkspaceSettingNamePrefix = "workspace/settings/"
-	UserNamePrefix             = "users/"
-	MemoNamePrefix             = "memos/"
-	AttachmentNamePrefix       = "attachments/"
-	ReactionNamePrefix         = "reactions/"
-	InboxNamePrefix            = "inboxes/"
-	IdentityProviderNamePrefix = "identityProviders/"
-	ActivityNamePrefix         = "activities/"
-	WebhookNamePrefix          = "webhooks/"
+	WorkspaceSettingNamePrefix        = "workspace/settings/"
+	RunnerNamePrefix                  = "workspace/runners/"
+	DeadLetterNamePrefix              = "workspace/deadLetters/"
+	AnnouncementNamePrefix            = "workspace/announcements/"
+	MaintenanceWindowNamePrefix       = "workspace/maintenanceWindows/"
+	SyndicationSubscriptionNamePrefix = "workspace/syndicationSubscriptions/"
+	FederatedMemoNamePrefix           = "federatedMemos/"
+	UserNamePrefix                    = "users/"
+	MemoNamePrefix                    = "memos/"
+	AttachmentNamePrefix              = "attachments/"
+	ReactionNamePrefix                = "reactions/"
+	InboxNamePrefix                   = "inboxes/"
+	IdentityProviderNamePrefix        = "identityProviders/"
+	ActivityNamePrefix                = "activities/"
+	WebhookNamePrefix                 = "webhooks/"
+	EventNamePrefix                   = "events/"
+	AIJobNamePrefix                   = "aiJobs/"
+	WebmentionNamePrefix              = "webmentions/"
+	PersonNamePrefix                  = "people/"
+
+	MemoReadStateNameSuffix    = "/readState"
+	MemoSubscriptionNameSuffix = "/subscription"
 )
 
 // GetNameParentTokens returns the tokens from a resource name.
@@ -60,6 +73,67 @@ func ExtractWorkspaceSettingKeyFromName(name string) (string, error) {
 	return settingKey, nil
 }
 
+// ExtractRunnerNameFromName returns the runner name from a resource name.
+func ExtractRunnerNameFromName(name string) (string, error) {
+	runnerName, ok := strings.CutPrefix(name, RunnerNamePrefix)
+	if !ok || runnerName == "" || strings.Contains(runnerName, "/") {
+		return "", errors.Errorf("invalid runner name %q", name)
+	}
+	return runnerName, nil
+}
+
+// ExtractDeadLetterIDFromName returns the dead letter ID from a resource name.
+func ExtractDeadLetterIDFromName(name string) (int32, error) {
+	idString, ok := strings.CutPrefix(name, DeadLetterNamePrefix)
+	if !ok || idString == "" {
+		return 0, errors.Errorf("invalid dead letter name %q", name)
+	}
+	id, err := util.ConvertStringToInt32(idString)
+	if err != nil {
+		return 0, errors.Errorf("invalid dead letter ID %q", idString)
+	}
+	return id, nil
+}
+
+// ExtractAnnouncementIDFromName returns the announcement ID from a resource name.
+func ExtractAnnouncementIDFromName(name string) (int32, error) {
+	idString, ok := strings.CutPrefix(name, AnnouncementNamePrefix)
+	if !ok || idString == "" {
+		return 0, errors.Errorf("invalid announcement name %q", name)
+	}
+	id, err := util.ConvertStringToInt32(idString)
+	if err != nil {
+		return 0, errors.Errorf("invalid announcement ID %q", idString)
+	}
+	return id, nil
+}
+
+// ExtractSyndicationSubscriptionIDFromName returns the syndication subscription ID from a resource name.
+func ExtractSyndicationSubscriptionIDFromName(name string) (int32, error) {
+	idString, ok := strings.CutPrefix(name, SyndicationSubscriptionNamePrefix)
+	if !ok || idString == "" {
+		return 0, errors.Errorf("invalid syndication subscription name %q", name)
+	}
+	id, err := util.ConvertStringToInt32(idString)
+	if err != nil {
+		return 0, errors.Errorf("invalid syndication subscription ID %q", idString)
+	}
+	return id, nil
+}
+
+// ExtractAIJobIDFromName returns the AI job ID from a resource name.
+func ExtractAIJobIDFromName(name string) (int32, error) {
+	idString, ok := strings.CutPrefix(name, AIJobNamePrefix)
+	if !ok || idString == "" {
+		return 0, errors.Errorf("invalid AI job name %q", name)
+	}
+	id, err := util.ConvertStringToInt32(idString)
+	if err != nil {
+		return 0, errors.Errorf("invalid AI job ID %q", idString)
+	}
+	return id, nil
+}
+
 // ExtractUserIDFromName returns the uid from a resource name.
 func ExtractUserIDFromName(name string) (int32, error) {
 	tokens, err := GetNameParentTokens(name, UserNamePrefix)
@@ -95,6 +169,53 @@ func ExtractMemoUIDFromName(name string) (string, error) {
 	return id, nil
 }
 
+// ExtractMemoUIDFromReadStateName returns the memo UID from a memo read state resource name.
+// e.g., "memos/uuid/readState" -> "uuid".
+func ExtractMemoUIDFromReadStateName(name string) (string, error) {
+	memoName, ok := strings.CutSuffix(name, MemoReadStateNameSuffix)
+	if !ok {
+		return "", errors.Errorf("invalid memo read state name %q", name)
+	}
+	return ExtractMemoUIDFromName(memoName)
+}
+
+// ExtractMemoUIDFromSubscriptionName returns the memo UID from a memo subscription resource name.
+// e.g., "memos/uuid/subscription" -> "uuid".
+func ExtractMemoUIDFromSubscriptionName(name string) (string, error) {
+	memoName, ok := strings.CutSuffix(name, MemoSubscriptionNameSuffix)
+	if !ok {
+		return "", errors.Errorf("invalid memo subscription name %q", name)
+	}
+	return ExtractMemoUIDFromName(memoName)
+}
+
+// ExtractMemoWebmentionIDFromName returns the memo UID and the webmention ID from a webmention resource name.
+// e.g., "memos/uuid/webmentions/1" -> "uuid", 1.
+func ExtractMemoWebmentionIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, WebmentionNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid webmention ID %q", tokens[1])
+	}
+	return tokens[0], id, nil
+}
+
+// ExtractPersonFromName returns the person from a person resource name, lowercased like the tags.
+// e.g., "people/alice" -> "alice".
+func ExtractPersonFromName(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, PersonNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	if !personPattern.MatchString(tokens[0]) {
+		return "", errors.Errorf("invalid person %q", tokens[0])
+	}
+	return strings.ToLower(tokens[0]), nil
+}
+
 // ExtractAttachmentUIDFromName returns the attachment UID from a resource name.
 func ExtractAttachmentUIDFromName(name string) (string, error) {
 	tokens, err := GetNameParentTokens(name, AttachmentNamePrefix)
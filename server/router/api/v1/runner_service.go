@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/cron"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/scheduler"
+)
+
+// ListRunners lists the background runners with their schedule and last run.
+func (s *APIV1Service) ListRunners(ctx context.Context, _ *v1pb.ListRunnersRequest) (*v1pb.ListRunnersResponse, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	response := &v1pb.ListRunnersResponse{
+		Runners: []*v1pb.Runner{},
+	}
+	if s.Scheduler == nil {
+		return response, nil
+	}
+	for _, runnerStatus := range s.Scheduler.List() {
+		response.Runners = append(response.Runners, convertRunnerFromStatus(runnerStatus))
+	}
+	return response, nil
+}
+
+// UpdateRunner updates the schedule or enabled state of a background runner.
+func (s *APIV1Service) UpdateRunner(ctx context.Context, request *v1pb.UpdateRunnerRequest) (*v1pb.Runner, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+	if request.Runner == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "runner is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	runnerName, err := ExtractRunnerNameFromName(request.Runner.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var enabled *bool
+	var schedule *string
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "enabled":
+			enabled = &request.Runner.Enabled
+		case "schedule":
+			if request.Runner.Schedule != "" {
+				if _, err := cron.Parse(request.Runner.Schedule); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid schedule: %v", err)
+				}
+			}
+			schedule = &request.Runner.Schedule
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update path: %s", path)
+		}
+	}
+
+	if s.Scheduler == nil {
+		return nil, status.Errorf(codes.NotFound, "runner not found: %s", runnerName)
+	}
+	runnerStatus, err := s.Scheduler.Update(ctx, runnerName, enabled, schedule)
+	if err != nil {
+		return nil, convertSchedulerError(runnerName, err)
+	}
+	return convertRunnerFromStatus(runnerStatus), nil
+}
+
+// RunRunner runs a background runner now, regardless of its schedule.
+func (s *APIV1Service) RunRunner(ctx context.Context, request *v1pb.RunRunnerRequest) (*v1pb.Runner, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+	runnerName, err := ExtractRunnerNameFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if s.Scheduler == nil {
+		return nil, status.Errorf(codes.NotFound, "runner not found: %s", runnerName)
+	}
+	runnerStatus, err := s.Scheduler.Trigger(runnerName)
+	if err != nil {
+		return nil, convertSchedulerError(runnerName, err)
+	}
+	return convertRunnerFromStatus(runnerStatus), nil
+}
+
+func (s *APIV1Service) checkSuperUserPermission(ctx context.Context) error {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+func convertSchedulerError(runnerName string, err error) error {
+	switch {
+	case errors.Is(err, scheduler.ErrRunnerNotFound):
+		return status.Errorf(codes.NotFound, "runner not found: %s", runnerName)
+	case errors.Is(err, scheduler.ErrRunnerRunning):
+		return status.Errorf(codes.FailedPrecondition, "runner is already running: %s", runnerName)
+	default:
+		return status.Errorf(codes.Internal, "failed to update runner: %v", err)
+	}
+}
+
+func convertRunnerFromStatus(runnerStatus scheduler.RunnerStatus) *v1pb.Runner {
+	runner := &v1pb.Runner{
+		Name:         RunnerNamePrefix + runnerStatus.Name,
+		Description:  runnerStatus.Description,
+		Enabled:      runnerStatus.Enabled,
+		Schedule:     runnerStatus.Schedule,
+		Running:      runnerStatus.Running,
+		LastError:    runnerStatus.LastError,
+		LastRunState: v1pb.Runner_RUN_STATE_UNSPECIFIED,
+	}
+	switch {
+	case runnerStatus.Running:
+		runner.LastRunState = v1pb.Runner_RUNNING
+	case runnerStatus.LastError != "":
+		runner.LastRunState = v1pb.Runner_FAILED
+	case !runnerStatus.LastFinishTime.IsZero():
+		runner.LastRunState = v1pb.Runner_SUCCEEDED
+	}
+	if !runnerStatus.LastRunTime.IsZero() {
+		runner.LastRunTime = timestamppb.New(runnerStatus.LastRunTime)
+	}
+	if !runnerStatus.LastFinishTime.IsZero() {
+		runner.LastFinishTime = timestamppb.New(runnerStatus.LastFinishTime)
+	}
+	if !runnerStatus.NextRunTime.IsZero() {
+		runner.NextRunTime = timestamppb.New(runnerStatus.NextRunTime)
+	}
+	return runner
+}
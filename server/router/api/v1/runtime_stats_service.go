@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"runtime"
+	"runtime/pprof"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// GetRuntimeStats returns a snapshot of the goroutines and memory of the server to the host.
+func (s *APIV1Service) GetRuntimeStats(ctx context.Context, request *v1pb.GetRuntimeStatsRequest) (*v1pb.RuntimeStats, error) {
+	if err := s.checkHostUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	stats := &v1pb.RuntimeStats{
+		GoVersion:      runtime.Version(),
+		CpuCount:       int32(runtime.NumCPU()),
+		GoroutineCount: int32(runtime.NumGoroutine()),
+		Memory: &v1pb.RuntimeStats_Memory{
+			HeapAllocBytes:  memStats.HeapAlloc,
+			HeapInuseBytes:  memStats.HeapInuse,
+			HeapSysBytes:    memStats.HeapSys,
+			HeapObjects:     memStats.HeapObjects,
+			TotalAllocBytes: memStats.TotalAlloc,
+			SysBytes:        memStats.Sys,
+			GcCount:         memStats.NumGC,
+			GcPauseTotal:    durationpb.New(time.Duration(memStats.PauseTotalNs)),
+		},
+	}
+	if memStats.LastGC != 0 {
+		stats.Memory.LastGcTime = timestamppb.New(time.Unix(0, int64(memStats.LastGC)))
+	}
+	if request.IncludeGoroutineStacks {
+		var buffer bytes.Buffer
+		// The debug level 1 groups the goroutines with the same stack, keeping the snapshot readable.
+		if err := pprof.Lookup("goroutine").WriteTo(&buffer, 1); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to write goroutine stacks: %v", err)
+		}
+		stats.GoroutineStacks = buffer.String()
+	}
+	return stats, nil
+}
+
+// RequireHost returns a middleware of the HTTP routes only served to the host, authenticated by an access token in
+// the Authorization header or by a session cookie, such as the profiling endpoints.
+func (s *APIV1Service) RequireHost(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		authenticator := NewGRPCAuthInterceptor(s.Store, s.Secret)
+		var user *store.User
+		var err error
+		if accessToken, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer "); ok {
+			user, err = authenticator.authenticateByJWT(ctx, accessToken)
+		} else if cookie, cookieErr := c.Cookie(SessionCookieName); cookieErr == nil {
+			user, err = authenticator.authenticateBySession(ctx, cookie.Value)
+		}
+		if err != nil || user == nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, "user not authenticated")
+		}
+		if user.Role != store.RoleHost {
+			return echo.NewHTTPError(http.StatusForbidden, "permission denied")
+		}
+		return next(c)
+	}
+}
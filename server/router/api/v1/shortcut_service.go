@@ -329,18 +329,20 @@ func (s *APIV1Service) validateFilter(ctx context.Context, filterStr string) err
 		return err
 	}
 
-	var dialect filter.DialectName
-	switch s.Profile.Driver {
+	if _, err := engine.CompileToStatement(ctx, filterStr, filter.RenderOptions{Dialect: s.filterDialect()}); err != nil {
+		return errors.Wrap(err, "failed to compile filter")
+	}
+	return nil
+}
+
+// filterDialect returns the filter dialect of the database driver.
+func (s *APIV1Service) filterDialect() filter.DialectName {
+	switch s.Profile.Dialect() {
 	case "mysql":
-		dialect = filter.DialectMySQL
+		return filter.DialectMySQL
 	case "postgres":
-		dialect = filter.DialectPostgres
+		return filter.DialectPostgres
 	default:
-		dialect = filter.DialectSQLite
+		return filter.DialectSQLite
 	}
-
-	if _, err := engine.CompileToStatement(ctx, filterStr, filter.RenderOptions{Dialect: dialect}); err != nil {
-		return errors.Wrap(err, "failed to compile filter")
-	}
-	return nil
 }
@@ -0,0 +1,217 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/memosclient"
+	"github.com/usememos/memos/plugin/outbound"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/syndication"
+	"github.com/usememos/memos/store"
+)
+
+// ListSyndicationSubscriptions lists the subscriptions of the workspace to other instances.
+func (s *APIV1Service) ListSyndicationSubscriptions(ctx context.Context, _ *v1pb.ListSyndicationSubscriptionsRequest) (*v1pb.ListSyndicationSubscriptionsResponse, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+	subscriptions, err := s.Store.ListSyndicationSubscriptions(ctx, &store.FindSyndicationSubscription{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list syndication subscriptions: %v", err)
+	}
+	response := &v1pb.ListSyndicationSubscriptionsResponse{
+		SyndicationSubscriptions: []*v1pb.SyndicationSubscription{},
+	}
+	for _, subscription := range subscriptions {
+		response.SyndicationSubscriptions = append(response.SyndicationSubscriptions, convertSyndicationSubscriptionFromStore(subscription))
+	}
+	return response, nil
+}
+
+// CreateSyndicationSubscription subscribes the workspace to the public memos of another instance and syncs them
+// right away. The subscription is created even when the first sync fails, its error is returned with it.
+func (s *APIV1Service) CreateSyndicationSubscription(ctx context.Context, request *v1pb.CreateSyndicationSubscriptionRequest) (*v1pb.SyndicationSubscription, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	create := request.SyndicationSubscription
+	if create == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "syndication subscription is required")
+	}
+	instanceURL, err := memosclient.ParseInstanceURL(create.InstanceUrl)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid instance url: %v", err)
+	}
+	if err := outbound.ValidateURL(ctx, instanceURL); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid instance url: %v", err)
+	}
+	payload := &storepb.SyndicationSubscriptionPayload{}
+	for _, creator := range create.Creators {
+		if _, err := ExtractUserIDFromName(creator); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid creator %q", creator)
+		}
+		payload.Creators = append(payload.Creators, creator)
+	}
+	for _, tag := range create.Tags {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+		if tag == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "tag must not be empty")
+		}
+		payload.Tags = append(payload.Tags, tag)
+	}
+	slices.Sort(payload.Creators)
+	payload.Creators = slices.Compact(payload.Creators)
+	slices.Sort(payload.Tags)
+	payload.Tags = slices.Compact(payload.Tags)
+
+	subscription, err := s.Store.CreateSyndicationSubscription(ctx, &store.SyndicationSubscription{
+		CreatorID:   user.ID,
+		InstanceURL: instanceURL,
+		Payload:     payload,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create syndication subscription: %v", err)
+	}
+	// The error of the sync is recorded on the subscription.
+	_ = syndication.NewRunner(s.Store).Sync(ctx, subscription)
+	return convertSyndicationSubscriptionFromStore(subscription), nil
+}
+
+// DeleteSyndicationSubscription unsubscribes the workspace and deletes the memos mirrored by the subscription.
+func (s *APIV1Service) DeleteSyndicationSubscription(ctx context.Context, request *v1pb.DeleteSyndicationSubscriptionRequest) (*emptypb.Empty, error) {
+	if err := s.checkSuperUserPermission(ctx); err != nil {
+		return nil, err
+	}
+	id, err := ExtractSyndicationSubscriptionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	subscription, err := s.Store.GetSyndicationSubscription(ctx, &store.FindSyndicationSubscription{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get syndication subscription: %v", err)
+	}
+	if subscription == nil {
+		return nil, status.Errorf(codes.NotFound, "syndication subscription not found")
+	}
+	if err := s.Store.DeleteFederatedMemos(ctx, &store.DeleteFederatedMemos{SubscriptionID: subscription.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete federated memos: %v", err)
+	}
+	if err := s.Store.DeleteSyndicationSubscription(ctx, &store.DeleteSyndicationSubscription{ID: subscription.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete syndication subscription: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListFederatedMemos lists the memos mirrored from the other instances, the ones created last first.
+func (s *APIV1Service) ListFederatedMemos(ctx context.Context, request *v1pb.ListFederatedMemosRequest) (*v1pb.ListFederatedMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+
+	findFederatedMemo := &store.FindFederatedMemo{
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	}
+	if request.Subscription != "" {
+		subscriptionID, err := ExtractSyndicationSubscriptionIDFromName(request.Subscription)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		findFederatedMemo.SubscriptionID = &subscriptionID
+	}
+	federatedMemos, err := s.Store.ListFederatedMemos(ctx, findFederatedMemo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list federated memos: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(federatedMemos) == limitPlusOne {
+		federatedMemos = federatedMemos[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+	}
+	response := &v1pb.ListFederatedMemosResponse{
+		FederatedMemos: []*v1pb.FederatedMemo{},
+		NextPageToken:  nextPageToken,
+	}
+	for _, federatedMemo := range federatedMemos {
+		response.FederatedMemos = append(response.FederatedMemos, convertFederatedMemoFromStore(federatedMemo))
+	}
+	return response, nil
+}
+
+func convertSyndicationSubscriptionFromStore(subscription *store.SyndicationSubscription) *v1pb.SyndicationSubscription {
+	subscriptionMessage := &v1pb.SyndicationSubscription{
+		Name:        fmt.Sprintf("%s%d", SyndicationSubscriptionNamePrefix, subscription.ID),
+		InstanceUrl: subscription.InstanceURL,
+		Creators:    subscription.Payload.GetCreators(),
+		Tags:        subscription.Payload.GetTags(),
+		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, subscription.CreatorID),
+		CreateTime:  timestamppb.New(time.Unix(subscription.CreatedTs, 0)),
+		LastError:   subscription.LastError,
+	}
+	if subscription.LastSyncTs != 0 {
+		subscriptionMessage.LastSyncTime = timestamppb.New(time.Unix(subscription.LastSyncTs, 0))
+	}
+	return subscriptionMessage
+}
+
+func convertFederatedMemoFromStore(federatedMemo *store.FederatedMemo) *v1pb.FederatedMemo {
+	return &v1pb.FederatedMemo{
+		Name:               fmt.Sprintf("%s%d", FederatedMemoNamePrefix, federatedMemo.ID),
+		Subscription:       fmt.Sprintf("%s%d", SyndicationSubscriptionNamePrefix, federatedMemo.SubscriptionID),
+		InstanceUrl:        federatedMemo.InstanceURL,
+		OriginName:         federatedMemo.OriginName,
+		OriginUrl:          federatedMemo.InstanceURL + "/" + federatedMemo.OriginName,
+		Creator:            federatedMemo.Payload.GetCreator(),
+		CreatorUsername:    federatedMemo.Payload.GetCreatorUsername(),
+		CreatorDisplayName: federatedMemo.Payload.GetCreatorDisplayName(),
+		Content:            federatedMemo.Content,
+		Tags:               federatedMemo.Payload.GetTags(),
+		CreateTime:         timestamppb.New(time.Unix(federatedMemo.CreatedTs, 0)),
+		UpdateTime:         timestamppb.New(time.Unix(federatedMemo.UpdatedTs, 0)),
+		SyncTime:           timestamppb.New(time.Unix(federatedMemo.SyncedTs, 0)),
+	}
+}
@@ -0,0 +1,317 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxTagMetaEmojiLength is the maximum number of characters of a tag emoji, enough for emoji sequences.
+	maxTagMetaEmojiLength = 8
+	// maxTagMetaDescriptionLength is the maximum length of a tag description.
+	maxTagMetaDescriptionLength = 256
+)
+
+var tagMetaColorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// extractUserAndTagMetaIDFromName extracts the user ID and tag meta ID from a tag meta resource name.
+// Format: users/{user}/tagMetas/{tag_meta}.
+func extractUserAndTagMetaIDFromName(name string) (int32, string, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "tagMetas" {
+		return 0, "", errors.Errorf("invalid tag meta name format: %s", name)
+	}
+	userID, err := util.ConvertStringToInt32(parts[1])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", parts[1])
+	}
+	if parts[3] == "" {
+		return 0, "", errors.Errorf("empty tag meta ID in name: %s", name)
+	}
+	return userID, parts[3], nil
+}
+
+func (s *APIV1Service) ListTagMetas(ctx context.Context, request *v1pb.ListTagMetasRequest) (*v1pb.ListTagMetasResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkTagMetaOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	tagMetasUserSetting, err := s.getTagMetasUserSetting(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tagMetas := slices.Clone(tagMetasUserSetting.TagMetas)
+	// Pinned tags come first in their pinned order, then the other tags alphabetically.
+	slices.SortFunc(tagMetas, func(a, b *storepb.TagMetasUserSetting_TagMeta) int {
+		if (a.PinnedOrder > 0) != (b.PinnedOrder > 0) {
+			if a.PinnedOrder > 0 {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Or(cmp.Compare(a.PinnedOrder, b.PinnedOrder), strings.Compare(a.Tag, b.Tag))
+	})
+	response := &v1pb.ListTagMetasResponse{
+		TagMetas: []*v1pb.TagMeta{},
+	}
+	for _, tagMeta := range tagMetas {
+		response.TagMetas = append(response.TagMetas, convertTagMetaFromStore(userID, tagMeta))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetTagMeta(ctx context.Context, request *v1pb.GetTagMetaRequest) (*v1pb.TagMeta, error) {
+	userID, tagMetaID, err := extractUserAndTagMetaIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag meta name: %v", err)
+	}
+	if err := s.checkTagMetaOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	tagMetasUserSetting, err := s.getTagMetasUserSetting(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, tagMeta := range tagMetasUserSetting.TagMetas {
+		if tagMeta.Id == tagMetaID {
+			return convertTagMetaFromStore(userID, tagMeta), nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "tag meta not found")
+}
+
+func (s *APIV1Service) CreateTagMeta(ctx context.Context, request *v1pb.CreateTagMetaRequest) (*v1pb.TagMeta, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkTagMetaOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.TagMeta == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag meta is required")
+	}
+
+	tag, err := normalizeTagMetaTag(request.TagMeta.Tag)
+	if err != nil {
+		return nil, err
+	}
+	tagMeta := &storepb.TagMetasUserSetting_TagMeta{
+		Id:          util.GenUUID(),
+		Tag:         tag,
+		Color:       request.TagMeta.Color,
+		Emoji:       strings.TrimSpace(request.TagMeta.Emoji),
+		PinnedOrder: request.TagMeta.PinnedOrder,
+		Description: strings.TrimSpace(request.TagMeta.Description),
+	}
+	if err := validateTagMeta(tagMeta); err != nil {
+		return nil, err
+	}
+
+	tagMetasUserSetting, err := s.getTagMetasUserSetting(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if findTagMetaByTag(tagMetasUserSetting, tag) != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "tag meta of tag %q already exists", tag)
+	}
+	tagMetasUserSetting.TagMetas = append(tagMetasUserSetting.TagMetas, tagMeta)
+	if err := s.upsertTagMetasUserSetting(ctx, userID, tagMetasUserSetting); err != nil {
+		return nil, err
+	}
+	return convertTagMetaFromStore(userID, tagMeta), nil
+}
+
+func (s *APIV1Service) UpdateTagMeta(ctx context.Context, request *v1pb.UpdateTagMetaRequest) (*v1pb.TagMeta, error) {
+	if request.TagMeta == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag meta is required")
+	}
+	userID, tagMetaID, err := extractUserAndTagMetaIDFromName(request.TagMeta.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag meta name: %v", err)
+	}
+	if err := s.checkTagMetaOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	tagMetasUserSetting, err := s.getTagMetasUserSetting(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	index := slices.IndexFunc(tagMetasUserSetting.TagMetas, func(tagMeta *storepb.TagMetasUserSetting_TagMeta) bool {
+		return tagMeta.Id == tagMetaID
+	})
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "tag meta not found")
+	}
+	tagMeta := tagMetasUserSetting.TagMetas[index]
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "tag":
+			tag, err := normalizeTagMetaTag(request.TagMeta.Tag)
+			if err != nil {
+				return nil, err
+			}
+			if existing := findTagMetaByTag(tagMetasUserSetting, tag); existing != nil && existing.Id != tagMeta.Id {
+				return nil, status.Errorf(codes.AlreadyExists, "tag meta of tag %q already exists", tag)
+			}
+			tagMeta.Tag = tag
+		case "color":
+			tagMeta.Color = request.TagMeta.Color
+		case "emoji":
+			tagMeta.Emoji = strings.TrimSpace(request.TagMeta.Emoji)
+		case "pinned_order":
+			tagMeta.PinnedOrder = request.TagMeta.PinnedOrder
+		case "description":
+			tagMeta.Description = strings.TrimSpace(request.TagMeta.Description)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update path: %s", path)
+		}
+	}
+	if err := validateTagMeta(tagMeta); err != nil {
+		return nil, err
+	}
+	if err := s.upsertTagMetasUserSetting(ctx, userID, tagMetasUserSetting); err != nil {
+		return nil, err
+	}
+	return convertTagMetaFromStore(userID, tagMeta), nil
+}
+
+func (s *APIV1Service) DeleteTagMeta(ctx context.Context, request *v1pb.DeleteTagMetaRequest) (*emptypb.Empty, error) {
+	userID, tagMetaID, err := extractUserAndTagMetaIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag meta name: %v", err)
+	}
+	if err := s.checkTagMetaOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	tagMetasUserSetting, err := s.getTagMetasUserSetting(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tagMetas := slices.DeleteFunc(slices.Clone(tagMetasUserSetting.TagMetas), func(tagMeta *storepb.TagMetasUserSetting_TagMeta) bool {
+		return tagMeta.Id == tagMetaID
+	})
+	if len(tagMetas) == len(tagMetasUserSetting.TagMetas) {
+		return nil, status.Errorf(codes.NotFound, "tag meta not found")
+	}
+	tagMetasUserSetting.TagMetas = tagMetas
+	if err := s.upsertTagMetasUserSetting(ctx, userID, tagMetasUserSetting); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkTagMetaOwner checks that the current user is the user the tag metadata belong to.
+func (s *APIV1Service) checkTagMetaOwner(ctx context.Context, userID int32) error {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// getTagMetasUserSetting returns a copy of the tag metadata of the user, safe to modify.
+func (s *APIV1Service) getTagMetasUserSetting(ctx context.Context, userID int32) (*storepb.TagMetasUserSetting, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_TAG_METAS,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag metas user setting: %v", err)
+	}
+	tagMetasUserSetting := &storepb.TagMetasUserSetting{}
+	if cached := userSetting.GetTagMetas(); cached != nil {
+		tagMetasUserSetting = proto.Clone(cached).(*storepb.TagMetasUserSetting)
+	}
+	return tagMetasUserSetting, nil
+}
+
+func (s *APIV1Service) upsertTagMetasUserSetting(ctx context.Context, userID int32, tagMetasUserSetting *storepb.TagMetasUserSetting) error {
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_TAG_METAS,
+		Value: &storepb.UserSetting_TagMetas{
+			TagMetas: tagMetasUserSetting,
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert tag metas user setting: %v", err)
+	}
+	return nil
+}
+
+func findTagMetaByTag(tagMetasUserSetting *storepb.TagMetasUserSetting, tag string) *storepb.TagMetasUserSetting_TagMeta {
+	for _, tagMeta := range tagMetasUserSetting.TagMetas {
+		if tagMeta.Tag == tag {
+			return tagMeta
+		}
+	}
+	return nil
+}
+
+// normalizeTagMetaTag trims the tag and its leading "#".
+func normalizeTagMetaTag(tag string) (string, error) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+	if tag == "" {
+		return "", status.Errorf(codes.InvalidArgument, "tag is required")
+	}
+	if strings.ContainsFunc(tag, func(r rune) bool { return r == ' ' || r == '\t' || r == '\n' }) {
+		return "", status.Errorf(codes.InvalidArgument, "tag cannot contain whitespace")
+	}
+	return tag, nil
+}
+
+func validateTagMeta(tagMeta *storepb.TagMetasUserSetting_TagMeta) error {
+	if tagMeta.Color != "" && !tagMetaColorRegexp.MatchString(tagMeta.Color) {
+		return status.Errorf(codes.InvalidArgument, "invalid color %q, expected a hex color like #ff8800", tagMeta.Color)
+	}
+	if utf8.RuneCountInString(tagMeta.Emoji) > maxTagMetaEmojiLength {
+		return status.Errorf(codes.InvalidArgument, "emoji too long (max %d characters)", maxTagMetaEmojiLength)
+	}
+	if tagMeta.PinnedOrder < 0 {
+		return status.Errorf(codes.InvalidArgument, "pinned order cannot be negative")
+	}
+	if len(tagMeta.Description) > maxTagMetaDescriptionLength {
+		return status.Errorf(codes.InvalidArgument, "description too long (max %d characters)", maxTagMetaDescriptionLength)
+	}
+	return nil
+}
+
+func convertTagMetaFromStore(userID int32, tagMeta *storepb.TagMetasUserSetting_TagMeta) *v1pb.TagMeta {
+	return &v1pb.TagMeta{
+		Name:        fmt.Sprintf("%s%d/tagMetas/%s", UserNamePrefix, userID, tagMeta.Id),
+		Tag:         tagMeta.Tag,
+		Color:       tagMeta.Color,
+		Emoji:       tagMeta.Emoji,
+		PinnedOrder: tagMeta.PinnedOrder,
+		Description: tagMeta.Description,
+	}
+}
@@ -0,0 +1,173 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/rules"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// updateTaggingRulesSetting replaces the user's tagging rules when they are in the update mask.
+func (s *APIV1Service) updateTaggingRulesSetting(ctx context.Context, userID int32, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
+	incoming := request.Setting.GetTaggingRulesSetting()
+	if incoming == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tagging rules setting is required")
+	}
+	existing, err := s.Store.GetUserTaggingRulesSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	taggingRulesSetting := existing
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "rules":
+			normalized, err := rules.Normalize(convertTaggingRulesSettingToStore(incoming).Rules)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid rules: %v", err)
+			}
+			taggingRulesSetting = &storepb.TaggingRulesUserSetting{Rules: normalized}
+		default:
+			// Ignore unsupported fields
+		}
+	}
+
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_TAGGING_RULES,
+		Value:  &storepb.UserSetting_TaggingRules{TaggingRules: taggingRulesSetting},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
+// EvaluateTaggingRules evaluates the tagging rules of the current user, or the rules of the request, against the
+// content at the creation time in the timezone of the user, without saving anything.
+func (s *APIV1Service) EvaluateTaggingRules(ctx context.Context, request *v1pb.EvaluateTaggingRulesRequest) (*v1pb.EvaluateTaggingRulesResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	var taggingRules []*storepb.TaggingRulesUserSetting_Rule
+	if request.TaggingRules != nil {
+		if taggingRules, err = rules.Normalize(convertTaggingRulesSettingToStore(request.TaggingRules).Rules); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid rules: %v", err)
+		}
+	} else {
+		taggingRulesSetting, err := s.Store.GetUserTaggingRulesSetting(ctx, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+		}
+		taggingRules = taggingRulesSetting.Rules
+	}
+	createdTime := time.Now()
+	if request.CreateTime != nil {
+		createdTime = request.CreateTime.AsTime()
+	}
+
+	result, err := s.evaluateTaggingRules(ctx, user.ID, taggingRules, request.Content, createdTime)
+	if err != nil {
+		return nil, err
+	}
+	response := &v1pb.EvaluateTaggingRulesResponse{
+		Tags:       result.Tags,
+		Visibility: v1pb.Visibility_VISIBILITY_UNSPECIFIED,
+	}
+	if result.Visibility != "" {
+		response.Visibility = convertVisibilityFromStore(store.Visibility(result.Visibility))
+	}
+	for _, index := range result.MatchedRules {
+		response.MatchedRules = append(response.MatchedRules, int32(index))
+	}
+	return response, nil
+}
+
+// applyTaggingRules sets the rule tags of the memo to the tags of the tagging rules of its creator matching it, and
+// its visibility to the visibility of the rules when it is created. It is called before the payload is rebuilt.
+func (s *APIV1Service) applyTaggingRules(ctx context.Context, memo *store.Memo, created bool) error {
+	if memo.Payload == nil {
+		memo.Payload = &storepb.MemoPayload{}
+	}
+	taggingRulesSetting, err := s.Store.GetUserTaggingRulesSetting(ctx, memo.CreatorID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	if len(taggingRulesSetting.Rules) == 0 {
+		memo.Payload.RuleTags = nil
+		return nil
+	}
+	createdTime := time.Now()
+	if !created {
+		createdTime = time.Unix(memo.CreatedTs, 0)
+	}
+
+	result, err := s.evaluateTaggingRules(ctx, memo.CreatorID, taggingRulesSetting.Rules, memo.Content, createdTime)
+	if err != nil {
+		return err
+	}
+	memo.Payload.RuleTags = result.Tags
+	if created && result.Visibility != "" {
+		memo.Visibility = store.Visibility(result.Visibility)
+	}
+	return nil
+}
+
+// evaluateTaggingRules evaluates the rules against the content created at the time, in the timezone of the user.
+func (s *APIV1Service) evaluateTaggingRules(ctx context.Context, userID int32, taggingRules []*storepb.TaggingRulesUserSetting_Rule, content string, createdTime time.Time) (*rules.Result, error) {
+	data, err := s.MarkdownService.ExtractAll([]byte(content))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to extract markdown metadata: %v", err)
+	}
+	_, location, err := s.getUserLocation(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return rules.Evaluate(taggingRules, rules.Memo{
+		Content:     content,
+		Links:       data.Links,
+		CreatedTime: createdTime.In(location),
+	}), nil
+}
+
+func convertTaggingRulesSettingFromStore(taggingRulesSetting *storepb.TaggingRulesUserSetting) *v1pb.UserSetting_TaggingRulesSetting {
+	setting := &v1pb.UserSetting_TaggingRulesSetting{Rules: []*v1pb.UserSetting_TaggingRulesSetting_Rule{}}
+	for _, rule := range taggingRulesSetting.GetRules() {
+		setting.Rules = append(setting.Rules, &v1pb.UserSetting_TaggingRulesSetting_Rule{
+			Title:          rule.Title,
+			ContentPattern: rule.ContentPattern,
+			LinkDomain:     rule.LinkDomain,
+			Weekdays:       rule.Weekdays,
+			StartHour:      rule.StartHour,
+			EndHour:        rule.EndHour,
+			Tags:           rule.Tags,
+			Visibility:     rule.Visibility,
+		})
+	}
+	return setting
+}
+
+func convertTaggingRulesSettingToStore(setting *v1pb.UserSetting_TaggingRulesSetting) *storepb.TaggingRulesUserSetting {
+	taggingRulesSetting := &storepb.TaggingRulesUserSetting{}
+	for _, rule := range setting.GetRules() {
+		taggingRulesSetting.Rules = append(taggingRulesSetting.Rules, &storepb.TaggingRulesUserSetting_Rule{
+			Title:          rule.Title,
+			ContentPattern: rule.ContentPattern,
+			LinkDomain:     rule.LinkDomain,
+			Weekdays:       rule.Weekdays,
+			StartHour:      rule.StartHour,
+			EndHour:        rule.EndHour,
+			Tags:           rule.Tags,
+			Visibility:     rule.Visibility,
+		})
+	}
+	return taggingRulesSetting
+}
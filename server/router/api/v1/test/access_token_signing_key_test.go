@@ -0,0 +1,79 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+func TestRotateAccessTokenSigningKey(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	createToken := func() string {
+		accessToken, err := ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+			Parent:      fmt.Sprintf("users/%d", user.ID),
+			AccessToken: &v1pb.UserAccessToken{Description: "cli"},
+		})
+		require.NoError(t, err)
+		return accessToken.AccessToken
+	}
+	interceptor := apiv1.NewGRPCAuthInterceptor(ts.Store, ts.Secret)
+	authenticate := func(accessToken string) error {
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+accessToken))
+		_, err := interceptor.AuthenticationInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.MemoService/CreateMemo"}, func(context.Context, any) (any, error) {
+			return nil, nil
+		})
+		return err
+	}
+
+	first := createToken()
+	require.NoError(t, authenticate(first))
+
+	// The tokens signed with the previous key are accepted during the grace period.
+	response, err := ts.Service.RotateAccessTokenSigningKey(hostCtx, &v1pb.RotateAccessTokenSigningKeyRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Keys, 2)
+	require.Equal(t, "v1", response.Keys[0].Id)
+	require.NotNil(t, response.Keys[0].ExpireTime)
+	require.Equal(t, "v2", response.Keys[1].Id)
+	require.True(t, response.Keys[1].Current)
+	require.Nil(t, response.Keys[1].ExpireTime)
+	second := createToken()
+	require.NoError(t, authenticate(first))
+	require.NoError(t, authenticate(second))
+
+	// Without a grace period, they are rejected right away.
+	response, err = ts.Service.RotateAccessTokenSigningKey(hostCtx, &v1pb.RotateAccessTokenSigningKeyRequest{GracePeriod: durationpb.New(0)})
+	require.NoError(t, err)
+	require.Len(t, response.Keys, 1)
+	require.Equal(t, "v3", response.Keys[0].Id)
+	require.Equal(t, codes.Unauthenticated, status.Code(authenticate(first)))
+	require.Equal(t, codes.Unauthenticated, status.Code(authenticate(second)))
+	third := createToken()
+	require.NoError(t, authenticate(third))
+
+	_, err = ts.Service.RotateAccessTokenSigningKey(userCtx, &v1pb.RotateAccessTokenSigningKeyRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.RotateAccessTokenSigningKey(hostCtx, &v1pb.RotateAccessTokenSigningKeyRequest{GracePeriod: durationpb.New(-1)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/attachmentextract"
+)
+
+func TestExtractAttachments(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/audio/transcriptions":
+			require.NoError(t, r.ParseMultipartForm(1<<20))
+			require.Equal(t, "whisper-1", r.FormValue("model"))
+			_, _ = w.Write([]byte(`{"text": "Remember to renew the passport."}`))
+		case "/chat/completions":
+			// The image is sent to the vision of the model, downsized as a JPEG.
+			body := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			messages := body["messages"].([]any)
+			content := messages[len(messages)-1].(map[string]any)["content"].([]any)
+			imageURL := content[1].(map[string]any)["image_url"].(map[string]any)["url"].(string)
+			require.True(t, strings.HasPrefix(imageURL, "data:image/jpeg;base64,"))
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":     "completion",
+				"object": "chat.completion",
+				"model":  "gpt-4o-mini",
+				"choices": []map[string]any{{
+					"index":         0,
+					"finish_reason": "stop",
+					"message":       map[string]any{"role": "assistant", "content": "INVOICE 42\nA receipt from the hardware store."},
+				}},
+				"usage": map[string]any{"prompt_tokens": 10, "completion_tokens": 10, "total_tokens": 20},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer aiServer.Close()
+
+	aiSetting := &storepb.WorkspaceAISetting{
+		Endpoint:           aiServer.URL,
+		ApiKey:             "key",
+		Model:              "gpt-4o-mini",
+		TranscriptionModel: "whisper-1",
+	}
+	upsertAISetting := func() {
+		_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key:   storepb.WorkspaceSettingKey_AI_CONFIG,
+			Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: aiSetting},
+		})
+		require.NoError(t, err)
+	}
+	upsertAISetting()
+
+	var content bytes.Buffer
+	require.NoError(t, png.Encode(&content, image.NewRGBA(image.Rect(0, 0, 40, 20))))
+	photo, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "receipt.png", Type: "image/png", Content: content.Bytes()},
+	})
+	require.NoError(t, err)
+	recording, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "note.webm", Type: "audio/webm", Content: []byte("fake audio")},
+	})
+	require.NoError(t, err)
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:     "Errands of the day",
+		Attachments: []*v1pb.Attachment{{Name: photo.Name}, {Name: recording.Name}},
+	}})
+	require.NoError(t, err)
+
+	// Nothing is extracted until a modality is enabled.
+	runner := attachmentextract.NewRunner(ts.Store, ts.Service.ExtractAttachment)
+	require.NoError(t, runner.RunOnce(ctx))
+	text, err := ts.Service.GetAttachmentText(userCtx, &v1pb.GetAttachmentTextRequest{Name: photo.Name})
+	require.NoError(t, err)
+	require.Empty(t, text.Text)
+
+	aiSetting.AttachmentExtraction = &storepb.WorkspaceAISetting_AttachmentExtraction{
+		Images:             true,
+		Audio:              true,
+		IncludeInSummaries: true,
+		IncludeInSearch:    true,
+	}
+	upsertAISetting()
+	require.NoError(t, runner.RunOnce(ctx))
+	text, err = ts.Service.GetAttachmentText(userCtx, &v1pb.GetAttachmentTextRequest{Name: photo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "INVOICE 42\nA receipt from the hardware store.", text.Text)
+	text, err = ts.Service.GetAttachmentText(userCtx, &v1pb.GetAttachmentTextRequest{Name: recording.Name})
+	require.NoError(t, err)
+	require.Equal(t, "Remember to renew the passport.", text.Text)
+
+	// The extracted text is searchable.
+	memos, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: `content.contains("passport")`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	require.Equal(t, memo.Name, memos.Memos[0].Name)
+
+	// The extracted text is included in the summary prompts.
+	today := time.Now().UTC()
+	preview, err := ts.Service.PreviewAISummary(userCtx, &v1pb.GenerateAISummaryRequest{
+		TimeRange: "custom",
+		StartDate: today.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:   today.Format("2006-01-02"),
+	})
+	require.NoError(t, err)
+	require.Contains(t, preview.Prompt, "Errands of the day\n\n[Attachment receipt.png]\nINVOICE 42")
+	require.Contains(t, preview.Prompt, "[Attachment note.webm]\nRemember to renew the passport.")
+
+	aiSetting.AttachmentExtraction.IncludeInSummaries = false
+	upsertAISetting()
+	preview, err = ts.Service.PreviewAISummary(userCtx, &v1pb.GenerateAISummaryRequest{
+		TimeRange: "custom",
+		StartDate: today.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:   today.Format("2006-01-02"),
+	})
+	require.NoError(t, err)
+	require.NotContains(t, preview.Prompt, "INVOICE 42")
+}
@@ -0,0 +1,116 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestAIAuditLogs(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","object":"chat.completion","model":"gpt-4o-mini","choices":[{"index":0,"message":{"role":"assistant","content":"You planted tomatoes and watered the roses in the garden."}}],"usage":{"prompt_tokens":40,"completion_tokens":10,"total_tokens":50}}`))
+	}))
+	defer aiServer.Close()
+	configure := func(patterns ...string) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/AI_CONFIG",
+				Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+					Endpoint:  aiServer.URL,
+					ApiKey:    "secret-key",
+					Model:     "gpt-4o-mini",
+					Redaction: &v1pb.WorkspaceSetting_AISetting_Redaction{Patterns: patterns},
+				}},
+			},
+		})
+		return err
+	}
+	require.Equal(t, codes.InvalidArgument, status.Code(configure("(")))
+	require.NoError(t, configure())
+	_, err = ts.Service.TestAIConfig(hostCtx, &v1pb.TestAIConfigRequest{})
+	require.NoError(t, err)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planted tomatoes #garden"}})
+	require.NoError(t, err)
+	today := time.Now().UTC().Format("2006-01-02")
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today, Tags: []string{"garden"}})
+	require.NoError(t, err)
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "decade"})
+	require.Error(t, err)
+	// The source memos stay in the log once deleted.
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+
+	// Only admins may list the audit logs.
+	_, err = ts.Service.ListAIAuditLogs(userCtx, &v1pb.ListAIAuditLogsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	response, err := ts.Service.ListAIAuditLogs(hostCtx, &v1pb.ListAIAuditLogsRequest{})
+	require.NoError(t, err)
+	actions := []string{}
+	for _, auditLog := range response.AuditLogs {
+		actions = append(actions, auditLog.Action)
+	}
+	require.Equal(t, []string{"summary", "summary", "config_test", "config_update", "config_update"}, actions)
+
+	failed := response.AuditLogs[0]
+	require.Equal(t, fmt.Sprintf("users/%d", user.ID), failed.User)
+	require.False(t, failed.Success)
+	require.NotEmpty(t, failed.Error)
+	require.Equal(t, "decade", failed.Parameters["time_range"])
+	require.Empty(t, failed.SourceMemos)
+
+	summary := response.AuditLogs[1]
+	require.True(t, summary.Success)
+	require.Equal(t, []string{memo.Name}, summary.SourceMemos)
+	require.Equal(t, map[string]string{"time_range": "custom", "start_date": today, "end_date": today, "tags": "garden", "endpoint": aiServer.URL, "model": "gpt-4o-mini"}, summary.Parameters)
+
+	require.True(t, response.AuditLogs[2].Success)
+	require.Equal(t, fmt.Sprintf("users/%d", host.ID), response.AuditLogs[2].User)
+	configUpdate := response.AuditLogs[3]
+	require.True(t, configUpdate.Success)
+	require.Equal(t, aiServer.URL, configUpdate.Parameters["endpoint"])
+	for _, value := range configUpdate.Parameters {
+		require.NotContains(t, value, "secret-key")
+	}
+	require.False(t, response.AuditLogs[4].Success)
+
+	// The logs are filtered by user, action and outcome, and paginated.
+	success := false
+	response, err = ts.Service.ListAIAuditLogs(hostCtx, &v1pb.ListAIAuditLogsRequest{Success: &success})
+	require.NoError(t, err)
+	require.Len(t, response.AuditLogs, 2)
+	response, err = ts.Service.ListAIAuditLogs(hostCtx, &v1pb.ListAIAuditLogsRequest{User: fmt.Sprintf("users/%d", host.ID), Action: "config_update"})
+	require.NoError(t, err)
+	require.Len(t, response.AuditLogs, 2)
+	response, err = ts.Service.ListAIAuditLogs(hostCtx, &v1pb.ListAIAuditLogsRequest{PageSize: 3})
+	require.NoError(t, err)
+	require.Len(t, response.AuditLogs, 3)
+	require.NotEmpty(t, response.NextPageToken)
+	response, err = ts.Service.ListAIAuditLogs(hostCtx, &v1pb.ListAIAuditLogsRequest{PageToken: response.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, response.AuditLogs, 2)
+	require.Empty(t, response.NextPageToken)
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/aisummary"
+	"github.com/usememos/memos/store"
+)
+
+func TestScheduledAISummary(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	summary := "## Weekly digest\n\n- Shipped the release and reviewed the roadmap with the team, planning the next milestones together."
+	prompts := []string{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []struct {
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		prompts = append(prompts, body.Messages[len(body.Messages)-1].Content)
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": summary}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini", SummaryMinLength: 100},
+		},
+	})
+	require.NoError(t, err)
+
+	// The memos of the last days are summarized, not the ones of the day of the summary.
+	createMemo := func(content string, createdTs int64) {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}})
+		require.NoError(t, err)
+		storeMemo := getStoreMemo(ctx, t, ts, memo.Name)
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: storeMemo.ID, CreatedTs: &createdTs}))
+	}
+	now := time.Now().UTC()
+	createMemo("Shipped the release #work", now.AddDate(0, 0, -2).Unix())
+	createMemo("Planted tomatoes #garden", now.AddDate(0, 0, -2).Unix())
+	createMemo("Reviewed the roadmap #work", now.Unix())
+
+	settingName := fmt.Sprintf("users/%d/settings/AI_AUTO_SUMMARY", user.ID)
+	dayOfWeek := int32(now.Weekday())
+	if dayOfWeek == 0 {
+		dayOfWeek = 7
+	}
+	setting, err := ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name: settingName,
+			Value: &v1pb.UserSetting_AiAutoSummarySetting{AiAutoSummarySetting: &v1pb.UserSetting_AIAutoSummarySetting{
+				Enabled:       true,
+				FrequencyDays: 7,
+				DayOfWeek:     dayOfWeek,
+				Tags:          []string{"#work"},
+				Timezone:      "UTC",
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"enabled", "frequencyDays", "dayOfWeek", "tags", "timezone"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"work"}, setting.GetAiAutoSummarySetting().Tags)
+	require.Nil(t, setting.GetAiAutoSummarySetting().LastRunTime)
+
+	for _, invalid := range []*v1pb.UserSetting_AIAutoSummarySetting{{Hour: 24}, {DayOfWeek: 8}, {Timezone: "Mars/Olympus"}} {
+		_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting:    &v1pb.UserSetting{Name: settingName, Value: &v1pb.UserSetting_AiAutoSummarySetting{AiAutoSummarySetting: invalid}},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"hour", "dayOfWeek", "timezone"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	runner := aisummary.NewRunner(ts.Store, ts.Service.GenerateScheduledAISummary)
+	require.NoError(t, runner.RunOnce(ctx))
+	require.Len(t, prompts, 1)
+	require.Contains(t, prompts[0], "Shipped the release")
+	require.NotContains(t, prompts[0], "Planted tomatoes")
+	require.NotContains(t, prompts[0], "Reviewed the roadmap")
+	memos, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	aiMemos := []*v1pb.Memo{}
+	for _, memo := range memos.Memos {
+		if strings.Contains(memo.Content, "#AI") {
+			aiMemos = append(aiMemos, memo)
+		}
+	}
+	require.Len(t, aiMemos, 1)
+	require.Contains(t, aiMemos[0].Content, summary)
+
+	setting, err = ts.Service.GetUserSetting(userCtx, &v1pb.GetUserSettingRequest{Name: settingName})
+	require.NoError(t, err)
+	require.NotNil(t, setting.GetAiAutoSummarySetting().LastRunTime)
+	// The next summary is due in a week.
+	require.NoError(t, runner.RunOnce(ctx))
+	require.Len(t, prompts, 1)
+
+	// The summaries are disabled after consecutive failures.
+	summary = "Too short"
+	_, err = ts.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_AI_AUTO_SUMMARY,
+		Value:  &storepb.UserSetting_AiAutoSummary{AiAutoSummary: &storepb.AIAutoSummaryUserSetting{Enabled: true, TimeRangeDays: 7, FailureCount: 2}},
+	})
+	require.NoError(t, err)
+	require.Error(t, runner.RunOnce(ctx))
+	setting, err = ts.Service.GetUserSetting(userCtx, &v1pb.GetUserSettingRequest{Name: settingName})
+	require.NoError(t, err)
+	require.False(t, setting.GetAiAutoSummarySetting().Enabled)
+	require.Equal(t, int32(3), setting.GetAiAutoSummarySetting().FailureCount)
+}
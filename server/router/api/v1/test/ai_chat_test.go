@@ -0,0 +1,113 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestChatWithMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	// The fake model cites the first memo of the question and a memo it was not given.
+	type message struct {
+		Role    string `json:"role"`
+		Content string `json:"content"`
+	}
+	memoNamePattern := regexp.MustCompile(`\[memos/[^\]]+\]`)
+	requests := [][]message{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []message `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requests = append(requests, body.Messages)
+		answer := "You planted tomatoes " + memoNamePattern.FindString(body.Messages[len(body.Messages)-1].Content) + " [memos/unknown]."
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": answer}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"},
+		},
+	})
+	require.NoError(t, err)
+
+	garden, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planted tomatoes #garden"}})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Reviewed the budget #work"}})
+	require.NoError(t, err)
+
+	response, err := ts.Service.ChatWithMemos(userCtx, &v1pb.ChatWithMemosRequest{Question: "What did I plant?", Tags: []string{"garden"}})
+	require.NoError(t, err)
+	require.NotEmpty(t, response.ConversationId)
+	require.Equal(t, "You planted tomatoes ["+garden.Name+"] [memos/unknown].", response.Answer)
+	// Only the memos the answer was grounded in are cited.
+	require.Equal(t, []string{garden.Name}, response.Citations)
+	require.Len(t, requests[0], 2)
+	require.Contains(t, requests[0][1].Content, "Planted tomatoes #garden")
+	require.NotContains(t, requests[0][1].Content, "budget")
+	require.Contains(t, requests[0][1].Content, "Question: What did I plant?")
+
+	// A follow-up question is sent with the previous messages of the conversation.
+	followUp, err := ts.Service.ChatWithMemos(userCtx, &v1pb.ChatWithMemosRequest{Question: "And when?", ConversationId: response.ConversationId})
+	require.NoError(t, err)
+	require.Equal(t, response.ConversationId, followUp.ConversationId)
+	require.Len(t, requests[1], 4)
+	require.Equal(t, message{Role: "user", Content: "What did I plant?"}, requests[1][1])
+	require.Equal(t, message{Role: "assistant", Content: response.Answer}, requests[1][2])
+	require.Contains(t, requests[1][3].Content, "Question: And when?")
+
+	_, err = ts.Service.ChatWithMemos(otherCtx, &v1pb.ChatWithMemosRequest{Question: "And where?", ConversationId: response.ConversationId})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = ts.Service.ChatWithMemos(userCtx, &v1pb.ChatWithMemosRequest{Question: " "})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.ChatWithMemos(userCtx, &v1pb.ChatWithMemosRequest{Question: "What did I plant?", StartDate: "2025-02-01", EndDate: "2025-01-01"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{
+				Endpoint:        aiServer.URL,
+				ApiKey:          "key",
+				Model:           "gpt-4o-mini",
+				RolePermissions: map[string]*storepb.WorkspaceAISetting_RolePermission{"USER": {DisableChat: true}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.ChatWithMemos(userCtx, &v1pb.ChatWithMemosRequest{Question: "What did I plant?"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Len(t, requests, 2)
+}
@@ -0,0 +1,177 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+func TestAIOptIn(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: &storepb.WorkspaceAISetting{
+			Provider:                   storepb.WorkspaceAISetting_FAKE,
+			Model:                      "fake",
+			DataProcessingDisclosure:   "Your memos are processed by a fake provider.",
+			WorkspaceSummaryDailyLimit: 5,
+		}},
+	})
+	require.NoError(t, err)
+
+	aliceMemo, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Shipped the release", Visibility: v1pb.Visibility_PROTECTED}})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(bobCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planned the offsite", Visibility: v1pb.Visibility_PROTECTED}})
+	require.NoError(t, err)
+
+	// The users see the disclosure before opting in.
+	capabilities, err := ts.Service.GetWorkspaceCapabilities(aliceCtx, &v1pb.GetWorkspaceCapabilitiesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "Your memos are processed by a fake provider.", capabilities.Ai.DataProcessingDisclosure)
+	require.False(t, capabilities.Ai.OptedIn)
+
+	// The memos of the users who did not opt in are left out of the AI operations of the other users.
+	today := time.Now().UTC()
+	request := &v1pb.GenerateWorkspaceAISummaryRequest{
+		TimeRange: "custom",
+		StartDate: today.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:   today.Format("2006-01-02"),
+	}
+	_, err = ts.Service.GenerateWorkspaceAISummary(hostCtx, request)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	setting, err := ts.Service.UpdateUserSetting(aliceCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name:  fmt.Sprintf("users/%d/settings/GENERAL", alice.ID),
+			Value: &v1pb.UserSetting_GeneralSetting_{GeneralSetting: &v1pb.UserSetting_GeneralSetting{AiOptIn: true}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"aiOptIn"}},
+	})
+	require.NoError(t, err)
+	require.True(t, setting.GetGeneralSetting().AiOptIn)
+	capabilities, err = ts.Service.GetWorkspaceCapabilities(aliceCtx, &v1pb.GetWorkspaceCapabilitiesRequest{})
+	require.NoError(t, err)
+	require.True(t, capabilities.Ai.OptedIn)
+
+	summary, err := ts.Service.GenerateWorkspaceAISummary(hostCtx, request)
+	require.NoError(t, err)
+	sourceMemos, err := ts.Service.GetMemoSourceMemos(hostCtx, &v1pb.GetMemoSourceMemosRequest{Name: summary.Name})
+	require.NoError(t, err)
+	require.Len(t, sourceMemos.Memos, 1)
+	require.Equal(t, aliceMemo.Name, sourceMemos.Memos[0].Name)
+
+	// The AI operations of the users on their own memos do not need the opt-in.
+	_, err = ts.Service.GenerateAISummary(bobCtx, &v1pb.GenerateAISummaryRequest{
+		TimeRange: request.TimeRange,
+		StartDate: request.StartDate,
+		EndDate:   request.EndDate,
+	})
+	require.NoError(t, err)
+}
+
+func TestAIDisabled(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Shipped the release"}})
+	require.NoError(t, err)
+	settingName := fmt.Sprintf("users/%d/settings/AI_AUTO_SUMMARY", user.ID)
+	_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name:  settingName,
+			Value: &v1pb.UserSetting_AiAutoSummarySetting{AiAutoSummarySetting: &v1pb.UserSetting_AIAutoSummarySetting{FrequencyDays: 7}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"frequencyDays"}},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: &storepb.WorkspaceAISetting{
+			Provider: storepb.WorkspaceAISetting_FAKE,
+			Model:    "fake",
+			Disabled: true,
+		}},
+	})
+	require.NoError(t, err)
+
+	// The AI features are rejected, whatever the configured provider.
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "7d"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	capabilities, err := ts.Service.GetWorkspaceCapabilities(userCtx, &v1pb.GetWorkspaceCapabilitiesRequest{})
+	require.NoError(t, err)
+	require.False(t, capabilities.Ai.Enabled)
+	require.Empty(t, capabilities.Ai.Features)
+
+	// The AI settings are hidden from the users, the admins still see them to turn the AI back on.
+	aiSetting, err := ts.Service.GetWorkspaceSetting(userCtx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/AI_CONFIG"})
+	require.NoError(t, err)
+	require.True(t, aiSetting.GetAiSetting().Disabled)
+	require.Empty(t, aiSetting.GetAiSetting().Model)
+	aiSetting, err = ts.Service.GetWorkspaceSetting(hostCtx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/AI_CONFIG"})
+	require.NoError(t, err)
+	require.Equal(t, "fake", aiSetting.GetAiSetting().Model)
+	settings, err := ts.Service.ListUserSettings(userCtx, &v1pb.ListUserSettingsRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	for _, setting := range settings.Settings {
+		require.Nil(t, setting.GetAiAutoSummarySetting())
+	}
+	_, err = ts.Service.GetUserSetting(userCtx, &v1pb.GetUserSettingRequest{Name: settingName})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The interceptor rejects all the AI methods, except the audits and exports of the past AI interactions.
+	interceptor := apiv1.NewAIDisabledInterceptor(ts.Store)
+	okHandler := func(context.Context, any) (any, error) {
+		return &v1pb.Memo{}, nil
+	}
+	for method, allowed := range map[string]bool{
+		"/memos.api.v1.AIService/ListAIJobs":                 false,
+		"/memos.api.v1.AIService/ListPromptTemplates":        false,
+		"/memos.api.v1.MemoService/SearchMemosSemantic":      false,
+		"/memos.api.v1.AIService/ListAIAuditLogs":            true,
+		"/memos.api.v1.AIService/PurgeAIDebugLogs":           true,
+		"/memos.api.v1.MemoService/ListMemos":                true,
+		"/memos.api.v1.WorkspaceService/GetWorkspaceSetting": true,
+	} {
+		_, err := interceptor.AIDisabledInterceptor(userCtx, &v1pb.ListMemosRequest{}, &grpc.UnaryServerInfo{FullMethod: method}, okHandler)
+		if allowed {
+			require.NoError(t, err, method)
+		} else {
+			require.Equal(t, codes.FailedPrecondition, status.Code(err), method)
+		}
+	}
+}
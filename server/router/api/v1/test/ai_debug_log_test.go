@@ -0,0 +1,116 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestAIDebugLogs(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","object":"chat.completion","model":"gpt-4o-mini","choices":[{"index":0,"message":{"role":"assistant","content":"{\"tags\":[\"work\"]}"}}],"usage":{"prompt_tokens":40,"completion_tokens":10,"total_tokens":50}}`))
+	}))
+	defer aiServer.Close()
+	configure := func(debugLogging bool, retentionDays int32) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/AI_CONFIG",
+				Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+					Endpoint:              aiServer.URL,
+					ApiKey:                "key",
+					Model:                 "gpt-4o-mini",
+					DebugLogging:          debugLogging,
+					DebugLogRetentionDays: retentionDays,
+				}},
+			},
+		})
+		return err
+	}
+	suggest := func() {
+		_, err := ts.Service.SuggestMemoTags(userCtx, &v1pb.SuggestMemoTagsRequest{Content: "Weekly sync with the design team"})
+		require.NoError(t, err)
+	}
+
+	// The retention is at most 30 days.
+	require.Equal(t, codes.InvalidArgument, status.Code(configure(true, 31)))
+	require.Equal(t, codes.InvalidArgument, status.Code(configure(true, -1)))
+
+	// Nothing is stored while the debug logging is off.
+	require.NoError(t, configure(false, 0))
+	suggest()
+	response, err := ts.Service.ListAIDebugLogs(hostCtx, &v1pb.ListAIDebugLogsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.DebugLogs)
+
+	// The exact prompt and raw response are stored encrypted, and only admins may read them.
+	require.NoError(t, configure(true, 0))
+	suggest()
+	debugLogs, err := ts.Store.ListAIDebugLogs(ctx, &store.FindAIDebugLog{})
+	require.NoError(t, err)
+	require.Len(t, debugLogs, 1)
+	require.NotContains(t, string(debugLogs[0].Prompt), "Weekly sync")
+	require.NotContains(t, string(debugLogs[0].Response), "work")
+
+	_, err = ts.Service.ListAIDebugLogs(userCtx, &v1pb.ListAIDebugLogsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	response, err = ts.Service.ListAIDebugLogs(hostCtx, &v1pb.ListAIDebugLogsRequest{User: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Len(t, response.DebugLogs, 1)
+	debugLog := response.DebugLogs[0]
+	require.Equal(t, fmt.Sprintf("users/%d", user.ID), debugLog.User)
+	require.Equal(t, "tag_suggestion", debugLog.Operation)
+	require.Equal(t, "gpt-4o-mini", debugLog.Model)
+	require.True(t, debugLog.Success)
+	require.Contains(t, debugLog.Prompt, "Weekly sync with the design team")
+	require.Contains(t, debugLog.Prompt, `"responseSchema":"memo_tags"`)
+	require.Equal(t, `{"tags":["work"]}`, debugLog.Response)
+
+	// The logs past the retention are deleted.
+	_, err = ts.Store.CreateAIDebugLog(ctx, &store.AIDebugLog{
+		CreatedTs: time.Now().AddDate(0, 0, -8).Unix(),
+		Operation: "summary",
+		Prompt:    []byte("expired"),
+		Response:  []byte("expired"),
+	})
+	require.NoError(t, err)
+	response, err = ts.Service.ListAIDebugLogs(hostCtx, &v1pb.ListAIDebugLogsRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.DebugLogs, 1)
+
+	// The stored prompts may be purged.
+	_, err = ts.Service.PurgeAIDebugLogs(userCtx, &v1pb.PurgeAIDebugLogsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	purged, err := ts.Service.PurgeAIDebugLogs(hostCtx, &v1pb.PurgeAIDebugLogsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), purged.PurgedCount)
+
+	// Turning the debug logging off deletes the stored prompts.
+	suggest()
+	require.NoError(t, configure(false, 0))
+	debugLogs, err = ts.Store.ListAIDebugLogs(ctx, &store.FindAIDebugLog{})
+	require.NoError(t, err)
+	require.Empty(t, debugLogs)
+}
@@ -0,0 +1,99 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestAIProviderErrors(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	summary := strings.Repeat("This week was spent planning the garden. ", 4)
+	// respond writes the response of each call in turn, the last one for the calls beyond them.
+	var responses []func(w http.ResponseWriter)
+	calls := 0
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		respond := responses[min(calls, len(responses)-1)]
+		calls++
+		respond(w)
+	}))
+	defer aiServer.Close()
+	fail := func(statusCode int, retryAfter string) func(w http.ResponseWriter) {
+		return func(w http.ResponseWriter) {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(statusCode)
+			_, _ = w.Write([]byte(`{"error":"Anfrage abgelehnt"}`))
+		}
+	}
+	succeed := func(w http.ResponseWriter) {
+		_, _ = w.Write([]byte(`{"message":{"role":"assistant","content":"` + summary + `"},"done":true}`))
+	}
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: &storepb.WorkspaceAISetting{
+			Provider:     storepb.WorkspaceAISetting_OLLAMA,
+			Endpoint:     aiServer.URL,
+			Model:        "llama3.2",
+			SystemPrompt: "Summarize my week.",
+		}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planned the garden #home"}})
+	require.NoError(t, err)
+	today := time.Now().UTC()
+	generate := func() error {
+		_, err := ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{
+			TimeRange: "custom",
+			StartDate: today.AddDate(0, 0, -1).Format("2006-01-02"),
+			EndDate:   today.Format("2006-01-02"),
+		})
+		return err
+	}
+
+	// The rate limited calls are retried after the delay the provider asked for.
+	responses, calls = []func(w http.ResponseWriter){fail(http.StatusTooManyRequests, "0.01"), succeed}, 0
+	require.NoError(t, generate())
+	require.Equal(t, 2, calls)
+
+	// The calls are not retried when the provider asks to wait too long, and the delay is returned.
+	responses, calls = []func(w http.ResponseWriter){fail(http.StatusTooManyRequests, "120")}, 0
+	err = generate()
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Equal(t, 1, calls)
+	var retryInfo *errdetails.RetryInfo
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			retryInfo = info
+		}
+	}
+	require.NotNil(t, retryInfo)
+	require.Equal(t, 120*time.Second, retryInfo.RetryDelay.AsDuration())
+
+	// The other failures are classified by their status code, whatever their message, and not retried.
+	responses, calls = []func(w http.ResponseWriter){fail(http.StatusUnauthorized, "")}, 0
+	require.Equal(t, codes.FailedPrecondition, status.Code(generate()))
+	responses, calls = []func(w http.ResponseWriter){fail(http.StatusBadRequest, "")}, 0
+	require.Equal(t, codes.InvalidArgument, status.Code(generate()))
+	require.Equal(t, 1, calls)
+}
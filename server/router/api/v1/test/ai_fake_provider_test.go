@@ -0,0 +1,60 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestFakeAIProvider(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+
+	// The fake provider needs neither an endpoint nor an API key.
+	fakeSetting := &v1pb.WorkspaceSetting{
+		Name: "workspace/settings/AI_CONFIG",
+		Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+			Provider: v1pb.WorkspaceSetting_AISetting_FAKE,
+			Model:    "fake",
+		}},
+	}
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{Setting: fakeSetting})
+	require.NoError(t, err)
+
+	response, err := ts.Service.SuggestMemoTags(hostCtx, &v1pb.SuggestMemoTagsRequest{Content: "Weekly sync"})
+	require.NoError(t, err)
+	require.Len(t, response.Suggestions, 1)
+	require.Equal(t, "fake", response.Suggestions[0].Tag)
+
+	// The failures are injected by markers in the prompts. The rate limits asking to wait longer than the retries
+	// are surfaced at once, with the delay in the details of the error.
+	_, err = ts.Service.SuggestMemoTags(hostCtx, &v1pb.SuggestMemoTagsRequest{Content: "Weekly sync [fake:rate_limit:3600]"})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	var retryInfo *errdetails.RetryInfo
+	for _, detail := range status.Convert(err).Details() {
+		if detail, ok := detail.(*errdetails.RetryInfo); ok {
+			retryInfo = detail
+		}
+	}
+	require.NotNil(t, retryInfo)
+	require.Equal(t, int64(3600), retryInfo.RetryDelay.Seconds)
+	_, err = ts.Service.SuggestMemoTags(hostCtx, &v1pb.SuggestMemoTagsRequest{Content: "Weekly sync [fake:timeout]"})
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// The fake provider is not available in the prod mode.
+	ts.Profile.Mode = "prod"
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{Setting: fakeSetting})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
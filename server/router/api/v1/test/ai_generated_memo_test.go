@@ -0,0 +1,87 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestAIGeneratedMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": strings.Repeat("You read about AI. ", 6)}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"},
+		},
+	})
+	require.NoError(t, err)
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	// A memo of the user mentioning the AI tag is neither an AI memo nor left out of the summaries.
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Read a paper on #AI"}})
+	require.NoError(t, err)
+	require.False(t, memo.Property.IsAiGenerated)
+	memo.Content = "Read two papers on #AI"
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: memo, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}}})
+	require.NoError(t, err)
+
+	today := time.Now().UTC().Format("2006-01-02")
+	request := &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today}
+	summary, err := ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.True(t, summary.Property.IsAiGenerated)
+	sources, err := ts.Service.GetMemoSourceMemos(userCtx, &v1pb.GetMemoSourceMemosRequest{Name: summary.Name})
+	require.NoError(t, err)
+	require.Len(t, sources.Memos, 1)
+	require.Equal(t, memo.Name, sources.Memos[0].Name)
+
+	// The summaries are not summarized again, and cannot be edited.
+	second, err := ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	sources, err = ts.Service.GetMemoSourceMemos(userCtx, &v1pb.GetMemoSourceMemosRequest{Name: second.Name})
+	require.NoError(t, err)
+	require.Len(t, sources.Memos, 1)
+	summary.Content = "Edited summary"
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: summary, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The AI memos can be filtered on.
+	memos, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: "is_ai_generated"})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 2)
+	memos, err = ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: "!is_ai_generated"})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	require.Equal(t, memo.Name, memos.Memos[0].Name)
+}
@@ -0,0 +1,107 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestExportAIInteractions(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","object":"chat.completion","model":"gpt-4o-mini","choices":[{"index":0,"message":{"role":"assistant","content":"You planted tomatoes in the garden."}}],"usage":{"prompt_tokens":40,"completion_tokens":10,"total_tokens":50}}`))
+	}))
+	defer aiServer.Close()
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/AI_CONFIG",
+			Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+				Endpoint: aiServer.URL,
+				ApiKey:   "secret-key",
+				Model:    "gpt-4o-mini",
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planted tomatoes #garden"}})
+	require.NoError(t, err)
+	today := time.Now().UTC().Format("2006-01-02")
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today})
+	require.NoError(t, err)
+
+	_, err = ts.Service.ExportAIInteractions(ctx, &v1pb.ExportAIInteractionsRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	type exportedInteractions struct {
+		User         string `json:"user"`
+		Interactions []struct {
+			Action      string   `json:"action"`
+			Endpoint    string   `json:"endpoint"`
+			Model       string   `json:"model"`
+			SourceMemos []string `json:"source_memos"`
+			Success     bool     `json:"success"`
+		} `json:"interactions"`
+		ProviderCalls []struct {
+			Operation        string `json:"operation"`
+			Model            string `json:"model"`
+			PromptTokens     int64  `json:"prompt_tokens"`
+			CompletionTokens int64  `json:"completion_tokens"`
+		} `json:"provider_calls"`
+	}
+	body, err := ts.Service.ExportAIInteractions(userCtx, &v1pb.ExportAIInteractionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "application/json", body.ContentType)
+	var export exportedInteractions
+	require.NoError(t, json.Unmarshal(body.Data, &export))
+	require.Equal(t, fmt.Sprintf("users/%d", user.ID), export.User)
+	require.Len(t, export.Interactions, 1)
+	require.Equal(t, "summary", export.Interactions[0].Action)
+	require.Equal(t, aiServer.URL, export.Interactions[0].Endpoint)
+	require.Equal(t, "gpt-4o-mini", export.Interactions[0].Model)
+	require.Equal(t, []string{memo.Name}, export.Interactions[0].SourceMemos)
+	require.True(t, export.Interactions[0].Success)
+	require.Len(t, export.ProviderCalls, 1)
+	require.Equal(t, "summary", export.ProviderCalls[0].Operation)
+	require.Equal(t, int64(40), export.ProviderCalls[0].PromptTokens)
+	require.Equal(t, int64(10), export.ProviderCalls[0].CompletionTokens)
+
+	// The export only has the interactions of the current user, without the changes of the configuration.
+	body, err = ts.Service.ExportAIInteractions(hostCtx, &v1pb.ExportAIInteractionsRequest{})
+	require.NoError(t, err)
+	export = exportedInteractions{}
+	require.NoError(t, json.Unmarshal(body.Data, &export))
+	require.Empty(t, export.Interactions)
+	require.Empty(t, export.ProviderCalls)
+
+	// The interactions can be narrowed down to a time range.
+	body, err = ts.Service.ExportAIInteractions(userCtx, &v1pb.ExportAIInteractionsRequest{EndTime: timestamppb.New(time.Now().Add(-time.Hour))})
+	require.NoError(t, err)
+	export = exportedInteractions{}
+	require.NoError(t, json.Unmarshal(body.Data, &export))
+	require.Empty(t, export.Interactions)
+	require.Empty(t, export.ProviderCalls)
+}
@@ -0,0 +1,134 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/runner/aijob"
+	"github.com/usememos/memos/store"
+)
+
+func TestAIJobs(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	var failing atomic.Bool
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"message":"invalid request","type":"invalid_request_error"}}`))
+			return
+		}
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": strings.Repeat("You planned the garden. ", 5)}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"},
+		},
+	})
+	require.NoError(t, err)
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	for _, content := range []string{"Planned the garden", "Bought seeds"} {
+		_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}})
+		require.NoError(t, err)
+	}
+	today := time.Now().UTC().Format("2006-01-02")
+	request := &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today}
+
+	// Invalid requests are rejected before being queued.
+	_, err = ts.Service.EnqueueAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "1y"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	job, err := ts.Service.EnqueueAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, v1pb.AIJob_QUEUED, job.State)
+	require.Empty(t, job.Memo)
+
+	runner := aijob.NewRunner(ts.Store, ts.Service.RunAIJob)
+	require.NoError(t, runner.RunOnce(ctx))
+	job, err = ts.Service.GetAIJob(userCtx, &v1pb.GetAIJobRequest{Name: job.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.AIJob_DONE, job.State)
+	memo, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: job.Memo})
+	require.NoError(t, err)
+	require.Contains(t, memo.Content, "You planned the garden.")
+
+	failing.Store(true)
+	failedJob, err := ts.Service.EnqueueAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.NoError(t, runner.RunOnce(ctx))
+	failedJob, err = ts.Service.GetAIJob(userCtx, &v1pb.GetAIJobRequest{Name: failedJob.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.AIJob_FAILED, failedJob.State)
+	require.Contains(t, failedJob.Error, "failed to generate AI summary")
+
+	// A job left running by a restart is queued again and processed.
+	failing.Store(false)
+	interruptedJob, err := ts.Service.EnqueueAISummary(userCtx, request)
+	require.NoError(t, err)
+	interruptedJobID, err := apiv1.ExtractAIJobIDFromName(interruptedJob.Name)
+	require.NoError(t, err)
+	running := store.AIJobStatusRunning
+	require.NoError(t, ts.Store.UpdateAIJob(ctx, &store.UpdateAIJob{ID: interruptedJobID, Status: &running}))
+	require.NoError(t, runner.RunOnce(ctx))
+	interruptedJob, err = ts.Service.GetAIJob(userCtx, &v1pb.GetAIJobRequest{Name: interruptedJob.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.AIJob_DONE, interruptedJob.State)
+
+	response, err := ts.Service.ListAIJobs(userCtx, &v1pb.ListAIJobsRequest{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, response.Jobs, 2)
+	require.Equal(t, interruptedJob.Name, response.Jobs[0].Name)
+	require.NotEmpty(t, response.NextPageToken)
+	response, err = ts.Service.ListAIJobs(userCtx, &v1pb.ListAIJobsRequest{PageToken: response.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, response.Jobs, 1)
+	require.Equal(t, job.Name, response.Jobs[0].Name)
+
+	// The jobs of other users are not visible.
+	otherUser, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, otherUser.ID)
+	_, err = ts.Service.GetAIJob(otherCtx, &v1pb.GetAIJobRequest{Name: job.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	response, err = ts.Service.ListAIJobs(otherCtx, &v1pb.ListAIJobsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.Jobs)
+
+	// A user cannot queue more than a few jobs at once.
+	for range 3 {
+		_, err = ts.Service.EnqueueAISummary(otherCtx, request)
+		require.NoError(t, err)
+	}
+	_, err = ts.Service.EnqueueAISummary(otherCtx, request)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
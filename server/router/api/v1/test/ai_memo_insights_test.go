@@ -0,0 +1,106 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestGenerateMemoInsights(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memoNumber := func(prompt, content string) int {
+		match := regexp.MustCompile(`\[Memo (\d+)\]\n` + regexp.QuoteMeta(content)).FindStringSubmatch(prompt)
+		require.NotNil(t, match, content)
+		var number int
+		_, err := fmt.Sscan(match[1], &number)
+		require.NoError(t, err)
+		return number
+	}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []struct {
+				Content string `json:"content"`
+			} `json:"messages"`
+			ResponseFormat struct {
+				Type string `json:"type"`
+			} `json:"response_format"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "json_schema", body.ResponseFormat.Type)
+		prompt := body.Messages[len(body.Messages)-1].Content
+		report, database := memoNumber(prompt, "Send the report"), memoNumber(prompt, "We chose PostgreSQL")
+		reply, err := json.Marshal(map[string]any{
+			"action_items": []map[string]any{
+				{"text": "Send the report to Alice", "due_hint": "by Friday", "memos": []int{report, report, 9}},
+				{"text": " ", "due_hint": "", "memos": []int{}},
+			},
+			"decisions":      []map[string]any{{"text": "Use PostgreSQL", "memos": []int{database}}},
+			"open_questions": []map[string]any{{"text": "Who owns the migration?", "memos": []int{database}}},
+			"topics":         []map[string]any{{"name": "Database", "description": "The choice of the database", "memos": []int{report, database}}},
+		})
+		require.NoError(t, err)
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": string(reply)}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o"},
+		},
+	})
+	require.NoError(t, err)
+
+	report, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Send the report to Alice by Friday, it covers the database."}})
+	require.NoError(t, err)
+	database, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "We chose PostgreSQL. Who owns the migration?"}})
+	require.NoError(t, err)
+
+	today := time.Now().UTC().Format("2006-01-02")
+	insights, err := ts.Service.GenerateMemoInsights(userCtx, &v1pb.GenerateMemoInsightsRequest{TimeRange: "custom", StartDate: today, EndDate: today})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{report.Name, database.Name}, insights.SourceMemos)
+
+	// The items without text are dropped, and so are the references to memos out of the source memos.
+	require.Len(t, insights.ActionItems, 1)
+	require.Equal(t, "Send the report to Alice", insights.ActionItems[0].Text)
+	require.Equal(t, "by Friday", insights.ActionItems[0].DueHint)
+	require.Equal(t, []string{report.Name}, insights.ActionItems[0].SourceMemos)
+	require.Len(t, insights.Decisions, 1)
+	require.Equal(t, []string{database.Name}, insights.Decisions[0].SourceMemos)
+	require.Len(t, insights.OpenQuestions, 1)
+	require.Equal(t, "Who owns the migration?", insights.OpenQuestions[0].Text)
+	require.Len(t, insights.Topics, 1)
+	require.Equal(t, "Database", insights.Topics[0].Name)
+	require.Equal(t, []string{report.Name, database.Name}, insights.Topics[0].SourceMemos)
+
+	usage, err := ts.Store.GetWorkspaceAIUsage(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), usage.Tokens)
+}
@@ -0,0 +1,113 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestAIOperationModels(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+
+	summary := strings.Repeat("This week was spent planning the garden. ", 4)
+	var requests []map[string]any
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requests = append(requests, body)
+		w.Header().Set("Content-Type", "application/json")
+		if body["model"] == "gpt-unknown" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"message":"The model does not exist","type":"invalid_request_error"}}`))
+			return
+		}
+		response, err := json.Marshal(map[string]any{
+			"id": "1", "object": "chat.completion", "model": body["model"],
+			"choices": []any{map[string]any{"index": 0, "message": map[string]any{"role": "assistant", "content": summary}}},
+			"usage":   map[string]any{"prompt_tokens": 20, "completion_tokens": 10, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+
+	updateAISetting := func(aiSetting *v1pb.WorkspaceSetting_AISetting) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name:  "workspace/settings/AI_CONFIG",
+				Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: aiSetting},
+			},
+		})
+		return err
+	}
+	aiSetting := &v1pb.WorkspaceSetting_AISetting{
+		Endpoint:     aiServer.URL,
+		ApiKey:       "key",
+		Model:        "gpt-4o-mini",
+		SummaryModel: "gpt-4o",
+		ChatModel:    "gpt-unknown",
+		VisionModel:  "gpt-4o-mini",
+		Temperature:  proto.Float64(0.2),
+		MaxTokens:    500,
+	}
+	require.NoError(t, updateAISetting(aiSetting))
+
+	// Each configured model is tested, the vision model with an image even though it is the default model too.
+	response, err := ts.Service.TestAIConfig(hostCtx, &v1pb.TestAIConfigRequest{})
+	require.NoError(t, err)
+	require.False(t, response.Success)
+	require.Len(t, requests, 4)
+	results := map[string]*v1pb.TestAIConfigResponse_ModelResult{}
+	for _, result := range response.ModelResults {
+		results[result.Operation] = result
+	}
+	require.Len(t, results, 4)
+	require.True(t, results["default"].Success)
+	require.True(t, results["summary"].Success)
+	require.Equal(t, "gpt-4o", results["summary"].Model)
+	require.True(t, results["vision"].Success)
+	require.False(t, results["chat"].Success)
+	require.Equal(t, "gpt-unknown", results["chat"].Model)
+	require.Equal(t, results["chat"].ErrorMessage, response.ErrorMessage)
+
+	// The summaries use the summary model with the generation options.
+	requests = nil
+	_, err = ts.Service.CreateMemo(hostCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planned the garden #home"}})
+	require.NoError(t, err)
+	today := time.Now().UTC()
+	_, err = ts.Service.GenerateAISummary(hostCtx, &v1pb.GenerateAISummaryRequest{
+		TimeRange: "custom",
+		StartDate: today.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:   today.Format("2006-01-02"),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, requests)
+	require.Equal(t, "gpt-4o", requests[0]["model"])
+	require.Equal(t, 0.2, requests[0]["temperature"])
+	require.Equal(t, float64(500), requests[0]["max_completion_tokens"])
+
+	// The generation options are validated.
+	aiSetting.Temperature = proto.Float64(3)
+	require.Equal(t, codes.InvalidArgument, status.Code(updateAISetting(aiSetting)))
+	aiSetting.Temperature = nil
+	aiSetting.MaxTokens = -1
+	require.Equal(t, codes.InvalidArgument, status.Code(updateAISetting(aiSetting)))
+}
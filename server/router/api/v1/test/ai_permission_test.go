@@ -0,0 +1,104 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestAIRolePermissions(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Unknown roles are rejected.
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/AI_CONFIG",
+			Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+				RolePermissions: map[string]*v1pb.WorkspaceSetting_AISetting_RolePermission{"GUEST": {DisableSummary: true}},
+			}},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/AI_CONFIG",
+			Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+				RolePermissions: map[string]*v1pb.WorkspaceSetting_AISetting_RolePermission{
+					"USER": {DisableSummary: true, DisableVoiceMemo: true},
+				},
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	// The features are denied to the role before the configuration is even looked at.
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "7d"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.CreateVoiceMemo(userCtx, &v1pb.CreateVoiceMemoRequest{
+		Audio: &v1pb.Attachment{Filename: "recording.webm", Type: "audio/webm", Content: []byte("fake audio")},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.SynthesizeMemoAudio(userCtx, &v1pb.SynthesizeMemoAudioRequest{DigestDate: "2025-01-01"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Roles without permissions can use all features.
+	_, err = ts.Service.GenerateAISummary(hostCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "7d"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestAIDisallowProtectedMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: &storepb.WorkspaceAISetting{
+			Endpoint:               "http://127.0.0.1:1",
+			ApiKey:                 "key",
+			Model:                  "gpt-4o-mini",
+			TtsModel:               "tts-1",
+			DisallowProtectedMemos: true,
+		}},
+	})
+	require.NoError(t, err)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Shared with the workspace", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+
+	// Protected memos are neither summarized nor synthesized.
+	today := time.Now().UTC()
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{
+		TimeRange: "custom",
+		StartDate: today.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:   today.Format("2006-01-02"),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = ts.Service.SynthesizeMemoAudio(userCtx, &v1pb.SynthesizeMemoAudioRequest{Memo: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,103 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestAIProfileRouting(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+
+	newAIServer := func(calls *[]string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*calls = append(*calls, r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"1","object":"chat.completion","model":"model","choices":[{"index":0,"message":{"role":"assistant","content":"{\"tags\":[\"work\"]}"}}],"usage":{"total_tokens":5}}`))
+		}))
+	}
+	var defaultCalls, cheapCalls []string
+	defaultServer, cheapServer := newAIServer(&defaultCalls), newAIServer(&cheapCalls)
+	defer defaultServer.Close()
+	defer cheapServer.Close()
+	updateAISetting := func(aiSetting *v1pb.WorkspaceSetting_AISetting) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name:  "workspace/settings/AI_CONFIG",
+				Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: aiSetting},
+			},
+		})
+		return err
+	}
+	aiSetting := &v1pb.WorkspaceSetting_AISetting{
+		Endpoint: defaultServer.URL,
+		ApiKey:   "key",
+		Model:    "gpt-4o",
+		Profiles: []*v1pb.WorkspaceSetting_AISetting_Profile{
+			{Name: "cheap", Endpoint: cheapServer.URL, ApiKey: "cheap-key", Model: "gpt-4o-mini"},
+		},
+		FeatureProfiles: map[string]string{"TAG_SUGGESTION": "cheap"},
+	}
+	require.NoError(t, updateAISetting(aiSetting))
+
+	// The API keys are only returned to the admins.
+	getAISetting := func(ctx context.Context) *v1pb.WorkspaceSetting_AISetting {
+		setting, err := ts.Service.GetWorkspaceSetting(ctx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/AI_CONFIG"})
+		require.NoError(t, err)
+		return setting.GetAiSetting()
+	}
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	for _, ctx := range []context.Context{ctx, ts.CreateUserContext(ctx, user.ID)} {
+		masked := getAISetting(ctx)
+		require.Empty(t, masked.ApiKey)
+		require.Len(t, masked.Profiles, 1)
+		require.Equal(t, "cheap", masked.Profiles[0].Name)
+		require.Empty(t, masked.Profiles[0].ApiKey)
+	}
+	require.Equal(t, "cheap-key", getAISetting(hostCtx).Profiles[0].ApiKey)
+
+	// The tag suggestions are routed to the profile, the other features use the default provider.
+	_, err = ts.Service.SuggestMemoTags(hostCtx, &v1pb.SuggestMemoTagsRequest{Content: "Weekly sync"})
+	require.NoError(t, err)
+	require.Len(t, cheapCalls, 1)
+	require.Empty(t, defaultCalls)
+	response, err := ts.Service.TestAIConfig(hostCtx, &v1pb.TestAIConfigRequest{})
+	require.NoError(t, err)
+	require.True(t, response.Success)
+	require.Len(t, defaultCalls, 1)
+	response, err = ts.Service.TestAIConfig(hostCtx, &v1pb.TestAIConfigRequest{Profile: "cheap"})
+	require.NoError(t, err)
+	require.True(t, response.Success)
+	require.Len(t, cheapCalls, 2)
+
+	// Each provider is monitored on its own.
+	providerStatus, err := ts.Service.GetAIProviderStatus(hostCtx, &v1pb.GetAIProviderStatusRequest{Profile: "cheap"})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), providerStatus.RecentCalls)
+	_, err = ts.Service.GetAIProviderStatus(hostCtx, &v1pb.GetAIProviderStatusRequest{Profile: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// The features may only be routed to the existing profiles.
+	aiSetting.FeatureProfiles = map[string]string{"SUMMARY": "strong"}
+	require.Equal(t, codes.InvalidArgument, status.Code(updateAISetting(aiSetting)))
+	aiSetting.FeatureProfiles = map[string]string{"SPEECH": "cheap"}
+	require.Equal(t, codes.InvalidArgument, status.Code(updateAISetting(aiSetting)))
+	aiSetting.FeatureProfiles = nil
+	aiSetting.Profiles = append(aiSetting.Profiles, &v1pb.WorkspaceSetting_AISetting_Profile{Name: "cheap"})
+	require.Equal(t, codes.InvalidArgument, status.Code(updateAISetting(aiSetting)))
+}
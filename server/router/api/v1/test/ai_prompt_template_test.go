@@ -0,0 +1,131 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestAIPromptTemplates(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	systemPrompts := []string{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []struct {
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		systemPrompts = append(systemPrompts, body.Messages[0].Content)
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": strings.Repeat("The release shipped on time. ", 5)}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini", SystemPrompt: "Workspace prompt."},
+		},
+	})
+	require.NoError(t, err)
+
+	// Only admins manage the workspace templates, and the variables must be known.
+	_, err = ts.Service.UpsertPromptTemplate(userCtx, &v1pb.UpsertPromptTemplateRequest{Template: &v1pb.PromptTemplate{Name: "team", Workspace: true, Content: "Team prompt."}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.UpsertPromptTemplate(hostCtx, &v1pb.UpsertPromptTemplateRequest{Template: &v1pb.PromptTemplate{Name: "team", Workspace: true, Content: "Summarize {{author}}."}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.UpsertPromptTemplate(hostCtx, &v1pb.UpsertPromptTemplateRequest{Template: &v1pb.PromptTemplate{Name: "team/weekly", Workspace: true, Content: "Team prompt."}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	for _, template := range []*v1pb.PromptTemplate{
+		{Name: "team", Workspace: true, Description: "For the team", Content: "Team summary of {{memo_count}} memos from {{date_range}} tagged {{tag_list}}."},
+		{Name: "standup", Workspace: true, Content: "Standup summary."},
+	} {
+		_, err := ts.Service.UpsertPromptTemplate(hostCtx, &v1pb.UpsertPromptTemplateRequest{Template: template})
+		require.NoError(t, err)
+	}
+	upserted, err := ts.Service.UpsertPromptTemplate(userCtx, &v1pb.UpsertPromptTemplateRequest{Template: &v1pb.PromptTemplate{Name: "standup", Content: "My standup summary."}})
+	require.NoError(t, err)
+	require.False(t, upserted.Workspace)
+	require.NotNil(t, upserted.UpdateTime)
+
+	// The templates of the user come first.
+	response, err := ts.Service.ListPromptTemplates(userCtx, &v1pb.ListPromptTemplatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Templates, 3)
+	require.Equal(t, "standup", response.Templates[0].Name)
+	require.False(t, response.Templates[0].Workspace)
+	require.Equal(t, "standup", response.Templates[1].Name)
+	require.Equal(t, "team", response.Templates[2].Name)
+	require.Equal(t, "For the team", response.Templates[2].Description)
+	response, err = ts.Service.ListPromptTemplates(hostCtx, &v1pb.ListPromptTemplatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Templates, 2)
+
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Shipped the release #work"}})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planned the next one #work"}})
+	require.NoError(t, err)
+	today := time.Now().UTC().Format("2006-01-02")
+	request := &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today, Tags: []string{"work"}}
+
+	// The variables of the template are replaced, and it is used instead of the workspace system prompt.
+	request.PromptTemplate = "team"
+	preview, err := ts.Service.PreviewAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Contains(t, preview.Prompt, "Team summary of 2 memos from "+today+" to "+today+" tagged #work.")
+	require.NotContains(t, preview.Prompt, "Workspace prompt.")
+	_, err = ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, "Team summary of 2 memos from "+today+" to "+today+" tagged #work.", systemPrompts[0])
+
+	// The template of the user takes precedence over the workspace one with the same name.
+	request.PromptTemplate = "standup"
+	_, err = ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, "My standup summary.", systemPrompts[1])
+
+	request.PromptTemplate = "missing"
+	_, err = ts.Service.PreviewAISummary(userCtx, request)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = ts.Service.DeletePromptTemplate(userCtx, &v1pb.DeletePromptTemplateRequest{Name: "team", Workspace: true})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DeletePromptTemplate(userCtx, &v1pb.DeletePromptTemplateRequest{Name: "standup"})
+	require.NoError(t, err)
+	_, err = ts.Service.DeletePromptTemplate(userCtx, &v1pb.DeletePromptTemplateRequest{Name: "standup"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	response, err = ts.Service.ListPromptTemplates(userCtx, &v1pb.ListPromptTemplatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Templates, 2)
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestAIProviderCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	failing, calls := true, 0
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if failing {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"message":{"role":"assistant","content":"{\"tags\":[\"work\"]}"},"done":true}`))
+	}))
+	defer aiServer.Close()
+	configure := func() {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/AI_CONFIG",
+				Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+					Provider: v1pb.WorkspaceSetting_AISetting_OLLAMA,
+					Endpoint: aiServer.URL,
+					Model:    "llama3.2",
+				}},
+			},
+		})
+		require.NoError(t, err)
+	}
+	configure()
+	suggest := func() error {
+		_, err := ts.Service.SuggestMemoTags(userCtx, &v1pb.SuggestMemoTagsRequest{Content: "Weekly sync"})
+		return err
+	}
+
+	// The failures of the provider are Unavailable errors.
+	for range 5 {
+		require.Equal(t, codes.Unavailable, status.Code(suggest()))
+	}
+	// The provider is deemed unavailable, the requests fail fast without calling it.
+	err = suggest()
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Contains(t, err.Error(), "AI provider unavailable")
+	require.Equal(t, 5, calls)
+
+	_, err = ts.Service.GetAIProviderStatus(userCtx, &v1pb.GetAIProviderStatusRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	providerStatus, err := ts.Service.GetAIProviderStatus(hostCtx, &v1pb.GetAIProviderStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.AIProviderStatus_OPEN, providerStatus.CircuitState)
+	require.Equal(t, int32(5), providerStatus.RecentCalls)
+	require.Equal(t, int32(5), providerStatus.RecentFailures)
+	require.Contains(t, providerStatus.LastError, "502")
+	require.NotNil(t, providerStatus.LastErrorTime)
+	require.NotNil(t, providerStatus.RetryTime)
+
+	// Configuring the provider again closes the circuit.
+	failing = false
+	configure()
+	require.NoError(t, suggest())
+	providerStatus, err = ts.Service.GetAIProviderStatus(hostCtx, &v1pb.GetAIProviderStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.AIProviderStatus_CLOSED, providerStatus.CircuitState)
+	require.Equal(t, int32(1), providerStatus.RecentCalls)
+	require.Equal(t, int32(0), providerStatus.RecentFailures)
+}
+
+func TestAIProviderCircuitBreakerSetting(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+
+	calls := 0
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer aiServer.Close()
+	configure := func(failures, cooldownSeconds, maxConcurrentRequests int32) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/AI_CONFIG",
+				Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+					Provider:                      v1pb.WorkspaceSetting_AISetting_OLLAMA,
+					Endpoint:                      aiServer.URL,
+					Model:                         "llama3.2",
+					CircuitBreakerFailures:        failures,
+					CircuitBreakerCooldownSeconds: cooldownSeconds,
+					MaxConcurrentRequests:         maxConcurrentRequests,
+				}},
+			},
+		})
+		return err
+	}
+	require.Equal(t, codes.InvalidArgument, status.Code(configure(-1, 0, 0)))
+	require.Equal(t, codes.InvalidArgument, status.Code(configure(0, 0, -1)))
+	require.NoError(t, configure(2, 600, 3))
+
+	// The circuit opens after the configured number of consecutive failures, for the configured cooldown.
+	for range 2 {
+		_, err := ts.Service.SuggestMemoTags(hostCtx, &v1pb.SuggestMemoTagsRequest{Content: "Weekly sync"})
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	}
+	_, err = ts.Service.SuggestMemoTags(hostCtx, &v1pb.SuggestMemoTagsRequest{Content: "Weekly sync"})
+	require.Contains(t, err.Error(), "AI provider unavailable")
+	require.Equal(t, 2, calls)
+
+	providerStatus, err := ts.Service.GetAIProviderStatus(hostCtx, &v1pb.GetAIProviderStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.AIProviderStatus_OPEN, providerStatus.CircuitState)
+	require.Equal(t, int32(2), providerStatus.ConsecutiveFailures)
+	require.Equal(t, int32(0), providerStatus.InFlightRequests)
+	require.Equal(t, int32(3), providerStatus.MaxConcurrentRequests)
+	require.InDelta(t, 600, providerStatus.RetryTime.AsTime().Sub(providerStatus.LastErrorTime.AsTime()).Seconds(), 1)
+}
@@ -0,0 +1,81 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestAIProviderOllama(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	summary := strings.Repeat("This week was spent planning the garden. ", 4)
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/chat", r.URL.Path)
+		require.Empty(t, r.Header.Get("Authorization"))
+		body := map[string]any{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "llama3.2", body["model"])
+		response, err := json.Marshal(map[string]any{
+			"message":           map[string]any{"role": "assistant", "content": summary},
+			"done":              true,
+			"prompt_eval_count": 20,
+			"eval_count":        10,
+		})
+		require.NoError(t, err)
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+
+	// A local Ollama server needs no API key.
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: &storepb.WorkspaceAISetting{
+			Provider:           storepb.WorkspaceAISetting_OLLAMA,
+			Endpoint:           aiServer.URL,
+			Model:              "llama3.2",
+			SystemPrompt:       "Summarize my week.",
+			TranscriptionModel: "whisper-1",
+		}},
+	})
+	require.NoError(t, err)
+
+	response, err := ts.Service.TestAIConfig(userCtx, &v1pb.TestAIConfigRequest{})
+	require.NoError(t, err)
+	require.True(t, response.Success, response.ErrorMessage)
+
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planned the garden #home"}})
+	require.NoError(t, err)
+	today := time.Now().UTC()
+	memo, err := ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{
+		TimeRange: "custom",
+		StartDate: today.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:   today.Format("2006-01-02"),
+	})
+	require.NoError(t, err)
+	require.Contains(t, memo.Content, strings.TrimSpace(summary))
+
+	// Transcription goes through the OpenAI API only.
+	_, err = ts.Service.CreateVoiceMemo(userCtx, &v1pb.CreateVoiceMemoRequest{
+		Audio: &v1pb.Attachment{Filename: "recording.webm", Type: "audio/webm", Content: []byte("fake audio")},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,179 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestAIRateLimit(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","object":"chat.completion","model":"gpt-4o-mini","choices":[{"index":0,"message":{"role":"assistant","content":"{\"tags\":[\"work\"]}"}}],"usage":{"total_tokens":5}}`))
+	}))
+	defer aiServer.Close()
+	setRateLimits := func(permission *storepb.WorkspaceAISetting_RolePermission) {
+		_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+			Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: &storepb.WorkspaceAISetting{
+				Endpoint:        aiServer.URL,
+				ApiKey:          "key",
+				Model:           "gpt-4o-mini",
+				RolePermissions: map[string]*storepb.WorkspaceAISetting_RolePermission{"USER": permission},
+			}},
+		})
+		require.NoError(t, err)
+	}
+	suggest := func() error {
+		_, err := ts.Service.SuggestMemoTags(userCtx, &v1pb.SuggestMemoTagsRequest{Content: "Weekly sync"})
+		return err
+	}
+
+	// Without limits for the role, the default hourly limit of the server applies.
+	setRateLimits(&storepb.WorkspaceAISetting_RolePermission{})
+	usage, err := ts.Service.GetAIUsage(userCtx, &v1pb.GetAIUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(5), usage.Hourly.Limit)
+	require.Equal(t, int32(5), usage.Hourly.Remaining)
+	require.Equal(t, int32(0), usage.Daily.Limit)
+	require.True(t, usage.Hourly.ResetTime.AsTime().After(time.Now()))
+
+	setRateLimits(&storepb.WorkspaceAISetting_RolePermission{HourlyRequestLimit: 2, DailyRequestLimit: 3})
+	require.NoError(t, suggest())
+	require.NoError(t, suggest())
+	err = suggest()
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	// The clients back off with the details of the error rather than its message.
+	var quotaFailure *errdetails.QuotaFailure
+	var retryInfo *errdetails.RetryInfo
+	for _, detail := range status.Convert(err).Details() {
+		switch detail := detail.(type) {
+		case *errdetails.QuotaFailure:
+			quotaFailure = detail
+		case *errdetails.RetryInfo:
+			retryInfo = detail
+		}
+	}
+	require.NotNil(t, quotaFailure)
+	require.Len(t, quotaFailure.Violations, 1)
+	require.Equal(t, fmt.Sprintf("users/%d", user.ID), quotaFailure.Violations[0].Subject)
+	require.Equal(t, "ai_requests_per_hour", quotaFailure.Violations[0].QuotaId)
+	require.Equal(t, int64(2), quotaFailure.Violations[0].QuotaValue)
+	require.NotNil(t, retryInfo)
+	require.Greater(t, retryInfo.RetryDelay.AsDuration(), time.Duration(0))
+	require.LessOrEqual(t, retryInfo.RetryDelay.AsDuration(), time.Hour)
+	usage, err = ts.Service.GetAIUsage(userCtx, &v1pb.GetAIUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &v1pb.AIUsage_Window{Limit: 2, Used: 2, Remaining: 0, ResetTime: usage.Hourly.ResetTime}, usage.Hourly)
+	require.Equal(t, &v1pb.AIUsage_Window{Limit: 3, Used: 2, Remaining: 1, ResetTime: usage.Daily.ResetTime}, usage.Daily)
+
+	// The daily limit applies on its own once the hourly one is lifted.
+	setRateLimits(&storepb.WorkspaceAISetting_RolePermission{HourlyRequestLimit: -1, DailyRequestLimit: 3})
+	require.NoError(t, suggest())
+	err = suggest()
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Contains(t, err.Error(), "per day")
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateWorkspaceSetting(ts.CreateUserContext(ctx, host.ID), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/AI_CONFIG",
+			Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+				RolePermissions: map[string]*v1pb.WorkspaceSetting_AISetting_RolePermission{"USER": {DailyRequestLimit: -1}},
+			}},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAIRateLimitReservation(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// The provider holds the requests until released, so that they are all in flight at the same time.
+	release := make(chan struct{})
+	var failing atomic.Bool
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","object":"chat.completion","model":"gpt-4o-mini","choices":[{"index":0,"message":{"role":"assistant","content":"{\"tags\":[\"work\"]}"}}],"usage":{"total_tokens":5}}`))
+	}))
+	defer aiServer.Close()
+	setHourlyLimit := func(limit int32) {
+		_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+			Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: &storepb.WorkspaceAISetting{
+				Endpoint: aiServer.URL,
+				ApiKey:   "key",
+				Model:    "gpt-4o-mini",
+				RolePermissions: map[string]*storepb.WorkspaceAISetting_RolePermission{
+					"USER": {HourlyRequestLimit: limit},
+				},
+			}},
+		})
+		require.NoError(t, err)
+	}
+	suggest := func() error {
+		_, err := ts.Service.SuggestMemoTags(userCtx, &v1pb.SuggestMemoTagsRequest{Content: "Weekly sync"})
+		return err
+	}
+
+	// The concurrent requests are reserved before the provider is called, they cannot exceed the limit.
+	setHourlyLimit(2)
+	errs := make(chan error, 5)
+	for range 5 {
+		go func() {
+			errs <- suggest()
+		}()
+	}
+	for range 3 {
+		require.Equal(t, codes.ResourceExhausted, status.Code(<-errs))
+	}
+	close(release)
+	for range 2 {
+		require.NoError(t, <-errs)
+	}
+
+	// The failed requests are refunded.
+	setHourlyLimit(3)
+	failing.Store(true)
+	require.Error(t, suggest())
+	usage, err := ts.Service.GetAIUsage(userCtx, &v1pb.GetAIUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), usage.Hourly.Used)
+	failing.Store(false)
+	require.NoError(t, suggest())
+	require.Equal(t, codes.ResourceExhausted, status.Code(suggest()))
+}
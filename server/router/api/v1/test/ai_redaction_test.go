@@ -0,0 +1,148 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestAIRedaction(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+
+	updateRedaction := func(redaction *v1pb.WorkspaceSetting_AISetting_Redaction) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/AI_CONFIG",
+				Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+					Endpoint:  "http://localhost",
+					ApiKey:    "key",
+					Model:     "gpt-4o-mini",
+					Redaction: redaction,
+				}},
+			},
+		})
+		return err
+	}
+	err = updateRedaction(&v1pb.WorkspaceSetting_AISetting_Redaction{Patterns: []string{"("}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = updateRedaction(&v1pb.WorkspaceSetting_AISetting_Redaction{
+		RedactEmails:       true,
+		RedactPhoneNumbers: true,
+		Patterns:           []string{`ACME-\d+`},
+		Tags:               []string{"clients"},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.CreateMemo(hostCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content: "Mailed jane@example.com about ACME-42 #clients/acme, call her at +1 (555) 123-4567 on 2025-01-15",
+	}})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(hostCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content: "jane@example.com replied, bob@example.com joins #work",
+	}})
+	require.NoError(t, err)
+
+	today := time.Now().UTC()
+	preview, err := ts.Service.PreviewAISummary(hostCtx, &v1pb.GenerateAISummaryRequest{
+		TimeRange: "custom",
+		StartDate: today.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:   today.Format("2006-01-02"),
+	})
+	require.NoError(t, err)
+	for _, secret := range []string{"jane@example.com", "bob@example.com", "555", "ACME-42", "#clients"} {
+		require.NotContains(t, preview.Prompt, secret)
+	}
+	// The same value keeps its placeholder across the memos, and what is not sensitive is kept.
+	require.Contains(t, preview.Prompt, "Mailed [EMAIL_1] about [REDACTED_1] [TAG_1], call her at [PHONE_1] on 2025-01-15")
+	require.Contains(t, preview.Prompt, "[EMAIL_1] replied, [EMAIL_2] joins #work")
+	require.Contains(t, preview.Prompt, "Keep the placeholders unchanged.")
+}
+
+func TestAIRedactionSecrets(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	prompts := []string{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		prompts = append(prompts, string(body))
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": "Rotated [SECRET_1] and sent [SECRET_2] to [EMAIL_1]."}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	setRedaction := func(redaction *storepb.WorkspaceAISetting_Redaction) {
+		_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+			Value: &storepb.WorkspaceSetting_AiSetting{
+				AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini", Redaction: redaction},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content: "Rotated sk-abcdefghijklmnopqrstuvwx and sent password: hunter22 to jane@example.com, token usage is fine",
+	}})
+	require.NoError(t, err)
+	secrets := []string{"sk-abcdefghijklmnopqrstuvwx", "hunter22", "jane@example.com"}
+
+	today := time.Now().UTC().Format("2006-01-02")
+	generate := func() *v1pb.Memo {
+		summary, err := ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today})
+		require.NoError(t, err)
+		return summary
+	}
+
+	// Without restoring the placeholders, the summary keeps them.
+	setRedaction(&storepb.WorkspaceAISetting_Redaction{RedactEmails: true, RedactSecrets: true})
+	summary := generate()
+	for _, secret := range secrets {
+		require.NotContains(t, prompts[len(prompts)-1], secret)
+	}
+	require.Contains(t, prompts[len(prompts)-1], "Rotated [SECRET_1] and sent password: [SECRET_2] to [EMAIL_1], token usage is fine")
+	require.Contains(t, summary.Content, "Rotated [SECRET_1] and sent [SECRET_2] to [EMAIL_1].")
+
+	// Restoring the placeholders puts the values back in the saved summary, and not in what is sent back to refine it.
+	setRedaction(&storepb.WorkspaceAISetting_Redaction{RedactEmails: true, RedactSecrets: true, RestorePlaceholders: true})
+	summary = generate()
+	require.Contains(t, summary.Content, "Rotated sk-abcdefghijklmnopqrstuvwx and sent hunter22 to jane@example.com.")
+	refined, err := ts.Service.RefineAISummary(userCtx, &v1pb.RefineAISummaryRequest{Name: summary.Name, Instruction: "Make it shorter"})
+	require.NoError(t, err)
+	for _, secret := range secrets {
+		require.NotContains(t, prompts[len(prompts)-1], secret)
+	}
+	require.Contains(t, prompts[len(prompts)-1], "Make it shorter")
+	require.Contains(t, refined.Content, "Rotated sk-abcdefghijklmnopqrstuvwx and sent hunter22 to jane@example.com.")
+}
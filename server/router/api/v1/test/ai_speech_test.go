@@ -0,0 +1,102 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestSynthesizeMemoAudio(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	viewer, err := ts.CreateRegularUser(ctx, "viewer")
+	require.NoError(t, err)
+	viewerCtx := ts.CreateUserContext(ctx, viewer.ID)
+
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "# Groceries\n\nBuy **milk** and bread.", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	// Speech synthesis is disabled until a text-to-speech model is configured.
+	_, err = ts.Service.SynthesizeMemoAudio(authorCtx, &v1pb.SynthesizeMemoAudioRequest{Memo: memo.Name})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// A local engine compatible with the OpenAI speech API, which needs no API key.
+	var mutex sync.Mutex
+	requests := []map[string]any{}
+	speechServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/audio/speech", r.URL.Path)
+		body := map[string]any{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		mutex.Lock()
+		requests = append(requests, body)
+		mutex.Unlock()
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("ID3audio"))
+	}))
+	defer speechServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: &storepb.WorkspaceAISetting{
+			TtsModel:    "tts-1",
+			TtsEndpoint: speechServer.URL,
+		}},
+	})
+	require.NoError(t, err)
+
+	attachment, err := ts.Service.SynthesizeMemoAudio(authorCtx, &v1pb.SynthesizeMemoAudioRequest{Memo: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "audio/mpeg", attachment.Type)
+	require.Equal(t, int64(len("ID3audio")), attachment.Size)
+	require.Equal(t, memo.Name, attachment.GetMemo())
+	require.Len(t, requests, 1)
+	require.Equal(t, "tts-1", requests[0]["model"])
+	require.Equal(t, "alloy", requests[0]["voice"])
+	require.Equal(t, "mp3", requests[0]["response_format"])
+	require.Equal(t, "Groceries Buy milk and bread.", requests[0]["input"])
+
+	// Private memos of other users cannot be rendered.
+	_, err = ts.Service.SynthesizeMemoAudio(viewerCtx, &v1pb.SynthesizeMemoAudioRequest{Memo: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.SynthesizeMemoAudio(authorCtx, &v1pb.SynthesizeMemoAudioRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The daily digest reads the memos of the day, split into chunks the speech API accepts.
+	_, err = ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: strings.Repeat("A long sentence. ", 300), Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	today := time.Now().UTC().Format("2006-01-02")
+	attachment, err = ts.Service.SynthesizeMemoAudio(authorCtx, &v1pb.SynthesizeMemoAudioRequest{DigestDate: today, Voice: "nova"})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("digest-%s.mp3", today), attachment.Filename)
+	require.Nil(t, attachment.Memo)
+	require.Len(t, requests, 3)
+	require.Equal(t, "nova", requests[1]["voice"])
+	require.True(t, strings.HasPrefix(requests[1]["input"].(string), "Your memos of "))
+	require.LessOrEqual(t, len([]rune(requests[1]["input"].(string))), 4096)
+	require.Equal(t, int64(2*len("ID3audio")), attachment.Size)
+
+	_, err = ts.Service.SynthesizeMemoAudio(authorCtx, &v1pb.SynthesizeMemoAudioRequest{DigestDate: "2001-01-01"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
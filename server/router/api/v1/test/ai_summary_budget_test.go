@@ -0,0 +1,106 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestAISummaryTokenBudget(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	maxTokens := []int{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			MaxCompletionTokens int `json:"max_completion_tokens"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		maxTokens = append(maxTokens, body.MaxCompletionTokens)
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "tiny-model",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": "All quiet this week."}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	configure := func(contextWindow, minLength, maxLength int32) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/AI_CONFIG",
+				Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+					Endpoint:         aiServer.URL,
+					ApiKey:           "key",
+					Model:            "tiny-model",
+					ContextWindows:   map[string]int32{"tiny-model": contextWindow},
+					SummaryMinLength: minLength,
+					SummaryMaxLength: maxLength,
+				}},
+			},
+		})
+		return err
+	}
+
+	require.Equal(t, codes.InvalidArgument, status.Code(configure(100, 0, 0)))
+	require.Equal(t, codes.InvalidArgument, status.Code(configure(2048, 50, 10)))
+
+	// Ten memos of about 150 tokens do not fit in the context window of the model at once.
+	for i := 0; i < 10; i++ {
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: fmt.Sprintf("Garden note %d:", i) + strings.Repeat(" the tomatoes", 150)}})
+		require.NoError(t, err)
+	}
+	today := time.Now().UTC().Format("2006-01-02")
+	request := &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today}
+
+	require.NoError(t, configure(2048, 0, 0))
+	preview, err := ts.Service.PreviewAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Greater(t, preview.ChunkCount, int32(1))
+	require.Equal(t, (preview.ChunkCount+1)*1024, preview.EstimatedCompletionTokens)
+
+	// The completions are capped to leave room for the prompt, and short summaries are kept.
+	memo, err := ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Contains(t, memo.Content, "All quiet this week.")
+	require.NotEmpty(t, maxTokens)
+	for _, tokens := range maxTokens {
+		require.Equal(t, 1024, tokens)
+	}
+
+	// The summaries are bounded by the lengths of the AI setting.
+	require.NoError(t, configure(200000, 0, 9))
+	maxTokens = nil
+	memo, err = ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, []int{1250}, maxTokens)
+	require.Contains(t, memo.Content, "All quiet")
+	require.NotContains(t, memo.Content, "this week")
+	require.NoError(t, configure(200000, 100, 0))
+	_, err = ts.Service.GenerateAISummary(userCtx, request)
+	require.ErrorContains(t, err, "too short")
+}
@@ -0,0 +1,97 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestAISummaryCache(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	calls := 0
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"completion","object":"chat.completion","model":"gpt-4o","choices":[{"index":0,"message":{"role":"assistant","content":"The week was spent in the garden."}}],"usage":{"prompt_tokens":10,"completion_tokens":20,"total_tokens":30}}`))
+	}))
+	defer aiServer.Close()
+	configure := func(ttlMinutes int32) {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/AI_CONFIG",
+				Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+					Endpoint:               aiServer.URL,
+					ApiKey:                 "key",
+					Model:                  "gpt-4o",
+					SummaryCacheTtlMinutes: ttlMinutes,
+				}},
+			},
+		})
+		require.NoError(t, err)
+	}
+	configure(60)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planted the tomatoes."}})
+	require.NoError(t, err)
+	today := time.Now().UTC().Format("2006-01-02")
+	request := &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today}
+
+	summary, err := ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.False(t, summary.AiSummaryCached)
+	require.Equal(t, 1, calls)
+
+	// The identical request returns the same AI memo without calling the provider.
+	cached, err := ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.True(t, cached.AiSummaryCached)
+	require.Equal(t, summary.Name, cached.Name)
+	require.Equal(t, 1, calls)
+
+	stream := &summaryStream{ctx: userCtx}
+	require.NoError(t, ts.Service.StreamAISummary(request, stream))
+	require.Len(t, stream.responses, 1)
+	require.True(t, stream.responses[0].Memo.AiSummaryCached)
+	require.Equal(t, summary.Name, stream.responses[0].Memo.Name)
+	require.Equal(t, 1, calls)
+
+	// Other options of the request miss the cache.
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: time.Now().UTC().AddDate(0, 0, -1).Format("2006-01-02"), EndDate: today})
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	// An edited source memo misses the cache.
+	memo.Content = "Planted the tomatoes and the basil."
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: memo, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}}})
+	require.NoError(t, err)
+	updated, err := ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.False(t, updated.AiSummaryCached)
+	require.NotEqual(t, summary.Name, updated.Name)
+	require.Equal(t, 3, calls)
+
+	// The cache is disabled with no TTL.
+	configure(0)
+	uncached, err := ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.False(t, uncached.AiSummaryCached)
+	require.Equal(t, 4, calls)
+}
@@ -0,0 +1,124 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestAISummaryChunking(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	type message struct {
+		Role    string `json:"role"`
+		Content string `json:"content"`
+	}
+	requests := [][]message{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []message `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requests = append(requests, body.Messages)
+		content := fmt.Sprintf("Summary %d: ", len(requests)) + strings.Repeat("the garden is growing well. ", 5)
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": content}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	configure := func(chunkSize, maxChunks int32) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/AI_CONFIG",
+				Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+					Endpoint:         aiServer.URL,
+					ApiKey:           "key",
+					Model:            "gpt-4o-mini",
+					SummaryChunkSize: chunkSize,
+					SummaryMaxChunks: maxChunks,
+				}},
+			},
+		})
+		return err
+	}
+
+	require.Equal(t, codes.InvalidArgument, status.Code(configure(100, 0)))
+	require.Equal(t, codes.InvalidArgument, status.Code(configure(0, 51)))
+
+	// Six memos of 400 characters fit two by two in chunks of 1000 characters.
+	for i := 0; i < 6; i++ {
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: fmt.Sprintf("Garden note %d ", i) + strings.Repeat("x", 386)}})
+		require.NoError(t, err)
+	}
+	today := time.Now().UTC().Format("2006-01-02")
+	request := &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today}
+
+	// The memos fit in a single request by default.
+	require.NoError(t, configure(0, 0))
+	preview, err := ts.Service.PreviewAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Zero(t, preview.ChunkCount)
+	require.Len(t, preview.SourceMemos, 6)
+
+	// Each chunk is summarized, then the summary is generated from the summaries of the chunks.
+	require.NoError(t, configure(1000, 0))
+	preview, err = ts.Service.PreviewAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), preview.ChunkCount)
+	require.Len(t, preview.SourceMemos, 6)
+	require.Equal(t, int32(4*1250), preview.EstimatedCompletionTokens)
+	require.Empty(t, requests)
+
+	_, err = ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Len(t, requests, 4)
+	for i, chunk := range requests[:3] {
+		require.Contains(t, chunk[0].Content, "summarizing a part of a larger set of memos")
+		require.Contains(t, chunk[1].Content, fmt.Sprintf("Garden note %d", 5-2*i))
+		require.Contains(t, chunk[1].Content, fmt.Sprintf("Garden note %d", 4-2*i))
+	}
+	final := requests[3][len(requests[3])-1].Content
+	require.Contains(t, final, "summarized in parts")
+	require.Contains(t, final, "[Part 1]\nSummary 1:")
+	require.Contains(t, final, "[Part 3]\nSummary 3:")
+	require.NotContains(t, final, "Garden note")
+
+	// The oldest memos beyond the maximum number of chunks are left out.
+	requests = nil
+	require.NoError(t, configure(1000, 2))
+	preview, err = ts.Service.PreviewAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), preview.ChunkCount)
+	require.Len(t, preview.SourceMemos, 4)
+	_, err = ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Len(t, requests, 3)
+}
@@ -0,0 +1,105 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestAISummaryComparePrevious(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	prompts := []string{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []struct {
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		prompts = append(prompts, body.Messages[len(body.Messages)-1].Content)
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": strings.Repeat("The fence is fixed and you planted tomatoes. ", 3)}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"},
+		},
+	})
+	require.NoError(t, err)
+
+	createMemo := func(content string, age time.Duration) {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}})
+		require.NoError(t, err)
+		memoUID := strings.TrimPrefix(memo.Name, "memos/")
+		storeMemo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		require.NoError(t, err)
+		createdTs := time.Now().Add(-age).Unix()
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: storeMemo.ID, CreatedTs: &createdTs}))
+	}
+	today := time.Now().UTC()
+	request := &v1pb.GenerateAISummaryRequest{
+		TimeRange:       "custom",
+		StartDate:       today.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:         today.Format("2006-01-02"),
+		ComparePrevious: true,
+	}
+
+	createMemo("Planted tomatoes", 0)
+	_, err = ts.Service.PreviewAISummary(userCtx, request)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Without a previous AI summary, the memos of the previous period of the same length are compared with.
+	createMemo("Fixing the fence", 48*time.Hour)
+	preview, err := ts.Service.PreviewAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Len(t, preview.SourceMemos, 1)
+	require.Contains(t, preview.Prompt, "Memos of the previous period:\n\n[Memo 1]\nFixing the fence\n\nMemos of the current period:\n\n[Memo 1]\nPlanted tomatoes")
+	require.Contains(t, preview.Prompt, "highlight what changed since the previous period")
+
+	// The source memos of the latest AI summary are compared with otherwise.
+	createMemo("Ordered the fence boards", 5*24*time.Hour)
+	fiveDaysAgo := time.Now().Add(-5 * 24 * time.Hour).UTC().Format("2006-01-02")
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: fiveDaysAgo, EndDate: fiveDaysAgo})
+	require.NoError(t, err)
+	summary, err := ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Len(t, prompts, 2)
+	require.Contains(t, prompts[1], "Memos of the previous period:\n\n[Memo 1]\nOrdered the fence boards\n\nMemos of the current period:")
+	require.NotContains(t, prompts[1], "Fixing the fence")
+	require.Contains(t, summary.Content, "**Compared With:** Previous period")
+
+	request.ComparePrevious = false
+	preview, err = ts.Service.PreviewAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.NotContains(t, preview.Prompt, "previous period")
+}
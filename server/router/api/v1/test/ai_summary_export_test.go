@@ -0,0 +1,130 @@
+package test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/archivecrypt"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestExportAISummaries(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": strings.Repeat("You planted tomatoes. ", 6)}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"},
+		},
+	})
+	require.NoError(t, err)
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planted the \"cherry\" tomatoes #garden"}})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(otherCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Watered the roses"}})
+	require.NoError(t, err)
+
+	today := time.Now().UTC().Format("2006-01-02")
+	summary, err := ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today})
+	require.NoError(t, err)
+	_, err = ts.Service.GenerateAISummary(otherCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today})
+	require.NoError(t, err)
+
+	export := func(ctx context.Context, request *v1pb.ExportAISummariesRequest) map[string]string {
+		body, err := ts.Service.ExportAISummaries(ctx, request)
+		require.NoError(t, err)
+		require.Equal(t, "application/zip", body.ContentType)
+		archive, err := zip.NewReader(bytes.NewReader(body.Data), int64(len(body.Data)))
+		require.NoError(t, err)
+		files := map[string]string{}
+		for _, file := range archive.File {
+			reader, err := archivecrypt.OpenZipEntry(file, request.Passphrase)
+			require.NoError(t, err)
+			content, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+			files[file.Name] = string(content)
+		}
+		return files
+	}
+	summaryUID := strings.TrimPrefix(summary.Name, "memos/")
+	memoUID := strings.TrimPrefix(memo.Name, "memos/")
+	files := export(userCtx, &v1pb.ExportAISummariesRequest{})
+	// Only the summaries of the user are exported.
+	require.Len(t, files, 3)
+	summaryFile := files["summaries/"+summaryUID+".md"]
+	require.True(t, strings.HasPrefix(summaryFile, "---\nname: \""+summary.Name+"\"\ngenerated: \""))
+	require.Contains(t, summaryFile, "\nstart_date: \""+today+"\"\nend_date: \""+today+"\"\n")
+	require.Contains(t, summaryFile, "\nmodel: \"gpt-4o-mini\"\n")
+	require.Contains(t, summaryFile, "\nsource_memos: [\""+memo.Name+"\"]\n---\n\nYou planted tomatoes.")
+	memoFile := files["memos/"+memoUID+".md"]
+	require.Contains(t, memoFile, "\ntags: [\"garden\"]\n---\n\nPlanted the \"cherry\" tomatoes #garden\n")
+
+	var relations []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(files["relations.json"]), &relations))
+	require.Equal(t, []map[string]any{{
+		"summary":      summary.Name,
+		"file":         "summaries/" + summaryUID + ".md",
+		"source_memos": []any{map[string]any{"name": memo.Name, "file": "memos/" + memoUID + ".md"}},
+	}}, relations)
+
+	// The summaries can be picked by name or filter.
+	require.Len(t, export(userCtx, &v1pb.ExportAISummariesRequest{Names: []string{summary.Name}}), 3)
+	require.Equal(t, map[string]string{"relations.json": "[]\n"}, export(userCtx, &v1pb.ExportAISummariesRequest{Filter: "pinned"}))
+	_, err = ts.Service.ExportAISummaries(otherCtx, &v1pb.ExportAISummariesRequest{Names: []string{summary.Name}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// The export can be encrypted with a passphrase, in a ZIP archive or in the age format.
+	require.Equal(t, files, export(userCtx, &v1pb.ExportAISummariesRequest{Encryption: v1pb.ArchiveEncryption_ZIP_AES, Passphrase: "correct horse"}))
+	body, err := ts.Service.ExportAISummaries(userCtx, &v1pb.ExportAISummariesRequest{Encryption: v1pb.ArchiveEncryption_AGE, Passphrase: "correct horse"})
+	require.NoError(t, err)
+	require.Equal(t, "application/octet-stream", body.ContentType)
+	reader, err := archivecrypt.NewAgeReader(bytes.NewReader(body.Data), "correct horse")
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	require.Len(t, archive.File, 3)
+	_, err = ts.Service.ExportAISummaries(userCtx, &v1pb.ExportAISummariesRequest{Encryption: v1pb.ArchiveEncryption_ZIP_AES, Passphrase: "short"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.ExportAISummaries(userCtx, &v1pb.ExportAISummariesRequest{Filter: "invalid filter"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.ExportAISummaries(ctx, &v1pb.ExportAISummariesRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,101 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestAISummaryIdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	calls := 0
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"completion","object":"chat.completion","model":"gpt-4o","choices":[{"index":0,"message":{"role":"assistant","content":"The week was spent in the garden."}}],"usage":{"prompt_tokens":10,"completion_tokens":20,"total_tokens":30}}`))
+	}))
+	defer aiServer.Close()
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/AI_CONFIG",
+			Value: &v1pb.WorkspaceSetting_AiSetting{AiSetting: &v1pb.WorkspaceSetting_AISetting{
+				Endpoint: aiServer.URL,
+				ApiKey:   "key",
+				Model:    "gpt-4o",
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planted the tomatoes."}})
+	require.NoError(t, err)
+	today := time.Now().UTC().Format("2006-01-02")
+	request := &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today, IdempotencyKey: "retry-1"}
+
+	summary, err := ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+
+	// The retry returns the same AI memo without calling the provider.
+	retried, err := ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, summary.Name, retried.Name)
+	require.Equal(t, 1, calls)
+
+	// The key of another user is another request.
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	_, err = ts.Service.CreateMemo(otherCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Fixed the bike."}})
+	require.NoError(t, err)
+	otherSummary, err := ts.Service.GenerateAISummary(otherCtx, request)
+	require.NoError(t, err)
+	require.NotEqual(t, summary.Name, otherSummary.Name)
+	require.Equal(t, 2, calls)
+
+	// The key cannot be reused for other parameters.
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today, Tags: []string{"garden"}, IdempotencyKey: "retry-1"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A retry while the request is in progress is rejected.
+	completed, err := ts.Store.GetAIIdempotencyKey(ctx, &store.FindAIIdempotencyKey{UserID: user.ID, Key: "retry-1"})
+	require.NoError(t, err)
+	require.NotZero(t, completed.MemoID)
+	_, err = ts.Store.CreateAIIdempotencyKey(ctx, &store.AIIdempotencyKey{UserID: user.ID, Key: "retry-2", Fingerprint: completed.Fingerprint})
+	require.NoError(t, err)
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today, IdempotencyKey: "retry-2"})
+	require.Equal(t, codes.Aborted, status.Code(err))
+	require.Equal(t, 2, calls)
+
+	// The summary is generated again once its memo is deleted.
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: summary.Name})
+	require.NoError(t, err)
+	regenerated, err := ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.NotEqual(t, summary.Name, regenerated.Name)
+	require.Equal(t, 3, calls)
+
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today, IdempotencyKey: strings.Repeat("k", 256)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
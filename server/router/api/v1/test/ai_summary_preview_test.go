@@ -0,0 +1,82 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestPreviewAISummary(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// The preview never reaches the AI provider.
+	aiServer := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("unexpected request to the AI provider")
+	}))
+	defer aiServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: &storepb.WorkspaceAISetting{
+			Endpoint:             aiServer.URL,
+			ApiKey:               "key",
+			Model:                "gpt-4o-mini",
+			SystemPrompt:         "Summarize my week.",
+			PromptTokenPrice:     1,
+			CompletionTokenPrice: 2,
+		}},
+	})
+	require.NoError(t, err)
+
+	first, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planned the garden #home"}})
+	require.NoError(t, err)
+	second, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Reviewed the budget #work"}})
+	require.NoError(t, err)
+
+	today := time.Now().UTC()
+	request := &v1pb.GenerateAISummaryRequest{
+		TimeRange: "custom",
+		StartDate: today.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:   today.Format("2006-01-02"),
+	}
+	preview, err := ts.Service.PreviewAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{first.Name, second.Name}, preview.SourceMemos)
+	require.Contains(t, preview.Prompt, "Summarize my week.")
+	require.Contains(t, preview.Prompt, "Planned the garden #home")
+	require.Contains(t, preview.Prompt, "Reviewed the budget #work")
+	require.Greater(t, preview.EstimatedPromptTokens, int32(0))
+	require.Less(t, preview.EstimatedPromptTokens, int32(len(preview.Prompt)))
+	require.Greater(t, preview.EstimatedCompletionTokens, int32(0))
+	expectedCost := (float64(preview.EstimatedPromptTokens)*1 + float64(preview.EstimatedCompletionTokens)*2) / 1_000_000
+	require.InDelta(t, expectedCost, preview.EstimatedCost, 1e-12)
+
+	// The preview follows the tag filter of the summary.
+	request.Tags = []string{"#work"}
+	preview, err = ts.Service.PreviewAISummary(userCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, []string{second.Name}, preview.SourceMemos)
+
+	// It does not count against the rate limit.
+	usage, err := ts.Service.GetAIUsage(userCtx, &v1pb.GetAIUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), usage.Hourly.Used)
+
+	_, err = ts.Service.PreviewAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "1y"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,111 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestRefineAISummary(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	replies := []string{
+		strings.Repeat("You planned the garden and reviewed the budget. ", 3),
+		strings.Repeat("You planned the garden. ", 5),
+		strings.Repeat("Work: you reviewed the budget. ", 4),
+	}
+	type message struct {
+		Role    string `json:"role"`
+		Content string `json:"content"`
+	}
+	requests := [][]message{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []message `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		reply := replies[len(requests)]
+		requests = append(requests, body.Messages)
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": reply}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"},
+		},
+	})
+	require.NoError(t, err)
+
+	source, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planned the garden and reviewed the budget"}})
+	require.NoError(t, err)
+	today := time.Now().UTC()
+	summary, err := ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{
+		TimeRange: "custom",
+		StartDate: today.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:   today.Format("2006-01-02"),
+	})
+	require.NoError(t, err)
+
+	refined, err := ts.Service.RefineAISummary(userCtx, &v1pb.RefineAISummaryRequest{Name: summary.Name, Instruction: "Make it shorter"})
+	require.NoError(t, err)
+	require.Equal(t, summary.Name, refined.Name)
+	require.Contains(t, refined.Content, strings.TrimSpace(replies[1]))
+	require.NotContains(t, refined.Content, strings.TrimSpace(replies[0]))
+	require.Contains(t, refined.Content, "**Time Range:**")
+	require.True(t, strings.HasSuffix(refined.Content, "#AI"))
+	require.Len(t, refined.AiSummaryRefinements, 1)
+	require.Equal(t, "Make it shorter", refined.AiSummaryRefinements[0].Instruction)
+	require.Equal(t, strings.TrimSpace(replies[0]), refined.AiSummaryRefinements[0].PreviousSummary)
+	// The refinement continues the conversation of the original prompt.
+	require.Len(t, requests[1], 4)
+	require.Equal(t, requests[0], requests[1][:2])
+	require.Equal(t, message{Role: "assistant", Content: strings.TrimSpace(replies[0])}, requests[1][2])
+	require.Equal(t, message{Role: "user", Content: "Make it shorter"}, requests[1][3])
+
+	refined, err = ts.Service.RefineAISummary(userCtx, &v1pb.RefineAISummaryRequest{Name: summary.Name, Instruction: "Focus on work items"})
+	require.NoError(t, err)
+	require.Contains(t, refined.Content, strings.TrimSpace(replies[2]))
+	require.Len(t, refined.AiSummaryRefinements, 2)
+	require.Len(t, requests[2], 6)
+	require.Equal(t, requests[1], requests[2][:4])
+	require.Equal(t, message{Role: "user", Content: "Focus on work items"}, requests[2][5])
+
+	_, err = ts.Service.RefineAISummary(userCtx, &v1pb.RefineAISummaryRequest{Name: summary.Name, Instruction: " "})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.RefineAISummary(otherCtx, &v1pb.RefineAISummaryRequest{Name: summary.Name, Instruction: "Make it longer"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.RefineAISummary(userCtx, &v1pb.RefineAISummaryRequest{Name: source.Name, Instruction: "Make it longer"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Len(t, requests, 3)
+}
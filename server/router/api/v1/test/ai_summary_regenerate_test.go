@@ -0,0 +1,125 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestRegenerateAISummary(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	replies := []string{
+		strings.Repeat("You planned the garden. ", 5),
+		strings.Repeat("You planned the garden, again. ", 4),
+		strings.Repeat("You planned the garden and booked the trip. ", 3),
+	}
+	prompts := []string{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []struct {
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		reply := replies[len(prompts)]
+		prompts = append(prompts, body.Messages[len(body.Messages)-1].Content)
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": reply}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"},
+		},
+	})
+	require.NoError(t, err)
+
+	source, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planned the garden"}})
+	require.NoError(t, err)
+	today := time.Now().UTC().Format("2006-01-02")
+	summary, err := ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{
+		TimeRange: "custom",
+		StartDate: today,
+		EndDate:   today,
+	})
+	require.NoError(t, err)
+
+	// The summary is regenerated from the same source memos, even with new memos in its time range.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Booked the trip"}})
+	require.NoError(t, err)
+	regenerated, err := ts.Service.RegenerateAISummary(userCtx, &v1pb.RegenerateAISummaryRequest{Name: summary.Name})
+	require.NoError(t, err)
+	require.Equal(t, summary.Name, regenerated.Name)
+	require.Contains(t, regenerated.Content, strings.TrimSpace(replies[1]))
+	require.NotContains(t, regenerated.Content, strings.TrimSpace(replies[0]))
+	require.True(t, strings.HasSuffix(regenerated.Content, "#AI"))
+	require.Contains(t, prompts[1], "Planned the garden")
+	require.NotContains(t, prompts[1], "Booked the trip")
+
+	// Refreshing the source memos takes the memos of the time range now.
+	refreshed, err := ts.Service.RegenerateAISummary(userCtx, &v1pb.RegenerateAISummaryRequest{Name: summary.Name, RefreshSourceMemos: true})
+	require.NoError(t, err)
+	require.Contains(t, refreshed.Content, strings.TrimSpace(replies[2]))
+	require.Contains(t, refreshed.Content, "**Time Range:** "+today+" to "+today)
+	require.Contains(t, prompts[2], "Booked the trip")
+	sourceMemos, err := ts.Service.GetMemoSourceMemos(userCtx, &v1pb.GetMemoSourceMemosRequest{Name: summary.Name})
+	require.NoError(t, err)
+	require.Len(t, sourceMemos.Memos, 2)
+
+	versions, err := ts.Service.ListAIMemoVersions(userCtx, &v1pb.ListAIMemoVersionsRequest{Name: summary.Name})
+	require.NoError(t, err)
+	require.Len(t, versions.Versions, 2)
+	require.Equal(t, int32(2), versions.Versions[0].Version)
+	require.Equal(t, regenerated.Content, versions.Versions[0].Content)
+	require.Equal(t, int32(1), versions.Versions[1].Version)
+	require.Equal(t, summary.Content, versions.Versions[1].Content)
+
+	_, err = ts.Service.ListAIMemoVersions(otherCtx, &v1pb.ListAIMemoVersionsRequest{Name: summary.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.RegenerateAISummary(otherCtx, &v1pb.RegenerateAISummaryRequest{Name: summary.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.RegenerateAISummary(userCtx, &v1pb.RegenerateAISummaryRequest{Name: source.Name})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The summaries generated before the time ranges were recorded cannot be refreshed.
+	memoUID := strings.TrimPrefix(summary.Name, "memos/")
+	memo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	require.NoError(t, err)
+	memo.Payload.AiSummarySource = nil
+	require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Payload: memo.Payload}))
+	_, err = ts.Service.RegenerateAISummary(userCtx, &v1pb.RegenerateAISummaryRequest{Name: summary.Name, RefreshSourceMemos: true})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Len(t, prompts, 3)
+}
@@ -0,0 +1,103 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestAISummarySourceSelection(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	prompts := []string{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []struct {
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		prompts = append(prompts, body.Messages[len(body.Messages)-1].Content)
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": strings.Repeat("The project is on track for the launch. ", 4)}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"},
+		},
+	})
+	require.NoError(t, err)
+
+	createMemo := func(ctx context.Context, content string) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}})
+		require.NoError(t, err)
+		return memo
+	}
+	launch := createMemo(userCtx, "Launch checklist #project-x")
+	createMemo(userCtx, "Budget review #project-x")
+	groceries := createMemo(userCtx, "Buy groceries")
+	otherMemo := createMemo(otherCtx, "Someone else's note")
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: launch.Name, Pinned: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+	})
+	require.NoError(t, err)
+
+	// A hand-picked set of memos, without a time range.
+	memo, err := ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{MemoNames: []string{launch.Name, groceries.Name}})
+	require.NoError(t, err)
+	require.Len(t, prompts, 1)
+	require.Contains(t, prompts[0], "Launch checklist")
+	require.Contains(t, prompts[0], "Buy groceries")
+	require.NotContains(t, prompts[0], "Budget review")
+	require.Contains(t, memo.Content, "**Selected Memos:** 2")
+
+	// The memos of a filter, with the syntax of ListMemos.
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{Filter: `pinned && tag in ["project-x"]`})
+	require.NoError(t, err)
+	require.Len(t, prompts, 2)
+	require.Contains(t, prompts[1], "Launch checklist")
+	require.NotContains(t, prompts[1], "Budget review")
+
+	// Only the memos of the user are summarized, and the filter must be valid.
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{MemoNames: []string{otherMemo.Name}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{Filter: "unknown_field == 1"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{Filter: "pinned", ComparePrevious: true})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Len(t, prompts, 2)
+}
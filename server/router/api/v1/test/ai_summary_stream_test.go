@@ -0,0 +1,103 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// summaryStream collects the messages sent on a StreamAISummary stream.
+type summaryStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*v1pb.StreamAISummaryResponse
+}
+
+func (s *summaryStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *summaryStream) Send(response *v1pb.StreamAISummaryResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func TestStreamAISummary(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	deltas := []string{"## Summary\n\n", strings.Repeat("You planned the garden. ", 4), strings.Repeat("You reviewed the budget. ", 4)}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Stream bool `json:"stream"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.True(t, body.Stream)
+		w.Header().Set("Content-Type", "text/event-stream")
+		writeChunk := func(chunk map[string]any) {
+			chunk["id"], chunk["object"], chunk["model"] = "completion", "chat.completion.chunk", "gpt-4o-mini"
+			data, err := json.Marshal(chunk)
+			require.NoError(t, err)
+			_, _ = fmt.Fprintf(w, "data: %s\n\n", data)
+		}
+		for _, delta := range deltas {
+			writeChunk(map[string]any{"choices": []map[string]any{{"index": 0, "delta": map[string]any{"content": delta}}}})
+		}
+		writeChunk(map[string]any{
+			"choices": []map[string]any{},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		_, _ = fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer aiServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planned the garden"}})
+	require.NoError(t, err)
+	today := time.Now().UTC()
+	stream := &summaryStream{ctx: userCtx}
+	err = ts.Service.StreamAISummary(&v1pb.GenerateAISummaryRequest{
+		TimeRange: "custom",
+		StartDate: today.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:   today.Format("2006-01-02"),
+	}, stream)
+	require.NoError(t, err)
+
+	// The deltas are sent as they arrive, then the memo created from the whole summary.
+	require.Len(t, stream.responses, len(deltas)+1)
+	for i, delta := range deltas {
+		require.Equal(t, delta, stream.responses[i].Delta)
+		require.Nil(t, stream.responses[i].Memo)
+	}
+	memo := stream.responses[len(deltas)].Memo
+	require.NotNil(t, memo)
+	require.Contains(t, memo.Content, strings.Join(deltas, ""))
+
+	// The tokens reported at the end of the stream count against the workspace usage.
+	usage, err := ts.Store.GetWorkspaceAIUsage(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), usage.Tokens)
+}
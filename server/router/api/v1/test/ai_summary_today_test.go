@@ -0,0 +1,99 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestSummarizeToday(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Provider: storepb.WorkspaceAISetting_FAKE, Model: "fake"},
+		},
+	})
+	require.NoError(t, err)
+
+	createMemo := func(content string, createdTs int64) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}})
+		require.NoError(t, err)
+		storeMemo := getStoreMemo(ctx, t, ts, memo.Name)
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: storeMemo.ID, CreatedTs: &createdTs}))
+		return memo
+	}
+	// The day starts at midnight in the timezone of the user, not in UTC.
+	_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name:  fmt.Sprintf("users/%d/settings/GENERAL", user.ID),
+			Value: &v1pb.UserSetting_GeneralSetting_{GeneralSetting: &v1pb.UserSetting_GeneralSetting{Timezone: "Asia/Tokyo"}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone"}},
+	})
+	require.NoError(t, err)
+	location, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	now := time.Now().In(location)
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	createMemo("Planned the sprint #work", midnight.Add(-time.Minute).Unix())
+	today := createMemo("Reviewed the roadmap #work", now.Unix())
+	createMemo("Planted tomatoes #garden", now.Unix())
+
+	// Without preferences, all the memos of the day are summarized into a private memo.
+	summary, err := ts.Service.SummarizeToday(userCtx, &v1pb.SummarizeTodayRequest{})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PRIVATE, summary.Visibility)
+	sources, err := ts.Service.GetMemoSourceMemos(userCtx, &v1pb.GetMemoSourceMemosRequest{Name: summary.Name})
+	require.NoError(t, err)
+	require.Len(t, sources.Memos, 2)
+
+	// The tags and the visibility of the AI auto summary setting are the defaults of the summary.
+	_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/AI_AUTO_SUMMARY", user.ID),
+			Value: &v1pb.UserSetting_AiAutoSummarySetting{AiAutoSummarySetting: &v1pb.UserSetting_AIAutoSummarySetting{
+				Tags:       []string{"work"},
+				Visibility: "PROTECTED",
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags", "visibility"}},
+	})
+	require.NoError(t, err)
+	summary, err = ts.Service.SummarizeToday(userCtx, &v1pb.SummarizeTodayRequest{})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PROTECTED, summary.Visibility)
+	sources, err = ts.Service.GetMemoSourceMemos(userCtx, &v1pb.GetMemoSourceMemosRequest{Name: summary.Name})
+	require.NoError(t, err)
+	require.Len(t, sources.Memos, 1)
+	require.Equal(t, today.Name, sources.Memos[0].Name)
+
+	_, err = ts.Service.SummarizeToday(userCtx, &v1pb.SummarizeTodayRequest{Timezone: "Mars/Olympus"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name:  fmt.Sprintf("users/%d/settings/AI_AUTO_SUMMARY", user.ID),
+			Value: &v1pb.UserSetting_AiAutoSummarySetting{AiAutoSummarySetting: &v1pb.UserSetting_AIAutoSummarySetting{Visibility: "SECRET"}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,113 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestAISummaryWebhooks(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	payloads := make(chan *webhook.WebhookRequestPayload, 10)
+	webhookServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload := &webhook.WebhookRequestPayload{}
+		if err := json.NewDecoder(r.Body).Decode(payload); err == nil {
+			payloads <- payload
+		}
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer webhookServer.Close()
+	// The webhooks are dispatched asynchronously, they may arrive in any order.
+	receivePayloads := func() map[string]*webhook.WebhookRequestPayload {
+		received := map[string]*webhook.WebhookRequestPayload{}
+		for len(received) < 2 {
+			select {
+			case payload := <-payloads:
+				received[payload.ActivityType] = payload
+			case <-time.After(5 * time.Second):
+				t.Fatal("webhook was not dispatched")
+			}
+		}
+		return received
+	}
+
+	var failing atomic.Bool
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"message":"invalid request","type":"invalid_request_error"}}`))
+			return
+		}
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": strings.Repeat("You planned the garden. ", 5)}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"},
+		},
+	})
+	require.NoError(t, err)
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	_, err = ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		Webhook: &v1pb.UserWebhook{
+			Url:    webhookServer.URL,
+			Events: []string{"memos.ai.summary.started", "memos.ai.summary.succeeded", "memos.ai.summary.failed"},
+		},
+	})
+	require.NoError(t, err)
+	for _, content := range []string{"Planned the garden", "Bought seeds"} {
+		_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}})
+		require.NoError(t, err)
+	}
+	today := time.Now().UTC().Format("2006-01-02")
+	request := &v1pb.GenerateAISummaryRequest{TimeRange: "custom", StartDate: today, EndDate: today}
+
+	summary, err := ts.Service.GenerateAISummary(userCtx, request)
+	require.NoError(t, err)
+	received := receivePayloads()
+	require.Contains(t, received, "memos.ai.summary.started")
+	require.Equal(t, fmt.Sprintf("users/%d", user.ID), received["memos.ai.summary.started"].Creator)
+	require.Equal(t, &webhook.AISummaryPayload{TimeRange: "custom", StartDate: today, EndDate: today, SourceMemoCount: 2}, received["memos.ai.summary.started"].AISummary)
+	require.Contains(t, received, "memos.ai.summary.succeeded")
+	require.Equal(t, summary.Name, received["memos.ai.summary.succeeded"].Memo.Name)
+	require.Equal(t, 2, received["memos.ai.summary.succeeded"].AISummary.SourceMemoCount)
+
+	failing.Store(true)
+	_, err = ts.Service.GenerateAISummary(userCtx, request)
+	require.Error(t, err)
+	received = receivePayloads()
+	require.Contains(t, received, "memos.ai.summary.started")
+	require.Contains(t, received, "memos.ai.summary.failed")
+	require.Nil(t, received["memos.ai.summary.failed"].Memo)
+	require.Contains(t, received["memos.ai.summary.failed"].AISummary.Error, "failed to generate AI summary")
+}
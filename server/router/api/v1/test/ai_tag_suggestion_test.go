@@ -0,0 +1,230 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+)
+
+func TestSuggestTagMerges(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	vectors := map[string][]float64{
+		"todo":         {1, 0, 0},
+		"todos":        {0.99, 0.1, 0},
+		"task":         {0.9, 0.3, 0},
+		"garden":       {0, 0, 1},
+		"work":         {0, 1, 0},
+		"work/meeting": {0, 1, 0.05},
+		"secret":       {1, 0, 0},
+	}
+	inputs := []string{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Input []string `json:"input"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		data := []map[string]any{}
+		for i, input := range body.Input {
+			inputs = append(inputs, input)
+			data = append(data, map[string]any{"object": "embedding", "index": i, "embedding": vectors[input]})
+		}
+		response, err := json.Marshal(map[string]any{
+			"object": "list",
+			"model":  "text-embedding-3-small",
+			"data":   data,
+			"usage":  map[string]any{"prompt_tokens": 5, "total_tokens": 5},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.SuggestTagMerges(userCtx, &v1pb.SuggestTagMergesRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{
+				Endpoint:       aiServer.URL,
+				ApiKey:         "key",
+				Model:          "gpt-4o-mini",
+				EmbeddingModel: "text-embedding-3-small",
+				Redaction:      &storepb.WorkspaceAISetting_Redaction{Tags: []string{"secret"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	for _, content := range []string{"#todo one", "#todo two", "#todo three", "#todos four", "#task five", "#garden", "#work", "#work/meeting", "#secret"} {
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}})
+		require.NoError(t, err)
+	}
+
+	response, err := ts.Service.SuggestTagMerges(userCtx, &v1pb.SuggestTagMergesRequest{})
+	require.NoError(t, err)
+	// The near-duplicates are merged into the most used tag, and a parent tag is kept apart from its children.
+	require.Len(t, response.Suggestions, 2)
+	require.Equal(t, "todos", response.Suggestions[0].Tag)
+	require.Equal(t, "todo", response.Suggestions[0].TargetTag)
+	require.Equal(t, int32(1), response.Suggestions[0].MemoCount)
+	require.Equal(t, int32(3), response.Suggestions[0].TargetMemoCount)
+	require.Equal(t, "task", response.Suggestions[1].Tag)
+	require.Equal(t, "todo", response.Suggestions[1].TargetTag)
+	require.Greater(t, response.Suggestions[0].Similarity, response.Suggestions[1].Similarity)
+	require.NotContains(t, inputs, "secret")
+
+	response, err = ts.Service.SuggestTagMerges(userCtx, &v1pb.SuggestTagMergesRequest{SimilarityThreshold: 0.99})
+	require.NoError(t, err)
+	require.Len(t, response.Suggestions, 1)
+
+	_, err = ts.Service.SuggestTagMerges(userCtx, &v1pb.SuggestTagMergesRequest{SimilarityThreshold: 2})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSuggestMemoTags(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	reply := `{"tags": ["#Work", "meeting-notes", "garden", "not a tag", "todo"]}`
+	prompts := []string{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []struct {
+				Content string `json:"content"`
+			} `json:"messages"`
+			ResponseFormat struct {
+				Type string `json:"type"`
+			} `json:"response_format"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "json_schema", body.ResponseFormat.Type)
+		prompts = append(prompts, body.Messages[len(body.Messages)-1].Content)
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": reply}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 5, "total_tokens": 15},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	aiSetting := &storepb.WorkspaceAISetting{
+		Endpoint:  aiServer.URL,
+		ApiKey:    "key",
+		Model:     "gpt-4o-mini",
+		Redaction: &storepb.WorkspaceAISetting_Redaction{Tags: []string{"secret"}},
+	}
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: aiSetting},
+	})
+	require.NoError(t, err)
+
+	for _, content := range []string{"Weekly sync #work", "Budget review #work", "Passwords #secret"} {
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}})
+		require.NoError(t, err)
+	}
+
+	// The existing tags come first, and the tags already in the content are not suggested.
+	response, err := ts.Service.SuggestMemoTags(userCtx, &v1pb.SuggestMemoTagsRequest{Content: "Discussed the roadmap #todo"})
+	require.NoError(t, err)
+	require.Equal(t, []*v1pb.SuggestMemoTagsResponse_Suggestion{
+		{Tag: "work", Existing: true},
+		{Tag: "meeting-notes"},
+		{Tag: "garden"},
+	}, response.Suggestions)
+	require.Len(t, prompts, 1)
+	require.Contains(t, prompts[0], "Existing tags: work")
+	require.NotContains(t, prompts[0], "secret")
+
+	response, err = ts.Service.SuggestMemoTags(userCtx, &v1pb.SuggestMemoTagsRequest{Content: "Discussed the roadmap", MaxSuggestions: 2})
+	require.NoError(t, err)
+	require.Len(t, response.Suggestions, 2)
+	_, err = ts.Service.SuggestMemoTags(userCtx, &v1pb.SuggestMemoTagsRequest{Content: " "})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A reply that does not match the schema is sent back to be repaired, and fails after the repair attempts.
+	reply = "work, garden"
+	_, err = ts.Service.SuggestMemoTags(userCtx, &v1pb.SuggestMemoTagsRequest{Content: "Discussed the roadmap"})
+	require.Equal(t, codes.Internal, status.Code(err))
+	require.Len(t, prompts, 5)
+	require.Contains(t, prompts[4], "not valid JSON")
+	reply = `{"tags": ["#Work", "meeting-notes", "garden", "not a tag", "todo"]}`
+
+	// The suggested tags are applied automatically when enabled, and kept when the payload is rebuilt.
+	aiSetting.AutoTag = true
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: aiSetting},
+	})
+	require.NoError(t, err)
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planning the sprint #todo"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"todo", "work", "meeting-notes", "garden"}, memo.Tags)
+	storeMemo := getStoreMemo(ctx, t, ts, memo.Name)
+	require.NoError(t, memopayload.RebuildMemoPayload(storeMemo, ts.Service.MarkdownService))
+	require.Equal(t, []string{"todo", "work", "meeting-notes", "garden"}, storeMemo.Payload.Tags)
+
+	reply = `{"tags": ["errands"]}`
+	memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "Buy groceries"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"errands"}, memo.Tags)
+
+	// Saving the memo does not fail when the tags cannot be suggested.
+	aiServer.Close()
+	memo, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Offline #todo"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"todo"}, memo.Tags)
+
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: &storepb.WorkspaceAISetting{
+			Endpoint:        aiServer.URL,
+			ApiKey:          "key",
+			Model:           "gpt-4o-mini",
+			RolePermissions: map[string]*storepb.WorkspaceAISetting_RolePermission{"USER": {DisableTagSuggestion: true}},
+		}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.SuggestMemoTags(userCtx, &v1pb.SuggestMemoTagsRequest{Content: "Discussed the roadmap"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
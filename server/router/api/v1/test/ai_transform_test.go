@@ -0,0 +1,139 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestTransformMemo(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	systemPrompts, userPrompts := []string{}, []string{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []struct {
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		systemPrompts = append(systemPrompts, body.Messages[0].Content)
+		userPrompts = append(userPrompts, body.Messages[1].Content)
+		reply := "Guten Morgen, schreib an [EMAIL_1] #garden"
+		if !strings.HasPrefix(body.Messages[0].Content, "Translate") {
+			reply = "Good morning, write to [EMAIL_1]. #garden"
+		}
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": reply}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{
+				Endpoint:  aiServer.URL,
+				ApiKey:    "key",
+				Model:     "gpt-4o-mini",
+				Redaction: &storepb.WorkspaceAISetting_Redaction{RedactEmails: true},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "good morning, write to jane@example.com #garden", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.TransformMemo(userCtx, &v1pb.TransformMemoRequest{Name: memo.Name, Action: v1pb.TransformMemoRequest_TRANSLATE})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Empty(t, systemPrompts)
+
+	// The result is returned with the redacted content restored.
+	response, err := ts.Service.TransformMemo(userCtx, &v1pb.TransformMemoRequest{Name: memo.Name, Action: v1pb.TransformMemoRequest_FIX_GRAMMAR})
+	require.NoError(t, err)
+	require.Equal(t, "Good morning, write to jane@example.com. #garden", response.Content)
+	require.Nil(t, response.Memo)
+	require.Equal(t, "good morning, write to [EMAIL_1] #garden", userPrompts[0])
+	require.Contains(t, systemPrompts[0], "grammar")
+
+	// A translation is saved as a translation of the memo, in the target language.
+	response, err = ts.Service.TransformMemo(userCtx, &v1pb.TransformMemoRequest{
+		Name:           memo.Name,
+		Action:         v1pb.TransformMemoRequest_TRANSLATE,
+		TargetLanguage: "DE",
+		CreateRevision: true,
+	})
+	require.NoError(t, err)
+	require.Contains(t, systemPrompts[1], "Translate the following memo to German.")
+	require.NotNil(t, response.Memo)
+	require.Equal(t, "Guten Morgen, schreib an jane@example.com #garden", response.Memo.Content)
+	require.Equal(t, "de", response.Memo.Language)
+	require.Equal(t, v1pb.Visibility_PROTECTED, response.Memo.Visibility)
+	require.Len(t, response.Memo.Relations, 1)
+	require.Equal(t, v1pb.MemoRelation_TRANSLATION_OF, response.Memo.Relations[0].Type)
+	require.Equal(t, memo.Name, response.Memo.Relations[0].RelatedMemo.Name)
+
+	// The other actions are saved as revisions of the memo.
+	response, err = ts.Service.TransformMemo(userCtx, &v1pb.TransformMemoRequest{
+		Name:           memo.Name,
+		Action:         v1pb.TransformMemoRequest_CONDENSE,
+		CreateRevision: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.MemoRelation_REVISION_OF, response.Memo.Relations[0].Type)
+
+	// The memos the user cannot see cannot be transformed.
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	private, err := ts.Service.CreateMemo(ts.CreateUserContext(ctx, other.ID), &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "My secret", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.TransformMemo(userCtx, &v1pb.TransformMemoRequest{Name: private.Name, Action: v1pb.TransformMemoRequest_EXPAND})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The roles can be denied the transformations.
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{
+				Endpoint: aiServer.URL,
+				ApiKey:   "key",
+				Model:    "gpt-4o-mini",
+				RolePermissions: map[string]*storepb.WorkspaceAISetting_RolePermission{
+					"USER": {DisableTransform: true},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.TransformMemo(userCtx, &v1pb.TransformMemoRequest{Name: memo.Name, Action: v1pb.TransformMemoRequest_EXPAND})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Len(t, systemPrompts, 3)
+}
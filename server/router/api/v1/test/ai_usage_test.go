@@ -0,0 +1,127 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestAIUsageAccounting(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	failing := false
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if failing {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","object":"chat.completion","model":"gpt-4o-mini","choices":[{"index":0,"message":{"role":"assistant","content":"{\"tags\":[\"work\"]}"}}],"usage":{"prompt_tokens":40,"completion_tokens":10,"total_tokens":50}}`))
+	}))
+	defer aiServer.Close()
+	configure := func(monthlyTokenBudget int64) {
+		_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+			Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: &storepb.WorkspaceAISetting{
+				Endpoint:             aiServer.URL,
+				ApiKey:               "key",
+				Model:                "gpt-4o-mini",
+				PromptTokenPrice:     2,
+				CompletionTokenPrice: 10,
+				RolePermissions: map[string]*storepb.WorkspaceAISetting_RolePermission{
+					"USER": {HourlyRequestLimit: -1, MonthlyTokenBudget: monthlyTokenBudget},
+				},
+			}},
+		})
+		require.NoError(t, err)
+	}
+	suggest := func(ctx context.Context) error {
+		_, err := ts.Service.SuggestMemoTags(ctx, &v1pb.SuggestMemoTagsRequest{Content: "Weekly sync"})
+		return err
+	}
+
+	// Each call is recorded with its tokens, the failed ones too.
+	configure(100)
+	require.NoError(t, suggest(userCtx))
+	require.NoError(t, suggest(hostCtx))
+	failing = true
+	require.Error(t, suggest(userCtx))
+	failing = false
+
+	_, err = ts.Service.ListAIUsage(userCtx, &v1pb.ListAIUsageRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	response, err := ts.Service.ListAIUsage(hostCtx, &v1pb.ListAIUsageRequest{User: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Len(t, response.Records, 2)
+	records := map[bool]*v1pb.AIUsageRecord{}
+	for _, record := range response.Records {
+		records[record.Success] = record
+	}
+	require.Equal(t, fmt.Sprintf("users/%d", user.ID), records[true].User)
+	require.Equal(t, "tag_suggestion", records[true].Operation)
+	require.Equal(t, "gpt-4o-mini", records[true].Model)
+	require.Equal(t, int64(40), records[true].PromptTokens)
+	require.Equal(t, int64(10), records[true].CompletionTokens)
+	require.Contains(t, records[false].Error, "400")
+
+	response, err = ts.Service.ListAIUsage(hostCtx, &v1pb.ListAIUsageRequest{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, response.Records, 2)
+	require.NotEmpty(t, response.NextPageToken)
+	response, err = ts.Service.ListAIUsage(hostCtx, &v1pb.ListAIUsageRequest{PageToken: response.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, response.Records, 1)
+	require.Empty(t, response.NextPageToken)
+
+	_, err = ts.Service.GetAIUsageStats(userCtx, &v1pb.GetAIUsageStatsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	stats, err := ts.Service.GetAIUsageStats(hostCtx, &v1pb.GetAIUsageStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), stats.Total.Calls)
+	require.Equal(t, int64(1), stats.Total.Failures)
+	require.Equal(t, int64(80), stats.Total.PromptTokens)
+	require.Equal(t, int64(20), stats.Total.CompletionTokens)
+	require.InDelta(t, (80*2+20*10)/1_000_000.0, stats.Total.EstimatedCost, 1e-12)
+	require.Len(t, stats.Users, 2)
+	// Both users used 50 tokens, the entries of a tie are sorted by user.
+	require.Equal(t, fmt.Sprintf("users/%d", host.ID), stats.Users[0].Key)
+	require.Equal(t, int64(1), stats.Users[0].Calls)
+	require.Equal(t, int64(2), stats.Users[1].Calls)
+	require.Len(t, stats.Operations, 1)
+	require.Equal(t, "tag_suggestion", stats.Operations[0].Key)
+	require.Equal(t, int64(3), stats.Operations[0].Calls)
+
+	// The user has used 50 of their 100 tokens, and may make another call.
+	usage, err := ts.Service.GetAIUsage(userCtx, &v1pb.GetAIUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), usage.MonthlyTokenBudget)
+	require.Equal(t, int64(50), usage.MonthlyTokensUsed)
+	require.NoError(t, suggest(userCtx))
+	err = suggest(userCtx)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Contains(t, err.Error(), "token budget exceeded")
+	// The budget applies per user, the host role has none.
+	require.NoError(t, suggest(hostCtx))
+
+	configure(0)
+	require.NoError(t, suggest(userCtx))
+}
@@ -0,0 +1,111 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestCreateVoiceMemo(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	structure := true
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/audio/transcriptions":
+			require.NoError(t, r.ParseMultipartForm(1<<20))
+			require.Equal(t, "whisper-1", r.FormValue("model"))
+			require.Equal(t, "en", r.FormValue("language"))
+			file, header, err := r.FormFile("file")
+			require.NoError(t, err)
+			content, err := io.ReadAll(file)
+			require.NoError(t, err)
+			require.Equal(t, "recording.webm", header.Filename)
+			require.Equal(t, "fake audio", string(content))
+			_, _ = w.Write([]byte(`{"text": "uh so remember to call the plumber and um buy paint"}`))
+		case "/chat/completions":
+			if !structure {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error": {"message": "model unavailable"}}`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":     "completion",
+				"object": "chat.completion",
+				"model":  "gpt-4o-mini",
+				"choices": []map[string]any{{
+					"index":         0,
+					"finish_reason": "stop",
+					"message": map[string]any{
+						"role":    "assistant",
+						"content": "```markdown\n- [ ] Call the plumber\n- [ ] Buy paint\n\n#home\n```",
+					},
+				}},
+				"usage": map[string]any{"prompt_tokens": 10, "completion_tokens": 10, "total_tokens": 20},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer aiServer.Close()
+
+	request := &v1pb.CreateVoiceMemoRequest{
+		Audio:    &v1pb.Attachment{Filename: "recording.webm", Type: "audio/webm", Content: []byte("fake audio")},
+		Language: "en",
+	}
+	aiSetting := &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"}
+	upsertAISetting := func() {
+		_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key:   storepb.WorkspaceSettingKey_AI_CONFIG,
+			Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: aiSetting},
+		})
+		require.NoError(t, err)
+	}
+	upsertAISetting()
+
+	// Voice memos are disabled until a transcription model is configured.
+	_, err = ts.Service.CreateVoiceMemo(userCtx, request)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	aiSetting.TranscriptionModel = "whisper-1"
+	upsertAISetting()
+
+	memo, err := ts.Service.CreateVoiceMemo(userCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, "- [ ] Call the plumber\n- [ ] Buy paint\n\n#home\n\n#voice", memo.Content)
+	require.ElementsMatch(t, []string{"home", "voice"}, memo.Tags)
+	require.Len(t, memo.Attachments, 1)
+	require.Equal(t, "recording.webm", memo.Attachments[0].Filename)
+	require.Equal(t, "audio/webm", memo.Attachments[0].Type)
+
+	// The raw transcript is kept when the model fails to structure it.
+	structure = false
+	memo, err = ts.Service.CreateVoiceMemo(userCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, "uh so remember to call the plumber and um buy paint\n\n#voice", memo.Content)
+	require.Len(t, memo.Attachments, 1)
+
+	_, err = ts.Service.CreateVoiceMemo(userCtx, &v1pb.CreateVoiceMemoRequest{
+		Audio: &v1pb.Attachment{Filename: "notes.txt", Type: "text/plain", Content: []byte("text")},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.CreateVoiceMemo(ctx, request)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
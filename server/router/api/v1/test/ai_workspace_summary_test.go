@@ -0,0 +1,137 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestGenerateWorkspaceAISummary(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	prompts := []string{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []struct {
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		prompts = append(prompts, body.Messages[len(body.Messages)-1].Content)
+		response, err := json.Marshal(map[string]any{
+			"id":      "completion",
+			"object":  "chat.completion",
+			"model":   "gpt-4o-mini",
+			"choices": []map[string]any{{"index": 0, "message": map[string]any{"role": "assistant", "content": strings.Repeat("The team shipped the release and planned the offsite. ", 3)}}},
+			"usage":   map[string]any{"prompt_tokens": 10, "completion_tokens": 20, "total_tokens": 30},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: &storepb.WorkspaceAISetting{
+			Endpoint:                   aiServer.URL,
+			ApiKey:                     "key",
+			Model:                      "gpt-4o-mini",
+			WorkspaceSummaryDailyLimit: 1,
+		}},
+	})
+	require.NoError(t, err)
+
+	for _, memo := range []struct {
+		ctx        context.Context
+		content    string
+		visibility v1pb.Visibility
+	}{
+		{aliceCtx, "Shipped the release #release", v1pb.Visibility_PUBLIC},
+		{bobCtx, "Planned the offsite #team", v1pb.Visibility_PROTECTED},
+		{bobCtx, "My salary negotiation", v1pb.Visibility_PRIVATE},
+	} {
+		_, err := ts.Service.CreateMemo(memo.ctx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: memo.content, Visibility: memo.visibility}})
+		require.NoError(t, err)
+	}
+
+	// The memos of the other users are only sent to the AI provider if they opted in.
+	for _, user := range []struct {
+		ctx context.Context
+		id  int32
+	}{{aliceCtx, alice.ID}, {bobCtx, bob.ID}} {
+		_, err := ts.Service.UpdateUserSetting(user.ctx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name:  fmt.Sprintf("users/%d/settings/GENERAL", user.id),
+				Value: &v1pb.UserSetting_GeneralSetting_{GeneralSetting: &v1pb.UserSetting_GeneralSetting{AiOptIn: true}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"aiOptIn"}},
+		})
+		require.NoError(t, err)
+	}
+
+	// Only the host generates the workspace summaries, and a public summary only covers public memos.
+	today := time.Now().UTC()
+	request := &v1pb.GenerateWorkspaceAISummaryRequest{
+		TimeRange: "custom",
+		StartDate: today.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:   today.Format("2006-01-02"),
+	}
+	_, err = ts.Service.GenerateWorkspaceAISummary(aliceCtx, request)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.GenerateWorkspaceAISummary(hostCtx, &v1pb.GenerateWorkspaceAISummaryRequest{
+		TimeRange:  request.TimeRange,
+		StartDate:  request.StartDate,
+		EndDate:    request.EndDate,
+		Visibility: v1pb.Visibility_PUBLIC,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Empty(t, prompts)
+
+	memo, err := ts.Service.GenerateWorkspaceAISummary(hostCtx, request)
+	require.NoError(t, err)
+	require.True(t, memo.Pinned)
+	require.Equal(t, "users/0", memo.Creator)
+	require.Equal(t, v1pb.Visibility_PROTECTED, memo.Visibility)
+	require.Len(t, prompts, 1)
+	require.Contains(t, prompts[0], "(@alice)")
+	require.Contains(t, prompts[0], "Shipped the release #release")
+	require.Contains(t, prompts[0], "(@bob)")
+	require.Contains(t, prompts[0], "#team")
+	require.NotContains(t, prompts[0], "salary")
+
+	// The workspace summaries have their own daily limit.
+	_, err = ts.Service.GenerateWorkspaceAISummary(hostCtx, request)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = ts.Service.GenerateAISummary(aliceCtx, &v1pb.GenerateAISummaryRequest{
+		TimeRange: request.TimeRange,
+		StartDate: request.StartDate,
+		EndDate:   request.EndDate,
+	})
+	require.NoError(t, err)
+}
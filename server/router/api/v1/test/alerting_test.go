@@ -0,0 +1,119 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/alert"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestAlerting(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alerts := make(chan alert.Alert, 10)
+	alertServer := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		received := alert.Alert{}
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		alerts <- received
+	}))
+	defer alertServer.Close()
+	failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failingServer.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	updateChannels := func(urls ...string) error {
+		channels := []*v1pb.WorkspaceSetting_AlertingSetting_AlertChannel{}
+		for _, url := range urls {
+			channels = append(channels, &v1pb.WorkspaceSetting_AlertingSetting_AlertChannel{
+				Type: v1pb.WorkspaceSetting_AlertingSetting_AlertChannel_WEBHOOK,
+				Url:  url,
+			})
+		}
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/ALERTING",
+				Value: &v1pb.WorkspaceSetting_AlertingSetting_{
+					AlertingSetting: &v1pb.WorkspaceSetting_AlertingSetting{Channels: channels},
+				},
+			},
+		})
+		return err
+	}
+
+	t.Run("the channels are validated and only the host manages them", func(t *testing.T) {
+		require.Equal(t, codes.InvalidArgument, status.Code(updateChannels("ftp://example.com")))
+		_, err := ts.Service.SendTestAlert(hostCtx, &v1pb.SendTestAlertRequest{})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		require.NoError(t, updateChannels(alertServer.URL, failingServer.URL))
+		_, err = ts.Service.GetWorkspaceSetting(userCtx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/ALERTING"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.SendTestAlert(userCtx, &v1pb.SendTestAlertRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("a test alert reports the failing channels", func(t *testing.T) {
+		response, err := ts.Service.SendTestAlert(hostCtx, &v1pb.SendTestAlertRequest{})
+		require.NoError(t, err)
+		require.Len(t, response.Errors, 1)
+		require.Contains(t, response.Errors[0], "channel 2")
+		require.Equal(t, "test", (<-alerts).Kind)
+	})
+
+	t.Run("persistent webhook delivery failures are alerted once", func(t *testing.T) {
+		require.NoError(t, updateChannels(alertServer.URL))
+		webhook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+			Parent:  fmt.Sprintf("users/%d", user.ID),
+			Webhook: &v1pb.UserWebhook{Url: failingServer.URL},
+		})
+		require.NoError(t, err)
+		for range 2 {
+			_, err := ts.Service.TestUserWebhook(userCtx, &v1pb.TestUserWebhookRequest{Name: webhook.Name})
+			require.NoError(t, err)
+		}
+
+		createMemo := func() {
+			_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+				Memo: &v1pb.Memo{Content: "hello", Visibility: v1pb.Visibility_PRIVATE},
+			})
+			require.NoError(t, err)
+		}
+		createMemo()
+		select {
+		case received := <-alerts:
+			require.Equal(t, "webhook_delivery", received.Kind)
+			require.Contains(t, received.Message, "500")
+		case <-time.After(5 * time.Second):
+			t.Fatal("webhook delivery failures were not alerted")
+		}
+
+		// The alerts of the same kind are rate limited.
+		createMemo()
+		select {
+		case received := <-alerts:
+			t.Fatalf("unexpected alert %q", received.Title)
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+}
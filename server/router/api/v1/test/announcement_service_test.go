@@ -0,0 +1,109 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestAnnouncements(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Only admins create announcements.
+	_, err = ts.Service.CreateAnnouncement(userCtx, &v1pb.CreateAnnouncementRequest{Announcement: &v1pb.Announcement{Content: "Hello"}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.CreateAnnouncement(hostCtx, &v1pb.CreateAnnouncementRequest{Announcement: &v1pb.Announcement{Content: " "}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	maintenance, err := ts.Service.CreateAnnouncement(hostCtx, &v1pb.CreateAnnouncementRequest{Announcement: &v1pb.Announcement{
+		Content:     "**Maintenance** on Sunday from 2am to 4am UTC.",
+		Severity:    v1pb.Announcement_WARNING,
+		EndTime:     timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Dismissible: true,
+	}})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Announcement_WARNING, maintenance.Severity)
+	require.Equal(t, fmt.Sprintf("users/%d", host.ID), maintenance.Creator)
+	scheduled, err := ts.Service.CreateAnnouncement(hostCtx, &v1pb.CreateAnnouncementRequest{Announcement: &v1pb.Announcement{
+		Content:   "The instance moves to a new server.",
+		Severity:  v1pb.Announcement_CRITICAL,
+		StartTime: timestamppb.New(time.Now().Add(time.Hour)),
+	}})
+	require.NoError(t, err)
+
+	// The users are notified in their inbox.
+	inboxes, err := ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Len(t, inboxes.Inboxes, 2)
+	require.Equal(t, v1pb.Inbox_ANNOUNCEMENT, inboxes.Inboxes[0].Type)
+	require.NotNil(t, inboxes.Inboxes[0].AnnouncementId)
+
+	// The scheduled announcement is not shown yet, to the users as to the visitors.
+	for _, listCtx := range []context.Context{userCtx, ctx} {
+		response, err := ts.Service.ListAnnouncements(listCtx, &v1pb.ListAnnouncementsRequest{})
+		require.NoError(t, err)
+		require.Len(t, response.Announcements, 1)
+		require.Equal(t, maintenance.Name, response.Announcements[0].Name)
+	}
+	_, err = ts.Service.ListAnnouncements(userCtx, &v1pb.ListAnnouncementsRequest{ShowAll: true})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	response, err := ts.Service.ListAnnouncements(hostCtx, &v1pb.ListAnnouncementsRequest{ShowAll: true})
+	require.NoError(t, err)
+	require.Len(t, response.Announcements, 2)
+
+	// The dismissed announcement is hidden from the user only.
+	_, err = ts.Service.DismissAnnouncement(userCtx, &v1pb.DismissAnnouncementRequest{Name: maintenance.Name})
+	require.NoError(t, err)
+	response, err = ts.Service.ListAnnouncements(userCtx, &v1pb.ListAnnouncementsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.Announcements)
+	response, err = ts.Service.ListAnnouncements(hostCtx, &v1pb.ListAnnouncementsRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Announcements, 1)
+	_, err = ts.Service.DismissAnnouncement(userCtx, &v1pb.DismissAnnouncementRequest{Name: scheduled.Name})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The scheduled announcement is shown once it starts.
+	scheduled.StartTime = timestamppb.New(time.Now().Add(-time.Minute))
+	_, err = ts.Service.UpdateAnnouncement(hostCtx, &v1pb.UpdateAnnouncementRequest{
+		Announcement: scheduled,
+		UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"start_time"}},
+	})
+	require.NoError(t, err)
+	response, err = ts.Service.ListAnnouncements(userCtx, &v1pb.ListAnnouncementsRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Announcements, 1)
+	require.Equal(t, scheduled.Name, response.Announcements[0].Name)
+
+	scheduled.EndTime = timestamppb.New(time.Now().Add(-time.Hour))
+	_, err = ts.Service.UpdateAnnouncement(hostCtx, &v1pb.UpdateAnnouncementRequest{
+		Announcement: scheduled,
+		UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"end_time"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = ts.Service.DeleteAnnouncement(hostCtx, &v1pb.DeleteAnnouncementRequest{Name: scheduled.Name})
+	require.NoError(t, err)
+	response, err = ts.Service.ListAnnouncements(userCtx, &v1pb.ListAnnouncementsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.Announcements)
+}
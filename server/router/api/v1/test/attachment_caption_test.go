@@ -0,0 +1,68 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestUpdateAttachmentCaption(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	attachment, err := ts.Service.CreateAttachment(authorCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "IMG_0001.txt", Type: "text/plain", Content: []byte("chart"), AltText: "A bar chart"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "A bar chart", attachment.AltText)
+
+	updateAttachment := func(ctx context.Context, attachment *v1pb.Attachment, paths ...string) (*v1pb.Attachment, error) {
+		return ts.Service.UpdateAttachment(ctx, &v1pb.UpdateAttachmentRequest{
+			Attachment: attachment,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+	}
+	updated, err := updateAttachment(authorCtx, &v1pb.Attachment{
+		Name:     attachment.Name,
+		Filename: "sales-2026.txt",
+		Caption:  "Figure 1. Sales by quarter.",
+		AltText:  "A bar chart of the sales growing each quarter",
+	}, "filename", "caption", "alt_text")
+	require.NoError(t, err)
+	require.Equal(t, "sales-2026.txt", updated.Filename)
+	require.Equal(t, "Figure 1. Sales by quarter.", updated.Caption)
+	require.Equal(t, "A bar chart of the sales growing each quarter", updated.AltText)
+
+	// The fields out of the update mask are kept.
+	updated, err = updateAttachment(authorCtx, &v1pb.Attachment{Name: attachment.Name}, "caption")
+	require.NoError(t, err)
+	require.Empty(t, updated.Caption)
+	require.Equal(t, "A bar chart of the sales growing each quarter", updated.AltText)
+	require.Equal(t, "sales-2026.txt", updated.Filename)
+
+	// Only the creator updates the attachment, with valid values.
+	_, err = updateAttachment(otherCtx, &v1pb.Attachment{Name: attachment.Name, Caption: "Mine"}, "caption")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = updateAttachment(authorCtx, &v1pb.Attachment{Name: attachment.Name, Filename: "../secret.txt"}, "filename")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = updateAttachment(authorCtx, &v1pb.Attachment{Name: attachment.Name, AltText: strings.Repeat("a", 1001)}, "alt_text")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = updateAttachment(authorCtx, &v1pb.Attachment{Name: attachment.Name}, "size")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
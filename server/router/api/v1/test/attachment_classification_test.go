@@ -0,0 +1,114 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/attachmentclassify"
+)
+
+func TestAttachmentClassification(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	viewer, err := ts.CreateRegularUser(ctx, "viewer")
+	require.NoError(t, err)
+	viewerCtx := ts.CreateUserContext(ctx, viewer.ID)
+
+	// The classifier tags every image as sensitive.
+	classifierServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"sensitive": true}`))
+	}))
+	defer classifierServer.Close()
+	updateSetting := func(setting *v1pb.WorkspaceSetting_SensitiveContentSetting) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name:  "workspace/settings/SENSITIVE_CONTENT",
+				Value: &v1pb.WorkspaceSetting_SensitiveContentSetting_{SensitiveContentSetting: setting},
+			},
+		})
+		return err
+	}
+	err = updateSetting(&v1pb.WorkspaceSetting_SensitiveContentSetting{ClassifierEndpoint: "ftp://classifier"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.NoError(t, updateSetting(&v1pb.WorkspaceSetting_SensitiveContentSetting{
+		ClassifierEndpoint: classifierServer.URL,
+		ClassifierApiKey:   "secret",
+		Policy:             v1pb.WorkspaceSetting_SensitiveContentSetting_BLUR,
+	}))
+
+	// The classifier configuration is only returned to admins.
+	setting, err := ts.Service.GetWorkspaceSetting(viewerCtx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/SENSITIVE_CONTENT"})
+	require.NoError(t, err)
+	require.Empty(t, setting.GetSensitiveContentSetting().ClassifierApiKey)
+	require.Equal(t, v1pb.WorkspaceSetting_SensitiveContentSetting_BLUR, setting.GetSensitiveContentSetting().Policy)
+
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for x := 0; x < 8; x++ {
+		img.Set(x, x, color.White)
+	}
+	var buffer bytes.Buffer
+	require.NoError(t, png.Encode(&buffer, img))
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "picture", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	attachment, err := ts.Service.CreateAttachment(authorCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "picture.png", Type: "image/png", Content: buffer.Bytes(), Memo: &memo.Name},
+	})
+	require.NoError(t, err)
+	require.False(t, attachment.Sensitive)
+
+	require.NoError(t, attachmentclassify.NewRunner(ts.Store, ts.Service.GetAttachmentBlob).RunOnce(ctx))
+	attachment, err = ts.Service.GetAttachment(authorCtx, &v1pb.GetAttachmentRequest{Name: attachment.Name})
+	require.NoError(t, err)
+	require.True(t, attachment.Sensitive)
+
+	// Sensitive images are blurred for other users unless revealed.
+	binary, err := ts.Service.GetAttachmentBinary(viewerCtx, &v1pb.GetAttachmentBinaryRequest{Name: attachment.Name})
+	require.NoError(t, err)
+	require.Equal(t, "image/jpeg", binary.ContentType)
+	binary, err = ts.Service.GetAttachmentBinary(viewerCtx, &v1pb.GetAttachmentBinaryRequest{Name: attachment.Name, Reveal: true})
+	require.NoError(t, err)
+	require.Equal(t, buffer.Bytes(), binary.Data)
+	binary, err = ts.Service.GetAttachmentBinary(authorCtx, &v1pb.GetAttachmentBinaryRequest{Name: attachment.Name})
+	require.NoError(t, err)
+	require.Equal(t, buffer.Bytes(), binary.Data)
+
+	// Restricted sensitive attachments are hidden from visitors.
+	require.NoError(t, updateSetting(&v1pb.WorkspaceSetting_SensitiveContentSetting{
+		ClassifierEndpoint: classifierServer.URL,
+		Policy:             v1pb.WorkspaceSetting_SensitiveContentSetting_RESTRICT,
+	}))
+	_, err = ts.Service.GetAttachmentBinary(ctx, &v1pb.GetAttachmentBinaryRequest{Name: attachment.Name})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	binary, err = ts.Service.GetAttachmentBinary(viewerCtx, &v1pb.GetAttachmentBinaryRequest{Name: attachment.Name})
+	require.NoError(t, err)
+	require.Equal(t, buffer.Bytes(), binary.Data)
+
+	visitorMemo, err := ts.Service.GetMemo(ctx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Empty(t, visitorMemo.Attachments)
+	viewerMemo, err := ts.Service.GetMemo(viewerCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, viewerMemo.Attachments, 1)
+}
@@ -0,0 +1,128 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestAttachmentMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	var buffer bytes.Buffer
+	require.NoError(t, png.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, 64, 48))))
+	picture, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "picture.png", Type: "image/png", Content: buffer.Bytes()},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, picture.Metadata)
+	require.Equal(t, int32(64), picture.Metadata.Width)
+	require.Equal(t, int32(48), picture.Metadata.Height)
+
+	// The metadata is stored with the attachment.
+	picture, err = ts.Service.GetAttachment(userCtx, &v1pb.GetAttachmentRequest{Name: picture.Name})
+	require.NoError(t, err)
+	require.Equal(t, int32(64), picture.Metadata.GetWidth())
+
+	document, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "notes.txt", Type: "text/plain", Content: []byte("hello world")},
+	})
+	require.NoError(t, err)
+	require.Nil(t, document.Metadata)
+
+	// Attachments can be filtered by type and size.
+	list, err := ts.Service.ListAttachments(userCtx, &v1pb.ListAttachmentsRequest{Filter: `mime_type.contains("image/")`})
+	require.NoError(t, err)
+	require.Len(t, list.Attachments, 1)
+	require.Equal(t, picture.Name, list.Attachments[0].Name)
+	list, err = ts.Service.ListAttachments(userCtx, &v1pb.ListAttachmentsRequest{Filter: `size < 100 && filename.contains("notes")`})
+	require.NoError(t, err)
+	require.Len(t, list.Attachments, 1)
+	require.Equal(t, document.Name, list.Attachments[0].Name)
+	list, err = ts.Service.ListAttachments(userCtx, &v1pb.ListAttachmentsRequest{Filter: `created_ts > now() - 60`})
+	require.NoError(t, err)
+	require.Len(t, list.Attachments, 2)
+
+	_, err = ts.Service.ListAttachments(userCtx, &v1pb.ListAttachmentsRequest{Filter: `content.contains("x")`})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListMediaAttachments(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	viewer, err := ts.CreateRegularUser(ctx, "viewer")
+	require.NoError(t, err)
+	viewerCtx := ts.CreateUserContext(ctx, viewer.ID)
+
+	var buffer bytes.Buffer
+	require.NoError(t, png.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, 4, 4))))
+	createMemoWithAttachment := func(visibility v1pb.Visibility, filename, contentType string) *v1pb.Attachment {
+		memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: filename, Visibility: visibility},
+		})
+		require.NoError(t, err)
+		attachment, err := ts.Service.CreateAttachment(authorCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: filename, Type: contentType, Content: buffer.Bytes(), Memo: &memo.Name},
+		})
+		require.NoError(t, err)
+		return attachment
+	}
+	public := createMemoWithAttachment(v1pb.Visibility_PUBLIC, "public.png", "image/png")
+	protected := createMemoWithAttachment(v1pb.Visibility_PROTECTED, "protected.png", "image/png")
+	private := createMemoWithAttachment(v1pb.Visibility_PRIVATE, "private.png", "image/png")
+	createMemoWithAttachment(v1pb.Visibility_PUBLIC, "public.txt", "text/plain")
+	// Attachments without a memo are not part of the media library.
+	_, err = ts.Service.CreateAttachment(authorCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "draft.png", Type: "image/png", Content: buffer.Bytes()},
+	})
+	require.NoError(t, err)
+
+	names := func(userCtx context.Context) []string {
+		response, err := ts.Service.ListMediaAttachments(userCtx, &v1pb.ListMediaAttachmentsRequest{})
+		require.NoError(t, err)
+		names := []string{}
+		for _, attachment := range response.Attachments {
+			names = append(names, attachment.Name)
+		}
+		return names
+	}
+	require.ElementsMatch(t, []string{public.Name}, names(ctx))
+	require.ElementsMatch(t, []string{public.Name, protected.Name}, names(viewerCtx))
+	require.ElementsMatch(t, []string{public.Name, protected.Name, private.Name}, names(authorCtx))
+
+	// The media library is paginated.
+	response, err := ts.Service.ListMediaAttachments(authorCtx, &v1pb.ListMediaAttachmentsRequest{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, response.Attachments, 2)
+	require.NotEmpty(t, response.NextPageToken)
+	response, err = ts.Service.ListMediaAttachments(authorCtx, &v1pb.ListMediaAttachmentsRequest{PageSize: 2, PageToken: response.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, response.Attachments, 1)
+	require.Empty(t, response.NextPageToken)
+
+	response, err = ts.Service.ListMediaAttachments(authorCtx, &v1pb.ListMediaAttachmentsRequest{Filter: `filename.contains("private")`})
+	require.NoError(t, err)
+	require.Len(t, response.Attachments, 1)
+	require.Equal(t, private.Name, response.Attachments[0].Name)
+}
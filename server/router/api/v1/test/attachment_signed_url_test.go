@@ -0,0 +1,80 @@
+package test
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestAttachmentSignedUrl(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Private photo", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	attachment, err := ts.Service.CreateAttachment(authorCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "notes.txt", Type: "text/plain", Content: []byte("hello"), Memo: &memo.Name},
+	})
+	require.NoError(t, err)
+
+	// The users who cannot see the attachment cannot sign URLs for it.
+	_, err = ts.Service.CreateAttachmentSignedUrl(otherCtx, &v1pb.CreateAttachmentSignedUrlRequest{Name: attachment.Name})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = ts.Service.CreateAttachmentSignedUrl(authorCtx, &v1pb.CreateAttachmentSignedUrlRequest{Name: attachment.Name, Ttl: durationpb.New(48 * time.Hour)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	signedURL, err := ts.Service.CreateAttachmentSignedUrl(authorCtx, &v1pb.CreateAttachmentSignedUrlRequest{Name: attachment.Name})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(signedURL.Url, "/file/"+attachment.Name+"/notes.txt?"))
+	require.WithinDuration(t, time.Now().Add(15*time.Minute), signedURL.ExpireTime.AsTime(), time.Minute)
+	parsed, err := url.Parse(signedURL.Url)
+	require.NoError(t, err)
+	expires, err := strconv.ParseInt(parsed.Query().Get("expires"), 10, 64)
+	require.NoError(t, err)
+	request := &v1pb.GetAttachmentBinaryRequest{
+		Name:      attachment.Name,
+		Filename:  "notes.txt",
+		Expires:   expires,
+		Signer:    parsed.Query().Get("signer"),
+		Signature: parsed.Query().Get("signature"),
+	}
+
+	// The signed URL is served without authentication.
+	_, err = ts.Service.GetAttachmentBinary(ctx, &v1pb.GetAttachmentBinaryRequest{Name: attachment.Name, Filename: "notes.txt"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	body, err := ts.Service.GetAttachmentBinary(ctx, request)
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), body.Data)
+
+	// The signature covers the attachment, the signer and the expiration.
+	tampered := proto.CloneOf(request)
+	tampered.Expires += 3600
+	_, err = ts.Service.GetAttachmentBinary(ctx, tampered)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	tampered = proto.CloneOf(request)
+	tampered.Signer = "users/" + strconv.Itoa(int(other.ID))
+	_, err = ts.Service.GetAttachmentBinary(ctx, tampered)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
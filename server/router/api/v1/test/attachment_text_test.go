@@ -0,0 +1,53 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestAttachmentTextSearch(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Meeting notes", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	document := "%PDF-1.4\n1 0 obj << /Length 44 >>\nstream\nBT /F1 12 Tf (Quarterly budget forecast) Tj ET\nendstream\nendobj\n"
+	attachment, err := ts.Service.CreateAttachment(authorCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "budget.pdf", Type: "application/pdf", Content: []byte(document), Memo: &memo.Name},
+	})
+	require.NoError(t, err)
+
+	attachmentText, err := ts.Service.GetAttachmentText(authorCtx, &v1pb.GetAttachmentTextRequest{Name: attachment.Name})
+	require.NoError(t, err)
+	require.Equal(t, "Quarterly budget forecast", attachmentText.Text)
+
+	// Searching the memo content also searches the text of the attached documents.
+	memos, err := ts.Service.ListMemos(authorCtx, &v1pb.ListMemosRequest{Filter: `content.contains("budget forecast")`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	require.Equal(t, memo.Name, memos.Memos[0].Name)
+	memos, err = ts.Service.ListMemos(authorCtx, &v1pb.ListMemosRequest{Filter: `content.contains("meeting")`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	memos, err = ts.Service.ListMemos(authorCtx, &v1pb.ListMemosRequest{Filter: `content.contains("invoice")`})
+	require.NoError(t, err)
+	require.Empty(t, memos.Memos)
+
+	// The text of the attachments of private memos is only available to their creator.
+	_, err = ts.Service.GetAttachmentText(ctx, &v1pb.GetAttachmentTextRequest{Name: attachment.Name})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,151 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestBlueskyCrossPost(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	// The server accepts the app password "secret" of alice.test.
+	var mutex sync.Mutex
+	posts := map[string]map[string]any{}
+	records := make(chan string, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		request := map[string]any{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		if r.URL.Path != "/xrpc/com.atproto.server.createSession" && r.Header.Get("Authorization") != "Bearer jwt" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/xrpc/com.atproto.server.createSession":
+			if request["identifier"] != "alice.test" || request["password"] != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"AuthenticationRequired","message":"Invalid identifier or password"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"did":"did:plc:alice","handle":"alice.test","accessJwt":"jwt"}`))
+		case "/xrpc/com.atproto.repo.createRecord":
+			require.Equal(t, "did:plc:alice", request["repo"])
+			require.Equal(t, "app.bsky.feed.post", request["collection"])
+			rkey := fmt.Sprintf("post%d", len(posts)+1)
+			posts[rkey] = request["record"].(map[string]any)
+			records <- "create " + rkey
+			_, _ = w.Write([]byte(`{"uri":"at://did:plc:alice/app.bsky.feed.post/` + rkey + `","cid":"cid"}`))
+		case "/xrpc/com.atproto.repo.deleteRecord":
+			rkey := request["rkey"].(string)
+			delete(posts, rkey)
+			records <- "delete " + rkey
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	nextRecord := func() string {
+		select {
+		case record := <-records:
+			return record
+		case <-time.After(5 * time.Second):
+			t.Fatal("no record changed")
+			return ""
+		}
+	}
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	settingName := fmt.Sprintf("users/%d/settings/BLUESKY", user.ID)
+	updateSetting := func(setting *v1pb.UserSetting_BlueskySetting, paths ...string) (*v1pb.UserSetting, error) {
+		return ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting:    &v1pb.UserSetting{Name: settingName, Value: &v1pb.UserSetting_BlueskySetting_{BlueskySetting: setting}},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+	}
+
+	setting, err := ts.Service.GetUserSetting(userCtx, &v1pb.GetUserSettingRequest{Name: settingName})
+	require.NoError(t, err)
+	require.Equal(t, "https://bsky.social", setting.GetBlueskySetting().Service)
+
+	// The cross-posting cannot be enabled without valid credentials.
+	_, err = updateSetting(&v1pb.UserSetting_BlueskySetting{Enabled: true}, "enabled")
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = updateSetting(&v1pb.UserSetting_BlueskySetting{Service: server.URL, Identifier: "alice.test", AppPassword: "wrong"}, "service", "identifier", "appPassword")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	setting, err = updateSetting(&v1pb.UserSetting_BlueskySetting{
+		Enabled:     true,
+		Service:     server.URL,
+		Identifier:  "@alice.test",
+		AppPassword: "secret",
+		Tag:         "#blog",
+	}, "enabled", "service", "identifier", "appPassword", "tag")
+	require.NoError(t, err)
+	require.Equal(t, "did:plc:alice", setting.GetBlueskySetting().Did)
+	require.Equal(t, "blog", setting.GetBlueskySetting().Tag)
+	require.Empty(t, setting.GetBlueskySetting().AppPassword)
+
+	// Only the public memos with the tag are cross-posted.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Private #blog", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Untagged", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	content := "First post #blog/travel " + strings.Repeat("word ", 100)
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	require.Equal(t, "create post1", nextRecord())
+	mutex.Lock()
+	text := posts["post1"]["text"].(string)
+	mutex.Unlock()
+	link := "http://localhost:8080/" + memo.Name
+	require.True(t, strings.HasPrefix(text, "First post #blog/travel word"))
+	require.True(t, strings.HasSuffix(text, "…\n\n"+link))
+	require.LessOrEqual(t, len([]rune(text)), 300)
+	syndicated := waitForSyndications(t, ts, userCtx, memo.Name, "bluesky")
+	require.Equal(t, "https://bsky.app/profile/did:plc:alice/post/post1", syndicated.Syndications[0].Url)
+
+	// An edit does not post the memo again, making it private deletes its post.
+	memo.Content = "First post edited #blog"
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: memo, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}}})
+	require.NoError(t, err)
+	memo.Visibility = v1pb.Visibility_PRIVATE
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: memo, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}}})
+	require.NoError(t, err)
+	require.Equal(t, "delete post1", nextRecord())
+
+	// Making it public again posts it again, deleting it deletes the new post.
+	memo.Visibility = v1pb.Visibility_PUBLIC
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: memo, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}}})
+	require.NoError(t, err)
+	require.Equal(t, "create post1", nextRecord())
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "delete post1", nextRecord())
+
+	select {
+	case record := <-records:
+		t.Fatalf("unexpected record change: %s", record)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
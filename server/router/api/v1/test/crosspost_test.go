@@ -0,0 +1,247 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// waitForSyndications waits until the memo has the syndications of the platforms.
+func waitForSyndications(t *testing.T, ts *TestService, ctx context.Context, name string, platforms ...string) *v1pb.Memo {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		memo, err := ts.Service.GetMemo(ctx, &v1pb.GetMemoRequest{Name: name})
+		require.NoError(t, err)
+		got := []string{}
+		for _, syndication := range memo.Syndications {
+			got = append(got, syndication.Platform)
+		}
+		if strings.Join(got, ",") == strings.Join(platforms, ",") {
+			return memo
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("syndications of %s are %v, expected %v", name, got, platforms)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestMastodonCrossPost(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	var mutex sync.Mutex
+	statuses := map[string]map[string]any{}
+	uploads := []string{}
+	deleted := []string{}
+	instance := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"The access token is invalid"}`))
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/accounts/verify_credentials":
+			_, _ = w.Write([]byte(`{"acct":"alice","url":"https://mastodon.example/@alice"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/media":
+			file, header, err := r.FormFile("file")
+			require.NoError(t, err)
+			content, _ := io.ReadAll(file)
+			uploads = append(uploads, header.Filename+":"+string(content)+":"+r.FormValue("description"))
+			_, _ = fmt.Fprintf(w, `{"id":"media%d"}`, len(uploads))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/statuses":
+			status := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&status))
+			id := fmt.Sprintf("%d", len(statuses)+1)
+			statuses[id] = status
+			_, _ = fmt.Fprintf(w, `{"id":"%s","url":"https://mastodon.example/@alice/%s"}`, id, id)
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v1/statuses/"):
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/api/v1/statuses/"))
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer instance.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	settingName := fmt.Sprintf("users/%d/settings/MASTODON", user.ID)
+	updateSetting := func(setting *v1pb.UserSetting_MastodonSetting, paths ...string) (*v1pb.UserSetting, error) {
+		return ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting:    &v1pb.UserSetting{Name: settingName, Value: &v1pb.UserSetting_MastodonSetting_{MastodonSetting: setting}},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+	}
+
+	_, err = updateSetting(&v1pb.UserSetting_MastodonSetting{Enabled: true}, "enabled")
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = updateSetting(&v1pb.UserSetting_MastodonSetting{InstanceUrl: instance.URL, AccessToken: "wrong"}, "instanceUrl", "accessToken")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	setting, err := updateSetting(&v1pb.UserSetting_MastodonSetting{Enabled: true, InstanceUrl: instance.URL + "/", AccessToken: "token"}, "enabled", "instanceUrl", "accessToken")
+	require.NoError(t, err)
+	require.Equal(t, instance.URL, setting.GetMastodonSetting().InstanceUrl)
+	require.Equal(t, "alice@"+strings.TrimPrefix(instance.URL, "http://"), setting.GetMastodonSetting().Account)
+	require.Empty(t, setting.GetMastodonSetting().AccessToken)
+
+	// The memo is posted with its image attachments and its content warning.
+	photo, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "photo.png", Type: "image/png", Content: []byte("png"), AltText: "A cat"},
+	})
+	require.NoError(t, err)
+	document, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "notes.txt", Type: "text/plain", Content: []byte("notes")},
+	})
+	require.NoError(t, err)
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:        "Spoilers ahead",
+		Visibility:     v1pb.Visibility_PUBLIC,
+		ContentWarning: "film",
+		Attachments:    []*v1pb.Attachment{{Name: photo.Name}, {Name: document.Name}},
+	}})
+	require.NoError(t, err)
+	memo = waitForSyndications(t, ts, userCtx, memo.Name, "mastodon")
+	require.Equal(t, "https://mastodon.example/@alice/1", memo.Syndications[0].Url)
+	mutex.Lock()
+	require.Equal(t, []string{"photo.png:png:A cat"}, uploads)
+	require.Equal(t, "Spoilers ahead\n\nhttp://localhost:8080/"+memo.Name, statuses["1"]["status"])
+	require.Equal(t, "film", statuses["1"]["spoiler_text"])
+	require.Equal(t, true, statuses["1"]["sensitive"])
+	require.Equal(t, "public", statuses["1"]["visibility"])
+	require.Equal(t, []any{"media1"}, statuses["1"]["media_ids"])
+	mutex.Unlock()
+
+	// Archiving the memo deletes its status.
+	memo.State = v1pb.State_ARCHIVED
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: memo, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}}})
+	require.NoError(t, err)
+	waitForSyndications(t, ts, userCtx, memo.Name)
+	mutex.Lock()
+	require.Equal(t, []string{"1"}, deleted)
+	mutex.Unlock()
+
+	// Deleting a posted memo deletes its status.
+	memo, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Hello", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	waitForSyndications(t, ts, userCtx, memo.Name, "mastodon")
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(deleted) == 2 && deleted[1] == "2"
+	}, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestMicropubCrossPost(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	var mutex sync.Mutex
+	entries := []map[string]any{}
+	deleted := []string{}
+	var site *httptest.Server
+	site = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/micropub" && r.URL.Query().Get("q") == "config":
+			_, _ = fmt.Fprintf(w, `{"media-endpoint":"%s/media"}`, site.URL)
+		case r.Method == http.MethodPost && r.URL.Path == "/media":
+			_, header, err := r.FormFile("file")
+			require.NoError(t, err)
+			w.Header().Set("Location", "/media/"+header.Filename)
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPost && r.URL.Path == "/micropub":
+			request := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			if request["action"] == "delete" {
+				deleted = append(deleted, request["url"].(string))
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			entries = append(entries, request)
+			w.Header().Set("Location", fmt.Sprintf("%s/notes/%d", site.URL, len(entries)))
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer site.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	setting, err := ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/MICROPUB", user.ID),
+			Value: &v1pb.UserSetting_MicropubSetting_{MicropubSetting: &v1pb.UserSetting_MicropubSetting{
+				Enabled:     true,
+				Endpoint:    site.URL + "/micropub",
+				AccessToken: "token",
+				Tag:         "blog",
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"enabled", "endpoint", "accessToken", "tag"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, site.URL+"/media", setting.GetMicropubSetting().MediaEndpoint)
+
+	photo, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "photo.png", Type: "image/png", Content: []byte("png")},
+	})
+	require.NoError(t, err)
+	untagged, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Untagged", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:     "Hello #blog",
+		Visibility:  v1pb.Visibility_PUBLIC,
+		Attachments: []*v1pb.Attachment{{Name: photo.Name}},
+	}})
+	require.NoError(t, err)
+	memo = waitForSyndications(t, ts, userCtx, memo.Name, "micropub")
+	require.Equal(t, site.URL+"/notes/1", memo.Syndications[0].Url)
+	waitForSyndications(t, ts, userCtx, untagged.Name)
+	mutex.Lock()
+	require.Len(t, entries, 1)
+	require.Equal(t, []any{"h-entry"}, entries[0]["type"])
+	properties := entries[0]["properties"].(map[string]any)
+	require.Equal(t, []any{"Hello #blog"}, properties["content"])
+	require.Equal(t, []any{"blog"}, properties["category"])
+	require.Equal(t, []any{site.URL + "/media/photo.png"}, properties["photo"])
+	mutex.Unlock()
+
+	// Removing the tag deletes the entry.
+	memo.Content = "Hello"
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: memo, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}}})
+	require.NoError(t, err)
+	waitForSyndications(t, ts, userCtx, memo.Name)
+	mutex.Lock()
+	require.Equal(t, []string{site.URL + "/notes/1"}, deleted)
+	mutex.Unlock()
+}
@@ -0,0 +1,165 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestDeadLetterWebhookRetry(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	var failing atomic.Bool
+	failing.Store(true)
+	var delivered atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		delivered.Add(1)
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &v1pb.UserWebhook{Url: server.URL},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "hello", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	var deadLetter *v1pb.DeadLetter
+	require.Eventually(t, func() bool {
+		response, err := ts.Service.ListDeadLetters(hostCtx, &v1pb.ListDeadLettersRequest{})
+		require.NoError(t, err)
+		if len(response.DeadLetters) != 1 {
+			return false
+		}
+		deadLetter = response.DeadLetters[0]
+		return true
+	}, 5*time.Second, 20*time.Millisecond)
+	require.Equal(t, v1pb.DeadLetter_WEBHOOK, deadLetter.JobType)
+	require.Equal(t, fmt.Sprintf("users/%d", user.ID), deadLetter.User)
+	require.Equal(t, server.URL, deadLetter.Target)
+	require.Equal(t, int32(1), deadLetter.Attempts)
+	require.Contains(t, deadLetter.Error, "503")
+
+	// Only admins can see the dead letters.
+	_, err = ts.Service.ListDeadLetters(userCtx, &v1pb.ListDeadLettersRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// A failed retry keeps the dead letter and counts the attempt.
+	_, err = ts.Service.RetryDeadLetter(hostCtx, &v1pb.RetryDeadLetterRequest{Name: deadLetter.Name})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	response, err := ts.Service.ListDeadLetters(hostCtx, &v1pb.ListDeadLettersRequest{JobType: v1pb.DeadLetter_WEBHOOK})
+	require.NoError(t, err)
+	require.Len(t, response.DeadLetters, 1)
+	require.Equal(t, int32(2), response.DeadLetters[0].Attempts)
+
+	// A successful retry removes it.
+	failing.Store(false)
+	_, err = ts.Service.RetryDeadLetter(hostCtx, &v1pb.RetryDeadLetterRequest{Name: deadLetter.Name})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), delivered.Load())
+	response, err = ts.Service.ListDeadLetters(hostCtx, &v1pb.ListDeadLettersRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.DeadLetters)
+}
+
+func TestDeadLetterAlertAndDelete(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Fill the queue up to one below the alert threshold.
+	for i := 0; i < 9; i++ {
+		_, err := ts.Store.CreateDeadLetter(ctx, &store.DeadLetter{
+			JobType: store.DeadLetterJobTypeAISummary,
+			UserID:  user.ID,
+			Payload: &storepb.DeadLetterPayload{
+				Payload: &storepb.DeadLetterPayload_AiSummary{
+					AiSummary: &storepb.DeadLetterPayload_AISummary{TimeRange: "7d"},
+				},
+			},
+			Error: "AI API returned no choices",
+		})
+		require.NoError(t, err)
+	}
+
+	_, err = ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &v1pb.UserWebhook{Url: server.URL},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "hello", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	// The failed webhook reaches the threshold and the admin is alerted.
+	require.Eventually(t, func() bool {
+		response, err := ts.Service.ListInboxes(hostCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", hostUser.ID)})
+		require.NoError(t, err)
+		return len(response.Inboxes) == 1 && response.Inboxes[0].Type == v1pb.Inbox_DEAD_LETTER_ALERT
+	}, 5*time.Second, 20*time.Millisecond)
+	inboxes, err := ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Empty(t, inboxes.Inboxes)
+
+	response, err := ts.Service.ListDeadLetters(hostCtx, &v1pb.ListDeadLettersRequest{JobType: v1pb.DeadLetter_AI_SUMMARY, PageSize: 5})
+	require.NoError(t, err)
+	require.Len(t, response.DeadLetters, 5)
+	require.NotEmpty(t, response.NextPageToken)
+	require.Equal(t, "7d", response.DeadLetters[0].Target)
+
+	deadLetterName := response.DeadLetters[0].Name
+	_, err = ts.Service.DeleteDeadLetter(userCtx, &v1pb.DeleteDeadLetterRequest{Name: deadLetterName})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DeleteDeadLetter(hostCtx, &v1pb.DeleteDeadLetterRequest{Name: deadLetterName})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteDeadLetter(hostCtx, &v1pb.DeleteDeadLetterRequest{Name: deadLetterName})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	response, err = ts.Service.ListDeadLetters(hostCtx, &v1pb.ListDeadLettersRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.DeadLetters, 9)
+}
@@ -0,0 +1,59 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestListEvents(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "hello", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpsertMemoReaction(otherUserCtx, &v1pb.UpsertMemoReactionRequest{
+		Name:     memo.Name,
+		Reaction: &v1pb.Reaction{ContentId: memo.Name, ReactionType: "👍"},
+	})
+	require.NoError(t, err)
+
+	response, err := ts.Service.ListEvents(userCtx, &v1pb.ListEventsRequest{PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, response.Events, 1)
+	require.Equal(t, store.EventTypeMemoCreated, response.Events[0].Type)
+	require.Equal(t, memo.Name, response.Events[0].Resource)
+	require.Equal(t, "hello", response.Events[0].Payload.Fields["content"].GetStringValue())
+
+	response, err = ts.Service.ListEvents(userCtx, &v1pb.ListEventsRequest{Cursor: response.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, response.Events, 1)
+	require.Equal(t, store.EventTypeReactionCreated, response.Events[0].Type)
+
+	// The cursor stays the same when there are no new events.
+	cursor := response.NextCursor
+	response, err = ts.Service.ListEvents(userCtx, &v1pb.ListEventsRequest{Cursor: cursor})
+	require.NoError(t, err)
+	require.Empty(t, response.Events)
+	require.Equal(t, cursor, response.NextCursor)
+
+	// Events of other users' resources are not visible.
+	response, err = ts.Service.ListEvents(otherUserCtx, &v1pb.ListEventsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.Events)
+}
@@ -0,0 +1,100 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestFeatureFlags(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	userName := fmt.Sprintf("users/%d", user.ID)
+
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/FEATURE_FLAGS",
+			Value: &v1pb.WorkspaceSetting_FeatureFlagSetting_{
+				FeatureFlagSetting: &v1pb.WorkspaceSetting_FeatureFlagSetting{
+					Flags: []*v1pb.WorkspaceSetting_FeatureFlag{
+						{Name: "ai-chat", Enabled: true},
+						{Name: "federation"},
+						{Name: "collaboration", RolloutPercentage: 100},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Anonymous users only see flags enabled for every user.
+	resp, err := ts.Service.ListFeatureFlags(ctx, &v1pb.ListFeatureFlagsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"ai-chat": true, "federation": false, "collaboration": false}, resp.Flags)
+
+	resp, err = ts.Service.ListFeatureFlags(userCtx, &v1pb.ListFeatureFlagsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"ai-chat": true, "federation": false, "collaboration": true}, resp.Flags)
+
+	// User overrides take precedence over the workspace flags.
+	enabled, disabled := true, false
+	_, err = ts.Service.SetUserFeatureFlag(hostCtx, &v1pb.SetUserFeatureFlagRequest{Name: userName, Flag: "federation", Enabled: &enabled})
+	require.NoError(t, err)
+	_, err = ts.Service.SetUserFeatureFlag(hostCtx, &v1pb.SetUserFeatureFlagRequest{Name: userName, Flag: "ai-chat", Enabled: &disabled})
+	require.NoError(t, err)
+	resp, err = ts.Service.ListFeatureFlags(userCtx, &v1pb.ListFeatureFlagsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"ai-chat": false, "federation": true, "collaboration": true}, resp.Flags)
+
+	// Admins can evaluate the flags for other users, regular users cannot.
+	resp, err = ts.Service.ListFeatureFlags(hostCtx, &v1pb.ListFeatureFlagsRequest{User: userName})
+	require.NoError(t, err)
+	require.True(t, resp.Flags["federation"])
+	_, err = ts.Service.ListFeatureFlags(userCtx, &v1pb.ListFeatureFlagsRequest{User: fmt.Sprintf("users/%d", hostUser.ID)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Removing an override falls back to the workspace flag.
+	_, err = ts.Service.SetUserFeatureFlag(hostCtx, &v1pb.SetUserFeatureFlagRequest{Name: userName, Flag: "ai-chat"})
+	require.NoError(t, err)
+	enabledForUser, err := ts.Service.IsFeatureEnabled(ctx, user, "ai-chat")
+	require.NoError(t, err)
+	require.True(t, enabledForUser)
+
+	// Only admins can set overrides.
+	_, err = ts.Service.SetUserFeatureFlag(userCtx, &v1pb.SetUserFeatureFlagRequest{Name: userName, Flag: "ai-chat", Enabled: &enabled})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Invalid flag definitions are rejected.
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/FEATURE_FLAGS",
+			Value: &v1pb.WorkspaceSetting_FeatureFlagSetting_{
+				FeatureFlagSetting: &v1pb.WorkspaceSetting_FeatureFlagSetting{
+					Flags: []*v1pb.WorkspaceSetting_FeatureFlag{{Name: "ai-chat", RolloutPercentage: 150}},
+				},
+			},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Flag definitions are only visible to admins.
+	_, err = ts.Service.GetWorkspaceSetting(userCtx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/FEATURE_FLAGS"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.GetWorkspaceSetting(hostCtx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/FEATURE_FLAGS"})
+	require.NoError(t, err)
+}
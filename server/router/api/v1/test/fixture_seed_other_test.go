@@ -0,0 +1,26 @@
+//go:build !testfixtures
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestSeedTestFixtureUnavailable(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	_, err = ts.Service.SeedTestFixture(ts.CreateUserContext(ctx, host.ID), &v1pb.SeedTestFixtureRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -0,0 +1,56 @@
+//go:build testfixtures
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestSeedTestFixture(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+
+	_, err = ts.Service.SeedTestFixture(ts.CreateUserContext(ctx, user.ID), &v1pb.SeedTestFixtureRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	response, err := ts.Service.SeedTestFixture(hostCtx, &v1pb.SeedTestFixtureRequest{UserCount: 2, MemoCount: 3, ConfigureFakeAi: true})
+	require.NoError(t, err)
+	require.Len(t, response.Users, 2)
+	require.Len(t, response.Memos, 6)
+
+	// The memos are a day apart and tagged.
+	memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{})
+	require.NoError(t, err)
+	require.Len(t, memos, 6)
+	createdDays := map[int64]bool{}
+	for _, memo := range memos {
+		require.Contains(t, memo.Payload.Tags, "fixture")
+		createdDays[memo.CreatedTs/86400] = true
+	}
+	require.Len(t, createdDays, 3)
+	aiSetting, err := ts.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{Name: storepb.WorkspaceSettingKey_AI_CONFIG.String()})
+	require.NoError(t, err)
+	require.Equal(t, storepb.WorkspaceAISetting_FAKE, aiSetting.GetAiSetting().Provider)
+
+	// Seeding again reuses the fixture users.
+	again, err := ts.Service.SeedTestFixture(hostCtx, &v1pb.SeedTestFixtureRequest{UserCount: 1, MemoCount: 1})
+	require.NoError(t, err)
+	require.Equal(t, response.Users[:1], again.Users)
+}
@@ -0,0 +1,89 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// headerStream lets the sign-in set its cookie header outside of a gRPC server.
+type headerStream struct{}
+
+func (*headerStream) Method() string               { return "" }
+func (*headerStream) SetHeader(metadata.MD) error  { return nil }
+func (*headerStream) SendHeader(metadata.MD) error { return nil }
+func (*headerStream) SetTrailer(metadata.MD) error { return nil }
+
+func TestLegalConsent(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	setLegal := func(legalSetting *v1pb.WorkspaceSetting_LegalSetting) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name:  "workspace/settings/LEGAL",
+				Value: &v1pb.WorkspaceSetting_LegalSetting_{LegalSetting: legalSetting},
+			},
+		})
+		return err
+	}
+
+	// A version requires the legal pages.
+	err = setLegal(&v1pb.WorkspaceSetting_LegalSetting{Version: "v1"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.NoError(t, setLegal(&v1pb.WorkspaceSetting_LegalSetting{TermsOfService: "# Terms", PrivacyPolicy: "# Privacy", Version: "v1"}))
+
+	// The legal pages are served to the visitors.
+	setting, err := ts.Service.GetWorkspaceSetting(ctx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/LEGAL"})
+	require.NoError(t, err)
+	require.Equal(t, "# Terms", setting.GetLegalSetting().TermsOfService)
+	require.Equal(t, "v1", setting.GetLegalSetting().Version)
+
+	// The sign-up requires the consent to the current version.
+	signUp := &v1pb.CreateUserRequest{User: &v1pb.User{Username: "alice", Password: "password"}}
+	_, err = ts.Service.CreateUser(ctx, signUp)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	signUp.LegalConsentVersion = "v1"
+	_, err = ts.Service.CreateUser(ctx, signUp)
+	require.NoError(t, err)
+
+	sessionCtx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(ctx, metadata.MD{}), &headerStream{})
+	signIn := &v1pb.CreateSessionRequest{
+		Credentials: &v1pb.CreateSessionRequest_PasswordCredentials_{
+			PasswordCredentials: &v1pb.CreateSessionRequest_PasswordCredentials{Username: "alice", Password: "password"},
+		},
+	}
+	_, err = ts.Service.CreateSession(sessionCtx, signIn)
+	require.NoError(t, err)
+
+	// A new version requires a new consent at the next sign-in.
+	require.NoError(t, setLegal(&v1pb.WorkspaceSetting_LegalSetting{TermsOfService: "# New terms", PrivacyPolicy: "# Privacy", Version: "v2"}))
+	_, err = ts.Service.CreateSession(sessionCtx, signIn)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	signIn.LegalConsentVersion = "v1"
+	_, err = ts.Service.CreateSession(sessionCtx, signIn)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	signIn.LegalConsentVersion = "v2"
+	_, err = ts.Service.CreateSession(sessionCtx, signIn)
+	require.NoError(t, err)
+	signIn.LegalConsentVersion = ""
+	_, err = ts.Service.CreateSession(sessionCtx, signIn)
+	require.NoError(t, err)
+
+	// No consent is required without a version.
+	require.NoError(t, setLegal(&v1pb.WorkspaceSetting_LegalSetting{TermsOfService: "# Terms"}))
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{User: &v1pb.User{Username: "bob", Password: "password"}})
+	require.NoError(t, err)
+}
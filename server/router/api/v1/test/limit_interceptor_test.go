@@ -0,0 +1,118 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+func TestLimitInterceptor(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	ts.Profile.RequestTimeout = 50 * time.Millisecond
+	ts.Profile.MaxRequestSize = 1 << 20
+	interceptor := apiv1.NewLimitInterceptor(ts.Store, ts.Profile)
+	okHandler := func(context.Context, any) (any, error) {
+		return &v1pb.Memo{}, nil
+	}
+
+	t.Run("memo content exceeding the limit is rejected", func(t *testing.T) {
+		serverInfo := &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.MemoService/CreateMemo"}
+		request := &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: strings.Repeat("a", 8*1024+1)}}
+		_, err := interceptor.LimitInterceptor(ctx, request, serverInfo, okHandler)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		request.Memo.Content = "hello"
+		_, err = interceptor.LimitInterceptor(ctx, request, serverInfo, okHandler)
+		require.NoError(t, err)
+	})
+
+	t.Run("attachment exceeding the upload limit is rejected", func(t *testing.T) {
+		_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_STORAGE,
+			Value: &storepb.WorkspaceSetting_StorageSetting{
+				StorageSetting: &storepb.WorkspaceStorageSetting{
+					StorageType:       storepb.WorkspaceStorageSetting_DATABASE,
+					UploadSizeLimitMb: 1,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		serverInfo := &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.AttachmentService/CreateAttachment"}
+		request := &v1pb.CreateAttachmentRequest{Attachment: &v1pb.Attachment{Content: make([]byte, apiv1.MebiByte+1)}}
+		_, err = interceptor.LimitInterceptor(ctx, request, serverInfo, okHandler)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Contains(t, err.Error(), "exceeds the limit")
+	})
+
+	t.Run("request exceeding the maximum request size is rejected", func(t *testing.T) {
+		serverInfo := &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.MemoService/SetMemoAttachments"}
+		request := &v1pb.SetMemoAttachmentsRequest{Name: strings.Repeat("a", 1<<20)}
+		_, err := interceptor.LimitInterceptor(ctx, request, serverInfo, okHandler)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("slow request times out", func(t *testing.T) {
+		serverInfo := &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.MemoService/ListMemos"}
+		_, err := interceptor.LimitInterceptor(ctx, &v1pb.ListMemosRequest{}, serverInfo, func(ctx context.Context, _ any) (any, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+		require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		require.Contains(t, err.Error(), "timed out")
+	})
+
+	t.Run("expensive requests over the work pool are rejected", func(t *testing.T) {
+		serverInfo := &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.WorkspaceService/BackupDatabase"}
+		started := make(chan struct{})
+		finish := make(chan struct{})
+		blockingHandler := func(context.Context, any) (any, error) {
+			started <- struct{}{}
+			<-finish
+			return &v1pb.BackupDatabaseResponse{}, nil
+		}
+		// The export work pool runs 2 requests and queues 4.
+		results := make(chan error, 6)
+		for index := range 6 {
+			go func() {
+				_, err := interceptor.LimitInterceptor(context.Background(), &v1pb.BackupDatabaseRequest{}, serverInfo, blockingHandler)
+				results <- err
+			}()
+			if index < 2 {
+				<-started
+			}
+		}
+		// The canceled requests leave the queue at once, until it is full and they are rejected.
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		require.Eventually(t, func() bool {
+			_, err := interceptor.LimitInterceptor(canceledCtx, &v1pb.BackupDatabaseRequest{}, serverInfo, okHandler)
+			return status.Code(err) == codes.ResourceExhausted
+		}, time.Second, 10*time.Millisecond)
+
+		// The other methods are not limited.
+		_, err := interceptor.LimitInterceptor(ctx, &v1pb.ListMemosRequest{}, &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.MemoService/ListMemos"}, okHandler)
+		require.NoError(t, err)
+
+		close(finish)
+		for range 4 {
+			<-started
+		}
+		for range 6 {
+			require.NoError(t, <-results)
+		}
+	})
+}
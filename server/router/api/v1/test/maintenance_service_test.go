@@ -0,0 +1,119 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/maintenance"
+	"github.com/usememos/memos/store"
+)
+
+func TestMaintenanceWindow(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Service.MaintenanceRunner = maintenance.NewRunner(ts.Store, nil, ts.Service.AnnounceMaintenance, ts.Service.ResumeDeferredWebhooks)
+
+	var delivered atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		delivered.Add(1)
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	_, err = ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &v1pb.UserWebhook{Url: server.URL},
+	})
+	require.NoError(t, err)
+
+	// Only admins can schedule maintenance windows.
+	now := time.Now()
+	_, err = ts.Service.CreateMaintenanceWindow(userCtx, &v1pb.CreateMaintenanceWindowRequest{
+		MaintenanceWindow: &v1pb.MaintenanceWindow{StartTime: timestamppb.New(now), EndTime: timestamppb.New(now.Add(time.Hour))},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.CreateMaintenanceWindow(hostCtx, &v1pb.CreateMaintenanceWindowRequest{
+		MaintenanceWindow: &v1pb.MaintenanceWindow{StartTime: timestamppb.New(now), EndTime: timestamppb.New(now.Add(-time.Minute))},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A window in the future is scheduled, and can be cancelled.
+	scheduled, err := ts.Service.CreateMaintenanceWindow(hostCtx, &v1pb.CreateMaintenanceWindowRequest{
+		MaintenanceWindow: &v1pb.MaintenanceWindow{StartTime: timestamppb.New(now.Add(time.Hour)), EndTime: timestamppb.New(now.Add(2 * time.Hour))},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.MaintenanceWindow_SCHEDULED, scheduled.State)
+	require.False(t, maintenance.IsReadOnly())
+	_, err = ts.Service.DeleteMaintenanceWindow(hostCtx, &v1pb.DeleteMaintenanceWindowRequest{Name: scheduled.Name})
+	require.NoError(t, err)
+	response, err := ts.Service.ListMaintenanceWindows(hostCtx, &v1pb.ListMaintenanceWindowsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.MaintenanceWindows)
+
+	// A window starting now makes the workspace read-only and is announced.
+	window, err := ts.Service.CreateMaintenanceWindow(hostCtx, &v1pb.CreateMaintenanceWindowRequest{
+		MaintenanceWindow: &v1pb.MaintenanceWindow{
+			StartTime: timestamppb.New(now),
+			EndTime:   timestamppb.New(now.Add(time.Hour)),
+			Message:   "Upgrading the database.",
+		},
+	})
+	require.NoError(t, err)
+	defer func() {
+		_, _ = ts.Service.DeleteMaintenanceWindow(hostCtx, &v1pb.DeleteMaintenanceWindowRequest{Name: window.Name})
+	}()
+	require.Equal(t, v1pb.MaintenanceWindow_IN_PROGRESS, window.State)
+	require.NotEmpty(t, window.Announcement)
+	require.True(t, maintenance.IsReadOnly())
+	announcements, err := ts.Service.ListAnnouncements(userCtx, &v1pb.ListAnnouncementsRequest{})
+	require.NoError(t, err)
+	require.Len(t, announcements.Announcements, 1)
+	require.Equal(t, window.Announcement, announcements.Announcements[0].Name)
+	require.Equal(t, "Upgrading the database.", announcements.Announcements[0].Content)
+
+	// The webhooks are deferred until the end of the window, without alerting the admins.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "hello", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	deadLetters, err := ts.Store.ListDeadLetters(ctx, &store.FindDeadLetter{})
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, int32(0), delivered.Load())
+
+	// Ending the window delivers them.
+	_, err = ts.Service.DeleteMaintenanceWindow(hostCtx, &v1pb.DeleteMaintenanceWindowRequest{Name: window.Name})
+	require.NoError(t, err)
+	require.False(t, maintenance.IsReadOnly())
+	require.Equal(t, int32(1), delivered.Load())
+	deadLetters, err = ts.Store.ListDeadLetters(ctx, &store.FindDeadLetter{})
+	require.NoError(t, err)
+	require.Empty(t, deadLetters)
+	response, err = ts.Service.ListMaintenanceWindows(hostCtx, &v1pb.ListMaintenanceWindowsRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.MaintenanceWindows, 1)
+	require.Equal(t, v1pb.MaintenanceWindow_COMPLETED, response.MaintenanceWindows[0].State)
+
+	// A completed window cannot be cancelled.
+	_, err = ts.Service.DeleteMaintenanceWindow(hostCtx, &v1pb.DeleteMaintenanceWindowRequest{Name: window.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,124 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoApproval(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	member, err := ts.CreateRegularUser(ctx, "member")
+	require.NoError(t, err)
+	memberCtx := ts.CreateUserContext(ctx, member.ID)
+
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/MEMO_RELATED",
+			Value: &v1pb.WorkspaceSetting_MemoRelatedSetting_{
+				MemoRelatedSetting: &v1pb.WorkspaceSetting_MemoRelatedSetting{
+					ApprovalRequiredTags: []string{"#announcement"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	createMemo := func(ctx context.Context, content string) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PROTECTED}})
+		require.NoError(t, err)
+		return memo
+	}
+
+	t.Run("the memos with the tags await an approval", func(t *testing.T) {
+		memo := createMemo(userCtx, "Office closed on Friday #announcement/office")
+		require.Equal(t, v1pb.Visibility_PRIVATE, memo.Visibility)
+		require.Equal(t, v1pb.Memo_Approval_PENDING, memo.Approval.State)
+		require.Equal(t, v1pb.Visibility_PROTECTED, memo.Approval.RequestedVisibility)
+
+		_, err := ts.Service.GetMemo(memberCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.Error(t, err)
+		// The admins can see the memos they review.
+		_, err = ts.Service.GetMemo(hostCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+
+		inboxes, err := ts.Service.ListInboxes(hostCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", hostUser.ID)})
+		require.NoError(t, err)
+		require.Len(t, inboxes.Inboxes, 1)
+		require.Equal(t, v1pb.Inbox_MEMO_APPROVAL, inboxes.Inboxes[0].Type)
+
+		pending, err := ts.Service.ListPendingMemos(hostCtx, &v1pb.ListPendingMemosRequest{})
+		require.NoError(t, err)
+		require.Len(t, pending.Memos, 1)
+		_, err = ts.Service.ListPendingMemos(userCtx, &v1pb.ListPendingMemosRequest{})
+		require.Error(t, err)
+
+		approved, err := ts.Service.ApproveMemo(hostCtx, &v1pb.ApproveMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.Visibility_PROTECTED, approved.Visibility)
+		require.Equal(t, v1pb.Memo_Approval_APPROVED, approved.Approval.State)
+		require.Equal(t, fmt.Sprintf("users/%d", hostUser.ID), approved.Approval.Reviewer)
+		_, err = ts.Service.GetMemo(memberCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+
+		// The approved memos keep their approval when edited.
+		updated, err := ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Office closed on Friday and Monday #announcement/office"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.Visibility_PROTECTED, updated.Visibility)
+	})
+
+	t.Run("the rejected memos are resubmitted when edited", func(t *testing.T) {
+		memo := createMemo(userCtx, "Free pizza #announcement")
+		rejected, err := ts.Service.RejectMemo(hostCtx, &v1pb.RejectMemoRequest{Name: memo.Name, Reason: "Not an announcement"})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.Memo_Approval_REJECTED, rejected.Approval.State)
+		require.Equal(t, "Not an announcement", rejected.Approval.Reason)
+		_, err = ts.Service.ApproveMemo(hostCtx, &v1pb.ApproveMemoRequest{Name: memo.Name})
+		require.Error(t, err)
+
+		updated, err := ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Free pizza in the kitchen #announcement"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.Memo_Approval_PENDING, updated.Approval.State)
+		require.Equal(t, v1pb.Visibility_PRIVATE, updated.Visibility)
+
+		// Dropping the tag drops the approval.
+		updated, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Free pizza in the kitchen"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		require.Nil(t, updated.Approval)
+		require.Equal(t, v1pb.Visibility_PROTECTED, updated.Visibility)
+	})
+
+	t.Run("the memos of the admins and the private memos need no approval", func(t *testing.T) {
+		memo := createMemo(hostCtx, "Welcome #announcement")
+		require.Equal(t, v1pb.Visibility_PROTECTED, memo.Visibility)
+		require.Nil(t, memo.Approval)
+
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Draft #announcement", Visibility: v1pb.Visibility_PRIVATE}})
+		require.NoError(t, err)
+		require.Nil(t, memo.Approval)
+	})
+}
@@ -0,0 +1,154 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// queryCountingDriver counts the lookups of the memo details, to check they are made once per page and not once per
+// memo.
+type queryCountingDriver struct {
+	store.Driver
+	reactionQueries   int
+	attachmentQueries int
+	relationQueries   int
+}
+
+func (d *queryCountingDriver) ListReactions(ctx context.Context, find *store.FindReaction) ([]*store.Reaction, error) {
+	d.reactionQueries++
+	return d.Driver.ListReactions(ctx, find)
+}
+
+func (d *queryCountingDriver) ListAttachments(ctx context.Context, find *store.FindAttachment) ([]*store.Attachment, error) {
+	d.attachmentQueries++
+	return d.Driver.ListAttachments(ctx, find)
+}
+
+func (d *queryCountingDriver) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation) ([]*store.MemoRelation, error) {
+	d.relationQueries++
+	return d.Driver.ListMemoRelations(ctx, find)
+}
+
+func TestListMemosBatchConversion(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "notes.txt", Type: "text/plain", Content: []byte("hello world")},
+	})
+	require.NoError(t, err)
+	task, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:     "- [ ] Plant the seeds",
+		Visibility:  v1pb.Visibility_PUBLIC,
+		Attachments: []*v1pb.Attachment{{Name: attachment.Name}},
+	}})
+	require.NoError(t, err)
+	blocker, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "- [ ] Buy the seeds", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	// The private memo of the other user is not visible, so neither is its relation to the task.
+	hidden, err := ts.Service.CreateMemo(otherCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Secret seeds", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	_, err = ts.Service.SetMemoRelations(userCtx, &v1pb.SetMemoRelationsRequest{
+		Name:      task.Name,
+		Relations: []*v1pb.MemoRelation{{RelatedMemo: &v1pb.MemoRelation_Memo{Name: blocker.Name}, Type: v1pb.MemoRelation_BLOCKED_BY}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.SetMemoRelations(otherCtx, &v1pb.SetMemoRelationsRequest{
+		Name:      hidden.Name,
+		Relations: []*v1pb.MemoRelation{{RelatedMemo: &v1pb.MemoRelation_Memo{Name: task.Name}, Type: v1pb.MemoRelation_REFERENCE}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpsertMemoReaction(otherCtx, &v1pb.UpsertMemoReactionRequest{
+		Name:     blocker.Name,
+		Reaction: &v1pb.Reaction{ContentId: blocker.Name, ReactionType: "👍"},
+	})
+	require.NoError(t, err)
+
+	// The memos of the list are the same as the memos fetched one by one.
+	response, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 2)
+	for _, memo := range response.Memos {
+		expected, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.True(t, proto.Equal(expected, memo), "memo %s differs from GetMemo", memo.Name)
+	}
+
+	memos := map[string]*v1pb.Memo{}
+	for _, memo := range response.Memos {
+		memos[memo.Name] = memo
+	}
+	require.Len(t, memos[task.Name].Attachments, 1)
+	require.Len(t, memos[task.Name].Relations, 1)
+	require.Equal(t, blocker.Name, memos[task.Name].Relations[0].RelatedMemo.Name)
+	require.Len(t, memos[blocker.Name].Relations, 1)
+	require.Equal(t, task.Name, memos[blocker.Name].Relations[0].Memo.Name)
+	require.Len(t, memos[blocker.Name].Reactions, 1)
+}
+
+func TestGetMemoSourceMemosBatchConversion(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	summary, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Summary of the week"}})
+	require.NoError(t, err)
+	summaryUID := strings.TrimPrefix(summary.Name, "memos/")
+	summaryMemo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &summaryUID})
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		source, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: fmt.Sprintf("Day %d", i)}})
+		require.NoError(t, err)
+		_, err = ts.Service.UpsertMemoReaction(userCtx, &v1pb.UpsertMemoReactionRequest{
+			Name:     source.Name,
+			Reaction: &v1pb.Reaction{ContentId: source.Name, ReactionType: "👍"},
+		})
+		require.NoError(t, err)
+		sourceUID := strings.TrimPrefix(source.Name, "memos/")
+		sourceMemo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &sourceUID})
+		require.NoError(t, err)
+		_, err = ts.Store.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: summaryMemo.ID, RelatedMemoID: sourceMemo.ID, Type: store.MemoRelationSummaryOf})
+		require.NoError(t, err)
+	}
+
+	// The details of the source memos are fetched for all of them at once.
+	driver := &queryCountingDriver{Driver: ts.Store.GetDriver()}
+	ts.Service.Store = store.New(driver, ts.Profile)
+	response, err := ts.Service.GetMemoSourceMemos(userCtx, &v1pb.GetMemoSourceMemosRequest{Name: summary.Name})
+	ts.Service.Store = ts.Store
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 5)
+	require.Equal(t, 1, driver.reactionQueries)
+	require.Equal(t, 1, driver.attachmentQueries)
+	// The source relations of the summary, then the outgoing and incoming relations of the source memos.
+	require.Equal(t, 3, driver.relationQueries)
+
+	for _, memo := range response.Memos {
+		require.Len(t, memo.Reactions, 1)
+		expected, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.True(t, proto.Equal(expected, memo), "memo %s differs from GetMemo", memo.Name)
+	}
+}
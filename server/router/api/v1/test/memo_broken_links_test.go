@@ -0,0 +1,60 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/linkcheck"
+)
+
+func TestListMemosWithBrokenLinks(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	brokenMemo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "See [docs](https://example.com/gone) and https://example.com/ok", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Only https://example.com/ok here", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	runner := linkcheck.NewRunner(ts.Store, ts.Service.MarkdownService)
+	runner.GetLinkStatus = func(url string) (int, error) {
+		if url == "https://example.com/gone" {
+			return http.StatusNotFound, nil
+		}
+		return http.StatusOK, nil
+	}
+	require.NoError(t, runner.RunOnce(ctx))
+
+	response, err := ts.Service.ListMemosWithBrokenLinks(userCtx, &v1pb.ListMemosWithBrokenLinksRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 1)
+	require.Equal(t, brokenMemo.Name, response.Memos[0].Name)
+	require.Len(t, response.Memos[0].Property.BrokenLinks, 1)
+	require.Equal(t, "https://example.com/gone", response.Memos[0].Property.BrokenLinks[0].Url)
+	require.Equal(t, int32(http.StatusNotFound), response.Memos[0].Property.BrokenLinks[0].StatusCode)
+
+	// Removing the dead link from the content clears the flag.
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: brokenMemo.Name, Content: "See https://example.com/ok"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	response, err = ts.Service.ListMemosWithBrokenLinks(userCtx, &v1pb.ListMemosWithBrokenLinksRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.Memos)
+}
@@ -0,0 +1,87 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestGetCalendarMonth(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "steven")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// createMemoAt creates the memo at the time.
+	createMemoAt := func(content string, visibility v1pb.Visibility, createdAt time.Time) {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content, Visibility: visibility}})
+		require.NoError(t, err)
+		memoUID, err := apiv1.ExtractMemoUIDFromName(memo.Name)
+		require.NoError(t, err)
+		stored, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		require.NoError(t, err)
+		createdTs := createdAt.Unix()
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: stored.ID, CreatedTs: &createdTs}))
+	}
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	createMemoAt("#work #home\n- [x] One\n- [x] Two\n- [ ] Three", v1pb.Visibility_PUBLIC, time.Date(2025, 3, 1, 8, 0, 0, 0, tokyo))
+	createMemoAt("#work #zoo #alpha", v1pb.Visibility_PUBLIC, time.Date(2025, 3, 1, 20, 0, 0, 0, tokyo))
+	createMemoAt("#secret", v1pb.Visibility_PRIVATE, time.Date(2025, 3, 31, 23, 0, 0, 0, tokyo))
+	createMemoAt("Next month", v1pb.Visibility_PUBLIC, time.Date(2025, 4, 1, 0, 30, 0, 0, tokyo))
+
+	t.Run("the days are summarized in the timezone", func(t *testing.T) {
+		calendar, err := ts.Service.GetCalendarMonth(userCtx, &v1pb.GetCalendarMonthRequest{Year: 2025, Month: 3, Timezone: "Asia/Tokyo"})
+		require.NoError(t, err)
+		require.Equal(t, "Asia/Tokyo", calendar.Timezone)
+		require.Len(t, calendar.Days, 31)
+		first := calendar.Days[0]
+		require.Equal(t, "2025-03-01", first.Date)
+		require.Equal(t, int32(2), first.MemoCount)
+		require.Equal(t, []string{"work", "alpha", "home"}, first.TopTags)
+		require.Equal(t, int32(2), first.CompletedTaskCount)
+		require.Equal(t, int32(0), calendar.Days[1].MemoCount)
+		require.Empty(t, calendar.Days[1].TopTags)
+		last := calendar.Days[30]
+		require.Equal(t, "2025-03-31", last.Date)
+		require.Equal(t, int32(1), last.MemoCount)
+		require.Equal(t, []string{"secret"}, last.TopTags)
+	})
+
+	t.Run("the days default to UTC when the user has no timezone", func(t *testing.T) {
+		calendar, err := ts.Service.GetCalendarMonth(userCtx, &v1pb.GetCalendarMonthRequest{Year: 2025, Month: 3})
+		require.NoError(t, err)
+		require.Equal(t, "UTC", calendar.Timezone)
+		// The first memo was created on February 28 and the last on March 31 in UTC.
+		require.Equal(t, int32(1), calendar.Days[0].MemoCount)
+		require.Equal(t, int32(2), calendar.Days[30].MemoCount)
+	})
+
+	t.Run("the memos are those visible to the user", func(t *testing.T) {
+		calendar, err := ts.Service.GetCalendarMonth(ctx, &v1pb.GetCalendarMonthRequest{Year: 2025, Month: 3, Timezone: "Asia/Tokyo"})
+		require.NoError(t, err)
+		require.Equal(t, int32(2), calendar.Days[0].MemoCount)
+		require.Equal(t, int32(0), calendar.Days[30].MemoCount)
+
+		calendar, err = ts.Service.GetCalendarMonth(userCtx, &v1pb.GetCalendarMonthRequest{Year: 2025, Month: 3, Timezone: "Asia/Tokyo", Filter: `tag in ["zoo"]`})
+		require.NoError(t, err)
+		require.Equal(t, int32(1), calendar.Days[0].MemoCount)
+	})
+
+	t.Run("the request is validated", func(t *testing.T) {
+		_, err := ts.Service.GetCalendarMonth(userCtx, &v1pb.GetCalendarMonthRequest{Year: 2025, Month: 13})
+		require.Error(t, err)
+		_, err = ts.Service.GetCalendarMonth(userCtx, &v1pb.GetCalendarMonthRequest{Year: 2025, Month: 1, Timezone: "Mars/Olympus"})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,61 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestColdStorageMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	otherUser, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "cold-memo",
+		CreatorID:  user.ID,
+		Content:    "forgotten thoughts",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	archived := store.Archived
+	updatedTs := time.Now().AddDate(-1, 0, 0).Unix()
+	require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, RowStatus: &archived, UpdatedTs: &updatedTs}))
+	moved, err := ts.Store.MoveMemosToColdStorage(ctx, &store.MoveMemosToColdStorage{UpdatedBefore: time.Now().Unix(), Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, 1, moved)
+
+	resp, err := ts.Service.ListColdMemos(userCtx, &v1pb.ListColdMemosRequest{Query: "forgotten"})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 1)
+	require.Equal(t, fmt.Sprintf("memos/%s", memo.UID), resp.Memos[0].Name)
+
+	resp, err = ts.Service.ListColdMemos(ts.CreateUserContext(ctx, otherUser.ID), &v1pb.ListColdMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 0)
+
+	_, err = ts.Service.RestoreColdMemo(ts.CreateUserContext(ctx, otherUser.ID), &v1pb.RestoreColdMemoRequest{Name: "memos/cold-memo"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	restored, err := ts.Service.RestoreColdMemo(userCtx, &v1pb.RestoreColdMemoRequest{Name: "memos/cold-memo"})
+	require.NoError(t, err)
+	require.Equal(t, "forgotten thoughts", restored.Content)
+	require.Greater(t, restored.UpdateTime.AsTime().Unix(), updatedTs)
+
+	_, err = ts.Service.RestoreColdMemo(userCtx, &v1pb.RestoreColdMemoRequest{Name: "memos/cold-memo"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,62 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoContentLengthLimit(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	paragraph := strings.Repeat("word ", 200) + "\n\n"
+	content := strings.Repeat(paragraph, 3*store.DefaultContentLengthLimit/len(paragraph))
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	var suggestion *v1pb.MemoContentSplitSuggestion
+	var badRequest *errdetails.BadRequest
+	for _, detail := range status.Convert(err).Details() {
+		switch detail := detail.(type) {
+		case *v1pb.MemoContentSplitSuggestion:
+			suggestion = detail
+		case *errdetails.BadRequest:
+			badRequest = detail
+		}
+	}
+	require.NotNil(t, badRequest)
+	require.Equal(t, "content", badRequest.FieldViolations[0].Field)
+	require.NotNil(t, suggestion)
+	require.EqualValues(t, store.DefaultContentLengthLimit, suggestion.ContentLengthLimit)
+	require.NotEmpty(t, suggestion.SplitOffsets)
+
+	// Every part is within the limit and made of whole paragraphs, so it can be saved as a memo.
+	start := 0
+	for _, offset := range append(suggestion.SplitOffsets, int32(len(content))) {
+		part := content[start:offset]
+		require.LessOrEqual(t, len(part), store.DefaultContentLengthLimit)
+		require.True(t, strings.HasSuffix(part, "\n\n"))
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: part, Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		start = int(offset)
+	}
+}
@@ -0,0 +1,62 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoContentWarning(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:        "The butler did it.",
+			Visibility:     v1pb.Visibility_PUBLIC,
+			ContentWarning: "  Spoilers  ",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Spoilers", memo.ContentWarning)
+	require.Equal(t, "Spoilers", memo.Snippet)
+	require.Equal(t, "The butler did it.", memo.Content)
+
+	memo, err = ts.Service.GetMemo(ctx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "Spoilers", memo.ContentWarning)
+
+	// Updating the content keeps the content warning.
+	memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "The gardener did it."},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Spoilers", memo.ContentWarning)
+
+	// Clearing the content warning reveals the content in the snippet.
+	memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content_warning"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, memo.ContentWarning)
+	require.Contains(t, memo.Snippet, "gardener")
+
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, ContentWarning: strings.Repeat("a", 257)},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content_warning"}},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,56 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoContributors(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Draft", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	require.Len(t, memo.Contributors, 1)
+	require.Equal(t, fmt.Sprintf("users/%d", user.ID), memo.Contributors[0].User)
+	require.Equal(t, int32(1), memo.Contributors[0].EditCount)
+
+	updateContent := func(ctx context.Context, content string) *v1pb.Memo {
+		updated, err := ts.Service.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: content},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		return updated
+	}
+	updateContent(hostCtx, "Draft, reviewed")
+	updated := updateContent(userCtx, "Final")
+	require.Len(t, updated.Contributors, 2)
+	require.Equal(t, fmt.Sprintf("users/%d", user.ID), updated.Contributors[0].User)
+	require.Equal(t, int32(2), updated.Contributors[0].EditCount)
+	require.Equal(t, fmt.Sprintf("users/%d", hostUser.ID), updated.Contributors[1].User)
+	require.Equal(t, int32(1), updated.Contributors[1].EditCount)
+
+	// The other updates are not edits of the content.
+	updated, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Pinned: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), updated.Contributors[0].EditCount)
+}
@@ -0,0 +1,73 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestComputeDiff(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "writer")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "reader")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createMemo := func(content string) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PRIVATE}})
+		require.NoError(t, err)
+		return memo
+	}
+	draft := createMemo("# Plan\nShip the quick fix.\nWrite docs.")
+	revision := createMemo("# Plan\nShip the proper fix.\nWrite docs.\nCelebrate.")
+
+	t.Run("the lines are diffed", func(t *testing.T) {
+		response, err := ts.Service.ComputeDiff(userCtx, &v1pb.ComputeDiffRequest{OldName: draft.Name, NewName: revision.Name})
+		require.NoError(t, err)
+		require.Equal(t, int32(2), response.InsertedLineCount)
+		require.Equal(t, int32(1), response.DeletedLineCount)
+		types := []v1pb.ComputeDiffResponse_Type{}
+		for _, line := range response.Lines {
+			types = append(types, line.Type)
+			require.Empty(t, line.Segments)
+		}
+		require.Equal(t, []v1pb.ComputeDiffResponse_Type{
+			v1pb.ComputeDiffResponse_EQUAL,
+			v1pb.ComputeDiffResponse_DELETE,
+			v1pb.ComputeDiffResponse_INSERT,
+			v1pb.ComputeDiffResponse_EQUAL,
+			v1pb.ComputeDiffResponse_INSERT,
+		}, types)
+		require.Equal(t, int32(2), response.Lines[1].OldLineNumber)
+		require.Zero(t, response.Lines[1].NewLineNumber)
+		require.Equal(t, int32(4), response.Lines[4].NewLineNumber)
+	})
+
+	t.Run("the changed lines have word segments", func(t *testing.T) {
+		response, err := ts.Service.ComputeDiff(userCtx, &v1pb.ComputeDiffRequest{OldName: draft.Name, NewName: revision.Name, Granularity: v1pb.ComputeDiffRequest_WORD})
+		require.NoError(t, err)
+		require.Equal(t, []*v1pb.ComputeDiffResponse_Segment{
+			{Type: v1pb.ComputeDiffResponse_EQUAL, Text: "Ship the "},
+			{Type: v1pb.ComputeDiffResponse_DELETE, Text: "quick"},
+			{Type: v1pb.ComputeDiffResponse_EQUAL, Text: " fix."},
+		}, response.Lines[1].Segments)
+		require.Equal(t, v1pb.ComputeDiffResponse_INSERT, response.Lines[2].Segments[1].Type)
+		require.Equal(t, "proper", response.Lines[2].Segments[1].Text)
+		// The inserted line without a deleted counterpart has no segments.
+		require.Empty(t, response.Lines[4].Segments)
+	})
+
+	t.Run("the memos must be visible", func(t *testing.T) {
+		_, err := ts.Service.ComputeDiff(otherCtx, &v1pb.ComputeDiffRequest{OldName: draft.Name, NewName: revision.Name})
+		require.Error(t, err)
+	})
+}
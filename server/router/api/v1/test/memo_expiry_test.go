@@ -0,0 +1,106 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/runner/memoexpiry"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoExpiry(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	expireTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	createMemo := func(content string, action v1pb.Memo_ExpiryAction) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{
+				Content:      content,
+				Visibility:   v1pb.Visibility_PRIVATE,
+				ExpireTime:   timestamppb.New(expireTime),
+				ExpiryAction: action,
+			},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	archivedMemo := createMemo("archived once expired", v1pb.Memo_EXPIRY_ACTION_UNSPECIFIED)
+	require.Equal(t, expireTime.Unix(), archivedMemo.ExpireTime.AsTime().Unix())
+	require.Equal(t, v1pb.Memo_ARCHIVE, archivedMemo.ExpiryAction)
+	deletedMemo := createMemo("deleted once expired", v1pb.Memo_DELETE)
+	require.Equal(t, v1pb.Memo_DELETE, deletedMemo.ExpiryAction)
+	pendingMemo := createMemo("not expired yet", v1pb.Memo_DELETE)
+
+	// Expire times in the past are rejected.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    "already expired",
+			ExpireTime: timestamppb.New(time.Now().Add(-time.Minute)),
+		},
+	})
+	require.Error(t, err)
+
+	// Updating the expire time keeps the expiry action.
+	pendingMemo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: pendingMemo.Name, ExpireTime: timestamppb.New(expireTime.Add(time.Hour))},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"expire_time"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Memo_DELETE, pendingMemo.ExpiryAction)
+
+	// Make the first two memos expired.
+	for _, memo := range []*v1pb.Memo{archivedMemo, deletedMemo} {
+		storeMemo := getStoreMemo(ctx, t, ts, memo.Name)
+		storeMemo.Payload.Expiry.ExpireTs = time.Now().Add(-time.Minute).Unix()
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: storeMemo.ID, Payload: storeMemo.Payload}))
+	}
+
+	runner := memoexpiry.NewRunner(ts.Store, ts.Service.PurgeMemo)
+	require.NoError(t, runner.RunOnce(ctx))
+
+	archivedMemo, err = ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: archivedMemo.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.State_ARCHIVED, archivedMemo.State)
+	require.Nil(t, archivedMemo.ExpireTime)
+	require.Nil(t, getStoreMemo(ctx, t, ts, deletedMemo.Name))
+	pendingMemo, err = ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: pendingMemo.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.State_NORMAL, pendingMemo.State)
+
+	// Clearing the expire time makes the memo never expire.
+	pendingMemo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: pendingMemo.Name},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"expire_time"}},
+	})
+	require.NoError(t, err)
+	require.Nil(t, pendingMemo.ExpireTime)
+
+	// The expiry action cannot be set without an expire time.
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: pendingMemo.Name, ExpiryAction: v1pb.Memo_ARCHIVE},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"expiry_action"}},
+	})
+	require.Error(t, err)
+}
+
+func getStoreMemo(ctx context.Context, t *testing.T, ts *TestService, name string) *store.Memo {
+	uid, err := apiv1.ExtractMemoUIDFromName(name)
+	require.NoError(t, err)
+	memo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+	require.NoError(t, err)
+	return memo
+}
@@ -0,0 +1,75 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoFormatting(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "formatter")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	_, err = ts.CreateRegularUser(ctx, "steven")
+	require.NoError(t, err)
+
+	const content = "Notes\n=====\n\nAsk @Steven about https://example.com #Work   \n\nLater #work"
+	t.Run("the content is saved as is by default", func(t *testing.T) {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}})
+		require.NoError(t, err)
+		require.Equal(t, content, memo.Content)
+	})
+
+	setting, err := ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/FORMATTING", user.ID),
+			Value: &v1pb.UserSetting_FormattingSetting_{FormattingSetting: &v1pb.UserSetting_FormattingSetting{
+				NormalizeHeadings:      true,
+				LinkUrls:               true,
+				NormalizeReferences:    true,
+				TrimTrailingWhitespace: true,
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"normalizeHeadings", "linkUrls", "normalizeReferences", "trimTrailingWhitespace"}},
+	})
+	require.NoError(t, err)
+	require.True(t, setting.GetFormattingSetting().LinkUrls)
+
+	const formatted = "# Notes\n\nAsk @steven about <https://example.com> #Work\n\nLater #Work"
+	t.Run("the content is formatted on create and update", func(t *testing.T) {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}})
+		require.NoError(t, err)
+		require.Equal(t, formatted, memo.Content)
+
+		memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Title\n---\ntrailing  \n\n"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "## Title\ntrailing", memo.Content)
+	})
+
+	t.Run("the formatting is skipped per request", func(t *testing.T) {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}, SkipFormatting: true})
+		require.NoError(t, err)
+		require.Equal(t, content, memo.Content)
+
+		memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:           &v1pb.Memo{Name: memo.Name, Content: content + " "},
+			UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+			SkipFormatting: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, content+" ", memo.Content)
+	})
+}
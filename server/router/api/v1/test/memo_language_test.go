@@ -0,0 +1,126 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestMemoDetectedLanguage(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	german, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Morgen muss ich unbedingt die Steuererklärung fertig machen, bevor die Frist abläuft. #steuer"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "de", german.DetectedLanguage)
+	require.Empty(t, german.Language)
+
+	english, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "I have to buy milk and bread on the way home, and remember to water the plants."},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "en", english.DetectedLanguage)
+
+	// The language set on the memo takes precedence over the detected one.
+	quote, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Ich bin ein Berliner, sagte der Präsident in seiner berühmten Rede.", Language: "en"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "de", quote.DetectedLanguage)
+
+	short, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "ok"}})
+	require.NoError(t, err)
+	require.Empty(t, short.DetectedLanguage)
+
+	names := func(filter string) []string {
+		response, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: filter})
+		require.NoError(t, err)
+		names := []string{}
+		for _, memo := range response.Memos {
+			names = append(names, memo.Name)
+		}
+		return names
+	}
+	require.ElementsMatch(t, []string{german.Name}, names(`language == "de"`))
+	require.ElementsMatch(t, []string{english.Name, quote.Name}, names(`language == "en"`))
+}
+
+func TestAISummaryLanguage(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	prompt := ""
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []struct {
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		for _, message := range body.Messages {
+			prompt += message.Content
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":     "completion",
+			"object": "chat.completion",
+			"model":  "gpt-4o-mini",
+			"choices": []map[string]any{{
+				"index":         0,
+				"finish_reason": "stop",
+				"message":       map[string]any{"role": "assistant", "content": strings.Repeat("Zusammenfassung der Notizen. ", 5)},
+			}},
+			"usage": map[string]any{"prompt_tokens": 10, "completion_tokens": 10, "total_tokens": 20},
+		})
+	}))
+	defer aiServer.Close()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"},
+		},
+	})
+	require.NoError(t, err)
+
+	for _, content := range []string{
+		"Morgen muss ich unbedingt die Steuererklärung fertig machen, bevor die Frist abläuft.",
+		"Am Wochenende fahren wir mit den Kindern an den See, wenn das Wetter schön bleibt.",
+		"I have to buy milk and bread on the way home, and remember to water the plants.",
+	} {
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}})
+		require.NoError(t, err)
+	}
+
+	// The summary is written in the language most of the memos are written in.
+	today := time.Now().UTC()
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{
+		TimeRange: "custom",
+		StartDate: today.AddDate(0, 0, -1).Format("2006-01-02"),
+		EndDate:   today.Format("2006-01-02"),
+	})
+	require.NoError(t, err)
+	require.Contains(t, prompt, "Write the summary in German.")
+}
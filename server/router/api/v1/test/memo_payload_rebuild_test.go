@@ -0,0 +1,68 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoPayloadRebuildJob(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("CreateMemoPayloadRebuildJob rebuilds stale payloads", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		hostUser, err := ts.CreateHostUser(ctx, "admin")
+		require.NoError(t, err)
+		hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+		// Memos created directly in the store have no payload yet.
+		memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        "stale-memo",
+			CreatorID:  hostUser.ID,
+			Content:    "payload is missing #rebuild",
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.GetMemoPayloadRebuildJob(hostCtx, &v1pb.GetMemoPayloadRebuildJobRequest{})
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		job, err := ts.Service.CreateMemoPayloadRebuildJob(hostCtx, &v1pb.CreateMemoPayloadRebuildJobRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "workspace/memoPayloadRebuildJob", job.Name)
+
+		require.Eventually(t, func() bool {
+			job, err = ts.Service.GetMemoPayloadRebuildJob(hostCtx, &v1pb.GetMemoPayloadRebuildJobRequest{})
+			require.NoError(t, err)
+			return job.State != v1pb.MemoPayloadRebuildJob_RUNNING
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, v1pb.MemoPayloadRebuildJob_SUCCEEDED, job.State)
+		require.Equal(t, int32(1), job.ProcessedMemoCount)
+		require.Equal(t, int32(1), job.UpdatedMemoCount)
+		require.NotNil(t, job.FinishTime)
+
+		memo, err = ts.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+		require.NoError(t, err)
+		require.Equal(t, []string{"rebuild"}, memo.Payload.Tags)
+	})
+
+	t.Run("CreateMemoPayloadRebuildJob requires admin", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+
+		_, err = ts.Service.CreateMemoPayloadRebuildJob(ts.CreateUserContext(ctx, user.ID), &v1pb.CreateMemoPayloadRebuildJobRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -0,0 +1,79 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoReadState(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	reader, err := ts.CreateRegularUser(ctx, "reader")
+	require.NoError(t, err)
+	readerCtx := ts.CreateUserContext(ctx, reader.ID)
+
+	sharedMemo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "shared memo", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	privateMemo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "private memo", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	// Shared memos of other users are unread until read.
+	unread, err := ts.Service.ListUnreadMemos(readerCtx, &v1pb.ListUnreadMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, unread.Memos, 1)
+	require.Equal(t, sharedMemo.Name, unread.Memos[0].Name)
+
+	unread, err = ts.Service.ListUnreadMemos(authorCtx, &v1pb.ListUnreadMemosRequest{})
+	require.NoError(t, err)
+	require.Empty(t, unread.Memos)
+
+	readState, err := ts.Service.GetMemoReadState(readerCtx, &v1pb.GetMemoReadStateRequest{Name: sharedMemo.Name + "/readState"})
+	require.NoError(t, err)
+	require.True(t, readState.Unread)
+
+	readState, err = ts.Service.UpdateMemoReadState(readerCtx, &v1pb.UpdateMemoReadStateRequest{
+		ReadState:  &v1pb.MemoReadState{Name: sharedMemo.Name + "/readState", Position: 42},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"read_time", "position"}},
+	})
+	require.NoError(t, err)
+	require.False(t, readState.Unread)
+	require.Equal(t, int32(42), readState.Position)
+
+	unread, err = ts.Service.ListUnreadMemos(readerCtx, &v1pb.ListUnreadMemosRequest{})
+	require.NoError(t, err)
+	require.Empty(t, unread.Memos)
+
+	// A read time before the last update marks the memo as unread again.
+	readState, err = ts.Service.UpdateMemoReadState(readerCtx, &v1pb.UpdateMemoReadStateRequest{
+		ReadState:  &v1pb.MemoReadState{Name: sharedMemo.Name + "/readState", ReadTime: timestamppb.New(time.Now().Add(-time.Hour))},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"read_time"}},
+	})
+	require.NoError(t, err)
+	require.True(t, readState.Unread)
+	require.Equal(t, int32(42), readState.Position)
+
+	unread, err = ts.Service.ListUnreadMemos(readerCtx, &v1pb.ListUnreadMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, unread.Memos, 1)
+
+	// Private memos of other users are not accessible.
+	_, err = ts.Service.GetMemoReadState(readerCtx, &v1pb.GetMemoReadStateRequest{Name: privateMemo.Name + "/readState"})
+	require.Error(t, err)
+}
@@ -0,0 +1,74 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestListReading(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "reader")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	createMemo := func(content string, visibility v1pb.Visibility) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content, Visibility: visibility}})
+		require.NoError(t, err)
+		return memo
+	}
+	earthsea := createMemo("---\nauthor: Ursula K. Le Guin\nstatus: finished\nrating: 9\nfinished_date: 2025-01-10\n---\nA Wizard of Earthsea", v1pb.Visibility_PUBLIC)
+	dispossessed := createMemo("---\nauthor: Ursula K. Le Guin\nfinished_date: 2025-03-02\nrating: 7\n---\nThe Dispossessed", v1pb.Visibility_PUBLIC)
+	dune := createMemo("---\nauthor: Frank Herbert\nstatus: reading\n---\nDune", v1pb.Visibility_PRIVATE)
+	createMemo("---\nstatus: on hold\n---\nUlysses", v1pb.Visibility_PUBLIC)
+	createMemo("---\nauthor: Me\n---\nA blog post", v1pb.Visibility_PUBLIC)
+
+	t.Run("the reading properties are exposed on the memo", func(t *testing.T) {
+		require.Equal(t, "Ursula K. Le Guin", dispossessed.Reading.Author)
+		require.Equal(t, "finished", dispossessed.Reading.Status)
+		require.Equal(t, int32(7), dispossessed.Reading.Rating)
+		require.Equal(t, "2025-03-02", dispossessed.Reading.FinishedDate)
+	})
+
+	t.Run("the memos are grouped by status", func(t *testing.T) {
+		response, err := ts.Service.ListReading(userCtx, &v1pb.ListReadingRequest{})
+		require.NoError(t, err)
+		require.Len(t, response.Groups, 3)
+		require.Equal(t, "reading", response.Groups[0].Status)
+		require.Equal(t, dune.Name, response.Groups[0].Memos[0].Name)
+		require.Equal(t, "finished", response.Groups[1].Status)
+		// The finished readings are by finished date, newest first.
+		require.Equal(t, []string{dispossessed.Name, earthsea.Name}, []string{response.Groups[1].Memos[0].Name, response.Groups[1].Memos[1].Name})
+		require.Equal(t, "on_hold", response.Groups[2].Status)
+	})
+
+	t.Run("the memos are visible to the current user", func(t *testing.T) {
+		response, err := ts.Service.ListReading(ctx, &v1pb.ListReadingRequest{})
+		require.NoError(t, err)
+		require.Len(t, response.Groups, 2)
+		require.Equal(t, "finished", response.Groups[0].Status)
+	})
+
+	t.Run("the memos are filtered by their reading properties", func(t *testing.T) {
+		response, err := ts.Service.ListReading(userCtx, &v1pb.ListReadingRequest{Filter: `reading_rating >= 8`})
+		require.NoError(t, err)
+		require.Len(t, response.Groups, 1)
+		require.Equal(t, earthsea.Name, response.Groups[0].Memos[0].Name)
+
+		response, err = ts.Service.ListReading(userCtx, &v1pb.ListReadingRequest{Filter: `reading_author.contains("Le Guin") && reading_finished_date >= "2025-02-01"`})
+		require.NoError(t, err)
+		require.Len(t, response.Groups, 1)
+		require.Equal(t, dispossessed.Name, response.Groups[0].Memos[0].Name)
+
+		response, err = ts.Service.ListReading(userCtx, &v1pb.ListReadingRequest{Filter: `reading_status in ["reading", "on_hold"]`})
+		require.NoError(t, err)
+		require.Len(t, response.Groups, 2)
+	})
+}
@@ -0,0 +1,98 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRelationDirections(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	createMemo := func(content string) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}})
+		require.NoError(t, err)
+		return memo
+	}
+	task := createMemo("- [ ] Plant the seeds")
+	blocker := createMemo("- [ ] Buy the seeds")
+	duplicate := createMemo("- [ ] Plant the seeds again")
+	summary := createMemo("Gardening summary")
+
+	_, err = ts.Service.SetMemoRelations(userCtx, &v1pb.SetMemoRelationsRequest{
+		Name:      task.Name,
+		Relations: []*v1pb.MemoRelation{{RelatedMemo: &v1pb.MemoRelation_Memo{Name: blocker.Name}, Type: v1pb.MemoRelation_BLOCKED_BY}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.SetMemoRelations(userCtx, &v1pb.SetMemoRelationsRequest{
+		Name:      duplicate.Name,
+		Relations: []*v1pb.MemoRelation{{RelatedMemo: &v1pb.MemoRelation_Memo{Name: task.Name}, Type: v1pb.MemoRelation_DUPLICATE_OF}},
+	})
+	require.NoError(t, err)
+	// The summary relations are managed by the AI summaries, setting the relations of a memo keeps them.
+	summaryUID, err := apiv1.ExtractMemoUIDFromName(summary.Name)
+	require.NoError(t, err)
+	taskUID, err := apiv1.ExtractMemoUIDFromName(task.Name)
+	require.NoError(t, err)
+	summaryMemo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &summaryUID})
+	require.NoError(t, err)
+	taskMemo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &taskUID})
+	require.NoError(t, err)
+	_, err = ts.Store.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: summaryMemo.ID, RelatedMemoID: taskMemo.ID, Type: store.MemoRelationSummaryOf})
+	require.NoError(t, err)
+	_, err = ts.Service.SetMemoRelations(userCtx, &v1pb.SetMemoRelationsRequest{
+		Name:      summary.Name,
+		Relations: []*v1pb.MemoRelation{{RelatedMemo: &v1pb.MemoRelation_Memo{Name: task.Name}, Type: v1pb.MemoRelation_SUMMARY_OF}},
+	})
+	require.NoError(t, err)
+
+	relations, err := ts.Service.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{Name: task.Name})
+	require.NoError(t, err)
+	require.Len(t, relations.Relations, 3)
+
+	relations, err = ts.Service.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{
+		Name:      task.Name,
+		Direction: v1pb.ListMemoRelationsRequest_OUTGOING,
+	})
+	require.NoError(t, err)
+	require.Len(t, relations.Relations, 1)
+	require.Equal(t, v1pb.MemoRelation_BLOCKED_BY, relations.Relations[0].Type)
+	require.Equal(t, blocker.Name, relations.Relations[0].RelatedMemo.Name)
+
+	relations, err = ts.Service.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{
+		Name:      task.Name,
+		Direction: v1pb.ListMemoRelationsRequest_INCOMING,
+	})
+	require.NoError(t, err)
+	require.Len(t, relations.Relations, 2)
+
+	relations, err = ts.Service.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{
+		Name:      task.Name,
+		Direction: v1pb.ListMemoRelationsRequest_INCOMING,
+		Types:     []v1pb.MemoRelation_Type{v1pb.MemoRelation_SUMMARY_OF},
+	})
+	require.NoError(t, err)
+	require.Len(t, relations.Relations, 1)
+	require.Equal(t, summary.Name, relations.Relations[0].Memo.Name)
+
+	// The memos blocked by the blocker.
+	relations, err = ts.Service.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{
+		Name:      blocker.Name,
+		Direction: v1pb.ListMemoRelationsRequest_INCOMING,
+		Types:     []v1pb.MemoRelation_Type{v1pb.MemoRelation_BLOCKED_BY},
+	})
+	require.NoError(t, err)
+	require.Len(t, relations.Relations, 1)
+	require.Equal(t, task.Name, relations.Relations[0].Memo.Name)
+}
@@ -0,0 +1,63 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestListMemosScope(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createMemo := func(ctx context.Context, content string, archived bool) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PUBLIC}})
+		require.NoError(t, err)
+		if archived {
+			memo, err = ts.Service.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+				Memo:       &v1pb.Memo{Name: memo.Name, State: v1pb.State_ARCHIVED},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+			})
+			require.NoError(t, err)
+		}
+		return memo
+	}
+	current := createMemo(userCtx, "Tomato seeds to order", false)
+	archived := createMemo(userCtx, "Tomato harvest of last year", true)
+	othersCurrent := createMemo(otherCtx, "Tomato soup recipe", false)
+	createMemo(otherCtx, "Tomato sauce of last year", true)
+
+	listMemoNames := func(ctx context.Context, scope v1pb.MemoScope) []string {
+		response, err := ts.Service.ListMemos(ctx, &v1pb.ListMemosRequest{Scope: scope, Filter: `content.contains("Tomato")`})
+		require.NoError(t, err)
+		names := []string{}
+		for _, memo := range response.Memos {
+			names = append(names, memo.Name)
+		}
+		return names
+	}
+	require.ElementsMatch(t, []string{current.Name, othersCurrent.Name}, listMemoNames(userCtx, v1pb.MemoScope_MEMO_SCOPE_NORMAL))
+	// The archived memos of other users are never listed, whatever their visibility.
+	require.ElementsMatch(t, []string{archived.Name}, listMemoNames(userCtx, v1pb.MemoScope_MEMO_SCOPE_ARCHIVED))
+	require.ElementsMatch(t, []string{current.Name, archived.Name, othersCurrent.Name}, listMemoNames(userCtx, v1pb.MemoScope_MEMO_SCOPE_ALL))
+
+	// The archived memos require authentication.
+	_, err = ts.Service.ListMemos(ctx, &v1pb.ListMemosRequest{Scope: v1pb.MemoScope_MEMO_SCOPE_ALL})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.ElementsMatch(t, []string{current.Name, othersCurrent.Name}, listMemoNames(ctx, v1pb.MemoScope_MEMO_SCOPE_NORMAL))
+}
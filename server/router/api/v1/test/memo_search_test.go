@@ -0,0 +1,234 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestSearchMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "map.txt", Type: "text/plain", Content: []byte("map")},
+	})
+	require.NoError(t, err)
+	trip, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:     "The road trip to the mountains #travel",
+		Visibility:  v1pb.Visibility_PUBLIC,
+		Attachments: []*v1pb.Attachment{{Name: attachment.Name}},
+	}})
+	require.NoError(t, err)
+	draft, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:    "A trip on the road, see [the map](https://example.com) #travel #draft",
+		Visibility: v1pb.Visibility_PUBLIC,
+	}})
+	require.NoError(t, err)
+	groceries, err := ts.Service.CreateMemo(hostCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:    "Groceries for the road trip",
+		Visibility: v1pb.Visibility_PUBLIC,
+	}})
+	require.NoError(t, err)
+
+	search := func(ctx context.Context, query string) ([]string, error) {
+		response, err := ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: query})
+		if err != nil {
+			return nil, err
+		}
+		names := []string{}
+		for _, memo := range response.Memos {
+			names = append(names, memo.Name)
+		}
+		return names, nil
+	}
+	today := time.Now().UTC().Format("2006-01-02")
+	tomorrow := time.Now().UTC().AddDate(0, 0, 1).Format("2006-01-02")
+	for _, tc := range []struct {
+		query string
+		want  []string
+	}{
+		{`road trip`, []string{trip.Name, draft.Name, groceries.Name}},
+		{`"road trip"`, []string{trip.Name, groceries.Name}},
+		{`"road trip" -groceries`, []string{trip.Name}},
+		{`tag:travel`, []string{trip.Name, draft.Name}},
+		{`tag:#travel -tag:draft`, []string{trip.Name}},
+		{`trip has:attachment`, []string{trip.Name}},
+		{`trip -has:attachment`, []string{draft.Name, groceries.Name}},
+		{`has:link`, []string{draft.Name}},
+		{`trip after:` + today, []string{trip.Name, draft.Name, groceries.Name}},
+		{`trip after:` + tomorrow, []string{}},
+		{`trip before:` + tomorrow + ` -"road trip"`, []string{draft.Name}},
+		// Unknown operators are plain words.
+		{`https://example.com`, []string{draft.Name}},
+	} {
+		names, err := search(userCtx, tc.query)
+		require.NoError(t, err, tc.query)
+		require.ElementsMatch(t, tc.want, names, tc.query)
+	}
+
+	// The creator operator is only available to the admins.
+	_, err = search(userCtx, "trip creator:host")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	names, err := search(hostCtx, "trip creator:user")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{trip.Name, draft.Name}, names)
+	_, err = search(hostCtx, "creator:nobody")
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	for _, query := range []string{"", "   ", "has:everything", "after:yesterday"} {
+		_, err := search(userCtx, query)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), query)
+	}
+}
+
+func TestSearchMemosRanking(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	thrice, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Tomato salad, tomato sauce and tomato juice"}})
+	require.NoError(t, err)
+	tagged, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Tomato seeds #garden"}})
+	require.NoError(t, err)
+	once, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Tomato soup"}})
+	require.NoError(t, err)
+
+	search := func(request *v1pb.SearchMemosRequest) []string {
+		response, err := ts.Service.SearchMemos(userCtx, request)
+		require.NoError(t, err)
+		names := []string{}
+		for _, memo := range response.Memos {
+			names = append(names, memo.Name)
+		}
+		return names
+	}
+	// The memos are ranked by relevance by default, the most recent first on a tie.
+	require.Equal(t, []string{thrice.Name, once.Name, tagged.Name}, search(&v1pb.SearchMemosRequest{Query: "tomato"}))
+	require.Equal(t, []string{thrice.Name, tagged.Name, once.Name}, search(&v1pb.SearchMemosRequest{Query: "tomato", TagBoosts: map[string]float64{"#garden": 1}}))
+	require.Equal(t, []string{tagged.Name, thrice.Name, once.Name}, search(&v1pb.SearchMemosRequest{Query: "tomato", TagBoosts: map[string]float64{"garden": 5}}))
+	require.Equal(t, []string{once.Name, tagged.Name, thrice.Name}, search(&v1pb.SearchMemosRequest{Query: "tomato", Ranking: v1pb.SearchMemosRequest_RECENCY}))
+
+	_, err = ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{Query: "tomato", RecencyHalfLifeDays: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSearchMemosFuzzy(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+
+	typo, err := ts.Service.CreateMemo(hostCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Deploying kuberentes on bare metal"}})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(hostCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Deploying kubectl plugins"}})
+	require.NoError(t, err)
+	plural, err := ts.Service.CreateMemo(hostCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Notes on the kubernetes clusters"}})
+	require.NoError(t, err)
+
+	search := func(query string) []string {
+		response, err := ts.Service.SearchMemos(hostCtx, &v1pb.SearchMemosRequest{Query: query})
+		require.NoError(t, err)
+		names := []string{}
+		for _, memo := range response.Memos {
+			names = append(names, memo.Name)
+		}
+		return names
+	}
+	require.ElementsMatch(t, []string{plural.Name}, search("kubernetes"))
+
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/MEMO_RELATED",
+			Value: &v1pb.WorkspaceSetting_MemoRelatedSetting_{
+				MemoRelatedSetting: &v1pb.WorkspaceSetting_MemoRelatedSetting{EnableFuzzySearch: true},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{typo.Name, plural.Name}, search("kubernetes"))
+	require.ElementsMatch(t, []string{typo.Name}, search(`"bare metall"`))
+	require.ElementsMatch(t, []string{plural.Name}, search("kubernetes cluster"))
+	// The excluded words are only excluded as spelled.
+	require.ElementsMatch(t, []string{typo.Name}, search("kubernetes -clusters"))
+	require.ElementsMatch(t, []string{typo.Name, plural.Name}, search("kubernetes -kubernets"))
+}
+
+func TestSearchMemosCommentsAndAttachments(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	commenter, err := ts.CreateRegularUser(ctx, "commenter")
+	require.NoError(t, err)
+	commenterCtx := ts.CreateUserContext(ctx, commenter.ID)
+
+	trip, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Camping trip", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(commenterCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    trip.Name,
+		Comment: &v1pb.Memo{Content: "Bring the tent for the trip", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(commenterCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    trip.Name,
+		Comment: &v1pb.Memo{Content: "My secret stove", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	budget, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Meeting notes", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	document := "%PDF-1.4\n1 0 obj << /Length 44 >>\nstream\nBT /F1 12 Tf (Quarterly budget forecast) Tj ET\nendstream\nendobj\n"
+	_, err = ts.Service.CreateAttachment(authorCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "budget.pdf", Type: "application/pdf", Content: []byte(document), Memo: &budget.Name},
+	})
+	require.NoError(t, err)
+
+	search := func(query string) map[string][]v1pb.SearchMemosResponse_MatchType {
+		response, err := ts.Service.SearchMemos(authorCtx, &v1pb.SearchMemosRequest{Query: query})
+		require.NoError(t, err)
+		require.Len(t, response.Matches, len(response.Memos))
+		matches := map[string][]v1pb.SearchMemosResponse_MatchType{}
+		for i, match := range response.Matches {
+			require.Equal(t, response.Memos[i].Name, match.Memo)
+			matches[match.Memo] = match.Types
+		}
+		return matches
+	}
+	// The memos are found by the text of their comments and attachments, not the comments themselves.
+	require.Equal(t, map[string][]v1pb.SearchMemosResponse_MatchType{trip.Name: {v1pb.SearchMemosResponse_COMMENT}}, search("tent"))
+	require.Equal(t, map[string][]v1pb.SearchMemosResponse_MatchType{trip.Name: {v1pb.SearchMemosResponse_CONTENT, v1pb.SearchMemosResponse_COMMENT}}, search("trip"))
+	require.Equal(t, map[string][]v1pb.SearchMemosResponse_MatchType{budget.Name: {v1pb.SearchMemosResponse_ATTACHMENT}}, search(`"budget forecast"`))
+	require.Equal(t, map[string][]v1pb.SearchMemosResponse_MatchType{budget.Name: {v1pb.SearchMemosResponse_CONTENT, v1pb.SearchMemosResponse_ATTACHMENT}}, search("meeting budget"))
+	require.Equal(t, map[string][]v1pb.SearchMemosResponse_MatchType{budget.Name: nil}, search("has:attachment"))
+	// The comments that not everyone who can see the memo can see are not searched.
+	require.Empty(t, search("stove"))
+	require.Empty(t, search("camping -tent"))
+}
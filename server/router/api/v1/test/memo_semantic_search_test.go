@@ -0,0 +1,169 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memoembed"
+	"github.com/usememos/memos/store"
+)
+
+func TestSearchMemosSemantic(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	// The fake model embeds the texts along a gardening axis and a money axis.
+	inputs := []string{}
+	aiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/embeddings", r.URL.Path)
+		var body struct {
+			Input []string `json:"input"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		data := []map[string]any{}
+		for i, input := range body.Input {
+			inputs = append(inputs, input)
+			embedding := []float64{0.1, 0.1}
+			if strings.Contains(input, "garden") || strings.Contains(input, "tomatoes") {
+				embedding[0] = 1
+			}
+			if strings.Contains(input, "budget") || strings.Contains(input, "money") {
+				embedding[1] = 1
+			}
+			data = append(data, map[string]any{"object": "embedding", "index": i, "embedding": embedding})
+		}
+		response, err := json.Marshal(map[string]any{
+			"object": "list",
+			"model":  "text-embedding-3-small",
+			"data":   data,
+			"usage":  map[string]any{"prompt_tokens": 5, "total_tokens": 5},
+		})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer aiServer.Close()
+
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini"},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.SearchMemosSemantic(userCtx, &v1pb.SearchMemosSemanticRequest{Query: "garden"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{
+			AiSetting: &storepb.WorkspaceAISetting{Endpoint: aiServer.URL, ApiKey: "key", Model: "gpt-4o-mini", EmbeddingModel: "text-embedding-3-small"},
+		},
+	})
+	require.NoError(t, err)
+
+	garden, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Planted tomatoes in the garden", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	budget, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Reviewed the monthly budget", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	otherGarden, err := ts.Service.CreateMemo(otherCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Watered the garden", Visibility: v1pb.Visibility_PROTECTED}})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(otherCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Weeded my secret garden", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+
+	setAIOptIn := func(ctx context.Context, userID int32, optIn bool) {
+		_, err := ts.Service.UpdateUserSetting(ctx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name:  fmt.Sprintf("users/%d/settings/GENERAL", userID),
+				Value: &v1pb.UserSetting_GeneralSetting_{GeneralSetting: &v1pb.UserSetting_GeneralSetting{AiOptIn: optIn}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"aiOptIn"}},
+		})
+		require.NoError(t, err)
+	}
+
+	// Only the memos of the users who opted in to the AI processing are embedded.
+	setAIOptIn(userCtx, user.ID, true)
+	runner := memoembed.NewRunner(ts.Store, ts.Service.MarkdownService, ts.Service.AddAITokenUsage)
+	require.NoError(t, runner.RunOnce(ctx))
+	require.Len(t, inputs, 2)
+	setAIOptIn(otherCtx, other.ID, true)
+	require.NoError(t, runner.RunOnce(ctx))
+	require.Len(t, inputs, 4)
+	// Memos whose content did not change are not embedded again.
+	require.NoError(t, runner.RunOnce(ctx))
+	require.Len(t, inputs, 4)
+
+	response, err := ts.Service.SearchMemosSemantic(userCtx, &v1pb.SearchMemosSemanticRequest{Query: "gardening"})
+	require.NoError(t, err)
+	require.Equal(t, "gardening", inputs[len(inputs)-1])
+	names := []string{}
+	for _, result := range response.Results {
+		names = append(names, result.Memo.Name)
+	}
+	// The private memo of the other user is not visible, and the closest memos come first.
+	require.Len(t, names, 3)
+	require.ElementsMatch(t, []string{garden.Name, otherGarden.Name}, names[:2])
+	require.Equal(t, budget.Name, names[2])
+	require.Greater(t, response.Results[1].Score, response.Results[2].Score)
+
+	response, err = ts.Service.SearchMemosSemantic(userCtx, &v1pb.SearchMemosSemanticRequest{Query: "money", PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 1)
+	require.Equal(t, budget.Name, response.Results[0].Memo.Name)
+
+	// Deleted memos are no longer found.
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: budget.Name})
+	require.NoError(t, err)
+	response, err = ts.Service.SearchMemosSemantic(userCtx, &v1pb.SearchMemosSemanticRequest{Query: "money"})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 2)
+
+	// The embeddings of the users opting out are deleted, and their memos are no longer found.
+	otherGardenUID := strings.TrimPrefix(otherGarden.Name, "memos/")
+	otherGardenMemo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &otherGardenUID})
+	require.NoError(t, err)
+	setAIOptIn(otherCtx, other.ID, false)
+	embeddings, err := ts.Store.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{MemoIDList: []int32{otherGardenMemo.ID}})
+	require.NoError(t, err)
+	require.Empty(t, embeddings)
+	require.NoError(t, ts.Store.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+		MemoID:      otherGardenMemo.ID,
+		Model:       "text-embedding-3-small",
+		ContentHash: "stale",
+		Embedding:   []float32{1, 0.1},
+	}))
+	response, err = ts.Service.SearchMemosSemantic(userCtx, &v1pb.SearchMemosSemanticRequest{Query: "gardening"})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 1)
+	require.Equal(t, garden.Name, response.Results[0].Memo.Name)
+	require.NoError(t, runner.RunOnce(ctx))
+	embeddings, err = ts.Store.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{MemoIDList: []int32{otherGardenMemo.ID}})
+	require.NoError(t, err)
+	require.Empty(t, embeddings)
+
+	_, err = ts.Service.SearchMemosSemantic(userCtx, &v1pb.SearchMemosSemanticRequest{Query: " "})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.SearchMemosSemantic(ctx, &v1pb.SearchMemosSemanticRequest{Query: "garden"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
 )
@@ -250,3 +252,45 @@ func TestListMemos(t *testing.T) {
 	require.NotNil(t, userTwoReaction)
 	require.Equal(t, "👍", userTwoReaction.ReactionType)
 }
+
+func TestCreateMemoAssociations(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "original memo", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	// A memo referencing a missing memo is not created at all.
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "dangling reference",
+			Visibility: apiv1.Visibility_PUBLIC,
+			Relations: []*apiv1.MemoRelation{
+				{RelatedMemo: &apiv1.MemoRelation_Memo{Name: "memos/missing"}, Type: apiv1.MemoRelation_REFERENCE},
+			},
+		},
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	memos, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+
+	comment, err := ts.Service.CreateMemoComment(userCtx, &apiv1.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &apiv1.Memo{Content: "a comment", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, comment.Parent)
+	require.Equal(t, memo.Name, *comment.Parent)
+	memo, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), memo.CommentCount)
+}
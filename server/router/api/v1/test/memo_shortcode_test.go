@@ -0,0 +1,75 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoShortcodes(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	updateShortcodes := func(expandShortcodes bool, shortcodes map[string]string) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/MEMO_RELATED",
+				Value: &v1pb.WorkspaceSetting_MemoRelatedSetting_{
+					MemoRelatedSetting: &v1pb.WorkspaceSetting_MemoRelatedSetting{
+						ExpandShortcodes: expandShortcodes,
+						Shortcodes:       shortcodes,
+					},
+				},
+			},
+		})
+		return err
+	}
+	createMemo := func(content string, skipFormatting bool) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo:           &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PRIVATE},
+			SkipFormatting: skipFormatting,
+		})
+		require.NoError(t, err)
+		return memo
+	}
+
+	t.Run("the shortcodes are not expanded by default", func(t *testing.T) {
+		require.Equal(t, "Done :tada:", createMemo("Done :tada:", false).Content)
+	})
+
+	t.Run("the shortcodes are validated", func(t *testing.T) {
+		require.Equal(t, codes.InvalidArgument, status.Code(updateShortcodes(true, map[string]string{"Ship It": "🚢"})))
+		require.Equal(t, codes.InvalidArgument, status.Code(updateShortcodes(true, map[string]string{"ship": ""})))
+		require.Equal(t, codes.InvalidArgument, status.Code(updateShortcodes(true, map[string]string{"ship": "one\ntwo"})))
+	})
+
+	t.Run("the built-in and workspace shortcodes are expanded on save", func(t *testing.T) {
+		require.NoError(t, updateShortcodes(true, map[string]string{"ship": "🚢", "tada": "🥳"}))
+
+		memo := createMemo("Done :tada: :ship: :shrug: `:ship:`", false)
+		require.Equal(t, "Done 🥳 🚢 "+`¯\\\_(ツ)\_/¯`+" `:ship:`", memo.Content)
+		require.Equal(t, "Done :ship:", createMemo("Done :ship:", true).Content)
+
+		updated, err := ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Shipped :ship:"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "Shipped 🚢", updated.Content)
+	})
+}
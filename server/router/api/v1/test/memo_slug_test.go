@@ -0,0 +1,83 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoSlug(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "writer")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	post, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "My first post", Visibility: v1pb.Visibility_PUBLIC, Slug: "First-Post"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "first-post", post.Slug)
+
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Invalid slug", Slug: "not a slug"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The visitors get the public memos by slug, with the creator's username.
+	found, err := ts.Service.GetMemoBySlug(ctx, &v1pb.GetMemoBySlugRequest{Parent: "users/writer", Slug: "first-post"})
+	require.NoError(t, err)
+	require.Equal(t, post.Name, found.Name)
+
+	// The previous slugs still resolve to the renamed memo, whose slug tells the clients to redirect.
+	post.Slug = "hello-world"
+	post, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: post, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"slug"}}})
+	require.NoError(t, err)
+	require.Equal(t, "hello-world", post.Slug)
+	found, err = ts.Service.GetMemoBySlug(ctx, &v1pb.GetMemoBySlugRequest{Parent: "users/writer", Slug: "first-post"})
+	require.NoError(t, err)
+	require.Equal(t, post.Name, found.Name)
+	require.Equal(t, "hello-world", found.Slug)
+
+	// The slugs are unique per user, but a previous slug can be taken by another memo.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Copy", Slug: "hello-world"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	other, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Another post", Visibility: v1pb.Visibility_PUBLIC, Slug: "first-post"},
+	})
+	require.NoError(t, err)
+	found, err = ts.Service.GetMemoBySlug(ctx, &v1pb.GetMemoBySlugRequest{Parent: "users/writer", Slug: "first-post"})
+	require.NoError(t, err)
+	require.Equal(t, other.Name, found.Name)
+
+	// Another user may use the same slug.
+	otherUser, err := ts.CreateRegularUser(ctx, "reader")
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(ts.CreateUserContext(ctx, otherUser.ID), &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Mine too", Slug: "hello-world"},
+	})
+	require.NoError(t, err)
+
+	// The private memos are not visible by slug.
+	private, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Draft", Visibility: v1pb.Visibility_PRIVATE, Slug: "draft"},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemoBySlug(ctx, &v1pb.GetMemoBySlugRequest{Parent: "users/writer", Slug: "draft"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The slugs of a deleted memo no longer resolve.
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: private.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemoBySlug(userCtx, &v1pb.GetMemoBySlugRequest{Parent: "users/writer", Slug: "draft"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,94 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memoview"
+)
+
+func TestMemoStats(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	recorder := memoview.NewRunner(ts.Store)
+	ts.Service.MemoViewRecorder = recorder
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	reader, err := ts.CreateRegularUser(ctx, "reader")
+	require.NoError(t, err)
+	readerCtx := ts.CreateUserContext(ctx, reader.ID)
+
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "public memo", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	visitorCtx := func(ip, userAgent string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("x-real-ip", ip, "user-agent", userAgent))
+	}
+
+	// Visitors are counted once per day, views of the creator are not counted.
+	for _, viewCtx := range []context.Context{
+		visitorCtx("10.0.0.1", "firefox"),
+		visitorCtx("10.0.0.1", "firefox"),
+		visitorCtx("10.0.0.1", "chrome"),
+		visitorCtx("10.0.0.2", "firefox"),
+		authorCtx,
+	} {
+		_, err := ts.Service.GetMemo(viewCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+	}
+
+	// The views are recorded by the runs of the recorder.
+	stats, err := ts.Service.GetMemoStats(authorCtx, &v1pb.GetMemoStatsRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Zero(t, stats.ViewCount)
+	require.NoError(t, recorder.RunOnce(ctx))
+	stats, err = ts.Service.GetMemoStats(authorCtx, &v1pb.GetMemoStatsRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, memo.Name, stats.Name)
+	require.Equal(t, int32(3), stats.ViewCount)
+	require.Len(t, stats.DailyViewCounts, 1)
+	require.Equal(t, int32(3), stats.DailyViewCounts[0].Count)
+
+	// The views of a protected memo opened through its link by another member are counted.
+	protectedMemo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "protected memo", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(readerCtx, &v1pb.GetMemoRequest{Name: protectedMemo.Name})
+	require.NoError(t, err)
+	require.NoError(t, recorder.RunOnce(ctx))
+	stats, err = ts.Service.GetMemoStats(authorCtx, &v1pb.GetMemoStatsRequest{Name: protectedMemo.Name})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), stats.ViewCount)
+
+	// Only the creator can get the stats.
+	_, err = ts.Service.GetMemoStats(readerCtx, &v1pb.GetMemoStatsRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Views are not counted when view tracking is disabled.
+	memoRelatedSetting, err := ts.Service.GetWorkspaceSetting(hostCtx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/MEMO_RELATED"})
+	require.NoError(t, err)
+	memoRelatedSetting.GetMemoRelatedSetting().DisableViewTracking = true
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{Setting: memoRelatedSetting})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(visitorCtx("10.0.0.3", "safari"), &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.NoError(t, recorder.RunOnce(ctx))
+	stats, err = ts.Service.GetMemoStats(authorCtx, &v1pb.GetMemoStatsRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), stats.ViewCount)
+}
@@ -0,0 +1,113 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoSubscription(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	users := map[string]*store.User{}
+	userCtxs := map[string]context.Context{}
+	for _, username := range []string{"author", "commenter", "reader"} {
+		user, err := ts.CreateRegularUser(ctx, username)
+		require.NoError(t, err)
+		users[username] = user
+		userCtxs[username] = ts.CreateUserContext(ctx, user.ID)
+	}
+	countInboxes := func(username string) int {
+		inboxes, err := ts.Store.ListInboxes(ctx, &store.FindInbox{ReceiverID: &users[username].ID})
+		require.NoError(t, err)
+		return len(inboxes)
+	}
+
+	memo, err := ts.Service.CreateMemo(userCtxs["author"], &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "shared memo", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	subscriptionName := memo.Name + "/subscription"
+
+	// Memo creators are subscribed by default, other users are not.
+	subscription, err := ts.Service.GetMemoSubscription(userCtxs["author"], &v1pb.GetMemoSubscriptionRequest{Name: subscriptionName})
+	require.NoError(t, err)
+	require.True(t, subscription.Subscribed)
+	require.Nil(t, subscription.UpdateTime)
+	subscription, err = ts.Service.GetMemoSubscription(userCtxs["commenter"], &v1pb.GetMemoSubscriptionRequest{Name: subscriptionName})
+	require.NoError(t, err)
+	require.False(t, subscription.Subscribed)
+
+	// Commenting subscribes the commenter.
+	_, err = ts.Service.CreateMemoComment(userCtxs["commenter"], &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "first comment", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	subscription, err = ts.Service.GetMemoSubscription(userCtxs["commenter"], &v1pb.GetMemoSubscriptionRequest{Name: subscriptionName})
+	require.NoError(t, err)
+	require.True(t, subscription.Subscribed)
+	require.NotNil(t, subscription.UpdateTime)
+	require.Equal(t, 1, countInboxes("author"))
+	require.Equal(t, 0, countInboxes("commenter"))
+
+	subscribed, err := ts.Service.ListSubscribedMemos(userCtxs["commenter"], &v1pb.ListSubscribedMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, subscribed.Memos, 1)
+	require.Equal(t, memo.Name, subscribed.Memos[0].Name)
+
+	// Comments are fanned out to the creator and the subscribers, except the comment creator.
+	_, err = ts.Service.CreateMemoComment(userCtxs["reader"], &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "second comment", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, countInboxes("author"))
+	require.Equal(t, 1, countInboxes("commenter"))
+	require.Equal(t, 0, countInboxes("reader"))
+
+	// Unsubscribing stops the notifications and is kept when commenting again.
+	subscription, err = ts.Service.UpdateMemoSubscription(userCtxs["commenter"], &v1pb.UpdateMemoSubscriptionRequest{
+		Subscription: &v1pb.MemoSubscription{Name: subscriptionName, Subscribed: false},
+		UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"subscribed"}},
+	})
+	require.NoError(t, err)
+	require.False(t, subscription.Subscribed)
+	_, err = ts.Service.UpdateMemoSubscription(userCtxs["author"], &v1pb.UpdateMemoSubscriptionRequest{
+		Subscription: &v1pb.MemoSubscription{Name: subscriptionName, Subscribed: false},
+		UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"subscribed"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(userCtxs["commenter"], &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "third comment", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, countInboxes("author"))
+	require.Equal(t, 1, countInboxes("reader"))
+	subscription, err = ts.Service.GetMemoSubscription(userCtxs["commenter"], &v1pb.GetMemoSubscriptionRequest{Name: subscriptionName})
+	require.NoError(t, err)
+	require.False(t, subscription.Subscribed)
+	subscribed, err = ts.Service.ListSubscribedMemos(userCtxs["commenter"], &v1pb.ListSubscribedMemosRequest{})
+	require.NoError(t, err)
+	require.Empty(t, subscribed.Memos)
+
+	// Private memos of other users are not accessible.
+	privateMemo, err := ts.Service.CreateMemo(userCtxs["author"], &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "private memo", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateMemoSubscription(userCtxs["reader"], &v1pb.UpdateMemoSubscriptionRequest{
+		Subscription: &v1pb.MemoSubscription{Name: privateMemo.Name + "/subscription", Subscribed: true},
+		UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"subscribed"}},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoTagTemplates(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "oncall")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	updateTagTemplates := func(tagTemplates ...*v1pb.WorkspaceSetting_MemoRelatedSetting_TagTemplate) (*v1pb.WorkspaceSetting, error) {
+		return ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/MEMO_RELATED",
+				Value: &v1pb.WorkspaceSetting_MemoRelatedSetting_{
+					MemoRelatedSetting: &v1pb.WorkspaceSetting_MemoRelatedSetting{TagTemplates: tagTemplates},
+				},
+			},
+		})
+	}
+
+	t.Run("the tag templates are validated", func(t *testing.T) {
+		_, err := updateTagTemplates(&v1pb.WorkspaceSetting_MemoRelatedSetting_TagTemplate{Tag: "incident", RequiredProperties: []string{"sev erity"}})
+		require.Error(t, err)
+		_, err = updateTagTemplates(
+			&v1pb.WorkspaceSetting_MemoRelatedSetting_TagTemplate{Tag: "incident"},
+			&v1pb.WorkspaceSetting_MemoRelatedSetting_TagTemplate{Tag: "#Incident"},
+		)
+		require.Error(t, err)
+
+		setting, err := updateTagTemplates(&v1pb.WorkspaceSetting_MemoRelatedSetting_TagTemplate{
+			Tag:                "#Incident",
+			Template:           "---\nseverity:\nstatus: open\n---\n#incident ",
+			RequiredProperties: []string{"Severity", "status"},
+		})
+		require.NoError(t, err)
+		tagTemplate := setting.GetMemoRelatedSetting().TagTemplates[0]
+		require.Equal(t, "incident", tagTemplate.Tag)
+		require.Equal(t, []string{"severity", "status"}, tagTemplate.RequiredProperties)
+	})
+
+	t.Run("the memos with the tag require the properties", func(t *testing.T) {
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "---\nseverity:\nstatus: open\n---\nDatabase down #incident/db"}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		st, _ := status.FromError(err)
+		require.Len(t, st.Details(), 1)
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		require.Len(t, badRequest.FieldViolations, 1)
+		require.Equal(t, "content", badRequest.FieldViolations[0].Field)
+		require.Contains(t, badRequest.FieldViolations[0].Description, `"severity"`)
+
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "---\nseverity: high\nstatus: open\n---\nDatabase down #incident/db"}})
+		require.NoError(t, err)
+
+		_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Database down #incident/db"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Not an incident #incidental"}})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,163 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// createTrackedMemo creates the private memo of the user at the time.
+func createTrackedMemo(t *testing.T, ts *TestService, userCtx context.Context, content string, createdAt time.Time) *v1pb.Memo {
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	memoUID, err := apiv1.ExtractMemoUIDFromName(memo.Name)
+	require.NoError(t, err)
+	stored, err := ts.Store.GetMemo(userCtx, &store.FindMemo{UID: &memoUID})
+	require.NoError(t, err)
+	createdTs := createdAt.Unix()
+	require.NoError(t, ts.Store.UpdateMemo(userCtx, &store.UpdateMemo{ID: stored.ID, CreatedTs: &createdTs}))
+	return memo
+}
+
+func TestGetTimeReport(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "consultant")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/GENERAL", user.ID),
+			Value: &v1pb.UserSetting_GeneralSetting_{GeneralSetting: &v1pb.UserSetting_GeneralSetting{
+				WeekStartDay: 1,
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"weekStartDay"}},
+	})
+	require.NoError(t, err)
+
+	// Monday, June 2, 2025 and the following week.
+	memo := createTrackedMemo(t, ts, userCtx, "Daily notes\n@time 2h #acme\n@time 30m #acme #meeting", time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC))
+	createTrackedMemo(t, ts, userCtx, "@time 1h #beta\n@time 15m", time.Date(2025, 6, 8, 18, 0, 0, 0, time.UTC))
+	createTrackedMemo(t, ts, userCtx, "@time 3h #acme", time.Date(2025, 6, 9, 9, 0, 0, 0, time.UTC))
+	createTrackedMemo(t, ts, userCtx, "@time 8h #acme", time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC))
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	createTrackedMemo(t, ts, ts.CreateUserContext(ctx, other.ID), "@time 5h #acme", time.Date(2025, 6, 3, 9, 0, 0, 0, time.UTC))
+
+	t.Run("the entries are exposed on the memo", func(t *testing.T) {
+		require.Len(t, memo.Property.TimeEntries, 2)
+		require.Equal(t, int32(120), memo.Property.TimeEntries[0].Minutes)
+		require.Equal(t, []string{"acme", "meeting"}, memo.Property.TimeEntries[1].Tags)
+	})
+
+	t.Run("the time is reported per week and tag", func(t *testing.T) {
+		report, err := ts.Service.GetTimeReport(userCtx, &v1pb.GetTimeReportRequest{StartDate: "2025-06-01", EndDate: "2025-06-30", Timezone: "UTC"})
+		require.NoError(t, err)
+		require.Equal(t, int32(2*60+30+60+15+3*60), report.TotalMinutes)
+		require.Len(t, report.Weeks, 2)
+
+		first := report.Weeks[0]
+		require.Equal(t, "2025-06-02", first.StartDate)
+		require.Equal(t, int32(2*60+30+60+15), first.TotalMinutes)
+		tags := map[string]int32{}
+		for _, tag := range first.Tags {
+			tags[tag.Tag] = tag.Minutes
+		}
+		require.Equal(t, map[string]int32{"acme": 150, "beta": 60, "meeting": 30, "": 15}, tags)
+		require.Equal(t, "acme", first.Tags[0].Tag)
+		require.Equal(t, 2.5, first.Tags[0].Hours)
+
+		second := report.Weeks[1]
+		require.Equal(t, "2025-06-09", second.StartDate)
+		require.Equal(t, int32(180), second.TotalMinutes)
+	})
+
+	t.Run("the memos are filtered", func(t *testing.T) {
+		report, err := ts.Service.GetTimeReport(userCtx, &v1pb.GetTimeReportRequest{StartDate: "2025-06-01", EndDate: "2025-07-31", Timezone: "UTC", Filter: `tag in ["beta"]`})
+		require.NoError(t, err)
+		require.Equal(t, int32(75), report.TotalMinutes)
+	})
+
+	t.Run("the request is validated", func(t *testing.T) {
+		_, err := ts.Service.GetTimeReport(ctx, &v1pb.GetTimeReportRequest{StartDate: "2025-06-01", EndDate: "2025-06-30"})
+		require.Error(t, err)
+		_, err = ts.Service.GetTimeReport(userCtx, &v1pb.GetTimeReportRequest{StartDate: "2025-06-30", EndDate: "2025-06-01"})
+		require.Error(t, err)
+		_, err = ts.Service.GetTimeReport(userCtx, &v1pb.GetTimeReportRequest{StartDate: "2024-01-01", EndDate: "2025-06-01"})
+		require.Error(t, err)
+	})
+}
+
+func TestSumProperties(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "budgeter")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo := createTrackedMemo(t, ts, userCtx, "Lunch $12.50 #food\nBus $2.75 #transport #work", time.Date(2025, 5, 3, 12, 0, 0, 0, time.UTC))
+	createTrackedMemo(t, ts, userCtx, "Dinner $20.10 #food\nCoffee €3", time.Date(2025, 5, 20, 19, 0, 0, 0, time.UTC))
+	createTrackedMemo(t, ts, userCtx, "Groceries $40 #food\nRefund -$10 #food", time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC))
+	createTrackedMemo(t, ts, userCtx, "Rent $1,000 #home", time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC))
+
+	t.Run("the amounts are exposed on the memo", func(t *testing.T) {
+		require.Len(t, memo.Property.Amounts, 2)
+		require.Equal(t, 12.5, memo.Property.Amounts[0].Value)
+		require.Equal(t, "$", memo.Property.Amounts[0].Currency)
+		require.Equal(t, []string{"transport", "work"}, memo.Property.Amounts[1].Tags)
+	})
+
+	t.Run("the amounts are summed per month", func(t *testing.T) {
+		response, err := ts.Service.SumProperties(userCtx, &v1pb.SumPropertiesRequest{StartDate: "2025-05-01", EndDate: "2025-06-30", Timezone: "UTC"})
+		require.NoError(t, err)
+		require.Len(t, response.Periods, 2)
+		may := response.Periods[0]
+		require.Equal(t, "2025-05-01", may.StartDate)
+		require.Equal(t, []*v1pb.SumPropertiesResponse_Sum{
+			{Tag: "", Currency: "€", Total: 3, Count: 1},
+			{Tag: "food", Currency: "$", Total: 32.6, Count: 2},
+			{Tag: "transport", Currency: "$", Total: 2.75, Count: 1},
+			{Tag: "work", Currency: "$", Total: 2.75, Count: 1},
+		}, may.Sums)
+		june := response.Periods[1]
+		require.Equal(t, "2025-06-01", june.StartDate)
+		require.Equal(t, []*v1pb.SumPropertiesResponse_Sum{{Tag: "food", Currency: "$", Total: 30, Count: 2}}, june.Sums)
+		require.Equal(t, 62.6, response.Totals[1].Total)
+	})
+
+	t.Run("the amounts are summed per week and year", func(t *testing.T) {
+		response, err := ts.Service.SumProperties(userCtx, &v1pb.SumPropertiesRequest{StartDate: "2025-05-01", EndDate: "2025-08-31", Timezone: "UTC", Period: v1pb.SumPropertiesRequest_WEEK})
+		require.NoError(t, err)
+		require.Len(t, response.Periods, 4)
+		// The weeks start on Sunday by default.
+		require.Equal(t, "2025-04-27", response.Periods[0].StartDate)
+
+		response, err = ts.Service.SumProperties(userCtx, &v1pb.SumPropertiesRequest{StartDate: "2025-01-01", EndDate: "2025-12-31", Timezone: "UTC", Period: v1pb.SumPropertiesRequest_YEAR, Filter: `tag in ["home"]`})
+		require.NoError(t, err)
+		require.Len(t, response.Periods, 1)
+		require.Equal(t, "2025-01-01", response.Periods[0].StartDate)
+		require.Equal(t, []*v1pb.SumPropertiesResponse_Sum{{Tag: "home", Currency: "$", Total: 1000, Count: 1}}, response.Totals)
+	})
+
+	t.Run("the request is validated", func(t *testing.T) {
+		_, err := ts.Service.SumProperties(ctx, &v1pb.SumPropertiesRequest{StartDate: "2025-05-01", EndDate: "2025-06-30"})
+		require.Error(t, err)
+		_, err = ts.Service.SumProperties(userCtx, &v1pb.SumPropertiesRequest{StartDate: "2025-05-01", EndDate: "2025-06-30", Period: 9})
+		require.Error(t, err)
+	})
+}
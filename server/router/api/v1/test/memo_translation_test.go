@@ -0,0 +1,80 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoTranslation(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Languages are stored in their canonical form.
+	original, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Good morning", Visibility: v1pb.Visibility_PRIVATE, Language: "EN-us"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "en-US", original.Language)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Invalid", Language: "not a language"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	translation, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    "Guten Morgen",
+			Visibility: v1pb.Visibility_PRIVATE,
+			Language:   "de",
+			Relations: []*v1pb.MemoRelation{{
+				RelatedMemo: &v1pb.MemoRelation_Memo{Name: original.Name},
+				Type:        v1pb.MemoRelation_TRANSLATION_OF,
+			}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, translation.Relations, 1)
+	require.Equal(t, v1pb.MemoRelation_TRANSLATION_OF, translation.Relations[0].Type)
+	require.Equal(t, original.Name, translation.Relations[0].RelatedMemo.Name)
+
+	// The original lists its translations among its relations.
+	relations, err := ts.Service.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{Name: original.Name})
+	require.NoError(t, err)
+	require.Len(t, relations.Relations, 1)
+	require.Equal(t, translation.Name, relations.Relations[0].Memo.Name)
+
+	// Memos can be listed by language.
+	memos, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: `language == "de"`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	require.Equal(t, translation.Name, memos.Memos[0].Name)
+
+	updated, err := ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: original.Name, Language: "fr"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"language"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "fr", updated.Language)
+	memos, err = ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: `language in ["de", "fr"]`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 2)
+
+	// Setting the relations replaces the translation relations too.
+	_, err = ts.Service.SetMemoRelations(userCtx, &v1pb.SetMemoRelationsRequest{Name: translation.Name})
+	require.NoError(t, err)
+	relations, err = ts.Service.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{Name: original.Name})
+	require.NoError(t, err)
+	require.Empty(t, relations.Relations)
+}
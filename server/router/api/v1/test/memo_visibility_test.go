@@ -0,0 +1,182 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestWorkspaceMemoVisibility(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	publicMemo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "public memo", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	// Role default visibility cannot be public while public visibility is disallowed.
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/MEMO_RELATED",
+			Value: &v1pb.WorkspaceSetting_MemoRelatedSetting_{
+				MemoRelatedSetting: &v1pb.WorkspaceSetting_MemoRelatedSetting{
+					DisallowPublicVisibility: true,
+					RoleDefaultVisibilities:  map[string]string{"USER": "PUBLIC"},
+				},
+			},
+		},
+	})
+	require.Error(t, err)
+
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/MEMO_RELATED",
+			Value: &v1pb.WorkspaceSetting_MemoRelatedSetting_{
+				MemoRelatedSetting: &v1pb.WorkspaceSetting_MemoRelatedSetting{
+					DisallowPublicVisibility: true,
+					RoleDefaultVisibilities:  map[string]string{"USER": "PROTECTED"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Memos without an explicit visibility get the role default.
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "default visibility"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PROTECTED, memo.Visibility)
+
+	// Regular users cannot downgrade public memos.
+	_, err = ts.Service.DowngradePublicMemos(userCtx, &v1pb.DowngradePublicMemosRequest{})
+	require.Error(t, err)
+
+	response, err := ts.Service.DowngradePublicMemos(hostCtx, &v1pb.DowngradePublicMemosRequest{Visibility: "PRIVATE"})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), response.DowngradedCount)
+
+	memo, err = ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: publicMemo.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PRIVATE, memo.Visibility)
+}
+
+func TestProtectedVisibilityRoles(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	adminUser, err := ts.Store.CreateUser(ctx, &store.User{Username: "admin", Role: store.RoleAdmin, Email: "admin@example.com"})
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, adminUser.ID)
+	creator, err := ts.CreateRegularUser(ctx, "creator")
+	require.NoError(t, err)
+	creatorCtx := ts.CreateUserContext(ctx, creator.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	protectedMemo, err := ts.Service.CreateMemo(creatorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "protected memo", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	summaryMemo, err := ts.Service.CreateMemo(creatorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "public summary", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	summaryMemoUID, protectedMemoUID := summaryMemo.Name[len("memos/"):], protectedMemo.Name[len("memos/"):]
+	summary, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &summaryMemoUID})
+	require.NoError(t, err)
+	protected, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &protectedMemoUID})
+	require.NoError(t, err)
+	_, err = ts.Store.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: summary.ID, RelatedMemoID: protected.ID, Type: store.MemoRelationReference})
+	require.NoError(t, err)
+
+	setProtectedVisibilityRoles := func(roles []string) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/MEMO_RELATED",
+				Value: &v1pb.WorkspaceSetting_MemoRelatedSetting_{
+					MemoRelatedSetting: &v1pb.WorkspaceSetting_MemoRelatedSetting{ProtectedVisibilityRoles: roles},
+				},
+			},
+		})
+		return err
+	}
+	canView := func(userCtx context.Context) bool {
+		_, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: protectedMemo.Name})
+		if status.Code(err) == codes.PermissionDenied {
+			return false
+		}
+		require.NoError(t, err)
+		memos, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{})
+		require.NoError(t, err)
+		listed := false
+		for _, memo := range memos.Memos {
+			listed = listed || memo.Name == protectedMemo.Name
+		}
+		require.True(t, listed)
+		sourceMemos, err := ts.Service.GetMemoSourceMemos(userCtx, &v1pb.GetMemoSourceMemosRequest{Name: summaryMemo.Name})
+		require.NoError(t, err)
+		require.Len(t, sourceMemos.Memos, 1)
+		return true
+	}
+
+	// Without roles, the protected memos are visible to all the signed-in users.
+	require.True(t, canView(userCtx))
+
+	require.Equal(t, codes.InvalidArgument, status.Code(setProtectedVisibilityRoles([]string{"GUEST"})))
+	require.NoError(t, setProtectedVisibilityRoles([]string{"ADMIN"}))
+	require.True(t, canView(adminCtx))
+	require.True(t, canView(creatorCtx))
+	require.False(t, canView(userCtx))
+	memos, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	require.Equal(t, summaryMemo.Name, memos.Memos[0].Name)
+	sourceMemos, err := ts.Service.GetMemoSourceMemos(userCtx, &v1pb.GetMemoSourceMemosRequest{Name: summaryMemo.Name})
+	require.NoError(t, err)
+	require.Empty(t, sourceMemos.Memos)
+	require.Equal(t, int32(1), sourceMemos.HiddenCount)
+
+	// The private sources of other users are hidden from the creator of the AI memo too.
+	privateMemo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "private memo", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	privateMemoUID := privateMemo.Name[len("memos/"):]
+	private, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &privateMemoUID})
+	require.NoError(t, err)
+	_, err = ts.Store.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: summary.ID, RelatedMemoID: private.ID, Type: store.MemoRelationReference})
+	require.NoError(t, err)
+	sourceMemos, err = ts.Service.GetMemoSourceMemos(creatorCtx, &v1pb.GetMemoSourceMemosRequest{Name: summaryMemo.Name})
+	require.NoError(t, err)
+	require.Len(t, sourceMemos.Memos, 1)
+	require.Equal(t, protectedMemo.Name, sourceMemos.Memos[0].Name)
+	require.Equal(t, int32(1), sourceMemos.HiddenCount)
+	sourceMemos, err = ts.Service.GetMemoSourceMemos(userCtx, &v1pb.GetMemoSourceMemosRequest{Name: summaryMemo.Name})
+	require.NoError(t, err)
+	require.Len(t, sourceMemos.Memos, 1)
+	require.Equal(t, privateMemo.Name, sourceMemos.Memos[0].Name)
+	require.Equal(t, int32(1), sourceMemos.HiddenCount)
+}
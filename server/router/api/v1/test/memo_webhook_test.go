@@ -0,0 +1,67 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoUpdatedWebhookPayload(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	payloads := make(chan *webhook.WebhookRequestPayload, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload := &webhook.WebhookRequestPayload{}
+		if err := json.NewDecoder(r.Body).Decode(payload); err == nil {
+			payloads <- payload
+		}
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "hello #draft", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &v1pb.UserWebhook{Url: server.URL},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "hello #published", Pinned: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "pinned"}},
+	})
+	require.NoError(t, err)
+
+	select {
+	case payload := <-payloads:
+		require.Equal(t, "memos.memo.updated", payload.ActivityType)
+		require.NotNil(t, payload.PreviousMemo)
+		require.Equal(t, "hello #draft", payload.PreviousMemo.Content)
+		require.Equal(t, []string{"draft"}, payload.PreviousMemo.Tags)
+		require.Equal(t, "hello #published", payload.Memo.Content)
+		require.ElementsMatch(t, []string{"content", "pinned", "tags"}, payload.ChangedFields)
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not dispatched")
+	}
+}
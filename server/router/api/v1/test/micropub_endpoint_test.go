@@ -0,0 +1,290 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// newMicropubServer returns an HTTP server of the Micropub routes of the service.
+func newMicropubServer(ts *TestService) *httptest.Server {
+	e := echo.New()
+	ts.Service.RegisterMicropubRoutes(e.Group(""))
+	return httptest.NewServer(e)
+}
+
+// doMicropubRequest sends the request with the access token and returns its response, whose body is closed.
+func doMicropubRequest(t *testing.T, method, target, contentType, accessToken string, body []byte) *http.Response {
+	req, err := http.NewRequest(method, target, bytes.NewReader(body))
+	require.NoError(t, err)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	return resp
+}
+
+func TestMicropubEndpoint(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	server := newMicropubServer(ts)
+	defer server.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	accessToken, err := ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+		Parent:      fmt.Sprintf("users/%d", user.ID),
+		AccessToken: &v1pb.UserAccessToken{Description: "quill"},
+	})
+	require.NoError(t, err)
+	token := accessToken.AccessToken
+
+	t.Run("the access token is required", func(t *testing.T) {
+		resp := doMicropubRequest(t, http.MethodGet, server.URL+"/micropub?q=config", "", "", nil)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		resp = doMicropubRequest(t, http.MethodGet, server.URL+"/micropub?q=config", "", "invalid", nil)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("the configuration has the media endpoint", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/micropub?q=config", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		config := map[string]any{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&config))
+		require.Equal(t, "http://localhost:8080/micropub/media", config["media-endpoint"])
+	})
+
+	var memoName string
+	t.Run("a form-encoded entry creates a memo", func(t *testing.T) {
+		form := url.Values{
+			"h":          {"entry"},
+			"content":    {"Hello from #quill"},
+			"category[]": {"quill", "indie web"},
+			"visibility": {"public"},
+		}
+		resp := doMicropubRequest(t, http.MethodPost, server.URL+"/micropub", "application/x-www-form-urlencoded", token, []byte(form.Encode()))
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		location := resp.Header.Get("Location")
+		require.True(t, strings.HasPrefix(location, "http://localhost:8080/memos/"), location)
+
+		memoName = "memos/" + strings.TrimPrefix(location, "http://localhost:8080/memos/")
+		memo, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memoName})
+		require.NoError(t, err)
+		require.Equal(t, "Hello from #quill\n\n#indie-web", memo.Content)
+		require.Equal(t, v1pb.Visibility_PUBLIC, memo.Visibility)
+		require.ElementsMatch(t, []string{"quill", "indie-web"}, memo.Tags)
+	})
+
+	t.Run("an entry is updated", func(t *testing.T) {
+		body := `{"action":"update","url":"http://localhost:8080/` + memoName + `","replace":{"content":["Edited #quill"]},"add":{"category":["notes"]},"delete":{"category":["quill"]}}`
+		resp := doMicropubRequest(t, http.MethodPost, server.URL+"/micropub", "application/json", token, []byte(body))
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		memo, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memoName})
+		require.NoError(t, err)
+		require.Equal(t, "Edited\n\n#notes", memo.Content)
+	})
+
+	t.Run("the source of an entry is queried", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/micropub?q=source&url="+url.QueryEscape("http://localhost:8080/"+memoName), nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		source := struct {
+			Properties map[string][]string `json:"properties"`
+		}{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&source))
+		require.Equal(t, []string{"Edited\n\n#notes"}, source.Properties["content"])
+		require.Equal(t, []string{"public"}, source.Properties["visibility"])
+	})
+
+	t.Run("the entries of another user cannot be changed", func(t *testing.T) {
+		other, err := ts.CreateRegularUser(ctx, "bob")
+		require.NoError(t, err)
+		otherMemo, err := ts.Service.CreateMemo(ts.CreateUserContext(ctx, other.ID), &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Bob's memo", Visibility: v1pb.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		form := url.Values{"action": {"delete"}, "url": {"http://localhost:8080/" + otherMemo.Name}}
+		resp := doMicropubRequest(t, http.MethodPost, server.URL+"/micropub", "application/x-www-form-urlencoded", token, []byte(form.Encode()))
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("an uploaded photo is attached to the memo", func(t *testing.T) {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("file", "photo.png")
+		require.NoError(t, err)
+		_, err = part.Write([]byte("\x89PNG\r\n\x1a\nphoto"))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		resp := doMicropubRequest(t, http.MethodPost, server.URL+"/micropub/media", writer.FormDataContentType(), token, body.Bytes())
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		photoURL := resp.Header.Get("Location")
+		require.True(t, strings.HasPrefix(photoURL, "http://localhost:8080/file/attachments/"), photoURL)
+
+		entry := `{"type":["h-entry"],"properties":{"content":["A photo"],"photo":[{"value":"` + photoURL + `","alt":"A cat"},"https://example.com/dog.jpg"]}}`
+		resp = doMicropubRequest(t, http.MethodPost, server.URL+"/micropub", "application/json", token, []byte(entry))
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		name := "memos/" + strings.TrimPrefix(resp.Header.Get("Location"), "http://localhost:8080/memos/")
+		memo, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: name})
+		require.NoError(t, err)
+		require.Equal(t, "A photo\n\n![](https://example.com/dog.jpg)", memo.Content)
+		require.Len(t, memo.Attachments, 1)
+		require.Equal(t, "photo.png", memo.Attachments[0].Filename)
+	})
+
+	t.Run("an entry is deleted", func(t *testing.T) {
+		form := url.Values{"action": {"delete"}, "url": {"http://localhost:8080/" + memoName}}
+		resp := doMicropubRequest(t, http.MethodPost, server.URL+"/micropub", "application/x-www-form-urlencoded", token, []byte(form.Encode()))
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+		_, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memoName})
+		require.Error(t, err)
+	})
+}
+
+func TestMicropubIndieAuth(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	server := newMicropubServer(ts)
+	defer server.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	endpoint := fmt.Sprintf("%s/micropub/users/%d", server.URL, user.ID)
+
+	var me string
+	var verifiedTokens atomic.Int32
+	tokenEndpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		verifiedTokens.Add(1)
+		switch r.Header.Get("Authorization") {
+		case "Bearer create-token":
+			_, _ = fmt.Fprintf(w, `{"me":%q,"client_id":"https://quill.p3k.io/","scope":"create media"}`, me)
+		case "Bearer read-token":
+			_, _ = fmt.Fprintf(w, `{"me":%q,"client_id":"https://quill.p3k.io/","scope":"read"}`, me)
+		case "Bearer other-token":
+			_, _ = w.Write([]byte(`{"me":"https://bob.example/","client_id":"https://quill.p3k.io/","scope":"create"}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer tokenEndpoint.Close()
+	// The page of the identity advertises the Micropub endpoint of alice, and the page of the impostor does not.
+	page := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		micropub := fmt.Sprintf("http://localhost:8080/micropub/users/%d", user.ID)
+		if r.URL.Path == "/impostor" {
+			micropub = fmt.Sprintf("http://localhost:8080/micropub/users/%d", other.ID)
+		}
+		_, _ = fmt.Fprintf(w, `<html><head><link rel="micropub" href="%s"><link rel="token_endpoint" href="%s"></head></html>`, micropub, tokenEndpoint.URL)
+	}))
+	defer page.Close()
+	me = page.URL + "/"
+
+	updateSetting := func(ctx context.Context, userID int32, setting *v1pb.UserSetting_IndieAuthSetting, paths ...string) (*v1pb.UserSetting, error) {
+		return ts.Service.UpdateUserSetting(ctx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name:  fmt.Sprintf("users/%d/settings/INDIEAUTH", userID),
+				Value: &v1pb.UserSetting_IndieAuthSetting_{IndieAuthSetting: setting},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+	}
+
+	t.Run("the page must advertise the endpoint of the user", func(t *testing.T) {
+		_, err := updateSetting(userCtx, user.ID, &v1pb.UserSetting_IndieAuthSetting{Me: page.URL + "/impostor"}, "me")
+		require.Error(t, err)
+		_, err = updateSetting(userCtx, user.ID, &v1pb.UserSetting_IndieAuthSetting{Me: me, TokenEndpoint: "https://tokens.example/token"}, "me", "tokenEndpoint")
+		require.Error(t, err)
+	})
+
+	t.Run("the identity of another user is rejected", func(t *testing.T) {
+		_, err := updateSetting(ts.CreateUserContext(ctx, other.ID), other.ID, &v1pb.UserSetting_IndieAuthSetting{Me: me}, "me")
+		require.Error(t, err)
+	})
+
+	setting, err := updateSetting(userCtx, user.ID, &v1pb.UserSetting_IndieAuthSetting{Me: strings.ToUpper(page.URL[:4]) + page.URL[4:]}, "me")
+	require.NoError(t, err)
+	require.Equal(t, me, setting.GetIndieAuthSetting().Me)
+	require.Equal(t, tokenEndpoint.URL+"/", setting.GetIndieAuthSetting().TokenEndpoint)
+
+	t.Run("a token of the identity creates a memo", func(t *testing.T) {
+		form := url.Values{"h": {"entry"}, "content": {"Posted with Quill"}, "access_token": {"create-token"}}
+		resp := doMicropubRequest(t, http.MethodPost, endpoint, "application/x-www-form-urlencoded", "", []byte(form.Encode()))
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		name := "memos/" + strings.TrimPrefix(resp.Header.Get("Location"), "http://localhost:8080/memos/")
+		memo, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: name})
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("users/%d", user.ID), memo.Creator)
+		require.Equal(t, "Posted with Quill", memo.Content)
+	})
+
+	t.Run("the token must have the scope of the action", func(t *testing.T) {
+		form := url.Values{"h": {"entry"}, "content": {"Hello"}}
+		resp := doMicropubRequest(t, http.MethodPost, endpoint, "application/x-www-form-urlencoded", "read-token", []byte(form.Encode()))
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("a token of another identity is rejected", func(t *testing.T) {
+		form := url.Values{"h": {"entry"}, "content": {"Hello"}}
+		resp := doMicropubRequest(t, http.MethodPost, endpoint, "application/x-www-form-urlencoded", "other-token", []byte(form.Encode()))
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("the token endpoint is only asked by the endpoint of the user", func(t *testing.T) {
+		form := url.Values{"h": {"entry"}, "content": {"Hello"}}
+		verifiedTokens.Store(0)
+		resp := doMicropubRequest(t, http.MethodPost, server.URL+"/micropub", "application/x-www-form-urlencoded", "create-token", []byte(form.Encode()))
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		resp = doMicropubRequest(t, http.MethodPost, fmt.Sprintf("%s/micropub/users/%d", server.URL, other.ID), "application/x-www-form-urlencoded", "create-token", []byte(form.Encode()))
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		require.Zero(t, verifiedTokens.Load())
+	})
+
+	t.Run("the access tokens of the instance are never sent to the token endpoint", func(t *testing.T) {
+		accessToken, err := ts.Service.CreateUserAccessToken(ts.CreateUserContext(ctx, other.ID), &v1pb.CreateUserAccessTokenRequest{
+			Parent:      fmt.Sprintf("users/%d", other.ID),
+			AccessToken: &v1pb.UserAccessToken{Description: "quill"},
+		})
+		require.NoError(t, err)
+		form := url.Values{"h": {"entry"}, "content": {"Hello"}}
+		verifiedTokens.Store(0)
+		resp := doMicropubRequest(t, http.MethodPost, endpoint, "application/x-www-form-urlencoded", accessToken.AccessToken, []byte(form.Encode()))
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		require.Zero(t, verifiedTokens.Load())
+	})
+}
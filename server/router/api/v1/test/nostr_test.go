@@ -0,0 +1,128 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"nhooyr.io/websocket"
+
+	"github.com/usememos/memos/plugin/nostr"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestNostrPublication(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	// The relay accepts every event it receives.
+	events := make(chan *nostr.Event, 10)
+	relay := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close(websocket.StatusNormalClosure, "")
+		_, data, err := conn.Read(r.Context())
+		if err != nil {
+			return
+		}
+		var message []json.RawMessage
+		if err := json.Unmarshal(data, &message); err != nil || len(message) != 2 {
+			return
+		}
+		event := &nostr.Event{}
+		if err := json.Unmarshal(message[1], event); err != nil {
+			return
+		}
+		events <- event
+		response, _ := json.Marshal([]any{"OK", event.ID, true, ""})
+		_ = conn.Write(r.Context(), websocket.MessageText, response)
+	}))
+	defer relay.Close()
+	nextEvent := func() *nostr.Event {
+		select {
+		case event := <-events:
+			require.True(t, event.Verify())
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event published")
+			return nil
+		}
+	}
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	settingName := fmt.Sprintf("users/%d/settings/NOSTR", user.ID)
+
+	_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name:  settingName,
+			Value: &v1pb.UserSetting_NostrSetting_{NostrSetting: &v1pb.UserSetting_NostrSetting{Relays: []string{"https://relay.example.com"}}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"relays"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	setting, err := ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name: settingName,
+			Value: &v1pb.UserSetting_NostrSetting_{NostrSetting: &v1pb.UserSetting_NostrSetting{
+				Enabled: true,
+				Relays:  []string{"ws://" + strings.TrimPrefix(relay.URL, "http://")},
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"enabled", "relays"}},
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(setting.GetNostrSetting().PublicKey, nostr.PublicKeyPrefix+"1"))
+	require.Empty(t, setting.GetNostrSetting().PrivateKey)
+
+	// Private memos are not published.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "secret", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Hello #Work", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	note := nextEvent()
+	require.Equal(t, nostr.KindTextNote, note.Kind)
+	require.Equal(t, "Hello #Work", note.Content)
+	require.Contains(t, note.Tags, []string{"t", "work"})
+
+	// An edit replaces the note.
+	memo.Content = "Hello again #Work"
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: memo, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}}})
+	require.NoError(t, err)
+	edited := nextEvent()
+	require.Equal(t, nostr.KindTextNote, edited.Kind)
+	require.Equal(t, "Hello again #Work", edited.Content)
+	require.Equal(t, note.PubKey, edited.PubKey)
+	deletion := nextEvent()
+	require.Equal(t, nostr.KindDeletion, deletion.Kind)
+	require.Contains(t, deletion.Tags, []string{"e", note.ID})
+
+	// Deleting the memo deletes its note.
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	deletion = nextEvent()
+	require.Equal(t, nostr.KindDeletion, deletion.Kind)
+	require.Contains(t, deletion.Tags, []string{"e", edited.ID})
+
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event of kind %d", event.Kind)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
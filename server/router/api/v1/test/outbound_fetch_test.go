@@ -0,0 +1,72 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestOutboundFetchSetting(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	updateSetting := func(setting *v1pb.WorkspaceSetting_OutboundFetchSetting) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name:  "workspace/settings/OUTBOUND_FETCH",
+				Value: &v1pb.WorkspaceSetting_OutboundFetchSetting_{OutboundFetchSetting: setting},
+			},
+		})
+		return err
+	}
+	require.Equal(t, codes.InvalidArgument, status.Code(updateSetting(&v1pb.WorkspaceSetting_OutboundFetchSetting{AllowedHosts: []string{"10.0.0.0/33"}})))
+	require.Equal(t, codes.InvalidArgument, status.Code(updateSetting(&v1pb.WorkspaceSetting_OutboundFetchSetting{AllowedPorts: []int32{70000}})))
+	require.Equal(t, codes.InvalidArgument, status.Code(updateSetting(&v1pb.WorkspaceSetting_OutboundFetchSetting{TimeoutSeconds: -1})))
+
+	created, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &v1pb.UserWebhook{Url: server.URL},
+	})
+	require.NoError(t, err)
+
+	// Without allowlisting it, the loopback address of the test server is blocked.
+	require.NoError(t, updateSetting(&v1pb.WorkspaceSetting_OutboundFetchSetting{}))
+	delivery, err := ts.Service.TestUserWebhook(userCtx, &v1pb.TestUserWebhookRequest{Name: created.Name})
+	require.NoError(t, err)
+	require.Zero(t, delivery.ResponseCode)
+	require.Contains(t, delivery.Error, "outbound request blocked")
+
+	require.NoError(t, updateSetting(&v1pb.WorkspaceSetting_OutboundFetchSetting{AllowedHosts: []string{"127.0.0.0/8"}}))
+	delivery, err = ts.Service.TestUserWebhook(userCtx, &v1pb.TestUserWebhookRequest{Name: created.Name})
+	require.NoError(t, err)
+	require.Equal(t, int32(http.StatusOK), delivery.ResponseCode)
+
+	// The allowed hosts may reveal the internal network, only admins can get them.
+	setting, err := ts.Service.GetWorkspaceSetting(hostCtx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/OUTBOUND_FETCH"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"127.0.0.0/8"}, setting.GetOutboundFetchSetting().AllowedHosts)
+	_, err = ts.Service.GetWorkspaceSetting(userCtx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/OUTBOUND_FETCH"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
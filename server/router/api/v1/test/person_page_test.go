@@ -0,0 +1,73 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestGetPersonPage(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "networker")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	first := createTrackedMemo(t, ts, userCtx, "Coffee with #person/Alice #work", time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC))
+	second := createTrackedMemo(t, ts, userCtx, "Planning with #person/alice and #person/bob #work #project", time.Date(2025, 2, 3, 9, 0, 0, 0, time.UTC))
+	createTrackedMemo(t, ts, userCtx, "Lunch with #person/bob", time.Date(2025, 2, 4, 9, 0, 0, 0, time.UTC))
+	createTrackedMemo(t, ts, otherCtx, "Call #person/alice", time.Date(2025, 2, 5, 9, 0, 0, 0, time.UTC))
+
+	t.Run("the page aggregates the memos with the person tag", func(t *testing.T) {
+		page, err := ts.Service.GetPersonPage(userCtx, &v1pb.GetPersonPageRequest{Name: "people/Alice"})
+		require.NoError(t, err)
+		require.Equal(t, "people/alice", page.Name)
+		require.Equal(t, "person/alice", page.Tag)
+		require.Equal(t, int32(2), page.MemoCount)
+		require.Equal(t, "2025-01-10T09:00:00Z", page.FirstMentionTime.AsTime().Format(time.RFC3339))
+		require.Equal(t, "2025-02-03T09:00:00Z", page.LastMentionTime.AsTime().Format(time.RFC3339))
+		require.Len(t, page.Memos, 2)
+
+		require.Len(t, page.Timeline, 2)
+		require.Equal(t, second.Name, page.Timeline[0].Memo)
+		require.Equal(t, []string{"people/bob"}, page.Timeline[0].People)
+		require.Equal(t, first.Name, page.Timeline[1].Memo)
+		require.Empty(t, page.Timeline[1].People)
+
+		require.Equal(t, "work", page.CoOccurringTags[0].Tag)
+		require.Equal(t, int32(2), page.CoOccurringTags[0].Count)
+		require.Len(t, page.CoOccurringTags, 3)
+	})
+
+	t.Run("the memos are paginated", func(t *testing.T) {
+		page, err := ts.Service.GetPersonPage(userCtx, &v1pb.GetPersonPageRequest{Name: "people/alice", PageSize: 1})
+		require.NoError(t, err)
+		require.Len(t, page.Memos, 1)
+		require.NotEmpty(t, page.NextPageToken)
+		require.Equal(t, int32(2), page.MemoCount)
+	})
+
+	t.Run("the unknown people have an empty page", func(t *testing.T) {
+		page, err := ts.Service.GetPersonPage(userCtx, &v1pb.GetPersonPageRequest{Name: "people/carol"})
+		require.NoError(t, err)
+		require.Zero(t, page.MemoCount)
+		require.Nil(t, page.FirstMentionTime)
+	})
+
+	t.Run("the page requires authentication and a valid name", func(t *testing.T) {
+		_, err := ts.Service.GetPersonPage(ctx, &v1pb.GetPersonPageRequest{Name: "people/alice"})
+		require.Error(t, err)
+		_, err = ts.Service.GetPersonPage(userCtx, &v1pb.GetPersonPageRequest{Name: "people/a b"})
+		require.Error(t, err)
+	})
+}
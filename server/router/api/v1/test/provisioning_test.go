@@ -0,0 +1,115 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestProvisioning(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "bot")
+	require.NoError(t, err)
+	userName := fmt.Sprintf("users/%d", user.ID)
+
+	t.Run("updates with a stale etag are aborted", func(t *testing.T) {
+		read, err := ts.Service.GetUser(hostCtx, &v1pb.GetUserRequest{Name: userName})
+		require.NoError(t, err)
+		require.NotEmpty(t, read.Etag)
+
+		updated, err := ts.Service.UpdateUser(hostCtx, &v1pb.UpdateUserRequest{
+			User:       &v1pb.User{Name: userName, DisplayName: "Bot", Etag: read.Etag},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+		})
+		require.NoError(t, err)
+		require.NotEqual(t, read.Etag, updated.Etag)
+
+		_, err = ts.Service.UpdateUser(hostCtx, &v1pb.UpdateUserRequest{
+			User:       &v1pb.User{Name: userName, DisplayName: "Robot", Etag: read.Etag},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+		})
+		require.Equal(t, codes.Aborted, status.Code(err))
+	})
+
+	t.Run("workspace settings have etags", func(t *testing.T) {
+		setting, err := ts.Service.GetWorkspaceSetting(hostCtx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/GENERAL"})
+		require.NoError(t, err)
+		setting.GetGeneralSetting().AdditionalStyle = "body {}"
+		updated, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{Setting: setting})
+		require.NoError(t, err)
+
+		_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{Setting: setting})
+		require.Equal(t, codes.Aborted, status.Code(err))
+		_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{Setting: updated})
+		require.NoError(t, err)
+	})
+
+	t.Run("webhooks are read and deleted idempotently", func(t *testing.T) {
+		webhook, err := ts.Service.CreateUserWebhook(hostCtx, &v1pb.CreateUserWebhookRequest{
+			Parent:  userName,
+			Webhook: &v1pb.UserWebhook{Url: "https://example.com/hook"},
+		})
+		require.NoError(t, err)
+		read, err := ts.Service.GetUserWebhook(hostCtx, &v1pb.GetUserWebhookRequest{Name: webhook.Name})
+		require.NoError(t, err)
+		require.Equal(t, webhook.Etag, read.Etag)
+		require.Empty(t, read.Secret)
+
+		_, err = ts.Service.DeleteUserWebhook(hostCtx, &v1pb.DeleteUserWebhookRequest{Name: webhook.Name, Etag: "stale"})
+		require.Equal(t, codes.Aborted, status.Code(err))
+		_, err = ts.Service.DeleteUserWebhook(hostCtx, &v1pb.DeleteUserWebhookRequest{Name: webhook.Name, Etag: read.Etag})
+		require.NoError(t, err)
+		_, err = ts.Service.DeleteUserWebhook(hostCtx, &v1pb.DeleteUserWebhookRequest{Name: webhook.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		_, err = ts.Service.DeleteUserWebhook(hostCtx, &v1pb.DeleteUserWebhookRequest{Name: webhook.Name, AllowMissing: true})
+		require.NoError(t, err)
+	})
+
+	t.Run("the host manages the access tokens of other users", func(t *testing.T) {
+		accessToken, err := ts.Service.CreateUserAccessToken(hostCtx, &v1pb.CreateUserAccessTokenRequest{
+			Parent:      userName,
+			AccessToken: &v1pb.UserAccessToken{Description: "provisioning"},
+		})
+		require.NoError(t, err)
+		accessTokens, err := ts.Service.ListUserAccessTokens(ts.CreateUserContext(ctx, user.ID), &v1pb.ListUserAccessTokensRequest{Parent: userName})
+		require.NoError(t, err)
+		require.Len(t, accessTokens.AccessTokens, 1)
+		require.Equal(t, accessToken.Name, accessTokens.AccessTokens[0].Name)
+
+		_, err = ts.Service.DeleteUserAccessToken(hostCtx, &v1pb.DeleteUserAccessTokenRequest{Name: accessToken.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.DeleteUserAccessToken(hostCtx, &v1pb.DeleteUserAccessTokenRequest{Name: accessToken.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		_, err = ts.Service.DeleteUserAccessToken(hostCtx, &v1pb.DeleteUserAccessTokenRequest{Name: accessToken.Name, AllowMissing: true})
+		require.NoError(t, err)
+
+		_, err = ts.Service.CreateUserAccessToken(ts.CreateUserContext(ctx, user.ID), &v1pb.CreateUserAccessTokenRequest{
+			Parent:      fmt.Sprintf("users/%d", host.ID),
+			AccessToken: &v1pb.UserAccessToken{},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("users are deleted idempotently", func(t *testing.T) {
+		_, err := ts.Service.DeleteUser(hostCtx, &v1pb.DeleteUserRequest{Name: userName})
+		require.NoError(t, err)
+		_, err = ts.Service.DeleteUser(hostCtx, &v1pb.DeleteUserRequest{Name: userName})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		_, err = ts.Service.DeleteUser(hostCtx, &v1pb.DeleteUserRequest{Name: userName, AllowMissing: true})
+		require.NoError(t, err)
+	})
+}
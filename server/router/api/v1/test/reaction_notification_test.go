@@ -0,0 +1,93 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/reactionnotify"
+)
+
+func TestReactionNotificationAggregation(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Service.ReactionNotifier = reactionnotify.NewRunner(ts.Store)
+
+	owner, err := ts.CreateRegularUser(ctx, "owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	memo, err := ts.Service.CreateMemo(ownerCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "hello", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	users := map[string]int32{"owner": owner.ID}
+	for _, username := range []string{"alice", "bob", "carol", "dave", "erin", "frank"} {
+		user, err := ts.CreateRegularUser(ctx, username)
+		require.NoError(t, err)
+		users[username] = user.ID
+	}
+	react := func(username, reactionType string) *v1pb.Reaction {
+		reaction, err := ts.Service.UpsertMemoReaction(ts.CreateUserContext(ctx, users[username]), &v1pb.UpsertMemoReactionRequest{
+			Name:     memo.Name,
+			Reaction: &v1pb.Reaction{ContentId: memo.Name, ReactionType: reactionType},
+		})
+		require.NoError(t, err)
+		return reaction
+	}
+	listReactionInboxes := func() []*v1pb.Inbox {
+		response, err := ts.Service.ListInboxes(ownerCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", owner.ID)})
+		require.NoError(t, err)
+		inboxes := []*v1pb.Inbox{}
+		for _, inbox := range response.Inboxes {
+			if inbox.Type == v1pb.Inbox_MEMO_REACTION {
+				inboxes = append(inboxes, inbox)
+			}
+		}
+		return inboxes
+	}
+
+	// A burst reaching the default threshold is notified once. Reactions of the owner are not notified.
+	react("alice", "👍")
+	react("bob", "🎉")
+	react("carol", "👍")
+	react("owner", "👍")
+	require.NoError(t, ts.Service.ReactionNotifier.RunOnce(ctx))
+	inboxes := listReactionInboxes()
+	require.Len(t, inboxes, 1)
+	require.NotNil(t, inboxes[0].ActivityId)
+	activity, err := ts.Service.GetActivity(ownerCtx, &v1pb.GetActivityRequest{Name: fmt.Sprintf("activities/%d", *inboxes[0].ActivityId)})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Activity_MEMO_REACTION, activity.Type)
+	payload := activity.Payload.GetMemoReaction()
+	require.NotNil(t, payload)
+	require.Equal(t, memo.Name, payload.Memo)
+	require.Len(t, payload.Reactors, 3)
+	require.Equal(t, []string{"👍", "🎉", "👍"}, payload.ReactionTypes)
+
+	// Below the threshold of the owner, reactions are notified one by one.
+	_, err = ts.Service.UpdateUserSetting(ownerCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/GENERAL", owner.ID),
+			Value: &v1pb.UserSetting_GeneralSetting_{
+				GeneralSetting: &v1pb.UserSetting_GeneralSetting{ReactionNotificationThreshold: 10},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"reactionNotificationThreshold"}},
+	})
+	require.NoError(t, err)
+	react("dave", "🚀")
+	react("erin", "🚀")
+	// A reaction deleted before the notification is not notified.
+	deleted := react("frank", "👀")
+	_, err = ts.Service.DeleteMemoReaction(ts.CreateUserContext(ctx, users["frank"]), &v1pb.DeleteMemoReactionRequest{Name: deleted.Name})
+	require.NoError(t, err)
+	require.NoError(t, ts.Service.ReactionNotifier.RunOnce(ctx))
+	require.Len(t, listReactionInboxes(), 3)
+}
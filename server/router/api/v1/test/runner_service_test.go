@@ -0,0 +1,125 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/scheduler"
+)
+
+func TestRunnerService(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	runs := make(chan struct{}, 10)
+	ts.Service.Scheduler = scheduler.NewScheduler(ts.Store)
+	require.NoError(t, ts.Service.Scheduler.Register(ctx, scheduler.Runner{
+		Name:            "failing",
+		Description:     "Always fails.",
+		DefaultSchedule: "0 3 * * *",
+		Run: func(context.Context) error {
+			runs <- struct{}{}
+			return errors.New("boom")
+		},
+	}))
+
+	// Only admins can manage runners.
+	_, err = ts.Service.ListRunners(userCtx, &v1pb.ListRunnersRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	response, err := ts.Service.ListRunners(hostCtx, &v1pb.ListRunnersRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Runners, 1)
+	runner := response.Runners[0]
+	require.Equal(t, "workspace/runners/failing", runner.Name)
+	require.Equal(t, "0 3 * * *", runner.Schedule)
+	require.True(t, runner.Enabled)
+	require.NotNil(t, runner.NextRunTime)
+	require.Equal(t, v1pb.Runner_RUN_STATE_UNSPECIFIED, runner.LastRunState)
+
+	// Running a runner reports the result of the last run.
+	_, err = ts.Service.RunRunner(hostCtx, &v1pb.RunRunnerRequest{Name: "workspace/runners/failing"})
+	require.NoError(t, err)
+	<-runs
+	require.Eventually(t, func() bool {
+		runnerStatus, err := ts.Service.Scheduler.Get("failing")
+		return err == nil && !runnerStatus.Running
+	}, time.Second, 10*time.Millisecond)
+	response, err = ts.Service.ListRunners(hostCtx, &v1pb.ListRunnersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Runner_FAILED, response.Runners[0].LastRunState)
+	require.Equal(t, "boom", response.Runners[0].LastError)
+
+	_, err = ts.Service.RunRunner(hostCtx, &v1pb.RunRunnerRequest{Name: "workspace/runners/unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Updating a runner validates the schedule and persists the configuration.
+	_, err = ts.Service.UpdateRunner(hostCtx, &v1pb.UpdateRunnerRequest{
+		Runner:     &v1pb.Runner{Name: "workspace/runners/failing", Schedule: "not a cron"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"schedule"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	runner, err = ts.Service.UpdateRunner(hostCtx, &v1pb.UpdateRunnerRequest{
+		Runner:     &v1pb.Runner{Name: "workspace/runners/failing", Schedule: "@every 6h", Enabled: false},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"schedule", "enabled"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "@every 6h", runner.Schedule)
+	require.False(t, runner.Enabled)
+	require.Nil(t, runner.NextRunTime)
+
+	restarted := scheduler.NewScheduler(ts.Store)
+	require.NoError(t, restarted.Register(ctx, scheduler.Runner{
+		Name:            "failing",
+		DefaultSchedule: "0 3 * * *",
+		Run:             func(context.Context) error { return nil },
+	}))
+	runnerStatus, err := restarted.Get("failing")
+	require.NoError(t, err)
+	require.Equal(t, "@every 6h", runnerStatus.Schedule)
+	require.False(t, runnerStatus.Enabled)
+}
+
+func TestSchedulerRunsOnSchedule(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	runs := make(chan struct{}, 10)
+	s := scheduler.NewScheduler(ts.Store)
+	require.NoError(t, s.Register(ctx, scheduler.Runner{
+		Name:            "frequent",
+		DefaultSchedule: "@every 1s",
+		Run: func(context.Context) error {
+			runs <- struct{}{}
+			return nil
+		},
+	}))
+	go s.Start(ctx)
+
+	select {
+	case <-runs:
+	case <-time.After(3 * time.Second):
+		t.Fatal("runner did not run on schedule")
+	}
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/profiler"
+)
+
+func TestGetRuntimeStats(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+
+	stats, err := ts.Service.GetRuntimeStats(hostCtx, &v1pb.GetRuntimeStatsRequest{})
+	require.NoError(t, err)
+	require.Positive(t, stats.GoroutineCount)
+	require.NotZero(t, stats.Memory.HeapAllocBytes)
+	require.Empty(t, stats.GoroutineStacks)
+
+	stats, err = ts.Service.GetRuntimeStats(hostCtx, &v1pb.GetRuntimeStatsRequest{IncludeGoroutineStacks: true})
+	require.NoError(t, err)
+	require.Contains(t, stats.GoroutineStacks, "TestGetRuntimeStats")
+
+	_, err = ts.Service.GetRuntimeStats(ts.CreateUserContext(ctx, user.ID), &v1pb.GetRuntimeStatsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.GetRuntimeStats(ctx, &v1pb.GetRuntimeStatsRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestProfilingEndpoints(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	e := echo.New()
+	profiler.NewProfiler().RegisterRoutes(e, ts.Service.RequireHost)
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	createAccessToken := func(userID int32) string {
+		accessToken, err := ts.Service.CreateUserAccessToken(ts.CreateUserContext(ctx, userID), &v1pb.CreateUserAccessTokenRequest{
+			Parent:      fmt.Sprintf("users/%d", userID),
+			AccessToken: &v1pb.UserAccessToken{Description: "profiling"},
+		})
+		require.NoError(t, err)
+		return accessToken.AccessToken
+	}
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+
+	get := func(accessToken string) int {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/debug/pprof/memstats", nil)
+		require.NoError(t, err)
+		if accessToken != "" {
+			req.Header.Set("Authorization", "Bearer "+accessToken)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	require.Equal(t, http.StatusUnauthorized, get(""))
+	require.Equal(t, http.StatusUnauthorized, get("invalid"))
+	require.Equal(t, http.StatusForbidden, get(createAccessToken(user.ID)))
+	require.Equal(t, http.StatusOK, get(createAccessToken(host.ID)))
+}
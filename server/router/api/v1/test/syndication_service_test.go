@@ -0,0 +1,139 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/syndication"
+)
+
+func TestSyndication(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	// The remote instance serves its public memos on two pages.
+	createTime := timestamppb.New(time.Unix(1700000000, 0))
+	var mutex sync.Mutex
+	remoteMemos := []*v1pb.Memo{
+		{Name: "memos/a", Creator: "users/1", Content: "#work hello", Tags: []string{"work"}, Visibility: v1pb.Visibility_PUBLIC, CreateTime: createTime, UpdateTime: createTime},
+		{Name: "memos/b", Creator: "users/1", Content: "#home hello", Tags: []string{"home"}, Visibility: v1pb.Visibility_PUBLIC, CreateTime: createTime, UpdateTime: createTime},
+		{Name: "memos/c", Creator: "users/2", Content: "#work/meetings notes", Tags: []string{"work/meetings"}, Visibility: v1pb.Visibility_PUBLIC, CreateTime: createTime, UpdateTime: createTime},
+		{Name: "memos/d", Creator: "users/1", Content: "#work protected", Tags: []string{"work"}, Visibility: v1pb.Visibility_PROTECTED, CreateTime: createTime, UpdateTime: createTime},
+	}
+	failing := false
+	remote := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		require.Empty(t, r.Header.Get("Authorization"))
+		var response []byte
+		switch r.URL.Path {
+		case "/api/v1/memos":
+			page := &v1pb.ListMemosResponse{Memos: remoteMemos[:2], NextPageToken: "next"}
+			if r.URL.Query().Get("pageToken") == "next" {
+				page = &v1pb.ListMemosResponse{Memos: remoteMemos[2:]}
+			}
+			response, _ = protojson.Marshal(page)
+		case "/api/v1/users/1":
+			response, _ = protojson.Marshal(&v1pb.User{Name: "users/1", Username: "alice", DisplayName: "Alice"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(response)
+	}))
+	defer remote.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Only admins can subscribe to other instances.
+	_, err = ts.Service.CreateSyndicationSubscription(userCtx, &v1pb.CreateSyndicationSubscriptionRequest{
+		SyndicationSubscription: &v1pb.SyndicationSubscription{InstanceUrl: remote.URL},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.CreateSyndicationSubscription(hostCtx, &v1pb.CreateSyndicationSubscriptionRequest{
+		SyndicationSubscription: &v1pb.SyndicationSubscription{InstanceUrl: "ftp://example.com"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Subscribing syncs the public memos with the tag or its subtags right away.
+	subscription, err := ts.Service.CreateSyndicationSubscription(hostCtx, &v1pb.CreateSyndicationSubscriptionRequest{
+		SyndicationSubscription: &v1pb.SyndicationSubscription{InstanceUrl: remote.URL + "/", Tags: []string{"#work"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, remote.URL, subscription.InstanceUrl)
+	require.Equal(t, []string{"work"}, subscription.Tags)
+	require.NotNil(t, subscription.LastSyncTime)
+	require.Empty(t, subscription.LastError)
+
+	response, err := ts.Service.ListFederatedMemos(userCtx, &v1pb.ListFederatedMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.FederatedMemos, 2)
+	origins := map[string]*v1pb.FederatedMemo{}
+	for _, federatedMemo := range response.FederatedMemos {
+		origins[federatedMemo.OriginName] = federatedMemo
+	}
+	require.Contains(t, origins, "memos/a")
+	require.Contains(t, origins, "memos/c")
+	require.Equal(t, remote.URL+"/memos/a", origins["memos/a"].OriginUrl)
+	require.Equal(t, "alice", origins["memos/a"].CreatorUsername)
+	require.Equal(t, "Alice", origins["memos/a"].CreatorDisplayName)
+	// The creators that cannot be fetched are attributed by their resource name.
+	require.Equal(t, "users/2", origins["memos/c"].Creator)
+	require.Empty(t, origins["memos/c"].CreatorUsername)
+
+	// The next syncs update the memos without duplicating them, and delete the ones no longer public.
+	mutex.Lock()
+	remoteMemos[0].Content = "#work hello again"
+	remoteMemos = remoteMemos[:2]
+	mutex.Unlock()
+	require.NoError(t, syndication.NewRunner(ts.Store).RunOnce(ctx))
+	response, err = ts.Service.ListFederatedMemos(userCtx, &v1pb.ListFederatedMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.FederatedMemos, 1)
+	require.Equal(t, "memos/a", response.FederatedMemos[0].OriginName)
+	require.Equal(t, "#work hello again", response.FederatedMemos[0].Content)
+
+	// A failing instance keeps the mirrored memos and records the error.
+	mutex.Lock()
+	failing = true
+	mutex.Unlock()
+	require.NoError(t, syndication.NewRunner(ts.Store).RunOnce(ctx))
+	subscriptions, err := ts.Service.ListSyndicationSubscriptions(hostCtx, &v1pb.ListSyndicationSubscriptionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, subscriptions.SyndicationSubscriptions, 1)
+	require.NotEmpty(t, subscriptions.SyndicationSubscriptions[0].LastError)
+	response, err = ts.Service.ListFederatedMemos(userCtx, &v1pb.ListFederatedMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.FederatedMemos, 1)
+
+	// Unsubscribing deletes the mirrored memos.
+	_, err = ts.Service.DeleteSyndicationSubscription(hostCtx, &v1pb.DeleteSyndicationSubscriptionRequest{Name: subscription.Name})
+	require.NoError(t, err)
+	response, err = ts.Service.ListFederatedMemos(userCtx, &v1pb.ListFederatedMemosRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.FederatedMemos)
+	_, err = ts.Service.DeleteSyndicationSubscription(hostCtx, &v1pb.DeleteSyndicationSubscriptionRequest{Name: subscription.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,87 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestTagMetaService(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "tagger")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	createTagMeta := func(tagMeta *v1pb.TagMeta) *v1pb.TagMeta {
+		created, err := ts.Service.CreateTagMeta(userCtx, &v1pb.CreateTagMetaRequest{Parent: parent, TagMeta: tagMeta})
+		require.NoError(t, err)
+		return created
+	}
+	work := createTagMeta(&v1pb.TagMeta{Tag: "#work", Color: "#ff8800", Emoji: "💼", PinnedOrder: 2, Description: "Work notes"})
+	require.Equal(t, "work", work.Tag)
+	require.Equal(t, "#ff8800", work.Color)
+	createTagMeta(&v1pb.TagMeta{Tag: "reading"})
+	createTagMeta(&v1pb.TagMeta{Tag: "books"})
+	createTagMeta(&v1pb.TagMeta{Tag: "home", PinnedOrder: 1})
+
+	// Each tag has at most one metadata.
+	_, err = ts.Service.CreateTagMeta(userCtx, &v1pb.CreateTagMetaRequest{Parent: parent, TagMeta: &v1pb.TagMeta{Tag: "work"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = ts.Service.CreateTagMeta(userCtx, &v1pb.CreateTagMetaRequest{Parent: parent, TagMeta: &v1pb.TagMeta{Tag: "colored", Color: "orange"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Pinned tags come first in their pinned order, then the other tags alphabetically.
+	listTags := func() []string {
+		response, err := ts.Service.ListTagMetas(userCtx, &v1pb.ListTagMetasRequest{Parent: parent})
+		require.NoError(t, err)
+		tags := []string{}
+		for _, tagMeta := range response.TagMetas {
+			tags = append(tags, tagMeta.Tag)
+		}
+		return tags
+	}
+	require.Equal(t, []string{"home", "work", "books", "reading"}, listTags())
+
+	work, err = ts.Service.UpdateTagMeta(userCtx, &v1pb.UpdateTagMetaRequest{
+		TagMeta:    &v1pb.TagMeta{Name: work.Name, PinnedOrder: 0, Color: "#00AA00"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned_order", "color"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "#00AA00", work.Color)
+	require.Equal(t, "💼", work.Emoji)
+	require.Equal(t, []string{"home", "books", "reading", "work"}, listTags())
+
+	_, err = ts.Service.UpdateTagMeta(userCtx, &v1pb.UpdateTagMetaRequest{
+		TagMeta:    &v1pb.TagMeta{Name: work.Name, Tag: "books"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tag"}},
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	got, err := ts.Service.GetTagMeta(userCtx, &v1pb.GetTagMetaRequest{Name: work.Name})
+	require.NoError(t, err)
+	require.Equal(t, "Work notes", got.Description)
+
+	// Tag metadata are private to their user.
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	_, err = ts.Service.GetTagMeta(ts.CreateUserContext(ctx, other.ID), &v1pb.GetTagMetaRequest{Name: work.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ts.Service.DeleteTagMeta(userCtx, &v1pb.DeleteTagMetaRequest{Name: work.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.GetTagMeta(userCtx, &v1pb.GetTagMetaRequest{Name: work.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, []string{"home", "books", "reading"}, listTags())
+}
@@ -0,0 +1,101 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestTaggingRules(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "tagger")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	settingName := fmt.Sprintf("users/%d/settings/TAGGING_RULES", user.ID)
+	updateRules := func(rules ...*v1pb.UserSetting_TaggingRulesSetting_Rule) (*v1pb.UserSetting, error) {
+		return ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name:  settingName,
+				Value: &v1pb.UserSetting_TaggingRulesSetting_{TaggingRulesSetting: &v1pb.UserSetting_TaggingRulesSetting{Rules: rules}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"rules"}},
+		})
+	}
+
+	t.Run("the rules are validated", func(t *testing.T) {
+		_, err := updateRules(&v1pb.UserSetting_TaggingRulesSetting_Rule{ContentPattern: "(", Tags: []string{"broken"}})
+		require.Error(t, err)
+		_, err = updateRules(&v1pb.UserSetting_TaggingRulesSetting_Rule{Title: "No actions"})
+		require.Error(t, err)
+	})
+
+	setting, err := updateRules(
+		&v1pb.UserSetting_TaggingRulesSetting_Rule{Title: "GitHub", LinkDomain: "github.com", Tags: []string{"#code"}},
+		&v1pb.UserSetting_TaggingRulesSetting_Rule{Title: "Secrets", ContentPattern: `(?i)\bsecret\b`, Tags: []string{"secret"}, Visibility: "private"},
+		&v1pb.UserSetting_TaggingRulesSetting_Rule{Title: "Work hours", Weekdays: []int32{1, 2, 3, 4, 5}, StartHour: 9, EndHour: 17, Tags: []string{"work"}},
+	)
+	require.NoError(t, err)
+	require.Len(t, setting.GetTaggingRulesSetting().Rules, 3)
+	require.Equal(t, []string{"code"}, setting.GetTaggingRulesSetting().Rules[0].Tags)
+	require.Equal(t, "PRIVATE", setting.GetTaggingRulesSetting().Rules[1].Visibility)
+
+	t.Run("the rules apply when a memo is created", func(t *testing.T) {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+			Content:    "A secret fix for https://github.com/usememos/memos #bug",
+			Visibility: v1pb.Visibility_PUBLIC,
+		}})
+		require.NoError(t, err)
+		require.Subset(t, memo.Tags, []string{"bug", "code", "secret"})
+		require.Equal(t, v1pb.Visibility_PRIVATE, memo.Visibility)
+
+		// The rule tags follow the content, the visibility is only set at creation.
+		memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Not anymore #bug", Visibility: v1pb.Visibility_PUBLIC},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "visibility"}},
+		})
+		require.NoError(t, err)
+		require.NotContains(t, memo.Tags, "code")
+		require.NotContains(t, memo.Tags, "secret")
+		require.Equal(t, v1pb.Visibility_PUBLIC, memo.Visibility)
+	})
+
+	t.Run("the rules are evaluated without saving", func(t *testing.T) {
+		// Wednesday morning in UTC, the timezone of the user.
+		wednesday := time.Date(2025, 6, 4, 10, 0, 0, 0, time.UTC)
+		response, err := ts.Service.EvaluateTaggingRules(userCtx, &v1pb.EvaluateTaggingRulesRequest{
+			Content:    "Reviewing https://gist.github.com/x",
+			CreateTime: timestamppb.New(wednesday),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"code", "work"}, response.Tags)
+		require.Equal(t, []int32{0, 2}, response.MatchedRules)
+		require.Equal(t, v1pb.Visibility_VISIBILITY_UNSPECIFIED, response.Visibility)
+
+		// Rules under test take the place of the saved ones.
+		response, err = ts.Service.EvaluateTaggingRules(userCtx, &v1pb.EvaluateTaggingRulesRequest{
+			Content:    "Late night thoughts",
+			CreateTime: timestamppb.New(wednesday.Add(13 * time.Hour)),
+			TaggingRules: &v1pb.UserSetting_TaggingRulesSetting{Rules: []*v1pb.UserSetting_TaggingRulesSetting_Rule{
+				{StartHour: 22, EndHour: 6, Tags: []string{"night"}, Visibility: "PROTECTED"},
+			}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"night"}, response.Tags)
+		require.Equal(t, v1pb.Visibility_PROTECTED, response.Visibility)
+
+		_, err = ts.Service.EvaluateTaggingRules(ctx, &v1pb.EvaluateTaggingRulesRequest{Content: "anonymous"})
+		require.Error(t, err)
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/plugin/markdown"
+	"github.com/usememos/memos/plugin/outbound"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/store"
 	teststore "github.com/usememos/memos/store/test"
@@ -35,6 +36,9 @@ func NewTestService(t *testing.T) *TestService {
 		DSN:         ":memory:",
 	}
 
+	// The fake providers and webhook receivers of the tests listen on the loopback address.
+	outbound.SetPolicy(&outbound.Policy{AllowedHosts: []string{"127.0.0.1"}})
+
 	// Create APIV1Service with nil grpcServer since we're testing direct calls
 	secret := "test-secret"
 	markdownService := markdown.NewService(
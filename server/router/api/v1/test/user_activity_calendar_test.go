@@ -0,0 +1,75 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestGetUserActivityCalendar(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "steven")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	userName := fmt.Sprintf("users/%d", user.ID)
+
+	_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name: userName + "/settings/GENERAL",
+			Value: &v1pb.UserSetting_GeneralSetting_{GeneralSetting: &v1pb.UserSetting_GeneralSetting{
+				Timezone:     "Asia/Tokyo",
+				WeekStartDay: 1,
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone", "weekStartDay"}},
+	})
+	require.NoError(t, err)
+	for _, content := range []string{"First", "Second", "Third", "Fourth"} {
+		_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PUBLIC}})
+		require.NoError(t, err)
+	}
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Private", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+
+	calendar, err := ts.Service.GetUserActivityCalendar(userCtx, &v1pb.GetUserActivityCalendarRequest{Name: userName, Weeks: 2})
+	require.NoError(t, err)
+	require.Equal(t, "Asia/Tokyo", calendar.Timezone)
+	require.Equal(t, int32(4), calendar.MaxLevel)
+	// The calendar starts on a Monday and ends today in the timezone of the user.
+	location, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	first, err := time.Parse(time.DateOnly, calendar.Days[0].Date)
+	require.NoError(t, err)
+	require.Equal(t, time.Monday, first.Weekday())
+	require.True(t, len(calendar.Days) > 7 && len(calendar.Days) <= 14)
+	today := calendar.Days[len(calendar.Days)-1]
+	require.Equal(t, time.Now().In(location).Format(time.DateOnly), today.Date)
+	require.Equal(t, int32(4), today.Level)
+
+	// The calendar is not shared by default.
+	_, err = ts.Service.GetUserActivityCalendar(ctx, &v1pb.GetUserActivityCalendarRequest{Name: userName})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.UpdateUser(userCtx, &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Name: userName, Profile: &v1pb.User_Profile{PublicActivityCalendar: true}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"profile"}},
+	})
+	require.NoError(t, err)
+	calendar, err = ts.Service.GetUserActivityCalendar(ctx, &v1pb.GetUserActivityCalendarRequest{Name: userName})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), calendar.Days[len(calendar.Days)-1].Level)
+
+	_, err = ts.Service.GetUserActivityCalendar(userCtx, &v1pb.GetUserActivityCalendarRequest{Name: userName, Weeks: 200})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
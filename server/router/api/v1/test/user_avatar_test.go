@@ -0,0 +1,105 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestUploadUserAvatar(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "steven")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	userName := fmt.Sprintf("users/%d", user.ID)
+
+	// A landscape image is cropped to its center square.
+	img := image.NewRGBA(image.Rect(0, 0, 300, 200))
+	for x := 0; x < 300; x++ {
+		for y := 0; y < 200; y++ {
+			img.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+	var content bytes.Buffer
+	require.NoError(t, png.Encode(&content, img))
+
+	_, err = ts.Service.UploadUserAvatar(userCtx, &v1pb.UploadUserAvatarRequest{Name: userName, Content: []byte("not an image")})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.UploadUserAvatar(otherCtx, &v1pb.UploadUserAvatarRequest{Name: userName, Content: content.Bytes()})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	updated, err := ts.Service.UploadUserAvatar(userCtx, &v1pb.UploadUserAvatarRequest{Name: userName, Content: content.Bytes()})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(updated.AvatarUrl, fmt.Sprintf("/api/v1/%s/avatar?v=", userName)))
+
+	// The standard sizes are served, the closest one not smaller than the requested size.
+	for size, expected := range map[int32]int{0: 256, 32: 64, 64: 64, 100: 256, 1000: 256} {
+		avatar, err := ts.Service.GetUserAvatar(ctx, &v1pb.GetUserAvatarRequest{Name: userName, Size: size})
+		require.NoError(t, err)
+		require.Equal(t, "image/png", avatar.ContentType)
+		decoded, err := png.Decode(bytes.NewReader(avatar.Data))
+		require.NoError(t, err)
+		require.Equal(t, expected, decoded.Bounds().Dx())
+		require.Equal(t, expected, decoded.Bounds().Dy())
+	}
+
+	// The avatar changes with the upload, and conditional requests with the current ETag get no content.
+	content.Reset()
+	require.NoError(t, png.Encode(&content, image.NewRGBA(image.Rect(0, 0, 64, 64))))
+	reuploaded, err := ts.Service.UploadUserAvatar(userCtx, &v1pb.UploadUserAvatarRequest{Name: userName, Content: content.Bytes()})
+	require.NoError(t, err)
+	require.NotEqual(t, updated.AvatarUrl, reuploaded.AvatarUrl)
+	version := reuploaded.AvatarUrl[strings.Index(reuploaded.AvatarUrl, "?v=")+3:]
+	avatars, err := ts.Store.ListUserAvatars(ctx, &store.FindUserAvatar{})
+	require.NoError(t, err)
+	require.Len(t, avatars, 2)
+	etag := fmt.Sprintf("%q", avatars[1].Etag)
+	require.True(t, strings.HasPrefix(avatars[1].Etag, version))
+
+	conditionalCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("grpcgateway-if-none-match", `"stale", W/`+etag))
+	avatar, err := ts.Service.GetUserAvatar(conditionalCtx, &v1pb.GetUserAvatarRequest{Name: userName})
+	require.NoError(t, err)
+	require.Empty(t, avatar.Data)
+	staleCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("grpcgateway-if-none-match", `"stale"`))
+	avatar, err = ts.Service.GetUserAvatar(staleCtx, &v1pb.GetUserAvatarRequest{Name: userName})
+	require.NoError(t, err)
+	require.NotEmpty(t, avatar.Data)
+
+	// The base64 avatars are still served.
+	_, err = ts.Service.UpdateUser(otherCtx, &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Name: fmt.Sprintf("users/%d", other.ID), AvatarUrl: "data:image/png;base64,aGVsbG8="},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"avatar_url"}},
+	})
+	require.NoError(t, err)
+	avatar, err = ts.Service.GetUserAvatar(ctx, &v1pb.GetUserAvatarRequest{Name: fmt.Sprintf("users/%d", other.ID)})
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), avatar.Data)
+
+	// The avatars are deleted with the user.
+	_, err = ts.Service.DeleteUser(ts.CreateUserContext(ctx, user.ID), &v1pb.DeleteUserRequest{Name: userName})
+	require.NoError(t, err)
+	avatars, err = ts.Store.ListUserAvatars(ctx, &store.FindUserAvatar{})
+	require.NoError(t, err)
+	require.Empty(t, avatars)
+}
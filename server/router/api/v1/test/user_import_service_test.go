@@ -0,0 +1,191 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func newRemoteMemosServer(t *testing.T) *httptest.Server {
+	remoteUser := &v1pb.User{Name: "users/42", Username: "remote"}
+	memoOne := &v1pb.Memo{
+		Name:       "memos/remote-memo-one",
+		Creator:    remoteUser.Name,
+		Content:    "hello from the old instance #imported",
+		Visibility: v1pb.Visibility_PRIVATE,
+		State:      v1pb.State_NORMAL,
+		Attachments: []*v1pb.Attachment{
+			{Name: "attachments/remote-attachment", Filename: "hello.txt", Type: "text/plain"},
+		},
+		Relations: []*v1pb.MemoRelation{
+			{
+				Memo:        &v1pb.MemoRelation_Memo{Name: "memos/remote-memo-one"},
+				RelatedMemo: &v1pb.MemoRelation_Memo{Name: "memos/remote-memo-two"},
+				Type:        v1pb.MemoRelation_REFERENCE,
+			},
+		},
+	}
+	memoTwo := &v1pb.Memo{
+		Name:       "memos/remote-memo-two",
+		Creator:    remoteUser.Name,
+		Content:    "referenced memo",
+		Visibility: v1pb.Visibility_PROTECTED,
+		State:      v1pb.State_NORMAL,
+	}
+	// Memos created by other users on the remote instance must not be imported.
+	otherMemo := &v1pb.Memo{
+		Name:       "memos/remote-memo-other",
+		Creator:    "users/7",
+		Content:    "someone else's public memo",
+		Visibility: v1pb.Visibility_PUBLIC,
+		State:      v1pb.State_NORMAL,
+	}
+
+	writeJSON := func(w http.ResponseWriter, message proto.Message) {
+		body, err := protojson.Marshal(message)
+		require.NoError(t, err)
+		_, _ = w.Write(body)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/auth/sessions/current", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer remote-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, &v1pb.GetCurrentSessionResponse{User: remoteUser})
+	})
+	mux.HandleFunc("/api/v1/memos", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("state") == v1pb.State_ARCHIVED.String() {
+			writeJSON(w, &v1pb.ListMemosResponse{})
+			return
+		}
+		writeJSON(w, &v1pb.ListMemosResponse{Memos: []*v1pb.Memo{memoOne, memoTwo, otherMemo}})
+	})
+	mux.HandleFunc("/api/v1/memos/", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, &v1pb.ListMemoCommentsResponse{})
+	})
+	mux.HandleFunc("/file/attachments/remote-attachment/hello.txt", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("hello"))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestUserImportJob(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	remote := newRemoteMemosServer(t)
+	defer remote.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "importer")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	job, err := ts.Service.CreateUserImportJob(userCtx, &v1pb.CreateUserImportJobRequest{
+		Parent:      fmt.Sprintf("users/%d", user.ID),
+		SourceUrl:   remote.URL,
+		AccessToken: "remote-token",
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.UserImportJob_RUNNING, job.State)
+
+	require.Eventually(t, func() bool {
+		job, err = ts.Service.GetUserImportJob(userCtx, &v1pb.GetUserImportJobRequest{Name: job.Name})
+		require.NoError(t, err)
+		return job.State != v1pb.UserImportJob_RUNNING
+	}, 10*time.Second, 50*time.Millisecond)
+	require.Equal(t, v1pb.UserImportJob_SUCCEEDED, job.State, job.Error)
+	require.Equal(t, int32(2), job.ImportedMemoCount)
+	require.Equal(t, int32(1), job.ImportedAttachmentCount)
+	require.Equal(t, int32(1), job.ImportedRelationCount)
+
+	memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, memos, 2)
+
+	// Other users cannot read the import job.
+	otherUser, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	_, err = ts.Service.GetUserImportJob(ts.CreateUserContext(ctx, otherUser.ID), &v1pb.GetUserImportJobRequest{Name: job.Name})
+	require.Error(t, err)
+}
+
+func TestUserImportJobDeadLetter(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	var failing atomic.Bool
+	failing.Store(true)
+	remote := newRemoteMemosServer(t)
+	defer remote.Close()
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		remote.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "importer")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// The source must be allowed by the outbound policy.
+	_, err = ts.Service.CreateUserImportJob(userCtx, &v1pb.CreateUserImportJobRequest{
+		Parent:      fmt.Sprintf("users/%d", user.ID),
+		SourceUrl:   "http://10.0.0.1",
+		AccessToken: "remote-token",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	job, err := ts.Service.CreateUserImportJob(userCtx, &v1pb.CreateUserImportJobRequest{
+		Parent:      fmt.Sprintf("users/%d", user.ID),
+		SourceUrl:   proxy.URL,
+		AccessToken: "remote-token",
+	})
+	require.NoError(t, err)
+	var deadLetters []*store.DeadLetter
+	require.Eventually(t, func() bool {
+		deadLetters, err = ts.Store.ListDeadLetters(ctx, &store.FindDeadLetter{})
+		require.NoError(t, err)
+		return len(deadLetters) == 1
+	}, 10*time.Second, 50*time.Millisecond)
+
+	// The access token of the remote instance is not stored in plaintext.
+	payload, err := proto.Marshal(deadLetters[0].Payload)
+	require.NoError(t, err)
+	require.NotContains(t, string(payload), "remote-token")
+	require.Equal(t, proxy.URL, deadLetters[0].Payload.GetUserImport().SourceUrl)
+
+	failing.Store(false)
+	_, err = ts.Service.RetryDeadLetter(hostCtx, &v1pb.RetryDeadLetterRequest{Name: fmt.Sprintf("workspace/deadLetters/%d", deadLetters[0].ID)})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		job, err = ts.Service.GetUserImportJob(userCtx, &v1pb.GetUserImportJobRequest{Name: job.Name})
+		require.NoError(t, err)
+		return job.State != v1pb.UserImportJob_RUNNING
+	}, 10*time.Second, 50*time.Millisecond)
+	require.Equal(t, v1pb.UserImportJob_SUCCEEDED, job.State, job.Error)
+	require.Equal(t, int32(2), job.ImportedMemoCount)
+}
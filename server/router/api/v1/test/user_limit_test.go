@@ -0,0 +1,84 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestNewUserLimit(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/NEW_USER_LIMIT",
+			Value: &v1pb.WorkspaceSetting_NewUserLimitSetting_{
+				NewUserLimitSetting: &v1pb.WorkspaceSetting_NewUserLimitSetting{
+					ProbationDays:       7,
+					MemosPerDay:         2,
+					AttachmentsPerDay:   1,
+					DisallowPublicMemos: true,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	user, err := ts.CreateRegularUser(ctx, "newbie")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Public memos are not allowed for new users.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "public memo", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// New users can create up to the daily memo limit.
+	for i := 0; i < 2; i++ {
+		_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: fmt.Sprintf("memo %d", i), Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+	}
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "one too many", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Attachments are limited as well.
+	createAttachment := func() error {
+		_, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "hello.txt", Type: "text/plain", Content: []byte("hello")},
+		})
+		return err
+	}
+	require.NoError(t, createAttachment())
+	require.Equal(t, codes.ResourceExhausted, status.Code(createAttachment()))
+
+	// Regular users cannot approve themselves.
+	_, err = ts.Service.ApproveUser(userCtx, &v1pb.ApproveUserRequest{Name: fmt.Sprintf("users/%d", user.ID)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Limits are lifted after admin approval.
+	_, err = ts.Service.ApproveUser(hostCtx, &v1pb.ApproveUserRequest{Name: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "public memo", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	require.NoError(t, createAttachment())
+}
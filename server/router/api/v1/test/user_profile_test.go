@@ -0,0 +1,79 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestUserProfile(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "steven")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	userName := fmt.Sprintf("users/%d", user.ID)
+
+	updateProfile := func(profile *v1pb.User_Profile) (*v1pb.User, error) {
+		return ts.Service.UpdateUser(userCtx, &v1pb.UpdateUserRequest{
+			User:       &v1pb.User{Name: userName, Profile: profile},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"profile"}},
+		})
+	}
+	updated, err := updateProfile(&v1pb.User_Profile{
+		Bio:                "Gardener and note taker.",
+		Pronouns:           "they/them",
+		PronounsVisibility: v1pb.User_Profile_PROTECTED,
+		Links:              []*v1pb.User_Profile_Link{{Title: "Website", Url: "https://example.com"}},
+		LinksVisibility:    v1pb.User_Profile_PRIVATE,
+		PublicStats:        proto.Bool(false),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "they/them", updated.Profile.Pronouns)
+	require.Len(t, updated.Profile.Links, 1)
+
+	// Each field is shown according to its visibility.
+	anonymous, err := ts.Service.GetUser(ctx, &v1pb.GetUserRequest{Name: userName})
+	require.NoError(t, err)
+	require.Equal(t, "Gardener and note taker.", anonymous.Profile.Bio)
+	require.Empty(t, anonymous.Profile.Pronouns)
+	require.Empty(t, anonymous.Profile.Links)
+	signedIn, err := ts.Service.GetUser(otherCtx, &v1pb.GetUserRequest{Name: userName})
+	require.NoError(t, err)
+	require.Equal(t, "they/them", signedIn.Profile.Pronouns)
+	require.Empty(t, signedIn.Profile.Links)
+	own, err := ts.Service.GetUser(userCtx, &v1pb.GetUserRequest{Name: userName})
+	require.NoError(t, err)
+	require.Len(t, own.Profile.Links, 1)
+
+	// The statistics are hidden from the other users.
+	_, err = ts.Service.GetUserStats(otherCtx, &v1pb.GetUserStatsRequest{Name: userName})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.GetUserStats(userCtx, &v1pb.GetUserStatsRequest{Name: userName})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Public memo", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	allStats, err := ts.Service.ListAllUserStats(otherCtx, &v1pb.ListAllUserStatsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, allStats.Stats)
+
+	// The profile is validated.
+	_, err = updateProfile(&v1pb.User_Profile{Links: []*v1pb.User_Profile_Link{{Url: "javascript:alert(1)"}}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = updateProfile(&v1pb.User_Profile{Pronouns: "they/them/theirs/themselves/themself"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
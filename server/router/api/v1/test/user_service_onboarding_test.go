@@ -0,0 +1,78 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestCreateUserWithOnboarding(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("CreateUser without onboarding setting creates no memos", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		_, err := ts.CreateHostUser(ctx, "admin")
+		require.NoError(t, err)
+
+		user, err := ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+			User: &v1pb.User{Username: "alice", Password: "password"},
+		})
+		require.NoError(t, err)
+
+		storeUser, err := ts.Store.GetUser(ctx, &store.FindUser{Username: &user.Username})
+		require.NoError(t, err)
+		memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &storeUser.ID})
+		require.NoError(t, err)
+		require.Empty(t, memos)
+	})
+
+	t.Run("CreateUser applies onboarding pack", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		hostUser, err := ts.CreateHostUser(ctx, "admin")
+		require.NoError(t, err)
+		hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+		_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/ONBOARDING",
+				Value: &v1pb.WorkspaceSetting_OnboardingSetting_{
+					OnboardingSetting: &v1pb.WorkspaceSetting_OnboardingSetting{
+						WelcomeMemoContent: "Welcome to memos!",
+						TemplateMemos:      []string{"Daily journal template"},
+						DefaultTags:        []string{"#onboarding", "getting-started"},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		setting, err := ts.Service.GetWorkspaceSetting(hostCtx, &v1pb.GetWorkspaceSettingRequest{
+			Name: "workspace/settings/ONBOARDING",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "Welcome to memos!", setting.GetOnboardingSetting().WelcomeMemoContent)
+
+		user, err := ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+			User: &v1pb.User{Username: "alice", Password: "password"},
+		})
+		require.NoError(t, err)
+
+		storeUser, err := ts.Store.GetUser(ctx, &store.FindUser{Username: &user.Username})
+		require.NoError(t, err)
+		memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &storeUser.ID})
+		require.NoError(t, err)
+		require.Len(t, memos, 2)
+		for _, memo := range memos {
+			require.Equal(t, store.Private, memo.Visibility)
+			require.ElementsMatch(t, []string{"onboarding", "getting-started"}, memo.Payload.Tags)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+func TestChangeUsername(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "steven")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	userName := fmt.Sprintf("users/%d", user.ID)
+
+	mention, err := ts.Service.CreateMemo(otherCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Ask @steven, not @stevenson or steven@example.com."},
+	})
+	require.NoError(t, err)
+
+	// The reserved and the taken usernames are refused.
+	_, err = ts.Service.ChangeUsername(userCtx, &v1pb.ChangeUsernameRequest{Name: userName, NewUsername: "Explore"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.ChangeUsername(userCtx, &v1pb.ChangeUsernameRequest{Name: userName, NewUsername: "jane"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = ts.Service.ChangeUsername(otherCtx, &v1pb.ChangeUsernameRequest{Name: userName, NewUsername: "steve"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	changed, err := ts.Service.ChangeUsername(userCtx, &v1pb.ChangeUsernameRequest{Name: userName, NewUsername: "steve"})
+	require.NoError(t, err)
+	require.Equal(t, "steve", changed.Username)
+
+	// The mentions of the previous username are updated.
+	mention, err = ts.Service.GetMemo(otherCtx, &v1pb.GetMemoRequest{Name: mention.Name})
+	require.NoError(t, err)
+	require.Equal(t, "Ask @steve, not @stevenson or steven@example.com.", mention.Content)
+
+	// The previous username resolves to the user during the grace period, and cannot be taken by others.
+	found, err := ts.Service.GetUser(ctx, &v1pb.GetUserRequest{Name: "users/steven"})
+	require.NoError(t, err)
+	require.Equal(t, "steve", found.Username)
+	_, err = ts.Service.ChangeUsername(otherCtx, &v1pb.ChangeUsernameRequest{Name: fmt.Sprintf("users/%d", other.ID), NewUsername: "steven"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{User: &v1pb.User{Username: "steven", Password: "password"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// The user can take its previous username back.
+	changed, err = ts.Service.ChangeUsername(userCtx, &v1pb.ChangeUsernameRequest{Name: userName, NewUsername: "steven"})
+	require.NoError(t, err)
+	require.Equal(t, "steven", changed.Username)
+	found, err = ts.Service.GetUser(ctx, &v1pb.GetUserRequest{Name: "users/steve"})
+	require.NoError(t, err)
+	require.Equal(t, "steven", found.Username)
+}
+
+func TestChangeUsernameInDemoMode(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Profile.Mode = "demo"
+	host, err := ts.CreateHostUser(ctx, "demo")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+
+	// The demo visitors share the demo account, they must not rename it or replace its avatar.
+	request := &v1pb.ChangeUsernameRequest{Name: fmt.Sprintf("users/%d", host.ID), NewUsername: "hijacked"}
+	handler := func(ctx context.Context, request any) (any, error) {
+		return ts.Service.ChangeUsername(ctx, request.(*v1pb.ChangeUsernameRequest))
+	}
+	interceptor := apiv1.NewDemoModeInterceptor()
+	_, err = interceptor.DemoModeInterceptor(hostCtx, request, &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.UserService/ChangeUsername"}, handler)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = interceptor.DemoModeInterceptor(hostCtx, &v1pb.UploadUserAvatarRequest{}, &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.UserService/UploadUserAvatar"}, handler)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	user, err := ts.Service.GetUser(hostCtx, &v1pb.GetUserRequest{Name: request.Name})
+	require.NoError(t, err)
+	require.Equal(t, "demo", user.Username)
+}
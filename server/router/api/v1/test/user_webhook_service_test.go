@@ -0,0 +1,160 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+type receivedWebhook struct {
+	activityType string
+	signature    string
+	body         []byte
+}
+
+func TestUserWebhookEventsAndSignature(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	received := make(chan receivedWebhook, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		payload := &webhook.WebhookRequestPayload{}
+		_ = json.Unmarshal(body, payload)
+		received <- receivedWebhook{activityType: payload.ActivityType, signature: r.Header.Get(webhook.SignatureHeader), body: body}
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+	_, err = ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &v1pb.UserWebhook{Url: server.URL, Events: []string{"memos.unknown"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	created, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &v1pb.UserWebhook{Url: server.URL, Events: []string{"memos.memo.updated"}},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.Secret)
+	require.NotNil(t, created.CreateTime)
+
+	// The secret is not listed.
+	listResponse, err := ts.Service.ListUserWebhooks(userCtx, &v1pb.ListUserWebhooksRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Len(t, listResponse.Webhooks, 1)
+	require.Empty(t, listResponse.Webhooks[0].Secret)
+	require.Equal(t, []string{"memos.memo.updated"}, listResponse.Webhooks[0].Events)
+
+	// The memo created event is not subscribed, only the update is sent.
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "hello", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "hello world"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	select {
+	case request := <-received:
+		require.Equal(t, "memos.memo.updated", request.activityType)
+		require.Equal(t, webhook.Sign(request.body, created.Secret), request.signature)
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not dispatched")
+	}
+
+	require.Eventually(t, func() bool {
+		response, err := ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: created.Name})
+		require.NoError(t, err)
+		return len(response.Deliveries) == 1
+	}, 5*time.Second, 20*time.Millisecond)
+
+	// Rotating the secret signs the following requests with the new one.
+	rotated, err := ts.Service.RotateUserWebhookSecret(userCtx, &v1pb.RotateUserWebhookSecretRequest{Name: created.Name})
+	require.NoError(t, err)
+	require.NotEmpty(t, rotated.Secret)
+	require.NotEqual(t, created.Secret, rotated.Secret)
+	require.Equal(t, []string{"memos.memo.updated"}, rotated.Events)
+
+	delivery, err := ts.Service.TestUserWebhook(userCtx, &v1pb.TestUserWebhookRequest{Name: created.Name})
+	require.NoError(t, err)
+	require.Equal(t, "memos.webhook.test", delivery.ActivityType)
+	require.Equal(t, int32(http.StatusOK), delivery.ResponseCode)
+	require.Empty(t, delivery.Error)
+	select {
+	case request := <-received:
+		require.Equal(t, "memos.webhook.test", request.activityType)
+		require.Equal(t, webhook.Sign(request.body, rotated.Secret), request.signature)
+	case <-time.After(5 * time.Second):
+		t.Fatal("test webhook was not sent")
+	}
+
+	deliveriesResponse, err := ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: created.Name})
+	require.NoError(t, err)
+	require.Len(t, deliveriesResponse.Deliveries, 2)
+	require.Equal(t, "memos.webhook.test", deliveriesResponse.Deliveries[0].ActivityType)
+	require.Equal(t, "memos.memo.updated", deliveriesResponse.Deliveries[1].ActivityType)
+
+	// Other users cannot manage the webhook.
+	_, err = ts.Service.TestUserWebhook(otherCtx, &v1pb.TestUserWebhookRequest{Name: created.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.ListUserWebhookDeliveries(otherCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: created.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUserWebhookFailedDelivery(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	created, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &v1pb.UserWebhook{Url: server.URL},
+	})
+	require.NoError(t, err)
+
+	delivery, err := ts.Service.TestUserWebhook(userCtx, &v1pb.TestUserWebhookRequest{Name: created.Name})
+	require.NoError(t, err)
+	require.Equal(t, int32(http.StatusInternalServerError), delivery.ResponseCode)
+	require.Contains(t, delivery.Error, "500")
+
+	// Deleting the webhook removes its deliveries.
+	_, err = ts.Service.DeleteUserWebhook(userCtx, &v1pb.DeleteUserWebhookRequest{Name: created.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: created.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,168 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// sendWebmention posts the webmention to the Webmention routes of the service and returns the status code.
+func sendWebmention(t *testing.T, server *httptest.Server, source, target string) int {
+	resp, err := http.PostForm(server.URL+"/webmention", url.Values{"source": {source}, "target": {target}})
+	require.NoError(t, err)
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+// waitWebmentions returns the webmentions of the memo once their count is the expected one.
+func waitWebmentions(t *testing.T, ctx context.Context, ts *TestService, memoName string, count int) []*v1pb.Webmention {
+	var webmentions []*v1pb.Webmention
+	require.Eventually(t, func() bool {
+		response, err := ts.Service.ListMemoWebmentions(ctx, &v1pb.ListMemoWebmentionsRequest{Name: memoName})
+		require.NoError(t, err)
+		webmentions = response.Webmentions
+		return len(webmentions) == count
+	}, 5*time.Second, 20*time.Millisecond)
+	return webmentions
+}
+
+func TestWebmention(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	e := echo.New()
+	ts.Service.RegisterWebmentionRoutes(e.Group(""))
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "A public memo", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	privateMemo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "A private memo", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	target := "http://localhost:8080/" + memo.Name
+
+	// The source replies to the memo until it is gone.
+	var gone atomic.Bool
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if gone.Load() {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprintf(w, `<article class="h-entry">
+			<a class="p-author h-card" href="/"><span class="p-name">Bob</span></a>
+			<a class="u-in-reply-to" href="%s">In reply to</a>
+			<p class="e-content">Nice one.</p>
+		</article>`, target)
+	}))
+	defer source.Close()
+
+	t.Run("invalid webmentions are rejected", func(t *testing.T) {
+		require.Equal(t, http.StatusBadRequest, sendWebmention(t, server, "ftp://example.com", target))
+		require.Equal(t, http.StatusBadRequest, sendWebmention(t, server, source.URL, "https://example.com/"+memo.Name))
+		require.Equal(t, http.StatusBadRequest, sendWebmention(t, server, source.URL, "http://localhost:8080/"+privateMemo.Name))
+		require.Equal(t, http.StatusBadRequest, sendWebmention(t, server, target, target))
+	})
+
+	t.Run("the reply is recorded", func(t *testing.T) {
+		require.Equal(t, http.StatusAccepted, sendWebmention(t, server, source.URL, target))
+		webmentions := waitWebmentions(t, ctx, ts, memo.Name, 1)
+		require.Equal(t, source.URL, webmentions[0].Source)
+		require.Equal(t, v1pb.Webmention_REPLY, webmentions[0].Type)
+		require.Equal(t, "Bob", webmentions[0].Author.Name)
+		require.Equal(t, source.URL+"/", webmentions[0].Author.Url)
+		require.Equal(t, "Nice one.", webmentions[0].Content)
+		require.True(t, strings.HasPrefix(webmentions[0].Name, memo.Name+"/webmentions/"))
+
+		// Resending the webmention updates it.
+		require.Equal(t, http.StatusAccepted, sendWebmention(t, server, source.URL, target))
+		waitWebmentions(t, ctx, ts, memo.Name, 1)
+	})
+
+	t.Run("the webmentions are moderated by the creator", func(t *testing.T) {
+		webmentions := waitWebmentions(t, ctx, ts, memo.Name, 1)
+		other, err := ts.CreateRegularUser(ctx, "bob")
+		require.NoError(t, err)
+		_, err = ts.Service.DeleteMemoWebmention(ts.CreateUserContext(ctx, other.ID), &v1pb.DeleteMemoWebmentionRequest{Name: webmentions[0].Name})
+		require.Error(t, err)
+		_, err = ts.Service.DeleteMemoWebmention(userCtx, &v1pb.DeleteMemoWebmentionRequest{Name: webmentions[0].Name})
+		require.NoError(t, err)
+		waitWebmentions(t, ctx, ts, memo.Name, 0)
+	})
+
+	t.Run("the webmention is deleted once the source is gone", func(t *testing.T) {
+		require.Equal(t, http.StatusAccepted, sendWebmention(t, server, source.URL, target))
+		waitWebmentions(t, ctx, ts, memo.Name, 1)
+		gone.Store(true)
+		require.Equal(t, http.StatusAccepted, sendWebmention(t, server, source.URL, target))
+		waitWebmentions(t, ctx, ts, memo.Name, 0)
+	})
+}
+
+func TestWebmentionQueue(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	e := echo.New()
+	ts.Service.RegisterWebmentionRoutes(e.Group(""))
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	memo, err := ts.Service.CreateMemo(ts.CreateUserContext(ctx, user.ID), &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "A public memo", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	target := "http://localhost:8080/" + memo.Name
+
+	// The source holds the verifications until released, the webmentions received meanwhile wait in the queue.
+	release := make(chan struct{})
+	var requested atomic.Int32
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requested.Add(1)
+		<-release
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer source.Close()
+
+	require.Equal(t, http.StatusAccepted, sendWebmention(t, server, source.URL, target))
+	require.Eventually(t, func() bool {
+		return requested.Load() == 1
+	}, 5*time.Second, 20*time.Millisecond)
+	for range 64 {
+		require.Equal(t, http.StatusAccepted, sendWebmention(t, server, source.URL, target))
+	}
+	resp, err := http.PostForm(server.URL+"/webmention", url.Values{"source": {source.URL}, "target": {target}})
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.NotEmpty(t, resp.Header.Get("Retry-After"))
+
+	// The verifications run one at a time.
+	require.Equal(t, int32(1), requested.Load())
+	close(release)
+	require.Eventually(t, func() bool {
+		return requested.Load() == 65
+	}, 10*time.Second, 20*time.Millisecond)
+}
@@ -0,0 +1,148 @@
+package test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/archivecrypt"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestBackupDatabase(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("BackupDatabase writes a backup to local storage", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		ts.Profile.Data = t.TempDir()
+
+		hostUser, err := ts.CreateHostUser(ctx, "admin")
+		require.NoError(t, err)
+		_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_STORAGE,
+			Value: &storepb.WorkspaceSetting_StorageSetting{
+				StorageSetting: &storepb.WorkspaceStorageSetting{
+					StorageType: storepb.WorkspaceStorageSetting_LOCAL,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		resp, err := ts.Service.BackupDatabase(ts.CreateUserContext(ctx, hostUser.ID), &v1pb.BackupDatabaseRequest{})
+		require.NoError(t, err)
+		require.Positive(t, resp.SizeBytes)
+		require.NotNil(t, resp.CreateTime)
+
+		backupDB, err := sql.Open("sqlite", filepath.Join(ts.Profile.Data, filepath.FromSlash(resp.Location)))
+		require.NoError(t, err)
+		defer backupDB.Close()
+		var username string
+		require.NoError(t, backupDB.QueryRowContext(ctx, "SELECT username FROM user WHERE id = ?", hostUser.ID).Scan(&username))
+		require.Equal(t, "admin", username)
+	})
+
+	t.Run("BackupDatabase encrypts the backup with a passphrase", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		ts.Profile.Data = t.TempDir()
+
+		hostUser, err := ts.CreateHostUser(ctx, "admin")
+		require.NoError(t, err)
+		hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+		_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_STORAGE,
+			Value: &storepb.WorkspaceSetting_StorageSetting{
+				StorageSetting: &storepb.WorkspaceStorageSetting{
+					StorageType: storepb.WorkspaceStorageSetting_LOCAL,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.BackupDatabase(hostCtx, &v1pb.BackupDatabaseRequest{Encryption: v1pb.ArchiveEncryption_AGE})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.BackupDatabase(hostCtx, &v1pb.BackupDatabaseRequest{Passphrase: "correct horse"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// A ZIP_AES backup is a ZIP archive holding the database.
+		resp, err := ts.Service.BackupDatabase(hostCtx, &v1pb.BackupDatabaseRequest{
+			Encryption: v1pb.ArchiveEncryption_ZIP_AES,
+			Passphrase: "correct horse",
+		})
+		require.NoError(t, err)
+		require.True(t, strings.HasSuffix(resp.Location, ".db.zip"))
+		archive, err := zip.OpenReader(filepath.Join(ts.Profile.Data, filepath.FromSlash(resp.Location)))
+		require.NoError(t, err)
+		defer archive.Close()
+		require.Len(t, archive.File, 1)
+		require.Equal(t, strings.TrimSuffix(filepath.Base(resp.Location), ".zip"), archive.File[0].Name)
+		file, err := archivecrypt.OpenZipEntry(archive.File[0], "correct horse")
+		require.NoError(t, err)
+		data, err := io.ReadAll(file)
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(data, []byte("SQLite format 3")))
+
+		// An AGE backup is the database encrypted in the age format.
+		resp, err = ts.Service.BackupDatabase(hostCtx, &v1pb.BackupDatabaseRequest{
+			Encryption: v1pb.ArchiveEncryption_AGE,
+			Passphrase: "correct horse",
+		})
+		require.NoError(t, err)
+		require.True(t, strings.HasSuffix(resp.Location, ".db.age"))
+		encrypted, err := os.ReadFile(filepath.Join(ts.Profile.Data, filepath.FromSlash(resp.Location)))
+		require.NoError(t, err)
+		reader, err := archivecrypt.NewAgeReader(bytes.NewReader(encrypted), "correct horse")
+		require.NoError(t, err)
+		data, err = io.ReadAll(reader)
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(data, []byte("SQLite format 3")))
+
+		// Only the encrypted backups are kept.
+		entries, err := os.ReadDir(filepath.Join(ts.Profile.Data, "backups"))
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+	})
+
+	t.Run("BackupDatabase requires local or s3 storage", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		hostUser, err := ts.CreateHostUser(ctx, "admin")
+		require.NoError(t, err)
+		_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_STORAGE,
+			Value: &storepb.WorkspaceSetting_StorageSetting{
+				StorageSetting: &storepb.WorkspaceStorageSetting{
+					StorageType: storepb.WorkspaceStorageSetting_DATABASE,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.BackupDatabase(ts.CreateUserContext(ctx, hostUser.ID), &v1pb.BackupDatabaseRequest{})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("BackupDatabase is restricted to admins", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		regularUser, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+
+		_, err = ts.Service.BackupDatabase(ts.CreateUserContext(ctx, regularUser.ID), &v1pb.BackupDatabaseRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -0,0 +1,72 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestGetWorkspaceCapabilities(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Without any configuration, only the limits are reported.
+	capabilities, err := ts.Service.GetWorkspaceCapabilities(ctx, &v1pb.GetWorkspaceCapabilitiesRequest{})
+	require.NoError(t, err)
+	require.False(t, capabilities.Ai.Enabled)
+	require.Empty(t, capabilities.Ai.Features)
+	require.False(t, capabilities.SemanticSearch)
+	require.False(t, capabilities.Federation.Enabled)
+	require.Equal(t, int32(store.DefaultContentLengthLimit), capabilities.Limits.MemoContentLength)
+	require.Equal(t, int64(30<<20), capabilities.Limits.UploadSizeBytes)
+
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: &storepb.WorkspaceAISetting{
+			Provider:       storepb.WorkspaceAISetting_FAKE,
+			Model:          "fake",
+			EmbeddingModel: "fake-embedding",
+			RolePermissions: map[string]*storepb.WorkspaceAISetting_RolePermission{
+				"USER": {DisableChat: true, HourlyRequestLimit: 10, DailyRequestLimit: 50},
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	// The AI features are only available to the signed-in users.
+	capabilities, err = ts.Service.GetWorkspaceCapabilities(ctx, &v1pb.GetWorkspaceCapabilitiesRequest{})
+	require.NoError(t, err)
+	require.False(t, capabilities.Ai.Enabled)
+	require.False(t, capabilities.SemanticSearch)
+
+	capabilities, err = ts.Service.GetWorkspaceCapabilities(userCtx, &v1pb.GetWorkspaceCapabilitiesRequest{})
+	require.NoError(t, err)
+	require.True(t, capabilities.Ai.Enabled)
+	require.Equal(t, []string{"summary", "speech", "voice_memo", "tag_suggestion", "transform"}, capabilities.Ai.Features)
+	require.Equal(t, int32(10), capabilities.Ai.HourlyRequestLimit)
+	require.Equal(t, int32(50), capabilities.Ai.DailyRequestLimit)
+	require.True(t, capabilities.SemanticSearch)
+	require.False(t, capabilities.Federation.Enabled)
+	require.False(t, capabilities.Federation.CanSubscribe)
+
+	_, err = ts.Store.CreateSyndicationSubscription(ctx, &store.SyndicationSubscription{
+		CreatorID:   user.ID,
+		InstanceURL: "https://memos.example.com",
+		Payload:     &storepb.SyndicationSubscriptionPayload{},
+	})
+	require.NoError(t, err)
+	capabilities, err = ts.Service.GetWorkspaceCapabilities(ctx, &v1pb.GetWorkspaceCapabilitiesRequest{})
+	require.NoError(t, err)
+	require.True(t, capabilities.Federation.Enabled)
+}
@@ -0,0 +1,67 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestAuditMemoVisibility(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	leaver, err := ts.CreateRegularUser(ctx, "leaver")
+	require.NoError(t, err)
+	leaverCtx := ts.CreateUserContext(ctx, leaver.ID)
+
+	createMemo := func(ctx context.Context, content string, visibility v1pb.Visibility) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content, Visibility: visibility}})
+		require.NoError(t, err)
+		return memo
+	}
+	contact := createMemo(userCtx, "Write to jane@example.com or call +1 555-123-4567", v1pb.Visibility_PUBLIC)
+	secret := createMemo(userCtx, "The staging password: hunter22", v1pb.Visibility_PUBLIC)
+	createMemo(userCtx, "A public memo about nothing", v1pb.Visibility_PUBLIC)
+	createMemo(userCtx, "My own address jane@example.com", v1pb.Visibility_PRIVATE)
+	farewell := createMemo(leaverCtx, "Farewell", v1pb.Visibility_PUBLIC)
+	archivedStatus := store.Archived
+	_, err = ts.Store.UpdateUser(ctx, &store.UpdateUser{ID: leaver.ID, RowStatus: &archivedStatus})
+	require.NoError(t, err)
+
+	_, err = ts.Service.AuditMemoVisibility(userCtx, &v1pb.AuditMemoVisibilityRequest{})
+	require.Error(t, err)
+
+	response, err := ts.Service.AuditMemoVisibility(hostCtx, &v1pb.AuditMemoVisibilityRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), response.AuditedCount)
+	reasons := map[string][]v1pb.AuditMemoVisibilityResponse_Reason{}
+	for _, finding := range response.Findings {
+		reasons[finding.Memo] = finding.Reasons
+	}
+	require.Equal(t, map[string][]v1pb.AuditMemoVisibilityResponse_Reason{
+		contact.Name:  {v1pb.AuditMemoVisibilityResponse_EMAIL, v1pb.AuditMemoVisibilityResponse_PHONE_NUMBER},
+		secret.Name:   {v1pb.AuditMemoVisibilityResponse_SECRET},
+		farewell.Name: {v1pb.AuditMemoVisibilityResponse_DEACTIVATED_CREATOR},
+	}, reasons)
+
+	// The findings are downgraded in bulk.
+	downgraded, err := ts.Service.DowngradePublicMemos(hostCtx, &v1pb.DowngradePublicMemosRequest{Memos: []string{contact.Name, secret.Name}})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), downgraded.DowngradedCount)
+	response, err = ts.Service.AuditMemoVisibility(hostCtx, &v1pb.AuditMemoVisibilityRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), response.AuditedCount)
+	require.Len(t, response.Findings, 1)
+}
@@ -0,0 +1,150 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+func TestWorkspaceSettingsDocument(t *testing.T) {
+	ctx := context.Background()
+
+	source := NewTestService(t)
+	defer source.Cleanup()
+	host, err := source.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := source.CreateUserContext(ctx, host.ID)
+
+	_, err = source.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/GENERAL",
+			Value: &v1pb.WorkspaceSetting_GeneralSetting_{GeneralSetting: &v1pb.WorkspaceSetting_GeneralSetting{
+				DisallowUserRegistration: true,
+				// A string that reads as a boolean in YAML.
+				AdditionalStyle: "true",
+			}},
+		},
+	})
+	require.NoError(t, err)
+	_, err = source.Service.CreateIdentityProvider(hostCtx, &v1pb.CreateIdentityProviderRequest{
+		IdentityProvider: &v1pb.IdentityProvider{
+			Title: "Company SSO",
+			Type:  v1pb.IdentityProvider_OAUTH2,
+			Config: &v1pb.IdentityProviderConfig{
+				Config: &v1pb.IdentityProviderConfig_Oauth2Config{Oauth2Config: &v1pb.OAuth2Config{
+					ClientId:     "client",
+					ClientSecret: "secret",
+					AuthUrl:      "https://sso.example.com/authorize",
+					TokenUrl:     "https://sso.example.com/token",
+					UserInfoUrl:  "https://sso.example.com/userinfo",
+					Scopes:       []string{"openid"},
+					FieldMapping: &v1pb.FieldMapping{Identifier: "sub"},
+				}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	exported, err := source.Service.ExportWorkspaceSettings(hostCtx, &v1pb.ExportWorkspaceSettingsRequest{Format: v1pb.WorkspaceSettingsDocumentFormat_YAML})
+	require.NoError(t, err)
+	require.Contains(t, exported.Document, "version: 1\n")
+	require.Contains(t, exported.Document, "title: Company SSO")
+
+	target := NewTestService(t)
+	defer target.Cleanup()
+	targetHost, err := target.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	targetCtx := target.CreateUserContext(ctx, targetHost.ID)
+
+	t.Run("the changes are validated without being applied", func(t *testing.T) {
+		response, err := target.Service.ApplyWorkspaceSettings(targetCtx, &v1pb.ApplyWorkspaceSettingsRequest{Document: exported.Document, ValidateOnly: true})
+		require.NoError(t, err)
+		require.Contains(t, response.UpdatedSettings, "workspace/settings/GENERAL")
+		require.Equal(t, []string{"Company SSO"}, response.CreatedIdentityProviders)
+
+		identityProviders, err := target.Service.ListIdentityProviders(targetCtx, &v1pb.ListIdentityProvidersRequest{})
+		require.NoError(t, err)
+		require.Empty(t, identityProviders.IdentityProviders)
+	})
+
+	t.Run("the document is applied idempotently", func(t *testing.T) {
+		response, err := target.Service.ApplyWorkspaceSettings(targetCtx, &v1pb.ApplyWorkspaceSettingsRequest{Document: exported.Document})
+		require.NoError(t, err)
+		require.Contains(t, response.UpdatedSettings, "workspace/settings/GENERAL")
+		require.Equal(t, []string{"Company SSO"}, response.CreatedIdentityProviders)
+
+		setting, err := target.Service.GetWorkspaceSetting(targetCtx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/GENERAL"})
+		require.NoError(t, err)
+		require.True(t, setting.GetGeneralSetting().DisallowUserRegistration)
+		require.Equal(t, "true", setting.GetGeneralSetting().AdditionalStyle)
+		identityProviders, err := target.Service.ListIdentityProviders(targetCtx, &v1pb.ListIdentityProvidersRequest{})
+		require.NoError(t, err)
+		require.Len(t, identityProviders.IdentityProviders, 1)
+		require.Equal(t, "secret", identityProviders.IdentityProviders[0].Config.GetOauth2Config().ClientSecret)
+
+		response, err = target.Service.ApplyWorkspaceSettings(targetCtx, &v1pb.ApplyWorkspaceSettingsRequest{Document: exported.Document})
+		require.NoError(t, err)
+		require.Empty(t, response.UpdatedSettings)
+		require.Empty(t, response.CreatedIdentityProviders)
+		require.Empty(t, response.UpdatedIdentityProviders)
+
+		// The JSON export of the target is the same document.
+		sourceJSON, err := source.Service.ExportWorkspaceSettings(hostCtx, &v1pb.ExportWorkspaceSettingsRequest{})
+		require.NoError(t, err)
+		response, err = target.Service.ApplyWorkspaceSettings(targetCtx, &v1pb.ApplyWorkspaceSettingsRequest{Document: sourceJSON.Document})
+		require.NoError(t, err)
+		require.Empty(t, response.UpdatedSettings)
+	})
+
+	t.Run("the document is validated", func(t *testing.T) {
+		_, err := target.Service.ApplyWorkspaceSettings(targetCtx, &v1pb.ApplyWorkspaceSettingsRequest{Document: "version: 2\n"})
+		require.Error(t, err)
+		_, err = target.Service.ApplyWorkspaceSettings(targetCtx, &v1pb.ApplyWorkspaceSettingsRequest{Document: "version: 1\nsettings:\n  - name: workspace/settings/BASIC\n"})
+		require.Error(t, err)
+	})
+
+	t.Run("only the host exports and applies", func(t *testing.T) {
+		user, err := target.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+		userCtx := target.CreateUserContext(ctx, user.ID)
+		_, err = target.Service.ExportWorkspaceSettings(userCtx, &v1pb.ExportWorkspaceSettingsRequest{})
+		require.Error(t, err)
+		_, err = target.Service.ApplyWorkspaceSettings(userCtx, &v1pb.ApplyWorkspaceSettingsRequest{Document: exported.Document})
+		require.Error(t, err)
+	})
+}
+
+func TestApplyWorkspaceSettingsInDemoMode(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Profile.Mode = "demo"
+	require.True(t, ts.Profile.IsDemo())
+	host, err := ts.CreateHostUser(ctx, "demo")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+
+	// The demo visitors sign in as the host, the settings document must not rewrite the workspace settings.
+	request := &v1pb.ApplyWorkspaceSettingsRequest{Document: `{"version": 1, "settings": [{"name": "workspace/settings/GENERAL", "generalSetting": {"disallowUserRegistration": true}}]}`}
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.WorkspaceService/ApplyWorkspaceSettings"}
+	handler := func(ctx context.Context, request any) (any, error) {
+		return ts.Service.ApplyWorkspaceSettings(ctx, request.(*v1pb.ApplyWorkspaceSettingsRequest))
+	}
+	response, err := ts.Service.ApplyWorkspaceSettings(hostCtx, &v1pb.ApplyWorkspaceSettingsRequest{Document: request.Document, ValidateOnly: true})
+	require.NoError(t, err)
+	require.Contains(t, response.UpdatedSettings, "workspace/settings/GENERAL")
+	_, err = apiv1.NewDemoModeInterceptor().DemoModeInterceptor(hostCtx, request, serverInfo, handler)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	setting, err := ts.Service.GetWorkspaceSetting(hostCtx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/GENERAL"})
+	require.NoError(t, err)
+	require.False(t, setting.GetGeneralSetting().DisallowUserRegistration)
+}
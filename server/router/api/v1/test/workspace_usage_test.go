@@ -0,0 +1,123 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+func TestWorkspaceUsageLimits(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+	// Negative limits are rejected.
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/USAGE_LIMIT",
+			Value: &v1pb.WorkspaceSetting_UsageLimitSetting_{
+				UsageLimitSetting: &v1pb.WorkspaceSetting_UsageLimitSetting{MaxUsers: -1},
+			},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	limits := &v1pb.WorkspaceSetting_UsageLimitSetting{
+		MaxUsers:           2,
+		MaxMemos:           2,
+		MaxStorageBytes:    10,
+		MaxAiMonthlyTokens: 1000,
+	}
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name:  "workspace/settings/USAGE_LIMIT",
+			Value: &v1pb.WorkspaceSetting_UsageLimitSetting_{UsageLimitSetting: limits},
+		},
+	})
+	require.NoError(t, err)
+
+	// Users.
+	user, err := ts.Service.CreateUser(hostCtx, &v1pb.CreateUserRequest{
+		User: &v1pb.User{Username: "member", Password: "password"},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateUser(hostCtx, &v1pb.CreateUserRequest{
+		User: &v1pb.User{Username: "another", Password: "password"},
+	})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Memos, counted across the users.
+	userID, err := apiv1.ExtractUserIDFromName(user.Name)
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, userID)
+	_, err = ts.Service.CreateMemo(hostCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "host memo", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "member memo", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(userCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "a comment", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Storage.
+	_, err = ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "a.txt", Type: "text/plain", Content: []byte("12345678")},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "b.txt", Type: "text/plain", Content: []byte("12345678")},
+	})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// AI tokens of the current month.
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_USAGE,
+		Value: &storepb.WorkspaceSetting_AiUsage{AiUsage: &storepb.WorkspaceAIUsage{
+			Month:  time.Now().UTC().Format("2006-01"),
+			Tokens: 1000,
+		}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.GenerateAISummary(userCtx, &v1pb.GenerateAISummaryRequest{TimeRange: "7d"})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	usage, err := ts.Service.GetWorkspaceUsage(userCtx, &v1pb.GetWorkspaceUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), usage.UserCount)
+	require.Equal(t, int32(2), usage.MemoCount)
+	require.Equal(t, int64(8), usage.StorageBytes)
+	require.Equal(t, int64(1000), usage.AiMonthlyTokens)
+	require.Equal(t, limits.MaxUsers, usage.Limits.MaxUsers)
+	require.Equal(t, limits.MaxStorageBytes, usage.Limits.MaxStorageBytes)
+
+	// Tokens of a past month are not counted.
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_USAGE,
+		Value: &storepb.WorkspaceSetting_AiUsage{AiUsage: &storepb.WorkspaceAIUsage{
+			Month:  "2000-01",
+			Tokens: 1000,
+		}},
+	})
+	require.NoError(t, err)
+	usage, err = ts.Service.GetWorkspaceUsage(userCtx, &v1pb.GetWorkspaceUsageRequest{})
+	require.NoError(t, err)
+	require.Zero(t, usage.AiMonthlyTokens)
+}
@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// defaultActivityCalendarWeeks is the number of weeks of an activity calendar when the request does not set it.
+	defaultActivityCalendarWeeks = 53
+	// maxActivityCalendarWeeks is the maximum number of weeks of an activity calendar.
+	maxActivityCalendarWeeks = 106
+	// maxActivityCalendarLevel is the highest intensity level of the days of an activity calendar.
+	maxActivityCalendarLevel = 4
+)
+
+// GetUserActivityCalendar returns the activity calendar of the user. The other users only see the calendars
+// the users shared, counting the memos they may see.
+func (s *APIV1Service) GetUserActivityCalendar(ctx context.Context, request *v1pb.GetUserActivityCalendarRequest) (*v1pb.UserActivityCalendar, error) {
+	userID, err := ExtractUserIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if request.Weeks < 0 || request.Weeks > maxActivityCalendarWeeks {
+		return nil, status.Errorf(codes.InvalidArgument, "weeks must be between 0 and %d", maxActivityCalendarWeeks)
+	}
+	weeks := int(request.Weeks)
+	if weeks == 0 {
+		weeks = defaultActivityCalendarWeeks
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	isOwner := currentUser != nil && (currentUser.ID == userID || isSuperUser(currentUser))
+	if !isOwner {
+		profile, err := s.Store.GetUserProfile(ctx, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user profile: %v", err)
+		}
+		if !profile.PublicActivityCalendar {
+			return nil, status.Errorf(codes.PermissionDenied, "the activity calendar of the user is not shared")
+		}
+	}
+
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &userID, Key: storepb.UserSetting_GENERAL})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	timezone := generalSetting.GetGeneral().GetTimezone()
+	location, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "" {
+		timezone, location = "UTC", time.UTC
+	}
+	weekStartDay := generalSetting.GetGeneral().GetWeekStartDay()
+
+	// The calendar starts on the first day of the week of its oldest week.
+	now := time.Now().In(location)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	weekOffset := (int(today.Weekday()) - int(weekStartDay) + 7) % 7
+	start := today.AddDate(0, 0, -weekOffset-(weeks-1)*7)
+
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting: %v", err)
+	}
+	timeField := "created_ts"
+	if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
+		timeField = "updated_ts"
+	}
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		CreatorID:       &userID,
+		ExcludeComments: true,
+		ExcludeContent:  true,
+		RowStatus:       &normalStatus,
+		Filters:         []string{fmt.Sprintf("%s >= %d", timeField, start.Unix())},
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		visibilities, err := s.getVisibleMemoVisibilities(ctx, currentUser)
+		if err != nil {
+			return nil, err
+		}
+		memoFind.VisibilityList = visibilities
+	}
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	counts := map[string]int{}
+	for _, memo := range memos {
+		displayTs := memo.CreatedTs
+		if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
+			displayTs = memo.UpdatedTs
+		}
+		counts[time.Unix(displayTs, 0).In(location).Format(time.DateOnly)]++
+	}
+
+	calendar := &v1pb.UserActivityCalendar{
+		Name:         fmt.Sprintf("%s%d", UserNamePrefix, userID),
+		Timezone:     timezone,
+		WeekStartDay: weekStartDay,
+		MaxLevel:     maxActivityCalendarLevel,
+	}
+	dates := []string{}
+	maxCount := 0
+	for day := start; !day.After(today); day = day.AddDate(0, 0, 1) {
+		date := day.Format(time.DateOnly)
+		dates = append(dates, date)
+		maxCount = max(maxCount, counts[date])
+	}
+	for _, date := range dates {
+		calendar.Days = append(calendar.Days, &v1pb.UserActivityCalendar_Day{
+			Date:  date,
+			Level: getActivityLevel(counts[date], maxCount),
+		})
+	}
+	return calendar, nil
+}
+
+// getActivityLevel returns the intensity level of a day with the count of memos, relative to the busiest day.
+func getActivityLevel(count, maxCount int) int32 {
+	if count == 0 || maxCount == 0 {
+		return 0
+	}
+	return int32((count*maxActivityCalendarLevel + maxCount - 1) / maxCount)
+}
@@ -0,0 +1,222 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/disintegration/imaging"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxAvatarUploadSize is the maximum size in bytes of an uploaded avatar image.
+	maxAvatarUploadSize = 10 << 20
+	// avatarCacheControl is the cache policy of the uploaded avatars. The serving URLs change with the content, and
+	// the stale responses are revalidated with their ETag.
+	avatarCacheControl = "public, max-age=3600"
+)
+
+// avatarSizes are the standard sizes in pixels of the uploaded avatars, the smallest first.
+var avatarSizes = []int32{64, 256}
+
+// supportedAvatarMimeTypes are the image types accepted for the uploaded avatars, as detected from their content.
+var supportedAvatarMimeTypes = []string{
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+}
+
+func (s *APIV1Service) UploadUserAvatar(ctx context.Context, request *v1pb.UploadUserAvatarRequest) (*v1pb.User, error) {
+	userID, err := ExtractUserIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// Only allow admin or self to upload the avatar.
+	if currentUser.ID != userID && currentUser.Role != store.RoleAdmin && currentUser.Role != store.RoleHost {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if len(request.Content) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "avatar content is required")
+	}
+	if len(request.Content) > maxAvatarUploadSize {
+		return nil, status.Errorf(codes.InvalidArgument, "avatar is larger than %d MiB", maxAvatarUploadSize>>20)
+	}
+	contentType := http.DetectContentType(request.Content)
+	if !slices.Contains(supportedAvatarMimeTypes, contentType) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported avatar type: %s", contentType)
+	}
+	avatars, err := processAvatarImage(request.Content, contentType)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid avatar image: %v", err)
+	}
+
+	// The previous avatars are replaced, blobs included.
+	if err := s.Store.DeleteUserAvatar(ctx, &store.DeleteUserAvatar{UserID: &userID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete previous avatars: %v", err)
+	}
+	for _, avatar := range avatars {
+		avatar.UserID = userID
+		extension := ".jpg"
+		if avatar.Type == "image/png" {
+			extension = ".png"
+		}
+		attachment := &store.Attachment{
+			Filename: fmt.Sprintf("avatar_%d_%d_%s%s", userID, avatar.Size, avatar.Etag[:8], extension),
+			Type:     avatar.Type,
+			Size:     int64(len(avatar.Blob)),
+			Blob:     avatar.Blob,
+		}
+		if err := s.saveAttachmentBlob(ctx, attachment); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to save avatar blob: %v", err)
+		}
+		avatar.StorageType = attachment.StorageType
+		avatar.Reference = attachment.Reference
+		avatar.Blob = attachment.Blob
+		avatar.Payload = attachment.Payload
+		if _, err := s.Store.UpsertUserAvatar(ctx, avatar); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to save avatar: %v", err)
+		}
+	}
+
+	// The version parameter changes the URL with the content so that the cached avatars are not shown after a change.
+	largest := avatars[len(avatars)-1]
+	avatarURL := fmt.Sprintf("/api/v1/%s%d/avatar?v=%s", UserNamePrefix, userID, largest.Etag[:12])
+	return s.UpdateUser(ctx, &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Name: request.Name, AvatarUrl: avatarURL},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"avatar_url"}},
+	})
+}
+
+// getUploadedUserAvatar serves the uploaded avatar of the user in the smallest standard size not smaller than the
+// requested one, or in the largest one when size is 0.
+func (s *APIV1Service) getUploadedUserAvatar(ctx context.Context, userID int32, size int32) (*httpbody.HttpBody, error) {
+	avatars, err := s.Store.ListUserAvatars(ctx, &store.FindUserAvatar{UserID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list avatars: %v", err)
+	}
+	if len(avatars) == 0 {
+		return nil, status.Errorf(codes.NotFound, "avatar not found")
+	}
+	avatar := avatars[len(avatars)-1]
+	if size > 0 {
+		for _, candidate := range avatars {
+			if candidate.Size >= size {
+				avatar = candidate
+				break
+			}
+		}
+	}
+
+	etag := fmt.Sprintf("%q", avatar.Etag)
+	headers := map[string]string{
+		"etag":          etag,
+		"cache-control": avatarCacheControl,
+	}
+	if etagMatches(getIfNoneMatchHeader(ctx), etag) {
+		headers[httpStatusCodeHeader] = fmt.Sprintf("%d", http.StatusNotModified)
+		if err := setResponseHeaders(ctx, headers); err != nil {
+			slog.Warn("failed to set avatar headers", slog.Any("error", err))
+		}
+		return &httpbody.HttpBody{ContentType: avatar.Type}, nil
+	}
+
+	blob, err := s.GetAttachmentBlob(&store.Attachment{
+		StorageType: avatar.StorageType,
+		Reference:   avatar.Reference,
+		Blob:        avatar.Blob,
+		Payload:     avatar.Payload,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get avatar blob: %v", err)
+	}
+	if err := setResponseHeaders(ctx, headers); err != nil {
+		slog.Warn("failed to set avatar headers", slog.Any("error", err))
+	}
+	return &httpbody.HttpBody{
+		ContentType: avatar.Type,
+		Data:        blob,
+	}, nil
+}
+
+// processAvatarImage crops the center square of the image and resizes it to each of the standard avatar sizes.
+// JPEG images stay JPEG while the other types become PNG, keeping their transparency.
+func processAvatarImage(blob []byte, contentType string) ([]*store.UserAvatar, error) {
+	img, err := imaging.Decode(bytes.NewReader(blob), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode image")
+	}
+	format, avatarType := imaging.PNG, "image/png"
+	if contentType == "image/jpeg" {
+		format, avatarType = imaging.JPEG, "image/jpeg"
+	}
+	side := min(img.Bounds().Dx(), img.Bounds().Dy())
+	if side == 0 {
+		return nil, errors.New("image is empty")
+	}
+	square := imaging.CropCenter(img, side, side)
+
+	avatars := make([]*store.UserAvatar, 0, len(avatarSizes))
+	for _, size := range avatarSizes {
+		resized := imaging.Resize(square, int(size), int(size), imaging.Lanczos)
+		var buffer bytes.Buffer
+		if err := imaging.Encode(&buffer, resized, format); err != nil {
+			return nil, errors.Wrap(err, "failed to encode image")
+		}
+		hash := sha256.Sum256(buffer.Bytes())
+		avatars = append(avatars, &store.UserAvatar{
+			Size: size,
+			Type: avatarType,
+			Etag: hex.EncodeToString(hash[:]),
+			Blob: buffer.Bytes(),
+		})
+	}
+	return avatars, nil
+}
+
+// getIfNoneMatchHeader returns the If-None-Match header of the request forwarded by the gateway.
+func getIfNoneMatchHeader(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get("grpcgateway-if-none-match"); len(values) > 0 {
+		return values[0]
+	}
+	if values := md.Get("if-none-match"); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// etagMatches reports whether the If-None-Match header matches the ETag, comparing weakly as RFC 9110 requires.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,361 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/base"
+	"github.com/usememos/memos/plugin/memosclient"
+	"github.com/usememos/memos/plugin/outbound"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// CreateUserImportJob starts an async job pulling the user's data from another memos instance.
+func (s *APIV1Service) CreateUserImportJob(ctx context.Context, request *v1pb.CreateUserImportJobRequest) (*v1pb.UserImportJob, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if request.AccessToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "access token is required")
+	}
+	client, err := memosclient.NewClient(request.SourceUrl, request.AccessToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid source url: %v", err)
+	}
+	if err := outbound.ValidateURL(ctx, request.SourceUrl); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid source url: %v", err)
+	}
+
+	return s.startUserImportJob(currentUser, request.SourceUrl, request.AccessToken, client, 1)
+}
+
+// startUserImportJob runs the import in the background. attempts is the number of the attempt,
+// greater than one when retrying a failed import from the dead letter queue.
+func (s *APIV1Service) startUserImportJob(user *store.User, sourceURL, accessToken string, client *memosclient.Client, attempts int32) (*v1pb.UserImportJob, error) {
+	job := &v1pb.UserImportJob{
+		Name:       fmt.Sprintf("%s%d/importJob", UserNamePrefix, user.ID),
+		SourceUrl:  sourceURL,
+		State:      v1pb.UserImportJob_RUNNING,
+		CreateTime: timestamppb.Now(),
+	}
+	s.userImportJobsMutex.Lock()
+	if s.userImportJobs == nil {
+		s.userImportJobs = make(map[int32]*v1pb.UserImportJob)
+	}
+	if existing, ok := s.userImportJobs[user.ID]; ok && existing.State == v1pb.UserImportJob_RUNNING {
+		s.userImportJobsMutex.Unlock()
+		return nil, status.Errorf(codes.FailedPrecondition, "an import job is already running")
+	}
+	previousJob, hasPreviousJob := s.userImportJobs[user.ID]
+	s.userImportJobs[user.ID] = job
+	snapshot := proto.Clone(job).(*v1pb.UserImportJob)
+	s.userImportJobsMutex.Unlock()
+
+	// The imports are run by the import work pool, the ones waiting for a worker are reported as running.
+	err := s.getWorkPool(workPoolImport).Go(func() {
+		// Use a detached context so that the job outlives the request.
+		jobCtx := context.WithValue(context.Background(), userIDContextKey, user.ID)
+		err := s.runUserImportJob(jobCtx, user, client)
+		s.updateUserImportJob(user.ID, func(job *v1pb.UserImportJob) {
+			job.FinishTime = timestamppb.Now()
+			if err != nil {
+				job.State = v1pb.UserImportJob_FAILED
+				job.Error = err.Error()
+			} else {
+				job.State = v1pb.UserImportJob_SUCCEEDED
+			}
+		})
+		if err != nil {
+			slog.Warn("failed to import user data", "user", user.ID, "source", sourceURL, "error", err)
+			// The access token of the remote instance is sealed so that the dead letters do not leak it.
+			encryptedAccessToken, sealErr := s.sealWithWorkspaceSecret(deadLetterSecretPurpose, []byte(accessToken))
+			if sealErr != nil {
+				// The dead letter is still recorded, the retry asking for a new import.
+				slog.Warn("failed to seal the access token of the import", "user", user.ID, "error", sealErr)
+			}
+			s.recordDeadLetter(jobCtx, &store.DeadLetter{
+				JobType: store.DeadLetterJobTypeUserImport,
+				UserID:  user.ID,
+				Payload: &storepb.DeadLetterPayload{
+					Payload: &storepb.DeadLetterPayload_UserImport_{
+						UserImport: &storepb.DeadLetterPayload_UserImport{
+							SourceUrl:            sourceURL,
+							EncryptedAccessToken: encryptedAccessToken,
+						},
+					},
+				},
+				Error:    err.Error(),
+				Attempts: attempts,
+			})
+		}
+	})
+	if err != nil {
+		s.userImportJobsMutex.Lock()
+		if hasPreviousJob {
+			s.userImportJobs[user.ID] = previousJob
+		} else {
+			delete(s.userImportJobs, user.ID)
+		}
+		s.userImportJobsMutex.Unlock()
+		return nil, workPoolBusyError(workPoolImport)
+	}
+
+	return snapshot, nil
+}
+
+// GetUserImportJob returns the latest import job of the user.
+func (s *APIV1Service) GetUserImportJob(ctx context.Context, request *v1pb.GetUserImportJobRequest) (*v1pb.UserImportJob, error) {
+	userID, err := ExtractUserIDFromName(strings.TrimSuffix(request.Name, "/importJob"))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid import job name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	s.userImportJobsMutex.Lock()
+	defer s.userImportJobsMutex.Unlock()
+	job, ok := s.userImportJobs[userID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "import job not found")
+	}
+	return proto.Clone(job).(*v1pb.UserImportJob), nil
+}
+
+func (s *APIV1Service) updateUserImportJob(userID int32, update func(job *v1pb.UserImportJob)) {
+	s.userImportJobsMutex.Lock()
+	defer s.userImportJobsMutex.Unlock()
+	if job, ok := s.userImportJobs[userID]; ok {
+		update(job)
+	}
+}
+
+// runUserImportJob pulls the memos owned by the remote user, including their comments,
+// attachments and relations, and recreates them for the local user with remapped IDs.
+func (s *APIV1Service) runUserImportJob(ctx context.Context, user *store.User, client *memosclient.Client) error {
+	remoteUser, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get remote user")
+	}
+
+	remoteMemos := []*v1pb.Memo{}
+	seen := map[string]bool{}
+	collect := func(memo *v1pb.Memo) {
+		if memo.Creator != remoteUser.Name || seen[memo.Name] {
+			return
+		}
+		seen[memo.Name] = true
+		remoteMemos = append(remoteMemos, memo)
+	}
+	for _, state := range []v1pb.State{v1pb.State_NORMAL, v1pb.State_ARCHIVED} {
+		pageToken := ""
+		for {
+			response, err := client.ListMemos(ctx, state, pageToken)
+			if err != nil {
+				return errors.Wrap(err, "failed to list remote memos")
+			}
+			for _, memo := range response.Memos {
+				collect(memo)
+				comments, err := client.ListMemoComments(ctx, memo.Name)
+				if err != nil {
+					return errors.Wrap(err, "failed to list remote memo comments")
+				}
+				for _, comment := range comments {
+					collect(comment)
+				}
+			}
+			if response.NextPageToken == "" {
+				break
+			}
+			pageToken = response.NextPageToken
+		}
+	}
+
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace memo related setting")
+	}
+
+	existingUIDs, err := s.findExistingMemoUIDs(ctx, remoteMemos)
+	if err != nil {
+		return errors.Wrap(err, "failed to find existing memos")
+	}
+	batch := &store.BatchCreateMemos{}
+	// Remote memo name -> index of the memo in the batch.
+	memoIndexMap := map[string]int{}
+	for _, remoteMemo := range remoteMemos {
+		create, err := s.convertRemoteMemoToStore(user, remoteMemo, existingUIDs, workspaceMemoRelatedSetting)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert memo %s", remoteMemo.Name)
+		}
+		memoIndexMap[remoteMemo.Name] = len(batch.Memos)
+		batch.Memos = append(batch.Memos, create)
+	}
+	for _, remoteMemo := range remoteMemos {
+		for _, relation := range remoteMemo.Relations {
+			if relation.Memo == nil || relation.RelatedMemo == nil || relation.Memo.Name != remoteMemo.Name {
+				continue
+			}
+			memoIndex, ok := memoIndexMap[relation.Memo.Name]
+			if !ok {
+				continue
+			}
+			// Skip relations pointing to memos that were not imported.
+			relatedMemoIndex, ok := memoIndexMap[relation.RelatedMemo.Name]
+			if !ok {
+				continue
+			}
+			batch.Relations = append(batch.Relations, &store.BatchMemoRelation{
+				MemoIndex:        memoIndex,
+				RelatedMemoIndex: relatedMemoIndex,
+				Type:             convertMemoRelationTypeToStore(relation.Type),
+			})
+		}
+	}
+
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceMemos, int64(len(batch.Memos))); err != nil {
+		return err
+	}
+	memos, err := s.Store.BatchCreateMemos(ctx, batch)
+	if err != nil {
+		return errors.Wrap(err, "failed to create memos")
+	}
+	s.updateUserImportJob(user.ID, func(job *v1pb.UserImportJob) {
+		job.ImportedMemoCount = int32(len(memos))
+		job.ImportedRelationCount = int32(len(batch.Relations))
+	})
+
+	for i, remoteMemo := range remoteMemos {
+		for _, remoteAttachment := range remoteMemo.Attachments {
+			if err := s.importRemoteAttachment(ctx, user, memos[i], remoteAttachment, client); err != nil {
+				return errors.Wrapf(err, "failed to import attachment %s", remoteAttachment.Name)
+			}
+			s.updateUserImportJob(user.ID, func(job *v1pb.UserImportJob) {
+				job.ImportedAttachmentCount++
+			})
+		}
+	}
+	return nil
+}
+
+// findExistingMemoUIDs returns the UIDs of the remote memos already taken by local memos.
+func (s *APIV1Service) findExistingMemoUIDs(ctx context.Context, remoteMemos []*v1pb.Memo) (map[string]bool, error) {
+	uids := []string{}
+	for _, remoteMemo := range remoteMemos {
+		if uid := strings.TrimPrefix(remoteMemo.Name, MemoNamePrefix); base.UIDMatcher.MatchString(uid) {
+			uids = append(uids, uid)
+		}
+	}
+	existingUIDs := map[string]bool{}
+	for chunk := range slices.Chunk(uids, store.BatchInsertSize) {
+		memos, err := s.Store.ListMemos(ctx, &store.FindMemo{UIDList: chunk, ExcludeContent: true})
+		if err != nil {
+			return nil, err
+		}
+		for _, memo := range memos {
+			existingUIDs[memo.UID] = true
+		}
+	}
+	return existingUIDs, nil
+}
+
+func (s *APIV1Service) convertRemoteMemoToStore(user *store.User, remoteMemo *v1pb.Memo, existingUIDs map[string]bool, workspaceMemoRelatedSetting *storepb.WorkspaceMemoRelatedSetting) (*store.Memo, error) {
+	// Keep the remote UID when possible so that links to the memo keep working.
+	uid := strings.TrimPrefix(remoteMemo.Name, MemoNamePrefix)
+	if !base.UIDMatcher.MatchString(uid) || existingUIDs[uid] {
+		uid = shortuuid.New()
+	}
+
+	visibility := convertVisibilityToStore(remoteMemo.Visibility)
+	if workspaceMemoRelatedSetting.DisallowPublicVisibility && visibility == store.Public {
+		visibility = store.Protected
+	}
+	create := &store.Memo{
+		UID:        uid,
+		CreatorID:  user.ID,
+		Content:    remoteMemo.Content,
+		Visibility: visibility,
+		// Preserve the original state and timestamps.
+		RowStatus: store.Normal,
+		Pinned:    remoteMemo.Pinned,
+	}
+	if remoteMemo.State == v1pb.State_ARCHIVED {
+		create.RowStatus = store.Archived
+	}
+	if remoteMemo.CreateTime != nil {
+		create.CreatedTs = remoteMemo.CreateTime.AsTime().Unix()
+	}
+	if remoteMemo.UpdateTime != nil {
+		create.UpdatedTs = remoteMemo.UpdateTime.AsTime().Unix()
+	}
+	if err := memopayload.RebuildMemoPayload(create, s.MarkdownService); err != nil {
+		return nil, errors.Wrap(err, "failed to rebuild memo payload")
+	}
+	if remoteMemo.Location != nil {
+		create.Payload.Location = convertLocationToStore(remoteMemo.Location)
+	}
+	return create, nil
+}
+
+func (s *APIV1Service) importRemoteAttachment(ctx context.Context, user *store.User, memo *store.Memo, remoteAttachment *v1pb.Attachment, client *memosclient.Client) error {
+	create := &store.Attachment{
+		UID:       shortuuid.New(),
+		CreatorID: user.ID,
+		Filename:  remoteAttachment.Filename,
+		Type:      remoteAttachment.Type,
+		MemoID:    &memo.ID,
+	}
+	blob, err := client.GetAttachmentBinary(ctx, remoteAttachment)
+	if err != nil {
+		// Attachments only referencing an external link may not be served by the remote instance.
+		if remoteAttachment.ExternalLink == "" {
+			return err
+		}
+		create.StorageType = storepb.AttachmentStorageType_EXTERNAL
+		create.Reference = remoteAttachment.ExternalLink
+	} else {
+		create.Blob = blob
+		create.Size = int64(len(blob))
+		if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceStorage, create.Size); err != nil {
+			return err
+		}
+		if err := s.saveAttachmentBlob(ctx, create); err != nil {
+			return errors.Wrap(err, "failed to save attachment blob")
+		}
+	}
+	if _, err := s.Store.CreateAttachment(ctx, create); err != nil {
+		return errors.Wrap(err, "failed to create attachment")
+	}
+	return nil
+}
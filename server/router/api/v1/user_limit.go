@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/store"
+)
+
+// newUserLimitAction is an action that is subject to the workspace new user limits.
+type newUserLimitAction int
+
+const (
+	newUserLimitActionCreateMemo newUserLimitAction = iota
+	newUserLimitActionCreateAttachment
+	newUserLimitActionPublicMemo
+)
+
+// checkNewUserLimit enforces the workspace new user limits for the given action.
+// Admins, approved users and accounts older than the probation period are not limited.
+func (s *APIV1Service) checkNewUserLimit(ctx context.Context, user *store.User, action newUserLimitAction) error {
+	newUserLimitSetting, err := s.Store.GetWorkspaceNewUserLimitSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace new user limit setting: %v", err)
+	}
+	if newUserLimitSetting.ProbationDays <= 0 || isSuperUser(user) {
+		return nil
+	}
+	probation := time.Duration(newUserLimitSetting.ProbationDays) * 24 * time.Hour
+	if time.Since(time.Unix(user.CreatedTs, 0)) >= probation {
+		return nil
+	}
+	approved, err := s.Store.IsUserApproved(ctx, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user approval: %v", err)
+	}
+	if approved {
+		return nil
+	}
+
+	since := time.Now().Add(-24 * time.Hour).Unix()
+	switch action {
+	case newUserLimitActionCreateMemo:
+		if newUserLimitSetting.MemosPerDay <= 0 {
+			return nil
+		}
+		memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+			CreatorID:      &user.ID,
+			ExcludeContent: true,
+			Filters:        []string{fmt.Sprintf("created_ts >= %d", since)},
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		if len(memos) >= int(newUserLimitSetting.MemosPerDay) {
+			createdTsList := make([]int64, 0, len(memos))
+			for _, memo := range memos {
+				createdTsList = append(createdTsList, memo.CreatedTs)
+			}
+			return quotaExceededError(quotaViolation{
+				subject:   fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+				id:        "new_user_memos_per_day",
+				limit:     int64(newUserLimitSetting.MemosPerDay),
+				resetTime: newUserLimitResetTime(createdTsList),
+			}, "new users can create at most %d memos per day", newUserLimitSetting.MemosPerDay)
+		}
+	case newUserLimitActionCreateAttachment:
+		if newUserLimitSetting.AttachmentsPerDay <= 0 {
+			return nil
+		}
+		attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
+			CreatorID:      &user.ID,
+			CreatedTsAfter: &since,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list attachments: %v", err)
+		}
+		if len(attachments) >= int(newUserLimitSetting.AttachmentsPerDay) {
+			createdTsList := make([]int64, 0, len(attachments))
+			for _, attachment := range attachments {
+				createdTsList = append(createdTsList, attachment.CreatedTs)
+			}
+			return quotaExceededError(quotaViolation{
+				subject:   fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+				id:        "new_user_attachments_per_day",
+				limit:     int64(newUserLimitSetting.AttachmentsPerDay),
+				resetTime: newUserLimitResetTime(createdTsList),
+			}, "new users can upload at most %d attachments per day", newUserLimitSetting.AttachmentsPerDay)
+		}
+	case newUserLimitActionPublicMemo:
+		if newUserLimitSetting.DisallowPublicMemos {
+			return status.Errorf(codes.PermissionDenied, "new users cannot create public memos")
+		}
+	}
+	return nil
+}
+
+// newUserLimitResetTime returns the time a daily new user limit frees up: a day after the oldest of the items
+// created in the last day.
+func newUserLimitResetTime(createdTsList []int64) time.Time {
+	if len(createdTsList) == 0 {
+		return time.Time{}
+	}
+	return time.Unix(slices.Min(createdTsList), 0).Add(24 * time.Hour)
+}
@@ -0,0 +1,152 @@
+package v1
+
+import (
+	"context"
+	"net/url"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxUserBioLength is the maximum number of characters of the bio of a profile.
+	maxUserBioLength = 1000
+	// maxUserPronounsLength is the maximum number of characters of the pronouns of a profile.
+	maxUserPronounsLength = 32
+	// maxUserProfileLinks is the maximum number of links of a profile.
+	maxUserProfileLinks = 10
+	// maxUserProfileLinkTitleLength is the maximum number of characters of the title of a link of a profile.
+	maxUserProfileLinkTitleLength = 64
+)
+
+// getUserProfile returns the profile of the user with the fields the viewer is allowed to see, nil if the user
+// has not set it. The viewer is nil for anonymous requests.
+func (s *APIV1Service) getUserProfile(ctx context.Context, userID int32, viewer *store.User) (*v1pb.User_Profile, error) {
+	profile, err := s.Store.GetUserProfile(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user profile: %v", err)
+	}
+	return convertUserProfileFromStore(profile, userID, viewer), nil
+}
+
+// updateUserProfile replaces the profile of the user.
+func (s *APIV1Service) updateUserProfile(ctx context.Context, userID int32, profile *v1pb.User_Profile) error {
+	if err := validateUserProfile(profile); err != nil {
+		return err
+	}
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_PROFILE,
+		Value:  &storepb.UserSetting_Profile{Profile: convertUserProfileToStore(profile)},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to update user profile: %v", err)
+	}
+	return nil
+}
+
+// isUserStatsVisible reports whether the viewer may see the memo statistics of the user of the profile.
+func isUserStatsVisible(profile *storepb.ProfileUserSetting, userID int32, viewer *store.User) bool {
+	if viewer != nil && (viewer.ID == userID || isSuperUser(viewer)) {
+		return true
+	}
+	return profile.PublicStats == nil || profile.GetPublicStats()
+}
+
+// isUserProfileFieldVisible reports whether the viewer may see a field of the profile of the user with the visibility.
+func isUserProfileFieldVisible(visibility storepb.ProfileUserSetting_Visibility, userID int32, viewer *store.User) bool {
+	if viewer != nil && (viewer.ID == userID || isSuperUser(viewer)) {
+		return true
+	}
+	switch visibility {
+	case storepb.ProfileUserSetting_PRIVATE:
+		return false
+	case storepb.ProfileUserSetting_PROTECTED:
+		return viewer != nil
+	default:
+		return true
+	}
+}
+
+func validateUserProfile(profile *v1pb.User_Profile) error {
+	if profile == nil {
+		return nil
+	}
+	if utf8.RuneCountInString(profile.Bio) > maxUserBioLength {
+		return status.Errorf(codes.InvalidArgument, "bio must be at most %d characters", maxUserBioLength)
+	}
+	if utf8.RuneCountInString(profile.Pronouns) > maxUserPronounsLength {
+		return status.Errorf(codes.InvalidArgument, "pronouns must be at most %d characters", maxUserPronounsLength)
+	}
+	if len(profile.Links) > maxUserProfileLinks {
+		return status.Errorf(codes.InvalidArgument, "a profile has at most %d links", maxUserProfileLinks)
+	}
+	for _, link := range profile.Links {
+		if utf8.RuneCountInString(link.Title) > maxUserProfileLinkTitleLength {
+			return status.Errorf(codes.InvalidArgument, "link title must be at most %d characters", maxUserProfileLinkTitleLength)
+		}
+		u, err := url.Parse(link.Url)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return status.Errorf(codes.InvalidArgument, "invalid link URL: %s", link.Url)
+		}
+	}
+	return nil
+}
+
+// convertUserProfileFromStore converts the profile of the user, leaving empty the fields the viewer may not see.
+func convertUserProfileFromStore(profile *storepb.ProfileUserSetting, userID int32, viewer *store.User) *v1pb.User_Profile {
+	if profile == nil || proto.Equal(profile, &storepb.ProfileUserSetting{}) {
+		return nil
+	}
+	profilepb := &v1pb.User_Profile{
+		BioVisibility:          convertUserProfileVisibilityFromStore(profile.BioVisibility),
+		PronounsVisibility:     convertUserProfileVisibilityFromStore(profile.PronounsVisibility),
+		LinksVisibility:        convertUserProfileVisibilityFromStore(profile.LinksVisibility),
+		PublicStats:            profile.PublicStats,
+		PublicActivityCalendar: profile.PublicActivityCalendar,
+	}
+	if isUserProfileFieldVisible(profile.BioVisibility, userID, viewer) {
+		profilepb.Bio = profile.Bio
+	}
+	if isUserProfileFieldVisible(profile.PronounsVisibility, userID, viewer) {
+		profilepb.Pronouns = profile.Pronouns
+	}
+	if isUserProfileFieldVisible(profile.LinksVisibility, userID, viewer) {
+		for _, link := range profile.Links {
+			profilepb.Links = append(profilepb.Links, &v1pb.User_Profile_Link{Title: link.Title, Url: link.Url})
+		}
+	}
+	return profilepb
+}
+
+func convertUserProfileToStore(profile *v1pb.User_Profile) *storepb.ProfileUserSetting {
+	if profile == nil {
+		return &storepb.ProfileUserSetting{}
+	}
+	profileSetting := &storepb.ProfileUserSetting{
+		Bio:                    profile.Bio,
+		BioVisibility:          convertUserProfileVisibilityToStore(profile.BioVisibility),
+		Pronouns:               profile.Pronouns,
+		PronounsVisibility:     convertUserProfileVisibilityToStore(profile.PronounsVisibility),
+		LinksVisibility:        convertUserProfileVisibilityToStore(profile.LinksVisibility),
+		PublicStats:            profile.PublicStats,
+		PublicActivityCalendar: profile.PublicActivityCalendar,
+	}
+	for _, link := range profile.Links {
+		profileSetting.Links = append(profileSetting.Links, &storepb.ProfileUserSetting_Link{Title: link.Title, Url: link.Url})
+	}
+	return profileSetting
+}
+
+func convertUserProfileVisibilityFromStore(visibility storepb.ProfileUserSetting_Visibility) v1pb.User_Profile_Visibility {
+	return v1pb.User_Profile_Visibility(v1pb.User_Profile_Visibility_value[visibility.String()])
+}
+
+func convertUserProfileVisibilityToStore(visibility v1pb.User_Profile_Visibility) storepb.ProfileUserSetting_Visibility {
+	return storepb.ProfileUserSetting_Visibility(storepb.ProfileUserSetting_Visibility_value[visibility.String()])
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"regexp"
 	"slices"
@@ -17,18 +18,20 @@ import (
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/ast"
 	"github.com/labstack/echo/v4"
+	"github.com/lithammer/shortuuid/v4"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
-	"github.com/usememos/memos/internal/base"
 	"github.com/usememos/memos/internal/util"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
 	"github.com/usememos/memos/store"
 )
 
@@ -67,8 +70,18 @@ func (s *APIV1Service) ListUsers(ctx context.Context, request *v1pb.ListUsersReq
 		Users:     []*v1pb.User{},
 		TotalSize: int32(len(users)),
 	}
+	profileSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_PROFILE})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list user profiles: %v", err)
+	}
+	profiles := make(map[int32]*storepb.ProfileUserSetting, len(profileSettings))
+	for _, profileSetting := range profileSettings {
+		profiles[profileSetting.UserId] = profileSetting.GetProfile()
+	}
 	for _, user := range users {
-		response.Users = append(response.Users, convertUserFromStore(user))
+		userpb := convertUserFromStore(user)
+		userpb.Profile = convertUserProfileFromStore(profiles[user.ID], user.ID, currentUser)
+		response.Users = append(response.Users, userpb)
 	}
 	return response, nil
 }
@@ -91,10 +104,8 @@ func (s *APIV1Service) GetUser(ctx context.Context, request *v1pb.GetUserRequest
 			ID: &userID32,
 		})
 	} else {
-		// It's a username
-		user, err = s.Store.GetUser(ctx, &store.FindUser{
-			Username: &identifier,
-		})
+		// It's a username, or a previous username of the user.
+		user, err = s.Store.GetUserByUsername(ctx, identifier)
 	}
 
 	if err != nil {
@@ -103,7 +114,15 @@ func (s *APIV1Service) GetUser(ctx context.Context, request *v1pb.GetUserRequest
 	if user == nil {
 		return nil, status.Errorf(codes.NotFound, "user not found")
 	}
-	return convertUserFromStore(user), nil
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	userpb := convertUserFromStore(user)
+	if userpb.Profile, err = s.getUserProfile(ctx, user.ID, currentUser); err != nil {
+		return nil, err
+	}
+	return userpb, nil
 }
 
 func (s *APIV1Service) GetUserAvatar(ctx context.Context, request *v1pb.GetUserAvatarRequest) (*httpbody.HttpBody, error) {
@@ -123,6 +142,10 @@ func (s *APIV1Service) GetUserAvatar(ctx context.Context, request *v1pb.GetUserA
 	if user.AvatarURL == "" {
 		return nil, status.Errorf(codes.NotFound, "avatar not found")
 	}
+	// The avatars set as base64 data URLs predate the uploads and are served as is.
+	if !strings.HasPrefix(user.AvatarURL, "data:") {
+		return s.getUploadedUserAvatar(ctx, user.ID, request.Size)
+	}
 
 	imageType, base64Data, err := extractImageInfo(user.AvatarURL)
 	if err != nil {
@@ -151,6 +174,8 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 
 	// Determine the role to assign and check permissions
 	var roleToAssign store.Role
+	// The users signing up consent to the legal pages, the ones created by an admin at their first sign-in.
+	signUp := false
 	if len(existedHostUsers) == 0 {
 		// First-time setup: create the first user as HOST (no authentication required)
 		roleToAssign = store.RoleHost
@@ -168,11 +193,19 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 		} else {
 			// Unauthenticated or non-HOST users can only create normal users
 			roleToAssign = store.RoleUser
+			signUp = true
 		}
 	}
 
-	if !base.UIDMatcher.MatchString(strings.ToLower(request.User.Username)) {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid username: %s", request.User.Username)
+	if err := s.validateUsername(ctx, request.User.Username, 0); err != nil {
+		return nil, err
+	}
+	legalConsentVersion := ""
+	if signUp {
+		legalConsentVersion, err = s.checkLegalConsent(ctx, 0, request.LegalConsentVersion)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// If validate_only is true, just validate without creating
@@ -186,6 +219,10 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 		}, nil
 	}
 
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceUsers, 1); err != nil {
+		return nil, err
+	}
+
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.User.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to generate password hash").SetInternal(err)
@@ -201,10 +238,67 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
+	if err := s.recordLegalConsent(ctx, user.ID, legalConsentVersion); err != nil {
+		return nil, err
+	}
+	if err := s.applyWorkspaceOnboarding(ctx, user); err != nil {
+		slog.Warn("failed to apply workspace onboarding", "user", user.ID, "error", err)
+	}
 
 	return convertUserFromStore(user), nil
 }
 
+// applyWorkspaceOnboarding creates the welcome memo and template memos configured
+// in the workspace onboarding setting for the newly created user.
+func (s *APIV1Service) applyWorkspaceOnboarding(ctx context.Context, user *store.User) error {
+	onboardingSetting, err := s.Store.GetWorkspaceOnboardingSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace onboarding setting")
+	}
+
+	contents := []string{}
+	if onboardingSetting.WelcomeMemoContent != "" {
+		contents = append(contents, onboardingSetting.WelcomeMemoContent)
+	}
+	for _, content := range onboardingSetting.TemplateMemos {
+		if strings.TrimSpace(content) != "" {
+			contents = append(contents, content)
+		}
+	}
+	tags := []string{}
+	for _, tag := range onboardingSetting.DefaultTags {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+		if tag != "" {
+			tags = append(tags, "#"+tag)
+		}
+	}
+
+	if len(contents) == 0 {
+		return nil
+	}
+	if err := s.checkWorkspaceUsageLimit(ctx, workspaceUsageResourceMemos, int64(len(contents))); err != nil {
+		return err
+	}
+	for _, content := range contents {
+		if len(tags) > 0 {
+			content = fmt.Sprintf("%s\n\n%s", content, strings.Join(tags, " "))
+		}
+		create := &store.Memo{
+			UID:        shortuuid.New(),
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Private,
+		}
+		if err := memopayload.RebuildMemoPayload(create, s.MarkdownService); err != nil {
+			return errors.Wrap(err, "failed to rebuild memo payload")
+		}
+		if _, err := s.Store.CreateMemo(ctx, create); err != nil {
+			return errors.Wrap(err, "failed to create onboarding memo")
+		}
+	}
+	return nil
+}
+
 func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserRequest) (*v1pb.User, error) {
 	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "update mask is empty")
@@ -235,6 +329,9 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 		}
 		return nil, status.Errorf(codes.NotFound, "user not found")
 	}
+	if err := checkEtag(request.User.Etag, convertUserFromStore(user).Etag); err != nil {
+		return nil, err
+	}
 
 	currentTs := time.Now().Unix()
 	update := &store.UpdateUser{
@@ -245,14 +342,16 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
 	}
+	// The profile is stored apart from the user, and replaced as a whole.
+	updateProfile := false
 	for _, field := range request.UpdateMask.Paths {
 		switch field {
 		case "username":
 			if workspaceGeneralSetting.DisallowChangeUsername {
 				return nil, status.Errorf(codes.PermissionDenied, "permission denied: disallow change username")
 			}
-			if !base.UIDMatcher.MatchString(strings.ToLower(request.User.Username)) {
-				return nil, status.Errorf(codes.InvalidArgument, "invalid username: %s", request.User.Username)
+			if err := s.validateUsername(ctx, request.User.Username, user.ID); err != nil {
+				return nil, err
 			}
 			update.Username = &request.User.Username
 		case "display_name":
@@ -266,6 +365,11 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 			update.AvatarURL = &request.User.AvatarUrl
 		case "description":
 			update.Description = &request.User.Description
+		case "profile":
+			if err := validateUserProfile(request.User.Profile); err != nil {
+				return nil, err
+			}
+			updateProfile = true
 		case "role":
 			// Only allow admin to update role.
 			if currentUser.Role != store.RoleAdmin && currentUser.Role != store.RoleHost {
@@ -292,8 +396,22 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
+	if updatedUser.Username != user.Username {
+		if err := s.recordUsernameChange(ctx, user.ID, user.Username, updatedUser.Username); err != nil {
+			return nil, err
+		}
+	}
+	if updateProfile {
+		if err := s.updateUserProfile(ctx, user.ID, request.User.Profile); err != nil {
+			return nil, err
+		}
+	}
 
-	return convertUserFromStore(updatedUser), nil
+	userpb := convertUserFromStore(updatedUser)
+	if userpb.Profile, err = s.getUserProfile(ctx, user.ID, currentUser); err != nil {
+		return nil, err
+	}
+	return userpb, nil
 }
 
 func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserRequest) (*emptypb.Empty, error) {
@@ -314,18 +432,72 @@ func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserR
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
 	if user == nil {
+		if request.AllowMissing {
+			return &emptypb.Empty{}, nil
+		}
 		return nil, status.Errorf(codes.NotFound, "user not found")
 	}
+	if err := checkEtag(request.Etag, convertUserFromStore(user).Etag); err != nil {
+		return nil, err
+	}
 
 	if err := s.Store.DeleteUser(ctx, &store.DeleteUser{
 		ID: user.ID,
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
 	}
+	// The previous usernames of the user are free to take.
+	if err := s.Store.DeleteUsernameAlias(ctx, &store.DeleteUsernameAlias{UserID: &user.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete username aliases: %v", err)
+	}
+	if err := s.Store.DeleteUserAvatar(ctx, &store.DeleteUserAvatar{UserID: &user.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete user avatars: %v", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// ApproveUser lifts the new user limits of a user.
+func (s *APIV1Service) ApproveUser(ctx context.Context, request *v1pb.ApproveUserRequest) (*v1pb.User, error) {
+	userID, err := ExtractUserIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(currentUser) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_APPROVAL,
+		Value: &storepb.UserSetting_Approval{
+			Approval: &storepb.ApprovalUserSetting{
+				Approved:    true,
+				ApproveTime: timestamppb.Now(),
+			},
+		},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to approve user: %v", err)
+	}
+
+	return convertUserFromStore(user), nil
+}
+
 func getDefaultUserGeneralSetting() *v1pb.UserSetting_GeneralSetting {
 	return &v1pb.UserSetting_GeneralSetting{
 		Locale:         "en",
@@ -359,6 +531,16 @@ func (s *APIV1Service) GetUserSetting(ctx context.Context, request *v1pb.GetUser
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid setting key: %v", err)
 	}
+	// The AI settings are hidden when the AI features of the workspace are disabled.
+	if storeKey == storepb.UserSetting_AI_AUTO_SUMMARY {
+		aiDisabled, err := s.isAIDisabled(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if aiDisabled {
+			return nil, aiDisabledError()
+		}
+	}
 
 	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
 		UserID: &userID,
@@ -401,7 +583,31 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 		return nil, status.Errorf(codes.InvalidArgument, "invalid setting key: %v", err)
 	}
 
-	// Only GENERAL settings are supported via UpdateUserSetting
+	if storeKey == storepb.UserSetting_AI_AUTO_SUMMARY {
+		return s.updateAIAutoSummarySetting(ctx, userID, request)
+	}
+	if storeKey == storepb.UserSetting_NOSTR {
+		return s.updateNostrSetting(ctx, userID, request)
+	}
+	if storeKey == storepb.UserSetting_BLUESKY {
+		return s.updateBlueskySetting(ctx, userID, request)
+	}
+	if storeKey == storepb.UserSetting_MASTODON {
+		return s.updateMastodonSetting(ctx, userID, request)
+	}
+	if storeKey == storepb.UserSetting_MICROPUB {
+		return s.updateMicropubSetting(ctx, userID, request)
+	}
+	if storeKey == storepb.UserSetting_INDIEAUTH {
+		return s.updateIndieAuthSetting(ctx, userID, request)
+	}
+	if storeKey == storepb.UserSetting_TAGGING_RULES {
+		return s.updateTaggingRulesSetting(ctx, userID, request)
+	}
+	if storeKey == storepb.UserSetting_FORMATTING {
+		return s.updateFormattingSetting(ctx, userID, request)
+	}
+	// Only GENERAL, AI_AUTO_SUMMARY and the publishing and cross-posting settings are supported via UpdateUserSetting
 	// Other setting types have dedicated service methods
 	if storeKey != storepb.UserSetting_GENERAL {
 		return nil, status.Errorf(codes.InvalidArgument, "setting type %s should not be updated via UpdateUserSetting", storeKey.String())
@@ -419,13 +625,19 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 	}
 
 	updatedGeneral := &v1pb.UserSetting_GeneralSetting{
-		MemoVisibility: generalSetting.GetMemoVisibility(),
-		Locale:         generalSetting.GetLocale(),
-		Theme:          generalSetting.GetTheme(),
+		MemoVisibility:                generalSetting.GetMemoVisibility(),
+		Locale:                        generalSetting.GetLocale(),
+		Theme:                         generalSetting.GetTheme(),
+		ArchiveLinks:                  generalSetting.GetArchiveLinks(),
+		ReactionNotificationThreshold: generalSetting.GetReactionNotificationThreshold(),
+		Timezone:                      generalSetting.GetTimezone(),
+		WeekStartDay:                  generalSetting.GetWeekStartDay(),
+		AiOptIn:                       generalSetting.GetAiOptIn(),
 	}
 
 	// Apply updates for fields specified in the update mask
 	incomingGeneral := request.Setting.GetGeneralSetting()
+	optedOut := false
 	for _, field := range request.UpdateMask.Paths {
 		switch field {
 		case "memoVisibility":
@@ -434,6 +646,26 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 			updatedGeneral.Theme = incomingGeneral.Theme
 		case "locale":
 			updatedGeneral.Locale = incomingGeneral.Locale
+		case "archiveLinks":
+			updatedGeneral.ArchiveLinks = incomingGeneral.ArchiveLinks
+		case "reactionNotificationThreshold":
+			if incomingGeneral.ReactionNotificationThreshold < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "reaction notification threshold must not be negative")
+			}
+			updatedGeneral.ReactionNotificationThreshold = incomingGeneral.ReactionNotificationThreshold
+		case "timezone":
+			if _, err := time.LoadLocation(incomingGeneral.Timezone); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid timezone %q", incomingGeneral.Timezone)
+			}
+			updatedGeneral.Timezone = incomingGeneral.Timezone
+		case "weekStartDay":
+			if incomingGeneral.WeekStartDay < 0 || incomingGeneral.WeekStartDay > 6 {
+				return nil, status.Errorf(codes.InvalidArgument, "week start day must be between 0 and 6")
+			}
+			updatedGeneral.WeekStartDay = incomingGeneral.WeekStartDay
+		case "aiOptIn":
+			optedOut = updatedGeneral.AiOptIn && !incomingGeneral.AiOptIn
+			updatedGeneral.AiOptIn = incomingGeneral.AiOptIn
 		default:
 			// Ignore unsupported fields
 		}
@@ -457,6 +689,12 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 	if _, err := s.Store.UpsertUserSetting(ctx, storeSetting); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
 	}
+	// The content of the users who opted out of the AI processing must not be kept in the embeddings either.
+	if optedOut {
+		if err := s.Store.DeleteMemoEmbedding(ctx, &store.DeleteMemoEmbedding{CreatorID: &userID}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete memo embeddings: %v", err)
+		}
+	}
 
 	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
 }
@@ -486,9 +724,41 @@ func (s *APIV1Service) ListUserSettings(ctx context.Context, request *v1pb.ListU
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list user settings: %v", err)
 	}
+	aiDisabled, err := s.isAIDisabled(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	settings := make([]*v1pb.UserSetting, 0, len(userSettings))
 	for _, storeSetting := range userSettings {
+		// Approval and feature flag overrides are managed by admins and not exposed as user settings.
+		if storeSetting.Key == storepb.UserSetting_APPROVAL || storeSetting.Key == storepb.UserSetting_FEATURE_FLAGS {
+			continue
+		}
+		// The legal consent is given at sign-in and sign-up.
+		if storeSetting.Key == storepb.UserSetting_LEGAL_CONSENT {
+			continue
+		}
+		// The announcements are dismissed through the workspace service.
+		if storeSetting.Key == storepb.UserSetting_DISMISSED_ANNOUNCEMENTS {
+			continue
+		}
+		// Tag metadata are managed through the tag meta service.
+		if storeSetting.Key == storepb.UserSetting_TAG_METAS {
+			continue
+		}
+		// AI conversations are managed through the AI service.
+		if storeSetting.Key == storepb.UserSetting_AI_CONVERSATIONS {
+			continue
+		}
+		// The AI settings are hidden when the AI features of the workspace are disabled.
+		if storeSetting.Key == storepb.UserSetting_AI_AUTO_SUMMARY && aiDisabled {
+			continue
+		}
+		// The profile is part of the user resource.
+		if storeSetting.Key == storepb.UserSetting_PROFILE {
+			continue
+		}
 		apiSetting := convertUserSettingFromStore(storeSetting, userID, storeSetting.Key)
 		if apiSetting != nil {
 			settings = append(settings, apiSetting)
@@ -535,7 +805,7 @@ func (s *APIV1Service) ListUserSettings(ctx context.Context, request *v1pb.ListU
 // - Invalid or expired tokens are filtered out
 //
 // Authentication: Required (session cookie or access token)
-// Authorization: User can only list their own tokens.
+// Authorization: User can only list their own tokens, the host the tokens of any user.
 func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.ListUserAccessTokensRequest) (*v1pb.ListUserAccessTokensResponse, error) {
 	userID, err := ExtractUserIDFromName(request.Parent)
 	if err != nil {
@@ -549,7 +819,7 @@ func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.L
 	if currentUser == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-	if currentUser.ID != userID {
+	if currentUser.ID != userID && currentUser.Role != store.RoleHost {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -561,17 +831,7 @@ func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.L
 	accessTokens := []*v1pb.UserAccessToken{}
 	for _, userAccessToken := range userAccessTokens {
 		claims := &ClaimsMessage{}
-		_, err := jwt.ParseWithClaims(userAccessToken.AccessToken, claims, func(t *jwt.Token) (any, error) {
-			if t.Method.Alg() != jwt.SigningMethodHS256.Name {
-				return nil, errors.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
-			}
-			if kid, ok := t.Header["kid"].(string); ok {
-				if kid == "v1" {
-					return []byte(s.Secret), nil
-				}
-			}
-			return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
-		})
+		_, err := jwt.ParseWithClaims(userAccessToken.AccessToken, claims, newAccessTokenKeyfunc(ctx, s.Store, s.Secret))
 		if err != nil {
 			// If the access token is invalid or expired, just ignore it.
 			continue
@@ -607,7 +867,7 @@ func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.L
 // - User creates token for third-party integration
 //
 // Token properties:
-// - JWT format signed with server secret
+// - JWT format signed with the current signing key
 // - Contains user ID and username in claims
 // - Optional expiration time (can be never-expiring)
 // - User-provided description for identification
@@ -618,7 +878,7 @@ func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.L
 // - Token can be revoked by deleting it from settings
 //
 // Authentication: Required (session cookie or access token)
-// Authorization: User can only create tokens for themselves.
+// Authorization: User can only create tokens for themselves, the host for any user.
 func (s *APIV1Service) CreateUserAccessToken(ctx context.Context, request *v1pb.CreateUserAccessTokenRequest) (*v1pb.UserAccessToken, error) {
 	userID, err := ExtractUserIDFromName(request.Parent)
 	if err != nil {
@@ -631,38 +891,42 @@ func (s *APIV1Service) CreateUserAccessToken(ctx context.Context, request *v1pb.
 	if currentUser == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-	if currentUser.ID != userID {
+	if currentUser.ID != userID && currentUser.Role != store.RoleHost {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
+	// The host may create the tokens of other users, e.g. for the tooling provisioning the instance.
+	user := currentUser
+	if userID != currentUser.ID {
+		if user, err = s.Store.GetUser(ctx, &store.FindUser{ID: &userID}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+	}
 
 	expiresAt := time.Time{}
 	if request.AccessToken.ExpiresAt != nil {
 		expiresAt = request.AccessToken.ExpiresAt.AsTime()
 	}
 
-	accessToken, err := GenerateAccessToken(currentUser.Username, currentUser.ID, expiresAt, []byte(s.Secret))
+	keyID, secret, err := getAccessTokenSigningKey(ctx, s.Store, s.Secret)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get access token signing key: %v", err)
+	}
+	accessToken, err := GenerateAccessToken(user.Username, user.ID, expiresAt, keyID, secret)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate access token: %v", err)
 	}
 
 	claims := &ClaimsMessage{}
-	_, err = jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
-		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
-			return nil, errors.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
-		}
-		if kid, ok := t.Header["kid"].(string); ok {
-			if kid == "v1" {
-				return []byte(s.Secret), nil
-			}
-		}
-		return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
-	})
+	_, err = jwt.ParseWithClaims(accessToken, claims, newAccessTokenKeyfunc(ctx, s.Store, s.Secret))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to parse access token: %v", err)
 	}
 
 	// Upsert the access token to user setting store.
-	if err := s.UpsertAccessTokenToStore(ctx, currentUser, accessToken, request.AccessToken.Description); err != nil {
+	if err := s.UpsertAccessTokenToStore(ctx, user, accessToken, request.AccessToken.Description); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert access token to store: %v", err)
 	}
 
@@ -690,7 +954,7 @@ func (s *APIV1Service) CreateUserAccessToken(ctx context.Context, request *v1pb.
 // - User cleans up old tokens
 //
 // Authentication: Required (session cookie or access token)
-// Authorization: User can only delete their own tokens.
+// Authorization: User can only delete their own tokens, the host the tokens of any user.
 func (s *APIV1Service) DeleteUserAccessToken(ctx context.Context, request *v1pb.DeleteUserAccessTokenRequest) (*emptypb.Empty, error) {
 	// Extract user ID from the access token resource name
 	// Format: users/{user}/accessTokens/{access_token}
@@ -712,11 +976,11 @@ func (s *APIV1Service) DeleteUserAccessToken(ctx context.Context, request *v1pb.
 	if currentUser == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-	if currentUser.ID != userID {
+	if currentUser.ID != userID && currentUser.Role != store.RoleHost {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
-	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, currentUser.ID)
+	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, userID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list access tokens: %v", err)
 	}
@@ -727,8 +991,14 @@ func (s *APIV1Service) DeleteUserAccessToken(ctx context.Context, request *v1pb.
 		}
 		updatedUserAccessTokens = append(updatedUserAccessTokens, userAccessToken)
 	}
+	if len(updatedUserAccessTokens) == len(userAccessTokens) {
+		if request.AllowMissing {
+			return &emptypb.Empty{}, nil
+		}
+		return nil, status.Errorf(codes.NotFound, "access token not found")
+	}
 	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
-		UserId: currentUser.ID,
+		UserId: userID,
 		Key:    storepb.UserSetting_ACCESS_TOKENS,
 		Value: &storepb.UserSetting_AccessTokens{
 			AccessTokens: &storepb.AccessTokensUserSetting{
@@ -930,6 +1200,35 @@ func (s *APIV1Service) ListUserWebhooks(ctx context.Context, request *v1pb.ListU
 	}, nil
 }
 
+func (s *APIV1Service) GetUserWebhook(ctx context.Context, request *v1pb.GetUserWebhookRequest) (*v1pb.UserWebhook, error) {
+	webhookID, userID, err := parseUserWebhookName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID && currentUser.Role != store.RoleHost && currentUser.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user webhooks: %v", err)
+	}
+	for _, webhook := range webhooks {
+		if webhook.Id == webhookID {
+			return convertUserWebhookFromUserSetting(webhook, userID), nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "webhook not found")
+}
+
 func (s *APIV1Service) CreateUserWebhook(ctx context.Context, request *v1pb.CreateUserWebhookRequest) (*v1pb.UserWebhook, error) {
 	userID, err := ExtractUserIDFromName(request.Parent)
 	if err != nil {
@@ -950,12 +1249,20 @@ func (s *APIV1Service) CreateUserWebhook(ctx context.Context, request *v1pb.Crea
 	if request.Webhook.Url == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "webhook URL is required")
 	}
+	if err := validateUserWebhookEvents(request.Webhook.Events); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 
+	now := time.Now().Unix()
 	webhookID := generateUserWebhookID()
 	webhook := &storepb.WebhooksUserSetting_Webhook{
-		Id:    webhookID,
-		Title: request.Webhook.DisplayName,
-		Url:   strings.TrimSpace(request.Webhook.Url),
+		Id:        webhookID,
+		Title:     request.Webhook.DisplayName,
+		Url:       strings.TrimSpace(request.Webhook.Url),
+		Events:    request.Webhook.Events,
+		Secret:    generateUserWebhookSecret(),
+		CreatedTs: now,
+		UpdatedTs: now,
 	}
 
 	err = s.Store.AddUserWebhook(ctx, userID, webhook)
@@ -963,7 +1270,10 @@ func (s *APIV1Service) CreateUserWebhook(ctx context.Context, request *v1pb.Crea
 		return nil, status.Errorf(codes.Internal, "failed to create webhook: %v", err)
 	}
 
-	return convertUserWebhookFromUserSetting(webhook, userID), nil
+	userWebhook := convertUserWebhookFromUserSetting(webhook, userID)
+	// The secret is only returned on creation and rotation.
+	userWebhook.Secret = webhook.Secret
+	return userWebhook, nil
 }
 
 func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.UpdateUserWebhookRequest) (*v1pb.UserWebhook, error) {
@@ -1005,13 +1315,13 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 	if targetWebhook == nil {
 		return nil, status.Errorf(codes.NotFound, "webhook not found")
 	}
+	if err := checkEtag(request.Webhook.Etag, convertUserWebhookFromUserSetting(targetWebhook, userID).Etag); err != nil {
+		return nil, err
+	}
 
 	// Update the webhook
-	updatedWebhook := &storepb.WebhooksUserSetting_Webhook{
-		Id:    webhookID,
-		Title: targetWebhook.Title,
-		Url:   targetWebhook.Url,
-	}
+	updatedWebhook := proto.Clone(targetWebhook).(*storepb.WebhooksUserSetting_Webhook)
+	updatedWebhook.UpdatedTs = time.Now().Unix()
 
 	if request.UpdateMask != nil {
 		for _, path := range request.UpdateMask.Paths {
@@ -1022,16 +1332,25 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 				}
 			case "display_name":
 				updatedWebhook.Title = request.Webhook.DisplayName
+			case "events":
+				if err := validateUserWebhookEvents(request.Webhook.Events); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+				}
+				updatedWebhook.Events = request.Webhook.Events
 			default:
 				// Ignore unsupported fields
 			}
 		}
 	} else {
 		// If no update mask is provided, update all fields
+		if err := validateUserWebhookEvents(request.Webhook.Events); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
 		if request.Webhook.Url != "" {
 			updatedWebhook.Url = strings.TrimSpace(request.Webhook.Url)
 		}
 		updatedWebhook.Title = request.Webhook.DisplayName
+		updatedWebhook.Events = request.Webhook.Events
 	}
 
 	err = s.Store.UpdateUserWebhook(ctx, userID, updatedWebhook)
@@ -1066,22 +1385,34 @@ func (s *APIV1Service) DeleteUserWebhook(ctx context.Context, request *v1pb.Dele
 	}
 
 	// Check if webhook exists
-	found := false
+	var targetWebhook *storepb.WebhooksUserSetting_Webhook
 	for _, webhook := range webhooks {
 		if webhook.Id == webhookID {
-			found = true
+			targetWebhook = webhook
 			break
 		}
 	}
 
-	if !found {
+	if targetWebhook == nil {
+		if request.AllowMissing {
+			return &emptypb.Empty{}, nil
+		}
 		return nil, status.Errorf(codes.NotFound, "webhook not found")
 	}
+	if err := checkEtag(request.Etag, convertUserWebhookFromUserSetting(targetWebhook, userID).Etag); err != nil {
+		return nil, err
+	}
 
 	err = s.Store.RemoveUserWebhook(ctx, userID, webhookID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %v", err)
 	}
+	if err := s.Store.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{
+		UserID:    userID,
+		WebhookID: webhookID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook deliveries: %v", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -1095,6 +1426,13 @@ func generateUserWebhookID() string {
 	return hex.EncodeToString(b)
 }
 
+// generateUserWebhookSecret generates a secret used to sign the requests of user webhooks.
+func generateUserWebhookSecret() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
 // parseUserWebhookName parses a webhook name and returns the webhook ID and user ID.
 // Format: users/{user}/webhooks/{webhook}.
 func parseUserWebhookName(name string) (string, int32, error) {
@@ -1112,14 +1450,23 @@ func parseUserWebhookName(name string) (string, int32, error) {
 }
 
 // convertUserWebhookFromUserSetting converts a storepb webhook to a v1pb UserWebhook.
+// The secret is left out, it is only returned when the webhook is created or its secret is rotated.
 func convertUserWebhookFromUserSetting(webhook *storepb.WebhooksUserSetting_Webhook, userID int32) *v1pb.UserWebhook {
-	return &v1pb.UserWebhook{
+	userWebhook := &v1pb.UserWebhook{
 		Name:        fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
 		Url:         webhook.Url,
 		DisplayName: webhook.Title,
-		// Note: create_time and update_time are not available in the user setting webhook structure
-		// This is a limitation of storing webhooks in user settings vs the dedicated webhook table
+		Events:      webhook.Events,
+	}
+	// Webhooks created before the timestamps were recorded have none.
+	if webhook.CreatedTs != 0 {
+		userWebhook.CreateTime = timestamppb.New(time.Unix(webhook.CreatedTs, 0))
 	}
+	if webhook.UpdatedTs != 0 {
+		userWebhook.UpdateTime = timestamppb.New(time.Unix(webhook.UpdatedTs, 0))
+	}
+	userWebhook.Etag = computeEtag(userWebhook)
+	return userWebhook
 }
 
 func convertUserFromStore(user *store.User) *v1pb.User {
@@ -1145,6 +1492,8 @@ func convertUserFromStore(user *store.User) *v1pb.User {
 			userpb.AvatarUrl = user.AvatarURL
 		}
 	}
+	// The profile is set apart, the etag only covers the stored user.
+	userpb.Etag = computeEtag(userpb)
 	return userpb
 }
 
@@ -1214,6 +1563,22 @@ func convertSettingKeyToStore(key string) (storepb.UserSetting_Key, error) {
 		return storepb.UserSetting_ACCESS_TOKENS, nil
 	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]:
 		return storepb.UserSetting_WEBHOOKS, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_AI_AUTO_SUMMARY)]:
+		return storepb.UserSetting_AI_AUTO_SUMMARY, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_NOSTR)]:
+		return storepb.UserSetting_NOSTR, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_BLUESKY)]:
+		return storepb.UserSetting_BLUESKY, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_MASTODON)]:
+		return storepb.UserSetting_MASTODON, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_MICROPUB)]:
+		return storepb.UserSetting_MICROPUB, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_INDIEAUTH)]:
+		return storepb.UserSetting_INDIEAUTH, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_TAGGING_RULES)]:
+		return storepb.UserSetting_TAGGING_RULES, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_FORMATTING)]:
+		return storepb.UserSetting_FORMATTING, nil
 	default:
 		return storepb.UserSetting_KEY_UNSPECIFIED, errors.Errorf("unknown setting key: %s", key)
 	}
@@ -1232,6 +1597,22 @@ func convertSettingKeyFromStore(key storepb.UserSetting_Key) string {
 		return "SHORTCUTS" // Not defined in API proto
 	case storepb.UserSetting_WEBHOOKS:
 		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]
+	case storepb.UserSetting_AI_AUTO_SUMMARY:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_AI_AUTO_SUMMARY)]
+	case storepb.UserSetting_NOSTR:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_NOSTR)]
+	case storepb.UserSetting_BLUESKY:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_BLUESKY)]
+	case storepb.UserSetting_MASTODON:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_MASTODON)]
+	case storepb.UserSetting_MICROPUB:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_MICROPUB)]
+	case storepb.UserSetting_INDIEAUTH:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_INDIEAUTH)]
+	case storepb.UserSetting_TAGGING_RULES:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_TAGGING_RULES)]
+	case storepb.UserSetting_FORMATTING:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_FORMATTING)]
 	default:
 		return "unknown"
 	}
@@ -1265,6 +1646,38 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 					Webhooks: []*v1pb.UserWebhook{},
 				},
 			}
+		case storepb.UserSetting_AI_AUTO_SUMMARY:
+			setting.Value = &v1pb.UserSetting_AiAutoSummarySetting{
+				AiAutoSummarySetting: convertAIAutoSummarySettingFromStore(&storepb.AIAutoSummaryUserSetting{}),
+			}
+		case storepb.UserSetting_NOSTR:
+			setting.Value = &v1pb.UserSetting_NostrSetting_{
+				NostrSetting: convertNostrSettingFromStore(&storepb.NostrUserSetting{}),
+			}
+		case storepb.UserSetting_BLUESKY:
+			setting.Value = &v1pb.UserSetting_BlueskySetting_{
+				BlueskySetting: convertBlueskySettingFromStore(&storepb.BlueskyUserSetting{}),
+			}
+		case storepb.UserSetting_MASTODON:
+			setting.Value = &v1pb.UserSetting_MastodonSetting_{
+				MastodonSetting: convertMastodonSettingFromStore(&storepb.MastodonUserSetting{}),
+			}
+		case storepb.UserSetting_MICROPUB:
+			setting.Value = &v1pb.UserSetting_MicropubSetting_{
+				MicropubSetting: convertMicropubSettingFromStore(&storepb.MicropubUserSetting{}),
+			}
+		case storepb.UserSetting_INDIEAUTH:
+			setting.Value = &v1pb.UserSetting_IndieAuthSetting_{
+				IndieAuthSetting: convertIndieAuthSettingFromStore(&storepb.IndieAuthUserSetting{}),
+			}
+		case storepb.UserSetting_TAGGING_RULES:
+			setting.Value = &v1pb.UserSetting_TaggingRulesSetting_{
+				TaggingRulesSetting: convertTaggingRulesSettingFromStore(&storepb.TaggingRulesUserSetting{}),
+			}
+		case storepb.UserSetting_FORMATTING:
+			setting.Value = &v1pb.UserSetting_FormattingSetting_{
+				FormattingSetting: convertFormattingSettingFromStore(&storepb.FormattingUserSetting{}),
+			}
 		default:
 			// Default to general setting
 			setting.Value = &v1pb.UserSetting_GeneralSetting_{
@@ -1284,9 +1697,14 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 		if general := storeSetting.GetGeneral(); general != nil {
 			setting.Value = &v1pb.UserSetting_GeneralSetting_{
 				GeneralSetting: &v1pb.UserSetting_GeneralSetting{
-					Locale:         general.Locale,
-					MemoVisibility: general.MemoVisibility,
-					Theme:          general.Theme,
+					Locale:                        general.Locale,
+					MemoVisibility:                general.MemoVisibility,
+					Theme:                         general.Theme,
+					ArchiveLinks:                  general.ArchiveLinks,
+					ReactionNotificationThreshold: general.ReactionNotificationThreshold,
+					Timezone:                      general.Timezone,
+					WeekStartDay:                  general.WeekStartDay,
+					AiOptIn:                       general.AiOptIn,
 				},
 			}
 		} else {
@@ -1338,18 +1756,45 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 		webhooks := storeSetting.GetWebhooks()
 		apiWebhooks := make([]*v1pb.UserWebhook, 0, len(webhooks.Webhooks))
 		for _, webhook := range webhooks.Webhooks {
-			apiWebhook := &v1pb.UserWebhook{
-				Name:        fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
-				Url:         webhook.Url,
-				DisplayName: webhook.Title,
-			}
-			apiWebhooks = append(apiWebhooks, apiWebhook)
+			apiWebhooks = append(apiWebhooks, convertUserWebhookFromUserSetting(webhook, userID))
 		}
 		setting.Value = &v1pb.UserSetting_WebhooksSetting_{
 			WebhooksSetting: &v1pb.UserSetting_WebhooksSetting{
 				Webhooks: apiWebhooks,
 			},
 		}
+	case storepb.UserSetting_AI_AUTO_SUMMARY:
+		setting.Value = &v1pb.UserSetting_AiAutoSummarySetting{
+			AiAutoSummarySetting: convertAIAutoSummarySettingFromStore(storeSetting.GetAiAutoSummary()),
+		}
+	case storepb.UserSetting_NOSTR:
+		setting.Value = &v1pb.UserSetting_NostrSetting_{
+			NostrSetting: convertNostrSettingFromStore(storeSetting.GetNostr()),
+		}
+	case storepb.UserSetting_BLUESKY:
+		setting.Value = &v1pb.UserSetting_BlueskySetting_{
+			BlueskySetting: convertBlueskySettingFromStore(storeSetting.GetBluesky()),
+		}
+	case storepb.UserSetting_MASTODON:
+		setting.Value = &v1pb.UserSetting_MastodonSetting_{
+			MastodonSetting: convertMastodonSettingFromStore(storeSetting.GetMastodon()),
+		}
+	case storepb.UserSetting_MICROPUB:
+		setting.Value = &v1pb.UserSetting_MicropubSetting_{
+			MicropubSetting: convertMicropubSettingFromStore(storeSetting.GetMicropub()),
+		}
+	case storepb.UserSetting_INDIEAUTH:
+		setting.Value = &v1pb.UserSetting_IndieAuthSetting_{
+			IndieAuthSetting: convertIndieAuthSettingFromStore(storeSetting.GetIndieAuth()),
+		}
+	case storepb.UserSetting_TAGGING_RULES:
+		setting.Value = &v1pb.UserSetting_TaggingRulesSetting_{
+			TaggingRulesSetting: convertTaggingRulesSettingFromStore(storeSetting.GetTaggingRules()),
+		}
+	case storepb.UserSetting_FORMATTING:
+		setting.Value = &v1pb.UserSetting_FormattingSetting_{
+			FormattingSetting: convertFormattingSettingFromStore(storeSetting.GetFormatting()),
+		}
 	default:
 		// Default to general setting if unknown key
 		setting.Value = &v1pb.UserSetting_GeneralSetting_{
@@ -1372,9 +1817,14 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 		if general := apiSetting.GetGeneralSetting(); general != nil {
 			storeSetting.Value = &storepb.UserSetting_General{
 				General: &storepb.GeneralUserSetting{
-					Locale:         general.Locale,
-					MemoVisibility: general.MemoVisibility,
-					Theme:          general.Theme,
+					Locale:                        general.Locale,
+					MemoVisibility:                general.MemoVisibility,
+					Theme:                         general.Theme,
+					ArchiveLinks:                  general.ArchiveLinks,
+					ReactionNotificationThreshold: general.ReactionNotificationThreshold,
+					Timezone:                      general.Timezone,
+					WeekStartDay:                  general.WeekStartDay,
+					AiOptIn:                       general.AiOptIn,
 				},
 			}
 		} else {
@@ -1443,6 +1893,14 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 		} else {
 			return nil, errors.Errorf("webhooks setting is required")
 		}
+	case storepb.UserSetting_AI_AUTO_SUMMARY:
+		if aiAutoSummary := apiSetting.GetAiAutoSummarySetting(); aiAutoSummary != nil {
+			storeSetting.Value = &storepb.UserSetting_AiAutoSummary{
+				AiAutoSummary: convertAIAutoSummarySettingToStore(aiAutoSummary),
+			}
+		} else {
+			return nil, errors.Errorf("AI auto summary setting is required")
+		}
 	default:
 		return nil, errors.Errorf("unsupported setting key: %v", key)
 	}
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -32,15 +33,18 @@ func (s *APIV1Service) ListAllUserStats(ctx context.Context, _ *v1pb.ListAllUser
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
-	if currentUser == nil {
-		memoFind.VisibilityList = []store.Visibility{store.Public}
-	} else {
-		if memoFind.CreatorID == nil {
-			filter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
-			memoFind.Filters = append(memoFind.Filters, filter)
-		} else if *memoFind.CreatorID != currentUser.ID {
-			memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+	if currentUser == nil || (memoFind.CreatorID != nil && *memoFind.CreatorID != currentUser.ID) {
+		visibilities, err := s.getVisibleMemoVisibilities(ctx, currentUser)
+		if err != nil {
+			return nil, err
 		}
+		memoFind.VisibilityList = visibilities
+	} else if memoFind.CreatorID == nil {
+		filter, err := s.getVisibleMemoFilter(ctx, currentUser)
+		if err != nil {
+			return nil, err
+		}
+		memoFind.Filters = append(memoFind.Filters, filter)
 	}
 	memos, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
@@ -59,6 +63,17 @@ func (s *APIV1Service) ListAllUserStats(ctx context.Context, _ *v1pb.ListAllUser
 		userMemoStatMap[memo.CreatorID].MemoDisplayTimestamps = append(userMemoStatMap[memo.CreatorID].MemoDisplayTimestamps, timestamppb.New(time.Unix(displayTs, 0)))
 	}
 
+	// The users may hide their statistics from the other users.
+	profileSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_PROFILE})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list user profiles: %v", err)
+	}
+	for _, profileSetting := range profileSettings {
+		if !isUserStatsVisible(profileSetting.GetProfile(), profileSetting.UserId, currentUser) {
+			delete(userMemoStatMap, profileSetting.UserId)
+		}
+	}
+
 	userMemoStats := []*v1pb.UserStats{}
 	for _, userMemoStat := range userMemoStatMap {
 		userMemoStats = append(userMemoStats, userMemoStat)
@@ -80,6 +95,13 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
+	profile, err := s.Store.GetUserProfile(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user profile: %v", err)
+	}
+	if !isUserStatsVisible(profile, userID, currentUser) {
+		return nil, status.Errorf(codes.PermissionDenied, "the statistics of the user are private")
+	}
 
 	normalStatus := store.Normal
 	memoFind := &store.FindMemo{
@@ -90,16 +112,27 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 		RowStatus:       &normalStatus,
 	}
 
-	if currentUser == nil {
-		memoFind.VisibilityList = []store.Visibility{store.Public}
-	} else if currentUser.ID != userID {
-		memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+	if currentUser == nil || currentUser.ID != userID {
+		visibilities, err := s.getVisibleMemoVisibilities(ctx, currentUser)
+		if err != nil {
+			return nil, err
+		}
+		memoFind.VisibilityList = visibilities
 	}
 
 	memos, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
 	}
+	memoTagCounts, err := s.Store.ListMemoTagCounts(ctx, &store.FindMemoTagCount{
+		CreatorID:       memoFind.CreatorID,
+		RowStatus:       memoFind.RowStatus,
+		VisibilityList:  memoFind.VisibilityList,
+		ExcludeComments: memoFind.ExcludeComments,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo tag counts: %v", err)
+	}
 
 	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
 	if err != nil {
@@ -108,6 +141,9 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 
 	displayTimestamps := []*timestamppb.Timestamp{}
 	tagCount := make(map[string]int32)
+	for _, memoTagCount := range memoTagCounts {
+		tagCount[memoTagCount.Tag] = memoTagCount.Count
+	}
 	linkCount := int32(0)
 	codeCount := int32(0)
 	todoCount := int32(0)
@@ -122,9 +158,6 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 		displayTimestamps = append(displayTimestamps, timestamppb.New(time.Unix(displayTs, 0)))
 		// Count different memo types based on content.
 		if memo.Payload != nil {
-			for _, tag := range memo.Payload.Tags {
-				tagCount[tag]++
-			}
 			if memo.Payload.Property != nil {
 				if memo.Payload.Property.HasLink {
 					linkCount++
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/internal/base"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// usernameAliasGracePeriod is how long a previous username keeps resolving to its user.
+const usernameAliasGracePeriod = 30 * 24 * time.Hour
+
+// reservedUsernames are the usernames colliding with the routes of the web app and the API, compared case-insensitively.
+var reservedUsernames = []string{
+	"admin", "api", "archived", "attachments", "auth", "explore", "healthz", "inbox", "memos", "setting", "settings", "signup",
+}
+
+// ChangeUsername changes the username of a user, see UpdateUser.
+func (s *APIV1Service) ChangeUsername(ctx context.Context, request *v1pb.ChangeUsernameRequest) (*v1pb.User, error) {
+	return s.UpdateUser(ctx, &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Name: request.Name, Username: request.NewUsername},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"username"}},
+	})
+}
+
+// validateUsername checks the username is valid, not reserved and free: neither the username of another user nor
+// one of their unexpired aliases. userID is the user taking it, 0 for a user to be created.
+func (s *APIV1Service) validateUsername(ctx context.Context, username string, userID int32) error {
+	if !base.UIDMatcher.MatchString(strings.ToLower(username)) {
+		return status.Errorf(codes.InvalidArgument, "invalid username: %s", username)
+	}
+	if slices.Contains(reservedUsernames, strings.ToLower(username)) {
+		return status.Errorf(codes.InvalidArgument, "username %q is reserved", username)
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &username})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user != nil && user.ID != userID {
+		return status.Errorf(codes.AlreadyExists, "username %q is already taken", username)
+	}
+	now := time.Now().Unix()
+	alias, err := s.Store.GetUsernameAlias(ctx, &store.FindUsernameAlias{Username: &username, ExpiresAfter: &now})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get username alias: %v", err)
+	}
+	if alias != nil && alias.UserID != userID {
+		return status.Errorf(codes.AlreadyExists, "username %q was recently used by another user", username)
+	}
+	return nil
+}
+
+// recordUsernameChange keeps the previous username of the user as an alias for the grace period, and updates the
+// memos mentioning it.
+func (s *APIV1Service) recordUsernameChange(ctx context.Context, userID int32, previousUsername, username string) error {
+	// The user may take back one of its previous usernames.
+	if err := s.Store.DeleteUsernameAlias(ctx, &store.DeleteUsernameAlias{Username: &username}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete username alias: %v", err)
+	}
+	if _, err := s.Store.UpsertUsernameAlias(ctx, &store.UsernameAlias{
+		Username:  previousUsername,
+		UserID:    userID,
+		ExpiresTs: time.Now().Add(usernameAliasGracePeriod).Unix(),
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert username alias: %v", err)
+	}
+
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		Filters: []string{fmt.Sprintf("content.contains(%q)", "@"+previousUsername)},
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	for _, memo := range memos {
+		content := replaceUsernameMentions(memo.Content, previousUsername, username)
+		if content == memo.Content {
+			continue
+		}
+		memo.Content = content
+		if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
+			return status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+		}
+		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:      memo.ID,
+			Content: &memo.Content,
+			Payload: memo.Payload,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to update memo: %v", err)
+		}
+	}
+	return nil
+}
+
+// replaceUsernameMentions replaces the mentions of the username in the content, e.g. "@steven", leaving alone the
+// longer usernames and the e-mail addresses it is part of.
+func replaceUsernameMentions(content, previousUsername, username string) string {
+	mention := "@" + previousUsername
+	var builder strings.Builder
+	last := 0
+	for {
+		index := strings.Index(content[last:], mention)
+		if index < 0 {
+			break
+		}
+		start, end := last+index, last+index+len(mention)
+		builder.WriteString(content[last:start])
+		before, _ := utf8.DecodeLastRuneInString(content[:start])
+		after, _ := utf8.DecodeRuneInString(content[end:])
+		if (start == 0 || !isMentionRune(before) && before != '.' && before != '@') && (end == len(content) || !isMentionRune(after)) {
+			builder.WriteString("@" + username)
+		} else {
+			builder.WriteString(mention)
+		}
+		last = end
+	}
+	builder.WriteString(content[last:])
+	return builder.String()
+}
+
+// isMentionRune reports whether the rune may be part of a username mention.
+func isMentionRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-'
+}
@@ -0,0 +1,215 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// webhookDeliveryHistorySize is the number of recent deliveries kept for each webhook.
+	webhookDeliveryHistorySize = 20
+	// webhookPersistentFailureCount is the number of deliveries of a webhook failing in a row alerted of.
+	webhookPersistentFailureCount = 3
+	// webhookTestActivityType is the activity type of the requests sent by TestUserWebhook.
+	webhookTestActivityType = "memos.webhook.test"
+)
+
+// userWebhookEvents are the activity types a user webhook can be subscribed to.
+var userWebhookEvents = []string{
+	"memos.memo.created",
+	"memos.memo.updated",
+	"memos.memo.deleted",
+	aiSummaryStartedActivityType,
+	aiSummarySucceededActivityType,
+	aiSummaryFailedActivityType,
+}
+
+// RotateUserWebhookSecret replaces the secret of the webhook. Requests are signed with the new secret right away.
+func (s *APIV1Service) RotateUserWebhookSecret(ctx context.Context, request *v1pb.RotateUserWebhookSecretRequest) (*v1pb.UserWebhook, error) {
+	userID, targetWebhook, err := s.getUserWebhookByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedWebhook := proto.Clone(targetWebhook).(*storepb.WebhooksUserSetting_Webhook)
+	updatedWebhook.Secret = generateUserWebhookSecret()
+	updatedWebhook.UpdatedTs = time.Now().Unix()
+	if err := s.Store.UpdateUserWebhook(ctx, userID, updatedWebhook); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update webhook: %v", err)
+	}
+
+	userWebhook := convertUserWebhookFromUserSetting(updatedWebhook, userID)
+	userWebhook.Secret = updatedWebhook.Secret
+	return userWebhook, nil
+}
+
+// TestUserWebhook sends a test request to the webhook and returns its delivery, failed or not.
+func (s *APIV1Service) TestUserWebhook(ctx context.Context, request *v1pb.TestUserWebhookRequest) (*v1pb.UserWebhookDelivery, error) {
+	userID, targetWebhook, err := s.getUserWebhookByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	statusCode, sendErr := webhook.Send(&webhook.WebhookRequestPayload{
+		URL:          targetWebhook.Url,
+		ActivityType: webhookTestActivityType,
+		Creator:      fmt.Sprintf("%s%d", UserNamePrefix, userID),
+		Secret:       targetWebhook.Secret,
+	})
+	delivery, err := s.createWebhookDelivery(ctx, userID, targetWebhook.Id, webhookTestActivityType, statusCode, sendErr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record webhook delivery: %v", err)
+	}
+	return convertUserWebhookDeliveryFromStore(delivery), nil
+}
+
+// ListUserWebhookDeliveries lists the recent deliveries of the webhook, most recent first.
+func (s *APIV1Service) ListUserWebhookDeliveries(ctx context.Context, request *v1pb.ListUserWebhookDeliveriesRequest) (*v1pb.ListUserWebhookDeliveriesResponse, error) {
+	userID, targetWebhook, err := s.getUserWebhookByName(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := webhookDeliveryHistorySize
+	deliveries, err := s.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		UserID:    &userID,
+		WebhookID: &targetWebhook.Id,
+		Limit:     &limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	response := &v1pb.ListUserWebhookDeliveriesResponse{
+		Deliveries: []*v1pb.UserWebhookDelivery{},
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, convertUserWebhookDeliveryFromStore(delivery))
+	}
+	return response, nil
+}
+
+// getUserWebhookByName returns the webhook and the id of its owner, checking that the current user can manage it.
+func (s *APIV1Service) getUserWebhookByName(ctx context.Context, name string) (int32, *storepb.WebhooksUserSetting_Webhook, error) {
+	webhookID, userID, err := parseUserWebhookName(name)
+	if err != nil {
+		return 0, nil, status.Errorf(codes.InvalidArgument, "invalid webhook name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID && !isSuperUser(currentUser) {
+		return 0, nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
+	if err != nil {
+		return 0, nil, status.Errorf(codes.Internal, "failed to get user webhooks: %v", err)
+	}
+	for _, webhook := range webhooks {
+		if webhook.Id == webhookID {
+			return userID, webhook, nil
+		}
+	}
+	return 0, nil, status.Errorf(codes.NotFound, "webhook not found")
+}
+
+// createWebhookDelivery records a request sent to a user webhook, keeping only the recent deliveries of the webhook.
+func (s *APIV1Service) createWebhookDelivery(ctx context.Context, userID int32, webhookID, activityType string, statusCode int, sendErr error) (*store.WebhookDelivery, error) {
+	create := &store.WebhookDelivery{
+		UserID:       userID,
+		WebhookID:    webhookID,
+		ActivityType: activityType,
+		StatusCode:   int32(statusCode),
+	}
+	if sendErr != nil {
+		create.Error = sendErr.Error()
+	}
+	delivery, err := s.Store.CreateWebhookDelivery(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.PruneWebhookDeliveries(ctx, userID, webhookID, webhookDeliveryHistorySize); err != nil {
+		return nil, errors.Wrap(err, "failed to prune webhook deliveries")
+	}
+	return delivery, nil
+}
+
+// recordWebhookDelivery records a request sent in the background, only logging the errors.
+func (s *APIV1Service) recordWebhookDelivery(ctx context.Context, userID int32, webhookID, activityType string, statusCode int, sendErr error) {
+	if _, err := s.createWebhookDelivery(ctx, userID, webhookID, activityType, statusCode, sendErr); err != nil {
+		slog.ErrorContext(ctx, "failed to record webhook delivery", "webhookID", webhookID, "error", err)
+		return
+	}
+	if sendErr != nil {
+		s.alertPersistentWebhookFailure(ctx, userID, webhookID, sendErr)
+	}
+}
+
+// alertPersistentWebhookFailure alerts of the failures of the webhook if its last deliveries all failed.
+func (s *APIV1Service) alertPersistentWebhookFailure(ctx context.Context, userID int32, webhookID string, sendErr error) {
+	limit := webhookPersistentFailureCount
+	deliveries, err := s.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		UserID:    &userID,
+		WebhookID: &webhookID,
+		Limit:     &limit,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list webhook deliveries", "webhookID", webhookID, "error", err)
+		return
+	}
+	if len(deliveries) < webhookPersistentFailureCount {
+		return
+	}
+	for _, delivery := range deliveries {
+		if delivery.Error == "" {
+			return
+		}
+	}
+	s.raiseAlert(ctx, alertKindWebhookDelivery, "Webhook deliveries failing",
+		fmt.Sprintf("The last %d deliveries of the webhook %s of the user %d failed, the last error: %v.", len(deliveries), webhookID, userID, sendErr))
+}
+
+// validateUserWebhookEvents checks that the events are known activity types.
+func validateUserWebhookEvents(events []string) error {
+	for _, event := range events {
+		if !slices.Contains(userWebhookEvents, event) {
+			return errors.Errorf("unsupported webhook event %q", event)
+		}
+	}
+	return nil
+}
+
+// isUserWebhookSubscribed returns whether the webhook is sent for the activity type.
+func isUserWebhookSubscribed(webhook *storepb.WebhooksUserSetting_Webhook, activityType string) bool {
+	return len(webhook.Events) == 0 || slices.Contains(webhook.Events, activityType)
+}
+
+func convertUserWebhookDeliveryFromStore(delivery *store.WebhookDelivery) *v1pb.UserWebhookDelivery {
+	return &v1pb.UserWebhookDelivery{
+		Name:         fmt.Sprintf("%s%d/webhooks/%s/deliveries/%d", UserNamePrefix, delivery.UserID, delivery.WebhookID, delivery.ID),
+		ActivityType: delivery.ActivityType,
+		ResponseCode: delivery.StatusCode,
+		Error:        delivery.Error,
+		CreateTime:   timestamppb.New(time.Unix(delivery.CreatedTs, 0)),
+	}
+}
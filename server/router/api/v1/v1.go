@@ -4,6 +4,10 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -13,10 +17,22 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/usememos/memos/internal/logging"
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/ai"
 	"github.com/usememos/memos/plugin/markdown"
+	"github.com/usememos/memos/plugin/workpool"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/aijob"
+	"github.com/usememos/memos/server/runner/attachmentclassify"
+	"github.com/usememos/memos/server/runner/attachmentextract"
+	"github.com/usememos/memos/server/runner/maintenance"
+	"github.com/usememos/memos/server/runner/memoembed"
+	"github.com/usememos/memos/server/runner/memoview"
+	"github.com/usememos/memos/server/runner/reactionnotify"
+	"github.com/usememos/memos/server/runner/scheduler"
 	"github.com/usememos/memos/store"
 )
 
@@ -29,17 +45,58 @@ type APIV1Service struct {
 	v1pb.UnimplementedMemoServiceServer
 	v1pb.UnimplementedAttachmentServiceServer
 	v1pb.UnimplementedShortcutServiceServer
+	v1pb.UnimplementedTagMetaServiceServer
+	v1pb.UnimplementedSyndicationServiceServer
 	v1pb.UnimplementedInboxServiceServer
 	v1pb.UnimplementedActivityServiceServer
 	v1pb.UnimplementedIdentityProviderServiceServer
 	v1pb.UnimplementedAIServiceServer
+	v1pb.UnimplementedEventServiceServer
 
 	Secret          string
 	Profile         *profile.Profile
 	Store           *store.Store
 	MarkdownService markdown.Service
+	// Scheduler runs the background runners, it is nil when they are not started.
+	Scheduler *scheduler.Scheduler
+	// ReactionNotifier queues the reactions to notify, reactions are not notified when it is nil.
+	ReactionNotifier *reactionnotify.Runner
+	// MemoViewRecorder queues the views of the memos to record, views are not counted when it is nil.
+	MemoViewRecorder *memoview.Runner
+	// AttachmentClassifier classifies the uploaded images, they are classified by its runner only when it is nil.
+	AttachmentClassifier *attachmentclassify.Runner
+	// AttachmentExtractor extracts the text of the uploaded images and recordings, they are extracted by its runner
+	// only when it is nil.
+	AttachmentExtractor *attachmentextract.Runner
+	// MemoEmbedder embeds the created and updated memos, they are embedded by its runner only when it is nil.
+	MemoEmbedder *memoembed.Runner
+	// AIJobRunner processes the queued AI jobs, they are processed by its scheduled run only when it is nil.
+	AIJobRunner *aijob.Runner
+	// MaintenanceRunner starts and ends the maintenance windows, they cannot be scheduled when it is nil.
+	MaintenanceRunner *maintenance.Runner
 
 	grpcServer *grpc.Server
+
+	// userImportJobs holds the latest import job of each user, keyed by user ID.
+	userImportJobs      map[int32]*v1pb.UserImportJob
+	userImportJobsMutex sync.Mutex
+
+	// memoPayloadRebuildJob holds the latest memo payload rebuild job.
+	memoPayloadRebuildJob      *v1pb.MemoPayloadRebuildJob
+	memoPayloadRebuildJobMutex sync.Mutex
+
+	// aiUsageMutex serializes the updates of the monthly AI token usage.
+	aiUsageMutex sync.Mutex
+	// aiMonitor tracks the health of the AI provider, and fails the AI requests fast while it is unhealthy.
+	aiMonitor ai.Monitor
+	// aiProfileMonitors holds the *ai.Monitor of the provider of each AI profile, keyed by profile name.
+	aiProfileMonitors sync.Map
+	// aiLimiter limits the number of AI requests in flight to all the providers.
+	aiLimiter ai.Limiter
+	// workPools holds the *workpool.Pool of the expensive jobs run by the service, keyed by name, created on first use.
+	workPools sync.Map
+	// lastAlerts holds the time.Time of the last alert of each kind, keyed by kind, for the alerts to be rate limited.
+	lastAlerts sync.Map
 }
 
 func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, grpcServer *grpc.Server) *APIV1Service {
@@ -61,14 +118,85 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 	v1pb.RegisterMemoServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterAttachmentServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterShortcutServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterTagMetaServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterSyndicationServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterInboxServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterActivityServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterIdentityProviderServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterAIServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterEventServiceServer(grpcServer, apiv1Service)
 	reflection.Register(grpcServer)
 	return apiv1Service
 }
 
+// getWorkPool returns the work pool of the name.
+func (s *APIV1Service) getWorkPool(name string) *workpool.Pool {
+	if pool, ok := s.workPools.Load(name); ok {
+		return pool.(*workpool.Pool)
+	}
+	pool, _ := s.workPools.LoadOrStore(name, newWorkPool(name))
+	return pool.(*workpool.Pool)
+}
+
+// runtimeSettings returns the current runtime settings of the instance.
+func (s *APIV1Service) runtimeSettings() *profile.RuntimeSettings {
+	if s.Profile == nil {
+		return profile.DefaultRuntimeSettings()
+	}
+	return s.Profile.Runtime.Get()
+}
+
+// gatewayIncomingHeaderMatcher forwards the request ID header along with the default headers.
+func gatewayIncomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, logging.RequestIDHeader) {
+		return strings.ToLower(logging.RequestIDHeader), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// httpStatusCodeHeader is the response metadata overriding the HTTP status code of a successful response,
+// e.g. 304 for the conditional requests. It is not forwarded as a header.
+const httpStatusCodeHeader = "x-http-code"
+
+// gatewayPassthroughHeaders are the response headers returned as is, used by the HTTP caches.
+var gatewayPassthroughHeaders = map[string]string{
+	"etag":          "ETag",
+	"cache-control": "Cache-Control",
+}
+
+// gatewayOutgoingHeaderMatcher returns the request ID and caching headers as is instead of prefixing them with
+// Grpc-Metadata-.
+func gatewayOutgoingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, logging.RequestIDHeader) {
+		return logging.RequestIDHeader, true
+	}
+	if header, ok := gatewayPassthroughHeaders[strings.ToLower(key)]; ok {
+		return header, true
+	}
+	if strings.EqualFold(key, httpStatusCodeHeader) {
+		return "", false
+	}
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
+
+// gatewayResponseModifier writes the HTTP status code set with the httpStatusCodeHeader metadata.
+func gatewayResponseModifier(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+	md, ok := runtime.ServerMetadataFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	values := md.HeaderMD.Get(httpStatusCodeHeader)
+	if len(values) == 0 {
+		return nil
+	}
+	code, err := strconv.Atoi(values[0])
+	if err != nil {
+		return err
+	}
+	w.WriteHeader(code)
+	return nil
+}
+
 // RegisterGateway registers the gRPC-Gateway with the given Echo instance.
 func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Echo) error {
 	var target string
@@ -90,7 +218,12 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 		return err
 	}
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayIncomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(gatewayOutgoingHeaderMatcher),
+		runtime.WithErrorHandler(gatewayErrorHandler),
+		runtime.WithForwardResponseOption(gatewayResponseModifier),
+	)
 	if err := v1pb.RegisterWorkspaceServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
@@ -109,6 +242,12 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterShortcutServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterTagMetaServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterSyndicationServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
 	if err := v1pb.RegisterInboxServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
@@ -121,12 +260,21 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterAIServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterEventServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
 	gwGroup := echoServer.Group("")
 	gwGroup.Use(middleware.CORS())
+	if s.Profile.MaxRequestSize > 0 {
+		// Binary fields are base64 encoded in JSON bodies, which grows them by a third.
+		gwGroup.Use(middleware.BodyLimit(strconv.FormatInt(s.Profile.MaxRequestSize/3*4+MebiByte, 10)))
+	}
 	handler := echo.WrapHandler(gwMux)
 
 	gwGroup.Any("/api/v1/*", handler)
 	gwGroup.Any("/file/*", handler)
+	s.RegisterMicropubRoutes(gwGroup)
+	s.RegisterWebmentionRoutes(gwGroup)
 
 	// GRPC web proxy.
 	options := []grpcweb.Option{
@@ -0,0 +1,247 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/outbound"
+	"github.com/usememos/memos/plugin/webmention"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxWebmentionURLLength is the maximum length of the URLs of a webmention.
+	maxWebmentionURLLength = 512
+	// webmentionVerifyTimeout is the timeout of the verification of a webmention.
+	webmentionVerifyTimeout = 30 * time.Second
+)
+
+// RegisterWebmentionRoutes registers the Webmention endpoint of the instance, see https://www.w3.org/TR/webmention/,
+// receiving the webmentions of the public memos.
+func (s *APIV1Service) RegisterWebmentionRoutes(g *echo.Group) {
+	g.POST("/webmention", s.handleWebmention)
+}
+
+// handleWebmention accepts the webmention of a public memo, verifying its source in the background. The webmentions
+// beyond the queue of the verifications are rejected with 429 Too Many Requests.
+func (s *APIV1Service) handleWebmention(c echo.Context) error {
+	ctx := c.Request().Context()
+	source, target := strings.TrimSpace(c.FormValue("source")), strings.TrimSpace(c.FormValue("target"))
+	sourceURL, err := url.Parse(source)
+	if err != nil || (sourceURL.Scheme != "http" && sourceURL.Scheme != "https") || sourceURL.Host == "" || len(source) > maxWebmentionURLLength {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid source")
+	}
+	targetURL, err := url.Parse(target)
+	if err != nil || (targetURL.Scheme != "http" && targetURL.Scheme != "https") || !s.isInstanceURL(c, targetURL) {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid target")
+	}
+	if source == target {
+		return echo.NewHTTPError(http.StatusBadRequest, "the source and the target are the same")
+	}
+	memo, err := s.findWebmentionMemo(ctx, targetURL)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get memo").SetInternal(err)
+	}
+	if memo == nil || memo.Visibility != store.Public || memo.RowStatus != store.Normal {
+		return echo.NewHTTPError(http.StatusBadRequest, "the target does not accept webmentions")
+	}
+	if err := outbound.ValidateURL(ctx, source); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid source")
+	}
+
+	if err := s.getWorkPool(workPoolWebmention).Go(func() {
+		if err := s.verifyWebmention(context.Background(), memo.ID, source, target); err != nil {
+			slog.Warn("Failed to verify webmention", slog.String("source", source), slog.Int("memoID", int(memo.ID)), slog.Any("err", err))
+		}
+	}); err != nil {
+		c.Response().Header().Set(retryAfterHeader, strconv.Itoa(int(workPoolRetryDelay.Seconds())))
+		return echo.NewHTTPError(http.StatusTooManyRequests, "too many webmentions in progress, retry later")
+	}
+	return c.NoContent(http.StatusAccepted)
+}
+
+// findWebmentionMemo returns the memo of the URL on the instance, by its UID or the slug of its creator, nil if
+// there is none.
+func (s *APIV1Service) findWebmentionMemo(ctx context.Context, target *url.URL) (*store.Memo, error) {
+	parts := strings.Split(strings.Trim(target.Path, "/"), "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "memos" && parts[1] != "":
+		return s.Store.GetMemo(ctx, &store.FindMemo{UID: &parts[1]})
+	case len(parts) == 4 && parts[0] == "u" && parts[2] == "m":
+		user, err := s.Store.GetUserByUsername(ctx, parts[1])
+		if err != nil || user == nil {
+			return nil, err
+		}
+		slug := strings.ToLower(parts[3])
+		memoSlug, err := s.Store.GetMemoSlug(ctx, &store.FindMemoSlug{CreatorID: &user.ID, Slug: &slug})
+		if err != nil || memoSlug == nil {
+			return nil, err
+		}
+		return s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoSlug.MemoID})
+	default:
+		return nil, nil
+	}
+}
+
+// verifyWebmention fetches the source of the webmention and records the response to the memo it contains. The
+// webmention already recorded is updated, or deleted once the source is gone or no longer links to the memo.
+func (s *APIV1Service) verifyWebmention(ctx context.Context, memoID int32, source, target string) error {
+	ctx, cancel := context.WithTimeout(ctx, webmentionVerifyTimeout)
+	defer cancel()
+	mention, err := webmention.Verify(ctx, source, target)
+	if errors.Is(err, webmention.ErrGone) || errors.Is(err, webmention.ErrNoLink) {
+		return s.Store.DeleteWebmention(ctx, &store.DeleteWebmention{MemoID: &memoID, Source: &source})
+	}
+	if err != nil {
+		return err
+	}
+
+	upsert := &store.Webmention{
+		MemoID:     memoID,
+		Source:     source,
+		Type:       convertWebmentionTypeToStore(mention.Type),
+		AuthorName: truncateWebmentionField(mention.AuthorName, 256),
+		// The URLs too long to be recorded are dropped rather than truncated.
+		AuthorURL:   dropLongWebmentionURL(mention.AuthorURL),
+		AuthorPhoto: dropLongWebmentionURL(mention.AuthorPhoto),
+		Content:     mention.Content,
+	}
+	if !mention.Published.IsZero() {
+		upsert.PublishedTs = mention.Published.Unix()
+	}
+	return s.Store.UpsertWebmention(ctx, upsert)
+}
+
+func (s *APIV1Service) ListMemoWebmentions(ctx context.Context, request *v1pb.ListMemoWebmentionsRequest) (*v1pb.ListMemoWebmentionsResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	// The webmentions of a memo are kept when it is no longer public, but only shown to its creator.
+	if memo.Visibility != store.Public {
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user")
+		}
+		if user == nil || user.ID != memo.CreatorID {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
+
+	webmentions, err := s.Store.ListWebmentions(ctx, &store.FindWebmention{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webmentions: %v", err)
+	}
+	response := &v1pb.ListMemoWebmentionsResponse{Webmentions: []*v1pb.Webmention{}}
+	for _, webmention := range webmentions {
+		response.Webmentions = append(response.Webmentions, convertWebmentionFromStore(memo, webmention))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) DeleteMemoWebmention(ctx context.Context, request *v1pb.DeleteMemoWebmentionRequest) (*emptypb.Empty, error) {
+	memoUID, webmentionID, err := ExtractMemoWebmentionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webmention name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	// Only the creator or admin can moderate the webmentions of the memo.
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	webmention, err := s.Store.GetWebmention(ctx, &store.FindWebmention{ID: &webmentionID, MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webmention: %v", err)
+	}
+	if webmention == nil {
+		return nil, status.Errorf(codes.NotFound, "webmention not found")
+	}
+	if err := s.Store.DeleteWebmention(ctx, &store.DeleteWebmention{ID: &webmention.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webmention: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func convertWebmentionFromStore(memo *store.Memo, webmention *store.Webmention) *v1pb.Webmention {
+	webmentionMessage := &v1pb.Webmention{
+		Name:   fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, WebmentionNamePrefix, webmention.ID),
+		Source: webmention.Source,
+		Type:   v1pb.Webmention_Type(v1pb.Webmention_Type_value[webmention.Type.String()]),
+		Author: &v1pb.Webmention_Author{
+			Name:  webmention.AuthorName,
+			Url:   webmention.AuthorURL,
+			Photo: webmention.AuthorPhoto,
+		},
+		Content:    webmention.Content,
+		CreateTime: timestamppb.New(time.Unix(webmention.CreatedTs, 0)),
+		UpdateTime: timestamppb.New(time.Unix(webmention.UpdatedTs, 0)),
+	}
+	if webmention.PublishedTs != 0 {
+		webmentionMessage.PublishTime = timestamppb.New(time.Unix(webmention.PublishedTs, 0))
+	}
+	return webmentionMessage
+}
+
+func convertWebmentionTypeToStore(mentionType string) store.WebmentionType {
+	switch mentionType {
+	case webmention.TypeReply:
+		return store.WebmentionReply
+	case webmention.TypeLike:
+		return store.WebmentionLike
+	case webmention.TypeRepost:
+		return store.WebmentionRepost
+	case webmention.TypeBookmark:
+		return store.WebmentionBookmark
+	default:
+		return store.WebmentionMention
+	}
+}
+
+func truncateWebmentionField(value string, maxLength int) string {
+	runes := []rune(value)
+	if len(runes) <= maxLength {
+		return value
+	}
+	return string(runes[:maxLength])
+}
+
+func dropLongWebmentionURL(value string) string {
+	if len(value) > maxWebmentionURLLength {
+		return ""
+	}
+	return value
+}
@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// GetWorkspaceCapabilities returns the optional subsystems enabled for the current user, anonymous or not, and their
+// limits. The AI features and the semantic search are only available to the signed-in users.
+func (s *APIV1Service) GetWorkspaceCapabilities(ctx context.Context, _ *v1pb.GetWorkspaceCapabilitiesRequest) (*v1pb.WorkspaceCapabilities, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	capabilities := &v1pb.WorkspaceCapabilities{
+		Ai:         &v1pb.WorkspaceCapabilities_AI{Features: []string{}},
+		Federation: &v1pb.WorkspaceCapabilities_Federation{},
+	}
+	if user != nil {
+		if capabilities.Ai, err = s.getAICapabilities(ctx, user); err != nil {
+			return nil, err
+		}
+		if capabilities.SemanticSearch, err = s.isAIConfigured(ctx, store.AIFeatureEmbedding); err != nil {
+			return nil, err
+		}
+		capabilities.Federation.CanSubscribe = isSuperUser(user)
+	}
+	subscriptions, err := s.Store.ListSyndicationSubscriptions(ctx, &store.FindSyndicationSubscription{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list syndication subscriptions: %v", err)
+	}
+	capabilities.Federation.Enabled = len(subscriptions) > 0
+
+	memoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting: %v", err)
+	}
+	storageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace storage setting: %v", err)
+	}
+	capabilities.Limits = &v1pb.WorkspaceCapabilities_Limits{
+		MemoContentLength: memoRelatedSetting.ContentLengthLimit,
+		UploadSizeBytes:   int64(getUploadSizeLimit(storageSetting)),
+	}
+	if s.Profile != nil {
+		capabilities.Limits.RequestSizeBytes = s.Profile.MaxRequestSize
+	}
+	return capabilities, nil
+}
+
+// getAICapabilities returns the AI features the role of the user may use, the AI rate limits of the user and the
+// disclosure of the processing of their content, no feature if no AI provider is configured or the AI is disabled.
+func (s *APIV1Service) getAICapabilities(ctx context.Context, user *store.User) (*v1pb.WorkspaceCapabilities_AI, error) {
+	capabilities := &v1pb.WorkspaceCapabilities_AI{Features: []string{}}
+	configured, err := s.isAIConfigured(ctx, "")
+	if err != nil || !configured {
+		return capabilities, err
+	}
+	capabilities.Enabled = true
+	aiSetting, err := s.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace AI setting: %v", err)
+	}
+	capabilities.DataProcessingDisclosure = aiSetting.DataProcessingDisclosure
+	if capabilities.OptedIn, err = s.Store.GetUserAIOptIn(ctx, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user AI opt-in: %v", err)
+	}
+	for _, feature := range aiFeatures {
+		if err := s.checkAIFeaturePermission(ctx, user, feature); err != nil {
+			if status.Code(err) == codes.PermissionDenied {
+				continue
+			}
+			return nil, err
+		}
+		capabilities.Features = append(capabilities.Features, strings.ReplaceAll(string(feature), " ", "_"))
+	}
+	limits, err := s.getAIRateLimits(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	capabilities.HourlyRequestLimit = limits.hourly
+	capabilities.DailyRequestLimit = limits.daily
+	capabilities.MonthlyTokenBudget = limits.monthlyTokens
+	return capabilities, nil
+}
+
+// isAIConfigured reports whether the AI provider of the feature is configured, with an embedding model for the
+// embeddings and a chat model otherwise.
+func (s *APIV1Service) isAIConfigured(ctx context.Context, feature string) (bool, error) {
+	config, err := s.getAIConfig(ctx, feature)
+	if status.Code(err) == codes.FailedPrecondition {
+		return false, nil
+	}
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to get AI config: %v", err)
+	}
+	return feature != store.AIFeatureEmbedding || config.EmbeddingModel != "", nil
+}
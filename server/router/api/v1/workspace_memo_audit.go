@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// memoAuditBatchSize is the number of public memos audited at once.
+	memoAuditBatchSize = 100
+	// maxMemoAuditFindings is the maximum number of findings of an audit.
+	maxMemoAuditFindings = 1000
+)
+
+// AuditMemoVisibility reports the public memos with email addresses, phone numbers or secrets in their content, and
+// the public memos of the deactivated users.
+func (s *APIV1Service) AuditMemoVisibility(ctx context.Context, _ *v1pb.AuditMemoVisibilityRequest) (*v1pb.AuditMemoVisibilityResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	archivedStatus := store.Archived
+	deactivatedUsers, err := s.Store.ListUsers(ctx, &store.FindUser{RowStatus: &archivedStatus})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list deactivated users: %v", err)
+	}
+	deactivatedUserIDs := make(map[int32]bool, len(deactivatedUsers))
+	for _, deactivatedUser := range deactivatedUsers {
+		deactivatedUserIDs[deactivatedUser.ID] = true
+	}
+
+	response := &v1pb.AuditMemoVisibilityResponse{}
+	offset := 0
+	for len(response.Findings) < maxMemoAuditFindings {
+		limit := memoAuditBatchSize
+		memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+			VisibilityList: []store.Visibility{store.Public},
+			Limit:          &limit,
+			Offset:         &offset,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list public memos: %v", err)
+		}
+		if len(memos) == 0 {
+			break
+		}
+		offset += len(memos)
+		for _, memo := range memos {
+			response.AuditedCount++
+			reasons := auditMemoContent(memo.Content)
+			if deactivatedUserIDs[memo.CreatorID] {
+				reasons = append(reasons, v1pb.AuditMemoVisibilityResponse_DEACTIVATED_CREATOR)
+			}
+			if len(reasons) == 0 {
+				continue
+			}
+			response.Findings = append(response.Findings, &v1pb.AuditMemoVisibilityResponse_Finding{
+				Memo:    fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+				Creator: fmt.Sprintf("%s%d", UserNamePrefix, memo.CreatorID),
+				Reasons: reasons,
+			})
+			if len(response.Findings) == maxMemoAuditFindings {
+				break
+			}
+		}
+	}
+	return response, nil
+}
+
+// auditMemoContent returns the reasons the content may not be meant to be public, with the patterns of the AI
+// redaction.
+func auditMemoContent(content string) []v1pb.AuditMemoVisibilityResponse_Reason {
+	reasons := []v1pb.AuditMemoVisibilityResponse_Reason{}
+	if emailPattern.MatchString(content) {
+		reasons = append(reasons, v1pb.AuditMemoVisibilityResponse_EMAIL)
+	}
+	if phoneNumberPattern.MatchString(content) {
+		reasons = append(reasons, v1pb.AuditMemoVisibilityResponse_PHONE_NUMBER)
+	}
+	if secretPattern.MatchString(content) || secretAssignmentPattern.MatchString(content) {
+		reasons = append(reasons, v1pb.AuditMemoVisibilityResponse_SECRET)
+	}
+	return reasons
+}
@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+
+	"github.com/pkg/errors"
+)
+
+// workspaceSecretCipher returns the AES-GCM cipher keyed with the workspace secret, the purpose deriving a key per
+// kind of sealed data.
+func (s *APIV1Service) workspaceSecretCipher(purpose string) (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(purpose + ":" + s.Secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// sealWithWorkspaceSecret returns the sealed data, prefixed with its nonce.
+func (s *APIV1Service) sealWithWorkspaceSecret(purpose string, data []byte) ([]byte, error) {
+	gcm, err := s.workspaceSecretCipher(purpose)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate nonce")
+	}
+	return gcm.Seal(nonce, nonce, data, nil), nil
+}
+
+func (s *APIV1Service) openWithWorkspaceSecret(purpose string, data []byte) ([]byte, error) {
+	gcm, err := s.workspaceSecretCipher(purpose)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("encrypted data too short")
+	}
+	return gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+}
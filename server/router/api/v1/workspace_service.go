@@ -3,13 +3,22 @@ package v1
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/usememos/memos/plugin/outbound"
+	"github.com/usememos/memos/plugin/storage/s3"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/maintenance"
 	"github.com/usememos/memos/store"
 )
 
@@ -19,6 +28,7 @@ func (s *APIV1Service) GetWorkspaceProfile(ctx context.Context, _ *v1pb.GetWorks
 		Version:     s.Profile.Version,
 		Mode:        s.Profile.Mode,
 		InstanceUrl: s.Profile.InstanceURL,
+		ReadOnly:    maintenance.IsReadOnly(),
 	}
 	owner, err := s.GetInstanceOwner(ctx)
 	if err != nil {
@@ -47,6 +57,22 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, request *v1pb.Ge
 		_, err = s.Store.GetWorkspaceMemoRelatedSetting(ctx)
 	case storepb.WorkspaceSettingKey_STORAGE:
 		_, err = s.Store.GetWorkspaceStorageSetting(ctx)
+	case storepb.WorkspaceSettingKey_ONBOARDING:
+		_, err = s.Store.GetWorkspaceOnboardingSetting(ctx)
+	case storepb.WorkspaceSettingKey_NEW_USER_LIMIT:
+		_, err = s.Store.GetWorkspaceNewUserLimitSetting(ctx)
+	case storepb.WorkspaceSettingKey_FEATURE_FLAGS:
+		_, err = s.Store.GetWorkspaceFeatureFlagSetting(ctx)
+	case storepb.WorkspaceSettingKey_USAGE_LIMIT:
+		_, err = s.Store.GetWorkspaceUsageLimitSetting(ctx)
+	case storepb.WorkspaceSettingKey_SENSITIVE_CONTENT:
+		_, err = s.Store.GetWorkspaceSensitiveContentSetting(ctx)
+	case storepb.WorkspaceSettingKey_OUTBOUND_FETCH:
+		_, err = s.Store.GetWorkspaceOutboundFetchSetting(ctx)
+	case storepb.WorkspaceSettingKey_LEGAL:
+		_, err = s.Store.GetWorkspaceLegalSetting(ctx)
+	case storepb.WorkspaceSettingKey_ALERTING:
+		_, err = s.Store.GetWorkspaceAlertingSetting(ctx)
 	case storepb.WorkspaceSettingKey_AI_CONFIG:
 		// AI_CONFIG doesn't need default value initialization
 		err = nil
@@ -70,8 +96,8 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, request *v1pb.Ge
 		return nil, status.Errorf(codes.NotFound, "workspace setting not found")
 	}
 
-	// For storage setting, only host can get it.
-	if workspaceSetting.Key == storepb.WorkspaceSettingKey_STORAGE {
+	// For storage and alerting settings, only host can get them.
+	if workspaceSetting.Key == storepb.WorkspaceSettingKey_STORAGE || workspaceSetting.Key == storepb.WorkspaceSettingKey_ALERTING {
 		user, err := s.GetCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -80,8 +106,53 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, request *v1pb.Ge
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 	}
+	// Feature flag definitions may reveal unreleased features, and the allowed hosts the internal network, only admins
+	// can get them.
+	if workspaceSetting.Key == storepb.WorkspaceSettingKey_FEATURE_FLAGS || workspaceSetting.Key == storepb.WorkspaceSettingKey_OUTBOUND_FETCH {
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		}
+		if user == nil || !isSuperUser(user) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
 
-	return convertWorkspaceSettingFromStore(workspaceSetting), nil
+	workspaceSettingMessage := convertWorkspaceSettingFromStore(workspaceSetting)
+	// The classifier configuration is only returned to admins, other users only need the policy.
+	if workspaceSetting.Key == storepb.WorkspaceSettingKey_SENSITIVE_CONTENT {
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		}
+		if user == nil || !isSuperUser(user) {
+			sensitiveContentSetting := workspaceSettingMessage.GetSensitiveContentSetting()
+			sensitiveContentSetting.ClassifierEndpoint = ""
+			sensitiveContentSetting.ClassifierApiKey = ""
+		}
+	}
+	// The API keys of the AI providers are only returned to admins. The AI setting is hidden from the other users when
+	// the AI features are disabled, only the switch remains.
+	if workspaceSetting.Key == storepb.WorkspaceSettingKey_AI_CONFIG {
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		}
+		if user == nil || !isSuperUser(user) {
+			if aiSetting := workspaceSettingMessage.GetAiSetting(); aiSetting != nil {
+				aiSetting.ApiKey = ""
+				for _, profile := range aiSetting.Profiles {
+					profile.ApiKey = ""
+				}
+			}
+			if workspaceSetting.GetAiSetting().GetDisabled() {
+				workspaceSettingMessage.Value = &v1pb.WorkspaceSetting_AiSetting{
+					AiSetting: &v1pb.WorkspaceSetting_AISetting{Disabled: true},
+				}
+			}
+		}
+	}
+	return workspaceSettingMessage, nil
 }
 
 func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb.UpdateWorkspaceSettingRequest) (*v1pb.WorkspaceSetting, error) {
@@ -100,14 +171,406 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 	_ = request.UpdateMask
 
 	updateSetting := convertWorkspaceSettingToStore(request.Setting)
+	if request.Setting.Etag != "" {
+		currentSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{Name: updateSetting.Key.String()})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+		}
+		currentEtag := ""
+		if currentSetting != nil {
+			currentEtag = convertWorkspaceSettingFromStore(currentSetting).Etag
+		}
+		if err := checkEtag(request.Setting.Etag, currentEtag); err != nil {
+			return nil, err
+		}
+	}
+	if updateSetting.Key == storepb.WorkspaceSettingKey_MEMO_RELATED {
+		if err := validateRoleDefaultVisibilities(updateSetting.GetMemoRelatedSetting()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo related setting: %v", err)
+		}
+		if err := validateTagTemplates(updateSetting.GetMemoRelatedSetting()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo related setting: %v", err)
+		}
+		if err := validateShortcodes(updateSetting.GetMemoRelatedSetting().GetShortcodes()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo related setting: %v", err)
+		}
+		if updateSetting.GetMemoRelatedSetting().GetColdStorageAfterDays() < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "cold storage after days must not be negative")
+		}
+		if updateSetting.GetMemoRelatedSetting().GetEnableFuzzySearch() {
+			if err := s.Store.PrepareFuzzySearch(ctx); err != nil {
+				return nil, status.Errorf(codes.FailedPrecondition, "failed to enable fuzzy search: %v", err)
+			}
+		}
+	}
+	var configAuditLog *aiAuditLog
+	if updateSetting.Key == storepb.WorkspaceSettingKey_AI_CONFIG {
+		configAuditLog = newAIConfigAuditLog(user.ID, updateSetting.GetAiSetting())
+		if err := validateAISetting(updateSetting.GetAiSetting()); err != nil {
+			err = status.Errorf(codes.InvalidArgument, "invalid AI setting: %v", err)
+			s.recordAIAuditLog(ctx, configAuditLog, err)
+			return nil, err
+		}
+		if !s.Profile.IsDev() && usesFakeAIProvider(updateSetting.GetAiSetting()) {
+			err = status.Errorf(codes.InvalidArgument, "invalid AI setting: the FAKE provider is not available in the prod mode")
+			s.recordAIAuditLog(ctx, configAuditLog, err)
+			return nil, err
+		}
+	}
+	if updateSetting.Key == storepb.WorkspaceSettingKey_FEATURE_FLAGS {
+		if err := validateFeatureFlagSetting(updateSetting.GetFeatureFlagSetting()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid feature flag setting: %v", err)
+		}
+	}
+	if updateSetting.Key == storepb.WorkspaceSettingKey_USAGE_LIMIT {
+		if err := validateWorkspaceUsageLimitSetting(updateSetting.GetUsageLimitSetting()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid usage limit setting: %v", err)
+		}
+	}
+	if updateSetting.Key == storepb.WorkspaceSettingKey_SENSITIVE_CONTENT {
+		if err := validateWorkspaceSensitiveContentSetting(updateSetting.GetSensitiveContentSetting()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sensitive content setting: %v", err)
+		}
+	}
+	if updateSetting.Key == storepb.WorkspaceSettingKey_OUTBOUND_FETCH {
+		if err := validateWorkspaceOutboundFetchSetting(updateSetting.GetOutboundFetchSetting()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid outbound fetch setting: %v", err)
+		}
+	}
+	if updateSetting.Key == storepb.WorkspaceSettingKey_LEGAL {
+		if err := validateWorkspaceLegalSetting(updateSetting.GetLegalSetting()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid legal setting: %v", err)
+		}
+	}
+	if updateSetting.Key == storepb.WorkspaceSettingKey_ALERTING {
+		if err := validateWorkspaceAlertingSetting(updateSetting.GetAlertingSetting()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid alerting setting: %v", err)
+		}
+	}
 	workspaceSetting, err := s.Store.UpsertWorkspaceSetting(ctx, updateSetting)
+	if configAuditLog != nil {
+		s.recordAIAuditLog(ctx, configAuditLog, err)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert workspace setting: %v", err)
 	}
+	// The health of the providers is tracked anew once they are configured again.
+	if updateSetting.Key == storepb.WorkspaceSettingKey_AI_CONFIG {
+		s.aiMonitor.Reset()
+		s.aiProfileMonitors.Clear()
+		s.aiLimiter.SetLimit(int(updateSetting.GetAiSetting().GetMaxConcurrentRequests()))
+		// The stored prompts are deleted once the debug logging is turned off.
+		if !updateSetting.GetAiSetting().GetDebugLogging() {
+			if _, err := s.Store.DeleteAIDebugLogs(ctx, &store.DeleteAIDebugLog{}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to delete AI debug logs: %v", err)
+			}
+		}
+	}
+	if updateSetting.Key == storepb.WorkspaceSettingKey_OUTBOUND_FETCH {
+		outbound.SetPolicy(convertOutboundPolicyFromStore(updateSetting.GetOutboundFetchSetting()))
+	}
 
 	return convertWorkspaceSettingFromStore(workspaceSetting), nil
 }
 
+// DowngradePublicMemos changes the visibility of every public memo in the workspace.
+func (s *APIV1Service) DowngradePublicMemos(ctx context.Context, request *v1pb.DowngradePublicMemosRequest) (*v1pb.DowngradePublicMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	visibility := store.Protected
+	if request.Visibility != "" {
+		visibility = store.Visibility(request.Visibility)
+	}
+	if visibility != store.Protected && visibility != store.Private {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid visibility: %s", request.Visibility)
+	}
+
+	memoFind := &store.FindMemo{
+		VisibilityList: []store.Visibility{store.Public},
+		ExcludeContent: true,
+	}
+	for _, name := range request.Memos {
+		memoUID, err := ExtractMemoUIDFromName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+		}
+		memoFind.UIDList = append(memoFind.UIDList, memoUID)
+	}
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list public memos: %v", err)
+	}
+	for _, memo := range memos {
+		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:         memo.ID,
+			Visibility: &visibility,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+		}
+	}
+
+	return &v1pb.DowngradePublicMemosResponse{
+		DowngradedCount: int32(len(memos)),
+	}, nil
+}
+
+// backupDirectory is the directory, relative to the data directory or the S3 bucket, that holds database backups.
+const backupDirectory = "backups"
+
+// BackupDatabase creates a consistent online backup of the database and stores it in the configured storage.
+func (s *APIV1Service) BackupDatabase(ctx context.Context, request *v1pb.BackupDatabaseRequest) (*v1pb.BackupDatabaseResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if err := validateArchiveEncryption(request.Encryption, request.Passphrase); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid encryption: %v", err)
+	}
+
+	workspaceStorageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace storage setting: %v", err)
+	}
+
+	now := time.Now()
+	filename := fmt.Sprintf("memos_%s_%s.db", s.Profile.Mode, now.UTC().Format("20060102150405")) + archiveEncryptionExtension(request.Encryption)
+	location := filepath.ToSlash(filepath.Join(backupDirectory, filename))
+	var size int64
+	switch workspaceStorageSetting.StorageType {
+	case storepb.WorkspaceStorageSetting_LOCAL:
+		dir := filepath.Join(s.Profile.Data, backupDirectory)
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create backup directory: %v", err)
+		}
+		size, err = s.backupDatabaseToFile(ctx, filepath.Join(dir, filename), request.Encryption, request.Passphrase)
+		if err != nil {
+			return nil, err
+		}
+	case storepb.WorkspaceStorageSetting_S3:
+		if workspaceStorageSetting.S3Config == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "s3 storage is not configured")
+		}
+		s3Client, err := s3.NewClient(ctx, workspaceStorageSetting.S3Config)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create s3 client: %v", err)
+		}
+		tempDir, err := os.MkdirTemp("", "memos-backup-")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create temporary directory: %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		backupPath := filepath.Join(tempDir, filename)
+		size, err = s.backupDatabaseToFile(ctx, backupPath, request.Encryption, request.Passphrase)
+		if err != nil {
+			return nil, err
+		}
+		file, err := os.Open(backupPath)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to open backup: %v", err)
+		}
+		defer file.Close()
+		contentType := "application/vnd.sqlite3"
+		switch request.Encryption {
+		case v1pb.ArchiveEncryption_ZIP_AES:
+			contentType = "application/zip"
+		case v1pb.ArchiveEncryption_AGE:
+			contentType = "application/octet-stream"
+		}
+		location, err = s3Client.UploadObject(ctx, location, contentType, file)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to upload backup: %v", err)
+		}
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "database backups require local or s3 storage")
+	}
+
+	return &v1pb.BackupDatabaseResponse{
+		Location:   location,
+		SizeBytes:  size,
+		CreateTime: timestamppb.New(now),
+	}, nil
+}
+
+// backupDatabaseToFile writes an online backup of the database to path, encrypted with the passphrase unless the
+// encryption is unspecified, and returns its size.
+func (s *APIV1Service) backupDatabaseToFile(ctx context.Context, path string, encryption v1pb.ArchiveEncryption, passphrase string) (int64, error) {
+	if encryption == v1pb.ArchiveEncryption_ARCHIVE_ENCRYPTION_UNSPECIFIED {
+		return s.writeDatabaseBackup(ctx, path)
+	}
+	// The plain backup is written to a temporary directory rather than next to the encrypted one.
+	tempDir, err := os.MkdirTemp("", "memos-backup-")
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+	plainPath := filepath.Join(tempDir, strings.TrimSuffix(filepath.Base(path), archiveEncryptionExtension(encryption)))
+	if _, err := s.writeDatabaseBackup(ctx, plainPath); err != nil {
+		return 0, err
+	}
+	if err := encryptArchiveFile(plainPath, path, encryption, passphrase); err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to encrypt backup: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to stat backup: %v", err)
+	}
+	return info.Size(), nil
+}
+
+// writeDatabaseBackup writes an online backup of the database to path and returns its size.
+func (s *APIV1Service) writeDatabaseBackup(ctx context.Context, path string) (int64, error) {
+	if err := s.Store.BackupDatabase(ctx, path); err != nil {
+		if errors.Is(err, store.ErrBackupNotSupported) {
+			return 0, status.Errorf(codes.FailedPrecondition, "database driver %q does not support online backups", s.Profile.Driver)
+		}
+		return 0, status.Errorf(codes.Internal, "failed to backup database: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to stat backup: %v", err)
+	}
+	return info.Size(), nil
+}
+
+// usesFakeAIProvider reports whether the AI setting or one of its profiles uses the test-mode FAKE provider.
+func usesFakeAIProvider(setting *storepb.WorkspaceAISetting) bool {
+	if setting.GetProvider() == storepb.WorkspaceAISetting_FAKE {
+		return true
+	}
+	return slices.ContainsFunc(setting.GetProfiles(), func(profile *storepb.WorkspaceAISetting_Profile) bool {
+		return profile.GetProvider() == storepb.WorkspaceAISetting_FAKE
+	})
+}
+
+// validateAISetting checks the roles of the AI role permissions, the token prices, the debug log retention, the summary
+// chunking and the routing of the features to the AI profiles.
+func validateAISetting(setting *storepb.WorkspaceAISetting) error {
+	if setting.GetPromptTokenPrice() < 0 || setting.GetCompletionTokenPrice() < 0 {
+		return errors.New("token prices must not be negative")
+	}
+	for role, permission := range setting.GetRolePermissions() {
+		switch store.Role(role) {
+		case store.RoleHost, store.RoleAdmin, store.RoleUser:
+		default:
+			return errors.Errorf("unknown role %q", role)
+		}
+		if permission.GetDailyRequestLimit() < 0 {
+			return errors.Errorf("daily request limit of role %q must not be negative", role)
+		}
+		if permission.GetMonthlyTokenBudget() < 0 {
+			return errors.Errorf("monthly token budget of role %q must not be negative", role)
+		}
+	}
+	if setting.GetDebugLogRetentionDays() < 0 || setting.GetDebugLogRetentionDays() > maxAIDebugLogRetentionDays {
+		return errors.Errorf("debug log retention must be between 0 and %d days", maxAIDebugLogRetentionDays)
+	}
+	if setting.GetSummaryChunkSize() != 0 && setting.GetSummaryChunkSize() < minAISummaryChunkSize {
+		return errors.Errorf("summary chunk size must be at least %d characters", minAISummaryChunkSize)
+	}
+	if setting.GetSummaryMaxChunks() < 0 || setting.GetSummaryMaxChunks() > maxAISummaryChunks {
+		return errors.Errorf("summary max chunks must be between 0 and %d", maxAISummaryChunks)
+	}
+	if setting.Temperature != nil && (setting.GetTemperature() < 0 || setting.GetTemperature() > maxAITemperature) {
+		return errors.Errorf("temperature must be between 0 and %v", maxAITemperature)
+	}
+	if setting.GetMaxTokens() < 0 {
+		return errors.New("max tokens must not be negative")
+	}
+	if setting.GetWorkspaceSummaryDailyLimit() < 0 {
+		return errors.New("workspace summary daily limit must not be negative")
+	}
+	for model, window := range setting.GetContextWindows() {
+		if model == "" {
+			return errors.New("context window model name is required")
+		}
+		if window < minAIContextWindow {
+			return errors.Errorf("context window of model %q must be at least %d tokens", model, minAIContextWindow)
+		}
+	}
+	if setting.GetSummaryCacheTtlMinutes() < 0 {
+		return errors.New("summary cache TTL must not be negative")
+	}
+	if setting.GetCircuitBreakerFailures() < 0 || setting.GetCircuitBreakerCooldownSeconds() < 0 {
+		return errors.New("circuit breaker failures and cooldown must not be negative")
+	}
+	if setting.GetMaxConcurrentRequests() < 0 {
+		return errors.New("max concurrent requests must not be negative")
+	}
+	if setting.GetSummaryMinLength() < 0 || setting.GetSummaryMaxLength() < 0 {
+		return errors.New("summary length bounds must not be negative")
+	}
+	if setting.GetSummaryMaxLength() != 0 && setting.GetSummaryMaxLength() < setting.GetSummaryMinLength() {
+		return errors.New("summary max length must not be less than the min length")
+	}
+	profileNames := map[string]bool{}
+	for _, profile := range setting.GetProfiles() {
+		if profile.GetName() == "" {
+			return errors.New("AI profile name is required")
+		}
+		if profileNames[profile.GetName()] {
+			return errors.Errorf("duplicate AI profile %q", profile.GetName())
+		}
+		profileNames[profile.GetName()] = true
+	}
+	for feature, profile := range setting.GetFeatureProfiles() {
+		if !slices.Contains(store.AIFeatures, feature) {
+			return errors.Errorf("unknown AI feature %q", feature)
+		}
+		if profile != "" && !profileNames[profile] {
+			return errors.Errorf("AI feature %q is routed to unknown profile %q", feature, profile)
+		}
+	}
+	if _, err := newAIRedactor(setting.GetRedaction()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateRoleDefaultVisibilities checks the role default visibilities and the protected visibility roles of the
+// memo related setting.
+func validateRoleDefaultVisibilities(setting *storepb.WorkspaceMemoRelatedSetting) error {
+	for role, visibility := range setting.GetRoleDefaultVisibilities() {
+		switch store.Role(role) {
+		case store.RoleHost, store.RoleAdmin, store.RoleUser:
+		default:
+			return errors.Errorf("unknown role %q", role)
+		}
+		switch store.Visibility(visibility) {
+		case store.Private, store.Protected:
+		case store.Public:
+			if setting.DisallowPublicVisibility {
+				return errors.Errorf("default visibility of role %q cannot be public while public visibility is disallowed", role)
+			}
+		default:
+			return errors.Errorf("unknown visibility %q for role %q", visibility, role)
+		}
+	}
+	for _, role := range setting.GetProtectedVisibilityRoles() {
+		switch store.Role(role) {
+		case store.RoleHost, store.RoleAdmin, store.RoleUser:
+		default:
+			return errors.Errorf("unknown protected visibility role %q", role)
+		}
+	}
+	return nil
+}
+
 func convertWorkspaceSettingFromStore(setting *storepb.WorkspaceSetting) *v1pb.WorkspaceSetting {
 	workspaceSetting := &v1pb.WorkspaceSetting{
 		Name: fmt.Sprintf("workspace/settings/%s", setting.Key.String()),
@@ -129,7 +592,40 @@ func convertWorkspaceSettingFromStore(setting *storepb.WorkspaceSetting) *v1pb.W
 		workspaceSetting.Value = &v1pb.WorkspaceSetting_AiSetting{
 			AiSetting: convertWorkspaceAISettingFromStore(setting.GetAiSetting()),
 		}
+	case *storepb.WorkspaceSetting_OnboardingSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_OnboardingSetting_{
+			OnboardingSetting: convertWorkspaceOnboardingSettingFromStore(setting.GetOnboardingSetting()),
+		}
+	case *storepb.WorkspaceSetting_NewUserLimitSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_NewUserLimitSetting_{
+			NewUserLimitSetting: convertWorkspaceNewUserLimitSettingFromStore(setting.GetNewUserLimitSetting()),
+		}
+	case *storepb.WorkspaceSetting_FeatureFlagSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_FeatureFlagSetting_{
+			FeatureFlagSetting: convertWorkspaceFeatureFlagSettingFromStore(setting.GetFeatureFlagSetting()),
+		}
+	case *storepb.WorkspaceSetting_UsageLimitSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_UsageLimitSetting_{
+			UsageLimitSetting: convertWorkspaceUsageLimitSettingFromStore(setting.GetUsageLimitSetting()),
+		}
+	case *storepb.WorkspaceSetting_SensitiveContentSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_SensitiveContentSetting_{
+			SensitiveContentSetting: convertWorkspaceSensitiveContentSettingFromStore(setting.GetSensitiveContentSetting()),
+		}
+	case *storepb.WorkspaceSetting_OutboundFetchSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_OutboundFetchSetting_{
+			OutboundFetchSetting: convertWorkspaceOutboundFetchSettingFromStore(setting.GetOutboundFetchSetting()),
+		}
+	case *storepb.WorkspaceSetting_LegalSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_LegalSetting_{
+			LegalSetting: convertWorkspaceLegalSettingFromStore(setting.GetLegalSetting()),
+		}
+	case *storepb.WorkspaceSetting_AlertingSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_AlertingSetting_{
+			AlertingSetting: convertWorkspaceAlertingSettingFromStore(setting.GetAlertingSetting()),
+		}
 	}
+	workspaceSetting.Etag = computeEtag(workspaceSetting)
 	return workspaceSetting
 }
 
@@ -158,6 +654,38 @@ func convertWorkspaceSettingToStore(setting *v1pb.WorkspaceSetting) *storepb.Wor
 		workspaceSetting.Value = &storepb.WorkspaceSetting_AiSetting{
 			AiSetting: convertWorkspaceAISettingToStore(setting.GetAiSetting()),
 		}
+	case storepb.WorkspaceSettingKey_ONBOARDING:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_OnboardingSetting{
+			OnboardingSetting: convertWorkspaceOnboardingSettingToStore(setting.GetOnboardingSetting()),
+		}
+	case storepb.WorkspaceSettingKey_NEW_USER_LIMIT:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_NewUserLimitSetting{
+			NewUserLimitSetting: convertWorkspaceNewUserLimitSettingToStore(setting.GetNewUserLimitSetting()),
+		}
+	case storepb.WorkspaceSettingKey_FEATURE_FLAGS:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_FeatureFlagSetting{
+			FeatureFlagSetting: convertWorkspaceFeatureFlagSettingToStore(setting.GetFeatureFlagSetting()),
+		}
+	case storepb.WorkspaceSettingKey_USAGE_LIMIT:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_UsageLimitSetting{
+			UsageLimitSetting: convertWorkspaceUsageLimitSettingToStore(setting.GetUsageLimitSetting()),
+		}
+	case storepb.WorkspaceSettingKey_SENSITIVE_CONTENT:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_SensitiveContentSetting{
+			SensitiveContentSetting: convertWorkspaceSensitiveContentSettingToStore(setting.GetSensitiveContentSetting()),
+		}
+	case storepb.WorkspaceSettingKey_OUTBOUND_FETCH:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_OutboundFetchSetting{
+			OutboundFetchSetting: convertWorkspaceOutboundFetchSettingToStore(setting.GetOutboundFetchSetting()),
+		}
+	case storepb.WorkspaceSettingKey_LEGAL:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_LegalSetting{
+			LegalSetting: convertWorkspaceLegalSettingToStore(setting.GetLegalSetting()),
+		}
+	case storepb.WorkspaceSettingKey_ALERTING:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_AlertingSetting{
+			AlertingSetting: convertWorkspaceAlertingSettingToStore(setting.GetAlertingSetting()),
+		}
 	default:
 		// Keep the default GeneralSetting value
 	}
@@ -278,6 +806,15 @@ func convertWorkspaceMemoRelatedSettingFromStore(setting *storepb.WorkspaceMemoR
 		DisableMarkdownShortcuts: setting.DisableMarkdownShortcuts,
 		EnableBlurNsfwContent:    setting.EnableBlurNsfwContent,
 		NsfwTags:                 setting.NsfwTags,
+		RoleDefaultVisibilities:  setting.RoleDefaultVisibilities,
+		ColdStorageAfterDays:     setting.ColdStorageAfterDays,
+		DisableViewTracking:      setting.DisableViewTracking,
+		ProtectedVisibilityRoles: setting.ProtectedVisibilityRoles,
+		EnableFuzzySearch:        setting.EnableFuzzySearch,
+		ApprovalRequiredTags:     setting.ApprovalRequiredTags,
+		TagTemplates:             convertWorkspaceTagTemplatesFromStore(setting.TagTemplates),
+		ExpandShortcodes:         setting.ExpandShortcodes,
+		Shortcodes:               setting.Shortcodes,
 	}
 }
 
@@ -295,18 +832,146 @@ func convertWorkspaceMemoRelatedSettingToStore(setting *v1pb.WorkspaceSetting_Me
 		DisableMarkdownShortcuts: setting.DisableMarkdownShortcuts,
 		EnableBlurNsfwContent:    setting.EnableBlurNsfwContent,
 		NsfwTags:                 setting.NsfwTags,
+		RoleDefaultVisibilities:  setting.RoleDefaultVisibilities,
+		ColdStorageAfterDays:     setting.ColdStorageAfterDays,
+		DisableViewTracking:      setting.DisableViewTracking,
+		ProtectedVisibilityRoles: setting.ProtectedVisibilityRoles,
+		EnableFuzzySearch:        setting.EnableFuzzySearch,
+		ApprovalRequiredTags:     setting.ApprovalRequiredTags,
+		TagTemplates:             convertWorkspaceTagTemplatesToStore(setting.TagTemplates),
+		ExpandShortcodes:         setting.ExpandShortcodes,
+		Shortcodes:               setting.Shortcodes,
 	}
 }
 
+func convertWorkspaceTagTemplatesFromStore(tagTemplates []*storepb.WorkspaceMemoRelatedSetting_TagTemplate) []*v1pb.WorkspaceSetting_MemoRelatedSetting_TagTemplate {
+	result := make([]*v1pb.WorkspaceSetting_MemoRelatedSetting_TagTemplate, 0, len(tagTemplates))
+	for _, tagTemplate := range tagTemplates {
+		result = append(result, &v1pb.WorkspaceSetting_MemoRelatedSetting_TagTemplate{
+			Tag:                tagTemplate.Tag,
+			Template:           tagTemplate.Template,
+			RequiredProperties: tagTemplate.RequiredProperties,
+		})
+	}
+	return result
+}
+
+func convertWorkspaceTagTemplatesToStore(tagTemplates []*v1pb.WorkspaceSetting_MemoRelatedSetting_TagTemplate) []*storepb.WorkspaceMemoRelatedSetting_TagTemplate {
+	result := make([]*storepb.WorkspaceMemoRelatedSetting_TagTemplate, 0, len(tagTemplates))
+	for _, tagTemplate := range tagTemplates {
+		result = append(result, &storepb.WorkspaceMemoRelatedSetting_TagTemplate{
+			Tag:                tagTemplate.Tag,
+			Template:           tagTemplate.Template,
+			RequiredProperties: tagTemplate.RequiredProperties,
+		})
+	}
+	return result
+}
+
 func convertWorkspaceAISettingFromStore(setting *storepb.WorkspaceAISetting) *v1pb.WorkspaceSetting_AISetting {
 	if setting == nil {
 		return nil
 	}
+	rolePermissions := make(map[string]*v1pb.WorkspaceSetting_AISetting_RolePermission, len(setting.RolePermissions))
+	for role, permission := range setting.RolePermissions {
+		rolePermissions[role] = &v1pb.WorkspaceSetting_AISetting_RolePermission{
+			DisableSummary:       permission.GetDisableSummary(),
+			DisableSpeech:        permission.GetDisableSpeech(),
+			DisableVoiceMemo:     permission.GetDisableVoiceMemo(),
+			DisableChat:          permission.GetDisableChat(),
+			DisableTagSuggestion: permission.GetDisableTagSuggestion(),
+			DisableTransform:     permission.GetDisableTransform(),
+			HourlyRequestLimit:   permission.GetHourlyRequestLimit(),
+			DailyRequestLimit:    permission.GetDailyRequestLimit(),
+			MonthlyTokenBudget:   permission.GetMonthlyTokenBudget(),
+		}
+	}
 	return &v1pb.WorkspaceSetting_AISetting{
-		Endpoint:     setting.Endpoint,
-		ApiKey:       setting.ApiKey,
-		Model:        setting.Model,
-		SystemPrompt: setting.SystemPrompt,
+		Endpoint:                      setting.Endpoint,
+		ApiKey:                        setting.ApiKey,
+		Model:                         setting.Model,
+		SystemPrompt:                  setting.SystemPrompt,
+		TtsModel:                      setting.TtsModel,
+		TtsVoice:                      setting.TtsVoice,
+		TtsEndpoint:                   setting.TtsEndpoint,
+		TranscriptionModel:            setting.TranscriptionModel,
+		RolePermissions:               rolePermissions,
+		DisallowProtectedMemos:        setting.DisallowProtectedMemos,
+		PromptTokenPrice:              setting.PromptTokenPrice,
+		CompletionTokenPrice:          setting.CompletionTokenPrice,
+		Provider:                      v1pb.WorkspaceSetting_AISetting_Provider(v1pb.WorkspaceSetting_AISetting_Provider_value[setting.Provider.String()]),
+		ApiVersion:                    setting.ApiVersion,
+		Redaction:                     convertWorkspaceAIRedactionFromStore(setting.Redaction),
+		EmbeddingModel:                setting.EmbeddingModel,
+		AutoTag:                       setting.AutoTag,
+		Profiles:                      convertWorkspaceAIProfilesFromStore(setting.Profiles),
+		FeatureProfiles:               setting.FeatureProfiles,
+		DebugLogging:                  setting.DebugLogging,
+		DebugLogRetentionDays:         setting.DebugLogRetentionDays,
+		SummaryChunkSize:              setting.SummaryChunkSize,
+		SummaryMaxChunks:              setting.SummaryMaxChunks,
+		AttachmentExtraction:          convertWorkspaceAIAttachmentExtractionFromStore(setting.AttachmentExtraction),
+		SummaryModel:                  setting.SummaryModel,
+		ChatModel:                     setting.ChatModel,
+		VisionModel:                   setting.VisionModel,
+		Temperature:                   setting.Temperature,
+		MaxTokens:                     setting.MaxTokens,
+		WorkspaceSummaryDailyLimit:    setting.WorkspaceSummaryDailyLimit,
+		ContextWindows:                setting.ContextWindows,
+		SummaryMinLength:              setting.SummaryMinLength,
+		SummaryMaxLength:              setting.SummaryMaxLength,
+		SummaryCacheTtlMinutes:        setting.SummaryCacheTtlMinutes,
+		CircuitBreakerFailures:        setting.CircuitBreakerFailures,
+		CircuitBreakerCooldownSeconds: setting.CircuitBreakerCooldownSeconds,
+		MaxConcurrentRequests:         setting.MaxConcurrentRequests,
+		Disabled:                      setting.Disabled,
+		DataProcessingDisclosure:      setting.DataProcessingDisclosure,
+	}
+}
+
+func convertWorkspaceAIProfilesFromStore(profiles []*storepb.WorkspaceAISetting_Profile) []*v1pb.WorkspaceSetting_AISetting_Profile {
+	list := make([]*v1pb.WorkspaceSetting_AISetting_Profile, 0, len(profiles))
+	for _, profile := range profiles {
+		list = append(list, &v1pb.WorkspaceSetting_AISetting_Profile{
+			Name:               profile.Name,
+			Provider:           v1pb.WorkspaceSetting_AISetting_Provider(v1pb.WorkspaceSetting_AISetting_Provider_value[profile.Provider.String()]),
+			Endpoint:           profile.Endpoint,
+			ApiKey:             profile.ApiKey,
+			ApiVersion:         profile.ApiVersion,
+			Model:              profile.Model,
+			EmbeddingModel:     profile.EmbeddingModel,
+			TranscriptionModel: profile.TranscriptionModel,
+			SummaryModel:       profile.SummaryModel,
+			ChatModel:          profile.ChatModel,
+			VisionModel:        profile.VisionModel,
+		})
+	}
+	return list
+}
+
+func convertWorkspaceAIRedactionFromStore(redaction *storepb.WorkspaceAISetting_Redaction) *v1pb.WorkspaceSetting_AISetting_Redaction {
+	if redaction == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceSetting_AISetting_Redaction{
+		RedactEmails:        redaction.RedactEmails,
+		RedactPhoneNumbers:  redaction.RedactPhoneNumbers,
+		Patterns:            redaction.Patterns,
+		Tags:                redaction.Tags,
+		RedactSecrets:       redaction.RedactSecrets,
+		RestorePlaceholders: redaction.RestorePlaceholders,
+	}
+}
+
+func convertWorkspaceAIAttachmentExtractionFromStore(extraction *storepb.WorkspaceAISetting_AttachmentExtraction) *v1pb.WorkspaceSetting_AISetting_AttachmentExtraction {
+	if extraction == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceSetting_AISetting_AttachmentExtraction{
+		Images:             extraction.Images,
+		Audio:              extraction.Audio,
+		IncludeInSummaries: extraction.IncludeInSummaries,
+		IncludeInSearch:    extraction.IncludeInSearch,
 	}
 }
 
@@ -314,12 +979,333 @@ func convertWorkspaceAISettingToStore(setting *v1pb.WorkspaceSetting_AISetting)
 	if setting == nil {
 		return nil
 	}
+	rolePermissions := make(map[string]*storepb.WorkspaceAISetting_RolePermission, len(setting.RolePermissions))
+	for role, permission := range setting.RolePermissions {
+		rolePermissions[role] = &storepb.WorkspaceAISetting_RolePermission{
+			DisableSummary:       permission.GetDisableSummary(),
+			DisableSpeech:        permission.GetDisableSpeech(),
+			DisableVoiceMemo:     permission.GetDisableVoiceMemo(),
+			DisableChat:          permission.GetDisableChat(),
+			DisableTagSuggestion: permission.GetDisableTagSuggestion(),
+			DisableTransform:     permission.GetDisableTransform(),
+			HourlyRequestLimit:   permission.GetHourlyRequestLimit(),
+			DailyRequestLimit:    permission.GetDailyRequestLimit(),
+			MonthlyTokenBudget:   permission.GetMonthlyTokenBudget(),
+		}
+	}
 	return &storepb.WorkspaceAISetting{
-		Endpoint:     setting.Endpoint,
-		ApiKey:       setting.ApiKey,
-		Model:        setting.Model,
-		SystemPrompt: setting.SystemPrompt,
+		Endpoint:                      setting.Endpoint,
+		ApiKey:                        setting.ApiKey,
+		Model:                         setting.Model,
+		SystemPrompt:                  setting.SystemPrompt,
+		TtsModel:                      setting.TtsModel,
+		TtsVoice:                      setting.TtsVoice,
+		TtsEndpoint:                   setting.TtsEndpoint,
+		TranscriptionModel:            setting.TranscriptionModel,
+		RolePermissions:               rolePermissions,
+		DisallowProtectedMemos:        setting.DisallowProtectedMemos,
+		PromptTokenPrice:              setting.PromptTokenPrice,
+		CompletionTokenPrice:          setting.CompletionTokenPrice,
+		Provider:                      storepb.WorkspaceAISetting_Provider(storepb.WorkspaceAISetting_Provider_value[setting.Provider.String()]),
+		ApiVersion:                    setting.ApiVersion,
+		Redaction:                     convertWorkspaceAIRedactionToStore(setting.Redaction),
+		EmbeddingModel:                setting.EmbeddingModel,
+		AutoTag:                       setting.AutoTag,
+		Profiles:                      convertWorkspaceAIProfilesToStore(setting.Profiles),
+		FeatureProfiles:               setting.FeatureProfiles,
+		DebugLogging:                  setting.DebugLogging,
+		DebugLogRetentionDays:         setting.DebugLogRetentionDays,
+		SummaryChunkSize:              setting.SummaryChunkSize,
+		SummaryMaxChunks:              setting.SummaryMaxChunks,
+		AttachmentExtraction:          convertWorkspaceAIAttachmentExtractionToStore(setting.AttachmentExtraction),
+		SummaryModel:                  setting.SummaryModel,
+		ChatModel:                     setting.ChatModel,
+		VisionModel:                   setting.VisionModel,
+		Temperature:                   setting.Temperature,
+		MaxTokens:                     setting.MaxTokens,
+		WorkspaceSummaryDailyLimit:    setting.WorkspaceSummaryDailyLimit,
+		ContextWindows:                setting.ContextWindows,
+		SummaryMinLength:              setting.SummaryMinLength,
+		SummaryMaxLength:              setting.SummaryMaxLength,
+		SummaryCacheTtlMinutes:        setting.SummaryCacheTtlMinutes,
+		CircuitBreakerFailures:        setting.CircuitBreakerFailures,
+		CircuitBreakerCooldownSeconds: setting.CircuitBreakerCooldownSeconds,
+		MaxConcurrentRequests:         setting.MaxConcurrentRequests,
+		Disabled:                      setting.Disabled,
+		DataProcessingDisclosure:      setting.DataProcessingDisclosure,
+	}
+}
+
+func convertWorkspaceAIProfilesToStore(profiles []*v1pb.WorkspaceSetting_AISetting_Profile) []*storepb.WorkspaceAISetting_Profile {
+	list := make([]*storepb.WorkspaceAISetting_Profile, 0, len(profiles))
+	for _, profile := range profiles {
+		list = append(list, &storepb.WorkspaceAISetting_Profile{
+			Name:               profile.Name,
+			Provider:           storepb.WorkspaceAISetting_Provider(storepb.WorkspaceAISetting_Provider_value[profile.Provider.String()]),
+			Endpoint:           profile.Endpoint,
+			ApiKey:             profile.ApiKey,
+			ApiVersion:         profile.ApiVersion,
+			Model:              profile.Model,
+			EmbeddingModel:     profile.EmbeddingModel,
+			TranscriptionModel: profile.TranscriptionModel,
+			SummaryModel:       profile.SummaryModel,
+			ChatModel:          profile.ChatModel,
+			VisionModel:        profile.VisionModel,
+		})
+	}
+	return list
+}
+
+func convertWorkspaceAIRedactionToStore(redaction *v1pb.WorkspaceSetting_AISetting_Redaction) *storepb.WorkspaceAISetting_Redaction {
+	if redaction == nil {
+		return nil
+	}
+	return &storepb.WorkspaceAISetting_Redaction{
+		RedactEmails:        redaction.RedactEmails,
+		RedactPhoneNumbers:  redaction.RedactPhoneNumbers,
+		Patterns:            redaction.Patterns,
+		Tags:                redaction.Tags,
+		RedactSecrets:       redaction.RedactSecrets,
+		RestorePlaceholders: redaction.RestorePlaceholders,
+	}
+}
+
+func convertWorkspaceAIAttachmentExtractionToStore(extraction *v1pb.WorkspaceSetting_AISetting_AttachmentExtraction) *storepb.WorkspaceAISetting_AttachmentExtraction {
+	if extraction == nil {
+		return nil
+	}
+	return &storepb.WorkspaceAISetting_AttachmentExtraction{
+		Images:             extraction.Images,
+		Audio:              extraction.Audio,
+		IncludeInSummaries: extraction.IncludeInSummaries,
+		IncludeInSearch:    extraction.IncludeInSearch,
+	}
+}
+
+func convertWorkspaceOnboardingSettingFromStore(setting *storepb.WorkspaceOnboardingSetting) *v1pb.WorkspaceSetting_OnboardingSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceSetting_OnboardingSetting{
+		WelcomeMemoContent: setting.WelcomeMemoContent,
+		TemplateMemos:      setting.TemplateMemos,
+		DefaultTags:        setting.DefaultTags,
+	}
+}
+
+func convertWorkspaceOnboardingSettingToStore(setting *v1pb.WorkspaceSetting_OnboardingSetting) *storepb.WorkspaceOnboardingSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceOnboardingSetting{
+		WelcomeMemoContent: setting.WelcomeMemoContent,
+		TemplateMemos:      setting.TemplateMemos,
+		DefaultTags:        setting.DefaultTags,
+	}
+}
+
+func convertWorkspaceNewUserLimitSettingFromStore(setting *storepb.WorkspaceNewUserLimitSetting) *v1pb.WorkspaceSetting_NewUserLimitSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceSetting_NewUserLimitSetting{
+		ProbationDays:       setting.ProbationDays,
+		MemosPerDay:         setting.MemosPerDay,
+		AttachmentsPerDay:   setting.AttachmentsPerDay,
+		DisallowPublicMemos: setting.DisallowPublicMemos,
+	}
+}
+
+func convertWorkspaceNewUserLimitSettingToStore(setting *v1pb.WorkspaceSetting_NewUserLimitSetting) *storepb.WorkspaceNewUserLimitSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceNewUserLimitSetting{
+		ProbationDays:       setting.ProbationDays,
+		MemosPerDay:         setting.MemosPerDay,
+		AttachmentsPerDay:   setting.AttachmentsPerDay,
+		DisallowPublicMemos: setting.DisallowPublicMemos,
+	}
+}
+
+func convertWorkspaceFeatureFlagSettingFromStore(setting *storepb.WorkspaceFeatureFlagSetting) *v1pb.WorkspaceSetting_FeatureFlagSetting {
+	if setting == nil {
+		return nil
+	}
+	flags := make([]*v1pb.WorkspaceSetting_FeatureFlag, 0, len(setting.Flags))
+	for _, flag := range setting.Flags {
+		flags = append(flags, &v1pb.WorkspaceSetting_FeatureFlag{
+			Name:              flag.Name,
+			Enabled:           flag.Enabled,
+			RolloutPercentage: flag.RolloutPercentage,
+		})
+	}
+	return &v1pb.WorkspaceSetting_FeatureFlagSetting{
+		Flags: flags,
+	}
+}
+
+func convertWorkspaceFeatureFlagSettingToStore(setting *v1pb.WorkspaceSetting_FeatureFlagSetting) *storepb.WorkspaceFeatureFlagSetting {
+	if setting == nil {
+		return nil
+	}
+	flags := make([]*storepb.FeatureFlag, 0, len(setting.Flags))
+	for _, flag := range setting.Flags {
+		flags = append(flags, &storepb.FeatureFlag{
+			Name:              flag.Name,
+			Enabled:           flag.Enabled,
+			RolloutPercentage: flag.RolloutPercentage,
+		})
+	}
+	return &storepb.WorkspaceFeatureFlagSetting{
+		Flags: flags,
+	}
+}
+
+func convertWorkspaceUsageLimitSettingFromStore(setting *storepb.WorkspaceUsageLimitSetting) *v1pb.WorkspaceSetting_UsageLimitSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceSetting_UsageLimitSetting{
+		MaxUsers:           setting.MaxUsers,
+		MaxMemos:           setting.MaxMemos,
+		MaxStorageBytes:    setting.MaxStorageBytes,
+		MaxAiMonthlyTokens: setting.MaxAiMonthlyTokens,
+	}
+}
+
+func convertWorkspaceUsageLimitSettingToStore(setting *v1pb.WorkspaceSetting_UsageLimitSetting) *storepb.WorkspaceUsageLimitSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceUsageLimitSetting{
+		MaxUsers:           setting.MaxUsers,
+		MaxMemos:           setting.MaxMemos,
+		MaxStorageBytes:    setting.MaxStorageBytes,
+		MaxAiMonthlyTokens: setting.MaxAiMonthlyTokens,
+	}
+}
+
+func convertWorkspaceSensitiveContentSettingFromStore(setting *storepb.WorkspaceSensitiveContentSetting) *v1pb.WorkspaceSetting_SensitiveContentSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceSetting_SensitiveContentSetting{
+		ClassifierEndpoint: setting.ClassifierEndpoint,
+		ClassifierApiKey:   setting.ClassifierApiKey,
+		Policy:             v1pb.WorkspaceSetting_SensitiveContentSetting_Policy(setting.Policy),
+	}
+}
+
+func convertWorkspaceSensitiveContentSettingToStore(setting *v1pb.WorkspaceSetting_SensitiveContentSetting) *storepb.WorkspaceSensitiveContentSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceSensitiveContentSetting{
+		ClassifierEndpoint: setting.ClassifierEndpoint,
+		ClassifierApiKey:   setting.ClassifierApiKey,
+		Policy:             storepb.SensitiveContentPolicy(setting.Policy),
+	}
+}
+
+func convertWorkspaceOutboundFetchSettingFromStore(setting *storepb.WorkspaceOutboundFetchSetting) *v1pb.WorkspaceSetting_OutboundFetchSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceSetting_OutboundFetchSetting{
+		AllowedHosts:     setting.AllowedHosts,
+		AllowedPorts:     setting.AllowedPorts,
+		MaxResponseBytes: setting.MaxResponseBytes,
+		TimeoutSeconds:   setting.TimeoutSeconds,
+	}
+}
+
+func convertWorkspaceOutboundFetchSettingToStore(setting *v1pb.WorkspaceSetting_OutboundFetchSetting) *storepb.WorkspaceOutboundFetchSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceOutboundFetchSetting{
+		AllowedHosts:     setting.AllowedHosts,
+		AllowedPorts:     setting.AllowedPorts,
+		MaxResponseBytes: setting.MaxResponseBytes,
+		TimeoutSeconds:   setting.TimeoutSeconds,
+	}
+}
+
+func convertWorkspaceLegalSettingFromStore(setting *storepb.WorkspaceLegalSetting) *v1pb.WorkspaceSetting_LegalSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceSetting_LegalSetting{
+		TermsOfService: setting.TermsOfService,
+		PrivacyPolicy:  setting.PrivacyPolicy,
+		Version:        setting.Version,
+	}
+}
+
+func convertWorkspaceLegalSettingToStore(setting *v1pb.WorkspaceSetting_LegalSetting) *storepb.WorkspaceLegalSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceLegalSetting{
+		TermsOfService: setting.TermsOfService,
+		PrivacyPolicy:  setting.PrivacyPolicy,
+		Version:        setting.Version,
+	}
+}
+
+func convertWorkspaceAlertingSettingFromStore(setting *storepb.WorkspaceAlertingSetting) *v1pb.WorkspaceSetting_AlertingSetting {
+	if setting == nil {
+		return nil
+	}
+	alertingSetting := &v1pb.WorkspaceSetting_AlertingSetting{
+		DiskUsageThresholdPercent: setting.DiskUsageThresholdPercent,
+	}
+	for _, channel := range setting.Channels {
+		alertChannel := &v1pb.WorkspaceSetting_AlertingSetting_AlertChannel{
+			Type:       v1pb.WorkspaceSetting_AlertingSetting_AlertChannel_Type(channel.Type),
+			Url:        channel.Url,
+			Recipients: channel.Recipients,
+		}
+		if channel.Smtp != nil {
+			alertChannel.Smtp = &v1pb.WorkspaceSetting_AlertingSetting_SMTPConfig{
+				Host:     channel.Smtp.Host,
+				Port:     channel.Smtp.Port,
+				Username: channel.Smtp.Username,
+				Password: channel.Smtp.Password,
+				From:     channel.Smtp.From,
+			}
+		}
+		alertingSetting.Channels = append(alertingSetting.Channels, alertChannel)
+	}
+	return alertingSetting
+}
+
+func convertWorkspaceAlertingSettingToStore(setting *v1pb.WorkspaceSetting_AlertingSetting) *storepb.WorkspaceAlertingSetting {
+	if setting == nil {
+		return nil
+	}
+	alertingSetting := &storepb.WorkspaceAlertingSetting{
+		DiskUsageThresholdPercent: setting.DiskUsageThresholdPercent,
+	}
+	for _, channel := range setting.Channels {
+		alertChannel := &storepb.WorkspaceAlertingSetting_AlertChannel{
+			Type:       storepb.WorkspaceAlertingSetting_AlertChannel_Type(channel.Type),
+			Url:        channel.Url,
+			Recipients: channel.Recipients,
+		}
+		if channel.Smtp != nil {
+			alertChannel.Smtp = &storepb.WorkspaceAlertingSetting_SMTPConfig{
+				Host:     channel.Smtp.Host,
+				Port:     channel.Smtp.Port,
+				Username: channel.Smtp.Username,
+				Password: channel.Smtp.Password,
+				From:     channel.Smtp.From,
+			}
+		}
+		alertingSetting.Channels = append(alertingSetting.Channels, alertChannel)
 	}
+	return alertingSetting
 }
 
 var ownerCache *v1pb.User
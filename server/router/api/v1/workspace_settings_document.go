@@ -0,0 +1,236 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// workspaceSettingsDocumentVersion is the version of the documents exported, the only one applied.
+const workspaceSettingsDocumentVersion = 1
+
+// documentedWorkspaceSettingKeys are the keys of the settings in a workspace settings document, in their order. The
+// other settings are either internal or the state of the instance.
+var documentedWorkspaceSettingKeys = []storepb.WorkspaceSettingKey{
+	storepb.WorkspaceSettingKey_GENERAL,
+	storepb.WorkspaceSettingKey_STORAGE,
+	storepb.WorkspaceSettingKey_MEMO_RELATED,
+	storepb.WorkspaceSettingKey_AI_CONFIG,
+	storepb.WorkspaceSettingKey_ONBOARDING,
+	storepb.WorkspaceSettingKey_NEW_USER_LIMIT,
+	storepb.WorkspaceSettingKey_FEATURE_FLAGS,
+	storepb.WorkspaceSettingKey_USAGE_LIMIT,
+	storepb.WorkspaceSettingKey_SENSITIVE_CONTENT,
+	storepb.WorkspaceSettingKey_OUTBOUND_FETCH,
+	storepb.WorkspaceSettingKey_LEGAL,
+	storepb.WorkspaceSettingKey_ALERTING,
+}
+
+// ExportWorkspaceSettings returns the stored workspace settings and the identity providers as a document.
+func (s *APIV1Service) ExportWorkspaceSettings(ctx context.Context, request *v1pb.ExportWorkspaceSettingsRequest) (*v1pb.ExportWorkspaceSettingsResponse, error) {
+	if err := s.checkHostUser(ctx); err != nil {
+		return nil, err
+	}
+
+	document := &v1pb.WorkspaceSettingsDocument{Version: workspaceSettingsDocumentVersion}
+	settings, err := s.Store.ListWorkspaceSettings(ctx, &store.FindWorkspaceSetting{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list workspace settings: %v", err)
+	}
+	for _, key := range documentedWorkspaceSettingKeys {
+		index := slices.IndexFunc(settings, func(setting *storepb.WorkspaceSetting) bool { return setting.Key == key })
+		if index < 0 {
+			continue
+		}
+		exported := convertWorkspaceSettingFromStore(settings[index])
+		// The etags are only valid on the instance.
+		exported.Etag = ""
+		document.Settings = append(document.Settings, exported)
+	}
+	identityProviders, err := s.Store.ListIdentityProviders(ctx, &store.FindIdentityProvider{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list identity providers: %v", err)
+	}
+	for _, identityProvider := range identityProviders {
+		exported := convertIdentityProviderFromStore(identityProvider)
+		// The identity providers are matched by title, their names differ between instances.
+		exported.Name = ""
+		exported.Etag = ""
+		document.IdentityProviders = append(document.IdentityProviders, exported)
+	}
+
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(document)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal document: %v", err)
+	}
+	if request.Format == v1pb.WorkspaceSettingsDocumentFormat_YAML {
+		// The JSON is decoded into YAML nodes, rather than a map, to keep the order of the fields.
+		var node yaml.Node
+		if err := yaml.Unmarshal(data, &node); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert document: %v", err)
+		}
+		clearYAMLStyle(&node)
+		if data, err = yaml.Marshal(&node); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert document: %v", err)
+		}
+	}
+	return &v1pb.ExportWorkspaceSettingsResponse{Document: string(data)}, nil
+}
+
+// ApplyWorkspaceSettings updates the workspace settings that differ from the document, and creates or updates its
+// identity providers. The settings and identity providers not in the document are kept.
+func (s *APIV1Service) ApplyWorkspaceSettings(ctx context.Context, request *v1pb.ApplyWorkspaceSettingsRequest) (*v1pb.ApplyWorkspaceSettingsResponse, error) {
+	if err := s.checkHostUser(ctx); err != nil {
+		return nil, err
+	}
+	document, err := parseWorkspaceSettingsDocument(request.Document)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid document: %v", err)
+	}
+
+	settings := map[storepb.WorkspaceSettingKey]*v1pb.WorkspaceSetting{}
+	for _, setting := range document.Settings {
+		keyString, err := ExtractWorkspaceSettingKeyFromName(setting.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid workspace setting name: %v", err)
+		}
+		key := storepb.WorkspaceSettingKey(storepb.WorkspaceSettingKey_value[keyString])
+		if !slices.Contains(documentedWorkspaceSettingKeys, key) {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported workspace setting %q", setting.Name)
+		}
+		if _, ok := settings[key]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate workspace setting %q", setting.Name)
+		}
+		if converted := convertWorkspaceSettingToStore(setting); converted.Value == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "workspace setting %q has no value", setting.Name)
+		}
+		settings[key] = setting
+	}
+	identityProviders := map[string]*v1pb.IdentityProvider{}
+	for _, identityProvider := range document.IdentityProviders {
+		if identityProvider.Title == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "identity provider title is required")
+		}
+		if _, ok := identityProviders[identityProvider.Title]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate identity provider %q", identityProvider.Title)
+		}
+		identityProviders[identityProvider.Title] = identityProvider
+	}
+
+	response := &v1pb.ApplyWorkspaceSettingsResponse{}
+	for _, key := range documentedWorkspaceSettingKeys {
+		setting, ok := settings[key]
+		if !ok {
+			continue
+		}
+		current, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{Name: key.String()})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+		}
+		// The setting is compared once converted, as stored.
+		setting = convertWorkspaceSettingFromStore(convertWorkspaceSettingToStore(setting))
+		if current != nil && proto.Equal(convertWorkspaceSettingFromStore(current), setting) {
+			continue
+		}
+		if !request.ValidateOnly {
+			setting.Etag = ""
+			if _, err := s.UpdateWorkspaceSetting(ctx, &v1pb.UpdateWorkspaceSettingRequest{Setting: setting}); err != nil {
+				return nil, err
+			}
+		}
+		response.UpdatedSettings = append(response.UpdatedSettings, setting.Name)
+	}
+
+	existing, err := s.Store.ListIdentityProviders(ctx, &store.FindIdentityProvider{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list identity providers: %v", err)
+	}
+	for _, identityProvider := range document.IdentityProviders {
+		create := convertIdentityProviderToStore(identityProvider)
+		create.Id = 0
+		index := slices.IndexFunc(existing, func(current *storepb.IdentityProvider) bool { return current.Name == identityProvider.Title })
+		if index < 0 {
+			if !request.ValidateOnly {
+				if _, err := s.Store.CreateIdentityProvider(ctx, create); err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to create identity provider: %v", err)
+				}
+			}
+			response.CreatedIdentityProviders = append(response.CreatedIdentityProviders, identityProvider.Title)
+			continue
+		}
+		current := existing[index]
+		create.Id = current.Id
+		if proto.Equal(current, create) {
+			continue
+		}
+		if !request.ValidateOnly {
+			if _, err := s.Store.UpdateIdentityProvider(ctx, &store.UpdateIdentityProviderV1{
+				ID:               current.Id,
+				Type:             create.Type,
+				IdentifierFilter: &create.IdentifierFilter,
+				Config:           create.Config,
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update identity provider: %v", err)
+			}
+		}
+		response.UpdatedIdentityProviders = append(response.UpdatedIdentityProviders, identityProvider.Title)
+	}
+	return response, nil
+}
+
+// checkHostUser returns an error unless the current user is the host.
+func (s *APIV1Service) checkHostUser(ctx context.Context) error {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if user.Role != store.RoleHost {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// parseWorkspaceSettingsDocument parses a document in YAML or JSON, a subset of YAML.
+func parseWorkspaceSettingsDocument(text string) (*v1pb.WorkspaceSettingsDocument, error) {
+	var value any
+	if err := yaml.Unmarshal([]byte(text), &value); err != nil {
+		return nil, err
+	}
+	if _, ok := value.(map[string]any); !ok {
+		return nil, fmt.Errorf("the document must be an object")
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	document := &v1pb.WorkspaceSettingsDocument{}
+	if err := protojson.Unmarshal(data, document); err != nil {
+		return nil, err
+	}
+	if document.Version != workspaceSettingsDocumentVersion {
+		return nil, fmt.Errorf("unsupported version %d, want %d", document.Version, workspaceSettingsDocumentVersion)
+	}
+	return document, nil
+}
+
+// clearYAMLStyle clears the flow and quoting styles of the nodes decoded from JSON, for them to be written as YAML.
+func clearYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearYAMLStyle(child)
+	}
+}
@@ -0,0 +1,160 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// workspaceUsageResource is a resource counted against the workspace usage limits.
+type workspaceUsageResource int
+
+const (
+	workspaceUsageResourceUsers workspaceUsageResource = iota
+	workspaceUsageResourceMemos
+	workspaceUsageResourceStorage
+	workspaceUsageResourceAITokens
+)
+
+// GetWorkspaceUsage returns the usage of the workspace against the usage limits set by the host.
+func (s *APIV1Service) GetWorkspaceUsage(ctx context.Context, _ *v1pb.GetWorkspaceUsageRequest) (*v1pb.WorkspaceUsage, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	usageLimitSetting, err := s.Store.GetWorkspaceUsageLimitSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace usage limit setting: %v", err)
+	}
+	usage, err := s.Store.GetWorkspaceUsage(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace usage: %v", err)
+	}
+	aiMonthlyTokens, err := s.getAIMonthlyTokens(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get AI usage: %v", err)
+	}
+	return &v1pb.WorkspaceUsage{
+		UserCount:       usage.UserCount,
+		MemoCount:       usage.MemoCount,
+		StorageBytes:    usage.StorageBytes,
+		AiMonthlyTokens: aiMonthlyTokens,
+		Limits:          convertWorkspaceUsageLimitSettingFromStore(usageLimitSetting),
+	}, nil
+}
+
+// checkWorkspaceUsageLimit returns a ResourceExhausted error when adding amount of the resource
+// would exceed the workspace usage limits. The limits apply to every user, the host included.
+func (s *APIV1Service) checkWorkspaceUsageLimit(ctx context.Context, resource workspaceUsageResource, amount int64) error {
+	usageLimitSetting, err := s.Store.GetWorkspaceUsageLimitSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace usage limit setting: %v", err)
+	}
+
+	var limit, used int64
+	switch resource {
+	case workspaceUsageResourceAITokens:
+		if limit = usageLimitSetting.MaxAiMonthlyTokens; limit <= 0 {
+			return nil
+		}
+		if used, err = s.getAIMonthlyTokens(ctx); err != nil {
+			return status.Errorf(codes.Internal, "failed to get AI usage: %v", err)
+		}
+		if used+amount > limit {
+			return quotaExceededError(quotaViolation{
+				subject:   "workspace",
+				id:        "ai_tokens_per_month",
+				limit:     limit,
+				resetTime: startOfUsageMonth(time.Now()).AddDate(0, 1, 0),
+			}, "the workspace has used its %d AI tokens of the month", limit)
+		}
+		return nil
+	case workspaceUsageResourceUsers:
+		limit = int64(usageLimitSetting.MaxUsers)
+	case workspaceUsageResourceMemos:
+		limit = int64(usageLimitSetting.MaxMemos)
+	case workspaceUsageResourceStorage:
+		limit = usageLimitSetting.MaxStorageBytes
+	}
+	if limit <= 0 {
+		return nil
+	}
+
+	usage, err := s.Store.GetWorkspaceUsage(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace usage: %v", err)
+	}
+	switch resource {
+	case workspaceUsageResourceUsers:
+		if int64(usage.UserCount)+amount > limit {
+			return quotaExceededError(quotaViolation{subject: "workspace", id: "users", limit: limit}, "the workspace has reached its limit of %d users", limit)
+		}
+	case workspaceUsageResourceMemos:
+		if int64(usage.MemoCount)+amount > limit {
+			return quotaExceededError(quotaViolation{subject: "workspace", id: "memos", limit: limit}, "the workspace has reached its limit of %d memos", limit)
+		}
+	case workspaceUsageResourceStorage:
+		if usage.StorageBytes+amount > limit {
+			return quotaExceededError(quotaViolation{subject: "workspace", id: "storage_bytes", limit: limit}, "the workspace has reached its storage limit of %d bytes", limit)
+		}
+	}
+	return nil
+}
+
+// getAIMonthlyTokens returns the number of AI tokens used in the current month.
+func (s *APIV1Service) getAIMonthlyTokens(ctx context.Context) (int64, error) {
+	aiUsage, err := s.Store.GetWorkspaceAIUsage(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if aiUsage.Month != currentUsageMonth() {
+		return 0, nil
+	}
+	return aiUsage.Tokens, nil
+}
+
+// AddAITokenUsage adds the tokens used by an AI request to the usage of the current month.
+func (s *APIV1Service) AddAITokenUsage(ctx context.Context, tokens int64) error {
+	if tokens <= 0 {
+		return nil
+	}
+	s.aiUsageMutex.Lock()
+	defer s.aiUsageMutex.Unlock()
+
+	month := currentUsageMonth()
+	usedTokens, err := s.getAIMonthlyTokens(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_AI_USAGE,
+		Value: &storepb.WorkspaceSetting_AiUsage{AiUsage: &storepb.WorkspaceAIUsage{
+			Month:  month,
+			Tokens: usedTokens + tokens,
+		}},
+	}); err != nil {
+		return errors.Wrap(err, "failed to update AI usage")
+	}
+	return nil
+}
+
+func currentUsageMonth() string {
+	return time.Now().UTC().Format("2006-01")
+}
+
+func validateWorkspaceUsageLimitSetting(setting *storepb.WorkspaceUsageLimitSetting) error {
+	if setting.GetMaxUsers() < 0 || setting.GetMaxMemos() < 0 || setting.GetMaxStorageBytes() < 0 || setting.GetMaxAiMonthlyTokens() < 0 {
+		return errors.New("usage limits must not be negative")
+	}
+	return nil
+}
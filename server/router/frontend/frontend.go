@@ -5,6 +5,7 @@ import (
 	"embed"
 	"io/fs"
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -29,7 +30,7 @@ func NewFrontendService(profile *profile.Profile, store *store.Store) *FrontendS
 	}
 }
 
-func (*FrontendService) Serve(_ context.Context, e *echo.Echo) {
+func (s *FrontendService) Serve(_ context.Context, e *echo.Echo) {
 	skipper := func(c echo.Context) bool {
 		// Skip API routes.
 		if util.HasPrefixes(c.Path(), "/api", "/memos.api.v1") {
@@ -48,6 +49,19 @@ func (*FrontendService) Serve(_ context.Context, e *echo.Echo) {
 		return false
 	}
 
+	// Redirect the pretty URLs of the memos with a slug to their page.
+	e.GET("/u/:username/m/:slug", s.redirectMemoSlug)
+
+	// Advertise the Webmention endpoint on the memo pages, see https://www.w3.org/TR/webmention/#sender-discovers-receiver-webmention-endpoint.
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if strings.HasPrefix(c.Request().URL.Path, "/memos/") {
+				c.Response().Header().Set("Link", `</webmention>; rel="webmention"`)
+			}
+			return next(c)
+		}
+	})
+
 	// Route to serve the main app with HTML5 fallback for SPA behavior.
 	e.Use(middleware.StaticWithConfig(middleware.StaticConfig{
 		Filesystem: getFileSystem("dist"),
@@ -56,6 +70,37 @@ func (*FrontendService) Serve(_ context.Context, e *echo.Echo) {
 	}))
 }
 
+// redirectMemoSlug redirects the URL of a memo by the slug of its creator, current or previous, to the memo page.
+// The private memos are not found, so that their slugs are not disclosed.
+func (s *FrontendService) redirectMemoSlug(c echo.Context) error {
+	ctx := c.Request().Context()
+	username := c.Param("username")
+	user, err := s.Store.GetUserByUsername(ctx, username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get user").SetInternal(err)
+	}
+	if user == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Memo not found")
+	}
+	slug := strings.ToLower(c.Param("slug"))
+	memoSlug, err := s.Store.GetMemoSlug(ctx, &store.FindMemoSlug{CreatorID: &user.ID, Slug: &slug})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get memo slug").SetInternal(err)
+	}
+	if memoSlug == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Memo not found")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoSlug.MemoID})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get memo").SetInternal(err)
+	}
+	if memo == nil || memo.Visibility == store.Private {
+		return echo.NewHTTPError(http.StatusNotFound, "Memo not found")
+	}
+	// The slug may be renamed, the redirect is not permanent.
+	return c.Redirect(http.StatusFound, "/memos/"+memo.UID)
+}
+
 func getFileSystem(path string) http.FileSystem {
 	fs, err := fs.Sub(embeddedFiles, path)
 	if err != nil {
@@ -3,7 +3,9 @@ package rss
 import (
 	"context"
 	"fmt"
+	"html"
 	"net/http"
+	"net/url"
 	"strconv"
 	"time"
 
@@ -18,6 +20,8 @@ import (
 
 const (
 	maxRSSItemCount = 100
+	// maxRSSPage bounds the page query parameter, which keeps the offset of the memo query in range.
+	maxRSSPage = 1000
 )
 
 type RSSService struct {
@@ -41,36 +45,52 @@ func NewRSSService(profile *profile.Profile, store *store.Store, markdownService
 
 func (s *RSSService) RegisterRoutes(g *echo.Group) {
 	g.GET("/explore/rss.xml", s.GetExploreRSS)
+	g.GET("/explore/feed.json", s.GetExploreJSONFeed)
 	g.GET("/u/:username/rss.xml", s.GetUserRSS)
+	g.GET("/u/:username/feed.json", s.GetUserJSONFeed)
 }
 
+// feedFormat is the syndication format a feed is served in.
+type feedFormat int
+
+const (
+	feedFormatRSS feedFormat = iota
+	// feedFormatJSON is JSON Feed 1.1, see https://jsonfeed.org/version/1.1.
+	feedFormatJSON
+)
+
 func (s *RSSService) GetExploreRSS(c echo.Context) error {
-	ctx := c.Request().Context()
+	return s.serveExploreFeed(c, feedFormatRSS)
+}
+
+func (s *RSSService) GetExploreJSONFeed(c echo.Context) error {
+	return s.serveExploreFeed(c, feedFormatJSON)
+}
+
+func (s *RSSService) GetUserRSS(c echo.Context) error {
+	return s.serveUserFeed(c, feedFormatRSS)
+}
+
+func (s *RSSService) GetUserJSONFeed(c echo.Context) error {
+	return s.serveUserFeed(c, feedFormatJSON)
+}
+
+func (s *RSSService) serveExploreFeed(c echo.Context, format feedFormat) error {
 	normalStatus := store.Normal
-	memoFind := store.FindMemo{
+	memoFind := &store.FindMemo{
 		RowStatus:      &normalStatus,
 		VisibilityList: []store.Visibility{store.Public},
 	}
-	memoList, err := s.Store.ListMemos(ctx, &memoFind)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
-	}
-
-	baseURL := c.Scheme() + "://" + c.Request().Host
-	rss, err := s.generateRSSFromMemoList(ctx, memoList, baseURL)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate rss").SetInternal(err)
-	}
-	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationXMLCharsetUTF8)
-	return c.String(http.StatusOK, rss)
+	return s.serveFeed(c, memoFind, format)
 }
 
-func (s *RSSService) GetUserRSS(c echo.Context) error {
+// serveUserFeed serves the public memos of a user, optionally filtered by one of their shortcuts
+// given by its ID in the "shortcut" query parameter.
+func (s *RSSService) serveUserFeed(c echo.Context, format feedFormat) error {
 	ctx := c.Request().Context()
 	username := c.Param("username")
-	user, err := s.Store.GetUser(ctx, &store.FindUser{
-		Username: &username,
-	})
+	// The feeds of the previous usernames keep working during their grace period.
+	user, err := s.Store.GetUserByUsername(ctx, username)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
@@ -79,29 +99,110 @@ func (s *RSSService) GetUserRSS(c echo.Context) error {
 	}
 
 	normalStatus := store.Normal
-	memoFind := store.FindMemo{
+	memoFind := &store.FindMemo{
 		CreatorID:      &user.ID,
 		RowStatus:      &normalStatus,
 		VisibilityList: []store.Visibility{store.Public},
 	}
-	memoList, err := s.Store.ListMemos(ctx, &memoFind)
+	if shortcutID := c.QueryParam("shortcut"); shortcutID != "" {
+		shortcut, err := s.getUserShortcut(ctx, user.ID, shortcutID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find shortcut").SetInternal(err)
+		}
+		if shortcut == nil {
+			return echo.NewHTTPError(http.StatusNotFound, "Shortcut not found")
+		}
+		if shortcut.Filter != "" {
+			memoFind.Filters = append(memoFind.Filters, shortcut.Filter)
+		}
+	}
+	return s.serveFeed(c, memoFind, format)
+}
+
+// serveFeed serves the memos found as a feed, optionally filtered by the "tag" query parameter and
+// paginated by the 1-based "page" query parameter.
+func (s *RSSService) serveFeed(c echo.Context, memoFind *store.FindMemo, format feedFormat) error {
+	ctx := c.Request().Context()
+	if tag := c.QueryParam("tag"); tag != "" {
+		memoFind.Filters = append(memoFind.Filters, fmt.Sprintf("tag in [%s]", strconv.Quote(tag)))
+	}
+	page := 1
+	if rawPage := c.QueryParam("page"); rawPage != "" {
+		var err error
+		if page, err = strconv.Atoi(rawPage); err != nil || page < 1 || page > maxRSSPage {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid page")
+		}
+	}
+	// Fetch one more memo than the page size to know whether there is a next page.
+	limit, offset := maxRSSItemCount+1, (page-1)*maxRSSItemCount
+	memoFind.Limit, memoFind.Offset = &limit, &offset
+	memoList, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
 	}
+	hasNextPage := len(memoList) > maxRSSItemCount
+	if hasNextPage {
+		memoList = memoList[:maxRSSItemCount]
+	}
 
 	baseURL := c.Scheme() + "://" + c.Request().Host
-	rss, err := s.generateRSSFromMemoList(ctx, memoList, baseURL)
+	feed, err := s.generateFeedFromMemoList(ctx, memoList, baseURL)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate rss").SetInternal(err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate feed").SetInternal(err)
+	}
+	if format == feedFormatRSS {
+		rss, err := feed.ToRss()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate rss").SetInternal(err)
+		}
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationXMLCharsetUTF8)
+		return c.String(http.StatusOK, rss)
+	}
+
+	jsonFeed := (&feeds.JSON{Feed: feed}).JSONFeed()
+	feedURL := *c.Request().URL
+	jsonFeed.FeedUrl = baseURL + feedURL.RequestURI()
+	if hasNextPage {
+		query := feedURL.Query()
+		query.Set("page", strconv.Itoa(page+1))
+		feedURL.RawQuery = query.Encode()
+		jsonFeed.NextUrl = baseURL + feedURL.RequestURI()
+	}
+	for i, item := range jsonFeed.Items {
+		// The memo content is the item content rather than a summary of it.
+		item.ContentHTML, item.Summary = item.Summary, ""
+		if memoList[i].Payload.GetContentWarning() == "" {
+			item.Tags = memoList[i].Payload.GetTags()
+		}
+	}
+	content, err := jsonFeed.ToJSON()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate json feed").SetInternal(err)
+	}
+	c.Response().Header().Set(echo.HeaderContentType, "application/feed+json; charset=UTF-8")
+	return c.String(http.StatusOK, content)
+}
+
+func (s *RSSService) getUserShortcut(ctx context.Context, userID int32, shortcutID string) (*storepb.ShortcutsUserSetting_Shortcut, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_SHORTCUTS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, shortcut := range userSetting.GetShortcuts().GetShortcuts() {
+		if shortcut.Id == shortcutID {
+			return shortcut, nil
+		}
 	}
-	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationXMLCharsetUTF8)
-	return c.String(http.StatusOK, rss)
+	return nil, nil
 }
 
-func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*store.Memo, baseURL string) (string, error) {
+func (s *RSSService) generateFeedFromMemoList(ctx context.Context, memoList []*store.Memo, baseURL string) (*feeds.Feed, error) {
 	rssHeading, err := getRSSHeading(ctx, s.Store)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	feed := &feeds.Feed{
 		Title:       rssHeading.Title,
@@ -112,13 +213,27 @@ func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*st
 
 	var itemCountLimit = min(len(memoList), maxRSSItemCount)
 	feed.Items = make([]*feeds.Item, itemCountLimit)
+	creators := map[int32]*store.User{}
 	for i := 0; i < itemCountLimit; i++ {
 		memo := memoList[i]
+		link, err := s.getRSSItemLink(ctx, memo, baseURL, creators)
+		if err != nil {
+			return nil, err
+		}
+		// Feed readers cannot collapse the content, so memos with a content warning only show the warning.
+		if contentWarning := memo.Payload.GetContentWarning(); contentWarning != "" {
+			feed.Items[i] = &feeds.Item{
+				Link:        link,
+				Description: getRSSItemContentWarningDescription(contentWarning, link.Href),
+				Created:     time.Unix(memo.CreatedTs, 0),
+				Id:          link.Href,
+			}
+			continue
+		}
 		description, err := s.getRSSItemDescription(memo.Content)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
-		link := &feeds.Link{Href: baseURL + "/memos/" + memo.UID}
 		feed.Items[i] = &feeds.Item{
 			Link:        link,
 			Description: description,
@@ -129,7 +244,7 @@ func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*st
 			MemoID: &memo.ID,
 		})
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		if len(attachments) > 0 {
 			attachment := attachments[0]
@@ -145,11 +260,7 @@ func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*st
 		}
 	}
 
-	rss, err := feed.ToRss()
-	if err != nil {
-		return "", err
-	}
-	return rss, nil
+	return feed, nil
 }
 
 func (s *RSSService) getRSSItemDescription(content string) (string, error) {
@@ -160,6 +271,32 @@ func (s *RSSService) getRSSItemDescription(content string) (string, error) {
 	return html, nil
 }
 
+// getRSSItemLink returns the link of the memo, the pretty URL of its slug if it has one. The creators are cached
+// in the map by ID.
+func (s *RSSService) getRSSItemLink(ctx context.Context, memo *store.Memo, baseURL string, creators map[int32]*store.User) (*feeds.Link, error) {
+	slug := memo.Payload.GetSlug()
+	if slug == "" {
+		return &feeds.Link{Href: baseURL + "/memos/" + memo.UID}, nil
+	}
+	creator, ok := creators[memo.CreatorID]
+	if !ok {
+		var err error
+		creator, err = s.Store.GetUser(ctx, &store.FindUser{ID: &memo.CreatorID})
+		if err != nil {
+			return nil, err
+		}
+		creators[memo.CreatorID] = creator
+	}
+	if creator == nil {
+		return &feeds.Link{Href: baseURL + "/memos/" + memo.UID}, nil
+	}
+	return &feeds.Link{Href: baseURL + "/u/" + url.PathEscape(creator.Username) + "/m/" + slug}, nil
+}
+
+func getRSSItemContentWarningDescription(contentWarning, link string) string {
+	return fmt.Sprintf(`<p>Content warning: %s</p><p><a href="%s">Show memo</a></p>`, html.EscapeString(contentWarning), html.EscapeString(link))
+}
+
 func getRSSHeading(ctx context.Context, stores *store.Store) (RSSHeading, error) {
 	settings, err := stores.GetWorkspaceGeneralSetting(ctx)
 	if err != nil {
@@ -0,0 +1,174 @@
+package rss
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/markdown"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+type testJSONFeed struct {
+	Version string `json:"version"`
+	Title   string `json:"title"`
+	FeedURL string `json:"feed_url"`
+	NextURL string `json:"next_url"`
+	Items   []struct {
+		ID          string   `json:"id"`
+		ContentHTML string   `json:"content_html"`
+		Tags        []string `json:"tags"`
+	} `json:"items"`
+}
+
+type testFeedService struct {
+	echo  *echo.Echo
+	store *store.Store
+}
+
+func newTestFeedService(t *testing.T) *testFeedService {
+	ctx := context.Background()
+	testStore := teststore.NewTestingStore(ctx, t)
+	t.Cleanup(func() {
+		testStore.Close()
+	})
+	service := NewRSSService(&profile.Profile{Mode: "dev", Driver: "sqlite"}, testStore, markdown.NewService(markdown.WithTagExtension()))
+	e := echo.New()
+	service.RegisterRoutes(e.Group(""))
+	return &testFeedService{echo: e, store: testStore}
+}
+
+func (ts *testFeedService) get(path string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodGet, path, nil)
+	recorder := httptest.NewRecorder()
+	ts.echo.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func (ts *testFeedService) getJSONFeed(t *testing.T, path string) *testJSONFeed {
+	recorder := ts.get(path)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	feed := &testJSONFeed{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), feed))
+	return feed
+}
+
+func (ts *testFeedService) createMemo(t *testing.T, creatorID int32, uid string, payload *storepb.MemoPayload) *store.Memo {
+	memo, err := ts.store.CreateMemo(context.Background(), &store.Memo{
+		UID:        uid,
+		CreatorID:  creatorID,
+		Content:    uid,
+		Visibility: store.Public,
+		Payload:    payload,
+	})
+	require.NoError(t, err)
+	return memo
+}
+
+func TestFeedPagination(t *testing.T) {
+	ts := newTestFeedService(t)
+	user, err := ts.store.CreateUser(context.Background(), &store.User{Username: "alice", Role: store.RoleUser})
+	require.NoError(t, err)
+	for i := 0; i <= maxRSSItemCount; i++ {
+		ts.createMemo(t, user.ID, fmt.Sprintf("memo-%d", i), &storepb.MemoPayload{})
+	}
+
+	// The first page is full and links to the next one, which holds the remaining memo.
+	feed := ts.getJSONFeed(t, "/explore/feed.json")
+	require.Len(t, feed.Items, maxRSSItemCount)
+	require.Equal(t, "http://example.com/explore/feed.json?page=2", feed.NextURL)
+	feed = ts.getJSONFeed(t, "/explore/feed.json?page=2")
+	require.Len(t, feed.Items, 1)
+	require.Empty(t, feed.NextURL)
+	feed = ts.getJSONFeed(t, "/explore/feed.json?page="+strconv.Itoa(maxRSSPage))
+	require.Empty(t, feed.Items)
+
+	for _, page := range []string{"0", "-1", "next", strconv.Itoa(maxRSSPage + 1), "9223372036854775807", "99999999999999999999"} {
+		require.Equal(t, http.StatusBadRequest, ts.get("/explore/rss.xml?page="+page).Code, page)
+		require.Equal(t, http.StatusBadRequest, ts.get("/u/alice/feed.json?page="+page).Code, page)
+	}
+}
+
+func TestUserFeedShortcut(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestFeedService(t)
+	user, err := ts.store.CreateUser(ctx, &store.User{Username: "alice", Role: store.RoleUser})
+	require.NoError(t, err)
+	other, err := ts.store.CreateUser(ctx, &store.User{Username: "bob", Role: store.RoleUser})
+	require.NoError(t, err)
+	garden := ts.createMemo(t, user.ID, "garden", &storepb.MemoPayload{Tags: []string{"garden"}})
+	ts.createMemo(t, user.ID, "budget", &storepb.MemoPayload{Tags: []string{"budget"}})
+	ts.createMemo(t, other.ID, "lawn", &storepb.MemoPayload{Tags: []string{"garden"}})
+	_, err = ts.store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_SHORTCUTS,
+		Value: &storepb.UserSetting_Shortcuts{Shortcuts: &storepb.ShortcutsUserSetting{
+			Shortcuts: []*storepb.ShortcutsUserSetting_Shortcut{{Id: "plants", Title: "Plants", Filter: `tag in ["garden"]`}},
+		}},
+	})
+	require.NoError(t, err)
+
+	feed := ts.getJSONFeed(t, "/u/alice/feed.json")
+	require.Len(t, feed.Items, 2)
+
+	// The shortcut filters the memos of the user only.
+	feed = ts.getJSONFeed(t, "/u/alice/feed.json?shortcut=plants")
+	require.Len(t, feed.Items, 1)
+	require.Equal(t, "http://example.com/memos/"+garden.UID, feed.Items[0].ID)
+	recorder := ts.get("/u/alice/rss.xml?shortcut=plants")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "/memos/"+garden.UID)
+	require.NotContains(t, recorder.Body.String(), "/memos/budget")
+
+	// The shortcuts are looked up among the shortcuts of the user of the feed.
+	require.Equal(t, http.StatusNotFound, ts.get("/u/alice/feed.json?shortcut=missing").Code)
+	require.Equal(t, http.StatusNotFound, ts.get("/u/bob/feed.json?shortcut=plants").Code)
+	require.Equal(t, http.StatusNotFound, ts.get("/u/carol/feed.json").Code)
+}
+
+func TestJSONFeed(t *testing.T) {
+	ts := newTestFeedService(t)
+	user, err := ts.store.CreateUser(context.Background(), &store.User{Username: "alice", Role: store.RoleUser})
+	require.NoError(t, err)
+	ts.createMemo(t, user.ID, "garden", &storepb.MemoPayload{Tags: []string{"garden"}})
+	ts.createMemo(t, user.ID, "spoiler", &storepb.MemoPayload{Tags: []string{"movies"}, ContentWarning: "Spoilers"})
+	ts.createMemo(t, user.ID, "notes", &storepb.MemoPayload{Slug: "my-notes"})
+
+	recorder := ts.get("/u/alice/feed.json?tag=garden")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "application/feed+json; charset=UTF-8", recorder.Header().Get(echo.HeaderContentType))
+	feed := &testJSONFeed{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), feed))
+	require.Equal(t, "https://jsonfeed.org/version/1.1", feed.Version)
+	require.Equal(t, "Memos", feed.Title)
+	require.Equal(t, "http://example.com/u/alice/feed.json?tag=garden", feed.FeedURL)
+	require.Empty(t, feed.NextURL)
+	require.Len(t, feed.Items, 1)
+	require.Equal(t, "<p>garden</p>\n", feed.Items[0].ContentHTML)
+	require.Equal(t, []string{"garden"}, feed.Items[0].Tags)
+
+	items := map[string]int{}
+	feed = ts.getJSONFeed(t, "/u/alice/feed.json")
+	require.Len(t, feed.Items, 3)
+	for i, item := range feed.Items {
+		items[item.ID] = i
+	}
+	// The memos with a content warning only show the warning, not their content or tags.
+	spoiler := feed.Items[items["http://example.com/memos/spoiler"]]
+	require.Contains(t, spoiler.ContentHTML, "Content warning: Spoilers")
+	require.NotContains(t, spoiler.ContentHTML, "<p>spoiler</p>")
+	require.Empty(t, spoiler.Tags)
+	// The memos with a slug link to their pretty URL.
+	_, ok := items["http://example.com/u/alice/m/my-notes"]
+	require.True(t, ok)
+}
@@ -0,0 +1,111 @@
+package aijob
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// Runner processes the queued AI jobs, one at a time in the order they were queued.
+type Runner struct {
+	Store *store.Store
+	// RunJob runs the job and returns the uid of the memo it created.
+	RunJob func(ctx context.Context, job *store.AIJob) (string, error)
+
+	// mutex makes the scheduled runs and the triggered ones process the jobs one at a time.
+	mutex sync.Mutex
+}
+
+func NewRunner(store *store.Store, runJob func(ctx context.Context, job *store.AIJob) (string, error)) *Runner {
+	return &Runner{
+		Store:  store,
+		RunJob: runJob,
+	}
+}
+
+// Trigger processes the queued jobs in the background, e.g. right after a job was queued.
+func (r *Runner) Trigger() {
+	go func() {
+		if err := r.RunOnce(context.Background()); err != nil {
+			slog.Warn("failed to process AI jobs", "error", err)
+		}
+	}()
+}
+
+// RunOnce processes the queued jobs until none is left. The jobs left running, by a restart in the middle of
+// one, are queued again first.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// No job runs while the lock is held, the running ones were interrupted.
+	runningJobs, err := r.Store.ListAIJobs(ctx, &store.FindAIJob{StatusList: []store.AIJobStatus{store.AIJobStatusRunning}})
+	if err != nil {
+		return errors.Wrap(err, "failed to list running AI jobs")
+	}
+	for _, job := range runningJobs {
+		if err := r.updateStatus(ctx, job.ID, store.AIJobStatusQueued, "", ""); err != nil {
+			return err
+		}
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		queuedJobs, err := r.Store.ListAIJobs(ctx, &store.FindAIJob{StatusList: []store.AIJobStatus{store.AIJobStatusQueued}})
+		if err != nil {
+			return errors.Wrap(err, "failed to list queued AI jobs")
+		}
+		if len(queuedJobs) == 0 {
+			return nil
+		}
+		// The jobs are listed most recent first.
+		slices.Reverse(queuedJobs)
+		for _, job := range queuedJobs {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err := r.run(ctx, job); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// run runs the job and records its outcome, it only returns the errors of the store.
+func (r *Runner) run(ctx context.Context, job *store.AIJob) error {
+	if err := r.updateStatus(ctx, job.ID, store.AIJobStatusRunning, "", ""); err != nil {
+		return err
+	}
+	memoUID, err := r.RunJob(ctx, job)
+	if ctx.Err() != nil {
+		// Interrupted by a shutdown, the job is queued again by the next run.
+		return ctx.Err()
+	}
+	if err != nil {
+		slog.Warn("AI job failed", "job_id", job.ID, "user_id", job.UserID, "error", err)
+		return r.updateStatus(ctx, job.ID, store.AIJobStatusFailed, "", err.Error())
+	}
+	return r.updateStatus(ctx, job.ID, store.AIJobStatusDone, memoUID, "")
+}
+
+func (r *Runner) updateStatus(ctx context.Context, id int32, status store.AIJobStatus, memoUID, jobError string) error {
+	updatedTs := time.Now().Unix()
+	if err := r.Store.UpdateAIJob(ctx, &store.UpdateAIJob{
+		ID:        id,
+		UpdatedTs: &updatedTs,
+		Status:    &status,
+		MemoUID:   &memoUID,
+		Error:     &jobError,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to update AI job %d", id)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package aisummary
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// maxFailureCount is the number of consecutive failures after which the summaries of a user are disabled.
+const maxFailureCount = 3
+
+// Runner generates the recurring AI summaries of the users, as scheduled by their AI auto summary setting.
+type Runner struct {
+	Store *store.Store
+	// GenerateSummary generates the AI summary of the user's memos created from the start date to the end date included.
+	GenerateSummary func(ctx context.Context, user *store.User, startDate, endDate string, tags []string) error
+}
+
+func NewRunner(store *store.Store, generateSummary func(ctx context.Context, user *store.User, startDate, endDate string, tags []string) error) *Runner {
+	return &Runner{
+		Store:           store,
+		GenerateSummary: generateSummary,
+	}
+}
+
+// RunOnce generates the summaries that are due, none when the AI features of the workspace are disabled.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	aiSetting, err := r.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace AI setting")
+	}
+	if aiSetting.Disabled {
+		return nil
+	}
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_AI_AUTO_SUMMARY,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list AI auto summary settings")
+	}
+
+	now := time.Now()
+	failed := 0
+	for _, userSetting := range userSettings {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !userSetting.GetAiAutoSummary().GetEnabled() {
+			continue
+		}
+		if err := r.Summarize(ctx, userSetting, now); err != nil {
+			slog.Error("failed to generate scheduled AI summary", "user_id", userSetting.UserId, "error", err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf("failed to generate %d AI summaries", failed)
+	}
+	return nil
+}
+
+// Summarize generates the summary of the user if it is due at the given time, and records the attempt in their setting.
+func (r *Runner) Summarize(ctx context.Context, userSetting *storepb.UserSetting, now time.Time) error {
+	aiAutoSummary := userSetting.GetAiAutoSummary()
+	startDate, endDate, due := schedule(aiAutoSummary, now)
+	if !due {
+		return nil
+	}
+	user, err := r.Store.GetUser(ctx, &store.FindUser{ID: &userSetting.UserId})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus != store.Normal {
+		return nil
+	}
+
+	summaryErr := r.GenerateSummary(ctx, user, startDate, endDate, aiAutoSummary.Tags)
+	// A rate limited summary is not an attempt, it is retried by the next run.
+	if status.Code(summaryErr) == codes.ResourceExhausted {
+		slog.Info("scheduled AI summary rate limited", "user_id", user.ID)
+		return nil
+	}
+	updated := proto.Clone(aiAutoSummary).(*storepb.AIAutoSummaryUserSetting)
+	updated.LastRunTs = now.Unix()
+	updated.FailureCount = 0
+	if summaryErr != nil {
+		updated.FailureCount = aiAutoSummary.FailureCount + 1
+		if updated.FailureCount >= maxFailureCount {
+			updated.Enabled = false
+			slog.Warn("disabled scheduled AI summaries after consecutive failures", "user_id", user.ID, "failures", updated.FailureCount)
+		}
+	}
+	if _, err := r.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_AI_AUTO_SUMMARY,
+		Value:  &storepb.UserSetting_AiAutoSummary{AiAutoSummary: updated},
+	}); err != nil {
+		return errors.Wrap(err, "failed to update AI auto summary setting")
+	}
+	return summaryErr
+}
+
+// schedule returns the first and last dates summarized if a summary is due at the given time.
+// A summary is due once the hour of the day is reached, on the day of the week if any,
+// and when the frequency in days has elapsed since the day of the last attempt.
+func schedule(aiAutoSummary *storepb.AIAutoSummaryUserSetting, now time.Time) (string, string, bool) {
+	location, err := time.LoadLocation(aiAutoSummary.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	local := now.In(location)
+	scheduled := time.Date(local.Year(), local.Month(), local.Day(), int(aiAutoSummary.Hour), 0, 0, 0, location)
+	if local.Before(scheduled) {
+		return "", "", false
+	}
+	if aiAutoSummary.DayOfWeek != 0 && isoWeekday(scheduled) != aiAutoSummary.DayOfWeek {
+		return "", "", false
+	}
+	frequencyDays := max(aiAutoSummary.FrequencyDays, 1)
+	if aiAutoSummary.LastRunTs > 0 && daysBetween(time.Unix(aiAutoSummary.LastRunTs, 0).In(location), scheduled) < int(frequencyDays) {
+		return "", "", false
+	}
+
+	timeRangeDays := aiAutoSummary.TimeRangeDays
+	if timeRangeDays == 0 {
+		timeRangeDays = frequencyDays
+	}
+	// The day of the summary is not over yet, the summarized days are the ones before it.
+	startDate := scheduled.AddDate(0, 0, -int(timeRangeDays)).Format(time.DateOnly)
+	endDate := scheduled.AddDate(0, 0, -1).Format(time.DateOnly)
+	return startDate, endDate, true
+}
+
+// isoWeekday returns the day of the week of the time, from 1 for Monday to 7 for Sunday.
+func isoWeekday(t time.Time) int32 {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+	return int32(t.Weekday())
+}
+
+// daysBetween returns the number of calendar days from the day of the first time to the day of the second one.
+func daysBetween(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
@@ -0,0 +1,129 @@
+package attachmentclassify
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/plugin/classifier"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// Runner tags image attachments as sensitive using the classifier configured in the workspace settings.
+type Runner struct {
+	Store *store.Store
+	// LoadBlob returns the content of the attachment wherever it is stored.
+	LoadBlob func(attachment *store.Attachment) ([]byte, error)
+}
+
+func NewRunner(store *store.Store, loadBlob func(attachment *store.Attachment) ([]byte, error)) *Runner {
+	return &Runner{
+		Store:    store,
+		LoadBlob: loadBlob,
+	}
+}
+
+// RunOnce classifies the image attachments that were not classified yet, e.g. because they were
+// uploaded before the classifier was configured or the classifier was unavailable.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	setting, err := r.Store.GetWorkspaceSensitiveContentSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace sensitive content setting")
+	}
+	if setting.ClassifierEndpoint == "" {
+		return nil
+	}
+
+	const batchSize = 100
+	offset := 0
+	failed := 0
+	for {
+		limit := batchSize
+		attachments, err := r.Store.ListAttachments(ctx, &store.FindAttachment{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list attachments")
+		}
+		if len(attachments) == 0 {
+			break
+		}
+		for _, attachment := range attachments {
+			if !IsClassifiable(attachment) {
+				continue
+			}
+			if err := r.classify(ctx, setting, attachment); err != nil {
+				slog.Error("failed to classify attachment", "attachmentID", attachment.ID, "error", err)
+				failed++
+			}
+		}
+		offset += len(attachments)
+	}
+	if failed > 0 {
+		return errors.Errorf("failed to classify %d attachments", failed)
+	}
+	return nil
+}
+
+// Classify classifies the attachment if a classifier is configured.
+func (r *Runner) Classify(ctx context.Context, attachment *store.Attachment) error {
+	if !IsClassifiable(attachment) {
+		return nil
+	}
+	setting, err := r.Store.GetWorkspaceSensitiveContentSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace sensitive content setting")
+	}
+	if setting.ClassifierEndpoint == "" {
+		return nil
+	}
+	return r.classify(ctx, setting, attachment)
+}
+
+// IsClassifiable returns whether the attachment is an image stored by memos that was not classified yet.
+func IsClassifiable(attachment *store.Attachment) bool {
+	return strings.HasPrefix(attachment.Type, "image/") &&
+		attachment.StorageType != storepb.AttachmentStorageType_EXTERNAL &&
+		attachment.Payload.GetClassification() == nil
+}
+
+func (r *Runner) classify(ctx context.Context, setting *storepb.WorkspaceSensitiveContentSetting, attachment *store.Attachment) error {
+	// Attachments are listed without their blob, which is needed for the ones stored in the database.
+	attachment, err := r.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID, GetBlob: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to get attachment")
+	}
+	if attachment == nil {
+		return nil
+	}
+	blob, err := r.LoadBlob(attachment)
+	if err != nil {
+		return errors.Wrap(err, "failed to load attachment blob")
+	}
+	sensitive, err := classifier.Classify(ctx, setting.ClassifierEndpoint, setting.ClassifierApiKey, attachment.Type, blob)
+	if err != nil {
+		return err
+	}
+
+	payload := &storepb.AttachmentPayload{}
+	if attachment.Payload != nil {
+		payload = proto.Clone(attachment.Payload).(*storepb.AttachmentPayload)
+	}
+	payload.Classification = &storepb.AttachmentPayload_Classification{
+		Sensitive:    sensitive,
+		ClassifiedTs: time.Now().Unix(),
+	}
+	if err := r.Store.UpdateAttachment(ctx, &store.UpdateAttachment{
+		ID:      attachment.ID,
+		Payload: payload,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update attachment")
+	}
+	return nil
+}
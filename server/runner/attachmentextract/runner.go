@@ -0,0 +1,105 @@
+package attachmentextract
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxAudioSize is the maximum size in bytes of the audio recordings transcribed, the limit of the Whisper API.
+	maxAudioSize = 25 << 20
+	// maxImageSize is the maximum size in bytes of the images described.
+	maxImageSize = 20 << 20
+)
+
+// imageTypes are the image types that can be decoded and downsized before they are sent to the AI provider.
+var imageTypes = []string{"image/png", "image/jpeg", "image/gif"}
+
+// Runner extracts the text of the image and audio attachments with the AI provider, as configured by the
+// attachment extraction of the workspace AI setting.
+type Runner struct {
+	Store *store.Store
+	// Extract extracts the text of the attachment and stores it in its payload.
+	Extract func(ctx context.Context, attachment *store.Attachment) error
+}
+
+func NewRunner(store *store.Store, extract func(ctx context.Context, attachment *store.Attachment) error) *Runner {
+	return &Runner{
+		Store:   store,
+		Extract: extract,
+	}
+}
+
+// RunOnce extracts the attachments that were not extracted yet, e.g. because they were uploaded before the
+// extraction was enabled or the AI provider was unavailable.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	aiSetting, err := r.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace AI setting")
+	}
+	extraction := aiSetting.GetAttachmentExtraction()
+	if aiSetting.Disabled || (!extraction.GetImages() && !extraction.GetAudio()) {
+		return nil
+	}
+
+	const batchSize = 100
+	offset := 0
+	failed := 0
+	for {
+		limit := batchSize
+		attachments, err := r.Store.ListAttachments(ctx, &store.FindAttachment{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list attachments")
+		}
+		if len(attachments) == 0 {
+			break
+		}
+		for _, attachment := range attachments {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if !IsExtractable(extraction, attachment) {
+				continue
+			}
+			if err := r.Extract(ctx, attachment); err != nil {
+				slog.Error("failed to extract attachment", "attachmentID", attachment.ID, "error", err)
+				failed++
+			}
+		}
+		offset += len(attachments)
+	}
+	if failed > 0 {
+		return errors.Errorf("failed to extract %d attachments", failed)
+	}
+	return nil
+}
+
+// IsExtractable returns whether the attachment is an image or an audio recording stored by memos that was not
+// extracted yet, and whose modality is enabled by the extraction setting.
+func IsExtractable(extraction *storepb.WorkspaceAISetting_AttachmentExtraction, attachment *store.Attachment) bool {
+	if attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL || attachment.Payload.GetExtraction() != nil {
+		return false
+	}
+	if IsImage(attachment) {
+		return extraction.GetImages() && attachment.Size <= maxImageSize
+	}
+	if strings.HasPrefix(attachment.Type, "audio/") {
+		return extraction.GetAudio() && attachment.Size <= maxAudioSize
+	}
+	return false
+}
+
+// IsImage returns whether the attachment is an image the AI provider is sent a description request for.
+func IsImage(attachment *store.Attachment) bool {
+	return slices.Contains(imageTypes, attachment.Type)
+}
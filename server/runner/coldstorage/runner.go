@@ -0,0 +1,57 @@
+package coldstorage
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// Runner periodically moves old archived memos into cold storage.
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// batchSize is the number of memos moved per transaction.
+const batchSize = 100
+
+// RunOnce moves the archived memos not updated within the configured number of days into cold storage.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	memoRelatedSetting, err := r.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace memo related setting")
+	}
+	days := memoRelatedSetting.GetColdStorageAfterDays()
+	if days <= 0 {
+		return nil
+	}
+
+	updatedBefore := time.Now().AddDate(0, 0, -int(days)).Unix()
+	total := 0
+	for ctx.Err() == nil {
+		moved, err := r.Store.MoveMemosToColdStorage(ctx, &store.MoveMemosToColdStorage{
+			UpdatedBefore: updatedBefore,
+			Limit:         batchSize,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to move memos to cold storage")
+		}
+		total += moved
+		if moved < batchSize {
+			break
+		}
+	}
+	if total > 0 {
+		slog.Info("moved memos to cold storage", "count", total)
+	}
+	return ctx.Err()
+}
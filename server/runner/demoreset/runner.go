@@ -0,0 +1,30 @@
+package demoreset
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// Runner resets the database of a demo instance back to the seed data.
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// RunOnce resets the database back to the seed data.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	if err := r.Store.ResetDemoData(ctx); err != nil {
+		return errors.Wrap(err, "failed to reset demo data")
+	}
+	slog.Info("demo data has been reset")
+	return nil
+}
@@ -0,0 +1,52 @@
+package diskusage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// Runner periodically checks the usage of the disk of the data directory, alerting when it crosses the threshold of
+// the workspace.
+type Runner struct {
+	Store   *store.Store
+	DataDir string
+	// alert alerts the admins of the usage of the disk.
+	alert func(ctx context.Context, message string)
+}
+
+func NewRunner(store *store.Store, dataDir string, alert func(ctx context.Context, message string)) *Runner {
+	return &Runner{
+		Store:   store,
+		DataDir: dataDir,
+		alert:   alert,
+	}
+}
+
+// RunOnce alerts if the usage of the disk of the data directory is at or over the threshold.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	alertingSetting, err := r.Store.GetWorkspaceAlertingSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace alerting setting")
+	}
+	threshold := alertingSetting.GetDiskUsageThresholdPercent()
+	if threshold <= 0 {
+		return nil
+	}
+
+	used, total, err := diskUsage(r.DataDir)
+	if err != nil {
+		return errors.Wrap(err, "failed to get disk usage")
+	}
+	if total == 0 {
+		return nil
+	}
+	percent := float64(used) * 100 / float64(total)
+	if percent >= float64(threshold) {
+		r.alert(ctx, fmt.Sprintf("The disk of the data directory %s is %.1f%% full, %d of %d bytes used, over the threshold of %d%%.", r.DataDir, percent, used, total, threshold))
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+//go:build !linux && !darwin
+
+package diskusage
+
+import "github.com/pkg/errors"
+
+// diskUsage is not supported on this platform.
+func diskUsage(_ string) (uint64, uint64, error) {
+	return 0, 0, errors.New("disk usage is not supported on this platform")
+}
@@ -0,0 +1,18 @@
+//go:build linux || darwin
+
+package diskusage
+
+import "syscall"
+
+// diskUsage returns the used and total bytes of the file system of the path, as seen by unprivileged users.
+func diskUsage(path string) (uint64, uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, 0, err
+	}
+	blockSize := uint64(stat.Bsize)
+	// The blocks reserved for root are not available, they are counted as used.
+	total := stat.Blocks * blockSize
+	available := stat.Bavail * blockSize
+	return total - available, total, nil
+}
@@ -0,0 +1,126 @@
+package linkcheck
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+	"github.com/usememos/memos/plugin/markdown"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// Runner periodically checks the links in memo contents and flags the unreachable ones in the memo payload.
+type Runner struct {
+	Store           *store.Store
+	MarkdownService markdown.Service
+	// GetLinkStatus returns the HTTP status code of a link. Defaults to httpgetter.GetLinkStatus.
+	GetLinkStatus func(url string) (int, error)
+}
+
+func NewRunner(store *store.Store, markdownService markdown.Service) *Runner {
+	return &Runner{
+		Store:           store,
+		MarkdownService: markdownService,
+		GetLinkStatus:   httpgetter.GetLinkStatus,
+	}
+}
+
+// RunOnce checks the links of all normal memos.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	const batchSize = 100
+	offset := 0
+	normalStatus := store.Normal
+	// Cache the status of links shared by multiple memos within a single run.
+	linkStatuses := map[string]*storepb.MemoPayload_BrokenLink{}
+
+	for {
+		limit := batchSize
+		memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+			RowStatus: &normalStatus,
+			Limit:     &limit,
+			Offset:    &offset,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list memos")
+		}
+		if len(memos) == 0 {
+			break
+		}
+
+		for _, memo := range memos {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err := r.checkMemoLinks(ctx, memo, linkStatuses); err != nil {
+				slog.Error("failed to check memo links", "err", err, "memoID", memo.ID)
+			}
+		}
+		offset += len(memos)
+	}
+	return nil
+}
+
+func (r *Runner) checkMemoLinks(ctx context.Context, memo *store.Memo, linkStatuses map[string]*storepb.MemoPayload_BrokenLink) error {
+	data, err := r.MarkdownService.ExtractAll([]byte(memo.Content))
+	if err != nil {
+		return errors.Wrap(err, "failed to extract markdown metadata")
+	}
+	if memo.Payload == nil {
+		memo.Payload = &storepb.MemoPayload{}
+	}
+	if len(data.Links) == 0 && len(memo.Payload.BrokenLinks) == 0 {
+		return nil
+	}
+
+	brokenLinks := []*storepb.MemoPayload_BrokenLink{}
+	for _, link := range data.Links {
+		brokenLink, ok := linkStatuses[link]
+		if !ok {
+			brokenLink = r.checkLink(link)
+			linkStatuses[link] = brokenLink
+		}
+		if brokenLink != nil {
+			brokenLinks = append(brokenLinks, brokenLink)
+		}
+	}
+	if len(brokenLinks) == 0 && len(memo.Payload.BrokenLinks) == 0 {
+		return nil
+	}
+
+	memo.Payload.BrokenLinks = brokenLinks
+	if memo.Payload.Property == nil {
+		memo.Payload.Property = data.Property
+	}
+	memo.Payload.Property.HasBrokenLink = len(brokenLinks) > 0
+	if err := r.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Payload: memo.Payload,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update memo")
+	}
+	return nil
+}
+
+// checkLink returns the broken link record for the given link, or nil if the link is reachable.
+func (r *Runner) checkLink(link string) *storepb.MemoPayload_BrokenLink {
+	statusCode, err := r.GetLinkStatus(link)
+	if err != nil {
+		// Links to internal addresses are not checked.
+		if errors.Is(err, httpgetter.ErrInternalIP) {
+			return nil
+		}
+		slog.Debug("failed to check link", "link", link, "err", err)
+		statusCode = 0
+	} else if statusCode < 400 {
+		return nil
+	}
+	return &storepb.MemoPayload_BrokenLink{
+		Url:        link,
+		StatusCode: int32(statusCode),
+		CheckedTs:  time.Now().Unix(),
+	}
+}
@@ -0,0 +1,203 @@
+// Package maintenance runs the maintenance windows scheduled by the admins. During a window the workspace is
+// read-only, the other runners are paused and the webhook deliveries are deferred until its end.
+package maintenance
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/scheduler"
+	"github.com/usememos/memos/store"
+)
+
+// RunnerName is the name the runner is registered with in the scheduler.
+const RunnerName = "maintenance"
+
+// completedRetention is how long the completed windows are kept for the admins.
+const completedRetention = 30 * 24 * time.Hour
+
+var (
+	// ErrWindowNotFound is returned when cancelling a window that does not exist or is completed.
+	ErrWindowNotFound = errors.New("maintenance window not found")
+
+	readOnly atomic.Bool
+)
+
+// IsReadOnly returns whether a maintenance window is in progress.
+func IsReadOnly() bool {
+	return readOnly.Load()
+}
+
+// Runner starts and ends the maintenance windows.
+type Runner struct {
+	Store *store.Store
+	// Scheduler is paused during the windows, it is nil when the runners are not started.
+	Scheduler *scheduler.Scheduler
+	// Announce publishes the announcement of a starting window and returns its ID.
+	Announce func(ctx context.Context, window *storepb.MaintenanceWindow) (int32, error)
+	// ResumeWebhooks delivers the webhooks deferred during the windows.
+	ResumeWebhooks func(ctx context.Context) error
+
+	// mutex serializes the changes of the maintenance setting.
+	mutex sync.Mutex
+}
+
+func NewRunner(store *store.Store, scheduler *scheduler.Scheduler, announce func(ctx context.Context, window *storepb.MaintenanceWindow) (int32, error), resumeWebhooks func(ctx context.Context) error) *Runner {
+	return &Runner{
+		Store:          store,
+		Scheduler:      scheduler,
+		Announce:       announce,
+		ResumeWebhooks: resumeWebhooks,
+	}
+}
+
+// RunOnce starts the windows whose start time has passed and ends the ones whose end time has passed.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	setting, err := r.getSetting(ctx)
+	if err != nil {
+		return err
+	}
+	return r.apply(ctx, setting, false)
+}
+
+// Schedule adds a window and starts it right away when its start time has passed.
+func (r *Runner) Schedule(ctx context.Context, window *storepb.MaintenanceWindow) (*storepb.MaintenanceWindow, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	setting, err := r.getSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	window = proto.Clone(window).(*storepb.MaintenanceWindow)
+	window.Id = shortuuid.New()
+	window.State = storepb.MaintenanceWindowState_MAINTENANCE_WINDOW_STATE_UNSPECIFIED
+	window.AnnouncementId = 0
+	setting.Windows = append(setting.Windows, window)
+	slices.SortStableFunc(setting.Windows, func(a, b *storepb.MaintenanceWindow) int {
+		return cmp.Compare(a.StartTs, b.StartTs)
+	})
+	if err := r.apply(ctx, setting, true); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
+// Cancel removes a scheduled window, or ends a window in progress now.
+func (r *Runner) Cancel(ctx context.Context, id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	setting, err := r.getSetting(ctx)
+	if err != nil {
+		return err
+	}
+	index := slices.IndexFunc(setting.Windows, func(window *storepb.MaintenanceWindow) bool {
+		return window.Id == id && window.State != storepb.MaintenanceWindowState_COMPLETED
+	})
+	if index < 0 {
+		return ErrWindowNotFound
+	}
+	if window := setting.Windows[index]; window.State == storepb.MaintenanceWindowState_IN_PROGRESS {
+		window.EndTs = time.Now().Unix()
+	} else {
+		setting.Windows = slices.Delete(setting.Windows, index, index+1)
+	}
+	return r.apply(ctx, setting, true)
+}
+
+// List returns the windows, sorted by start time.
+func (r *Runner) List(ctx context.Context) ([]*storepb.MaintenanceWindow, error) {
+	setting, err := r.Store.GetWorkspaceMaintenanceSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return setting.Windows, nil
+}
+
+// getSetting returns a copy of the maintenance setting to be changed.
+func (r *Runner) getSetting(ctx context.Context) (*storepb.WorkspaceMaintenanceSetting, error) {
+	setting, err := r.Store.GetWorkspaceMaintenanceSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(setting).(*storepb.WorkspaceMaintenanceSetting), nil
+}
+
+// apply updates the state of the windows at the current time, stores them when they changed, then switches the
+// workspace in or out of maintenance. Failing to announce a window or to deliver the deferred webhooks is only
+// logged, the maintenance goes on without them.
+func (r *Runner) apply(ctx context.Context, setting *storepb.WorkspaceMaintenanceSetting, changed bool) error {
+	now := time.Now()
+	ended := false
+	windows := make([]*storepb.MaintenanceWindow, 0, len(setting.Windows))
+	for _, window := range setting.Windows {
+		switch window.State {
+		case storepb.MaintenanceWindowState_MAINTENANCE_WINDOW_STATE_UNSPECIFIED:
+			if now.Unix() >= window.EndTs {
+				// The window passed while the server was stopped.
+				window.State = storepb.MaintenanceWindowState_COMPLETED
+				changed = true
+			} else if now.Unix() >= window.StartTs {
+				window.State = storepb.MaintenanceWindowState_IN_PROGRESS
+				if r.Announce != nil {
+					announcementID, err := r.Announce(ctx, window)
+					if err != nil {
+						slog.Error("failed to announce maintenance window", "window", window.Id, "error", err)
+					}
+					window.AnnouncementId = announcementID
+				}
+				changed = true
+			}
+		case storepb.MaintenanceWindowState_IN_PROGRESS:
+			if now.Unix() >= window.EndTs {
+				window.State = storepb.MaintenanceWindowState_COMPLETED
+				ended = true
+				changed = true
+			}
+		case storepb.MaintenanceWindowState_COMPLETED:
+			if now.Sub(time.Unix(window.EndTs, 0)) > completedRetention {
+				changed = true
+				continue
+			}
+		}
+		windows = append(windows, window)
+	}
+	setting.Windows = windows
+	if changed {
+		if _, err := r.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key:   storepb.WorkspaceSettingKey_MAINTENANCE,
+			Value: &storepb.WorkspaceSetting_MaintenanceSetting{MaintenanceSetting: setting},
+		}); err != nil {
+			return errors.Wrap(err, "failed to update the maintenance windows")
+		}
+	}
+
+	active := slices.ContainsFunc(windows, func(window *storepb.MaintenanceWindow) bool {
+		return window.State == storepb.MaintenanceWindowState_IN_PROGRESS
+	})
+	wasActive := readOnly.Swap(active)
+	if r.Scheduler != nil {
+		r.Scheduler.SetPaused(active)
+	}
+	if active != wasActive {
+		slog.Info("maintenance mode changed", "read_only", active)
+	}
+	// The webhooks deferred before a restart are delivered at the end of their window too.
+	if !active && (wasActive || ended) && r.ResumeWebhooks != nil {
+		if err := r.ResumeWebhooks(ctx); err != nil {
+			slog.Error("failed to deliver the deferred webhooks", "error", err)
+		}
+	}
+	return nil
+}
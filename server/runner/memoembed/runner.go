@@ -0,0 +1,232 @@
+package memoembed
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/ai"
+	"github.com/usememos/memos/plugin/markdown"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// Maximum length of the text embedded for a memo, which keeps it within the input limit of the models.
+	maxEmbeddingTextLength = 4000
+	// Number of memos embedded by a single request to the provider.
+	batchSize = 32
+	// The operation the embedding requests are recorded for in the AI usage.
+	aiUsageOperation = "memo_embedding"
+)
+
+// Runner computes the embeddings of the memos with the embedding model configured in the workspace AI settings.
+type Runner struct {
+	Store           *store.Store
+	MarkdownService markdown.Service
+	// AddTokenUsage adds the tokens used by the embedding requests to the AI usage of the workspace.
+	AddTokenUsage func(ctx context.Context, tokens int64) error
+}
+
+func NewRunner(store *store.Store, markdownService markdown.Service, addTokenUsage func(ctx context.Context, tokens int64) error) *Runner {
+	return &Runner{
+		Store:           store,
+		MarkdownService: markdownService,
+		AddTokenUsage:   addTokenUsage,
+	}
+}
+
+// RunOnce embeds the memos without an embedding of the configured model or whose content changed since, e.g.
+// because they were created before the embedding model was configured or the provider was unavailable.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	aiSetting, err := r.getAISetting(ctx)
+	if err != nil {
+		return err
+	}
+	if aiSetting.EmbeddingModel == "" {
+		return nil
+	}
+
+	normalStatus := store.Normal
+	offset := 0
+	failed := 0
+	for {
+		limit := batchSize
+		memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+			RowStatus: &normalStatus,
+			Limit:     &limit,
+			Offset:    &offset,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list memos")
+		}
+		if len(memos) == 0 {
+			break
+		}
+		if err := r.embed(ctx, aiSetting, memos); err != nil {
+			slog.Error("failed to embed memos", "offset", offset, "error", err)
+			failed += len(memos)
+		}
+		offset += len(memos)
+	}
+	if failed > 0 {
+		return errors.Errorf("failed to embed %d memos", failed)
+	}
+	return nil
+}
+
+// Embed embeds the memo if an embedding model is configured.
+func (r *Runner) Embed(ctx context.Context, memo *store.Memo) error {
+	aiSetting, err := r.getAISetting(ctx)
+	if err != nil {
+		return err
+	}
+	if aiSetting.EmbeddingModel == "" {
+		return nil
+	}
+	return r.embed(ctx, aiSetting, []*store.Memo{memo})
+}
+
+// getAISetting returns the workspace AI setting with the provider configuration of the profile the embeddings are routed to,
+// an empty one without embedding model when the AI features of the workspace are disabled.
+func (r *Runner) getAISetting(ctx context.Context) (*storepb.WorkspaceAISetting, error) {
+	aiSetting, err := r.Store.GetWorkspaceAISetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace AI setting")
+	}
+	if aiSetting.Disabled {
+		return &storepb.WorkspaceAISetting{}, nil
+	}
+	profile := aiSetting.FeatureProfiles[store.AIFeatureEmbedding]
+	profileSetting := store.GetAIProfileSetting(aiSetting, profile)
+	if profileSetting == nil {
+		return nil, errors.Errorf("AI profile %q not found", profile)
+	}
+	return profileSetting, nil
+}
+
+// embed embeds the memos whose embedding is missing or stale, and removes the embedding of the memos that must not be sent to the provider:
+// the memos not normal, the protected memos if disallowed, and the memos of the users who did not opt in to the AI processing of their content.
+func (r *Runner) embed(ctx context.Context, aiSetting *storepb.WorkspaceAISetting, memos []*store.Memo) error {
+	memoIDs := make([]int32, 0, len(memos))
+	for _, memo := range memos {
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	embeddings, err := r.Store.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{MemoIDList: memoIDs, Model: &aiSetting.EmbeddingModel})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo embeddings")
+	}
+	contentHashes := map[int32]string{}
+	for _, embedding := range embeddings {
+		contentHashes[embedding.MemoID] = embedding.ContentHash
+	}
+
+	optIns := map[int32]bool{}
+	for _, memo := range memos {
+		if _, ok := optIns[memo.CreatorID]; ok {
+			continue
+		}
+		optIn, err := r.Store.GetUserAIOptIn(ctx, memo.CreatorID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get user AI opt-in")
+		}
+		optIns[memo.CreatorID] = optIn
+	}
+
+	pending, texts, hashes := []*store.Memo{}, []string{}, []string{}
+	for _, memo := range memos {
+		text := ""
+		if memo.RowStatus == store.Normal && optIns[memo.CreatorID] && !(aiSetting.DisallowProtectedMemos && memo.Visibility == store.Protected) {
+			if text, err = r.MarkdownService.GenerateSnippet([]byte(memo.Content), maxEmbeddingTextLength); err != nil {
+				return errors.Wrapf(err, "failed to generate snippet of memo %d", memo.ID)
+			}
+		}
+		if text == "" {
+			if err := r.Store.DeleteMemoEmbedding(ctx, &store.DeleteMemoEmbedding{MemoID: &memo.ID}); err != nil {
+				return errors.Wrap(err, "failed to delete memo embedding")
+			}
+			continue
+		}
+		hash := sha256.Sum256([]byte(text))
+		contentHash := hex.EncodeToString(hash[:])
+		if contentHashes[memo.ID] == contentHash {
+			continue
+		}
+		pending, texts, hashes = append(pending, memo), append(texts, text), append(hashes, contentHash)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	provider, err := newProvider(aiSetting)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	result, err := provider.Embed(ctx, &ai.EmbeddingRequest{Model: aiSetting.EmbeddingModel, Input: texts})
+	r.recordUsage(ctx, aiSetting.EmbeddingModel, start, result, err)
+	if err != nil {
+		return errors.Wrap(err, "failed to embed memos")
+	}
+	if len(result.Vectors) != len(pending) {
+		return errors.Errorf("expected %d embeddings, got %d", len(pending), len(result.Vectors))
+	}
+	if r.AddTokenUsage != nil {
+		if err := r.AddTokenUsage(ctx, result.TotalTokens); err != nil {
+			slog.Warn("failed to record AI token usage", "error", err)
+		}
+	}
+	for i, memo := range pending {
+		if err := r.Store.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+			MemoID:      memo.ID,
+			Model:       aiSetting.EmbeddingModel,
+			ContentHash: hashes[i],
+			Embedding:   result.Vectors[i],
+			UpdatedTs:   time.Now().Unix(),
+		}); err != nil {
+			return errors.Wrap(err, "failed to upsert memo embedding")
+		}
+	}
+	return nil
+}
+
+// recordUsage records the embedding request in the AI usage of the workspace. It is best effort.
+func (r *Runner) recordUsage(ctx context.Context, model string, start time.Time, result *ai.Embeddings, err error) {
+	usage := &store.AIUsage{
+		Operation: aiUsageOperation,
+		Model:     model,
+		LatencyMs: time.Since(start).Milliseconds(),
+		Success:   err == nil,
+	}
+	if result != nil {
+		usage.PromptTokens = result.TotalTokens
+	}
+	if err != nil {
+		usage.Error = err.Error()
+	}
+	if _, err := r.Store.CreateAIUsage(context.WithoutCancel(ctx), usage); err != nil {
+		slog.Warn("failed to record AI usage", "error", err)
+	}
+}
+
+// newProvider creates the AI provider configured in the workspace AI setting, OpenAI if none is set.
+func newProvider(aiSetting *storepb.WorkspaceAISetting) (ai.Provider, error) {
+	providerType := ai.ProviderOpenAI
+	if aiSetting.Provider != storepb.WorkspaceAISetting_PROVIDER_UNSPECIFIED {
+		providerType = ai.ProviderType(aiSetting.Provider.String())
+	}
+	provider, err := ai.NewProvider(ai.Config{
+		Type:       providerType,
+		Endpoint:   aiSetting.Endpoint,
+		APIKey:     aiSetting.ApiKey,
+		APIVersion: aiSetting.ApiVersion,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid AI provider")
+	}
+	return provider, nil
+}
@@ -0,0 +1,83 @@
+package memoexpiry
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// Runner archives or deletes the memos whose expiry time has passed.
+type Runner struct {
+	Store *store.Store
+	// DeleteMemo deletes an expired memo together with its comments, attachments and related records.
+	DeleteMemo func(ctx context.Context, memo *store.Memo) error
+}
+
+func NewRunner(store *store.Store, deleteMemo func(ctx context.Context, memo *store.Memo) error) *Runner {
+	return &Runner{
+		Store:      store,
+		DeleteMemo: deleteMemo,
+	}
+}
+
+// batchSize is the number of expired memos listed at once.
+const batchSize = 100
+
+// RunOnce applies the expiry action of every expired memo.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	now := time.Now().Unix()
+	// Expired memos drop out of the list once handled, only the failed ones are skipped.
+	offset := 0
+	expired := 0
+	var expireErr error
+	for ctx.Err() == nil {
+		limit := batchSize
+		memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+			ExpiredBefore: &now,
+			Limit:         &limit,
+			Offset:        &offset,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list expired memos")
+		}
+		for _, memo := range memos {
+			if err := r.Expire(ctx, memo); err != nil {
+				expireErr = errors.Wrapf(err, "failed to expire memo %d", memo.ID)
+				offset++
+				continue
+			}
+			expired++
+		}
+		if len(memos) < batchSize {
+			break
+		}
+	}
+	if expired > 0 {
+		slog.Info("expired memos", "count", expired)
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return expireErr
+}
+
+// Expire archives or deletes the memo according to its expiry action.
+func (r *Runner) Expire(ctx context.Context, memo *store.Memo) error {
+	if memo.Payload.GetExpiry().GetAction() == storepb.MemoPayload_DELETE {
+		return r.DeleteMemo(ctx, memo)
+	}
+	// Clear the expiry so that the archived memo is not expired again.
+	payload := memo.Payload
+	payload.Expiry = nil
+	archived := store.Archived
+	return r.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:        memo.ID,
+		RowStatus: &archived,
+		Payload:   payload,
+	})
+}
@@ -3,9 +3,12 @@ package memopayload
 import (
 	"context"
 	"log/slog"
+	"slices"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/usememos/memos/plugin/langdetect"
 	"github.com/usememos/memos/plugin/markdown"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -23,12 +26,30 @@ func NewRunner(store *store.Store, markdownService markdown.Service) *Runner {
 	}
 }
 
+// Progress reports how many memos a payload rebuild went through.
+type Progress struct {
+	Processed int
+	Updated   int
+	Failed    int
+}
+
 // RunOnce rebuilds the payload of all memos.
 func (r *Runner) RunOnce(ctx context.Context) {
+	progress, err := r.Rebuild(ctx, nil)
+	if err != nil {
+		slog.Error("failed to rebuild memo payloads", "err", err)
+		return
+	}
+	slog.Info("Rebuilt memo payloads", "processed", progress.Processed, "updated", progress.Updated, "failed", progress.Failed)
+}
+
+// Rebuild rebuilds the payload of all memos in batches, only writing back the payloads
+// that changed. onProgress, if set, is called after every batch.
+func (r *Runner) Rebuild(ctx context.Context, onProgress func(Progress)) (Progress, error) {
 	// Process memos in batches to avoid loading all memos into memory at once
 	const batchSize = 100
 	offset := 0
-	processed := 0
+	progress := Progress{}
 
 	for {
 		limit := batchSize
@@ -37,8 +58,7 @@ func (r *Runner) RunOnce(ctx context.Context) {
 			Offset: &offset,
 		})
 		if err != nil {
-			slog.Error("failed to list memos", "err", err)
-			return
+			return progress, errors.Wrap(err, "failed to list memos")
 		}
 
 		// Break if no more memos
@@ -47,10 +67,14 @@ func (r *Runner) RunOnce(ctx context.Context) {
 		}
 
 		// Process batch
-		batchSuccessCount := 0
 		for _, memo := range memos {
+			original := proto.Clone(memo.Payload)
 			if err := RebuildMemoPayload(memo, r.MarkdownService); err != nil {
 				slog.Error("failed to rebuild memo payload", "err", err, "memoID", memo.ID)
+				progress.Failed++
+				continue
+			}
+			if original != nil && proto.Equal(original, memo.Payload) {
 				continue
 			}
 			if err := r.Store.UpdateMemo(ctx, &store.UpdateMemo{
@@ -58,19 +82,26 @@ func (r *Runner) RunOnce(ctx context.Context) {
 				Payload: memo.Payload,
 			}); err != nil {
 				slog.Error("failed to update memo", "err", err, "memoID", memo.ID)
+				progress.Failed++
 				continue
 			}
-			batchSuccessCount++
+			progress.Updated++
 		}
 
-		processed += len(memos)
-		slog.Info("Processed memo batch", "batchSize", len(memos), "successCount", batchSuccessCount, "totalProcessed", processed)
+		progress.Processed += len(memos)
+		if onProgress != nil {
+			onProgress(progress)
+		}
 
 		// Move to next batch
 		offset += len(memos)
 	}
+	return progress, nil
 }
 
+// languageDetectionTextLength is the length of the memo text the language is detected from.
+const languageDetectionTextLength = 2000
+
 func RebuildMemoPayload(memo *store.Memo, markdownService markdown.Service) error {
 	if memo.Payload == nil {
 		memo.Payload = &storepb.MemoPayload{}
@@ -83,6 +114,64 @@ func RebuildMemoPayload(memo *store.Memo, markdownService markdown.Service) erro
 	}
 
 	memo.Payload.Tags = data.Tags
+	// The tags applied by the AI and the tagging rules are not in the content, they are kept along its tags.
+	for _, tag := range slices.Concat(memo.Payload.AiTags, memo.Payload.RuleTags) {
+		if !slices.Contains(memo.Payload.Tags, tag) {
+			memo.Payload.Tags = append(memo.Payload.Tags, tag)
+		}
+	}
+	// Whether the memo was generated by the AI does not depend on its content.
+	isAIGenerated := memo.Payload.GetProperty().GetIsAiGenerated()
 	memo.Payload.Property = data.Property
+	memo.Payload.Property.IsAiGenerated = isAIGenerated
+	memo.Payload.BrokenLinks = filterBrokenLinks(memo.Payload.BrokenLinks, data.Links)
+	memo.Payload.Property.HasBrokenLink = len(memo.Payload.BrokenLinks) > 0
+	memo.Payload.LinkSnapshots = filterLinkSnapshots(memo.Payload.LinkSnapshots, data.Links)
+	memo.Payload.TimeEntries = data.TimeEntries
+	memo.Payload.Amounts = data.Amounts
+	memo.Payload.Reading = data.Reading
+
+	// Detect the language from the plain text, so code blocks and link targets are not taken into account.
+	text, err := markdownService.GenerateSnippet([]byte(memo.Content), languageDetectionTextLength)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate memo text")
+	}
+	memo.Payload.DetectedLanguage = langdetect.Detect(text)
 	return nil
 }
+
+// filterBrokenLinks drops the broken links that no longer appear in the memo content.
+func filterBrokenLinks(brokenLinks []*storepb.MemoPayload_BrokenLink, links []string) []*storepb.MemoPayload_BrokenLink {
+	if len(brokenLinks) == 0 {
+		return brokenLinks
+	}
+	linkSet := make(map[string]bool, len(links))
+	for _, link := range links {
+		linkSet[link] = true
+	}
+	result := []*storepb.MemoPayload_BrokenLink{}
+	for _, brokenLink := range brokenLinks {
+		if linkSet[brokenLink.Url] {
+			result = append(result, brokenLink)
+		}
+	}
+	return result
+}
+
+// filterLinkSnapshots drops the link snapshots that no longer appear in the memo content.
+func filterLinkSnapshots(linkSnapshots []*storepb.MemoPayload_LinkSnapshot, links []string) []*storepb.MemoPayload_LinkSnapshot {
+	if len(linkSnapshots) == 0 {
+		return linkSnapshots
+	}
+	linkSet := make(map[string]bool, len(links))
+	for _, link := range links {
+		linkSet[link] = true
+	}
+	result := []*storepb.MemoPayload_LinkSnapshot{}
+	for _, linkSnapshot := range linkSnapshots {
+		if linkSet[linkSnapshot.Url] {
+			result = append(result, linkSnapshot)
+		}
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package memoview
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// MaxPending is the maximum number of views queued between two runs, the views beyond it are dropped.
+const MaxPending = 10000
+
+// Runner records the views of the memos. Views are queued as the memos are read and recorded together on each run,
+// so that reading a memo does not wait for a write to the database.
+type Runner struct {
+	Store *store.Store
+
+	mutex sync.Mutex
+	// pending holds the queued views, a visitor being counted once per day.
+	pending map[store.MemoView]struct{}
+	// dropped tells whether views were dropped since the last run, to only log it once per run.
+	dropped bool
+}
+
+func NewRunner(s *store.Store) *Runner {
+	return &Runner{
+		Store:   s,
+		pending: map[store.MemoView]struct{}{},
+	}
+}
+
+// Add queues the view for the next run.
+func (r *Runner) Add(view store.MemoView) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, ok := r.pending[view]; ok {
+		return
+	}
+	if len(r.pending) >= MaxPending {
+		if !r.dropped {
+			slog.Warn("too many memo views queued, dropping the new ones until the next run", slog.Int("max", MaxPending))
+			r.dropped = true
+		}
+		return
+	}
+	r.pending[view] = struct{}{}
+}
+
+// RunOnce records the queued views.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	r.mutex.Lock()
+	pending := r.pending
+	r.pending, r.dropped = map[store.MemoView]struct{}{}, false
+	r.mutex.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+	views := make([]*store.MemoView, 0, len(pending))
+	for view := range pending {
+		views = append(views, &view)
+	}
+	if err := r.Store.CreateMemoViews(ctx, views); err != nil {
+		return errors.Wrapf(err, "failed to record %d memo views", len(views))
+	}
+	return nil
+}
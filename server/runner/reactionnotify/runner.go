@@ -0,0 +1,163 @@
+package reactionnotify
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// DefaultThreshold is the number of reactions to a memo received at once from which they are
+// notified as a single aggregated notification, unless the memo creator configured another one.
+const DefaultThreshold = 3
+
+// Runner notifies memo creators of the reactions to their memos. Reactions are queued as they come
+// and dispatched on each run, so that a burst of reactions to a memo ends up in a single notification.
+type Runner struct {
+	Store *store.Store
+
+	mutex sync.Mutex
+	// pending holds the queued reactions of each memo, in the order they were received.
+	pending map[pendingKey][]pendingReaction
+	// order is the order in which the memos first received a queued reaction.
+	order []pendingKey
+}
+
+type pendingKey struct {
+	receiverID int32
+	memoID     int32
+}
+
+type pendingReaction struct {
+	reactorID    int32
+	reactionType string
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store:   store,
+		pending: map[pendingKey][]pendingReaction{},
+	}
+}
+
+// Add queues the reaction to the memo for the next run. Reactions of memo creators to their own memos are ignored.
+func (r *Runner) Add(memo *store.Memo, reactorID int32, reactionType string) {
+	if memo.CreatorID == reactorID {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	key := pendingKey{receiverID: memo.CreatorID, memoID: memo.ID}
+	reactions, ok := r.pending[key]
+	if !ok {
+		r.order = append(r.order, key)
+	}
+	r.pending[key] = append(reactions, pendingReaction{reactorID: reactorID, reactionType: reactionType})
+}
+
+// Remove drops a queued reaction that was deleted before being notified.
+func (r *Runner) Remove(memo *store.Memo, reactorID int32, reactionType string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	key := pendingKey{receiverID: memo.CreatorID, memoID: memo.ID}
+	reactions := r.pending[key]
+	for i, reaction := range reactions {
+		if reaction.reactorID == reactorID && reaction.reactionType == reactionType {
+			r.pending[key] = append(reactions[:i:i], reactions[i+1:]...)
+			break
+		}
+	}
+}
+
+// RunOnce notifies the queued reactions. The reactions to a memo are notified one by one, or as a
+// single aggregated notification when there are at least as many as the threshold of the memo creator.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	r.mutex.Lock()
+	pending, order := r.pending, r.order
+	r.pending, r.order = map[pendingKey][]pendingReaction{}, nil
+	r.mutex.Unlock()
+
+	var notifyErr error
+	for _, key := range order {
+		reactions := pending[key]
+		if len(reactions) == 0 {
+			continue
+		}
+		// Keep notifying the other memos, the reactions of a failed one are dropped.
+		if err := r.notify(ctx, key, reactions); err != nil {
+			notifyErr = errors.Wrapf(err, "failed to notify reactions to memo %d", key.memoID)
+		}
+	}
+	return notifyErr
+}
+
+func (r *Runner) notify(ctx context.Context, key pendingKey, reactions []pendingReaction) error {
+	threshold, err := r.getThreshold(ctx, key.receiverID)
+	if err != nil {
+		return err
+	}
+	if len(reactions) >= threshold {
+		return r.createNotification(ctx, key, reactions)
+	}
+	for _, reaction := range reactions {
+		if err := r.createNotification(ctx, key, []pendingReaction{reaction}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Runner) getThreshold(ctx context.Context, userID int32) (int, error) {
+	userSetting, err := r.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get user general setting")
+	}
+	if threshold := userSetting.GetGeneral().GetReactionNotificationThreshold(); threshold > 0 {
+		return int(threshold), nil
+	}
+	return DefaultThreshold, nil
+}
+
+// createNotification records the reactions as an activity and notifies the memo creator of it,
+// on behalf of the last reactor.
+func (r *Runner) createNotification(ctx context.Context, key pendingKey, reactions []pendingReaction) error {
+	payload := &storepb.ActivityMemoReactionPayload{
+		MemoId: key.memoID,
+	}
+	for _, reaction := range reactions {
+		payload.ReactorIds = append(payload.ReactorIds, reaction.reactorID)
+		payload.ReactionTypes = append(payload.ReactionTypes, reaction.reactionType)
+	}
+	senderID := reactions[len(reactions)-1].reactorID
+	activity, err := r.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: senderID,
+		Type:      store.ActivityTypeMemoReaction,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoReaction: payload,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	if _, err := r.Store.CreateInbox(ctx, &store.Inbox{
+		SenderID:   senderID,
+		ReceiverID: key.receiverID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_REACTION,
+			ActivityId: &activity.ID,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	slog.Debug("notified memo reactions", "memo", key.memoID, "reactions", len(reactions))
+	return nil
+}
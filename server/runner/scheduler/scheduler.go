@@ -0,0 +1,327 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/internal/cron"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+var (
+	// ErrRunnerNotFound is returned for operations on unregistered runners.
+	ErrRunnerNotFound = errors.New("runner not found")
+	// ErrRunnerRunning is returned when triggering a runner that is still running.
+	ErrRunnerRunning = errors.New("runner is already running")
+)
+
+// Runner is a background job run by the scheduler.
+type Runner struct {
+	// Name is the unique name of the runner, e.g. "link-check".
+	Name string
+	// Description describes what the runner does.
+	Description string
+	// DefaultSchedule is the cron expression used unless an admin configures another one.
+	DefaultSchedule string
+	// Run runs the job once.
+	Run func(ctx context.Context) error
+	// RunWhenPaused keeps running the runner on schedule while the scheduler is paused.
+	RunWhenPaused bool
+}
+
+// RunnerStatus is the configuration and the last run of a runner.
+type RunnerStatus struct {
+	Name        string
+	Description string
+	Schedule    string
+	Enabled     bool
+	Running     bool
+	// LastRunTime and LastFinishTime are zero when the runner has not run since the server started.
+	LastRunTime    time.Time
+	LastFinishTime time.Time
+	// LastError is the error of the last run, empty when it succeeded.
+	LastError   string
+	NextRunTime time.Time
+}
+
+type runnerState struct {
+	runner   Runner
+	schedule cron.Schedule
+	status   RunnerStatus
+}
+
+// Scheduler runs the registered runners according to their cron schedules. The schedule and
+// enabled state of each runner can be changed by admins and are stored in the workspace settings.
+type Scheduler struct {
+	Store *store.Store
+
+	mutex   sync.Mutex
+	runners map[string]*runnerState
+	// ctx is the context runs are started with, set by Start.
+	ctx  context.Context
+	wake chan struct{}
+	wg   sync.WaitGroup
+	// paused skips the scheduled runs of the runners, e.g. during a maintenance window.
+	paused bool
+}
+
+func NewScheduler(store *store.Store) *Scheduler {
+	return &Scheduler{
+		Store:   store,
+		runners: map[string]*runnerState{},
+		ctx:     context.Background(),
+		wake:    make(chan struct{}, 1),
+	}
+}
+
+// Register adds a runner, applying the configuration stored in the workspace settings.
+func (s *Scheduler) Register(ctx context.Context, runner Runner) error {
+	schedule, err := cron.Parse(runner.DefaultSchedule)
+	if err != nil {
+		return errors.Wrapf(err, "invalid default schedule of runner %s", runner.Name)
+	}
+	state := &runnerState{
+		runner:   runner,
+		schedule: schedule,
+		status: RunnerStatus{
+			Name:        runner.Name,
+			Description: runner.Description,
+			Schedule:    runner.DefaultSchedule,
+			Enabled:     true,
+		},
+	}
+
+	runnerSetting, err := s.Store.GetWorkspaceRunnerSetting(ctx)
+	if err != nil {
+		return err
+	}
+	if config := findRunnerConfig(runnerSetting, runner.Name); config != nil {
+		state.status.Enabled = !config.Disabled
+		if config.Schedule != "" {
+			if schedule, err := cron.Parse(config.Schedule); err != nil {
+				slog.Warn("invalid runner schedule, using the default one", "runner", runner.Name, "schedule", config.Schedule, "error", err)
+			} else {
+				state.schedule = schedule
+				state.status.Schedule = config.Schedule
+			}
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.runners[runner.Name]; ok {
+		return errors.Errorf("runner %s is already registered", runner.Name)
+	}
+	s.scheduleNextLocked(state, time.Now())
+	s.runners[runner.Name] = state
+	s.notify()
+	return nil
+}
+
+// Start runs the runners on schedule until ctx is done, then waits for the running ones to stop.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mutex.Lock()
+	s.ctx = ctx
+	s.mutex.Unlock()
+
+	for {
+		s.mutex.Lock()
+		now := time.Now()
+		var next time.Time
+		for _, state := range s.runners {
+			if !state.status.Enabled || state.status.NextRunTime.IsZero() {
+				continue
+			}
+			if !state.status.NextRunTime.After(now) {
+				if !state.status.Running && (!s.paused || state.runner.RunWhenPaused) {
+					s.runLocked(state)
+				}
+				s.scheduleNextLocked(state, now)
+			}
+			if next.IsZero() || state.status.NextRunTime.Before(next) {
+				next = state.status.NextRunTime
+			}
+		}
+		s.mutex.Unlock()
+
+		wait := time.Hour
+		if !next.IsZero() {
+			wait = time.Until(next)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.wg.Wait()
+			return
+		case <-s.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// SetPaused pauses or resumes the scheduled runs of the runners. The runs in progress are not interrupted,
+// and the runs missed while paused are not caught up.
+func (s *Scheduler) SetPaused(paused bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.paused = paused
+}
+
+// Trigger runs the runner now, regardless of its schedule and enabled state.
+func (s *Scheduler) Trigger(name string) (RunnerStatus, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	state, ok := s.runners[name]
+	if !ok {
+		return RunnerStatus{}, ErrRunnerNotFound
+	}
+	if state.status.Running {
+		return RunnerStatus{}, ErrRunnerRunning
+	}
+	s.runLocked(state)
+	return state.status, nil
+}
+
+// Update changes the schedule and enabled state of the runner and stores them in the workspace settings.
+// An empty schedule restores the default one.
+func (s *Scheduler) Update(ctx context.Context, name string, enabled *bool, scheduleSpec *string) (RunnerStatus, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	state, ok := s.runners[name]
+	if !ok {
+		return RunnerStatus{}, ErrRunnerNotFound
+	}
+
+	schedule, status := state.schedule, state.status
+	if scheduleSpec != nil {
+		spec := *scheduleSpec
+		if spec == "" {
+			spec = state.runner.DefaultSchedule
+		}
+		var err error
+		if schedule, err = cron.Parse(spec); err != nil {
+			return RunnerStatus{}, errors.Wrap(err, "invalid schedule")
+		}
+		status.Schedule = spec
+	}
+	if enabled != nil {
+		status.Enabled = *enabled
+	}
+
+	cachedRunnerSetting, err := s.Store.GetWorkspaceRunnerSetting(ctx)
+	if err != nil {
+		return RunnerStatus{}, err
+	}
+	runnerSetting := proto.Clone(cachedRunnerSetting).(*storepb.WorkspaceRunnerSetting)
+	config := findRunnerConfig(runnerSetting, name)
+	if config == nil {
+		config = &storepb.RunnerConfig{Name: name}
+		runnerSetting.Runners = append(runnerSetting.Runners, config)
+	}
+	if scheduleSpec != nil {
+		config.Schedule = *scheduleSpec
+	}
+	config.Disabled = !status.Enabled
+	if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_RUNNERS,
+		Value: &storepb.WorkspaceSetting_RunnerSetting{RunnerSetting: runnerSetting},
+	}); err != nil {
+		return RunnerStatus{}, err
+	}
+
+	state.schedule, state.status = schedule, status
+	s.scheduleNextLocked(state, time.Now())
+	s.notify()
+	return state.status, nil
+}
+
+// Get returns the status of the runner.
+func (s *Scheduler) Get(name string) (RunnerStatus, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	state, ok := s.runners[name]
+	if !ok {
+		return RunnerStatus{}, ErrRunnerNotFound
+	}
+	return state.status, nil
+}
+
+// List returns the status of every runner, sorted by name.
+func (s *Scheduler) List() []RunnerStatus {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	statuses := make([]RunnerStatus, 0, len(s.runners))
+	for _, state := range s.runners {
+		statuses = append(statuses, state.status)
+	}
+	slices.SortFunc(statuses, func(a, b RunnerStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return statuses
+}
+
+func (s *Scheduler) runLocked(state *runnerState) {
+	state.status.Running = true
+	state.status.LastRunTime = time.Now()
+	ctx := s.ctx
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		err := runSafely(ctx, state.runner)
+		if err != nil {
+			slog.Error("runner failed", "runner", state.runner.Name, "error", err)
+		}
+
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		state.status.Running = false
+		state.status.LastFinishTime = time.Now()
+		state.status.LastError = ""
+		if err != nil {
+			state.status.LastError = err.Error()
+		}
+	}()
+}
+
+func runSafely(ctx context.Context, runner Runner) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("runner panicked: %v", r)
+		}
+	}()
+	return runner.Run(ctx)
+}
+
+func (*Scheduler) scheduleNextLocked(state *runnerState, now time.Time) {
+	state.status.NextRunTime = time.Time{}
+	if state.status.Enabled {
+		state.status.NextRunTime = state.schedule.Next(now)
+	}
+}
+
+// notify wakes up the scheduling loop to pick up schedule changes.
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func findRunnerConfig(setting *storepb.WorkspaceRunnerSetting, name string) *storepb.RunnerConfig {
+	for _, config := range setting.GetRunners() {
+		if config.Name == name {
+			return config
+		}
+	}
+	return nil
+}
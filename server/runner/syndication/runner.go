@@ -0,0 +1,165 @@
+// Package syndication mirrors the public memos of other instances the workspace subscribed to. The memos are pulled
+// from the public feed of each instance, filtered by creator and tag, and kept as read-only federated memos
+// attributed to their origin.
+package syndication
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/memosclient"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// maxPages is the number of pages of public memos read from an instance at each sync, the most recent first.
+const maxPages = 5
+
+// Runner syncs the syndication subscriptions.
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// RunOnce syncs every subscription. A failing instance does not stop the others, its error is recorded on its
+// subscription.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	subscriptions, err := r.Store.ListSyndicationSubscriptions(ctx, &store.FindSyndicationSubscription{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list syndication subscriptions")
+	}
+	for _, subscription := range subscriptions {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := r.Sync(ctx, subscription); err != nil {
+			slog.Warn("failed to sync syndication subscription", "subscription", subscription.ID, "instance", subscription.InstanceURL, "error", err)
+		}
+	}
+	return nil
+}
+
+// Sync mirrors the public memos of the subscription and records the result of the sync on it.
+func (r *Runner) Sync(ctx context.Context, subscription *store.SyndicationSubscription) error {
+	// The memos not seen by a sync are told apart by their sync time, which must be later than the previous one.
+	syncTs := max(time.Now().Unix(), subscription.LastSyncTs+1)
+	syncErr := r.sync(ctx, subscription, syncTs)
+	lastError := ""
+	if syncErr != nil {
+		lastError = syncErr.Error()
+	}
+	if err := r.Store.UpdateSyndicationSubscription(ctx, &store.UpdateSyndicationSubscription{
+		ID:         subscription.ID,
+		LastSyncTs: &syncTs,
+		LastError:  &lastError,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update syndication subscription")
+	}
+	subscription.LastSyncTs, subscription.LastError = syncTs, lastError
+	return syncErr
+}
+
+func (r *Runner) sync(ctx context.Context, subscription *store.SyndicationSubscription, syncTs int64) error {
+	client, err := memosclient.NewPublicClient(subscription.InstanceURL)
+	if err != nil {
+		return err
+	}
+
+	memos := []*v1pb.Memo{}
+	complete := false
+	pageToken := ""
+	for range maxPages {
+		response, err := client.ListMemos(ctx, v1pb.State_NORMAL, pageToken)
+		if err != nil {
+			return errors.Wrap(err, "failed to list public memos")
+		}
+		for _, memo := range response.Memos {
+			if matches(subscription.Payload, memo) {
+				memos = append(memos, memo)
+			}
+		}
+		pageToken = response.NextPageToken
+		if pageToken == "" {
+			complete = true
+			break
+		}
+	}
+
+	// The creators are fetched once for the attribution of all their memos.
+	creators := map[string]*v1pb.User{}
+	for _, memo := range memos {
+		if _, ok := creators[memo.Creator]; ok || memo.Creator == "" {
+			continue
+		}
+		creator, err := client.GetUser(ctx, memo.Creator)
+		if err != nil {
+			// The memo is still attributed to the resource name of its creator.
+			slog.Warn("failed to get creator of federated memo", "instance", subscription.InstanceURL, "creator", memo.Creator, "error", err)
+		}
+		creators[memo.Creator] = creator
+	}
+	for _, memo := range memos {
+		payload := &storepb.FederatedMemoPayload{
+			Creator: memo.Creator,
+			Tags:    memo.Tags,
+		}
+		if creator := creators[memo.Creator]; creator != nil {
+			payload.CreatorUsername = creator.Username
+			payload.CreatorDisplayName = creator.DisplayName
+		}
+		if err := r.Store.UpsertFederatedMemo(ctx, &store.FederatedMemo{
+			SubscriptionID: subscription.ID,
+			InstanceURL:    subscription.InstanceURL,
+			OriginName:     memo.Name,
+			Content:        memo.Content,
+			CreatedTs:      memo.GetCreateTime().AsTime().Unix(),
+			UpdatedTs:      memo.GetUpdateTime().AsTime().Unix(),
+			SyncedTs:       syncTs,
+			Payload:        payload,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to upsert federated memo %s", memo.Name)
+		}
+	}
+
+	// The memos deleted, made private or no longer matching on the instance are only known when all its public
+	// memos were read.
+	if complete {
+		if err := r.Store.DeleteFederatedMemos(ctx, &store.DeleteFederatedMemos{
+			SubscriptionID: subscription.ID,
+			SyncedTsBefore: &syncTs,
+		}); err != nil {
+			return errors.Wrap(err, "failed to delete stale federated memos")
+		}
+	}
+	return nil
+}
+
+// matches returns whether the public memo is mirrored by the subscription: created by one of its creators and
+// tagged with one of its tags or their subtags, when set.
+func matches(payload *storepb.SyndicationSubscriptionPayload, memo *v1pb.Memo) bool {
+	if memo.Visibility != v1pb.Visibility_PUBLIC {
+		return false
+	}
+	if creators := payload.GetCreators(); len(creators) > 0 && !slices.Contains(creators, memo.Creator) {
+		return false
+	}
+	if tags := payload.GetTags(); len(tags) > 0 {
+		return slices.ContainsFunc(memo.Tags, func(memoTag string) bool {
+			return slices.ContainsFunc(tags, func(tag string) bool {
+				return memoTag == tag || strings.HasPrefix(memoTag, tag+"/")
+			})
+		})
+	}
+	return true
+}
@@ -20,12 +20,28 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/markdown"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/profiler"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/server/router/frontend"
 	"github.com/usememos/memos/server/router/rss"
+	"github.com/usememos/memos/server/runner/aijob"
+	"github.com/usememos/memos/server/runner/aisummary"
+	"github.com/usememos/memos/server/runner/attachmentclassify"
+	"github.com/usememos/memos/server/runner/attachmentextract"
+	"github.com/usememos/memos/server/runner/coldstorage"
+	"github.com/usememos/memos/server/runner/demoreset"
+	"github.com/usememos/memos/server/runner/diskusage"
+	"github.com/usememos/memos/server/runner/linkcheck"
+	"github.com/usememos/memos/server/runner/maintenance"
+	"github.com/usememos/memos/server/runner/memoembed"
+	"github.com/usememos/memos/server/runner/memoexpiry"
+	"github.com/usememos/memos/server/runner/memoview"
+	"github.com/usememos/memos/server/runner/reactionnotify"
 	"github.com/usememos/memos/server/runner/s3presign"
+	"github.com/usememos/memos/server/runner/scheduler"
+	"github.com/usememos/memos/server/runner/syndication"
 	"github.com/usememos/memos/store"
 )
 
@@ -34,10 +50,21 @@ type Server struct {
 	Profile *profile.Profile
 	Store   *store.Store
 
-	echoServer        *echo.Echo
-	grpcServer        *grpc.Server
-	profiler          *profiler.Profiler
-	runnerCancelFuncs []context.CancelFunc
+	echoServer           *echo.Echo
+	grpcServer           *grpc.Server
+	profiler             *profiler.Profiler
+	scheduler            *scheduler.Scheduler
+	reactionNotifier     *reactionnotify.Runner
+	memoViewRecorder     *memoview.Runner
+	attachmentClassifier *attachmentclassify.Runner
+	attachmentExtractor  *attachmentextract.Runner
+	memoEmbedder         *memoembed.Runner
+	memoExpiry           *memoexpiry.Runner
+	aiSummary            *aisummary.Runner
+	aiJob                *aijob.Runner
+	maintenance          *maintenance.Runner
+	diskUsage            *diskusage.Runner
+	runnerCancelFuncs    []context.CancelFunc
 }
 
 func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store) (*Server, error) {
@@ -53,10 +80,9 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	echoServer.Use(middleware.Recover())
 	s.echoServer = echoServer
 
-	if profile.Mode != "prod" {
+	if profile.Mode != "prod" || profile.EnableProfiling {
 		// Initialize profiler
 		s.profiler = profiler.NewProfiler()
-		s.profiler.RegisterRoutes(echoServer)
 		s.profiler.StartMemoryMonitor(ctx)
 	}
 
@@ -84,20 +110,68 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	// Log full stacktraces if we're in dev
 	logStacktraces := profile.IsDev()
 
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		apiv1.NewLoggerInterceptor(logStacktraces).LoggerInterceptor,
+		newRecoveryInterceptor(logStacktraces),
+		apiv1.NewLimitInterceptor(store, profile).LimitInterceptor,
+		apiv1.NewGRPCAuthInterceptor(store, secret).AuthenticationInterceptor,
+		// Reject changes during the maintenance windows.
+		apiv1.NewReadOnlyInterceptor().ReadOnlyInterceptor,
+		// Reject the AI methods when the workspace disabled its AI features.
+		apiv1.NewAIDisabledInterceptor(store).AIDisabledInterceptor,
+	}
+	// Reject destructive changes on public demo instances.
+	if profile.IsDemo() {
+		unaryInterceptors = append(unaryInterceptors, apiv1.NewDemoModeInterceptor().DemoModeInterceptor)
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpcrecovery.StreamServerInterceptor(newRecoveryOptions(logStacktraces)...),
+		apiv1.NewGRPCAuthInterceptor(store, secret).AuthenticationStreamInterceptor,
+		apiv1.NewReadOnlyInterceptor().ReadOnlyStreamInterceptor,
+		apiv1.NewAIDisabledInterceptor(store).AIDisabledStreamInterceptor,
+	}
 	grpcServer := grpc.NewServer(
 		// Override the maximum receiving message size to math.MaxInt32 for uploading large attachments.
 		grpc.MaxRecvMsgSize(math.MaxInt32),
-		grpc.ChainUnaryInterceptor(
-			apiv1.NewLoggerInterceptor(logStacktraces).LoggerInterceptor,
-			newRecoveryInterceptor(logStacktraces),
-			apiv1.NewGRPCAuthInterceptor(store, secret).AuthenticationInterceptor,
-		))
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 	s.grpcServer = grpcServer
 
+	s.scheduler = scheduler.NewScheduler(store)
+	s.reactionNotifier = reactionnotify.NewRunner(store)
+	s.memoViewRecorder = memoview.NewRunner(store)
 	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store, grpcServer)
+	apiV1Service.Scheduler = s.scheduler
+	apiV1Service.ReactionNotifier = s.reactionNotifier
+	apiV1Service.MemoViewRecorder = s.memoViewRecorder
+	if err := apiV1Service.LoadOutboundPolicy(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to load outbound fetch policy")
+	}
+	s.attachmentClassifier = attachmentclassify.NewRunner(store, apiV1Service.GetAttachmentBlob)
+	apiV1Service.AttachmentClassifier = s.attachmentClassifier
+	s.attachmentExtractor = attachmentextract.NewRunner(store, apiV1Service.ExtractAttachment)
+	apiV1Service.AttachmentExtractor = s.attachmentExtractor
+	s.memoEmbedder = memoembed.NewRunner(store, apiV1Service.MarkdownService, apiV1Service.AddAITokenUsage)
+	apiV1Service.MemoEmbedder = s.memoEmbedder
+	s.memoExpiry = memoexpiry.NewRunner(store, apiV1Service.PurgeMemo)
+	s.aiSummary = aisummary.NewRunner(store, apiV1Service.GenerateScheduledAISummary)
+	s.aiJob = aijob.NewRunner(store, apiV1Service.RunAIJob)
+	apiV1Service.AIJobRunner = s.aiJob
+	s.maintenance = maintenance.NewRunner(store, s.scheduler, apiV1Service.AnnounceMaintenance, apiV1Service.ResumeDeferredWebhooks)
+	apiV1Service.MaintenanceRunner = s.maintenance
+	s.diskUsage = diskusage.NewRunner(store, profile.Data, apiV1Service.AlertDiskUsage)
+	// Enter the maintenance window in progress, if any, before serving.
+	if err := s.maintenance.RunOnce(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to apply maintenance windows")
+	}
 
 	// Create and register RSS routes (needs markdown service from apiV1Service).
 	rss.NewRSSService(s.Profile, s.Store, apiV1Service.MarkdownService).RegisterRoutes(rootGroup)
+	// The profiling endpoints expose the internals of the server, they are opt-in and only served to the host.
+	if profile.EnableProfiling {
+		s.profiler.RegisterRoutes(echoServer, apiV1Service.RequireHost)
+	}
 	// Register gRPC gateway as api v1.
 	if err := apiV1Service.RegisterGateway(ctx, echoServer); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
@@ -107,6 +181,10 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 }
 
 func newRecoveryInterceptor(logStacktraces bool) grpc.UnaryServerInterceptor {
+	return grpcrecovery.UnaryServerInterceptor(newRecoveryOptions(logStacktraces)...)
+}
+
+func newRecoveryOptions(logStacktraces bool) []grpcrecovery.Option {
 	var recoveryOptions []grpcrecovery.Option
 	if logStacktraces {
 		recoveryOptions = append(recoveryOptions, grpcrecovery.WithRecoveryHandler(func(p any) error {
@@ -123,7 +201,7 @@ func newRecoveryInterceptor(logStacktraces bool) grpc.UnaryServerInterceptor {
 		}))
 	}
 
-	return grpcrecovery.UnaryServerInterceptor(recoveryOptions...)
+	return recoveryOptions
 }
 
 func (s *Server) Start(ctx context.Context) error {
@@ -185,6 +263,16 @@ func (s *Server) Shutdown(ctx context.Context) {
 	// Shutdown gRPC server.
 	s.grpcServer.GracefulStop()
 
+	// Notify the queued reactions before they are lost.
+	if err := s.reactionNotifier.RunOnce(ctx); err != nil {
+		slog.Error("failed to notify reactions", slog.String("error", err.Error()))
+	}
+
+	// Record the queued memo views before they are lost.
+	if err := s.memoViewRecorder.RunOnce(ctx); err != nil {
+		slog.Error("failed to record memo views", slog.String("error", err.Error()))
+	}
+
 	// Stop the profiler
 	if s.profiler != nil {
 		slog.Info("stopping profiler")
@@ -208,21 +296,120 @@ func (s *Server) Shutdown(ctx context.Context) {
 }
 
 func (s *Server) StartBackgroundRunners(ctx context.Context) {
-	// Create a separate context for each background runner
-	// This allows us to control cancellation for each runner independently
-	s3Context, s3Cancel := context.WithCancel(ctx)
-
-	// Store the cancel function so we can properly shut down runners
-	s.runnerCancelFuncs = append(s.runnerCancelFuncs, s3Cancel)
-
-	// Create and start S3 presign runner
+	// Presign the S3 attachments once before serving, so that their links are valid right away.
 	s3presignRunner := s3presign.NewRunner(s.Store)
-	s3presignRunner.RunOnce(ctx)
+	if err := s3presignRunner.RunOnce(ctx); err != nil {
+		slog.Error("failed to presign s3 attachments", "error", err)
+	}
+
+	runners := []scheduler.Runner{
+		{
+			Name:            "s3-presign",
+			Description:     "Refreshes the presigned URLs of attachments stored in S3.",
+			DefaultSchedule: "@every 12h",
+			Run:             s3presignRunner.RunOnce,
+		},
+		{
+			Name:            "link-check",
+			Description:     "Checks the links in memo contents and flags the broken ones.",
+			DefaultSchedule: "0 3 * * *",
+			Run:             linkcheck.NewRunner(s.Store, markdown.NewService()).RunOnce,
+		},
+		{
+			Name:            "reaction-notify",
+			Description:     "Notifies memo creators of the reactions to their memos, aggregating bursts of reactions.",
+			DefaultSchedule: "@every 5m",
+			Run:             s.reactionNotifier.RunOnce,
+		},
+		{
+			Name:            "memo-view-record",
+			Description:     "Records the queued views of the memos.",
+			DefaultSchedule: "@every 1m",
+			Run:             s.memoViewRecorder.RunOnce,
+		},
+		{
+			Name:            "attachment-classify",
+			Description:     "Tags the image attachments not classified yet as sensitive or not.",
+			DefaultSchedule: "@every 1h",
+			Run:             s.attachmentClassifier.RunOnce,
+		},
+		{
+			Name:            "attachment-extract",
+			Description:     "Extracts the text of the image and audio attachments not extracted yet with the AI provider.",
+			DefaultSchedule: "@every 1h",
+			Run:             s.attachmentExtractor.RunOnce,
+		},
+		{
+			Name:            "memo-embed",
+			Description:     "Computes the embeddings of the memos for semantic search.",
+			DefaultSchedule: "@every 1h",
+			Run:             s.memoEmbedder.RunOnce,
+		},
+		{
+			Name:            "memo-expiry",
+			Description:     "Archives or deletes the memos whose expire time has passed.",
+			DefaultSchedule: "@every 1m",
+			Run:             s.memoExpiry.RunOnce,
+		},
+		{
+			Name:            "ai-summary",
+			Description:     "Generates the recurring AI summaries scheduled by the users.",
+			DefaultSchedule: "@every 15m",
+			Run:             s.aiSummary.RunOnce,
+		},
+		{
+			Name:            "ai-job",
+			Description:     "Processes the queued AI jobs, including the ones interrupted by a restart.",
+			DefaultSchedule: "@every 1m",
+			Run:             s.aiJob.RunOnce,
+		},
+		{
+			Name:            "cold-storage",
+			Description:     "Moves old archived memos into cold storage.",
+			DefaultSchedule: "0 4 * * *",
+			Run:             coldstorage.NewRunner(s.Store).RunOnce,
+		},
+		{
+			Name:            "syndication",
+			Description:     "Mirrors the public memos of the instances the workspace subscribed to.",
+			DefaultSchedule: "@every 30m",
+			Run:             syndication.NewRunner(s.Store).RunOnce,
+		},
+		{
+			Name:            "disk-usage",
+			Description:     "Alerts the admins when the disk of the data directory fills up over the threshold.",
+			DefaultSchedule: "@every 10m",
+			Run:             s.diskUsage.RunOnce,
+		},
+		{
+			Name:            maintenance.RunnerName,
+			Description:     "Starts and ends the maintenance windows scheduled by the admins.",
+			DefaultSchedule: "@every 1m",
+			Run:             s.maintenance.RunOnce,
+			// The runner ends the maintenance windows that pause the other runners.
+			RunWhenPaused: true,
+		},
+	}
+	// Periodically reset the database back to the seed data on demo instances.
+	if s.Profile.IsDemo() {
+		runners = append(runners, scheduler.Runner{
+			Name:            "demo-reset",
+			Description:     "Resets the database back to the seed data.",
+			DefaultSchedule: "@every 2h",
+			Run:             demoreset.NewRunner(s.Store).RunOnce,
+		})
+	}
+	for _, runner := range runners {
+		if err := s.scheduler.Register(ctx, runner); err != nil {
+			slog.Error("failed to register runner", "runner", runner.Name, "error", err)
+		}
+	}
 
-	// Start continuous S3 presign runner
+	schedulerContext, schedulerCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, schedulerCancel)
 	go func() {
-		s3presignRunner.Run(s3Context)
-		slog.Info("s3presign runner stopped")
+		s.scheduler.Start(schedulerContext)
+		slog.Info("scheduler stopped")
 	}()
 
 	// Log the number of goroutines running
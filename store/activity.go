@@ -9,7 +9,9 @@ import (
 type ActivityType string
 
 const (
-	ActivityTypeMemoComment ActivityType = "MEMO_COMMENT"
+	ActivityTypeMemoComment  ActivityType = "MEMO_COMMENT"
+	ActivityTypeMemoReaction ActivityType = "MEMO_REACTION"
+	ActivityTypeMemoApproval ActivityType = "MEMO_APPROVAL"
 )
 
 func (t ActivityType) String() string {
@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// AIAuditLog is an AI action recorded for auditing: a change or a test of the AI configuration, or a generation
+// sending memo content to the AI provider.
+type AIAuditLog struct {
+	ID        int32
+	CreatedTs int64
+
+	// UserID is the user who performed the action, 0 for the actions of the workspace.
+	UserID int32
+	// Action is the audited action, e.g. "config_update" or "summary".
+	Action  string
+	Success bool
+	// Error is the error of the failed action.
+	Error   string
+	Payload *storepb.AIAuditLogPayload
+}
+
+type FindAIAuditLog struct {
+	UserID  *int32
+	Action  *string
+	Success *bool
+	// CreatedTsAfter and CreatedTsBefore bound the time of the actions, inclusive and exclusive.
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+func (s *Store) CreateAIAuditLog(ctx context.Context, create *AIAuditLog) (*AIAuditLog, error) {
+	if create.CreatedTs == 0 {
+		create.CreatedTs = time.Now().Unix()
+	}
+	return s.driver.CreateAIAuditLog(ctx, create)
+}
+
+// ListAIAuditLogs lists the audited AI actions, most recent first.
+func (s *Store) ListAIAuditLogs(ctx context.Context, find *FindAIAuditLog) ([]*AIAuditLog, error) {
+	return s.driver.ListAIAuditLogs(ctx, find)
+}
@@ -0,0 +1,51 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// AIDebugLog is the exact prompt and raw response of a call to the AI provider, stored while debug logging is on.
+// The prompt and the response are encrypted by the caller.
+type AIDebugLog struct {
+	ID        int32
+	CreatedTs int64
+
+	// UserID is the user the call was made for, 0 for the calls made for the workspace.
+	UserID    int32
+	Operation string
+	Model     string
+	Success   bool
+	Prompt    []byte
+	Response  []byte
+}
+
+type FindAIDebugLog struct {
+	UserID *int32
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type DeleteAIDebugLog struct {
+	// CreatedTsBefore only deletes the logs stored before that time, all of them when nil.
+	CreatedTsBefore *int64
+}
+
+func (s *Store) CreateAIDebugLog(ctx context.Context, create *AIDebugLog) (*AIDebugLog, error) {
+	if create.CreatedTs == 0 {
+		create.CreatedTs = time.Now().Unix()
+	}
+	return s.driver.CreateAIDebugLog(ctx, create)
+}
+
+// ListAIDebugLogs lists the debug logs, most recent first.
+func (s *Store) ListAIDebugLogs(ctx context.Context, find *FindAIDebugLog) ([]*AIDebugLog, error) {
+	return s.driver.ListAIDebugLogs(ctx, find)
+}
+
+// DeleteAIDebugLogs deletes the debug logs and returns the number of deleted logs.
+func (s *Store) DeleteAIDebugLogs(ctx context.Context, delete *DeleteAIDebugLog) (int64, error) {
+	return s.driver.DeleteAIDebugLogs(ctx, delete)
+}
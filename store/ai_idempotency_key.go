@@ -0,0 +1,58 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// AIIdempotencyKey is a summary request identified by the idempotency key of the client, in progress until its AI memo
+// is set. The retries of the request with the key are answered with that memo rather than generating another one.
+type AIIdempotencyKey struct {
+	UserID int32
+	Key    string
+	// Fingerprint is the hash of the parameters of the request, which the retries must send again.
+	Fingerprint string
+	// MemoID is the AI memo generated for the request, 0 while it is in progress.
+	MemoID    int32
+	CreatedTs int64
+}
+
+type FindAIIdempotencyKey struct {
+	UserID int32
+	Key    string
+}
+
+type UpdateAIIdempotencyKey struct {
+	UserID int32
+	Key    string
+	MemoID int32
+}
+
+type DeleteAIIdempotencyKey struct {
+	// UserID and Key delete the key of the user, when set.
+	UserID *int32
+	Key    *string
+	// CreatedTsBefore deletes the keys created before that time, when set.
+	CreatedTsBefore *int64
+}
+
+// CreateAIIdempotencyKey saves the key unless the user has it already, and reports whether it was saved.
+func (s *Store) CreateAIIdempotencyKey(ctx context.Context, create *AIIdempotencyKey) (bool, error) {
+	if create.CreatedTs == 0 {
+		create.CreatedTs = time.Now().Unix()
+	}
+	return s.driver.CreateAIIdempotencyKey(ctx, create)
+}
+
+// GetAIIdempotencyKey returns the key of the user, nil if there is none.
+func (s *Store) GetAIIdempotencyKey(ctx context.Context, find *FindAIIdempotencyKey) (*AIIdempotencyKey, error) {
+	return s.driver.GetAIIdempotencyKey(ctx, find)
+}
+
+func (s *Store) UpdateAIIdempotencyKey(ctx context.Context, update *UpdateAIIdempotencyKey) error {
+	return s.driver.UpdateAIIdempotencyKey(ctx, update)
+}
+
+func (s *Store) DeleteAIIdempotencyKeys(ctx context.Context, delete *DeleteAIIdempotencyKey) error {
+	return s.driver.DeleteAIIdempotencyKeys(ctx, delete)
+}
@@ -0,0 +1,101 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// AIJobType is the type of the work of an AI job.
+type AIJobType string
+
+const (
+	AIJobTypeSummary AIJobType = "SUMMARY"
+)
+
+func (t AIJobType) String() string {
+	return string(t)
+}
+
+// AIJobStatus is the status of an AI job.
+type AIJobStatus string
+
+const (
+	AIJobStatusQueued  AIJobStatus = "QUEUED"
+	AIJobStatusRunning AIJobStatus = "RUNNING"
+	AIJobStatusDone    AIJobStatus = "DONE"
+	AIJobStatusFailed  AIJobStatus = "FAILED"
+)
+
+func (s AIJobStatus) String() string {
+	return string(s)
+}
+
+// AIJob is an AI generation queued to be processed in the background.
+type AIJob struct {
+	ID        int32
+	CreatedTs int64
+	UpdatedTs int64
+
+	JobType AIJobType
+	// UserID is the user the job runs for.
+	UserID  int32
+	Status  AIJobStatus
+	Payload *storepb.AIJobPayload
+	// MemoUID is the uid of the memo the job created, once it is done.
+	MemoUID string
+	// Error is the error of the job if it failed.
+	Error string
+}
+
+type FindAIJob struct {
+	ID         *int32
+	UserID     *int32
+	StatusList []AIJobStatus
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type UpdateAIJob struct {
+	ID        int32
+	UpdatedTs *int64
+	Status    *AIJobStatus
+	MemoUID   *string
+	Error     *string
+}
+
+func (s *Store) CreateAIJob(ctx context.Context, create *AIJob) (*AIJob, error) {
+	if create.CreatedTs == 0 {
+		create.CreatedTs = time.Now().Unix()
+	}
+	if create.UpdatedTs == 0 {
+		create.UpdatedTs = create.CreatedTs
+	}
+	if create.Status == "" {
+		create.Status = AIJobStatusQueued
+	}
+	return s.driver.CreateAIJob(ctx, create)
+}
+
+// ListAIJobs lists the AI jobs, most recently queued first.
+func (s *Store) ListAIJobs(ctx context.Context, find *FindAIJob) ([]*AIJob, error) {
+	return s.driver.ListAIJobs(ctx, find)
+}
+
+func (s *Store) GetAIJob(ctx context.Context, find *FindAIJob) (*AIJob, error) {
+	list, err := s.ListAIJobs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateAIJob(ctx context.Context, update *UpdateAIJob) error {
+	return s.driver.UpdateAIJob(ctx, update)
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// AIPromptTemplate is a named system prompt of the AI summaries, with variables replaced when it is used.
+type AIPromptTemplate struct {
+	// CreatorID is the user the template belongs to, 0 for the templates of the workspace.
+	CreatorID   int32
+	Name        string
+	Description string
+	Content     string
+	UpdatedTs   int64
+}
+
+type FindAIPromptTemplate struct {
+	CreatorID *int32
+	Name      *string
+}
+
+type DeleteAIPromptTemplate struct {
+	CreatorID int32
+	Name      string
+}
+
+// UpsertAIPromptTemplate creates the template or replaces the one of the creator with the same name.
+func (s *Store) UpsertAIPromptTemplate(ctx context.Context, upsert *AIPromptTemplate) (*AIPromptTemplate, error) {
+	if upsert.UpdatedTs == 0 {
+		upsert.UpdatedTs = time.Now().Unix()
+	}
+	if err := s.driver.UpsertAIPromptTemplate(ctx, upsert); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+// ListAIPromptTemplates lists the templates ordered by creator and name.
+func (s *Store) ListAIPromptTemplates(ctx context.Context, find *FindAIPromptTemplate) ([]*AIPromptTemplate, error) {
+	return s.driver.ListAIPromptTemplates(ctx, find)
+}
+
+func (s *Store) GetAIPromptTemplate(ctx context.Context, find *FindAIPromptTemplate) (*AIPromptTemplate, error) {
+	list, err := s.ListAIPromptTemplates(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteAIPromptTemplate(ctx context.Context, delete *DeleteAIPromptTemplate) error {
+	return s.driver.DeleteAIPromptTemplate(ctx, delete)
+}
@@ -0,0 +1,44 @@
+package store
+
+import (
+	"context"
+)
+
+// AIRequestCount is the number of AI requests of a user in an hour.
+type AIRequestCount struct {
+	UserID int32
+	// HourTs is the start of the hour, in seconds.
+	HourTs int64
+	Count  int32
+}
+
+type FindAIRequestCount struct {
+	UserID int32
+	// HourTsAfter only lists the counts from that hour, inclusive.
+	HourTsAfter *int64
+}
+
+type DeleteAIRequestCount struct {
+	// HourTsBefore deletes the counts of the hours before that one.
+	HourTsBefore int64
+}
+
+// IncrementAIRequestCount atomically adds a request to the count of the user in the hour if the count is below the
+// limit, 0 for no limit, reporting whether the request was added.
+func (s *Store) IncrementAIRequestCount(ctx context.Context, userID int32, hourTs int64, limit int32) (bool, error) {
+	return s.driver.IncrementAIRequestCount(ctx, userID, hourTs, limit)
+}
+
+// DecrementAIRequestCount removes a request from the count of the user in the hour, e.g. a request that failed.
+func (s *Store) DecrementAIRequestCount(ctx context.Context, userID int32, hourTs int64) error {
+	return s.driver.DecrementAIRequestCount(ctx, userID, hourTs)
+}
+
+// ListAIRequestCounts returns the request counts of the user per hour, oldest hour first.
+func (s *Store) ListAIRequestCounts(ctx context.Context, find *FindAIRequestCount) ([]*AIRequestCount, error) {
+	return s.driver.ListAIRequestCounts(ctx, find)
+}
+
+func (s *Store) DeleteAIRequestCounts(ctx context.Context, delete *DeleteAIRequestCount) error {
+	return s.driver.DeleteAIRequestCounts(ctx, delete)
+}
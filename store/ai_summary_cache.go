@@ -0,0 +1,44 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// AISummaryCache is the AI memo generated for a summary request, returned again for the identical requests of the
+// user. The key is the hash of the source memos and of the options of the request.
+type AISummaryCache struct {
+	UserID    int32
+	CacheKey  string
+	MemoID    int32
+	CreatedTs int64
+}
+
+type FindAISummaryCache struct {
+	UserID   int32
+	CacheKey string
+	// CreatedTsAfter only finds the entries created from that time, inclusive.
+	CreatedTsAfter *int64
+}
+
+type DeleteAISummaryCache struct {
+	// CreatedTsBefore deletes the entries created before that time.
+	CreatedTsBefore int64
+}
+
+// UpsertAISummaryCache saves the entry, replacing the entry of the same user and key if any.
+func (s *Store) UpsertAISummaryCache(ctx context.Context, upsert *AISummaryCache) error {
+	if upsert.CreatedTs == 0 {
+		upsert.CreatedTs = time.Now().Unix()
+	}
+	return s.driver.UpsertAISummaryCache(ctx, upsert)
+}
+
+// GetAISummaryCache returns the entry of the user and key, nil if there is none.
+func (s *Store) GetAISummaryCache(ctx context.Context, find *FindAISummaryCache) (*AISummaryCache, error) {
+	return s.driver.GetAISummaryCache(ctx, find)
+}
+
+func (s *Store) DeleteAISummaryCaches(ctx context.Context, delete *DeleteAISummaryCache) error {
+	return s.driver.DeleteAISummaryCaches(ctx, delete)
+}
@@ -0,0 +1,64 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// AIUsage is a call to the AI provider.
+type AIUsage struct {
+	ID        int32
+	CreatedTs int64
+
+	// UserID is the user the call was made for, 0 for the calls made for the workspace, e.g. memo embeddings.
+	UserID int32
+	// Operation is the AI feature that made the call, e.g. "summary".
+	Operation        string
+	Model            string
+	PromptTokens     int64
+	CompletionTokens int64
+	LatencyMs        int64
+	Success          bool
+	// Error is the error of the failed call.
+	Error string
+}
+
+type FindAIUsage struct {
+	UserID    *int32
+	Operation *string
+	// CreatedTsAfter and CreatedTsBefore bound the time of the calls, inclusive and exclusive.
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+// AIUsageStats sums up the calls of a user for an operation.
+type AIUsageStats struct {
+	UserID           int32
+	Operation        string
+	Calls            int64
+	Failures         int64
+	PromptTokens     int64
+	CompletionTokens int64
+	LatencyMs        int64
+}
+
+func (s *Store) CreateAIUsage(ctx context.Context, create *AIUsage) (*AIUsage, error) {
+	if create.CreatedTs == 0 {
+		create.CreatedTs = time.Now().Unix()
+	}
+	return s.driver.CreateAIUsage(ctx, create)
+}
+
+// ListAIUsages lists the calls, most recent first.
+func (s *Store) ListAIUsages(ctx context.Context, find *FindAIUsage) ([]*AIUsage, error) {
+	return s.driver.ListAIUsages(ctx, find)
+}
+
+// ListAIUsageStats sums up the calls per user and operation. The pagination of find is ignored.
+func (s *Store) ListAIUsageStats(ctx context.Context, find *FindAIUsage) ([]*AIUsageStats, error) {
+	return s.driver.ListAIUsageStats(ctx, find)
+}
@@ -0,0 +1,88 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// AnnouncementSeverity is how prominently an announcement is shown.
+type AnnouncementSeverity string
+
+const (
+	AnnouncementSeverityInfo     AnnouncementSeverity = "INFO"
+	AnnouncementSeverityWarning  AnnouncementSeverity = "WARNING"
+	AnnouncementSeverityCritical AnnouncementSeverity = "CRITICAL"
+)
+
+func (s AnnouncementSeverity) String() string {
+	return string(s)
+}
+
+// Announcement is a message of the admins shown to all the users, e.g. about a maintenance window.
+type Announcement struct {
+	ID        int32
+	CreatedTs int64
+	UpdatedTs int64
+
+	CreatorID int32
+	// Content is the message in Markdown.
+	Content  string
+	Severity AnnouncementSeverity
+	// StartTs and EndTs bound the time the announcement is shown, EndTs is 0 for no end.
+	StartTs int64
+	EndTs   int64
+	// Dismissible is whether the users can hide the announcement.
+	Dismissible bool
+}
+
+type FindAnnouncement struct {
+	ID *int32
+	// ActiveTs only finds the announcements shown at that time.
+	ActiveTs *int64
+}
+
+type UpdateAnnouncement struct {
+	ID          int32
+	UpdatedTs   *int64
+	Content     *string
+	Severity    *AnnouncementSeverity
+	StartTs     *int64
+	EndTs       *int64
+	Dismissible *bool
+}
+
+type DeleteAnnouncement struct {
+	ID int32
+}
+
+func (s *Store) CreateAnnouncement(ctx context.Context, create *Announcement) (*Announcement, error) {
+	if create.CreatedTs == 0 {
+		create.CreatedTs = time.Now().Unix()
+	}
+	create.UpdatedTs = create.CreatedTs
+	return s.driver.CreateAnnouncement(ctx, create)
+}
+
+// ListAnnouncements lists the announcements, the ones starting last first.
+func (s *Store) ListAnnouncements(ctx context.Context, find *FindAnnouncement) ([]*Announcement, error) {
+	return s.driver.ListAnnouncements(ctx, find)
+}
+
+func (s *Store) GetAnnouncement(ctx context.Context, find *FindAnnouncement) (*Announcement, error) {
+	list, err := s.ListAnnouncements(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateAnnouncement(ctx context.Context, update *UpdateAnnouncement) error {
+	return s.driver.UpdateAnnouncement(ctx, update)
+}
+
+func (s *Store) DeleteAnnouncement(ctx context.Context, delete *DeleteAnnouncement) error {
+	return s.driver.DeleteAnnouncement(ctx, delete)
+}
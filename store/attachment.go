@@ -51,8 +51,18 @@ type FindAttachment struct {
 	MemoIDList     []int32
 	HasRelatedMemo bool
 	StorageType    *storepb.AttachmentStorageType
-	Limit          *int
-	Offset         *int
+	CreatedTsAfter *int64
+	Filters        []string
+	// TypePrefixList filters the attachments whose MIME type starts with one of the prefixes.
+	TypePrefixList []string
+	// MemoRowStatus filters the attachments of memos with the row status.
+	MemoRowStatus *RowStatus
+	// MemoVisibilityList filters the attachments of memos with one of the visibilities, or created
+	// by MemoViewerID when it is set.
+	MemoVisibilityList []Visibility
+	MemoViewerID       *int32
+	Limit              *int
+	Offset             *int
 }
 
 type UpdateAttachment struct {
@@ -121,9 +131,19 @@ func (s *Store) DeleteAttachment(ctx context.Context, delete *DeleteAttachment)
 		return errors.New("attachment not found")
 	}
 
-	if attachment.StorageType == storepb.AttachmentStorageType_LOCAL {
+	if err := s.deleteBlob(ctx, attachment.StorageType, attachment.Reference, attachment.Payload); err != nil {
+		return err
+	}
+
+	return s.driver.DeleteAttachment(ctx, delete)
+}
+
+// deleteBlob deletes the blob persisted out of the database, i.e. the local file or the S3 object.
+// The failures to delete S3 objects are only logged.
+func (s *Store) deleteBlob(ctx context.Context, storageType storepb.AttachmentStorageType, reference string, payload *storepb.AttachmentPayload) error {
+	if storageType == storepb.AttachmentStorageType_LOCAL {
 		if err := func() error {
-			p := filepath.FromSlash(attachment.Reference)
+			p := filepath.FromSlash(reference)
 			if !filepath.IsAbs(p) {
 				p = filepath.Join(s.profile.Data, p)
 			}
@@ -135,9 +155,9 @@ func (s *Store) DeleteAttachment(ctx context.Context, delete *DeleteAttachment)
 		}(); err != nil {
 			return errors.Wrap(err, "failed to delete local file")
 		}
-	} else if attachment.StorageType == storepb.AttachmentStorageType_S3 {
+	} else if storageType == storepb.AttachmentStorageType_S3 {
 		if err := func() error {
-			s3ObjectPayload := attachment.Payload.GetS3Object()
+			s3ObjectPayload := payload.GetS3Object()
 			if s3ObjectPayload == nil {
 				return errors.Errorf("No s3 object found")
 			}
@@ -165,6 +185,5 @@ func (s *Store) DeleteAttachment(ctx context.Context, delete *DeleteAttachment)
 			slog.Warn("Failed to delete s3 object", slog.Any("err", err))
 		}
 	}
-
-	return s.driver.DeleteAttachment(ctx, delete)
+	return nil
 }
@@ -0,0 +1,24 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// ErrBackupNotSupported is returned when the database driver cannot perform online backups.
+var ErrBackupNotSupported = errors.New("online backup is not supported by the database driver")
+
+// Backuper is implemented by drivers that can write a consistent copy of a live database.
+type Backuper interface {
+	Backup(ctx context.Context, dstPath string) error
+}
+
+// BackupDatabase writes a consistent copy of the live database to dstPath.
+func (s *Store) BackupDatabase(ctx context.Context, dstPath string) error {
+	backuper, ok := s.driver.(Backuper)
+	if !ok {
+		return ErrBackupNotSupported
+	}
+	return backuper.Backup(ctx, dstPath)
+}
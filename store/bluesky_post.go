@@ -0,0 +1,50 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// BlueskyPost is the Bluesky post a memo was cross-posted as, to delete it when the memo is deleted.
+type BlueskyPost struct {
+	ID        int32
+	MemoID    int32
+	CreatorID int32
+	// URI is the AT URI of the post, e.g. "at://did:plc:abc/app.bsky.feed.post/xyz".
+	URI       string
+	CreatedTs int64
+}
+
+type FindBlueskyPost struct {
+	MemoID *int32
+}
+
+type DeleteBlueskyPost struct {
+	MemoID int32
+}
+
+func (s *Store) CreateBlueskyPost(ctx context.Context, create *BlueskyPost) (*BlueskyPost, error) {
+	if create.CreatedTs == 0 {
+		create.CreatedTs = time.Now().Unix()
+	}
+	return s.driver.CreateBlueskyPost(ctx, create)
+}
+
+func (s *Store) ListBlueskyPosts(ctx context.Context, find *FindBlueskyPost) ([]*BlueskyPost, error) {
+	return s.driver.ListBlueskyPosts(ctx, find)
+}
+
+func (s *Store) GetBlueskyPost(ctx context.Context, find *FindBlueskyPost) (*BlueskyPost, error) {
+	list, err := s.ListBlueskyPosts(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteBlueskyPost(ctx context.Context, delete *DeleteBlueskyPost) error {
+	return s.driver.DeleteBlueskyPost(ctx, delete)
+}
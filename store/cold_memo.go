@@ -0,0 +1,51 @@
+package store
+
+import (
+	"context"
+)
+
+// MoveMemosToColdStorage describes which archived memos are moved into the cold_memo table.
+type MoveMemosToColdStorage struct {
+	// UpdatedBefore only moves archived memos last updated before this unix timestamp.
+	UpdatedBefore int64
+	// Limit is the maximum number of memos moved at once.
+	Limit int
+}
+
+type FindColdMemo struct {
+	ID        *int32
+	UID       *string
+	CreatorID *int32
+	// ContentSearch matches cold memos whose content contains the given text.
+	ContentSearch *string
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+// MoveMemosToColdStorage moves old archived memos out of the memo table, keeping the
+// hot timeline queries small. It returns the number of moved memos.
+func (s *Store) MoveMemosToColdStorage(ctx context.Context, move *MoveMemosToColdStorage) (int, error) {
+	return s.driver.MoveMemosToColdStorage(ctx, move)
+}
+
+func (s *Store) ListColdMemos(ctx context.Context, find *FindColdMemo) ([]*Memo, error) {
+	return s.driver.ListColdMemos(ctx, find)
+}
+
+func (s *Store) GetColdMemo(ctx context.Context, find *FindColdMemo) (*Memo, error) {
+	list, err := s.ListColdMemos(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// RestoreColdMemo moves a memo from cold storage back into the memo table, keeping its id.
+func (s *Store) RestoreColdMemo(ctx context.Context, id int32) error {
+	return s.driver.RestoreColdMemo(ctx, id)
+}
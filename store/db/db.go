@@ -1,6 +1,8 @@
 package db
 
 import (
+	"database/sql"
+
 	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/profile"
@@ -22,11 +24,27 @@ func NewDBDriver(profile *profile.Profile) (store.Driver, error) {
 		driver, err = mysql.NewDB(profile)
 	case "postgres":
 		driver, err = postgres.NewDB(profile)
+	case "cockroach":
+		driver, err = postgres.NewCockroachDB(profile)
 	default:
 		return nil, errors.New("unknown db driver")
 	}
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create db driver")
 	}
+	configureConnectionPool(driver.GetDB(), profile)
 	return driver, nil
 }
+
+// configureConnectionPool applies the connection pool limits from the profile.
+func configureConnectionPool(db *sql.DB, profile *profile.Profile) {
+	if profile.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(profile.MaxOpenConns)
+	}
+	if profile.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(profile.MaxIdleConns)
+	}
+	if profile.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(profile.ConnMaxLifetime)
+	}
+}
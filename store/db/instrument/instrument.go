@@ -0,0 +1,227 @@
+// Package instrument wraps database connections to log slow queries.
+package instrument
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Open opens a database like sql.Open. When threshold is positive, queries
+// taking longer than it are logged together with the types of their
+// parameters; parameter values are never logged.
+func Open(driverName, dsn string, threshold time.Duration) (*sql.DB, error) {
+	db, err := sql.Open(driverName, dsn)
+	if err != nil || threshold <= 0 {
+		return db, err
+	}
+
+	// sql.Open does not connect, so the handle is only used to resolve the driver.
+	d := db.Driver()
+	if err := db.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close database")
+	}
+	var connector driver.Connector = &dsnConnector{driver: d, dsn: dsn}
+	if driverContext, ok := d.(driver.DriverContext); ok {
+		if connector, err = driverContext.OpenConnector(dsn); err != nil {
+			return nil, err
+		}
+	}
+	return sql.OpenDB(WrapConnector(connector, threshold)), nil
+}
+
+// WrapConnector returns a connector whose connections log queries slower than threshold.
+func WrapConnector(connector driver.Connector, threshold time.Duration) driver.Connector {
+	if threshold <= 0 {
+		return connector
+	}
+	return &instrumentedConnector{connector: connector, threshold: threshold}
+}
+
+type dsnConnector struct {
+	driver driver.Driver
+	dsn    string
+}
+
+func (c *dsnConnector) Connect(_ context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+func (c *dsnConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+type instrumentedConnector struct {
+	connector driver.Connector
+	threshold time.Duration
+}
+
+func (c *instrumentedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedConn{Conn: conn, threshold: c.threshold}, nil
+}
+
+func (c *instrumentedConnector) Driver() driver.Driver {
+	return c.connector.Driver()
+}
+
+// instrumentedConn times statements executed on the wrapped connection.
+// Optional driver interfaces are forwarded, falling back to driver.ErrSkip
+// so database/sql uses its default behaviour when they are not implemented.
+type instrumentedConn struct {
+	driver.Conn
+	threshold time.Duration
+}
+
+// Unwrap returns the underlying driver connection.
+func (c *instrumentedConn) Unwrap() driver.Conn {
+	return c.Conn
+}
+
+func (c *instrumentedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	defer logSlowQuery(time.Now(), c.threshold, query, args)
+	return execer.ExecContext(ctx, query, args)
+}
+
+func (c *instrumentedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	defer logSlowQuery(time.Now(), c.threshold, query, args)
+	return queryer.QueryContext(ctx, query, args)
+}
+
+func (c *instrumentedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	var stmt driver.Stmt
+	var err error
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		stmt, err = preparer.PrepareContext(ctx, query)
+	} else {
+		stmt, err = c.Conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedStmt{Stmt: stmt, query: query, threshold: c.threshold}, nil
+}
+
+func (c *instrumentedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	//nolint:staticcheck // Fallback for drivers without BeginTx support.
+	return c.Conn.Begin()
+}
+
+func (c *instrumentedConn) CheckNamedValue(value *driver.NamedValue) error {
+	if checker, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(value)
+	}
+	return driver.ErrSkip
+}
+
+func (c *instrumentedConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *instrumentedConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *instrumentedConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+type instrumentedStmt struct {
+	driver.Stmt
+	query     string
+	threshold time.Duration
+}
+
+func (s *instrumentedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	defer logSlowQuery(time.Now(), s.threshold, s.query, args)
+	if execer, ok := s.Stmt.(driver.StmtExecContext); ok {
+		return execer.ExecContext(ctx, args)
+	}
+	values, err := namedValuesToValues(args)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:staticcheck // Fallback for drivers without StmtExecContext support.
+	return s.Stmt.Exec(values)
+}
+
+func (s *instrumentedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	defer logSlowQuery(time.Now(), s.threshold, s.query, args)
+	if queryer, ok := s.Stmt.(driver.StmtQueryContext); ok {
+		return queryer.QueryContext(ctx, args)
+	}
+	values, err := namedValuesToValues(args)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:staticcheck // Fallback for drivers without StmtQueryContext support.
+	return s.Stmt.Query(values)
+}
+
+func (s *instrumentedStmt) CheckNamedValue(value *driver.NamedValue) error {
+	if checker, ok := s.Stmt.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(value)
+	}
+	return driver.ErrSkip
+}
+
+func namedValuesToValues(args []driver.NamedValue) ([]driver.Value, error) {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		if arg.Name != "" {
+			return nil, errors.New("driver does not support named parameters")
+		}
+		values[i] = arg.Value
+	}
+	return values, nil
+}
+
+func logSlowQuery(start time.Time, threshold time.Duration, query string, args []driver.NamedValue) {
+	elapsed := time.Since(start)
+	if elapsed < threshold {
+		return
+	}
+	slog.Warn("slow query",
+		slog.Duration("duration", elapsed),
+		slog.String("query", strings.Join(strings.Fields(query), " ")),
+		slog.Any("args", redactArgs(args)),
+	)
+}
+
+// redactArgs replaces parameter values with their types so that logs never contain user data.
+func redactArgs(args []driver.NamedValue) []string {
+	redacted := make([]string, len(args))
+	for i, arg := range args {
+		redacted[i] = fmt.Sprintf("%T", arg.Value)
+	}
+	return redacted
+}
@@ -0,0 +1,48 @@
+package instrument
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	// Import the SQLite driver.
+	_ "modernc.org/sqlite"
+)
+
+func TestOpenLogsSlowQueries(t *testing.T) {
+	ctx := context.Background()
+	var buf bytes.Buffer
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, nil)))
+	defer slog.SetDefault(defaultLogger)
+
+	db, err := Open("sqlite", ":memory:", time.Nanosecond)
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	_, err = db.ExecContext(ctx, "CREATE TABLE secret (value TEXT)")
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "INSERT INTO secret (value) VALUES (?)", "hunter2")
+	require.NoError(t, err)
+	var value string
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT value FROM secret WHERE value = ?", "hunter2").Scan(&value))
+	require.Equal(t, "hunter2", value)
+
+	logs := buf.String()
+	require.Contains(t, logs, "slow query")
+	require.Contains(t, logs, "SELECT value FROM secret WHERE value = ?")
+	require.Contains(t, logs, "string")
+	require.NotContains(t, logs, "hunter2")
+}
+
+func TestOpenWithoutThreshold(t *testing.T) {
+	db, err := Open("sqlite", ":memory:", 0)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.PingContext(context.Background()))
+}
@@ -0,0 +1,102 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateAIAuditLog(ctx context.Context, create *store.AIAuditLog) (*store.AIAuditLog, error) {
+	payloadString := "{}"
+	if create.Payload != nil {
+		bytes, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal AI audit log payload")
+		}
+		payloadString = string(bytes)
+	}
+
+	fields := []string{"`created_ts`", "`user_id`", "`action`", "`success`", "`error`", "`payload`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?"}
+	args := []any{create.CreatedTs, create.UserID, create.Action, create.Success, create.Error, payloadString}
+
+	stmt := "INSERT INTO `ai_audit_log` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	create.ID = int32(id)
+	return create, nil
+}
+
+func (d *DB) ListAIAuditLogs(ctx context.Context, find *store.FindAIAuditLog) ([]*store.AIAuditLog, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
+	}
+	if find.Action != nil {
+		where, args = append(where, "`action` = ?"), append(args, *find.Action)
+	}
+	if find.Success != nil {
+		where, args = append(where, "`success` = ?"), append(args, *find.Success)
+	}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "`created_ts` >= ?"), append(args, *find.CreatedTsAfter)
+	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "`created_ts` < ?"), append(args, *find.CreatedTsBefore)
+	}
+
+	query := "SELECT `id`, `created_ts`, `user_id`, `action`, `success`, `error`, `payload` FROM `ai_audit_log` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.AIAuditLog{}
+	for rows.Next() {
+		auditLog := &store.AIAuditLog{}
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&auditLog.ID,
+			&auditLog.CreatedTs,
+			&auditLog.UserID,
+			&auditLog.Action,
+			&auditLog.Success,
+			&auditLog.Error,
+			&payloadBytes,
+		); err != nil {
+			return nil, err
+		}
+
+		payload := &storepb.AIAuditLogPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, err
+		}
+		auditLog.Payload = payload
+		list = append(list, auditLog)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
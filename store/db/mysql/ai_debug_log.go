@@ -0,0 +1,83 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateAIDebugLog(ctx context.Context, create *store.AIDebugLog) (*store.AIDebugLog, error) {
+	fields := []string{"`created_ts`", "`user_id`", "`operation`", "`model`", "`success`", "`prompt`", "`response`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
+	args := []any{create.CreatedTs, create.UserID, create.Operation, create.Model, create.Success, create.Prompt, create.Response}
+
+	stmt := "INSERT INTO `ai_debug_log` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	create.ID = int32(id)
+	return create, nil
+}
+
+func (d *DB) ListAIDebugLogs(ctx context.Context, find *store.FindAIDebugLog) ([]*store.AIDebugLog, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
+	}
+
+	query := "SELECT `id`, `created_ts`, `user_id`, `operation`, `model`, `success`, `prompt`, `response` FROM `ai_debug_log` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.AIDebugLog{}
+	for rows.Next() {
+		debugLog := &store.AIDebugLog{}
+		if err := rows.Scan(
+			&debugLog.ID,
+			&debugLog.CreatedTs,
+			&debugLog.UserID,
+			&debugLog.Operation,
+			&debugLog.Model,
+			&debugLog.Success,
+			&debugLog.Prompt,
+			&debugLog.Response,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, debugLog)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteAIDebugLogs(ctx context.Context, delete *store.DeleteAIDebugLog) (int64, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.CreatedTsBefore != nil {
+		where, args = append(where, "`created_ts` < ?"), append(args, *delete.CreatedTsBefore)
+	}
+	result, err := d.db.ExecContext(ctx, "DELETE FROM `ai_debug_log` WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,57 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateAIIdempotencyKey(ctx context.Context, create *store.AIIdempotencyKey) (bool, error) {
+	stmt := "INSERT INTO `ai_idempotency_key` (`user_id`, `idempotency_key`, `fingerprint`, `memo_id`, `created_ts`) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE `user_id` = `user_id`"
+	result, err := d.db.ExecContext(ctx, stmt, create.UserID, create.Key, create.Fingerprint, create.MemoID, create.CreatedTs)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows == 1, nil
+}
+
+func (d *DB) GetAIIdempotencyKey(ctx context.Context, find *store.FindAIIdempotencyKey) (*store.AIIdempotencyKey, error) {
+	key := &store.AIIdempotencyKey{}
+	if err := d.db.QueryRowContext(ctx, "SELECT `user_id`, `idempotency_key`, `fingerprint`, `memo_id`, `created_ts` FROM `ai_idempotency_key` WHERE `user_id` = ? AND `idempotency_key` = ?", find.UserID, find.Key).Scan(
+		&key.UserID, &key.Key, &key.Fingerprint, &key.MemoID, &key.CreatedTs,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return key, nil
+}
+
+func (d *DB) UpdateAIIdempotencyKey(ctx context.Context, update *store.UpdateAIIdempotencyKey) error {
+	_, err := d.db.ExecContext(ctx, "UPDATE `ai_idempotency_key` SET `memo_id` = ? WHERE `user_id` = ? AND `idempotency_key` = ?", update.MemoID, update.UserID, update.Key)
+	return err
+}
+
+func (d *DB) DeleteAIIdempotencyKeys(ctx context.Context, delete *store.DeleteAIIdempotencyKey) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.UserID != nil {
+		where, args = append(where, "`user_id` = "+"?"), append(args, *delete.UserID)
+	}
+	if delete.Key != nil {
+		where, args = append(where, "`idempotency_key` = "+"?"), append(args, *delete.Key)
+	}
+	if delete.CreatedTsBefore != nil {
+		where, args = append(where, "`created_ts` < "+"?"), append(args, *delete.CreatedTsBefore)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `ai_idempotency_key` WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
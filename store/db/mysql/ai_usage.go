@@ -0,0 +1,119 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateAIUsage(ctx context.Context, create *store.AIUsage) (*store.AIUsage, error) {
+	fields := []string{"`created_ts`", "`user_id`", "`operation`", "`model`", "`prompt_tokens`", "`completion_tokens`", "`latency_ms`", "`success`", "`error`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?"}
+	args := []any{create.CreatedTs, create.UserID, create.Operation, create.Model, create.PromptTokens, create.CompletionTokens, create.LatencyMs, create.Success, create.Error}
+
+	stmt := "INSERT INTO `ai_usage` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	create.ID = int32(id)
+	return create, nil
+}
+
+func (d *DB) ListAIUsages(ctx context.Context, find *store.FindAIUsage) ([]*store.AIUsage, error) {
+	where, args := buildAIUsageWhere(find)
+	query := "SELECT `id`, `created_ts`, `user_id`, `operation`, `model`, `prompt_tokens`, `completion_tokens`, `latency_ms`, `success`, `error` FROM `ai_usage` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.AIUsage{}
+	for rows.Next() {
+		usage := &store.AIUsage{}
+		if err := rows.Scan(
+			&usage.ID,
+			&usage.CreatedTs,
+			&usage.UserID,
+			&usage.Operation,
+			&usage.Model,
+			&usage.PromptTokens,
+			&usage.CompletionTokens,
+			&usage.LatencyMs,
+			&usage.Success,
+			&usage.Error,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, usage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) ListAIUsageStats(ctx context.Context, find *store.FindAIUsage) ([]*store.AIUsageStats, error) {
+	where, args := buildAIUsageWhere(find)
+	query := "SELECT `user_id`, `operation`, COUNT(*), SUM(CASE WHEN `success` THEN 0 ELSE 1 END), SUM(`prompt_tokens`), SUM(`completion_tokens`), SUM(`latency_ms`) FROM `ai_usage` WHERE " + strings.Join(where, " AND ") + " GROUP BY `user_id`, `operation` ORDER BY `user_id`, `operation`"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.AIUsageStats{}
+	for rows.Next() {
+		stats := &store.AIUsageStats{}
+		if err := rows.Scan(
+			&stats.UserID,
+			&stats.Operation,
+			&stats.Calls,
+			&stats.Failures,
+			&stats.PromptTokens,
+			&stats.CompletionTokens,
+			&stats.LatencyMs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, stats)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func buildAIUsageWhere(find *store.FindAIUsage) ([]string, []any) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
+	}
+	if find.Operation != nil {
+		where, args = append(where, "`operation` = ?"), append(args, *find.Operation)
+	}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "`created_ts` >= ?"), append(args, *find.CreatedTsAfter)
+	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "`created_ts` < ?"), append(args, *find.CreatedTsBefore)
+	}
+	return where, args
+}
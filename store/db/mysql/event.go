@@ -0,0 +1,85 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateEvent(ctx context.Context, create *store.Event) (*store.Event, error) {
+	payload := create.Payload
+	if payload == "" {
+		payload = "{}"
+	}
+	fields := []string{"`user_id`", "`type`", "`resource`", "`payload`"}
+	placeholder := []string{"?", "?", "?", "?"}
+	args := []any{create.UserID, create.Type, create.Resource, payload}
+
+	stmt := "INSERT INTO `event` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute statement")
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get last insert id")
+	}
+
+	id32 := int32(id)
+	list, err := d.ListEvents(ctx, &store.FindEvent{ID: &id32})
+	if err != nil || len(list) == 0 {
+		return nil, errors.Wrap(err, "failed to find event")
+	}
+
+	return list[0], nil
+}
+
+func (d *DB) ListEvents(ctx context.Context, find *store.FindEvent) ([]*store.Event, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
+	}
+	if find.AfterID != nil {
+		where, args = append(where, "`id` > ?"), append(args, *find.AfterID)
+	}
+
+	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `user_id`, `type`, `resource`, `payload` FROM `event` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` ASC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Event{}
+	for rows.Next() {
+		event := &store.Event{}
+		if err := rows.Scan(
+			&event.ID,
+			&event.CreatedTs,
+			&event.UserID,
+			&event.Type,
+			&event.Resource,
+			&event.Payload,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
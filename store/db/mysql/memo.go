@@ -2,8 +2,10 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -14,37 +16,108 @@ import (
 )
 
 func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	id, err := insertMemo(ctx, tx, create)
+	if err != nil {
+		return nil, err
+	}
+	if err := refreshMemoTags(ctx, tx, []int32{id}); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	memo, err := d.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if memo == nil {
+		return nil, errors.Errorf("failed to create memo")
+	}
+	return memo, nil
+}
+
+func (d *DB) CreateMemoWithAssociations(ctx context.Context, create *store.Memo, associations *store.MemoAssociations) (*store.Memo, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	id, err := insertMemo(ctx, tx, create)
+	if err != nil {
+		return nil, err
+	}
+	if err := refreshMemoTags(ctx, tx, []int32{id}); err != nil {
+		return nil, err
+	}
+	memoIDs := []int32{id}
+	for _, relation := range associations.Relations {
+		relation.MemoID = id
+		if _, err := tx.ExecContext(ctx, "INSERT INTO `memo_relation` (`memo_id`, `related_memo_id`, `type`) VALUES (?, ?, ?)", relation.MemoID, relation.RelatedMemoID, relation.Type); err != nil {
+			return nil, err
+		}
+		memoIDs = append(memoIDs, relation.RelatedMemoID)
+	}
+	if err := refreshMemoRelationCounts(ctx, tx, memoIDs); err != nil {
+		return nil, err
+	}
+	// The first attachment gets the latest updated_ts, attachments are listed by updated_ts DESC.
+	now := time.Now().Unix()
+	for index, attachmentID := range associations.AttachmentIDs {
+		updatedTs := now + int64(len(associations.AttachmentIDs)-1-index)
+		if _, err := tx.ExecContext(ctx, "UPDATE `resource` SET `memo_id` = ?, `updated_ts` = FROM_UNIXTIME(?) WHERE `id` = ?", id, updatedTs, attachmentID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	memo, err := d.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if memo == nil {
+		return nil, errors.Errorf("failed to create memo")
+	}
+	return memo, nil
+}
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func insertMemo(ctx context.Context, db execer, create *store.Memo) (int32, error) {
 	fields := []string{"`uid`", "`creator_id`", "`content`", "`visibility`", "`payload`"}
 	placeholder := []string{"?", "?", "?", "?", "?"}
 	payload := "{}"
 	if create.Payload != nil {
 		payloadBytes, err := protojson.Marshal(create.Payload)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 		payload = string(payloadBytes)
 	}
 	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload}
 
 	stmt := "INSERT INTO `memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := db.ExecContext(ctx, stmt, args...)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	rawID, err := result.LastInsertId()
 	if err != nil {
-		return nil, err
-	}
-	id := int32(rawID)
-	memo, err := d.GetMemo(ctx, &store.FindMemo{ID: &id})
-	if err != nil {
-		return nil, err
-	}
-	if memo == nil {
-		return nil, errors.Errorf("failed to create memo")
+		return 0, err
 	}
-	return memo, nil
+	return int32(rawID), nil
 }
 
 func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
@@ -97,6 +170,15 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		}
 		where = append(where, fmt.Sprintf("`memo`.`visibility` in (%s)", strings.Join(placeholder, ",")))
 	}
+	if v := find.UnreadByUserID; v != nil {
+		where, args = append(where, "NOT EXISTS (SELECT 1 FROM `memo_read_state` WHERE `memo_read_state`.`memo_id` = `memo`.`id` AND `memo_read_state`.`user_id` = ? AND `memo_read_state`.`read_ts` >= UNIX_TIMESTAMP(`memo`.`updated_ts`))"), append(args, *v)
+	}
+	if v := find.SubscribedByUserID; v != nil {
+		where, args = append(where, "EXISTS (SELECT 1 FROM `memo_subscription` WHERE `memo_subscription`.`memo_id` = `memo`.`id` AND `memo_subscription`.`user_id` = ? AND `memo_subscription`.`subscribed` = TRUE)"), append(args, *v)
+	}
+	if v := find.ExpiredBefore; v != nil {
+		where, args = append(where, "CAST(JSON_UNQUOTE(JSON_EXTRACT(`memo`.`payload`, '$.expiry.expireTs')) AS SIGNED) <= ?"), append(args, *v)
+	}
 	if find.ExcludeComments {
 		having = append(having, "`parent_uid` IS NULL")
 	}
@@ -109,6 +191,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByPinned {
 		orderBy = append(orderBy, "`pinned` DESC")
 	}
+	if v := find.OrderBySearchScore; v != nil {
+		orderBy = append(orderBy, searchScoreExpr(v, &args)+" DESC")
+	}
 	if find.OrderByUpdatedTs {
 		orderBy = append(orderBy, "`updated_ts` "+order)
 	} else {
@@ -126,6 +211,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		"`memo`.`visibility` AS `visibility`",
 		"`memo`.`pinned` AS `pinned`",
 		"`memo`.`payload` AS `payload`",
+		"`memo`.`comment_count` AS `comment_count`",
+		"`memo`.`reaction_count` AS `reaction_count`",
+		"`memo`.`relation_count` AS `relation_count`",
 		"CASE WHEN `parent_memo`.`uid` IS NOT NULL THEN `parent_memo`.`uid` ELSE NULL END AS `parent_uid`",
 	}
 	if !find.ExcludeContent {
@@ -165,6 +253,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.Visibility,
 			&memo.Pinned,
 			&payloadBytes,
+			&memo.CommentCount,
+			&memo.ReactionCount,
+			&memo.RelationCount,
 			&memo.ParentUID,
 		}
 		if !find.ExcludeContent {
@@ -237,21 +328,43 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	args = append(args, update.ID)
 
 	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	if update.Payload == nil {
+		_, err := d.db.ExecContext(ctx, stmt, args...)
+		return err
+	}
+
+	// The tags of the payload are indexed along with it.
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	if err := refreshMemoTags(ctx, tx, []int32{update.ID}); err != nil {
 		return err
 	}
-	return nil
+	return tx.Commit()
 }
 
 func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	where, args := []string{"`id` = ?"}, []any{delete.ID}
 	stmt := "DELETE FROM `memo` WHERE " + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	result, err := tx.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
 	if _, err := result.RowsAffected(); err != nil {
 		return err
 	}
-	return nil
+	if err := refreshMemoTags(ctx, tx, []int32{delete.ID}); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
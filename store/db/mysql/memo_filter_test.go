@@ -18,17 +18,28 @@ func TestConvertExprToSQL(t *testing.T) {
 	}{
 		{
 			filter: `tag in ["tag1", "tag2"]`,
-			want:   "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?))",
-			args:   []any{`"tag1"`, `"tag2"`},
+			want:   "EXISTS (SELECT 1 FROM `memo_tag` WHERE `memo_tag`.`memo_id` = `memo`.`id` AND `memo_tag`.`tag` IN (?, ?))",
+			args:   []any{"tag1", "tag2"},
 		},
 		{
 			filter: `!(tag in ["tag1", "tag2"])`,
-			want:   "NOT ((JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?)))",
-			args:   []any{`"tag1"`, `"tag2"`},
+			want:   "NOT (EXISTS (SELECT 1 FROM `memo_tag` WHERE `memo_tag`.`memo_id` = `memo`.`id` AND `memo_tag`.`tag` IN (?, ?)))",
+			args:   []any{"tag1", "tag2"},
 		},
 		{
 			filter: `content.contains("memos")`,
-			want:   "`memo`.`content` LIKE ?",
+			want:   "(`memo`.`content` LIKE ? OR EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND JSON_UNQUOTE(JSON_EXTRACT(`resource`.`payload`, '$.extractedText')) LIKE ?))",
+			args:   []any{"%memos%", "%memos%"},
+		},
+		{
+			// MySQL has no trigram matching, the fuzzy search is an exact one.
+			filter: `content.fuzzy_contains("kubernetes")`,
+			want:   "(`memo`.`content` LIKE ? OR EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND JSON_UNQUOTE(JSON_EXTRACT(`resource`.`payload`, '$.extractedText')) LIKE ?))",
+			args:   []any{"%kubernetes%", "%kubernetes%"},
+		},
+		{
+			filter: `comment_content.contains("memos")`,
+			want:   "EXISTS (SELECT 1 FROM `memo_relation` JOIN `memo` AS `comment_memo` ON `comment_memo`.`id` = `memo_relation`.`memo_id` WHERE `memo_relation`.`related_memo_id` = `memo`.`id` AND `memo_relation`.`type` = 'COMMENT' AND `comment_memo`.`row_status` = 'NORMAL' AND (`comment_memo`.`visibility` = 'PUBLIC' OR `comment_memo`.`visibility` = `memo`.`visibility`) AND `comment_memo`.`content` LIKE ?)",
 			args:   []any{"%memos%"},
 		},
 		{
@@ -36,6 +47,31 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "`memo`.`visibility` IN (?)",
 			args:   []any{"PUBLIC"},
 		},
+		{
+			filter: `language == "de"`,
+			want:   "COALESCE(JSON_UNQUOTE(JSON_EXTRACT(`memo`.`payload`, '$.language')), JSON_UNQUOTE(JSON_EXTRACT(`memo`.`payload`, '$.detectedLanguage'))) = ?",
+			args:   []any{"de"},
+		},
+		{
+			filter: `language in ["de", "fr"]`,
+			want:   "COALESCE(JSON_UNQUOTE(JSON_EXTRACT(`memo`.`payload`, '$.language')), JSON_UNQUOTE(JSON_EXTRACT(`memo`.`payload`, '$.detectedLanguage'))) IN (?,?)",
+			args:   []any{"de", "fr"},
+		},
+		{
+			filter: `reading_status == "finished" && reading_rating >= 8`,
+			want:   "(JSON_UNQUOTE(JSON_EXTRACT(`memo`.`payload`, '$.reading.status')) = ? AND COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.reading.rating') AS SIGNED), 0) >= ?)",
+			args:   []any{"finished", int64(8)},
+		},
+		{
+			filter: `reading_author.contains("Le Guin") && reading_finished_date >= "2025-01-01"`,
+			want:   "(JSON_UNQUOTE(JSON_EXTRACT(`memo`.`payload`, '$.reading.author')) LIKE ? AND JSON_UNQUOTE(JSON_EXTRACT(`memo`.`payload`, '$.reading.finishedDate')) >= ?)",
+			args:   []any{"%Le Guin%", "2025-01-01"},
+		},
+		{
+			filter: `approval_state == "PENDING"`,
+			want:   "COALESCE(JSON_UNQUOTE(JSON_EXTRACT(`memo`.`payload`, '$.approval.state')), '') = ?",
+			args:   []any{"PENDING"},
+		},
 		{
 			filter: `visibility in ["PUBLIC", "PRIVATE"]`,
 			want:   "`memo`.`visibility` IN (?,?)",
@@ -43,8 +79,8 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `tag in ['tag1'] || content.contains('hello')`,
-			want:   "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR `memo`.`content` LIKE ?)",
-			args:   []any{`"tag1"`, "%hello%"},
+			want:   "(EXISTS (SELECT 1 FROM `memo_tag` WHERE `memo_tag`.`memo_id` = `memo`.`id` AND `memo_tag`.`tag` IN (?)) OR (`memo`.`content` LIKE ? OR EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND JSON_UNQUOTE(JSON_EXTRACT(`resource`.`payload`, '$.extractedText')) LIKE ?)))",
+			args:   []any{"tag1", "%hello%", "%hello%"},
 		},
 		{
 			filter: `1`,
@@ -58,7 +94,7 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `has_task_list`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON)",
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') <=> CAST('true' AS JSON)",
 			args:   []any{},
 		},
 		{
@@ -78,18 +114,23 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `!has_task_list`,
-			want:   "NOT (JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON))",
+			want:   "NOT (JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') <=> CAST('true' AS JSON))",
+			args:   []any{},
+		},
+		{
+			filter: `!is_ai_generated`,
+			want:   "NOT (JSON_EXTRACT(`memo`.`payload`, '$.property.isAiGenerated') <=> CAST('true' AS JSON))",
 			args:   []any{},
 		},
 		{
 			filter: `has_task_list && pinned`,
-			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON) AND `memo`.`pinned` IS TRUE)",
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') <=> CAST('true' AS JSON) AND `memo`.`pinned` IS TRUE)",
 			args:   []any{},
 		},
 		{
 			filter: `has_task_list && content.contains("todo")`,
-			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON) AND `memo`.`content` LIKE ?)",
-			args:   []any{"%todo%"},
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') <=> CAST('true' AS JSON) AND (`memo`.`content` LIKE ? OR EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND JSON_UNQUOTE(JSON_EXTRACT(`resource`.`payload`, '$.extractedText')) LIKE ?)))",
+			args:   []any{"%todo%", "%todo%"},
 		},
 		{
 			filter: `created_ts > now() - 60 * 60 * 24`,
@@ -108,8 +149,8 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `"work" in tags`,
-			want:   "JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?)",
-			args:   []any{`"work"`},
+			want:   "EXISTS (SELECT 1 FROM `memo_tag` WHERE `memo_tag`.`memo_id` = `memo`.`id` AND `memo_tag`.`tag` IN (?))",
+			args:   []any{"work"},
 		},
 		{
 			filter: `size(tags) == 2`,
@@ -133,17 +174,27 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `has_link`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasLink') = CAST('true' AS JSON)",
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasLink') <=> CAST('true' AS JSON)",
 			args:   []any{},
 		},
 		{
 			filter: `has_code`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasCode') = CAST('true' AS JSON)",
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasCode') <=> CAST('true' AS JSON)",
+			args:   []any{},
+		},
+		{
+			filter: `has_attachment`,
+			want:   "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`)",
+			args:   []any{},
+		},
+		{
+			filter: `has_attachment == false`,
+			want:   "NOT (EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`))",
 			args:   []any{},
 		},
 		{
 			filter: `has_incomplete_tasks`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') = CAST('true' AS JSON)",
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') <=> CAST('true' AS JSON)",
 			args:   []any{},
 		},
 	}
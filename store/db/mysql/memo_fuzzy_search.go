@@ -0,0 +1,12 @@
+package mysql
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// PrepareFuzzySearch fails, MySQL has no trigram matching.
+func (*DB) PrepareFuzzySearch(_ context.Context) error {
+	return errors.New("fuzzy search is not supported on MySQL")
+}
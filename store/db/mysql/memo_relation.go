@@ -11,14 +11,24 @@ import (
 
 func (d *DB) UpsertMemoRelation(ctx context.Context, create *store.MemoRelation) (*store.MemoRelation, error) {
 	stmt := "INSERT INTO `memo_relation` (`memo_id`, `related_memo_id`, `type`) VALUES (?, ?, ?)"
-	_, err := d.db.ExecContext(
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(
 		ctx,
 		stmt,
 		create.MemoID,
 		create.RelatedMemoID,
 		create.Type,
-	)
-	if err != nil {
+	); err != nil {
+		return nil, err
+	}
+	if err := refreshMemoRelationCounts(ctx, tx, []int32{create.MemoID, create.RelatedMemoID}); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
@@ -39,9 +49,33 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 	if find.RelatedMemoID != nil {
 		where, args = append(where, "`related_memo_id` = ?"), append(args, find.RelatedMemoID)
 	}
+	if len(find.MemoIDList) > 0 {
+		placeholders := make([]string, 0, len(find.MemoIDList))
+		for _, id := range find.MemoIDList {
+			placeholders = append(placeholders, "?")
+			args = append(args, id)
+		}
+		where = append(where, "`memo_id` IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if len(find.RelatedMemoIDList) > 0 {
+		placeholders := make([]string, 0, len(find.RelatedMemoIDList))
+		for _, id := range find.RelatedMemoIDList {
+			placeholders = append(placeholders, "?")
+			args = append(args, id)
+		}
+		where = append(where, "`related_memo_id` IN ("+strings.Join(placeholders, ",")+")")
+	}
 	if find.Type != nil {
 		where, args = append(where, "`type` = ?"), append(args, find.Type)
 	}
+	if len(find.TypeList) > 0 {
+		placeholders := make([]string, 0, len(find.TypeList))
+		for _, relationType := range find.TypeList {
+			placeholders = append(placeholders, "?")
+			args = append(args, relationType)
+		}
+		where = append(where, "`type` IN ("+strings.Join(placeholders, ",")+")")
+	}
 	if find.MemoFilter != nil {
 		engine, err := filter.DefaultEngine()
 		if err != nil {
@@ -98,13 +132,44 @@ func (d *DB) DeleteMemoRelation(ctx context.Context, delete *store.DeleteMemoRel
 	if delete.Type != nil {
 		where, args = append(where, "`type` = ?"), append(args, delete.Type)
 	}
-	stmt := "DELETE FROM `memo_relation` WHERE " + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if len(delete.TypeList) > 0 {
+		placeholders := make([]string, 0, len(delete.TypeList))
+		for _, relationType := range delete.TypeList {
+			placeholders = append(placeholders, "?")
+			args = append(args, relationType)
+		}
+		where = append(where, "`type` IN ("+strings.Join(placeholders, ",")+")")
+	}
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	rows, err := tx.QueryContext(ctx, "SELECT `memo_id`, `related_memo_id` FROM `memo_relation` WHERE "+strings.Join(where, " AND ")+" FOR UPDATE", args...)
 	if err != nil {
 		return err
 	}
-	if _, err = result.RowsAffected(); err != nil {
+	defer rows.Close()
+
+	memoIDs := []int32{}
+	for rows.Next() {
+		var memoID, relatedMemoID int32
+		if err := rows.Scan(&memoID, &relatedMemoID); err != nil {
+			return err
+		}
+		memoIDs = append(memoIDs, memoID, relatedMemoID)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	stmt := "DELETE FROM `memo_relation` WHERE " + strings.Join(where, " AND ")
+	if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	if err := refreshMemoRelationCounts(ctx, tx, memoIDs); err != nil {
 		return err
 	}
-	return nil
+	return tx.Commit()
 }
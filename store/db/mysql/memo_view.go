@@ -0,0 +1,55 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoViews(ctx context.Context, creates []*store.MemoView) error {
+	if len(creates) == 0 {
+		return nil
+	}
+	placeholders, args := make([]string, 0, len(creates)), make([]any, 0, 3*len(creates))
+	for _, create := range creates {
+		placeholders = append(placeholders, "(?, ?, ?)")
+		args = append(args, create.MemoID, create.Day, create.ViewerHash)
+	}
+	stmt := "INSERT IGNORE INTO `memo_view` (`memo_id`, `day`, `viewer_hash`) VALUES " + strings.Join(placeholders, ", ")
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
+
+func (d *DB) ListMemoViewCounts(ctx context.Context, find *store.FindMemoView) ([]*store.MemoViewCount, error) {
+	where, args := []string{"`memo_id` = ?"}, []any{find.MemoID}
+	if find.DayAfter != nil {
+		where, args = append(where, "`day` >= ?"), append(args, *find.DayAfter)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT `day`, COUNT(*) FROM `memo_view` WHERE "+strings.Join(where, " AND ")+" GROUP BY `day` ORDER BY `day` ASC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoViewCount{}
+	for rows.Next() {
+		viewCount := &store.MemoViewCount{}
+		if err := rows.Scan(&viewCount.Day, &viewCount.Count); err != nil {
+			return nil, err
+		}
+		list = append(list, viewCount)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoViews(ctx context.Context, delete *store.DeleteMemoView) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `memo_view` WHERE `memo_id` = ?", delete.MemoID)
+	return err
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db/instrument"
 )
 
 type DB struct {
@@ -32,7 +33,7 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 		return nil, errors.New("Parse DSN eroor")
 	}
 
-	driver.db, err = sql.Open("mysql", dsn)
+	driver.db, err = instrument.Open("mysql", dsn, profile.SlowQueryThreshold)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to open db: %s", profile.DSN)
 	}
@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -14,7 +15,12 @@ func (d *DB) UpsertReaction(ctx context.Context, upsert *store.Reaction) (*store
 	placeholder := []string{"?", "?", "?"}
 	args := []interface{}{upsert.CreatorID, upsert.ContentID, upsert.ReactionType}
 	stmt := "INSERT INTO `reaction` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	result, err := tx.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -23,6 +29,12 @@ func (d *DB) UpsertReaction(ctx context.Context, upsert *store.Reaction) (*store
 	if err != nil {
 		return nil, err
 	}
+	if err := refreshMemoReactionCount(ctx, tx, upsert.ContentID); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	id := int32(rawID)
 	reaction, err := d.GetReaction(ctx, &store.FindReaction{ID: &id})
 	if err != nil {
@@ -108,6 +120,23 @@ func (d *DB) GetReaction(ctx context.Context, find *store.FindReaction) (*store.
 }
 
 func (d *DB) DeleteReaction(ctx context.Context, delete *store.DeleteReaction) error {
-	_, err := d.db.ExecContext(ctx, "DELETE FROM `reaction` WHERE `id` = ?", delete.ID)
-	return err
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	var contentID string
+	if err := tx.QueryRowContext(ctx, "SELECT `content_id` FROM `reaction` WHERE `id` = ? FOR UPDATE", delete.ID).Scan(&contentID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `reaction` WHERE `id` = ?", delete.ID); err != nil {
+		return err
+	}
+	if err := refreshMemoReactionCount(ctx, tx, contentID); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
@@ -0,0 +1,81 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertWebmention(ctx context.Context, upsert *store.Webmention) error {
+	stmt := "INSERT INTO `webmention` (`memo_id`, `source`, `type`, `author_name`, `author_url`, `author_photo`, `content`, `published_ts`, `created_ts`, `updated_ts`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE `type` = VALUES(`type`), `author_name` = VALUES(`author_name`), `author_url` = VALUES(`author_url`), `author_photo` = VALUES(`author_photo`), `content` = VALUES(`content`), `published_ts` = VALUES(`published_ts`), `updated_ts` = VALUES(`updated_ts`)"
+	_, err := d.db.ExecContext(ctx, stmt, upsert.MemoID, upsert.Source, upsert.Type, upsert.AuthorName, upsert.AuthorURL, upsert.AuthorPhoto, upsert.Content, upsert.PublishedTs, upsert.CreatedTs, upsert.UpdatedTs)
+	return err
+}
+
+func (d *DB) ListWebmentions(ctx context.Context, find *store.FindWebmention) ([]*store.Webmention, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *find.MemoID)
+	}
+	if find.Source != nil {
+		where, args = append(where, "`source` = ?"), append(args, *find.Source)
+	}
+
+	query := "SELECT `id`, `memo_id`, `source`, `type`, `author_name`, `author_url`, `author_photo`, `content`, `published_ts`, `created_ts`, `updated_ts` FROM `webmention` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` ASC, `id` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Webmention{}
+	for rows.Next() {
+		webmention := &store.Webmention{}
+		if err := rows.Scan(
+			&webmention.ID,
+			&webmention.MemoID,
+			&webmention.Source,
+			&webmention.Type,
+			&webmention.AuthorName,
+			&webmention.AuthorURL,
+			&webmention.AuthorPhoto,
+			&webmention.Content,
+			&webmention.PublishedTs,
+			&webmention.CreatedTs,
+			&webmention.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, webmention)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteWebmention(ctx context.Context, delete *store.DeleteWebmention) error {
+	where, args := []string{}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *delete.ID)
+	}
+	if delete.MemoID != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *delete.MemoID)
+	}
+	if delete.Source != nil {
+		where, args = append(where, "`source` = ?"), append(args, *delete.Source)
+	}
+	if len(where) == 0 {
+		return errors.New("no condition to delete webmentions")
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `webmention` WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
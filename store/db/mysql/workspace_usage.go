@@ -0,0 +1,20 @@
+package mysql
+
+import (
+	"context"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) GetWorkspaceUsage(ctx context.Context) (*store.WorkspaceUsage, error) {
+	query := "SELECT (SELECT COUNT(*) FROM `user`), (SELECT COUNT(*) FROM `memo`) + (SELECT COUNT(*) FROM `cold_memo`), (SELECT COALESCE(SUM(`size`), 0) FROM `resource`)"
+	usage := &store.WorkspaceUsage{}
+	if err := d.db.QueryRowContext(ctx, query).Scan(
+		&usage.UserCount,
+		&usage.MemoCount,
+		&usage.StorageBytes,
+	); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateAIJob(ctx context.Context, create *store.AIJob) (*store.AIJob, error) {
+	payloadString := "{}"
+	if create.Payload != nil {
+		bytes, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal AI job payload")
+		}
+		payloadString = string(bytes)
+	}
+
+	fields := []string{"created_ts", "updated_ts", "job_type", "user_id", "status", "payload", "memo_uid", "error"}
+	args := []any{create.CreatedTs, create.UpdatedTs, create.JobType, create.UserID, create.Status, payloadString, create.MemoUID, create.Error}
+	stmt := "INSERT INTO ai_job (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListAIJobs(ctx context.Context, find *store.FindAIJob) ([]*store.AIJob, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+	if len(find.StatusList) > 0 {
+		holders := make([]string, 0, len(find.StatusList))
+		for _, status := range find.StatusList {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, status)
+		}
+		where = append(where, "status IN ("+strings.Join(holders, ", ")+")")
+	}
+
+	query := "SELECT id, created_ts, updated_ts, job_type, user_id, status, payload, memo_uid, error FROM ai_job WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC, id DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.AIJob{}
+	for rows.Next() {
+		job := &store.AIJob{}
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&job.ID,
+			&job.CreatedTs,
+			&job.UpdatedTs,
+			&job.JobType,
+			&job.UserID,
+			&job.Status,
+			&payloadBytes,
+			&job.MemoUID,
+			&job.Error,
+		); err != nil {
+			return nil, err
+		}
+
+		payload := &storepb.AIJobPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, err
+		}
+		job.Payload = payload
+		list = append(list, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateAIJob(ctx context.Context, update *store.UpdateAIJob) error {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Status; v != nil {
+		set, args = append(set, "status = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.MemoUID; v != nil {
+		set, args = append(set, "memo_uid = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Error; v != nil {
+		set, args = append(set, "error = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE ai_job SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args))
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) IncrementAIRequestCount(ctx context.Context, userID int32, hourTs int64, limit int32) (bool, error) {
+	stmt := "INSERT INTO ai_request_count (user_id, hour_ts, count) VALUES ($1, $2, 1) ON CONFLICT(user_id, hour_ts) DO UPDATE SET count = ai_request_count.count + 1 WHERE $3 = 0 OR ai_request_count.count < $3"
+	result, err := d.db.ExecContext(ctx, stmt, userID, hourTs, limit)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (d *DB) DecrementAIRequestCount(ctx context.Context, userID int32, hourTs int64) error {
+	_, err := d.db.ExecContext(ctx, "UPDATE ai_request_count SET count = count - 1 WHERE user_id = $1 AND hour_ts = $2 AND count > 0", userID, hourTs)
+	return err
+}
+
+func (d *DB) ListAIRequestCounts(ctx context.Context, find *store.FindAIRequestCount) ([]*store.AIRequestCount, error) {
+	where, args := []string{"user_id = " + placeholder(1)}, []any{find.UserID}
+	if find.HourTsAfter != nil {
+		where, args = append(where, "hour_ts >= "+placeholder(len(args)+1)), append(args, *find.HourTsAfter)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT user_id, hour_ts, count FROM ai_request_count WHERE "+strings.Join(where, " AND ")+" ORDER BY hour_ts ASC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.AIRequestCount{}
+	for rows.Next() {
+		requestCount := &store.AIRequestCount{}
+		if err := rows.Scan(&requestCount.UserID, &requestCount.HourTs, &requestCount.Count); err != nil {
+			return nil, err
+		}
+		list = append(list, requestCount)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteAIRequestCounts(ctx context.Context, delete *store.DeleteAIRequestCount) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM ai_request_count WHERE hour_ts < "+placeholder(1), delete.HourTsBefore)
+	return err
+}
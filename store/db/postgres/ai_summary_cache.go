@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertAISummaryCache(ctx context.Context, upsert *store.AISummaryCache) error {
+	stmt := "INSERT INTO ai_summary_cache (user_id, cache_key, memo_id, created_ts) VALUES (" + placeholders(4) + ") ON CONFLICT(user_id, cache_key) DO UPDATE SET memo_id = EXCLUDED.memo_id, created_ts = EXCLUDED.created_ts"
+	_, err := d.db.ExecContext(ctx, stmt, upsert.UserID, upsert.CacheKey, upsert.MemoID, upsert.CreatedTs)
+	return err
+}
+
+func (d *DB) GetAISummaryCache(ctx context.Context, find *store.FindAISummaryCache) (*store.AISummaryCache, error) {
+	where, args := []string{"user_id = " + placeholder(1), "cache_key = " + placeholder(2)}, []any{find.UserID, find.CacheKey}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts >= "+placeholder(len(args)+1)), append(args, *find.CreatedTsAfter)
+	}
+
+	cache := &store.AISummaryCache{}
+	if err := d.db.QueryRowContext(ctx, "SELECT user_id, cache_key, memo_id, created_ts FROM ai_summary_cache WHERE "+strings.Join(where, " AND "), args...).Scan(
+		&cache.UserID, &cache.CacheKey, &cache.MemoID, &cache.CreatedTs,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return cache, nil
+}
+
+func (d *DB) DeleteAISummaryCaches(ctx context.Context, delete *store.DeleteAISummaryCache) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM ai_summary_cache WHERE created_ts < "+placeholder(1), delete.CreatedTsBefore)
+	return err
+}
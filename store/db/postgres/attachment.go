@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/usememos/memos/plugin/filter"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
@@ -39,6 +40,13 @@ func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*s
 func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([]*store.Attachment, error) {
 	where, args := []string{"1 = 1"}, []any{}
 
+	engine, err := filter.AttachmentEngine()
+	if err != nil {
+		return nil, err
+	}
+	if err := filter.AppendConditions(ctx, engine, find.Filters, filter.DialectPostgres, &where, &args); err != nil {
+		return nil, err
+	}
 	if v := find.ID; v != nil {
 		where, args = append(where, "resource.id = "+placeholder(len(args)+1)), append(args, *v)
 	}
@@ -68,9 +76,37 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 	if find.HasRelatedMemo {
 		where = append(where, "resource.memo_id IS NOT NULL")
 	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, "resource.created_ts >= "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if v := find.StorageType; v != nil {
 		where, args = append(where, "resource.storage_type = "+placeholder(len(args)+1)), append(args, v.String())
 	}
+	if len(find.TypePrefixList) > 0 {
+		typeWhere := make([]string, 0, len(find.TypePrefixList))
+		for _, prefix := range find.TypePrefixList {
+			typeWhere, args = append(typeWhere, "resource.type LIKE "+placeholder(len(args)+1)), append(args, prefix+"%")
+		}
+		where = append(where, "("+strings.Join(typeWhere, " OR ")+")")
+	}
+	if v := find.MemoRowStatus; v != nil {
+		where, args = append(where, "memo.row_status = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(find.MemoVisibilityList) > 0 || find.MemoViewerID != nil {
+		visibilityWhere := []string{}
+		if len(find.MemoVisibilityList) > 0 {
+			holders := make([]string, 0, len(find.MemoVisibilityList))
+			for _, visibility := range find.MemoVisibilityList {
+				holders = append(holders, placeholder(len(args)+1))
+				args = append(args, visibility.String())
+			}
+			visibilityWhere = append(visibilityWhere, "memo.visibility IN ("+strings.Join(holders, ", ")+")")
+		}
+		if v := find.MemoViewerID; v != nil {
+			visibilityWhere, args = append(visibilityWhere, "memo.creator_id = "+placeholder(len(args)+1)), append(args, *v)
+		}
+		where = append(where, "("+strings.Join(visibilityWhere, " OR ")+")")
+	}
 
 	fields := []string{
 		"resource.id AS id",
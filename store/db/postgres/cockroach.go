@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db/instrument"
+)
+
+const (
+	// cockroachRetryErrorCode is the SQLSTATE returned by CockroachDB when a
+	// transaction must be retried because of a serialization conflict.
+	cockroachRetryErrorCode = "40001"
+	cockroachMaxRetries     = 5
+	cockroachRetryBaseDelay = 10 * time.Millisecond
+)
+
+// cockroachSessionStatements keep the PostgreSQL schema compatible with
+// CockroachDB: SERIAL columns are backed by sequences instead of
+// unique_rowid() and INTEGER stays 32 bits wide, so ids fit into int32.
+var cockroachSessionStatements = []string{
+	"SET serial_normalization = 'sql_sequence'",
+	"SET default_int_size = 4",
+}
+
+// NewCockroachDB opens a CockroachDB database in PostgreSQL compatibility mode.
+// Statements executed outside of an explicit transaction are retried when
+// CockroachDB reports a serialization conflict.
+func NewCockroachDB(profile *profile.Profile) (store.Driver, error) {
+	if profile == nil {
+		return nil, errors.New("profile is nil")
+	}
+
+	connector, err := pq.NewConnector(profile.DSN)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open database: %s", profile.DSN)
+	}
+
+	var driver store.Driver = &DB{
+		db:      sql.OpenDB(instrument.WrapConnector(&cockroachConnector{connector: connector}, profile.SlowQueryThreshold)),
+		profile: profile,
+	}
+	return driver, nil
+}
+
+type cockroachConnector struct {
+	connector driver.Connector
+}
+
+func (c *cockroachConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	execer, ok := conn.(driver.ExecerContext)
+	if !ok {
+		conn.Close()
+		return nil, errors.New("postgres connection does not support ExecContext")
+	}
+	for _, stmt := range cockroachSessionStatements {
+		if _, err := execer.ExecContext(ctx, stmt, nil); err != nil {
+			conn.Close()
+			return nil, errors.Wrapf(err, "failed to prepare cockroach session: %s", stmt)
+		}
+	}
+	return &cockroachConn{Conn: conn}, nil
+}
+
+func (c *cockroachConnector) Driver() driver.Driver {
+	return c.connector.Driver()
+}
+
+// cockroachConn wraps a lib/pq connection and retries implicit transactions
+// on serialization conflicts. Statements inside an explicit transaction are
+// never retried, since the whole transaction would need to be replayed.
+type cockroachConn struct {
+	driver.Conn
+	inTx bool
+}
+
+func (c *cockroachConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	if c.inTx {
+		return execer.ExecContext(ctx, query, args)
+	}
+	return withCockroachRetry(ctx, func() (driver.Result, error) {
+		return execer.ExecContext(ctx, query, args)
+	})
+}
+
+func (c *cockroachConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	if c.inTx {
+		return queryer.QueryContext(ctx, query, args)
+	}
+	return withCockroachRetry(ctx, func() (driver.Rows, error) {
+		return queryer.QueryContext(ctx, query, args)
+	})
+}
+
+func (c *cockroachConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+	return c.Conn.Prepare(query)
+}
+
+func (c *cockroachConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	beginner, ok := c.Conn.(driver.ConnBeginTx)
+	if !ok {
+		return nil, errors.New("postgres connection does not support BeginTx")
+	}
+	tx, err := beginner.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.inTx = true
+	return &cockroachTx{Tx: tx, conn: c}, nil
+}
+
+func (c *cockroachConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *cockroachConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *cockroachConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+type cockroachTx struct {
+	driver.Tx
+	conn *cockroachConn
+}
+
+func (tx *cockroachTx) Commit() error {
+	tx.conn.inTx = false
+	return tx.Tx.Commit()
+}
+
+func (tx *cockroachTx) Rollback() error {
+	tx.conn.inTx = false
+	return tx.Tx.Rollback()
+}
+
+// withCockroachRetry runs fn until it succeeds, fails with a non-retryable
+// error, runs out of attempts, or the context is done.
+func withCockroachRetry[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	delay := cockroachRetryBaseDelay
+	for attempt := 1; ; attempt++ {
+		result, err := fn()
+		if err == nil || !isCockroachRetryableError(err) || attempt >= cockroachMaxRetries {
+			return result, err
+		}
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+func isCockroachRetryableError(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == cockroachRetryErrorCode
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCockroachRetry(t *testing.T) {
+	ctx := context.Background()
+	retryErr := &pq.Error{Code: cockroachRetryErrorCode}
+
+	attempts := 0
+	result, err := withCockroachRetry(ctx, func() (int, error) {
+		attempts++
+		if attempts < 3 {
+			return 0, retryErr
+		}
+		return 42, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 42, result)
+	require.Equal(t, 3, attempts)
+
+	attempts = 0
+	_, err = withCockroachRetry(ctx, func() (int, error) {
+		attempts++
+		return 0, retryErr
+	})
+	require.ErrorIs(t, err, retryErr)
+	require.Equal(t, cockroachMaxRetries, attempts)
+
+	attempts = 0
+	_, err = withCockroachRetry(ctx, func() (int, error) {
+		attempts++
+		return 0, errors.New("syntax error")
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, attempts)
+}
+
+func TestIsCockroachRetryableError(t *testing.T) {
+	require.True(t, isCockroachRetryableError(errors.Wrap(&pq.Error{Code: "40001"}, "failed to exec")))
+	require.False(t, isCockroachRetryableError(&pq.Error{Code: "23505"}))
+	require.False(t, isCockroachRetryableError(errors.New("boom")))
+}
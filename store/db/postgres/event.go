@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateEvent(ctx context.Context, create *store.Event) (*store.Event, error) {
+	payload := create.Payload
+	if payload == "" {
+		payload = "{}"
+	}
+	fields := []string{"user_id", "type", "resource", "payload"}
+	args := []any{create.UserID, create.Type, create.Resource, payload}
+	stmt := "INSERT INTO event (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	create.Payload = payload
+	return create, nil
+}
+
+func (d *DB) ListEvents(ctx context.Context, find *store.FindEvent) ([]*store.Event, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+	if find.AfterID != nil {
+		where, args = append(where, "id > "+placeholder(len(args)+1)), append(args, *find.AfterID)
+	}
+
+	query := "SELECT id, created_ts, user_id, type, resource, payload FROM event WHERE " + strings.Join(where, " AND ") + " ORDER BY id ASC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Event{}
+	for rows.Next() {
+		event := &store.Event{}
+		if err := rows.Scan(
+			&event.ID,
+			&event.CreatedTs,
+			&event.UserID,
+			&event.Type,
+			&event.Resource,
+			&event.Payload,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -2,8 +2,10 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -14,6 +16,71 @@ import (
 )
 
 func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	memo, err := insertMemo(ctx, tx, create)
+	if err != nil {
+		return nil, err
+	}
+	if err := refreshMemoTags(ctx, tx, []int32{memo.ID}); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return memo, nil
+}
+
+func (d *DB) CreateMemoWithAssociations(ctx context.Context, create *store.Memo, associations *store.MemoAssociations) (*store.Memo, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	memo, err := insertMemo(ctx, tx, create)
+	if err != nil {
+		return nil, err
+	}
+	if err := refreshMemoTags(ctx, tx, []int32{memo.ID}); err != nil {
+		return nil, err
+	}
+	memoIDs := []int32{memo.ID}
+	for _, relation := range associations.Relations {
+		relation.MemoID = memo.ID
+		if _, err := tx.ExecContext(ctx, "INSERT INTO memo_relation (memo_id, related_memo_id, type) VALUES ("+placeholders(3)+")", relation.MemoID, relation.RelatedMemoID, relation.Type); err != nil {
+			return nil, err
+		}
+		memoIDs = append(memoIDs, relation.RelatedMemoID)
+	}
+	if err := refreshMemoRelationCounts(ctx, tx, memoIDs); err != nil {
+		return nil, err
+	}
+	// The first attachment gets the latest updated_ts, attachments are listed by updated_ts DESC.
+	now := time.Now().Unix()
+	for index, attachmentID := range associations.AttachmentIDs {
+		updatedTs := now + int64(len(associations.AttachmentIDs)-1-index)
+		if _, err := tx.ExecContext(ctx, "UPDATE resource SET memo_id = $1, updated_ts = $2 WHERE id = $3", memo.ID, updatedTs, attachmentID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return memo, nil
+}
+
+// rowQueryer is implemented by both *sql.DB and *sql.Tx.
+type rowQueryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func insertMemo(ctx context.Context, db rowQueryer, create *store.Memo) (*store.Memo, error) {
 	fields := []string{"uid", "creator_id", "content", "visibility", "payload"}
 	payload := "{}"
 	if create.Payload != nil {
@@ -26,7 +93,7 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload}
 
 	stmt := "INSERT INTO memo (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts, row_status"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 		&create.UpdatedTs,
@@ -87,6 +154,15 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		where = append(where, "memo_relation.related_memo_id IS NULL")
 	}
+	if v := find.UnreadByUserID; v != nil {
+		where, args = append(where, "NOT EXISTS (SELECT 1 FROM memo_read_state WHERE memo_read_state.memo_id = memo.id AND memo_read_state.user_id = "+placeholder(len(args)+1)+" AND memo_read_state.read_ts >= memo.updated_ts)"), append(args, *v)
+	}
+	if v := find.SubscribedByUserID; v != nil {
+		where, args = append(where, "EXISTS (SELECT 1 FROM memo_subscription WHERE memo_subscription.memo_id = memo.id AND memo_subscription.user_id = "+placeholder(len(args)+1)+" AND memo_subscription.subscribed = TRUE)"), append(args, *v)
+	}
+	if v := find.ExpiredBefore; v != nil {
+		where, args = append(where, "(memo.payload->'expiry'->>'expireTs')::BIGINT <= "+placeholder(len(args)+1)), append(args, *v)
+	}
 
 	order := "DESC"
 	if find.OrderByTimeAsc {
@@ -96,6 +172,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByPinned {
 		orderBy = append(orderBy, "pinned DESC")
 	}
+	if v := find.OrderBySearchScore; v != nil {
+		orderBy = append(orderBy, searchScoreExpr(v, &args)+" DESC")
+	}
 	if find.OrderByUpdatedTs {
 		orderBy = append(orderBy, "updated_ts "+order)
 	} else {
@@ -113,6 +192,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		`memo.visibility AS visibility`,
 		`memo.pinned AS pinned`,
 		`memo.payload AS payload`,
+		`memo.comment_count AS comment_count`,
+		`memo.reaction_count AS reaction_count`,
+		`memo.relation_count AS relation_count`,
 		`CASE WHEN parent_memo.uid IS NOT NULL THEN parent_memo.uid ELSE NULL END AS parent_uid`,
 	}
 	if !find.ExcludeContent {
@@ -152,6 +234,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.Visibility,
 			&memo.Pinned,
 			&payloadBytes,
+			&memo.CommentCount,
+			&memo.ReactionCount,
+			&memo.RelationCount,
 			&memo.ParentUID,
 		}
 		if !find.ExcludeContent {
@@ -224,21 +309,43 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 
 	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
 	args = append(args, update.ID)
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	if update.Payload == nil {
+		_, err := d.db.ExecContext(ctx, stmt, args...)
+		return err
+	}
+
+	// The tags of the payload are indexed along with it.
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
 		return err
 	}
-	return nil
+	if err := refreshMemoTags(ctx, tx, []int32{update.ID}); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	where, args := []string{"id = " + placeholder(1)}, []any{delete.ID}
 	stmt := `DELETE FROM memo WHERE ` + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	result, err := tx.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return errors.Wrap(err, "failed to delete memo")
 	}
 	if _, err := result.RowsAffected(); err != nil {
 		return err
 	}
-	return nil
+	if err := refreshMemoTags(ctx, tx, []int32{delete.ID}); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) BatchCreateMemos(ctx context.Context, create *store.BatchCreateMemos) ([]*store.Memo, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	ids := make(map[string]int32, len(create.Memos))
+	for chunk := range slices.Chunk(create.Memos, store.BatchInsertSize) {
+		values, args := make([]string, 0, len(chunk)), make([]any, 0, len(chunk)*9)
+		for _, memo := range chunk {
+			payload := "{}"
+			if memo.Payload != nil {
+				payloadBytes, err := protojson.Marshal(memo.Payload)
+				if err != nil {
+					return nil, err
+				}
+				payload = string(payloadBytes)
+			}
+			args = append(args, memo.UID, memo.CreatorID, memo.CreatedTs, memo.UpdatedTs, memo.RowStatus, memo.Content, memo.Visibility, memo.Pinned, payload)
+			values = append(values, rowPlaceholders(len(args)-8, 9))
+		}
+		stmt := "INSERT INTO memo (uid, creator_id, created_ts, updated_ts, row_status, content, visibility, pinned, payload) VALUES " + strings.Join(values, ", ") + " RETURNING id, uid"
+		rows, err := tx.QueryContext(ctx, stmt, args...)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var id int32
+			var uid string
+			if err := rows.Scan(&id, &uid); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			ids[uid] = id
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		rows.Close()
+	}
+	memoIDs := make([]int32, 0, len(create.Memos))
+	for _, memo := range create.Memos {
+		id, ok := ids[memo.UID]
+		if !ok {
+			return nil, errors.Errorf("failed to create memo %s", memo.UID)
+		}
+		memo.ID = id
+		memoIDs = append(memoIDs, id)
+	}
+	if err := refreshMemoTags(ctx, tx, memoIDs); err != nil {
+		return nil, err
+	}
+
+	relatedMemoIDs := []int32{}
+	for chunk := range slices.Chunk(create.Relations, store.BatchInsertSize) {
+		values, args := make([]string, 0, len(chunk)), make([]any, 0, len(chunk)*3)
+		for _, relation := range chunk {
+			memoID, relatedMemoID := create.Memos[relation.MemoIndex].ID, create.Memos[relation.RelatedMemoIndex].ID
+			args = append(args, memoID, relatedMemoID, relation.Type)
+			values = append(values, rowPlaceholders(len(args)-2, 3))
+			relatedMemoIDs = append(relatedMemoIDs, memoID, relatedMemoID)
+		}
+		stmt := "INSERT INTO memo_relation (memo_id, related_memo_id, type) VALUES " + strings.Join(values, ", ")
+		if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+			return nil, err
+		}
+	}
+	for chunk := range slices.Chunk(slices.Compact(slices.Sorted(slices.Values(relatedMemoIDs))), store.BatchInsertSize) {
+		if err := refreshMemoRelationCounts(ctx, tx, chunk); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return create.Memos, nil
+}
+
+// rowPlaceholders returns a parenthesized list of n placeholders starting at $start.
+func rowPlaceholders(start, n int) string {
+	list := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		list = append(list, placeholder(start+i))
+	}
+	return "(" + strings.Join(list, ", ") + ")"
+}
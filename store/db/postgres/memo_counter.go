@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+// refreshMemoRelationCounts recomputes the comment and relation counters of the given memos.
+func refreshMemoRelationCounts(ctx context.Context, tx *sql.Tx, memoIDs []int32) error {
+	memoIDs = slices.Compact(slices.Sorted(slices.Values(memoIDs)))
+	if len(memoIDs) == 0 {
+		return nil
+	}
+	args := []any{store.MemoRelationComment, store.MemoRelationReference}
+	idPlaceholders := make([]string, 0, len(memoIDs))
+	for _, id := range memoIDs {
+		args = append(args, id)
+		idPlaceholders = append(idPlaceholders, placeholder(len(args)))
+	}
+	stmt := `UPDATE memo SET
+		comment_count = (SELECT COUNT(*) FROM memo_relation WHERE memo_relation.related_memo_id = memo.id AND memo_relation.type = $1),
+		relation_count = (SELECT COUNT(*) FROM memo_relation WHERE memo_relation.memo_id = memo.id AND memo_relation.type = $2)
+		WHERE id IN (` + strings.Join(idPlaceholders, ",") + `)`
+	_, err := tx.ExecContext(ctx, stmt, args...)
+	return err
+}
+
+// refreshMemoReactionCount recomputes the reaction counter of the memo the content id refers to.
+func refreshMemoReactionCount(ctx context.Context, tx *sql.Tx, contentID string) error {
+	memoUID, ok := store.ExtractMemoUIDFromContentID(contentID)
+	if !ok {
+		return nil
+	}
+	stmt := "UPDATE memo SET reaction_count = (SELECT COUNT(*) FROM reaction WHERE content_id = $1) WHERE uid = $2"
+	_, err := tx.ExecContext(ctx, stmt, contentID, memoUID)
+	return err
+}
@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoEmbedding(ctx context.Context, upsert *store.MemoEmbedding) error {
+	stmt := `
+		INSERT INTO memo_embedding (
+			memo_id, model, content_hash, embedding, updated_ts
+		)
+		VALUES (` + placeholders(5) + `)
+		ON CONFLICT(memo_id) DO UPDATE
+		SET model = EXCLUDED.model, content_hash = EXCLUDED.content_hash, embedding = EXCLUDED.embedding, updated_ts = EXCLUDED.updated_ts
+	`
+	_, err := d.db.ExecContext(ctx, stmt, upsert.MemoID, upsert.Model, upsert.ContentHash, pq.Float32Array(upsert.Embedding), upsert.UpdatedTs)
+	return err
+}
+
+func (d *DB) ListMemoEmbeddings(ctx context.Context, find *store.FindMemoEmbedding) ([]*store.MemoEmbedding, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if len(find.MemoIDList) > 0 {
+		holders := []string{}
+		for _, id := range find.MemoIDList {
+			holders, args = append(holders, placeholder(len(args)+1)), append(args, id)
+		}
+		where = append(where, fmt.Sprintf("memo_id IN (%s)", strings.Join(holders, ", ")))
+	}
+	if find.Model != nil {
+		where, args = append(where, "model = "+placeholder(len(args)+1)), append(args, *find.Model)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT memo_id, model, content_hash, embedding, updated_ts FROM memo_embedding WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoEmbedding{}
+	for rows.Next() {
+		memoEmbedding := &store.MemoEmbedding{}
+		var embedding pq.Float32Array
+		if err := rows.Scan(&memoEmbedding.MemoID, &memoEmbedding.Model, &memoEmbedding.ContentHash, &embedding, &memoEmbedding.UpdatedTs); err != nil {
+			return nil, err
+		}
+		memoEmbedding.Embedding = embedding
+		list = append(list, memoEmbedding)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// SearchMemoEmbeddings orders the memos by cosine distance in the database when the pgvector extension is installed,
+// and ranks them in process otherwise.
+func (d *DB) SearchMemoEmbeddings(ctx context.Context, search *store.SearchMemoEmbedding) ([]*store.MemoEmbeddingMatch, error) {
+	where, args := []string{"memo_embedding.model = " + placeholder(1)}, []any{search.Model}
+	states := []string{}
+	for _, rowStatus := range store.GetSearchedRowStatuses(search.RowStatusList) {
+		switch rowStatus {
+		case store.Normal:
+			if search.ViewerID == nil && len(search.VisibilityList) == 0 {
+				continue
+			}
+			rowStatusHolder := placeholder(len(args) + 1)
+			args = append(args, store.Normal)
+			visible := []string{}
+			if search.ViewerID != nil {
+				visible, args = append(visible, "memo.creator_id = "+placeholder(len(args)+1)), append(args, *search.ViewerID)
+			}
+			if len(search.VisibilityList) > 0 {
+				holders := []string{}
+				for _, visibility := range search.VisibilityList {
+					holders, args = append(holders, placeholder(len(args)+1)), append(args, visibility.String())
+				}
+				visible = append(visible, fmt.Sprintf("memo.visibility IN (%s)", strings.Join(holders, ", ")))
+			}
+			states = append(states, "(memo.row_status = "+rowStatusHolder+" AND ("+strings.Join(visible, " OR ")+"))")
+		case store.Archived:
+			// The archived memos are only searched among the memos of the viewer.
+			if search.ViewerID != nil {
+				states = append(states, "(memo.row_status = "+placeholder(len(args)+1)+" AND memo.creator_id = "+placeholder(len(args)+2)+")")
+				args = append(args, store.Archived, *search.ViewerID)
+			}
+		}
+	}
+	if len(states) == 0 {
+		return []*store.MemoEmbeddingMatch{}, nil
+	}
+	where = append(where, "("+strings.Join(states, " OR ")+")")
+
+	hasVector := false
+	if err := d.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'vector')").Scan(&hasVector); err != nil {
+		return nil, err
+	}
+	if !hasVector {
+		query := "SELECT memo_embedding.memo_id, memo_embedding.embedding FROM memo_embedding JOIN memo ON memo.id = memo_embedding.memo_id WHERE " + strings.Join(where, " AND ")
+		rows, err := d.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		embeddings := []*store.MemoEmbedding{}
+		for rows.Next() {
+			memoEmbedding := &store.MemoEmbedding{}
+			var embedding pq.Float32Array
+			if err := rows.Scan(&memoEmbedding.MemoID, &embedding); err != nil {
+				return nil, err
+			}
+			memoEmbedding.Embedding = embedding
+			embeddings = append(embeddings, memoEmbedding)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return store.RankMemoEmbeddings(embeddings, search.Embedding, search.Limit), nil
+	}
+
+	vector := placeholder(len(args)+1) + "::real[]::vector"
+	args = append(args, pq.Float32Array(search.Embedding))
+	query := "SELECT memo_embedding.memo_id, 1 - (memo_embedding.embedding::vector <=> " + vector + ") FROM memo_embedding JOIN memo ON memo.id = memo_embedding.memo_id WHERE " + strings.Join(where, " AND ") +
+		" AND array_length(memo_embedding.embedding, 1) = " + placeholder(len(args)+1) + " ORDER BY memo_embedding.embedding::vector <=> " + vector
+	args = append(args, len(search.Embedding))
+	if search.Limit > 0 {
+		query = fmt.Sprintf("%s LIMIT %d", query, search.Limit)
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoEmbeddingMatch{}
+	for rows.Next() {
+		match := &store.MemoEmbeddingMatch{}
+		if err := rows.Scan(&match.MemoID, &match.Score); err != nil {
+			return nil, err
+		}
+		list = append(list, match)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoEmbedding(ctx context.Context, delete *store.DeleteMemoEmbedding) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *delete.MemoID)
+	}
+	if delete.CreatorID != nil {
+		where, args = append(where, "memo_id IN (SELECT id FROM memo WHERE creator_id = "+placeholder(len(args)+1)+")"), append(args, *delete.CreatorID)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM memo_embedding WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
@@ -18,17 +18,27 @@ func TestConvertExprToSQL(t *testing.T) {
 	}{
 		{
 			filter: `tag in ["tag1", "tag2"]`,
-			want:   "(memo.payload->'tags' @> jsonb_build_array($1::json) OR memo.payload->'tags' @> jsonb_build_array($2::json))",
-			args:   []any{`"tag1"`, `"tag2"`},
+			want:   "EXISTS (SELECT 1 FROM memo_tag WHERE memo_tag.memo_id = memo.id AND memo_tag.tag IN ($1, $2))",
+			args:   []any{"tag1", "tag2"},
 		},
 		{
 			filter: `!(tag in ["tag1", "tag2"])`,
-			want:   "NOT ((memo.payload->'tags' @> jsonb_build_array($1::json) OR memo.payload->'tags' @> jsonb_build_array($2::json)))",
-			args:   []any{`"tag1"`, `"tag2"`},
+			want:   "NOT (EXISTS (SELECT 1 FROM memo_tag WHERE memo_tag.memo_id = memo.id AND memo_tag.tag IN ($1, $2)))",
+			args:   []any{"tag1", "tag2"},
 		},
 		{
 			filter: `content.contains("memos")`,
-			want:   "memo.content ILIKE $1",
+			want:   "(memo.content ILIKE $1 OR EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id AND resource.payload::JSONB->>'extractedText' ILIKE $2))",
+			args:   []any{"%memos%", "%memos%"},
+		},
+		{
+			filter: `content.fuzzy_contains("kubernetes")`,
+			want:   "((memo.content ILIKE $1 OR EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id AND resource.payload::JSONB->>'extractedText' ILIKE $2)) OR word_similarity($3, memo.content) >= 0.4)",
+			args:   []any{"%kubernetes%", "%kubernetes%", "kubernetes"},
+		},
+		{
+			filter: `comment_content.contains("memos")`,
+			want:   "EXISTS (SELECT 1 FROM memo_relation JOIN memo AS comment_memo ON comment_memo.id = memo_relation.memo_id WHERE memo_relation.related_memo_id = memo.id AND memo_relation.type = 'COMMENT' AND comment_memo.row_status = 'NORMAL' AND (comment_memo.visibility = 'PUBLIC' OR comment_memo.visibility = memo.visibility) AND comment_memo.content ILIKE $1)",
 			args:   []any{"%memos%"},
 		},
 		{
@@ -36,6 +46,31 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "memo.visibility IN ($1)",
 			args:   []any{"PUBLIC"},
 		},
+		{
+			filter: `language == "de"`,
+			want:   "COALESCE(memo.payload->>'language', memo.payload->>'detectedLanguage') = $1",
+			args:   []any{"de"},
+		},
+		{
+			filter: `language in ["de", "fr"]`,
+			want:   "COALESCE(memo.payload->>'language', memo.payload->>'detectedLanguage') IN ($1,$2)",
+			args:   []any{"de", "fr"},
+		},
+		{
+			filter: `reading_status == "finished" && reading_rating >= 8`,
+			want:   "(memo.payload->'reading'->>'status' = $1 AND COALESCE((memo.payload->'reading'->>'rating')::INTEGER, 0) >= $2)",
+			args:   []any{"finished", int64(8)},
+		},
+		{
+			filter: `reading_author.contains("Le Guin") && reading_finished_date >= "2025-01-01"`,
+			want:   "(memo.payload->'reading'->>'author' ILIKE $1 AND memo.payload->'reading'->>'finishedDate' >= $2)",
+			args:   []any{"%Le Guin%", "2025-01-01"},
+		},
+		{
+			filter: `approval_state == "PENDING"`,
+			want:   "COALESCE(memo.payload->'approval'->>'state', '') = $1",
+			args:   []any{"PENDING"},
+		},
 		{
 			filter: `visibility in ["PUBLIC", "PRIVATE"]`,
 			want:   "memo.visibility IN ($1,$2)",
@@ -43,8 +78,8 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `tag in ['tag1'] || content.contains('hello')`,
-			want:   "(memo.payload->'tags' @> jsonb_build_array($1::json) OR memo.content ILIKE $2)",
-			args:   []any{`"tag1"`, "%hello%"},
+			want:   "(EXISTS (SELECT 1 FROM memo_tag WHERE memo_tag.memo_id = memo.id AND memo_tag.tag IN ($1)) OR (memo.content ILIKE $2 OR EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id AND resource.payload::JSONB->>'extractedText' ILIKE $3)))",
+			args:   []any{"tag1", "%hello%", "%hello%"},
 		},
 		{
 			filter: `1`,
@@ -81,6 +116,11 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "NOT ((memo.payload->'property'->>'hasTaskList')::boolean IS TRUE)",
 			args:   []any{},
 		},
+		{
+			filter: `!is_ai_generated`,
+			want:   "NOT ((memo.payload->'property'->>'isAiGenerated')::boolean IS TRUE)",
+			args:   []any{},
+		},
 		{
 			filter: `has_task_list && pinned`,
 			want:   "((memo.payload->'property'->>'hasTaskList')::boolean IS TRUE AND memo.pinned IS TRUE)",
@@ -88,8 +128,8 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `has_task_list && content.contains("todo")`,
-			want:   "((memo.payload->'property'->>'hasTaskList')::boolean IS TRUE AND memo.content ILIKE $1)",
-			args:   []any{"%todo%"},
+			want:   "((memo.payload->'property'->>'hasTaskList')::boolean IS TRUE AND (memo.content ILIKE $1 OR EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id AND resource.payload::JSONB->>'extractedText' ILIKE $2)))",
+			args:   []any{"%todo%", "%todo%"},
 		},
 		{
 			filter: `created_ts > now() - 60 * 60 * 24`,
@@ -108,8 +148,8 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `"work" in tags`,
-			want:   "memo.payload->'tags' @> jsonb_build_array($1::json)",
-			args:   []any{`"work"`},
+			want:   "EXISTS (SELECT 1 FROM memo_tag WHERE memo_tag.memo_id = memo.id AND memo_tag.tag IN ($1))",
+			args:   []any{"work"},
 		},
 		{
 			filter: `size(tags) == 2`,
@@ -141,6 +181,16 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "(memo.payload->'property'->>'hasCode')::boolean IS TRUE",
 			args:   []any{},
 		},
+		{
+			filter: `has_attachment`,
+			want:   "EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id)",
+			args:   []any{},
+		},
+		{
+			filter: `has_attachment == false`,
+			want:   "NOT (EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id))",
+			args:   []any{},
+		},
 		{
 			filter: `has_incomplete_tasks`,
 			want:   "(memo.payload->'property'->>'hasIncompleteTasks')::boolean IS TRUE",
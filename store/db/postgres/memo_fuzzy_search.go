@@ -0,0 +1,15 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// PrepareFuzzySearch creates the pg_trgm extension providing the word_similarity function of the fuzzy search.
+func (d *DB) PrepareFuzzySearch(ctx context.Context) error {
+	if _, err := d.db.ExecContext(ctx, "CREATE EXTENSION IF NOT EXISTS pg_trgm"); err != nil {
+		return errors.Wrap(err, "failed to create the pg_trgm extension")
+	}
+	return nil
+}
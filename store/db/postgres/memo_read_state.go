@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoReadState(ctx context.Context, upsert *store.MemoReadState) (*store.MemoReadState, error) {
+	stmt := `
+		INSERT INTO memo_read_state (
+			user_id, memo_id, read_ts, position
+		)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT(user_id, memo_id) DO UPDATE
+		SET read_ts = EXCLUDED.read_ts, position = EXCLUDED.position
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.UserID, upsert.MemoID, upsert.ReadTs, upsert.Position); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListMemoReadStates(ctx context.Context, find *store.FindMemoReadState) ([]*store.MemoReadState, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+	if len(find.MemoIDList) > 0 {
+		placeholders := make([]string, 0, len(find.MemoIDList))
+		for _, id := range find.MemoIDList {
+			placeholders = append(placeholders, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("memo_id IN (%s)", strings.Join(placeholders, ",")))
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			user_id,
+			memo_id,
+			read_ts,
+			position
+		FROM memo_read_state
+		WHERE `+strings.Join(where, " AND "),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoReadState{}
+	for rows.Next() {
+		readState := &store.MemoReadState{}
+		if err := rows.Scan(
+			&readState.UserID,
+			&readState.MemoID,
+			&readState.ReadTs,
+			&readState.Position,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, readState)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoReadState(ctx context.Context, delete *store.DeleteMemoReadState) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *delete.UserID)
+	}
+	if delete.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *delete.MemoID)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM memo_read_state WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
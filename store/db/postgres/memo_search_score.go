@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/usememos/memos/store"
+)
+
+// searchScoreExpr returns the SQL expression of the search score of the memos, appending its arguments.
+func searchScoreExpr(score *store.MemoSearchScore, args *[]any) string {
+	parts := []string{"1"}
+	for _, term := range score.Terms {
+		term = strings.ToLower(term)
+		length := utf8.RuneCountInString(term)
+		if length == 0 {
+			continue
+		}
+		// The number of occurrences of the term is the length its removal takes from the content.
+		*args = append(*args, term)
+		parts = append(parts, fmt.Sprintf("(LENGTH(LOWER(memo.content)) - LENGTH(REPLACE(LOWER(memo.content), %s, ''))) / %d.0", placeholder(len(*args)), length))
+	}
+	for _, tag := range slices.Sorted(maps.Keys(score.TagBoosts)) {
+		tagsJSON, _ := json.Marshal([]string{tag})
+		*args = append(*args, string(tagsJSON))
+		parts = append(parts, fmt.Sprintf("CASE WHEN memo.payload->'tags' @> %s::jsonb THEN %s ELSE 0 END", placeholder(len(*args)), strconv.FormatFloat(score.TagBoosts[tag], 'f', -1, 64)))
+	}
+	expr := "(" + strings.Join(parts, " + ") + ")"
+	if score.RecencyHalfLife > 0 {
+		expr = fmt.Sprintf("%s / (1 + GREATEST(%d - memo.created_ts, 0) / %d.0)", expr, score.NowTs, score.RecencyHalfLife)
+	}
+	return expr
+}
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoSubscription(ctx context.Context, upsert *store.MemoSubscription) (*store.MemoSubscription, error) {
+	stmt := `
+		INSERT INTO memo_subscription (
+			user_id, memo_id, subscribed, updated_ts
+		)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT(user_id, memo_id) DO UPDATE
+		SET subscribed = EXCLUDED.subscribed, updated_ts = EXCLUDED.updated_ts
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.UserID, upsert.MemoID, upsert.Subscribed, upsert.UpdatedTs); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListMemoSubscriptions(ctx context.Context, find *store.FindMemoSubscription) ([]*store.MemoSubscription, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+	if find.Subscribed != nil {
+		where, args = append(where, "subscribed = "+placeholder(len(args)+1)), append(args, *find.Subscribed)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			user_id,
+			memo_id,
+			subscribed,
+			updated_ts
+		FROM memo_subscription
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY updated_ts DESC, user_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoSubscription{}
+	for rows.Next() {
+		subscription := &store.MemoSubscription{}
+		if err := rows.Scan(
+			&subscription.UserID,
+			&subscription.MemoID,
+			&subscription.Subscribed,
+			&subscription.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, subscription)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoSubscription(ctx context.Context, delete *store.DeleteMemoSubscription) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *delete.UserID)
+	}
+	if delete.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *delete.MemoID)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM memo_subscription WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertNostrPublication(ctx context.Context, upsert *store.NostrPublication) error {
+	stmt := "INSERT INTO nostr_publication (memo_id, creator_id, event_id, content_hash, updated_ts) VALUES (" + placeholders(5) + ") ON CONFLICT(memo_id) DO UPDATE SET event_id = EXCLUDED.event_id, content_hash = EXCLUDED.content_hash, updated_ts = EXCLUDED.updated_ts"
+	_, err := d.db.ExecContext(ctx, stmt, upsert.MemoID, upsert.CreatorID, upsert.EventID, upsert.ContentHash, upsert.UpdatedTs)
+	return err
+}
+
+func (d *DB) ListNostrPublications(ctx context.Context, find *store.FindNostrPublication) ([]*store.NostrPublication, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+
+	query := "SELECT id, memo_id, creator_id, event_id, content_hash, updated_ts FROM nostr_publication WHERE " + strings.Join(where, " AND ") + " ORDER BY id ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.NostrPublication{}
+	for rows.Next() {
+		publication := &store.NostrPublication{}
+		if err := rows.Scan(
+			&publication.ID,
+			&publication.MemoID,
+			&publication.CreatorID,
+			&publication.EventID,
+			&publication.ContentHash,
+			&publication.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, publication)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteNostrPublication(ctx context.Context, delete *store.DeleteNostrPublication) error {
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM nostr_publication WHERE memo_id = $1", delete.MemoID); err != nil {
+		return err
+	}
+	return nil
+}
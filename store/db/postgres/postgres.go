@@ -11,6 +11,7 @@ import (
 
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db/instrument"
 )
 
 type DB struct {
@@ -24,7 +25,7 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 	}
 
 	// Open the PostgreSQL connection
-	db, err := sql.Open("postgres", profile.DSN)
+	db, err := instrument.Open("postgres", profile.DSN, profile.SlowQueryThreshold)
 	if err != nil {
 		log.Printf("Failed to open database: %s", err)
 		return nil, errors.Wrapf(err, "failed to open database: %s", profile.DSN)
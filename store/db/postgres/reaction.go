@@ -2,8 +2,11 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 
+	"github.com/pkg/errors"
+
 	"github.com/usememos/memos/store"
 )
 
@@ -11,12 +14,23 @@ func (d *DB) UpsertReaction(ctx context.Context, upsert *store.Reaction) (*store
 	fields := []string{"creator_id", "content_id", "reaction_type"}
 	args := []interface{}{upsert.CreatorID, upsert.ContentID, upsert.ReactionType}
 	stmt := "INSERT INTO reaction (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	if err := tx.QueryRowContext(ctx, stmt, args...).Scan(
 		&upsert.ID,
 		&upsert.CreatedTs,
 	); err != nil {
 		return nil, err
 	}
+	if err := refreshMemoReactionCount(ctx, tx, upsert.ContentID); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 
 	reaction := upsert
 	return reaction, nil
@@ -83,6 +97,20 @@ func (d *DB) ListReactions(ctx context.Context, find *store.FindReaction) ([]*st
 }
 
 func (d *DB) DeleteReaction(ctx context.Context, delete *store.DeleteReaction) error {
-	_, err := d.db.ExecContext(ctx, "DELETE FROM reaction WHERE id = $1", delete.ID)
-	return err
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	var contentID string
+	if err := tx.QueryRowContext(ctx, "DELETE FROM reaction WHERE id = $1 RETURNING content_id", delete.ID).Scan(&contentID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if err := refreshMemoReactionCount(ctx, tx, contentID); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateSyndicationSubscription(ctx context.Context, create *store.SyndicationSubscription) (*store.SyndicationSubscription, error) {
+	payloadString := "{}"
+	if create.Payload != nil {
+		bytes, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal syndication subscription payload")
+		}
+		payloadString = string(bytes)
+	}
+
+	fields := []string{"created_ts", "creator_id", "instance_url", "payload"}
+	args := []any{create.CreatedTs, create.CreatorID, create.InstanceURL, payloadString}
+	stmt := "INSERT INTO syndication_subscription (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListSyndicationSubscriptions(ctx context.Context, find *store.FindSyndicationSubscription) ([]*store.SyndicationSubscription, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+
+	query := "SELECT id, created_ts, creator_id, instance_url, payload, last_sync_ts, last_error FROM syndication_subscription WHERE " + strings.Join(where, " AND ") + " ORDER BY id ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.SyndicationSubscription{}
+	for rows.Next() {
+		subscription := &store.SyndicationSubscription{}
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&subscription.ID,
+			&subscription.CreatedTs,
+			&subscription.CreatorID,
+			&subscription.InstanceURL,
+			&payloadBytes,
+			&subscription.LastSyncTs,
+			&subscription.LastError,
+		); err != nil {
+			return nil, err
+		}
+
+		payload := &storepb.SyndicationSubscriptionPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, err
+		}
+		subscription.Payload = payload
+		list = append(list, subscription)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateSyndicationSubscription(ctx context.Context, update *store.UpdateSyndicationSubscription) error {
+	set, args := []string{}, []any{}
+	if v := update.LastSyncTs; v != nil {
+		set, args = append(set, "last_sync_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.LastError; v != nil {
+		set, args = append(set, "last_error = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	args = append(args, update.ID)
+	stmt := "UPDATE syndication_subscription SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args))
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) DeleteSyndicationSubscription(ctx context.Context, delete *store.DeleteSyndicationSubscription) error {
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM syndication_subscription WHERE id = $1", delete.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) UpsertFederatedMemo(ctx context.Context, upsert *store.FederatedMemo) error {
+	payloadString := "{}"
+	if upsert.Payload != nil {
+		bytes, err := protojson.Marshal(upsert.Payload)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal federated memo payload")
+		}
+		payloadString = string(bytes)
+	}
+
+	stmt := "INSERT INTO federated_memo (subscription_id, instance_url, origin_name, content, created_ts, updated_ts, synced_ts, payload) VALUES (" + placeholders(8) + ") ON CONFLICT(instance_url, origin_name) DO UPDATE SET content = EXCLUDED.content, updated_ts = EXCLUDED.updated_ts, synced_ts = EXCLUDED.synced_ts, payload = EXCLUDED.payload"
+	_, err := d.db.ExecContext(ctx, stmt, upsert.SubscriptionID, upsert.InstanceURL, upsert.OriginName, upsert.Content, upsert.CreatedTs, upsert.UpdatedTs, upsert.SyncedTs, payloadString)
+	return err
+}
+
+func (d *DB) ListFederatedMemos(ctx context.Context, find *store.FindFederatedMemo) ([]*store.FederatedMemo, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.SubscriptionID != nil {
+		where, args = append(where, "subscription_id = "+placeholder(len(args)+1)), append(args, *find.SubscriptionID)
+	}
+
+	query := "SELECT id, subscription_id, instance_url, origin_name, content, created_ts, updated_ts, synced_ts, payload FROM federated_memo WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC, id DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.FederatedMemo{}
+	for rows.Next() {
+		federatedMemo := &store.FederatedMemo{}
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&federatedMemo.ID,
+			&federatedMemo.SubscriptionID,
+			&federatedMemo.InstanceURL,
+			&federatedMemo.OriginName,
+			&federatedMemo.Content,
+			&federatedMemo.CreatedTs,
+			&federatedMemo.UpdatedTs,
+			&federatedMemo.SyncedTs,
+			&payloadBytes,
+		); err != nil {
+			return nil, err
+		}
+
+		payload := &storepb.FederatedMemoPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, err
+		}
+		federatedMemo.Payload = payload
+		list = append(list, federatedMemo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteFederatedMemos(ctx context.Context, delete *store.DeleteFederatedMemos) error {
+	where, args := []string{"subscription_id = $1"}, []any{delete.SubscriptionID}
+	if delete.SyncedTsBefore != nil {
+		where, args = append(where, "synced_ts < "+placeholder(len(args)+1)), append(args, *delete.SyncedTsBefore)
+	}
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM federated_memo WHERE "+strings.Join(where, " AND "), args...); err != nil {
+		return err
+	}
+	return nil
+}
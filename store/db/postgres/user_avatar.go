@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertUserAvatar(ctx context.Context, upsert *store.UserAvatar) error {
+	storageType, payloadString, err := marshalUserAvatarStorage(upsert)
+	if err != nil {
+		return err
+	}
+	stmt := `
+		INSERT INTO user_avatar (
+			user_id, size, type, etag, storage_type, reference, blob, payload, updated_ts
+		)
+		VALUES (` + placeholders(9) + `)
+		ON CONFLICT(user_id, size) DO UPDATE
+		SET type = EXCLUDED.type, etag = EXCLUDED.etag, storage_type = EXCLUDED.storage_type, reference = EXCLUDED.reference,
+			blob = EXCLUDED.blob, payload = EXCLUDED.payload, updated_ts = EXCLUDED.updated_ts
+	`
+	_, err = d.db.ExecContext(ctx, stmt, upsert.UserID, upsert.Size, upsert.Type, upsert.Etag, storageType, upsert.Reference, upsert.Blob, payloadString, upsert.UpdatedTs)
+	return err
+}
+
+func (d *DB) ListUserAvatars(ctx context.Context, find *store.FindUserAvatar) ([]*store.UserAvatar, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+	if find.Size != nil {
+		where, args = append(where, "size = "+placeholder(len(args)+1)), append(args, *find.Size)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT user_id, size, type, etag, storage_type, reference, blob, payload, updated_ts FROM user_avatar WHERE "+strings.Join(where, " AND ")+" ORDER BY user_id, size", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserAvatar{}
+	for rows.Next() {
+		avatar := &store.UserAvatar{}
+		var storageType string
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&avatar.UserID,
+			&avatar.Size,
+			&avatar.Type,
+			&avatar.Etag,
+			&storageType,
+			&avatar.Reference,
+			&avatar.Blob,
+			&payloadBytes,
+			&avatar.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		avatar.StorageType = storepb.AttachmentStorageType(storepb.AttachmentStorageType_value[storageType])
+		payload := &storepb.AttachmentPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, err
+		}
+		avatar.Payload = payload
+		list = append(list, avatar)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteUserAvatar(ctx context.Context, delete *store.DeleteUserAvatar) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *delete.UserID)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM user_avatar WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
+
+func marshalUserAvatarStorage(avatar *store.UserAvatar) (string, string, error) {
+	storageType := ""
+	if avatar.StorageType != storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED {
+		storageType = avatar.StorageType.String()
+	}
+	payloadString := "{}"
+	if avatar.Payload != nil {
+		bytes, err := protojson.Marshal(avatar.Payload)
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to marshal user avatar payload")
+		}
+		payloadString = string(bytes)
+	}
+	return storageType, payloadString, nil
+}
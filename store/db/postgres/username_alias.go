@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertUsernameAlias(ctx context.Context, upsert *store.UsernameAlias) error {
+	stmt := `
+		INSERT INTO username_alias (
+			username, user_id, created_ts, expires_ts
+		)
+		VALUES (` + placeholders(4) + `)
+		ON CONFLICT(username) DO UPDATE
+		SET user_id = EXCLUDED.user_id, created_ts = EXCLUDED.created_ts, expires_ts = EXCLUDED.expires_ts
+	`
+	_, err := d.db.ExecContext(ctx, stmt, upsert.Username, upsert.UserID, upsert.CreatedTs, upsert.ExpiresTs)
+	return err
+}
+
+func (d *DB) ListUsernameAliases(ctx context.Context, find *store.FindUsernameAlias) ([]*store.UsernameAlias, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.Username != nil {
+		where, args = append(where, "username = "+placeholder(len(args)+1)), append(args, *find.Username)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+	if find.ExpiresAfter != nil {
+		where, args = append(where, "expires_ts > "+placeholder(len(args)+1)), append(args, *find.ExpiresAfter)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT username, user_id, created_ts, expires_ts FROM username_alias WHERE "+strings.Join(where, " AND ")+" ORDER BY created_ts DESC, username", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UsernameAlias{}
+	for rows.Next() {
+		alias := &store.UsernameAlias{}
+		if err := rows.Scan(
+			&alias.Username,
+			&alias.UserID,
+			&alias.CreatedTs,
+			&alias.ExpiresTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, alias)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteUsernameAlias(ctx context.Context, delete *store.DeleteUsernameAlias) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.Username != nil {
+		where, args = append(where, "username = "+placeholder(len(args)+1)), append(args, *delete.Username)
+	}
+	if delete.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *delete.UserID)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM username_alias WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
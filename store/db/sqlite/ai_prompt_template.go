@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertAIPromptTemplate(ctx context.Context, upsert *store.AIPromptTemplate) error {
+	stmt := `
+		INSERT INTO ai_prompt_template (
+			creator_id, name, description, content, updated_ts
+		)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(creator_id, name) DO UPDATE
+		SET description = EXCLUDED.description, content = EXCLUDED.content, updated_ts = EXCLUDED.updated_ts
+	`
+	_, err := d.db.ExecContext(ctx, stmt, upsert.CreatorID, upsert.Name, upsert.Description, upsert.Content, upsert.UpdatedTs)
+	return err
+}
+
+func (d *DB) ListAIPromptTemplates(ctx context.Context, find *store.FindAIPromptTemplate) ([]*store.AIPromptTemplate, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.CreatorID != nil {
+		where, args = append(where, "creator_id = ?"), append(args, *find.CreatorID)
+	}
+	if find.Name != nil {
+		where, args = append(where, "name = ?"), append(args, *find.Name)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT creator_id, name, description, content, updated_ts FROM ai_prompt_template WHERE "+strings.Join(where, " AND ")+" ORDER BY creator_id, name", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.AIPromptTemplate{}
+	for rows.Next() {
+		template := &store.AIPromptTemplate{}
+		if err := rows.Scan(
+			&template.CreatorID,
+			&template.Name,
+			&template.Description,
+			&template.Content,
+			&template.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, template)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteAIPromptTemplate(ctx context.Context, delete *store.DeleteAIPromptTemplate) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM ai_prompt_template WHERE creator_id = ? AND name = ?", delete.CreatorID, delete.Name)
+	return err
+}
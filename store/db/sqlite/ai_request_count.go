@@ -0,0 +1,59 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) IncrementAIRequestCount(ctx context.Context, userID int32, hourTs int64, limit int32) (bool, error) {
+	stmt := "INSERT INTO ai_request_count (user_id, hour_ts, count) VALUES (?, ?, 1) ON CONFLICT(user_id, hour_ts) DO UPDATE SET count = count + 1 WHERE ? = 0 OR count < ?"
+	result, err := d.db.ExecContext(ctx, stmt, userID, hourTs, limit, limit)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (d *DB) DecrementAIRequestCount(ctx context.Context, userID int32, hourTs int64) error {
+	_, err := d.db.ExecContext(ctx, "UPDATE ai_request_count SET count = count - 1 WHERE user_id = ? AND hour_ts = ? AND count > 0", userID, hourTs)
+	return err
+}
+
+func (d *DB) ListAIRequestCounts(ctx context.Context, find *store.FindAIRequestCount) ([]*store.AIRequestCount, error) {
+	where, args := []string{"user_id = ?"}, []any{find.UserID}
+	if find.HourTsAfter != nil {
+		where, args = append(where, "hour_ts >= ?"), append(args, *find.HourTsAfter)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT user_id, hour_ts, count FROM ai_request_count WHERE "+strings.Join(where, " AND ")+" ORDER BY hour_ts ASC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.AIRequestCount{}
+	for rows.Next() {
+		requestCount := &store.AIRequestCount{}
+		if err := rows.Scan(&requestCount.UserID, &requestCount.HourTs, &requestCount.Count); err != nil {
+			return nil, err
+		}
+		list = append(list, requestCount)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteAIRequestCounts(ctx context.Context, delete *store.DeleteAIRequestCount) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM ai_request_count WHERE hour_ts < ?", delete.HourTsBefore)
+	return err
+}
@@ -0,0 +1,101 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateAnnouncement(ctx context.Context, create *store.Announcement) (*store.Announcement, error) {
+	fields := []string{"`created_ts`", "`updated_ts`", "`creator_id`", "`content`", "`severity`", "`start_ts`", "`end_ts`", "`dismissible`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?"}
+	args := []any{create.CreatedTs, create.UpdatedTs, create.CreatorID, create.Content, create.Severity, create.StartTs, create.EndTs, create.Dismissible}
+	stmt := "INSERT INTO `announcement` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListAnnouncements(ctx context.Context, find *store.FindAnnouncement) ([]*store.Announcement, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.ActiveTs != nil {
+		where, args = append(where, "`start_ts` <= ?"), append(args, *find.ActiveTs)
+		where, args = append(where, "(`end_ts` = 0 OR `end_ts` > ?)"), append(args, *find.ActiveTs)
+	}
+
+	query := "SELECT `id`, `created_ts`, `updated_ts`, `creator_id`, `content`, `severity`, `start_ts`, `end_ts`, `dismissible` FROM `announcement` WHERE " + strings.Join(where, " AND ") + " ORDER BY `start_ts` DESC, `id` DESC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Announcement{}
+	for rows.Next() {
+		announcement := &store.Announcement{}
+		if err := rows.Scan(
+			&announcement.ID,
+			&announcement.CreatedTs,
+			&announcement.UpdatedTs,
+			&announcement.CreatorID,
+			&announcement.Content,
+			&announcement.Severity,
+			&announcement.StartTs,
+			&announcement.EndTs,
+			&announcement.Dismissible,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, announcement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateAnnouncement(ctx context.Context, update *store.UpdateAnnouncement) error {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = ?"), append(args, *v)
+	}
+	if v := update.Content; v != nil {
+		set, args = append(set, "`content` = ?"), append(args, *v)
+	}
+	if v := update.Severity; v != nil {
+		set, args = append(set, "`severity` = ?"), append(args, *v)
+	}
+	if v := update.StartTs; v != nil {
+		set, args = append(set, "`start_ts` = ?"), append(args, *v)
+	}
+	if v := update.EndTs; v != nil {
+		set, args = append(set, "`end_ts` = ?"), append(args, *v)
+	}
+	if v := update.Dismissible; v != nil {
+		set, args = append(set, "`dismissible` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	stmt := "UPDATE `announcement` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
+	args = append(args, update.ID)
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) DeleteAnnouncement(ctx context.Context, delete *store.DeleteAnnouncement) error {
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM `announcement` WHERE `id` = ?", delete.ID); err != nil {
+		return err
+	}
+	return nil
+}
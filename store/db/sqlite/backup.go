@@ -0,0 +1,60 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql/driver"
+
+	"github.com/pkg/errors"
+	"modernc.org/sqlite"
+)
+
+// backupPagesPerStep is the number of pages copied per backup step, so that
+// writers are only blocked for short periods while the backup is running.
+const backupPagesPerStep = 1024
+
+type backuper interface {
+	NewBackup(dstURI string) (*sqlite.Backup, error)
+}
+
+// Backup writes a consistent copy of the live database to dstPath using the
+// SQLite online backup API.
+func (d *DB) Backup(ctx context.Context, dstPath string) error {
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get connection")
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		// Connections may be wrapped, e.g. for slow query logging.
+		for {
+			wrapper, ok := driverConn.(interface{ Unwrap() driver.Conn })
+			if !ok {
+				break
+			}
+			driverConn = wrapper.Unwrap()
+		}
+		source, ok := driverConn.(backuper)
+		if !ok {
+			return errors.New("sqlite connection does not support online backup")
+		}
+		backup, err := source.NewBackup(dstPath)
+		if err != nil {
+			return errors.Wrap(err, "failed to start backup")
+		}
+		for more := true; more; {
+			if err := ctx.Err(); err != nil {
+				backup.Finish()
+				return err
+			}
+			if more, err = backup.Step(backupPagesPerStep); err != nil {
+				backup.Finish()
+				return errors.Wrap(err, "failed to step backup")
+			}
+		}
+		if err := backup.Finish(); err != nil {
+			return errors.Wrap(err, "failed to finish backup")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,58 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateBlueskyPost(ctx context.Context, create *store.BlueskyPost) (*store.BlueskyPost, error) {
+	stmt := "INSERT INTO `bluesky_post` (`memo_id`, `creator_id`, `uri`, `created_ts`) VALUES (?, ?, ?, ?) RETURNING `id`"
+	if err := d.db.QueryRowContext(ctx, stmt, create.MemoID, create.CreatorID, create.URI, create.CreatedTs).Scan(&create.ID); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListBlueskyPosts(ctx context.Context, find *store.FindBlueskyPost) ([]*store.BlueskyPost, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.MemoID != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *find.MemoID)
+	}
+
+	query := "SELECT `id`, `memo_id`, `creator_id`, `uri`, `created_ts` FROM `bluesky_post` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.BlueskyPost{}
+	for rows.Next() {
+		post := &store.BlueskyPost{}
+		if err := rows.Scan(
+			&post.ID,
+			&post.MemoID,
+			&post.CreatorID,
+			&post.URI,
+			&post.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, post)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteBlueskyPost(ctx context.Context, delete *store.DeleteBlueskyPost) error {
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM `bluesky_post` WHERE `memo_id` = ?", delete.MemoID); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// coldMemoColumns are the memo columns copied between the memo and cold_memo tables.
+const coldMemoColumns = "`id`, `uid`, `creator_id`, `created_ts`, `updated_ts`, `row_status`, `content`, `visibility`, `pinned`, `payload`, `comment_count`, `reaction_count`, `relation_count`"
+
+func (d *DB) MoveMemosToColdStorage(ctx context.Context, move *store.MoveMemosToColdStorage) (int, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, "SELECT `id` FROM `memo` WHERE `row_status` = ? AND `updated_ts` < ? ORDER BY `id` LIMIT ?", store.Archived, move.UpdatedBefore, move.Limit)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	placeholders, args, ids := []string{}, []any{}, []int32{}
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return 0, err
+		}
+		placeholders, args, ids = append(placeholders, "?"), append(args, id), append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	rows.Close()
+	if len(args) == 0 {
+		return 0, nil
+	}
+
+	where := "`id` IN (" + strings.Join(placeholders, ",") + ")"
+	if _, err := tx.ExecContext(ctx, "INSERT INTO `cold_memo` ("+coldMemoColumns+") SELECT "+coldMemoColumns+" FROM `memo` WHERE "+where, args...); err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `memo` WHERE "+where, args...); err != nil {
+		return 0, err
+	}
+	// The cold memos are not filtered by tag, their tags are indexed again once restored.
+	if err := refreshMemoTags(ctx, tx, ids); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(args), nil
+}
+
+func (d *DB) ListColdMemos(ctx context.Context, find *store.FindColdMemo) ([]*store.Memo, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.UID; v != nil {
+		where, args = append(where, "`uid` = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.ContentSearch; v != nil {
+		where, args = append(where, "`content` LIKE ?"), append(args, fmt.Sprintf("%%%s%%", *v))
+	}
+
+	query := "SELECT " + coldMemoColumns + " FROM `cold_memo` WHERE " + strings.Join(where, " AND ") + " ORDER BY `updated_ts` DESC, `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Memo{}
+	for rows.Next() {
+		var memo store.Memo
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&memo.ID,
+			&memo.UID,
+			&memo.CreatorID,
+			&memo.CreatedTs,
+			&memo.UpdatedTs,
+			&memo.RowStatus,
+			&memo.Content,
+			&memo.Visibility,
+			&memo.Pinned,
+			&payloadBytes,
+			&memo.CommentCount,
+			&memo.ReactionCount,
+			&memo.RelationCount,
+		); err != nil {
+			return nil, err
+		}
+		payload := &storepb.MemoPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal payload")
+		}
+		memo.Payload = payload
+		list = append(list, &memo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) RestoreColdMemo(ctx context.Context, id int32) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "INSERT INTO `memo` ("+coldMemoColumns+") SELECT "+coldMemoColumns+" FROM `cold_memo` WHERE `id` = ?", id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.Errorf("cold memo not found: %d", id)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `cold_memo` WHERE `id` = ?", id); err != nil {
+		return err
+	}
+	if err := refreshMemoTags(ctx, tx, []int32{id}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
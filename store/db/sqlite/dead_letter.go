@@ -0,0 +1,129 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateDeadLetter(ctx context.Context, create *store.DeadLetter) (*store.DeadLetter, error) {
+	payloadString := "{}"
+	if create.Payload != nil {
+		bytes, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal dead letter payload")
+		}
+		payloadString = string(bytes)
+	}
+	if create.Attempts == 0 {
+		create.Attempts = 1
+	}
+
+	fields := []string{"`job_type`", "`user_id`", "`payload`", "`error`", "`attempts`"}
+	placeholder := []string{"?", "?", "?", "?", "?"}
+	args := []any{create.JobType, create.UserID, payloadString, create.Error, create.Attempts}
+
+	stmt := "INSERT INTO `dead_letter` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListDeadLetters(ctx context.Context, find *store.FindDeadLetter) ([]*store.DeadLetter, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.JobType != nil {
+		where, args = append(where, "`job_type` = ?"), append(args, *find.JobType)
+	}
+
+	query := "SELECT `id`, `created_ts`, `updated_ts`, `job_type`, `user_id`, `payload`, `error`, `attempts` FROM `dead_letter` WHERE " + strings.Join(where, " AND ") + " ORDER BY `updated_ts` DESC, `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.DeadLetter{}
+	for rows.Next() {
+		deadLetter := &store.DeadLetter{}
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&deadLetter.ID,
+			&deadLetter.CreatedTs,
+			&deadLetter.UpdatedTs,
+			&deadLetter.JobType,
+			&deadLetter.UserID,
+			&payloadBytes,
+			&deadLetter.Error,
+			&deadLetter.Attempts,
+		); err != nil {
+			return nil, err
+		}
+
+		payload := &storepb.DeadLetterPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, err
+		}
+		deadLetter.Payload = payload
+		list = append(list, deadLetter)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateDeadLetter(ctx context.Context, update *store.UpdateDeadLetter) error {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = ?"), append(args, *v)
+	}
+	if v := update.Error; v != nil {
+		set, args = append(set, "`error` = ?"), append(args, *v)
+	}
+	if v := update.Attempts; v != nil {
+		set, args = append(set, "`attempts` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE `dead_letter` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) DeleteDeadLetter(ctx context.Context, delete *store.DeleteDeadLetter) error {
+	result, err := d.db.ExecContext(ctx, "DELETE FROM `dead_letter` WHERE `id` = ?", delete.ID)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
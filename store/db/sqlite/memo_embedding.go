@@ -0,0 +1,117 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoEmbedding(ctx context.Context, upsert *store.MemoEmbedding) error {
+	stmt := `
+		INSERT INTO memo_embedding (
+			memo_id, model, content_hash, embedding, updated_ts
+		)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(memo_id) DO UPDATE
+		SET model = EXCLUDED.model, content_hash = EXCLUDED.content_hash, embedding = EXCLUDED.embedding, updated_ts = EXCLUDED.updated_ts
+	`
+	_, err := d.db.ExecContext(ctx, stmt, upsert.MemoID, upsert.Model, upsert.ContentHash, store.EncodeEmbedding(upsert.Embedding), upsert.UpdatedTs)
+	return err
+}
+
+func (d *DB) ListMemoEmbeddings(ctx context.Context, find *store.FindMemoEmbedding) ([]*store.MemoEmbedding, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if len(find.MemoIDList) > 0 {
+		placeholder := []string{}
+		for _, id := range find.MemoIDList {
+			placeholder, args = append(placeholder, "?"), append(args, id)
+		}
+		where = append(where, fmt.Sprintf("memo_id IN (%s)", strings.Join(placeholder, ",")))
+	}
+	if find.Model != nil {
+		where, args = append(where, "model = ?"), append(args, *find.Model)
+	}
+	return d.listMemoEmbeddings(ctx, "SELECT memo_id, model, content_hash, embedding, updated_ts FROM memo_embedding WHERE "+strings.Join(where, " AND "), args...)
+}
+
+// SearchMemoEmbeddings ranks the embeddings in process, SQLite has no vector support.
+func (d *DB) SearchMemoEmbeddings(ctx context.Context, search *store.SearchMemoEmbedding) ([]*store.MemoEmbeddingMatch, error) {
+	where, args := []string{"memo_embedding.model = ?"}, []any{search.Model}
+	states := []string{}
+	for _, rowStatus := range store.GetSearchedRowStatuses(search.RowStatusList) {
+		switch rowStatus {
+		case store.Normal:
+			visible, visibleArgs := []string{}, []any{}
+			if search.ViewerID != nil {
+				visible, visibleArgs = append(visible, "memo.creator_id = ?"), append(visibleArgs, *search.ViewerID)
+			}
+			if len(search.VisibilityList) > 0 {
+				placeholder := []string{}
+				for _, visibility := range search.VisibilityList {
+					placeholder, visibleArgs = append(placeholder, "?"), append(visibleArgs, visibility.String())
+				}
+				visible = append(visible, fmt.Sprintf("memo.visibility IN (%s)", strings.Join(placeholder, ",")))
+			}
+			if len(visible) > 0 {
+				states = append(states, "(memo.row_status = ? AND ("+strings.Join(visible, " OR ")+"))")
+				args = append(append(args, store.Normal), visibleArgs...)
+			}
+		case store.Archived:
+			// The archived memos are only searched among the memos of the viewer.
+			if search.ViewerID != nil {
+				states, args = append(states, "(memo.row_status = ? AND memo.creator_id = ?)"), append(args, store.Archived, *search.ViewerID)
+			}
+		}
+	}
+	if len(states) == 0 {
+		return []*store.MemoEmbeddingMatch{}, nil
+	}
+	where = append(where, "("+strings.Join(states, " OR ")+")")
+
+	embeddings, err := d.listMemoEmbeddings(ctx, "SELECT memo_embedding.memo_id, memo_embedding.model, memo_embedding.content_hash, memo_embedding.embedding, memo_embedding.updated_ts FROM memo_embedding JOIN memo ON memo.id = memo_embedding.memo_id WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return nil, err
+	}
+	return store.RankMemoEmbeddings(embeddings, search.Embedding, search.Limit), nil
+}
+
+func (d *DB) listMemoEmbeddings(ctx context.Context, query string, args ...any) ([]*store.MemoEmbedding, error) {
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoEmbedding{}
+	for rows.Next() {
+		memoEmbedding := &store.MemoEmbedding{}
+		var embedding []byte
+		if err := rows.Scan(&memoEmbedding.MemoID, &memoEmbedding.Model, &memoEmbedding.ContentHash, &embedding, &memoEmbedding.UpdatedTs); err != nil {
+			return nil, err
+		}
+		if memoEmbedding.Embedding, err = store.DecodeEmbedding(embedding); err != nil {
+			return nil, err
+		}
+		list = append(list, memoEmbedding)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoEmbedding(ctx context.Context, delete *store.DeleteMemoEmbedding) error {
+	where, args := []string{"TRUE"}, []any{}
+	if delete.MemoID != nil {
+		where, args = append(where, "memo_id = ?"), append(args, *delete.MemoID)
+	}
+	if delete.CreatorID != nil {
+		where, args = append(where, "memo_id IN (SELECT id FROM memo WHERE creator_id = ?)"), append(args, *delete.CreatorID)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM memo_embedding WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
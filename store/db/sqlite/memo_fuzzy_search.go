@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"unicode"
+
+	moderncsqlite "modernc.org/sqlite"
+)
+
+func init() {
+	// The fuzzy search of the memo filter calls the word_similarity function of pg_trgm, which SQLite lacks.
+	moderncsqlite.MustRegisterDeterministicScalarFunction("word_similarity", 2, func(_ *moderncsqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		return wordSimilarity(sqlText(args[0]), sqlText(args[1])), nil
+	})
+}
+
+// PrepareFuzzySearch is a no-op, the word_similarity function is registered with the driver.
+func (*DB) PrepareFuzzySearch(_ context.Context) error {
+	return nil
+}
+
+// wordSimilarity emulates the word_similarity function of pg_trgm: the greatest similarity of the trigrams of the
+// words of the term to the trigrams of the same number of consecutive words of the content, from 0 to 1. The
+// similarity of two sets of trigrams is the number of trigrams they share over the number of distinct trigrams.
+func wordSimilarity(term, content string) float64 {
+	termWords := trigramWords(term)
+	if len(termWords) == 0 {
+		return 0
+	}
+	termTrigrams := wordTrigrams(termWords)
+	words := trigramWords(content)
+	best := 0.0
+	for i := 0; i+len(termWords) <= len(words); i++ {
+		trigrams := wordTrigrams(words[i : i+len(termWords)])
+		shared := 0
+		for trigram := range trigrams {
+			if termTrigrams[trigram] {
+				shared++
+			}
+		}
+		best = max(best, float64(shared)/float64(len(termTrigrams)+len(trigrams)-shared))
+	}
+	return best
+}
+
+// trigramWords returns the lower case words of the text, the runs of letters and digits like in pg_trgm.
+func trigramWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// wordTrigrams returns the trigrams of the words, each padded with two spaces before it and one after it.
+func wordTrigrams(words []string) map[string]bool {
+	trigrams := map[string]bool{}
+	for _, word := range words {
+		runes := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			trigrams[string(runes[i:i+3])] = true
+		}
+	}
+	return trigrams
+}
+
+// sqlText returns the text of a value passed to a SQL function, empty for NULL.
+func sqlText(value driver.Value) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case []byte:
+		return string(value)
+	default:
+		return ""
+	}
+}
@@ -19,8 +19,13 @@ func (d *DB) UpsertMemoRelation(ctx context.Context, create *store.MemoRelation)
 		VALUES (?, ?, ?)
 		RETURNING memo_id, related_memo_id, type
 	`
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 	memoRelation := &store.MemoRelation{}
-	if err := d.db.QueryRowContext(
+	if err := tx.QueryRowContext(
 		ctx,
 		stmt,
 		create.MemoID,
@@ -33,6 +38,12 @@ func (d *DB) UpsertMemoRelation(ctx context.Context, create *store.MemoRelation)
 	); err != nil {
 		return nil, err
 	}
+	if err := refreshMemoRelationCounts(ctx, tx, []int32{memoRelation.MemoID, memoRelation.RelatedMemoID}); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 
 	return memoRelation, nil
 }
@@ -45,9 +56,33 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 	if find.RelatedMemoID != nil {
 		where, args = append(where, "related_memo_id = ?"), append(args, find.RelatedMemoID)
 	}
+	if len(find.MemoIDList) > 0 {
+		placeholders := make([]string, 0, len(find.MemoIDList))
+		for _, id := range find.MemoIDList {
+			placeholders = append(placeholders, "?")
+			args = append(args, id)
+		}
+		where = append(where, "memo_id IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if len(find.RelatedMemoIDList) > 0 {
+		placeholders := make([]string, 0, len(find.RelatedMemoIDList))
+		for _, id := range find.RelatedMemoIDList {
+			placeholders = append(placeholders, "?")
+			args = append(args, id)
+		}
+		where = append(where, "related_memo_id IN ("+strings.Join(placeholders, ",")+")")
+	}
 	if find.Type != nil {
 		where, args = append(where, "type = ?"), append(args, find.Type)
 	}
+	if len(find.TypeList) > 0 {
+		placeholders := make([]string, 0, len(find.TypeList))
+		for _, relationType := range find.TypeList {
+			placeholders = append(placeholders, "?")
+			args = append(args, relationType)
+		}
+		where = append(where, "type IN ("+strings.Join(placeholders, ",")+")")
+	}
 	if find.MemoFilter != nil {
 		engine, err := filter.DefaultEngine()
 		if err != nil {
@@ -107,15 +142,43 @@ func (d *DB) DeleteMemoRelation(ctx context.Context, delete *store.DeleteMemoRel
 	if delete.Type != nil {
 		where, args = append(where, "type = ?"), append(args, delete.Type)
 	}
+	if len(delete.TypeList) > 0 {
+		placeholders := make([]string, 0, len(delete.TypeList))
+		for _, relationType := range delete.TypeList {
+			placeholders = append(placeholders, "?")
+			args = append(args, relationType)
+		}
+		where = append(where, "type IN ("+strings.Join(placeholders, ",")+")")
+	}
 	stmt := `
 		DELETE FROM memo_relation
-		WHERE ` + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+		WHERE ` + strings.Join(where, " AND ") + `
+		RETURNING memo_id, related_memo_id`
+	tx, err := d.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
-	if _, err = result.RowsAffected(); err != nil {
+	defer tx.Rollback()
+	rows, err := tx.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	memoIDs := []int32{}
+	for rows.Next() {
+		var memoID, relatedMemoID int32
+		if err := rows.Scan(&memoID, &relatedMemoID); err != nil {
+			return err
+		}
+		memoIDs = append(memoIDs, memoID, relatedMemoID)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+	if err := refreshMemoRelationCounts(ctx, tx, memoIDs); err != nil {
 		return err
 	}
-	return nil
+	return tx.Commit()
 }
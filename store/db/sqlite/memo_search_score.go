@@ -0,0 +1,36 @@
+package sqlite
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/usememos/memos/store"
+)
+
+// searchScoreExpr returns the SQL expression of the search score of the memos, appending its arguments.
+func searchScoreExpr(score *store.MemoSearchScore, args *[]any) string {
+	parts := []string{"1"}
+	for _, term := range score.Terms {
+		term = strings.ToLower(term)
+		length := utf8.RuneCountInString(term)
+		if length == 0 {
+			continue
+		}
+		// The number of occurrences of the term is the length its removal takes from the content.
+		parts = append(parts, fmt.Sprintf("(LENGTH(LOWER(`memo`.`content`)) - LENGTH(REPLACE(LOWER(`memo`.`content`), ?, ''))) / %d.0", length))
+		*args = append(*args, term)
+	}
+	for _, tag := range slices.Sorted(maps.Keys(score.TagBoosts)) {
+		parts = append(parts, fmt.Sprintf("CASE WHEN JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? THEN %s ELSE 0 END", strconv.FormatFloat(score.TagBoosts[tag], 'f', -1, 64)))
+		*args = append(*args, fmt.Sprintf(`%%"%s"%%`, tag))
+	}
+	expr := "(" + strings.Join(parts, " + ") + ")"
+	if score.RecencyHalfLife > 0 {
+		expr = fmt.Sprintf("%s / (1 + MAX(%d - `memo`.`created_ts`, 0) / %d.0)", expr, score.NowTs, score.RecencyHalfLife)
+	}
+	return expr
+}
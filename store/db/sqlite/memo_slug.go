@@ -0,0 +1,65 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoSlug(ctx context.Context, upsert *store.MemoSlug) error {
+	stmt := `
+		INSERT INTO memo_slug (
+			creator_id, slug, memo_id, created_ts
+		)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(creator_id, slug) DO UPDATE
+		SET memo_id = EXCLUDED.memo_id, created_ts = EXCLUDED.created_ts
+	`
+	_, err := d.db.ExecContext(ctx, stmt, upsert.CreatorID, upsert.Slug, upsert.MemoID, upsert.CreatedTs)
+	return err
+}
+
+func (d *DB) ListMemoSlugs(ctx context.Context, find *store.FindMemoSlug) ([]*store.MemoSlug, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.CreatorID != nil {
+		where, args = append(where, "creator_id = ?"), append(args, *find.CreatorID)
+	}
+	if find.Slug != nil {
+		where, args = append(where, "slug = ?"), append(args, *find.Slug)
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = ?"), append(args, *find.MemoID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT creator_id, slug, memo_id, created_ts FROM memo_slug WHERE "+strings.Join(where, " AND ")+" ORDER BY created_ts DESC, slug", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoSlug{}
+	for rows.Next() {
+		memoSlug := &store.MemoSlug{}
+		if err := rows.Scan(
+			&memoSlug.CreatorID,
+			&memoSlug.Slug,
+			&memoSlug.MemoID,
+			&memoSlug.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, memoSlug)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoSlug(ctx context.Context, delete *store.DeleteMemoSlug) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM memo_slug WHERE memo_id = ?", delete.MemoID)
+	return err
+}
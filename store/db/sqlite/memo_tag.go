@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// refreshMemoTags indexes the tags of the payload of the given memos in the memo_tag table, the memos not in the memo
+// table anymore losing their tags.
+func refreshMemoTags(ctx context.Context, db execer, memoIDs []int32) error {
+	memoIDs = slices.Compact(slices.Sorted(slices.Values(memoIDs)))
+	for chunk := range slices.Chunk(memoIDs, store.BatchInsertSize) {
+		placeholders, args := make([]string, 0, len(chunk)), make([]any, 0, len(chunk))
+		for _, id := range chunk {
+			placeholders, args = append(placeholders, "?"), append(args, id)
+		}
+		in := "(" + strings.Join(placeholders, ",") + ")"
+		if _, err := db.ExecContext(ctx, "DELETE FROM `memo_tag` WHERE `memo_id` IN "+in, args...); err != nil {
+			return err
+		}
+		stmt := "INSERT INTO `memo_tag` (`memo_id`, `tag`) " +
+			"SELECT DISTINCT `memo`.`id`, `json_each`.`value` FROM `memo`, json_each(`memo`.`payload`, '$.tags') " +
+			"WHERE `memo`.`id` IN " + in
+		if _, err := db.ExecContext(ctx, stmt, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *DB) ListMemoTagCounts(ctx context.Context, find *store.FindMemoTagCount) ([]*store.MemoTagCount, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "`memo`.`row_status` = ?"), append(args, *v)
+	}
+	if v := find.VisibilityList; len(v) != 0 {
+		placeholder := []string{}
+		for _, visibility := range v {
+			placeholder = append(placeholder, "?")
+			args = append(args, visibility.String())
+		}
+		where = append(where, fmt.Sprintf("`memo`.`visibility` IN (%s)", strings.Join(placeholder, ",")))
+	}
+	if find.ExcludeComments {
+		where, args = append(where, "NOT EXISTS (SELECT 1 FROM `memo_relation` WHERE `memo_relation`.`memo_id` = `memo`.`id` AND `memo_relation`.`type` = ?)"), append(args, store.MemoRelationComment)
+	}
+
+	query := "SELECT `memo_tag`.`tag`, COUNT(*) FROM `memo_tag` JOIN `memo` ON `memo`.`id` = `memo_tag`.`memo_id` " +
+		"WHERE " + strings.Join(where, " AND ") + " GROUP BY `memo_tag`.`tag` ORDER BY COUNT(*) DESC, `memo_tag`.`tag` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTagCount{}
+	for rows.Next() {
+		tagCount := &store.MemoTagCount{}
+		if err := rows.Scan(&tagCount.Tag, &tagCount.Count); err != nil {
+			return nil, err
+		}
+		list = append(list, tagCount)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -3,6 +3,9 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -11,8 +14,16 @@ import (
 
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db/instrument"
 )
 
+const (
+	defaultJournalMode = "WAL"
+	defaultBusyTimeout = 10000
+)
+
+var journalModes = []string{"DELETE", "TRUNCATE", "PERSIST", "MEMORY", "WAL", "OFF"}
+
 type DB struct {
 	db      *sql.DB
 	profile *profile.Profile
@@ -31,8 +42,9 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 	// - No shared-cache: it's obsolete; WAL journal mode is a better solution.
 	// - No foreign key constraints: it's currently disabled by default, but it's a
 	// good practice to be explicit and prevent future surprises on SQLite upgrades.
-	// - Journal mode set to WAL: it's the recommended journal mode for most applications
+	// - Journal mode set to WAL by default: it's the recommended journal mode for most applications
 	// as it prevents locking issues.
+	// - Busy timeout and memory-mapped I/O size are configurable through the profile.
 	//
 	// Notes:
 	// - When using the `modernc.org/sqlite` driver, each pragma must be prefixed with `_pragma=`.
@@ -41,7 +53,11 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 	// - https://pkg.go.dev/modernc.org/sqlite#Driver.Open
 	// - https://www.sqlite.org/sharedcache.html
 	// - https://www.sqlite.org/pragma.html
-	sqliteDB, err := sql.Open("sqlite", profile.DSN+"?_pragma=foreign_keys(0)&_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)")
+	pragmas, err := buildPragmas(profile)
+	if err != nil {
+		return nil, err
+	}
+	sqliteDB, err := instrument.Open("sqlite", profile.DSN+"?"+pragmas, profile.SlowQueryThreshold)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to open db with dsn: %s", profile.DSN)
 	}
@@ -51,6 +67,34 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 	return &driver, nil
 }
 
+// buildPragmas returns the connection pragmas derived from the profile.
+func buildPragmas(profile *profile.Profile) (string, error) {
+	journalMode := strings.ToUpper(profile.SQLiteJournalMode)
+	if journalMode == "" {
+		journalMode = defaultJournalMode
+	}
+	if !slices.Contains(journalModes, journalMode) {
+		return "", errors.Errorf("invalid sqlite journal mode: %s", profile.SQLiteJournalMode)
+	}
+	busyTimeout := profile.SQLiteBusyTimeout
+	if busyTimeout <= 0 {
+		busyTimeout = defaultBusyTimeout
+	}
+	if profile.SQLiteMmapSize < 0 {
+		return "", errors.Errorf("invalid sqlite mmap size: %d", profile.SQLiteMmapSize)
+	}
+
+	pragmas := []string{
+		"_pragma=foreign_keys(0)",
+		fmt.Sprintf("_pragma=busy_timeout(%d)", busyTimeout),
+		fmt.Sprintf("_pragma=journal_mode(%s)", journalMode),
+	}
+	if profile.SQLiteMmapSize > 0 {
+		pragmas = append(pragmas, fmt.Sprintf("_pragma=mmap_size(%d)", profile.SQLiteMmapSize))
+	}
+	return strings.Join(pragmas, "&"), nil
+}
+
 func (d *DB) GetDB() *sql.DB {
 	return d.db
 }
@@ -0,0 +1,24 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+)
+
+func TestBuildPragmas(t *testing.T) {
+	pragmas, err := buildPragmas(&profile.Profile{})
+	require.NoError(t, err)
+	require.Equal(t, "_pragma=foreign_keys(0)&_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)", pragmas)
+
+	pragmas, err = buildPragmas(&profile.Profile{SQLiteJournalMode: "delete", SQLiteBusyTimeout: 500, SQLiteMmapSize: 268435456})
+	require.NoError(t, err)
+	require.Equal(t, "_pragma=foreign_keys(0)&_pragma=busy_timeout(500)&_pragma=journal_mode(DELETE)&_pragma=mmap_size(268435456)", pragmas)
+
+	_, err = buildPragmas(&profile.Profile{SQLiteJournalMode: "bogus"})
+	require.Error(t, err)
+	_, err = buildPragmas(&profile.Profile{SQLiteMmapSize: -1})
+	require.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// DeadLetterJobType is the type of the async job a dead letter was recorded for.
+type DeadLetterJobType string
+
+const (
+	DeadLetterJobTypeWebhook    DeadLetterJobType = "WEBHOOK"
+	DeadLetterJobTypeAISummary  DeadLetterJobType = "AI_SUMMARY"
+	DeadLetterJobTypeUserImport DeadLetterJobType = "USER_IMPORT"
+)
+
+func (t DeadLetterJobType) String() string {
+	return string(t)
+}
+
+// DeadLetter is a failed async job, kept with its input so that it can be retried or discarded.
+type DeadLetter struct {
+	ID        int32
+	CreatedTs int64
+	UpdatedTs int64
+
+	JobType DeadLetterJobType
+	// UserID is the user the job ran for.
+	UserID  int32
+	Payload *storepb.DeadLetterPayload
+	// Error is the error of the last attempt.
+	Error    string
+	Attempts int32
+}
+
+type FindDeadLetter struct {
+	ID      *int32
+	JobType *DeadLetterJobType
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type UpdateDeadLetter struct {
+	ID        int32
+	UpdatedTs *int64
+	Error     *string
+	Attempts  *int32
+}
+
+type DeleteDeadLetter struct {
+	ID int32
+}
+
+func (s *Store) CreateDeadLetter(ctx context.Context, create *DeadLetter) (*DeadLetter, error) {
+	return s.driver.CreateDeadLetter(ctx, create)
+}
+
+// ListDeadLetters lists dead letters, most recent failures first.
+func (s *Store) ListDeadLetters(ctx context.Context, find *FindDeadLetter) ([]*DeadLetter, error) {
+	return s.driver.ListDeadLetters(ctx, find)
+}
+
+func (s *Store) GetDeadLetter(ctx context.Context, find *FindDeadLetter) (*DeadLetter, error) {
+	list, err := s.ListDeadLetters(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateDeadLetter(ctx context.Context, update *UpdateDeadLetter) error {
+	return s.driver.UpdateDeadLetter(ctx, update)
+}
+
+func (s *Store) DeleteDeadLetter(ctx context.Context, delete *DeleteDeadLetter) error {
+	return s.driver.DeleteDeadLetter(ctx, delete)
+}
@@ -35,12 +35,39 @@ type Driver interface {
 	ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error)
 	UpdateMemo(ctx context.Context, update *UpdateMemo) error
 	DeleteMemo(ctx context.Context, delete *DeleteMemo) error
+	BatchCreateMemos(ctx context.Context, create *BatchCreateMemos) ([]*Memo, error)
+	CreateMemoWithAssociations(ctx context.Context, create *Memo, associations *MemoAssociations) (*Memo, error)
+	// PrepareFuzzySearch prepares the database for the fuzzy_contains() of the memo filter, e.g. creates an extension.
+	PrepareFuzzySearch(ctx context.Context) error
 
 	// MemoRelation model related methods.
 	UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error)
 	ListMemoRelations(ctx context.Context, find *FindMemoRelation) ([]*MemoRelation, error)
 	DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error
 
+	// MemoReadState model related methods.
+	UpsertMemoReadState(ctx context.Context, upsert *MemoReadState) (*MemoReadState, error)
+	ListMemoReadStates(ctx context.Context, find *FindMemoReadState) ([]*MemoReadState, error)
+	DeleteMemoReadState(ctx context.Context, delete *DeleteMemoReadState) error
+
+	// MemoSubscription model related methods.
+	UpsertMemoSubscription(ctx context.Context, upsert *MemoSubscription) (*MemoSubscription, error)
+	ListMemoSubscriptions(ctx context.Context, find *FindMemoSubscription) ([]*MemoSubscription, error)
+	DeleteMemoSubscription(ctx context.Context, delete *DeleteMemoSubscription) error
+
+	// MemoTag model related methods.
+	ListMemoTagCounts(ctx context.Context, find *FindMemoTagCount) ([]*MemoTagCount, error)
+
+	// MemoView model related methods.
+	CreateMemoViews(ctx context.Context, creates []*MemoView) error
+	ListMemoViewCounts(ctx context.Context, find *FindMemoView) ([]*MemoViewCount, error)
+	DeleteMemoViews(ctx context.Context, delete *DeleteMemoView) error
+
+	// ColdMemo model related methods.
+	MoveMemosToColdStorage(ctx context.Context, move *MoveMemosToColdStorage) (int, error)
+	ListColdMemos(ctx context.Context, find *FindColdMemo) ([]*Memo, error)
+	RestoreColdMemo(ctx context.Context, id int32) error
+
 	// WorkspaceSetting model related methods.
 	UpsertWorkspaceSetting(ctx context.Context, upsert *WorkspaceSetting) (*WorkspaceSetting, error)
 	ListWorkspaceSettings(ctx context.Context, find *FindWorkspaceSetting) ([]*WorkspaceSetting, error)
@@ -68,8 +95,118 @@ type Driver interface {
 	UpdateInbox(ctx context.Context, update *UpdateInbox) (*Inbox, error)
 	DeleteInbox(ctx context.Context, delete *DeleteInbox) error
 
+	// Event model related methods.
+	CreateEvent(ctx context.Context, create *Event) (*Event, error)
+	ListEvents(ctx context.Context, find *FindEvent) ([]*Event, error)
+
+	// WorkspaceUsage related methods.
+	GetWorkspaceUsage(ctx context.Context) (*WorkspaceUsage, error)
+
+	// DeadLetter model related methods.
+	CreateDeadLetter(ctx context.Context, create *DeadLetter) (*DeadLetter, error)
+	ListDeadLetters(ctx context.Context, find *FindDeadLetter) ([]*DeadLetter, error)
+	UpdateDeadLetter(ctx context.Context, update *UpdateDeadLetter) error
+	DeleteDeadLetter(ctx context.Context, delete *DeleteDeadLetter) error
+
+	// WebhookDelivery model related methods.
+	CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error)
+	DeleteWebhookDeliveries(ctx context.Context, delete *DeleteWebhookDelivery) error
+
 	// Reaction model related methods.
 	UpsertReaction(ctx context.Context, create *Reaction) (*Reaction, error)
 	ListReactions(ctx context.Context, find *FindReaction) ([]*Reaction, error)
 	DeleteReaction(ctx context.Context, delete *DeleteReaction) error
+
+	// MemoEmbedding model related methods.
+	UpsertMemoEmbedding(ctx context.Context, upsert *MemoEmbedding) error
+	ListMemoEmbeddings(ctx context.Context, find *FindMemoEmbedding) ([]*MemoEmbedding, error)
+	SearchMemoEmbeddings(ctx context.Context, search *SearchMemoEmbedding) ([]*MemoEmbeddingMatch, error)
+	DeleteMemoEmbedding(ctx context.Context, delete *DeleteMemoEmbedding) error
+
+	// AIRequestCount model related methods.
+	IncrementAIRequestCount(ctx context.Context, userID int32, hourTs int64, limit int32) (bool, error)
+	DecrementAIRequestCount(ctx context.Context, userID int32, hourTs int64) error
+	ListAIRequestCounts(ctx context.Context, find *FindAIRequestCount) ([]*AIRequestCount, error)
+	DeleteAIRequestCounts(ctx context.Context, delete *DeleteAIRequestCount) error
+
+	// AIUsage model related methods.
+	CreateAIUsage(ctx context.Context, create *AIUsage) (*AIUsage, error)
+	ListAIUsages(ctx context.Context, find *FindAIUsage) ([]*AIUsage, error)
+	ListAIUsageStats(ctx context.Context, find *FindAIUsage) ([]*AIUsageStats, error)
+
+	// AIDebugLog model related methods.
+	CreateAIDebugLog(ctx context.Context, create *AIDebugLog) (*AIDebugLog, error)
+	ListAIDebugLogs(ctx context.Context, find *FindAIDebugLog) ([]*AIDebugLog, error)
+	DeleteAIDebugLogs(ctx context.Context, delete *DeleteAIDebugLog) (int64, error)
+
+	// AIPromptTemplate model related methods.
+	UpsertAIPromptTemplate(ctx context.Context, upsert *AIPromptTemplate) error
+	ListAIPromptTemplates(ctx context.Context, find *FindAIPromptTemplate) ([]*AIPromptTemplate, error)
+	DeleteAIPromptTemplate(ctx context.Context, delete *DeleteAIPromptTemplate) error
+
+	// AIJob model related methods.
+	CreateAIJob(ctx context.Context, create *AIJob) (*AIJob, error)
+	ListAIJobs(ctx context.Context, find *FindAIJob) ([]*AIJob, error)
+	UpdateAIJob(ctx context.Context, update *UpdateAIJob) error
+
+	// MemoSlug model related methods.
+	UpsertMemoSlug(ctx context.Context, upsert *MemoSlug) error
+	ListMemoSlugs(ctx context.Context, find *FindMemoSlug) ([]*MemoSlug, error)
+	DeleteMemoSlug(ctx context.Context, delete *DeleteMemoSlug) error
+
+	// UsernameAlias model related methods.
+	UpsertUsernameAlias(ctx context.Context, upsert *UsernameAlias) error
+	ListUsernameAliases(ctx context.Context, find *FindUsernameAlias) ([]*UsernameAlias, error)
+	DeleteUsernameAlias(ctx context.Context, delete *DeleteUsernameAlias) error
+
+	// UserAvatar model related methods.
+	UpsertUserAvatar(ctx context.Context, upsert *UserAvatar) error
+	ListUserAvatars(ctx context.Context, find *FindUserAvatar) ([]*UserAvatar, error)
+	DeleteUserAvatar(ctx context.Context, delete *DeleteUserAvatar) error
+
+	// AISummaryCache model related methods.
+	UpsertAISummaryCache(ctx context.Context, upsert *AISummaryCache) error
+	GetAISummaryCache(ctx context.Context, find *FindAISummaryCache) (*AISummaryCache, error)
+	DeleteAISummaryCaches(ctx context.Context, delete *DeleteAISummaryCache) error
+
+	// AIAuditLog model related methods.
+	CreateAIAuditLog(ctx context.Context, create *AIAuditLog) (*AIAuditLog, error)
+	ListAIAuditLogs(ctx context.Context, find *FindAIAuditLog) ([]*AIAuditLog, error)
+
+	// AIIdempotencyKey model related methods.
+	CreateAIIdempotencyKey(ctx context.Context, create *AIIdempotencyKey) (bool, error)
+	GetAIIdempotencyKey(ctx context.Context, find *FindAIIdempotencyKey) (*AIIdempotencyKey, error)
+	UpdateAIIdempotencyKey(ctx context.Context, update *UpdateAIIdempotencyKey) error
+	DeleteAIIdempotencyKeys(ctx context.Context, delete *DeleteAIIdempotencyKey) error
+
+	// Announcement model related methods.
+	CreateAnnouncement(ctx context.Context, create *Announcement) (*Announcement, error)
+	ListAnnouncements(ctx context.Context, find *FindAnnouncement) ([]*Announcement, error)
+	UpdateAnnouncement(ctx context.Context, update *UpdateAnnouncement) error
+	DeleteAnnouncement(ctx context.Context, delete *DeleteAnnouncement) error
+
+	// Syndication model related methods.
+	CreateSyndicationSubscription(ctx context.Context, create *SyndicationSubscription) (*SyndicationSubscription, error)
+	ListSyndicationSubscriptions(ctx context.Context, find *FindSyndicationSubscription) ([]*SyndicationSubscription, error)
+	UpdateSyndicationSubscription(ctx context.Context, update *UpdateSyndicationSubscription) error
+	DeleteSyndicationSubscription(ctx context.Context, delete *DeleteSyndicationSubscription) error
+	UpsertFederatedMemo(ctx context.Context, upsert *FederatedMemo) error
+	ListFederatedMemos(ctx context.Context, find *FindFederatedMemo) ([]*FederatedMemo, error)
+	DeleteFederatedMemos(ctx context.Context, delete *DeleteFederatedMemos) error
+
+	// NostrPublication model related methods.
+	UpsertNostrPublication(ctx context.Context, upsert *NostrPublication) error
+	ListNostrPublications(ctx context.Context, find *FindNostrPublication) ([]*NostrPublication, error)
+	DeleteNostrPublication(ctx context.Context, delete *DeleteNostrPublication) error
+
+	// BlueskyPost model related methods.
+	CreateBlueskyPost(ctx context.Context, create *BlueskyPost) (*BlueskyPost, error)
+	ListBlueskyPosts(ctx context.Context, find *FindBlueskyPost) ([]*BlueskyPost, error)
+	DeleteBlueskyPost(ctx context.Context, delete *DeleteBlueskyPost) error
+
+	// Webmention model related methods.
+	UpsertWebmention(ctx context.Context, upsert *Webmention) error
+	ListWebmentions(ctx context.Context, find *FindWebmention) ([]*Webmention, error)
+	DeleteWebmention(ctx context.Context, delete *DeleteWebmention) error
 }
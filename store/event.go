@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+)
+
+// Event types recorded in the event log.
+const (
+	EventTypeMemoCreated        = "memos.memo.created"
+	EventTypeMemoUpdated        = "memos.memo.updated"
+	EventTypeMemoDeleted        = "memos.memo.deleted"
+	EventTypeMemoCommentCreated = "memos.memo.comment.created"
+	EventTypeReactionCreated    = "memos.reaction.created"
+	EventTypeReactionDeleted    = "memos.reaction.deleted"
+	EventTypeAISummaryGenerated = "memos.ai.summary.generated"
+)
+
+// Event is an append-only record of a domain event.
+// The ID is monotonically increasing and serves as the cursor for consumers.
+type Event struct {
+	ID        int32
+	CreatedTs int64
+
+	// UserID is the owner of the resource affected by the event.
+	UserID int32
+	Type   string
+	// Resource is the name of the affected resource, e.g. memos/{memo}.
+	Resource string
+	// Payload is the JSON snapshot of the affected resource.
+	Payload string
+}
+
+type FindEvent struct {
+	ID     *int32
+	UserID *int32
+	// AfterID filters the events recorded after the given event ID.
+	AfterID *int32
+	Limit   *int
+}
+
+func (s *Store) CreateEvent(ctx context.Context, create *Event) (*Event, error) {
+	return s.driver.CreateEvent(ctx, create)
+}
+
+// ListEvents lists events in ascending order of ID.
+func (s *Store) ListEvents(ctx context.Context, find *FindEvent) ([]*Event, error) {
+	return s.driver.ListEvents(ctx, find)
+}
@@ -50,6 +50,11 @@ type Memo struct {
 	Pinned     bool
 	Payload    *storepb.MemoPayload
 
+	// Counter fields, maintained with comment, reaction and relation changes.
+	CommentCount  int32
+	ReactionCount int32
+	RelationCount int32
+
 	// Composed fields
 	ParentUID *string
 }
@@ -70,6 +75,12 @@ type FindMemo struct {
 	ExcludeContent  bool
 	ExcludeComments bool
 	Filters         []string
+	// UnreadByUserID filters memos that the user hasn't read since their last update.
+	UnreadByUserID *int32
+	// SubscribedByUserID filters memos whose comments the user subscribed to.
+	SubscribedByUserID *int32
+	// ExpiredBefore filters memos with an expiry time not later than the timestamp.
+	ExpiredBefore *int64
 
 	// Pagination
 	Limit  *int
@@ -79,6 +90,22 @@ type FindMemo struct {
 	OrderByPinned    bool
 	OrderByUpdatedTs bool
 	OrderByTimeAsc   bool
+	// OrderBySearchScore orders the memos by their search score, the highest first, before their time.
+	OrderBySearchScore *MemoSearchScore
+}
+
+// MemoSearchScore scores the memos of a search by their relevance. Each driver computes the score in SQL:
+// 1, plus the number of occurrences of each term in the content, plus the boosts of the tags of the memo,
+// divided by 1 plus the age of the memo in half-lives.
+type MemoSearchScore struct {
+	// Terms are the words and phrases searched, matched case-insensitively.
+	Terms []string
+	// TagBoosts are added to the score of the memos with the tag, negative ones lower it.
+	TagBoosts map[string]float64
+	// RecencyHalfLife is the age in seconds that halves the score of a memo, the age is ignored when 0.
+	RecencyHalfLife int64
+	// NowTs is the time the age of the memos is measured from.
+	NowTs int64
 }
 
 type FindMemoPayload struct {
@@ -102,6 +129,14 @@ type UpdateMemo struct {
 	Payload    *storepb.MemoPayload
 }
 
+// MemoAssociations are the relations and attachments written together with a new memo.
+type MemoAssociations struct {
+	// Relations of the memo, their MemoID is set to the ID of the created memo.
+	Relations []*MemoRelation
+	// AttachmentIDs are the attachments linked to the memo, in display order.
+	AttachmentIDs []int32
+}
+
 type DeleteMemo struct {
 	ID int32
 }
@@ -113,6 +148,17 @@ func (s *Store) CreateMemo(ctx context.Context, create *Memo) (*Memo, error) {
 	return s.driver.CreateMemo(ctx, create)
 }
 
+// CreateMemoWithAssociations creates the memo, its relations and links its attachments in a single transaction.
+func (s *Store) CreateMemoWithAssociations(ctx context.Context, create *Memo, associations *MemoAssociations) (*Memo, error) {
+	if !base.UIDMatcher.MatchString(create.UID) {
+		return nil, errors.New("invalid uid")
+	}
+	if associations == nil {
+		associations = &MemoAssociations{}
+	}
+	return s.driver.CreateMemoWithAssociations(ctx, create, associations)
+}
+
 func (s *Store) ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error) {
 	return s.driver.ListMemos(ctx, find)
 }
@@ -140,3 +186,8 @@ func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
 func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
 	return s.driver.DeleteMemo(ctx, delete)
 }
+
+// PrepareFuzzySearch prepares the database for the fuzzy search of the memos, failing if it does not support it.
+func (s *Store) PrepareFuzzySearch(ctx context.Context) error {
+	return s.driver.PrepareFuzzySearch(ctx)
+}
@@ -0,0 +1,76 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/base"
+)
+
+// BatchInsertSize is the maximum number of rows inserted by a single multi-row INSERT statement.
+const BatchInsertSize = 500
+
+// BatchCreateMemos describes memos created in one transaction, typically by an import.
+type BatchCreateMemos struct {
+	// Memos are inserted with their timestamps, row status and pinned flag as given.
+	// A zero CreatedTs or UpdatedTs defaults to now, an empty RowStatus to Normal.
+	Memos []*Memo
+	// Relations between memos of the batch, inserted in the same transaction.
+	Relations []*BatchMemoRelation
+}
+
+// BatchMemoRelation references the memos of a batch by their index in BatchCreateMemos.Memos.
+type BatchMemoRelation struct {
+	MemoIndex        int
+	RelatedMemoIndex int
+	Type             MemoRelationType
+}
+
+// BatchCreateMemos inserts the memos and their relations with multi-row INSERT statements.
+// The memos are returned in the same order with their IDs set.
+func (s *Store) BatchCreateMemos(ctx context.Context, create *BatchCreateMemos) ([]*Memo, error) {
+	if len(create.Memos) == 0 {
+		return []*Memo{}, nil
+	}
+	now := time.Now().Unix()
+	uids := make(map[string]bool, len(create.Memos))
+	for _, memo := range create.Memos {
+		if !base.UIDMatcher.MatchString(memo.UID) {
+			return nil, errors.Errorf("invalid uid %q", memo.UID)
+		}
+		if uids[memo.UID] {
+			return nil, errors.Errorf("duplicate memo uid %q in batch", memo.UID)
+		}
+		uids[memo.UID] = true
+		if memo.CreatedTs == 0 {
+			memo.CreatedTs = now
+		}
+		if memo.UpdatedTs == 0 {
+			memo.UpdatedTs = memo.CreatedTs
+		}
+		if memo.RowStatus == "" {
+			memo.RowStatus = Normal
+		}
+	}
+
+	// Drop duplicated relations, the memo_relation table only keeps unique ones.
+	relations := []*BatchMemoRelation{}
+	seen := map[BatchMemoRelation]bool{}
+	for _, relation := range create.Relations {
+		if relation.MemoIndex < 0 || relation.MemoIndex >= len(create.Memos) || relation.RelatedMemoIndex < 0 || relation.RelatedMemoIndex >= len(create.Memos) {
+			return nil, errors.Errorf("memo relation references memo outside of the batch")
+		}
+		if seen[*relation] {
+			continue
+		}
+		seen[*relation] = true
+		relations = append(relations, relation)
+	}
+
+	return s.driver.BatchCreateMemos(ctx, &BatchCreateMemos{
+		Memos:     create.Memos,
+		Relations: relations,
+	})
+}
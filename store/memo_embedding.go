@@ -0,0 +1,135 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// MemoEmbedding is the vector embedding of the content of a memo, used for semantic search.
+type MemoEmbedding struct {
+	MemoID int32
+	// Model is the embedding model the vector was computed with, vectors of different models are not comparable.
+	Model string
+	// ContentHash is the hash of the embedded text, the memo is embedded again when it changes.
+	ContentHash string
+	Embedding   []float32
+	UpdatedTs   int64
+}
+
+type FindMemoEmbedding struct {
+	MemoIDList []int32
+	Model      *string
+}
+
+// SearchMemoEmbedding finds the normal memos whose embedding is the closest to the given one.
+type SearchMemoEmbedding struct {
+	Model     string
+	Embedding []float32
+	// ViewerID is the user whose memos are searched whatever their visibility.
+	ViewerID *int32
+	// VisibilityList is the visibilities of the memos of other users that are searched.
+	VisibilityList []Visibility
+	// RowStatusList is the states of the memos searched, only the normal memos when empty. The archived memos are
+	// only searched among the memos of the viewer.
+	RowStatusList []RowStatus
+	Limit         int
+}
+
+// GetSearchedRowStatuses returns the states of the memos a search covers, the normal memos by default.
+func GetSearchedRowStatuses(rowStatusList []RowStatus) []RowStatus {
+	if len(rowStatusList) == 0 {
+		return []RowStatus{Normal}
+	}
+	return rowStatusList
+}
+
+// MemoEmbeddingMatch is a memo found by a search, with the cosine similarity of its embedding.
+type MemoEmbeddingMatch struct {
+	MemoID int32
+	Score  float32
+}
+
+type DeleteMemoEmbedding struct {
+	MemoID *int32
+	// CreatorID deletes the embeddings of all the memos of the user.
+	CreatorID *int32
+}
+
+// UpsertMemoEmbedding creates the embedding of the memo or replaces it.
+func (s *Store) UpsertMemoEmbedding(ctx context.Context, upsert *MemoEmbedding) error {
+	return s.driver.UpsertMemoEmbedding(ctx, upsert)
+}
+
+func (s *Store) ListMemoEmbeddings(ctx context.Context, find *FindMemoEmbedding) ([]*MemoEmbedding, error) {
+	return s.driver.ListMemoEmbeddings(ctx, find)
+}
+
+// SearchMemoEmbeddings returns the closest memos first.
+func (s *Store) SearchMemoEmbeddings(ctx context.Context, search *SearchMemoEmbedding) ([]*MemoEmbeddingMatch, error) {
+	return s.driver.SearchMemoEmbeddings(ctx, search)
+}
+
+func (s *Store) DeleteMemoEmbedding(ctx context.Context, delete *DeleteMemoEmbedding) error {
+	return s.driver.DeleteMemoEmbedding(ctx, delete)
+}
+
+// EncodeEmbedding encodes the vector as little-endian float32 values, for the drivers storing it as a blob.
+func EncodeEmbedding(embedding []float32) []byte {
+	data := make([]byte, 4*len(embedding))
+	for i, value := range embedding {
+		binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(value))
+	}
+	return data
+}
+
+// DecodeEmbedding decodes a vector encoded by EncodeEmbedding.
+func DecodeEmbedding(data []byte) ([]float32, error) {
+	if len(data)%4 != 0 {
+		return nil, errors.Errorf("invalid embedding length %d", len(data))
+	}
+	embedding := make([]float32, len(data)/4)
+	for i := range embedding {
+		embedding[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+	}
+	return embedding, nil
+}
+
+// CosineSimilarity returns the cosine similarity of the vectors, 0 if their dimensions differ or one is zero.
+func CosineSimilarity(a, b []float32) float32 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return float32(dot / (math.Sqrt(normA) * math.Sqrt(normB)))
+}
+
+// RankMemoEmbeddings returns the limit embeddings the most similar to the vector, closest first.
+// It is the search of the drivers without vector support in the database.
+func RankMemoEmbeddings(embeddings []*MemoEmbedding, embedding []float32, limit int) []*MemoEmbeddingMatch {
+	matches := make([]*MemoEmbeddingMatch, 0, len(embeddings))
+	for _, memoEmbedding := range embeddings {
+		matches = append(matches, &MemoEmbeddingMatch{
+			MemoID: memoEmbedding.MemoID,
+			Score:  CosineSimilarity(memoEmbedding.Embedding, embedding),
+		})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
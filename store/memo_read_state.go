@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoReadState is the read state of a memo for a user.
+type MemoReadState struct {
+	UserID int32
+	MemoID int32
+	// ReadTs is the time the user last read the memo.
+	ReadTs int64
+	// Position is the last reading position of the user in the memo content.
+	Position int32
+}
+
+type FindMemoReadState struct {
+	UserID     *int32
+	MemoID     *int32
+	MemoIDList []int32
+}
+
+type DeleteMemoReadState struct {
+	UserID *int32
+	MemoID *int32
+}
+
+func (s *Store) UpsertMemoReadState(ctx context.Context, upsert *MemoReadState) (*MemoReadState, error) {
+	return s.driver.UpsertMemoReadState(ctx, upsert)
+}
+
+func (s *Store) ListMemoReadStates(ctx context.Context, find *FindMemoReadState) ([]*MemoReadState, error) {
+	return s.driver.ListMemoReadStates(ctx, find)
+}
+
+func (s *Store) GetMemoReadState(ctx context.Context, find *FindMemoReadState) (*MemoReadState, error) {
+	list, err := s.ListMemoReadStates(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoReadState(ctx context.Context, delete *DeleteMemoReadState) error {
+	return s.driver.DeleteMemoReadState(ctx, delete)
+}
@@ -11,8 +11,24 @@ const (
 	MemoRelationReference MemoRelationType = "REFERENCE"
 	// MemoRelationComment is the type for a comment memo relation.
 	MemoRelationComment MemoRelationType = "COMMENT"
+	// MemoRelationTranslationOf is the type for a relation from a translation to its original memo.
+	MemoRelationTranslationOf MemoRelationType = "TRANSLATION_OF"
+	// MemoRelationSummaryOf is the type for a relation from an AI summary to one of its source memos.
+	MemoRelationSummaryOf MemoRelationType = "SUMMARY_OF"
+	// MemoRelationReplyTo is the type for a relation from a reply to the memo it answers.
+	MemoRelationReplyTo MemoRelationType = "REPLY_TO"
+	// MemoRelationDuplicateOf is the type for a relation from a duplicate to the memo it duplicates.
+	MemoRelationDuplicateOf MemoRelationType = "DUPLICATE_OF"
+	// MemoRelationBlockedBy is the type for a relation from a task to the memo blocking it.
+	MemoRelationBlockedBy MemoRelationType = "BLOCKED_BY"
+	// MemoRelationRevisionOf is the type for a relation from a revision to the memo it revises.
+	MemoRelationRevisionOf MemoRelationType = "REVISION_OF"
 )
 
+// MemoRelationSourceTypes are the types of the relations from an AI summary to its source memos. The summaries
+// created before SUMMARY_OF reference their source memos.
+var MemoRelationSourceTypes = []MemoRelationType{MemoRelationSummaryOf, MemoRelationReference}
+
 type MemoRelation struct {
 	MemoID        int32
 	RelatedMemoID int32
@@ -22,14 +38,19 @@ type MemoRelation struct {
 type FindMemoRelation struct {
 	MemoID        *int32
 	RelatedMemoID *int32
-	Type          *MemoRelationType
-	MemoFilter    *string
+	// MemoIDList and RelatedMemoIDList batch the lookups of the relations of many memos in one query.
+	MemoIDList        []int32
+	RelatedMemoIDList []int32
+	Type              *MemoRelationType
+	TypeList          []MemoRelationType
+	MemoFilter        *string
 }
 
 type DeleteMemoRelation struct {
 	MemoID        *int32
 	RelatedMemoID *int32
 	Type          *MemoRelationType
+	TypeList      []MemoRelationType
 }
 
 func (s *Store) UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error) {
@@ -0,0 +1,56 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// MemoSlug maps a slug of a user to a memo. The slugs a memo was renamed from keep pointing to it, so that the
+// URLs with them still resolve, until another memo of the user takes them.
+type MemoSlug struct {
+	CreatorID int32
+	Slug      string
+	MemoID    int32
+	CreatedTs int64
+}
+
+type FindMemoSlug struct {
+	CreatorID *int32
+	Slug      *string
+	MemoID    *int32
+}
+
+type DeleteMemoSlug struct {
+	MemoID int32
+}
+
+// UpsertMemoSlug points the slug of the creator to the memo, taking it from the memo it pointed to if any.
+func (s *Store) UpsertMemoSlug(ctx context.Context, upsert *MemoSlug) (*MemoSlug, error) {
+	if upsert.CreatedTs == 0 {
+		upsert.CreatedTs = time.Now().Unix()
+	}
+	if err := s.driver.UpsertMemoSlug(ctx, upsert); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+// ListMemoSlugs lists the slugs, the most recent first.
+func (s *Store) ListMemoSlugs(ctx context.Context, find *FindMemoSlug) ([]*MemoSlug, error) {
+	return s.driver.ListMemoSlugs(ctx, find)
+}
+
+func (s *Store) GetMemoSlug(ctx context.Context, find *FindMemoSlug) (*MemoSlug, error) {
+	list, err := s.ListMemoSlugs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoSlug(ctx context.Context, delete *DeleteMemoSlug) error {
+	return s.driver.DeleteMemoSlug(ctx, delete)
+}
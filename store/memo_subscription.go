@@ -0,0 +1,52 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// MemoSubscription is the subscription of a user to the comments of a memo.
+type MemoSubscription struct {
+	UserID int32
+	MemoID int32
+	// Subscribed is false when the user unsubscribed, which also prevents automatic subscriptions.
+	Subscribed bool
+	UpdatedTs  int64
+}
+
+type FindMemoSubscription struct {
+	UserID     *int32
+	MemoID     *int32
+	Subscribed *bool
+}
+
+type DeleteMemoSubscription struct {
+	UserID *int32
+	MemoID *int32
+}
+
+func (s *Store) UpsertMemoSubscription(ctx context.Context, upsert *MemoSubscription) (*MemoSubscription, error) {
+	if upsert.UpdatedTs == 0 {
+		upsert.UpdatedTs = time.Now().Unix()
+	}
+	return s.driver.UpsertMemoSubscription(ctx, upsert)
+}
+
+func (s *Store) ListMemoSubscriptions(ctx context.Context, find *FindMemoSubscription) ([]*MemoSubscription, error) {
+	return s.driver.ListMemoSubscriptions(ctx, find)
+}
+
+func (s *Store) GetMemoSubscription(ctx context.Context, find *FindMemoSubscription) (*MemoSubscription, error) {
+	list, err := s.ListMemoSubscriptions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoSubscription(ctx context.Context, delete *DeleteMemoSubscription) error {
+	return s.driver.DeleteMemoSubscription(ctx, delete)
+}
@@ -0,0 +1,25 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoTagCount is the number of memos with a tag. The tags of the memos are indexed in the memo_tag table from their
+// payload, whenever the payload of a memo is written.
+type MemoTagCount struct {
+	Tag   string
+	Count int32
+}
+
+type FindMemoTagCount struct {
+	CreatorID      *int32
+	RowStatus      *RowStatus
+	VisibilityList []Visibility
+	// ExcludeComments only counts the tags of the memos that are not comments.
+	ExcludeComments bool
+}
+
+// ListMemoTagCounts returns the number of the memos found per tag, the most used tag first.
+func (s *Store) ListMemoTagCounts(ctx context.Context, find *FindMemoTagCount) ([]*MemoTagCount, error) {
+	return s.driver.ListMemoTagCounts(ctx, find)
+}
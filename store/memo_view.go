@@ -0,0 +1,53 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoView is a view of a memo by a visitor on a day, a visitor being counted once per day.
+type MemoView struct {
+	MemoID int32
+	// Day is the day of the view in UTC, e.g. "2025-06-01".
+	Day string
+	// ViewerHash identifies the visitor on the day without storing their IP address or user agent.
+	ViewerHash string
+}
+
+type FindMemoView struct {
+	MemoID int32
+	// DayAfter only counts the views from that day, inclusive.
+	DayAfter *string
+}
+
+// MemoViewCount is the number of views of a memo on a day.
+type MemoViewCount struct {
+	Day   string
+	Count int32
+}
+
+type DeleteMemoView struct {
+	MemoID int32
+}
+
+// memoViewBatchSize is the maximum number of views inserted by a statement, below the limits of bound parameters of
+// the databases.
+const memoViewBatchSize = 500
+
+// CreateMemoViews records the views, views already recorded for the visitor on the day are ignored.
+func (s *Store) CreateMemoViews(ctx context.Context, creates []*MemoView) error {
+	for start := 0; start < len(creates); start += memoViewBatchSize {
+		if err := s.driver.CreateMemoViews(ctx, creates[start:min(start+memoViewBatchSize, len(creates))]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListMemoViewCounts returns the number of views of the memo per day, oldest day first.
+func (s *Store) ListMemoViewCounts(ctx context.Context, find *FindMemoView) ([]*MemoViewCount, error) {
+	return s.driver.ListMemoViewCounts(ctx, find)
+}
+
+func (s *Store) DeleteMemoViews(ctx context.Context, delete *DeleteMemoView) error {
+	return s.driver.DeleteMemoViews(ctx, delete)
+}
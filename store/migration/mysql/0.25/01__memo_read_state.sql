@@ -0,0 +1,7 @@
+CREATE TABLE `memo_read_state` (
+  `user_id` INT NOT NULL,
+  `memo_id` INT NOT NULL,
+  `read_ts` BIGINT NOT NULL,
+  `position` INT NOT NULL DEFAULT 0,
+  UNIQUE(`user_id`,`memo_id`)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE `event` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `user_id` INT NOT NULL,
+  `type` VARCHAR(256) NOT NULL,
+  `resource` VARCHAR(256) NOT NULL DEFAULT '',
+  `payload` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_event_user_id` ON `event` (`user_id`);
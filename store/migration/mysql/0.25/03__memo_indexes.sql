@@ -0,0 +1,3 @@
+CREATE INDEX `idx_memo_creator_status_created` ON `memo` (`creator_id`, `row_status`, `created_ts`);
+
+CREATE INDEX `idx_memo_status_visibility_created` ON `memo` (`row_status`, `visibility`, `created_ts`);
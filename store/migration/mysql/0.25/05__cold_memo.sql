@@ -0,0 +1,18 @@
+CREATE TABLE `cold_memo` (
+  `id` INT NOT NULL PRIMARY KEY,
+  `uid` VARCHAR(256) NOT NULL UNIQUE,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `row_status` VARCHAR(256) NOT NULL,
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL,
+  `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
+  `payload` JSON NOT NULL,
+  `comment_count` INT NOT NULL DEFAULT 0,
+  `reaction_count` INT NOT NULL DEFAULT 0,
+  `relation_count` INT NOT NULL DEFAULT 0,
+  `moved_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `idx_cold_memo_creator_id` ON `cold_memo` (`creator_id`);
@@ -0,0 +1,12 @@
+CREATE TABLE `dead_letter` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `job_type` VARCHAR(256) NOT NULL,
+  `user_id` INT NOT NULL,
+  `payload` JSON NOT NULL,
+  `error` TEXT NOT NULL,
+  `attempts` INT NOT NULL DEFAULT 1
+);
+
+CREATE INDEX `idx_dead_letter_job_type` ON `dead_letter` (`job_type`);
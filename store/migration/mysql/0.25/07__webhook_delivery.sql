@@ -0,0 +1,11 @@
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `user_id` INT NOT NULL,
+  `webhook_id` VARCHAR(256) NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL,
+  `status_code` INT NOT NULL DEFAULT 0,
+  `error` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_webhook_delivery_user_id_webhook_id` ON `webhook_delivery` (`user_id`, `webhook_id`);
@@ -0,0 +1,6 @@
+CREATE TABLE `memo_view` (
+  `memo_id` INT NOT NULL,
+  `day` VARCHAR(10) NOT NULL,
+  `viewer_hash` VARCHAR(64) NOT NULL,
+  UNIQUE(`memo_id`,`day`,`viewer_hash`)
+);
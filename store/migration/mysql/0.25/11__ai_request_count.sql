@@ -0,0 +1,8 @@
+CREATE TABLE `ai_request_count` (
+  `user_id` INT NOT NULL,
+  `hour_ts` BIGINT NOT NULL,
+  `count` INT NOT NULL DEFAULT 0,
+  UNIQUE(`user_id`,`hour_ts`)
+);
+
+DELETE FROM `system_setting` WHERE `name` = 'AI_RATE_LIMIT';
@@ -0,0 +1,16 @@
+CREATE TABLE `ai_usage` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` BIGINT NOT NULL,
+  `user_id` INT NOT NULL,
+  `operation` VARCHAR(256) NOT NULL,
+  `model` VARCHAR(256) NOT NULL DEFAULT '',
+  `prompt_tokens` BIGINT NOT NULL DEFAULT 0,
+  `completion_tokens` BIGINT NOT NULL DEFAULT 0,
+  `latency_ms` BIGINT NOT NULL DEFAULT 0,
+  `success` BOOLEAN NOT NULL DEFAULT TRUE,
+  `error` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_ai_usage_created_ts` ON `ai_usage` (`created_ts`);
+
+CREATE INDEX `idx_ai_usage_user_id_created_ts` ON `ai_usage` (`user_id`, `created_ts`);
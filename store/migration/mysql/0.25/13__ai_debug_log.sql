@@ -0,0 +1,12 @@
+CREATE TABLE `ai_debug_log` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` BIGINT NOT NULL,
+  `user_id` INT NOT NULL,
+  `operation` VARCHAR(256) NOT NULL,
+  `model` VARCHAR(256) NOT NULL DEFAULT '',
+  `success` BOOLEAN NOT NULL DEFAULT TRUE,
+  `prompt` LONGBLOB NOT NULL,
+  `response` LONGBLOB NOT NULL
+);
+
+CREATE INDEX `idx_ai_debug_log_created_ts` ON `ai_debug_log` (`created_ts`);
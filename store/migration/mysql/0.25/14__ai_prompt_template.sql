@@ -0,0 +1,8 @@
+CREATE TABLE `ai_prompt_template` (
+  `creator_id` INT NOT NULL,
+  `name` VARCHAR(256) NOT NULL,
+  `description` TEXT NOT NULL,
+  `content` TEXT NOT NULL,
+  `updated_ts` BIGINT NOT NULL,
+  PRIMARY KEY (`creator_id`, `name`)
+);
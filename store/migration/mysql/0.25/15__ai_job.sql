@@ -0,0 +1,15 @@
+CREATE TABLE `ai_job` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` BIGINT NOT NULL,
+  `updated_ts` BIGINT NOT NULL,
+  `job_type` VARCHAR(256) NOT NULL,
+  `user_id` INT NOT NULL,
+  `status` VARCHAR(256) NOT NULL,
+  `payload` JSON NOT NULL,
+  `memo_uid` VARCHAR(256) NOT NULL DEFAULT '',
+  `error` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_ai_job_user_id` ON `ai_job` (`user_id`);
+
+CREATE INDEX `idx_ai_job_status` ON `ai_job` (`status`);
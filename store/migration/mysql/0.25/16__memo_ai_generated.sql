@@ -0,0 +1,3 @@
+UPDATE `memo`
+SET `payload` = JSON_SET(`payload`, '$.property', JSON_MERGE_PATCH(COALESCE(JSON_EXTRACT(`payload`, '$.property'), JSON_OBJECT()), JSON_OBJECT('isAiGenerated', TRUE)))
+WHERE `content` LIKE '<!-- AI Generated Summary -->%';
@@ -0,0 +1,12 @@
+CREATE TABLE `user_avatar` (
+  `user_id` INT NOT NULL,
+  `size` INT NOT NULL,
+  `type` VARCHAR(256) NOT NULL DEFAULT '',
+  `etag` VARCHAR(256) NOT NULL DEFAULT '',
+  `storage_type` VARCHAR(256) NOT NULL DEFAULT '',
+  `reference` TEXT NOT NULL,
+  `blob` MEDIUMBLOB,
+  `payload` TEXT NOT NULL,
+  `updated_ts` BIGINT NOT NULL,
+  PRIMARY KEY (`user_id`, `size`)
+);
@@ -0,0 +1,7 @@
+CREATE TABLE `ai_summary_cache` (
+  `user_id` INT NOT NULL,
+  `cache_key` VARCHAR(64) NOT NULL,
+  `memo_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL,
+  PRIMARY KEY (`user_id`, `cache_key`)
+);
@@ -0,0 +1,11 @@
+CREATE TABLE `ai_audit_log` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` BIGINT NOT NULL,
+  `user_id` INT NOT NULL,
+  `action` VARCHAR(256) NOT NULL,
+  `success` BOOLEAN NOT NULL DEFAULT TRUE,
+  `error` TEXT NOT NULL,
+  `payload` JSON NOT NULL
+);
+
+CREATE INDEX `idx_ai_audit_log_created_ts` ON `ai_audit_log` (`created_ts`);
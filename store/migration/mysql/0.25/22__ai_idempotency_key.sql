@@ -0,0 +1,8 @@
+CREATE TABLE `ai_idempotency_key` (
+  `user_id` INT NOT NULL,
+  `idempotency_key` VARCHAR(255) NOT NULL,
+  `fingerprint` VARCHAR(64) NOT NULL,
+  `memo_id` INT NOT NULL DEFAULT 0,
+  `created_ts` BIGINT NOT NULL,
+  PRIMARY KEY (`user_id`, `idempotency_key`)
+);
@@ -0,0 +1,24 @@
+CREATE TABLE `syndication_subscription` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` BIGINT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `instance_url` VARCHAR(512) NOT NULL,
+  `payload` JSON NOT NULL,
+  `last_sync_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_error` TEXT NOT NULL
+);
+
+CREATE TABLE `federated_memo` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `subscription_id` INT NOT NULL,
+  `instance_url` VARCHAR(512) NOT NULL,
+  `origin_name` VARCHAR(256) NOT NULL,
+  `content` MEDIUMTEXT NOT NULL,
+  `created_ts` BIGINT NOT NULL,
+  `updated_ts` BIGINT NOT NULL,
+  `synced_ts` BIGINT NOT NULL,
+  `payload` JSON NOT NULL,
+  UNIQUE(`instance_url`, `origin_name`)
+);
+
+CREATE INDEX `idx_federated_memo_subscription_id` ON `federated_memo` (`subscription_id`);
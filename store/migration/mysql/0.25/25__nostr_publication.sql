@@ -0,0 +1,8 @@
+CREATE TABLE `nostr_publication` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL UNIQUE,
+  `creator_id` INT NOT NULL,
+  `event_id` VARCHAR(64) NOT NULL,
+  `content_hash` VARCHAR(64) NOT NULL,
+  `updated_ts` BIGINT NOT NULL
+);
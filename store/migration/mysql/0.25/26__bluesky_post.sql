@@ -0,0 +1,7 @@
+CREATE TABLE `bluesky_post` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL UNIQUE,
+  `creator_id` INT NOT NULL,
+  `uri` VARCHAR(512) NOT NULL,
+  `created_ts` BIGINT NOT NULL
+);
@@ -0,0 +1,14 @@
+CREATE TABLE `webmention` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `source` VARCHAR(512) NOT NULL,
+  `type` VARCHAR(32) NOT NULL DEFAULT 'MENTION',
+  `author_name` VARCHAR(256) NOT NULL DEFAULT '',
+  `author_url` VARCHAR(512) NOT NULL DEFAULT '',
+  `author_photo` VARCHAR(512) NOT NULL DEFAULT '',
+  `content` TEXT NOT NULL,
+  `published_ts` BIGINT NOT NULL DEFAULT 0,
+  `created_ts` BIGINT NOT NULL,
+  `updated_ts` BIGINT NOT NULL,
+  UNIQUE(`memo_id`, `source`)
+);
@@ -45,9 +45,16 @@ CREATE TABLE `memo` (
   `content` TEXT NOT NULL,
   `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
   `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
-  `payload` JSON NOT NULL
+  `payload` JSON NOT NULL,
+  `comment_count` INT NOT NULL DEFAULT 0,
+  `reaction_count` INT NOT NULL DEFAULT 0,
+  `relation_count` INT NOT NULL DEFAULT 0
 );
 
+CREATE INDEX `idx_memo_creator_status_created` ON `memo` (`creator_id`, `row_status`, `created_ts`);
+
+CREATE INDEX `idx_memo_status_visibility_created` ON `memo` (`row_status`, `visibility`, `created_ts`);
+
 -- memo_organizer
 CREATE TABLE `memo_organizer` (
   `memo_id` INT NOT NULL,
@@ -64,6 +71,15 @@ CREATE TABLE `memo_relation` (
   UNIQUE(`memo_id`,`related_memo_id`,`type`)
 );
 
+-- memo_read_state
+CREATE TABLE `memo_read_state` (
+  `user_id` INT NOT NULL,
+  `memo_id` INT NOT NULL,
+  `read_ts` BIGINT NOT NULL,
+  `position` INT NOT NULL DEFAULT 0,
+  UNIQUE(`user_id`,`memo_id`)
+);
+
 -- resource
 CREATE TABLE `resource` (
   `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
@@ -91,6 +107,18 @@ CREATE TABLE `activity` (
   `payload` TEXT NOT NULL
 );
 
+-- event
+CREATE TABLE `event` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `user_id` INT NOT NULL,
+  `type` VARCHAR(256) NOT NULL,
+  `resource` VARCHAR(256) NOT NULL DEFAULT '',
+  `payload` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_event_user_id` ON `event` (`user_id`);
+
 -- idp
 CREATE TABLE `idp` (
   `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
@@ -119,3 +147,295 @@ CREATE TABLE `reaction` (
   `reaction_type` VARCHAR(256) NOT NULL,
   UNIQUE(`creator_id`,`content_id`,`reaction_type`)  
 );
+
+-- cold_memo
+CREATE TABLE `cold_memo` (
+  `id` INT NOT NULL PRIMARY KEY,
+  `uid` VARCHAR(256) NOT NULL UNIQUE,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `row_status` VARCHAR(256) NOT NULL,
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL,
+  `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
+  `payload` JSON NOT NULL,
+  `comment_count` INT NOT NULL DEFAULT 0,
+  `reaction_count` INT NOT NULL DEFAULT 0,
+  `relation_count` INT NOT NULL DEFAULT 0,
+  `moved_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `idx_cold_memo_creator_id` ON `cold_memo` (`creator_id`);
+
+-- dead_letter
+CREATE TABLE `dead_letter` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `job_type` VARCHAR(256) NOT NULL,
+  `user_id` INT NOT NULL,
+  `payload` JSON NOT NULL,
+  `error` TEXT NOT NULL,
+  `attempts` INT NOT NULL DEFAULT 1
+);
+
+CREATE INDEX `idx_dead_letter_job_type` ON `dead_letter` (`job_type`);
+
+-- webhook_delivery
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `user_id` INT NOT NULL,
+  `webhook_id` VARCHAR(256) NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL,
+  `status_code` INT NOT NULL DEFAULT 0,
+  `error` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_webhook_delivery_user_id_webhook_id` ON `webhook_delivery` (`user_id`, `webhook_id`);
+
+-- memo_subscription
+CREATE TABLE `memo_subscription` (
+  `user_id` INT NOT NULL,
+  `memo_id` INT NOT NULL,
+  `subscribed` BOOLEAN NOT NULL DEFAULT TRUE,
+  `updated_ts` BIGINT NOT NULL,
+  UNIQUE(`user_id`,`memo_id`)
+);
+
+CREATE INDEX `idx_memo_subscription_memo_id` ON `memo_subscription` (`memo_id`);
+
+-- memo_view
+CREATE TABLE `memo_view` (
+  `memo_id` INT NOT NULL,
+  `day` VARCHAR(10) NOT NULL,
+  `viewer_hash` VARCHAR(64) NOT NULL,
+  UNIQUE(`memo_id`,`day`,`viewer_hash`)
+);
+
+-- memo_embedding
+CREATE TABLE `memo_embedding` (
+  `memo_id` INT NOT NULL PRIMARY KEY,
+  `model` VARCHAR(256) NOT NULL,
+  `content_hash` VARCHAR(64) NOT NULL,
+  `embedding` LONGBLOB NOT NULL,
+  `updated_ts` BIGINT NOT NULL
+);
+
+-- ai_request_count
+CREATE TABLE `ai_request_count` (
+  `user_id` INT NOT NULL,
+  `hour_ts` BIGINT NOT NULL,
+  `count` INT NOT NULL DEFAULT 0,
+  UNIQUE(`user_id`,`hour_ts`)
+);
+
+-- ai_usage
+CREATE TABLE `ai_usage` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` BIGINT NOT NULL,
+  `user_id` INT NOT NULL,
+  `operation` VARCHAR(256) NOT NULL,
+  `model` VARCHAR(256) NOT NULL DEFAULT '',
+  `prompt_tokens` BIGINT NOT NULL DEFAULT 0,
+  `completion_tokens` BIGINT NOT NULL DEFAULT 0,
+  `latency_ms` BIGINT NOT NULL DEFAULT 0,
+  `success` BOOLEAN NOT NULL DEFAULT TRUE,
+  `error` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_ai_usage_created_ts` ON `ai_usage` (`created_ts`);
+
+CREATE INDEX `idx_ai_usage_user_id_created_ts` ON `ai_usage` (`user_id`, `created_ts`);
+
+-- ai_debug_log
+CREATE TABLE `ai_debug_log` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` BIGINT NOT NULL,
+  `user_id` INT NOT NULL,
+  `operation` VARCHAR(256) NOT NULL,
+  `model` VARCHAR(256) NOT NULL DEFAULT '',
+  `success` BOOLEAN NOT NULL DEFAULT TRUE,
+  `prompt` LONGBLOB NOT NULL,
+  `response` LONGBLOB NOT NULL
+);
+
+CREATE INDEX `idx_ai_debug_log_created_ts` ON `ai_debug_log` (`created_ts`);
+
+-- ai_prompt_template
+CREATE TABLE `ai_prompt_template` (
+  `creator_id` INT NOT NULL,
+  `name` VARCHAR(256) NOT NULL,
+  `description` TEXT NOT NULL,
+  `content` TEXT NOT NULL,
+  `updated_ts` BIGINT NOT NULL,
+  PRIMARY KEY (`creator_id`, `name`)
+);
+
+-- ai_job
+CREATE TABLE `ai_job` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` BIGINT NOT NULL,
+  `updated_ts` BIGINT NOT NULL,
+  `job_type` VARCHAR(256) NOT NULL,
+  `user_id` INT NOT NULL,
+  `status` VARCHAR(256) NOT NULL,
+  `payload` JSON NOT NULL,
+  `memo_uid` VARCHAR(256) NOT NULL DEFAULT '',
+  `error` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_ai_job_user_id` ON `ai_job` (`user_id`);
+
+CREATE INDEX `idx_ai_job_status` ON `ai_job` (`status`);
+
+-- memo_slug
+CREATE TABLE `memo_slug` (
+  `creator_id` INT NOT NULL,
+  `slug` VARCHAR(128) NOT NULL,
+  `memo_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL,
+  PRIMARY KEY (`creator_id`, `slug`)
+);
+
+CREATE INDEX `idx_memo_slug_memo_id` ON `memo_slug` (`memo_id`);
+
+-- username_alias
+CREATE TABLE `username_alias` (
+  `username` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `user_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL,
+  `expires_ts` BIGINT NOT NULL
+);
+
+CREATE INDEX `idx_username_alias_user_id` ON `username_alias` (`user_id`);
+
+-- user_avatar
+CREATE TABLE `user_avatar` (
+  `user_id` INT NOT NULL,
+  `size` INT NOT NULL,
+  `type` VARCHAR(256) NOT NULL DEFAULT '',
+  `etag` VARCHAR(256) NOT NULL DEFAULT '',
+  `storage_type` VARCHAR(256) NOT NULL DEFAULT '',
+  `reference` TEXT NOT NULL,
+  `blob` MEDIUMBLOB,
+  `payload` TEXT NOT NULL,
+  `updated_ts` BIGINT NOT NULL,
+  PRIMARY KEY (`user_id`, `size`)
+);
+
+-- ai_summary_cache
+CREATE TABLE `ai_summary_cache` (
+  `user_id` INT NOT NULL,
+  `cache_key` VARCHAR(64) NOT NULL,
+  `memo_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL,
+  PRIMARY KEY (`user_id`, `cache_key`)
+);
+
+-- ai_audit_log
+CREATE TABLE `ai_audit_log` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` BIGINT NOT NULL,
+  `user_id` INT NOT NULL,
+  `action` VARCHAR(256) NOT NULL,
+  `success` BOOLEAN NOT NULL DEFAULT TRUE,
+  `error` TEXT NOT NULL,
+  `payload` JSON NOT NULL
+);
+
+CREATE INDEX `idx_ai_audit_log_created_ts` ON `ai_audit_log` (`created_ts`);
+
+-- ai_idempotency_key
+CREATE TABLE `ai_idempotency_key` (
+  `user_id` INT NOT NULL,
+  `idempotency_key` VARCHAR(255) NOT NULL,
+  `fingerprint` VARCHAR(64) NOT NULL,
+  `memo_id` INT NOT NULL DEFAULT 0,
+  `created_ts` BIGINT NOT NULL,
+  PRIMARY KEY (`user_id`, `idempotency_key`)
+);
+
+-- announcement
+CREATE TABLE `announcement` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` BIGINT NOT NULL,
+  `updated_ts` BIGINT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `content` TEXT NOT NULL,
+  `severity` VARCHAR(256) NOT NULL DEFAULT 'INFO',
+  `start_ts` BIGINT NOT NULL DEFAULT 0,
+  `end_ts` BIGINT NOT NULL DEFAULT 0,
+  `dismissible` BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+-- syndication_subscription, federated_memo
+CREATE TABLE `syndication_subscription` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` BIGINT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `instance_url` VARCHAR(512) NOT NULL,
+  `payload` JSON NOT NULL,
+  `last_sync_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_error` TEXT NOT NULL
+);
+
+CREATE TABLE `federated_memo` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `subscription_id` INT NOT NULL,
+  `instance_url` VARCHAR(512) NOT NULL,
+  `origin_name` VARCHAR(256) NOT NULL,
+  `content` MEDIUMTEXT NOT NULL,
+  `created_ts` BIGINT NOT NULL,
+  `updated_ts` BIGINT NOT NULL,
+  `synced_ts` BIGINT NOT NULL,
+  `payload` JSON NOT NULL,
+  UNIQUE(`instance_url`, `origin_name`)
+);
+
+CREATE INDEX `idx_federated_memo_subscription_id` ON `federated_memo` (`subscription_id`);
+
+-- nostr_publication
+CREATE TABLE `nostr_publication` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL UNIQUE,
+  `creator_id` INT NOT NULL,
+  `event_id` VARCHAR(64) NOT NULL,
+  `content_hash` VARCHAR(64) NOT NULL,
+  `updated_ts` BIGINT NOT NULL
+);
+
+-- bluesky_post
+CREATE TABLE `bluesky_post` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL UNIQUE,
+  `creator_id` INT NOT NULL,
+  `uri` VARCHAR(512) NOT NULL,
+  `created_ts` BIGINT NOT NULL
+);
+
+-- webmention
+CREATE TABLE `webmention` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `source` VARCHAR(512) NOT NULL,
+  `type` VARCHAR(32) NOT NULL DEFAULT 'MENTION',
+  `author_name` VARCHAR(256) NOT NULL DEFAULT '',
+  `author_url` VARCHAR(512) NOT NULL DEFAULT '',
+  `author_photo` VARCHAR(512) NOT NULL DEFAULT '',
+  `content` TEXT NOT NULL,
+  `published_ts` BIGINT NOT NULL DEFAULT 0,
+  `created_ts` BIGINT NOT NULL,
+  `updated_ts` BIGINT NOT NULL,
+  UNIQUE(`memo_id`, `source`)
+);
+
+-- memo_tag
+CREATE TABLE `memo_tag` (
+  `memo_id` INT NOT NULL,
+  `tag` VARCHAR(256) NOT NULL,
+  PRIMARY KEY (`memo_id`, `tag`)
+);
+
+CREATE INDEX `idx_memo_tag_tag` ON `memo_tag` (`tag`, `memo_id`);
@@ -0,0 +1,10 @@
+CREATE TABLE event (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  user_id INTEGER NOT NULL,
+  type TEXT NOT NULL,
+  resource TEXT NOT NULL DEFAULT '',
+  payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_event_user_id ON event (user_id);
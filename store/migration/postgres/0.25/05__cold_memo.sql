@@ -0,0 +1,18 @@
+CREATE TABLE cold_memo (
+  id INTEGER PRIMARY KEY,
+  uid TEXT NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL,
+  updated_ts BIGINT NOT NULL,
+  row_status TEXT NOT NULL,
+  content TEXT NOT NULL,
+  visibility TEXT NOT NULL,
+  pinned BOOLEAN NOT NULL DEFAULT FALSE,
+  payload JSONB NOT NULL DEFAULT '{}',
+  comment_count INTEGER NOT NULL DEFAULT 0,
+  reaction_count INTEGER NOT NULL DEFAULT 0,
+  relation_count INTEGER NOT NULL DEFAULT 0,
+  moved_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX idx_cold_memo_creator_id ON cold_memo (creator_id);
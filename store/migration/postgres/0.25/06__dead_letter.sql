@@ -0,0 +1,12 @@
+CREATE TABLE dead_letter (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  job_type TEXT NOT NULL,
+  user_id INTEGER NOT NULL,
+  payload JSONB NOT NULL DEFAULT '{}',
+  error TEXT NOT NULL DEFAULT '',
+  attempts INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX idx_dead_letter_job_type ON dead_letter (job_type);
@@ -0,0 +1,11 @@
+CREATE TABLE webhook_delivery (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  user_id INTEGER NOT NULL,
+  webhook_id TEXT NOT NULL,
+  activity_type TEXT NOT NULL,
+  status_code INTEGER NOT NULL DEFAULT 0,
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_webhook_delivery_user_id_webhook_id ON webhook_delivery (user_id, webhook_id);
@@ -0,0 +1,9 @@
+CREATE TABLE memo_subscription (
+  user_id INTEGER NOT NULL,
+  memo_id INTEGER NOT NULL,
+  subscribed BOOLEAN NOT NULL DEFAULT TRUE,
+  updated_ts BIGINT NOT NULL,
+  UNIQUE(user_id, memo_id)
+);
+
+CREATE INDEX idx_memo_subscription_memo_id ON memo_subscription (memo_id);
@@ -0,0 +1,8 @@
+-- The embedding is a REAL array, which the pgvector extension casts to a vector when it is installed.
+CREATE TABLE memo_embedding (
+  memo_id INTEGER NOT NULL PRIMARY KEY,
+  model TEXT NOT NULL,
+  content_hash TEXT NOT NULL,
+  embedding REAL[] NOT NULL,
+  updated_ts BIGINT NOT NULL
+);
@@ -0,0 +1,12 @@
+CREATE TABLE ai_debug_log (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL,
+  user_id INTEGER NOT NULL,
+  operation TEXT NOT NULL,
+  model TEXT NOT NULL DEFAULT '',
+  success BOOLEAN NOT NULL DEFAULT TRUE,
+  prompt BYTEA NOT NULL,
+  response BYTEA NOT NULL
+);
+
+CREATE INDEX idx_ai_debug_log_created_ts ON ai_debug_log (created_ts);
@@ -0,0 +1,8 @@
+CREATE TABLE ai_prompt_template (
+  creator_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  content TEXT NOT NULL,
+  updated_ts BIGINT NOT NULL,
+  PRIMARY KEY (creator_id, name)
+);
@@ -0,0 +1,15 @@
+CREATE TABLE ai_job (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL,
+  updated_ts BIGINT NOT NULL,
+  job_type TEXT NOT NULL,
+  user_id INTEGER NOT NULL,
+  status TEXT NOT NULL,
+  payload JSONB NOT NULL DEFAULT '{}',
+  memo_uid TEXT NOT NULL DEFAULT '',
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_ai_job_user_id ON ai_job (user_id);
+
+CREATE INDEX idx_ai_job_status ON ai_job (status);
@@ -0,0 +1,3 @@
+UPDATE memo
+SET payload = jsonb_set(payload, '{property}', COALESCE(payload->'property', '{}'::jsonb) || '{"isAiGenerated": true}'::jsonb)
+WHERE content LIKE '<!-- AI Generated Summary -->%';
@@ -0,0 +1,9 @@
+CREATE TABLE memo_slug (
+  creator_id INTEGER NOT NULL,
+  slug TEXT NOT NULL,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL,
+  PRIMARY KEY (creator_id, slug)
+);
+
+CREATE INDEX idx_memo_slug_memo_id ON memo_slug (memo_id);
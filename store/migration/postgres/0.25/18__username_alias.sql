@@ -0,0 +1,8 @@
+CREATE TABLE username_alias (
+  username TEXT NOT NULL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL,
+  expires_ts BIGINT NOT NULL
+);
+
+CREATE INDEX idx_username_alias_user_id ON username_alias (user_id);
@@ -0,0 +1,12 @@
+CREATE TABLE user_avatar (
+  user_id INTEGER NOT NULL,
+  size INTEGER NOT NULL,
+  type TEXT NOT NULL DEFAULT '',
+  etag TEXT NOT NULL DEFAULT '',
+  storage_type TEXT NOT NULL DEFAULT '',
+  reference TEXT NOT NULL DEFAULT '',
+  blob BYTEA DEFAULT NULL,
+  payload TEXT NOT NULL DEFAULT '{}',
+  updated_ts BIGINT NOT NULL,
+  PRIMARY KEY (user_id, size)
+);
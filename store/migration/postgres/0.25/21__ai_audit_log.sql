@@ -0,0 +1,11 @@
+CREATE TABLE ai_audit_log (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL,
+  user_id INTEGER NOT NULL,
+  action TEXT NOT NULL,
+  success BOOLEAN NOT NULL DEFAULT TRUE,
+  error TEXT NOT NULL DEFAULT '',
+  payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_ai_audit_log_created_ts ON ai_audit_log (created_ts);
@@ -0,0 +1,11 @@
+CREATE TABLE announcement (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL,
+  updated_ts BIGINT NOT NULL,
+  creator_id INTEGER NOT NULL,
+  content TEXT NOT NULL,
+  severity TEXT NOT NULL DEFAULT 'INFO',
+  start_ts BIGINT NOT NULL DEFAULT 0,
+  end_ts BIGINT NOT NULL DEFAULT 0,
+  dismissible BOOLEAN NOT NULL DEFAULT TRUE
+);
@@ -0,0 +1,8 @@
+CREATE TABLE nostr_publication (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  event_id TEXT NOT NULL,
+  content_hash TEXT NOT NULL,
+  updated_ts BIGINT NOT NULL
+);
@@ -0,0 +1,10 @@
+CREATE TABLE memo_tag (
+  memo_id INTEGER NOT NULL,
+  tag TEXT NOT NULL,
+  PRIMARY KEY (memo_id, tag)
+);
+
+CREATE INDEX idx_memo_tag_tag ON memo_tag (tag, memo_id);
+
+INSERT INTO memo_tag (memo_id, tag)
+SELECT DISTINCT memo.id, tags.tag FROM memo, jsonb_array_elements_text(COALESCE(memo.payload->'tags', '[]'::jsonb)) AS tags(tag);
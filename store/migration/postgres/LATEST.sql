@@ -45,9 +45,18 @@ CREATE TABLE memo (
   content TEXT NOT NULL,
   visibility TEXT NOT NULL DEFAULT 'PRIVATE',
   pinned BOOLEAN NOT NULL DEFAULT FALSE,
-  payload JSONB NOT NULL DEFAULT '{}'
+  payload JSONB NOT NULL DEFAULT '{}',
+  comment_count INTEGER NOT NULL DEFAULT 0,
+  reaction_count INTEGER NOT NULL DEFAULT 0,
+  relation_count INTEGER NOT NULL DEFAULT 0
 );
 
+CREATE INDEX idx_memo_creator_status_created ON memo (creator_id, row_status, created_ts);
+
+CREATE INDEX idx_memo_status_visibility_created ON memo (row_status, visibility, created_ts);
+
+CREATE INDEX idx_memo_payload_tags ON memo USING GIN ((payload->'tags'));
+
 -- memo_organizer
 CREATE TABLE memo_organizer (
   memo_id INTEGER NOT NULL,
@@ -64,6 +73,15 @@ CREATE TABLE memo_relation (
   UNIQUE(memo_id, related_memo_id, type)
 );
 
+-- memo_read_state
+CREATE TABLE memo_read_state (
+  user_id INTEGER NOT NULL,
+  memo_id INTEGER NOT NULL,
+  read_ts BIGINT NOT NULL,
+  position INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(user_id, memo_id)
+);
+
 -- resource
 CREATE TABLE resource (
   id SERIAL PRIMARY KEY,
@@ -91,6 +109,18 @@ CREATE TABLE activity (
   payload JSONB NOT NULL DEFAULT '{}'
 );
 
+-- event
+CREATE TABLE event (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  user_id INTEGER NOT NULL,
+  type TEXT NOT NULL,
+  resource TEXT NOT NULL DEFAULT '',
+  payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_event_user_id ON event (user_id);
+
 -- idp
 CREATE TABLE idp (
   id SERIAL PRIMARY KEY,
@@ -119,3 +149,295 @@ CREATE TABLE reaction (
   reaction_type TEXT NOT NULL,
   UNIQUE(creator_id, content_id, reaction_type)
 );
+
+-- cold_memo
+CREATE TABLE cold_memo (
+  id INTEGER PRIMARY KEY,
+  uid TEXT NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL,
+  updated_ts BIGINT NOT NULL,
+  row_status TEXT NOT NULL,
+  content TEXT NOT NULL,
+  visibility TEXT NOT NULL,
+  pinned BOOLEAN NOT NULL DEFAULT FALSE,
+  payload JSONB NOT NULL DEFAULT '{}',
+  comment_count INTEGER NOT NULL DEFAULT 0,
+  reaction_count INTEGER NOT NULL DEFAULT 0,
+  relation_count INTEGER NOT NULL DEFAULT 0,
+  moved_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX idx_cold_memo_creator_id ON cold_memo (creator_id);
+
+-- dead_letter
+CREATE TABLE dead_letter (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  job_type TEXT NOT NULL,
+  user_id INTEGER NOT NULL,
+  payload JSONB NOT NULL DEFAULT '{}',
+  error TEXT NOT NULL DEFAULT '',
+  attempts INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX idx_dead_letter_job_type ON dead_letter (job_type);
+
+-- webhook_delivery
+CREATE TABLE webhook_delivery (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  user_id INTEGER NOT NULL,
+  webhook_id TEXT NOT NULL,
+  activity_type TEXT NOT NULL,
+  status_code INTEGER NOT NULL DEFAULT 0,
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_webhook_delivery_user_id_webhook_id ON webhook_delivery (user_id, webhook_id);
+
+-- memo_subscription
+CREATE TABLE memo_subscription (
+  user_id INTEGER NOT NULL,
+  memo_id INTEGER NOT NULL,
+  subscribed BOOLEAN NOT NULL DEFAULT TRUE,
+  updated_ts BIGINT NOT NULL,
+  UNIQUE(user_id, memo_id)
+);
+
+CREATE INDEX idx_memo_subscription_memo_id ON memo_subscription (memo_id);
+
+-- memo_view
+CREATE TABLE memo_view (
+  memo_id INTEGER NOT NULL,
+  day TEXT NOT NULL,
+  viewer_hash TEXT NOT NULL,
+  UNIQUE(memo_id, day, viewer_hash)
+);
+
+-- memo_embedding
+CREATE TABLE memo_embedding (
+  memo_id INTEGER NOT NULL PRIMARY KEY,
+  model TEXT NOT NULL,
+  content_hash TEXT NOT NULL,
+  embedding REAL[] NOT NULL,
+  updated_ts BIGINT NOT NULL
+);
+
+-- ai_request_count
+CREATE TABLE ai_request_count (
+  user_id INTEGER NOT NULL,
+  hour_ts BIGINT NOT NULL,
+  count INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(user_id, hour_ts)
+);
+
+-- ai_usage
+CREATE TABLE ai_usage (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL,
+  user_id INTEGER NOT NULL,
+  operation TEXT NOT NULL,
+  model TEXT NOT NULL DEFAULT '',
+  prompt_tokens BIGINT NOT NULL DEFAULT 0,
+  completion_tokens BIGINT NOT NULL DEFAULT 0,
+  latency_ms BIGINT NOT NULL DEFAULT 0,
+  success BOOLEAN NOT NULL DEFAULT TRUE,
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_ai_usage_created_ts ON ai_usage (created_ts);
+
+CREATE INDEX idx_ai_usage_user_id_created_ts ON ai_usage (user_id, created_ts);
+
+-- ai_debug_log
+CREATE TABLE ai_debug_log (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL,
+  user_id INTEGER NOT NULL,
+  operation TEXT NOT NULL,
+  model TEXT NOT NULL DEFAULT '',
+  success BOOLEAN NOT NULL DEFAULT TRUE,
+  prompt BYTEA NOT NULL,
+  response BYTEA NOT NULL
+);
+
+CREATE INDEX idx_ai_debug_log_created_ts ON ai_debug_log (created_ts);
+
+-- ai_prompt_template
+CREATE TABLE ai_prompt_template (
+  creator_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  content TEXT NOT NULL,
+  updated_ts BIGINT NOT NULL,
+  PRIMARY KEY (creator_id, name)
+);
+
+-- ai_job
+CREATE TABLE ai_job (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL,
+  updated_ts BIGINT NOT NULL,
+  job_type TEXT NOT NULL,
+  user_id INTEGER NOT NULL,
+  status TEXT NOT NULL,
+  payload JSONB NOT NULL DEFAULT '{}',
+  memo_uid TEXT NOT NULL DEFAULT '',
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_ai_job_user_id ON ai_job (user_id);
+
+CREATE INDEX idx_ai_job_status ON ai_job (status);
+
+-- memo_slug
+CREATE TABLE memo_slug (
+  creator_id INTEGER NOT NULL,
+  slug TEXT NOT NULL,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL,
+  PRIMARY KEY (creator_id, slug)
+);
+
+CREATE INDEX idx_memo_slug_memo_id ON memo_slug (memo_id);
+
+-- username_alias
+CREATE TABLE username_alias (
+  username TEXT NOT NULL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL,
+  expires_ts BIGINT NOT NULL
+);
+
+CREATE INDEX idx_username_alias_user_id ON username_alias (user_id);
+
+-- user_avatar
+CREATE TABLE user_avatar (
+  user_id INTEGER NOT NULL,
+  size INTEGER NOT NULL,
+  type TEXT NOT NULL DEFAULT '',
+  etag TEXT NOT NULL DEFAULT '',
+  storage_type TEXT NOT NULL DEFAULT '',
+  reference TEXT NOT NULL DEFAULT '',
+  blob BYTEA DEFAULT NULL,
+  payload TEXT NOT NULL DEFAULT '{}',
+  updated_ts BIGINT NOT NULL,
+  PRIMARY KEY (user_id, size)
+);
+
+-- ai_summary_cache
+CREATE TABLE ai_summary_cache (
+  user_id INTEGER NOT NULL,
+  cache_key TEXT NOT NULL,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL,
+  PRIMARY KEY (user_id, cache_key)
+);
+
+-- ai_audit_log
+CREATE TABLE ai_audit_log (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL,
+  user_id INTEGER NOT NULL,
+  action TEXT NOT NULL,
+  success BOOLEAN NOT NULL DEFAULT TRUE,
+  error TEXT NOT NULL DEFAULT '',
+  payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_ai_audit_log_created_ts ON ai_audit_log (created_ts);
+
+-- ai_idempotency_key
+CREATE TABLE ai_idempotency_key (
+  user_id INTEGER NOT NULL,
+  idempotency_key TEXT NOT NULL,
+  fingerprint TEXT NOT NULL,
+  memo_id INTEGER NOT NULL DEFAULT 0,
+  created_ts BIGINT NOT NULL,
+  PRIMARY KEY (user_id, idempotency_key)
+);
+
+-- announcement
+CREATE TABLE announcement (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL,
+  updated_ts BIGINT NOT NULL,
+  creator_id INTEGER NOT NULL,
+  content TEXT NOT NULL,
+  severity TEXT NOT NULL DEFAULT 'INFO',
+  start_ts BIGINT NOT NULL DEFAULT 0,
+  end_ts BIGINT NOT NULL DEFAULT 0,
+  dismissible BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+-- syndication_subscription, federated_memo
+CREATE TABLE syndication_subscription (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL,
+  creator_id INTEGER NOT NULL,
+  instance_url TEXT NOT NULL,
+  payload JSONB NOT NULL DEFAULT '{}',
+  last_sync_ts BIGINT NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE federated_memo (
+  id SERIAL PRIMARY KEY,
+  subscription_id INTEGER NOT NULL,
+  instance_url TEXT NOT NULL,
+  origin_name TEXT NOT NULL,
+  content TEXT NOT NULL,
+  created_ts BIGINT NOT NULL,
+  updated_ts BIGINT NOT NULL,
+  synced_ts BIGINT NOT NULL,
+  payload JSONB NOT NULL DEFAULT '{}',
+  UNIQUE(instance_url, origin_name)
+);
+
+CREATE INDEX idx_federated_memo_subscription_id ON federated_memo (subscription_id);
+
+-- nostr_publication
+CREATE TABLE nostr_publication (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  event_id TEXT NOT NULL,
+  content_hash TEXT NOT NULL,
+  updated_ts BIGINT NOT NULL
+);
+
+-- bluesky_post
+CREATE TABLE bluesky_post (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  uri TEXT NOT NULL,
+  created_ts BIGINT NOT NULL
+);
+
+-- webmention
+CREATE TABLE webmention (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  source TEXT NOT NULL,
+  type TEXT NOT NULL DEFAULT 'MENTION',
+  author_name TEXT NOT NULL DEFAULT '',
+  author_url TEXT NOT NULL DEFAULT '',
+  author_photo TEXT NOT NULL DEFAULT '',
+  content TEXT NOT NULL DEFAULT '',
+  published_ts BIGINT NOT NULL DEFAULT 0,
+  created_ts BIGINT NOT NULL,
+  updated_ts BIGINT NOT NULL,
+  UNIQUE(memo_id, source)
+);
+
+-- memo_tag
+CREATE TABLE memo_tag (
+  memo_id INTEGER NOT NULL,
+  tag TEXT NOT NULL,
+  PRIMARY KEY (memo_id, tag)
+);
+
+CREATE INDEX idx_memo_tag_tag ON memo_tag (tag, memo_id);
@@ -0,0 +1,7 @@
+CREATE TABLE memo_read_state (
+  user_id INTEGER NOT NULL,
+  memo_id INTEGER NOT NULL,
+  read_ts BIGINT NOT NULL,
+  position INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(user_id, memo_id)
+);
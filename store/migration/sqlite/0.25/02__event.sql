@@ -0,0 +1,10 @@
+CREATE TABLE event (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  user_id INTEGER NOT NULL,
+  type TEXT NOT NULL,
+  resource TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_event_user_id ON event (user_id);
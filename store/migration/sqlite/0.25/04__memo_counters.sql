@@ -0,0 +1,10 @@
+ALTER TABLE memo ADD COLUMN comment_count INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE memo ADD COLUMN reaction_count INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE memo ADD COLUMN relation_count INTEGER NOT NULL DEFAULT 0;
+
+UPDATE memo SET
+  comment_count = (SELECT COUNT(*) FROM memo_relation WHERE memo_relation.related_memo_id = memo.id AND memo_relation.type = 'COMMENT'),
+  reaction_count = (SELECT COUNT(*) FROM reaction WHERE reaction.content_id = 'memos/' || memo.uid),
+  relation_count = (SELECT COUNT(*) FROM memo_relation WHERE memo_relation.memo_id = memo.id AND memo_relation.type = 'REFERENCE');
@@ -0,0 +1,12 @@
+CREATE TABLE dead_letter (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  job_type TEXT NOT NULL,
+  user_id INTEGER NOT NULL,
+  payload TEXT NOT NULL DEFAULT '{}',
+  error TEXT NOT NULL DEFAULT '',
+  attempts INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX idx_dead_letter_job_type ON dead_letter (job_type);
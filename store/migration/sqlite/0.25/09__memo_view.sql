@@ -0,0 +1,6 @@
+CREATE TABLE memo_view (
+  memo_id INTEGER NOT NULL,
+  day TEXT NOT NULL,
+  viewer_hash TEXT NOT NULL,
+  UNIQUE(memo_id, day, viewer_hash)
+);
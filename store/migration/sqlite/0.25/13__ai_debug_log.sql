@@ -0,0 +1,12 @@
+CREATE TABLE ai_debug_log (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL,
+  user_id INTEGER NOT NULL,
+  operation TEXT NOT NULL,
+  model TEXT NOT NULL DEFAULT '',
+  success INTEGER NOT NULL CHECK (success IN (0, 1)) DEFAULT 1,
+  prompt BLOB NOT NULL,
+  response BLOB NOT NULL
+);
+
+CREATE INDEX idx_ai_debug_log_created_ts ON ai_debug_log (created_ts);
@@ -0,0 +1,3 @@
+UPDATE memo
+SET payload = JSON_SET(payload, '$.property', JSON_PATCH(COALESCE(JSON_EXTRACT(payload, '$.property'), '{}'), '{"isAiGenerated":true}'))
+WHERE content LIKE '<!-- AI Generated Summary -->%';
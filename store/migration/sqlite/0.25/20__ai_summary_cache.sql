@@ -0,0 +1,7 @@
+CREATE TABLE ai_summary_cache (
+  user_id INTEGER NOT NULL,
+  cache_key TEXT NOT NULL,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL,
+  PRIMARY KEY (user_id, cache_key)
+);
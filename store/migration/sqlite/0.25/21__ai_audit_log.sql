@@ -0,0 +1,11 @@
+CREATE TABLE ai_audit_log (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL,
+  user_id INTEGER NOT NULL,
+  action TEXT NOT NULL,
+  success INTEGER NOT NULL CHECK (success IN (0, 1)) DEFAULT 1,
+  error TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_ai_audit_log_created_ts ON ai_audit_log (created_ts);
@@ -0,0 +1,8 @@
+CREATE TABLE ai_idempotency_key (
+  user_id INTEGER NOT NULL,
+  idempotency_key TEXT NOT NULL,
+  fingerprint TEXT NOT NULL,
+  memo_id INTEGER NOT NULL DEFAULT 0,
+  created_ts BIGINT NOT NULL,
+  PRIMARY KEY (user_id, idempotency_key)
+);
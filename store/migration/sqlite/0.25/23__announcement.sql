@@ -0,0 +1,11 @@
+CREATE TABLE announcement (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL,
+  updated_ts BIGINT NOT NULL,
+  creator_id INTEGER NOT NULL,
+  content TEXT NOT NULL,
+  severity TEXT NOT NULL DEFAULT 'INFO',
+  start_ts BIGINT NOT NULL DEFAULT 0,
+  end_ts BIGINT NOT NULL DEFAULT 0,
+  dismissible INTEGER NOT NULL CHECK (dismissible IN (0, 1)) DEFAULT 1
+);
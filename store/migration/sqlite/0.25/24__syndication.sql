@@ -0,0 +1,24 @@
+CREATE TABLE syndication_subscription (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL,
+  creator_id INTEGER NOT NULL,
+  instance_url TEXT NOT NULL,
+  payload TEXT NOT NULL DEFAULT '{}',
+  last_sync_ts BIGINT NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE federated_memo (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  subscription_id INTEGER NOT NULL,
+  instance_url TEXT NOT NULL,
+  origin_name TEXT NOT NULL,
+  content TEXT NOT NULL,
+  created_ts BIGINT NOT NULL,
+  updated_ts BIGINT NOT NULL,
+  synced_ts BIGINT NOT NULL,
+  payload TEXT NOT NULL DEFAULT '{}',
+  UNIQUE(instance_url, origin_name)
+);
+
+CREATE INDEX idx_federated_memo_subscription_id ON federated_memo (subscription_id);
@@ -0,0 +1,7 @@
+CREATE TABLE bluesky_post (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  uri TEXT NOT NULL,
+  created_ts BIGINT NOT NULL
+);
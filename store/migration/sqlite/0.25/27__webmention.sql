@@ -0,0 +1,14 @@
+CREATE TABLE webmention (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  source TEXT NOT NULL,
+  type TEXT NOT NULL DEFAULT 'MENTION',
+  author_name TEXT NOT NULL DEFAULT '',
+  author_url TEXT NOT NULL DEFAULT '',
+  author_photo TEXT NOT NULL DEFAULT '',
+  content TEXT NOT NULL DEFAULT '',
+  published_ts BIGINT NOT NULL DEFAULT 0,
+  created_ts BIGINT NOT NULL,
+  updated_ts BIGINT NOT NULL,
+  UNIQUE(memo_id, source)
+);
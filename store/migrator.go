@@ -273,11 +273,11 @@ func (s *Store) preMigrate(ctx context.Context) error {
 }
 
 func (s *Store) getMigrationBasePath() string {
-	return fmt.Sprintf("migration/%s/", s.profile.Driver)
+	return fmt.Sprintf("migration/%s/", s.profile.Dialect())
 }
 
 func (s *Store) getSeedBasePath() string {
-	return fmt.Sprintf("seed/%s/", s.profile.Driver)
+	return fmt.Sprintf("seed/%s/", s.profile.Dialect())
 }
 
 // seed seeds the database with initial data.
@@ -285,7 +285,7 @@ func (s *Store) getSeedBasePath() string {
 // This is only supported for SQLite databases and is used in demo mode.
 func (s *Store) seed(ctx context.Context) error {
 	// Only seed for SQLite - other databases should use production data
-	if s.profile.Driver != "sqlite" {
+	if s.profile.Dialect() != "sqlite" {
 		slog.Warn("seed is only supported for SQLite, skipping for other databases")
 		return nil
 	}
@@ -316,6 +316,32 @@ func (s *Store) seed(ctx context.Context) error {
 	return tx.Commit()
 }
 
+// ResetDemoData wipes all data and re-applies the demo seed data.
+// The workspace basic setting is kept so that the secret key and schema version survive the reset.
+func (s *Store) ResetDemoData(ctx context.Context) error {
+	if s.profile.Mode != modeDemo {
+		return errors.New("reset is only supported in demo mode")
+	}
+
+	workspaceBasicSetting, err := s.GetWorkspaceBasicSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace basic setting")
+	}
+	if err := s.seed(ctx); err != nil {
+		return errors.Wrap(err, "failed to seed")
+	}
+	s.workspaceSettingCache.Clear(ctx)
+	s.userCache.Clear(ctx)
+	s.userSettingCache.Clear(ctx)
+	if _, err := s.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_BASIC,
+		Value: &storepb.WorkspaceSetting_BasicSetting{BasicSetting: workspaceBasicSetting},
+	}); err != nil {
+		return errors.Wrap(err, "failed to restore workspace basic setting")
+	}
+	return nil
+}
+
 func (s *Store) GetCurrentSchemaVersion() (string, error) {
 	currentVersion := version.GetCurrentVersion(s.profile.Mode)
 	minorVersion := version.GetMinorVersion(currentVersion)
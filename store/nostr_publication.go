@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// NostrPublication is the Nostr note a memo was last published as, to replace it when the memo is edited and to
+// delete it when the memo is deleted.
+type NostrPublication struct {
+	ID        int32
+	MemoID    int32
+	CreatorID int32
+	// EventID is the hex-encoded ID of the note.
+	EventID string
+	// ContentHash is the hash of the content and the tags of the note, to publish the memo again only when they change.
+	ContentHash string
+	UpdatedTs   int64
+}
+
+type FindNostrPublication struct {
+	MemoID *int32
+}
+
+type DeleteNostrPublication struct {
+	MemoID int32
+}
+
+// UpsertNostrPublication records the note a memo was published as, replacing the previous one.
+func (s *Store) UpsertNostrPublication(ctx context.Context, upsert *NostrPublication) error {
+	if upsert.UpdatedTs == 0 {
+		upsert.UpdatedTs = time.Now().Unix()
+	}
+	return s.driver.UpsertNostrPublication(ctx, upsert)
+}
+
+func (s *Store) ListNostrPublications(ctx context.Context, find *FindNostrPublication) ([]*NostrPublication, error) {
+	return s.driver.ListNostrPublications(ctx, find)
+}
+
+func (s *Store) GetNostrPublication(ctx context.Context, find *FindNostrPublication) (*NostrPublication, error) {
+	list, err := s.ListNostrPublications(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteNostrPublication(ctx context.Context, delete *DeleteNostrPublication) error {
+	return s.driver.DeleteNostrPublication(ctx, delete)
+}
@@ -2,8 +2,12 @@ package store
 
 import (
 	"context"
+	"strings"
 )
 
+// MemoContentIDPrefix is the content id prefix of reactions on memos.
+const MemoContentIDPrefix = "memos/"
+
 type Reaction struct {
 	ID        int32
 	CreatedTs int64
@@ -24,6 +28,12 @@ type DeleteReaction struct {
 	ID int32
 }
 
+// ExtractMemoUIDFromContentID returns the memo uid of a reaction content id, if it refers to a memo.
+func ExtractMemoUIDFromContentID(contentID string) (string, bool) {
+	uid, ok := strings.CutPrefix(contentID, MemoContentIDPrefix)
+	return uid, ok && uid != ""
+}
+
 func (s *Store) UpsertReaction(ctx context.Context, upsert *Reaction) (*Reaction, error) {
 	return s.driver.UpsertReaction(ctx, upsert)
 }
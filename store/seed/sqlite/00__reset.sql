@@ -9,3 +9,29 @@ DELETE FROM activity;
 DELETE FROM idp;
 DELETE FROM inbox;
 DELETE FROM reaction;
+DELETE FROM memo_read_state;
+DELETE FROM event;
+DELETE FROM cold_memo;
+DELETE FROM dead_letter;
+DELETE FROM webhook_delivery;
+DELETE FROM memo_subscription;
+DELETE FROM memo_view;
+DELETE FROM memo_embedding;
+DELETE FROM ai_request_count;
+DELETE FROM ai_usage;
+DELETE FROM ai_debug_log;
+DELETE FROM ai_prompt_template;
+DELETE FROM ai_job;
+DELETE FROM memo_slug;
+DELETE FROM username_alias;
+DELETE FROM user_avatar;
+DELETE FROM ai_summary_cache;
+DELETE FROM ai_audit_log;
+DELETE FROM ai_idempotency_key;
+DELETE FROM announcement;
+DELETE FROM syndication_subscription;
+DELETE FROM federated_memo;
+DELETE FROM nostr_publication;
+DELETE FROM bluesky_post;
+DELETE FROM webmention;
+DELETE FROM memo_tag;
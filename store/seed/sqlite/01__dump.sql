@@ -31,3 +31,13 @@ INSERT INTO reaction (id,creator_id,content_id,reaction_type) VALUES(5,1,'memos/
 
 -- System Settings
 INSERT INTO system_setting VALUES ('MEMO_RELATED', '{"contentLengthLimit":8192,"enableAutoCompact":true,"enableComment":true,"enableLocation":true,"defaultVisibility":"PUBLIC","reactions":["👍","💛","🔥","👏","😂","👌","🚀","👀","🤔","🤡","❓","+1","🎉","💡","✅"]}', '');
+
+-- Memo Counters
+UPDATE memo SET
+  comment_count = (SELECT COUNT(*) FROM memo_relation WHERE memo_relation.related_memo_id = memo.id AND memo_relation.type = 'COMMENT'),
+  reaction_count = (SELECT COUNT(*) FROM reaction WHERE reaction.content_id = 'memos/' || memo.uid),
+  relation_count = (SELECT COUNT(*) FROM memo_relation WHERE memo_relation.memo_id = memo.id AND memo_relation.type = 'REFERENCE');
+
+-- Memo Tags
+INSERT INTO memo_tag (memo_id, tag)
+SELECT DISTINCT memo.id, json_each.value FROM memo, json_each(memo.payload, '$.tags');
@@ -0,0 +1,123 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// SyndicationSubscription is the subscription of the workspace to the public memos of another instance, mirrored
+// as federated memos.
+type SyndicationSubscription struct {
+	ID        int32
+	CreatedTs int64
+
+	CreatorID int32
+	// InstanceURL is the base URL of the other instance, without trailing slash.
+	InstanceURL string
+	Payload     *storepb.SyndicationSubscriptionPayload
+	// LastSyncTs is the time of the last sync, 0 before the first one.
+	LastSyncTs int64
+	// LastError is the error of the last sync, empty when it succeeded.
+	LastError string
+}
+
+type FindSyndicationSubscription struct {
+	ID *int32
+}
+
+type UpdateSyndicationSubscription struct {
+	ID         int32
+	LastSyncTs *int64
+	LastError  *string
+}
+
+type DeleteSyndicationSubscription struct {
+	ID int32
+}
+
+// FederatedMemo is a public memo of another instance mirrored by a syndication subscription. It is read-only.
+type FederatedMemo struct {
+	ID             int32
+	SubscriptionID int32
+	InstanceURL    string
+	// OriginName is the resource name of the memo on its origin instance, e.g. "memos/abc".
+	OriginName string
+	Content    string
+	// CreatedTs and UpdatedTs are the times of the memo on its origin instance.
+	CreatedTs int64
+	UpdatedTs int64
+	// SyncedTs is the time of the last sync the memo was seen in.
+	SyncedTs int64
+	Payload  *storepb.FederatedMemoPayload
+}
+
+type FindFederatedMemo struct {
+	ID             *int32
+	SubscriptionID *int32
+	Limit          *int
+	Offset         *int
+}
+
+type DeleteFederatedMemos struct {
+	SubscriptionID int32
+	// SyncedTsBefore only deletes the memos not seen since that time.
+	SyncedTsBefore *int64
+}
+
+func (s *Store) CreateSyndicationSubscription(ctx context.Context, create *SyndicationSubscription) (*SyndicationSubscription, error) {
+	if create.CreatedTs == 0 {
+		create.CreatedTs = time.Now().Unix()
+	}
+	return s.driver.CreateSyndicationSubscription(ctx, create)
+}
+
+// ListSyndicationSubscriptions lists the subscriptions, the oldest first.
+func (s *Store) ListSyndicationSubscriptions(ctx context.Context, find *FindSyndicationSubscription) ([]*SyndicationSubscription, error) {
+	return s.driver.ListSyndicationSubscriptions(ctx, find)
+}
+
+func (s *Store) GetSyndicationSubscription(ctx context.Context, find *FindSyndicationSubscription) (*SyndicationSubscription, error) {
+	list, err := s.ListSyndicationSubscriptions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateSyndicationSubscription(ctx context.Context, update *UpdateSyndicationSubscription) error {
+	return s.driver.UpdateSyndicationSubscription(ctx, update)
+}
+
+func (s *Store) DeleteSyndicationSubscription(ctx context.Context, delete *DeleteSyndicationSubscription) error {
+	return s.driver.DeleteSyndicationSubscription(ctx, delete)
+}
+
+// UpsertFederatedMemo creates a federated memo, or updates the one with the same origin, which keeps its subscription.
+func (s *Store) UpsertFederatedMemo(ctx context.Context, upsert *FederatedMemo) error {
+	return s.driver.UpsertFederatedMemo(ctx, upsert)
+}
+
+// ListFederatedMemos lists the federated memos, the ones created last on their origin first.
+func (s *Store) ListFederatedMemos(ctx context.Context, find *FindFederatedMemo) ([]*FederatedMemo, error) {
+	return s.driver.ListFederatedMemos(ctx, find)
+}
+
+func (s *Store) GetFederatedMemo(ctx context.Context, find *FindFederatedMemo) (*FederatedMemo, error) {
+	list, err := s.ListFederatedMemos(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteFederatedMemos(ctx context.Context, delete *DeleteFederatedMemos) error {
+	return s.driver.DeleteFederatedMemos(ctx, delete)
+}
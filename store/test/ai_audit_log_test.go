@@ -0,0 +1,69 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestAIAuditLogStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	for _, create := range []*store.AIAuditLog{
+		{CreatedTs: 100, UserID: user.ID, Action: "config_update", Success: true, Payload: &storepb.AIAuditLogPayload{
+			Parameters: map[string]string{"model": "gpt-4o"},
+		}},
+		{CreatedTs: 200, UserID: user.ID, Action: "summary", Error: "rate limited", Payload: &storepb.AIAuditLogPayload{
+			Parameters:     map[string]string{"time_range": "7d"},
+			SourceMemoIds:  []int32{1, 2},
+			SourceMemoUids: []string{"a", "b"},
+		}},
+		{CreatedTs: 300, Action: "workspace_summary", Success: true},
+	} {
+		auditLog, err := ts.CreateAIAuditLog(ctx, create)
+		require.NoError(t, err)
+		require.NotZero(t, auditLog.ID)
+	}
+
+	// The logs are listed most recent first.
+	auditLogs, err := ts.ListAIAuditLogs(ctx, &store.FindAIAuditLog{})
+	require.NoError(t, err)
+	require.Len(t, auditLogs, 3)
+	require.Equal(t, "workspace_summary", auditLogs[0].Action)
+	require.Empty(t, auditLogs[0].Payload.Parameters)
+	require.False(t, auditLogs[1].Success)
+	require.Equal(t, "rate limited", auditLogs[1].Error)
+	require.Equal(t, map[string]string{"time_range": "7d"}, auditLogs[1].Payload.Parameters)
+	require.Equal(t, []int32{1, 2}, auditLogs[1].Payload.SourceMemoIds)
+	require.Equal(t, []string{"a", "b"}, auditLogs[1].Payload.SourceMemoUids)
+
+	limit, offset := 1, 1
+	auditLogs, err = ts.ListAIAuditLogs(ctx, &store.FindAIAuditLog{UserID: &user.ID, Limit: &limit, Offset: &offset})
+	require.NoError(t, err)
+	require.Len(t, auditLogs, 1)
+	require.Equal(t, int64(100), auditLogs[0].CreatedTs)
+
+	action, success := "summary", false
+	auditLogs, err = ts.ListAIAuditLogs(ctx, &store.FindAIAuditLog{Action: &action, Success: &success})
+	require.NoError(t, err)
+	require.Len(t, auditLogs, 1)
+	success = true
+	auditLogs, err = ts.ListAIAuditLogs(ctx, &store.FindAIAuditLog{Action: &action, Success: &success})
+	require.NoError(t, err)
+	require.Empty(t, auditLogs)
+
+	createdTsAfter, createdTsBefore := int64(200), int64(300)
+	auditLogs, err = ts.ListAIAuditLogs(ctx, &store.FindAIAuditLog{CreatedTsAfter: &createdTsAfter, CreatedTsBefore: &createdTsBefore})
+	require.NoError(t, err)
+	require.Len(t, auditLogs, 1)
+	require.Equal(t, "summary", auditLogs[0].Action)
+
+	ts.Close()
+}
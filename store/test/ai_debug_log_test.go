@@ -0,0 +1,56 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestAIDebugLogStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	for _, create := range []*store.AIDebugLog{
+		{CreatedTs: 100, UserID: user.ID, Operation: "summary", Model: "gpt-4o", Success: true, Prompt: []byte("prompt 1"), Response: []byte("response 1")},
+		{CreatedTs: 200, UserID: user.ID, Operation: "chat", Model: "gpt-4o", Prompt: []byte("prompt 2"), Response: []byte("rate limited")},
+		{CreatedTs: 300, Operation: "memo_embedding", Model: "text-embedding-3-small", Success: true, Prompt: []byte("prompt 3"), Response: []byte("1 embeddings")},
+	} {
+		debugLog, err := ts.CreateAIDebugLog(ctx, create)
+		require.NoError(t, err)
+		require.NotZero(t, debugLog.ID)
+	}
+
+	// The logs are listed most recent first.
+	debugLogs, err := ts.ListAIDebugLogs(ctx, &store.FindAIDebugLog{})
+	require.NoError(t, err)
+	require.Len(t, debugLogs, 3)
+	require.Equal(t, "memo_embedding", debugLogs[0].Operation)
+	require.Equal(t, []byte("prompt 2"), debugLogs[1].Prompt)
+	require.Equal(t, []byte("rate limited"), debugLogs[1].Response)
+	require.False(t, debugLogs[1].Success)
+
+	limit, offset := 1, 1
+	debugLogs, err = ts.ListAIDebugLogs(ctx, &store.FindAIDebugLog{UserID: &user.ID, Limit: &limit, Offset: &offset})
+	require.NoError(t, err)
+	require.Len(t, debugLogs, 1)
+	require.Equal(t, int64(100), debugLogs[0].CreatedTs)
+
+	createdTsBefore := int64(300)
+	deleted, err := ts.DeleteAIDebugLogs(ctx, &store.DeleteAIDebugLog{CreatedTsBefore: &createdTsBefore})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted)
+
+	deleted, err = ts.DeleteAIDebugLogs(ctx, &store.DeleteAIDebugLog{})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+	debugLogs, err = ts.ListAIDebugLogs(ctx, &store.FindAIDebugLog{})
+	require.NoError(t, err)
+	require.Empty(t, debugLogs)
+
+	ts.Close()
+}
@@ -0,0 +1,56 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestAIJobStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	job, err := ts.CreateAIJob(ctx, &store.AIJob{
+		JobType: store.AIJobTypeSummary,
+		UserID:  user.ID,
+		Payload: &storepb.AIJobPayload{
+			Payload: &storepb.AIJobPayload_AiSummary{
+				AiSummary: &storepb.AIJobPayload_AISummary{TimeRange: "7d", Tags: []string{"work"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.AIJobStatusQueued, job.Status)
+	require.NotZero(t, job.CreatedTs)
+	_, err = ts.CreateAIJob(ctx, &store.AIJob{
+		JobType: store.AIJobTypeSummary,
+		UserID:  user.ID,
+		Status:  store.AIJobStatusFailed,
+		Error:   "AI API returned empty content",
+	})
+	require.NoError(t, err)
+
+	jobs, err := ts.ListAIJobs(ctx, &store.FindAIJob{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	jobs, err = ts.ListAIJobs(ctx, &store.FindAIJob{StatusList: []store.AIJobStatus{store.AIJobStatusQueued, store.AIJobStatusRunning}})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, job.ID, jobs[0].ID)
+	require.Equal(t, []string{"work"}, jobs[0].Payload.GetAiSummary().Tags)
+
+	status, memoUID := store.AIJobStatusDone, "summary"
+	require.NoError(t, ts.UpdateAIJob(ctx, &store.UpdateAIJob{ID: job.ID, Status: &status, MemoUID: &memoUID}))
+	job, err = ts.GetAIJob(ctx, &store.FindAIJob{ID: &job.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.AIJobStatusDone, job.Status)
+	require.Equal(t, "summary", job.MemoUID)
+
+	ts.Close()
+}
@@ -0,0 +1,49 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestAIPromptTemplateStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	for _, upsert := range []*store.AIPromptTemplate{
+		{Name: "weekly", Content: "Summarize the week.", UpdatedTs: 100},
+		{CreatorID: user.ID, Name: "weekly", Description: "My week", Content: "Summarize my week.", UpdatedTs: 100},
+		{CreatorID: user.ID, Name: "standup", Content: "Summarize for the standup.", UpdatedTs: 100},
+	} {
+		_, err := ts.UpsertAIPromptTemplate(ctx, upsert)
+		require.NoError(t, err)
+	}
+
+	// The templates are listed by creator, then by name.
+	templates, err := ts.ListAIPromptTemplates(ctx, &store.FindAIPromptTemplate{})
+	require.NoError(t, err)
+	require.Len(t, templates, 3)
+	require.Equal(t, int32(0), templates[0].CreatorID)
+	require.Equal(t, "standup", templates[1].Name)
+
+	// Upserting a template of the same creator and name replaces it.
+	_, err = ts.UpsertAIPromptTemplate(ctx, &store.AIPromptTemplate{CreatorID: user.ID, Name: "weekly", Content: "Summarize my week in French.", UpdatedTs: 200})
+	require.NoError(t, err)
+	name := "weekly"
+	template, err := ts.GetAIPromptTemplate(ctx, &store.FindAIPromptTemplate{CreatorID: &user.ID, Name: &name})
+	require.NoError(t, err)
+	require.Equal(t, &store.AIPromptTemplate{CreatorID: user.ID, Name: "weekly", Content: "Summarize my week in French.", UpdatedTs: 200}, template)
+
+	require.NoError(t, ts.DeleteAIPromptTemplate(ctx, &store.DeleteAIPromptTemplate{CreatorID: user.ID, Name: "weekly"}))
+	templates, err = ts.ListAIPromptTemplates(ctx, &store.FindAIPromptTemplate{Name: &name})
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	require.Equal(t, int32(0), templates[0].CreatorID)
+
+	ts.Close()
+}
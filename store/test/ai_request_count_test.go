@@ -0,0 +1,94 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestAIRequestCountStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	// Concurrent requests are all counted.
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			added, err := ts.IncrementAIRequestCount(ctx, user.ID, 7200, 0)
+			require.NoError(t, err)
+			require.True(t, added)
+		}()
+	}
+	wg.Wait()
+	added, err := ts.IncrementAIRequestCount(ctx, user.ID, 3600, 0)
+	require.NoError(t, err)
+	require.True(t, added)
+	added, err = ts.IncrementAIRequestCount(ctx, user.ID+1, 7200, 0)
+	require.NoError(t, err)
+	require.True(t, added)
+
+	counts, err := ts.ListAIRequestCounts(ctx, &store.FindAIRequestCount{UserID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.AIRequestCount{
+		{UserID: user.ID, HourTs: 3600, Count: 1},
+		{UserID: user.ID, HourTs: 7200, Count: 5},
+	}, counts)
+	hourTsAfter := int64(7200)
+	counts, err = ts.ListAIRequestCounts(ctx, &store.FindAIRequestCount{UserID: user.ID, HourTsAfter: &hourTsAfter})
+	require.NoError(t, err)
+	require.Len(t, counts, 1)
+
+	require.NoError(t, ts.DeleteAIRequestCounts(ctx, &store.DeleteAIRequestCount{HourTsBefore: 7200}))
+	counts, err = ts.ListAIRequestCounts(ctx, &store.FindAIRequestCount{UserID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.AIRequestCount{{UserID: user.ID, HourTs: 7200, Count: 5}}, counts)
+
+	ts.Close()
+}
+
+func TestAIRequestCountLimit(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	// Concurrent requests never exceed the limit.
+	var wg sync.WaitGroup
+	var addedCount atomic.Int32
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			added, err := ts.IncrementAIRequestCount(ctx, user.ID, 3600, 3)
+			require.NoError(t, err)
+			if added {
+				addedCount.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(3), addedCount.Load())
+
+	// A removed request frees its place.
+	require.NoError(t, ts.DecrementAIRequestCount(ctx, user.ID, 3600))
+	added, err := ts.IncrementAIRequestCount(ctx, user.ID, 3600, 3)
+	require.NoError(t, err)
+	require.True(t, added)
+	added, err = ts.IncrementAIRequestCount(ctx, user.ID, 3600, 3)
+	require.NoError(t, err)
+	require.False(t, added)
+	counts, err := ts.ListAIRequestCounts(ctx, &store.FindAIRequestCount{UserID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.AIRequestCount{{UserID: user.ID, HourTs: 3600, Count: 3}}, counts)
+
+	ts.Close()
+}
@@ -0,0 +1,39 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestAISummaryCacheStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	require.NoError(t, ts.UpsertAISummaryCache(ctx, &store.AISummaryCache{UserID: user.ID, CacheKey: "weekly", MemoID: 1, CreatedTs: 100}))
+	// The entry of the same user and key is replaced.
+	require.NoError(t, ts.UpsertAISummaryCache(ctx, &store.AISummaryCache{UserID: user.ID, CacheKey: "weekly", MemoID: 2, CreatedTs: 200}))
+
+	cache, err := ts.GetAISummaryCache(ctx, &store.FindAISummaryCache{UserID: user.ID, CacheKey: "weekly"})
+	require.NoError(t, err)
+	require.Equal(t, &store.AISummaryCache{UserID: user.ID, CacheKey: "weekly", MemoID: 2, CreatedTs: 200}, cache)
+	cache, err = ts.GetAISummaryCache(ctx, &store.FindAISummaryCache{UserID: user.ID + 1, CacheKey: "weekly"})
+	require.NoError(t, err)
+	require.Nil(t, cache)
+	createdTsAfter := int64(300)
+	cache, err = ts.GetAISummaryCache(ctx, &store.FindAISummaryCache{UserID: user.ID, CacheKey: "weekly", CreatedTsAfter: &createdTsAfter})
+	require.NoError(t, err)
+	require.Nil(t, cache)
+
+	require.NoError(t, ts.DeleteAISummaryCaches(ctx, &store.DeleteAISummaryCache{CreatedTsBefore: 300}))
+	cache, err = ts.GetAISummaryCache(ctx, &store.FindAISummaryCache{UserID: user.ID, CacheKey: "weekly"})
+	require.NoError(t, err)
+	require.Nil(t, cache)
+
+	ts.Close()
+}
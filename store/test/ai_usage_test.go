@@ -0,0 +1,56 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestAIUsageStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	for _, create := range []*store.AIUsage{
+		{CreatedTs: 100, UserID: user.ID, Operation: "summary", Model: "gpt-4o", PromptTokens: 10, CompletionTokens: 5, LatencyMs: 200, Success: true},
+		{CreatedTs: 200, UserID: user.ID, Operation: "summary", Model: "gpt-4o", LatencyMs: 100, Error: "rate limited"},
+		{CreatedTs: 300, UserID: user.ID, Operation: "chat", Model: "gpt-4o", PromptTokens: 20, CompletionTokens: 8, LatencyMs: 300, Success: true},
+		{CreatedTs: 400, Operation: "memo_embedding", Model: "text-embedding-3-small", PromptTokens: 7, LatencyMs: 50, Success: true},
+	} {
+		usage, err := ts.CreateAIUsage(ctx, create)
+		require.NoError(t, err)
+		require.NotZero(t, usage.ID)
+	}
+
+	// The calls are listed most recent first.
+	usages, err := ts.ListAIUsages(ctx, &store.FindAIUsage{})
+	require.NoError(t, err)
+	require.Len(t, usages, 4)
+	require.Equal(t, "memo_embedding", usages[0].Operation)
+	require.Equal(t, "rate limited", usages[2].Error)
+	require.False(t, usages[2].Success)
+
+	limit, offset := 1, 1
+	usages, err = ts.ListAIUsages(ctx, &store.FindAIUsage{UserID: &user.ID, Limit: &limit, Offset: &offset})
+	require.NoError(t, err)
+	require.Len(t, usages, 1)
+	require.Equal(t, int64(200), usages[0].CreatedTs)
+
+	createdTsAfter, createdTsBefore := int64(200), int64(400)
+	usages, err = ts.ListAIUsages(ctx, &store.FindAIUsage{CreatedTsAfter: &createdTsAfter, CreatedTsBefore: &createdTsBefore})
+	require.NoError(t, err)
+	require.Len(t, usages, 2)
+
+	stats, err := ts.ListAIUsageStats(ctx, &store.FindAIUsage{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.AIUsageStats{
+		{UserID: user.ID, Operation: "chat", Calls: 1, PromptTokens: 20, CompletionTokens: 8, LatencyMs: 300},
+		{UserID: user.ID, Operation: "summary", Calls: 2, Failures: 1, PromptTokens: 10, CompletionTokens: 5, LatencyMs: 300},
+	}, stats)
+
+	ts.Close()
+}
@@ -0,0 +1,69 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestColdMemoStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	oldMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "old-memo",
+		CreatorID:  user.ID,
+		Content:    "an old archived memo",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	recentMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "recent-memo",
+		CreatorID:  user.ID,
+		Content:    "a recently archived memo",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	archived := store.Archived
+	oldUpdatedTs := time.Now().AddDate(0, 0, -60).Unix()
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: oldMemo.ID, RowStatus: &archived, UpdatedTs: &oldUpdatedTs}))
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: recentMemo.ID, RowStatus: &archived}))
+
+	moved, err := ts.MoveMemosToColdStorage(ctx, &store.MoveMemosToColdStorage{
+		UpdatedBefore: time.Now().AddDate(0, 0, -30).Unix(),
+		Limit:         10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, moved)
+
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &oldMemo.ID})
+	require.NoError(t, err)
+	require.Nil(t, memo)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &recentMemo.ID})
+	require.NoError(t, err)
+	require.NotNil(t, memo)
+
+	contentSearch := "old archived"
+	coldMemos, err := ts.ListColdMemos(ctx, &store.FindColdMemo{CreatorID: &user.ID, ContentSearch: &contentSearch})
+	require.NoError(t, err)
+	require.Len(t, coldMemos, 1)
+	require.Equal(t, oldMemo.UID, coldMemos[0].UID)
+	require.Equal(t, store.Archived, coldMemos[0].RowStatus)
+
+	require.NoError(t, ts.RestoreColdMemo(ctx, oldMemo.ID))
+	coldMemos, err = ts.ListColdMemos(ctx, &store.FindColdMemo{})
+	require.NoError(t, err)
+	require.Len(t, coldMemos, 0)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &oldMemo.ID})
+	require.NoError(t, err)
+	require.NotNil(t, memo)
+	require.Equal(t, oldMemo.Content, memo.Content)
+	ts.Close()
+}
@@ -0,0 +1,69 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestDeadLetterStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	webhookDeadLetter, err := ts.CreateDeadLetter(ctx, &store.DeadLetter{
+		JobType: store.DeadLetterJobTypeWebhook,
+		UserID:  user.ID,
+		Payload: &storepb.DeadLetterPayload{
+			Payload: &storepb.DeadLetterPayload_Webhook_{
+				Webhook: &storepb.DeadLetterPayload_Webhook{
+					Url:          "https://example.com/hook",
+					ActivityType: "memos.memo.created",
+					Body:         `{"activityType":"memos.memo.created"}`,
+				},
+			},
+		},
+		Error: "failed to post webhook",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), webhookDeadLetter.Attempts)
+	_, err = ts.CreateDeadLetter(ctx, &store.DeadLetter{
+		JobType: store.DeadLetterJobTypeUserImport,
+		UserID:  user.ID,
+		Payload: &storepb.DeadLetterPayload{
+			Payload: &storepb.DeadLetterPayload_UserImport_{
+				UserImport: &storepb.DeadLetterPayload_UserImport{SourceUrl: "https://memos.example.com", EncryptedAccessToken: []byte("sealed")},
+			},
+		},
+		Error: "failed to get remote user",
+	})
+	require.NoError(t, err)
+
+	deadLetters, err := ts.ListDeadLetters(ctx, &store.FindDeadLetter{})
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 2)
+
+	jobType := store.DeadLetterJobTypeWebhook
+	deadLetter, err := ts.GetDeadLetter(ctx, &store.FindDeadLetter{JobType: &jobType})
+	require.NoError(t, err)
+	require.Equal(t, webhookDeadLetter.ID, deadLetter.ID)
+	require.Equal(t, "https://example.com/hook", deadLetter.Payload.GetWebhook().Url)
+
+	errorMessage, attempts := "status code: 500", int32(2)
+	require.NoError(t, ts.UpdateDeadLetter(ctx, &store.UpdateDeadLetter{ID: deadLetter.ID, Error: &errorMessage, Attempts: &attempts}))
+	deadLetter, err = ts.GetDeadLetter(ctx, &store.FindDeadLetter{ID: &deadLetter.ID})
+	require.NoError(t, err)
+	require.Equal(t, errorMessage, deadLetter.Error)
+	require.Equal(t, attempts, deadLetter.Attempts)
+
+	require.NoError(t, ts.DeleteDeadLetter(ctx, &store.DeleteDeadLetter{ID: deadLetter.ID}))
+	deadLetter, err = ts.GetDeadLetter(ctx, &store.FindDeadLetter{ID: &deadLetter.ID})
+	require.NoError(t, err)
+	require.Nil(t, deadLetter)
+	ts.Close()
+}
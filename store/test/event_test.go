@@ -0,0 +1,45 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestEventStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	first, err := ts.CreateEvent(ctx, &store.Event{
+		UserID:   user.ID,
+		Type:     store.EventTypeMemoCreated,
+		Resource: "memos/test",
+		Payload:  `{"content":"hello"}`,
+	})
+	require.NoError(t, err)
+	second, err := ts.CreateEvent(ctx, &store.Event{
+		UserID:   user.ID,
+		Type:     store.EventTypeMemoDeleted,
+		Resource: "memos/test",
+	})
+	require.NoError(t, err)
+	require.Greater(t, second.ID, first.ID)
+	require.Equal(t, "{}", second.Payload)
+
+	events, err := ts.ListEvents(ctx, &store.FindEvent{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(events))
+	require.Equal(t, first.ID, events[0].ID)
+	require.Equal(t, `{"content":"hello"}`, events[0].Payload)
+
+	events, err = ts.ListEvents(ctx, &store.FindEvent{UserID: &user.ID, AfterID: &first.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(events))
+	require.Equal(t, second.ID, events[0].ID)
+	ts.Close()
+}
@@ -0,0 +1,73 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestBatchCreateMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	// Span several INSERT statements.
+	count := store.BatchInsertSize*2 + 1
+	batch := &store.BatchCreateMemos{}
+	for i := 0; i < count; i++ {
+		batch.Memos = append(batch.Memos, &store.Memo{
+			UID:        fmt.Sprintf("batch-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("batch memo %d #imported", i),
+			Visibility: store.Private,
+			Payload:    &storepb.MemoPayload{Tags: []string{"imported"}},
+		})
+	}
+	batch.Memos[0].CreatedTs = 1700000000
+	batch.Memos[0].RowStatus = store.Archived
+	batch.Memos[0].Pinned = true
+	batch.Relations = []*store.BatchMemoRelation{
+		{MemoIndex: 1, RelatedMemoIndex: 0, Type: store.MemoRelationComment},
+		{MemoIndex: count - 1, RelatedMemoIndex: 0, Type: store.MemoRelationReference},
+		// Duplicated relations are ignored.
+		{MemoIndex: count - 1, RelatedMemoIndex: 0, Type: store.MemoRelationReference},
+	}
+
+	memos, err := ts.BatchCreateMemos(ctx, batch)
+	require.NoError(t, err)
+	require.Len(t, memos, count)
+	for _, memo := range memos {
+		require.NotZero(t, memo.ID)
+	}
+
+	first, err := ts.GetMemo(ctx, &store.FindMemo{ID: &memos[0].ID})
+	require.NoError(t, err)
+	require.Equal(t, "batch-memo-0", first.UID)
+	require.Equal(t, int64(1700000000), first.CreatedTs)
+	require.Equal(t, int64(1700000000), first.UpdatedTs)
+	require.Equal(t, store.Archived, first.RowStatus)
+	require.True(t, first.Pinned)
+	require.Equal(t, []string{"imported"}, first.Payload.Tags)
+	require.Equal(t, int32(1), first.CommentCount)
+
+	last, err := ts.GetMemo(ctx, &store.FindMemo{ID: &memos[count-1].ID})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("batch-memo-%d", count-1), last.UID)
+	require.Equal(t, int32(1), last.RelationCount)
+
+	relations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memos[0].ID})
+	require.NoError(t, err)
+	require.Len(t, relations, 2)
+
+	_, err = ts.BatchCreateMemos(ctx, &store.BatchCreateMemos{
+		Memos: []*store.Memo{{UID: "batch-memo-0", CreatorID: user.ID, Content: "conflict", Visibility: store.Private}},
+	})
+	require.Error(t, err)
+	ts.Close()
+}
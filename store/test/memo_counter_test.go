@@ -0,0 +1,76 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoCounters(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	createMemo := func(uid string) *store.Memo {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    uid + " content",
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	getMemo := func(id int32) *store.Memo {
+		memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &id})
+		require.NoError(t, err)
+		require.NotNil(t, memo)
+		return memo
+	}
+	memo := createMemo("main-memo")
+	relatedMemo := createMemo("related-memo")
+	commentMemo := createMemo("comment-memo")
+
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        memo.ID,
+		RelatedMemoID: relatedMemo.ID,
+		Type:          store.MemoRelationReference,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        commentMemo.ID,
+		RelatedMemoID: memo.ID,
+		Type:          store.MemoRelationComment,
+	})
+	require.NoError(t, err)
+	reaction, err := ts.UpsertReaction(ctx, &store.Reaction{
+		CreatorID:    user.ID,
+		ContentID:    store.MemoContentIDPrefix + memo.UID,
+		ReactionType: "👍",
+	})
+	require.NoError(t, err)
+
+	memo = getMemo(memo.ID)
+	require.Equal(t, int32(1), memo.CommentCount)
+	require.Equal(t, int32(1), memo.ReactionCount)
+	require.Equal(t, int32(1), memo.RelationCount)
+	require.Equal(t, int32(0), getMemo(relatedMemo.ID).RelationCount)
+	require.Equal(t, int32(0), getMemo(commentMemo.ID).RelationCount)
+
+	commentType := store.MemoRelationComment
+	require.NoError(t, ts.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
+		MemoID: &commentMemo.ID,
+		Type:   &commentType,
+	}))
+	require.NoError(t, ts.DeleteReaction(ctx, &store.DeleteReaction{ID: reaction.ID}))
+
+	memo = getMemo(memo.ID)
+	require.Equal(t, int32(0), memo.CommentCount)
+	require.Equal(t, int32(0), memo.ReactionCount)
+	require.Equal(t, int32(1), memo.RelationCount)
+	ts.Close()
+}
@@ -0,0 +1,114 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoEmbeddingStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	createMemo := func(uid string, visibility store.Visibility) *store.Memo {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{UID: uid, CreatorID: user.ID, Content: uid, Visibility: visibility})
+		require.NoError(t, err)
+		return memo
+	}
+	garden := createMemo("garden", store.Public)
+	budget := createMemo("budget", store.Private)
+	archived := createMemo("archived", store.Public)
+
+	upsert := func(memo *store.Memo, model string, embedding []float32) {
+		require.NoError(t, ts.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+			MemoID:      memo.ID,
+			Model:       model,
+			ContentHash: memo.Content,
+			Embedding:   embedding,
+			UpdatedTs:   1,
+		}))
+	}
+	upsert(garden, "small", []float32{0, 1})
+	upsert(garden, "small", []float32{1, 0.1})
+	upsert(budget, "small", []float32{0, 1})
+	upsert(archived, "small", []float32{1, 0})
+	rowStatus := store.Archived
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: archived.ID, RowStatus: &rowStatus}))
+
+	model := "small"
+	embeddings, err := ts.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{MemoIDList: []int32{garden.ID}, Model: &model})
+	require.NoError(t, err)
+	require.Len(t, embeddings, 1)
+	require.Equal(t, []float32{1, 0.1}, embeddings[0].Embedding)
+
+	// The closest memo comes first and archived memos are ignored.
+	matches, err := ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{
+		Model:     "small",
+		Embedding: []float32{1, 0},
+		ViewerID:  &user.ID,
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	require.Equal(t, garden.ID, matches[0].MemoID)
+	require.InDelta(t, 0.995, matches[0].Score, 0.001)
+	require.Equal(t, budget.ID, matches[1].MemoID)
+
+	// Memos of other users are only found if their visibility is listed.
+	matches, err = ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{
+		Model:          "small",
+		Embedding:      []float32{0, 1},
+		VisibilityList: []store.Visibility{store.Public, store.Protected},
+		Limit:          10,
+	})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, garden.ID, matches[0].MemoID)
+
+	// The archived memos are only searched among the memos of the viewer.
+	matches, err = ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{
+		Model:         "small",
+		Embedding:     []float32{1, 0},
+		ViewerID:      &user.ID,
+		RowStatusList: []store.RowStatus{store.Normal, store.Archived},
+		Limit:         10,
+	})
+	require.NoError(t, err)
+	require.Len(t, matches, 3)
+	require.Equal(t, archived.ID, matches[0].MemoID)
+	matches, err = ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{
+		Model:          "small",
+		Embedding:      []float32{1, 0},
+		VisibilityList: []store.Visibility{store.Public},
+		RowStatusList:  []store.RowStatus{store.Archived},
+		Limit:          10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, matches)
+
+	matches, err = ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{Model: "large", Embedding: []float32{0, 1}, ViewerID: &user.ID})
+	require.NoError(t, err)
+	require.Empty(t, matches)
+
+	require.NoError(t, ts.DeleteMemoEmbedding(ctx, &store.DeleteMemoEmbedding{MemoID: &garden.ID}))
+	embeddings, err = ts.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{})
+	require.NoError(t, err)
+	require.Len(t, embeddings, 2)
+
+	// The embeddings of the memos of a user are deleted together.
+	other, err := ts.CreateUser(ctx, &store.User{Username: "other", Role: store.RoleUser})
+	require.NoError(t, err)
+	notes, err := ts.CreateMemo(ctx, &store.Memo{UID: "notes", CreatorID: other.ID, Content: "notes", Visibility: store.Public})
+	require.NoError(t, err)
+	upsert(notes, "small", []float32{0, 1})
+	require.NoError(t, ts.DeleteMemoEmbedding(ctx, &store.DeleteMemoEmbedding{CreatorID: &user.ID}))
+	embeddings, err = ts.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{})
+	require.NoError(t, err)
+	require.Len(t, embeddings, 1)
+	require.Equal(t, notes.ID, embeddings[0].MemoID)
+	ts.Close()
+}
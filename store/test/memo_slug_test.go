@@ -0,0 +1,49 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoSlugStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	for _, upsert := range []*store.MemoSlug{
+		{CreatorID: user.ID, Slug: "hello-world", MemoID: 1, CreatedTs: 100},
+		{CreatorID: user.ID, Slug: "hello-again", MemoID: 1, CreatedTs: 200},
+		{CreatorID: user.ID, Slug: "other", MemoID: 2, CreatedTs: 100},
+	} {
+		_, err := ts.UpsertMemoSlug(ctx, upsert)
+		require.NoError(t, err)
+	}
+
+	// The slugs of a memo are listed from the most recent.
+	memoID := int32(1)
+	slugs, err := ts.ListMemoSlugs(ctx, &store.FindMemoSlug{MemoID: &memoID})
+	require.NoError(t, err)
+	require.Len(t, slugs, 2)
+	require.Equal(t, "hello-again", slugs[0].Slug)
+
+	// Upserting a slug of the same creator points it to the new memo.
+	_, err = ts.UpsertMemoSlug(ctx, &store.MemoSlug{CreatorID: user.ID, Slug: "hello-world", MemoID: 2, CreatedTs: 300})
+	require.NoError(t, err)
+	slug := "hello-world"
+	memoSlug, err := ts.GetMemoSlug(ctx, &store.FindMemoSlug{CreatorID: &user.ID, Slug: &slug})
+	require.NoError(t, err)
+	require.Equal(t, &store.MemoSlug{CreatorID: user.ID, Slug: "hello-world", MemoID: 2, CreatedTs: 300}, memoSlug)
+
+	require.NoError(t, ts.DeleteMemoSlug(ctx, &store.DeleteMemoSlug{MemoID: 2}))
+	slugs, err = ts.ListMemoSlugs(ctx, &store.FindMemoSlug{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, slugs, 1)
+	require.Equal(t, "hello-again", slugs[0].Slug)
+
+	ts.Close()
+}
@@ -0,0 +1,66 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoTagStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	createMemo := func(uid string, visibility store.Visibility, tags ...string) *store.Memo {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    uid,
+			Visibility: visibility,
+			Payload:    &storepb.MemoPayload{Tags: tags},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	listTagged := func(filter string) []string {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{Filters: []string{filter}})
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range memos {
+			uids = append(uids, memo.UID)
+		}
+		return uids
+	}
+	listTagCounts := func(find *store.FindMemoTagCount) map[string]int32 {
+		tagCounts, err := ts.ListMemoTagCounts(ctx, find)
+		require.NoError(t, err)
+		counts := map[string]int32{}
+		for _, tagCount := range tagCounts {
+			counts[tagCount.Tag] = tagCount.Count
+		}
+		return counts
+	}
+
+	work := createMemo("work", store.Public, "work", "work/project")
+	createMemo("home", store.Private, "home", "work")
+	createMemo("untagged", store.Public)
+
+	require.ElementsMatch(t, []string{"work", "home"}, listTagged(`tag in ["work"]`))
+	require.Equal(t, []string{"work"}, listTagged(`"work/project" in tags`))
+	require.Equal(t, []string{"untagged"}, listTagged(`!(tag in ["work", "home"])`))
+	require.Equal(t, map[string]int32{"work": 2, "work/project": 1, "home": 1}, listTagCounts(&store.FindMemoTagCount{CreatorID: &user.ID}))
+	require.Equal(t, map[string]int32{"work": 1, "work/project": 1}, listTagCounts(&store.FindMemoTagCount{VisibilityList: []store.Visibility{store.Public}}))
+
+	// The tags are indexed again when the payload is updated, and dropped with the memo.
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: work.ID, Payload: &storepb.MemoPayload{Tags: []string{"done"}}}))
+	require.Equal(t, []string{"home"}, listTagged(`tag in ["work"]`))
+	require.Equal(t, []string{"work"}, listTagged(`tag in ["done"]`))
+	require.NoError(t, ts.DeleteMemo(ctx, &store.DeleteMemo{ID: work.ID}))
+	require.Equal(t, map[string]int32{"work": 1, "home": 1}, listTagCounts(&store.FindMemoTagCount{}))
+	ts.Close()
+}
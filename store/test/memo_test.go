@@ -3,7 +3,9 @@ package test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/lithammer/shortuuid/v4"
 	"github.com/stretchr/testify/require"
 
 	"github.com/usememos/memos/store"
@@ -94,6 +96,46 @@ func TestMemoListByTags(t *testing.T) {
 	ts.Close()
 }
 
+func TestMemoListBySearchScore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	now := time.Now().Unix()
+	createMemo := func(uid, content string, tags []string, age time.Duration) {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+			Payload:    &storepb.MemoPayload{Tags: tags},
+		})
+		require.NoError(t, err)
+		createdTs := now - int64(age.Seconds())
+		require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, CreatedTs: &createdTs}))
+	}
+	createMemo("once", "Tomato soup", nil, 0)
+	createMemo("thrice", "Tomato salad, tomato sauce and TOMATO juice", nil, 24*time.Hour)
+	createMemo("tagged", "Tomato seeds", []string{"garden"}, 48*time.Hour)
+	createMemo("old", "Tomato, tomato, tomato, tomato", nil, 400*24*time.Hour)
+
+	list := func(score *store.MemoSearchScore) []string {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{OrderBySearchScore: score})
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range memos {
+			uids = append(uids, memo.UID)
+		}
+		return uids
+	}
+	// The occurrences of the terms rank the memos, the most recent first on a tie.
+	require.Equal(t, []string{"old", "thrice", "once", "tagged"}, list(&store.MemoSearchScore{Terms: []string{"tomato"}, NowTs: now}))
+	require.Equal(t, []string{"old", "thrice", "tagged", "once"}, list(&store.MemoSearchScore{Terms: []string{"tomato"}, TagBoosts: map[string]float64{"garden": 1.5}, NowTs: now}))
+	// The older memos score less with a recency half-life.
+	require.Equal(t, []string{"thrice", "once", "tagged", "old"}, list(&store.MemoSearchScore{Terms: []string{"tomato"}, RecencyHalfLife: 30 * 24 * 60 * 60, NowTs: now}))
+	ts.Close()
+}
+
 func TestDeleteMemoStore(t *testing.T) {
 	ctx := context.Background()
 	ts := NewTestingStore(ctx, t)
@@ -114,3 +156,67 @@ func TestDeleteMemoStore(t *testing.T) {
 	require.NoError(t, err)
 	ts.Close()
 }
+
+func TestCreateMemoWithAssociations(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	relatedMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "related-memo",
+		CreatorID:  user.ID,
+		Content:    "related memo",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	attachments := []*store.Attachment{}
+	for _, filename := range []string{"first.txt", "second.txt"} {
+		attachment, err := ts.CreateAttachment(ctx, &store.Attachment{
+			UID:       shortuuid.New(),
+			CreatorID: user.ID,
+			Filename:  filename,
+			Blob:      []byte(filename),
+			Type:      "text/plain",
+			Size:      int64(len(filename)),
+		})
+		require.NoError(t, err)
+		attachments = append(attachments, attachment)
+	}
+
+	memo, err := ts.CreateMemoWithAssociations(ctx, &store.Memo{
+		UID:        "memo-with-associations",
+		CreatorID:  user.ID,
+		Content:    "memo with associations",
+		Visibility: store.Public,
+	}, &store.MemoAssociations{
+		Relations:     []*store.MemoRelation{{RelatedMemoID: relatedMemo.ID, Type: store.MemoRelationComment}},
+		AttachmentIDs: []int32{attachments[0].ID, attachments[1].ID},
+	})
+	require.NoError(t, err)
+	memoAttachments, err := ts.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, memoAttachments, 2)
+	require.Equal(t, "first.txt", memoAttachments[0].Filename)
+	relatedMemo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &relatedMemo.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), relatedMemo.CommentCount)
+
+	// A failing relation rolls back the memo.
+	uid := "rolled-back-memo"
+	_, err = ts.CreateMemoWithAssociations(ctx, &store.Memo{
+		UID:        uid,
+		CreatorID:  user.ID,
+		Content:    "rolled back memo",
+		Visibility: store.Public,
+	}, &store.MemoAssociations{
+		Relations: []*store.MemoRelation{
+			{RelatedMemoID: relatedMemo.ID, Type: store.MemoRelationReference},
+			{RelatedMemoID: relatedMemo.ID, Type: store.MemoRelationReference},
+		},
+	})
+	require.Error(t, err)
+	rolledBack, err := ts.GetMemo(ctx, &store.FindMemo{UID: &uid})
+	require.NoError(t, err)
+	require.Nil(t, rolledBack)
+	ts.Close()
+}
@@ -5,6 +5,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
 )
 
 func TestGetCurrentSchemaVersion(t *testing.T) {
@@ -13,5 +17,74 @@ func TestGetCurrentSchemaVersion(t *testing.T) {
 
 	currentSchemaVersion, err := ts.GetCurrentSchemaVersion()
 	require.NoError(t, err)
-	require.Equal(t, "0.25.1", currentSchemaVersion)
+	require.Equal(t, "0.25.29", currentSchemaVersion)
+}
+
+func TestResetDemoData(t *testing.T) {
+	ctx := context.Background()
+	profile := getTestingProfile(t)
+	if profile.Driver != "sqlite" {
+		t.Skip("the demo data is only seeded for sqlite")
+	}
+	profile.Mode = "demo"
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	ts := store.New(dbDriver, profile)
+	defer ts.Close()
+	require.NoError(t, ts.Migrate(ctx))
+
+	username := "demo"
+	demoUser, err := ts.GetUser(ctx, &store.FindUser{Username: &username})
+	require.NoError(t, err)
+	require.NotNil(t, demoUser)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_BASIC,
+		Value: &storepb.WorkspaceSetting_BasicSetting{BasicSetting: &storepb.WorkspaceBasicSetting{SecretKey: "demo-secret", SchemaVersion: "0.25.29"}},
+	})
+	require.NoError(t, err)
+
+	// The visitors change the demo data, the changes are also held by the caches.
+	visitor, err := ts.CreateUser(ctx, &store.User{Username: "visitor", Role: store.RoleUser})
+	require.NoError(t, err)
+	nickname := "Hijacked"
+	_, err = ts.UpdateUser(ctx, &store.UpdateUser{ID: demoUser.ID, Nickname: &nickname})
+	require.NoError(t, err)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_GENERAL,
+		Value: &storepb.WorkspaceSetting_GeneralSetting{GeneralSetting: &storepb.WorkspaceGeneralSetting{DisallowUserRegistration: true}},
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: demoUser.ID,
+		Key:    storepb.UserSetting_GENERAL,
+		Value:  &storepb.UserSetting_General{General: &storepb.GeneralUserSetting{AiOptIn: true}},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ts.ResetDemoData(ctx))
+
+	// The seed data is back, and the basic setting survived the reset.
+	basicSetting, err := ts.GetWorkspaceBasicSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "demo-secret", basicSetting.SecretKey)
+	require.Equal(t, "0.25.29", basicSetting.SchemaVersion)
+	user, err := ts.GetUser(ctx, &store.FindUser{ID: &demoUser.ID})
+	require.NoError(t, err)
+	require.Equal(t, demoUser.Nickname, user.Nickname)
+	user, err = ts.GetUser(ctx, &store.FindUser{ID: &visitor.ID})
+	require.NoError(t, err)
+	require.Nil(t, user)
+	generalSetting, err := ts.GetWorkspaceGeneralSetting(ctx)
+	require.NoError(t, err)
+	require.False(t, generalSetting.DisallowUserRegistration)
+	optIn, err := ts.GetUserAIOptIn(ctx, demoUser.ID)
+	require.NoError(t, err)
+	require.False(t, optIn)
+}
+
+func TestResetDemoDataOutsideDemoMode(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	require.Error(t, ts.ResetDemoData(ctx))
 }
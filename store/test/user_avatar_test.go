@@ -0,0 +1,48 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestUserAvatarStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	for _, upsert := range []*store.UserAvatar{
+		{UserID: user.ID, Size: 256, Type: "image/png", Etag: "large", Blob: []byte("large")},
+		{UserID: user.ID, Size: 64, Type: "image/png", Etag: "small", Blob: []byte("small")},
+		{UserID: user.ID, Size: 64, Type: "image/jpeg", Etag: "replaced", Blob: []byte("replaced")},
+	} {
+		_, err := ts.UpsertUserAvatar(ctx, upsert)
+		require.NoError(t, err)
+	}
+
+	// The avatars are listed the smallest first, replaced by size.
+	avatars, err := ts.ListUserAvatars(ctx, &store.FindUserAvatar{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, avatars, 2)
+	require.Equal(t, int32(64), avatars[0].Size)
+	require.Equal(t, "replaced", avatars[0].Etag)
+	require.Equal(t, "image/jpeg", avatars[0].Type)
+	require.Equal(t, []byte("replaced"), avatars[0].Blob)
+	require.Equal(t, int32(256), avatars[1].Size)
+
+	size := int32(256)
+	avatar, err := ts.GetUserAvatar(ctx, &store.FindUserAvatar{UserID: &user.ID, Size: &size})
+	require.NoError(t, err)
+	require.Equal(t, "large", avatar.Etag)
+
+	require.NoError(t, ts.DeleteUserAvatar(ctx, &store.DeleteUserAvatar{UserID: &user.ID}))
+	avatars, err = ts.ListUserAvatars(ctx, &store.FindUserAvatar{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Empty(t, avatars)
+
+	ts.Close()
+}
@@ -0,0 +1,55 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestUsernameAliasStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+	for _, upsert := range []*store.UsernameAlias{
+		{Username: "old", UserID: user.ID, CreatedTs: 100, ExpiresTs: now + 3600},
+		{Username: "older", UserID: user.ID, CreatedTs: 50, ExpiresTs: now - 1},
+	} {
+		_, err := ts.UpsertUsernameAlias(ctx, upsert)
+		require.NoError(t, err)
+	}
+
+	// The user is found by its username, or by its unexpired aliases.
+	found, err := ts.GetUserByUsername(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, user.ID, found.ID)
+	found, err = ts.GetUserByUsername(ctx, "old")
+	require.NoError(t, err)
+	require.Equal(t, user.ID, found.ID)
+	found, err = ts.GetUserByUsername(ctx, "older")
+	require.NoError(t, err)
+	require.Nil(t, found)
+
+	aliases, err := ts.ListUsernameAliases(ctx, &store.FindUsernameAlias{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, aliases, 2)
+	require.Equal(t, "old", aliases[0].Username)
+	aliases, err = ts.ListUsernameAliases(ctx, &store.FindUsernameAlias{ExpiresAfter: &now})
+	require.NoError(t, err)
+	require.Len(t, aliases, 1)
+
+	username := "old"
+	require.NoError(t, ts.DeleteUsernameAlias(ctx, &store.DeleteUsernameAlias{Username: &username}))
+	aliases, err = ts.ListUsernameAliases(ctx, &store.FindUsernameAlias{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, aliases, 1)
+	require.Equal(t, "older", aliases[0].Username)
+
+	ts.Close()
+}
@@ -0,0 +1,67 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestWebhookDeliveryStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		_, err := ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+			UserID:       user.ID,
+			WebhookID:    "hook",
+			ActivityType: "memos.memo.created",
+			StatusCode:   200,
+		})
+		require.NoError(t, err)
+	}
+	failed, err := ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		UserID:       user.ID,
+		WebhookID:    "hook",
+		ActivityType: "memos.memo.updated",
+		Error:        "failed to post webhook",
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		UserID:       user.ID,
+		WebhookID:    "other",
+		ActivityType: "memos.memo.created",
+		StatusCode:   200,
+	})
+	require.NoError(t, err)
+
+	webhookID := "hook"
+	deliveries, err := ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{UserID: &user.ID, WebhookID: &webhookID})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 6)
+	require.Equal(t, failed.ID, deliveries[0].ID)
+	require.Equal(t, int32(0), deliveries[0].StatusCode)
+	require.Equal(t, "failed to post webhook", deliveries[0].Error)
+
+	// Pruning keeps the most recent deliveries of the webhook only.
+	require.NoError(t, ts.PruneWebhookDeliveries(ctx, user.ID, webhookID, 3))
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{UserID: &user.ID, WebhookID: &webhookID})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 3)
+	require.Equal(t, failed.ID, deliveries[0].ID)
+	otherWebhookID := "other"
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{UserID: &user.ID, WebhookID: &otherWebhookID})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+
+	require.NoError(t, ts.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{UserID: user.ID, WebhookID: webhookID}))
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{UserID: &user.ID, WebhookID: &webhookID})
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+
+	ts.Close()
+}
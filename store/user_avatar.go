@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// UserAvatar is a processed avatar image of a user in one of the standard sizes.
+// The image is persisted with the workspace storage backend like attachments are.
+type UserAvatar struct {
+	UserID int32
+	// Size is the width and height of the square image in pixels.
+	Size int32
+	Type string
+	// Etag is the hash of the image content, used for the conditional requests.
+	Etag        string
+	StorageType storepb.AttachmentStorageType
+	Reference   string
+	Blob        []byte
+	Payload     *storepb.AttachmentPayload
+	UpdatedTs   int64
+}
+
+type FindUserAvatar struct {
+	UserID *int32
+	Size   *int32
+}
+
+type DeleteUserAvatar struct {
+	UserID *int32
+}
+
+// UpsertUserAvatar saves the avatar, replacing the avatar of the same user and size if any.
+func (s *Store) UpsertUserAvatar(ctx context.Context, upsert *UserAvatar) (*UserAvatar, error) {
+	if upsert.UpdatedTs == 0 {
+		upsert.UpdatedTs = time.Now().Unix()
+	}
+	if err := s.driver.UpsertUserAvatar(ctx, upsert); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+// ListUserAvatars lists the avatars, the smallest first.
+func (s *Store) ListUserAvatars(ctx context.Context, find *FindUserAvatar) ([]*UserAvatar, error) {
+	return s.driver.ListUserAvatars(ctx, find)
+}
+
+func (s *Store) GetUserAvatar(ctx context.Context, find *FindUserAvatar) (*UserAvatar, error) {
+	list, err := s.ListUserAvatars(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// DeleteUserAvatar deletes the avatars along with their blobs persisted out of the database.
+func (s *Store) DeleteUserAvatar(ctx context.Context, delete *DeleteUserAvatar) error {
+	avatars, err := s.ListUserAvatars(ctx, &FindUserAvatar{UserID: delete.UserID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list user avatars")
+	}
+	for _, avatar := range avatars {
+		if err := s.deleteBlob(ctx, avatar.StorageType, avatar.Reference, avatar.Payload); err != nil {
+			slog.Warn("failed to delete user avatar blob", slog.Int("user", int(avatar.UserID)), slog.Any("error", err))
+		}
+	}
+	return s.driver.DeleteUserAvatar(ctx, delete)
+}
@@ -349,6 +349,256 @@ func (s *Store) UpdateUserWebhook(ctx context.Context, userID int32, webhook *st
 	return err
 }
 
+// IsUserApproved returns whether the user has been approved by an admin.
+func (s *Store) IsUserApproved(ctx context.Context, userID int32) (bool, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_APPROVAL,
+	})
+	if err != nil {
+		return false, err
+	}
+	if userSetting == nil {
+		return false, nil
+	}
+	return userSetting.GetApproval().GetApproved(), nil
+}
+
+// GetUserFeatureFlagOverrides returns the feature flag overrides of the user, keyed by flag name.
+func (s *Store) GetUserFeatureFlagOverrides(ctx context.Context, userID int32) (map[string]bool, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_FEATURE_FLAGS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return map[string]bool{}, nil
+	}
+	overrides := userSetting.GetFeatureFlags().GetOverrides()
+	if overrides == nil {
+		overrides = map[string]bool{}
+	}
+	return overrides, nil
+}
+
+// SetUserFeatureFlagOverride overrides a feature flag for the user, a nil value removes the override.
+func (s *Store) SetUserFeatureFlagOverride(ctx context.Context, userID int32, flag string, enabled *bool) error {
+	overrides, err := s.GetUserFeatureFlagOverrides(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if enabled == nil {
+		delete(overrides, flag)
+	} else {
+		overrides[flag] = *enabled
+	}
+
+	_, err = s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_FEATURE_FLAGS,
+		Value: &storepb.UserSetting_FeatureFlags{
+			FeatureFlags: &storepb.FeatureFlagsUserSetting{
+				Overrides: overrides,
+			},
+		},
+	})
+	return err
+}
+
+// GetUserProfile returns the public profile of the user, empty if the user has not set it.
+func (s *Store) GetUserProfile(ctx context.Context, userID int32) (*storepb.ProfileUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_PROFILE,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.ProfileUserSetting{}, nil
+	}
+	return userSetting.GetProfile(), nil
+}
+
+// GetUserLegalConsentVersion returns the version of the legal pages the user consented to, empty if none.
+func (s *Store) GetUserLegalConsentVersion(ctx context.Context, userID int32) (string, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_LEGAL_CONSENT,
+	})
+	if err != nil {
+		return "", err
+	}
+	if userSetting == nil {
+		return "", nil
+	}
+	return userSetting.GetLegalConsent().GetVersion(), nil
+}
+
+// SetUserLegalConsentVersion records the consent of the user to the version of the legal pages.
+func (s *Store) SetUserLegalConsentVersion(ctx context.Context, userID int32, version string) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_LEGAL_CONSENT,
+		Value: &storepb.UserSetting_LegalConsent{
+			LegalConsent: &storepb.LegalConsentUserSetting{
+				Version:     version,
+				ConsentTime: timestamppb.Now(),
+			},
+		},
+	})
+	return err
+}
+
+// GetUserAIOptIn returns whether the user opted in to the AI processing of their content in the AI operations of the
+// other users.
+func (s *Store) GetUserAIOptIn(ctx context.Context, userID int32) (bool, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return false, err
+	}
+	return userSetting.GetGeneral().GetAiOptIn(), nil
+}
+
+// GetUserDismissedAnnouncementIDs returns the IDs of the announcements dismissed by the user.
+func (s *Store) GetUserDismissedAnnouncementIDs(ctx context.Context, userID int32) ([]int32, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_DISMISSED_ANNOUNCEMENTS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []int32{}, nil
+	}
+	return userSetting.GetDismissedAnnouncements().GetAnnouncementIds(), nil
+}
+
+// SetUserDismissedAnnouncementIDs replaces the IDs of the announcements dismissed by the user.
+func (s *Store) SetUserDismissedAnnouncementIDs(ctx context.Context, userID int32, announcementIDs []int32) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_DISMISSED_ANNOUNCEMENTS,
+		Value: &storepb.UserSetting_DismissedAnnouncements{
+			DismissedAnnouncements: &storepb.DismissedAnnouncementsUserSetting{
+				AnnouncementIds: announcementIDs,
+			},
+		},
+	})
+	return err
+}
+
+// GetUserNostrSetting returns the Nostr publishing setting of the user, disabled if not set.
+func (s *Store) GetUserNostrSetting(ctx context.Context, userID int32) (*storepb.NostrUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_NOSTR,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.NostrUserSetting{}, nil
+	}
+	return userSetting.GetNostr(), nil
+}
+
+// GetUserBlueskySetting returns the Bluesky cross-posting setting of the user, disabled if not set.
+func (s *Store) GetUserBlueskySetting(ctx context.Context, userID int32) (*storepb.BlueskyUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_BLUESKY,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.BlueskyUserSetting{}, nil
+	}
+	return userSetting.GetBluesky(), nil
+}
+
+// GetUserMastodonSetting returns the Mastodon cross-posting setting of the user, disabled if not set.
+func (s *Store) GetUserMastodonSetting(ctx context.Context, userID int32) (*storepb.MastodonUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_MASTODON,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.MastodonUserSetting{}, nil
+	}
+	return userSetting.GetMastodon(), nil
+}
+
+// GetUserMicropubSetting returns the Micropub cross-posting setting of the user, disabled if not set.
+func (s *Store) GetUserMicropubSetting(ctx context.Context, userID int32) (*storepb.MicropubUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_MICROPUB,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.MicropubUserSetting{}, nil
+	}
+	return userSetting.GetMicropub(), nil
+}
+
+// GetUserIndieAuthSetting returns the IndieAuth identity of the user, empty if not set.
+func (s *Store) GetUserIndieAuthSetting(ctx context.Context, userID int32) (*storepb.IndieAuthUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_INDIEAUTH,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.IndieAuthUserSetting{}, nil
+	}
+	return userSetting.GetIndieAuth(), nil
+}
+
+// GetUserTaggingRulesSetting returns the tagging rules of the user, empty if not set.
+func (s *Store) GetUserTaggingRulesSetting(ctx context.Context, userID int32) (*storepb.TaggingRulesUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_TAGGING_RULES,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.TaggingRulesUserSetting{}, nil
+	}
+	return userSetting.GetTaggingRules(), nil
+}
+
+// GetUserFormattingSetting returns the formatting setting of the user, no formatters if not set.
+func (s *Store) GetUserFormattingSetting(ctx context.Context, userID int32) (*storepb.FormattingUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_FORMATTING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.FormattingUserSetting{}, nil
+	}
+	return userSetting.GetFormatting(), nil
+}
+
 func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 	userSetting := &storepb.UserSetting{
 		UserId: raw.UserID,
@@ -386,6 +636,96 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_Webhooks{Webhooks: webhooksUserSetting}
+	case storepb.UserSetting_APPROVAL:
+		approvalUserSetting := &storepb.ApprovalUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), approvalUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Approval{Approval: approvalUserSetting}
+	case storepb.UserSetting_FEATURE_FLAGS:
+		featureFlagsUserSetting := &storepb.FeatureFlagsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), featureFlagsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_FeatureFlags{FeatureFlags: featureFlagsUserSetting}
+	case storepb.UserSetting_TAG_METAS:
+		tagMetasUserSetting := &storepb.TagMetasUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), tagMetasUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_TagMetas{TagMetas: tagMetasUserSetting}
+	case storepb.UserSetting_AI_CONVERSATIONS:
+		aiConversationsUserSetting := &storepb.AIConversationsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), aiConversationsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_AiConversations{AiConversations: aiConversationsUserSetting}
+	case storepb.UserSetting_AI_AUTO_SUMMARY:
+		aiAutoSummaryUserSetting := &storepb.AIAutoSummaryUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), aiAutoSummaryUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_AiAutoSummary{AiAutoSummary: aiAutoSummaryUserSetting}
+	case storepb.UserSetting_PROFILE:
+		profileUserSetting := &storepb.ProfileUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), profileUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Profile{Profile: profileUserSetting}
+	case storepb.UserSetting_LEGAL_CONSENT:
+		legalConsentUserSetting := &storepb.LegalConsentUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), legalConsentUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_LegalConsent{LegalConsent: legalConsentUserSetting}
+	case storepb.UserSetting_DISMISSED_ANNOUNCEMENTS:
+		dismissedAnnouncementsUserSetting := &storepb.DismissedAnnouncementsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), dismissedAnnouncementsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_DismissedAnnouncements{DismissedAnnouncements: dismissedAnnouncementsUserSetting}
+	case storepb.UserSetting_NOSTR:
+		nostrUserSetting := &storepb.NostrUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), nostrUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Nostr{Nostr: nostrUserSetting}
+	case storepb.UserSetting_BLUESKY:
+		blueskyUserSetting := &storepb.BlueskyUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), blueskyUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Bluesky{Bluesky: blueskyUserSetting}
+	case storepb.UserSetting_MASTODON:
+		mastodonUserSetting := &storepb.MastodonUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), mastodonUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Mastodon{Mastodon: mastodonUserSetting}
+	case storepb.UserSetting_MICROPUB:
+		micropubUserSetting := &storepb.MicropubUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), micropubUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Micropub{Micropub: micropubUserSetting}
+	case storepb.UserSetting_INDIEAUTH:
+		indieAuthUserSetting := &storepb.IndieAuthUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), indieAuthUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_IndieAuth{IndieAuth: indieAuthUserSetting}
+	case storepb.UserSetting_TAGGING_RULES:
+		taggingRulesUserSetting := &storepb.TaggingRulesUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), taggingRulesUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_TaggingRules{TaggingRules: taggingRulesUserSetting}
+	case storepb.UserSetting_FORMATTING:
+		formattingUserSetting := &storepb.FormattingUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), formattingUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Formatting{Formatting: formattingUserSetting}
 	default:
 		return nil, nil
 	}
@@ -434,6 +774,111 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSetting_APPROVAL:
+		approvalUserSetting := userSetting.GetApproval()
+		value, err := protojson.Marshal(approvalUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_FEATURE_FLAGS:
+		featureFlagsUserSetting := userSetting.GetFeatureFlags()
+		value, err := protojson.Marshal(featureFlagsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_TAG_METAS:
+		tagMetasUserSetting := userSetting.GetTagMetas()
+		value, err := protojson.Marshal(tagMetasUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_AI_CONVERSATIONS:
+		aiConversationsUserSetting := userSetting.GetAiConversations()
+		value, err := protojson.Marshal(aiConversationsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_AI_AUTO_SUMMARY:
+		aiAutoSummaryUserSetting := userSetting.GetAiAutoSummary()
+		value, err := protojson.Marshal(aiAutoSummaryUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_PROFILE:
+		profileUserSetting := userSetting.GetProfile()
+		value, err := protojson.Marshal(profileUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_LEGAL_CONSENT:
+		legalConsentUserSetting := userSetting.GetLegalConsent()
+		value, err := protojson.Marshal(legalConsentUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_DISMISSED_ANNOUNCEMENTS:
+		dismissedAnnouncementsUserSetting := userSetting.GetDismissedAnnouncements()
+		value, err := protojson.Marshal(dismissedAnnouncementsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_NOSTR:
+		nostrUserSetting := userSetting.GetNostr()
+		value, err := protojson.Marshal(nostrUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_BLUESKY:
+		blueskyUserSetting := userSetting.GetBluesky()
+		value, err := protojson.Marshal(blueskyUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_MASTODON:
+		mastodonUserSetting := userSetting.GetMastodon()
+		value, err := protojson.Marshal(mastodonUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_MICROPUB:
+		micropubUserSetting := userSetting.GetMicropub()
+		value, err := protojson.Marshal(micropubUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_INDIEAUTH:
+		indieAuthUserSetting := userSetting.GetIndieAuth()
+		value, err := protojson.Marshal(indieAuthUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_TAGGING_RULES:
+		taggingRulesUserSetting := userSetting.GetTaggingRules()
+		value, err := protojson.Marshal(taggingRulesUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_FORMATTING:
+		formattingUserSetting := userSetting.GetFormatting()
+		value, err := protojson.Marshal(formattingUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	default:
 		return nil, errors.Errorf("unsupported user setting key: %v", userSetting.Key)
 	}
@@ -0,0 +1,72 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// UsernameAlias is a previous username of a user, which still resolves to the user until it expires so that the
+// links with it keep working. Other users cannot take it meanwhile.
+type UsernameAlias struct {
+	Username  string
+	UserID    int32
+	CreatedTs int64
+	ExpiresTs int64
+}
+
+type FindUsernameAlias struct {
+	Username *string
+	UserID   *int32
+	// ExpiresAfter keeps the aliases expiring after the timestamp.
+	ExpiresAfter *int64
+}
+
+type DeleteUsernameAlias struct {
+	Username *string
+	UserID   *int32
+}
+
+// UpsertUsernameAlias points the username to the user, replacing the alias of the same username if any.
+func (s *Store) UpsertUsernameAlias(ctx context.Context, upsert *UsernameAlias) (*UsernameAlias, error) {
+	if upsert.CreatedTs == 0 {
+		upsert.CreatedTs = time.Now().Unix()
+	}
+	if err := s.driver.UpsertUsernameAlias(ctx, upsert); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+// ListUsernameAliases lists the aliases, the most recent first.
+func (s *Store) ListUsernameAliases(ctx context.Context, find *FindUsernameAlias) ([]*UsernameAlias, error) {
+	return s.driver.ListUsernameAliases(ctx, find)
+}
+
+func (s *Store) GetUsernameAlias(ctx context.Context, find *FindUsernameAlias) (*UsernameAlias, error) {
+	list, err := s.ListUsernameAliases(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteUsernameAlias(ctx context.Context, delete *DeleteUsernameAlias) error {
+	return s.driver.DeleteUsernameAlias(ctx, delete)
+}
+
+// GetUserByUsername returns the user of the username, or of the unexpired alias of the username.
+func (s *Store) GetUserByUsername(ctx context.Context, username string) (*User, error) {
+	user, err := s.GetUser(ctx, &FindUser{Username: &username})
+	if err != nil || user != nil {
+		return user, err
+	}
+	now := time.Now().Unix()
+	alias, err := s.GetUsernameAlias(ctx, &FindUsernameAlias{Username: &username, ExpiresAfter: &now})
+	if err != nil || alias == nil {
+		return nil, err
+	}
+	return s.GetUser(ctx, &FindUser{ID: &alias.UserID})
+}
@@ -0,0 +1,72 @@
+package store
+
+import (
+	"context"
+)
+
+// WebhookDelivery is a request sent to a user webhook, kept so that users can debug their webhooks.
+type WebhookDelivery struct {
+	ID        int32
+	CreatedTs int64
+
+	UserID       int32
+	WebhookID    string
+	ActivityType string
+	// StatusCode is the HTTP status code of the response, 0 if no response was received.
+	StatusCode int32
+	// Error is the error of the request, empty when it succeeded.
+	Error string
+}
+
+type FindWebhookDelivery struct {
+	ID        *int32
+	UserID    *int32
+	WebhookID *string
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type DeleteWebhookDelivery struct {
+	UserID    int32
+	WebhookID string
+	// MaxID, if set, only deletes the deliveries with an id lower than or equal to it.
+	MaxID *int32
+}
+
+func (s *Store) CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error) {
+	return s.driver.CreateWebhookDelivery(ctx, create)
+}
+
+// ListWebhookDeliveries lists webhook deliveries, most recent first.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error) {
+	return s.driver.ListWebhookDeliveries(ctx, find)
+}
+
+func (s *Store) DeleteWebhookDeliveries(ctx context.Context, delete *DeleteWebhookDelivery) error {
+	return s.driver.DeleteWebhookDeliveries(ctx, delete)
+}
+
+// PruneWebhookDeliveries deletes the deliveries of the webhook but the keep most recent ones.
+func (s *Store) PruneWebhookDeliveries(ctx context.Context, userID int32, webhookID string, keep int) error {
+	offset := keep
+	limit := 1
+	list, err := s.ListWebhookDeliveries(ctx, &FindWebhookDelivery{
+		UserID:    &userID,
+		WebhookID: &webhookID,
+		Limit:     &limit,
+		Offset:    &offset,
+	})
+	if err != nil {
+		return err
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	return s.DeleteWebhookDeliveries(ctx, &DeleteWebhookDelivery{
+		UserID:    userID,
+		WebhookID: webhookID,
+		MaxID:     &list[0].ID,
+	})
+}
@@ -0,0 +1,84 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// WebmentionType is the type of the response to a memo a Webmention notified of.
+type WebmentionType string
+
+const (
+	WebmentionMention  WebmentionType = "MENTION"
+	WebmentionReply    WebmentionType = "REPLY"
+	WebmentionLike     WebmentionType = "LIKE"
+	WebmentionRepost   WebmentionType = "REPOST"
+	WebmentionBookmark WebmentionType = "BOOKMARK"
+)
+
+func (t WebmentionType) String() string {
+	return string(t)
+}
+
+// Webmention is a verified Webmention of a public memo, a page of another site responding to the memo.
+type Webmention struct {
+	ID     int32
+	MemoID int32
+	// Source is the URL of the page responding to the memo, unique per memo.
+	Source      string
+	Type        WebmentionType
+	AuthorName  string
+	AuthorURL   string
+	AuthorPhoto string
+	// Content is the text of the response, truncated.
+	Content string
+	// PublishedTs is the time the response was published, 0 if unknown.
+	PublishedTs int64
+	CreatedTs   int64
+	UpdatedTs   int64
+}
+
+type FindWebmention struct {
+	ID     *int32
+	MemoID *int32
+	Source *string
+}
+
+type DeleteWebmention struct {
+	ID     *int32
+	MemoID *int32
+	Source *string
+}
+
+// UpsertWebmention creates a Webmention, or updates the one of the memo with the same source, which keeps its
+// creation time.
+func (s *Store) UpsertWebmention(ctx context.Context, upsert *Webmention) error {
+	now := time.Now().Unix()
+	if upsert.CreatedTs == 0 {
+		upsert.CreatedTs = now
+	}
+	if upsert.UpdatedTs == 0 {
+		upsert.UpdatedTs = now
+	}
+	return s.driver.UpsertWebmention(ctx, upsert)
+}
+
+// ListWebmentions lists the Webmentions, the oldest first.
+func (s *Store) ListWebmentions(ctx context.Context, find *FindWebmention) ([]*Webmention, error) {
+	return s.driver.ListWebmentions(ctx, find)
+}
+
+func (s *Store) GetWebmention(ctx context.Context, find *FindWebmention) (*Webmention, error) {
+	list, err := s.ListWebmentions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteWebmention(ctx context.Context, delete *DeleteWebmention) error {
+	return s.driver.DeleteWebmention(ctx, delete)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
@@ -39,6 +40,28 @@ func (s *Store) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.Work
 		valueBytes, err = protojson.Marshal(upsert.GetMemoRelatedSetting())
 	} else if upsert.Key == storepb.WorkspaceSettingKey_AI_CONFIG {
 		valueBytes, err = protojson.Marshal(upsert.GetAiSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_ONBOARDING {
+		valueBytes, err = protojson.Marshal(upsert.GetOnboardingSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_NEW_USER_LIMIT {
+		valueBytes, err = protojson.Marshal(upsert.GetNewUserLimitSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_FEATURE_FLAGS {
+		valueBytes, err = protojson.Marshal(upsert.GetFeatureFlagSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_RUNNERS {
+		valueBytes, err = protojson.Marshal(upsert.GetRunnerSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_USAGE_LIMIT {
+		valueBytes, err = protojson.Marshal(upsert.GetUsageLimitSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_AI_USAGE {
+		valueBytes, err = protojson.Marshal(upsert.GetAiUsage())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_SENSITIVE_CONTENT {
+		valueBytes, err = protojson.Marshal(upsert.GetSensitiveContentSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_OUTBOUND_FETCH {
+		valueBytes, err = protojson.Marshal(upsert.GetOutboundFetchSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_LEGAL {
+		valueBytes, err = protojson.Marshal(upsert.GetLegalSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_MAINTENANCE {
+		valueBytes, err = protojson.Marshal(upsert.GetMaintenanceSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_ALERTING {
+		valueBytes, err = protojson.Marshal(upsert.GetAlertingSetting())
 	} else if upsert.Key == storepb.WorkspaceSettingKey_AI_RATE_LIMIT {
 		valueString := upsert.GetAiRateLimit()
 		workspaceSettingRaw.Value = valueString
@@ -223,6 +246,275 @@ func (s *Store) GetWorkspaceStorageSetting(ctx context.Context) (*storepb.Worksp
 	return workspaceStorageSetting, nil
 }
 
+func (s *Store) GetWorkspaceOnboardingSetting(ctx context.Context) (*storepb.WorkspaceOnboardingSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_ONBOARDING.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace onboarding setting")
+	}
+
+	workspaceOnboardingSetting := &storepb.WorkspaceOnboardingSetting{}
+	if workspaceSetting != nil {
+		workspaceOnboardingSetting = workspaceSetting.GetOnboardingSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_ONBOARDING.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_ONBOARDING,
+		Value: &storepb.WorkspaceSetting_OnboardingSetting{OnboardingSetting: workspaceOnboardingSetting},
+	})
+	return workspaceOnboardingSetting, nil
+}
+
+func (s *Store) GetWorkspaceNewUserLimitSetting(ctx context.Context) (*storepb.WorkspaceNewUserLimitSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_NEW_USER_LIMIT.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace new user limit setting")
+	}
+
+	workspaceNewUserLimitSetting := &storepb.WorkspaceNewUserLimitSetting{}
+	if workspaceSetting != nil {
+		workspaceNewUserLimitSetting = workspaceSetting.GetNewUserLimitSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_NEW_USER_LIMIT.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_NEW_USER_LIMIT,
+		Value: &storepb.WorkspaceSetting_NewUserLimitSetting{NewUserLimitSetting: workspaceNewUserLimitSetting},
+	})
+	return workspaceNewUserLimitSetting, nil
+}
+
+func (s *Store) GetWorkspaceFeatureFlagSetting(ctx context.Context) (*storepb.WorkspaceFeatureFlagSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_FEATURE_FLAGS.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace feature flag setting")
+	}
+
+	workspaceFeatureFlagSetting := &storepb.WorkspaceFeatureFlagSetting{}
+	if workspaceSetting != nil {
+		workspaceFeatureFlagSetting = workspaceSetting.GetFeatureFlagSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_FEATURE_FLAGS.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_FEATURE_FLAGS,
+		Value: &storepb.WorkspaceSetting_FeatureFlagSetting{FeatureFlagSetting: workspaceFeatureFlagSetting},
+	})
+	return workspaceFeatureFlagSetting, nil
+}
+
+func (s *Store) GetWorkspaceRunnerSetting(ctx context.Context) (*storepb.WorkspaceRunnerSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_RUNNERS.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace runner setting")
+	}
+
+	workspaceRunnerSetting := &storepb.WorkspaceRunnerSetting{}
+	if workspaceSetting != nil {
+		workspaceRunnerSetting = workspaceSetting.GetRunnerSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_RUNNERS.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_RUNNERS,
+		Value: &storepb.WorkspaceSetting_RunnerSetting{RunnerSetting: workspaceRunnerSetting},
+	})
+	return workspaceRunnerSetting, nil
+}
+
+func (s *Store) GetWorkspaceUsageLimitSetting(ctx context.Context) (*storepb.WorkspaceUsageLimitSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_USAGE_LIMIT.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace usage limit setting")
+	}
+
+	workspaceUsageLimitSetting := &storepb.WorkspaceUsageLimitSetting{}
+	if workspaceSetting != nil {
+		workspaceUsageLimitSetting = workspaceSetting.GetUsageLimitSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_USAGE_LIMIT.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_USAGE_LIMIT,
+		Value: &storepb.WorkspaceSetting_UsageLimitSetting{UsageLimitSetting: workspaceUsageLimitSetting},
+	})
+	return workspaceUsageLimitSetting, nil
+}
+
+func (s *Store) GetWorkspaceAIUsage(ctx context.Context) (*storepb.WorkspaceAIUsage, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_AI_USAGE.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace AI usage")
+	}
+
+	workspaceAIUsage := &storepb.WorkspaceAIUsage{}
+	if workspaceSetting != nil {
+		workspaceAIUsage = workspaceSetting.GetAiUsage()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_AI_USAGE.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_AI_USAGE,
+		Value: &storepb.WorkspaceSetting_AiUsage{AiUsage: workspaceAIUsage},
+	})
+	return workspaceAIUsage, nil
+}
+
+func (s *Store) GetWorkspaceAISetting(ctx context.Context) (*storepb.WorkspaceAISetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_AI_CONFIG.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace AI setting")
+	}
+
+	workspaceAISetting := &storepb.WorkspaceAISetting{}
+	if workspaceSetting != nil && workspaceSetting.GetAiSetting() != nil {
+		workspaceAISetting = workspaceSetting.GetAiSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_AI_CONFIG.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_AI_CONFIG,
+		Value: &storepb.WorkspaceSetting_AiSetting{AiSetting: workspaceAISetting},
+	})
+	return workspaceAISetting, nil
+}
+
+// The AI features the workspace AI setting can route to a named AI profile.
+const (
+	AIFeatureSummary       = "SUMMARY"
+	AIFeatureChat          = "CHAT"
+	AIFeatureTagSuggestion = "TAG_SUGGESTION"
+	AIFeatureVoiceMemo     = "VOICE_MEMO"
+	AIFeatureEmbedding     = "EMBEDDING"
+	AIFeatureTransform     = "TRANSFORM"
+	// AIFeatureAttachmentExtraction is the description of the images and the transcription of the audio attachments.
+	AIFeatureAttachmentExtraction = "ATTACHMENT_EXTRACTION"
+)
+
+// AIFeatures lists the AI features that can be routed to a named AI profile.
+var AIFeatures = []string{AIFeatureSummary, AIFeatureChat, AIFeatureTagSuggestion, AIFeatureVoiceMemo, AIFeatureEmbedding, AIFeatureTransform, AIFeatureAttachmentExtraction}
+
+// GetAIProfileSetting returns the AI setting with the provider configuration and models of the named profile,
+// or nil if there is no such profile. The empty name is the provider configured in the setting itself.
+func GetAIProfileSetting(setting *storepb.WorkspaceAISetting, name string) *storepb.WorkspaceAISetting {
+	if name == "" {
+		return setting
+	}
+	for _, profile := range setting.GetProfiles() {
+		if profile.Name != name {
+			continue
+		}
+		profileSetting := proto.Clone(setting).(*storepb.WorkspaceAISetting)
+		profileSetting.Provider = profile.Provider
+		profileSetting.Endpoint = profile.Endpoint
+		profileSetting.ApiKey = profile.ApiKey
+		profileSetting.ApiVersion = profile.ApiVersion
+		profileSetting.Model = profile.Model
+		profileSetting.EmbeddingModel = profile.EmbeddingModel
+		profileSetting.TranscriptionModel = profile.TranscriptionModel
+		profileSetting.SummaryModel = profile.SummaryModel
+		profileSetting.ChatModel = profile.ChatModel
+		profileSetting.VisionModel = profile.VisionModel
+		return profileSetting
+	}
+	return nil
+}
+
+func (s *Store) GetWorkspaceSensitiveContentSetting(ctx context.Context) (*storepb.WorkspaceSensitiveContentSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_SENSITIVE_CONTENT.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace sensitive content setting")
+	}
+
+	workspaceSensitiveContentSetting := &storepb.WorkspaceSensitiveContentSetting{}
+	if workspaceSetting != nil {
+		workspaceSensitiveContentSetting = workspaceSetting.GetSensitiveContentSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_SENSITIVE_CONTENT.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_SENSITIVE_CONTENT,
+		Value: &storepb.WorkspaceSetting_SensitiveContentSetting{SensitiveContentSetting: workspaceSensitiveContentSetting},
+	})
+	return workspaceSensitiveContentSetting, nil
+}
+
+func (s *Store) GetWorkspaceOutboundFetchSetting(ctx context.Context) (*storepb.WorkspaceOutboundFetchSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_OUTBOUND_FETCH.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace outbound fetch setting")
+	}
+
+	workspaceOutboundFetchSetting := &storepb.WorkspaceOutboundFetchSetting{}
+	if workspaceSetting != nil {
+		workspaceOutboundFetchSetting = workspaceSetting.GetOutboundFetchSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_OUTBOUND_FETCH.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_OUTBOUND_FETCH,
+		Value: &storepb.WorkspaceSetting_OutboundFetchSetting{OutboundFetchSetting: workspaceOutboundFetchSetting},
+	})
+	return workspaceOutboundFetchSetting, nil
+}
+
+func (s *Store) GetWorkspaceLegalSetting(ctx context.Context) (*storepb.WorkspaceLegalSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_LEGAL.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace legal setting")
+	}
+
+	workspaceLegalSetting := &storepb.WorkspaceLegalSetting{}
+	if workspaceSetting != nil {
+		workspaceLegalSetting = workspaceSetting.GetLegalSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_LEGAL.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_LEGAL,
+		Value: &storepb.WorkspaceSetting_LegalSetting{LegalSetting: workspaceLegalSetting},
+	})
+	return workspaceLegalSetting, nil
+}
+
+func (s *Store) GetWorkspaceMaintenanceSetting(ctx context.Context) (*storepb.WorkspaceMaintenanceSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_MAINTENANCE.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace maintenance setting")
+	}
+
+	workspaceMaintenanceSetting := &storepb.WorkspaceMaintenanceSetting{}
+	if workspaceSetting != nil {
+		workspaceMaintenanceSetting = workspaceSetting.GetMaintenanceSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_MAINTENANCE.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_MAINTENANCE,
+		Value: &storepb.WorkspaceSetting_MaintenanceSetting{MaintenanceSetting: workspaceMaintenanceSetting},
+	})
+	return workspaceMaintenanceSetting, nil
+}
+
+func (s *Store) GetWorkspaceAlertingSetting(ctx context.Context) (*storepb.WorkspaceAlertingSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_ALERTING.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace alerting setting")
+	}
+
+	workspaceAlertingSetting := &storepb.WorkspaceAlertingSetting{}
+	if workspaceSetting != nil {
+		workspaceAlertingSetting = workspaceSetting.GetAlertingSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_ALERTING.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_ALERTING,
+		Value: &storepb.WorkspaceSetting_AlertingSetting{AlertingSetting: workspaceAlertingSetting},
+	})
+	return workspaceAlertingSetting, nil
+}
+
 func convertWorkspaceSettingFromRaw(workspaceSettingRaw *WorkspaceSetting) (*storepb.WorkspaceSetting, error) {
 	workspaceSetting := &storepb.WorkspaceSetting{
 		Key: storepb.WorkspaceSettingKey(storepb.WorkspaceSettingKey_value[workspaceSettingRaw.Name]),
@@ -258,6 +550,72 @@ func convertWorkspaceSettingFromRaw(workspaceSettingRaw *WorkspaceSetting) (*sto
 			return nil, err
 		}
 		workspaceSetting.Value = &storepb.WorkspaceSetting_AiSetting{AiSetting: aiSetting}
+	case storepb.WorkspaceSettingKey_ONBOARDING.String():
+		onboardingSetting := &storepb.WorkspaceOnboardingSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), onboardingSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_OnboardingSetting{OnboardingSetting: onboardingSetting}
+	case storepb.WorkspaceSettingKey_NEW_USER_LIMIT.String():
+		newUserLimitSetting := &storepb.WorkspaceNewUserLimitSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), newUserLimitSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_NewUserLimitSetting{NewUserLimitSetting: newUserLimitSetting}
+	case storepb.WorkspaceSettingKey_FEATURE_FLAGS.String():
+		featureFlagSetting := &storepb.WorkspaceFeatureFlagSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), featureFlagSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_FeatureFlagSetting{FeatureFlagSetting: featureFlagSetting}
+	case storepb.WorkspaceSettingKey_RUNNERS.String():
+		runnerSetting := &storepb.WorkspaceRunnerSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), runnerSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_RunnerSetting{RunnerSetting: runnerSetting}
+	case storepb.WorkspaceSettingKey_USAGE_LIMIT.String():
+		usageLimitSetting := &storepb.WorkspaceUsageLimitSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), usageLimitSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_UsageLimitSetting{UsageLimitSetting: usageLimitSetting}
+	case storepb.WorkspaceSettingKey_AI_USAGE.String():
+		aiUsage := &storepb.WorkspaceAIUsage{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), aiUsage); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_AiUsage{AiUsage: aiUsage}
+	case storepb.WorkspaceSettingKey_SENSITIVE_CONTENT.String():
+		sensitiveContentSetting := &storepb.WorkspaceSensitiveContentSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), sensitiveContentSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_SensitiveContentSetting{SensitiveContentSetting: sensitiveContentSetting}
+	case storepb.WorkspaceSettingKey_OUTBOUND_FETCH.String():
+		outboundFetchSetting := &storepb.WorkspaceOutboundFetchSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), outboundFetchSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_OutboundFetchSetting{OutboundFetchSetting: outboundFetchSetting}
+	case storepb.WorkspaceSettingKey_LEGAL.String():
+		legalSetting := &storepb.WorkspaceLegalSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), legalSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_LegalSetting{LegalSetting: legalSetting}
+	case storepb.WorkspaceSettingKey_MAINTENANCE.String():
+		maintenanceSetting := &storepb.WorkspaceMaintenanceSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), maintenanceSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_MaintenanceSetting{MaintenanceSetting: maintenanceSetting}
+	case storepb.WorkspaceSettingKey_ALERTING.String():
+		alertingSetting := &storepb.WorkspaceAlertingSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), alertingSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_AlertingSetting{AlertingSetting: alertingSetting}
 	case storepb.WorkspaceSettingKey_AI_RATE_LIMIT.String():
 		workspaceSetting.Value = &storepb.WorkspaceSetting_AiRateLimit{AiRateLimit: workspaceSettingRaw.Value}
 	default:
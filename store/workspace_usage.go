@@ -0,0 +1,18 @@
+package store
+
+import (
+	"context"
+)
+
+// WorkspaceUsage is the usage of the whole workspace counted against its usage limits.
+type WorkspaceUsage struct {
+	UserCount int32
+	// MemoCount includes comments and the memos in cold storage.
+	MemoCount int32
+	// StorageBytes is the total size of the attachments.
+	StorageBytes int64
+}
+
+func (s *Store) GetWorkspaceUsage(ctx context.Context) (*WorkspaceUsage, error) {
+	return s.driver.GetWorkspaceUsage(ctx)
+}